@@ -22,6 +22,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/constants"
@@ -121,6 +122,11 @@ func main() {
 			logger.Fatal(ctx, "Runtime crypto provider does not support TLS material retrieval")
 		}
 		tlsConfig := loadCertConfig(ctx, logger, tlsConfigProvider)
+		if cfg.OAuth.MTLS.Enabled {
+			// Request, but do not require, a client certificate: only the OAuth endpoints of mTLS
+			// clients depend on it, and browsers must not be prompted for one.
+			tlsConfig.ClientAuth = tls.RequestClientCert
+		}
 		ln = createTLSListener(ctx, logger, server, tlsConfig)
 	}
 
@@ -232,14 +238,20 @@ func accessLogExcludePaths(configured []string) []string {
 func createHTTPServer(ctx context.Context, logger *log.Logger, cfg *config.Config, mux *http.ServeMux,
 	jwtService jwt.JWTServiceInterface, revocationEnforcer revocationcache.EnforcerInterface) *http.Server {
 	securityMiddleware := createSecurityMiddleware(ctx, logger, mux, jwtService, revocationEnforcer)
+	if cfg.OAuth.MTLS.Enabled {
+		securityMiddleware = createMTLSMiddleware(ctx, logger, cfg, securityMiddleware)
+	}
 
 	// Build the middleware chain with proper execution order.
-	// Request flow: CorrelationID (outermost) -> SecurityHeaders -> AccessLog -> Security -> Route Handler (innermost)
+	// Request flow: CorrelationID (outermost) -> ClientIP -> UserAgent -> SecurityHeaders -> AccessLog ->
+	// Security -> Route Handler (innermost)
 	// Note: Middlewares are wrapped in reverse order - the last added will execute first.
 	// The Gate and Console frontend paths are always excluded from the access log to keep it
 	// focused on API traffic. Additional prefixes can be excluded via log.access.exclude_paths.
 	handler := log.AccessLogHandler(logger, accessLogExcludePaths(cfg.Log.Access.ExcludePaths), securityMiddleware)
 	handler = middleware.SecurityHeadersMiddleware()(handler)
+	handler = middleware.UserAgentMiddleware(handler)
+	handler = createClientIPMiddleware(ctx, logger, cfg, handler)
 	handler = middleware.CorrelationIDMiddleware(handler)
 
 	// Build the server address using hostname and port from the configurations.
@@ -285,6 +297,28 @@ func createSecurityMiddleware(ctx context.Context, logger *log.Logger, mux *http
 	return middlewareFunc(mux)
 }
 
+// createMTLSMiddleware wraps next with the middleware that captures the client certificate used for
+// OAuth 2.0 mutual-TLS client authentication and certificate-bound tokens.
+func createMTLSMiddleware(ctx context.Context, logger *log.Logger, cfg *config.Config,
+	next http.Handler) http.Handler {
+	middlewareFunc, err := mtls.Initialize(cfg.OAuth.MTLS)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize mTLS middleware", log.Error(err))
+	}
+	return middlewareFunc(next)
+}
+
+// createClientIPMiddleware wraps next with the middleware that resolves the client IP of each
+// request.
+func createClientIPMiddleware(ctx context.Context, logger *log.Logger, cfg *config.Config,
+	next http.Handler) http.Handler {
+	middlewareFunc, err := middleware.ClientIPMiddleware(cfg.Lockout.ClientIPHeader, cfg.Lockout.TrustedProxies)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize client IP middleware", log.Error(err))
+	}
+	return middlewareFunc(next)
+}
+
 // gracefulShutdown handles the graceful shutdown of all components.
 func gracefulShutdown(
 	ctx context.Context,
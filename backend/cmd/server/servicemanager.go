@@ -16,6 +16,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/application"
 	"github.com/thunder-id/thunderid/internal/attestation"
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/auditlog"
 	"github.com/thunder-id/thunderid/internal/authn"
 	authnAssert "github.com/thunder-id/thunderid/internal/authn/assert"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
@@ -28,7 +29,10 @@ import (
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	authnSAML "github.com/thunder-id/thunderid/internal/authn/saml"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
 	"github.com/thunder-id/thunderid/internal/authnprovider/defaultprovider"
+	"github.com/thunder-id/thunderid/internal/authnprovider/ldapprovider"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/authnprovider/restprovider"
 	"github.com/thunder-id/thunderid/internal/authz"
@@ -54,19 +58,27 @@ import (
 	"github.com/thunder-id/thunderid/internal/group"
 	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	"github.com/thunder-id/thunderid/internal/notification"
 	"github.com/thunder-id/thunderid/internal/oauth"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/backchannellogout"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dcr"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/frontchannellogout"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/openid4vci"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/passwordpolicy"
 	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/role"
 	"github.com/thunder-id/thunderid/internal/runtimestore"
+	"github.com/thunder-id/thunderid/internal/saml"
+	"github.com/thunder-id/thunderid/internal/scim"
 	"github.com/thunder-id/thunderid/internal/serverconfig"
+	"github.com/thunder-id/thunderid/internal/ssf"
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/cmodels"
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -93,8 +105,10 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/template"
 	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/internal/userbulk"
 	"github.com/thunder-id/thunderid/internal/vc/credential"
 	"github.com/thunder-id/thunderid/internal/vc/presentation"
+	"github.com/thunder-id/thunderid/internal/webhook"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -153,7 +167,8 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	ouAuthzService, err := sysauthz.Initialize()
 	fatalOnError(ctx, logger, err, "Failed to initialize system authorization service")
 
-	ouService, ouHierarchyResolver, ouExporter, err := ou.Initialize(mux, mcpServer, cacheManager, ouAuthzService)
+	ouService, ouHierarchyResolver, ouExporter, err := ou.Initialize(mux, mcpServer, cacheManager, ouAuthzService,
+		observabilitySvc)
 	fatalOnError(ctx, logger, err, "Failed to initialize OrganizationUnitService")
 	exporters = append(exporters, ouExporter)
 
@@ -178,27 +193,46 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	entityService, err := entity.Initialize(cacheManager, hashService, entityTypeService, ouService)
 	fatalOnError(ctx, logger, err, "Failed to initialize EntityService")
 
+	passwordPolicyService, err := passwordpolicy.Initialize(entityService, hashService)
+	fatalOnError(ctx, logger, err, "Failed to initialize PasswordPolicyService")
+
 	// Initialize entity provider
-	entityProvider := entityprovider.InitializeEntityProvider(entityService)
+	entityProvider, err := entityprovider.InitializeEntityProvider(entityService)
+	fatalOnError(ctx, logger, err, "Failed to initialize EntityProvider")
+
+	runtimeStoreProvider, transactioner, err := runtimestore.Initialize(runtime.Config.Database.RuntimeTransient.Type,
+		runtime.Config.Server.Identifier)
+	fatalOnError(ctx, logger, err, "Failed to initialize runtime store")
+
+	// Initialize the account lockout service
+	lockoutService := lockout.Initialize(runtimeStoreProvider, entityProvider)
 
 	userService, ouUserResolver, userExporter, err := user.Initialize(
-		mux, entityService, ouService, entityTypeService, ouAuthzService,
+		mux, entityService, ouService, entityTypeService, ouAuthzService, lockoutService, observabilitySvc,
 	)
 	fatalOnError(ctx, logger, err, "Failed to initialize UserService")
 	exporters = append(exporters, userExporter)
 
+	// Initialize the bulk user import and export API.
+	userbulk.Initialize(mux, userService, entityTypeService, ouAuthzService, observabilitySvc)
+
 	groupService, ouGroupResolver, groupExporter, err := group.Initialize(
 		mux, dbprovider.GetDBProvider(), ouService, entityService, entityTypeService, ouAuthzService,
+		observabilitySvc,
 	)
 	fatalOnError(ctx, logger, err, "Failed to initialize GroupService")
 	exporters = append(exporters, groupExporter)
 
-	resourceService, resourceExporter, err := resource.Initialize(mux, ouService)
+	_, err = scim.Initialize(mux, userService, groupService, entityTypeService)
+	fatalOnError(ctx, logger, err, "Failed to initialize SCIM server")
+
+	resourceService, resourceExporter, err := resource.Initialize(mux, ouService, observabilitySvc)
 	fatalOnError(ctx, logger, err, "Failed to initialize Resource Service")
 	exporters = append(exporters, resourceExporter)
 
 	roleService, roleAssignmentService, ouRoleResolver, roleExporter, err := role.Initialize(
 		mux, entityService, groupService, ouService, resourceService, entityTypeService, ouAuthzService,
+		observabilitySvc,
 	)
 	fatalOnError(ctx, logger, err, "Failed to initialize RoleService")
 	exporters = append(exporters, roleExporter)
@@ -216,7 +250,13 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	authZService := authz.Initialize(roleService)
 
-	idpService, err := idp.Initialize(cacheManager, entityTypeService)
+	// Initialize the audit event search API over the events stored by the database observability subscriber.
+	auditlog.Initialize(mux, ouAuthzService)
+
+	// Initialize the webhook management API. Deliveries are sent by the webhook observability subscriber.
+	webhook.Initialize(mux, ouAuthzService, observabilitySvc)
+
+	idpService, err := idp.Initialize(cacheManager, entityTypeService, observabilitySvc)
 	fatalOnError(ctx, logger, err, "Failed to initialize IDPService")
 
 	templateService, err := template.Initialize()
@@ -237,23 +277,27 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Initialize otp core service
 	otpCoreService := otp.Initialize(notifOTPService)
 
+	// Initialize authenticator app (TOTP) service
+	totpService, err := totp.Initialize(entityProvider, hashService, runtimeCryptoSvc, runtimeStoreProvider)
+	fatalOnError(ctx, logger, err, "Failed to initialize TOTP service")
+
 	// Initialize federated authentication services.
 	oauthAuthnService := authnOAuth.Initialize(idpService, entityProvider)
 	oidcAuthnService := authnOIDC.Initialize(oauthAuthnService, jwtService)
 	googleAuthnService := google.Initialize(oidcAuthnService, jwtService)
 	githubAuthnService := github.Initialize(oauthAuthnService)
+	samlAuthnService, err := authnSAML.Initialize(mux, oauthAuthnService, idpService, runtimeCryptoSvc,
+		runtimeStoreProvider)
+	fatalOnError(ctx, logger, err, "Failed to initialize SAML authentication service")
 
 	federatedAuths := map[providers.IDPType]authncm.FederatedAuthenticator{
 		providers.IDPTypeOAuth:  oauthAuthnService,
 		providers.IDPTypeOIDC:   oidcAuthnService,
 		providers.IDPTypeGoogle: googleAuthnService,
 		providers.IDPTypeGitHub: githubAuthnService,
+		providers.IDPTypeSAML:   samlAuthnService,
 	}
 
-	runtimeStoreProvider, transactioner, err := runtimestore.Initialize(runtime.Config.Database.RuntimeTransient.Type,
-		runtime.Config.Server.Identifier)
-	fatalOnError(ctx, logger, err, "Failed to initialize runtime store")
-
 	// Initialize passkey service
 	passkeyService := passkey.Initialize(entityService, runtimeStoreProvider)
 
@@ -267,7 +311,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 			ouService, runtimeStoreProvider, exporters)
 
 	defaultProvider := defaultprovider.Initialize(entityService, passkeyService,
-		otpCoreService, magicLinkService, openid4vpSvc, federatedAuths)
+		otpCoreService, totpService, magicLinkService, openid4vpSvc, federatedAuths)
 
 	customProviders := map[string]providers.CustomAuthnProvider{}
 	restCfg := runtime.Config.AuthnProvider.Rest
@@ -279,6 +323,15 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 			Creds:    restCfg.CredentialTypes,
 		}
 	}
+	if runtime.Config.EntityProvider.Type == entityprovider.TypeLDAP {
+		ldapProvider, err := ldapprovider.Initialize(runtime.Config.EntityProvider.LDAP, entityProvider,
+			defaultProvider)
+		fatalOnError(ctx, logger, err, "Failed to initialize LDAP authn provider")
+		customProviders[ldapprovider.Name] = providers.CustomAuthnProvider{
+			Instance: ldapProvider,
+			Creds:    runtime.Config.EntityProvider.LDAP.CredentialTypes,
+		}
+	}
 	authnProvider, err := authnprovidermgr.Initialize(defaultProvider, customProviders)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize authn provider manager", log.Error(err))
@@ -290,7 +343,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	_, directAuthGuard := authn.Initialize(mux, mcpServer, idpService, jwtService, authnProvider, authAssertGen,
 		otpCoreService, notifSenderSvc, templateService, magicLinkService, oauthAuthnService,
-		oidcAuthnService, googleAuthnService, githubAuthnService,
+		oidcAuthnService, googleAuthnService, githubAuthnService, lockoutService,
 		runtime.Config.Server.SecurityConfig.DirectAuthSecret)
 
 	// AuthZEN access-evaluation endpoints are Direct API endpoints, so they reuse the Direct Auth
@@ -315,7 +368,8 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		serverconfig.ConfigNameFlow:                  flowConfigHandler,
 		serverconfig.ConfigNameCSP:                   csp.PolicyHandler{},
 	}
-	serverConfigService, serverConfigExporter, err := serverconfig.Initialize(mux, cacheManager, serverConfigHandlers)
+	serverConfigService, serverConfigExporter, err := serverconfig.Initialize(mux, cacheManager, serverConfigHandlers,
+		observabilitySvc)
 	fatalOnError(ctx, logger, err, "Failed to initialize server config service")
 	exporters = append(exporters, serverConfigExporter)
 
@@ -333,12 +387,30 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	flowConfig := flowconfig.FromServerRuntime()
 	tokenFamilyRevocationTTL := time.Duration(runtime.Config.OAuth.RefreshToken.ValidityPeriod) * time.Second
+	// The Shared Signals transmitter, when enabled, is told about ended sessions and criteria revocations.
+	_, ssfTransmitter := ssf.Initialize(mux, jwtService, ouAuthzService, observabilitySvc, oauthCfg)
+	var criteriaRevocationNotifier revocation.CriteriaRevocationNotifier
+	if ssfTransmitter != nil {
+		criteriaRevocationNotifier = ssfTransmitter
+	}
 	revocationEnforcer, revocationSvc := revocation.Initialize(jwtService, observabilitySvc,
-		tokenFamilyRevocationTTL, runtime.Config.OAuth.Revocation.TokenFamily.OnExplicitRevokeEnabled())
+		tokenFamilyRevocationTTL, runtime.Config.OAuth.Revocation.TokenFamily.OnExplicitRevokeEnabled(),
+		criteriaRevocationNotifier)
 	sessionRevoker := sessionCriteriaRevoker{revoker: revocationSvc}
+	pairwiseSubjectSvc, err := pairwise.Initialize(oauthCfg)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize pairwise subject service", log.Error(err))
+	}
+	backchannelLogoutSvc := backchannellogout.Initialize(jwtService, pairwiseSubjectSvc, oauthCfg)
+	frontchannelLogoutSvc := frontchannellogout.Initialize(runtimeStoreProvider, oauthCfg)
+	logoutNotifiers := sessionLogoutNotifiers{backchannelLogoutSvc, frontchannelLogoutSvc}
+	if ssfTransmitter != nil {
+		logoutNotifiers = append(logoutNotifiers, ssfTransmitter)
+	}
 	sessionService, sessionCfg := initSessionService(ctx, serverConfigService,
-		runtime.Config.Server.Identifier, sessionRevoker, logger)
+		runtime.Config.Server.Identifier, sessionRevoker, logoutNotifiers, logger)
 	flowConfig.Session = sessionCfg
+	userService.SetSessionService(sessionService)
 	flowFactory, execRegistry, interceptorRegistry, graphBuilder := initializeFlowCoreAndExecutor(ctx, logger,
 		cacheManager, executor.ExecutorDependencies{
 			OUService:             ouService,
@@ -349,6 +421,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 			ConsentEnforcer:       consentEnforcer,
 			AuthnProvider:         authnProvider,
 			OTPService:            otpCoreService,
+			TOTPService:           totpService,
 			MagicLinkService:      magicLinkService,
 			AuthZService:          authZService,
 			EntityTypeService:     entityTypeService,
@@ -363,8 +436,11 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 			OIDCSvc:               oidcAuthnService,
 			GithubSvc:             githubAuthnService,
 			GoogleSvc:             googleAuthnService,
+			SAMLSvc:               samlAuthnService,
 			OpenID4VPVerifierSvc:  openid4vpSvc,
 			SessionService:        sessionService,
+			LockoutService:        lockoutService,
+			PasswordPolicyService: passwordPolicyService,
 			ResourceService:       resourceServerProvider,
 			UserService:           userService,
 			CriteriaRevoker:       revocationSvc,
@@ -375,7 +451,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	flowMgtService, flowMgtExporter, err := flowmgt.Initialize(
 		mux, mcpServer, cacheManager, flowFactory, execRegistry, interceptorRegistry, graphBuilder,
-		serverConfigService, ouService, flowConfigHandler)
+		serverConfigService, ouService, flowConfigHandler, observabilitySvc)
 	fatalOnError(ctx, logger, err, "Failed to initialize FlowMgtService")
 
 	// Two-phase initialization: inject the flow resolver into the OU service.
@@ -407,7 +483,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// TODO: Remove entityService dependency after finalizing declarative resource loading pattern
 	applicationService, applicationExporter, err := application.Initialize(
 		mux, mcpServer, entityProvider, entityService, inboundClientService, ouService, i18nService,
-		runtimeCryptoSvc, serverConfigService)
+		runtimeCryptoSvc, serverConfigService, observabilitySvc)
 	fatalOnError(ctx, logger, err, "Failed to initialize ApplicationService")
 	exporters = append(exporters, applicationExporter)
 
@@ -438,6 +514,11 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	designResolveService := resolve.Initialize(mux, themeMgtService, layoutMgtService, applicationService)
 
 	actorProvider := actorprovider.Initialize(inboundClientService, entityProvider, authnProvider, roleService)
+	// Inject the actor provider into the back- and front-channel logout services. It is wired here rather
+	// than at construction because the session service, which notifies them, is built before the inbound
+	// client service the actor provider depends on.
+	backchannelLogoutSvc.SetActorProvider(actorProvider)
+	frontchannelLogoutSvc.SetActorProvider(actorProvider)
 
 	// Initialize flow metadata service
 	_ = flowmeta.Initialize(mux, actorProvider, ouService, designResolveService, i18nService)
@@ -474,11 +555,18 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		graphBuilder, jwtService, runtimeStoreProvider, transactioner, serverConfigService, flowConfig)
 	fatalOnError(ctx, logger, err, "Failed to initialize flow execution service")
 
+	// Initialized before the OAuth services because the shared flow callback completes SAML SSO
+	// requests as well as OAuth authorization requests.
+	samlService, err := saml.Initialize(mux, inboundClientService, flowExecService, jwtService,
+		attributeCacheService, runtimeCryptoSvc, runtimeStoreProvider)
+	fatalOnError(ctx, logger, err, "Failed to initialize SAML identity provider")
+
 	// Initialize OAuth services.
 	tokenValidator, err := oauth.Initialize(mux, actorProvider, authnProvider, jwtService, jweService,
 		flowExecService, observabilitySvc, runtimeCryptoSvc, ouService, attributeCacheService, authZService,
 		resourceServerProvider, i18nService, idpService, dpopVerifier,
-		runtimeStoreProvider, transactioner, revocationEnforcer, revocationSvc, oauthCfg)
+		runtimeStoreProvider, transactioner, revocationEnforcer, revocationSvc, samlService, frontchannelLogoutSvc,
+		pairwiseSubjectSvc, oauthCfg)
 	fatalOnError(ctx, logger, err, "Failed to initialize OAuth services")
 
 	// Initialized after the OAuth services because credential issuance validates the presented
@@ -489,7 +577,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	if oauthCfg.OAuth.DCR.IsEnabled() {
 		// Register OAuth2 DCR service.
-		err = dcr.Initialize(mux, applicationService, ouService, i18nService, oauthCfg)
+		err = dcr.Initialize(mux, applicationService, ouService, i18nService, jwtService, oauthCfg)
 		fatalOnError(ctx, logger, err, "Failed to initialize OAuth2 DCR service")
 	}
 
@@ -552,11 +640,12 @@ func unregisterServices() {
 // initSessionService reads the effective SSO session configuration from the server-config section and
 // builds the session service, returning both so the caller can thread the config into flowexec too.
 func initSessionService(ctx context.Context, svc serverconfig.ServerConfigService, deploymentID string,
-	criteriaRevoker flowsession.CriteriaRevoker, logger *log.Logger) (flowsession.Service, flowsession.Config) {
+	criteriaRevoker flowsession.CriteriaRevoker, logoutNotifier flowsession.LogoutNotifier,
+	logger *log.Logger) (flowsession.Service, flowsession.Config) {
 	cfg := readSessionConfig(ctx, svc, logger)
 	sessionService, err := flowsession.Initialize(dbprovider.GetDBProvider(), deploymentID,
 		flowsession.NewTimeouts(cfg.IdleTimeoutSeconds, cfg.AbsoluteTimeoutSeconds,
-			cfg.ActivityRefreshIntervalSeconds), criteriaRevoker)
+			cfg.ActivityRefreshIntervalSeconds), criteriaRevoker, logoutNotifier)
 	fatalOnError(ctx, logger, err, "Failed to initialize SSO session service")
 	return sessionService, cfg
 }
@@ -571,6 +660,18 @@ func (a sessionCriteriaRevoker) RevokeTokenFamily(ctx context.Context, tokenFami
 	return a.revoker.RevokeTokenFamily(ctx, tokenFamilyID, revocation.RevocationReasonSessionLogout)
 }
 
+// sessionLogoutNotifiers fans a session-ended notification out to every logout mechanism, and to the
+// Shared Signals transmitter when it is enabled.
+type sessionLogoutNotifiers []flowsession.LogoutNotifier
+
+// NotifySessionEnded notifies each logout mechanism in order.
+func (n sessionLogoutNotifiers) NotifySessionEnded(ctx context.Context, sess flowsession.Session,
+	participants []flowsession.Participant) {
+	for _, notifier := range n {
+		notifier.NotifySessionEnded(ctx, sess, participants)
+	}
+}
+
 // readSessionConfig reads the effective SSO session lifetime configuration from the server-config
 // "session" section. An unset section resolves to the zero Config, which NewTimeouts turns into the
 // built-in defaults; a read error is non-fatal for the same reason, so it logs and falls back.
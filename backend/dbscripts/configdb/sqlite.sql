@@ -124,6 +124,19 @@ CREATE TABLE "OAUTH_INBOUND_PROFILE" (
     FOREIGN KEY (ENTITY_ID) REFERENCES "INBOUND_CLIENT"(ENTITY_ID) ON DELETE CASCADE
 );
 
+-- Table to store SAML 2.0 service provider profile for an entity.
+CREATE TABLE "SAML_INBOUND_PROFILE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ENTITY_ID VARCHAR(36) NOT NULL,
+    SP_ENTITY_ID VARCHAR(1024) NOT NULL,
+    SAML_CONFIG TEXT,
+    PRIMARY KEY (ENTITY_ID, DEPLOYMENT_ID),
+    FOREIGN KEY (ENTITY_ID) REFERENCES "INBOUND_CLIENT"(ENTITY_ID) ON DELETE CASCADE
+);
+
+-- Index for resolving a service provider from the Issuer of an AuthnRequest.
+CREATE UNIQUE INDEX idx_saml_inbound_profile_sp_entity_id ON "SAML_INBOUND_PROFILE" (SP_ENTITY_ID, DEPLOYMENT_ID);
+
 -- Table to store identity providers.
 CREATE TABLE "IDP" (
     DEPLOYMENT_ID VARCHAR(255) NOT NULL,
@@ -362,3 +375,42 @@ CREATE TABLE "SERVER_CONFIG" (
     UPDATED_AT    TEXT         DEFAULT (datetime('now')),
     PRIMARY KEY (DEPLOYMENT_ID, NAME)
 );
+
+-- Table to store webhook endpoints. EVENT_TYPES holds the JSON array of subscribed event types and
+-- PROPERTIES holds the encrypted signing secret.
+CREATE TABLE "WEBHOOK" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    NAME VARCHAR(255) NOT NULL,
+    DESCRIPTION VARCHAR(500),
+    URL VARCHAR(2048) NOT NULL,
+    EVENT_TYPES TEXT NOT NULL,
+    FORMAT VARCHAR(20) NOT NULL,
+    ENABLED CHAR(1) DEFAULT '1',
+    PROPERTIES TEXT,
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    UPDATED_AT TEXT DEFAULT (datetime('now'))
+);
+
+-- Index for deployment-scoped webhook listing.
+CREATE INDEX idx_webhook_deployment ON "WEBHOOK" (DEPLOYMENT_ID, NAME);
+
+-- Table to store Shared Signals Framework event streams. EVENTS_REQUESTED holds the JSON array of event
+-- types the receiver requested and PROPERTIES holds the encrypted push authorization header.
+CREATE TABLE "SSF_STREAM" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    AUDIENCE VARCHAR(255) NOT NULL,
+    DESCRIPTION VARCHAR(500),
+    DELIVERY_METHOD VARCHAR(100) NOT NULL,
+    ENDPOINT_URL VARCHAR(2048),
+    EVENTS_REQUESTED TEXT NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    STATUS_REASON VARCHAR(500),
+    PROPERTIES TEXT,
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    UPDATED_AT TEXT DEFAULT (datetime('now'))
+);
+
+-- Index for deployment-scoped stream listing by status.
+CREATE INDEX idx_ssf_stream_deployment ON "SSF_STREAM" (DEPLOYMENT_ID, STATUS);
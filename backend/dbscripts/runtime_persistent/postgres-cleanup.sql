@@ -6,7 +6,10 @@
 --
 -- Unlike runtime_transient, runtime_persistent data is authoritative and must survive a
 -- runtime_transient flush; only rows past their EXPIRY_TIME are safe to delete. A revoked
--- token's row is removable once the token itself would have naturally expired.
+-- token's row is removable once the token itself would have naturally expired, an audit event's
+-- row once its retention period has passed, and a webhook delivery's row once it has been delivered
+-- or dead-lettered and its retention period has passed, and a queued Security Event Token's row once
+-- its retention period has passed without it being delivered.
 --
 -- Deletes expired rows in batches of p_batch_size (default 1000), committing
 -- after each batch to keep locks short on large tables. Must run as a top-level
@@ -113,5 +116,61 @@ BEGIN
         COMMIT;
         EXIT WHEN v_deleted = 0;
     END LOOP;
+
+    -- Audit events past their retention period.
+    LOOP
+        DELETE FROM "AUDIT_EVENT"
+        WHERE ctid IN (
+            SELECT ctid FROM "AUDIT_EVENT" WHERE EXPIRY_TIME < v_now LIMIT p_batch_size
+        );
+        GET DIAGNOSTICS v_deleted = ROW_COUNT;
+        COMMIT;
+        EXIT WHEN v_deleted = 0;
+    END LOOP;
+
+    -- Delivered and dead-lettered webhook deliveries past their retention period.
+    LOOP
+        DELETE FROM "WEBHOOK_DELIVERY"
+        WHERE ctid IN (
+            SELECT ctid FROM "WEBHOOK_DELIVERY" WHERE EXPIRY_TIME < v_now LIMIT p_batch_size
+        );
+        GET DIAGNOSTICS v_deleted = ROW_COUNT;
+        COMMIT;
+        EXIT WHEN v_deleted = 0;
+    END LOOP;
+
+    -- Undelivered Security Event Tokens past their retention period.
+    LOOP
+        DELETE FROM "SSF_EVENT"
+        WHERE ctid IN (
+            SELECT ctid FROM "SSF_EVENT" WHERE EXPIRY_TIME < v_now LIMIT p_batch_size
+        );
+        GET DIAGNOSTICS v_deleted = ROW_COUNT;
+        COMMIT;
+        EXIT WHEN v_deleted = 0;
+    END LOOP;
+
+    -- Row errors of the user import jobs past their retention period, then the jobs themselves.
+    LOOP
+        DELETE FROM "USER_IMPORT_ERROR"
+        WHERE ctid IN (
+            SELECT e.ctid FROM "USER_IMPORT_ERROR" e
+            JOIN "USER_IMPORT_JOB" j ON j.JOB_ID = e.JOB_ID AND j.DEPLOYMENT_ID = e.DEPLOYMENT_ID
+            WHERE j.EXPIRY_TIME < v_now LIMIT p_batch_size
+        );
+        GET DIAGNOSTICS v_deleted = ROW_COUNT;
+        COMMIT;
+        EXIT WHEN v_deleted = 0;
+    END LOOP;
+
+    LOOP
+        DELETE FROM "USER_IMPORT_JOB"
+        WHERE ctid IN (
+            SELECT ctid FROM "USER_IMPORT_JOB" WHERE EXPIRY_TIME < v_now LIMIT p_batch_size
+        );
+        GET DIAGNOSTICS v_deleted = ROW_COUNT;
+        COMMIT;
+        EXIT WHEN v_deleted = 0;
+    END LOOP;
 END;
 $$;
@@ -59,6 +59,8 @@ CREATE TABLE "SSO_SESSION" (
     ABSOLUTE_EXPIRES_AT DATETIME,
     STATE VARCHAR(50) NOT NULL,
     VERSION INTEGER NOT NULL,
+    USER_AGENT VARCHAR(512),
+    IP_ADDRESS VARCHAR(45),
     UPDATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     PRIMARY KEY (SESSION_ID, DEPLOYMENT_ID)
 );
@@ -73,6 +75,9 @@ CREATE UNIQUE INDEX idx_sso_session_flow_execution ON "SSO_SESSION" (FLOW_EXECUT
 -- Index for absolute expiry on SSO_SESSION (supports cleanup)
 CREATE INDEX idx_sso_session_absolute_expires_at ON "SSO_SESSION" (ABSOLUTE_EXPIRES_AT);
 
+-- Index for subject lookup on SSO_SESSION (supports listing and ending a user's sessions)
+CREATE INDEX idx_sso_session_subject_id ON "SSO_SESSION" (SUBJECT_ID, DEPLOYMENT_ID);
+
 -- Table to store the durable session context for an SSO session, one row per checkpoint.
 CREATE TABLE "SSO_SESSION_CONTEXT" (
     SESSION_ID VARCHAR(36) NOT NULL,
@@ -128,3 +133,144 @@ CREATE INDEX idx_consent_authz_user ON "CONSENT_AUTHORIZATION" (DEPLOYMENT_ID, U
 
 -- Index for loading a consent's authorization records.
 CREATE INDEX idx_consent_authz_consent ON "CONSENT_AUTHORIZATION" (CONSENT_ID, DEPLOYMENT_ID);
+
+-- Table to store the pairwise subject identifiers issued to clients, mapped to their local subjects.
+-- PAIRWISE_ID is a keyed hash of SECTOR_IDENTIFIER and SUBJECT_ID, so the mapping never changes once
+-- written. It is used to resolve a pairwise subject presented back to the server (for example in an
+-- id_token_hint) to its local subject.
+CREATE TABLE "PAIRWISE_SUBJECT" (
+    PAIRWISE_ID VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    SECTOR_IDENTIFIER VARCHAR(255) NOT NULL,
+    SUBJECT_ID VARCHAR(36) NOT NULL,
+    CREATED_AT DATETIME NOT NULL,
+    PRIMARY KEY (PAIRWISE_ID, DEPLOYMENT_ID)
+);
+
+-- Table to store observability events persisted by the database subscriber, searchable through the
+-- audit events API. SUBJECT, CLIENT_ID and CORRELATION_ID are promoted out of DATA so events can be
+-- filtered by them. EXPIRY_TIME is the event time plus the configured retention period.
+CREATE TABLE "AUDIT_EVENT" (
+    EVENT_ID VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    EVENT_TYPE VARCHAR(100) NOT NULL,
+    CATEGORY VARCHAR(100) NOT NULL,
+    COMPONENT VARCHAR(100) NOT NULL,
+    STATUS VARCHAR(30) NOT NULL,
+    SUBJECT VARCHAR(255),
+    CLIENT_ID VARCHAR(255),
+    CORRELATION_ID VARCHAR(255),
+    EVENT_TIME DATETIME NOT NULL,
+    DATA TEXT,
+    EXPIRY_TIME DATETIME NOT NULL,
+    PRIMARY KEY (EVENT_ID, DEPLOYMENT_ID)
+);
+
+-- Index for time-ordered listing of AUDIT_EVENT.
+CREATE INDEX idx_audit_event_time ON "AUDIT_EVENT" (DEPLOYMENT_ID, EVENT_TIME);
+
+-- Indexes for the filterable identity and correlation columns on AUDIT_EVENT.
+CREATE INDEX idx_audit_event_subject ON "AUDIT_EVENT" (DEPLOYMENT_ID, SUBJECT);
+CREATE INDEX idx_audit_event_client_id ON "AUDIT_EVENT" (DEPLOYMENT_ID, CLIENT_ID);
+CREATE INDEX idx_audit_event_correlation_id ON "AUDIT_EVENT" (DEPLOYMENT_ID, CORRELATION_ID);
+
+-- Index for expiry time on AUDIT_EVENT (supports retention cleanup).
+CREATE INDEX idx_audit_event_expiry_time ON "AUDIT_EVENT" (EXPIRY_TIME);
+
+-- Outbox of webhook deliveries. A delivery is written for every event a webhook subscribes to and is
+-- retried with exponential backoff until the endpoint accepts it or the attempts run out, when it is
+-- moved to the dead-letter status. EXPIRY_TIME is set once the delivery reaches a final status.
+CREATE TABLE "WEBHOOK_DELIVERY" (
+    DELIVERY_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    WEBHOOK_ID VARCHAR(36) NOT NULL,
+    EVENT_ID VARCHAR(255) NOT NULL,
+    EVENT_TYPE VARCHAR(100) NOT NULL,
+    PAYLOAD TEXT NOT NULL,
+    CONTENT_TYPE VARCHAR(100) NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    ATTEMPTS INTEGER NOT NULL DEFAULT 0,
+    NEXT_ATTEMPT_AT DATETIME NOT NULL,
+    LAST_ATTEMPT_AT DATETIME,
+    LAST_RESPONSE_STATUS INTEGER,
+    LAST_ERROR TEXT,
+    CREATED_AT DATETIME NOT NULL,
+    EXPIRY_TIME DATETIME,
+    PRIMARY KEY (DELIVERY_ID, DEPLOYMENT_ID)
+);
+
+-- Index for polling the due pending deliveries of WEBHOOK_DELIVERY.
+CREATE INDEX idx_webhook_delivery_due ON "WEBHOOK_DELIVERY" (DEPLOYMENT_ID, STATUS, NEXT_ATTEMPT_AT);
+
+-- Index for listing the deliveries of a webhook.
+CREATE INDEX idx_webhook_delivery_webhook ON "WEBHOOK_DELIVERY" (DEPLOYMENT_ID, WEBHOOK_ID, CREATED_AT);
+
+-- Index for expiry time on WEBHOOK_DELIVERY (supports retention cleanup).
+CREATE INDEX idx_webhook_delivery_expiry_time ON "WEBHOOK_DELIVERY" (EXPIRY_TIME);
+
+-- Table to store the Security Event Tokens queued for Shared Signals Framework streams until they are
+-- pushed or polled and acknowledged.
+CREATE TABLE "SSF_EVENT" (
+    JTI VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    STREAM_ID VARCHAR(36) NOT NULL,
+    EVENT_TYPE VARCHAR(255) NOT NULL,
+    SET_TOKEN TEXT NOT NULL,
+    ATTEMPTS INTEGER NOT NULL DEFAULT 0,
+    NEXT_ATTEMPT_AT DATETIME NOT NULL,
+    LAST_ERROR TEXT,
+    CREATED_AT DATETIME NOT NULL,
+    EXPIRY_TIME DATETIME NOT NULL,
+    PRIMARY KEY (JTI, DEPLOYMENT_ID)
+);
+
+-- Index for finding the due push attempts of a stream.
+CREATE INDEX idx_ssf_event_due ON "SSF_EVENT" (DEPLOYMENT_ID, STREAM_ID, NEXT_ATTEMPT_AT);
+
+-- Index for polling the pending events of a stream.
+CREATE INDEX idx_ssf_event_stream ON "SSF_EVENT" (DEPLOYMENT_ID, STREAM_ID, CREATED_AT);
+
+-- Index for expiry time on SSF_EVENT (supports retention cleanup).
+CREATE INDEX idx_ssf_event_expiry_time ON "SSF_EVENT" (EXPIRY_TIME);
+
+-- Table to store the bulk user import jobs. A job is processed by the node that accepted the upload, which
+-- records its progress as it goes; EXPIRY_TIME is set once the job ends.
+CREATE TABLE "USER_IMPORT_JOB" (
+    JOB_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    FORMAT VARCHAR(20) NOT NULL,
+    FILE_NAME VARCHAR(255),
+    USER_TYPE VARCHAR(255),
+    OU_ID VARCHAR(36),
+    CREATED_BY VARCHAR(255),
+    PROCESSED INTEGER NOT NULL DEFAULT 0,
+    SUCCEEDED INTEGER NOT NULL DEFAULT 0,
+    FAILED INTEGER NOT NULL DEFAULT 0,
+    REPORTED_ERRORS INTEGER NOT NULL DEFAULT 0,
+    ERROR_MESSAGE TEXT,
+    CREATED_AT DATETIME NOT NULL,
+    UPDATED_AT DATETIME NOT NULL,
+    COMPLETED_AT DATETIME,
+    EXPIRY_TIME DATETIME,
+    PRIMARY KEY (JOB_ID, DEPLOYMENT_ID)
+);
+
+-- Index for listing the import jobs.
+CREATE INDEX idx_user_import_job_created_at ON "USER_IMPORT_JOB" (DEPLOYMENT_ID, CREATED_AT);
+
+-- Index for finding the running jobs that stopped reporting progress.
+CREATE INDEX idx_user_import_job_status ON "USER_IMPORT_JOB" (DEPLOYMENT_ID, STATUS, UPDATED_AT);
+
+-- Index for expiry time on USER_IMPORT_JOB (supports retention cleanup).
+CREATE INDEX idx_user_import_job_expiry_time ON "USER_IMPORT_JOB" (EXPIRY_TIME);
+
+-- Table to store the rows a bulk user import job failed to import, for its error report.
+CREATE TABLE "USER_IMPORT_ERROR" (
+    JOB_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    LINE_NUMBER INTEGER NOT NULL,
+    ERROR_CODE VARCHAR(50) NOT NULL,
+    ERROR_MESSAGE TEXT NOT NULL,
+    PRIMARY KEY (JOB_ID, DEPLOYMENT_ID, LINE_NUMBER)
+);
@@ -16,14 +16,23 @@ CREATE TABLE "RUNTIME_STORE_ATTRIBUTE_CACHE" PARTITION OF "RUNTIME_STORE" FOR VA
 CREATE TABLE "RUNTIME_STORE_FLOW_STATE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('flow:state');
 CREATE TABLE "RUNTIME_STORE_AUTHZ_CODE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('authz:code');
 CREATE TABLE "RUNTIME_STORE_AUTHZ_REQ"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('authz:req');
+CREATE TABLE "RUNTIME_STORE_AUTHZ_RESP" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('authz:resp');
 CREATE TABLE "RUNTIME_STORE_LOGOUT_REQ" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('logout:req');
+CREATE TABLE "RUNTIME_STORE_LOGOUT_FRONTCHANNEL" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('logout:frontchannel');
 CREATE TABLE "RUNTIME_STORE_PAR_REQ"    PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('par:req');
 CREATE TABLE "RUNTIME_STORE_CIBA_REQ"   PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('ciba:req');
+CREATE TABLE "RUNTIME_STORE_DEVICE_CODE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('device:code');
+CREATE TABLE "RUNTIME_STORE_DEVICE_USERCODE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('device:usercode');
+CREATE TABLE "RUNTIME_STORE_SAML_REQ"   PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('saml:req');
+CREATE TABLE "RUNTIME_STORE_SAML_RESP"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('saml:resp');
+CREATE TABLE "RUNTIME_STORE_SAML_ACS"   PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('saml:acs');
 CREATE TABLE "RUNTIME_STORE_JTI_TOKEN"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('jti:token');
 CREATE TABLE "RUNTIME_STORE_VCI_NONCE"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('vci:nonce');
 CREATE TABLE "RUNTIME_STORE_VCI_OFFER"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('vci:offer');
 CREATE TABLE "RUNTIME_STORE_VP_STATE"   PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('vp:state');
 CREATE TABLE "RUNTIME_STORE_WEBAUTHN_SESSION" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('webauthn:session');
+CREATE TABLE "RUNTIME_STORE_LOCKOUT_ATTEMPTS" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('lockout:attempts');
+CREATE TABLE "RUNTIME_STORE_TOTP_LOCK" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('totp:lock');
 
 -- Index for expiry time on RUNTIME_STORE (propagates to all partitions; supports cleanup and expiry checks)
 CREATE INDEX idx_runtime_store_expiry_time ON "RUNTIME_STORE" (EXPIRY_TIME);
@@ -156,19 +156,31 @@ func toProviderOAuthClient(c *providers.OAuthClient) *providers.OAuthClient {
 		ClientID:                           c.ClientID,
 		RedirectURIs:                       c.RedirectURIs,
 		PostLogoutRedirectURIs:             c.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               c.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   c.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              c.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  c.FrontchannelLogoutSessionRequired,
 		TokenEndpointAuthMethod:            c.TokenEndpointAuthMethod,
 		PKCERequired:                       c.PKCERequired,
 		PublicClient:                       c.PublicClient,
 		RequirePushedAuthorizationRequests: c.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         c.RequireSignedRequestObject,
 		DPoPBoundAccessTokens:              c.DPoPBoundAccessTokens,
+		MTLSBoundAccessTokens:              c.MTLSBoundAccessTokens,
+		SecurityProfile:                    c.SecurityProfile,
+		SubjectType:                        c.SubjectType,
+		SectorIdentifierURI:                c.SectorIdentifierURI,
 		IncludeActClaim:                    c.IncludeActClaim,
 		EntityCategory:                     c.EntityCategory,
 		Token:                              c.Token,
 		Scopes:                             c.Scopes,
 		UserInfo:                           c.UserInfo,
+		AuthorizationResponse:              c.AuthorizationResponse,
+		TLSClientAuth:                      c.TLSClientAuth,
 		ScopeClaims:                        c.ScopeClaims,
 		Certificate:                        c.Certificate,
 		AcrValues:                          c.AcrValues,
+		ClientSecret:                       c.ClientSecret,
 	}
 	client.GrantTypes = append(client.GrantTypes, c.GrantTypes...)
 	client.ResponseTypes = append(client.ResponseTypes, c.ResponseTypes...)
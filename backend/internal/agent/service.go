@@ -1248,6 +1248,10 @@ func pickOAuthConfig(
 			found = configs[i].OAuthConfig
 		}
 	}
+	// Agents keep only a hash of their client secret, which cannot verify HMAC client assertions.
+	if found != nil && found.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodClientSecretJWT {
+		return nil, &ErrorInvalidTokenEndpointAuthMethod
+	}
 	return found, nil
 }
 
@@ -1264,7 +1268,9 @@ func requiresClientSecret(cfg *providers.OAuthConfigWithSecret) bool {
 		providers.TokenEndpointAuthMethodClientSecretPost:
 		return true
 	case providers.TokenEndpointAuthMethodNone,
-		providers.TokenEndpointAuthMethodPrivateKeyJWT:
+		providers.TokenEndpointAuthMethodPrivateKeyJWT,
+		providers.TokenEndpointAuthMethodTLSClientAuth,
+		providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
 		return false
 	}
 	// Default to client_secret_basic when unspecified.
@@ -1434,18 +1440,29 @@ func buildOAuthProfile(configs []providers.InboundAuthConfigWithSecret) *provide
 	return &providers.OAuthProfile{
 		RedirectURIs:                       cfg.RedirectURIs,
 		PostLogoutRedirectURIs:             cfg.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               cfg.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   cfg.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              cfg.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  cfg.FrontchannelLogoutSessionRequired,
 		GrantTypes:                         grantTypes,
 		ResponseTypes:                      sysutils.ConvertToStringSlice(cfg.ResponseTypes),
 		TokenEndpointAuthMethod:            string(authMethod),
 		PKCERequired:                       cfg.PKCERequired,
 		PublicClient:                       cfg.PublicClient,
 		RequirePushedAuthorizationRequests: cfg.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         cfg.RequireSignedRequestObject,
 		DPoPBoundAccessTokens:              cfg.DPoPBoundAccessTokens,
+		MTLSBoundAccessTokens:              cfg.MTLSBoundAccessTokens,
+		SecurityProfile:                    string(cfg.SecurityProfile),
+		SubjectType:                        string(cfg.SubjectType),
+		SectorIdentifierURI:                cfg.SectorIdentifierURI,
 		IncludeActClaim:                    cfg.IncludeActClaim,
 		Certificate:                        cfg.Certificate,
 		Token:                              cfg.Token,
 		Scopes:                             cfg.Scopes,
 		UserInfo:                           cfg.UserInfo,
+		AuthorizationResponse:              cfg.AuthorizationResponse,
+		TLSClientAuth:                      cfg.TLSClientAuth,
 		ScopeClaims:                        cfg.ScopeClaims,
 	}
 }
@@ -1460,18 +1477,29 @@ func oauthProfileToComplete(clientID string, p *providers.OAuthProfile) *provide
 		ClientID:                           clientID,
 		RedirectURIs:                       p.RedirectURIs,
 		PostLogoutRedirectURIs:             p.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               p.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   p.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              p.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  p.FrontchannelLogoutSessionRequired,
 		GrantTypes:                         grants,
 		ResponseTypes:                      respTypes,
 		TokenEndpointAuthMethod:            providers.TokenEndpointAuthMethod(p.TokenEndpointAuthMethod),
 		PKCERequired:                       p.PKCERequired,
 		PublicClient:                       p.PublicClient,
 		RequirePushedAuthorizationRequests: p.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         p.RequireSignedRequestObject,
 		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
+		MTLSBoundAccessTokens:              p.MTLSBoundAccessTokens,
+		SecurityProfile:                    providers.SecurityProfile(p.SecurityProfile),
+		SubjectType:                        providers.SubjectType(p.SubjectType),
+		SectorIdentifierURI:                p.SectorIdentifierURI,
 		IncludeActClaim:                    p.IncludeActClaim,
 		Certificate:                        p.Certificate,
 		Token:                              p.Token,
 		Scopes:                             p.Scopes,
 		UserInfo:                           p.UserInfo,
+		AuthorizationResponse:              p.AuthorizationResponse,
+		TLSClientAuth:                      p.TLSClientAuth,
 		ScopeClaims:                        p.ScopeClaims,
 	}
 }
@@ -1603,6 +1631,52 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.agentservice.redirect_uri_fragment_not_allowed_description",
 			DefaultValue: "Redirect URIs must not contain a fragment component",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidBackchannelLogoutURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_backchannel_logout_uri_description",
+			DefaultValue: "Back-channel logout URI must be a public https URL without a fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidFrontchannelLogoutURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_frontchannel_logout_uri_description",
+			DefaultValue: "Front-channel logout URI must share a registered redirect URI's origin and have no fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseUnsupportedSigningAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.authorization_response_unsupported_signing_alg_description",
+			DefaultValue: "authorization response signing algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.authorization_response_unsupported_encryption_alg_description",
+			DefaultValue: "authorization response encryption algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.authorization_response_unsupported_encryption_enc_description",
+			DefaultValue: "authorization response content-encryption algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.authorization_response_encryption_alg_requires_enc_description",
+			DefaultValue: "authorization response encryptionEnc is required when encryptionAlg is set",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.authorization_response_encryption_enc_requires_alg_description",
+			DefaultValue: "authorization response encryptionAlg is required when encryptionEnc is set",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseEncryptionRequiresCertificate):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.agentservice.authorization_response_encryption_requires_certificate_description",
+			DefaultValue: "a certificate (JWKS or JWKS_URI) is " +
+				"required when authorization response encryption is configured",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.authorization_response_jwks_uri_not_ssrf_safe_description",
+			DefaultValue: "authorization response JWKS URI must be a publicly reachable HTTPS URL",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.agentservice.auth_code_requires_redirect_uris_description",
@@ -1658,6 +1732,21 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.agentservice.private_key_jwt_cannot_have_client_secret_description",
 			DefaultValue: "private_key_jwt authentication method cannot have a client secret",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthTLSClientAuthRequiresSubject):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.tls_client_auth_requires_subject_description",
+			DefaultValue: "tls_client_auth authentication method requires exactly one certificate subject identifier",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSelfSignedTLSClientAuthRequiresCertificate):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.self_signed_tls_client_auth_requires_certificate_description",
+			DefaultValue: "self_signed_tls_client_auth authentication method requires an inline JWKS certificate",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthMTLSAuthCannotHaveClientSecret):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.mtls_auth_cannot_have_client_secret_description",
+			DefaultValue: "mutual-TLS authentication methods cannot have a client secret",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthNoneAuthRequiresPublicClient):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.agentservice.none_auth_method_requires_public_client_description",
@@ -1674,6 +1763,45 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			DefaultValue: "client_credentials grant type cannot use 'none' authentication method",
 		})
 
+	// OAuth: security profile
+	case errors.Is(err, inboundclient.ErrOAuthInvalidSecurityProfile):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_security_profile_description",
+			DefaultValue: "Invalid security profile. Supported values are 'fapi2' and 'none'",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthFAPI2RequiresStrongClientAuth):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.agentservice.fapi2_requires_strong_client_auth_description",
+			DefaultValue: "FAPI 2.0 security profile requires a confidential client using private_key_jwt, " +
+				"tls_client_auth or self_signed_tls_client_auth authentication method",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthFAPI2RequiresCodeResponseType):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.fapi2_requires_code_response_type_description",
+			DefaultValue: "FAPI 2.0 security profile only allows the 'code' response type",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidSubjectType):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_subject_type_description",
+			DefaultValue: "Invalid subject type. Supported values are 'public' and 'pairwise'",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidSectorIdentifierURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.agentservice.invalid_sector_identifier_uri_description",
+			DefaultValue: "Sector identifier URI must be an absolute https URL that returns a " +
+				"JSON array of redirect URIs",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSectorIdentifierURIMissingRedirectURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.sector_identifier_uri_missing_redirect_uri_description",
+			DefaultValue: "Sector identifier URI must list all registered redirect URIs",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthPairwiseRequiresSectorIdentifierURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.agentservice.pairwise_requires_sector_identifier_uri_description",
+			DefaultValue: "Pairwise subject type requires a sector identifier URI when redirect " +
+				"URIs use more than one host",
+		})
 	// OAuth: public client
 	case errors.Is(err, inboundclient.ErrOAuthPublicClientMustUseNoneAuth):
 		return tidcommon.CustomServiceError(ErrorInvalidPublicClientConfiguration, tidcommon.I18nMessage{
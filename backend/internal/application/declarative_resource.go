@@ -140,6 +140,12 @@ func makeAppInboundParser(appService ApplicationServiceInterface) func([]byte) (
 				break
 			}
 		}
+		if samlProfile := toSAMLProfile(validatedApp); samlProfile != nil {
+			if profile.Properties == nil {
+				profile.Properties = make(map[string]interface{})
+			}
+			profile.Properties[inboundclient.PropSAMLProfile] = *samlProfile
+		}
 		return &profile, nil
 	}
 }
@@ -191,6 +197,13 @@ func parseToApplicationDTO(data []byte) (*model.ApplicationDTO, error) {
 	if len(appRequest.InboundAuthConfig) > 0 {
 		inboundAuthConfigDTOs := make([]providers.InboundAuthConfigWithSecret, 0)
 		for _, config := range appRequest.InboundAuthConfig {
+			if config.Type == providers.SAMLInboundAuthType && config.SAMLConfig != nil {
+				inboundAuthConfigDTOs = append(inboundAuthConfigDTOs, providers.InboundAuthConfigWithSecret{
+					Type:       config.Type,
+					SAMLConfig: config.SAMLConfig,
+				})
+				continue
+			}
 			if config.Type != providers.OAuthInboundAuthType || config.OAuthConfig == nil {
 				continue
 			}
@@ -202,17 +215,28 @@ func parseToApplicationDTO(data []byte) (*model.ApplicationDTO, error) {
 					ClientSecret:                       config.OAuthConfig.ClientSecret,
 					RedirectURIs:                       config.OAuthConfig.RedirectURIs,
 					PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+					BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+					BackchannelLogoutSessionRequired:   config.OAuthConfig.BackchannelLogoutSessionRequired,
+					FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
+					FrontchannelLogoutSessionRequired:  config.OAuthConfig.FrontchannelLogoutSessionRequired,
 					GrantTypes:                         config.OAuthConfig.GrantTypes,
 					ResponseTypes:                      config.OAuthConfig.ResponseTypes,
 					TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 					PKCERequired:                       config.OAuthConfig.PKCERequired,
 					PublicClient:                       config.OAuthConfig.PublicClient,
 					RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+					RequireSignedRequestObject:         config.OAuthConfig.RequireSignedRequestObject,
 					DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+					MTLSBoundAccessTokens:              config.OAuthConfig.MTLSBoundAccessTokens,
+					SecurityProfile:                    config.OAuthConfig.SecurityProfile,
+					SubjectType:                        config.OAuthConfig.SubjectType,
+					SectorIdentifierURI:                config.OAuthConfig.SectorIdentifierURI,
 					IncludeActClaim:                    config.OAuthConfig.IncludeActClaim,
 					Token:                              config.OAuthConfig.Token,
 					Scopes:                             config.OAuthConfig.Scopes,
 					UserInfo:                           config.OAuthConfig.UserInfo,
+					AuthorizationResponse:              config.OAuthConfig.AuthorizationResponse,
+					TLSClientAuth:                      config.OAuthConfig.TLSClientAuth,
 					ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 					Certificate:                        config.OAuthConfig.Certificate,
 				},
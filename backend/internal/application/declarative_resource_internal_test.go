@@ -231,6 +231,36 @@ inboundAuthConfig:
 	assert.Contains(s.T(), oauthConfig.Scopes, "openid")
 }
 
+func (s *ParseToApplicationDTOTestSuite) TestParseToApplicationDTO_WithSAMLConfig() {
+	yamlData := `
+id: saml-app
+name: SAML Application
+inboundAuthConfig:
+  - type: saml2
+    samlConfig:
+      entityId: https://sp.example.com
+      assertionConsumerServiceUrls:
+        - https://sp.example.com/acs
+      nameIdFormat: urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress
+      nameIdAttribute: email
+      signResponse: true
+`
+
+	appDTO, err := parseToApplicationDTO([]byte(yamlData))
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), appDTO.InboundAuthConfig, 1)
+	assert.Equal(s.T(), providers.SAMLInboundAuthType, appDTO.InboundAuthConfig[0].Type)
+
+	samlConfig := appDTO.InboundAuthConfig[0].SAMLConfig
+	assert.NotNil(s.T(), samlConfig)
+	assert.Equal(s.T(), "https://sp.example.com", samlConfig.EntityID)
+	assert.Equal(s.T(), []string{"https://sp.example.com/acs"}, samlConfig.AssertionConsumerServiceURLs)
+	assert.Equal(s.T(), providers.SAMLNameIDFormatEmail, samlConfig.NameIDFormat)
+	assert.Equal(s.T(), "email", samlConfig.NameIDAttribute)
+	assert.True(s.T(), samlConfig.SignResponse)
+}
+
 func (s *ParseToApplicationDTOTestSuite) TestParseToApplicationDTO_TemplateAndOtherFieldsCombination() {
 	// Test template field with other new fields
 	yamlData := `
@@ -575,4 +575,96 @@ var (
 				"and string-typed in an allowed user type",
 		},
 	}
+	// ErrorMultipleSAMLConfigs is returned when more than one SAML inbound auth config is supplied.
+	ErrorMultipleSAMLConfigs = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1046",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.multiple_saml_configs",
+			DefaultValue: "Multiple SAML inbound auth configs are not allowed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.multiple_saml_configs_description",
+			DefaultValue: "An application may have at most one inbound auth config per protocol",
+		},
+	}
+	// ErrorInvalidSAMLEntityID is returned when the SAML service provider entity ID is missing.
+	ErrorInvalidSAMLEntityID = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1047",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_saml_entity_id",
+			DefaultValue: "Invalid SAML entity ID",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_saml_entity_id_description",
+			DefaultValue: "The SAML configuration must specify the entity ID of the service provider",
+		},
+	}
+	// ErrorSAMLEntityIDAlreadyExists is returned when the SAML entity ID is registered by another application.
+	ErrorSAMLEntityIDAlreadyExists = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1048",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.saml_entity_id_already_exists",
+			DefaultValue: "SAML entity ID already exists",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.saml_entity_id_already_exists_description",
+			DefaultValue: "Another application is already registered with the provided SAML entity ID",
+		},
+	}
+	// ErrorInvalidSAMLACSURL is returned when an assertion consumer service URL is missing or invalid.
+	ErrorInvalidSAMLACSURL = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1049",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_saml_acs_url",
+			DefaultValue: "Invalid assertion consumer service URL",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.applicationservice.invalid_saml_acs_url_description",
+			DefaultValue: "At least one assertion consumer service URL is required and each must be an " +
+				"absolute HTTP(S) URL without a fragment",
+		},
+	}
+	// ErrorInvalidSAMLNameIDFormat is returned when an unsupported NameID format is supplied.
+	ErrorInvalidSAMLNameIDFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1050",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_saml_nameid_format",
+			DefaultValue: "Invalid NameID format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_saml_nameid_format_description",
+			DefaultValue: "The provided SAML NameID format is not supported",
+		},
+	}
+	// ErrorInvalidSAMLCertificate is returned when the SAML service provider certificate cannot be parsed.
+	ErrorInvalidSAMLCertificate = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1051",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_saml_certificate",
+			DefaultValue: "Invalid SAML certificate",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_saml_certificate_description",
+			DefaultValue: "The SAML service provider certificate must be a PEM-encoded X.509 certificate",
+		},
+	}
+	// ErrorSAMLSignedRequestsRequireCertificate is returned when signed AuthnRequests are required without a certificate.
+	ErrorSAMLSignedRequestsRequireCertificate = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1052",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.saml_signed_requests_require_certificate",
+			DefaultValue: "SAML certificate required",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.saml_signed_requests_require_certificate_description",
+			DefaultValue: "A service provider certificate is required to verify signed AuthnRequests",
+		},
+	}
 )
@@ -201,42 +201,29 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 		Metadata:  appDTO.Metadata,
 	}
 
-	// TODO: Need to refactor when supporting other/multiple inbound auth types.
 	if len(appDTO.InboundAuthConfig) > 0 {
-		if appDTO.InboundAuthConfig[0].Type != providers.OAuthInboundAuthType {
-			logger.Error(ctx, "Unsupported inbound authentication type returned",
-				log.String("type", string(appDTO.InboundAuthConfig[0].Type)))
-
-			errResp := apierror.ErrorResponse{
-				Code:        tidcommon.InternalServerError.Code,
-				Message:     tidcommon.InternalServerError.Error,
-				Description: tidcommon.InternalServerError.ErrorDescription,
-			}
-			sysutils.WriteErrorResponse(ctx, w, http.StatusInternalServerError, errResp)
-			return
-		}
-
-		if appDTO.InboundAuthConfig[0].OAuthConfig == nil {
-			logger.Error(ctx, "OAuth application configuration is nil")
-
-			errResp := apierror.ErrorResponse{
-				Code:        tidcommon.InternalServerError.Code,
-				Message:     tidcommon.InternalServerError.Error,
-				Description: tidcommon.InternalServerError.ErrorDescription,
-			}
-			sysutils.WriteErrorResponse(ctx, w, http.StatusInternalServerError, errResp)
-			return
+		errResp := apierror.ErrorResponse{
+			Code:        tidcommon.InternalServerError.Code,
+			Message:     tidcommon.InternalServerError.Error,
+			Description: tidcommon.InternalServerError.ErrorDescription,
 		}
-
 		returnInboundAuthConfigs := make([]inboundmodel.InboundAuthConfig, 0, len(appDTO.InboundAuthConfig))
 		for _, config := range appDTO.InboundAuthConfig {
+			if config.Type == providers.SAMLInboundAuthType && config.SAMLConfig != nil {
+				returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfig{
+					Type:       config.Type,
+					SAMLConfig: config.SAMLConfig,
+				})
+				continue
+			}
+			if config.Type != providers.OAuthInboundAuthType {
+				logger.Error(ctx, "Unsupported inbound authentication type returned",
+					log.String("type", string(config.Type)))
+				sysutils.WriteErrorResponse(ctx, w, http.StatusInternalServerError, errResp)
+				return
+			}
 			if config.OAuthConfig == nil {
 				logger.Error(ctx, "OAuth application configuration is nil")
-				errResp := apierror.ErrorResponse{
-					Code:        tidcommon.InternalServerError.Code,
-					Message:     tidcommon.InternalServerError.Error,
-					Description: tidcommon.InternalServerError.ErrorDescription,
-				}
 				sysutils.WriteErrorResponse(ctx, w, http.StatusInternalServerError, errResp)
 				return
 			}
@@ -256,17 +243,28 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 				ClientID:                           config.OAuthConfig.ClientID,
 				RedirectURIs:                       redirectURIs,
 				PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+				BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+				BackchannelLogoutSessionRequired:   config.OAuthConfig.BackchannelLogoutSessionRequired,
+				FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
+				FrontchannelLogoutSessionRequired:  config.OAuthConfig.FrontchannelLogoutSessionRequired,
 				GrantTypes:                         grantTypes,
 				ResponseTypes:                      responseTypes,
 				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 				PKCERequired:                       config.OAuthConfig.PKCERequired,
 				PublicClient:                       config.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+				RequireSignedRequestObject:         config.OAuthConfig.RequireSignedRequestObject,
 				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				MTLSBoundAccessTokens:              config.OAuthConfig.MTLSBoundAccessTokens,
+				SecurityProfile:                    config.OAuthConfig.SecurityProfile,
+				SubjectType:                        config.OAuthConfig.SubjectType,
+				SectorIdentifierURI:                config.OAuthConfig.SectorIdentifierURI,
 				IncludeActClaim:                    config.OAuthConfig.IncludeActClaim,
 				Token:                              config.OAuthConfig.Token,
 				Scopes:                             config.OAuthConfig.Scopes,
 				UserInfo:                           config.OAuthConfig.UserInfo,
+				AuthorizationResponse:              config.OAuthConfig.AuthorizationResponse,
+				TLSClientAuth:                      config.OAuthConfig.TLSClientAuth,
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
@@ -275,9 +273,11 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 				Type:        config.Type,
 				OAuthConfig: &oAuthAppConfig,
 			})
+			if returnApp.ClientID == "" {
+				returnApp.ClientID = config.OAuthConfig.ClientID
+			}
 		}
 		returnApp.InboundAuthConfig = returnInboundAuthConfigs
-		returnApp.ClientID = appDTO.InboundAuthConfig[0].OAuthConfig.ClientID
 	}
 
 	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, returnApp)
@@ -429,20 +429,20 @@ func (ah *applicationHandler) processInboundAuthConfig(
 	ctx context.Context, logger *log.Logger, appDTO *model.ApplicationDTO,
 	returnApp *model.ApplicationCompleteResponse) bool {
 	if len(appDTO.InboundAuthConfig) > 0 {
-		if appDTO.InboundAuthConfig[0].Type != providers.OAuthInboundAuthType {
-			logger.Error(ctx, "Unsupported inbound authentication type returned",
-				log.String("type", string(appDTO.InboundAuthConfig[0].Type)))
-
-			return false
-		}
-
-		if appDTO.InboundAuthConfig[0].OAuthConfig == nil {
-			logger.Error(ctx, "OAuth application configuration is nil")
-			return false
-		}
-
 		returnInboundAuthConfigs := make([]providers.InboundAuthConfigWithSecret, 0, len(appDTO.InboundAuthConfig))
 		for _, config := range appDTO.InboundAuthConfig {
+			if config.Type == providers.SAMLInboundAuthType && config.SAMLConfig != nil {
+				returnInboundAuthConfigs = append(returnInboundAuthConfigs, providers.InboundAuthConfigWithSecret{
+					Type:       config.Type,
+					SAMLConfig: config.SAMLConfig,
+				})
+				continue
+			}
+			if config.Type != providers.OAuthInboundAuthType {
+				logger.Error(ctx, "Unsupported inbound authentication type returned",
+					log.String("type", string(config.Type)))
+				return false
+			}
 			if config.OAuthConfig == nil {
 				logger.Error(ctx, "OAuth application configuration is nil")
 				return false
@@ -464,17 +464,28 @@ func (ah *applicationHandler) processInboundAuthConfig(
 				ClientSecret:                       config.OAuthConfig.ClientSecret,
 				RedirectURIs:                       redirectURIs,
 				PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+				BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+				BackchannelLogoutSessionRequired:   config.OAuthConfig.BackchannelLogoutSessionRequired,
+				FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
+				FrontchannelLogoutSessionRequired:  config.OAuthConfig.FrontchannelLogoutSessionRequired,
 				GrantTypes:                         grantTypes,
 				ResponseTypes:                      responseTypes,
 				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 				PKCERequired:                       config.OAuthConfig.PKCERequired,
 				PublicClient:                       config.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+				RequireSignedRequestObject:         config.OAuthConfig.RequireSignedRequestObject,
 				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				MTLSBoundAccessTokens:              config.OAuthConfig.MTLSBoundAccessTokens,
+				SecurityProfile:                    config.OAuthConfig.SecurityProfile,
+				SubjectType:                        config.OAuthConfig.SubjectType,
+				SectorIdentifierURI:                config.OAuthConfig.SectorIdentifierURI,
 				IncludeActClaim:                    config.OAuthConfig.IncludeActClaim,
 				Token:                              config.OAuthConfig.Token,
 				Scopes:                             config.OAuthConfig.Scopes,
 				UserInfo:                           config.OAuthConfig.UserInfo,
+				AuthorizationResponse:              config.OAuthConfig.AuthorizationResponse,
+				TLSClientAuth:                      config.OAuthConfig.TLSClientAuth,
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
@@ -483,9 +494,11 @@ func (ah *applicationHandler) processInboundAuthConfig(
 				Type:        config.Type,
 				OAuthConfig: &oAuthAppConfig,
 			})
+			if returnApp.ClientID == "" {
+				returnApp.ClientID = config.OAuthConfig.ClientID
+			}
 		}
 		returnApp.InboundAuthConfig = returnInboundAuthConfigs
-		returnApp.ClientID = appDTO.InboundAuthConfig[0].OAuthConfig.ClientID
 	}
 
 	return true
@@ -532,6 +545,13 @@ func (ah *applicationHandler) processInboundAuthConfigFromRequest(
 
 	inboundAuthConfigDTOs := make([]providers.InboundAuthConfigWithSecret, 0)
 	for _, config := range configs {
+		if config.Type == providers.SAMLInboundAuthType {
+			inboundAuthConfigDTOs = append(inboundAuthConfigDTOs, providers.InboundAuthConfigWithSecret{
+				Type:       config.Type,
+				SAMLConfig: config.SAMLConfig,
+			})
+			continue
+		}
 		if config.Type != providers.OAuthInboundAuthType || config.OAuthConfig == nil {
 			continue
 		}
@@ -543,17 +563,28 @@ func (ah *applicationHandler) processInboundAuthConfigFromRequest(
 				ClientSecret:                       config.OAuthConfig.ClientSecret,
 				RedirectURIs:                       config.OAuthConfig.RedirectURIs,
 				PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+				BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+				BackchannelLogoutSessionRequired:   config.OAuthConfig.BackchannelLogoutSessionRequired,
+				FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
+				FrontchannelLogoutSessionRequired:  config.OAuthConfig.FrontchannelLogoutSessionRequired,
 				GrantTypes:                         config.OAuthConfig.GrantTypes,
 				ResponseTypes:                      config.OAuthConfig.ResponseTypes,
 				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 				PKCERequired:                       config.OAuthConfig.PKCERequired,
 				PublicClient:                       config.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+				RequireSignedRequestObject:         config.OAuthConfig.RequireSignedRequestObject,
 				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				MTLSBoundAccessTokens:              config.OAuthConfig.MTLSBoundAccessTokens,
+				SecurityProfile:                    config.OAuthConfig.SecurityProfile,
+				SubjectType:                        config.OAuthConfig.SubjectType,
+				SectorIdentifierURI:                config.OAuthConfig.SectorIdentifierURI,
 				IncludeActClaim:                    config.OAuthConfig.IncludeActClaim,
 				Token:                              config.OAuthConfig.Token,
 				Scopes:                             config.OAuthConfig.Scopes,
 				UserInfo:                           config.OAuthConfig.UserInfo,
+				AuthorizationResponse:              config.OAuthConfig.AuthorizationResponse,
+				TLSClientAuth:                      config.OAuthConfig.TLSClientAuth,
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
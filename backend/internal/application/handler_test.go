@@ -508,6 +508,57 @@ func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_SuccessWithOAuth(
 	mockService.AssertExpectations(suite.T())
 }
 
+func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_SuccessWithSAML() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	expectedApp := &providers.Application{
+		ID:   "test-app-id",
+		Name: "TestApp",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{
+			{
+				Type: providers.OAuthInboundAuthType,
+				OAuthConfig: &providers.OAuthConfigWithSecret{
+					ClientID:                "test-client-id",
+					RedirectURIs:            []string{"https://example.com/callback"},
+					GrantTypes:              []providers.GrantType{providers.GrantTypeAuthorizationCode},
+					ResponseTypes:           []providers.ResponseType{providers.ResponseTypeCode},
+					TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodClientSecretBasic,
+				},
+			},
+			{
+				Type: providers.SAMLInboundAuthType,
+				SAMLConfig: &providers.SAMLProfile{
+					EntityID:                     "https://sp.example.com",
+					AssertionConsumerServiceURLs: []string{"https://sp.example.com/acs"},
+					NameIDFormat:                 providers.SAMLNameIDFormatEmail,
+				},
+			},
+		},
+	}
+
+	mockService.On("GetApplication", mock.Anything, "test-app-id").Return(expectedApp, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id", nil)
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationGetRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response model.ApplicationGetResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "test-client-id", response.ClientID)
+	assert.Len(suite.T(), response.InboundAuthConfig, 2)
+	assert.Equal(suite.T(), providers.SAMLInboundAuthType, response.InboundAuthConfig[1].Type)
+	assert.NotNil(suite.T(), response.InboundAuthConfig[1].SAMLConfig)
+	assert.Equal(suite.T(), "https://sp.example.com", response.InboundAuthConfig[1].SAMLConfig.EntityID)
+
+	mockService.AssertExpectations(suite.T())
+}
+
 func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_WithTemplate() {
 	mockService := NewApplicationServiceInterfaceMock(suite.T())
 	handler := newApplicationHandler(mockService)
@@ -1906,6 +1957,53 @@ func (suite *HandlerTestSuite) TestHandleApplicationPostRequest_MultipleInboundA
 	mockService.AssertExpectations(suite.T())
 }
 
+func (suite *HandlerTestSuite) TestHandleApplicationPostRequest_SAMLOnly() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	samlConfig := &providers.SAMLProfile{
+		EntityID:                     "https://sp.example.com",
+		AssertionConsumerServiceURLs: []string{"https://sp.example.com/acs"},
+	}
+	appRequest := model.ApplicationRequest{
+		OUID: "ou-123",
+		Name: "SAML App",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{
+			{Type: providers.SAMLInboundAuthType, SAMLConfig: samlConfig},
+		},
+	}
+	createdApp := &model.ApplicationDTO{
+		ID:   "saml-app-id",
+		Name: "SAML App",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{
+			{Type: providers.SAMLInboundAuthType, SAMLConfig: samlConfig},
+		},
+	}
+
+	mockService.On("CreateApplication", mock.Anything, mock.MatchedBy(func(app *model.ApplicationDTO) bool {
+		return len(app.InboundAuthConfig) == 1 && app.InboundAuthConfig[0].SAMLConfig != nil &&
+			app.InboundAuthConfig[0].SAMLConfig.EntityID == "https://sp.example.com"
+	})).Return(createdApp, nil)
+
+	body, _ := json.Marshal(appRequest)
+	req := httptest.NewRequest(http.MethodPost, "/applications", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationPostRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+
+	var response model.ApplicationCompleteResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), response.ClientID)
+	assert.Len(suite.T(), response.InboundAuthConfig, 1)
+	assert.Equal(suite.T(), providers.SAMLInboundAuthType, response.InboundAuthConfig[0].Type)
+
+	mockService.AssertExpectations(suite.T())
+}
+
 func (suite *HandlerTestSuite) TestHandleError_EncodeErrorResponseFails() {
 	mockService := NewApplicationServiceInterfaceMock(suite.T())
 	handler := newApplicationHandler(mockService)
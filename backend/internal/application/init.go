@@ -19,6 +19,7 @@ import (
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -33,9 +34,11 @@ func Initialize(
 	i18nService i18nmgt.I18nServiceInterface,
 	cryptoSvc providers.RuntimeCryptoProvider,
 	serverConfigSvc serverconfig.ServerConfigService,
+	observabilitySvc providers.ObservabilityProvider,
 ) (ApplicationServiceInterface, declarativeresource.ResourceExporter, error) {
 	appService := newApplicationService(
 		inboundClient, entityProvider, ouService, i18nService, cryptoSvc, serverConfigSvc,
+		audit.NewRecorder(observabilitySvc),
 	)
 
 	if err := entityService.LoadIndexedAttributes(getAppIndexedAttributes()); err != nil {
@@ -145,6 +145,7 @@ func (suite *InitTestSuite) TestInitialize_WithDeclarativeResourcesDisabled() {
 		nil, // i18nService - not needed for this test
 		nil, // cryptoSvc - not needed for this test
 		nil, // serverConfigSvc - not needed for this test
+		nil, // observabilitySvc - not needed for this test
 	)
 
 	// Assert
@@ -189,6 +190,7 @@ func (suite *InitTestSuite) TestInitialize_WithMCPServer() {
 		nil, // i18nService - not needed for this test
 		nil, // cryptoSvc - not needed for this test
 		nil, // serverConfigSvc - not needed for this test
+		nil, // observabilitySvc - not needed for this test
 	)
 
 	// Assert
@@ -581,6 +583,7 @@ func TestInitialize_Standalone(t *testing.T) {
 		nil, // i18nService - not needed for this test
 		nil, // cryptoSvc - not needed for this test
 		nil, // serverConfigSvc - not needed for this test
+		nil, // observabilitySvc - not needed for this test
 	)
 
 	// Assert
@@ -633,6 +636,7 @@ func TestInitialize_WithDeclarativeResources_Standalone(t *testing.T) {
 		nil, // i18nService - not needed for this test
 		nil, // cryptoSvc - not needed for this test
 		nil, // serverConfigSvc - not needed for this test
+		nil, // observabilitySvc - not needed for this test
 	)
 
 	// Assert
@@ -25,6 +25,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
@@ -60,6 +61,7 @@ type applicationService struct {
 	cryptoSvc            providers.RuntimeCryptoProvider
 	dependencyRegistry   resourcedependency.Registry
 	serverConfigService  serverconfig.ServerConfigService
+	auditRecorder        *audit.Recorder
 }
 
 // newApplicationService creates a new instance of ApplicationService.
@@ -70,6 +72,7 @@ func newApplicationService(
 	i18nService i18nmgt.I18nServiceInterface,
 	cryptoSvc providers.RuntimeCryptoProvider,
 	serverConfigSvc serverconfig.ServerConfigService,
+	auditRecorder *audit.Recorder,
 ) ApplicationServiceInterface {
 	return &applicationService{
 		logger:               log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ApplicationService")),
@@ -79,6 +82,7 @@ func newApplicationService(
 		i18nService:          i18nService,
 		cryptoSvc:            cryptoSvc,
 		serverConfigService:  serverConfigSvc,
+		auditRecorder:        auditRecorder,
 	}
 }
 
@@ -114,9 +118,13 @@ func (as *applicationService) CreateApplication(ctx context.Context, app *model.
 
 	inboundClient := toInboundClient(processedDTO)
 	oauthProfile := toOAuthProfile(processedDTO)
+	samlProfile := toSAMLProfile(processedDTO)
 	if svcErr := as.resolveAttestationCredentialsForPersist(ctx, appID, &inboundClient); svcErr != nil {
 		return nil, svcErr
 	}
+	if svcErr := as.resolveClientSecretForPersist(ctx, appID, inboundAuthConfig, oauthProfile); svcErr != nil {
+		return nil, svcErr
+	}
 
 	// Create entity.
 	var clientID string
@@ -172,6 +180,21 @@ func (as *applicationService) CreateApplication(ctx context.Context, app *model.
 		as.logger.Error(ctx, "Failed to create application", log.Error(err), log.String("appID", appID))
 		return nil, &tidcommon.InternalServerError
 	}
+	if samlProfile != nil {
+		if err := as.inboundClientService.SyncSAMLProfile(ctx, appID, samlProfile); err != nil {
+			// Compensate: the SAML profile is the last write, so roll back the inbound client and entity.
+			if delErr := as.inboundClientService.DeleteInboundClient(ctx, appID); delErr != nil {
+				as.logger.Error(ctx, "Failed to delete inbound client during compensation", log.Error(delErr),
+					log.String("appID", appID))
+			}
+			as.deleteEntityCompensation(ctx, appID)
+			if svcErr := as.translateInboundClientError(ctx, err); svcErr != nil {
+				return nil, svcErr
+			}
+			as.logger.Error(ctx, "Failed to create SAML profile", log.Error(err), log.String("appID", appID))
+			return nil, &tidcommon.InternalServerError
+		}
+	}
 
 	as.syncPasskeyOriginsToCORS(ctx, processedDTO.PasskeyAllowedOrigins)
 
@@ -195,6 +218,8 @@ func (as *applicationService) CreateApplication(ctx context.Context, app *model.
 	}
 	returnDTO := buildReturnApplicationDTO(appID, &appForReturn, inboundClient.Assertion, processedDTO.Metadata,
 		inboundAuthConfig, oauthToken, userInfo, scopeClaims)
+	appendSAMLInboundAuthConfig(returnDTO, samlProfile)
+	as.auditRecorder.Created(ctx, audit.TargetApplication, appID, processedDTO)
 	// Surface the Flow Secret once, on creation only.
 	returnDTO.FlowSecret = flowSecret
 	return returnDTO, nil
@@ -248,6 +273,7 @@ func (as *applicationService) ValidateApplication(ctx context.Context, app *mode
 		)
 		processedDTO.InboundAuthConfig = []inboundmodel.InboundAuthConfigProcessed{processedInboundAuthConfig}
 	}
+	appendSAMLInboundAuthConfigProcessed(processedDTO, app)
 
 	// Validate FK constraints (flow, theme, layout, user-type) and OAuth profile.
 	// This runs the same checks as Create/Update so declarative resources are validated consistently.
@@ -264,6 +290,15 @@ func (as *applicationService) ValidateApplication(ctx context.Context, app *mode
 		as.logger.Error(ctx, "Inbound client validation failed", log.Error(err))
 		return nil, nil, &tidcommon.InternalServerError
 	}
+	if samlProfile := toSAMLProfile(processedDTO); samlProfile != nil {
+		if err := as.inboundClientService.ValidateSAMLProfile(ctx, appID, samlProfile); err != nil {
+			if svcErr := as.translateInboundClientError(ctx, err); svcErr != nil {
+				return nil, nil, svcErr
+			}
+			as.logger.Error(ctx, "SAML profile validation failed", log.Error(err))
+			return nil, nil, &tidcommon.InternalServerError
+		}
+	}
 	processedDTO.AuthFlowID = inboundClient.AuthFlowID
 	processedDTO.RegistrationFlowID = inboundClient.RegistrationFlowID
 	processedDTO.RecoveryFlowID = inboundClient.RecoveryFlowID
@@ -397,9 +432,23 @@ func (as *applicationService) UpdateApplication(ctx context.Context, appID strin
 
 	inboundClient := toInboundClient(processedDTO)
 	oauthProfile := toOAuthProfile(processedDTO)
+	samlProfile := toSAMLProfile(processedDTO)
 	if svcErr := as.resolveAttestationCredentialsForPersist(ctx, appID, &inboundClient); svcErr != nil {
 		return nil, svcErr
 	}
+	if svcErr := as.resolveClientSecretForPersist(ctx, appID, inboundAuthConfig, oauthProfile); svcErr != nil {
+		return nil, svcErr
+	}
+	// Validate the SAML profile up front so a rejected registration leaves the application untouched.
+	if samlProfile != nil {
+		if err := as.inboundClientService.ValidateSAMLProfile(ctx, appID, samlProfile); err != nil {
+			if svcErr := as.translateInboundClientError(ctx, err); svcErr != nil {
+				return nil, svcErr
+			}
+			as.logger.Error(ctx, "SAML profile validation failed", log.Error(err), log.String("appID", appID))
+			return nil, &tidcommon.InternalServerError
+		}
+	}
 
 	var newOAuthClientID string
 	if inboundAuthConfig != nil && inboundAuthConfig.OAuthConfig != nil {
@@ -419,6 +468,15 @@ func (as *applicationService) UpdateApplication(ctx context.Context, appID strin
 		as.logger.Error(ctx, "Failed to update application", log.Error(err), log.String("appID", appID))
 		return nil, &tidcommon.InternalServerError
 	}
+	if samlProfile != nil || toSAMLProfile(existingApp) != nil {
+		if err := as.inboundClientService.SyncSAMLProfile(ctx, appID, samlProfile); err != nil {
+			if svcErr := as.translateInboundClientError(ctx, err); svcErr != nil {
+				return nil, svcErr
+			}
+			as.logger.Error(ctx, "Failed to update SAML profile", log.Error(err), log.String("appID", appID))
+			return nil, &tidcommon.InternalServerError
+		}
+	}
 
 	if svcErr := as.updateEntityDataForApplicationUpdate(ctx, appID, app, inboundAuthConfig); svcErr != nil {
 		return nil, svcErr
@@ -449,8 +507,39 @@ func (as *applicationService) UpdateApplication(ctx context.Context, appID strin
 			inboundAuthConfig.OAuthConfig.Certificate = nil
 		}
 	}
-	return buildReturnApplicationDTO(appID, &appForReturn, inboundClient.Assertion, processedDTO.Metadata,
-		inboundAuthConfig, oauthToken, userInfo, scopeClaims), nil
+	returnDTO := buildReturnApplicationDTO(appID, &appForReturn, inboundClient.Assertion, processedDTO.Metadata,
+		inboundAuthConfig, oauthToken, userInfo, scopeClaims)
+	appendSAMLInboundAuthConfig(returnDTO, samlProfile)
+	as.recordApplicationUpdate(ctx, appID, existingApp, processedDTO, app, inboundAuthConfig)
+	return returnDTO, nil
+}
+
+// recordApplicationUpdate records the audit events for an application update. Secret rotations are
+// recorded as a separate application.secret.rotate action naming the rotated secrets.
+func (as *applicationService) recordApplicationUpdate(ctx context.Context, appID string,
+	before, after *model.ApplicationProcessedDTO, app *model.ApplicationDTO,
+	inboundAuthConfig *providers.InboundAuthConfigWithSecret,
+) {
+	as.auditRecorder.Updated(ctx, audit.TargetApplication, appID, before, after)
+
+	var rotated []string
+	if inboundAuthConfig != nil && inboundAuthConfig.OAuthConfig != nil &&
+		appRequiresClientSecret(inboundAuthConfig.OAuthConfig) && inboundAuthConfig.OAuthConfig.ClientSecret != "" {
+		rotated = append(rotated, "clientSecret")
+	}
+	if app.FlowSecret != "" && isFlowSecretEligible(app.Type, inboundAuthConfig) {
+		rotated = append(rotated, "flowSecret")
+	}
+	if len(rotated) == 0 {
+		return
+	}
+	as.auditRecorder.Record(ctx, audit.Entry{
+		Operation:  audit.OperationUpdate,
+		TargetType: audit.TargetApplication,
+		TargetID:   appID,
+		Action:     "application.secret.rotate",
+		After:      map[string]interface{}{"rotated": rotated},
+	})
 }
 
 func (as *applicationService) updateEntityDataForApplicationUpdate(ctx context.Context,
@@ -568,10 +657,13 @@ func appRequiresClientSecret(cfg *providers.OAuthConfigWithSecret) bool {
 	}
 	switch cfg.TokenEndpointAuthMethod {
 	case providers.TokenEndpointAuthMethodClientSecretBasic,
-		providers.TokenEndpointAuthMethodClientSecretPost:
+		providers.TokenEndpointAuthMethodClientSecretPost,
+		providers.TokenEndpointAuthMethodClientSecretJWT:
 		return true
 	case providers.TokenEndpointAuthMethodNone,
-		providers.TokenEndpointAuthMethodPrivateKeyJWT:
+		providers.TokenEndpointAuthMethodPrivateKeyJWT,
+		providers.TokenEndpointAuthMethodTLSClientAuth,
+		providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
 		return false
 	}
 	// Default to requiring a secret when method is unspecified.
@@ -608,6 +700,11 @@ func (as *applicationService) DeleteApplication(ctx context.Context, appID strin
 			log.String("appID", appID))
 		return &tidcommon.InternalServerError
 	}
+
+	var previousApp *model.ApplicationProcessedDTO
+	if as.auditRecorder.IsEnabled() {
+		previousApp, _ = as.getApplication(ctx, appID)
+	}
 	if _, err := as.dependencyRegistry.CascadeDelete(
 		ctx, resourcedependency.ResourceTypeApplication, appID); err != nil {
 		as.logger.Error(ctx, "Failed to cascade-delete application dependencies",
@@ -635,6 +732,7 @@ func (as *applicationService) DeleteApplication(ctx context.Context, appID strin
 			log.String("appID", appID), log.Error(epErr))
 		return &tidcommon.InternalServerError
 	}
+	as.auditRecorder.Deleted(ctx, audit.TargetApplication, appID, previousApp)
 
 	return as.deleteLocalizedVariants(ctx, appID)
 }
@@ -780,7 +878,20 @@ func (as *applicationService) getApplication(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	samlProfile, err := as.inboundClientService.GetSAMLProfileByEntityID(ctx, appID)
+	if err != nil && !errors.Is(err, inboundclient.ErrInboundClientNotFound) {
+		as.logger.Error(ctx, "Failed to get SAML profile for application",
+			log.String("appID", appID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
 	dto := toProcessedDTO(entity, inboundClient, oauthProfile)
+	if samlProfile != nil {
+		dto.InboundAuthConfig = append(dto.InboundAuthConfig, inboundmodel.InboundAuthConfigProcessed{
+			Type:       providers.SAMLInboundAuthType,
+			SAMLConfig: samlProfile,
+		})
+	}
 	return dto, nil
 }
 
@@ -976,20 +1087,32 @@ func buildOAuthProfileFromProcessed(inboundAuth inboundmodel.InboundAuthConfigPr
 	return &providers.OAuthProfile{
 		RedirectURIs:                       oa.RedirectURIs,
 		PostLogoutRedirectURIs:             oa.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               oa.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   oa.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              oa.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  oa.FrontchannelLogoutSessionRequired,
 		GrantTypes:                         sysutils.ConvertToStringSlice(oa.GrantTypes),
 		ResponseTypes:                      sysutils.ConvertToStringSlice(oa.ResponseTypes),
 		TokenEndpointAuthMethod:            string(oa.TokenEndpointAuthMethod),
 		PKCERequired:                       oa.PKCERequired,
 		PublicClient:                       oa.PublicClient,
 		RequirePushedAuthorizationRequests: oa.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         oa.RequireSignedRequestObject,
 		DPoPBoundAccessTokens:              oa.DPoPBoundAccessTokens,
+		MTLSBoundAccessTokens:              oa.MTLSBoundAccessTokens,
+		SecurityProfile:                    string(oa.SecurityProfile),
+		SubjectType:                        string(oa.SubjectType),
+		SectorIdentifierURI:                oa.SectorIdentifierURI,
 		IncludeActClaim:                    oa.IncludeActClaim,
 		Scopes:                             oa.Scopes,
 		ScopeClaims:                        oa.ScopeClaims,
 		Token:                              oa.Token,
 		UserInfo:                           oa.UserInfo,
+		AuthorizationResponse:              oa.AuthorizationResponse,
+		TLSClientAuth:                      oa.TLSClientAuth,
 		Certificate:                        oa.Certificate,
 		AcrValues:                          oa.AcrValues,
+		RegistrationTokenHash:              oa.RegistrationTokenHash,
 	}
 }
 
@@ -1077,6 +1200,55 @@ func getOAuthInboundAuthConfigProcessedDTO(
 	return nil
 }
 
+// getSAMLInboundAuthConfigDTO returns the SAML inbound auth config from the request, or nil.
+func getSAMLInboundAuthConfigDTO(
+	configs []providers.InboundAuthConfigWithSecret,
+) *providers.InboundAuthConfigWithSecret {
+	for i := range configs {
+		if configs[i].Type == providers.SAMLInboundAuthType && configs[i].SAMLConfig != nil {
+			return &configs[i]
+		}
+	}
+	return nil
+}
+
+// toSAMLProfile returns the SAML profile carried by a processed DTO, or nil when the application
+// is not registered as a SAML service provider.
+func toSAMLProfile(processedDTO *model.ApplicationProcessedDTO) *providers.SAMLProfile {
+	for i := range processedDTO.InboundAuthConfig {
+		if processedDTO.InboundAuthConfig[i].Type == providers.SAMLInboundAuthType {
+			return processedDTO.InboundAuthConfig[i].SAMLConfig
+		}
+	}
+	return nil
+}
+
+// appendSAMLInboundAuthConfigProcessed adds the request's SAML configuration, if any, to the
+// processed DTO. The profile is copied so that defaults applied during validation do not leak back
+// into the caller's request.
+func appendSAMLInboundAuthConfigProcessed(processedDTO *model.ApplicationProcessedDTO, app *model.ApplicationDTO) {
+	samlConfig := getSAMLInboundAuthConfigDTO(app.InboundAuthConfig)
+	if samlConfig == nil {
+		return
+	}
+	profile := *samlConfig.SAMLConfig
+	processedDTO.InboundAuthConfig = append(processedDTO.InboundAuthConfig, inboundmodel.InboundAuthConfigProcessed{
+		Type:       providers.SAMLInboundAuthType,
+		SAMLConfig: &profile,
+	})
+}
+
+// appendSAMLInboundAuthConfig adds the persisted SAML profile, if any, to the returned application.
+func appendSAMLInboundAuthConfig(returnDTO *model.ApplicationDTO, samlProfile *providers.SAMLProfile) {
+	if samlProfile == nil {
+		return
+	}
+	returnDTO.InboundAuthConfig = append(returnDTO.InboundAuthConfig, providers.InboundAuthConfigWithSecret{
+		Type:       providers.SAMLInboundAuthType,
+		SAMLConfig: samlProfile,
+	})
+}
+
 func (as *applicationService) validateApplicationForUpdate(
 	ctx context.Context, appID string, app *model.ApplicationDTO) (
 	*model.ApplicationProcessedDTO, *providers.InboundAuthConfigWithSecret, *tidcommon.ServiceError) {
@@ -1175,15 +1347,23 @@ func (as *applicationService) validateApplicationFields(
 	}
 	// Reject requests with more than one OAuth-typed inbound auth entry — at most one
 	// inbound auth config per protocol per application is allowed.
-	isOAuthConfig := false
+	isOAuthConfig, isSAMLConfig := false, false
 	for i := range app.InboundAuthConfig {
-		if app.InboundAuthConfig[i].Type != providers.OAuthInboundAuthType {
-			continue
-		}
-		if isOAuthConfig {
-			return &ErrorMultipleOAuthConfigs
+		switch app.InboundAuthConfig[i].Type {
+		case providers.OAuthInboundAuthType:
+			if isOAuthConfig {
+				return &ErrorMultipleOAuthConfigs
+			}
+			isOAuthConfig = true
+		case providers.SAMLInboundAuthType:
+			if isSAMLConfig {
+				return &ErrorMultipleSAMLConfigs
+			}
+			if app.InboundAuthConfig[i].SAMLConfig == nil {
+				return &ErrorInvalidInboundAuthConfig
+			}
+			isSAMLConfig = true
 		}
-		isOAuthConfig = true
 	}
 	as.validateConsentConfig(app)
 
@@ -1223,6 +1403,10 @@ func validateOAuthParamsForCreateAndUpdate(app *model.ApplicationDTO) (*provider
 		return nil, svcErr
 	}
 	if inboundAuthConfig == nil {
+		// A SAML-only application carries no OAuth configuration.
+		if getSAMLInboundAuthConfigDTO(app.InboundAuthConfig) != nil {
+			return nil, nil
+		}
 		return nil, &ErrorInvalidInboundAuthConfig
 	}
 	if inboundAuthConfig.OAuthConfig == nil {
@@ -1303,6 +1487,9 @@ func (as *applicationService) translateInboundClientError(ctx context.Context, e
 	if svcErr := translateCertValidationError(err); svcErr != nil {
 		return svcErr
 	}
+	if svcErr := translateSAMLValidationError(err); svcErr != nil {
+		return svcErr
+	}
 	var opErr *inboundclient.CertOperationError
 	if errors.As(err, &opErr) {
 		return as.translateCertOperationError(ctx, opErr)
@@ -1310,6 +1497,26 @@ func (as *applicationService) translateInboundClientError(ctx context.Context, e
 	return nil
 }
 
+// translateSAMLValidationError maps SAML profile validation sentinels to application-service errors.
+func translateSAMLValidationError(err error) *tidcommon.ServiceError {
+	switch {
+	case errors.Is(err, inboundclient.ErrSAMLEntityIDRequired):
+		return &ErrorInvalidSAMLEntityID
+	case errors.Is(err, inboundclient.ErrSAMLEntityIDAlreadyExists):
+		return &ErrorSAMLEntityIDAlreadyExists
+	case errors.Is(err, inboundclient.ErrSAMLInvalidACSURL):
+		return &ErrorInvalidSAMLACSURL
+	case errors.Is(err, inboundclient.ErrSAMLInvalidNameIDFormat):
+		return &ErrorInvalidSAMLNameIDFormat
+	case errors.Is(err, inboundclient.ErrSAMLInvalidCertificate):
+		return &ErrorInvalidSAMLCertificate
+	case errors.Is(err, inboundclient.ErrSAMLSignedRequestsRequireCertificate):
+		return &ErrorSAMLSignedRequestsRequireCertificate
+	default:
+		return nil
+	}
+}
+
 // translateOAuthValidationError maps OAuth redirect URI, grant/response type, token endpoint
 // auth method, and public client validation sentinels to application-service errors.
 func translateOAuthValidationError(err error) *tidcommon.ServiceError {
@@ -1322,6 +1529,52 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.applicationservice.redirect_uri_fragment_not_allowed_description",
 			DefaultValue: "Redirect URIs must not contain a fragment component",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidBackchannelLogoutURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_backchannel_logout_uri_description",
+			DefaultValue: "Back-channel logout URI must be a public https URL without a fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidFrontchannelLogoutURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_frontchannel_logout_uri_description",
+			DefaultValue: "Front-channel logout URI must share a registered redirect URI's origin and have no fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseUnsupportedSigningAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_unsupported_signing_alg_description",
+			DefaultValue: "authorization response signing algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_unsupported_encryption_alg_description",
+			DefaultValue: "authorization response encryption algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_unsupported_encryption_enc_description",
+			DefaultValue: "authorization response content-encryption algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_encryption_alg_requires_enc_description",
+			DefaultValue: "authorization response encryptionEnc is required when encryptionAlg is set",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_encryption_enc_requires_alg_description",
+			DefaultValue: "authorization response encryptionAlg is required when encryptionEnc is set",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseEncryptionRequiresCertificate):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.applicationservice.authorization_response_encryption_requires_certificate_description",
+			DefaultValue: "a certificate (JWKS or JWKS_URI) is " +
+				"required when authorization response encryption is configured",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_jwks_uri_not_ssrf_safe_description",
+			DefaultValue: "authorization response JWKS URI must be a publicly reachable HTTPS URL",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.applicationservice.auth_code_requires_redirect_uris_description",
@@ -1377,6 +1630,21 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.applicationservice.private_key_jwt_cannot_have_client_secret_description",
 			DefaultValue: "private_key_jwt authentication method cannot have a client secret",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthTLSClientAuthRequiresSubject):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.tls_client_auth_requires_subject_description",
+			DefaultValue: "tls_client_auth authentication method requires exactly one certificate subject identifier",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSelfSignedTLSClientAuthRequiresCertificate):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.self_signed_tls_client_auth_requires_certificate_description",
+			DefaultValue: "self_signed_tls_client_auth authentication method requires an inline JWKS certificate",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthMTLSAuthCannotHaveClientSecret):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.mtls_auth_cannot_have_client_secret_description",
+			DefaultValue: "mutual-TLS authentication methods cannot have a client secret",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthNoneAuthRequiresPublicClient):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.applicationservice.none_auth_method_requires_public_client_description",
@@ -1405,6 +1673,45 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 				"token endpoint authentication method",
 		})
 
+	// OAuth: security profile
+	case errors.Is(err, inboundclient.ErrOAuthInvalidSecurityProfile):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_security_profile_description",
+			DefaultValue: "Invalid security profile. Supported values are 'fapi2' and 'none'",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthFAPI2RequiresStrongClientAuth):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.applicationservice.fapi2_requires_strong_client_auth_description",
+			DefaultValue: "FAPI 2.0 security profile requires a confidential client using private_key_jwt, " +
+				"tls_client_auth or self_signed_tls_client_auth authentication method",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthFAPI2RequiresCodeResponseType):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.fapi2_requires_code_response_type_description",
+			DefaultValue: "FAPI 2.0 security profile only allows the 'code' response type",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidSubjectType):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_subject_type_description",
+			DefaultValue: "Invalid subject type. Supported values are 'public' and 'pairwise'",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidSectorIdentifierURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.applicationservice.invalid_sector_identifier_uri_description",
+			DefaultValue: "Sector identifier URI must be an absolute https URL that returns a " +
+				"JSON array of redirect URIs",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSectorIdentifierURIMissingRedirectURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.sector_identifier_uri_missing_redirect_uri_description",
+			DefaultValue: "Sector identifier URI must list all registered redirect URIs",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthPairwiseRequiresSectorIdentifierURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.applicationservice.pairwise_requires_sector_identifier_uri_description",
+			DefaultValue: "Pairwise subject type requires a sector identifier URI when redirect " +
+				"URIs use more than one host",
+		})
 	// OAuth: public client
 	case errors.Is(err, inboundclient.ErrOAuthPublicClientMustUseNoneAuth):
 		return tidcommon.CustomServiceError(ErrorInvalidPublicClientConfiguration, tidcommon.I18nMessage{
@@ -1678,10 +1985,18 @@ func (as *applicationService) processInboundAuthConfig(ctx context.Context, app
 	if svcErr := resolveClientSecret(ctx, inboundAuthConfig, existingApp); svcErr != nil {
 		return nil, svcErr
 	}
+	resolveRegistrationTokenHash(inboundAuthConfig, existingApp)
 
 	return inboundAuthConfig, nil
 }
 
+// isClientSecretAuthMethod reports whether the method authenticates the client with its client secret.
+func isClientSecretAuthMethod(method providers.TokenEndpointAuthMethod) bool {
+	return method == providers.TokenEndpointAuthMethodClientSecretBasic ||
+		method == providers.TokenEndpointAuthMethodClientSecretPost ||
+		method == providers.TokenEndpointAuthMethodClientSecretJWT
+}
+
 // generateAndAssignClientID generates an OAuth 2.0 compliant client ID and assigns it to the inbound auth config.
 func generateAndAssignClientID(
 	ctx context.Context, inboundAuthConfig *providers.InboundAuthConfigWithSecret,
@@ -1700,11 +2015,8 @@ func resolveClientSecret(
 	inboundAuthConfig *providers.InboundAuthConfigWithSecret,
 	existingApp *model.ApplicationProcessedDTO,
 ) *tidcommon.ServiceError {
-	if (inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod !=
-		providers.TokenEndpointAuthMethodClientSecretBasic &&
-		inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod !=
-			providers.TokenEndpointAuthMethodClientSecretPost) ||
-		inboundAuthConfig.OAuthConfig.ClientSecret != "" {
+	authMethod := inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod
+	if !isClientSecretAuthMethod(authMethod) || inboundAuthConfig.OAuthConfig.ClientSecret != "" {
 		return nil
 	}
 
@@ -1712,9 +2024,12 @@ func resolveClientSecret(
 		if existingInboundAuth := getOAuthInboundAuthConfigProcessedDTO(
 			existingApp.InboundAuthConfig); existingInboundAuth != nil {
 			existingOAuth := existingInboundAuth.OAuthConfig
+			// Only a client_secret_jwt client has its secret stored recoverably, so switching to that
+			// method from a hashed-secret one requires a new secret.
 			if existingOAuth != nil && !existingOAuth.PublicClient &&
-				(existingOAuth.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodClientSecretBasic ||
-					existingOAuth.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodClientSecretPost) {
+				isClientSecretAuthMethod(existingOAuth.TokenEndpointAuthMethod) &&
+				(authMethod != providers.TokenEndpointAuthMethodClientSecretJWT ||
+					existingOAuth.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodClientSecretJWT) {
 				return nil
 			}
 		}
@@ -1730,6 +2045,21 @@ func resolveClientSecret(
 	return nil
 }
 
+// resolveRegistrationTokenHash keeps the registration access token hash of a dynamically registered
+// client when an update does not issue a new token, so editing the application does not lock the
+// client out of its configuration endpoint.
+func resolveRegistrationTokenHash(
+	inboundAuthConfig *providers.InboundAuthConfigWithSecret, existingApp *model.ApplicationProcessedDTO,
+) {
+	if inboundAuthConfig.OAuthConfig.RegistrationTokenHash != "" || existingApp == nil {
+		return
+	}
+	if existingInboundAuth := getOAuthInboundAuthConfigProcessedDTO(
+		existingApp.InboundAuthConfig); existingInboundAuth != nil && existingInboundAuth.OAuthConfig != nil {
+		inboundAuthConfig.OAuthConfig.RegistrationTokenHash = existingInboundAuth.OAuthConfig.RegistrationTokenHash
+	}
+}
+
 // resolveAttestationCredentialsForPersist prepares the write-only Play Integrity service account
 // credentials on the inbound client for persistence: newly supplied credentials are encrypted so
 // they are never stored in plaintext, while an omitted value on an update falls back to the
@@ -1783,6 +2113,46 @@ func (as *applicationService) resolveAttestationCredentialsForPersist(
 	return nil
 }
 
+// resolveClientSecretForPersist stores the client secret of a client_secret_jwt client encrypted on
+// its OAuth profile, since HMAC client assertions are verified with the secret itself. A newly
+// supplied secret is encrypted, while an update that omits it keeps the previously stored value.
+func (as *applicationService) resolveClientSecretForPersist(ctx context.Context, appID string,
+	inboundAuthConfig *providers.InboundAuthConfigWithSecret, oauthProfile *providers.OAuthProfile,
+) *tidcommon.ServiceError {
+	if oauthProfile == nil || oauthProfile.TokenEndpointAuthMethod !=
+		string(providers.TokenEndpointAuthMethodClientSecretJWT) {
+		return nil
+	}
+
+	if inboundAuthConfig != nil && inboundAuthConfig.OAuthConfig != nil &&
+		inboundAuthConfig.OAuthConfig.ClientSecret != "" {
+		ciphertext, _, err := as.cryptoSvc.Encrypt(ctx, nil, string(cryptolib.AlgorithmAESGCM), nil,
+			[]byte(inboundAuthConfig.OAuthConfig.ClientSecret))
+		if err != nil {
+			as.logger.Error(ctx, "Failed to encrypt client secret",
+				log.String("appID", appID), log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		oauthProfile.EncryptedClientSecret = string(ciphertext)
+		return nil
+	}
+
+	existing, err := as.inboundClientService.GetOAuthProfileByEntityID(ctx, appID)
+	switch {
+	case err == nil:
+		if existing != nil {
+			oauthProfile.EncryptedClientSecret = existing.EncryptedClientSecret
+		}
+	case errors.Is(err, inboundclient.ErrInboundClientNotFound):
+		// No existing record; there is no stored secret to preserve.
+	default:
+		as.logger.Error(ctx, "Failed to load existing client secret for preservation",
+			log.String("appID", appID), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
 // enrichApplicationWithCertificate retrieves and adds OAuth certificates to the application.
 func (as *applicationService) enrichApplicationWithCertificate(
 	ctx context.Context, application *providers.Application,
@@ -1847,22 +2217,39 @@ func buildApplicationResponse(dto *model.ApplicationProcessedDTO) *providers.App
 					ClientID:                           oauthAppConfig.ClientID,
 					RedirectURIs:                       oauthAppConfig.RedirectURIs,
 					PostLogoutRedirectURIs:             oauthAppConfig.PostLogoutRedirectURIs,
+					BackchannelLogoutURI:               oauthAppConfig.BackchannelLogoutURI,
+					BackchannelLogoutSessionRequired:   oauthAppConfig.BackchannelLogoutSessionRequired,
+					FrontchannelLogoutURI:              oauthAppConfig.FrontchannelLogoutURI,
+					FrontchannelLogoutSessionRequired:  oauthAppConfig.FrontchannelLogoutSessionRequired,
 					GrantTypes:                         oauthAppConfig.GrantTypes,
 					ResponseTypes:                      oauthAppConfig.ResponseTypes,
 					TokenEndpointAuthMethod:            oauthAppConfig.TokenEndpointAuthMethod,
 					PKCERequired:                       oauthAppConfig.PKCERequired,
 					PublicClient:                       oauthAppConfig.PublicClient,
 					RequirePushedAuthorizationRequests: oauthAppConfig.RequirePushedAuthorizationRequests,
+					RequireSignedRequestObject:         oauthAppConfig.RequireSignedRequestObject,
 					DPoPBoundAccessTokens:              oauthAppConfig.DPoPBoundAccessTokens,
+					MTLSBoundAccessTokens:              oauthAppConfig.MTLSBoundAccessTokens,
+					SecurityProfile:                    oauthAppConfig.SecurityProfile,
+					SubjectType:                        oauthAppConfig.SubjectType,
+					SectorIdentifierURI:                oauthAppConfig.SectorIdentifierURI,
 					IncludeActClaim:                    oauthAppConfig.IncludeActClaim,
 					Token:                              oauthAppConfig.Token,
 					Scopes:                             oauthAppConfig.Scopes,
 					UserInfo:                           oauthAppConfig.UserInfo,
+					AuthorizationResponse:              oauthAppConfig.AuthorizationResponse,
+					TLSClientAuth:                      oauthAppConfig.TLSClientAuth,
 					ScopeClaims:                        oauthAppConfig.ScopeClaims,
 					AcrValues:                          oauthAppConfig.AcrValues,
 				},
 			})
 		}
+		if config.Type == providers.SAMLInboundAuthType && config.SAMLConfig != nil {
+			inboundAuthConfigs = append(inboundAuthConfigs, providers.InboundAuthConfigWithSecret{
+				Type:       providers.SAMLInboundAuthType,
+				SAMLConfig: config.SAMLConfig,
+			})
+		}
 	}
 	application.InboundAuthConfig = inboundAuthConfigs
 	return application
@@ -1965,6 +2352,7 @@ func (as *applicationService) buildProcessedDTOForUpdate(appID string, app *mode
 		)
 		processedDTO.InboundAuthConfig = []inboundmodel.InboundAuthConfigProcessed{processedInboundAuthConfig}
 	}
+	appendSAMLInboundAuthConfigProcessed(processedDTO, app)
 
 	return processedDTO
 }
@@ -1982,20 +2370,32 @@ func buildOAuthInboundAuthConfigProcessedDTO(
 			ClientID:                           inboundAuthConfig.OAuthConfig.ClientID,
 			RedirectURIs:                       inboundAuthConfig.OAuthConfig.RedirectURIs,
 			PostLogoutRedirectURIs:             inboundAuthConfig.OAuthConfig.PostLogoutRedirectURIs,
+			BackchannelLogoutURI:               inboundAuthConfig.OAuthConfig.BackchannelLogoutURI,
+			BackchannelLogoutSessionRequired:   inboundAuthConfig.OAuthConfig.BackchannelLogoutSessionRequired,
+			FrontchannelLogoutURI:              inboundAuthConfig.OAuthConfig.FrontchannelLogoutURI,
+			FrontchannelLogoutSessionRequired:  inboundAuthConfig.OAuthConfig.FrontchannelLogoutSessionRequired,
 			GrantTypes:                         inboundAuthConfig.OAuthConfig.GrantTypes,
 			ResponseTypes:                      inboundAuthConfig.OAuthConfig.ResponseTypes,
 			TokenEndpointAuthMethod:            inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod,
 			PKCERequired:                       inboundAuthConfig.OAuthConfig.PKCERequired,
 			PublicClient:                       inboundAuthConfig.OAuthConfig.PublicClient,
 			RequirePushedAuthorizationRequests: inboundAuthConfig.OAuthConfig.RequirePushedAuthorizationRequests,
+			RequireSignedRequestObject:         inboundAuthConfig.OAuthConfig.RequireSignedRequestObject,
 			DPoPBoundAccessTokens:              inboundAuthConfig.OAuthConfig.DPoPBoundAccessTokens,
+			MTLSBoundAccessTokens:              inboundAuthConfig.OAuthConfig.MTLSBoundAccessTokens,
+			SecurityProfile:                    inboundAuthConfig.OAuthConfig.SecurityProfile,
+			SubjectType:                        inboundAuthConfig.OAuthConfig.SubjectType,
+			SectorIdentifierURI:                inboundAuthConfig.OAuthConfig.SectorIdentifierURI,
 			IncludeActClaim:                    inboundAuthConfig.OAuthConfig.IncludeActClaim,
 			Token:                              oauthToken,
 			Scopes:                             inboundAuthConfig.OAuthConfig.Scopes,
 			UserInfo:                           userInfo,
+			AuthorizationResponse:              inboundAuthConfig.OAuthConfig.AuthorizationResponse,
+			TLSClientAuth:                      inboundAuthConfig.OAuthConfig.TLSClientAuth,
 			ScopeClaims:                        scopeClaims,
 			Certificate:                        certificate,
 			AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
+			RegistrationTokenHash:              inboundAuthConfig.OAuthConfig.RegistrationTokenHash,
 		},
 	}
 }
@@ -2048,17 +2448,28 @@ func buildReturnApplicationDTO(
 				ClientSecret:                       inboundAuthConfig.OAuthConfig.ClientSecret,
 				RedirectURIs:                       inboundAuthConfig.OAuthConfig.RedirectURIs,
 				PostLogoutRedirectURIs:             inboundAuthConfig.OAuthConfig.PostLogoutRedirectURIs,
+				BackchannelLogoutURI:               inboundAuthConfig.OAuthConfig.BackchannelLogoutURI,
+				BackchannelLogoutSessionRequired:   inboundAuthConfig.OAuthConfig.BackchannelLogoutSessionRequired,
+				FrontchannelLogoutURI:              inboundAuthConfig.OAuthConfig.FrontchannelLogoutURI,
+				FrontchannelLogoutSessionRequired:  inboundAuthConfig.OAuthConfig.FrontchannelLogoutSessionRequired,
 				GrantTypes:                         inboundAuthConfig.OAuthConfig.GrantTypes,
 				ResponseTypes:                      inboundAuthConfig.OAuthConfig.ResponseTypes,
 				TokenEndpointAuthMethod:            inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod,
 				PKCERequired:                       inboundAuthConfig.OAuthConfig.PKCERequired,
 				PublicClient:                       inboundAuthConfig.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: inboundAuthConfig.OAuthConfig.RequirePushedAuthorizationRequests,
+				RequireSignedRequestObject:         inboundAuthConfig.OAuthConfig.RequireSignedRequestObject,
 				DPoPBoundAccessTokens:              inboundAuthConfig.OAuthConfig.DPoPBoundAccessTokens,
+				MTLSBoundAccessTokens:              inboundAuthConfig.OAuthConfig.MTLSBoundAccessTokens,
+				SecurityProfile:                    inboundAuthConfig.OAuthConfig.SecurityProfile,
+				SubjectType:                        inboundAuthConfig.OAuthConfig.SubjectType,
+				SectorIdentifierURI:                inboundAuthConfig.OAuthConfig.SectorIdentifierURI,
 				IncludeActClaim:                    inboundAuthConfig.OAuthConfig.IncludeActClaim,
 				Token:                              oauthToken,
 				Scopes:                             inboundAuthConfig.OAuthConfig.Scopes,
 				UserInfo:                           userInfo,
+				AuthorizationResponse:              inboundAuthConfig.OAuthConfig.AuthorizationResponse,
+				TLSClientAuth:                      inboundAuthConfig.OAuthConfig.TLSClientAuth,
 				ScopeClaims:                        scopeClaims,
 				Certificate:                        oauthCert,
 				AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
@@ -147,6 +147,8 @@ func (suite *ServiceTestSuite) setupTestService() (
 	mockEntityProvider.On("UpdateSystemCredentials", mock.Anything, mock.Anything).Maybe().Return(noEPErr)
 	mockStore.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil)
 	mockStore.On("ResolveInboundAuthProfileHandles", mock.Anything, mock.Anything).Maybe().Return(nil)
+	mockStore.On("GetSAMLProfileByEntityID", mock.Anything, mock.Anything).Maybe().
+		Return((*providers.SAMLProfile)(nil), inboundclient.ErrInboundClientNotFound)
 	mockOUService := oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
 	mockOUService.On("IsOrganizationUnitExists", mock.Anything, mock.Anything).Maybe().Return(true, nil)
 	service := &applicationService{
@@ -1808,6 +1810,86 @@ func (suite *ServiceTestSuite) TestCreateApplication_WithAttestation_EncryptsAnd
 	assert.Empty(suite.T(), result.Attestation.Android.ServiceAccountCredentials)
 }
 
+// A client_secret_jwt application keeps an encrypted copy of its secret in the OAuth profile so the
+// HMAC of its client assertions can be verified.
+func (suite *ServiceTestSuite) TestResolveClientSecretForPersist_EncryptsForClientSecretJWT() {
+	service, _ := suite.setupTestService()
+	mockCrypto := cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+	service.cryptoSvc = mockCrypto
+	mockCrypto.EXPECT().Encrypt(mock.Anything, mock.Anything, mock.Anything, mock.Anything, []byte("s3cret")).
+		Return([]byte("encrypted-secret"), nil, nil)
+
+	inboundAuthConfig := &providers.InboundAuthConfigWithSecret{
+		Type:        providers.OAuthInboundAuthType,
+		OAuthConfig: &providers.OAuthConfigWithSecret{ClientSecret: "s3cret"},
+	}
+	oauthProfile := &providers.OAuthProfile{
+		TokenEndpointAuthMethod: string(providers.TokenEndpointAuthMethodClientSecretJWT),
+	}
+
+	svcErr := service.resolveClientSecretForPersist(context.Background(), "app-1", inboundAuthConfig, oauthProfile)
+	require.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), "encrypted-secret", oauthProfile.EncryptedClientSecret)
+}
+
+// An update that does not rotate the secret preserves the previously stored encrypted copy.
+func (suite *ServiceTestSuite) TestResolveClientSecretForPersist_PreservesExistingWhenOmitted() {
+	service, mockStore := suite.setupTestService()
+
+	const appID = "app-1"
+	mockStore.On("GetOAuthProfileByEntityID", mock.Anything, appID).Return(
+		&providers.OAuthProfile{EncryptedClientSecret: "stored-encrypted"}, nil)
+
+	inboundAuthConfig := &providers.InboundAuthConfigWithSecret{
+		Type:        providers.OAuthInboundAuthType,
+		OAuthConfig: &providers.OAuthConfigWithSecret{},
+	}
+	oauthProfile := &providers.OAuthProfile{
+		TokenEndpointAuthMethod: string(providers.TokenEndpointAuthMethodClientSecretJWT),
+	}
+
+	svcErr := service.resolveClientSecretForPersist(context.Background(), appID, inboundAuthConfig, oauthProfile)
+	require.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), "stored-encrypted", oauthProfile.EncryptedClientSecret)
+}
+
+// An update that does not issue a new registration access token keeps the stored token hash.
+func (suite *ServiceTestSuite) TestResolveRegistrationTokenHash_PreservesExistingWhenOmitted() {
+	existingApp := &model.ApplicationProcessedDTO{
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigProcessed{{
+			Type:        providers.OAuthInboundAuthType,
+			OAuthConfig: &providers.OAuthClient{RegistrationTokenHash: "stored-hash"},
+		}},
+	}
+
+	omitted := &providers.InboundAuthConfigWithSecret{OAuthConfig: &providers.OAuthConfigWithSecret{}}
+	resolveRegistrationTokenHash(omitted, existingApp)
+	assert.Equal(suite.T(), "stored-hash", omitted.OAuthConfig.RegistrationTokenHash)
+
+	rotated := &providers.InboundAuthConfigWithSecret{
+		OAuthConfig: &providers.OAuthConfigWithSecret{RegistrationTokenHash: "new-hash"},
+	}
+	resolveRegistrationTokenHash(rotated, existingApp)
+	assert.Equal(suite.T(), "new-hash", rotated.OAuthConfig.RegistrationTokenHash)
+}
+
+// Other authentication methods never store a recoverable copy of the secret.
+func (suite *ServiceTestSuite) TestResolveClientSecretForPersist_SkipsOtherMethods() {
+	service, _ := suite.setupTestService()
+
+	inboundAuthConfig := &providers.InboundAuthConfigWithSecret{
+		Type:        providers.OAuthInboundAuthType,
+		OAuthConfig: &providers.OAuthConfigWithSecret{ClientSecret: "s3cret"},
+	}
+	oauthProfile := &providers.OAuthProfile{
+		TokenEndpointAuthMethod: string(providers.TokenEndpointAuthMethodClientSecretBasic),
+	}
+
+	svcErr := service.resolveClientSecretForPersist(context.Background(), "app-1", inboundAuthConfig, oauthProfile)
+	require.Nil(suite.T(), svcErr)
+	assert.Empty(suite.T(), oauthProfile.EncryptedClientSecret)
+}
+
 // When credentials are omitted (as on an update that does not change them), the previously stored
 // encrypted value is preserved rather than overwritten with an empty value.
 func (suite *ServiceTestSuite) TestResolveAttestationCredentials_PreservesExistingWhenOmitted() {
@@ -0,0 +1,113 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/filter"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const loggerComponentName = "AuditLogHandler"
+
+// auditLogHandler is the handler for the audit event search API.
+type auditLogHandler struct {
+	service AuditLogServiceInterface
+}
+
+// newAuditLogHandler creates a new instance of auditLogHandler.
+func newAuditLogHandler(service AuditLogServiceInterface) *auditLogHandler {
+	return &auditLogHandler{
+		service: service,
+	}
+}
+
+// HandleAuditEventListRequest handles the list audit events request.
+func (h *auditLogHandler) HandleAuditEventListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	if svcErr != nil {
+		h.handleError(ctx, w, svcErr)
+		return
+	}
+
+	if limit == 0 {
+		limit = serverconst.DefaultPageSize
+	}
+
+	f, err := filter.ParseFilterParam(r.URL.Query())
+	if err != nil {
+		h.handleError(ctx, w, &ErrorInvalidFilter)
+		return
+	}
+
+	eventList, svcErr := h.service.GetAuditEventList(ctx, limit, offset, f)
+	if svcErr != nil {
+		h.handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, eventList)
+
+	logger.Debug(ctx, "Successfully listed audit events",
+		log.Int("limit", limit), log.Int("offset", offset),
+		log.Int("totalResults", eventList.TotalResults),
+		log.Int("count", eventList.Count))
+}
+
+// handleError writes the error response for a service error.
+func (h *auditLogHandler) handleError(ctx context.Context, w http.ResponseWriter,
+	svcErr *tidcommon.ServiceError) {
+	var statusCode int
+	switch svcErr.Type {
+	case tidcommon.ClientErrorType:
+		statusCode = http.StatusBadRequest
+		if svcErr.Code == tidcommon.ErrorUnauthorized.Code {
+			statusCode = http.StatusForbidden
+		}
+	default:
+		statusCode = http.StatusInternalServerError
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
+
+// parsePaginationParams parses the limit and offset query parameters.
+func parsePaginationParams(query url.Values) (int, int, *tidcommon.ServiceError) {
+	limit := 0
+	offset := 0
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidLimit
+		}
+		limit = parsedLimit
+	}
+
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidOffset
+		}
+		offset = parsedOffset
+	}
+
+	return limit, offset, nil
+}
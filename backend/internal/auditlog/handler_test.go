@@ -0,0 +1,124 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type AuditLogHandlerTestSuite struct {
+	suite.Suite
+	mockService *AuditLogServiceInterfaceMock
+	mux         *http.ServeMux
+}
+
+func TestAuditLogHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditLogHandlerTestSuite))
+}
+
+func (suite *AuditLogHandlerTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+
+	suite.mockService = NewAuditLogServiceInterfaceMock(suite.T())
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, newAuditLogHandler(suite.mockService))
+}
+
+func (suite *AuditLogHandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *AuditLogHandlerTestSuite) serve(method, target string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	resp := httptest.NewRecorder()
+	suite.mux.ServeHTTP(resp, req)
+	return resp
+}
+
+func (suite *AuditLogHandlerTestSuite) errorCode(resp *httptest.ResponseRecorder) string {
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &errResp))
+	return errResp.Code
+}
+
+func (suite *AuditLogHandlerTestSuite) TestHandleAuditEventListRequest() {
+	suite.mockService.On("GetAuditEventList", mock.Anything, serverconst.DefaultPageSize, 5,
+		mock.MatchedBy(func(f *tidcommon.FilterGroup) bool {
+			return f != nil && len(f.Clauses) == 1 && f.Clauses[0].Expr.Attribute == "subject" &&
+				f.Clauses[0].Expr.Value == "user-1"
+		})).
+		Return(&AuditEventListResponse{TotalResults: 1, StartIndex: 6, Count: 1,
+			Events: []AuditEvent{{ID: "event-1"}}}, nil)
+
+	resp := suite.serve(http.MethodGet, `/audit/events?offset=5&filter=subject+eq+%22user-1%22`)
+	suite.Require().Equal(http.StatusOK, resp.Code)
+
+	var body AuditEventListResponse
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(suite.T(), 1, body.TotalResults)
+	assert.Equal(suite.T(), "event-1", body.Events[0].ID)
+}
+
+func (suite *AuditLogHandlerTestSuite) TestHandleAuditEventListRequest_InvalidParams() {
+	testCases := []struct {
+		name   string
+		target string
+		code   string
+	}{
+		{"invalid limit", "/audit/events?limit=abc", ErrorInvalidLimit.Code},
+		{"invalid offset", "/audit/events?offset=abc", ErrorInvalidOffset.Code},
+		{"invalid filter", "/audit/events?filter=subject+eq", ErrorInvalidFilter.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			resp := suite.serve(http.MethodGet, tc.target)
+			assert.Equal(suite.T(), http.StatusBadRequest, resp.Code)
+			assert.Equal(suite.T(), tc.code, suite.errorCode(resp))
+		})
+	}
+}
+
+func (suite *AuditLogHandlerTestSuite) TestHandleAuditEventListRequest_ServiceErrors() {
+	testCases := []struct {
+		name   string
+		svcErr *tidcommon.ServiceError
+		status int
+	}{
+		{"unauthorized", &tidcommon.ErrorUnauthorized, http.StatusForbidden},
+		{"client error", &ErrorInvalidFilter, http.StatusBadRequest},
+		{"server error", &tidcommon.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockService.On("GetAuditEventList", mock.Anything, serverconst.DefaultPageSize, 0,
+				mock.Anything).Return(nil, tc.svcErr)
+
+			resp := suite.serve(http.MethodGet, "/audit/events")
+			assert.Equal(suite.T(), tc.status, resp.Code)
+			assert.Equal(suite.T(), tc.svcErr.Code, suite.errorCode(resp))
+		})
+	}
+}
+
+func (suite *AuditLogHandlerTestSuite) TestRegisterRoutes_Options() {
+	resp := suite.serve(http.MethodOptions, "/audit/events")
+	assert.Equal(suite.T(), http.StatusNoContent, resp.Code)
+}
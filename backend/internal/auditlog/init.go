@@ -0,0 +1,41 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+// Initialize initializes the audit log service and registers its routes. The events it serves are
+// written by the database observability subscriber, enabled with observability.output.database.enabled.
+func Initialize(
+	mux *http.ServeMux,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+) AuditLogServiceInterface {
+	auditLogService := newAuditLogService(newAuditEventStore(), authzService)
+
+	auditLogHandler := newAuditLogHandler(auditLogService)
+	registerRoutes(mux, auditLogHandler)
+
+	return auditLogService
+}
+
+// registerRoutes registers the routes for the audit event search API.
+func registerRoutes(mux *http.ServeMux, auditLogHandler *auditLogHandler) {
+	corsOptions := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /audit/events",
+		auditLogHandler.HandleAuditEventListRequest, corsOptions))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /audit/events",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, corsOptions))
+}
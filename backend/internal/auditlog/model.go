@@ -0,0 +1,33 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// AuditEvent is an observability event persisted by the database subscriber.
+type AuditEvent struct {
+	ID            string                 `json:"id"`
+	EventType     string                 `json:"eventType"`
+	Category      string                 `json:"category"`
+	Component     string                 `json:"component"`
+	Status        string                 `json:"status"`
+	Subject       string                 `json:"subject,omitempty"`
+	ClientID      string                 `json:"clientId,omitempty"`
+	CorrelationID string                 `json:"correlationId,omitempty"`
+	Timestamp     time.Time              `json:"timestamp"`
+	Data          map[string]interface{} `json:"data,omitempty"`
+}
+
+// AuditEventListResponse is a paginated list of stored audit events.
+type AuditEventListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	StartIndex   int          `json:"startIndex"`
+	Count        int          `json:"count"`
+	Events       []AuditEvent `json:"events"`
+	Links        []utils.Link `json:"links"`
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package auditlog persists observability events and provides the audit event search API.
+package auditlog
+
+import (
+	"context"
+	"time"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const loggerComponentNameService = "AuditLogService"
+
+// AuditLogServiceInterface defines the operations for searching stored audit events.
+type AuditLogServiceInterface interface {
+	GetAuditEventList(ctx context.Context, limit, offset int, f *tidcommon.FilterGroup) (
+		*AuditEventListResponse, *tidcommon.ServiceError)
+}
+
+// auditLogService is the default implementation of AuditLogServiceInterface.
+type auditLogService struct {
+	store        auditEventStoreInterface
+	authzService sysauthz.SystemAuthorizationServiceInterface
+	logger       *log.Logger
+}
+
+// newAuditLogService creates a new instance of auditLogService.
+func newAuditLogService(store auditEventStoreInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface) AuditLogServiceInterface {
+	return &auditLogService{
+		store:        store,
+		authzService: authzService,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentNameService)),
+	}
+}
+
+// GetAuditEventList returns a page of stored audit events matching the filter, newest first.
+func (s *auditLogService) GetAuditEventList(ctx context.Context, limit, offset int, f *tidcommon.FilterGroup) (
+	*AuditEventListResponse, *tidcommon.ServiceError) {
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, security.ActionListAuditEvents, nil)
+	if svcErr != nil {
+		return nil, &tidcommon.InternalServerError
+	}
+	if !allowed {
+		return nil, &tidcommon.ErrorUnauthorized
+	}
+
+	if err := validatePaginationParams(limit, offset); err != nil {
+		return nil, err
+	}
+
+	filter, svcErr := validateFilter(f)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	totalCount, err := s.store.getEventListCount(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get audit event count", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	events, err := s.store.getEventList(ctx, limit, offset, filter)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list audit events", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return &AuditEventListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(events),
+		Events:       events,
+		Links:        utils.BuildPaginationLinks("/audit/events", limit, offset, totalCount, ""),
+	}, nil
+}
+
+// validatePaginationParams validates pagination parameters.
+func validatePaginationParams(limit, offset int) *tidcommon.ServiceError {
+	if limit < 1 || limit > serverconst.MaxPageSize {
+		return &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return &ErrorInvalidOffset
+	}
+	return nil
+}
+
+// validateFilter checks the filter against the searchable audit event attributes and returns a copy with
+// the timestamp bounds parsed into times. The timestamp attribute accepts gt and lt with an RFC 3339 value;
+// every other attribute accepts eq with a string value.
+func validateFilter(f *tidcommon.FilterGroup) (*tidcommon.FilterGroup, *tidcommon.ServiceError) {
+	if f == nil {
+		return nil, nil
+	}
+
+	validated := &tidcommon.FilterGroup{Clauses: make([]tidcommon.FilterClause, 0, len(f.Clauses))}
+	for _, clause := range f.Clauses {
+		if _, ok := auditEventFilterableColumns[clause.Expr.Attribute]; !ok {
+			return nil, &ErrorInvalidFilter
+		}
+		value, ok := clause.Expr.Value.(string)
+		if !ok {
+			return nil, &ErrorInvalidFilter
+		}
+
+		if clause.Expr.Attribute == auditEventTimeAttribute {
+			if clause.Expr.Operator != tidcommon.OperatorGt && clause.Expr.Operator != tidcommon.OperatorLt {
+				return nil, &ErrorInvalidFilter
+			}
+			bound, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, &ErrorInvalidFilter
+			}
+			clause.Expr.Value = bound.UTC()
+		} else if clause.Expr.Operator != tidcommon.OperatorEq {
+			return nil, &ErrorInvalidFilter
+		}
+
+		validated.Clauses = append(validated.Clauses, clause)
+	}
+
+	return validated, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+type AuditLogServiceTestSuite struct {
+	suite.Suite
+	mockStore *auditEventStoreInterfaceMock
+	mockAuthz *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	service   AuditLogServiceInterface
+}
+
+func TestAuditLogServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditLogServiceTestSuite))
+}
+
+func (suite *AuditLogServiceTestSuite) SetupTest() {
+	suite.mockStore = newAuditEventStoreInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.service = newAuditLogService(suite.mockStore, suite.mockAuthz)
+}
+
+func (suite *AuditLogServiceTestSuite) allowList() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionListAuditEvents,
+		mock.Anything).Return(true, nil)
+}
+
+func filterOf(attribute string, operator tidcommon.Operator, value interface{}) *tidcommon.FilterGroup {
+	return &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: attribute, Operator: operator, Value: value}},
+	}}
+}
+
+func (suite *AuditLogServiceTestSuite) TestGetAuditEventList() {
+	suite.allowList()
+	events := []AuditEvent{{ID: "event-1"}, {ID: "event-2"}}
+	suite.mockStore.On("getEventListCount", mock.Anything, (*tidcommon.FilterGroup)(nil)).Return(5, nil)
+	suite.mockStore.On("getEventList", mock.Anything, 2, 0, (*tidcommon.FilterGroup)(nil)).Return(events, nil)
+
+	resp, svcErr := suite.service.GetAuditEventList(context.Background(), 2, 0, nil)
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), 5, resp.TotalResults)
+	assert.Equal(suite.T(), 1, resp.StartIndex)
+	assert.Equal(suite.T(), 2, resp.Count)
+	assert.Equal(suite.T(), events, resp.Events)
+	assert.NotEmpty(suite.T(), resp.Links)
+}
+
+func (suite *AuditLogServiceTestSuite) TestGetAuditEventList_ParsesTimestampBounds() {
+	suite.allowList()
+	expected := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	matchesBound := mock.MatchedBy(func(f *tidcommon.FilterGroup) bool {
+		return f != nil && len(f.Clauses) == 1 && f.Clauses[0].Expr.Value == expected
+	})
+	suite.mockStore.On("getEventListCount", mock.Anything, matchesBound).Return(0, nil)
+	suite.mockStore.On("getEventList", mock.Anything, 10, 0, matchesBound).Return([]AuditEvent{}, nil)
+
+	input := filterOf("timestamp", tidcommon.OperatorGt, "2026-10-01T12:00:00+02:00")
+	_, svcErr := suite.service.GetAuditEventList(context.Background(), 10, 0, input)
+	assert.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), "2026-10-01T12:00:00+02:00", input.Clauses[0].Expr.Value)
+}
+
+func (suite *AuditLogServiceTestSuite) TestGetAuditEventList_Unauthorized() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionListAuditEvents,
+		mock.Anything).Return(false, nil)
+
+	_, svcErr := suite.service.GetAuditEventList(context.Background(), 10, 0, nil)
+	assert.Equal(suite.T(), &tidcommon.ErrorUnauthorized, svcErr)
+}
+
+func (suite *AuditLogServiceTestSuite) TestGetAuditEventList_AuthzError() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionListAuditEvents,
+		mock.Anything).Return(false, &tidcommon.InternalServerError)
+
+	_, svcErr := suite.service.GetAuditEventList(context.Background(), 10, 0, nil)
+	assert.Equal(suite.T(), &tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *AuditLogServiceTestSuite) TestGetAuditEventList_InvalidParams() {
+	testCases := []struct {
+		name     string
+		limit    int
+		offset   int
+		filter   *tidcommon.FilterGroup
+		expected *tidcommon.ServiceError
+	}{
+		{"zero limit", 0, 0, nil, &ErrorInvalidLimit},
+		{"limit above max", serverconst.MaxPageSize + 1, 0, nil, &ErrorInvalidLimit},
+		{"negative offset", 10, -1, nil, &ErrorInvalidOffset},
+		{"unknown attribute", 10, 0, filterOf("data", tidcommon.OperatorEq, "x"), &ErrorInvalidFilter},
+		{"non-string value", 10, 0, filterOf("subject", tidcommon.OperatorEq, int64(1)), &ErrorInvalidFilter},
+		{"range on subject", 10, 0, filterOf("subject", tidcommon.OperatorGt, "a"), &ErrorInvalidFilter},
+		{"eq on timestamp", 10, 0, filterOf("timestamp", tidcommon.OperatorEq, "2026-10-01T00:00:00Z"),
+			&ErrorInvalidFilter},
+		{"bad timestamp", 10, 0, filterOf("timestamp", tidcommon.OperatorLt, "yesterday"), &ErrorInvalidFilter},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.allowList()
+
+			_, svcErr := suite.service.GetAuditEventList(context.Background(), tc.limit, tc.offset, tc.filter)
+			assert.Equal(suite.T(), tc.expected, svcErr)
+		})
+	}
+}
+
+func (suite *AuditLogServiceTestSuite) TestGetAuditEventList_StoreErrors() {
+	suite.Run("count error", func() {
+		suite.SetupTest()
+		suite.allowList()
+		suite.mockStore.On("getEventListCount", mock.Anything, mock.Anything).Return(0, errors.New("db error"))
+
+		_, svcErr := suite.service.GetAuditEventList(context.Background(), 10, 0, nil)
+		assert.Equal(suite.T(), &tidcommon.InternalServerError, svcErr)
+	})
+
+	suite.Run("list error", func() {
+		suite.SetupTest()
+		suite.allowList()
+		suite.mockStore.On("getEventListCount", mock.Anything, mock.Anything).Return(1, nil)
+		suite.mockStore.On("getEventList", mock.Anything, 10, 0, mock.Anything).
+			Return(nil, errors.New("db error"))
+
+		_, svcErr := suite.service.GetAuditEventList(context.Background(), 10, 0, nil)
+		assert.Equal(suite.T(), &tidcommon.InternalServerError, svcErr)
+	})
+}
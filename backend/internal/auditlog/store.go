@@ -0,0 +1,214 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// auditEventStoreInterface defines the persistence of audit events.
+type auditEventStoreInterface interface {
+	// insertEvent records an audit event that is kept until expiryTime.
+	insertEvent(ctx context.Context, evt AuditEvent, expiryTime time.Time) error
+	// getEventListCount returns the number of stored audit events matching the filter.
+	getEventListCount(ctx context.Context, filter *tidcommon.FilterGroup) (int, error)
+	// getEventList returns a page of stored audit events matching the filter, newest first.
+	getEventList(ctx context.Context, limit, offset int, filter *tidcommon.FilterGroup) ([]AuditEvent, error)
+	// deleteExpiredEvents removes the audit events that expired before now and returns how many were removed.
+	deleteExpiredEvents(ctx context.Context, now time.Time) (int64, error)
+}
+
+// auditEventStore implements auditEventStoreInterface against the runtime persistent database.
+type auditEventStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAuditEventStore creates a new auditEventStore.
+func newAuditEventStore() auditEventStoreInterface {
+	return &auditEventStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// insertEvent records an audit event.
+func (s *auditEventStore) insertEvent(ctx context.Context, evt AuditEvent, expiryTime time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	data := evt.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event data: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryInsertAuditEvent, evt.ID, s.deploymentID, evt.EventType,
+		evt.Category, evt.Component, evt.Status, evt.Subject, evt.ClientID, evt.CorrelationID, evt.Timestamp,
+		string(dataJSON), expiryTime)
+	if err != nil {
+		return fmt.Errorf("error inserting audit event: %w", err)
+	}
+
+	return nil
+}
+
+// getEventListCount returns the number of stored audit events matching the filter.
+func (s *auditEventStore) getEventListCount(ctx context.Context, filter *tidcommon.FilterGroup) (int, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	query, filterArgs, err := buildAuditEventCountQuery(filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build audit event count query: %w", err)
+	}
+
+	args := append([]interface{}{s.deploymentID}, filterArgs...)
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute count query: %w", err)
+	}
+
+	var total int
+	if len(results) > 0 {
+		if count, ok := results[0]["total"].(int64); ok {
+			total = int(count)
+		} else {
+			return 0, fmt.Errorf("unexpected type for total: %T", results[0]["total"])
+		}
+	}
+
+	return total, nil
+}
+
+// getEventList returns a page of stored audit events matching the filter, newest first.
+func (s *auditEventStore) getEventList(ctx context.Context, limit, offset int,
+	filter *tidcommon.FilterGroup) ([]AuditEvent, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	query, filterArgs, err := buildAuditEventListQuery(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build audit event list query: %w", err)
+	}
+
+	args := append([]interface{}{limit, offset, s.deploymentID}, filterArgs...)
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute audit event list query: %w", err)
+	}
+
+	events := make([]AuditEvent, 0, len(results))
+	for _, row := range results {
+		evt, err := buildAuditEventFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, evt)
+	}
+
+	return events, nil
+}
+
+// deleteExpiredEvents removes the audit events that expired before now.
+func (s *auditEventStore) deleteExpiredEvents(ctx context.Context, now time.Time) (int64, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteExpiredAuditEvents, now, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting expired audit events: %w", err)
+	}
+
+	return rows, nil
+}
+
+// buildAuditEventFromResultRow constructs an AuditEvent from a database result row.
+func buildAuditEventFromResultRow(row map[string]interface{}) (AuditEvent, error) {
+	eventID, ok := row["event_id"].(string)
+	if !ok {
+		return AuditEvent{}, errors.New("failed to parse event_id as string")
+	}
+
+	eventTime, err := utils.ParseDBTimeField(row["event_time"], "event_time")
+	if err != nil {
+		return AuditEvent{}, err
+	}
+
+	data, err := parseAuditEventData(row)
+	if err != nil {
+		return AuditEvent{}, err
+	}
+
+	return AuditEvent{
+		ID:            eventID,
+		EventType:     stringField(row, "event_type"),
+		Category:      stringField(row, "category"),
+		Component:     stringField(row, "component"),
+		Status:        stringField(row, "status"),
+		Subject:       stringField(row, "subject"),
+		ClientID:      stringField(row, "client_id"),
+		CorrelationID: stringField(row, "correlation_id"),
+		Timestamp:     eventTime,
+		Data:          data,
+	}, nil
+}
+
+// parseAuditEventData parses the JSON event data from the database result row.
+func parseAuditEventData(row map[string]interface{}) (map[string]interface{}, error) {
+	var dataStr string
+	switch v := row["data"].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		dataStr = v
+	case []byte:
+		dataStr = string(v)
+	default:
+		return nil, fmt.Errorf("failed to parse data as string or []byte, got type: %T", v)
+	}
+
+	if dataStr == "" {
+		return nil, nil
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(dataStr), &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal audit event data: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	return data, nil
+}
+
+// stringField returns the string value of a nullable column, or an empty string when it is NULL.
+func stringField(row map[string]interface{}, column string) string {
+	if v, ok := row[column].(string); ok {
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"fmt"
+	"strings"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// auditEventFilterableColumns maps API attribute names to AUDIT_EVENT table column names.
+var auditEventFilterableColumns = map[string]string{
+	"subject":       "SUBJECT",
+	"clientId":      "CLIENT_ID",
+	"eventType":     "EVENT_TYPE",
+	"category":      "CATEGORY",
+	"component":     "COMPONENT",
+	"status":        "STATUS",
+	"correlationId": "CORRELATION_ID",
+	"timestamp":     "EVENT_TIME",
+}
+
+// auditEventTimeAttribute is the filter attribute that ranges over the event time.
+const auditEventTimeAttribute = "timestamp"
+
+// queryInsertAuditEvent records an audit event.
+var queryInsertAuditEvent = dbmodel.DBQuery{
+	ID: "AEQ-AE-01",
+	Query: `INSERT INTO "AUDIT_EVENT" (EVENT_ID, DEPLOYMENT_ID, EVENT_TYPE, CATEGORY, COMPONENT, STATUS, ` +
+		`SUBJECT, CLIENT_ID, CORRELATION_ID, EVENT_TIME, DATA, EXPIRY_TIME) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+}
+
+// queryDeleteExpiredAuditEvents removes the audit events past their retention period.
+var queryDeleteExpiredAuditEvents = dbmodel.DBQuery{
+	ID:    "AEQ-AE-02",
+	Query: `DELETE FROM "AUDIT_EVENT" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2`,
+}
+
+// buildAuditEventFilterGroup generates a SQL WHERE fragment for a FilterGroup and returns the bound args.
+// startParamIdx is the positional parameter index for the first filter value.
+// Returns an empty string and no args when g is nil.
+// For multi-clause groups the fragment is wrapped in AND (...); single-clause groups omit the parens.
+func buildAuditEventFilterGroup(g *tidcommon.FilterGroup, startParamIdx int) (
+	cond string, args []interface{}, err error) {
+	if g == nil || len(g.Clauses) == 0 {
+		return "", nil, nil
+	}
+
+	var sb strings.Builder
+	idx := startParamIdx
+
+	for i, clause := range g.Clauses {
+		col, ok := auditEventFilterableColumns[clause.Expr.Attribute]
+		if !ok {
+			return "", nil, fmt.Errorf("attribute %q is not filterable", clause.Expr.Attribute)
+		}
+
+		var clauseCond string
+		switch clause.Expr.Operator {
+		case tidcommon.OperatorEq:
+			clauseCond = fmt.Sprintf("%s = $%d", col, idx)
+		case tidcommon.OperatorGt:
+			clauseCond = fmt.Sprintf("%s > $%d", col, idx)
+		case tidcommon.OperatorLt:
+			clauseCond = fmt.Sprintf("%s < $%d", col, idx)
+		default:
+			return "", nil, fmt.Errorf("unsupported operator %q", clause.Expr.Operator)
+		}
+
+		if i > 0 {
+			sb.WriteString(" ")
+			sb.WriteString(string(clause.Connector))
+			sb.WriteString(" ")
+		}
+		sb.WriteString(clauseCond)
+		args = append(args, clause.Expr.Value)
+		idx++
+	}
+
+	if len(g.Clauses) == 1 {
+		cond = " AND " + sb.String()
+	} else {
+		cond = " AND (" + sb.String() + ")"
+	}
+	return cond, args, nil
+}
+
+// buildAuditEventCountQuery constructs the audit event count query with an optional filter group.
+// Args order: deploymentID=$1 [, filterArgs...]
+func buildAuditEventCountQuery(g *tidcommon.FilterGroup) (dbmodel.DBQuery, []interface{}, error) {
+	query := `SELECT COUNT(*) as total FROM "AUDIT_EVENT" WHERE DEPLOYMENT_ID = $1`
+
+	filterArgs := []interface{}{}
+	if g != nil {
+		cond, args, err := buildAuditEventFilterGroup(g, 2)
+		if err != nil {
+			return dbmodel.DBQuery{}, nil, err
+		}
+		query += cond
+		filterArgs = append(filterArgs, args...)
+	}
+
+	return dbmodel.DBQuery{ID: "AEQ-AE-03", Query: query}, filterArgs, nil
+}
+
+// buildAuditEventListQuery constructs the paginated audit event list query with an optional filter group.
+// Events are returned newest first.
+// Args order: limit=$1, offset=$2, deploymentID=$3 [, filterArgs...]
+func buildAuditEventListQuery(g *tidcommon.FilterGroup) (dbmodel.DBQuery, []interface{}, error) {
+	query := `SELECT EVENT_ID, EVENT_TYPE, CATEGORY, COMPONENT, STATUS, SUBJECT, CLIENT_ID, CORRELATION_ID, ` +
+		`EVENT_TIME, DATA FROM "AUDIT_EVENT" WHERE DEPLOYMENT_ID = $3`
+
+	filterArgs := []interface{}{}
+	if g != nil {
+		cond, args, err := buildAuditEventFilterGroup(g, 4)
+		if err != nil {
+			return dbmodel.DBQuery{}, nil, err
+		}
+		query += cond
+		filterArgs = append(filterArgs, args...)
+	}
+
+	query += " ORDER BY EVENT_TIME DESC, EVENT_ID LIMIT $1 OFFSET $2"
+
+	return dbmodel.DBQuery{ID: "AEQ-AE-04", Query: query}, filterArgs, nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type AuditEventStoreTestSuite struct {
+	suite.Suite
+	mockdbProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *auditEventStore
+}
+
+func TestAuditEventStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditEventStoreTestSuite))
+}
+
+func (suite *AuditEventStoreTestSuite) SetupTest() {
+	suite.mockdbProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &auditEventStore{
+		dbProvider:   suite.mockdbProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *AuditEventStoreTestSuite) TestInsertEvent() {
+	eventTime := time.Now().UTC()
+	expiry := eventTime.Add(24 * time.Hour)
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryInsertAuditEvent,
+		"event-1", testDeploymentID, "USER_CREATED", "observability.audit", "UserService", "success",
+		"admin-1", "", "trace-1", eventTime, `{"actor":"admin-1"}`, expiry).Return(int64(1), nil)
+
+	err := suite.store.insertEvent(context.Background(), AuditEvent{
+		ID:            "event-1",
+		EventType:     "USER_CREATED",
+		Category:      "observability.audit",
+		Component:     "UserService",
+		Status:        "success",
+		Subject:       "admin-1",
+		CorrelationID: "trace-1",
+		Timestamp:     eventTime,
+		Data:          map[string]interface{}{"actor": "admin-1"},
+	}, expiry)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *AuditEventStoreTestSuite) TestInsertEvent_NilDataStoredAsEmptyObject() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryInsertAuditEvent,
+		"event-1", testDeploymentID, "", "", "", "", "", "", "", time.Time{}, "{}", time.Time{}).
+		Return(int64(1), nil)
+
+	err := suite.store.insertEvent(context.Background(), AuditEvent{ID: "event-1"}, time.Time{})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *AuditEventStoreTestSuite) TestInsertEvent_DBClientError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(nil, errors.New("db unavailable"))
+
+	err := suite.store.insertEvent(context.Background(), AuditEvent{ID: "event-1"}, time.Time{})
+	assert.ErrorContains(suite.T(), err, "failed to get runtime persistent database client")
+}
+
+func (suite *AuditEventStoreTestSuite) TestInsertEvent_ExecuteError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertAuditEvent, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("insert error"))
+
+	err := suite.store.insertEvent(context.Background(), AuditEvent{ID: "event-1"}, time.Time{})
+	assert.ErrorContains(suite.T(), err, "error inserting audit event")
+}
+
+func (suite *AuditEventStoreTestSuite) TestGetEventListCount() {
+	filter := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "subject", Operator: tidcommon.OperatorEq, Value: "user-1"}},
+	}}
+	query, _, err := buildAuditEventCountQuery(filter)
+	suite.Require().NoError(err)
+
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), query, testDeploymentID, "user-1").
+		Return([]map[string]interface{}{{"total": int64(3)}}, nil)
+
+	count, err := suite.store.getEventListCount(context.Background(), filter)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, count)
+}
+
+func (suite *AuditEventStoreTestSuite) TestGetEventListCount_UnexpectedType() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{{"total": "3"}}, nil)
+
+	_, err := suite.store.getEventListCount(context.Background(), nil)
+	assert.ErrorContains(suite.T(), err, "unexpected type for total")
+}
+
+func (suite *AuditEventStoreTestSuite) TestGetEventListCount_QueryError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), mock.Anything, testDeploymentID).
+		Return(nil, errors.New("query error"))
+
+	_, err := suite.store.getEventListCount(context.Background(), nil)
+	assert.ErrorContains(suite.T(), err, "failed to execute count query")
+}
+
+func (suite *AuditEventStoreTestSuite) TestGetEventList() {
+	eventTime := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	query, _, err := buildAuditEventListQuery(nil)
+	suite.Require().NoError(err)
+
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), query, 10, 0, testDeploymentID).
+		Return([]map[string]interface{}{
+			{
+				"event_id":       "event-1",
+				"event_type":     "TOKEN_ISSUED",
+				"category":       "observability.authentication",
+				"component":      "TokenHandler",
+				"status":         "success",
+				"subject":        "user-1",
+				"client_id":      "client-1",
+				"correlation_id": "trace-1",
+				"event_time":     eventTime,
+				"data":           []byte(`{"client_id":"client-1"}`),
+			},
+			{
+				"event_id":   "event-2",
+				"event_type": "USER_DELETED",
+				"subject":    nil,
+				"event_time": eventTime,
+				"data":       "{}",
+			},
+		}, nil)
+
+	events, err := suite.store.getEventList(context.Background(), 10, 0, nil)
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(events, 2)
+	assert.Equal(suite.T(), AuditEvent{
+		ID:            "event-1",
+		EventType:     "TOKEN_ISSUED",
+		Category:      "observability.authentication",
+		Component:     "TokenHandler",
+		Status:        "success",
+		Subject:       "user-1",
+		ClientID:      "client-1",
+		CorrelationID: "trace-1",
+		Timestamp:     eventTime,
+		Data:          map[string]interface{}{"client_id": "client-1"},
+	}, events[0])
+	assert.Equal(suite.T(), "event-2", events[1].ID)
+	assert.Empty(suite.T(), events[1].Subject)
+	assert.Nil(suite.T(), events[1].Data)
+}
+
+func (suite *AuditEventStoreTestSuite) TestGetEventList_InvalidRow() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), mock.Anything, 10, 0, testDeploymentID).
+		Return([]map[string]interface{}{{"event_id": "event-1", "event_time": 42}}, nil)
+
+	_, err := suite.store.getEventList(context.Background(), 10, 0, nil)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *AuditEventStoreTestSuite) TestGetEventList_InvalidFilterAttribute() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	filter := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "data", Operator: tidcommon.OperatorEq, Value: "x"}},
+	}}
+
+	_, err := suite.store.getEventList(context.Background(), 10, 0, filter)
+	assert.ErrorContains(suite.T(), err, "failed to build audit event list query")
+}
+
+func (suite *AuditEventStoreTestSuite) TestDeleteExpiredEvents() {
+	now := time.Now().UTC()
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteExpiredAuditEvents,
+		now, testDeploymentID).Return(int64(4), nil)
+
+	deleted, err := suite.store.deleteExpiredEvents(context.Background(), now)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(4), deleted)
+}
+
+func (suite *AuditEventStoreTestSuite) TestDeleteExpiredEvents_ExecuteError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteExpiredAuditEvents,
+		mock.Anything, testDeploymentID).Return(int64(0), errors.New("delete error"))
+
+	_, err := suite.store.deleteExpiredEvents(context.Background(), time.Now())
+	assert.ErrorContains(suite.T(), err, "error deleting expired audit events")
+}
+
+func (suite *AuditEventStoreTestSuite) TestBuildAuditEventListQuery_WithFilter() {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	filter := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "clientId", Operator: tidcommon.OperatorEq, Value: "c1"}},
+		{
+			Connector: tidcommon.LogicalAnd,
+			Expr:      tidcommon.FilterExpression{Attribute: "timestamp", Operator: tidcommon.OperatorGt, Value: from},
+		},
+	}}
+
+	query, args, err := buildAuditEventListQuery(filter)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), query.Query, "AND (CLIENT_ID = $4 AND EVENT_TIME > $5)")
+	assert.Contains(suite.T(), query.Query, "ORDER BY EVENT_TIME DESC, EVENT_ID LIMIT $1 OFFSET $2")
+	assert.Equal(suite.T(), []interface{}{"c1", from}, args)
+}
+
+func (suite *AuditEventStoreTestSuite) TestBuildAuditEventCountQuery_UnsupportedOperator() {
+	filter := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "subject", Operator: "co", Value: "u"}},
+	}}
+
+	_, _, err := buildAuditEventCountQuery(filter)
+	assert.ErrorContains(suite.T(), err, "unsupported operator")
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const (
+	databaseSubscriberComponentName = "DatabaseSubscriber"
+	defaultRetentionDays            = 90
+	defaultCleanupInterval          = time.Hour
+)
+
+// databaseSubscriber persists observability events to the runtime persistent database so that they can be
+// searched through the audit log API. Stored events are purged once their retention period has passed.
+type databaseSubscriber struct {
+	id              string
+	categories      []event.EventCategory
+	store           auditEventStoreInterface
+	retention       time.Duration
+	cleanupInterval time.Duration
+	stopCleanup     chan struct{}
+	closeOnce       sync.Once
+	logger          *log.Logger
+}
+
+var _ subscriber.SubscriberInterface = (*databaseSubscriber)(nil)
+
+// init registers the database subscriber factory with the observability subscriber registry.
+func init() {
+	subscriber.RegisterSubscriberFactory("database", func() subscriber.SubscriberInterface {
+		return newDatabaseSubscriber()
+	})
+}
+
+// newDatabaseSubscriber creates a new database subscriber instance.
+func newDatabaseSubscriber() *databaseSubscriber {
+	return &databaseSubscriber{}
+}
+
+// IsEnabled checks if the database subscriber should be activated based on configuration.
+func (ds *databaseSubscriber) IsEnabled() bool {
+	return config.GetServerRuntime().Config.Observability.Output.Database.Enabled
+}
+
+// Initialize sets up the database subscriber and starts the retention cleanup routine.
+func (ds *databaseSubscriber) Initialize() error {
+	// Subscriber initialization runs during application startup, outside any request.
+	ctx := context.Background()
+
+	dbConfig := config.GetServerRuntime().Config.Observability.Output.Database
+
+	if ds.store == nil {
+		ds.store = newAuditEventStore()
+	}
+
+	ds.categories = make([]event.EventCategory, 0, len(dbConfig.Categories))
+	for _, cat := range dbConfig.Categories {
+		ds.categories = append(ds.categories, event.EventCategory(cat))
+	}
+	if len(ds.categories) == 0 {
+		ds.categories = []event.EventCategory{event.CategoryAll}
+	}
+
+	retentionDays := dbConfig.RetentionDays
+	if retentionDays <= 0 {
+		retentionDays = defaultRetentionDays
+	}
+	ds.retention = time.Duration(retentionDays) * 24 * time.Hour
+
+	ds.cleanupInterval = time.Duration(dbConfig.CleanupInterval) * time.Second
+	if ds.cleanupInterval <= 0 {
+		ds.cleanupInterval = defaultCleanupInterval
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate database subscriber ID: %w", err)
+	}
+
+	ds.id = id
+	ds.logger = log.GetLogger().With(log.String(log.LoggerKeyComponentName, databaseSubscriberComponentName))
+	ds.stopCleanup = make(chan struct{})
+	go ds.runCleanup()
+
+	ds.logger.Debug(ctx, "Database subscriber initialized",
+		log.Int("retentionDays", retentionDays),
+		log.Int("categories", len(ds.categories)))
+
+	return nil
+}
+
+// GetID returns the unique identifier for this subscriber.
+func (ds *databaseSubscriber) GetID() string {
+	return ds.id
+}
+
+// GetCategories returns the categories this subscriber is interested in.
+func (ds *databaseSubscriber) GetCategories() []event.EventCategory {
+	if len(ds.categories) > 0 {
+		return ds.categories
+	}
+	// Default: all categories
+	return []event.EventCategory{event.CategoryAll}
+}
+
+// OnEvent persists the published event.
+func (ds *databaseSubscriber) OnEvent(evt *providers.Event) error {
+	if evt == nil {
+		return fmt.Errorf("event is nil")
+	}
+
+	// Subscribers run in detached goroutines after the request context may be
+	// cancelled, so derive a context from the event's trace ID.
+	ctx := sysContext.WithTraceID(context.Background(), evt.TraceID)
+
+	auditEvent := buildAuditEvent(evt)
+	if err := ds.store.insertEvent(ctx, auditEvent, auditEvent.Timestamp.Add(ds.retention)); err != nil {
+		ds.logger.Error(ctx, "Failed to persist event",
+			log.String("eventType", evt.Type),
+			log.String("eventID", evt.EventID),
+			log.Error(err))
+		return fmt.Errorf("failed to write to database: %w", err)
+	}
+
+	return nil
+}
+
+// Close stops the retention cleanup routine.
+func (ds *databaseSubscriber) Close() error {
+	ds.closeOnce.Do(func() {
+		if ds.stopCleanup != nil {
+			close(ds.stopCleanup)
+		}
+	})
+	return nil
+}
+
+// runCleanup purges expired events on start-up and then once every cleanup interval until Close is called.
+func (ds *databaseSubscriber) runCleanup() {
+	ds.purgeExpiredEvents()
+
+	ticker := time.NewTicker(ds.cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ds.purgeExpiredEvents()
+		case <-ds.stopCleanup:
+			return
+		}
+	}
+}
+
+// purgeExpiredEvents removes the stored events that are past their retention period.
+func (ds *databaseSubscriber) purgeExpiredEvents() {
+	// Retention cleanup runs in the background, outside any request.
+	ctx := context.Background()
+
+	deleted, err := ds.store.deleteExpiredEvents(ctx, time.Now().UTC())
+	if err != nil {
+		ds.logger.Error(ctx, "Failed to purge expired audit events", log.Error(err))
+		return
+	}
+	if deleted > 0 {
+		ds.logger.Debug(ctx, "Purged expired audit events", log.Any("count", deleted))
+	}
+}
+
+// buildAuditEvent maps a published event to its stored form. The subject is the user the event is about,
+// falling back to the administrator who performed the change for administrative events.
+func buildAuditEvent(evt *providers.Event) AuditEvent {
+	category, _ := event.GetCategory(providers.EventType(evt.Type))
+
+	subject := dataString(evt.Data, event.DataKey.UserID)
+	if subject == "" {
+		subject = dataString(evt.Data, event.DataKey.Actor)
+	}
+
+	return AuditEvent{
+		ID:            evt.EventID,
+		EventType:     evt.Type,
+		Category:      string(category),
+		Component:     evt.Component,
+		Status:        evt.Status,
+		Subject:       subject,
+		ClientID:      dataString(evt.Data, event.DataKey.ClientID),
+		CorrelationID: evt.TraceID,
+		Timestamp:     evt.Timestamp.UTC(),
+		Data:          evt.Data,
+	}
+}
+
+// dataString returns the string value stored under key in the event data.
+func dataString(data map[string]interface{}, key string) string {
+	if v, ok := data[key].(string); ok {
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type DatabaseSubscriberTestSuite struct {
+	suite.Suite
+	mockStore *auditEventStoreInterfaceMock
+	sub       *databaseSubscriber
+}
+
+func TestDatabaseSubscriberTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseSubscriberTestSuite))
+}
+
+func (suite *DatabaseSubscriberTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+
+	suite.mockStore = newAuditEventStoreInterfaceMock(suite.T())
+	suite.mockStore.On("deleteExpiredEvents", mock.Anything, mock.Anything).Return(int64(0), nil).Maybe()
+	suite.sub = newDatabaseSubscriber()
+	suite.sub.store = suite.mockStore
+}
+
+func (suite *DatabaseSubscriberTestSuite) TearDownTest() {
+	_ = suite.sub.Close()
+	config.ResetServerRuntime()
+}
+
+func (suite *DatabaseSubscriberTestSuite) TestFactoryRegistered() {
+	assert.NotNil(suite.T(), subscriber.GetFactory("database"))
+}
+
+func (suite *DatabaseSubscriberTestSuite) TestIsEnabled() {
+	assert.False(suite.T(), suite.sub.IsEnabled())
+
+	config.GetServerRuntime().Config.Observability.Output.Database.Enabled = true
+	assert.True(suite.T(), suite.sub.IsEnabled())
+}
+
+func (suite *DatabaseSubscriberTestSuite) TestInitialize_Defaults() {
+	suite.Require().NoError(suite.sub.Initialize())
+
+	assert.NotEmpty(suite.T(), suite.sub.GetID())
+	assert.Equal(suite.T(), []event.EventCategory{event.CategoryAll}, suite.sub.GetCategories())
+	assert.Equal(suite.T(), 90*24*time.Hour, suite.sub.retention)
+	assert.Equal(suite.T(), time.Hour, suite.sub.cleanupInterval)
+}
+
+func (suite *DatabaseSubscriberTestSuite) TestInitialize_FromConfig() {
+	dbConfig := &config.GetServerRuntime().Config.Observability.Output.Database
+	dbConfig.RetentionDays = 7
+	dbConfig.CleanupInterval = 60
+	dbConfig.Categories = []string{string(event.CategoryAudit)}
+
+	suite.Require().NoError(suite.sub.Initialize())
+
+	assert.Equal(suite.T(), []event.EventCategory{event.CategoryAudit}, suite.sub.GetCategories())
+	assert.Equal(suite.T(), 7*24*time.Hour, suite.sub.retention)
+	assert.Equal(suite.T(), time.Minute, suite.sub.cleanupInterval)
+}
+
+func (suite *DatabaseSubscriberTestSuite) TestInitialize_PurgesExpiredEvents() {
+	purged := make(chan struct{})
+	suite.mockStore.ExpectedCalls = nil
+	suite.mockStore.On("deleteExpiredEvents", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { close(purged) }).Return(int64(2), nil).Once()
+
+	suite.Require().NoError(suite.sub.Initialize())
+
+	select {
+	case <-purged:
+	case <-time.After(time.Second):
+		suite.Fail("expired audit events were not purged on start-up")
+	}
+}
+
+func (suite *DatabaseSubscriberTestSuite) TestOnEvent() {
+	suite.Require().NoError(suite.sub.Initialize())
+	eventTime := time.Date(2026, 10, 1, 12, 0, 0, 0, time.FixedZone("IST", 19800))
+	evt := &providers.Event{
+		TraceID:   "trace-1",
+		EventID:   "event-1",
+		Type:      string(event.EventTypeTokenIssued),
+		Timestamp: eventTime,
+		Component: "TokenHandler",
+		Status:    providers.StatusSuccess,
+		Data: map[string]interface{}{
+			event.DataKey.UserID:   "user-1",
+			event.DataKey.ClientID: "client-1",
+		},
+	}
+	suite.mockStore.On("insertEvent", mock.Anything, AuditEvent{
+		ID:            "event-1",
+		EventType:     "TOKEN_ISSUED",
+		Category:      string(event.CategoryAuthentication),
+		Component:     "TokenHandler",
+		Status:        providers.StatusSuccess,
+		Subject:       "user-1",
+		ClientID:      "client-1",
+		CorrelationID: "trace-1",
+		Timestamp:     eventTime.UTC(),
+		Data:          evt.Data,
+	}, eventTime.UTC().Add(90*24*time.Hour)).Return(nil)
+
+	assert.NoError(suite.T(), suite.sub.OnEvent(evt))
+}
+
+func (suite *DatabaseSubscriberTestSuite) TestOnEvent_SubjectFallsBackToActor() {
+	suite.Require().NoError(suite.sub.Initialize())
+	suite.mockStore.On("insertEvent", mock.Anything, mock.MatchedBy(func(evt AuditEvent) bool {
+		return evt.Subject == "admin-1" && evt.Category == string(event.CategoryAudit)
+	}), mock.Anything).Return(nil)
+
+	err := suite.sub.OnEvent(&providers.Event{
+		EventID: "event-1",
+		Type:    string(event.EventTypeAdminResourceCreated),
+		Data:    map[string]interface{}{event.DataKey.Actor: "admin-1"},
+	})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *DatabaseSubscriberTestSuite) TestOnEvent_Errors() {
+	suite.Require().NoError(suite.sub.Initialize())
+	assert.Error(suite.T(), suite.sub.OnEvent(nil))
+
+	suite.mockStore.On("insertEvent", mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("db error"))
+	err := suite.sub.OnEvent(&providers.Event{EventID: "event-1"})
+	assert.ErrorContains(suite.T(), err, "failed to write to database")
+}
+
+func (suite *DatabaseSubscriberTestSuite) TestClose_Idempotent() {
+	suite.Require().NoError(suite.sub.Initialize())
+
+	assert.NoError(suite.T(), suite.sub.Close())
+	assert.NoError(suite.T(), suite.sub.Close())
+}
@@ -15,11 +15,13 @@ const (
 	AuthenticatorCredentials = "CredentialsAuthenticator"
 	AuthenticatorSMSOTP      = "SMSOTPAuthenticator"
 	AuthenticatorOTP         = "OTPAuthenticator"
+	AuthenticatorTOTP        = "TOTPAuthenticator"
 	AuthenticatorMagicLink   = "MagicLinkAuthenticator"
 	AuthenticatorGoogle      = "GoogleOIDCAuthenticator"
 	AuthenticatorGithub      = "GithubOAuthAuthenticator"
 	AuthenticatorOAuth       = "OAuthAuthenticator"
 	AuthenticatorOIDC        = "OIDCAuthenticator"
+	AuthenticatorSAML        = "SAMLAuthenticator"
 	AuthenticatorPasskey     = "Passkey"
 	AuthenticatorOpenID4VP   = "OpenID4VPAuthenticator"
 )
@@ -66,6 +66,8 @@ type AuthorizationData struct {
 	Code string
 	// Nonce is the nonce parameter received from the identity provider (if applicable)
 	Nonce string
+	// RequestID is the ID of the SAML AuthnRequest the response must answer (if applicable)
+	RequestID string
 }
 
 // FederatedAuthCredential carries the credential data for federated authentication.
@@ -4,10 +4,11 @@
 package consent
 
 // Wire-level discriminator values for ConsentPurposePrompt.Type. These are the strings the UI
-// reads to choose between attribute and permission rendering.
+// reads to choose between attribute, permission and authorization detail rendering.
 const (
-	consentPromptTypeAttributes  = "attributes"
-	consentPromptTypePermissions = "permissions"
+	consentPromptTypeAttributes           = "attributes"
+	consentPromptTypePermissions          = "permissions"
+	consentPromptTypeAuthorizationDetails = "authorization_details"
 )
 
 // consentSessionData holds the consent session state that is signed into a JWT token.
@@ -53,7 +53,7 @@ func (s *consentEnforcerService) SetConsentService(consentService consent.Consen
 
 // ResolveConsent implements providers.ConsentProvider.ResolveConsent.
 func (s *consentEnforcerService) ResolveConsent(ctx context.Context, ouID, appID, appName, userID string,
-	essentialAttributes, optionalAttributes, authorizedPermissions []string,
+	essentialAttributes, optionalAttributes, authorizedPermissions, authorizationDetails []string,
 	availableAttributes *providers.AttributesResponse, forceReprompt bool,
 	runtimeMetadata map[string][]string) (
 	*providers.ConsentPromptData, *tidcommon.ServiceError) {
@@ -83,6 +83,12 @@ func (s *consentEnforcerService) ResolveConsent(ctx context.Context, ouID, appID
 		purposes = append(purposes, *permissionsPurpose)
 	}
 
+	// Authorization details purpose is likewise built from the details requested by the client
+	authorizationDetailsPurpose := s.buildAuthorizationDetailsPurpose(appID, appName, authorizationDetails)
+	if authorizationDetailsPurpose != nil {
+		purposes = append(purposes, *authorizationDetailsPurpose)
+	}
+
 	if len(purposes) == 0 {
 		logger.Debug(ctx, "No consent purposes configured for application; skipping consent")
 		return nil, nil
@@ -115,7 +121,7 @@ func (s *consentEnforcerService) ResolveConsent(ctx context.Context, ouID, appID
 	userAttributeSet := buildUserAttributeSet(availableAttributes)
 
 	promptPurposes := buildPurposePrompts(purposes, essentialAttributes, optionalAttributes,
-		consentedElements, userAttributeSet, authorizedPermissions)
+		consentedElements, userAttributeSet, authorizedPermissions, authorizationDetails)
 	if len(promptPurposes) == 0 {
 		logger.Debug(ctx, "All required consents are active; no prompt needed")
 		return nil, nil
@@ -541,7 +547,7 @@ func purposeElementKey(purposeName, elementName string) string {
 // prompts that still require user consent. Purposes whose Namespace was not inferred are skipped.
 func buildPurposePrompts(purposes []consent.ConsentPurpose, essentialAttributes, optionalAttributes []string,
 	consentedElements map[string]bool, userAttributeSet map[string]bool,
-	authorizedPermissions []string) []providers.ConsentPurposePrompt {
+	authorizedPermissions, authorizationDetails []string) []providers.ConsentPurposePrompt {
 	promptPurposes := make([]providers.ConsentPurposePrompt, 0, len(purposes))
 	for _, purpose := range purposes {
 		switch deriveConsentPromptTypeFromPurpose(purpose) {
@@ -555,6 +561,11 @@ func buildPurposePrompts(purposes []consent.ConsentPurpose, essentialAttributes,
 				authorizedPermissions); ok {
 				promptPurposes = append(promptPurposes, prompt)
 			}
+		case consentPromptTypeAuthorizationDetails:
+			if prompt, ok := buildAuthorizationDetailPurposePrompt(purpose, consentedElements,
+				authorizationDetails); ok {
+				promptPurposes = append(promptPurposes, prompt)
+			}
 		}
 	}
 	return promptPurposes
@@ -567,6 +578,9 @@ func deriveConsentPromptTypeFromPurpose(purpose consent.ConsentPurpose) string {
 	if strings.HasPrefix(purpose.Name, consent.AttributePurposeNamePrefix) {
 		return consentPromptTypeAttributes
 	}
+	if strings.HasPrefix(purpose.Name, consent.AuthorizationDetailPurposeNamePrefix) {
+		return consentPromptTypeAuthorizationDetails
+	}
 	return ""
 }
 
@@ -655,6 +669,35 @@ func buildPermissionPurposePrompt(purpose consent.ConsentPurpose,
 	}, true
 }
 
+// buildAuthorizationDetailPurposePrompt builds a ConsentPurposePrompt for an authorization detail
+// purpose. Every requested authorization detail that is not already consented is prompted as an
+// essential element, since a detail the user declines cannot be granted to the client.
+func buildAuthorizationDetailPurposePrompt(purpose consent.ConsentPurpose,
+	consentedElements map[string]bool, authorizationDetails []string) (providers.ConsentPurposePrompt, bool) {
+	essential := make([]providers.PromptElement, 0, len(purpose.Elements))
+	for _, elem := range purpose.Elements {
+		if !slices.Contains(authorizationDetails, elem.Name) {
+			continue
+		}
+		if consentedElements[purposeElementKey(purpose.Name, elem.Name)] {
+			continue
+		}
+		essential = append(essential, providers.PromptElement{Name: elem.Name})
+	}
+	if len(essential) == 0 {
+		return providers.ConsentPurposePrompt{}, false
+	}
+
+	return providers.ConsentPurposePrompt{
+		PurposeName: purpose.Name,
+		PurposeID:   purpose.ID,
+		Description: purpose.Description,
+		Type:        consentPromptTypeAuthorizationDetails,
+		Essential:   essential,
+		Optional:    []providers.PromptElement{},
+	}, true
+}
+
 // computePermissionParents returns each permission's rollup parent within the supplied set, or ""
 // when no parent is present. P's parent is the longest other Q in the set such that P starts with
 // Q followed by a permission-delimiter character.
@@ -818,6 +861,8 @@ func namespaceFromPurposeName(name string) providers.Namespace {
 		return providers.NamespacePermission
 	case strings.HasPrefix(name, consent.AttributePurposeNamePrefix):
 		return providers.NamespaceAttribute
+	case strings.HasPrefix(name, consent.AuthorizationDetailPurposeNamePrefix):
+		return providers.NamespaceAuthorizationDetail
 	default:
 		return ""
 	}
@@ -854,6 +899,30 @@ func permissionsToPurposeElements(permissions []string) []consent.PurposeElement
 	return out
 }
 
+func (s *consentEnforcerService) buildAuthorizationDetailsPurpose(
+	appID, appName string, authorizationDetails []string,
+) *consent.ConsentPurpose {
+	if len(authorizationDetails) == 0 {
+		return nil
+	}
+
+	elements := make([]consent.PurposeElement, 0, len(authorizationDetails))
+	for _, detail := range authorizationDetails {
+		elements = append(elements, consent.PurposeElement{
+			Name:        detail,
+			Namespace:   consent.NamespaceAuthorizationDetail,
+			IsMandatory: true,
+		})
+	}
+
+	return &consent.ConsentPurpose{
+		Name:        consent.AuthorizationDetailPurposeName(appID),
+		Description: "Authorization details consent purpose for application " + appName,
+		GroupID:     appID,
+		Elements:    elements,
+	}
+}
+
 // elementNames extracts the Name field from each PromptElement.
 func elementNames(elements []providers.PromptElement) []string {
 	if len(elements) == 0 {
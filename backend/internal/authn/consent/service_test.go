@@ -94,7 +94,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_ListPurposesClientE
 	s.mockConsentSvc.On("ListPurposes", mock.Anything, mock.Anything).Return(nil, clientErr)
 
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, nil, nil, nil, false, nil)
+		[]string{"email"}, nil, nil, nil, nil, false, nil)
 
 	s.Nil(result)
 	s.NotNil(svcErr)
@@ -110,7 +110,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_ListPurposesServerE
 	s.mockConsentSvc.On("ListPurposes", mock.Anything, mock.Anything).Return(nil, serverErr)
 
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, nil, nil, nil, false, nil)
+		[]string{"email"}, nil, nil, nil, nil, false, nil)
 
 	s.Nil(result)
 	s.NotNil(svcErr)
@@ -124,7 +124,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_PassesGroupIDFilter
 
 	// No purposes and no authorized permissions -> consent is skipped.
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, nil, nil, nil, false, nil)
+		[]string{"email"}, nil, nil, nil, nil, false, nil)
 
 	s.Nil(result)
 	s.Nil(svcErr)
@@ -135,7 +135,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_NoPurposesConfigure
 		Return([]consent.ConsentPurpose{}, nil)
 
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, nil, nil, nil, false, nil)
+		[]string{"email"}, nil, nil, nil, nil, false, nil)
 
 	s.Nil(result)
 	s.Nil(svcErr)
@@ -152,7 +152,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_SearchConsentsClien
 	s.mockConsentSvc.On("SearchConsents", mock.Anything, mock.Anything).Return(nil, clientErr)
 
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, nil, nil, nil, false, nil)
+		[]string{"email"}, nil, nil, nil, nil, false, nil)
 
 	s.Nil(result)
 	s.NotNil(svcErr)
@@ -170,7 +170,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_SearchConsentsServe
 	s.mockConsentSvc.On("SearchConsents", mock.Anything, mock.Anything).Return(nil, serverErr)
 
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, nil, nil, nil, false, nil)
+		[]string{"email"}, nil, nil, nil, nil, false, nil)
 
 	s.Nil(result)
 	s.NotNil(svcErr)
@@ -199,7 +199,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_AllConsentsActive()
 	s.mockConsentSvc.On("SearchConsents", mock.Anything, mock.Anything).Return(existingConsents, nil)
 
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, nil, nil, nil, false, nil)
+		[]string{"email"}, nil, nil, nil, nil, false, nil)
 
 	s.Nil(result)
 	s.Nil(svcErr)
@@ -214,7 +214,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_ForceRepromptIgnore
 	// forceReprompt is honored: existing active consent is ignored, the element is prompted again,
 	// and SearchConsents is never called.
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, nil, nil, nil, true, nil)
+		[]string{"email"}, nil, nil, nil, nil, true, nil)
 
 	s.Nil(svcErr)
 	s.NotNil(result)
@@ -233,7 +233,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_PromptNeeded() {
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("test-session-token", int64(0), nil)
 
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, []string{"phone"}, nil, nil, false, nil)
+		[]string{"email"}, []string{"phone"}, nil, nil, nil, false, nil)
 
 	s.Nil(svcErr)
 	s.NotNil(result)
@@ -253,7 +253,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_RequiredAttributesF
 
 	// Only request "email" — "phone" and "address" should be filtered out
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, nil, nil, nil, false, nil)
+		[]string{"email"}, nil, nil, nil, nil, false, nil)
 
 	s.Nil(svcErr)
 	s.NotNil(result)
@@ -278,7 +278,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_UserProfileFilter()
 	// Both "email" and "phone" are requested as optional; the user-profile filter must
 	// drop "phone" because it is not present in availableAttributes.
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		nil, []string{"email", "phone"}, nil, availableAttributes, false, nil)
+		nil, []string{"email", "phone"}, nil, nil, availableAttributes, false, nil)
 
 	s.Nil(svcErr)
 	s.NotNil(result)
@@ -310,7 +310,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_PartialConsentsExis
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("test-session-token", int64(0), nil)
 
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, []string{"phone"}, nil, nil, false, nil)
+		[]string{"email"}, []string{"phone"}, nil, nil, nil, false, nil)
 
 	s.Nil(svcErr)
 	s.NotNil(result)
@@ -329,7 +329,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_PermissionsPurposeP
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("test-session-token", int64(0), nil)
 
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		nil, nil, []string{"booking:read"}, nil, false, nil)
+		nil, nil, []string{"booking:read"}, nil, nil, false, nil)
 
 	s.Nil(svcErr)
 	s.NotNil(result)
@@ -339,6 +339,60 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_PermissionsPurposeP
 	s.Equal([]providers.PromptElement{{Name: "booking:read"}}, result.Purposes[0].Optional)
 }
 
+func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_AuthorizationDetailsPurposePrompted() {
+	// Requested authorization details produce a dynamically-built purpose whose elements are all
+	// essential, so declining any of them fails the flow.
+	detail := `{"actions":["read"],"type":"account_information"}`
+	s.mockConsentSvc.On("ListPurposes", mock.Anything, mock.Anything).
+		Return([]consent.ConsentPurpose{}, nil)
+	s.mockConsentSvc.On("SearchConsents", mock.Anything, mock.Anything).Return([]*consent.Consent{}, nil)
+	s.mockJWTSvc.On("GenerateJWT", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("test-session-token", int64(0), nil)
+
+	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
+		nil, nil, nil, []string{detail}, nil, false, nil)
+
+	s.Nil(svcErr)
+	s.NotNil(result)
+	s.Len(result.Purposes, 1)
+	s.Equal(consent.AuthorizationDetailPurposeName("app1"), result.Purposes[0].PurposeName)
+	s.Equal(consentPromptTypeAuthorizationDetails, result.Purposes[0].Type)
+	s.Equal([]providers.PromptElement{{Name: detail}}, result.Purposes[0].Essential)
+	s.Empty(result.Purposes[0].Optional)
+}
+
+func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_AuthorizationDetailsAlreadyConsented() {
+	detail := `{"actions":["read"],"type":"account_information"}`
+	purposeName := consent.AuthorizationDetailPurposeName("app1")
+	existingConsents := []*consent.Consent{
+		{
+			ID: "consent-1",
+			Purposes: []consent.ConsentPurposeItem{
+				{
+					Name: purposeName,
+					Elements: []consent.ConsentElementApproval{
+						{Name: detail, Namespace: consent.NamespaceAuthorizationDetail, IsUserApproved: true},
+					},
+				},
+			},
+		},
+	}
+	s.mockConsentSvc.On("ListPurposes", mock.Anything, mock.Anything).
+		Return([]consent.ConsentPurpose{}, nil)
+	s.mockConsentSvc.On("SearchConsents", mock.Anything, mock.Anything).Return(existingConsents, nil)
+
+	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
+		nil, nil, nil, []string{detail}, nil, false, nil)
+
+	s.Nil(svcErr)
+	s.Nil(result)
+}
+
+func (s *ConsentEnforcerServiceTestSuite) TestNamespaceFromPurposeName_AuthorizationDetails() {
+	s.Equal(providers.NamespaceAuthorizationDetail,
+		namespaceFromPurposeName(consent.AuthorizationDetailPurposeName("app1")))
+}
+
 func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_CreateConsentSessionTokenFails() {
 	s.mockConsentSvc.On("ListPurposes", mock.Anything, mock.Anything).
 		Return([]consent.ConsentPurpose{attributesPurpose("email")}, nil)
@@ -352,7 +406,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_CreateConsentSessio
 		})
 
 	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
-		[]string{"email"}, nil, nil, nil, false, nil)
+		[]string{"email"}, nil, nil, nil, nil, false, nil)
 
 	s.Nil(result)
 	s.NotNil(svcErr)
@@ -1160,7 +1214,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_AllNeedConsent
 		},
 	}
 
-	result := buildPurposePrompts(purposes, nil, []string{"email", "phone"}, map[string]bool{}, nil, nil)
+	result := buildPurposePrompts(purposes, nil, []string{"email", "phone"}, map[string]bool{}, nil, nil, nil)
 
 	s.Len(result, 1)
 	s.Equal("attributes:app1", result[0].PurposeName)
@@ -1182,7 +1236,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_AllAlreadyCons
 	consentedElements := map[string]bool{"attributes:app1:email": true}
 
 	// "email" is requested but already consented; the prompt builder must drop it.
-	result := buildPurposePrompts(purposes, []string{"email"}, nil, consentedElements, nil, nil)
+	result := buildPurposePrompts(purposes, []string{"email"}, nil, consentedElements, nil, nil, nil)
 
 	s.Empty(result)
 }
@@ -1198,7 +1252,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_RequiredAttrib
 		},
 	}
 
-	result := buildPurposePrompts(purposes, []string{"email"}, nil, map[string]bool{}, nil, nil)
+	result := buildPurposePrompts(purposes, []string{"email"}, nil, map[string]bool{}, nil, nil, nil)
 
 	s.Len(result, 1)
 	s.Equal([]providers.PromptElement{{Name: "email"}}, result[0].Essential)
@@ -1220,7 +1274,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_UserProfileFil
 	// Both elements are requested; the user-profile filter must drop "phone" since it is
 	// not in availableAttributes.
 	result := buildPurposePrompts(purposes, nil, []string{"email", "phone"}, map[string]bool{},
-		userAttributeSet, nil)
+		userAttributeSet, nil, nil)
 
 	s.Len(result, 1)
 	s.Empty(result[0].Essential)
@@ -1238,7 +1292,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_NoMatchingElem
 	}
 
 	// email is filtered out by required attributes
-	result := buildPurposePrompts(purposes, []string{"phone"}, nil, map[string]bool{}, nil, nil)
+	result := buildPurposePrompts(purposes, []string{"phone"}, nil, map[string]bool{}, nil, nil, nil)
 
 	s.Empty(result)
 }
@@ -1253,7 +1307,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_UnknownPrefixS
 		},
 	}
 
-	result := buildPurposePrompts(purposes, []string{"email"}, nil, map[string]bool{}, nil, nil)
+	result := buildPurposePrompts(purposes, []string{"email"}, nil, map[string]bool{}, nil, nil, nil)
 
 	s.Empty(result)
 }
@@ -76,6 +76,48 @@ var (
 			DefaultValue: "The provided credentials contain a credential type that is reserved for internal use",
 		},
 	}
+	// ErrorAccountLocked is the error when the account is temporarily locked after repeated failed attempts.
+	ErrorAccountLocked = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTH-CRED-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authnservice.account_locked",
+			DefaultValue: "Account locked",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authnservice.account_locked_description",
+			DefaultValue: "The account is locked until {{param(lockedUntil)}} due to repeated failed sign-in attempts",
+		},
+	}
+	// ErrorAccountLockedPermanently is the error when the account is locked until an administrator
+	// unlocks it.
+	ErrorAccountLockedPermanently = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTH-CRED-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authnservice.account_locked_permanently",
+			DefaultValue: "Account locked",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.authnservice.account_locked_permanently_description",
+			DefaultValue: "The account is locked due to repeated failed sign-in attempts." +
+				" Contact an administrator to unlock it",
+		},
+	}
+	// ErrorTooManyFailedAttempts is the error when an attempt is made before the delay imposed after
+	// previous failed attempts has elapsed.
+	ErrorTooManyFailedAttempts = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTH-CRED-1008",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authnservice.too_many_failed_attempts",
+			DefaultValue: "Too many failed attempts",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authnservice.too_many_failed_attempts_description",
+			DefaultValue: "Too many failed sign-in attempts. Try again after {{param(retryAfter)}}",
+		},
+	}
 	// ErrorOTPAuthenticationFailed is the error when the OTP authentication attempt fails.
 	ErrorOTPAuthenticationFailed = tidcommon.ServiceError{
 		Type: tidcommon.ClientErrorType,
@@ -408,6 +408,10 @@ func (
 			status = http.StatusUnauthorized
 		case common.ErrorUserNotFound.Code:
 			status = http.StatusNotFound
+		case ErrorAccountLocked.Code, ErrorAccountLockedPermanently.Code:
+			status = http.StatusForbidden
+		case ErrorTooManyFailedAttempts.Code:
+			status = http.StatusTooManyRequests
 		default:
 			status = http.StatusBadRequest
 		}
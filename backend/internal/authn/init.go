@@ -19,6 +19,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/reactsdk"
 	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	"github.com/thunder-id/thunderid/internal/notification"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -44,6 +45,7 @@ func Initialize(
 	oidcSvc oidc.OIDCAuthnServiceInterface,
 	googleSvc google.GoogleOIDCAuthnServiceInterface,
 	githubSvc github.GithubOAuthAuthnServiceInterface,
+	lockoutSvc lockout.LockoutServiceInterface,
 	directAuthSecret string,
 ) (AuthenticationServiceInterface, DirectAuthGuardInterface) {
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
@@ -54,6 +56,10 @@ func Initialize(
 		Name:    common.AuthenticatorOTP,
 		Factors: []common.AuthenticationFactor{common.FactorPossession},
 	})
+	common.RegisterAuthenticator(common.AuthenticatorMeta{
+		Name:    common.AuthenticatorTOTP,
+		Factors: []common.AuthenticationFactor{common.FactorPossession},
+	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:    common.AuthenticatorPasskey,
 		Factors: []common.AuthenticationFactor{common.FactorPossession, common.FactorInherence},
@@ -78,6 +84,11 @@ func Initialize(
 		Factors:       []common.AuthenticationFactor{common.FactorKnowledge},
 		AssociatedIDP: providers.IDPTypeGoogle,
 	})
+	common.RegisterAuthenticator(common.AuthenticatorMeta{
+		Name:          common.AuthenticatorSAML,
+		Factors:       []common.AuthenticationFactor{common.FactorKnowledge},
+		AssociatedIDP: providers.IDPTypeSAML,
+	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:    common.AuthenticatorMagicLink,
 		Factors: []common.AuthenticationFactor{common.FactorPossession},
@@ -100,6 +111,7 @@ func Initialize(
 		oidcSvc,
 		googleSvc,
 		githubSvc,
+		lockoutSvc,
 	)
 
 	directAuthGuard := newDirectAuthGuard(directAuthSecret)
@@ -0,0 +1,73 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import "time"
+
+const (
+	loggerComponentName = "SAMLAuthnService"
+)
+
+// acsPath is the assertion consumer service endpoint at which SAML identity providers deliver their
+// responses. The IdP ID in the path selects the connection the response is validated against.
+const (
+	acsPathPrefix = "/saml2/sp/"
+	acsPathSuffix = "/acs"
+	acsPath       = acsPathPrefix + "{" + pathParamIDPID + "}" + acsPathSuffix
+
+	pathParamIDPID = "idpId"
+)
+
+// Metadata keys returned alongside the AuthnRequest URL.
+const (
+	// MetadataKeyState is the state carried to the IdP as RelayState and returned with the response.
+	MetadataKeyState = "state"
+	// MetadataKeyRequestID is the ID of the AuthnRequest the response must answer.
+	MetadataKeyRequestID = "requestId"
+)
+
+// SAML 2.0 namespaces and the prefixes used when emitting them.
+const (
+	namespaceProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	namespaceAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+
+	prefixProtocol  = "samlp"
+	prefixAssertion = "saml"
+)
+
+const (
+	// samlVersion is the only protocol version sent and accepted.
+	samlVersion = "2.0"
+	// bindingHTTPPost is the binding on which responses are expected at the ACS.
+	bindingHTTPPost = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	// statusSuccess is the top-level status code of a successful response.
+	statusSuccess = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	// confirmationMethodBearer is the bearer subject confirmation method.
+	confirmationMethodBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+)
+
+// HTTP parameters of the Redirect and POST bindings.
+const (
+	paramSAMLRequest  = "SAMLRequest"
+	paramSAMLResponse = "SAMLResponse"
+	paramRelayState   = "RelayState"
+	paramSigAlg       = "SigAlg"
+	paramSignature    = "Signature"
+)
+
+// Parameters with which the ACS hands a received response to the connection's redirect URI, matching
+// the parameters of an OAuth authorization response so the login page handles both alike.
+const (
+	paramCode  = "code"
+	paramState = "state"
+)
+
+const (
+	// maxACSBodySize bounds the size of a POST to the assertion consumer service.
+	maxACSBodySize = 512 * 1024
+	// clockSkew is tolerated between the identity provider's clock and ours.
+	clockSkew = 2 * time.Minute
+	// responseTTLSeconds bounds how long a received response waits for the flow to consume it.
+	responseTTLSeconds = 300
+)
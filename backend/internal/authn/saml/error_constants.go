@@ -0,0 +1,77 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for SAML authentication.
+var (
+	// ErrorEmptyIdpID is the error when the IDP identifier is empty.
+	ErrorEmptyIdpID = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTH-SAML-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authsamlservice.empty_idp_id",
+			DefaultValue: "IDP id is empty",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authsamlservice.empty_idp_id_description",
+			DefaultValue: "The identity provider id cannot be empty",
+		},
+	}
+	// ErrorInvalidIDP is the error when the retrieved IDP is missing or is not a SAML identity provider.
+	ErrorInvalidIDP = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTH-SAML-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authsamlservice.invalid_idp",
+			DefaultValue: "Invalid identity provider",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authsamlservice.invalid_idp_description",
+			DefaultValue: "The identity provider is not a valid SAML identity provider",
+		},
+	}
+	// ErrorResponseNotFound is the error when a received response handle is empty, unknown or already used.
+	ErrorResponseNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTH-SAML-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authsamlservice.response_not_found",
+			DefaultValue: "SAML response not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authsamlservice.response_not_found_description",
+			DefaultValue: "The SAML response was not found, has expired or was already used",
+		},
+	}
+	// ErrorInvalidResponse is the error when a SAML response is malformed or fails validation.
+	ErrorInvalidResponse = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTH-SAML-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authsamlservice.invalid_response",
+			DefaultValue: "Invalid SAML response",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authsamlservice.invalid_response_description",
+			DefaultValue: "The SAML response from the identity provider is malformed or failed validation",
+		},
+	}
+	// ErrorAuthenticationFailed is the error when the identity provider answers with a non-success status.
+	ErrorAuthenticationFailed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTH-SAML-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authsamlservice.authentication_failed",
+			DefaultValue: "Authentication failed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authsamlservice.authentication_failed_description",
+			DefaultValue: "The identity provider did not authenticate the user",
+		},
+	}
+)
@@ -0,0 +1,69 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// samlACSHandler serves the assertion consumer service of SAML connections.
+type samlACSHandler struct {
+	cfg         serviceConfig
+	samlService SAMLAuthnServiceInterface
+	logger      *log.Logger
+}
+
+// newSAMLACSHandler creates a new instance of samlACSHandler.
+func newSAMLACSHandler(samlService SAMLAuthnServiceInterface, cfg serviceConfig) *samlACSHandler {
+	return &samlACSHandler{
+		cfg:         cfg,
+		samlService: samlService,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SAMLACSHandler")),
+	}
+}
+
+// HandleACSRequest handles a response posted by an identity provider to POST /saml2/sp/{idpId}/acs.
+// The browser is sent on to the connection's redirect URI, from where the login page resumes the
+// authentication flow. Responses that cannot be accepted end on the error page.
+func (h *samlACSHandler) HandleACSRequest(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxACSBodySize)
+	if err := r.ParseForm(); err != nil {
+		h.redirectToErrorPage(w, r, ErrorInvalidResponse.Code, "Failed to parse the SAML response")
+		return
+	}
+
+	redirectURL, svcErr := h.samlService.AcceptResponse(r.Context(), r.PathValue(pathParamIDPID),
+		r.PostForm.Get(paramSAMLResponse), r.PostForm.Get(paramRelayState))
+	if svcErr != nil {
+		h.redirectToErrorPage(w, r, svcErr.Code, svcErr.ErrorDescription.DefaultValue)
+		return
+	}
+	// 303 turns the IdP's POST into a GET on the redirect URI.
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+// redirectToErrorPage redirects the browser to the Gate error page.
+func (h *samlACSHandler) redirectToErrorPage(w http.ResponseWriter, r *http.Request, code, msg string) {
+	errorPageURL := (&url.URL{
+		Scheme: h.cfg.GateClient.Scheme,
+		Host:   fmt.Sprintf("%s:%d", h.cfg.GateClient.Hostname, h.cfg.GateClient.Port),
+		Path:   h.cfg.GateClient.ErrorPath,
+	}).String()
+
+	redirectURL, err := utils.GetURIWithQueryParams(errorPageURL, map[string]string{
+		"errorCode":    code,
+		"errorMessage": msg,
+	})
+	if err != nil {
+		h.logger.Error(r.Context(), "Failed to construct error page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
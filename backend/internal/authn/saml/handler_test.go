@@ -0,0 +1,70 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/authn/samlmock"
+)
+
+type SAMLACSHandlerTestSuite struct {
+	suite.Suite
+	mockService *samlmock.SAMLAuthnServiceInterfaceMock
+	mux         *http.ServeMux
+}
+
+func TestSAMLACSHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SAMLACSHandlerTestSuite))
+}
+
+func (s *SAMLACSHandlerTestSuite) SetupTest() {
+	s.mockService = samlmock.NewSAMLAuthnServiceInterfaceMock(s.T())
+	s.mux = http.NewServeMux()
+	registerRoutes(s.mux, newSAMLACSHandler(s.mockService, testServiceConfig()))
+}
+
+func (s *SAMLACSHandlerTestSuite) post(form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/saml2/sp/"+testIDPID+"/acs", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	s.mux.ServeHTTP(w, req)
+	return w
+}
+
+func (s *SAMLACSHandlerTestSuite) TestHandleACSRequest_RedirectsToRedirectURI() {
+	s.mockService.EXPECT().AcceptResponse(mock.Anything, testIDPID, "PHJlc3BvbnNlLz4=", "state-1").
+		Return(testRedirectURI+"?code=handle-1&state=state-1", nil)
+
+	w := s.post(url.Values{paramSAMLResponse: {"PHJlc3BvbnNlLz4="}, paramRelayState: {"state-1"}})
+
+	s.Equal(http.StatusSeeOther, w.Code)
+	s.Equal(testRedirectURI+"?code=handle-1&state=state-1", w.Header().Get("Location"))
+}
+
+func (s *SAMLACSHandlerTestSuite) TestHandleACSRequest_ErrorRedirectsToErrorPage() {
+	s.mockService.EXPECT().AcceptResponse(mock.Anything, testIDPID, "", "").Return("", &ErrorInvalidResponse)
+
+	w := s.post(url.Values{})
+
+	s.Equal(http.StatusSeeOther, w.Code)
+	location, err := url.Parse(w.Header().Get("Location"))
+	s.Require().NoError(err)
+	s.Equal("/gate/error", location.Path)
+	s.Equal(ErrorInvalidResponse.Code, location.Query().Get("errorCode"))
+}
+
+func (s *SAMLACSHandlerTestSuite) TestHandleACSRequest_RejectsOversizedBody() {
+	w := s.post(url.Values{paramSAMLResponse: {strings.Repeat("a", maxACSBodySize)}})
+
+	s.Equal(http.StatusSeeOther, w.Code)
+	s.Contains(w.Header().Get("Location"), "/gate/error")
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	authnoauth "github.com/thunder-id/thunderid/internal/authn/oauth"
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize initializes the SAML authentication service and registers its assertion consumer
+// service. AuthnRequests are signed with the configured SAML signing key; without one, connections
+// that ask for signed requests cannot be used.
+func Initialize(
+	mux *http.ServeMux,
+	oauthSvc authnoauth.OAuthAuthnCoreServiceInterface,
+	idpSvc idp.IDPServiceInterface,
+	cryptoProvider providers.RuntimeCryptoProvider,
+	runtimeStore providers.RuntimeStoreProvider,
+) (SAMLAuthnServiceInterface, error) {
+	runtime := config.GetServerRuntime()
+	cfg := serviceConfig{
+		ServerURL:    strings.TrimRight(config.GetServerURL(&runtime.Config.Server), "/"),
+		SigningKeyID: runtime.Config.SAML.SigningKeyID,
+		GateClient:   runtime.Config.GateClient,
+	}
+	if cfg.SigningKeyID != "" {
+		keys, err := cryptoProvider.GetPublicKeys(context.Background(),
+			providers.PublicKeyFilter{KeyID: cfg.SigningKeyID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load SAML signing key %q: %w", cfg.SigningKeyID, err)
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("no SAML signing key found for key id %q", cfg.SigningKeyID)
+		}
+		cfg.SigningAlgorithm = keys[0].Algorithm
+	}
+
+	svc := newSAMLAuthnService(cfg, oauthSvc, idpSvc, cryptoProvider, runtimeStore)
+	registerRoutes(mux, newSAMLACSHandler(svc, cfg))
+	return svc, nil
+}
+
+// registerRoutes registers the assertion consumer service. Responses arrive as top-level browser
+// form posts and need no CORS handling.
+func registerRoutes(mux *http.ServeMux, h *samlACSHandler) {
+	mux.HandleFunc("POST "+acsPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleACSRequest)).ServeHTTP)
+}
+
+// GetACSURL returns the assertion consumer service URL of the SAML connection with the given ID, for
+// registration with the identity provider.
+func GetACSURL(idpID string) string {
+	runtime := config.GetServerRuntime()
+	return buildACSURL(strings.TrimRight(config.GetServerURL(&runtime.Config.Server), "/"), idpID)
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"crypto/x509"
+
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+)
+
+// serviceConfig holds the deployment settings the SAML authenticator runs with.
+type serviceConfig struct {
+	// ServerURL is the public base URL the assertion consumer service URLs are built from.
+	ServerURL string
+	// SigningKeyID and SigningAlgorithm select the key AuthnRequests are signed with. Both are empty
+	// when no SAML signing key is configured, in which case requests cannot be signed.
+	SigningKeyID     string
+	SigningAlgorithm string
+	GateClient       engineconfig.GateClientConfig
+}
+
+// idpConfig holds the SAML settings of a connection, read from its properties.
+type idpConfig struct {
+	SPEntityID        string
+	RedirectURI       string
+	IDPEntityID       string
+	SSOURL            string
+	Certificate       *x509.Certificate
+	NameIDFormat      string
+	SignAuthnRequests bool
+}
+
+// receivedResponse is a SAML response accepted at the assertion consumer service, held until the
+// authentication flow consumes it.
+type receivedResponse struct {
+	IDPID        string `json:"idpId"`
+	SAMLResponse string `json:"samlResponse"`
+}
+
+// assertionResult holds the identity asserted by a validated response.
+type assertionResult struct {
+	NameID     string
+	Attributes map[string]interface{}
+}
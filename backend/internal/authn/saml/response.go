@@ -0,0 +1,240 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/xmldsig"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// errAuthenticationFailed reports a well-formed response whose status is not Success.
+var errAuthenticationFailed = errors.New("identity provider returned a non-success status")
+
+// responseValidator validates a response against the connection and the AuthnRequest it answers.
+type responseValidator struct {
+	cryptoProvider providers.RuntimeCryptoProvider
+	idpCfg         *idpConfig
+	acsURL         string
+	requestID      string
+	now            time.Time
+}
+
+// validate checks a response and its single assertion and returns the asserted identity. Either the
+// response or the assertion must carry a valid signature by the identity provider; values are only
+// read from the assertion once a signature covering it has been verified.
+func (v *responseValidator) validate(ctx context.Context, document []byte) (*assertionResult, error) {
+	response, err := xmldsig.Parse(document)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Is(namespaceProtocol, "Response") {
+		return nil, errors.New("document is not a Response")
+	}
+	if response.AttrValue("Version") != samlVersion {
+		return nil, errors.New("unsupported SAML version")
+	}
+	if response.AttrValue("ID") == "" {
+		return nil, errors.New("response has no ID")
+	}
+	if destination, ok := response.LookupAttr("Destination"); ok && destination != v.acsURL {
+		return nil, errors.New("response destination does not match the assertion consumer service")
+	}
+	if err := v.checkInResponseTo(response.AttrValue("InResponseTo")); err != nil {
+		return nil, err
+	}
+	if issuer := response.FindChild(namespaceAssertion, "Issuer"); issuer != nil &&
+		issuer.Text() != v.idpCfg.IDPEntityID {
+		return nil, errors.New("response issuer does not match the identity provider")
+	}
+
+	responseSigned := xmldsig.HasSignature(response)
+	if responseSigned {
+		if err := xmldsig.Verify(ctx, response, v.cryptoProvider, v.idpCfg.Certificate.PublicKey); err != nil {
+			return nil, fmt.Errorf("response signature: %w", err)
+		}
+	}
+
+	if err := checkStatus(response); err != nil {
+		return nil, err
+	}
+
+	if len(response.FindChildren(namespaceAssertion, "EncryptedAssertion")) > 0 {
+		return nil, errors.New("encrypted assertions are not supported")
+	}
+	assertions := response.FindChildren(namespaceAssertion, "Assertion")
+	if len(assertions) != 1 {
+		return nil, errors.New("response must carry exactly one assertion")
+	}
+	assertion := assertions[0]
+	if xmldsig.HasSignature(assertion) {
+		if err := xmldsig.Verify(ctx, assertion, v.cryptoProvider, v.idpCfg.Certificate.PublicKey); err != nil {
+			return nil, fmt.Errorf("assertion signature: %w", err)
+		}
+	} else if !responseSigned {
+		return nil, errors.New("neither the response nor the assertion is signed")
+	}
+
+	return v.validateAssertion(assertion)
+}
+
+// validateAssertion checks the issuer, subject confirmation and conditions of a verified assertion
+// and reads the NameID and attributes from it.
+func (v *responseValidator) validateAssertion(assertion *xmldsig.Element) (*assertionResult, error) {
+	if assertion.AttrValue("Version") != samlVersion {
+		return nil, errors.New("unsupported assertion version")
+	}
+	issuer := assertion.FindChild(namespaceAssertion, "Issuer")
+	if issuer == nil || issuer.Text() != v.idpCfg.IDPEntityID {
+		return nil, errors.New("assertion issuer does not match the identity provider")
+	}
+
+	subject := assertion.FindChild(namespaceAssertion, "Subject")
+	if subject == nil {
+		return nil, errors.New("assertion has no subject")
+	}
+	nameID := subject.FindChild(namespaceAssertion, "NameID")
+	if nameID == nil || nameID.Text() == "" {
+		return nil, errors.New("assertion has no NameID")
+	}
+	if err := v.checkSubjectConfirmation(subject); err != nil {
+		return nil, err
+	}
+	if err := v.checkConditions(assertion.FindChild(namespaceAssertion, "Conditions")); err != nil {
+		return nil, err
+	}
+
+	return &assertionResult{
+		NameID:     nameID.Text(),
+		Attributes: readAttributes(assertion),
+	}, nil
+}
+
+// checkSubjectConfirmation requires a bearer confirmation addressed to the assertion consumer
+// service, answering our request and still within its validity window.
+func (v *responseValidator) checkSubjectConfirmation(subject *xmldsig.Element) error {
+	for _, confirmation := range subject.FindChildren(namespaceAssertion, "SubjectConfirmation") {
+		if confirmation.AttrValue("Method") != confirmationMethodBearer {
+			continue
+		}
+		data := confirmation.FindChild(namespaceAssertion, "SubjectConfirmationData")
+		if data == nil || data.AttrValue("Recipient") != v.acsURL {
+			continue
+		}
+		if v.checkInResponseTo(data.AttrValue("InResponseTo")) != nil {
+			continue
+		}
+		notOnOrAfter, err := parseInstant(data.AttrValue("NotOnOrAfter"))
+		if err != nil || !v.now.Before(notOnOrAfter.Add(clockSkew)) {
+			continue
+		}
+		if notBefore, ok := data.LookupAttr("NotBefore"); ok {
+			instant, err := parseInstant(notBefore)
+			if err != nil || v.now.Add(clockSkew).Before(instant) {
+				continue
+			}
+		}
+		return nil
+	}
+	return errors.New("assertion has no valid bearer subject confirmation")
+}
+
+// checkConditions checks the assertion's validity window and requires an audience restriction that
+// names this service provider.
+func (v *responseValidator) checkConditions(conditions *xmldsig.Element) error {
+	if conditions == nil {
+		return errors.New("assertion has no conditions")
+	}
+	if value, ok := conditions.LookupAttr("NotBefore"); ok {
+		notBefore, err := parseInstant(value)
+		if err != nil || v.now.Add(clockSkew).Before(notBefore) {
+			return errors.New("assertion is not yet valid")
+		}
+	}
+	if value, ok := conditions.LookupAttr("NotOnOrAfter"); ok {
+		notOnOrAfter, err := parseInstant(value)
+		if err != nil || !v.now.Before(notOnOrAfter.Add(clockSkew)) {
+			return errors.New("assertion has expired")
+		}
+	}
+
+	restrictions := conditions.FindChildren(namespaceAssertion, "AudienceRestriction")
+	if len(restrictions) == 0 {
+		return errors.New("assertion has no audience restriction")
+	}
+	// Each restriction must be satisfied on its own: the assertion is valid only for audiences named
+	// in every one of them.
+	for _, restriction := range restrictions {
+		audiences := []string{}
+		for _, audience := range restriction.FindChildren(namespaceAssertion, "Audience") {
+			audiences = append(audiences, audience.Text())
+		}
+		if !slices.Contains(audiences, v.idpCfg.SPEntityID) {
+			return errors.New("assertion audience does not include the service provider")
+		}
+	}
+	return nil
+}
+
+// checkInResponseTo requires a response to answer the AuthnRequest sent for this flow, rejecting
+// unsolicited responses.
+func (v *responseValidator) checkInResponseTo(inResponseTo string) error {
+	if v.requestID == "" || inResponseTo != v.requestID {
+		return errors.New("response does not answer the authentication request")
+	}
+	return nil
+}
+
+// checkStatus returns errAuthenticationFailed unless the response's top-level status is Success.
+func checkStatus(response *xmldsig.Element) error {
+	status := response.FindChild(namespaceProtocol, "Status")
+	if status == nil {
+		return errors.New("response has no status")
+	}
+	code := status.FindChild(namespaceProtocol, "StatusCode")
+	if code == nil {
+		return errors.New("response has no status code")
+	}
+	if value := code.AttrValue("Value"); value != statusSuccess {
+		return fmt.Errorf("%w: %s", errAuthenticationFailed, value)
+	}
+	return nil
+}
+
+// readAttributes returns the attributes of an assertion keyed by attribute name. Single-valued
+// attributes map to a string and multi-valued attributes to a string slice.
+func readAttributes(assertion *xmldsig.Element) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	for _, statement := range assertion.FindChildren(namespaceAssertion, "AttributeStatement") {
+		for _, attribute := range statement.FindChildren(namespaceAssertion, "Attribute") {
+			name := attribute.AttrValue("Name")
+			if name == "" {
+				continue
+			}
+			values := []string{}
+			for _, value := range attribute.FindChildren(namespaceAssertion, "AttributeValue") {
+				values = append(values, value.Text())
+			}
+			switch len(values) {
+			case 0:
+				continue
+			case 1:
+				attributes[name] = values[0]
+			default:
+				attributes[name] = values
+			}
+		}
+	}
+	return attributes
+}
+
+// parseInstant parses a SAML xs:dateTime value.
+func parseInstant(value string) (time.Time, error) {
+	return time.Parse(time.RFC3339, value)
+}
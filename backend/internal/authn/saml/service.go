@@ -0,0 +1,325 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package saml implements an authentication service for authenticating via a SAML 2.0 identity
+// provider. AuthnRequests are sent over the HTTP-Redirect binding and responses are received over the
+// HTTP-POST binding at a per-connection assertion consumer service.
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	authnoauth "github.com/thunder-id/thunderid/internal/authn/oauth"
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/system/xmldsig"
+)
+
+// SAMLAuthnServiceInterface defines the contract for the SAML authenticator service.
+type SAMLAuthnServiceInterface interface {
+	authncm.FederatedAuthenticator
+	// BuildAuthnRequestURL builds the HTTP-Redirect binding URL that sends an AuthnRequest to the
+	// identity provider. The returned metadata carries the state and the ID of the request.
+	BuildAuthnRequestURL(ctx context.Context, idpID string) (string, map[string]string, *tidcommon.ServiceError)
+	// AcceptResponse holds a response received at the assertion consumer service and returns the URL
+	// that hands it to the authentication flow through the connection's redirect URI.
+	AcceptResponse(ctx context.Context, idpID, samlResponse, relayState string) (string, *tidcommon.ServiceError)
+}
+
+// samlAuthnService is the default implementation of SAMLAuthnServiceInterface.
+type samlAuthnService struct {
+	cfg            serviceConfig
+	oauthService   authnoauth.OAuthAuthnCoreServiceInterface
+	idpService     idp.IDPServiceInterface
+	cryptoProvider providers.RuntimeCryptoProvider
+	runtimeStore   providers.RuntimeStoreProvider
+	logger         *log.Logger
+}
+
+// newSAMLAuthnService creates a new instance of the SAML authenticator service.
+func newSAMLAuthnService(cfg serviceConfig, oauthSvc authnoauth.OAuthAuthnCoreServiceInterface,
+	idpSvc idp.IDPServiceInterface, cryptoProvider providers.RuntimeCryptoProvider,
+	runtimeStore providers.RuntimeStoreProvider) SAMLAuthnServiceInterface {
+	return &samlAuthnService{
+		cfg:            cfg,
+		oauthService:   oauthSvc,
+		idpService:     idpSvc,
+		cryptoProvider: cryptoProvider,
+		runtimeStore:   runtimeStore,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// BuildAuthnRequestURL builds the HTTP-Redirect binding URL that sends an AuthnRequest to the identity
+// provider, signing the query string when the connection asks for signed requests.
+func (s *samlAuthnService) BuildAuthnRequestURL(ctx context.Context, idpID string) (
+	string, map[string]string, *tidcommon.ServiceError) {
+	logger := s.logger.With(log.String("idpId", idpID))
+	logger.Debug(ctx, "Building SAML AuthnRequest URL")
+
+	idpCfg, svcErr := s.getIDPConfig(ctx, idpID)
+	if svcErr != nil {
+		return "", nil, svcErr
+	}
+
+	requestID := newID()
+	state := sysutils.GenerateUUID()
+	request, err := encodeRedirect(s.newAuthnRequest(idpCfg, idpID, requestID).Bytes())
+	if err != nil {
+		logger.Error(ctx, "Failed to encode AuthnRequest", log.Error(err))
+		return "", nil, &tidcommon.InternalServerError
+	}
+
+	query := paramSAMLRequest + "=" + url.QueryEscape(request) + "&" + paramRelayState + "=" + url.QueryEscape(state)
+	if idpCfg.SignAuthnRequests {
+		query, err = s.signQuery(ctx, query)
+		if err != nil {
+			logger.Error(ctx, "Failed to sign AuthnRequest", log.Error(err))
+			return "", nil, &tidcommon.InternalServerError
+		}
+	}
+
+	separator := "?"
+	if strings.Contains(idpCfg.SSOURL, "?") {
+		separator = "&"
+	}
+	metadata := map[string]string{
+		MetadataKeyState:     state,
+		MetadataKeyRequestID: requestID,
+	}
+	return idpCfg.SSOURL + separator + query, metadata, nil
+}
+
+// newAuthnRequest creates an AuthnRequest asking for a response at the connection's assertion
+// consumer service over the POST binding.
+func (s *samlAuthnService) newAuthnRequest(idpCfg *idpConfig, idpID, requestID string) *xmldsig.Element {
+	request := xmldsig.NewElement(prefixProtocol, "AuthnRequest")
+	request.DeclareNamespace(prefixProtocol, namespaceProtocol)
+	request.DeclareNamespace(prefixAssertion, namespaceAssertion)
+	request.SetAttr("ID", requestID).
+		SetAttr("Version", samlVersion).
+		SetAttr("IssueInstant", time.Now().UTC().Format(time.RFC3339)).
+		SetAttr("Destination", idpCfg.SSOURL).
+		SetAttr("AssertionConsumerServiceURL", buildACSURL(s.cfg.ServerURL, idpID)).
+		SetAttr("ProtocolBinding", bindingHTTPPost)
+	request.AddChild(xmldsig.NewElement(prefixAssertion, "Issuer")).SetText(idpCfg.SPEntityID)
+	if idpCfg.NameIDFormat != "" {
+		request.AddChild(xmldsig.NewElement(prefixProtocol, "NameIDPolicy")).
+			SetAttr("Format", idpCfg.NameIDFormat).
+			SetAttr("AllowCreate", "true")
+	}
+	return request
+}
+
+// signQuery appends the SigAlg and Signature parameters of the Redirect binding to query.
+func (s *samlAuthnService) signQuery(ctx context.Context, query string) (string, error) {
+	if s.cfg.SigningKeyID == "" {
+		return "", errors.New("no SAML signing key is configured")
+	}
+	sigAlg, err := xmldsig.SignatureMethodURI(s.cfg.SigningAlgorithm)
+	if err != nil {
+		return "", err
+	}
+	query += "&" + paramSigAlg + "=" + url.QueryEscape(sigAlg)
+	signature, err := s.cryptoProvider.Sign(ctx, providers.KeyRef{KeyID: s.cfg.SigningKeyID},
+		s.cfg.SigningAlgorithm, []byte(query))
+	if err != nil {
+		return "", err
+	}
+	return query + "&" + paramSignature + "=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature)), nil
+}
+
+// AcceptResponse holds a response received at the assertion consumer service for a short time and
+// returns the connection's redirect URI carrying a handle to it as the code and the RelayState as the
+// state, so the login page resumes the flow exactly as it does for an OAuth authorization response.
+func (s *samlAuthnService) AcceptResponse(ctx context.Context, idpID, samlResponse, relayState string) (
+	string, *tidcommon.ServiceError) {
+	logger := s.logger.With(log.String("idpId", idpID))
+
+	idpCfg, svcErr := s.getIDPConfig(ctx, idpID)
+	if svcErr != nil {
+		return "", svcErr
+	}
+	if samlResponse == "" {
+		return "", &ErrorInvalidResponse
+	}
+
+	data, err := json.Marshal(receivedResponse{IDPID: idpID, SAMLResponse: samlResponse})
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal SAML response", log.Error(err))
+		return "", &tidcommon.InternalServerError
+	}
+	handle := sysutils.GenerateUUID()
+	if err := s.runtimeStore.Put(ctx, providers.NamespaceSAMLACS, handle, data, responseTTLSeconds); err != nil {
+		logger.Error(ctx, "Failed to store SAML response", log.Error(err))
+		return "", &tidcommon.InternalServerError
+	}
+
+	queryParams := map[string]string{paramCode: handle}
+	if relayState != "" {
+		queryParams[paramState] = relayState
+	}
+	redirectURL, err := sysutils.GetURIWithQueryParams(idpCfg.RedirectURI, queryParams)
+	if err != nil {
+		logger.Error(ctx, "Failed to build redirect URL", log.Error(err))
+		return "", &tidcommon.InternalServerError
+	}
+	return redirectURL, nil
+}
+
+// Authenticate validates the response held under the handle in authzData.Code against the connection
+// and the AuthnRequest named by authzData.RequestID, and resolves the asserted identity. The NameID
+// becomes the subject and the assertion's attributes the claims, which pass through the connection's
+// attribute mappings like the claims of any other federated identity.
+func (s *samlAuthnService) Authenticate(ctx context.Context, idpID string,
+	authzData authncm.AuthorizationData) (*authncm.AuthnResult, *tidcommon.ServiceError) {
+	logger := s.logger.With(log.String("idpId", idpID))
+
+	idpCfg, svcErr := s.getIDPConfig(ctx, idpID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	received, svcErr := s.takeResponse(ctx, authzData.Code)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if received.IDPID != idpID {
+		logger.Debug(ctx, "SAML response was received for a different identity provider")
+		return nil, &ErrorResponseNotFound
+	}
+
+	document, err := base64.StdEncoding.DecodeString(received.SAMLResponse)
+	if err != nil {
+		logger.Debug(ctx, "SAML response is not base64 encoded")
+		return nil, &ErrorInvalidResponse
+	}
+	validator := &responseValidator{
+		cryptoProvider: s.cryptoProvider,
+		idpCfg:         idpCfg,
+		acsURL:         buildACSURL(s.cfg.ServerURL, idpID),
+		requestID:      authzData.RequestID,
+		now:            time.Now(),
+	}
+	result, err := validator.validate(ctx, document)
+	if err != nil {
+		if errors.Is(err, errAuthenticationFailed) {
+			logger.Debug(ctx, "Identity provider reported a failed authentication", log.Error(err))
+			return nil, &ErrorAuthenticationFailed
+		}
+		logger.Debug(ctx, "SAML response failed validation", log.Error(err))
+		return nil, &ErrorInvalidResponse
+	}
+
+	claims := result.Attributes
+	claims["sub"] = result.NameID
+	return s.oauthService.BuildFederatedAuthResult(ctx, idpID, result.NameID, claims)
+}
+
+// takeResponse retrieves and removes a response held by the assertion consumer service.
+func (s *samlAuthnService) takeResponse(ctx context.Context, handle string) (
+	*receivedResponse, *tidcommon.ServiceError) {
+	if handle == "" {
+		return nil, &ErrorResponseNotFound
+	}
+	data, err := s.runtimeStore.Take(ctx, providers.NamespaceSAMLACS, handle)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to read SAML response", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if data == nil {
+		return nil, &ErrorResponseNotFound
+	}
+
+	var received receivedResponse
+	if err := json.Unmarshal(data, &received); err != nil {
+		s.logger.Error(ctx, "Failed to unmarshal SAML response", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return &received, nil
+}
+
+// getIDPConfig retrieves the SAML settings of the identity provider with the given ID.
+func (s *samlAuthnService) getIDPConfig(ctx context.Context, idpID string) (*idpConfig, *tidcommon.ServiceError) {
+	if strings.TrimSpace(idpID) == "" {
+		return nil, &ErrorEmptyIdpID
+	}
+
+	idpDTO, svcErr := s.idpService.GetIdentityProvider(ctx, idpID)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			return nil, &ErrorInvalidIDP
+		}
+		s.logger.Error(ctx, "Error while retrieving identity provider", log.String("idpId", idpID),
+			log.String("errorCode", svcErr.Code), log.String("description", svcErr.ErrorDescription.DefaultValue))
+		return nil, &tidcommon.InternalServerError
+	}
+	if idpDTO == nil || idpDTO.Type != providers.IDPTypeSAML {
+		return nil, &ErrorInvalidIDP
+	}
+
+	certificate, err := parseCertificate(idp.GetPropertyValue(idpDTO.Properties, idp.PropIDPCertificate))
+	if err != nil {
+		s.logger.Error(ctx, "SAML identity provider certificate is invalid", log.String("idpId", idpID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return &idpConfig{
+		SPEntityID:        idp.GetPropertyValue(idpDTO.Properties, idp.PropSPEntityID),
+		RedirectURI:       idp.GetPropertyValue(idpDTO.Properties, idp.PropRedirectURI),
+		IDPEntityID:       idp.GetPropertyValue(idpDTO.Properties, idp.PropIDPEntityID),
+		SSOURL:            idp.GetPropertyValue(idpDTO.Properties, idp.PropSSOURL),
+		Certificate:       certificate,
+		NameIDFormat:      idp.GetPropertyValue(idpDTO.Properties, idp.PropNameIDFormat),
+		SignAuthnRequests: idp.GetPropertyValue(idpDTO.Properties, idp.PropSignAuthnRequests) == "true",
+	}, nil
+}
+
+// encodeRedirect deflates and base64 encodes a message for the HTTP-Redirect binding.
+func encodeRedirect(data []byte) (string, error) {
+	var buf bytes.Buffer
+	writer, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// parseCertificate parses a PEM-encoded X.509 certificate.
+func parseCertificate(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("certificate is not PEM encoded")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// newID returns an identifier usable as an xs:ID, which must not start with a digit.
+func newID() string {
+	return "_" + sysutils.GenerateUUID()
+}
+
+// buildACSURL returns the assertion consumer service URL of a connection.
+func buildACSURL(serverURL, idpID string) string {
+	return serverURL + acsPathPrefix + url.PathEscape(idpID) + acsPathSuffix
+}
@@ -0,0 +1,442 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/xmldsig"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/oauthmock"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
+)
+
+const (
+	testIDPID         = "idp-1"
+	testServerURL     = "https://thunder.example.com"
+	testACSURL        = testServerURL + "/saml2/sp/" + testIDPID + "/acs"
+	testRedirectURI   = "https://thunder.example.com/gate/callback"
+	testSPEntityID    = "https://thunder.example.com/sp"
+	testIDPEntityID   = "https://idp.example.com/saml"
+	testSSOURL        = "https://idp.example.com/saml/sso"
+	testIDPKeyID      = "idp-key"
+	testSPKeyID       = "sp-key"
+	testRequestID     = "_request-1"
+	testNameID        = "alice@example.com"
+	testDeploymentID  = "test-deployment"
+	testNameIDFormat  = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	testAttributeName = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"
+)
+
+type SAMLAuthnServiceTestSuite struct {
+	suite.Suite
+	idpKey           *ecdsa.PrivateKey
+	spKey            *ecdsa.PrivateKey
+	idpCertPEM       string
+	mockIDPService   *idpmock.IDPServiceInterfaceMock
+	mockOAuthService *oauthmock.OAuthAuthnServiceInterfaceMock
+	mockCrypto       *cryptomock.RuntimeCryptoProviderMock
+	runtimeStore     providers.RuntimeStoreProvider
+	service          SAMLAuthnServiceInterface
+	signRequests     bool
+}
+
+func TestSAMLAuthnServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SAMLAuthnServiceTestSuite))
+}
+
+func (s *SAMLAuthnServiceTestSuite) SetupTest() {
+	var err error
+	s.idpKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	s.spKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	s.idpCertPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE",
+		Bytes: newTestCertificate(s.T(), s.idpKey)}))
+	s.signRequests = false
+
+	s.mockIDPService = idpmock.NewIDPServiceInterfaceMock(s.T())
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, testIDPID).
+		RunAndReturn(func(_ context.Context, _ string) (*providers.IDPDTO, *tidcommon.ServiceError) {
+			return s.idpDTO(), nil
+		}).Maybe()
+	s.mockOAuthService = oauthmock.NewOAuthAuthnServiceInterfaceMock(s.T())
+	s.mockCrypto = cryptomock.NewRuntimeCryptoProviderMock(s.T())
+	s.mockCrypto.EXPECT().Sign(mock.Anything, mock.Anything, "ES256", mock.Anything).
+		RunAndReturn(func(_ context.Context, ref providers.KeyRef, _ string, content []byte) ([]byte, error) {
+			key := s.idpKey
+			if ref.KeyID == testSPKeyID {
+				key = s.spKey
+			}
+			return cryptolib.Generate(content, cryptolib.ECDSASHA256, key)
+		}).Maybe()
+	s.mockCrypto.EXPECT().Verify(mock.Anything, mock.Anything, "ES256", mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, ref providers.KeyRef, _ string, content, sig []byte) error {
+			return cryptolib.Verify(content, sig, cryptolib.ECDSASHA256, ref.PublicKey)
+		}).Maybe()
+	s.runtimeStore = inmemory.Initialize(testDeploymentID)
+
+	s.service = newSAMLAuthnService(testServiceConfig(), s.mockOAuthService, s.mockIDPService, s.mockCrypto,
+		s.runtimeStore)
+}
+
+func testServiceConfig() serviceConfig {
+	return serviceConfig{
+		ServerURL:        testServerURL,
+		SigningKeyID:     testSPKeyID,
+		SigningAlgorithm: "ES256",
+		GateClient: engineconfig.GateClientConfig{
+			Scheme:    "https",
+			Hostname:  "localhost",
+			Port:      5190,
+			ErrorPath: "/gate/error",
+		},
+	}
+}
+
+func (s *SAMLAuthnServiceTestSuite) idpDTO() *providers.IDPDTO {
+	values := map[string]string{
+		idp.PropSPEntityID:     testSPEntityID,
+		idp.PropRedirectURI:    testRedirectURI,
+		idp.PropIDPEntityID:    testIDPEntityID,
+		idp.PropSSOURL:         testSSOURL,
+		idp.PropIDPCertificate: s.idpCertPEM,
+		idp.PropNameIDFormat:   testNameIDFormat,
+	}
+	if s.signRequests {
+		values[idp.PropSignAuthnRequests] = "true"
+	}
+	properties := make([]cmodels.Property, 0, len(values))
+	for name, value := range values {
+		prop, err := cmodels.NewProperty(name, value, false)
+		s.Require().NoError(err)
+		properties = append(properties, *prop)
+	}
+	return &providers.IDPDTO{ID: testIDPID, Name: "Okta", Type: providers.IDPTypeSAML, Properties: properties}
+}
+
+// newTestCertificate creates a self-signed certificate for key.
+func newTestCertificate(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	return der
+}
+
+// testResponse describes a response built by the test identity provider.
+type testResponse struct {
+	destination   string
+	inResponseTo  string
+	issuer        string
+	status        string
+	recipient     string
+	audience      string
+	notOnOrAfter  time.Time
+	signAssertion bool
+	signResponse  bool
+}
+
+func defaultTestResponse() testResponse {
+	return testResponse{
+		destination:   testACSURL,
+		inResponseTo:  testRequestID,
+		issuer:        testIDPEntityID,
+		status:        statusSuccess,
+		recipient:     testACSURL,
+		audience:      testSPEntityID,
+		notOnOrAfter:  time.Now().Add(5 * time.Minute),
+		signAssertion: true,
+	}
+}
+
+// build creates the response and returns it base64 encoded for the POST binding.
+func (s *SAMLAuthnServiceTestSuite) build(r testResponse) string {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	signer := &xmldsig.Signer{CryptoProvider: s.mockCrypto, KeyID: testIDPKeyID, Algorithm: "ES256"}
+
+	response := xmldsig.NewElement(prefixProtocol, "Response")
+	response.DeclareNamespace(prefixProtocol, namespaceProtocol)
+	response.DeclareNamespace(prefixAssertion, namespaceAssertion)
+	response.SetAttr("ID", newID()).
+		SetAttr("Version", samlVersion).
+		SetAttr("IssueInstant", now.Format(time.RFC3339)).
+		SetAttr("Destination", r.destination).
+		SetAttr("InResponseTo", r.inResponseTo)
+	response.AddChild(xmldsig.NewElement(prefixAssertion, "Issuer")).SetText(r.issuer)
+	response.AddChild(xmldsig.NewElement(prefixProtocol, "Status")).
+		AddChild(xmldsig.NewElement(prefixProtocol, "StatusCode")).SetAttr("Value", r.status)
+
+	assertion := response.AddChild(xmldsig.NewElement(prefixAssertion, "Assertion")).
+		SetAttr("ID", newID()).
+		SetAttr("Version", samlVersion).
+		SetAttr("IssueInstant", now.Format(time.RFC3339))
+	assertion.AddChild(xmldsig.NewElement(prefixAssertion, "Issuer")).SetText(r.issuer)
+	subject := assertion.AddChild(xmldsig.NewElement(prefixAssertion, "Subject"))
+	subject.AddChild(xmldsig.NewElement(prefixAssertion, "NameID")).SetText(testNameID)
+	subject.AddChild(xmldsig.NewElement(prefixAssertion, "SubjectConfirmation")).
+		SetAttr("Method", confirmationMethodBearer).
+		AddChild(xmldsig.NewElement(prefixAssertion, "SubjectConfirmationData")).
+		SetAttr("InResponseTo", r.inResponseTo).
+		SetAttr("Recipient", r.recipient).
+		SetAttr("NotOnOrAfter", r.notOnOrAfter.UTC().Format(time.RFC3339))
+	conditions := assertion.AddChild(xmldsig.NewElement(prefixAssertion, "Conditions")).
+		SetAttr("NotBefore", now.Add(-time.Minute).Format(time.RFC3339)).
+		SetAttr("NotOnOrAfter", r.notOnOrAfter.UTC().Format(time.RFC3339))
+	conditions.AddChild(xmldsig.NewElement(prefixAssertion, "AudienceRestriction")).
+		AddChild(xmldsig.NewElement(prefixAssertion, "Audience")).SetText(r.audience)
+	statement := assertion.AddChild(xmldsig.NewElement(prefixAssertion, "AttributeStatement"))
+	email := statement.AddChild(xmldsig.NewElement(prefixAssertion, "Attribute")).SetAttr("Name", testAttributeName)
+	email.AddChild(xmldsig.NewElement(prefixAssertion, "AttributeValue")).SetText(testNameID)
+	groups := statement.AddChild(xmldsig.NewElement(prefixAssertion, "Attribute")).SetAttr("Name", "groups")
+	groups.AddChild(xmldsig.NewElement(prefixAssertion, "AttributeValue")).SetText("admins")
+	groups.AddChild(xmldsig.NewElement(prefixAssertion, "AttributeValue")).SetText("staff")
+
+	if r.signAssertion {
+		s.Require().NoError(signer.Sign(ctx, assertion, 1))
+	}
+	if r.signResponse {
+		s.Require().NoError(signer.Sign(ctx, response, 1))
+	}
+	return base64.StdEncoding.EncodeToString(response.Bytes())
+}
+
+// accept hands a response to the service as the assertion consumer service would and returns its handle.
+func (s *SAMLAuthnServiceTestSuite) accept(samlResponse string) string {
+	redirectURL, svcErr := s.service.AcceptResponse(context.Background(), testIDPID, samlResponse, "state-1")
+	s.Require().Nil(svcErr)
+	parsed, err := url.Parse(redirectURL)
+	s.Require().NoError(err)
+	return parsed.Query().Get(paramCode)
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestBuildAuthnRequestURL_Unsigned() {
+	authnURL, metadata, svcErr := s.service.BuildAuthnRequestURL(context.Background(), testIDPID)
+
+	s.Require().Nil(svcErr)
+	s.True(strings.HasPrefix(authnURL, testSSOURL+"?"))
+	parsed, err := url.Parse(authnURL)
+	s.Require().NoError(err)
+	query := parsed.Query()
+	s.Equal(metadata[MetadataKeyState], query.Get(paramRelayState))
+	s.Empty(query.Get(paramSignature))
+
+	request := s.decodeRequest(query.Get(paramSAMLRequest))
+	s.Equal(metadata[MetadataKeyRequestID], request.AttrValue("ID"))
+	s.Equal(testSSOURL, request.AttrValue("Destination"))
+	s.Equal(testACSURL, request.AttrValue("AssertionConsumerServiceURL"))
+	s.Equal(bindingHTTPPost, request.AttrValue("ProtocolBinding"))
+	s.Equal(testSPEntityID, request.FindChild(namespaceAssertion, "Issuer").Text())
+	s.Equal(testNameIDFormat, request.FindChild(namespaceProtocol, "NameIDPolicy").AttrValue("Format"))
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestBuildAuthnRequestURL_Signed() {
+	s.signRequests = true
+
+	authnURL, _, svcErr := s.service.BuildAuthnRequestURL(context.Background(), testIDPID)
+
+	s.Require().Nil(svcErr)
+	rawQuery := authnURL[strings.Index(authnURL, "?")+1:]
+	signed, encodedSignature, found := strings.Cut(rawQuery, "&"+paramSignature+"=")
+	s.Require().True(found)
+	signatureB64, err := url.QueryUnescape(encodedSignature)
+	s.Require().NoError(err)
+	signature, err := base64.StdEncoding.DecodeString(signatureB64)
+	s.Require().NoError(err)
+	s.NoError(cryptolib.Verify([]byte(signed), signature, cryptolib.ECDSASHA256, &s.spKey.PublicKey))
+	s.Contains(signed, "&"+paramSigAlg+"=")
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestBuildAuthnRequestURL_SigningWithoutKey() {
+	s.signRequests = true
+	cfg := testServiceConfig()
+	cfg.SigningKeyID = ""
+	service := newSAMLAuthnService(cfg, s.mockOAuthService, s.mockIDPService, s.mockCrypto, s.runtimeStore)
+
+	_, _, svcErr := service.BuildAuthnRequestURL(context.Background(), testIDPID)
+
+	s.Require().NotNil(svcErr)
+	s.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestBuildAuthnRequestURL_InvalidIDP() {
+	_, _, svcErr := s.service.BuildAuthnRequestURL(context.Background(), " ")
+	s.Equal(&ErrorEmptyIdpID, svcErr)
+
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, "oidc-idp").
+		Return(&providers.IDPDTO{ID: "oidc-idp", Type: providers.IDPTypeOIDC}, nil)
+	_, _, svcErr = s.service.BuildAuthnRequestURL(context.Background(), "oidc-idp")
+	s.Equal(&ErrorInvalidIDP, svcErr)
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestAcceptResponse_RedirectsToRedirectURI() {
+	redirectURL, svcErr := s.service.AcceptResponse(context.Background(), testIDPID, "PHJlc3BvbnNlLz4=", "state-1")
+
+	s.Require().Nil(svcErr)
+	parsed, err := url.Parse(redirectURL)
+	s.Require().NoError(err)
+	s.Equal(testRedirectURI, parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	s.Equal("state-1", parsed.Query().Get(paramState))
+	s.NotEmpty(parsed.Query().Get(paramCode))
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestAcceptResponse_EmptyResponse() {
+	_, svcErr := s.service.AcceptResponse(context.Background(), testIDPID, "", "state-1")
+
+	s.Equal(&ErrorInvalidResponse, svcErr)
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestAuthenticate_Success() {
+	handle := s.accept(s.build(defaultTestResponse()))
+	expected := &authncm.AuthnResult{Token: map[string]interface{}{"sub": testNameID}}
+	s.mockOAuthService.EXPECT().BuildFederatedAuthResult(mock.Anything, testIDPID, testNameID,
+		map[string]interface{}{
+			"sub":             testNameID,
+			testAttributeName: testNameID,
+			"groups":          []string{"admins", "staff"},
+		}).Return(expected, nil)
+
+	result, svcErr := s.service.Authenticate(context.Background(), testIDPID,
+		authncm.AuthorizationData{Code: handle, RequestID: testRequestID})
+
+	s.Nil(svcErr)
+	s.Equal(expected, result)
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestAuthenticate_SignedResponseOnly() {
+	r := defaultTestResponse()
+	r.signAssertion = false
+	r.signResponse = true
+	handle := s.accept(s.build(r))
+	s.mockOAuthService.EXPECT().BuildFederatedAuthResult(mock.Anything, testIDPID, testNameID, mock.Anything).
+		Return(&authncm.AuthnResult{}, nil)
+
+	_, svcErr := s.service.Authenticate(context.Background(), testIDPID,
+		authncm.AuthorizationData{Code: handle, RequestID: testRequestID})
+
+	s.Nil(svcErr)
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestAuthenticate_HandleIsSingleUse() {
+	handle := s.accept(s.build(defaultTestResponse()))
+	s.mockOAuthService.EXPECT().BuildFederatedAuthResult(mock.Anything, testIDPID, testNameID, mock.Anything).
+		Return(&authncm.AuthnResult{}, nil).Once()
+	authzData := authncm.AuthorizationData{Code: handle, RequestID: testRequestID}
+
+	_, svcErr := s.service.Authenticate(context.Background(), testIDPID, authzData)
+	s.Require().Nil(svcErr)
+	_, svcErr = s.service.Authenticate(context.Background(), testIDPID, authzData)
+	s.Equal(&ErrorResponseNotFound, svcErr)
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestAuthenticate_FailureStatus() {
+	r := defaultTestResponse()
+	r.status = "urn:oasis:names:tc:SAML:2.0:status:Responder"
+	handle := s.accept(s.build(r))
+
+	_, svcErr := s.service.Authenticate(context.Background(), testIDPID,
+		authncm.AuthorizationData{Code: handle, RequestID: testRequestID})
+
+	s.Equal(&ErrorAuthenticationFailed, svcErr)
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestAuthenticate_RejectsInvalidResponses() {
+	testCases := map[string]func(r *testResponse){
+		"Unsigned":          func(r *testResponse) { r.signAssertion = false },
+		"WrongDestination":  func(r *testResponse) { r.destination = "https://evil.example.com/acs" },
+		"WrongInResponseTo": func(r *testResponse) { r.inResponseTo = "_other" },
+		"WrongIssuer":       func(r *testResponse) { r.issuer = "https://evil.example.com" },
+		"WrongRecipient":    func(r *testResponse) { r.recipient = "https://evil.example.com/acs" },
+		"WrongAudience":     func(r *testResponse) { r.audience = "https://other-sp.example.com" },
+		"Expired":           func(r *testResponse) { r.notOnOrAfter = time.Now().Add(-10 * time.Minute) },
+	}
+	for name, modify := range testCases {
+		s.Run(name, func() {
+			r := defaultTestResponse()
+			modify(&r)
+			handle := s.accept(s.build(r))
+
+			_, svcErr := s.service.Authenticate(context.Background(), testIDPID,
+				authncm.AuthorizationData{Code: handle, RequestID: testRequestID})
+
+			s.Equal(&ErrorInvalidResponse, svcErr)
+		})
+	}
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestAuthenticate_RejectsTamperedAssertion() {
+	document, err := base64.StdEncoding.DecodeString(s.build(defaultTestResponse()))
+	s.Require().NoError(err)
+	tampered := strings.Replace(string(document), testNameID, "mallory@example.com", 1)
+	handle := s.accept(base64.StdEncoding.EncodeToString([]byte(tampered)))
+
+	_, svcErr := s.service.Authenticate(context.Background(), testIDPID,
+		authncm.AuthorizationData{Code: handle, RequestID: testRequestID})
+
+	s.Equal(&ErrorInvalidResponse, svcErr)
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestAuthenticate_MissingRequestID() {
+	handle := s.accept(s.build(defaultTestResponse()))
+
+	_, svcErr := s.service.Authenticate(context.Background(), testIDPID, authncm.AuthorizationData{Code: handle})
+
+	s.Equal(&ErrorInvalidResponse, svcErr)
+}
+
+func (s *SAMLAuthnServiceTestSuite) TestAuthenticate_ResponseForAnotherIDP() {
+	handle := s.accept(s.build(defaultTestResponse()))
+	otherIDP := s.idpDTO()
+	otherIDP.ID = "idp-2"
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, "idp-2").Return(otherIDP, nil)
+
+	_, svcErr := s.service.Authenticate(context.Background(), "idp-2",
+		authncm.AuthorizationData{Code: handle, RequestID: testRequestID})
+
+	s.Equal(&ErrorResponseNotFound, svcErr)
+}
+
+// decodeRequest inflates and parses a Redirect binding AuthnRequest.
+func (s *SAMLAuthnServiceTestSuite) decodeRequest(encoded string) *xmldsig.Element {
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	s.Require().NoError(err)
+	data, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	s.Require().NoError(err)
+	request, err := xmldsig.Parse(data)
+	s.Require().NoError(err)
+	return request
+}
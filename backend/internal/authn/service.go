@@ -27,6 +27,7 @@ import (
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	"github.com/thunder-id/thunderid/internal/notification"
 	notifcommon "github.com/thunder-id/thunderid/internal/notification/common"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
@@ -93,6 +94,7 @@ type authenticationService struct {
 	oidcService            oidc.OIDCAuthnServiceInterface
 	googleService          google.GoogleOIDCAuthnServiceInterface
 	githubService          github.GithubOAuthAuthnServiceInterface
+	lockoutService         lockout.LockoutServiceInterface
 }
 
 // newAuthenticationService creates a new instance of AuthenticationService.
@@ -109,6 +111,7 @@ func newAuthenticationService(
 	oidcAuthnSvc oidc.OIDCAuthnServiceInterface,
 	googleAuthnSvc google.GoogleOIDCAuthnServiceInterface,
 	githubAuthnSvc github.GithubOAuthAuthnServiceInterface,
+	lockoutSvc lockout.LockoutServiceInterface,
 ) AuthenticationServiceInterface {
 	return &authenticationService{
 		idpService:             idpSvc,
@@ -123,6 +126,7 @@ func newAuthenticationService(
 		oidcService:            oidcAuthnSvc,
 		googleService:          googleAuthnSvc,
 		githubService:          githubAuthnSvc,
+		lockoutService:         lockoutSvc,
 	}
 }
 
@@ -145,16 +149,34 @@ func (as *authenticationService) AuthenticateWithCredentials(ctx context.Context
 		return nil, &ErrorReservedCredentialType
 	}
 
+	lockoutEntityID := ""
+	if as.lockoutService != nil {
+		lockoutEntityID = as.lockoutService.ResolveEntityID(ctx, identifiers)
+		refusal, svcErr := as.lockoutService.CheckAttempt(ctx, lockoutEntityID)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		if refusal != nil {
+			return nil, mapLockoutError(refusal)
+		}
+	}
+
 	newAuthUser, _, svcErr := as.authnProvider.AuthenticateUser(ctx, identifiers, credentials, nil, nil,
 		providers.AuthUser{})
 	if svcErr != nil {
-		return nil, as.mapCredentialsAuthnError(ctx, svcErr, logger)
+		return nil, as.recordCredentialsFailure(ctx, as.mapCredentialsAuthnError(ctx, svcErr, logger),
+			lockoutEntityID)
 	}
 
 	newAuthUser, entityRef, svcErr := as.authnProvider.GetEntityReference(ctx, newAuthUser)
 	if svcErr != nil {
 		return nil, as.mapCredentialsGetAttributesError(ctx, svcErr, logger)
 	}
+	if as.lockoutService != nil {
+		if svcErr := as.lockoutService.RecordSuccess(ctx, entityRef.EntityID); svcErr != nil {
+			return nil, svcErr
+		}
+	}
 
 	_, attrsResponse, svcErr := as.authnProvider.GetUserAttributes(ctx, nil, nil, newAuthUser)
 	if svcErr != nil {
@@ -605,6 +627,48 @@ func (as *authenticationService) mapCredentialsAuthnError(ctx context.Context, s
 	}
 }
 
+// recordCredentialsFailure reports a failed credentials authentication to the lockout policy and returns
+// the error to respond with. Unknown users are tracked by client only; when the failure locks the
+// account or blocks the client, the refusal is returned instead of the authentication error.
+func (as *authenticationService) recordCredentialsFailure(ctx context.Context, authnErr *tidcommon.ServiceError,
+	lockoutEntityID string) *tidcommon.ServiceError {
+	if as.lockoutService == nil {
+		return authnErr
+	}
+
+	switch authnErr.Code {
+	case ErrorInvalidCredentials.Code:
+		// Tracked against both the user and the client.
+	case common.ErrorUserNotFound.Code:
+		lockoutEntityID = ""
+	default:
+		return authnErr
+	}
+
+	refusal, svcErr := as.lockoutService.RecordFailure(ctx, lockoutEntityID)
+	if svcErr != nil {
+		return svcErr
+	}
+	if refusal != nil {
+		return mapLockoutError(refusal)
+	}
+	return authnErr
+}
+
+// mapLockoutError maps an attempt refused by the lockout policy to the credentials error reporting it.
+func mapLockoutError(refusal *lockout.Lockout) *tidcommon.ServiceError {
+	switch refusal.Reason {
+	case lockout.LockReasonPermanent:
+		return &ErrorAccountLockedPermanently
+	case lockout.LockReasonTemporary:
+		return ErrorAccountLocked.WithParams(
+			map[string]string{"lockedUntil": refusal.Until.UTC().Format(time.RFC3339)})
+	default:
+		return ErrorTooManyFailedAttempts.WithParams(
+			map[string]string{"retryAfter": refusal.Until.UTC().Format(time.RFC3339)})
+	}
+}
+
 // mapCredentialsGetAttributesError maps provider manager errors from GetUserAttributes to credentials-specific errors.
 func (as *authenticationService) mapCredentialsGetAttributesError(
 	ctx context.Context, svcErr *tidcommon.ServiceError,
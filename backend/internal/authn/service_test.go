@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	notifcommon "github.com/thunder-id/thunderid/internal/notification/common"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -37,6 +39,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/lockoutmock"
 	"github.com/thunder-id/thunderid/tests/mocks/notification/notificationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/templatemock"
 )
@@ -331,6 +334,75 @@ func (suite *AuthenticationServiceTestSuite) TestAuthenticateWithCredentialsServ
 	suite.Equal(ErrorInvalidCredentials.Code, err.Code)
 }
 
+func (suite *AuthenticationServiceTestSuite) TestAuthenticateWithCredentialsAccountLocked() {
+	identifiers := map[string]interface{}{"username": "testuser"}
+	authnCredentials := map[string]interface{}{"password": "testpass"}
+	lockedUntil := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	lockoutMock.EXPECT().ResolveEntityID(mock.Anything, identifiers).Return("user123")
+	lockoutMock.EXPECT().CheckAttempt(mock.Anything, "user123").
+		Return(&lockout.Lockout{Reason: lockout.LockReasonTemporary, Until: lockedUntil}, nil)
+	suite.service.lockoutService = lockoutMock
+
+	result, err := suite.service.AuthenticateWithCredentials(context.Background(), identifiers,
+		authnCredentials, false, "")
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(ErrorAccountLocked.Code, err.Code)
+	suite.Equal("2026-01-01T10:00:00Z", err.ErrorDescription.Params["lockedUntil"])
+	suite.mockAuthnProvider.AssertNotCalled(suite.T(), "AuthenticateUser", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AuthenticationServiceTestSuite) TestAuthenticateWithCredentialsFailureLocksAccount() {
+	identifiers := map[string]interface{}{"username": "testuser"}
+	authnCredentials := map[string]interface{}{"password": "wrongpass"}
+
+	lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	lockoutMock.EXPECT().ResolveEntityID(mock.Anything, identifiers).Return("user123")
+	lockoutMock.EXPECT().CheckAttempt(mock.Anything, "user123").Return(nil, nil)
+	lockoutMock.EXPECT().RecordFailure(mock.Anything, "user123").
+		Return(&lockout.Lockout{Reason: lockout.LockReasonPermanent}, nil)
+	suite.service.lockoutService = lockoutMock
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, identifiers,
+		authnCredentials, mock.Anything, mock.Anything, mock.Anything).Return(
+		providers.AuthUser{}, (providers.AuthenticatedClaims)(nil),
+		&authnprovidermgr.ErrorAuthenticationFailed)
+
+	result, err := suite.service.AuthenticateWithCredentials(context.Background(), identifiers,
+		authnCredentials, false, "")
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(ErrorAccountLockedPermanently.Code, err.Code)
+}
+
+func (suite *AuthenticationServiceTestSuite) TestAuthenticateWithCredentialsUnknownUserTrackedByClient() {
+	identifiers := map[string]interface{}{"username": "unknown"}
+	authnCredentials := map[string]interface{}{"password": "testpass"}
+
+	lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	lockoutMock.EXPECT().ResolveEntityID(mock.Anything, identifiers).Return("")
+	lockoutMock.EXPECT().CheckAttempt(mock.Anything, "").Return(nil, nil)
+	lockoutMock.EXPECT().RecordFailure(mock.Anything, "").Return(nil, nil)
+	suite.service.lockoutService = lockoutMock
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, identifiers,
+		authnCredentials, mock.Anything, mock.Anything, mock.Anything).Return(
+		providers.AuthUser{}, (providers.AuthenticatedClaims)(nil),
+		&authnprovidermgr.ErrorUserNotFound)
+
+	result, err := suite.service.AuthenticateWithCredentials(context.Background(), identifiers,
+		authnCredentials, false, "")
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(common.ErrorUserNotFound.Code, err.Code)
+}
+
 func (suite *AuthenticationServiceTestSuite) TestAuthenticateWithCredentialsJWTGenerationError() {
 	identifiers := map[string]interface{}{
 		"username": "testuser",
@@ -2460,6 +2532,7 @@ func (suite *AuthenticationServiceTestSuite) TestNewAuthenticationService() {
 		suite.mockOIDCService,
 		suite.mockGoogleService,
 		suite.mockGithubService,
+		nil,
 	)
 	suite.NotNil(svc)
 }
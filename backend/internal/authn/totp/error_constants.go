@@ -0,0 +1,90 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for the TOTP authentication service.
+var (
+	// ErrorInvalidEntityID is the error returned when the provided entity ID is empty.
+	ErrorInvalidEntityID = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTHN-TOTP-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.invalid_entity_id",
+			DefaultValue: "Invalid entity ID",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.invalid_entity_id_description",
+			DefaultValue: "The provided entity ID is invalid or empty",
+		},
+	}
+	// ErrorInvalidCode is the error returned when the provided code is empty.
+	ErrorInvalidCode = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTHN-TOTP-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.invalid_code",
+			DefaultValue: "Invalid code",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.invalid_code_description",
+			DefaultValue: "The provided code is invalid or empty",
+		},
+	}
+	// ErrorIncorrectCode is the error returned when the provided code does not match.
+	ErrorIncorrectCode = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTHN-TOTP-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.incorrect_code",
+			DefaultValue: "Incorrect code",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.incorrect_code_description",
+			DefaultValue: "The provided code is incorrect, expired, or has already been used",
+		},
+	}
+	// ErrorNotEnrolled is the error returned when the entity has no authenticator app enrolled.
+	ErrorNotEnrolled = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTHN-TOTP-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.not_enrolled",
+			DefaultValue: "Authenticator app not enrolled",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.not_enrolled_description",
+			DefaultValue: "No authenticator app is enrolled for the user",
+		},
+	}
+	// ErrorInvalidSecret is the error returned when the enrollment secret is malformed.
+	ErrorInvalidSecret = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTHN-TOTP-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.invalid_secret",
+			DefaultValue: "Invalid secret",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.invalid_secret_description",
+			DefaultValue: "The enrollment secret is invalid",
+		},
+	}
+	// ErrorEntityNotFound is the error returned when the entity does not exist.
+	ErrorEntityNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTHN-TOTP-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.entity_not_found",
+			DefaultValue: "Entity not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authntotpservice.entity_not_found_description",
+			DefaultValue: "No entity exists for the provided entity ID",
+		},
+	}
+)
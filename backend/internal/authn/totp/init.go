@@ -0,0 +1,29 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize validates the TOTP configuration and initializes the TOTP authentication service.
+func Initialize(entityProvider entityprovider.EntityProviderInterface,
+	hashService cryptolib.HashServiceInterface, cryptoSvc providers.RuntimeCryptoProvider,
+	storeProvider providers.RuntimeStoreProvider) (TOTPAuthnServiceInterface, error) {
+	totpCfg := config.GetServerRuntime().Config.TOTP
+	if err := totpCfg.Validate(); err != nil {
+		return nil, err
+	}
+	cfg := Config{
+		Issuer:            totpCfg.Issuer,
+		Digits:            totpCfg.Digits,
+		PeriodSeconds:     totpCfg.PeriodSeconds,
+		Skew:              totpCfg.Skew,
+		RecoveryCodeCount: totpCfg.RecoveryCodeCount,
+	}
+	return newTOTPAuthnService(cfg, entityProvider, hashService, cryptoSvc, storeProvider), nil
+}
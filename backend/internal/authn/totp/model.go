@@ -0,0 +1,37 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"github.com/thunder-id/thunderid/internal/entity"
+)
+
+// Config holds the TOTP parameters shared by enrollment and verification.
+type Config struct {
+	Issuer            string
+	Digits            int
+	PeriodSeconds     int
+	Skew              int
+	RecoveryCodeCount int
+}
+
+// EnrollmentData is the pending enrollment handed to the user. The secret is not stored until
+// FinishEnrollment proves the user's authenticator produces matching codes.
+type EnrollmentData struct {
+	Secret string
+	KeyURI string
+}
+
+// storedTOTPCredential is the value stored under the TOTP system credential. Recovery codes are
+// kept alongside the secret so a code and its consumption are written in a single update.
+type storedTOTPCredential struct {
+	// EncryptedSecret is the secret encrypted with the runtime crypto provider. Secret holds its
+	// plaintext once loaded and is never written to the store.
+	EncryptedSecret string `json:"encryptedSecret"`
+	Secret          string `json:"-"`
+	// LastUsedStep is the time step of the last accepted code. Codes for this or any earlier step
+	// are rejected.
+	LastUsedStep  int64                     `json:"lastUsedStep"`
+	RecoveryCodes []entity.StoredCredential `json:"recoveryCodes,omitempty"`
+}
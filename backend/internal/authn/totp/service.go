@@ -0,0 +1,374 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package totp implements the authenticator app (TOTP, RFC 6238) authentication service.
+package totp
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/authn/common"
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const (
+	loggerComponentName = "TOTPAuthnService"
+	// credentialLockTTLSeconds bounds how long a request that dies mid-update keeps the credential locked.
+	credentialLockTTLSeconds = 10
+)
+
+// TOTPAuthnServiceInterface defines the interface for authenticator app enrollment and authentication.
+type TOTPAuthnServiceInterface interface {
+	// StartEnrollment generates a new secret and the otpauth:// URI for the authenticator app. Nothing
+	// is stored until FinishEnrollment succeeds.
+	StartEnrollment(ctx context.Context, accountName string) (*EnrollmentData, *tidcommon.ServiceError)
+	// FinishEnrollment verifies a code produced from secret, stores the secret for the entity, and
+	// returns a fresh set of plaintext recovery codes. Any previous enrollment is replaced.
+	FinishEnrollment(ctx context.Context, entityID, secret, code string) ([]string, *tidcommon.ServiceError)
+	// IsEnrolled reports whether the entity has an authenticator app enrolled.
+	IsEnrolled(ctx context.Context, entityID string) (bool, *tidcommon.ServiceError)
+	// Authenticate verifies a code from the entity's authenticator app.
+	Authenticate(ctx context.Context, entityID, code string) (*common.AuthnResult, *tidcommon.ServiceError)
+	// AuthenticateWithRecoveryCode verifies and consumes one of the entity's recovery codes.
+	AuthenticateWithRecoveryCode(ctx context.Context, entityID, recoveryCode string) (
+		*common.AuthnResult, *tidcommon.ServiceError)
+}
+
+// totpAuthnService is the default implementation of TOTPAuthnServiceInterface.
+type totpAuthnService struct {
+	cfg            Config
+	entityProvider entityprovider.EntityProviderInterface
+	hashService    cryptolib.HashServiceInterface
+	cryptoSvc      providers.RuntimeCryptoProvider
+	storeProvider  providers.RuntimeStoreProvider
+	now            func() time.Time
+	logger         *log.Logger
+}
+
+// newTOTPAuthnService creates a new instance of the TOTP authentication service.
+func newTOTPAuthnService(cfg Config, entityProvider entityprovider.EntityProviderInterface,
+	hashService cryptolib.HashServiceInterface, cryptoSvc providers.RuntimeCryptoProvider,
+	storeProvider providers.RuntimeStoreProvider) TOTPAuthnServiceInterface {
+	return &totpAuthnService{
+		cfg:            cfg,
+		entityProvider: entityProvider,
+		hashService:    hashService,
+		cryptoSvc:      cryptoSvc,
+		storeProvider:  storeProvider,
+		now:            time.Now,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// StartEnrollment generates a new secret and builds the key URI for it.
+func (s *totpAuthnService) StartEnrollment(ctx context.Context,
+	accountName string) (*EnrollmentData, *tidcommon.ServiceError) {
+	secret, err := generateSecret()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate TOTP secret", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return &EnrollmentData{
+		Secret: secret,
+		KeyURI: buildKeyURI(s.cfg.Issuer, accountName, secret, s.cfg.Digits, s.cfg.PeriodSeconds),
+	}, nil
+}
+
+// FinishEnrollment activates the secret once the user proves their authenticator holds it.
+func (s *totpAuthnService) FinishEnrollment(ctx context.Context,
+	entityID, secret, code string) ([]string, *tidcommon.ServiceError) {
+	if strings.TrimSpace(entityID) == "" {
+		return nil, &ErrorInvalidEntityID
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, &ErrorInvalidCode
+	}
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return nil, &ErrorInvalidSecret
+	}
+
+	step, ok := matchCode(key, code, s.currentStep(), -1, s.cfg.Skew, s.cfg.Digits)
+	if !ok {
+		return nil, &ErrorIncorrectCode
+	}
+
+	plaintextCodes, hashedCodes, svcErr := s.generateRecoveryCodes(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	encryptedSecret, _, err := s.cryptoSvc.Encrypt(ctx, nil, string(cryptolib.AlgorithmAESGCM), nil, []byte(secret))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to encrypt TOTP secret", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	// The enrollment code counts as used so it cannot be replayed to authenticate.
+	stored := &storedTOTPCredential{
+		EncryptedSecret: string(encryptedSecret),
+		LastUsedStep:    step,
+		RecoveryCodes:   hashedCodes,
+	}
+	svcErr = s.withCredentialLock(ctx, entityID, func() *tidcommon.ServiceError {
+		return s.storeCredential(ctx, entityID, stored)
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	s.logger.Debug(ctx, "Authenticator app enrolled", log.MaskedString("entityID", entityID))
+	return plaintextCodes, nil
+}
+
+// IsEnrolled reports whether a TOTP credential is stored for the entity.
+func (s *totpAuthnService) IsEnrolled(ctx context.Context, entityID string) (bool, *tidcommon.ServiceError) {
+	if strings.TrimSpace(entityID) == "" {
+		return false, &ErrorInvalidEntityID
+	}
+	stored, svcErr := s.loadCredential(ctx, entityID)
+	if svcErr != nil {
+		return false, svcErr
+	}
+	return stored != nil, nil
+}
+
+// Authenticate verifies a TOTP code within the configured drift window and records its time step.
+func (s *totpAuthnService) Authenticate(ctx context.Context,
+	entityID, code string) (*common.AuthnResult, *tidcommon.ServiceError) {
+	if strings.TrimSpace(entityID) == "" {
+		return nil, &ErrorInvalidEntityID
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, &ErrorInvalidCode
+	}
+
+	svcErr := s.withCredentialLock(ctx, entityID, func() *tidcommon.ServiceError {
+		stored, svcErr := s.loadCredential(ctx, entityID)
+		if svcErr != nil {
+			return svcErr
+		}
+		if stored == nil {
+			return &ErrorNotEnrolled
+		}
+		key, err := decodeSecret(stored.Secret)
+		if err != nil {
+			s.logger.Error(ctx, "Stored TOTP secret is invalid", log.MaskedString("entityID", entityID))
+			return &tidcommon.InternalServerError
+		}
+
+		step, ok := matchCode(key, code, s.currentStep(), stored.LastUsedStep, s.cfg.Skew, s.cfg.Digits)
+		if !ok {
+			s.logger.Debug(ctx, "TOTP code rejected", log.MaskedString("entityID", entityID))
+			return &ErrorIncorrectCode
+		}
+
+		stored.LastUsedStep = step
+		return s.storeCredential(ctx, entityID, stored)
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return newAuthnResult(entityID), nil
+}
+
+// AuthenticateWithRecoveryCode verifies a recovery code against the stored hashes and removes it,
+// so each code can be used only once.
+func (s *totpAuthnService) AuthenticateWithRecoveryCode(ctx context.Context,
+	entityID, recoveryCode string) (*common.AuthnResult, *tidcommon.ServiceError) {
+	if strings.TrimSpace(entityID) == "" {
+		return nil, &ErrorInvalidEntityID
+	}
+	normalized := normalizeRecoveryCode(recoveryCode)
+	if normalized == "" {
+		return nil, &ErrorInvalidCode
+	}
+
+	svcErr := s.withCredentialLock(ctx, entityID, func() *tidcommon.ServiceError {
+		stored, svcErr := s.loadCredential(ctx, entityID)
+		if svcErr != nil {
+			return svcErr
+		}
+		if stored == nil {
+			return &ErrorNotEnrolled
+		}
+
+		for i, hashed := range stored.RecoveryCodes {
+			matched, err := s.hashService.Verify([]byte(normalized), cryptolib.Credential{
+				Algorithm:  hashed.StorageAlgo,
+				Hash:       hashed.Value,
+				Parameters: hashed.StorageAlgoParams,
+			})
+			if err != nil {
+				s.logger.Error(ctx, "Failed to verify recovery code", log.Error(err))
+				return &tidcommon.InternalServerError
+			}
+			if !matched {
+				continue
+			}
+
+			stored.RecoveryCodes = slices.Delete(stored.RecoveryCodes, i, i+1)
+			if svcErr := s.storeCredential(ctx, entityID, stored); svcErr != nil {
+				return svcErr
+			}
+			s.logger.Debug(ctx, "Recovery code consumed", log.MaskedString("entityID", entityID),
+				log.Int("remaining", len(stored.RecoveryCodes)))
+			return nil
+		}
+
+		s.logger.Debug(ctx, "Recovery code rejected", log.MaskedString("entityID", entityID))
+		return &ErrorIncorrectCode
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return newAuthnResult(entityID), nil
+}
+
+// withCredentialLock runs update while holding the entity's TOTP credential lock, so that reading,
+// checking, and writing the credential cannot interleave with another request for the same entity on
+// any node. Without it, two requests could both accept the same code or recovery code before either
+// records it as used. When the lock is already held, update is not run and ErrorIncorrectCode is
+// returned, since the competing request is a replay or a duplicate submission of the same code.
+func (s *totpAuthnService) withCredentialLock(ctx context.Context, entityID string,
+	update func() *tidcommon.ServiceError) *tidcommon.ServiceError {
+	locked, err := s.storeProvider.PutIfNotExists(ctx, providers.NamespaceTOTPLock, entityID, []byte("{}"),
+		credentialLockTTLSeconds)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to lock TOTP credential", log.MaskedString("entityID", entityID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if !locked {
+		s.logger.Debug(ctx, "TOTP credential is being updated by another request",
+			log.MaskedString("entityID", entityID))
+		return &ErrorIncorrectCode
+	}
+	defer func() {
+		if err := s.storeProvider.Delete(ctx, providers.NamespaceTOTPLock, entityID); err != nil {
+			s.logger.Error(ctx, "Failed to unlock TOTP credential", log.MaskedString("entityID", entityID),
+				log.Error(err))
+		}
+	}()
+
+	return update()
+}
+
+// currentStep returns the RFC 6238 time step for the current time.
+func (s *totpAuthnService) currentStep() int64 {
+	return s.now().Unix() / int64(s.cfg.PeriodSeconds)
+}
+
+// generateRecoveryCodes returns the configured number of recovery codes in plaintext, to show the
+// user once, and hashed, to store.
+func (s *totpAuthnService) generateRecoveryCodes(ctx context.Context) (
+	[]string, []entity.StoredCredential, *tidcommon.ServiceError) {
+	plaintext := make([]string, 0, s.cfg.RecoveryCodeCount)
+	hashed := make([]entity.StoredCredential, 0, s.cfg.RecoveryCodeCount)
+	for range s.cfg.RecoveryCodeCount {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			s.logger.Error(ctx, "Failed to generate recovery code", log.Error(err))
+			return nil, nil, &tidcommon.InternalServerError
+		}
+		credHash, err := s.hashService.Generate([]byte(normalizeRecoveryCode(code)))
+		if err != nil {
+			s.logger.Error(ctx, "Failed to hash recovery code", log.Error(err))
+			return nil, nil, &tidcommon.InternalServerError
+		}
+		plaintext = append(plaintext, code)
+		hashed = append(hashed, entity.StoredCredential{
+			StorageAlgo:       credHash.Algorithm,
+			StorageAlgoParams: credHash.Parameters,
+			Value:             credHash.Hash,
+		})
+	}
+	return plaintext, hashed, nil
+}
+
+// loadCredential reads the TOTP system credential of the entity and decrypts its secret. It returns nil
+// when none is stored.
+func (s *totpAuthnService) loadCredential(ctx context.Context,
+	entityID string) (*storedTOTPCredential, *tidcommon.ServiceError) {
+	credsJSON, epErr := s.entityProvider.GetCredentials(entityID, authnprovidercm.CredentialTypeTOTP)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return nil, &ErrorEntityNotFound
+		}
+		s.logger.Error(ctx, "Failed to load TOTP credential", log.MaskedString("entityID", entityID),
+			log.String("error", epErr.Error()))
+		return nil, &tidcommon.InternalServerError
+	}
+	if len(credsJSON) == 0 {
+		return nil, nil
+	}
+
+	var entries []entity.StoredCredential
+	if err := json.Unmarshal(credsJSON, &entries); err != nil || len(entries) == 0 || entries[0].Value == "" {
+		s.logger.Error(ctx, "Stored TOTP credential is malformed", log.MaskedString("entityID", entityID))
+		return nil, &tidcommon.InternalServerError
+	}
+	var stored storedTOTPCredential
+	if err := json.Unmarshal([]byte(entries[0].Value), &stored); err != nil || stored.EncryptedSecret == "" {
+		s.logger.Error(ctx, "Stored TOTP credential is malformed", log.MaskedString("entityID", entityID))
+		return nil, &tidcommon.InternalServerError
+	}
+	secret, err := s.cryptoSvc.Decrypt(ctx, nil, string(cryptolib.AlgorithmAESGCM), nil,
+		[]byte(stored.EncryptedSecret))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to decrypt TOTP secret", log.MaskedString("entityID", entityID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	stored.Secret = string(secret)
+	return &stored, nil
+}
+
+// storeCredential writes the TOTP system credential of the entity. The value is passed in stored form
+// so the entity layer keeps it as is instead of hashing it like a password.
+func (s *totpAuthnService) storeCredential(ctx context.Context, entityID string,
+	stored *storedTOTPCredential) *tidcommon.ServiceError {
+	value, err := json.Marshal(stored)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to marshal TOTP credential", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	payload, err := json.Marshal(map[string][]entity.StoredCredential{
+		authnprovidercm.CredentialTypeTOTP: {{Value: string(value)}},
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to marshal TOTP credential", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	if epErr := s.entityProvider.UpdateSystemCredentials(entityID, payload); epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return &ErrorEntityNotFound
+		}
+		s.logger.Error(ctx, "Failed to store TOTP credential", log.MaskedString("entityID", entityID),
+			log.String("error", epErr.Error()))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
+// newAuthnResult builds the authentication result for an entity identified by its ID.
+func newAuthnResult(entityID string) *common.AuthnResult {
+	return &common.AuthnResult{
+		Token:               map[string]interface{}{authnprovidercm.UserAttributeUserID: entityID},
+		AuthenticatedClaims: map[string]interface{}{authnprovidercm.UserAttributeUserID: entityID},
+	}
+}
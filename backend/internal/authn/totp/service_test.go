@@ -0,0 +1,374 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+)
+
+const (
+	testEntityID = "user-123"
+	// testSecret is the base32 form of the RFC 6238 SHA1 seed.
+	testSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	// encryptedPrefix marks content "encrypted" by the crypto provider mock.
+	encryptedPrefix     = "encrypted:"
+	testEncryptedSecret = encryptedPrefix + testSecret
+)
+
+var testNow = time.Unix(1_700_000_000, 0)
+
+type TOTPServiceTestSuite struct {
+	suite.Suite
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	hashService        cryptolib.HashServiceInterface
+	mockCryptoSvc      *cryptomock.RuntimeCryptoProviderMock
+	storeProvider      providers.RuntimeStoreProvider
+	service            *totpAuthnService
+}
+
+func TestTOTPServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TOTPServiceTestSuite))
+}
+
+func (s *TOTPServiceTestSuite) SetupTest() {
+	s.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(s.T())
+	hashService, err := cryptolib.Initialize(cryptolib.HashConfig{Algorithm: cryptolib.SHA256, SaltSize: 16})
+	s.Require().NoError(err)
+	s.hashService = hashService
+
+	s.storeProvider = inmemory.Initialize("test-deployment")
+
+	s.mockCryptoSvc = cryptomock.NewRuntimeCryptoProviderMock(s.T())
+	s.mockCryptoSvc.EXPECT().Encrypt(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ *providers.KeyRef, _ string, _ map[string]interface{},
+			content []byte) ([]byte, *providers.CryptoDetails, error) {
+			return []byte(encryptedPrefix + string(content)), nil, nil
+		}).Maybe()
+	s.mockCryptoSvc.EXPECT().Decrypt(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ *providers.KeyRef, _ string, _ map[string]interface{},
+			content []byte) ([]byte, error) {
+			plaintext, ok := strings.CutPrefix(string(content), encryptedPrefix)
+			if !ok {
+				return nil, errors.New("decryption failed")
+			}
+			return []byte(plaintext), nil
+		}).Maybe()
+
+	cfg := Config{Issuer: "ThunderID", Digits: 6, PeriodSeconds: 30, Skew: 1, RecoveryCodeCount: 3}
+	s.service = newTOTPAuthnService(cfg, s.mockEntityProvider, hashService, s.mockCryptoSvc,
+		s.storeProvider).(*totpAuthnService)
+	s.service.now = func() time.Time { return testNow }
+}
+
+func (s *TOTPServiceTestSuite) codeAt(offset int64) string {
+	key, err := decodeSecret(testSecret)
+	s.Require().NoError(err)
+	return generateCode(key, s.service.currentStep()+offset, 6)
+}
+
+// storedJSON returns the stored-credential JSON the entity provider would return for stored.
+func (s *TOTPServiceTestSuite) storedJSON(stored storedTOTPCredential) json.RawMessage {
+	value, err := json.Marshal(stored)
+	s.Require().NoError(err)
+	out, err := json.Marshal([]entity.StoredCredential{{Value: string(value)}})
+	s.Require().NoError(err)
+	return out
+}
+
+// decodeUpdate extracts the TOTP credential from a system credential update payload.
+func (s *TOTPServiceTestSuite) decodeUpdate(payload json.RawMessage) storedTOTPCredential {
+	var update map[string][]entity.StoredCredential
+	s.Require().NoError(json.Unmarshal(payload, &update))
+	entries := update[authnprovidercm.CredentialTypeTOTP]
+	s.Require().Len(entries, 1)
+	var stored storedTOTPCredential
+	s.Require().NoError(json.Unmarshal([]byte(entries[0].Value), &stored))
+	return stored
+}
+
+// backCredentialWith makes the entity provider mock read and write the TOTP credential like a store
+// that starts out holding stored. Reads are slowed down so concurrent requests overlap.
+func (s *TOTPServiceTestSuite) backCredentialWith(stored storedTOTPCredential) {
+	var mu sync.Mutex
+	current := s.storedJSON(stored)
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		RunAndReturn(func(string, string) (json.RawMessage, *entityprovider.EntityProviderError) {
+			mu.Lock()
+			value := current
+			mu.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			return value, nil
+		}).Maybe()
+	s.mockEntityProvider.EXPECT().UpdateSystemCredentials(testEntityID, mock.Anything).
+		RunAndReturn(func(_ string, credentials json.RawMessage) *entityprovider.EntityProviderError {
+			updated := s.decodeUpdate(credentials)
+			mu.Lock()
+			current = s.storedJSON(updated)
+			mu.Unlock()
+			return nil
+		}).Maybe()
+}
+
+// countConcurrentSuccesses runs attempt from several goroutines at once and returns how many succeeded.
+func countConcurrentSuccesses(attempt func() *tidcommon.ServiceError) int {
+	const attempts = 8
+	var succeeded atomic.Int32
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for range attempts {
+		wg.Go(func() {
+			<-start
+			if attempt() == nil {
+				succeeded.Add(1)
+			}
+		})
+	}
+	close(start)
+	wg.Wait()
+	return int(succeeded.Load())
+}
+
+func (s *TOTPServiceTestSuite) hashedCode(code string) entity.StoredCredential {
+	credHash, err := s.hashService.Generate([]byte(normalizeRecoveryCode(code)))
+	s.Require().NoError(err)
+	return entity.StoredCredential{
+		StorageAlgo:       credHash.Algorithm,
+		StorageAlgoParams: credHash.Parameters,
+		Value:             credHash.Hash,
+	}
+}
+
+func (s *TOTPServiceTestSuite) TestStartEnrollment() {
+	data, svcErr := s.service.StartEnrollment(s.T().Context(), "alice")
+
+	s.Nil(svcErr)
+	s.NotEmpty(data.Secret)
+	s.Contains(data.KeyURI, "otpauth://totp/ThunderID:alice?")
+	s.Contains(data.KeyURI, "secret="+data.Secret)
+}
+
+func (s *TOTPServiceTestSuite) TestFinishEnrollment() {
+	var captured json.RawMessage
+	s.mockEntityProvider.EXPECT().UpdateSystemCredentials(testEntityID, mock.Anything).
+		Run(func(_ string, credentials json.RawMessage) { captured = credentials }).Return(nil)
+
+	codes, svcErr := s.service.FinishEnrollment(s.T().Context(), testEntityID, testSecret, s.codeAt(0))
+
+	s.Nil(svcErr)
+	s.Len(codes, 3)
+	stored := s.decodeUpdate(captured)
+	s.Equal(testEncryptedSecret, stored.EncryptedSecret)
+	s.Equal(s.service.currentStep(), stored.LastUsedStep)
+	s.Len(stored.RecoveryCodes, 3)
+	for i, hashed := range stored.RecoveryCodes {
+		s.NotEqual(normalizeRecoveryCode(codes[i]), hashed.Value)
+		ok, err := s.hashService.Verify([]byte(normalizeRecoveryCode(codes[i])), cryptolib.Credential{
+			Algorithm: hashed.StorageAlgo, Hash: hashed.Value, Parameters: hashed.StorageAlgoParams,
+		})
+		s.NoError(err)
+		s.True(ok)
+	}
+}
+
+func (s *TOTPServiceTestSuite) TestFinishEnrollment_Errors() {
+	cases := []struct {
+		name   string
+		entity string
+		secret string
+		code   string
+		want   tidcommon.ServiceError
+	}{
+		{"MissingEntity", "", testSecret, "123456", ErrorInvalidEntityID},
+		{"MissingCode", testEntityID, testSecret, " ", ErrorInvalidCode},
+		{"InvalidSecret", testEntityID, "!!", "123456", ErrorInvalidSecret},
+		{"IncorrectCode", testEntityID, testSecret, "000000", ErrorIncorrectCode},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			codes, svcErr := s.service.FinishEnrollment(s.T().Context(), tc.entity, tc.secret, tc.code)
+			s.Nil(codes)
+			s.Require().NotNil(svcErr)
+			s.Equal(tc.want.Code, svcErr.Code)
+		})
+	}
+}
+
+func (s *TOTPServiceTestSuite) TestIsEnrolled() {
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		Return(s.storedJSON(storedTOTPCredential{EncryptedSecret: testEncryptedSecret}), nil).Once()
+	enrolled, svcErr := s.service.IsEnrolled(s.T().Context(), testEntityID)
+	s.Nil(svcErr)
+	s.True(enrolled)
+
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		Return(nil, nil).Once()
+	enrolled, svcErr = s.service.IsEnrolled(s.T().Context(), testEntityID)
+	s.Nil(svcErr)
+	s.False(enrolled)
+
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", "")).Once()
+	_, svcErr = s.service.IsEnrolled(s.T().Context(), testEntityID)
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorEntityNotFound.Code, svcErr.Code)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticate_AcceptsDriftAndRecordsStep() {
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		Return(s.storedJSON(storedTOTPCredential{EncryptedSecret: testEncryptedSecret, LastUsedStep: 1}), nil)
+	var captured json.RawMessage
+	s.mockEntityProvider.EXPECT().UpdateSystemCredentials(testEntityID, mock.Anything).
+		Run(func(_ string, credentials json.RawMessage) { captured = credentials }).Return(nil)
+
+	result, svcErr := s.service.Authenticate(s.T().Context(), testEntityID, s.codeAt(-1))
+
+	s.Nil(svcErr)
+	s.Equal(testEntityID, result.Token[authnprovidercm.UserAttributeUserID])
+	s.Equal(s.service.currentStep()-1, s.decodeUpdate(captured).LastUsedStep)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticate_RejectsReplay() {
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		Return(s.storedJSON(storedTOTPCredential{EncryptedSecret: testEncryptedSecret, LastUsedStep: s.service.currentStep()}), nil)
+
+	result, svcErr := s.service.Authenticate(s.T().Context(), testEntityID, s.codeAt(0))
+
+	s.Nil(result)
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorIncorrectCode.Code, svcErr.Code)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticate_ConcurrentReplayAcceptedOnce() {
+	s.backCredentialWith(storedTOTPCredential{EncryptedSecret: testEncryptedSecret, LastUsedStep: 1})
+	code := s.codeAt(0)
+
+	succeeded := countConcurrentSuccesses(func() *tidcommon.ServiceError {
+		_, svcErr := s.service.Authenticate(s.T().Context(), testEntityID, code)
+		return svcErr
+	})
+
+	s.Equal(1, succeeded)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticate_RejectsWhileCredentialLocked() {
+	locked, err := s.storeProvider.PutIfNotExists(s.T().Context(), providers.NamespaceTOTPLock, testEntityID,
+		[]byte("{}"), credentialLockTTLSeconds)
+	s.Require().NoError(err)
+	s.Require().True(locked)
+
+	_, svcErr := s.service.Authenticate(s.T().Context(), testEntityID, s.codeAt(0))
+
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorIncorrectCode.Code, svcErr.Code)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticate_NotEnrolled() {
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		Return(nil, nil)
+
+	_, svcErr := s.service.Authenticate(s.T().Context(), testEntityID, "123456")
+
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorNotEnrolled.Code, svcErr.Code)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticate_MalformedCredential() {
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		Return(json.RawMessage(`[{"value":"not-json"}]`), nil)
+
+	_, svcErr := s.service.Authenticate(s.T().Context(), testEntityID, "123456")
+
+	s.Require().NotNil(svcErr)
+	s.Equal(tidcommon.ServerErrorType, svcErr.Type)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticate_UndecryptableSecret() {
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		Return(s.storedJSON(storedTOTPCredential{EncryptedSecret: testSecret}), nil)
+
+	_, svcErr := s.service.Authenticate(s.T().Context(), testEntityID, s.codeAt(0))
+
+	s.Require().NotNil(svcErr)
+	s.Equal(tidcommon.ServerErrorType, svcErr.Type)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticateWithRecoveryCode_ConsumesCode() {
+	stored := storedTOTPCredential{
+		EncryptedSecret: testEncryptedSecret,
+		RecoveryCodes:   []entity.StoredCredential{s.hashedCode("aaaaa-bbbbb"), s.hashedCode("ccccc-ddddd")},
+	}
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		Return(s.storedJSON(stored), nil)
+	var captured json.RawMessage
+	s.mockEntityProvider.EXPECT().UpdateSystemCredentials(testEntityID, mock.Anything).
+		Run(func(_ string, credentials json.RawMessage) { captured = credentials }).Return(nil)
+
+	result, svcErr := s.service.AuthenticateWithRecoveryCode(s.T().Context(), testEntityID, "CCCCC DDDDD")
+
+	s.Nil(svcErr)
+	s.Equal(testEntityID, result.AuthenticatedClaims[authnprovidercm.UserAttributeUserID])
+	remaining := s.decodeUpdate(captured).RecoveryCodes
+	s.Require().Len(remaining, 1)
+	s.Equal(stored.RecoveryCodes[0].Value, remaining[0].Value)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticateWithRecoveryCode_ConcurrentUseConsumedOnce() {
+	s.backCredentialWith(storedTOTPCredential{
+		EncryptedSecret: testEncryptedSecret,
+		RecoveryCodes:   []entity.StoredCredential{s.hashedCode("aaaaa-bbbbb"), s.hashedCode("ccccc-ddddd")},
+	})
+
+	succeeded := countConcurrentSuccesses(func() *tidcommon.ServiceError {
+		_, svcErr := s.service.AuthenticateWithRecoveryCode(s.T().Context(), testEntityID, "aaaaa-bbbbb")
+		return svcErr
+	})
+
+	s.Equal(1, succeeded)
+	// The lock is released once the code is consumed, so the other code still works.
+	_, svcErr := s.service.AuthenticateWithRecoveryCode(s.T().Context(), testEntityID, "ccccc-ddddd")
+	s.Nil(svcErr)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticateWithRecoveryCode_Incorrect() {
+	stored := storedTOTPCredential{
+		EncryptedSecret: testEncryptedSecret,
+		RecoveryCodes:   []entity.StoredCredential{s.hashedCode("aaaaa-bbbbb")},
+	}
+	s.mockEntityProvider.EXPECT().GetCredentials(testEntityID, authnprovidercm.CredentialTypeTOTP).
+		Return(s.storedJSON(stored), nil)
+
+	result, svcErr := s.service.AuthenticateWithRecoveryCode(s.T().Context(), testEntityID, "zzzzz-zzzzz")
+
+	s.Nil(result)
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorIncorrectCode.Code, svcErr.Code)
+}
+
+func (s *TOTPServiceTestSuite) TestAuthenticateWithRecoveryCode_EmptyCode() {
+	_, svcErr := s.service.AuthenticateWithRecoveryCode(s.T().Context(), testEntityID, " - ")
+
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorInvalidCode.Code, svcErr.Code)
+}
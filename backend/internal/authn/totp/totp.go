@@ -0,0 +1,148 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // #nosec G505 -- RFC 6238 authenticator apps only reliably support HMAC-SHA1
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// secretSize is the shared secret length in bytes. RFC 4226 recommends 160 bits.
+	secretSize = 20
+	// recoveryCodeLength is the number of characters in a recovery code, excluding the separator.
+	recoveryCodeLength = 10
+	// recoveryCodeAlphabet omits characters that are easily confused when read back (0/o, 1/l/i).
+	recoveryCodeAlphabet = "23456789abcdefghjkmnpqrstuvwxyz"
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateSecret returns a new random shared secret encoded as unpadded base32, the form
+// authenticator apps accept for manual entry.
+func generateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return base32NoPadding.EncodeToString(secret), nil
+}
+
+// decodeSecret decodes a base32 shared secret, tolerating lower case, spaces, and padding.
+func decodeSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	normalized = strings.TrimRight(normalized, "=")
+	key, err := base32NoPadding.DecodeString(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("invalid TOTP secret: empty key")
+	}
+	return key, nil
+}
+
+// generateCode computes the HOTP value (RFC 4226) of key for the given counter. With the counter
+// set to a time step it yields the TOTP value (RFC 6238).
+func generateCode(key []byte, counter int64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter)) // #nosec G115 -- time steps are never negative
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	binCode := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, binCode%mod)
+}
+
+// matchCode returns the time step within skew steps of current whose code equals code, and whether
+// one was found. Steps at or before lastUsedStep are never matched, so a code that has already been
+// accepted cannot be replayed, nor can an older one that is still inside the drift window.
+func matchCode(key []byte, code string, current, lastUsedStep int64, skew, digits int) (int64, bool) {
+	if len(code) != digits {
+		return 0, false
+	}
+	for offset := -int64(skew); offset <= int64(skew); offset++ {
+		step := current + offset
+		if step <= lastUsedStep || step < 0 {
+			continue
+		}
+		if hmac.Equal([]byte(generateCode(key, step, digits)), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// buildKeyURI builds the otpauth:// URI that authenticator apps import, usually through a QR code.
+// See https://github.com/google/google-authenticator/wiki/Key-Uri-Format.
+func buildKeyURI(issuer, accountName, secret string, digits, period int) string {
+	label := accountName
+	if issuer != "" {
+		label = issuer + ":" + accountName
+	}
+
+	params := url.Values{}
+	params.Set("secret", secret)
+	if issuer != "" {
+		params.Set("issuer", issuer)
+	}
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", strconv.Itoa(digits))
+	params.Set("period", strconv.Itoa(period))
+
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + label,
+		RawQuery: params.Encode(),
+	}).String()
+}
+
+// generateRecoveryCode returns a random recovery code formatted as two dash-separated halves.
+func generateRecoveryCode() (string, error) {
+	alphabetSize := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	var b strings.Builder
+	for i := range recoveryCodeLength {
+		if i == recoveryCodeLength/2 {
+			b.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		b.WriteByte(recoveryCodeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// normalizeRecoveryCode strips separators and whitespace and lowers the case, so a code is accepted
+// however the user types it back.
+func normalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ', '\t':
+			return -1
+		}
+		if r >= 'A' && r <= 'Z' {
+			return r + ('a' - 'A')
+		}
+		return r
+	}, code)
+}
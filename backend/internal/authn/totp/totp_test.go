@@ -0,0 +1,123 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfc6238Key is the SHA1 seed of the RFC 6238 appendix B test vectors.
+var rfc6238Key = []byte("12345678901234567890")
+
+func TestGenerateCode_RFC6238Vectors(t *testing.T) {
+	tests := []struct {
+		unixTime int64
+		want     string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, generateCode(rfc6238Key, tt.unixTime/30, 8))
+		})
+	}
+}
+
+func TestMatchCode(t *testing.T) {
+	const current = int64(1000)
+	codeAt := func(step int64) string { return generateCode(rfc6238Key, step, 6) }
+
+	tests := []struct {
+		name         string
+		code         string
+		lastUsedStep int64
+		skew         int
+		wantStep     int64
+		wantOK       bool
+	}{
+		{name: "current step", code: codeAt(current), lastUsedStep: -1, skew: 1, wantStep: current, wantOK: true},
+		{name: "previous step within skew", code: codeAt(current - 1), lastUsedStep: -1, skew: 1,
+			wantStep: current - 1, wantOK: true},
+		{name: "next step within skew", code: codeAt(current + 1), lastUsedStep: -1, skew: 1,
+			wantStep: current + 1, wantOK: true},
+		{name: "outside skew", code: codeAt(current - 2), lastUsedStep: -1, skew: 1},
+		{name: "no skew rejects drift", code: codeAt(current - 1), lastUsedStep: -1, skew: 0},
+		{name: "replayed step", code: codeAt(current), lastUsedStep: current, skew: 1},
+		{name: "step older than last used", code: codeAt(current - 1), lastUsedStep: current, skew: 1},
+		{name: "wrong length", code: "12345", lastUsedStep: -1, skew: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := matchCode(rfc6238Key, tt.code, current, tt.lastUsedStep, tt.skew, 6)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantStep, step)
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := generateSecret()
+	require.NoError(t, err)
+
+	key, err := decodeSecret(secret)
+	require.NoError(t, err)
+	assert.Len(t, key, secretSize)
+
+	other, err := generateSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+}
+
+func TestDecodeSecret(t *testing.T) {
+	key, err := decodeSecret("gezd gnbv gy3t qojq GEZDGNBVGY3TQOJQ====")
+	require.NoError(t, err)
+	assert.Equal(t, rfc6238Key, key)
+
+	_, err = decodeSecret("not base32!")
+	assert.Error(t, err)
+
+	_, err = decodeSecret("")
+	assert.Error(t, err)
+}
+
+func TestBuildKeyURI(t *testing.T) {
+	uri := buildKeyURI("ThunderID", "alice@example.com", "JBSWY3DPEHPK3PXP", 6, 30)
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/ThunderID:alice@example.com", parsed.Path)
+	query := parsed.Query()
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", query.Get("secret"))
+	assert.Equal(t, "ThunderID", query.Get("issuer"))
+	assert.Equal(t, "SHA1", query.Get("algorithm"))
+	assert.Equal(t, "6", query.Get("digits"))
+	assert.Equal(t, "30", query.Get("period"))
+
+	parsed, err = url.Parse(buildKeyURI("", "alice", "JBSWY3DPEHPK3PXP", 6, 30))
+	require.NoError(t, err)
+	assert.Equal(t, "/alice", parsed.Path)
+	assert.False(t, parsed.Query().Has("issuer"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	code, err := generateRecoveryCode()
+	require.NoError(t, err)
+	assert.Len(t, code, recoveryCodeLength+1)
+	assert.Equal(t, byte('-'), code[recoveryCodeLength/2])
+
+	assert.Equal(t, "abcde23456", normalizeRecoveryCode(" ABCDE-23456 "))
+}
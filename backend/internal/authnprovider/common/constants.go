@@ -15,6 +15,10 @@ const (
 // authentication credential last changed: a user's password, or a client secret.
 const SystemAttrCredentialUpdatedAt = "credentialUpdatedAt" // #nosec G101 -- attribute key, not a secret
 
+// SystemAttrCredentialCreatedAt is the entity system-attribute key recording when the entity's
+// initial credential was set at creation. Credential age falls back to it until the first change.
+const SystemAttrCredentialCreatedAt = "credentialCreatedAt" // #nosec G101 -- attribute key, not a secret
+
 // Credential type keys used in the credentials map passed to the authentication providers.
 const (
 	// CredentialTypeProvisionedEntityID identifies an entity provisioned earlier in the same flow.
@@ -23,6 +27,9 @@ const (
 	CredentialTypePasskey = "passkey"
 	// CredentialTypeOTP identifies a one time password.
 	CredentialTypeOTP = "otp"
+	// CredentialTypeTOTP identifies an authenticator app code or recovery code. It is also the key of
+	// the system credential holding the authenticator app secret and the hashed recovery codes.
+	CredentialTypeTOTP = "totp"
 	// CredentialTypeFederated identifies an authorization code from an external identity provider.
 	CredentialTypeFederated = "federated"
 	// CredentialTypeMagicLink identifies a magic link token.
@@ -42,6 +49,7 @@ var InternalCredentialTypes = []string{
 	CredentialTypeProvisionedEntityID,
 	CredentialTypePasskey,
 	CredentialTypeOTP,
+	CredentialTypeTOTP,
 	CredentialTypeFederated,
 	CredentialTypeMagicLink,
 	CredentialTypeOpenID4VP,
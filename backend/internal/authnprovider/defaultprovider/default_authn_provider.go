@@ -18,6 +18,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -27,6 +28,7 @@ type defaultAuthnProvider struct {
 	entitySvc        entity.EntityServiceInterface
 	passkeyService   passkey.PasskeyServiceInterface
 	otpService       otp.OTPAuthnServiceInterface
+	totpService      totp.TOTPAuthnServiceInterface
 	magicLinkService magiclink.MagicLinkAuthnServiceInterface
 	openid4vpService openid4vp.OpenID4VPServiceInterface
 	federatedAuths   map[providers.IDPType]authncommon.FederatedAuthenticator
@@ -36,6 +38,7 @@ type defaultAuthnProvider struct {
 // newDefaultAuthnProvider creates a new internal user authn provider.
 func newDefaultAuthnProvider(entitySvc entity.EntityServiceInterface,
 	passkeyService passkey.PasskeyServiceInterface, otpService otp.OTPAuthnServiceInterface,
+	totpService totp.TOTPAuthnServiceInterface,
 	magicLinkService magiclink.MagicLinkAuthnServiceInterface,
 	openid4vpService openid4vp.OpenID4VPServiceInterface,
 	federatedAuths map[providers.IDPType]authncommon.FederatedAuthenticator) providers.AuthnProviderInterface {
@@ -43,6 +46,7 @@ func newDefaultAuthnProvider(entitySvc entity.EntityServiceInterface,
 		entitySvc:        entitySvc,
 		passkeyService:   passkeyService,
 		otpService:       otpService,
+		totpService:      totpService,
 		magicLinkService: magicLinkService,
 		openid4vpService: openid4vpService,
 		federatedAuths:   federatedAuths,
@@ -373,6 +377,9 @@ func (p *defaultAuthnProvider) authenticateWithCredential(
 	if otpCredential, ok := credentials[authnprovidercm.CredentialTypeOTP]; ok {
 		return p.authenticateWithOTP(ctx, otpCredential)
 	}
+	if totpCredential, ok := credentials[authnprovidercm.CredentialTypeTOTP]; ok {
+		return p.authenticateWithTOTP(ctx, identifiers, totpCredential)
+	}
 	if fedCred, ok := credentials[authnprovidercm.CredentialTypeFederated]; ok {
 		return p.authenticateWithFederated(ctx, fedCred)
 	}
@@ -460,6 +467,49 @@ func (p *defaultAuthnProvider) authenticateWithOTP(
 	return result, nil
 }
 
+// authenticateWithTOTP authenticates the user using the TOTP service. The user is taken from the
+// userID identifier and the raw credential is expected to be a map with either a "code" or a
+// "recoveryCode" string field.
+func (p *defaultAuthnProvider) authenticateWithTOTP(
+	ctx context.Context, identifiers map[string]interface{}, raw interface{},
+) (*authncommon.AuthnResult, *tidcommon.ServiceError) {
+	userID, ok := identifiers[authnprovidercm.UserAttributeUserID].(string)
+	if !ok || userID == "" {
+		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+			"Invalid TOTP payload", "userID identifier is required")
+	}
+	totpCredential, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+			"Invalid TOTP payload", "The provided TOTP credential is invalid")
+	}
+
+	var result *authncommon.AuthnResult
+	var authErr *tidcommon.ServiceError
+	if code, ok := totpCredential["code"].(string); ok && code != "" {
+		result, authErr = p.totpService.Authenticate(ctx, userID, code)
+	} else if recoveryCode, ok := totpCredential["recoveryCode"].(string); ok && recoveryCode != "" {
+		result, authErr = p.totpService.AuthenticateWithRecoveryCode(ctx, userID, recoveryCode)
+	} else {
+		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+			"Invalid TOTP payload", "code or recoveryCode is required")
+	}
+	if authErr != nil {
+		if authErr.Type == tidcommon.ClientErrorType {
+			if authErr.Code == totp.ErrorIncorrectCode.Code {
+				return nil, newClientError(authnprovidercm.ErrorCodeAuthenticationFailed,
+					authErr.Error.DefaultValue, authErr.ErrorDescription.DefaultValue)
+			}
+			return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+				authErr.Error.DefaultValue, authErr.ErrorDescription.DefaultValue)
+		}
+		return nil, p.logAndReturnServerError(ctx, "TOTP authentication failed with server error",
+			log.String("error", authErr.Error.DefaultValue),
+			log.String("errorDescription", authErr.ErrorDescription.DefaultValue))
+	}
+	return result, nil
+}
+
 // authenticateWithFederated authenticates the user using a federated identity provider.
 // The raw credential is expected to be a FederatedAuthCredential struct with non-empty IDP ID and authorization code.
 func (p *defaultAuthnProvider) authenticateWithFederated(
@@ -21,12 +21,14 @@ import (
 	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/commonmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/magiclinkmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/otpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/passkeymock"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/totpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 )
 
@@ -42,7 +44,7 @@ func (suite *DefaultAuthnProviderTestSuite) SetupTest() {
 	suite.mockService = entitymock.NewEntityServiceInterfaceMock(suite.T())
 	suite.mockPasskey = passkeymock.NewPasskeyServiceInterfaceMock(suite.T())
 	suite.mockFederated = commonmock.NewFederatedAuthenticatorMock(suite.T())
-	suite.provider = Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	suite.provider = Initialize(suite.mockService, nil, nil, nil, nil, nil, nil)
 }
 
 func TestDefaultAuthnProviderTestSuite(t *testing.T) {
@@ -400,7 +402,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Provisioning_GetEnt
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_ServerError() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -428,7 +430,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_Serv
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_Success_ThenGetEntity() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -467,7 +469,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_Succ
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_GetEntityFails() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -763,7 +765,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestGetAttributes_InvalidTokenFormat
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_IncorrectOTP() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -784,7 +786,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_IncorrectOTP()
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_InvalidPayload() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": "not-a-map",
@@ -799,7 +801,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_InvalidPayload(
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingSessionToken() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -816,7 +818,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingSessionT
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingOTPValue() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -833,7 +835,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingOTPValue
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ClientError_NonIncorrectOTP() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -859,7 +861,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ClientError_Non
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ServerError() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -883,11 +885,119 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ServerError() {
 	suite.Equal(tidcommon.InternalServerError.Code, err.Code)
 }
 
+// --- TOTP authentication tests ---
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TOTP_Success() {
+	mockTOTP := totpmock.NewTOTPAuthnServiceInterfaceMock(suite.T())
+	provider := Initialize(suite.mockService, nil, nil, mockTOTP, nil, nil, nil)
+
+	identifiers := map[string]interface{}{"userID": "user-1"}
+	credentials := map[string]interface{}{
+		"totp": map[string]interface{}{"code": "123456"},
+	}
+
+	mockTOTP.On("Authenticate", mock.Anything, "user-1", "123456").
+		Return(&authncommon.AuthnResult{
+			Token:               map[string]interface{}{"userID": "user-1"},
+			AuthenticatedClaims: map[string]interface{}{"userID": "user-1"},
+		}, nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user-1").
+		Return(&providers.Entity{ID: "user-1", Category: providers.EntityCategoryUser, Type: "person"}, nil).Once()
+
+	result, err := provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(err)
+	suite.Require().NotNil(result)
+	suite.Equal("user-1", result.EntityReference.EntityID)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TOTP_RecoveryCode() {
+	mockTOTP := totpmock.NewTOTPAuthnServiceInterfaceMock(suite.T())
+	provider := Initialize(suite.mockService, nil, nil, mockTOTP, nil, nil, nil)
+
+	identifiers := map[string]interface{}{"userID": "user-1"}
+	credentials := map[string]interface{}{
+		"totp": map[string]interface{}{"recoveryCode": "abcde-fghjk"},
+	}
+
+	mockTOTP.On("AuthenticateWithRecoveryCode", mock.Anything, "user-1", "abcde-fghjk").
+		Return(nil, &totp.ErrorIncorrectCode).Once()
+
+	result, err := provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TOTP_InvalidPayload() {
+	mockTOTP := totpmock.NewTOTPAuthnServiceInterfaceMock(suite.T())
+	provider := Initialize(suite.mockService, nil, nil, mockTOTP, nil, nil, nil)
+
+	tests := []struct {
+		name        string
+		identifiers map[string]interface{}
+		credential  interface{}
+	}{
+		{"MissingUserID", nil, map[string]interface{}{"code": "123456"}},
+		{"NotAMap", map[string]interface{}{"userID": "user-1"}, "123456"},
+		{"MissingCode", map[string]interface{}{"userID": "user-1"}, map[string]interface{}{}},
+	}
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			credentials := map[string]interface{}{"totp": tt.credential}
+			result, err := provider.Authenticate(context.Background(), tt.identifiers, credentials, nil)
+
+			suite.Nil(result)
+			suite.Require().NotNil(err)
+			suite.Equal(authnprovidercm.ErrorCodeInvalidRequest, err.Code)
+		})
+	}
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TOTP_NotEnrolled() {
+	mockTOTP := totpmock.NewTOTPAuthnServiceInterfaceMock(suite.T())
+	provider := Initialize(suite.mockService, nil, nil, mockTOTP, nil, nil, nil)
+
+	identifiers := map[string]interface{}{"userID": "user-1"}
+	credentials := map[string]interface{}{
+		"totp": map[string]interface{}{"code": "123456"},
+	}
+
+	mockTOTP.On("Authenticate", mock.Anything, "user-1", "123456").
+		Return(nil, &totp.ErrorNotEnrolled).Once()
+
+	result, err := provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeInvalidRequest, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TOTP_ServerError() {
+	mockTOTP := totpmock.NewTOTPAuthnServiceInterfaceMock(suite.T())
+	provider := Initialize(suite.mockService, nil, nil, mockTOTP, nil, nil, nil)
+
+	identifiers := map[string]interface{}{"userID": "user-1"}
+	credentials := map[string]interface{}{
+		"totp": map[string]interface{}{"code": "123456"},
+	}
+
+	mockTOTP.On("Authenticate", mock.Anything, "user-1", "123456").
+		Return(nil, &tidcommon.InternalServerError).Once()
+
+	result, err := provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(tidcommon.InternalServerError.Code, err.Code)
+}
+
 // --- Magic Link authentication tests ---
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_AuthenticationFailed() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": map[string]interface{}{
@@ -912,7 +1022,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_Authentic
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_ServerError() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": map[string]interface{}{
@@ -937,7 +1047,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_ServerErr
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_InvalidPayload() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": "not-a-map",
@@ -952,7 +1062,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_InvalidPa
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_MissingToken() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": map[string]interface{}{},
@@ -982,7 +1092,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Entit
 				"otp":          "123456",
 			},
 		}
-		return Initialize(suite.mockService, nil, mockOTP, nil, nil, nil), creds, token
+		return Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil), creds, token
 	}
 
 	setupMagicLink := func() (providers.AuthnProviderInterface, map[string]interface{}, map[string]interface{}) {
@@ -999,7 +1109,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Entit
 				"subjectAttribute": "",
 			},
 		}
-		return Initialize(suite.mockService, nil, nil, mockML, nil, nil), creds, token
+		return Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil), creds, token
 	}
 
 	tests := []struct {
@@ -1051,7 +1161,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Ident
 				"otp":          "123456",
 			},
 		}
-		return Initialize(suite.mockService, nil, mockOTP, nil, nil, nil), creds, token
+		return Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil), creds, token
 	}
 
 	setupMagicLink := func() (providers.AuthnProviderInterface, map[string]interface{}, map[string]interface{}) {
@@ -1068,7 +1178,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Ident
 				"subjectAttribute": "email",
 			},
 		}
-		return Initialize(suite.mockService, nil, nil, mockML, nil, nil), creds, token
+		return Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil), creds, token
 	}
 
 	tests := []struct {
@@ -1102,7 +1212,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Ident
 // --- Passkey authentication tests ---
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_InvalidPayload() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": "not-a-passkey-struct",
@@ -1116,7 +1226,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_InvalidPayl
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_NilPayload() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": (*passkey.PasskeyAuthenticationFinishRequest)(nil),
@@ -1132,7 +1242,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_NilPayload(
 // --- Federated authentication tests ---
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_InvalidPayload() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": "not-a-struct",
@@ -1146,7 +1256,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_InvalidPa
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_NilPayload() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": (*authncommon.FederatedAuthCredential)(nil),
@@ -1160,7 +1270,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_NilPayloa
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingIDPID() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1177,7 +1287,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingID
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingCode() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1194,7 +1304,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingCo
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_UnsupportedIDPType() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil,
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil,
 		map[providers.IDPType]authncommon.FederatedAuthenticator{})
 
 	credentials := map[string]interface{}{
@@ -1221,7 +1331,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_Success() {
 			Token:               passkeyToken,
 			AuthenticatedClaims: map[string]interface{}{"userID": "pk-user-1"},
 		}, nil).Once()
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": &passkey.PasskeyAuthenticationFinishRequest{
@@ -1256,7 +1366,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_AuthFailed(
 			Error:            tidcommon.I18nMessage{DefaultValue: "Passkey auth failed"},
 			ErrorDescription: tidcommon.I18nMessage{DefaultValue: "Invalid passkey credential"},
 		}).Once()
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": &passkey.PasskeyAuthenticationFinishRequest{
@@ -1284,7 +1394,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_Success()
 	federatedAuths := map[providers.IDPType]authncommon.FederatedAuthenticator{
 		providers.IDPType("google"): suite.mockFederated,
 	}
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, federatedAuths)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, federatedAuths)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1327,7 +1437,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_ClientErr
 	federatedAuths := map[providers.IDPType]authncommon.FederatedAuthenticator{
 		providers.IDPType("google"): suite.mockFederated,
 	}
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, federatedAuths)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, federatedAuths)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1356,7 +1466,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_ServerErr
 	federatedAuths := map[providers.IDPType]authncommon.FederatedAuthenticator{
 		providers.IDPType("google"): suite.mockFederated,
 	}
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, federatedAuths)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, federatedAuths)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1374,7 +1484,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_ServerErr
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestInitiateAuthentication_Passkey() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil)
 	req := &passkey.PasskeyAuthenticationStartRequest{UserID: "user123", RelyingPartyID: "example.com"}
 	startData := &passkey.PasskeyAuthenticationStartData{SessionToken: "sess-1"}
 	suite.mockPasskey.On("StartAuthentication", mock.Anything, req).Return(startData, nil).Once()
@@ -1394,7 +1504,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestInitiateAuthentication_Unsupport
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestInitiateAuthentication_InvalidPayload() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil)
 
 	result, err := provider.InitiateAuthentication(context.Background(), passkey.CredentialType, "bad", nil)
 
@@ -1404,7 +1514,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestInitiateAuthentication_InvalidPa
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestInitiateEnrollment_Passkey() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil)
 	req := &passkey.PasskeyRegistrationStartRequest{UserID: "user123", RelyingPartyID: "example.com"}
 	startData := &passkey.PasskeyRegistrationStartData{SessionToken: "sess-1"}
 	suite.mockPasskey.On("StartRegistration", mock.Anything, req).Return(startData, nil).Once()
@@ -1416,7 +1526,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestInitiateEnrollment_Passkey() {
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestInitiateEnrollment_InvalidPayload() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil)
 
 	result, err := provider.InitiateEnrollment(context.Background(), passkey.CredentialType, 42, nil)
 
@@ -1426,7 +1536,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestInitiateEnrollment_InvalidPayloa
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestEnroll_Passkey_Success() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil)
 	req := &passkey.PasskeyRegistrationFinishRequest{CredentialID: "cred-1"}
 	credentials := map[string]interface{}{"passkey": req}
 	suite.mockPasskey.On("FinishRegistration", mock.Anything, req).
@@ -1460,7 +1570,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestEnroll_NilCredentials() {
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestEnroll_Passkey_InvalidPayload() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil)
 	credentials := map[string]interface{}{"passkey": "not-a-request-struct"}
 
 	result, err := provider.Enroll(context.Background(), nil, credentials, nil)
@@ -0,0 +1,39 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ldapprovider implements an authentication provider that verifies passwords against an
+// LDAP directory or Active Directory.
+package ldapprovider
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Name is the name of the built-in LDAP authn provider.
+const Name = "ldap"
+
+// defaultPasswordCredential is the credential key holding the password when none is configured.
+const defaultPasswordCredential = "password"
+
+// Initialize builds the LDAP authentication provider. The entity provider must be the LDAP entity
+// provider, which verifies the passwords; users it does not hold are authenticated by the fallback
+// provider.
+func Initialize(cfg config.LDAPEntityProviderConfig, entityProvider entityprovider.EntityProviderInterface,
+	fallback providers.AuthnProviderInterface) (providers.AuthnProviderInterface, error) {
+	verifier, ok := entityProvider.(entityprovider.CredentialVerifierInterface)
+	if !ok {
+		return nil, errors.New("the ldap authn provider requires the ldap entity provider")
+	}
+	if fallback == nil {
+		return nil, errors.New("the ldap authn provider requires a fallback provider")
+	}
+	passwordCredential := cfg.User.PasswordAttribute
+	if passwordCredential == "" {
+		passwordCredential = defaultPasswordCredential
+	}
+	return newLDAPAuthnProvider(entityProvider, verifier, fallback, passwordCredential), nil
+}
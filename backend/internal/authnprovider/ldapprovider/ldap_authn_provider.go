@@ -0,0 +1,266 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ldapprovider
+
+import (
+	"context"
+	"encoding/json"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// ldapAuthnProvider authenticates directory users by binding to the directory with their password.
+// Every other request, including the authentication of users the directory does not hold, is
+// handled by the fallback provider.
+type ldapAuthnProvider struct {
+	entityProvider     entityprovider.EntityProviderInterface
+	verifier           entityprovider.CredentialVerifierInterface
+	fallback           providers.AuthnProviderInterface
+	passwordCredential string
+	logger             *log.Logger
+}
+
+// newLDAPAuthnProvider creates a new LDAP authentication provider.
+func newLDAPAuthnProvider(entityProvider entityprovider.EntityProviderInterface,
+	verifier entityprovider.CredentialVerifierInterface, fallback providers.AuthnProviderInterface,
+	passwordCredential string) providers.AuthnProviderInterface {
+	return &ldapAuthnProvider{
+		entityProvider:     entityProvider,
+		verifier:           verifier,
+		fallback:           fallback,
+		passwordCredential: passwordCredential,
+		logger:             log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LDAPAuthnProvider")),
+	}
+}
+
+// InitiateAuthentication delegates to the fallback provider. Password authentication needs no
+// initiation.
+func (p *ldapAuthnProvider) InitiateAuthentication(ctx context.Context, credentialType string, initData any,
+	metadata *providers.AuthnMetadata) (any, *tidcommon.ServiceError) {
+	return p.fallback.InitiateAuthentication(ctx, credentialType, initData, metadata)
+}
+
+// Authenticate authenticates a user with a password. The user is resolved from the identifiers and
+// the password is verified by binding to the directory.
+func (p *ldapAuthnProvider) Authenticate(ctx context.Context, identifiers, credentials map[string]interface{},
+	metadata *providers.AuthnMetadata) (*providers.AuthnResult, *tidcommon.ServiceError) {
+	password, ok := credentials[p.passwordCredential].(string)
+	if !ok || len(credentials) != 1 {
+		return p.fallback.Authenticate(ctx, identifiers, credentials, metadata)
+	}
+
+	entityID, svcErr := p.resolveEntityID(ctx, identifiers)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if entityID == "" {
+		return p.fallback.Authenticate(ctx, identifiers, credentials, metadata)
+	}
+
+	if epErr := p.verifier.VerifyCredentials(entityID, password); epErr != nil {
+		switch epErr.Code {
+		case entityprovider.ErrorCodeEntityNotFound:
+			// Not a directory user, so the password is held locally.
+			return p.fallback.Authenticate(ctx, identifiers, credentials, metadata)
+		case entityprovider.ErrorCodeInvalidCredentials:
+			return nil, newClientError(authnprovidercm.ErrorCodeAuthenticationFailed,
+				"Authentication failed", "Invalid credentials provided")
+		default:
+			return nil, p.logAndReturnServerError(ctx, "Failed to verify credentials with the directory",
+				log.String("error", epErr.Error()))
+		}
+	}
+
+	entity, epErr := p.entityProvider.GetEntity(entityID)
+	if epErr != nil {
+		return nil, p.logAndReturnServerError(ctx, "Failed to get entity after authentication",
+			log.String("error", epErr.Error()))
+	}
+	attributes, svcErr := p.entityAttributes(ctx, entity)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return &providers.AuthnResult{
+		AuthenticatedClaims: providers.AuthenticatedClaims{authnprovidercm.UserAttributeUserID: entity.ID},
+		EntityReference:     toEntityReference(entity),
+		Attributes:          buildAttributesResponse(attributes, nil),
+	}, nil
+}
+
+// GetEntityReference retrieves the entity reference for a user ID token. Other tokens are
+// resolved by the fallback provider.
+func (p *ldapAuthnProvider) GetEntityReference(ctx context.Context, entityReferenceToken any,
+) (*providers.EntityReference, *tidcommon.ServiceError) {
+	entityID := tokenEntityID(entityReferenceToken)
+	if entityID == "" {
+		return p.fallback.GetEntityReference(ctx, entityReferenceToken)
+	}
+	entity, svcErr := p.getEntity(ctx, entityID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return toEntityReference(entity), nil
+}
+
+// GetAttributes retrieves the attributes of the user identified by a user ID token, limited to the
+// consented attributes when given. Other tokens are resolved by the fallback provider.
+func (p *ldapAuthnProvider) GetAttributes(ctx context.Context, attributeToken any,
+	consentedAttributes *providers.RequestedAttributes,
+	metadata *providers.GetAttributesMetadata) (*providers.AttributesResponse, *tidcommon.ServiceError) {
+	entityID := tokenEntityID(attributeToken)
+	if entityID == "" {
+		return p.fallback.GetAttributes(ctx, attributeToken, consentedAttributes, metadata)
+	}
+	entity, svcErr := p.getEntity(ctx, entityID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	attributes, svcErr := p.entityAttributes(ctx, entity)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return buildAttributesResponse(attributes, consentedAttributes), nil
+}
+
+// InitiateEnrollment delegates to the fallback provider.
+func (p *ldapAuthnProvider) InitiateEnrollment(ctx context.Context, credentialType string, initData any,
+	metadata *providers.AuthnMetadata) (any, *tidcommon.ServiceError) {
+	return p.fallback.InitiateEnrollment(ctx, credentialType, initData, metadata)
+}
+
+// Enroll delegates to the fallback provider. Directory passwords are set through the entity provider.
+func (p *ldapAuthnProvider) Enroll(ctx context.Context, identifiers, credentials map[string]interface{},
+	metadata *providers.AuthnMetadata) (*providers.AuthnResult, *tidcommon.ServiceError) {
+	return p.fallback.Enroll(ctx, identifiers, credentials, metadata)
+}
+
+// resolveEntityID resolves the entity ID from the identifiers. It returns an empty ID when no
+// entity matches.
+func (p *ldapAuthnProvider) resolveEntityID(ctx context.Context,
+	identifiers map[string]interface{}) (string, *tidcommon.ServiceError) {
+	if userID, ok := identifiers[authnprovidercm.UserAttributeUserID]; ok {
+		userIDStr, ok := userID.(string)
+		if !ok || userIDStr == "" {
+			return "", newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+				"Invalid user ID", "The provided userID is invalid")
+		}
+		return userIDStr, nil
+	}
+	if len(identifiers) == 0 {
+		return "", newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+			"Identifiers are required", "Identifiers are required for authentication")
+	}
+
+	entityID, epErr := p.entityProvider.IdentifyEntity(identifiers)
+	if epErr != nil {
+		switch epErr.Code {
+		case entityprovider.ErrorCodeEntityNotFound:
+			return "", nil
+		case entityprovider.ErrorCodeAmbiguousEntity:
+			return "", newClientError(authnprovidercm.ErrorCodeAuthenticationFailed,
+				"Authentication failed", "The identifiers match more than one user")
+		default:
+			return "", p.logAndReturnServerError(ctx, "Failed to identify entity",
+				log.String("error", epErr.Error()))
+		}
+	}
+	return *entityID, nil
+}
+
+// getEntity retrieves an entity, reporting a missing entity as a client error since the caller
+// controls the token contents.
+func (p *ldapAuthnProvider) getEntity(ctx context.Context,
+	entityID string) (*providers.Entity, *tidcommon.ServiceError) {
+	entity, epErr := p.entityProvider.GetEntity(entityID)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return nil, newClientError(authnprovidercm.ErrorCodeUserNotFound,
+				"User not found", "The specified user does not exist")
+		}
+		return nil, p.logAndReturnServerError(ctx, "Failed to get entity", log.String("error", epErr.Error()))
+	}
+	return entity, nil
+}
+
+func (p *ldapAuthnProvider) entityAttributes(ctx context.Context,
+	entity *providers.Entity) (map[string]interface{}, *tidcommon.ServiceError) {
+	attributes := make(map[string]interface{})
+	if len(entity.Attributes) > 0 {
+		if err := json.Unmarshal(entity.Attributes, &attributes); err != nil {
+			return nil, p.logAndReturnServerError(ctx, "Failed to unmarshal entity attributes",
+				log.String("error", err.Error()))
+		}
+	}
+	return attributes, nil
+}
+
+func (p *ldapAuthnProvider) logAndReturnServerError(
+	ctx context.Context, msg string, fields ...log.Field) *tidcommon.ServiceError {
+	p.logger.Error(ctx, msg, fields...)
+	err := tidcommon.InternalServerError
+	return &err
+}
+
+// tokenEntityID returns the user ID carried by a token, or an empty string.
+func tokenEntityID(token any) string {
+	parsed, ok := token.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	entityID, _ := parsed[authnprovidercm.UserAttributeUserID].(string)
+	return entityID
+}
+
+func toEntityReference(entity *providers.Entity) *providers.EntityReference {
+	return &providers.EntityReference{
+		EntityID:       entity.ID,
+		EntityCategory: string(entity.Category),
+		EntityType:     entity.Type,
+		OUID:           entity.OUID,
+	}
+}
+
+// buildAttributesResponse builds the attributes response, limited to the consented attributes
+// when given.
+func buildAttributesResponse(attrs map[string]interface{},
+	consentedAttributes *providers.RequestedAttributes) *providers.AttributesResponse {
+	resp := &providers.AttributesResponse{
+		Attributes:    make(map[string]*providers.AttributeResponse),
+		Verifications: make(map[string]*providers.VerificationResponse),
+	}
+	for name, value := range attrs {
+		if consentedAttributes != nil && len(consentedAttributes.Attributes) > 0 {
+			if _, consented := consentedAttributes.Attributes[name]; !consented {
+				continue
+			}
+		}
+		resp.Attributes[name] = &providers.AttributeResponse{
+			Value: value,
+			AssuranceMetadataResponse: &providers.AssuranceMetadataResponse{
+				IsVerified: false,
+			},
+		}
+	}
+	return resp
+}
+
+func newClientError(code, msg, desc string) *tidcommon.ServiceError {
+	return &tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: code,
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authnproviderservice." + code,
+			DefaultValue: msg,
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authnproviderservice." + code + "_description",
+			DefaultValue: desc,
+		},
+	}
+}
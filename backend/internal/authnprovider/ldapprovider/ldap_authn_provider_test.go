@@ -0,0 +1,279 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ldapprovider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/providermock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+)
+
+// ldapEntityProviderMock combines the entity provider and credential verifier mocks, as the LDAP
+// entity provider implements both interfaces.
+type ldapEntityProviderMock struct {
+	*entityprovidermock.EntityProviderInterfaceMock
+	*entityprovidermock.CredentialVerifierInterfaceMock
+}
+
+type LDAPAuthnProviderTestSuite struct {
+	suite.Suite
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockVerifier       *entityprovidermock.CredentialVerifierInterfaceMock
+	mockFallback       *providermock.AuthnProviderInterfaceMock
+	provider           providers.AuthnProviderInterface
+}
+
+func TestLDAPAuthnProviderTestSuite(t *testing.T) {
+	suite.Run(t, new(LDAPAuthnProviderTestSuite))
+}
+
+func (suite *LDAPAuthnProviderTestSuite) SetupTest() {
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockVerifier = entityprovidermock.NewCredentialVerifierInterfaceMock(suite.T())
+	suite.mockFallback = providermock.NewAuthnProviderInterfaceMock(suite.T())
+
+	provider, err := Initialize(config.LDAPEntityProviderConfig{},
+		&ldapEntityProviderMock{suite.mockEntityProvider, suite.mockVerifier}, suite.mockFallback)
+	suite.Require().NoError(err)
+	suite.provider = provider
+}
+
+func (suite *LDAPAuthnProviderTestSuite) directoryUser() *providers.Entity {
+	return &providers.Entity{
+		ID:         "uuid-alice",
+		Category:   providers.EntityCategoryUser,
+		Type:       "Person",
+		OUID:       "ou-ldap",
+		Attributes: json.RawMessage(`{"username":"alice","email":"alice@example.com"}`),
+	}
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestInitialize_RequiresCredentialVerifier() {
+	_, err := Initialize(config.LDAPEntityProviderConfig{}, suite.mockEntityProvider, suite.mockFallback)
+
+	suite.Error(err)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestInitialize_RequiresFallback() {
+	_, err := Initialize(config.LDAPEntityProviderConfig{},
+		&ldapEntityProviderMock{suite.mockEntityProvider, suite.mockVerifier}, nil)
+
+	suite.Error(err)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestInitialize_CustomPasswordCredential() {
+	cfg := config.LDAPEntityProviderConfig{User: config.LDAPUserConfig{PasswordAttribute: "secret"}}
+
+	provider, err := Initialize(cfg, &ldapEntityProviderMock{suite.mockEntityProvider, suite.mockVerifier},
+		suite.mockFallback)
+
+	suite.Require().NoError(err)
+	suite.Equal("secret", provider.(*ldapAuthnProvider).passwordCredential)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_Success() {
+	identifiers := map[string]interface{}{"username": "alice"}
+	entityID := "uuid-alice"
+	suite.mockEntityProvider.On("IdentifyEntity", identifiers).Return(&entityID, nil).Once()
+	suite.mockVerifier.On("VerifyCredentials", entityID, "alice-secret").Return(nil).Once()
+	suite.mockEntityProvider.On("GetEntity", entityID).Return(suite.directoryUser(), nil).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers,
+		map[string]interface{}{"password": "alice-secret"}, nil)
+
+	suite.Nil(err)
+	suite.Require().NotNil(result)
+	suite.Equal(entityID, result.AuthenticatedClaims[authnprovidercm.UserAttributeUserID])
+	suite.Equal(&providers.EntityReference{EntityID: entityID, EntityCategory: "user", EntityType: "Person",
+		OUID: "ou-ldap"}, result.EntityReference)
+	suite.Equal("alice@example.com", result.Attributes.Attributes["email"].Value)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_WithUserID() {
+	suite.mockVerifier.On("VerifyCredentials", "uuid-alice", "alice-secret").Return(nil).Once()
+	suite.mockEntityProvider.On("GetEntity", "uuid-alice").Return(suite.directoryUser(), nil).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(),
+		map[string]interface{}{authnprovidercm.UserAttributeUserID: "uuid-alice"},
+		map[string]interface{}{"password": "alice-secret"}, nil)
+
+	suite.Nil(err)
+	suite.Equal("uuid-alice", result.EntityReference.EntityID)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_InvalidPassword() {
+	identifiers := map[string]interface{}{"username": "alice"}
+	entityID := "uuid-alice"
+	suite.mockEntityProvider.On("IdentifyEntity", identifiers).Return(&entityID, nil).Once()
+	suite.mockVerifier.On("VerifyCredentials", entityID, "wrong").Return(
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeInvalidCredentials, "Invalid credentials", ""),
+	).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers,
+		map[string]interface{}{"password": "wrong"}, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(tidcommon.ClientErrorType, err.Type)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_LocalUserUsesFallback() {
+	identifiers := map[string]interface{}{"username": "carol"}
+	credentials := map[string]interface{}{"password": "carol-secret"}
+	entityID := "local-carol"
+	expected := &providers.AuthnResult{EntityReference: &providers.EntityReference{EntityID: entityID}}
+	suite.mockEntityProvider.On("IdentifyEntity", identifiers).Return(&entityID, nil).Once()
+	suite.mockVerifier.On("VerifyCredentials", entityID, "carol-secret").Return(
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", ""),
+	).Once()
+	suite.mockFallback.On("Authenticate", mock.Anything, identifiers, credentials, mock.Anything).
+		Return(expected, nil).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(err)
+	suite.Equal(expected, result)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_UnknownUserUsesFallback() {
+	identifiers := map[string]interface{}{"username": "nobody"}
+	credentials := map[string]interface{}{"password": "secret"}
+	failure := &tidcommon.ServiceError{Type: tidcommon.ClientErrorType,
+		Code: authnprovidercm.ErrorCodeUserNotFound}
+	suite.mockEntityProvider.On("IdentifyEntity", identifiers).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", ""),
+	).Once()
+	suite.mockFallback.On("Authenticate", mock.Anything, identifiers, credentials, mock.Anything).
+		Return(nil, failure).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.Equal(failure, err)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_NonPasswordCredentialsUseFallback() {
+	identifiers := map[string]interface{}{"mobileNumber": "+100000000"}
+	credentials := map[string]interface{}{"otp": "123456"}
+	expected := &providers.AuthnResult{}
+	suite.mockFallback.On("Authenticate", mock.Anything, identifiers, credentials, mock.Anything).
+		Return(expected, nil).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(err)
+	suite.Equal(expected, result)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_AmbiguousIdentifiers() {
+	identifiers := map[string]interface{}{"family_name": "Smith"}
+	suite.mockEntityProvider.On("IdentifyEntity", identifiers).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeAmbiguousEntity, "Ambiguous entity", ""),
+	).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers,
+		map[string]interface{}{"password": "secret"}, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_InvalidUserID() {
+	result, err := suite.provider.Authenticate(context.Background(),
+		map[string]interface{}{authnprovidercm.UserAttributeUserID: 42},
+		map[string]interface{}{"password": "secret"}, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeInvalidRequest, err.Code)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_DirectoryUnavailable() {
+	identifiers := map[string]interface{}{"username": "alice"}
+	entityID := "uuid-alice"
+	suite.mockEntityProvider.On("IdentifyEntity", identifiers).Return(&entityID, nil).Once()
+	suite.mockVerifier.On("VerifyCredentials", entityID, "alice-secret").Return(
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeSystemError, "System error", ""),
+	).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers,
+		map[string]interface{}{"password": "alice-secret"}, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(tidcommon.ServerErrorType, err.Type)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestGetEntityReference() {
+	suite.mockEntityProvider.On("GetEntity", "uuid-alice").Return(suite.directoryUser(), nil).Once()
+
+	ref, err := suite.provider.GetEntityReference(context.Background(),
+		map[string]interface{}{authnprovidercm.UserAttributeUserID: "uuid-alice"})
+
+	suite.Nil(err)
+	suite.Equal("uuid-alice", ref.EntityID)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestGetEntityReference_UserNotFound() {
+	suite.mockEntityProvider.On("GetEntity", "missing").Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", ""),
+	).Once()
+
+	ref, err := suite.provider.GetEntityReference(context.Background(),
+		map[string]interface{}{authnprovidercm.UserAttributeUserID: "missing"})
+
+	suite.Nil(ref)
+	suite.Require().NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeUserNotFound, err.Code)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestGetEntityReference_OtherTokenUsesFallback() {
+	token := map[string]interface{}{"sub": "federated-sub"}
+	expected := &providers.EntityReference{EntityID: "local-1"}
+	suite.mockFallback.On("GetEntityReference", mock.Anything, token).Return(expected, nil).Once()
+
+	ref, err := suite.provider.GetEntityReference(context.Background(), token)
+
+	suite.Nil(err)
+	suite.Equal(expected, ref)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestGetAttributes_FiltersConsentedAttributes() {
+	suite.mockEntityProvider.On("GetEntity", "uuid-alice").Return(suite.directoryUser(), nil).Once()
+	consented := &providers.RequestedAttributes{
+		Attributes: map[string]*providers.AttributeMetadataRequest{"email": nil},
+	}
+
+	resp, err := suite.provider.GetAttributes(context.Background(),
+		map[string]interface{}{authnprovidercm.UserAttributeUserID: "uuid-alice"}, consented, nil)
+
+	suite.Nil(err)
+	suite.Len(resp.Attributes, 1)
+	suite.Equal("alice@example.com", resp.Attributes["email"].Value)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestEnroll_UsesFallback() {
+	identifiers := map[string]interface{}{"username": "alice"}
+	credentials := map[string]interface{}{"passkey": "data"}
+	suite.mockFallback.On("Enroll", mock.Anything, identifiers, credentials, mock.Anything).
+		Return(&providers.AuthnResult{}, nil).Once()
+
+	_, err := suite.provider.Enroll(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(err)
+}
@@ -32,6 +32,15 @@ type connectionExportModel struct {
 	TokenExchangeEnabled  *bool    `yaml:"tokenExchangeEnabled,omitempty"  json:"tokenExchangeEnabled,omitempty"`
 	TrustedTokenAudience  string   `yaml:"trustedTokenAudience,omitempty"  json:"trustedTokenAudience,omitempty"`
 
+	// SAML vendor fields (saml); redirectUri is shared with the OAuth-based vendors.
+	SPEntityID        string `yaml:"spEntityId,omitempty"        json:"spEntityId,omitempty"`
+	IDPEntityID       string `yaml:"idpEntityId,omitempty"       json:"idpEntityId,omitempty"`
+	SSOURL            string `yaml:"ssoUrl,omitempty"            json:"ssoUrl,omitempty"`
+	IDPCertificate    string `yaml:"idpCertificate,omitempty"    json:"idpCertificate,omitempty"`
+	NameIDFormat      string `yaml:"nameIdFormat,omitempty"      json:"nameIdFormat,omitempty"`
+	SignAuthnRequests *bool  `yaml:"signAuthnRequests,omitempty" json:"signAuthnRequests,omitempty"`
+	Metadata          string `yaml:"metadata,omitempty"          json:"metadata,omitempty"`
+
 	//nolint:lll // long struct tag: both yaml and json keys needed for declarative load/export and import
 	AttributeConfiguration *providers.AttributeConfiguration `yaml:"attributeConfiguration,omitempty" json:"attributeConfiguration,omitempty"`
 
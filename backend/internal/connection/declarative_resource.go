@@ -233,6 +233,11 @@ func connectionModelFromIDPDTO(dto providers.IDPDTO) (connectionExportModel, err
 		JwksEndpoint:           values[idp.PropJwksEndpoint],
 		Issuer:                 values[idp.PropIssuer],
 		TrustedTokenAudience:   values[idp.PropTrustedTokenAudience],
+		SPEntityID:             values[idp.PropSPEntityID],
+		IDPEntityID:            values[idp.PropIDPEntityID],
+		SSOURL:                 values[idp.PropSSOURL],
+		IDPCertificate:         values[idp.PropIDPCertificate],
+		NameIDFormat:           values[idp.PropNameIDFormat],
 		AttributeConfiguration: dto.AttributeConfiguration,
 	}
 	if raw, ok := values[idp.PropTokenExchangeEnabled]; ok {
@@ -240,6 +245,11 @@ func connectionModelFromIDPDTO(dto providers.IDPDTO) (connectionExportModel, err
 			model.TokenExchangeEnabled = &enabled
 		}
 	}
+	if raw, ok := values[idp.PropSignAuthnRequests]; ok {
+		if enabled, parseErr := strconv.ParseBool(raw); parseErr == nil {
+			model.SignAuthnRequests = &enabled
+		}
+	}
 	return model, nil
 }
 
@@ -335,6 +345,19 @@ func connectionModelToDTO(model connectionExportModel) (*providers.IDPDTO, *ncom
 		}
 		dto.ID = model.ID
 		return dto, nil, nil
+	case "saml":
+		dto, err := samlToIDPDTO(samlConnectionRequest{
+			Name: model.Name, Description: model.Description, SPEntityID: model.SPEntityID,
+			RedirectURI: model.RedirectURI, IDPEntityID: model.IDPEntityID, SSOURL: model.SSOURL,
+			IDPCertificate: model.IDPCertificate, NameIDFormat: model.NameIDFormat,
+			SignAuthnRequests: model.SignAuthnRequests, Metadata: model.Metadata,
+			AttributeConfiguration: model.AttributeConfiguration,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		dto.ID = model.ID
+		return dto, nil, nil
 	case "twilio":
 		dto, err := twilioToSenderDTO(twilioConnectionRequest{
 			Name: model.Name, Description: model.Description, AccountSID: model.AccountSID,
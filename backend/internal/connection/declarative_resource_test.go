@@ -68,7 +68,7 @@ func (s *DeclarativeResourceTestSuite) TestConnectionModelFromIDPDTOUnmasksSecre
 }
 
 func (s *DeclarativeResourceTestSuite) TestConnectionModelFromIDPDTORejectsUnregisteredType() {
-	_, err := connectionModelFromIDPDTO(providers.IDPDTO{ID: "x", Type: providers.IDPType("SAML")})
+	_, err := connectionModelFromIDPDTO(providers.IDPDTO{ID: "x", Type: providers.IDPType("UNKNOWN")})
 	s.Error(err)
 }
 
@@ -379,7 +379,7 @@ func (s *DeclarativeResourceTestSuite) TestGetResourceByIDFallsBackToSender() {
 func (s *DeclarativeResourceTestSuite) TestGetAllResourceIDsFiltersUnregisteredVendors() {
 	s.mockIDP.On("GetIdentityProviderList", mock.Anything).Return([]idp.BasicIDPDTO{
 		{ID: "1", Type: providers.IDPTypeGoogle},
-		{ID: "2", Type: providers.IDPType("SAML")}, // unregistered -> excluded
+		{ID: "2", Type: providers.IDPType("UNKNOWN")}, // unregistered -> excluded
 	}, (*tidcommon.ServiceError)(nil))
 	s.mockNotif.On("ListSenders", mock.Anything).Return([]ncommon.NotificationSenderDTO{
 		{ID: "s1", Type: ncommon.NotificationSenderTypeMessage, Provider: ncommon.MessageProviderTypeTwilio},
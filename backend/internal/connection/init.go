@@ -81,6 +81,11 @@ func registerRoutes(mux *http.ServeMux, h *handler) {
 		getHandler(h, providers.IDPTypeOAuth, oauthFromIDPDTO),
 		updateHandler(h, providers.IDPTypeOAuth, oauthToIDPDTO, oauthFromIDPDTO),
 		collectionOpts, itemOpts)
+	registerVendorRoutes(mux, h, "/connections/saml", providers.IDPTypeSAML,
+		createHandler(h, samlToIDPDTO, samlFromIDPDTO),
+		getHandler(h, providers.IDPTypeSAML, samlFromIDPDTO),
+		updateHandler(h, providers.IDPTypeSAML, samlToIDPDTO, samlFromIDPDTO),
+		collectionOpts, itemOpts)
 
 	// SMS-backed vendors.
 	registerSMSVendorRoutes(mux, h, "/connections/twilio", ncommon.MessageProviderTypeTwilio,
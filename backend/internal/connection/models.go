@@ -22,12 +22,14 @@ type idpBackedVendor struct {
 // idpBackedVendors is the set of connection types backed by the identity-provider service.
 // The generic "oidc" connection covers custom OIDC providers;
 // "oauth" covers OAuth 2.0 providers that don't implement OIDC discovery and have no id_token,
-// taking user attributes from the provider's own profile API instead.
+// taking user attributes from the provider's own profile API instead; "saml" covers SAML 2.0
+// identity providers such as ADFS or Okta.
 var idpBackedVendors = []idpBackedVendor{
 	{name: "google", idpType: providers.IDPTypeGoogle},
 	{name: "github", idpType: providers.IDPTypeGitHub},
 	{name: "oidc", idpType: providers.IDPTypeOIDC},
 	{name: "oauth", idpType: providers.IDPTypeOAuth},
+	{name: "saml", idpType: providers.IDPTypeSAML},
 }
 
 // smsGatewayVendorName is the connection vendor name for the generic HTTP SMS gateway. The
@@ -0,0 +1,114 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"strconv"
+
+	authnsaml "github.com/thunder-id/thunderid/internal/authn/saml"
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// samlConnectionRequest is the create/update payload for a SAML 2.0 connection. The identity
+// provider's entity ID, SSO URL and signing certificate can be given directly or imported from its
+// metadata document; explicitly given values take precedence over the metadata.
+type samlConnectionRequest struct {
+	Name              string `json:"name"`
+	Description       string `json:"description,omitempty"`
+	SPEntityID        string `json:"spEntityId"`
+	RedirectURI       string `json:"redirectUri"`
+	IDPEntityID       string `json:"idpEntityId,omitempty"`
+	SSOURL            string `json:"ssoUrl,omitempty"`
+	IDPCertificate    string `json:"idpCertificate,omitempty"`
+	NameIDFormat      string `json:"nameIdFormat,omitempty"`
+	SignAuthnRequests *bool  `json:"signAuthnRequests,omitempty"`
+	Metadata          string `json:"metadata,omitempty"`
+
+	AttributeConfiguration *providers.AttributeConfiguration `json:"attributeConfiguration,omitempty"`
+}
+
+// samlConnectionResponse is the detail payload for a SAML 2.0 connection. ACSURL is the assertion
+// consumer service to register with the identity provider.
+type samlConnectionResponse struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Description       string `json:"description,omitempty"`
+	Type              string `json:"type"`
+	SPEntityID        string `json:"spEntityId,omitempty"`
+	RedirectURI       string `json:"redirectUri,omitempty"`
+	ACSURL            string `json:"acsUrl,omitempty"`
+	IDPEntityID       string `json:"idpEntityId,omitempty"`
+	SSOURL            string `json:"ssoUrl,omitempty"`
+	IDPCertificate    string `json:"idpCertificate,omitempty"`
+	NameIDFormat      string `json:"nameIdFormat,omitempty"`
+	SignAuthnRequests *bool  `json:"signAuthnRequests,omitempty"`
+
+	AttributeConfiguration *providers.AttributeConfiguration `json:"attributeConfiguration,omitempty"`
+}
+
+func samlToIDPDTO(req samlConnectionRequest) (*providers.IDPDTO, error) {
+	var props []cmodels.Property
+	var err error
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{idp.PropSPEntityID, req.SPEntityID},
+		{idp.PropRedirectURI, req.RedirectURI},
+		{idp.PropIDPEntityID, req.IDPEntityID},
+		{idp.PropSSOURL, req.SSOURL},
+		{idp.PropIDPCertificate, req.IDPCertificate},
+		{idp.PropNameIDFormat, req.NameIDFormat},
+		{idp.PropMetadata, req.Metadata},
+	}
+	for _, field := range fields {
+		if props, err = appendProperty(props, field.name, field.value, false); err != nil {
+			return nil, err
+		}
+	}
+	if req.SignAuthnRequests != nil {
+		if props, err = appendProperty(props, idp.PropSignAuthnRequests,
+			strconv.FormatBool(*req.SignAuthnRequests), false); err != nil {
+			return nil, err
+		}
+	}
+	return &providers.IDPDTO{
+		Name:                   req.Name,
+		Description:            req.Description,
+		Type:                   providers.IDPTypeSAML,
+		Properties:             props,
+		AttributeConfiguration: req.AttributeConfiguration,
+	}, nil
+}
+
+func samlFromIDPDTO(dto providers.IDPDTO) (samlConnectionResponse, error) {
+	values, err := propertyValues(dto.Properties)
+	if err != nil {
+		return samlConnectionResponse{}, err
+	}
+	resp := samlConnectionResponse{
+		ID:             dto.ID,
+		Name:           dto.Name,
+		Description:    dto.Description,
+		Type:           connectionTypeName(dto.Type),
+		SPEntityID:     values[idp.PropSPEntityID],
+		RedirectURI:    values[idp.PropRedirectURI],
+		IDPEntityID:    values[idp.PropIDPEntityID],
+		SSOURL:         values[idp.PropSSOURL],
+		IDPCertificate: values[idp.PropIDPCertificate],
+		NameIDFormat:   values[idp.PropNameIDFormat],
+	}
+	if dto.ID != "" {
+		resp.ACSURL = authnsaml.GetACSURL(dto.ID)
+	}
+	if raw, ok := values[idp.PropSignAuthnRequests]; ok {
+		if enabled, parseErr := strconv.ParseBool(raw); parseErr == nil {
+			resp.SignAuthnRequests = &enabled
+		}
+	}
+	resp.AttributeConfiguration = dto.AttributeConfiguration
+	return resp, nil
+}
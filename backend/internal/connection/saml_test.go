@@ -0,0 +1,112 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
+)
+
+type SAMLTestSuite struct {
+	suite.Suite
+	handler *handler
+	mockIDP *idpmock.IDPServiceInterfaceMock
+}
+
+func TestSAMLSuite(t *testing.T) {
+	suite.Run(t, new(SAMLTestSuite))
+}
+
+func (s *SAMLTestSuite) SetupTest() {
+	s.handler, s.mockIDP, _ = newConnectionTestHandler(s.T())
+	config.GetServerRuntime().Config.Server.PublicURL = "https://thunderid.io"
+}
+
+func (s *SAMLTestSuite) TestToIDPDTOSetsSAMLType() {
+	dto, err := samlToIDPDTO(samlConnectionRequest{
+		Name: "ADFS", SPEntityID: "https://thunderid.io/sp", RedirectURI: "https://app/cb",
+		IDPEntityID: "https://adfs.example.com", SSOURL: "https://adfs.example.com/sso",
+		IDPCertificate: "cert", SignAuthnRequests: boolPtr(true),
+	})
+	s.Require().NoError(err)
+	s.Equal(providers.IDPTypeSAML, dto.Type)
+
+	values, err := propertyValues(dto.Properties)
+	s.Require().NoError(err)
+	s.Equal("https://thunderid.io/sp", values[idp.PropSPEntityID])
+	s.Equal("https://adfs.example.com/sso", values[idp.PropSSOURL])
+	s.Equal("true", values[idp.PropSignAuthnRequests])
+	s.NotContains(values, idp.PropMetadata)
+}
+
+func (s *SAMLTestSuite) TestToIDPDTOPassesMetadata() {
+	dto, err := samlToIDPDTO(samlConnectionRequest{
+		Name: "Okta", SPEntityID: "https://thunderid.io/sp", RedirectURI: "https://app/cb",
+		Metadata: "<EntityDescriptor/>",
+	})
+	s.Require().NoError(err)
+
+	values, err := propertyValues(dto.Properties)
+	s.Require().NoError(err)
+	s.Equal("<EntityDescriptor/>", values[idp.PropMetadata])
+	s.NotContains(values, idp.PropSignAuthnRequests)
+}
+
+func (s *SAMLTestSuite) TestGetIncludesACSURL() {
+	s.mockIDP.On("GetIdentityProvider", mock.Anything, "saml-1").
+		Return(&providers.IDPDTO{
+			ID:   "saml-1",
+			Name: "ADFS",
+			Type: providers.IDPTypeSAML,
+			Properties: []cmodels.Property{
+				mustProperty(s.T(), idp.PropIDPEntityID, "https://adfs.example.com", false),
+				mustProperty(s.T(), idp.PropSignAuthnRequests, "false", false),
+			},
+		}, (*tidcommon.ServiceError)(nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/connections/saml/saml-1", nil)
+	req.SetPathValue("id", "saml-1")
+	rr := httptest.NewRecorder()
+	getHandler(s.handler, providers.IDPTypeSAML, samlFromIDPDTO)(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp samlConnectionResponse
+	s.Require().NoError(json.NewDecoder(rr.Body).Decode(&resp))
+	s.Equal("saml", resp.Type)
+	s.Equal("https://adfs.example.com", resp.IDPEntityID)
+	s.Equal("https://thunderid.io/saml2/sp/saml-1/acs", resp.ACSURL)
+	s.Require().NotNil(resp.SignAuthnRequests)
+	s.False(*resp.SignAuthnRequests)
+}
+
+func (s *SAMLTestSuite) TestCreate() {
+	created := &providers.IDPDTO{ID: "saml-1", Name: "ADFS", Type: providers.IDPTypeSAML}
+	s.mockIDP.On("CreateIdentityProvider", mock.Anything, mock.MatchedBy(func(dto *providers.IDPDTO) bool {
+		return dto.Type == providers.IDPTypeSAML
+	})).Return(created, (*tidcommon.ServiceError)(nil))
+
+	body, _ := json.Marshal(samlConnectionRequest{
+		Name: "ADFS", SPEntityID: "https://thunderid.io/sp", RedirectURI: "https://app/cb",
+		Metadata: "<EntityDescriptor/>",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/connections/saml", bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	createHandler(s.handler, samlToIDPDTO, samlFromIDPDTO)(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	s.Contains(rr.Body.String(), `"acsUrl":"https://thunderid.io/saml2/sp/saml-1/acs"`)
+}
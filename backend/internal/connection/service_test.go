@@ -73,7 +73,7 @@ func (s *ServiceTestSuite) TestListInstancesAllCategories() {
 	s.mockIDP.On("GetIdentityProviderList", mock.Anything).Return([]idp.BasicIDPDTO{
 		{ID: "1", Name: "google B", Type: providers.IDPTypeGoogle},
 		{ID: "2", Name: "Google A", Type: providers.IDPTypeGoogle},
-		{ID: "3", Name: "Legacy", Type: providers.IDPType("SAML")},
+		{ID: "3", Name: "Legacy", Type: providers.IDPType("UNKNOWN")},
 	}, (*tidcommon.ServiceError)(nil))
 	s.mockNotif.On("ListSendersByType", mock.Anything, ncommon.NotificationSenderTypeMessage).
 		Return([]ncommon.NotificationSenderDTO{
@@ -74,12 +74,15 @@ const (
 	// NamespacePermission represents the permission consent namespace.
 	// Used for managing consent over resource action permissions (e.g. booking:reservations:read).
 	NamespacePermission Namespace = "permission"
+	// NamespaceAuthorizationDetail represents the authorization detail consent namespace.
+	// Used for managing consent over RFC 9396 authorization details, named by their canonical JSON form.
+	NamespaceAuthorizationDetail Namespace = "authorization_detail"
 )
 
 // IsValid reports whether the namespace is one of the known consent namespaces.
 func (n Namespace) IsValid() bool {
 	switch n {
-	case NamespaceAttribute, NamespacePermission:
+	case NamespaceAttribute, NamespacePermission, NamespaceAuthorizationDetail:
 		return true
 	default:
 		return false
@@ -202,6 +202,12 @@ func (s *cacheBackedEntityStore) GetEntityListByOUIDs(ctx context.Context,
 	return s.store.GetEntityListByOUIDs(ctx, category, ouIDs, limit, offset, filters)
 }
 
+func (s *cacheBackedEntityStore) GetEntityListAfterID(ctx context.Context,
+	category string, ouIDs []string, limit int, afterID string,
+	filters map[string]interface{}) ([]providers.Entity, error) {
+	return s.store.GetEntityListAfterID(ctx, category, ouIDs, limit, afterID, filters)
+}
+
 func (s *cacheBackedEntityStore) ValidateEntityIDs(ctx context.Context,
 	entityIDs []string) ([]string, error) {
 	return s.store.ValidateEntityIDs(ctx, entityIDs)
@@ -217,6 +223,11 @@ func (s *cacheBackedEntityStore) ValidateEntityIDsInOUs(ctx context.Context,
 	return s.store.ValidateEntityIDsInOUs(ctx, entityIDs, ouIDs)
 }
 
+func (s *cacheBackedEntityStore) GetCredentialAlgorithmCounts(ctx context.Context,
+	category string) ([]credentialAlgorithmCount, error) {
+	return s.store.GetCredentialAlgorithmCounts(ctx, category)
+}
+
 func (s *cacheBackedEntityStore) GetGroupCountForEntity(ctx context.Context,
 	entityID string) (int, error) {
 	return s.store.GetGroupCountForEntity(ctx, entityID)
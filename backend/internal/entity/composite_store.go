@@ -182,6 +182,22 @@ func (c *entityCompositeStore) GetEntityList(ctx context.Context, category strin
 	return entities, nil
 }
 
+// GetEntityListAfterID retrieves up to limit entities whose ID sorts after afterID from both stores, in
+// ID order. Each store returns its own first limit entities, so the first limit of their union are the
+// first limit overall.
+func (c *entityCompositeStore) GetEntityListAfterID(ctx context.Context, category string,
+	ouIDs []string, limit int, afterID string, filters map[string]interface{}) ([]providers.Entity, error) {
+	dbEntities, err := c.dbStore.GetEntityListAfterID(ctx, category, ouIDs, limit, afterID, filters)
+	if err != nil {
+		return nil, err
+	}
+	fileEntities, err := c.fileStore.GetEntityListAfterID(ctx, category, ouIDs, limit, afterID, filters)
+	if err != nil {
+		return nil, err
+	}
+	return firstEntitiesByID(mergeAndDeduplicateEntities(dbEntities, fileEntities), limit), nil
+}
+
 // GetEntityListCountByOUIDs retrieves the total count of entities by OU IDs from both stores.
 func (c *entityCompositeStore) GetEntityListCountByOUIDs(ctx context.Context, category string,
 	ouIDs []string, filters map[string]interface{}) (int, error) {
@@ -293,6 +309,20 @@ func (c *entityCompositeStore) ValidateEntityIDsInOUs(
 	return outOfScope, nil
 }
 
+// GetCredentialAlgorithmCounts counts the stored credentials of both stores.
+func (c *entityCompositeStore) GetCredentialAlgorithmCounts(ctx context.Context,
+	category string) ([]credentialAlgorithmCount, error) {
+	dbCounts, err := c.dbStore.GetCredentialAlgorithmCounts(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	fileCounts, err := c.fileStore.GetCredentialAlgorithmCounts(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	return append(dbCounts, fileCounts...), nil
+}
+
 // GetGroupCountForEntity delegates to DB store only (groups are for mutable entities).
 func (c *entityCompositeStore) GetGroupCountForEntity(ctx context.Context, entityID string) (int, error) {
 	return c.dbStore.GetGroupCountForEntity(ctx, entityID)
@@ -150,6 +150,26 @@ func (s *CompositeStoreTestSuite) TestGetGroupCountForEntity_Delegates() {
 	s.Equal(3, count)
 }
 
+func (s *CompositeStoreTestSuite) TestGetCredentialAlgorithmCounts_CombinesStores() {
+	dbCount := credentialAlgorithmCount{CredentialType: "password", Count: 4}
+	fileCount := credentialAlgorithmCount{CredentialType: "password", Count: 1}
+	s.dbStore.On("GetCredentialAlgorithmCounts", mock.Anything, "user").
+		Return([]credentialAlgorithmCount{dbCount}, nil)
+	s.fileStore.On("GetCredentialAlgorithmCounts", mock.Anything, "user").
+		Return([]credentialAlgorithmCount{fileCount}, nil)
+
+	counts, err := s.store.GetCredentialAlgorithmCounts(s.ctx, "user")
+	s.NoError(err)
+	s.Equal([]credentialAlgorithmCount{dbCount, fileCount}, counts)
+}
+
+func (s *CompositeStoreTestSuite) TestGetCredentialAlgorithmCounts_DBError() {
+	s.dbStore.On("GetCredentialAlgorithmCounts", mock.Anything, "user").Return(nil, s.testErr)
+
+	_, err := s.store.GetCredentialAlgorithmCounts(s.ctx, "user")
+	s.ErrorIs(err, s.testErr)
+}
+
 func (s *CompositeStoreTestSuite) TestGetEntityGroups_Delegates() {
 	groups := []providers.EntityGroup{{ID: "g1"}}
 	s.dbStore.On("GetEntityGroups", mock.Anything, "e1", 10, 0).Return(groups, nil)
@@ -248,6 +268,26 @@ func (s *CompositeStoreTestSuite) TestGetEntityList_Success() {
 	s.Len(list, 2)
 }
 
+func (s *CompositeStoreTestSuite) TestGetEntityListAfterID_MergesInIDOrder() {
+	s.dbStore.On("GetEntityListAfterID", mock.Anything, "user", []string(nil), 2, "a", mock.Anything).
+		Return([]providers.Entity{compEntity("b", "ou1"), compEntity("d", "ou1")}, nil)
+	s.fileStore.On("GetEntityListAfterID", mock.Anything, "user", []string(nil), 2, "a", mock.Anything).
+		Return([]providers.Entity{compEntity("c", "ou1"), compEntity("e", "ou1")}, nil)
+
+	list, err := s.store.GetEntityListAfterID(s.ctx, "user", nil, 2, "a", nil)
+	s.NoError(err)
+	s.Require().Len(list, 2)
+	s.Equal("b", list[0].ID)
+	s.Equal("c", list[1].ID)
+}
+
+func (s *CompositeStoreTestSuite) TestGetEntityListAfterID_Error() {
+	s.dbStore.On("GetEntityListAfterID", mock.Anything, "user", []string(nil), 2, "", mock.Anything).
+		Return(nil, s.testErr)
+	_, err := s.store.GetEntityListAfterID(s.ctx, "user", nil, 2, "", nil)
+	s.Error(err)
+}
+
 func (s *CompositeStoreTestSuite) TestGetEntityList_LimitExceeded() {
 	limit := serverconst.MaxCompositeStoreRecords + 1
 
@@ -165,6 +165,74 @@ func (_c *entityStoreInterfaceMock_DeleteEntity_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetCredentialAlgorithmCounts provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) GetCredentialAlgorithmCounts(ctx context.Context, category string) ([]credentialAlgorithmCount, error) {
+	ret := _mock.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredentialAlgorithmCounts")
+	}
+
+	var r0 []credentialAlgorithmCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]credentialAlgorithmCount, error)); ok {
+		return returnFunc(ctx, category)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []credentialAlgorithmCount); ok {
+		r0 = returnFunc(ctx, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]credentialAlgorithmCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialAlgorithmCounts'
+type entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call struct {
+	*mock.Call
+}
+
+// GetCredentialAlgorithmCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category string
+func (_e *entityStoreInterfaceMock_Expecter) GetCredentialAlgorithmCounts(ctx interface{}, category interface{}) *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call {
+	return &entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call{Call: _e.mock.On("GetCredentialAlgorithmCounts", ctx, category)}
+}
+
+func (_c *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call) Run(run func(ctx context.Context, category string)) *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call) Return(credentialAlgorithmCounts []credentialAlgorithmCount, err error) *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call {
+	_c.Call.Return(credentialAlgorithmCounts, err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call) RunAndReturn(run func(ctx context.Context, category string) ([]credentialAlgorithmCount, error)) *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntitiesByIDs provides a mock function for the type entityStoreInterfaceMock
 func (_mock *entityStoreInterfaceMock) GetEntitiesByIDs(ctx context.Context, entityIDs []string) ([]providers.Entity, error) {
 	ret := _mock.Called(ctx, entityIDs)
@@ -465,6 +533,98 @@ func (_c *entityStoreInterfaceMock_GetEntityList_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetEntityListAfterID provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) GetEntityListAfterID(ctx context.Context, category string, ouIDs []string, limit int, afterID string, filters map[string]interface{}) ([]providers.Entity, error) {
+	ret := _mock.Called(ctx, category, ouIDs, limit, afterID, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntityListAfterID")
+	}
+
+	var r0 []providers.Entity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, string, map[string]interface{}) ([]providers.Entity, error)); ok {
+		return returnFunc(ctx, category, ouIDs, limit, afterID, filters)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, int, string, map[string]interface{}) []providers.Entity); ok {
+		r0 = returnFunc(ctx, category, ouIDs, limit, afterID, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]providers.Entity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, int, string, map[string]interface{}) error); ok {
+		r1 = returnFunc(ctx, category, ouIDs, limit, afterID, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// entityStoreInterfaceMock_GetEntityListAfterID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntityListAfterID'
+type entityStoreInterfaceMock_GetEntityListAfterID_Call struct {
+	*mock.Call
+}
+
+// GetEntityListAfterID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category string
+//   - ouIDs []string
+//   - limit int
+//   - afterID string
+//   - filters map[string]interface{}
+func (_e *entityStoreInterfaceMock_Expecter) GetEntityListAfterID(ctx interface{}, category interface{}, ouIDs interface{}, limit interface{}, afterID interface{}, filters interface{}) *entityStoreInterfaceMock_GetEntityListAfterID_Call {
+	return &entityStoreInterfaceMock_GetEntityListAfterID_Call{Call: _e.mock.On("GetEntityListAfterID", ctx, category, ouIDs, limit, afterID, filters)}
+}
+
+func (_c *entityStoreInterfaceMock_GetEntityListAfterID_Call) Run(run func(ctx context.Context, category string, ouIDs []string, limit int, afterID string, filters map[string]interface{})) *entityStoreInterfaceMock_GetEntityListAfterID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 map[string]interface{}
+		if args[5] != nil {
+			arg5 = args[5].(map[string]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetEntityListAfterID_Call) Return(entitys []providers.Entity, err error) *entityStoreInterfaceMock_GetEntityListAfterID_Call {
+	_c.Call.Return(entitys, err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetEntityListAfterID_Call) RunAndReturn(run func(ctx context.Context, category string, ouIDs []string, limit int, afterID string, filters map[string]interface{}) ([]providers.Entity, error)) *entityStoreInterfaceMock_GetEntityListAfterID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntityListByOUIDs provides a mock function for the type entityStoreInterfaceMock
 func (_mock *entityStoreInterfaceMock) GetEntityListByOUIDs(ctx context.Context, category string, ouIDs []string, limit int, offset int, filters map[string]interface{}) ([]providers.Entity, error) {
 	ret := _mock.Called(ctx, category, ouIDs, limit, offset, filters)
@@ -3,7 +3,11 @@
 
 package entity
 
-import "errors"
+import (
+	"errors"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
 
 // Error variables for entity operations.
 var (
@@ -22,6 +26,9 @@ var (
 	// ErrInvalidCredential is returned when a credential value is invalid.
 	ErrInvalidCredential = errors.New("invalid credential")
 
+	// ErrCredentialPolicyViolation is returned when a credential value violates the credential policy.
+	ErrCredentialPolicyViolation = errors.New("credential policy violation")
+
 	// ErrAmbiguousEntity is returned when multiple entities match the provided filters.
 	ErrAmbiguousEntity = errors.New("ambiguous entity")
 
@@ -31,3 +38,20 @@ var (
 	// errResultLimitExceededInCompositeMode is returned when the result limit is exceeded in composite mode.
 	errResultLimitExceededInCompositeMode = errors.New("result limit exceeded in composite mode")
 )
+
+// CredentialPolicyError reports a credential value rejected by the credential policy. It matches
+// ErrCredentialPolicyViolation with errors.Is.
+type CredentialPolicyError struct {
+	// Violation describes the policy rule the value violates.
+	Violation *tidcommon.ServiceError
+}
+
+// Error returns the error message.
+func (e *CredentialPolicyError) Error() string {
+	return ErrCredentialPolicyViolation.Error() + ": " + e.Violation.ErrorDescription.String()
+}
+
+// Unwrap returns ErrCredentialPolicyViolation.
+func (e *CredentialPolicyError) Unwrap() error {
+	return ErrCredentialPolicyViolation
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"slices"
 	"strings"
 
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	entitystore "github.com/thunder-id/thunderid/internal/system/declarative_resource/entity"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -266,6 +268,32 @@ func (f *entityFileBasedStore) GetEntityListByOUIDs(ctx context.Context, categor
 	return applyPagination(entities, limit, offset), nil
 }
 
+// GetEntityListAfterID retrieves up to limit entities whose ID sorts after afterID, in ID order. ouIDs,
+// when not nil, restricts the entities to those organization units.
+func (f *entityFileBasedStore) GetEntityListAfterID(ctx context.Context, category string,
+	ouIDs []string, limit int, afterID string, filters map[string]interface{}) ([]providers.Entity, error) {
+	resources, err := f.listEntityResources()
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]providers.Entity, 0)
+	for _, resource := range resources {
+		if string(resource.Entity.Category) != category || resource.Entity.ID <= afterID {
+			continue
+		}
+		if ouIDs != nil && !slices.Contains(ouIDs, resource.Entity.OUID) {
+			continue
+		}
+		combined := mergeJSONObjects(resource.Entity.Attributes, resource.Entity.SystemAttributes)
+		if matchesFilters(combined, filters) {
+			entities = append(entities, resource.Entity)
+		}
+	}
+
+	return firstEntitiesByID(entities, limit), nil
+}
+
 // GetGroupCountForEntity returns 0 for file-based store (groups are for mutable entities only).
 func (f *entityFileBasedStore) GetGroupCountForEntity(ctx context.Context, entityID string) (int, error) {
 	return 0, nil
@@ -347,6 +375,65 @@ func (f *entityFileBasedStore) ValidateEntityIDsInOUs(
 	return outOfScope, nil
 }
 
+// GetCredentialAlgorithmCounts counts the stored credentials of the category's entities in the file
+// store, grouped as the database store groups them.
+func (f *entityFileBasedStore) GetCredentialAlgorithmCounts(ctx context.Context,
+	category string) ([]credentialAlgorithmCount, error) {
+	resources, err := f.listEntityResources()
+	if err != nil {
+		return nil, err
+	}
+
+	type countKey struct {
+		credType    string
+		algorithm   cryptolib.CredAlgorithm
+		iterations  int
+		memory      int
+		parallelism int
+		keySize     int
+		saltLength  int
+	}
+	index := make(map[countKey]int)
+	counts := make([]credentialAlgorithmCount, 0)
+	for _, resource := range resources {
+		if string(resource.Entity.Category) != category {
+			continue
+		}
+		for _, column := range []json.RawMessage{resource.Credentials, resource.SystemCredentials} {
+			for credType, stored := range storedCredentialsByType(column) {
+				for _, cred := range stored {
+					if cred.StorageAlgo == "" {
+						continue
+					}
+					params := cred.StorageAlgoParams
+					key := countKey{credType, cred.StorageAlgo, params.Iterations, params.Memory,
+						params.Parallelism, params.KeySize, len(params.Salt)}
+					i, ok := index[key]
+					if !ok {
+						i = len(counts)
+						index[key] = i
+						counts = append(counts, credentialAlgorithmCount{
+							CredentialType: credType,
+							Credential: cryptolib.Credential{
+								Algorithm: cred.StorageAlgo,
+								Parameters: cryptolib.CredParameters{
+									Iterations:  params.Iterations,
+									Memory:      params.Memory,
+									Parallelism: params.Parallelism,
+									KeySize:     params.KeySize,
+									Salt:        strings.Repeat("0", len(params.Salt)),
+								},
+							},
+						})
+					}
+					counts[i].Count++
+				}
+			}
+		}
+	}
+	return counts, nil
+}
+
 // IsEntityDeclarative checks if an entity exists in the file store (all file entities are declarative).
 func (f *entityFileBasedStore) IsEntityDeclarative(ctx context.Context, id string) (bool, error) {
 	_, err := f.GetEntity(ctx, id)
@@ -389,6 +476,14 @@ func (f *entityFileBasedStore) listEntityResources() ([]*entityStoreEntry, error
 	return resources, nil
 }
 
+// firstEntitiesByID sorts the entities by ID and returns the first limit of them.
+func firstEntitiesByID(entities []providers.Entity, limit int) []providers.Entity {
+	slices.SortFunc(entities, func(a, b providers.Entity) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return entities[:min(max(limit, 0), len(entities))]
+}
+
 func applyPagination(entities []providers.Entity, limit, offset int) []providers.Entity {
 	if limit < 0 {
 		return []providers.Entity{}
@@ -6,10 +6,12 @@ package entity
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	entitystore "github.com/thunder-id/thunderid/internal/system/declarative_resource/entity"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -177,6 +179,30 @@ func (s *FileBasedStoreTestSuite) TestGetEntityList_Pagination() {
 	s.Empty(negLimit)
 }
 
+func (s *FileBasedStoreTestSuite) TestGetEntityListAfterID() {
+	s.seedEntity(makeTestEntity("k3", "user", "ou1"))
+	s.seedEntity(makeTestEntity("k1", "user", "ou1"))
+	s.seedEntity(makeTestEntity("k2", "user", "ou2"))
+	s.seedEntity(makeTestEntity("k0", "app", "ou1"))
+
+	page, err := s.store.GetEntityListAfterID(s.ctx, "user", nil, 2, "", nil)
+	s.NoError(err)
+	s.Require().Len(page, 2)
+	s.Equal("k1", page[0].ID)
+	s.Equal("k2", page[1].ID)
+
+	page, err = s.store.GetEntityListAfterID(s.ctx, "user", nil, 2, "k2", nil)
+	s.NoError(err)
+	s.Require().Len(page, 1)
+	s.Equal("k3", page[0].ID)
+
+	page, err = s.store.GetEntityListAfterID(s.ctx, "user", []string{"ou1"}, 10, "", nil)
+	s.NoError(err)
+	s.Require().Len(page, 2)
+	s.Equal("k1", page[0].ID)
+	s.Equal("k3", page[1].ID)
+}
+
 func (s *FileBasedStoreTestSuite) TestGetEntityListCountByOUIDs() {
 	s.seedEntity(makeTestEntity("ou1e1", "user", "ou-A"))
 	s.seedEntity(makeTestEntity("ou2e1", "user", "ou-B"))
@@ -198,6 +224,26 @@ func (s *FileBasedStoreTestSuite) TestGetEntityListByOUIDs_WithFilter() {
 	s.Equal("flt1", list[0].ID)
 }
 
+func (s *FileBasedStoreTestSuite) TestGetCredentialAlgorithmCounts() {
+	argon := `[{"storageAlgo":"ARGON2ID","storageAlgoParams":{"Iterations":2,"Salt":"%s"},"value":"h"}]`
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("u1", "user", "ou1"),
+		json.RawMessage(`{"password":`+fmt.Sprintf(argon, "aaaa")+`}`),
+		json.RawMessage(`{"passkey":{"id":"not-a-list"}}`)))
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("u2", "user", "ou1"),
+		json.RawMessage(`{"password":`+fmt.Sprintf(argon, "bbbb")+`}`), nil))
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("a1", "app", "ou1"),
+		json.RawMessage(`{"password":`+fmt.Sprintf(argon, "cccc")+`}`), nil))
+
+	counts, err := s.store.GetCredentialAlgorithmCounts(s.ctx, "user")
+	s.NoError(err)
+	s.Require().Len(counts, 1)
+	s.Equal("password", counts[0].CredentialType)
+	s.Equal(cryptolib.ARGON2ID, counts[0].Credential.Algorithm)
+	s.Equal(2, counts[0].Credential.Parameters.Iterations)
+	s.Len(counts[0].Credential.Parameters.Salt, 4)
+	s.Equal(2, counts[0].Count)
+}
+
 func (s *FileBasedStoreTestSuite) TestGetGroupCountForEntity() {
 	count, err := s.store.GetGroupCountForEntity(s.ctx, "any-id")
 	s.NoError(err)
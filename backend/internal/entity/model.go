@@ -7,10 +7,23 @@ package entity
 import (
 	"encoding/json"
 
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
+// systemAttrCredentialHistory is the entity system-attribute key retaining the replaced values of each
+// credential type, most recent first, for the credential policy to check new values against.
+const systemAttrCredentialHistory = "credentialHistory" // #nosec G101 -- attribute key, not a secret
+
+// reservedSystemAttributes lists the system-attribute keys this package writes, which are carried
+// over whenever a caller replaces an entity's system attributes.
+var reservedSystemAttributes = []string{
+	authnprovidercm.SystemAttrCredentialUpdatedAt,
+	authnprovidercm.SystemAttrCredentialCreatedAt,
+	systemAttrCredentialHistory,
+}
+
 // entityWithCredentials wraps an providers.Entity with its credential data.
 type entityWithCredentials struct {
 	Entity            *providers.Entity
@@ -42,6 +55,24 @@ type StoredCredential struct {
 	Value             string                   `json:"value"`
 }
 
+// CredentialAlgorithmUsage reports how many stored credentials of a type use a hashing algorithm, and how
+// many of them are due to be re-hashed with the configured algorithm on next successful verification.
+type CredentialAlgorithmUsage struct {
+	CredentialType string                  `json:"credentialType"`
+	Algorithm      cryptolib.CredAlgorithm `json:"algorithm"`
+	Count          int                     `json:"count"`
+	PendingUpgrade int                     `json:"pendingUpgrade"`
+}
+
+// credentialAlgorithmCount is the number of stored credentials of a type that share an algorithm and the
+// parameters deciding whether they need rehashing. Salts are unique per credential, so Credential holds a
+// placeholder salt of the shared salt length and no hash.
+type credentialAlgorithmCount struct {
+	CredentialType string
+	Credential     cryptolib.Credential
+	Count          int
+}
+
 // DeclarativeLoaderConfig configures declarative resource loading for a specific entity category.
 // Consumer packages (e.g., user) provide parser and validator callbacks for type-specific processing.
 type DeclarativeLoaderConfig struct {
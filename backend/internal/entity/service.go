@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -25,6 +28,8 @@ type EntityServiceInterface interface {
 	// Core CRUD
 	CreateEntity(ctx context.Context, entity *providers.Entity,
 		systemCredentials json.RawMessage) (*providers.Entity, error)
+	ImportEntity(ctx context.Context, entity *providers.Entity,
+		credentials map[string][]StoredCredential) (*providers.Entity, error)
 	GetEntity(ctx context.Context, entityID string) (*providers.Entity, error)
 	GetCredentialsByType(ctx context.Context, entityID string,
 		credType string) ([]StoredCredential, error)
@@ -52,6 +57,8 @@ type EntityServiceInterface interface {
 		ouIDs []string, filters map[string]interface{}) (int, error)
 	GetEntityListByOUIDs(ctx context.Context, category providers.EntityCategory,
 		ouIDs []string, limit, offset int, filters map[string]interface{}) ([]providers.Entity, error)
+	GetEntityListAfterID(ctx context.Context, category providers.EntityCategory,
+		ouIDs []string, limit int, afterID string, filters map[string]interface{}) ([]providers.Entity, error)
 
 	// Bulk
 	ValidateEntityIDs(ctx context.Context, entityIDs []string) ([]string, error)
@@ -68,6 +75,8 @@ type EntityServiceInterface interface {
 		credentials map[string]interface{}) (*AuthenticateResult, error)
 	AuthenticateEntityByID(ctx context.Context, entityID string,
 		credentials map[string]interface{}) (*AuthenticateResult, error)
+	GetCredentialAlgorithmUsage(ctx context.Context,
+		category providers.EntityCategory) ([]CredentialAlgorithmUsage, error)
 
 	// Declarative
 	IsEntityDeclarative(ctx context.Context, entityID string) (bool, error)
@@ -78,6 +87,9 @@ type EntityServiceInterface interface {
 
 	// GroupMembershipProvider registration
 	SetGroupMembershipProvider(provider GroupMembershipProvider)
+
+	// CredentialPolicy registration
+	SetCredentialPolicy(policy CredentialPolicy)
 }
 
 // GroupMembershipProvider resolves group memberships for entities. Implemented by the group
@@ -87,6 +99,17 @@ type GroupMembershipProvider interface {
 	GetTransitiveGroupsForEntity(ctx context.Context, entityID string) ([]providers.EntityGroup, error)
 }
 
+// CredentialPolicy validates new credential values against the policy governing an entity. Implemented
+// by the password policy package and injected after initialization to avoid a circular import.
+type CredentialPolicy interface {
+	// ValidateCredential returns the violation rejecting a new value of the credential type, or nil if the
+	// value is accepted. previous holds the values the entity used before, most recent first.
+	ValidateCredential(ctx context.Context, entity *providers.Entity, credType, value string,
+		previous []StoredCredential) *tidcommon.ServiceError
+	// HistorySize returns the number of replaced values of the credential type to retain for the entity.
+	HistorySize(ctx context.Context, entity *providers.Entity, credType string) int
+}
+
 // entityService is the default implementation of EntityServiceInterface.
 type entityService struct {
 	store                   entityStoreInterface
@@ -96,6 +119,7 @@ type entityService struct {
 	transactioner           providers.Transactioner
 	logger                  *log.Logger
 	groupMembershipProvider GroupMembershipProvider
+	credentialPolicy        CredentialPolicy
 }
 
 // usesEntityType reports whether entities of the given category route through the entity type
@@ -126,6 +150,31 @@ func newEntityService(
 // Uses a transaction to ensure the entity row and its indexed identifiers are created atomically.
 func (s *entityService) CreateEntity(ctx context.Context, entity *providers.Entity,
 	systemCredentials json.RawMessage) (*providers.Entity, error) {
+	return s.createEntity(ctx, entity, systemCredentials, nil)
+}
+
+// ImportEntity creates a new entity with schema credentials that were hashed by another system, such as
+// the identity provider the entity is migrated from. The imported credentials are stored as-is, so they
+// satisfy required credential attributes and are not checked against the credential policy. Plaintext
+// credential attributes are hashed as on create.
+func (s *entityService) ImportEntity(ctx context.Context, entity *providers.Entity,
+	credentials map[string][]StoredCredential) (*providers.Entity, error) {
+	if entity == nil {
+		return nil, ErrEntityNotFound
+	}
+	if len(credentials) > 0 {
+		if err := s.validateImportedCredentials(ctx, entity, credentials); err != nil {
+			return nil, err
+		}
+	}
+	return s.createEntity(ctx, entity, nil, credentials)
+}
+
+// createEntity creates a new entity, storing the imported schema credentials alongside the ones hashed
+// from its attributes.
+func (s *entityService) createEntity(ctx context.Context, entity *providers.Entity,
+	systemCredentials json.RawMessage, importedCredentials map[string][]StoredCredential,
+) (*providers.Entity, error) {
 	if entity == nil {
 		return nil, ErrEntityNotFound
 	}
@@ -140,7 +189,8 @@ func (s *entityService) CreateEntity(ctx context.Context, entity *providers.Enti
 	s.logger.Debug(ctx, "Creating entity", log.MaskedString("id", entity.ID))
 
 	// Validate entity attributes and uniqueness via schema.
-	if err := s.validateEntityType(ctx, entity.Category, entity.Type, entity.Attributes, "", false); err != nil {
+	if err := s.validateEntityType(ctx, entity.Category, entity.Type, entity.Attributes, "",
+		len(importedCredentials) > 0); err != nil {
 		return nil, err
 	}
 
@@ -149,6 +199,12 @@ func (s *entityService) CreateEntity(ctx context.Context, entity *providers.Enti
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract schema credentials: %w", err)
 	}
+	if len(importedCredentials) > 0 {
+		schemaCredsJSON, err = mergeImportedCredentials(schemaCredsJSON, importedCredentials)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Hash plaintext system credentials.
 	hashedSysCreds, err := s.hashPlaintextCredentials(systemCredentials)
@@ -156,6 +212,18 @@ func (s *entityService) CreateEntity(ctx context.Context, entity *providers.Enti
 		return nil, fmt.Errorf("failed to hash system credentials: %w", err)
 	}
 
+	// Record when the initial credential was set so credential age can be measured before the
+	// first change. The change marker itself is not stamped: tokens issued in the same second as
+	// the registration would otherwise be treated as predating a credential change.
+	if len(schemaCredsJSON) > 0 {
+		stamped, err := setSystemTimestamp(entity.SystemAttributes,
+			authnprovidercm.SystemAttrCredentialCreatedAt, time.Now().UTC())
+		if err != nil {
+			return nil, err
+		}
+		entity.SystemAttributes = stamped
+	}
+
 	var created providers.Entity
 	err = s.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		if err := s.store.CreateEntity(txCtx, *entity, schemaCredsJSON, hashedSysCreds); err != nil {
@@ -409,6 +477,14 @@ func (s *entityService) GetEntityListByOUIDs(ctx context.Context, category provi
 	return s.store.GetEntityListByOUIDs(ctx, string(category), ouIDs, limit, offset, filters)
 }
 
+// GetEntityListAfterID retrieves up to limit entities of a category whose ID sorts after afterID, in ID
+// order. Passing the last ID of one page as afterID of the next pages through the entities without the
+// cost of an offset. ouIDs, when not nil, restricts the entities to those organization units.
+func (s *entityService) GetEntityListAfterID(ctx context.Context, category providers.EntityCategory,
+	ouIDs []string, limit int, afterID string, filters map[string]interface{}) ([]providers.Entity, error) {
+	return s.store.GetEntityListAfterID(ctx, string(category), ouIDs, limit, afterID, filters)
+}
+
 // ValidateEntityIDs checks if all provided entity IDs exist.
 func (s *entityService) ValidateEntityIDs(ctx context.Context, entityIDs []string) ([]string, error) {
 	return s.store.ValidateEntityIDs(ctx, entityIDs)
@@ -441,6 +517,11 @@ func (s *entityService) SetGroupMembershipProvider(provider GroupMembershipProvi
 	s.groupMembershipProvider = provider
 }
 
+// SetCredentialPolicy registers the policy new credential values are validated against.
+func (s *entityService) SetCredentialPolicy(policy CredentialPolicy) {
+	s.credentialPolicy = policy
+}
+
 // GetTransitiveEntityGroups retrieves all groups an entity belongs to, including nested group membership.
 // Delegates entirely to the group membership provider which covers both DB and declarative groups.
 func (s *entityService) GetTransitiveEntityGroups(
@@ -498,9 +579,13 @@ func (s *entityService) AuthenticateEntityByID(
 		return nil, ErrEntityNotFound
 	}
 
-	if err := s.verifyCredentials(credentials, result.SchemaCredentials, result.SystemCredentials); err != nil {
+	upgrades, err := s.verifyCredentials(credentials, result.SchemaCredentials, result.SystemCredentials)
+	if err != nil {
 		return nil, err
 	}
+	if len(upgrades) > 0 {
+		s.upgradeCredentialHashes(ctx, entityID, upgrades)
+	}
 
 	return &AuthenticateResult{
 		EntityID:       result.Entity.ID,
@@ -510,15 +595,85 @@ func (s *entityService) AuthenticateEntityByID(
 	}, nil
 }
 
-// verifyCredentials verifies provided credentials from both schema and system credentials.
+// GetCredentialAlgorithmUsage reports, for every credential type stored by entities of the category, how
+// many credentials use each hashing algorithm and how many are due to be re-hashed on next use.
+func (s *entityService) GetCredentialAlgorithmUsage(ctx context.Context,
+	category providers.EntityCategory) ([]CredentialAlgorithmUsage, error) {
+	counts, err := s.store.GetCredentialAlgorithmCounts(ctx, string(category))
+	if err != nil {
+		return nil, err
+	}
+
+	type usageKey struct {
+		credType  string
+		algorithm cryptolib.CredAlgorithm
+	}
+	usage := make(map[usageKey]*CredentialAlgorithmUsage)
+	for _, count := range counts {
+		key := usageKey{credType: count.CredentialType, algorithm: count.Credential.Algorithm}
+		entry, ok := usage[key]
+		if !ok {
+			entry = &CredentialAlgorithmUsage{CredentialType: count.CredentialType, Algorithm: key.algorithm}
+			usage[key] = entry
+		}
+		entry.Count += count.Count
+		if s.hashService.NeedsRehash(count.Credential) {
+			entry.PendingUpgrade += count.Count
+		}
+	}
+
+	result := make([]CredentialAlgorithmUsage, 0, len(usage))
+	for _, entry := range usage {
+		result = append(result, *entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].CredentialType != result[j].CredentialType {
+			return result[i].CredentialType < result[j].CredentialType
+		}
+		return result[i].Algorithm < result[j].Algorithm
+	})
+	return result, nil
+}
+
+// storedCredentialsByType parses a credential column, skipping credential types that are not stored as
+// hashed credential entries.
+func storedCredentialsByType(credsJSON json.RawMessage) map[string][]StoredCredential {
+	if len(credsJSON) == 0 {
+		return nil
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(credsJSON, &raw); err != nil {
+		return nil
+	}
+	creds := make(map[string][]StoredCredential, len(raw))
+	for credType, value := range raw {
+		var list []StoredCredential
+		if err := json.Unmarshal(value, &list); err == nil {
+			creds[credType] = list
+		}
+	}
+	return creds
+}
+
+// credentialUpgrade identifies a verified stored credential whose hash is out of date, together with
+// the plaintext needed to regenerate it.
+type credentialUpgrade struct {
+	index int
+	value string
+	hash  string
+}
+
+// verifyCredentials verifies provided credentials from both schema and system credentials. On success it
+// returns the verified credentials, keyed by type, that were not hashed with the configured algorithm
+// and parameters.
 func (s *entityService) verifyCredentials(credentials map[string]interface{},
-	schemaCredsJSON, systemCredsJSON json.RawMessage) error {
+	schemaCredsJSON, systemCredsJSON json.RawMessage) (map[string]credentialUpgrade, error) {
 	// Merge both credential columns for verification.
 	storedCreds := make(map[string][]StoredCredential)
 	if len(schemaCredsJSON) > 0 {
 		var schemaCreds map[string][]StoredCredential
 		if err := json.Unmarshal(schemaCredsJSON, &schemaCreds); err != nil {
-			return fmt.Errorf("failed to unmarshal schema credentials: %w", err)
+			return nil, fmt.Errorf("failed to unmarshal schema credentials: %w", err)
 		}
 		for k, v := range schemaCreds {
 			storedCreds[k] = v
@@ -527,7 +682,7 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 	if len(systemCredsJSON) > 0 {
 		var sysCreds map[string][]StoredCredential
 		if err := json.Unmarshal(systemCredsJSON, &sysCreds); err != nil {
-			return fmt.Errorf("failed to unmarshal system credentials: %w", err)
+			return nil, fmt.Errorf("failed to unmarshal system credentials: %w", err)
 		}
 		for k, v := range sysCreds {
 			storedCreds[k] = v
@@ -535,7 +690,7 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 	}
 
 	if len(storedCreds) == 0 {
-		return ErrAuthenticationFailed
+		return nil, ErrAuthenticationFailed
 	}
 
 	// Filter to credentials that have stored entries.
@@ -552,35 +707,140 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 	}
 
 	if len(credentialsToVerify) == 0 {
-		return ErrAuthenticationFailed
+		return nil, ErrAuthenticationFailed
 	}
 
 	// Verify each credential against stored values.
+	upgrades := make(map[string]credentialUpgrade)
 	for credType, credValue := range credentialsToVerify {
 		credList := storedCreds[credType]
 		verified := false
-		for _, stored := range credList {
-			ref := cryptolib.Credential{
-				Algorithm: stored.StorageAlgo,
-				Hash:      stored.Value,
-				Parameters: cryptolib.CredParameters{
-					Salt:       stored.StorageAlgoParams.Salt,
-					Iterations: stored.StorageAlgoParams.Iterations,
-					KeySize:    stored.StorageAlgoParams.KeySize,
-				},
-			}
+		for i, stored := range credList {
+			ref := storedCredentialRef(stored)
 			ok, verifyErr := s.hashService.Verify([]byte(credValue), ref)
 			if verifyErr == nil && ok {
 				verified = true
+				if s.hashService.NeedsRehash(ref) {
+					upgrades[credType] = credentialUpgrade{index: i, value: credValue, hash: stored.Value}
+				}
 				break
 			}
 		}
 		if !verified {
-			return ErrAuthenticationFailed
+			return nil, ErrAuthenticationFailed
 		}
 	}
 
-	return nil
+	return upgrades, nil
+}
+
+// upgradeCredentialHashes replaces verified credentials hashed with an outdated algorithm or parameters
+// with hashes generated by the configured algorithm. The plaintext is unchanged, so neither the
+// credential change marker nor the credential history is touched. Failures are logged and never fail the
+// authentication that triggered the upgrade.
+func (s *entityService) upgradeCredentialHashes(ctx context.Context, entityID string,
+	upgrades map[string]credentialUpgrade) {
+	if declarative, err := s.store.IsEntityDeclarative(ctx, entityID); err != nil || declarative {
+		return
+	}
+
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.GetEntityWithCredentials(txCtx, entityID)
+		if err != nil {
+			return err
+		}
+
+		// System credentials take precedence over schema credentials of the same type during
+		// verification, so upgrade the column the verified entry came from.
+		pending := maps.Clone(upgrades)
+		systemCreds, systemChanged, err := s.applyCredentialUpgrades(existing.SystemCredentials, pending)
+		if err != nil {
+			return err
+		}
+		schemaCreds, schemaChanged, err := s.applyCredentialUpgrades(existing.SchemaCredentials, pending)
+		if err != nil {
+			return err
+		}
+
+		if schemaChanged {
+			if err := s.store.UpdateCredentials(txCtx, entityID, schemaCreds); err != nil {
+				return err
+			}
+		}
+		if systemChanged {
+			return s.store.UpdateSystemCredentials(txCtx, entityID, systemCreds)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Warn(ctx, "Failed to upgrade credential hashes",
+			log.MaskedString("id", entityID), log.Error(err))
+		return
+	}
+	s.logger.Debug(ctx, "Upgraded credential hashes to the configured algorithm",
+		log.MaskedString("id", entityID))
+}
+
+// applyCredentialUpgrades regenerates the upgraded entries found in a credential column and consumes the
+// matching upgrades. An entry is only replaced while it still holds the hash that was verified, so a
+// credential changed concurrently is left alone.
+func (s *entityService) applyCredentialUpgrades(credsJSON json.RawMessage,
+	upgrades map[string]credentialUpgrade) (json.RawMessage, bool, error) {
+	if len(credsJSON) == 0 {
+		return credsJSON, false, nil
+	}
+	var creds map[string]json.RawMessage
+	if err := json.Unmarshal(credsJSON, &creds); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal credentials: %w", err)
+	}
+
+	changed := false
+	for credType, upgrade := range upgrades {
+		raw, ok := creds[credType]
+		if !ok {
+			continue
+		}
+		delete(upgrades, credType)
+
+		var list []StoredCredential
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return nil, false, fmt.Errorf("failed to unmarshal credential %q: %w", credType, err)
+		}
+		if upgrade.index >= len(list) || list[upgrade.index].Value != upgrade.hash {
+			continue
+		}
+		credHash, err := s.hashService.Generate([]byte(upgrade.value))
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to hash credential %q: %w", credType, err)
+		}
+		list[upgrade.index] = StoredCredential{
+			StorageAlgo:       credHash.Algorithm,
+			StorageAlgoParams: credHash.Parameters,
+			Value:             credHash.Hash,
+		}
+		if creds[credType], err = json.Marshal(list); err != nil {
+			return nil, false, fmt.Errorf("failed to marshal credential %q: %w", credType, err)
+		}
+		changed = true
+	}
+
+	if !changed {
+		return credsJSON, false, nil
+	}
+	updated, err := json.Marshal(creds)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+	return updated, true, nil
+}
+
+// storedCredentialRef converts a stored credential into the reference form verified by the hash service.
+func storedCredentialRef(stored StoredCredential) cryptolib.Credential {
+	return cryptolib.Credential{
+		Algorithm:  stored.StorageAlgo,
+		Hash:       stored.Value,
+		Parameters: stored.StorageAlgoParams,
+	}
 }
 
 // UpdateCredentials updates schema-defined credentials (e.g., password) by hashing new
@@ -619,6 +879,9 @@ func (s *entityService) UpdateCredentials(ctx context.Context, entityID string,
 	if err := s.validateCredentialKeys(ctx, existing.Category, existing.Type, updates); err != nil {
 		return err
 	}
+	if err := s.enforceCredentialPolicy(ctx, entityID, updates); err != nil {
+		return err
+	}
 
 	// Hash new plaintext values.
 	hashedUpdates, err := s.hashPlaintextCredentials(plaintextUpdates)
@@ -666,10 +929,60 @@ func (s *entityService) UpdateCredentials(ctx context.Context, entityID string,
 		if err != nil {
 			return err
 		}
+		markedAttrs, err = s.recordCredentialHistory(txCtx, existingWithCreds, hashedMap, markedAttrs)
+		if err != nil {
+			return err
+		}
 		return s.store.UpdateSystemAttributes(txCtx, entityID, markedAttrs)
 	})
 }
 
+// validateImportedCredentials rejects imported credentials that are not declared as credential fields in
+// the entity's schema, or whose hashing algorithm or parameters cannot be verified.
+func (s *entityService) validateImportedCredentials(ctx context.Context, entity *providers.Entity,
+	credentials map[string][]StoredCredential) error {
+	updates := make(map[string]interface{}, len(credentials))
+	for credType, stored := range credentials {
+		if len(stored) == 0 {
+			return fmt.Errorf("%w: %q has no value", ErrInvalidCredential, credType)
+		}
+		for _, cred := range stored {
+			if cred.Value == "" || !cryptolib.IsSupportedCredAlgorithm(cred.StorageAlgo) {
+				return fmt.Errorf("%w: %q is not a supported stored credential", ErrInvalidCredential, credType)
+			}
+			if err := cryptolib.ValidateImportedParameters(cred.StorageAlgo, cred.Value,
+				cred.StorageAlgoParams); err != nil {
+				return fmt.Errorf("%w: %q has unsupported parameters: %w", ErrInvalidCredential, credType, err)
+			}
+		}
+		updates[credType] = stored
+	}
+	return s.validateCredentialKeys(ctx, entity.Category, entity.Type, updates)
+}
+
+// mergeImportedCredentials adds imported credentials to the hashed schema credentials. A credential type
+// given both in plaintext and as an imported hash is rejected.
+func mergeImportedCredentials(hashed json.RawMessage,
+	imported map[string][]StoredCredential) (json.RawMessage, error) {
+	merged := make(map[string]json.RawMessage, len(imported))
+	if len(hashed) > 0 {
+		if err := json.Unmarshal(hashed, &merged); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal hashed credentials: %w", err)
+		}
+	}
+	for credType, stored := range imported {
+		if _, exists := merged[credType]; exists {
+			return nil, fmt.Errorf("%w: %q is given both in plaintext and hashed", ErrInvalidCredential, credType)
+		}
+		value, err := json.Marshal(stored)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal imported credential: %w", err)
+		}
+		merged[credType] = value
+	}
+	return json.Marshal(merged)
+}
+
 // validateCredentialKeys rejects any payload key that isn't declared as a credential field
 // in the entity's schema. Non-user categories are skipped until they get schema validation.
 func (s *entityService) validateCredentialKeys(
@@ -696,6 +1009,118 @@ func (s *entityService) validateCredentialKeys(
 	return nil
 }
 
+// enforceCredentialPolicy validates the plaintext credential updates against the registered credential
+// policy, comparing each value with the current and retained previous values of its type.
+func (s *entityService) enforceCredentialPolicy(ctx context.Context, entityID string,
+	updates map[string]interface{}) error {
+	if s.credentialPolicy == nil {
+		return nil
+	}
+
+	existing, err := s.store.GetEntityWithCredentials(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	current, err := storedCredentialsOf(existing.SchemaCredentials)
+	if err != nil {
+		return err
+	}
+	history := credentialHistoryOf(existing.Entity.SystemAttributes)
+
+	for credType, credValue := range updates {
+		value, ok := credValue.(string)
+		if !ok {
+			continue
+		}
+		previous := append(append([]StoredCredential{}, current[credType]...), history[credType]...)
+		if violation := s.credentialPolicy.ValidateCredential(
+			ctx, existing.Entity, credType, value, previous); violation != nil {
+			return &CredentialPolicyError{Violation: violation}
+		}
+	}
+	return nil
+}
+
+// recordCredentialHistory returns systemAttributes with the replaced values of the updated credential
+// types pushed onto their history, trimmed to the size the credential policy retains.
+func (s *entityService) recordCredentialHistory(ctx context.Context, existing *entityWithCredentials,
+	updated map[string]interface{}, systemAttributes json.RawMessage) (json.RawMessage, error) {
+	if s.credentialPolicy == nil {
+		return systemAttributes, nil
+	}
+
+	current, err := storedCredentialsOf(existing.SchemaCredentials)
+	if err != nil {
+		return nil, err
+	}
+	history := credentialHistoryOf(existing.Entity.SystemAttributes)
+	for credType := range updated {
+		size := s.credentialPolicy.HistorySize(ctx, existing.Entity, credType)
+		entries := append(append([]StoredCredential{}, current[credType]...), history[credType]...)
+		if len(entries) > size {
+			entries = entries[:size]
+		}
+		if len(entries) == 0 {
+			delete(history, credType)
+			continue
+		}
+		history[credType] = entries
+	}
+
+	attrs := map[string]interface{}{}
+	if len(systemAttributes) > 0 {
+		if err := json.Unmarshal(systemAttributes, &attrs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal system attributes: %w", err)
+		}
+	}
+	if len(history) == 0 {
+		delete(attrs, systemAttrCredentialHistory)
+	} else {
+		attrs[systemAttrCredentialHistory] = history
+	}
+
+	recorded, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal system attributes: %w", err)
+	}
+	return recorded, nil
+}
+
+// storedCredentialsOf parses a credential column into stored credentials by type. Types that are not in
+// the stored format are skipped.
+func storedCredentialsOf(credentials json.RawMessage) (map[string][]StoredCredential, error) {
+	if len(credentials) == 0 {
+		return map[string][]StoredCredential{}, nil
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(credentials, &raw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal credentials: %w", err)
+	}
+	stored := make(map[string][]StoredCredential, len(raw))
+	for credType, value := range raw {
+		var entries []StoredCredential
+		if err := json.Unmarshal(value, &entries); err == nil {
+			stored[credType] = entries
+		}
+	}
+	return stored, nil
+}
+
+// credentialHistoryOf returns the replaced credential values retained in the given system attributes.
+func credentialHistoryOf(systemAttributes json.RawMessage) map[string][]StoredCredential {
+	history := map[string][]StoredCredential{}
+	if len(systemAttributes) == 0 {
+		return history
+	}
+	var attrs struct {
+		History map[string][]StoredCredential `json:"credentialHistory"`
+	}
+	if err := json.Unmarshal(systemAttributes, &attrs); err != nil || attrs.History == nil {
+		return history
+	}
+	return attrs.History
+}
+
 // stripUndeclaredAttributes drops top-level attribute keys not declared in the entity type's current
 // schema, so a schema changed after an entity was created (attribute renamed or removed) does not
 // block updates. Only used on update; create still rejects undeclared attributes.
@@ -917,25 +1342,38 @@ func (s *entityService) validateEntityType(
 // mergeReservedAttributes carries the reserved, server-owned keys of an entity's stored system
 // attributes into a replacement blob. Both write paths replace the blob wholesale, and the services
 // that own an entity rebuild it from their own model, so without this a rename would drop the
-// credential-change marker this package writes and revive the tokens a credential change invalidated.
+// credential-change marker this package writes and revive the tokens a credential change invalidated,
+// or drop the credential timestamps and history the credential policy relies on.
 func (s *entityService) mergeReservedAttributes(ctx context.Context, entityID string,
 	incoming json.RawMessage) (json.RawMessage, error) {
 	current, err := s.store.GetEntity(ctx, entityID)
 	if err != nil {
 		return nil, err
 	}
-	marker := credentialUpdatedAtOf(current.SystemAttributes)
-	if marker == "" {
+	reserved := map[string]json.RawMessage{}
+	if len(current.SystemAttributes) > 0 {
+		var currentAttrs map[string]json.RawMessage
+		if err := json.Unmarshal(current.SystemAttributes, &currentAttrs); err == nil {
+			for _, key := range reservedSystemAttributes {
+				if value, ok := currentAttrs[key]; ok {
+					reserved[key] = value
+				}
+			}
+		}
+	}
+	if len(reserved) == 0 {
 		return incoming, nil
 	}
 
-	attrs := map[string]interface{}{}
+	attrs := map[string]json.RawMessage{}
 	if len(incoming) > 0 {
 		if err := json.Unmarshal(incoming, &attrs); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal system attributes: %w", err)
 		}
 	}
-	attrs[authnprovidercm.SystemAttrCredentialUpdatedAt] = marker
+	for key, value := range reserved {
+		attrs[key] = value
+	}
 
 	merged, err := json.Marshal(attrs)
 	if err != nil {
@@ -944,32 +1382,23 @@ func (s *entityService) mergeReservedAttributes(ctx context.Context, entityID st
 	return merged, nil
 }
 
-// credentialUpdatedAtOf returns the credential-change marker in the given system attributes, or empty
-// when none is recorded.
-func credentialUpdatedAtOf(systemAttributes json.RawMessage) string {
-	if len(systemAttributes) == 0 {
-		return ""
-	}
-	var attrs map[string]interface{}
-	if err := json.Unmarshal(systemAttributes, &attrs); err != nil {
-		return ""
-	}
-	marker, _ := attrs[authnprovidercm.SystemAttrCredentialUpdatedAt].(string)
-	return marker
-}
-
 // setCredentialUpdatedAt returns systemAttributes with the credential-change marker set to at. The
 // marker is merged in rather than replacing the blob, whose other keys belong to the service that
 // owns the entity. Unlike mergeCredentialJSON, an unparsable blob is an error rather than a silent
 // overwrite, since dropping those keys would go unnoticed.
 func setCredentialUpdatedAt(systemAttributes json.RawMessage, at time.Time) (json.RawMessage, error) {
+	return setSystemTimestamp(systemAttributes, authnprovidercm.SystemAttrCredentialUpdatedAt, at)
+}
+
+// setSystemTimestamp returns systemAttributes with key set to at, formatted as RFC 3339.
+func setSystemTimestamp(systemAttributes json.RawMessage, key string, at time.Time) (json.RawMessage, error) {
 	attrs := map[string]interface{}{}
 	if len(systemAttributes) > 0 {
 		if err := json.Unmarshal(systemAttributes, &attrs); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal system attributes: %w", err)
 		}
 	}
-	attrs[authnprovidercm.SystemAttrCredentialUpdatedAt] = at.UTC().Format(time.RFC3339)
+	attrs[key] = at.UTC().Format(time.RFC3339)
 
 	marked, err := json.Marshal(attrs)
 	if err != nil {
@@ -1050,6 +1479,15 @@ func (s *entityService) extractAndHashSchemaCredentials(
 		return nil, nil
 	}
 
+	if s.credentialPolicy != nil {
+		for credType, value := range plaintextCreds {
+			if violation := s.credentialPolicy.ValidateCredential(
+				ctx, entity, credType, value, nil); violation != nil {
+				return nil, &CredentialPolicyError{Violation: violation}
+			}
+		}
+	}
+
 	// Update entity.Attributes with credentials removed.
 	cleanAttrs, err := json.Marshal(attrsMap)
 	if err != nil {
@@ -1097,13 +1535,9 @@ func (s *entityService) hashPlaintextCredentials(creds json.RawMessage) (json.Ra
 			}
 			result[credType] = []StoredCredential{
 				{
-					StorageAlgo: credHash.Algorithm,
-					StorageAlgoParams: cryptolib.CredParameters{
-						Salt:       credHash.Parameters.Salt,
-						Iterations: credHash.Parameters.Iterations,
-						KeySize:    credHash.Parameters.KeySize,
-					},
-					Value: credHash.Hash,
+					StorageAlgo:       credHash.Algorithm,
+					StorageAlgoParams: credHash.Parameters,
+					Value:             credHash.Hash,
 				},
 			}
 		default:
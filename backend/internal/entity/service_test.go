@@ -17,6 +17,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/crypto/hashmock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
@@ -46,6 +47,7 @@ func (s *ServiceTestSuite) SetupTest() {
 			Salt: "testsalt", Iterations: 1, KeySize: 32,
 		},
 	}, nil).Maybe()
+	s.hashService.On("NeedsRehash", mock.Anything).Return(false).Maybe()
 	s.svc = newEntityService(s.store, s.hashService, nil, nil, transaction.NewNoOpTransactioner())
 	s.ctx = context.Background()
 	s.testErr = errors.New("store error")
@@ -95,6 +97,90 @@ func (s *ServiceTestSuite) TestCreateEntity_Success() {
 	s.Equal(e.ID, got.ID)
 }
 
+func (s *ServiceTestSuite) TestImportEntity_NilEntity() {
+	_, err := s.svc.ImportEntity(s.ctx, nil, nil)
+	s.ErrorIs(err, ErrEntityNotFound)
+}
+
+func (s *ServiceTestSuite) TestImportEntity_StoresImportedCredentials() {
+	svc, ets := s.newSvcWithEntityType()
+	e := testEntity("imp1")
+	e.Attributes = json.RawMessage(`{"username":"alice","pin":"1234"}`)
+	imported := map[string][]StoredCredential{
+		"password": {{StorageAlgo: cryptolib.BCRYPT, Value: "$2a$10$hash"}},
+	}
+	ets.On("GetAttributes", mock.Anything, mock.Anything, e.Type, entitytype.AttributeFilter{AllowCredential: true}).
+		Return([]entitytype.AttributeInfo{{Attribute: "password"}, {Attribute: "pin"}}, nil)
+	// Imported credentials satisfy required credential attributes.
+	ets.On("ValidateEntity", mock.Anything, mock.Anything, e.Type, e.Attributes, true).Return(true, nil)
+	ets.On("ValidateEntityUniqueness", mock.Anything, mock.Anything, e.Type, e.Attributes, mock.Anything).
+		Return(true, nil)
+	var storedCreds json.RawMessage
+	s.store.On("CreateEntity", mock.Anything, mock.Anything, mock.Anything, json.RawMessage(nil)).
+		Run(func(args mock.Arguments) { storedCreds = args.Get(2).(json.RawMessage) }).Return(nil)
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+
+	_, err := svc.ImportEntity(s.ctx, e, imported)
+	s.Require().NoError(err)
+
+	stored, err := storedCredentialsOf(storedCreds)
+	s.Require().NoError(err)
+	s.Equal(imported["password"], stored["password"])
+	s.Require().Len(stored["pin"], 1)
+	s.Equal("testhash", stored["pin"][0].Value)
+	s.JSONEq(`{"username":"alice"}`, string(e.Attributes))
+}
+
+func (s *ServiceTestSuite) TestImportEntity_RejectsInvalidCredentials() {
+	testCases := []struct {
+		name        string
+		credentials map[string][]StoredCredential
+	}{
+		{"UndeclaredType", map[string][]StoredCredential{
+			"secret": {{StorageAlgo: cryptolib.BCRYPT, Value: "$2a$10$hash"}}}},
+		{"UnsupportedAlgorithm", map[string][]StoredCredential{
+			"password": {{StorageAlgo: "MD5", Value: "hash"}}}},
+		{"MissingValue", map[string][]StoredCredential{
+			"password": {{StorageAlgo: cryptolib.BCRYPT}}}},
+		{"NoValues", map[string][]StoredCredential{"password": {}}},
+		{"OversizedScryptParameters", map[string][]StoredCredential{
+			"password": {{StorageAlgo: cryptolib.SCRYPT, Value: "aGFzaA==", StorageAlgoParams: cryptolib.CredParameters{
+				Iterations: 1 << 30, BlockSize: 8, Parallelism: 1, Salt: "c2FsdA=="}}}}},
+		{"ScryptKeySizeMismatch", map[string][]StoredCredential{
+			"password": {{StorageAlgo: cryptolib.SCRYPT, Value: "aGFzaA==", StorageAlgoParams: cryptolib.CredParameters{
+				Iterations: 1024, BlockSize: 8, Parallelism: 1, KeySize: 32, Salt: "c2FsdA=="}}}}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			svc, ets := s.newSvcWithEntityType()
+			ets.On("GetAttributes", mock.Anything, mock.Anything, "employee",
+				entitytype.AttributeFilter{AllowCredential: true}).
+				Return([]entitytype.AttributeInfo{{Attribute: "password"}}, nil).Maybe()
+
+			_, err := svc.ImportEntity(s.ctx, testEntity("imp2"), tc.credentials)
+			s.ErrorIs(err, ErrInvalidCredential)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestImportEntity_RejectsPlaintextAndHashedCredential() {
+	svc, ets := s.newSvcWithEntityType()
+	e := testEntity("imp3")
+	e.Attributes = json.RawMessage(`{"username":"bob","password":"secret"}`)
+	ets.On("GetAttributes", mock.Anything, mock.Anything, e.Type, entitytype.AttributeFilter{AllowCredential: true}).
+		Return([]entitytype.AttributeInfo{{Attribute: "password"}}, nil)
+	ets.On("ValidateEntity", mock.Anything, mock.Anything, e.Type, mock.Anything, true).Return(true, nil)
+	ets.On("ValidateEntityUniqueness", mock.Anything, mock.Anything, e.Type, mock.Anything, mock.Anything).
+		Return(true, nil)
+
+	_, err := svc.ImportEntity(s.ctx, e, map[string][]StoredCredential{
+		"password": {{StorageAlgo: cryptolib.PHPASS, Value: "$P$hash"}},
+	})
+	s.ErrorIs(err, ErrInvalidCredential)
+	s.store.AssertNotCalled(s.T(), "CreateEntity", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (s *ServiceTestSuite) TestGetEntity_Success() {
 	e := testEntity("e4")
 	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
@@ -649,6 +735,150 @@ func (s *ServiceTestSuite) TestAuthenticateEntity_DelegatesToByID() {
 	s.Equal(id, result.EntityID)
 }
 
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_VerifiesWithStoredParameters() {
+	e := testEntity("argon-1")
+	storedCreds := json.RawMessage(`{"password":[{"value":"argonhash","storageAlgo":"ARGON2ID",` +
+		`"storageAlgoParams":{"Salt":"abcd","Iterations":2,"Memory":19456,"Parallelism":1,"KeySize":32}}]}`)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: storedCreds}, nil)
+	s.hashService.On("Verify", []byte("pass"), cryptolib.Credential{
+		Algorithm: cryptolib.ARGON2ID,
+		Hash:      "argonhash",
+		Parameters: cryptolib.CredParameters{
+			Salt: "abcd", Iterations: 2, Memory: 19456, Parallelism: 1, KeySize: 32,
+		},
+	}).Return(true, nil)
+
+	_, err := s.svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"password": "pass"})
+	s.NoError(err)
+}
+
+// newUpgradingService returns a service whose hash service reports every stored credential as outdated.
+func (s *ServiceTestSuite) newUpgradingService() EntityServiceInterface {
+	hashService := hashmock.NewHashServiceInterfaceMock(s.T())
+	hashService.On("Verify", []byte("pass"), mock.Anything).Return(true, nil)
+	hashService.On("NeedsRehash", mock.Anything).Return(true)
+	hashService.On("Generate", []byte("pass")).Return(cryptolib.Credential{
+		Algorithm: cryptolib.ARGON2ID,
+		Hash:      "upgradedhash",
+		Parameters: cryptolib.CredParameters{
+			Salt: "newsalt", Iterations: 2, Memory: 19456, Parallelism: 1, KeySize: 32,
+		},
+	}, nil).Maybe()
+	return newEntityService(s.store, hashService, nil, nil, transaction.NewNoOpTransactioner())
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_UpgradesOutdatedHash() {
+	e := testEntity("upgrade-1")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: testCredentialsJSON()}, nil)
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(false, nil)
+	s.store.On("UpdateCredentials", mock.Anything, e.ID, mock.Anything).Return(nil)
+
+	_, err := s.newUpgradingService().AuthenticateEntityByID(
+		s.ctx, e.ID, map[string]interface{}{"password": "pass"})
+	s.NoError(err)
+
+	stored := s.store.Calls[len(s.store.Calls)-1].Arguments.Get(2).(json.RawMessage)
+	var creds map[string][]StoredCredential
+	s.Require().NoError(json.Unmarshal(stored, &creds))
+	s.Require().Len(creds["password"], 1)
+	s.Equal(cryptolib.ARGON2ID, creds["password"][0].StorageAlgo)
+	s.Equal("upgradedhash", creds["password"][0].Value)
+	s.Equal(19456, creds["password"][0].StorageAlgoParams.Memory)
+	s.store.AssertNotCalled(s.T(), "UpdateSystemAttributes", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_UpgradesSystemCredential() {
+	e := testEntity("upgrade-2")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SystemCredentials: testCredentialsJSON()}, nil)
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(false, nil)
+	s.store.On("UpdateSystemCredentials", mock.Anything, e.ID, mock.Anything).Return(nil)
+
+	_, err := s.newUpgradingService().AuthenticateEntityByID(
+		s.ctx, e.ID, map[string]interface{}{"password": "pass"})
+	s.NoError(err)
+	s.store.AssertNotCalled(s.T(), "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_UpgradeSkipsChangedCredential() {
+	e := testEntity("upgrade-3")
+	changed := json.RawMessage(`{"password":[{"value":"otherhash","storageAlgo":"PBKDF2",` +
+		`"storageAlgoParams":{"salt":"testsalt","iterations":1,"keySize":32}}]}`)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: testCredentialsJSON()}, nil).Once()
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: changed}, nil).Once()
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(false, nil)
+
+	_, err := s.newUpgradingService().AuthenticateEntityByID(
+		s.ctx, e.ID, map[string]interface{}{"password": "pass"})
+	s.NoError(err)
+	s.store.AssertNotCalled(s.T(), "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_UpgradeSkipsDeclarativeEntity() {
+	e := testEntity("upgrade-4")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: testCredentialsJSON()}, nil).Once()
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(true, nil)
+
+	_, err := s.newUpgradingService().AuthenticateEntityByID(
+		s.ctx, e.ID, map[string]interface{}{"password": "pass"})
+	s.NoError(err)
+	s.store.AssertNotCalled(s.T(), "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_UpgradeFailureDoesNotFailAuthentication() {
+	e := testEntity("upgrade-5")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: testCredentialsJSON()}, nil)
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(false, nil)
+	s.store.On("UpdateCredentials", mock.Anything, e.ID, mock.Anything).Return(s.testErr)
+
+	result, err := s.newUpgradingService().AuthenticateEntityByID(
+		s.ctx, e.ID, map[string]interface{}{"password": "pass"})
+	s.NoError(err)
+	s.Equal(e.ID, result.EntityID)
+}
+
+func (s *ServiceTestSuite) TestGetCredentialAlgorithmUsage() {
+	hashService := hashmock.NewHashServiceInterfaceMock(s.T())
+	hashService.On("NeedsRehash", mock.MatchedBy(func(c cryptolib.Credential) bool {
+		return c.Algorithm != cryptolib.ARGON2ID || c.Parameters.Iterations != 2
+	})).Return(true)
+	hashService.On("NeedsRehash", mock.Anything).Return(false)
+	svc := newEntityService(s.store, hashService, nil, nil, transaction.NewNoOpTransactioner())
+
+	current := cryptolib.Credential{Algorithm: cryptolib.ARGON2ID, Parameters: cryptolib.CredParameters{Iterations: 2}}
+	outdated := cryptolib.Credential{Algorithm: cryptolib.ARGON2ID, Parameters: cryptolib.CredParameters{Iterations: 1}}
+	s.store.On("GetCredentialAlgorithmCounts", mock.Anything, string(providers.EntityCategoryUser)).
+		Return([]credentialAlgorithmCount{
+			{CredentialType: "password", Credential: current, Count: 5},
+			{CredentialType: "password", Credential: outdated, Count: 2},
+			{CredentialType: "password", Credential: cryptolib.Credential{Algorithm: cryptolib.BCRYPT}, Count: 1},
+			{CredentialType: "pin", Credential: cryptolib.Credential{Algorithm: cryptolib.SHA256}, Count: 3},
+		}, nil).Once()
+
+	usage, err := svc.GetCredentialAlgorithmUsage(s.ctx, providers.EntityCategoryUser)
+	s.NoError(err)
+	s.Equal([]CredentialAlgorithmUsage{
+		{CredentialType: "password", Algorithm: cryptolib.ARGON2ID, Count: 7, PendingUpgrade: 2},
+		{CredentialType: "password", Algorithm: cryptolib.BCRYPT, Count: 1, PendingUpgrade: 1},
+		{CredentialType: "pin", Algorithm: cryptolib.SHA256, Count: 3, PendingUpgrade: 3},
+	}, usage)
+	s.store.AssertNotCalled(s.T(), "GetEntityWithCredentials", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestGetCredentialAlgorithmUsage_StoreError() {
+	s.store.On("GetCredentialAlgorithmCounts", mock.Anything, string(providers.EntityCategoryUser)).
+		Return(nil, s.testErr)
+
+	_, err := s.svc.GetCredentialAlgorithmUsage(s.ctx, providers.EntityCategoryUser)
+	s.ErrorIs(err, s.testErr)
+}
+
 // --- SetGroupMembershipProvider / GetTransitiveEntityGroups ---
 
 func (s *ServiceTestSuite) TestGetTransitiveEntityGroups_ProviderNil() {
@@ -747,3 +977,75 @@ func (s *ServiceTestSuite) TestUpdateSystemAttributes_NoMarkerPassesThrough() {
 	s.NoError(s.svc.UpdateSystemAttributes(s.ctx, e.ID, json.RawMessage(`{"name":"New"}`)))
 	s.JSONEq(`{"name":"New"}`, string(written))
 }
+
+// A replaced blob keeps the credential timestamps and history the credential policy relies on.
+func (s *ServiceTestSuite) TestUpdateSystemAttributes_PreservesCredentialPolicyKeys() {
+	e := testEntity("e-preserve-policy")
+	e.SystemAttributes = json.RawMessage(`{"name":"Old","credentialCreatedAt":"2026-01-01T00:00:00Z",` +
+		`"credentialHistory":{"password":[{"value":"h1"}]}}`)
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+
+	var written json.RawMessage
+	s.store.On("UpdateSystemAttributes", mock.Anything, e.ID, mock.Anything).
+		Run(func(args mock.Arguments) { written, _ = args.Get(2).(json.RawMessage) }).Return(nil)
+
+	s.NoError(s.svc.UpdateSystemAttributes(s.ctx, e.ID, json.RawMessage(`{"name":"New"}`)))
+
+	var attrs map[string]interface{}
+	s.Require().NoError(json.Unmarshal(written, &attrs))
+	s.Equal("New", attrs["name"])
+	s.Equal("2026-01-01T00:00:00Z", attrs[authnprovidercm.SystemAttrCredentialCreatedAt])
+	s.NotNil(attrs[systemAttrCredentialHistory])
+}
+
+// A value refused by the credential policy is never written.
+func (s *ServiceTestSuite) TestUpdateCredentials_CredentialPolicyViolation() {
+	policy := NewCredentialPolicyMock(s.T())
+	s.svc.SetCredentialPolicy(policy)
+	e := testEntity("e-policy")
+	e.SystemAttributes = json.RawMessage(`{"credentialHistory":{"password":[{"value":"h1"}]}}`)
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).Return(&entityWithCredentials{
+		Entity:            e,
+		SchemaCredentials: json.RawMessage(`{"password":[{"value":"current"}]}`),
+	}, nil)
+	violation := &tidcommon.ServiceError{Type: tidcommon.ClientErrorType, Code: "PWP-1009"}
+	policy.On("ValidateCredential", mock.Anything, e, "password", "reused",
+		[]StoredCredential{{Value: "current"}, {Value: "h1"}}).Return(violation)
+
+	err := s.svc.UpdateCredentials(s.ctx, e.ID, json.RawMessage(`{"password":"reused"}`))
+
+	var policyErr *CredentialPolicyError
+	s.Require().ErrorAs(err, &policyErr)
+	s.ErrorIs(err, ErrCredentialPolicyViolation)
+	s.Equal(violation, policyErr.Violation)
+	s.store.AssertNotCalled(s.T(), "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// The replaced value is pushed onto the history, which is trimmed to the size the policy retains.
+func (s *ServiceTestSuite) TestUpdateCredentials_RecordsCredentialHistory() {
+	policy := NewCredentialPolicyMock(s.T())
+	s.svc.SetCredentialPolicy(policy)
+	e := testEntity("e-history")
+	e.SystemAttributes = json.RawMessage(`{"credentialHistory":{"password":[{"value":"h1"},{"value":"h2"}]}}`)
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).Return(&entityWithCredentials{
+		Entity:            e,
+		SchemaCredentials: json.RawMessage(`{"password":[{"value":"current"}]}`),
+	}, nil)
+	s.store.On("UpdateCredentials", mock.Anything, e.ID, mock.Anything).Return(nil)
+	policy.On("ValidateCredential", mock.Anything, mock.Anything, "password", "new-secret", mock.Anything).
+		Return(nil)
+	policy.On("HistorySize", mock.Anything, mock.Anything, "password").Return(2)
+
+	var written json.RawMessage
+	s.store.On("UpdateSystemAttributes", mock.Anything, e.ID, mock.Anything).
+		Run(func(args mock.Arguments) { written, _ = args.Get(2).(json.RawMessage) }).Return(nil)
+
+	s.NoError(s.svc.UpdateCredentials(s.ctx, e.ID, json.RawMessage(`{"password":"new-secret"}`)))
+
+	history := credentialHistoryOf(written)
+	s.Require().Len(history["password"], 2)
+	s.Equal("current", history["password"][0].Value)
+	s.Equal("h1", history["password"][1].Value)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -46,9 +47,12 @@ type entityStoreInterface interface {
 		ouIDs []string, filters map[string]interface{}) (int, error)
 	GetEntityListByOUIDs(ctx context.Context, category string,
 		ouIDs []string, limit, offset int, filters map[string]interface{}) ([]providers.Entity, error)
+	GetEntityListAfterID(ctx context.Context, category string,
+		ouIDs []string, limit int, afterID string, filters map[string]interface{}) ([]providers.Entity, error)
 	ValidateEntityIDs(ctx context.Context, entityIDs []string) ([]string, error)
 	GetEntitiesByIDs(ctx context.Context, entityIDs []string) ([]providers.Entity, error)
 	ValidateEntityIDsInOUs(ctx context.Context, entityIDs []string, ouIDs []string) ([]string, error)
+	GetCredentialAlgorithmCounts(ctx context.Context, category string) ([]credentialAlgorithmCount, error)
 
 	// Groups
 	GetGroupCountForEntity(ctx context.Context, entityID string) (int, error)
@@ -550,6 +554,32 @@ func (es *entityDBStore) GetIndexedAttributes() map[string]bool {
 	return es.indexedAttributes
 }
 
+// GetCredentialAlgorithmCounts counts the stored credentials of the category's entities in a single
+// aggregate query, grouped by credential type, algorithm and rehash-relevant parameters.
+func (es *entityDBStore) GetCredentialAlgorithmCounts(ctx context.Context,
+	category string) ([]credentialAlgorithmCount, error) {
+	dbClient, err := es.dbProvider.GetEntityDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args := buildCredentialAlgorithmCountQuery(category, es.deploymentID)
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	counts := make([]credentialAlgorithmCount, 0, len(results))
+	for _, row := range results {
+		count, err := buildCredentialAlgorithmCountFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	return counts, nil
+}
+
 // GetEntityListCount retrieves the total count of entities by category.
 func (es *entityDBStore) GetEntityListCount(ctx context.Context, category string,
 	filters map[string]interface{}) (int, error) {
@@ -627,6 +657,28 @@ func (es *entityDBStore) GetEntityListByOUIDs(ctx context.Context, category stri
 	return buildEntitiesFromResults(results)
 }
 
+// GetEntityListAfterID retrieves up to limit entities whose ID sorts after afterID, in ID order. ouIDs,
+// when not nil, restricts the entities to those organization units.
+func (es *entityDBStore) GetEntityListAfterID(ctx context.Context, category string,
+	ouIDs []string, limit int, afterID string, filters map[string]interface{}) ([]providers.Entity, error) {
+	dbClient, err := es.dbProvider.GetEntityDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	listQuery, args, err := buildEntityListQueryAfterID(category, ouIDs, filters, limit, afterID, es.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build list query: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, listQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute paginated query: %w", err)
+	}
+
+	return buildEntitiesFromResults(results)
+}
+
 // ValidateEntityIDs checks if all provided entity IDs exist.
 func (es *entityDBStore) ValidateEntityIDs(ctx context.Context, entityIDs []string) ([]string, error) {
 	if len(entityIDs) == 0 {
@@ -891,6 +943,41 @@ func buildEntitiesFromResults(results []map[string]interface{}) ([]providers.Ent
 	return entities, nil
 }
 
+// buildCredentialAlgorithmCountFromResultRow builds a credentialAlgorithmCount from a row of the
+// credential algorithm count query.
+func buildCredentialAlgorithmCountFromResultRow(row map[string]interface{}) (credentialAlgorithmCount, error) {
+	credType, ok := row["cred_type"].(string)
+	if !ok {
+		return credentialAlgorithmCount{}, fmt.Errorf("unexpected type for cred_type: %T", row["cred_type"])
+	}
+	algorithm, ok := row["algorithm"].(string)
+	if !ok {
+		return credentialAlgorithmCount{}, fmt.Errorf("unexpected type for algorithm: %T", row["algorithm"])
+	}
+	values := make(map[string]int, 6)
+	for _, column := range []string{"iterations", "memory", "parallelism", "key_size", "salt_length", "total"} {
+		value, ok := row[column].(int64)
+		if !ok {
+			return credentialAlgorithmCount{}, fmt.Errorf("unexpected type for %s: %T", column, row[column])
+		}
+		values[column] = int(value)
+	}
+	return credentialAlgorithmCount{
+		CredentialType: credType,
+		Credential: cryptolib.Credential{
+			Algorithm: cryptolib.CredAlgorithm(algorithm),
+			Parameters: cryptolib.CredParameters{
+				Iterations:  values["iterations"],
+				Memory:      values["memory"],
+				Parallelism: values["parallelism"],
+				KeySize:     values["key_size"],
+				Salt:        strings.Repeat("0", values["salt_length"]),
+			},
+		},
+		Count: values["total"],
+	}, nil
+}
+
 func parseJSONColumn(row map[string]interface{}, column string) json.RawMessage {
 	val, exists := row[column]
 	if !exists || val == nil {
@@ -248,6 +248,37 @@ func buildEntityListQueryByOUIDs(
 	}, args, nil
 }
 
+// buildEntityListQueryAfterID constructs a keyset-paginated query for the entities of a category whose
+// ID sorts after afterID, with optional filtering and, when ouIDs is not nil, OU scoping.
+func buildEntityListQueryAfterID(
+	category string, ouIDs []string, filters map[string]interface{}, limit int, afterID, deploymentID string,
+) (model.DBQuery, []interface{}, error) {
+	queryID := "ASQ-ENTITY_MGT-31"
+	baseQuery := `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES ` +
+		`FROM "ENTITY" WHERE CATEGORY = $1`
+	args := []interface{}{category}
+
+	query, filterArgs, err := buildFilterQueryWithOffset(queryID, baseQuery, filters, len(args))
+	if err != nil {
+		return model.DBQuery{}, nil, err
+	}
+	args = append(args, filterArgs...)
+	if ouIDs != nil {
+		query, args = appendOUIDsINClause(query, args, ouIDs)
+	}
+	query, args = utils.AppendDeploymentIDToFilterQuery(query, args, deploymentID)
+	postgresQuery := fmt.Sprintf("%s AND ID > $%d ORDER BY ID LIMIT $%d",
+		query.PostgresQuery, len(args)+1, len(args)+2)
+	args = append(args, afterID, limit)
+
+	return model.DBQuery{
+		ID:            queryID,
+		Query:         postgresQuery,
+		PostgresQuery: postgresQuery,
+		SQLiteQuery:   query.SQLiteQuery + " AND ID > ? ORDER BY ID LIMIT ?",
+	}, args, nil
+}
+
 // buildIdentifyQuery constructs a query to identify an entity based on the provided filters.
 // It searches both ATTRIBUTES and SYSTEM_ATTRIBUTES columns so that any entity can be found
 // regardless of which column holds the filter key.
@@ -670,3 +701,53 @@ func buildFilterQueryWithOffset(
 
 	return resultQuery, args, nil
 }
+
+// credentialColumns are the entity columns holding stored credentials, keyed by credential type.
+var credentialColumns = []string{"CREDENTIALS", "SYSTEM_CREDENTIALS"}
+
+// buildCredentialAlgorithmCountQuery constructs a query counting the stored credentials of a category by
+// credential type, algorithm and the parameters that decide whether a credential needs rehashing. Salts
+// are unique per credential, so only their length is grouped on. Credential types whose value is not a
+// list of stored credentials are skipped.
+func buildCredentialAlgorithmCountQuery(category, deploymentID string) (model.DBQuery, []interface{}) {
+	pgParts := make([]string, 0, len(credentialColumns))
+	sqParts := make([]string, 0, len(credentialColumns))
+	for _, column := range credentialColumns {
+		pgParts = append(pgParts, fmt.Sprintf(
+			`SELECT c.key AS cred_type, v.value->>'storageAlgo' AS algorithm, `+
+				`COALESCE((v.value#>>'{storageAlgoParams,Iterations}')::BIGINT, 0) AS iterations, `+
+				`COALESCE((v.value#>>'{storageAlgoParams,Memory}')::BIGINT, 0) AS memory, `+
+				`COALESCE((v.value#>>'{storageAlgoParams,Parallelism}')::BIGINT, 0) AS parallelism, `+
+				`COALESCE((v.value#>>'{storageAlgoParams,KeySize}')::BIGINT, 0) AS key_size, `+
+				`LENGTH(COALESCE(v.value#>>'{storageAlgoParams,Salt}', '')) AS salt_length `+
+				`FROM "ENTITY" e `+
+				`CROSS JOIN LATERAL jsonb_each(CASE WHEN jsonb_typeof(e.%[1]s) = 'object' `+
+				`THEN e.%[1]s ELSE '{}'::JSONB END) c `+
+				`CROSS JOIN LATERAL jsonb_array_elements(CASE WHEN jsonb_typeof(c.value) = 'array' `+
+				`THEN c.value ELSE '[]'::JSONB END) v `+
+				`WHERE e.CATEGORY = $1 AND e.DEPLOYMENT_ID = $2 AND jsonb_typeof(v.value) = 'object'`, column))
+		sqParts = append(sqParts, fmt.Sprintf(
+			`SELECT c.key AS cred_type, json_extract(c.value, v.fullkey || '.storageAlgo') AS algorithm, `+
+				`COALESCE(json_extract(c.value, v.fullkey || '.storageAlgoParams.Iterations'), 0) AS iterations, `+
+				`COALESCE(json_extract(c.value, v.fullkey || '.storageAlgoParams.Memory'), 0) AS memory, `+
+				`COALESCE(json_extract(c.value, v.fullkey || '.storageAlgoParams.Parallelism'), 0) AS parallelism, `+
+				`COALESCE(json_extract(c.value, v.fullkey || '.storageAlgoParams.KeySize'), 0) AS key_size, `+
+				`LENGTH(COALESCE(json_extract(c.value, v.fullkey || '.storageAlgoParams.Salt'), '')) `+
+				`AS salt_length `+
+				`FROM "ENTITY" e, `+
+				`json_each(CASE WHEN json_type(e.%[1]s) = 'object' THEN e.%[1]s ELSE '{}' END) c, `+
+				`json_each(CASE WHEN c.type = 'array' THEN c.value ELSE '[]' END) v `+
+				`WHERE e.CATEGORY = $1 AND e.DEPLOYMENT_ID = $2 AND v.type = 'object'`, column))
+	}
+
+	const aggregate = `SELECT cred_type, algorithm, iterations, memory, parallelism, key_size, salt_length, ` +
+		`COUNT(*) AS total FROM (%s) creds WHERE algorithm <> '' ` +
+		`GROUP BY cred_type, algorithm, iterations, memory, parallelism, key_size, salt_length`
+	postgresQuery := fmt.Sprintf(aggregate, strings.Join(pgParts, " UNION ALL "))
+	return model.DBQuery{
+		ID:            "ASQ-ENTITY_MGT-30",
+		Query:         postgresQuery,
+		PostgresQuery: postgresQuery,
+		SQLiteQuery:   fmt.Sprintf(aggregate, strings.Join(sqParts, " UNION ALL ")),
+	}, []interface{}{category, deploymentID}
+}
@@ -61,6 +61,22 @@ func (s *StoreConstantsTestSuite) TestBuildEntityListQueryByOUIDs_WithFilters()
 	s.NotEmpty(args)
 }
 
+func (s *StoreConstantsTestSuite) TestBuildEntityListQueryAfterID_AllOUs() {
+	q, args, err := buildEntityListQueryAfterID("user", nil, nil, 10, "u1", testDeploymentID)
+	s.NoError(err)
+	s.NotContains(q.PostgresQuery, "OU_ID IN")
+	s.Contains(q.PostgresQuery, "AND ID > $3 ORDER BY ID LIMIT $4")
+	s.Contains(q.SQLiteQuery, "AND ID > ? ORDER BY ID LIMIT ?")
+	s.Equal([]interface{}{"user", testDeploymentID, "u1", 10}, args)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildEntityListQueryAfterID_WithOUIDs() {
+	q, args, err := buildEntityListQueryAfterID("user", []string{"ou1"}, nil, 10, "", testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, "OU_ID IN")
+	s.Equal([]interface{}{"user", "ou1", testDeploymentID, "", 10}, args)
+}
+
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_EmptyFilters() {
 	_, _, err := buildIdentifyQuery(map[string]interface{}{}, testDeploymentID)
 	s.Error(err)
@@ -275,3 +291,13 @@ func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryHybrid_NonIndexed_UsesCO
 	s.Contains(q.SQLiteQuery, "json_extract(e.ATTRIBUTES, '$.clientId')")
 	s.Contains(q.SQLiteQuery, "json_extract(e.SYSTEM_ATTRIBUTES, '$.clientId')")
 }
+
+func (s *StoreConstantsTestSuite) TestBuildCredentialAlgorithmCountQuery() {
+	q, args := buildCredentialAlgorithmCountQuery("user", testDeploymentID)
+	s.Equal([]interface{}{"user", testDeploymentID}, args)
+	for _, query := range []string{q.PostgresQuery, q.SQLiteQuery} {
+		s.Contains(query, "e.CREDENTIALS")
+		s.Contains(query, "e.SYSTEM_CREDENTIALS")
+		s.Contains(query, "GROUP BY cred_type, algorithm")
+	}
+}
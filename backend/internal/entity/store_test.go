@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -452,6 +453,46 @@ func (s *DBStoreTestSuite) TestGetEntityListCount_BadTotalType() {
 	s.Error(err)
 }
 
+func (s *DBStoreTestSuite) TestGetCredentialAlgorithmCounts_ProviderError() {
+	s.expectClientError()
+	_, err := s.store.GetCredentialAlgorithmCounts(s.ctx, "user")
+	s.Error(err)
+}
+
+func (s *DBStoreTestSuite) TestGetCredentialAlgorithmCounts_QueryError() {
+	s.expectClient()
+	s.onQueryAny(nil, s.testErr)
+	_, err := s.store.GetCredentialAlgorithmCounts(s.ctx, "user")
+	s.ErrorIs(err, s.testErr)
+}
+
+func (s *DBStoreTestSuite) TestGetCredentialAlgorithmCounts_Success() {
+	s.expectClient()
+	s.client.On("QueryContext", mock.Anything, mock.MatchedBy(func(q dbmodel.DBQuery) bool {
+		return q.ID == "ASQ-ENTITY_MGT-30"
+	}), "user", "dep1").Return([]map[string]interface{}{{
+		"cred_type": "password", "algorithm": "PBKDF2", "iterations": int64(600000), "memory": int64(0),
+		"parallelism": int64(0), "key_size": int64(32), "salt_length": int64(32), "total": int64(7),
+	}}, nil).Once()
+
+	counts, err := s.store.GetCredentialAlgorithmCounts(s.ctx, "user")
+	s.NoError(err)
+	s.Require().Len(counts, 1)
+	s.Equal("password", counts[0].CredentialType)
+	s.Equal(cryptolib.PBKDF2, counts[0].Credential.Algorithm)
+	s.Equal(600000, counts[0].Credential.Parameters.Iterations)
+	s.Equal(32, counts[0].Credential.Parameters.KeySize)
+	s.Len(counts[0].Credential.Parameters.Salt, 32)
+	s.Equal(7, counts[0].Count)
+}
+
+func (s *DBStoreTestSuite) TestGetCredentialAlgorithmCounts_BadRow() {
+	s.expectClient()
+	s.onQueryAny([]map[string]interface{}{{"cred_type": "password", "algorithm": "PBKDF2", "total": "7"}}, nil)
+	_, err := s.store.GetCredentialAlgorithmCounts(s.ctx, "user")
+	s.Error(err)
+}
+
 func (s *DBStoreTestSuite) TestGetEntityList_ProviderError() {
 	s.expectClientError()
 	_, err := s.store.GetEntityList(s.ctx, "user", 10, 0, nil)
@@ -167,6 +167,25 @@ func (p *defaultEntityProvider) UpdateSystemCredentials(
 	return nil
 }
 
+// GetCredentials retrieves the stored entries of one credential type for an entity.
+func (p *defaultEntityProvider) GetCredentials(
+	entityID, credentialType string,
+) (json.RawMessage, *EntityProviderError) {
+	ctx := security.WithRuntimeContext(context.Background())
+	creds, err := p.entitySvc.GetCredentialsByType(ctx, entityID, credentialType)
+	if err != nil {
+		return nil, mapEntityError(err)
+	}
+	if len(creds) == 0 {
+		return nil, nil
+	}
+	credsJSON, err := json.Marshal(creds)
+	if err != nil {
+		return nil, mapEntityError(err)
+	}
+	return credsJSON, nil
+}
+
 // GetTransitiveEntityGroups retrieves all groups an entity belongs to, including inherited groups.
 func (p *defaultEntityProvider) GetTransitiveEntityGroups(
 	entityID string,
@@ -275,7 +294,11 @@ func toServiceEntity(e *providers.Entity) *providers.Entity {
 // mapEntityError converts an entity service error into an EntityProviderError,
 // preserving the underlying error code semantics where possible.
 func mapEntityError(err error) *EntityProviderError {
+	var policyErr *entity.CredentialPolicyError
 	switch {
+	case errors.As(err, &policyErr):
+		return NewEntityProviderError(ErrorCodeCredentialPolicyViolation, "Credential policy violation",
+			policyErr.Violation.ErrorDescription.String())
 	case errors.Is(err, entity.ErrEntityNotFound):
 		return NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found", err.Error())
 	case errors.Is(err, entity.ErrAmbiguousEntity):
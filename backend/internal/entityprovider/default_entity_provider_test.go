@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/entity"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 )
@@ -333,6 +334,35 @@ func (suite *DefaultEntityProviderTestSuite) TestUpdateSystemCredentials() {
 	suite.Equal(ErrorCodeInvalidRequestFormat, err.Code)
 }
 
+func (suite *DefaultEntityProviderTestSuite) TestGetCredentials() {
+	stored := []entity.StoredCredential{{Value: `{"secret":"abc"}`}}
+	suite.mockService.On("GetCredentialsByType", mock.Anything, testEntityID, "totp").
+		Return(stored, nil).Once()
+
+	creds, err := suite.provider.GetCredentials(testEntityID, "totp")
+	suite.Nil(err)
+	var decoded []entity.StoredCredential
+	suite.Require().NoError(json.Unmarshal(creds, &decoded))
+	suite.Equal(stored[0].Value, decoded[0].Value)
+
+	// Test No Credentials
+	suite.mockService.On("GetCredentialsByType", mock.Anything, testEntityID, "totp").
+		Return(nil, nil).Once()
+
+	creds, err = suite.provider.GetCredentials(testEntityID, "totp")
+	suite.Nil(err)
+	suite.Nil(creds)
+
+	// Test Not Found
+	suite.mockService.On("GetCredentialsByType", mock.Anything, testEntityID, "totp").
+		Return(nil, entity.ErrEntityNotFound).Once()
+
+	creds, err = suite.provider.GetCredentials(testEntityID, "totp")
+	suite.Nil(creds)
+	suite.NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
 func (suite *DefaultEntityProviderTestSuite) TestMapEntityError() {
 	// Verifies the centralized error mapping helper.
 	cases := []struct {
@@ -345,6 +375,8 @@ func (suite *DefaultEntityProviderTestSuite) TestMapEntityError() {
 		{"SchemaValidationFailed", entity.ErrSchemaValidationFailed, ErrorCodeSchemaValidationFailed},
 		{"InvalidCredential", entity.ErrInvalidCredential, ErrorCodeInvalidRequestFormat},
 		{"BadAttributesInRequest", entity.ErrBadAttributesInRequest, ErrorCodeInvalidRequestFormat},
+		{"CredentialPolicyViolation", &entity.CredentialPolicyError{Violation: &tidcommon.ServiceError{}},
+			ErrorCodeCredentialPolicyViolation},
 		{"Unknown", errors.New("unexpected"), ErrorCodeSystemError},
 	}
 	for _, tc := range cases {
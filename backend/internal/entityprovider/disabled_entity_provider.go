@@ -73,6 +73,11 @@ func (p *disabledEntityProvider) UpdateSystemCredentials(_ string,
 	return errNotImplemented
 }
 
+func (p *disabledEntityProvider) GetCredentials(_ string,
+	_ string) (json.RawMessage, *EntityProviderError) {
+	return nil, errNotImplemented
+}
+
 func (p *disabledEntityProvider) GetTransitiveEntityGroups(
 	_ string) ([]providers.EntityGroup, *EntityProviderError) {
 	return nil, errNotImplemented
@@ -69,6 +69,12 @@ func (suite *DisabledEntityProviderTestSuite) TestUpdateSystemCredentials() {
 	suite.Equal(errNotImplemented, err)
 }
 
+func (suite *DisabledEntityProviderTestSuite) TestGetCredentials() {
+	creds, err := suite.provider.GetCredentials("entity-id", "totp")
+	suite.Nil(creds)
+	suite.Equal(errNotImplemented, err)
+}
+
 func (suite *DisabledEntityProviderTestSuite) TestGetTransitiveEntityGroups() {
 	groups, err := suite.provider.GetTransitiveEntityGroups("entity-id")
 	suite.Nil(groups)
@@ -17,6 +17,11 @@ const (
 	ErrorCodeNotImplemented         ErrorCode = "EP-0007"
 	ErrorCodeAmbiguousEntity        ErrorCode = "EP-0008"
 	ErrorCodeSchemaValidationFailed ErrorCode = "EP-0009"
+	ErrorCodeReadOnlyEntity         ErrorCode = "EP-0010"
+	ErrorCodeInvalidCredentials     ErrorCode = "EP-0011"
+	// ErrorCodeCredentialPolicyViolation is returned when a new credential value is refused by the
+	// credential policy. The description carries the reason.
+	ErrorCodeCredentialPolicyViolation ErrorCode = "EP-0012"
 )
 
 // EntityProviderError represents an error returned by the entity provider.
@@ -4,20 +4,31 @@
 package entityprovider
 
 import (
+	"fmt"
+
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/system/config"
 )
 
+const (
+	// TypeDisabled is the entity provider type that rejects every operation.
+	TypeDisabled = "disabled"
+	// TypeLDAP is the entity provider type that serves users from an LDAP directory.
+	TypeLDAP = "ldap"
+)
+
 // InitializeEntityProvider initializes the entity provider.
 func InitializeEntityProvider(
 	entitySvc entity.EntityServiceInterface,
-) EntityProviderInterface {
+) (EntityProviderInterface, error) {
 	entityProviderConfig := config.GetServerRuntime().Config.EntityProvider
 	switch entityProviderConfig.Type {
-	case "disabled":
-		return initializeDisabledEntityProvider()
+	case TypeDisabled:
+		return initializeDisabledEntityProvider(), nil
+	case TypeLDAP:
+		return initializeLDAPEntityProvider(entityProviderConfig.LDAP, entitySvc)
 	default:
-		return initializeDefaultEntityProvider(entitySvc)
+		return initializeDefaultEntityProvider(entitySvc), nil
 	}
 }
 
@@ -32,3 +43,15 @@ func initializeDefaultEntityProvider(
 func initializeDisabledEntityProvider() EntityProviderInterface {
 	return newDisabledEntityProvider()
 }
+
+// initializeLDAPEntityProvider initializes the LDAP entity provider, backed by the default entity
+// provider for the entities the directory does not hold.
+func initializeLDAPEntityProvider(
+	cfg config.LDAPEntityProviderConfig, entitySvc entity.EntityServiceInterface,
+) (EntityProviderInterface, error) {
+	settings, err := newLDAPSettings(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ldap entity provider configuration: %w", err)
+	}
+	return newLDAPEntityProvider(settings, newDefaultEntityProvider(entitySvc)), nil
+}
@@ -48,8 +48,9 @@ func (suite *InitEntityProviderTestSuite) TestInitializeEntityProvider_WithDisab
 		Type: "disabled",
 	}
 
-	provider := InitializeEntityProvider(suite.mockEntityService)
+	provider, err := InitializeEntityProvider(suite.mockEntityService)
 
+	suite.NoError(err)
 	suite.NotNil(provider)
 	_, ok := provider.(*disabledEntityProvider)
 	suite.True(ok, "Expected provider to be of type *disabledEntityProvider")
@@ -60,8 +61,9 @@ func (suite *InitEntityProviderTestSuite) TestInitializeEntityProvider_WithDefau
 		Type: "default",
 	}
 
-	provider := InitializeEntityProvider(suite.mockEntityService)
+	provider, err := InitializeEntityProvider(suite.mockEntityService)
 
+	suite.NoError(err)
 	suite.NotNil(provider)
 	_, ok := provider.(*defaultEntityProvider)
 	suite.True(ok, "Expected provider to be of type *defaultEntityProvider")
@@ -72,8 +74,9 @@ func (suite *InitEntityProviderTestSuite) TestInitializeEntityProvider_WithEmpty
 		Type: "",
 	}
 
-	provider := InitializeEntityProvider(suite.mockEntityService)
+	provider, err := InitializeEntityProvider(suite.mockEntityService)
 
+	suite.NoError(err)
 	suite.NotNil(provider)
 	_, ok := provider.(*defaultEntityProvider)
 	suite.True(ok, "Expected provider to be of type *defaultEntityProvider when type is empty")
@@ -84,9 +87,40 @@ func (suite *InitEntityProviderTestSuite) TestInitializeEntityProvider_WithUnkno
 		Type: "unknown",
 	}
 
-	provider := InitializeEntityProvider(suite.mockEntityService)
+	provider, err := InitializeEntityProvider(suite.mockEntityService)
 
+	suite.NoError(err)
 	suite.NotNil(provider)
 	_, ok := provider.(*defaultEntityProvider)
 	suite.True(ok, "Expected provider to be of type *defaultEntityProvider for unknown type")
 }
+
+func (suite *InitEntityProviderTestSuite) TestInitializeEntityProvider_WithLDAPType() {
+	config.GetServerRuntime().Config.EntityProvider = config.EntityProviderConfig{
+		Type: "ldap",
+		LDAP: config.LDAPEntityProviderConfig{
+			URL:  "ldap://localhost:389",
+			User: config.LDAPUserConfig{BaseDN: "ou=people,dc=example,dc=org", EntityType: "Person", OUID: "ou-1"},
+		},
+	}
+
+	provider, err := InitializeEntityProvider(suite.mockEntityService)
+
+	suite.NoError(err)
+	ldapProvider, ok := provider.(*ldapEntityProvider)
+	suite.True(ok, "Expected provider to be of type *ldapEntityProvider")
+	suite.True(ldapProvider.settings.readOnly)
+	suite.IsType(&defaultEntityProvider{}, ldapProvider.fallback)
+}
+
+func (suite *InitEntityProviderTestSuite) TestInitializeEntityProvider_WithInvalidLDAPConfig() {
+	config.GetServerRuntime().Config.EntityProvider = config.EntityProviderConfig{
+		Type: "ldap",
+		LDAP: config.LDAPEntityProviderConfig{URL: "ldap://localhost:389"},
+	}
+
+	provider, err := InitializeEntityProvider(suite.mockEntityService)
+
+	suite.Error(err)
+	suite.Nil(provider)
+}
@@ -47,6 +47,10 @@ type EntityProviderInterface interface {
 	UpdateSystemCredentials(entityID string,
 		credentials json.RawMessage) *EntityProviderError
 
+	// GetCredentials retrieves the stored entries of one credential type for an entity, as a JSON
+	// array. It returns nil when the entity holds no credential of that type.
+	GetCredentials(entityID, credentialType string) (json.RawMessage, *EntityProviderError)
+
 	// GetTransitiveEntityGroups retrieves all groups an entity belongs to, including inherited groups.
 	GetTransitiveEntityGroups(entityID string) ([]providers.EntityGroup, *EntityProviderError)
 
@@ -63,3 +67,12 @@ type EntityProviderInterface interface {
 	GetEntityList(category providers.EntityCategory, limit, offset int,
 		filters map[string]interface{}) ([]providers.Entity, *EntityProviderError)
 }
+
+// CredentialVerifierInterface is implemented by entity providers that verify credentials against the
+// store holding the entity, rather than against credentials kept by the server.
+type CredentialVerifierInterface interface {
+	// VerifyCredentials verifies the password of an entity. It returns an ErrorCodeEntityNotFound
+	// error when the entity is not held by the verifier, and an ErrorCodeInvalidCredentials error
+	// when the password is wrong.
+	VerifyCredentials(entityID, password string) *EntityProviderError
+}
@@ -0,0 +1,457 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entityprovider
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const (
+	// ldapModeReadOnly keeps directory entries read-only.
+	ldapModeReadOnly = "read_only"
+	// ldapModeReadWrite lets the provider create, update and delete directory entries.
+	ldapModeReadWrite = "read_write"
+
+	// ldapMatchingRuleInChain is the Active Directory matching rule that walks nested group
+	// memberships on the server.
+	ldapMatchingRuleInChain = "1.2.840.113556.1.4.1941"
+
+	ldapAttrObjectClass = "objectClass"
+	ldapAttrObjectGUID  = "objectGUID"
+	ldapAttrUnicodePwd  = "unicodePwd"
+	ldapAttrUserPwd     = "userPassword"
+
+	ldapDefaultTimeout  = 10 * time.Second
+	ldapSearchPageSize  = 500
+	ldapDefaultPassword = "password"
+)
+
+// ldapSettings is the LDAP entity provider configuration with defaults applied.
+type ldapSettings struct {
+	url                string
+	startTLS           bool
+	insecureSkipVerify bool
+	bindDN             string
+	bindPassword       string
+	timeout            time.Duration
+	readOnly           bool
+	activeDirectory    bool
+
+	userBaseDN        string
+	userFilter        string
+	userObjectClasses []string
+	rdnAttribute      string
+	idAttribute       string
+	entityType        string
+	ouID              string
+	passwordAttribute string
+	attributes        map[string]string
+
+	groupBaseDN     string
+	groupFilter     string
+	memberAttribute string
+	nameAttribute   string
+}
+
+// newLDAPSettings validates the LDAP entity provider configuration and applies the defaults,
+// which differ between Active Directory and other directories.
+func newLDAPSettings(cfg config.LDAPEntityProviderConfig) (*ldapSettings, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("url is required for the ldap entity provider")
+	}
+	if cfg.User.BaseDN == "" {
+		return nil, errors.New("user.base_dn is required for the ldap entity provider")
+	}
+	if cfg.User.EntityType == "" {
+		return nil, errors.New("user.entity_type is required for the ldap entity provider")
+	}
+	if cfg.User.OUID == "" {
+		return nil, errors.New("user.ou_id is required for the ldap entity provider")
+	}
+
+	s := &ldapSettings{
+		url:                cfg.URL,
+		startTLS:           cfg.StartTLS,
+		insecureSkipVerify: cfg.InsecureSkipVerify,
+		bindDN:             cfg.BindDN,
+		bindPassword:       cfg.BindPassword,
+		timeout:            ldapDefaultTimeout,
+		activeDirectory:    cfg.ActiveDirectory,
+		userBaseDN:         cfg.User.BaseDN,
+		userFilter:         cfg.User.Filter,
+		userObjectClasses:  cfg.User.ObjectClasses,
+		rdnAttribute:       cfg.User.RDNAttribute,
+		idAttribute:        cfg.User.IDAttribute,
+		entityType:         cfg.User.EntityType,
+		ouID:               cfg.User.OUID,
+		passwordAttribute:  cfg.User.PasswordAttribute,
+		attributes:         cfg.User.Attributes,
+		groupBaseDN:        cfg.Group.BaseDN,
+		groupFilter:        cfg.Group.Filter,
+		memberAttribute:    cfg.Group.MemberAttribute,
+		nameAttribute:      cfg.Group.NameAttribute,
+	}
+	if cfg.Timeout > 0 {
+		s.timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+	switch cfg.Mode {
+	case "", ldapModeReadOnly:
+		s.readOnly = true
+	case ldapModeReadWrite:
+		s.readOnly = false
+	default:
+		return nil, fmt.Errorf("unsupported ldap entity provider mode %q", cfg.Mode)
+	}
+
+	if s.activeDirectory {
+		setDefault(&s.userFilter, "(&(objectClass=user)(objectCategory=person))")
+		setDefault(&s.rdnAttribute, "cn")
+		setDefault(&s.idAttribute, ldapAttrObjectGUID)
+		setDefault(&s.groupFilter, "(objectClass=group)")
+		if len(s.userObjectClasses) == 0 {
+			s.userObjectClasses = []string{"top", "person", "organizationalPerson", "user"}
+		}
+		if len(s.attributes) == 0 {
+			s.attributes = map[string]string{"username": "sAMAccountName", "email": "mail",
+				"given_name": "givenName", "family_name": "sn", "name": "displayName"}
+		}
+	} else {
+		setDefault(&s.userFilter, "(objectClass=inetOrgPerson)")
+		setDefault(&s.rdnAttribute, "uid")
+		setDefault(&s.idAttribute, "entryUUID")
+		setDefault(&s.groupFilter, "(objectClass=groupOfNames)")
+		if len(s.userObjectClasses) == 0 {
+			s.userObjectClasses = []string{"top", "person", "organizationalPerson", "inetOrgPerson"}
+		}
+		if len(s.attributes) == 0 {
+			s.attributes = map[string]string{"username": "uid", "email": "mail",
+				"given_name": "givenName", "family_name": "sn", "name": "cn"}
+		}
+	}
+	setDefault(&s.passwordAttribute, ldapDefaultPassword)
+	setDefault(&s.memberAttribute, "member")
+	setDefault(&s.nameAttribute, "cn")
+	return s, nil
+}
+
+func setDefault(field *string, value string) {
+	if *field == "" {
+		*field = value
+	}
+}
+
+// dial opens a connection to the directory, upgrading it with StartTLS when configured. The
+// connection is not bound.
+func (s *ldapSettings) dial() (*ldap.Conn, error) {
+	// #nosec G402 -- skipping verification is an explicit opt-in for test directories.
+	tlsConfig := &tls.Config{InsecureSkipVerify: s.insecureSkipVerify, MinVersion: tls.VersionTLS12}
+	conn, err := ldap.DialURL(s.url, ldap.DialWithDialer(&net.Dialer{Timeout: s.timeout}),
+		ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the directory: %w", err)
+	}
+	conn.SetTimeout(s.timeout)
+	if s.startTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("failed to start TLS with the directory: %w", err)
+		}
+	}
+	return conn, nil
+}
+
+// withServiceConn runs fn on a connection bound with the service account.
+func (s *ldapSettings) withServiceConn(fn func(conn *ldap.Conn) error) error {
+	conn, err := s.dial()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	if s.bindDN != "" {
+		if err := conn.Bind(s.bindDN, s.bindPassword); err != nil {
+			return fmt.Errorf("failed to bind with the service account: %w", err)
+		}
+	}
+	return fn(conn)
+}
+
+// userAttributes returns the LDAP attributes requested for user entries.
+func (s *ldapSettings) userAttributes() []string {
+	attrs := []string{s.idAttribute, s.rdnAttribute}
+	for _, ldapName := range s.attributes {
+		if !slices.ContainsFunc(attrs, func(a string) bool { return strings.EqualFold(a, ldapName) }) {
+			attrs = append(attrs, ldapName)
+		}
+	}
+	sort.Strings(attrs[2:])
+	return attrs
+}
+
+// searchUsers returns the user entries matching the given filter within the user base.
+func (s *ldapSettings) searchUsers(conn *ldap.Conn, filter string) ([]*ldap.Entry, error) {
+	req := ldap.NewSearchRequest(s.userBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		fmt.Sprintf("(&%s%s)", s.userFilter, filter), s.userAttributes(), nil)
+	result, err := conn.SearchWithPaging(req, ldapSearchPageSize)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to search the directory: %w", err)
+	}
+	return result.Entries, nil
+}
+
+// getUserByDN returns the user entry with the given DN, or nil when there is none.
+func (s *ldapSettings) getUserByDN(conn *ldap.Conn, dn string) (*ldap.Entry, error) {
+	req := ldap.NewSearchRequest(dn, ldap.ScopeBaseObject, ldap.NeverDerefAliases, 0, 0, false,
+		"(objectClass=*)", s.userAttributes(), nil)
+	result, err := conn.Search(req)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the directory entry: %w", err)
+	}
+	if len(result.Entries) != 1 {
+		return nil, nil
+	}
+	return result.Entries[0], nil
+}
+
+// searchGroups returns the group entries matching the given filter within the group base.
+func (s *ldapSettings) searchGroups(conn *ldap.Conn, filter string) ([]*ldap.Entry, error) {
+	req := ldap.NewSearchRequest(s.groupBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		fmt.Sprintf("(&%s%s)", s.groupFilter, filter), []string{s.idAttribute, s.nameAttribute}, nil)
+	result, err := conn.SearchWithPaging(req, ldapSearchPageSize)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to search the directory groups: %w", err)
+	}
+	return result.Entries, nil
+}
+
+// walkGroups returns the groups that have the given member, directly or through nested groups. Each
+// group is visited once, so membership cycles terminate.
+func (s *ldapSettings) walkGroups(conn *ldap.Conn, memberDN string) ([]*ldap.Entry, error) {
+	var groups []*ldap.Entry
+	visited := map[string]struct{}{strings.ToLower(memberDN): {}}
+	pending := []string{memberDN}
+	for len(pending) > 0 {
+		dn := pending[0]
+		pending = pending[1:]
+		parents, err := s.searchGroups(conn, fmt.Sprintf("(%s=%s)", s.memberAttribute, ldap.EscapeFilter(dn)))
+		if err != nil {
+			return nil, err
+		}
+		for _, parent := range parents {
+			key := strings.ToLower(parent.DN)
+			if _, seen := visited[key]; seen {
+				continue
+			}
+			visited[key] = struct{}{}
+			groups = append(groups, parent)
+			pending = append(pending, parent.DN)
+		}
+	}
+	return groups, nil
+}
+
+// findUserByID returns the user entry with the given entity ID, or nil when there is none.
+func (s *ldapSettings) findUserByID(conn *ldap.Conn, entityID string) (*ldap.Entry, error) {
+	filter, ok := s.idFilter(entityID)
+	if !ok {
+		return nil, nil
+	}
+	entries, err := s.searchUsers(conn, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) != 1 {
+		return nil, nil
+	}
+	return entries[0], nil
+}
+
+// idFilter builds the equality filter for an entity ID. Active Directory object GUIDs are binary,
+// so they are matched byte by byte.
+func (s *ldapSettings) idFilter(entityID string) (string, bool) {
+	if strings.EqualFold(s.idAttribute, ldapAttrObjectGUID) {
+		raw, ok := parseObjectGUID(entityID)
+		if !ok {
+			return "", false
+		}
+		var b strings.Builder
+		for _, c := range raw {
+			fmt.Fprintf(&b, "\\%02x", c)
+		}
+		return fmt.Sprintf("(%s=%s)", s.idAttribute, b.String()), true
+	}
+	if entityID == "" {
+		return "", false
+	}
+	return fmt.Sprintf("(%s=%s)", s.idAttribute, ldap.EscapeFilter(entityID)), true
+}
+
+// entryID returns the entity ID of a directory entry.
+func (s *ldapSettings) entryID(entry *ldap.Entry) string {
+	if strings.EqualFold(s.idAttribute, ldapAttrObjectGUID) {
+		id, _ := formatObjectGUID(entry.GetEqualFoldRawAttributeValue(s.idAttribute))
+		return id
+	}
+	return entry.GetEqualFoldAttributeValue(s.idAttribute)
+}
+
+// attributeFilter builds an AND filter from entity attribute filters. It reports false when a
+// filter names an attribute that is not mapped to the directory.
+func (s *ldapSettings) attributeFilter(filters map[string]interface{}) (string, bool) {
+	if len(filters) == 0 {
+		return "", false
+	}
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		ldapName, ok := s.attributes[key]
+		if !ok {
+			return "", false
+		}
+		value, ok := filterValue(filters[key])
+		if !ok {
+			return "", false
+		}
+		fmt.Fprintf(&b, "(%s=%s)", ldapName, ldap.EscapeFilter(value))
+	}
+	return b.String(), true
+}
+
+func filterValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	case fmt.Stringer:
+		return v.String(), true
+	case bool, float64, int, int64:
+		return fmt.Sprint(v), true
+	default:
+		return "", false
+	}
+}
+
+// entryAttributes maps the LDAP attributes of an entry to entity attributes. Multi-valued LDAP
+// attributes become arrays.
+func (s *ldapSettings) entryAttributes(entry *ldap.Entry) (json.RawMessage, error) {
+	attrs := make(map[string]interface{}, len(s.attributes))
+	for localName, ldapName := range s.attributes {
+		values := entry.GetEqualFoldAttributeValues(ldapName)
+		switch len(values) {
+		case 0:
+		case 1:
+			attrs[localName] = values[0]
+		default:
+			attrs[localName] = values
+		}
+	}
+	return json.Marshal(attrs)
+}
+
+// ldapValues maps entity attributes to LDAP attribute values, keyed by LDAP attribute name.
+// Attributes with a nil value map to no values. The password is returned separately.
+func (s *ldapSettings) ldapValues(attributes json.RawMessage) (map[string][]string, string, error) {
+	var attrs map[string]interface{}
+	if len(attributes) > 0 {
+		if err := json.Unmarshal(attributes, &attrs); err != nil {
+			return nil, "", fmt.Errorf("invalid attributes: %w", err)
+		}
+	}
+
+	values := make(map[string][]string, len(attrs))
+	password := ""
+	for localName, value := range attrs {
+		if localName == s.passwordAttribute {
+			password, _ = value.(string)
+			continue
+		}
+		ldapName, ok := s.attributes[localName]
+		if !ok {
+			return nil, "", fmt.Errorf("attribute %q is not mapped to a directory attribute", localName)
+		}
+		switch v := value.(type) {
+		case nil:
+			values[ldapName] = nil
+		case []interface{}:
+			for _, item := range v {
+				values[ldapName] = append(values[ldapName], fmt.Sprint(item))
+			}
+		default:
+			values[ldapName] = []string{fmt.Sprint(v)}
+		}
+	}
+	return values, password, nil
+}
+
+// passwordChange returns the modification that sets a user's password. Active Directory only
+// accepts passwords as a quoted UTF-16LE unicodePwd value.
+func (s *ldapSettings) passwordChange(password string) (string, string) {
+	if !s.activeDirectory {
+		return ldapAttrUserPwd, password
+	}
+	encoded := utf16.Encode([]rune("\"" + password + "\""))
+	raw := make([]byte, 2*len(encoded))
+	for i, c := range encoded {
+		binary.LittleEndian.PutUint16(raw[2*i:], c)
+	}
+	return ldapAttrUnicodePwd, string(raw)
+}
+
+// formatObjectGUID renders a binary Active Directory object GUID in its string form. The first
+// three fields of the binary form are little-endian.
+func formatObjectGUID(raw []byte) (string, bool) {
+	if len(raw) != 16 {
+		return "", false
+	}
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x", binary.LittleEndian.Uint32(raw[0:4]),
+		binary.LittleEndian.Uint16(raw[4:6]), binary.LittleEndian.Uint16(raw[6:8]), raw[8:10], raw[10:16]), true
+}
+
+// parseObjectGUID is the inverse of formatObjectGUID.
+func parseObjectGUID(id string) ([]byte, bool) {
+	parts := strings.Split(id, "-")
+	if len(parts) != 5 || len(parts[0]) != 8 || len(parts[1]) != 4 || len(parts[2]) != 4 ||
+		len(parts[3]) != 4 || len(parts[4]) != 12 {
+		return nil, false
+	}
+	decoded, err := hex.DecodeString(strings.Join(parts, ""))
+	if err != nil {
+		return nil, false
+	}
+	raw := make([]byte, 16)
+	binary.LittleEndian.PutUint32(raw[0:4], binary.BigEndian.Uint32(decoded[0:4]))
+	binary.LittleEndian.PutUint16(raw[4:6], binary.BigEndian.Uint16(decoded[4:6]))
+	binary.LittleEndian.PutUint16(raw[6:8], binary.BigEndian.Uint16(decoded[6:8]))
+	copy(raw[8:], decoded[8:])
+	return raw, true
+}
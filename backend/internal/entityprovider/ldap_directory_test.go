@@ -0,0 +1,457 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entityprovider
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/jimlambrt/gldap"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// testDirectory is an embedded, in-process LDAP server holding a small directory in memory. It
+// evaluates the filters the provider sends, including Active Directory's matching-rule-in-chain.
+type testDirectory struct {
+	t       *testing.T
+	url     string
+	mu      sync.Mutex
+	entries []*testEntry
+}
+
+type testEntry struct {
+	dn    string
+	attrs map[string][]string
+}
+
+func (e *testEntry) values(name string) []string {
+	for attr, values := range e.attrs {
+		if strings.EqualFold(attr, name) {
+			return values
+		}
+	}
+	return nil
+}
+
+func (e *testEntry) set(name string, values []string) {
+	for attr := range e.attrs {
+		if strings.EqualFold(attr, name) {
+			delete(e.attrs, attr)
+		}
+	}
+	if len(values) > 0 {
+		e.attrs[name] = values
+	}
+}
+
+// startTestDirectory starts an embedded LDAP server with the given entries. It is stopped when
+// the test completes.
+func startTestDirectory(t *testing.T, entries ...*testEntry) *testDirectory {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to reserve a port: %v", err)
+	}
+	addr := listener.Addr().String()
+	_ = listener.Close()
+
+	d := &testDirectory{t: t, url: "ldap://" + addr, entries: entries}
+	server, err := gldap.NewServer()
+	if err != nil {
+		t.Fatalf("failed to create the ldap server: %v", err)
+	}
+	mux, err := gldap.NewMux()
+	if err != nil {
+		t.Fatalf("failed to create the ldap router: %v", err)
+	}
+	for _, route := range []error{
+		mux.Bind(d.handleBind),
+		mux.Search(d.handleSearch),
+		mux.Add(d.handleAdd),
+		mux.Modify(d.handleModify),
+		mux.Delete(d.handleDelete),
+	} {
+		if route != nil {
+			t.Fatalf("failed to register an ldap route: %v", route)
+		}
+	}
+	if err := server.Router(mux); err != nil {
+		t.Fatalf("failed to register the ldap router: %v", err)
+	}
+	go func() {
+		_ = server.Run(addr)
+	}()
+	t.Cleanup(func() { _ = server.Stop() })
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !server.Ready() {
+		if time.Now().After(deadline) {
+			t.Fatal("ldap server did not start")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return d
+}
+
+func newTestEntry(dn string, attrs map[string][]string) *testEntry {
+	return &testEntry{dn: dn, attrs: attrs}
+}
+
+// entry returns the entry with the given DN.
+func (d *testDirectory) entry(dn string) *testEntry {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.find(dn)
+}
+
+func (d *testDirectory) find(dn string) *testEntry {
+	for _, e := range d.entries {
+		if strings.EqualFold(e.dn, dn) {
+			return e
+		}
+	}
+	return nil
+}
+
+func (d *testDirectory) handleBind(w *gldap.ResponseWriter, r *gldap.Request) {
+	resp := r.NewBindResponse(gldap.WithResponseCode(gldap.ResultInvalidCredentials))
+	defer func() { _ = w.Write(resp) }()
+	m, err := r.GetSimpleBindMessage()
+	if err != nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e := d.find(m.UserName)
+	if e == nil || m.Password == "" {
+		return
+	}
+	for _, stored := range append(e.values(ldapAttrUserPwd), e.values(ldapAttrUnicodePwd)...) {
+		if stored == string(m.Password) || stored == adPassword(string(m.Password)) {
+			resp.SetResultCode(gldap.ResultSuccess)
+			return
+		}
+	}
+}
+
+func (d *testDirectory) handleSearch(w *gldap.ResponseWriter, r *gldap.Request) {
+	resp := r.NewSearchDoneResponse(gldap.WithResponseCode(gldap.ResultOperationsError))
+	defer func() { _ = w.Write(resp) }()
+	m, err := r.GetSearchMessage()
+	if err != nil {
+		return
+	}
+	filter, err := ldap.CompileFilter(m.Filter)
+	if err != nil {
+		resp.SetDiagnosticMessage(err.Error())
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.find(m.BaseDN) == nil {
+		resp.SetResultCode(gldap.ResultNoSuchObject)
+		return
+	}
+	for _, e := range d.entries {
+		inScope := strings.EqualFold(e.dn, m.BaseDN) ||
+			(m.Scope == gldap.WholeSubtree && strings.HasSuffix(strings.ToLower(e.dn), ","+strings.ToLower(m.BaseDN)))
+		if !inScope || !d.matches(filter, e) {
+			continue
+		}
+		result := r.NewSearchResponseEntry(e.dn)
+		for _, name := range m.Attributes {
+			if values := e.values(name); len(values) > 0 {
+				result.AddAttribute(name, values)
+			}
+		}
+		if err := w.Write(result); err != nil {
+			return
+		}
+	}
+	resp.SetResultCode(gldap.ResultSuccess)
+}
+
+func (d *testDirectory) handleAdd(w *gldap.ResponseWriter, r *gldap.Request) {
+	resp := r.NewResponse(gldap.WithApplicationCode(gldap.ApplicationAddResponse),
+		gldap.WithResponseCode(gldap.ResultOperationsError))
+	defer func() { _ = w.Write(resp) }()
+	m, err := r.GetAddMessage()
+	if err != nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.find(m.DN) != nil {
+		resp.SetResultCode(gldap.ResultEntryAlreadyExists)
+		return
+	}
+	attrs := map[string][]string{"entryUUID": {fmt.Sprintf("uuid-%d", len(d.entries)+1)}}
+	for _, a := range m.Attributes {
+		attrs[a.Type] = a.Vals
+	}
+	d.entries = append(d.entries, newTestEntry(m.DN, attrs))
+	resp.SetResultCode(gldap.ResultSuccess)
+}
+
+func (d *testDirectory) handleModify(w *gldap.ResponseWriter, r *gldap.Request) {
+	resp := r.NewModifyResponse(gldap.WithResponseCode(gldap.ResultNoSuchObject))
+	defer func() { _ = w.Write(resp) }()
+	m, err := r.GetModifyMessage()
+	if err != nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e := d.find(m.DN)
+	if e == nil {
+		return
+	}
+	for _, change := range m.Changes {
+		vals := decodeModifyValues(change.Modification.Vals)
+		switch change.Operation {
+		case gldap.AddAttribute:
+			e.set(change.Modification.Type, append(e.values(change.Modification.Type), vals...))
+		case gldap.DeleteAttribute:
+			e.set(change.Modification.Type, nil)
+		case gldap.ReplaceAttribute:
+			e.set(change.Modification.Type, vals)
+		}
+	}
+	resp.SetResultCode(gldap.ResultSuccess)
+}
+
+// decodeModifyValues decodes modification values. gldap hands over the encoded contents of the
+// value set rather than the individual values.
+func decodeModifyValues(raw []string) []string {
+	var vals []string
+	for _, r := range raw {
+		data := []byte(r)
+		for len(data) > 0 {
+			p, err := ber.DecodePacketErr(data)
+			if err != nil {
+				return append(vals, r)
+			}
+			vals = append(vals, p.Data.String())
+			data = data[len(p.Bytes()):]
+		}
+	}
+	return vals
+}
+
+func (d *testDirectory) handleDelete(w *gldap.ResponseWriter, r *gldap.Request) {
+	resp := r.NewResponse(gldap.WithApplicationCode(gldap.ApplicationDelResponse),
+		gldap.WithResponseCode(gldap.ResultNoSuchObject))
+	defer func() { _ = w.Write(resp) }()
+	m, err := r.GetDeleteMessage()
+	if err != nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, e := range d.entries {
+		if strings.EqualFold(e.dn, m.DN) {
+			d.entries = append(d.entries[:i], d.entries[i+1:]...)
+			resp.SetResultCode(gldap.ResultSuccess)
+			return
+		}
+	}
+}
+
+// matches evaluates a compiled search filter against an entry.
+func (d *testDirectory) matches(filter *ber.Packet, e *testEntry) bool {
+	switch filter.Tag {
+	case ldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !d.matches(child, e) {
+				return false
+			}
+		}
+		return true
+	case ldap.FilterOr:
+		for _, child := range filter.Children {
+			if d.matches(child, e) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterNot:
+		return !d.matches(filter.Children[0], e)
+	case ldap.FilterPresent:
+		return len(e.values(filter.Data.String())) > 0
+	case ldap.FilterEqualityMatch:
+		return containsFold(e.values(filter.Children[0].Data.String()), filter.Children[1].Data.String())
+	case ldap.FilterExtensibleMatch:
+		var rule, attr, value string
+		for _, child := range filter.Children {
+			switch child.Tag {
+			case ldap.MatchingRuleAssertionMatchingRule:
+				rule = child.Data.String()
+			case ldap.MatchingRuleAssertionType:
+				attr = child.Data.String()
+			case ldap.MatchingRuleAssertionMatchValue:
+				value = child.Data.String()
+			}
+		}
+		if rule != ldapMatchingRuleInChain {
+			return false
+		}
+		return d.hasTransitiveMember(e, attr, value, map[string]bool{})
+	default:
+		return false
+	}
+}
+
+func (d *testDirectory) hasTransitiveMember(group *testEntry, attr, dn string, visited map[string]bool) bool {
+	if visited[strings.ToLower(group.dn)] {
+		return false
+	}
+	visited[strings.ToLower(group.dn)] = true
+	for _, member := range group.values(attr) {
+		if strings.EqualFold(member, dn) {
+			return true
+		}
+		if nested := d.find(member); nested != nil && d.hasTransitiveMember(nested, attr, dn, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// adPassword encodes a password the way Active Directory expects it in unicodePwd.
+func adPassword(password string) string {
+	_, value := (&ldapSettings{activeDirectory: true}).passwordChange(password)
+	return value
+}
+
+type LDAPDirectoryTestSuite struct {
+	suite.Suite
+}
+
+func TestLDAPDirectoryTestSuite(t *testing.T) {
+	suite.Run(t, new(LDAPDirectoryTestSuite))
+}
+
+func (suite *LDAPDirectoryTestSuite) TestNewLDAPSettings_Defaults() {
+	settings, err := newLDAPSettings(config.LDAPEntityProviderConfig{
+		URL:  "ldap://localhost:389",
+		User: config.LDAPUserConfig{BaseDN: "ou=people,dc=example,dc=org", EntityType: "Person", OUID: "ou-1"},
+	})
+
+	suite.Require().NoError(err)
+	suite.True(settings.readOnly)
+	suite.Equal("entryUUID", settings.idAttribute)
+	suite.Equal("uid", settings.rdnAttribute)
+	suite.Equal("uid", settings.attributes["username"])
+	suite.Equal("password", settings.passwordAttribute)
+	suite.Equal(ldapDefaultTimeout, settings.timeout)
+}
+
+func (suite *LDAPDirectoryTestSuite) TestNewLDAPSettings_ActiveDirectoryDefaults() {
+	settings, err := newLDAPSettings(config.LDAPEntityProviderConfig{
+		URL:             "ldaps://dc.example.com",
+		Mode:            "read_write",
+		ActiveDirectory: true,
+		Timeout:         3,
+		User:            config.LDAPUserConfig{BaseDN: "cn=Users,dc=example,dc=com", EntityType: "Person", OUID: "ou-1"},
+	})
+
+	suite.Require().NoError(err)
+	suite.False(settings.readOnly)
+	suite.Equal(ldapAttrObjectGUID, settings.idAttribute)
+	suite.Equal("sAMAccountName", settings.attributes["username"])
+	suite.Equal("(objectClass=group)", settings.groupFilter)
+	suite.Equal(3*time.Second, settings.timeout)
+}
+
+func (suite *LDAPDirectoryTestSuite) TestNewLDAPSettings_Invalid() {
+	user := config.LDAPUserConfig{BaseDN: "ou=people,dc=example,dc=org", EntityType: "Person", OUID: "ou-1"}
+	testCases := []struct {
+		name string
+		cfg  config.LDAPEntityProviderConfig
+	}{
+		{"MissingURL", config.LDAPEntityProviderConfig{User: user}},
+		{"MissingBaseDN", config.LDAPEntityProviderConfig{URL: "ldap://localhost",
+			User: config.LDAPUserConfig{EntityType: "Person", OUID: "ou-1"}}},
+		{"MissingEntityType", config.LDAPEntityProviderConfig{URL: "ldap://localhost",
+			User: config.LDAPUserConfig{BaseDN: "dc=example", OUID: "ou-1"}}},
+		{"MissingOUID", config.LDAPEntityProviderConfig{URL: "ldap://localhost",
+			User: config.LDAPUserConfig{BaseDN: "dc=example", EntityType: "Person"}}},
+		{"UnknownMode", config.LDAPEntityProviderConfig{URL: "ldap://localhost", User: user, Mode: "write_only"}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := newLDAPSettings(tc.cfg)
+			suite.Error(err)
+		})
+	}
+}
+
+func (suite *LDAPDirectoryTestSuite) TestObjectGUIDRoundTrip() {
+	raw := []byte{0x67, 0x45, 0x23, 0x01, 0xab, 0x89, 0xef, 0xcd, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+
+	id, ok := formatObjectGUID(raw)
+	suite.Require().True(ok)
+	suite.Equal("01234567-89ab-cdef-0123-456789abcdef", id)
+
+	parsed, ok := parseObjectGUID(id)
+	suite.Require().True(ok)
+	suite.Equal(raw, parsed)
+}
+
+func (suite *LDAPDirectoryTestSuite) TestObjectGUIDInvalid() {
+	_, ok := formatObjectGUID([]byte{0x01})
+	suite.False(ok)
+	_, ok = parseObjectGUID("not-a-guid")
+	suite.False(ok)
+	_, ok = parseObjectGUID("0123456z-89ab-cdef-0123-456789abcdef")
+	suite.False(ok)
+}
+
+func (suite *LDAPDirectoryTestSuite) TestIDFilter_ObjectGUIDIsByteEscaped() {
+	settings := &ldapSettings{idAttribute: ldapAttrObjectGUID}
+
+	filter, ok := settings.idFilter("01234567-89ab-cdef-0123-456789abcdef")
+
+	suite.True(ok)
+	suite.Equal(`(objectGUID=\67\45\23\01\ab\89\ef\cd\01\23\45\67\89\ab\cd\ef)`, filter)
+}
+
+func (suite *LDAPDirectoryTestSuite) TestAttributeFilter() {
+	settings := &ldapSettings{attributes: map[string]string{"username": "uid", "email": "mail"}}
+
+	filter, ok := settings.attributeFilter(map[string]interface{}{"username": "a*b", "email": "a@example.com"})
+	suite.True(ok)
+	suite.Equal(`(mail=a@example.com)(uid=a\2ab)`, filter)
+
+	_, ok = settings.attributeFilter(map[string]interface{}{"clientId": "app"})
+	suite.False(ok)
+	_, ok = settings.attributeFilter(map[string]interface{}{})
+	suite.False(ok)
+}
+
+func (suite *LDAPDirectoryTestSuite) TestPasswordChange_ActiveDirectory() {
+	settings := &ldapSettings{activeDirectory: true}
+
+	attr, value := settings.passwordChange("ab")
+
+	suite.Equal(ldapAttrUnicodePwd, attr)
+	suite.Equal("\"\x00a\x00b\x00\"\x00", value)
+}
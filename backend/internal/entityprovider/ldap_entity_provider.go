@@ -0,0 +1,676 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entityprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// errReadOnlyEntity is the error returned when a directory entry is modified in read-only mode.
+var errReadOnlyEntity = NewEntityProviderError(
+	ErrorCodeReadOnlyEntity,
+	"Read-only entity",
+	"The entity is held in a read-only directory and cannot be modified.",
+)
+
+// errInvalidCredentials is the error returned when a directory bind is rejected.
+var errInvalidCredentials = NewEntityProviderError(
+	ErrorCodeInvalidCredentials,
+	"Invalid credentials",
+	"The provided credentials are invalid.",
+)
+
+// ldapEntityProvider serves users from an LDAP directory or Active Directory. Entities the directory
+// does not hold, such as applications, agents and local users, are delegated to the fallback
+// provider, so the directory and the local store are used side by side. Directory entries take
+// precedence when both match a lookup.
+type ldapEntityProvider struct {
+	settings *ldapSettings
+	fallback EntityProviderInterface
+}
+
+var (
+	_ EntityProviderInterface     = (*ldapEntityProvider)(nil)
+	_ CredentialVerifierInterface = (*ldapEntityProvider)(nil)
+)
+
+// newLDAPEntityProvider creates a new LDAP entity provider.
+func newLDAPEntityProvider(settings *ldapSettings, fallback EntityProviderInterface) *ldapEntityProvider {
+	return &ldapEntityProvider{
+		settings: settings,
+		fallback: fallback,
+	}
+}
+
+// IdentifyEntity resolves an entity ID from attribute filters, searching the directory first when
+// every filter is mapped to a directory attribute.
+func (p *ldapEntityProvider) IdentifyEntity(
+	filters map[string]interface{},
+) (*string, *EntityProviderError) {
+	entries, epErr := p.searchByAttributes(filters)
+	if epErr != nil {
+		return nil, epErr
+	}
+	switch len(entries) {
+	case 0:
+		return p.fallback.IdentifyEntity(filters)
+	case 1:
+		id := p.settings.entryID(entries[0])
+		return &id, nil
+	default:
+		return nil, NewEntityProviderError(ErrorCodeAmbiguousEntity, "Ambiguous entity",
+			"multiple directory entries match the given filters")
+	}
+}
+
+// SearchEntities searches the directory and the fallback provider for entities matching the filters.
+func (p *ldapEntityProvider) SearchEntities(
+	filters map[string]interface{},
+) ([]*providers.Entity, *EntityProviderError) {
+	entries, epErr := p.searchByAttributes(filters)
+	if epErr != nil {
+		return nil, epErr
+	}
+	result := make([]*providers.Entity, 0, len(entries))
+	for _, entry := range entries {
+		e, epErr := p.toEntity(entry)
+		if epErr != nil {
+			return nil, epErr
+		}
+		result = append(result, e)
+	}
+
+	local, epErr := p.fallback.SearchEntities(filters)
+	if epErr != nil {
+		return nil, epErr
+	}
+	return append(result, local...), nil
+}
+
+// GetEntity retrieves an entity by ID.
+func (p *ldapEntityProvider) GetEntity(
+	entityID string,
+) (*providers.Entity, *EntityProviderError) {
+	entry, epErr := p.findUser(entityID)
+	if epErr != nil {
+		return nil, epErr
+	}
+	if entry == nil {
+		return p.fallback.GetEntity(entityID)
+	}
+	return p.toEntity(entry)
+}
+
+// CreateEntity creates a user in the directory in read-write mode. Other entities, and users in
+// read-only mode, are created by the fallback provider.
+func (p *ldapEntityProvider) CreateEntity(
+	e *providers.Entity, systemCredentials json.RawMessage,
+) (*providers.Entity, *EntityProviderError) {
+	if e == nil {
+		return nil, NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request",
+			"Entity cannot be nil")
+	}
+	if p.settings.readOnly || e.Category != providers.EntityCategoryUser {
+		return p.fallback.CreateEntity(e, systemCredentials)
+	}
+
+	values, password, err := p.settings.ldapValues(e.Attributes)
+	if err != nil {
+		return nil, NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request", err.Error())
+	}
+	rdnValues := values[p.settings.rdnAttribute]
+	if len(rdnValues) == 0 || rdnValues[0] == "" {
+		return nil, NewEntityProviderError(ErrorCodeMissingRequiredFields, "Missing required fields",
+			fmt.Sprintf("the %s directory attribute is required", p.settings.rdnAttribute))
+	}
+	dn := fmt.Sprintf("%s=%s,%s", p.settings.rdnAttribute, ldap.EscapeDN(rdnValues[0]), p.settings.userBaseDN)
+
+	addReq := ldap.NewAddRequest(dn, nil)
+	addReq.Attribute(ldapAttrObjectClass, p.settings.userObjectClasses)
+	for _, name := range sortedKeys(values) {
+		if len(values[name]) > 0 {
+			addReq.Attribute(name, values[name])
+		}
+	}
+	if password != "" {
+		pwdAttr, pwdValue := p.settings.passwordChange(password)
+		addReq.Attribute(pwdAttr, []string{pwdValue})
+		if p.settings.activeDirectory {
+			// A normal, enabled account. Active Directory disables new accounts by default.
+			addReq.Attribute("userAccountControl", []string{"512"})
+		}
+	}
+
+	var created *ldap.Entry
+	err = p.settings.withServiceConn(func(conn *ldap.Conn) error {
+		if err := conn.Add(addReq); err != nil {
+			return err
+		}
+		var getErr error
+		created, getErr = p.settings.getUserByDN(conn, dn)
+		return getErr
+	})
+	if err != nil {
+		return nil, mapLDAPError(err)
+	}
+	if created == nil {
+		return nil, NewEntityProviderError(ErrorCodeSystemError, "System error",
+			"the created directory entry could not be read back")
+	}
+	return p.toEntity(created)
+}
+
+// UpdateEntity updates an entity's attributes. The entity type and organization unit of directory
+// users are fixed by configuration.
+func (p *ldapEntityProvider) UpdateEntity(
+	entityID string, e *providers.Entity,
+) (*providers.Entity, *EntityProviderError) {
+	if e == nil {
+		return nil, NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request",
+			"Entity cannot be nil")
+	}
+	entry, epErr := p.findUser(entityID)
+	if epErr != nil {
+		return nil, epErr
+	}
+	if entry == nil {
+		return p.fallback.UpdateEntity(entityID, e)
+	}
+	if epErr := p.modifyAttributes(entry, e.Attributes); epErr != nil {
+		return nil, epErr
+	}
+	return p.GetEntity(entityID)
+}
+
+// DeleteEntity deletes an entity by ID.
+func (p *ldapEntityProvider) DeleteEntity(
+	entityID string,
+) *EntityProviderError {
+	entry, epErr := p.findUser(entityID)
+	if epErr != nil {
+		return epErr
+	}
+	if entry == nil {
+		return p.fallback.DeleteEntity(entityID)
+	}
+	if p.settings.readOnly {
+		return errReadOnlyEntity
+	}
+	err := p.settings.withServiceConn(func(conn *ldap.Conn) error {
+		return conn.Del(ldap.NewDelRequest(entry.DN, nil))
+	})
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+		return mapLDAPError(err)
+	}
+	return nil
+}
+
+// UpdateCredentials sets the password of a directory user. Only the password credential is held in
+// the directory.
+func (p *ldapEntityProvider) UpdateCredentials(
+	entityID string, credentials json.RawMessage,
+) *EntityProviderError {
+	entry, epErr := p.findUser(entityID)
+	if epErr != nil {
+		return epErr
+	}
+	if entry == nil {
+		return p.fallback.UpdateCredentials(entityID, credentials)
+	}
+	if p.settings.readOnly {
+		return errReadOnlyEntity
+	}
+
+	var creds map[string]interface{}
+	if err := json.Unmarshal(credentials, &creds); err != nil {
+		return NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid credential", err.Error())
+	}
+	password, _ := creds[p.settings.passwordAttribute].(string)
+	if len(creds) != 1 || password == "" {
+		return NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid credential",
+			fmt.Sprintf("only the %s credential can be set for directory users", p.settings.passwordAttribute))
+	}
+
+	modifyReq := ldap.NewModifyRequest(entry.DN, nil)
+	pwdAttr, pwdValue := p.settings.passwordChange(password)
+	modifyReq.Replace(pwdAttr, []string{pwdValue})
+	if err := p.settings.withServiceConn(func(conn *ldap.Conn) error {
+		return conn.Modify(modifyReq)
+	}); err != nil {
+		return mapLDAPError(err)
+	}
+	return nil
+}
+
+// UpdateAttributes replaces the attributes of an entity.
+func (p *ldapEntityProvider) UpdateAttributes(
+	entityID string, attributes json.RawMessage,
+) *EntityProviderError {
+	entry, epErr := p.findUser(entityID)
+	if epErr != nil {
+		return epErr
+	}
+	if entry == nil {
+		return p.fallback.UpdateAttributes(entityID, attributes)
+	}
+	return p.modifyAttributes(entry, attributes)
+}
+
+// UpdateSystemAttributes updates system-managed attributes. Directory users have none.
+func (p *ldapEntityProvider) UpdateSystemAttributes(
+	entityID string, attributes json.RawMessage,
+) *EntityProviderError {
+	entry, epErr := p.findUser(entityID)
+	if epErr != nil {
+		return epErr
+	}
+	if entry == nil {
+		return p.fallback.UpdateSystemAttributes(entityID, attributes)
+	}
+	return errNotImplemented
+}
+
+// UpdateSystemCredentials updates system-managed credentials. Directory users have none.
+func (p *ldapEntityProvider) UpdateSystemCredentials(
+	entityID string, credentials json.RawMessage,
+) *EntityProviderError {
+	entry, epErr := p.findUser(entityID)
+	if epErr != nil {
+		return epErr
+	}
+	if entry == nil {
+		return p.fallback.UpdateSystemCredentials(entityID, credentials)
+	}
+	return errNotImplemented
+}
+
+// GetCredentials retrieves stored credentials. Directory users have none held by the server.
+func (p *ldapEntityProvider) GetCredentials(
+	entityID, credentialType string,
+) (json.RawMessage, *EntityProviderError) {
+	entry, epErr := p.findUser(entityID)
+	if epErr != nil {
+		return nil, epErr
+	}
+	if entry == nil {
+		return p.fallback.GetCredentials(entityID, credentialType)
+	}
+	return nil, errNotImplemented
+}
+
+// GetTransitiveEntityGroups retrieves the directory groups a directory user belongs to, directly or
+// through nested groups. Active Directory resolves the nesting on the server; other directories
+// are walked one level at a time.
+func (p *ldapEntityProvider) GetTransitiveEntityGroups(
+	entityID string,
+) ([]providers.EntityGroup, *EntityProviderError) {
+	entry, epErr := p.findUser(entityID)
+	if epErr != nil {
+		return nil, epErr
+	}
+	if entry == nil {
+		return p.fallback.GetTransitiveEntityGroups(entityID)
+	}
+	if p.settings.groupBaseDN == "" {
+		return []providers.EntityGroup{}, nil
+	}
+
+	var groups []*ldap.Entry
+	err := p.settings.withServiceConn(func(conn *ldap.Conn) error {
+		var searchErr error
+		if p.settings.activeDirectory {
+			groups, searchErr = p.settings.searchGroups(conn, fmt.Sprintf("(%s:%s:=%s)",
+				p.settings.memberAttribute, ldapMatchingRuleInChain, ldap.EscapeFilter(entry.DN)))
+			return searchErr
+		}
+		groups, searchErr = p.settings.walkGroups(conn, entry.DN)
+		return searchErr
+	})
+	if err != nil {
+		return nil, mapLDAPError(err)
+	}
+
+	result := make([]providers.EntityGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, providers.EntityGroup{
+			ID:   p.settings.entryID(group),
+			Name: group.GetEqualFoldAttributeValue(p.settings.nameAttribute),
+			OUID: p.settings.ouID,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+// ValidateEntityIDs validates that the given entity IDs exist in the directory or the fallback
+// provider.
+func (p *ldapEntityProvider) ValidateEntityIDs(
+	entityIDs []string,
+) ([]string, *EntityProviderError) {
+	_, remaining, epErr := p.partitionByDirectory(entityIDs)
+	if epErr != nil {
+		return nil, epErr
+	}
+	if len(remaining) == 0 {
+		return []string{}, nil
+	}
+	return p.fallback.ValidateEntityIDs(remaining)
+}
+
+// GetEntitiesByIDs retrieves multiple entities by their IDs.
+func (p *ldapEntityProvider) GetEntitiesByIDs(
+	entityIDs []string,
+) ([]providers.Entity, *EntityProviderError) {
+	found, remaining, epErr := p.partitionByDirectory(entityIDs)
+	if epErr != nil {
+		return nil, epErr
+	}
+	result := make([]providers.Entity, 0, len(entityIDs))
+	for _, entry := range found {
+		e, epErr := p.toEntity(entry)
+		if epErr != nil {
+			return nil, epErr
+		}
+		result = append(result, *e)
+	}
+	if len(remaining) == 0 {
+		return result, nil
+	}
+	local, epErr := p.fallback.GetEntitiesByIDs(remaining)
+	if epErr != nil {
+		return nil, epErr
+	}
+	return append(result, local...), nil
+}
+
+// GetEntityListCount returns the total number of entities in the given category. Directory users
+// are counted ahead of local users.
+func (p *ldapEntityProvider) GetEntityListCount(
+	category providers.EntityCategory, filters map[string]interface{},
+) (int, *EntityProviderError) {
+	if category != providers.EntityCategoryUser {
+		return p.fallback.GetEntityListCount(category, filters)
+	}
+	entries, epErr := p.listUsers(filters)
+	if epErr != nil {
+		return 0, epErr
+	}
+	localCount, epErr := p.fallback.GetEntityListCount(category, filters)
+	if epErr != nil {
+		return 0, epErr
+	}
+	return len(entries) + localCount, nil
+}
+
+// GetEntityList returns a page of entities in the given category. Directory users are listed ahead
+// of local users, ordered by entity ID.
+func (p *ldapEntityProvider) GetEntityList(
+	category providers.EntityCategory, limit, offset int, filters map[string]interface{},
+) ([]providers.Entity, *EntityProviderError) {
+	if category != providers.EntityCategoryUser {
+		return p.fallback.GetEntityList(category, limit, offset, filters)
+	}
+	entries, epErr := p.listUsers(filters)
+	if epErr != nil {
+		return nil, epErr
+	}
+
+	result := make([]providers.Entity, 0, limit)
+	for i := offset; i < len(entries) && len(result) < limit; i++ {
+		e, epErr := p.toEntity(entries[i])
+		if epErr != nil {
+			return nil, epErr
+		}
+		result = append(result, *e)
+	}
+	if len(result) == limit {
+		return result, nil
+	}
+
+	localOffset := max(offset-len(entries), 0)
+	local, epErr := p.fallback.GetEntityList(category, limit-len(result), localOffset, filters)
+	if epErr != nil {
+		return nil, epErr
+	}
+	return append(result, local...), nil
+}
+
+// VerifyCredentials verifies a directory user's password by binding as the user.
+func (p *ldapEntityProvider) VerifyCredentials(entityID, password string) *EntityProviderError {
+	entry, epErr := p.findUser(entityID)
+	if epErr != nil {
+		return epErr
+	}
+	if entry == nil {
+		return NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found",
+			"the entity is not held in the directory")
+	}
+	if password == "" {
+		// An empty password would make the bind an unauthenticated one.
+		return errInvalidCredentials
+	}
+
+	conn, err := p.settings.dial()
+	if err != nil {
+		return mapLDAPError(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return errInvalidCredentials
+		}
+		return mapLDAPError(err)
+	}
+	return nil
+}
+
+// searchByAttributes searches the directory for users matching the attribute filters. Filters on
+// attributes the directory does not hold match no directory users.
+func (p *ldapEntityProvider) searchByAttributes(
+	filters map[string]interface{},
+) ([]*ldap.Entry, *EntityProviderError) {
+	filter, ok := p.settings.attributeFilter(filters)
+	if !ok {
+		return nil, nil
+	}
+	var entries []*ldap.Entry
+	err := p.settings.withServiceConn(func(conn *ldap.Conn) error {
+		var searchErr error
+		entries, searchErr = p.settings.searchUsers(conn, filter)
+		return searchErr
+	})
+	if err != nil {
+		return nil, mapLDAPError(err)
+	}
+	return entries, nil
+}
+
+// listUsers returns the directory users matching the filters, ordered by entity ID.
+func (p *ldapEntityProvider) listUsers(
+	filters map[string]interface{},
+) ([]*ldap.Entry, *EntityProviderError) {
+	var entries []*ldap.Entry
+	if len(filters) == 0 {
+		err := p.settings.withServiceConn(func(conn *ldap.Conn) error {
+			var searchErr error
+			entries, searchErr = p.settings.searchUsers(conn, "")
+			return searchErr
+		})
+		if err != nil {
+			return nil, mapLDAPError(err)
+		}
+	} else {
+		var epErr *EntityProviderError
+		if entries, epErr = p.searchByAttributes(filters); epErr != nil {
+			return nil, epErr
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return p.settings.entryID(entries[i]) < p.settings.entryID(entries[j])
+	})
+	return entries, nil
+}
+
+// findUser returns the directory user with the given entity ID, or nil when the directory does not
+// hold the entity.
+func (p *ldapEntityProvider) findUser(entityID string) (*ldap.Entry, *EntityProviderError) {
+	var entry *ldap.Entry
+	err := p.settings.withServiceConn(func(conn *ldap.Conn) error {
+		var searchErr error
+		entry, searchErr = p.settings.findUserByID(conn, entityID)
+		return searchErr
+	})
+	if err != nil {
+		return nil, mapLDAPError(err)
+	}
+	return entry, nil
+}
+
+// partitionByDirectory splits entity IDs into the directory users found and the IDs left for the
+// fallback provider.
+func (p *ldapEntityProvider) partitionByDirectory(
+	entityIDs []string,
+) ([]*ldap.Entry, []string, *EntityProviderError) {
+	var found []*ldap.Entry
+	remaining := make([]string, 0, len(entityIDs))
+	err := p.settings.withServiceConn(func(conn *ldap.Conn) error {
+		for _, id := range entityIDs {
+			entry, searchErr := p.settings.findUserByID(conn, id)
+			if searchErr != nil {
+				return searchErr
+			}
+			if entry == nil {
+				remaining = append(remaining, id)
+				continue
+			}
+			found = append(found, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, mapLDAPError(err)
+	}
+	return found, remaining, nil
+}
+
+// modifyAttributes replaces the mapped attributes of a directory user. Mapped attributes missing
+// from the update are removed. The naming attribute cannot be changed.
+func (p *ldapEntityProvider) modifyAttributes(entry *ldap.Entry,
+	attributes json.RawMessage) *EntityProviderError {
+	if p.settings.readOnly {
+		return errReadOnlyEntity
+	}
+	values, password, err := p.settings.ldapValues(attributes)
+	if err != nil {
+		return NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request", err.Error())
+	}
+
+	modifyReq := ldap.NewModifyRequest(entry.DN, nil)
+	current := make(map[string]struct{}, len(p.settings.attributes))
+	for _, ldapName := range sortedValues(p.settings.attributes) {
+		if _, done := current[ldapName]; done {
+			continue
+		}
+		current[ldapName] = struct{}{}
+		if strings.EqualFold(ldapName, p.settings.rdnAttribute) {
+			if newValues, ok := values[ldapName]; ok &&
+				!equalFoldValues(newValues, entry.GetEqualFoldAttributeValues(ldapName)) {
+				return NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request",
+					fmt.Sprintf("the %s directory attribute cannot be changed", ldapName))
+			}
+			continue
+		}
+		newValues := values[ldapName]
+		if len(newValues) == 0 && len(entry.GetEqualFoldAttributeValues(ldapName)) == 0 {
+			continue
+		}
+		modifyReq.Replace(ldapName, newValues)
+	}
+	if password != "" {
+		pwdAttr, pwdValue := p.settings.passwordChange(password)
+		modifyReq.Replace(pwdAttr, []string{pwdValue})
+	}
+	if len(modifyReq.Changes) == 0 {
+		return nil
+	}
+
+	if err := p.settings.withServiceConn(func(conn *ldap.Conn) error {
+		return conn.Modify(modifyReq)
+	}); err != nil {
+		return mapLDAPError(err)
+	}
+	return nil
+}
+
+// toEntity converts a directory user entry to an entity.
+func (p *ldapEntityProvider) toEntity(entry *ldap.Entry) (*providers.Entity, *EntityProviderError) {
+	attributes, err := p.settings.entryAttributes(entry)
+	if err != nil {
+		return nil, NewEntityProviderError(ErrorCodeSystemError, "System error", err.Error())
+	}
+	return &providers.Entity{
+		ID:         p.settings.entryID(entry),
+		Category:   providers.EntityCategoryUser,
+		Type:       p.settings.entityType,
+		State:      providers.EntityStateActive,
+		OUID:       p.settings.ouID,
+		Attributes: attributes,
+		IsReadOnly: p.settings.readOnly,
+	}, nil
+}
+
+// mapLDAPError converts a directory error into an EntityProviderError.
+func mapLDAPError(err error) *EntityProviderError {
+	switch {
+	case ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject):
+		return NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found", err.Error())
+	case ldap.IsErrorWithCode(err, ldap.LDAPResultEntryAlreadyExists):
+		return NewEntityProviderError(ErrorCodeAttributeConflict, "Attribute conflict", err.Error())
+	case ldap.IsErrorWithCode(err, ldap.LDAPResultConstraintViolation),
+		ldap.IsErrorWithCode(err, ldap.LDAPResultObjectClassViolation),
+		ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidAttributeSyntax):
+		return NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request", err.Error())
+	default:
+		return NewEntityProviderError(ErrorCodeSystemError, "System error", err.Error())
+	}
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedValues(m map[string]string) []string {
+	values := make([]string, 0, len(m))
+	for _, value := range m {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return values
+}
+
+func equalFoldValues(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !strings.EqualFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
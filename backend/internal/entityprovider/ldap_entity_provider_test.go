@@ -0,0 +1,437 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entityprovider
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+)
+
+const (
+	testLDAPAdminDN  = "cn=admin,dc=example,dc=org"
+	testLDAPPeopleDN = "ou=people,dc=example,dc=org"
+	testLDAPGroupsDN = "ou=groups,dc=example,dc=org"
+	testLDAPAliceDN  = "uid=alice,ou=people,dc=example,dc=org"
+	testLDAPOUID     = "ou-ldap"
+)
+
+type LDAPEntityProviderTestSuite struct {
+	suite.Suite
+	directory   *testDirectory
+	mockService *entitymock.EntityServiceInterfaceMock
+	provider    *ldapEntityProvider
+}
+
+func TestLDAPEntityProviderTestSuite(t *testing.T) {
+	suite.Run(t, new(LDAPEntityProviderTestSuite))
+}
+
+func (suite *LDAPEntityProviderTestSuite) SetupTest() {
+	suite.directory = startTestDirectory(suite.T(),
+		newTestEntry(testLDAPAdminDN, map[string][]string{"userPassword": {"admin-secret"}}),
+		newTestEntry(testLDAPPeopleDN, map[string][]string{"objectClass": {"organizationalUnit"}}),
+		newTestEntry(testLDAPAliceDN, map[string][]string{
+			"objectClass": {"inetOrgPerson"}, "entryUUID": {"uuid-alice"}, "uid": {"alice"},
+			"mail": {"alice@example.com"}, "givenName": {"Alice"}, "sn": {"Smith"}, "cn": {"Alice Smith"},
+			"userPassword": {"alice-secret"},
+		}),
+		newTestEntry("uid=bob,ou=people,dc=example,dc=org", map[string][]string{
+			"objectClass": {"inetOrgPerson"}, "entryUUID": {"uuid-bob"}, "uid": {"bob"},
+			"mail": {"bob@example.com", "robert@example.com"}, "sn": {"Smith"}, "userPassword": {"bob-secret"},
+		}),
+		newTestEntry(testLDAPGroupsDN, map[string][]string{"objectClass": {"organizationalUnit"}}),
+		newTestEntry("cn=engineers,ou=groups,dc=example,dc=org", map[string][]string{
+			"objectClass": {"groupOfNames"}, "entryUUID": {"uuid-engineers"}, "cn": {"engineers"},
+			"member": {testLDAPAliceDN},
+		}),
+		newTestEntry("cn=staff,ou=groups,dc=example,dc=org", map[string][]string{
+			"objectClass": {"groupOfNames"}, "entryUUID": {"uuid-staff"}, "cn": {"staff"},
+			"member": {"cn=engineers,ou=groups,dc=example,dc=org", "cn=all,ou=groups,dc=example,dc=org"},
+		}),
+		newTestEntry("cn=all,ou=groups,dc=example,dc=org", map[string][]string{
+			"objectClass": {"groupOfNames"}, "entryUUID": {"uuid-all"}, "cn": {"all"},
+			"member": {"cn=staff,ou=groups,dc=example,dc=org"},
+		}),
+		newTestEntry("cn=sales,ou=groups,dc=example,dc=org", map[string][]string{
+			"objectClass": {"groupOfNames"}, "entryUUID": {"uuid-sales"}, "cn": {"sales"},
+			"member": {"uid=bob,ou=people,dc=example,dc=org"},
+		}),
+	)
+	suite.mockService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.provider = newLDAPEntityProvider(suite.newSettings(true), newDefaultEntityProvider(suite.mockService))
+}
+
+func (suite *LDAPEntityProviderTestSuite) newSettings(readOnly bool) *ldapSettings {
+	return &ldapSettings{
+		url:               suite.directory.url,
+		bindDN:            testLDAPAdminDN,
+		bindPassword:      "admin-secret",
+		timeout:           5 * time.Second,
+		readOnly:          readOnly,
+		userBaseDN:        testLDAPPeopleDN,
+		userFilter:        "(objectClass=inetOrgPerson)",
+		userObjectClasses: []string{"top", "inetOrgPerson"},
+		rdnAttribute:      "uid",
+		idAttribute:       "entryUUID",
+		entityType:        "Person",
+		ouID:              testLDAPOUID,
+		passwordAttribute: "password",
+		attributes: map[string]string{"username": "uid", "email": "mail", "given_name": "givenName",
+			"family_name": "sn", "name": "cn"},
+		groupBaseDN:     testLDAPGroupsDN,
+		groupFilter:     "(objectClass=groupOfNames)",
+		memberAttribute: "member",
+		nameAttribute:   "cn",
+	}
+}
+
+func (suite *LDAPEntityProviderTestSuite) useReadWrite() {
+	suite.provider = newLDAPEntityProvider(suite.newSettings(false), newDefaultEntityProvider(suite.mockService))
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestIdentifyEntity_FromDirectory() {
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"username": "alice"})
+
+	suite.Nil(err)
+	suite.Require().NotNil(id)
+	suite.Equal("uuid-alice", *id)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestIdentifyEntity_MultiValuedAttribute() {
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"email": "robert@example.com"})
+
+	suite.Nil(err)
+	suite.Require().NotNil(id)
+	suite.Equal("uuid-bob", *id)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestIdentifyEntity_NotInDirectoryUsesFallback() {
+	filters := map[string]interface{}{"username": "carol"}
+	localID := "local-carol"
+	suite.mockService.On("IdentifyEntity", mock.Anything, filters).Return(&localID, nil).Once()
+
+	id, err := suite.provider.IdentifyEntity(filters)
+
+	suite.Nil(err)
+	suite.Equal(localID, *id)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestIdentifyEntity_UnmappedFilterUsesFallback() {
+	filters := map[string]interface{}{"clientId": "console"}
+	suite.mockService.On("IdentifyEntity", mock.Anything, filters).Return(nil, entity.ErrEntityNotFound).Once()
+
+	id, err := suite.provider.IdentifyEntity(filters)
+
+	suite.Nil(id)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestIdentifyEntity_Ambiguous() {
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"family_name": "Smith"})
+
+	suite.Nil(id)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeAmbiguousEntity, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestIdentifyEntity_DirectoryUnavailable() {
+	settings := suite.newSettings(true)
+	settings.url = "ldap://127.0.0.1:1"
+	provider := newLDAPEntityProvider(settings, newDefaultEntityProvider(suite.mockService))
+
+	id, err := provider.IdentifyEntity(map[string]interface{}{"username": "alice"})
+
+	suite.Nil(id)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeSystemError, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestSearchEntities_CombinesDirectoryAndFallback() {
+	filters := map[string]interface{}{"family_name": "Smith"}
+	suite.mockService.On("SearchEntities", mock.Anything, filters).
+		Return([]providers.Entity{{ID: "local-smith", Category: providers.EntityCategoryUser}}, nil).Once()
+
+	entities, err := suite.provider.SearchEntities(filters)
+
+	suite.Nil(err)
+	suite.Require().Len(entities, 3)
+	suite.ElementsMatch([]string{"uuid-alice", "uuid-bob", "local-smith"},
+		[]string{entities[0].ID, entities[1].ID, entities[2].ID})
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetEntity_FromDirectory() {
+	e, err := suite.provider.GetEntity("uuid-alice")
+
+	suite.Nil(err)
+	suite.Require().NotNil(e)
+	suite.Equal("uuid-alice", e.ID)
+	suite.Equal(providers.EntityCategoryUser, e.Category)
+	suite.Equal("Person", e.Type)
+	suite.Equal(providers.EntityStateActive, e.State)
+	suite.Equal(testLDAPOUID, e.OUID)
+	suite.True(e.IsReadOnly)
+	suite.JSONEq(`{"username":"alice","email":"alice@example.com","given_name":"Alice","family_name":"Smith",
+		"name":"Alice Smith"}`, string(e.Attributes))
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetEntity_MultiValuedAttributeIsArray() {
+	e, err := suite.provider.GetEntity("uuid-bob")
+
+	suite.Nil(err)
+	var attrs map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(e.Attributes, &attrs))
+	suite.Equal([]interface{}{"bob@example.com", "robert@example.com"}, attrs["email"])
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetEntity_NotInDirectoryUsesFallback() {
+	suite.mockService.On("GetEntity", mock.Anything, "app-1").
+		Return(&providers.Entity{ID: "app-1", Category: providers.EntityCategoryApp}, nil).Once()
+
+	e, err := suite.provider.GetEntity("app-1")
+
+	suite.Nil(err)
+	suite.Equal("app-1", e.ID)
+	suite.False(e.IsReadOnly)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetTransitiveEntityGroups_NestedGroups() {
+	groups, err := suite.provider.GetTransitiveEntityGroups("uuid-alice")
+
+	suite.Nil(err)
+	suite.Equal([]providers.EntityGroup{
+		{ID: "uuid-all", Name: "all", OUID: testLDAPOUID},
+		{ID: "uuid-engineers", Name: "engineers", OUID: testLDAPOUID},
+		{ID: "uuid-staff", Name: "staff", OUID: testLDAPOUID},
+	}, groups)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetTransitiveEntityGroups_ActiveDirectoryMatchingRuleInChain() {
+	settings := suite.newSettings(true)
+	settings.activeDirectory = true
+	provider := newLDAPEntityProvider(settings, newDefaultEntityProvider(suite.mockService))
+
+	groups, err := provider.GetTransitiveEntityGroups("uuid-alice")
+
+	suite.Nil(err)
+	suite.Len(groups, 3)
+	suite.Equal("all", groups[0].Name)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetTransitiveEntityGroups_WithoutGroupBase() {
+	settings := suite.newSettings(true)
+	settings.groupBaseDN = ""
+	provider := newLDAPEntityProvider(settings, newDefaultEntityProvider(suite.mockService))
+
+	groups, err := provider.GetTransitiveEntityGroups("uuid-alice")
+
+	suite.Nil(err)
+	suite.Empty(groups)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetTransitiveEntityGroups_NotInDirectoryUsesFallback() {
+	suite.mockService.On("GetTransitiveEntityGroups", mock.Anything, "local-1").
+		Return([]providers.EntityGroup{{ID: "g1", Name: "local"}}, nil).Once()
+
+	groups, err := suite.provider.GetTransitiveEntityGroups("local-1")
+
+	suite.Nil(err)
+	suite.Equal([]providers.EntityGroup{{ID: "g1", Name: "local"}}, groups)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestVerifyCredentials() {
+	suite.Nil(suite.provider.VerifyCredentials("uuid-alice", "alice-secret"))
+
+	err := suite.provider.VerifyCredentials("uuid-alice", "wrong")
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeInvalidCredentials, err.Code)
+
+	err = suite.provider.VerifyCredentials("uuid-alice", "")
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeInvalidCredentials, err.Code)
+
+	err = suite.provider.VerifyCredentials("local-1", "secret")
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestReadOnly_RejectsDirectoryWrites() {
+	suite.Equal(errReadOnlyEntity, suite.provider.UpdateAttributes("uuid-alice", json.RawMessage(`{"email":"a@b.c"}`)))
+	suite.Equal(errReadOnlyEntity, suite.provider.UpdateCredentials("uuid-alice", json.RawMessage(`{"password":"x"}`)))
+	suite.Equal(errReadOnlyEntity, suite.provider.DeleteEntity("uuid-alice"))
+	suite.Equal(errNotImplemented, suite.provider.UpdateSystemAttributes("uuid-alice", json.RawMessage(`{}`)))
+	_, err := suite.provider.GetCredentials("uuid-alice", "totp")
+	suite.Equal(errNotImplemented, err)
+	suite.Equal([]string{"alice-secret"}, suite.directory.entry(testLDAPAliceDN).values("userPassword"))
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestReadOnly_CreatesUsersLocally() {
+	newEntity := &providers.Entity{Category: providers.EntityCategoryUser, Type: "Person",
+		Attributes: json.RawMessage(`{"username":"carol"}`)}
+	suite.mockService.On("CreateEntity", mock.Anything, mock.Anything, json.RawMessage(nil)).
+		Return(&providers.Entity{ID: "local-carol", Category: providers.EntityCategoryUser}, nil).Once()
+
+	created, err := suite.provider.CreateEntity(newEntity, nil)
+
+	suite.Nil(err)
+	suite.Equal("local-carol", created.ID)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestReadWrite_CreateUpdateAndDeleteUser() {
+	suite.useReadWrite()
+
+	created, err := suite.provider.CreateEntity(&providers.Entity{
+		Category:   providers.EntityCategoryUser,
+		Type:       "Person",
+		Attributes: json.RawMessage(`{"username":"carol","email":"carol@example.com","password":"carol-secret"}`),
+	}, nil)
+	suite.Require().Nil(err)
+	suite.NotEmpty(created.ID)
+	suite.False(created.IsReadOnly)
+	suite.JSONEq(`{"username":"carol","email":"carol@example.com"}`, string(created.Attributes))
+	suite.Nil(suite.provider.VerifyCredentials(created.ID, "carol-secret"))
+
+	err = suite.provider.UpdateAttributes(created.ID,
+		json.RawMessage(`{"username":"carol","email":"carol@example.org","given_name":"Carol"}`))
+	suite.Require().Nil(err)
+	updated, err := suite.provider.GetEntity(created.ID)
+	suite.Require().Nil(err)
+	suite.JSONEq(`{"username":"carol","email":"carol@example.org","given_name":"Carol"}`,
+		string(updated.Attributes))
+
+	suite.Nil(suite.provider.UpdateCredentials(created.ID, json.RawMessage(`{"password":"new-secret"}`)))
+	suite.Nil(suite.provider.VerifyCredentials(created.ID, "new-secret"))
+
+	suite.Nil(suite.provider.DeleteEntity(created.ID))
+	suite.Nil(suite.directory.entry("uid=carol,ou=people,dc=example,dc=org"))
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestReadWrite_CreateRequiresNamingAttribute() {
+	suite.useReadWrite()
+
+	_, err := suite.provider.CreateEntity(&providers.Entity{
+		Category:   providers.EntityCategoryUser,
+		Attributes: json.RawMessage(`{"email":"carol@example.com"}`),
+	}, nil)
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeMissingRequiredFields, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestReadWrite_CreateExistingEntryConflicts() {
+	suite.useReadWrite()
+
+	_, err := suite.provider.CreateEntity(&providers.Entity{
+		Category:   providers.EntityCategoryUser,
+		Attributes: json.RawMessage(`{"username":"alice"}`),
+	}, nil)
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeAttributeConflict, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestReadWrite_CreatesAppsLocally() {
+	suite.useReadWrite()
+	app := &providers.Entity{Category: providers.EntityCategoryApp, Attributes: json.RawMessage(`{}`)}
+	suite.mockService.On("CreateEntity", mock.Anything, mock.Anything, json.RawMessage(`{"clientSecret":"s"}`)).
+		Return(&providers.Entity{ID: "app-1", Category: providers.EntityCategoryApp}, nil).Once()
+
+	created, err := suite.provider.CreateEntity(app, json.RawMessage(`{"clientSecret":"s"}`))
+
+	suite.Nil(err)
+	suite.Equal("app-1", created.ID)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestReadWrite_UpdateRejectsUnmappedAttribute() {
+	suite.useReadWrite()
+
+	err := suite.provider.UpdateAttributes("uuid-alice", json.RawMessage(`{"username":"alice","nickname":"al"}`))
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeInvalidRequestFormat, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestReadWrite_UpdateRejectsRename() {
+	suite.useReadWrite()
+
+	_, err := suite.provider.UpdateEntity("uuid-alice", &providers.Entity{
+		Attributes: json.RawMessage(`{"username":"alicia"}`),
+	})
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeInvalidRequestFormat, err.Code)
+	suite.Equal([]string{"alice"}, suite.directory.entry(testLDAPAliceDN).values("uid"))
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestReadWrite_UpdateCredentialsRequiresPassword() {
+	suite.useReadWrite()
+
+	err := suite.provider.UpdateCredentials("uuid-alice", json.RawMessage(`{"pin":"1234"}`))
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeInvalidRequestFormat, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestValidateEntityIDs_ChecksFallbackForRemainingIDs() {
+	suite.mockService.On("ValidateEntityIDs", mock.Anything, []string{"local-1", "missing"}).
+		Return([]string{"missing"}, nil).Once()
+
+	invalid, err := suite.provider.ValidateEntityIDs([]string{"uuid-alice", "local-1", "missing"})
+
+	suite.Nil(err)
+	suite.Equal([]string{"missing"}, invalid)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetEntitiesByIDs_Mixed() {
+	suite.mockService.On("GetEntitiesByIDs", mock.Anything, []string{"local-1"}).
+		Return([]providers.Entity{{ID: "local-1"}}, nil).Once()
+
+	entities, err := suite.provider.GetEntitiesByIDs([]string{"uuid-bob", "local-1"})
+
+	suite.Nil(err)
+	suite.Require().Len(entities, 2)
+	suite.Equal("uuid-bob", entities[0].ID)
+	suite.Equal("local-1", entities[1].ID)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetEntityList_PagesAcrossDirectoryAndFallback() {
+	suite.mockService.On("GetEntityListCount", mock.Anything, providers.EntityCategoryUser,
+		map[string]interface{}(nil)).Return(3, nil).Once()
+	suite.mockService.On("GetEntityList", mock.Anything, providers.EntityCategoryUser, 1, 0,
+		map[string]interface{}(nil)).Return([]providers.Entity{{ID: "local-1"}}, nil).Once()
+	suite.mockService.On("GetEntityList", mock.Anything, providers.EntityCategoryUser, 2, 1,
+		map[string]interface{}(nil)).Return([]providers.Entity{{ID: "local-2"}, {ID: "local-3"}}, nil).Once()
+
+	count, err := suite.provider.GetEntityListCount(providers.EntityCategoryUser, nil)
+	suite.Nil(err)
+	suite.Equal(5, count)
+
+	page, err := suite.provider.GetEntityList(providers.EntityCategoryUser, 2, 1, nil)
+	suite.Nil(err)
+	suite.Require().Len(page, 2)
+	suite.Equal("uuid-bob", page[0].ID)
+	suite.Equal("local-1", page[1].ID)
+
+	page, err = suite.provider.GetEntityList(providers.EntityCategoryUser, 2, 3, nil)
+	suite.Nil(err)
+	suite.Equal([]string{"local-2", "local-3"}, []string{page[0].ID, page[1].ID})
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetEntityList_OtherCategoriesUseFallback() {
+	suite.mockService.On("GetEntityList", mock.Anything, providers.EntityCategoryApp, 10, 0,
+		map[string]interface{}(nil)).Return([]providers.Entity{{ID: "app-1"}}, nil).Once()
+
+	page, err := suite.provider.GetEntityList(providers.EntityCategoryApp, 10, 0, nil)
+
+	suite.Nil(err)
+	suite.Equal([]providers.Entity{{ID: "app-1"}}, page)
+}
@@ -92,6 +92,13 @@ const (
 	// DataOTPNumericOnly reports whether the OTP minted by the OTP executor in generate mode contains
 	// digits only, surfaced so the client restricts input to the characters the user has to type.
 	DataOTPNumericOnly = "otpNumericOnly"
+	// DataTOTPKeyURI is the otpauth:// URI rendered as a QR code while enrolling an authenticator app.
+	DataTOTPKeyURI = "totpKeyUri"
+	// DataTOTPSecret is the base32 secret shown for manual entry while enrolling an authenticator app.
+	DataTOTPSecret = "totpSecret"
+	// DataTOTPRecoveryCodes is the JSON array of one-time recovery codes issued when TOTP enrollment
+	// completes. The codes are shown once and only their hashes are stored.
+	DataTOTPRecoveryCodes = "totpRecoveryCodes"
 )
 
 // Error assertion claims.
@@ -141,6 +148,9 @@ const (
 	RuntimeKeyUserEligibleForProvisioning = "userEligibleForProvisioning"
 	// RuntimeKeyUserAmbiguous indicates the user exists in multiple OUs and requires disambiguation
 	RuntimeKeyUserAmbiguous = "userAmbiguous"
+	// RuntimeKeyPasswordExpired indicates the password the user signed in with has outlived the maximum
+	// age of the password policy. Flows branch on it to force a password change.
+	RuntimeKeyPasswordExpired = "passwordExpired"
 	// RuntimeKeyRevocationPlan holds the trusted revocation plan an administrative flow's
 	// pre-processing node produces for the executors that follow. It travels on the engine context's
 	// cross-frame store, so it survives a CALL into another flow.
@@ -157,6 +167,9 @@ const (
 	// RuntimeKeyConsentedPermissions holds the space-separated permission scopes the user has consented to
 	// release to the client, as produced by the ConsentExecutor.
 	RuntimeKeyConsentedPermissions = "consented_permissions"
+	// RuntimeKeyRequestedAuthorizationDetails holds the JSON array of RFC 9396 authorization details requested
+	// by the OAuth client, already validated against the bound resource server.
+	RuntimeKeyRequestedAuthorizationDetails = "requested_authorization_details"
 	// RuntimeKeyRequiredEssentialAttributes holds the space-separated essential user attributes required for the flow.
 	RuntimeKeyRequiredEssentialAttributes = "required_essential_attributes"
 	// RuntimeKeyRequiredOptionalAttributes holds the space-separated optional user attributes required for the flow.
@@ -198,12 +211,22 @@ const (
 	RuntimeKeyOTPSessionToken = "otpSessionToken"
 	// RuntimeKeyOTPAttemptCount holds the number of OTP generation attempts for the current flow execution.
 	RuntimeKeyOTPAttemptCount = "attemptCount"
+	// RuntimeKeyTOTPPendingSecret holds the TOTP secret issued by TOTPExecutor in enroll mode until the
+	// user proves their authenticator app produces matching codes.
+	RuntimeKeyTOTPPendingSecret = "totpPendingSecret" // #nosec G101 -- runtime data key, not a secret
+	// RuntimeKeyTOTPPendingKeyURI holds the otpauth:// URI of the pending TOTP enrollment.
+	RuntimeKeyTOTPPendingKeyURI = "totpPendingKeyUri"
+	// RuntimeKeyTOTPAttemptCount holds the number of failed TOTP verification attempts for the current
+	// flow execution.
+	RuntimeKeyTOTPAttemptCount = "totpAttemptCount"
 	// RuntimeKeyMagicLinkUsedJti is the JWT ID claim value of a magic link token that has already been used.
 	RuntimeKeyMagicLinkUsedJti = "magicLinkUsedJti"
 	// RuntimeKeyOAuthState holds the generated OAuth state parameter for CSRF validation.
 	RuntimeKeyOAuthState = "oauthState"
 	// RuntimeKeyOIDCNonce holds the server-generated nonce for OIDC ID token replay protection.
 	RuntimeKeyOIDCNonce = "oidcNonce"
+	// RuntimeKeySAMLRequestID holds the ID of the SAML AuthnRequest the identity provider's response must answer.
+	RuntimeKeySAMLRequestID = "samlRequestId"
 	// RuntimeKeyOpenID4VPState holds the OpenID4VP request state across poll steps.
 	RuntimeKeyOpenID4VPState = "openid4vpVerificationState"
 	// RuntimeKeyRequestedAuthClasses holds the space-separated ACR values from acr_values.
@@ -226,9 +249,10 @@ const (
 	// RuntimeKeyAuthorizationRequestID holds the auth request identifier bound to the current flow
 	// execution (the OAuth authorize authId or the CIBA auth_req_id), if applicable.
 	RuntimeKeyAuthorizationRequestID = "authorizationRequestId"
-	// RuntimeKeyCallbackType holds the OAuth grant type of the initiating request, seeded by the OAuth
-	// initiator and surfaced onto the terminal flow response as DataCallbackType so the Gate/SDK routes
-	// to the correct callback handler. Absent for non-OAuth flows.
+	// RuntimeKeyCallbackType holds the callback type of the initiating request (the OAuth grant type, or
+	// saml2 for SAML SSO), seeded by the protocol initiator and surfaced onto the terminal flow response
+	// as DataCallbackType so the Gate/SDK routes to the correct callback handler. Absent for flows not
+	// initiated through a federation protocol.
 	RuntimeKeyCallbackType = "callbackType"
 	// RuntimeKeySSOSessionPresent is the prefix of the per-checkpoint flag recording whether the
 	// SSO-Check node found a live session that already has this checkpoint's snapshot ("true") or not.
@@ -259,10 +283,22 @@ const (
 	// from the SSO checkpoint snapshot so each flow execution mints a fresh tfid rather than reusing a
 	// prior one on SSO reuse.
 	RuntimeKeyTokenFamilyID = "tokenFamilyId"
+	// RuntimeKeySessionSID carries the OpenID Connect session identifier (sid) of the SSO session the
+	// Session node saved to or loaded from. It is stamped onto the auth assertion and from there onto
+	// the grant's ID tokens, so back-channel logout tokens can name the session the relying party saw.
+	RuntimeKeySessionSID = "sessionSid"
+	// RuntimeKeySessionBrowserState carries the OIDC Session Management browser state of the SSO
+	// session the Session node saved to or loaded from. It rides the auth assertion to the
+	// authorization endpoint, which derives the response's session_state from it.
+	RuntimeKeySessionBrowserState = "sessionBrowserState"
 	// RuntimeKeyLogoutPromptRequired is set by the OAuth RP-initiated logout layer when a logout was
 	// requested without a valid id_token_hint. A sign-out flow's session sign-out node reads it to
 	// decide whether the End-User must confirm the logout before the session is terminated.
 	RuntimeKeyLogoutPromptRequired = "logoutPromptRequired"
+	// RuntimeKeyLogoutHintSubject carries the local subject identifier of the End-User named by a valid
+	// id_token_hint on an RP-initiated logout. Pairwise identifiers are resolved to the local subject
+	// before the sign-out flow sees them.
+	RuntimeKeyLogoutHintSubject = "logoutHintSubject"
 )
 
 // SSOCheckpointKey scopes a per-checkpoint SSO control key (RuntimeKeySSOSessionPresent,
@@ -218,6 +218,15 @@ func (a *authAssertExecutor) generateAuthAssertion(
 		jwtClaims[oauth2const.ClaimTokenFamilyID] = tokenFamilyID
 	}
 
+	// Carry the SSO session's sid so the grant's ID tokens can name the session for back-channel logout.
+	if sid, exists := ctx.RuntimeData[common.RuntimeKeySessionSID]; exists && sid != "" {
+		jwtClaims[oauth2const.ClaimSessionID] = sid
+	}
+	// And its browser state, from which the authorization response's session_state is derived.
+	if browserState := ctx.RuntimeData[common.RuntimeKeySessionBrowserState]; browserState != "" {
+		jwtClaims[oauth2const.ClaimSessionBrowserState] = browserState
+	}
+
 	requiredAttributes := a.getRequiredUserAttributes(ctx)
 
 	metadata := core.BuildGetAttributesMetadata(ctx)
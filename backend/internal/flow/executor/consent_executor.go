@@ -137,6 +137,12 @@ func (e *consentExecutor) checkConsent(ctx *providers.NodeContext, execResp *pro
 
 	essentialAttributes, optionalAttributes := e.getRequiredAttributes(ctx)
 	authorizedPermissions := strings.Fields(ctx.RuntimeData["authorized_permissions"])
+	authorizationDetails, err := parseRequestedAuthorizationDetails(
+		ctx.RuntimeData[common.RuntimeKeyRequestedAuthorizationDetails])
+	if err != nil {
+		logger.Error(ctx.Context, "Failed to parse requested authorization details", log.Error(err))
+		return nil, errors.New("failed to resolve consent")
+	}
 	availableAttributes := e.buildAugmentedAvailableAttributes(availableAttrResp, entityRef)
 	appName := ctx.Application.Name
 	forceReprompt := ctx.RuntimeData[common.RuntimeKeyForceConsentReprompt] == "true"
@@ -144,7 +150,7 @@ func (e *consentExecutor) checkConsent(ctx *providers.NodeContext, execResp *pro
 	// Resolve consent to determine if any required consents are missing and need to be prompted
 	promptData, svcErr := e.consentEnforcer.ResolveConsent(
 		ctx.Context, ouID, appID, appName, entityRef.EntityID,
-		essentialAttributes, optionalAttributes, authorizedPermissions,
+		essentialAttributes, optionalAttributes, authorizedPermissions, authorizationDetails,
 		availableAttributes, forceReprompt, core.BuildProviderMetadata(ctx).RuntimeMetadata)
 	if svcErr != nil {
 		if svcErr.Type == tidcommon.ClientErrorType {
@@ -413,3 +419,24 @@ func buildAttributePurposeName(appID string) string {
 func buildPermissionsPurposeName(appID string) string {
 	return "permissions:" + appID
 }
+
+// parseRequestedAuthorizationDetails converts the requested authorization details JSON array into the
+// canonical JSON form of each detail, which names the detail as a consent element.
+func parseRequestedAuthorizationDetails(raw string) ([]string, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var details []map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &details); err != nil {
+		return nil, err
+	}
+	canonical := make([]string, 0, len(details))
+	for _, detail := range details {
+		data, err := json.Marshal(detail)
+		if err != nil {
+			return nil, err
+		}
+		canonical = append(canonical, string(data))
+	}
+	return canonical, nil
+}
@@ -13,6 +13,7 @@ const (
 	ExecutorNameOIDCAuth                     = "OIDCAuthExecutor"
 	ExecutorNameGitHubAuth                   = "GithubOAuthExecutor"
 	ExecutorNameGoogleAuth                   = "GoogleOIDCAuthExecutor"
+	ExecutorNameSAMLAuth                     = "SAMLAuthExecutor"
 	ExecutorNameOpenID4VPVerify              = "OpenID4VPVerifyExecutor"
 	ExecutorNameIdentifying                  = "IdentifyingExecutor"
 	ExecutorNameAuthAssert                   = "AuthAssertExecutor"
@@ -35,6 +36,7 @@ const (
 	ExecutorNameSession                      = "SessionExecutor"
 	ExecutorNameSessionSignOut               = "SessionSignOutExecutor"
 	ExecutorNameOTPExecutor                  = "OTPExecutor"
+	ExecutorNameTOTPExecutor                 = "TOTPExecutor"
 	ExecutorNamePreDelete                    = "PreDeleteExecutor"
 	ExecutorNameCriteriaRevocation           = "CriteriaRevocationExecutor"
 	ExecutorNameSessionRevocation            = "SessionRevocationExecutor"
@@ -49,6 +51,7 @@ const (
 	ExecutorModeIdentify   = "identify"
 	ExecutorModeResolve    = "resolve"
 	ExecutorModeCheckState = "check_state"
+	ExecutorModeEnroll     = "enroll"
 )
 
 // User attribute and input constants
@@ -68,6 +71,8 @@ const (
 	userInputOuDesc           = "ouDescription"
 	userInputInviteToken      = "inviteToken"
 	userInputOTP              = "otp"
+	userInputTOTP             = "totp"
+	userInputRecoveryCode     = "recoveryCode"
 	userInputMagicLinkToken   = "token"
 	userInputConsentDecisions = "consent_decisions"
 	userInputLoginHint        = "login_hint"
@@ -125,6 +125,14 @@ func (e *credentialSetter) Execute(ctx *providers.NodeContext) (*providers.Execu
 
 	// Update user credentials
 	svcErr := e.entityProvider.UpdateCredentials(userID, credentials)
+	if svcErr != nil && svcErr.Code == entityprovider.ErrorCodeCredentialPolicyViolation {
+		logger.Debug(ctx.Context, "Credential refused by the password policy",
+			log.MaskedString(log.LoggerKeyUserID, userID))
+		execResp.Status = providers.ExecUserInputRequired
+		execResp.Inputs = e.GetRequiredInputs(ctx)
+		execResp.Error = errPasswordPolicyViolationFor(svcErr.Description)
+		return execResp, nil
+	}
 	if svcErr != nil {
 		logger.Debug(ctx.Context, "Failed to update user credentials",
 			log.MaskedString(log.LoggerKeyUserID, userID))
@@ -172,6 +172,41 @@ func (suite *CredentialSetterTestSuite) TestExecute_ServiceError() {
 	assert.Equal(suite.T(), ErrCredentialSetFailed.Code, resp.Error.Code)
 }
 
+func (suite *CredentialSetterTestSuite) TestExecute_PolicyViolationRequestsInputAgain() {
+	userID := testUserID
+	ctx := &providers.NodeContext{
+		ExecutionID: "test-flow",
+		UserInputs: map[string]string{
+			userAttributePassword: "short",
+		},
+	}
+	inputs := []providers.Input{
+		{
+			Identifier: userAttributePassword,
+			Type:       providers.InputTypePassword,
+			Required:   true,
+		},
+	}
+
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx, mock.Anything, mock.Anything).Return(userID)
+	suite.mockBaseExecutor.On("GetRequiredInputs", ctx).Return(inputs)
+
+	suite.mockEntityProvider.On("UpdateCredentials", userID, mock.Anything).
+		Return(entityprovider.NewEntityProviderError(entityprovider.ErrorCodeCredentialPolicyViolation,
+			"Credential policy violation", "The password must be at least 8 characters long"))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), inputs, resp.Inputs)
+	assert.Equal(suite.T(), ErrPasswordPolicyViolation.Code, resp.Error.Code)
+	assert.Equal(suite.T(), "The password must be at least 8 characters long",
+		resp.Error.ErrorDescription.Params["reason"])
+}
+
 func (suite *CredentialSetterTestSuite) TestExecute_CustomAttribute() {
 	userID := testUserID
 	const customAttr = "pin"
@@ -13,7 +13,10 @@ import (
 
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/lockout"
+	"github.com/thunder-id/thunderid/internal/passwordpolicy"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
@@ -23,6 +26,8 @@ type credentialsAuthExecutor struct {
 	identifyingExecutorInterface
 	entityProvider entityprovider.EntityProviderInterface
 	authnProvider  providers.AuthnProviderManager
+	lockoutService lockout.LockoutServiceInterface
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface
 	logger         *log.Logger
 }
 
@@ -34,6 +39,8 @@ func newCredentialsAuthExecutor(
 	flowFactory core.FlowFactoryInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	authnProvider providers.AuthnProviderManager,
+	lockoutService lockout.LockoutServiceInterface,
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface,
 ) *credentialsAuthExecutor {
 	defaultInputs := []providers.Input{
 		{
@@ -61,6 +68,8 @@ func newCredentialsAuthExecutor(
 		identifyingExecutorInterface: identifyExec,
 		entityProvider:               entityProvider,
 		authnProvider:                authnProvider,
+		lockoutService:               lockoutService,
+		passwordPolicy:               passwordPolicy,
 		logger:                       logger,
 	}
 }
@@ -121,6 +130,16 @@ func (b *credentialsAuthExecutor) Execute(ctx *providers.NodeContext) (*provider
 		execResp.Error = &ErrInvalidCredentials
 		return execResp, nil
 	}
+	if ctx.FlowType != providers.FlowTypeRegistration {
+		if err := b.flagExpiredPassword(ctx, execResp); err != nil {
+			execResp.Status = providers.ExecFailure
+			execResp.Error = &ErrUserAuthFailed
+			return execResp, nil
+		}
+		if execResp.Status == providers.ExecFailure {
+			return execResp, nil
+		}
+	}
 
 	execResp.Status = providers.ExecComplete
 
@@ -187,6 +206,13 @@ func (b *credentialsAuthExecutor) authenticateUser(ctx *providers.NodeContext,
 		return nil
 	}
 
+	// Refuse the attempt up front when the account is locked or the client is blocked.
+	lockoutEntityID := b.resolveLockoutEntityID(ctx, userIdentifiers)
+	if refused, err := checkLockout(ctx, b.lockoutService, lockoutEntityID, execResp); err != nil || refused {
+		execResp.Inputs = b.GetRequiredInputs(ctx)
+		return err
+	}
+
 	// For authentication flows, call Authenticate directly.
 	metadata := core.BuildProviderMetadata(ctx)
 	authUser, authenticatedClaims, svcErr := b.authnProvider.AuthenticateUser(ctx.Context, userIdentifiers,
@@ -200,8 +226,10 @@ func (b *credentialsAuthExecutor) authenticateUser(ctx *providers.NodeContext,
 			switch svcErr.Code {
 			case authnprovidermgr.ErrorUserNotFound.Code:
 				execResp.Error = &ErrUserNotFound
+				return recordFailedAttempt(ctx, b.lockoutService, "", execResp)
 			case authnprovidermgr.ErrorAuthenticationFailed.Code:
 				execResp.Error = &ErrInvalidCredentials
+				return recordFailedAttempt(ctx, b.lockoutService, lockoutEntityID, execResp)
 			default:
 				execResp.Error = &ErrUserAuthFailed
 			}
@@ -219,5 +247,50 @@ func (b *credentialsAuthExecutor) authenticateUser(ctx *providers.NodeContext,
 		}
 	}
 
+	return recordSuccessfulAttempt(ctx, b.lockoutService, lockoutEntityID)
+}
+
+// resolveLockoutEntityID returns the ID of the user the lockout policy tracks the attempt against, or ""
+// when the user cannot be identified, in which case only the client is tracked.
+func (b *credentialsAuthExecutor) resolveLockoutEntityID(ctx *providers.NodeContext,
+	userIdentifiers map[string]interface{}) string {
+	if b.lockoutService == nil {
+		return ""
+	}
+	return b.lockoutService.ResolveEntityID(ctx.Context, userIdentifiers)
+}
+
+// flagExpiredPassword records in the runtime data that the password the user signed in with has
+// expired, so the flow can branch to a forced password change.
+func (b *credentialsAuthExecutor) flagExpiredPassword(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse) error {
+	if b.passwordPolicy == nil || !b.passwordPolicy.IsExpiryEnabled() {
+		return nil
+	}
+	logger := b.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	authUser, entityRef, svcErr := b.authnProvider.GetEntityReference(ctx.Context, execResp.AuthUser)
+	execResp.AuthUser = authUser
+	if svcErr != nil || entityRef == nil || entityRef.EntityID == "" {
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrFailedToIdentifyUser
+		return nil
+	}
+
+	expired, svcErr := b.passwordPolicy.IsPasswordExpired(ctx.Context, entityRef.EntityID)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			logger.Debug(ctx.Context, "Password expiry could not be checked",
+				log.String("errorCode", svcErr.Code))
+			return nil
+		}
+		logger.Error(ctx.Context, "Failed to check password expiry", log.String("errorCode", svcErr.Code))
+		return errors.New("failed to check password expiry")
+	}
+	if expired {
+		logger.Debug(ctx.Context, "Password has expired", log.MaskedString(log.LoggerKeyUserID, entityRef.EntityID))
+		execResp.RuntimeData[common.RuntimeKeyPasswordExpired] = dataValueTrue
+		execResp.RuntimeData[userAttributeUserID] = entityRef.EntityID
+	}
 	return nil
 }
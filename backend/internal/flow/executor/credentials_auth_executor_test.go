@@ -8,6 +8,7 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -15,9 +16,13 @@ import (
 
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/lockoutmock"
+	"github.com/thunder-id/thunderid/tests/mocks/passwordpolicymock"
 )
 
 type CredentialsAuthExecutorTestSuite struct {
@@ -52,7 +57,7 @@ func (suite *CredentialsAuthExecutorTestSuite) SetupTest() {
 		defaultInputs, []providers.Input{}, mock.Anything).Return(mockExec)
 
 	suite.executor = newCredentialsAuthExecutor(suite.mockFlowFactory, suite.mockEntityProvider,
-		suite.mockAuthnProvider)
+		suite.mockAuthnProvider, nil, nil)
 }
 
 // newCredentialsAuthAuthenticatedUser creates an AuthUser that returns true for IsAuthenticated().
@@ -688,3 +693,157 @@ func (suite *CredentialsAuthExecutorTestSuite) TestExecute_PreResolvedUser_WithP
 	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
 	assert.True(suite.T(), resp.AuthUser.IsAuthenticated())
 }
+
+func (suite *CredentialsAuthExecutorTestSuite) newLockoutContext(password string) *providers.NodeContext {
+	return &providers.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userAttributeUsername: "testuser",
+			userAttributePassword: password,
+		},
+		RuntimeData: make(map[string]string),
+	}
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_Lockout_RefusesLockedAccount() {
+	mockLockout := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	suite.executor.lockoutService = mockLockout
+	lockedUntil := time.Date(2026, 10, 1, 12, 15, 0, 0, time.UTC)
+	mockLockout.On("ResolveEntityID", mock.Anything, map[string]interface{}{userAttributeUsername: "testuser"}).
+		Return("user-123")
+	mockLockout.On("CheckAttempt", mock.Anything, "user-123").
+		Return(&lockout.Lockout{Reason: lockout.LockReasonTemporary, Until: lockedUntil}, nil)
+
+	resp, err := suite.executor.Execute(suite.newLockoutContext("password123"))
+
+	suite.Require().NoError(err)
+	suite.Equal(providers.ExecUserInputRequired, resp.Status)
+	suite.Equal(ErrAccountLocked.Code, resp.Error.Code)
+	suite.Equal("2026-10-01T12:15:00Z", resp.Error.ErrorDescription.Params["lockedUntil"])
+	suite.NotEmpty(resp.Inputs)
+	suite.mockAuthnProvider.AssertNotCalled(suite.T(), "AuthenticateUser", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_Lockout_PermanentLockFailsFlow() {
+	mockLockout := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	suite.executor.lockoutService = mockLockout
+	mockLockout.On("ResolveEntityID", mock.Anything, mock.Anything).Return("user-123")
+	mockLockout.On("CheckAttempt", mock.Anything, "user-123").
+		Return(&lockout.Lockout{Reason: lockout.LockReasonPermanent}, nil)
+
+	resp, err := suite.executor.Execute(suite.newLockoutContext("password123"))
+
+	suite.Require().NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(ErrAccountLockedPermanently.Code, resp.Error.Code)
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_Lockout_FailureLocksAccount() {
+	mockLockout := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	suite.executor.lockoutService = mockLockout
+	mockLockout.On("ResolveEntityID", mock.Anything, mock.Anything).Return("user-123")
+	mockLockout.On("CheckAttempt", mock.Anything, "user-123").Return(nil, nil)
+	mockLockout.On("RecordFailure", mock.Anything, "user-123").
+		Return(&lockout.Lockout{Reason: lockout.LockReasonTemporary, Until: time.Now().Add(time.Minute)}, nil)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(providers.AuthUser{},
+		(providers.AuthenticatedClaims)(nil), &authnprovidermgr.ErrorAuthenticationFailed)
+
+	resp, err := suite.executor.Execute(suite.newLockoutContext("wrongpassword"))
+
+	suite.Require().NoError(err)
+	suite.Equal(providers.ExecUserInputRequired, resp.Status)
+	suite.Equal(ErrAccountLocked.Code, resp.Error.Code)
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_Lockout_UnknownUserTracksClientOnly() {
+	mockLockout := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	suite.executor.lockoutService = mockLockout
+	mockLockout.On("ResolveEntityID", mock.Anything, mock.Anything).Return("")
+	mockLockout.On("CheckAttempt", mock.Anything, "").Return(nil, nil)
+	mockLockout.On("RecordFailure", mock.Anything, "").Return(nil, nil)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(providers.AuthUser{},
+		(providers.AuthenticatedClaims)(nil), &authnprovidermgr.ErrorUserNotFound)
+
+	resp, err := suite.executor.Execute(suite.newLockoutContext("password123"))
+
+	suite.Require().NoError(err)
+	suite.Equal(ErrUserNotFound.Code, resp.Error.Code)
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_Lockout_SuccessClearsFailures() {
+	mockLockout := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	suite.executor.lockoutService = mockLockout
+	mockLockout.On("ResolveEntityID", mock.Anything,
+		map[string]interface{}{userAttributeUserID: "pre-resolved-user-123"}).Return("pre-resolved-user-123")
+	mockLockout.On("CheckAttempt", mock.Anything, "pre-resolved-user-123").Return(nil, nil)
+	mockLockout.On("RecordSuccess", mock.Anything, "pre-resolved-user-123").Return(nil)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(newCredentialsAuthAuthenticatedUser(), providers.AuthenticatedClaims{}, nil)
+
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs:  map[string]string{userAttributePassword: "password123"},
+		RuntimeData: map[string]string{userAttributeUserID: "pre-resolved-user-123"},
+	}
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.Require().NoError(err)
+	suite.Equal(providers.ExecComplete, resp.Status)
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_PasswordExpiry() {
+	cases := []struct {
+		name        string
+		expired     bool
+		svcErr      *tidcommon.ServiceError
+		wantStatus  providers.ExecutorStatus
+		wantExpired string
+	}{
+		{name: "Expired", expired: true, wantStatus: providers.ExecComplete, wantExpired: dataValueTrue},
+		{name: "NotExpired", wantStatus: providers.ExecComplete},
+		{name: "ServerError", svcErr: &tidcommon.InternalServerError, wantStatus: providers.ExecFailure},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			mockPolicy := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(suite.T())
+			suite.executor.passwordPolicy = mockPolicy
+			authUser := newCredentialsAuthAuthenticatedUser()
+			suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything).
+				Return(authUser, providers.AuthenticatedClaims{}, nil)
+			suite.mockAuthnProvider.On("GetEntityReference", mock.Anything, authUser).
+				Return(authUser, &providers.EntityReference{EntityID: "user-123"}, nil)
+			mockPolicy.On("IsExpiryEnabled").Return(true)
+			mockPolicy.On("IsPasswordExpired", mock.Anything, "user-123").Return(tc.expired, tc.svcErr)
+
+			resp, err := suite.executor.Execute(suite.newLockoutContext("password123"))
+
+			suite.Require().NoError(err)
+			suite.Equal(tc.wantStatus, resp.Status)
+			suite.Equal(tc.wantExpired, resp.RuntimeData[common.RuntimeKeyPasswordExpired])
+		})
+	}
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_PasswordExpiryDisabledSkipsCheck() {
+	mockPolicy := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(suite.T())
+	suite.executor.passwordPolicy = mockPolicy
+	mockPolicy.On("IsExpiryEnabled").Return(false)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(newCredentialsAuthAuthenticatedUser(), providers.AuthenticatedClaims{}, nil)
+
+	resp, err := suite.executor.Execute(suite.newLockoutContext("password123"))
+
+	suite.Require().NoError(err)
+	suite.Equal(providers.ExecComplete, resp.Status)
+	suite.NotContains(resp.RuntimeData, common.RuntimeKeyPasswordExpired)
+	mockPolicy.AssertNotCalled(suite.T(), "IsPasswordExpired", mock.Anything, mock.Anything)
+}
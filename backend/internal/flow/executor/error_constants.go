@@ -5,7 +5,9 @@ package executor
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/thunder-id/thunderid/internal/lockout"
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
 
@@ -1208,6 +1210,116 @@ var (
 			DefaultValue: "The user could not be deleted",
 		},
 	}
+
+	// ErrInvalidTOTP is returned when the authenticator app code or recovery code is incorrect.
+	ErrInvalidTOTP = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1086",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.invalid_totp",
+			DefaultValue: "Invalid authenticator code",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.invalid_totp_desc",
+			DefaultValue: "The authenticator app code or recovery code provided is invalid",
+		},
+	}
+
+	// ErrTOTPNotEnrolled is returned when verifying a user who has not enrolled an authenticator app.
+	ErrTOTPNotEnrolled = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1087",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.totp_not_enrolled",
+			DefaultValue: "Authenticator app not enrolled",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.totp_not_enrolled_desc",
+			DefaultValue: "The user has not enrolled an authenticator app",
+		},
+	}
+
+	// ErrUserIDRequiredForTOTPEnrollment is returned when TOTP enrollment cannot resolve the user.
+	ErrUserIDRequiredForTOTPEnrollment = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1088",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.totp_user_required",
+			DefaultValue: "User required for authenticator app enrollment",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.totp_user_required_desc",
+			DefaultValue: "An authenticated user is required to enroll an authenticator app",
+		},
+	}
+
+	// ErrMaxTOTPAttemptsReached is returned when the TOTP verification attempt limit is reached.
+	ErrMaxTOTPAttemptsReached = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1089",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.max_totp_attempts_reached",
+			DefaultValue: "Maximum authenticator code attempts reached",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.max_totp_attempts_reached_desc",
+			DefaultValue: "The maximum number of authenticator code verification attempts has been reached",
+		},
+	}
+	// ErrAccountLocked is returned when the account is temporarily locked after repeated failed attempts.
+	ErrAccountLocked = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1090",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.account_locked",
+			DefaultValue: "Account locked",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.account_locked_desc",
+			DefaultValue: "The account is locked until {{param(lockedUntil)}} due to repeated failed sign-in attempts",
+		},
+	}
+	// ErrAccountLockedPermanently is returned when the account is locked until an administrator unlocks it.
+	ErrAccountLockedPermanently = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1091",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.account_locked_permanently",
+			DefaultValue: "Account locked",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "flows.executor.errors.account_locked_permanently_desc",
+			DefaultValue: "The account is locked due to repeated failed sign-in attempts." +
+				" Contact an administrator to unlock it",
+		},
+	}
+	// ErrTooManyFailedAttempts is returned when an attempt is made before the delay imposed after
+	// previous failed attempts has elapsed.
+	ErrTooManyFailedAttempts = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1092",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.too_many_failed_attempts",
+			DefaultValue: "Too many failed attempts",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.too_many_failed_attempts_desc",
+			DefaultValue: "Too many failed sign-in attempts. Try again after {{param(retryAfter)}}",
+		},
+	}
+	// ErrPasswordPolicyViolation is returned when the new password is refused by the password policy.
+	ErrPasswordPolicyViolation = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1093",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.password_policy_violation",
+			DefaultValue: "Password does not meet the password policy",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.password_policy_violation_desc",
+			DefaultValue: "{{param(reason)}}",
+		},
+	}
 )
 
 // errAttributeNotUniqueFor returns a ServiceError for a specific attribute that is not unique.
@@ -1226,3 +1338,23 @@ func errMaxOTPAttemptsReachedFor(count int) *tidcommon.ServiceError {
 		"The maximum number of OTP verification attempts (%d) has been reached", count)
 	return &e
 }
+
+// errLockoutFor returns the ServiceError reporting an attempt refused by the lockout policy.
+func errLockoutFor(l *lockout.Lockout) *tidcommon.ServiceError {
+	switch l.Reason {
+	case lockout.LockReasonPermanent:
+		e := ErrAccountLockedPermanently
+		return &e
+	case lockout.LockReasonTemporary:
+		return ErrAccountLocked.WithParams(map[string]string{"lockedUntil": l.Until.UTC().Format(time.RFC3339)})
+	default:
+		return ErrTooManyFailedAttempts.WithParams(
+			map[string]string{"retryAfter": l.Until.UTC().Format(time.RFC3339)})
+	}
+}
+
+// errPasswordPolicyViolationFor returns the ServiceError reporting a password refused by the password
+// policy for the given reason.
+func errPasswordPolicyViolationFor(reason string) *tidcommon.ServiceError {
+	return ErrPasswordPolicyViolation.WithParams(map[string]string{"reason": reason})
+}
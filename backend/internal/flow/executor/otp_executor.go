@@ -13,6 +13,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	notifcommon "github.com/thunder-id/thunderid/internal/notification/common"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
@@ -28,6 +29,7 @@ type otpExecutor struct {
 	entityProvider entityprovider.EntityProviderInterface
 	otpService     otp.OTPAuthnServiceInterface
 	authnProvider  providers.AuthnProviderManager
+	lockoutService lockout.LockoutServiceInterface
 	logger         *log.Logger
 }
 
@@ -37,6 +39,7 @@ func newOTPExecutor(
 	otpService otp.OTPAuthnServiceInterface,
 	authnProvider providers.AuthnProviderManager,
 	entityProvider entityprovider.EntityProviderInterface,
+	lockoutService lockout.LockoutServiceInterface,
 ) *otpExecutor {
 	defaultInputs := []providers.Input{
 		{
@@ -78,6 +81,7 @@ func newOTPExecutor(
 		entityProvider: entityProvider,
 		otpService:     otpService,
 		authnProvider:  authnProvider,
+		lockoutService: lockoutService,
 		logger:         logger,
 	}
 }
@@ -287,6 +291,14 @@ func (e *otpExecutor) getAuthenticatedUser(ctx *providers.NodeContext,
 		return fmt.Errorf("no OTP session token found in runtime data")
 	}
 
+	// The user is known here only when it was identified before the OTP was sent; otherwise, as in
+	// registration, only the client is tracked.
+	userID := ctx.RuntimeData[userAttributeUserID]
+	if refused, err := checkLockout(ctx, e.lockoutService, userID, execResp); err != nil || refused {
+		execResp.Inputs = e.GetRequiredInputs(ctx)
+		return err
+	}
+
 	credentials := map[string]interface{}{
 		authnprovidercm.CredentialTypeOTP: map[string]interface{}{
 			"sessionToken": sessionToken,
@@ -302,10 +314,13 @@ func (e *otpExecutor) getAuthenticatedUser(ctx *providers.NodeContext,
 			execResp.Status = providers.ExecUserInputRequired
 			execResp.Inputs = e.GetRequiredInputs(ctx)
 			execResp.Error = &ErrInvalidOTP
-			return nil
+			return recordFailedAttempt(ctx, e.lockoutService, userID, execResp)
 		}
 		return fmt.Errorf("failed to verify OTP: %s", svcErr.ErrorDescription.DefaultValue)
 	}
+	if err := recordSuccessfulAttempt(ctx, e.lockoutService, userID); err != nil {
+		return err
+	}
 
 	execResp.AuthUser = authUser
 	execResp.RuntimeData[common.RuntimeKeyOTPSessionToken] = ""
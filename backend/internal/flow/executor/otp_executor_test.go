@@ -5,6 +5,7 @@ package executor
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -14,12 +15,14 @@ import (
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/otpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/lockoutmock"
 )
 
 const testOTPUserID = "user-abc-123"
@@ -72,7 +75,7 @@ func (suite *OTPExecutorTestSuite) SetupTest() {
 		defaultInputs, prerequisites, mock.Anything).Return(suite.mockBaseExec)
 
 	suite.executor = newOTPExecutor(suite.mockFlowFactory, suite.mockOTPService,
-		suite.mockAuthnProvider, suite.mockEntityProvider)
+		suite.mockAuthnProvider, suite.mockEntityProvider, nil)
 	suite.executor.Executor = suite.mockBaseExec
 }
 
@@ -524,6 +527,57 @@ func (suite *OTPExecutorTestSuite) TestExecuteVerify_InvalidOTP_ReturnsUserInput
 	assert.Equal(suite.T(), ErrInvalidOTP.Code, resp.Error.Code)
 }
 
+func (suite *OTPExecutorTestSuite) TestExecuteVerify_Lockout_FailureLocksAccount() {
+	mockLockout := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	suite.executor.lockoutService = mockLockout
+	mockLockout.On("CheckAttempt", mock.Anything, testOTPUserID).Return(nil, nil)
+	mockLockout.On("RecordFailure", mock.Anything, testOTPUserID).Return(
+		&lockout.Lockout{Reason: lockout.LockReasonTemporary, Until: time.Now().Add(time.Minute)}, nil)
+	suite.mockAuthnProvider.On("AuthenticateUser",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims(nil), &authnprovidermgr.ErrorAuthenticationFailed)
+
+	ctx := &providers.NodeContext{
+		ExecutionID:  "exec-8",
+		FlowType:     providers.FlowTypeAuthentication,
+		ExecutorMode: ExecutorModeVerify,
+		UserInputs:   map[string]string{userInputOTP: "000000"},
+		RuntimeData: map[string]string{
+			common.RuntimeKeyOTPSessionToken: "session-tok-1",
+			userAttributeUserID:              testOTPUserID,
+		},
+	}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), ErrAccountLocked.Code, resp.Error.Code)
+}
+
+func (suite *OTPExecutorTestSuite) TestExecuteVerify_Lockout_RefusesBlockedClient() {
+	mockLockout := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	suite.executor.lockoutService = mockLockout
+	mockLockout.On("CheckAttempt", mock.Anything, "").Return(
+		&lockout.Lockout{Reason: lockout.LockReasonIPBlocked, Until: time.Now().Add(time.Hour)}, nil)
+
+	ctx := &providers.NodeContext{
+		ExecutionID:  "exec-8",
+		FlowType:     providers.FlowTypeRegistration,
+		ExecutorMode: ExecutorModeVerify,
+		UserInputs:   map[string]string{userInputOTP: "000000"},
+		RuntimeData:  map[string]string{common.RuntimeKeyOTPSessionToken: "session-tok-1"},
+	}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), ErrTooManyFailedAttempts.Code, resp.Error.Code)
+	suite.mockAuthnProvider.AssertNotCalled(suite.T(), "AuthenticateUser",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *OTPExecutorTestSuite) TestExecuteVerify_MissingSessionToken_ReturnsError() {
 	ctx := &providers.NodeContext{
 		ExecutionID:  "exec-9",
@@ -19,14 +19,18 @@ import (
 	"github.com/thunder-id/thunderid/internal/authn/oidc"
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
+	"github.com/thunder-id/thunderid/internal/authn/saml"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/session"
 	"github.com/thunder-id/thunderid/internal/group"
 	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	"github.com/thunder-id/thunderid/internal/notification"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/passwordpolicy"
 	"github.com/thunder-id/thunderid/internal/revocation"
 	"github.com/thunder-id/thunderid/internal/role"
 	"github.com/thunder-id/thunderid/internal/system/email"
@@ -124,6 +128,7 @@ type ExecutorDependencies struct {
 	ConsentEnforcer       providers.ConsentProvider
 	AuthnProvider         providers.AuthnProviderManager
 	OTPService            otp.OTPAuthnServiceInterface
+	TOTPService           totp.TOTPAuthnServiceInterface
 	MagicLinkService      magiclink.MagicLinkAuthnServiceInterface
 	AuthZService          providers.AuthorizationProvider
 	EntityTypeService     entitytype.EntityTypeServiceInterface
@@ -138,11 +143,14 @@ type ExecutorDependencies struct {
 	OIDCSvc               oidc.OIDCAuthnServiceInterface
 	GithubSvc             github.GithubOAuthAuthnServiceInterface
 	GoogleSvc             google.GoogleOIDCAuthnServiceInterface
+	SAMLSvc               saml.SAMLAuthnServiceInterface
 	OpenID4VPVerifierSvc  openid4vp.OpenID4VPServiceInterface
 	SessionService        session.Service
 	ResourceService       providers.ResourceServerProvider
 	UserService           user.UserServiceInterface
 	CriteriaRevoker       revocation.CriteriaRevoker
+	LockoutService        lockout.LockoutServiceInterface
+	PasswordPolicyService passwordpolicy.PasswordPolicyServiceInterface
 }
 
 type builtInExecutorRegistrar func(ExecutorRegistryInterface, ExecutorDependencies)
@@ -152,7 +160,8 @@ func newBuiltInExecutorRegistrars() map[string]builtInExecutorRegistrar {
 	return map[string]builtInExecutorRegistrar{
 		ExecutorNameCredentialsAuth: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNameCredentialsAuth, newCredentialsAuthExecutor(
-				deps.FlowFactory, deps.EntityProvider, deps.AuthnProvider))
+				deps.FlowFactory, deps.EntityProvider, deps.AuthnProvider, deps.LockoutService,
+				deps.PasswordPolicyService))
 		},
 		ExecutorNamePasskeyAuth: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNamePasskeyAuth, newPasskeyAuthExecutor(
@@ -180,6 +189,10 @@ func newBuiltInExecutorRegistrars() map[string]builtInExecutorRegistrar {
 			reg.RegisterExecutor(ExecutorNameGoogleAuth, newGoogleOIDCAuthExecutor(
 				deps.FlowFactory, deps.IDPService, deps.GoogleSvc, deps.AuthnProvider))
 		},
+		ExecutorNameSAMLAuth: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
+			reg.RegisterExecutor(ExecutorNameSAMLAuth, newSAMLAuthExecutor(
+				deps.FlowFactory, deps.IDPService, deps.SAMLSvc, deps.AuthnProvider))
+		},
 		ExecutorNameProvisioning: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNameProvisioning, newProvisioningExecutor(
 				deps.FlowFactory, deps.GroupService, deps.RoleService, deps.RoleAssignmentService,
@@ -268,7 +281,12 @@ func newBuiltInExecutorRegistrars() map[string]builtInExecutorRegistrar {
 		},
 		ExecutorNameOTPExecutor: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNameOTPExecutor, newOTPExecutor(
-				deps.FlowFactory, deps.OTPService, deps.AuthnProvider, deps.EntityProvider))
+				deps.FlowFactory, deps.OTPService, deps.AuthnProvider, deps.EntityProvider,
+				deps.LockoutService))
+		},
+		ExecutorNameTOTPExecutor: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
+			reg.RegisterExecutor(ExecutorNameTOTPExecutor, newTOTPExecutor(
+				deps.FlowFactory, deps.TOTPService, deps.AuthnProvider, deps.EntityProvider))
 		},
 		ExecutorNamePreDelete: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNamePreDelete,
@@ -0,0 +1,283 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	authnsaml "github.com/thunder-id/thunderid/internal/authn/saml"
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	samlAuthLoggerComponentName = "SAMLAuthExecutor"
+)
+
+// samlAuthExecutor implements the authentication executor for SAML 2.0 identity providers. The
+// identity provider's response reaches the flow the way an OAuth authorization response does: the
+// assertion consumer service hands it to the login page as a code and state, which are submitted as
+// the executor's inputs.
+type samlAuthExecutor struct {
+	providers.Executor
+	samlService   authnsaml.SAMLAuthnServiceInterface
+	authnProvider providers.AuthnProviderManager
+	idpService    idp.IDPServiceInterface
+	logger        *log.Logger
+}
+
+var _ providers.Executor = (*samlAuthExecutor)(nil)
+
+// newSAMLAuthExecutor creates a new instance of SAMLAuthExecutor.
+func newSAMLAuthExecutor(
+	flowFactory core.FlowFactoryInterface,
+	idpService idp.IDPServiceInterface,
+	samlService authnsaml.SAMLAuthnServiceInterface,
+	authnProvider providers.AuthnProviderManager,
+) *samlAuthExecutor {
+	defaultInputs := []providers.Input{
+		{
+			Identifier: userInputCode,
+			Type:       "string",
+			Required:   true,
+		},
+	}
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, samlAuthLoggerComponentName),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameSAMLAuth))
+
+	base := flowFactory.CreateExecutor(ExecutorNameSAMLAuth, providers.ExecutorTypeAuthentication,
+		defaultInputs, []providers.Input{}, &providers.ExecutorMeta{
+			SupportedProperties: []providers.ExecutorSupportedProperties{
+				{Property: "idpId", IsRequired: true},
+				{Property: common.NodePropertyAllowAuthenticationWithoutLocalUser},
+				{Property: common.NodePropertyAllowRegistrationWithExistingUser},
+			},
+		})
+
+	return &samlAuthExecutor{
+		Executor:      base,
+		samlService:   samlService,
+		authnProvider: authnProvider,
+		idpService:    idpService,
+		logger:        logger,
+	}
+}
+
+// Execute executes the SAML authentication flow.
+func (s *samlAuthExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug(ctx.Context, "Executing SAML authentication executor")
+
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		AuthUser:       ctx.AuthUser,
+	}
+
+	if !s.HasRequiredInputs(ctx, execResp) {
+		logger.Debug(ctx.Context, "Required inputs for SAML authentication executor is not provided")
+		if err := s.buildAuthnRequestFlow(ctx, execResp); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := s.processAuthnResponse(ctx, execResp); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Debug(ctx.Context, "SAML authentication executor execution completed",
+		log.String("status", string(execResp.Status)),
+		log.Bool("isAuthenticated", execResp.AuthUser.IsAuthenticated()))
+
+	return execResp, nil
+}
+
+// buildAuthnRequestFlow redirects the user to the identity provider with an AuthnRequest, keeping the
+// state and the request ID for validating the response.
+func (s *samlAuthExecutor) buildAuthnRequestFlow(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse) error {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug(ctx.Context, "Initiating SAML authentication flow")
+
+	idpID, err := s.getIdpID(ctx)
+	if err != nil {
+		return err
+	}
+
+	authnRequestURL, metadata, svcErr := s.samlService.BuildAuthnRequestURL(ctx.Context, idpID)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			execResp.Status = providers.ExecFailure
+			execResp.Error = svcErr
+			return nil
+		}
+
+		logger.Error(ctx.Context, "Failed to build AuthnRequest URL", log.String("errorCode", svcErr.Code),
+			log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
+		return errors.New("failed to build AuthnRequest URL")
+	}
+
+	idpName, err := s.getIDPName(ctx.Context, idpID)
+	if err != nil {
+		return fmt.Errorf("failed to get idp name: %w", err)
+	}
+
+	execResp.Status = providers.ExecExternalRedirection
+	execResp.RedirectURL = authnRequestURL
+	execResp.AdditionalData = map[string]string{
+		common.DataIDPName: idpName,
+	}
+	execResp.RuntimeData[common.RuntimeKeyOAuthState] = metadata[authnsaml.MetadataKeyState]
+	execResp.RuntimeData[common.RuntimeKeySAMLRequestID] = metadata[authnsaml.MetadataKeyRequestID]
+	return nil
+}
+
+// processAuthnResponse authenticates the user with the response handed over by the assertion
+// consumer service.
+//
+//nolint:dupl // Shares the federated post-authentication handling of the OAuth executor.
+func (s *samlAuthExecutor) processAuthnResponse(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse) error {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug(ctx.Context, "Processing SAML authentication response")
+
+	code, ok := ctx.UserInputs[userInputCode]
+	if !ok || code == "" {
+		execResp.AuthUser = providers.AuthUser{}
+		return nil
+	}
+
+	// The state is the RelayState the identity provider returned. As with OAuth, it is validated only
+	// when the client sends it back; the response is bound to this flow by the request ID regardless.
+	if returnedState, ok := ctx.UserInputs[userInputState]; ok && returnedState != "" {
+		if returnedState != ctx.RuntimeData[common.RuntimeKeyOAuthState] {
+			logger.Debug(ctx.Context, "SAML RelayState mismatch")
+			execResp.Status = providers.ExecFailure
+			execResp.Error = &ErrInvalidOAuthState
+			return nil
+		}
+		delete(ctx.RuntimeData, common.RuntimeKeyOAuthState)
+	}
+	requestID := ctx.RuntimeData[common.RuntimeKeySAMLRequestID]
+	delete(ctx.RuntimeData, common.RuntimeKeySAMLRequestID)
+
+	idpID, err := s.getIdpID(ctx)
+	if err != nil {
+		return err
+	}
+
+	existingCtxUserAttributes := make(map[string]interface{})
+	if execResp.AuthUser.IsAuthenticated() {
+		metadata := core.BuildGetAttributesMetadata(ctx)
+		authUser, attributes, err := s.authnProvider.GetUserAttributes(ctx.Context, nil, metadata, execResp.AuthUser)
+		if err != nil {
+			logger.Warn(ctx.Context,
+				"Failed to fetch user attributes for authenticated user, proceeding without attributes")
+		} else {
+			execResp.AuthUser = authUser
+			for key, value := range attributes.Attributes {
+				existingCtxUserAttributes[key] = value
+			}
+		}
+	}
+
+	credentials := map[string]interface{}{
+		authnprovidercm.CredentialTypeFederated: &authncm.FederatedAuthCredential{
+			IDPID:   idpID,
+			IDPType: providers.IDPTypeSAML,
+			AuthorizationData: authncm.AuthorizationData{
+				Code:      code,
+				RequestID: requestID,
+			},
+		},
+	}
+	metadata := core.BuildProviderMetadata(ctx)
+	authUser, federatedAttributes, svcErr := s.authnProvider.AuthenticateUser(
+		ctx.Context, nil, credentials, nil, metadata, execResp.AuthUser)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			execResp.Status = providers.ExecFailure
+			execResp.Error = svcErr
+			return nil
+		}
+
+		logger.Error(ctx.Context, "Federated authentication failed", log.String("errorCode", svcErr.Code),
+			log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
+		return errors.New("federated authentication failed")
+	}
+	execResp.AuthUser = authUser
+
+	if !validateFederatedIdentifierConsistency(ctx, federatedAttributes, existingCtxUserAttributes) {
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrInvalidFederatedUser
+		return nil
+	}
+
+	for key, value := range federatedAttributes {
+		execResp.RuntimeData[key] = systemutils.ConvertInterfaceValueToString(value)
+	}
+
+	setFederatedEntityState(ctx.Context, execResp, s.authnProvider)
+
+	switch ctx.FlowType {
+	case providers.FlowTypeAuthentication:
+		if isAuthenticationWithoutLocalUserAllowed(ctx) {
+			execResp.RuntimeData[common.RuntimeKeyUserEligibleForProvisioning] = dataValueTrue
+		}
+	case providers.FlowTypeRegistration:
+		if isRegistrationWithExistingUserAllowed(ctx) {
+			execResp.RuntimeData[common.RuntimeKeyAllowRegistrationWithExistingUser] = dataValueTrue
+		}
+	}
+
+	execResp.Status = providers.ExecComplete
+	return nil
+}
+
+// HasRequiredInputs checks if the required inputs are provided in the context and appends any
+// missing inputs to the executor response. Returns true if required inputs are found, otherwise false.
+func (s *samlAuthExecutor) HasRequiredInputs(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse) bool {
+	if code, ok := ctx.UserInputs[userInputCode]; ok && code != "" {
+		return true
+	}
+
+	return s.Executor.HasRequiredInputs(ctx, execResp)
+}
+
+// getIdpID retrieves the identity provider ID from the node properties.
+func (s *samlAuthExecutor) getIdpID(ctx *providers.NodeContext) (string, error) {
+	if val, ok := ctx.NodeProperties["idpId"]; ok {
+		if idpID, valid := val.(string); valid && idpID != "" {
+			return idpID, nil
+		}
+	}
+	return "", errors.New("idpId is not configured in node properties")
+}
+
+// getIDPName retrieves the name of the identity provider using its ID.
+func (s *samlAuthExecutor) getIDPName(ctx context.Context, idpID string) (string, error) {
+	idpDTO, svcErr := s.idpService.GetIdentityProvider(ctx, idpID)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			return "", fmt.Errorf("failed to get identity provider: %s", svcErr.ErrorDescription.DefaultValue)
+		}
+
+		s.logger.Error(ctx, "Error while retrieving identity provider", log.String("errorCode", svcErr.Code),
+			log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
+		return "", errors.New("error while retrieving identity provider")
+	}
+
+	return idpDTO.Name, nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	authnsaml "github.com/thunder-id/thunderid/internal/authn/saml"
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/samlmock"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
+)
+
+type SAMLAuthExecutorTestSuite struct {
+	suite.Suite
+	mockSAMLService   *samlmock.SAMLAuthnServiceInterfaceMock
+	mockIDPService    *idpmock.IDPServiceInterfaceMock
+	mockFlowFactory   *coremock.FlowFactoryInterfaceMock
+	mockAuthnProvider *managermock.AuthnProviderManagerMock
+	executor          *samlAuthExecutor
+}
+
+func TestSAMLAuthExecutorSuite(t *testing.T) {
+	suite.Run(t, new(SAMLAuthExecutorTestSuite))
+}
+
+func (suite *SAMLAuthExecutorTestSuite) SetupTest() {
+	suite.mockSAMLService = samlmock.NewSAMLAuthnServiceInterfaceMock(suite.T())
+	suite.mockIDPService = idpmock.NewIDPServiceInterfaceMock(suite.T())
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerMock(suite.T())
+
+	mockExec := createMockAuthExecutor(suite.T(), ExecutorNameSAMLAuth)
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameSAMLAuth, providers.ExecutorTypeAuthentication,
+		defaultCodeOnlyInputs, []providers.Input{}, mock.Anything).Return(mockExec)
+
+	suite.executor = newSAMLAuthExecutor(suite.mockFlowFactory, suite.mockIDPService, suite.mockSAMLService,
+		suite.mockAuthnProvider)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) newResponseContext(userInputs map[string]string) *providers.NodeContext {
+	return &providers.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs:  userInputs,
+		RuntimeData: map[string]string{
+			common.RuntimeKeyOAuthState:    "relay-state",
+			common.RuntimeKeySAMLRequestID: "_request-1",
+		},
+		NodeProperties: map[string]interface{}{
+			"idpId": "idp-123",
+		},
+	}
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_CodeNotProvided_RedirectsToIDP() {
+	ctx := &providers.NodeContext{
+		ExecutionID:    "flow-123",
+		FlowType:       providers.FlowTypeAuthentication,
+		UserInputs:     map[string]string{},
+		NodeInputs:     defaultCodeOnlyInputs,
+		NodeProperties: map[string]interface{}{"idpId": "idp-123"},
+	}
+
+	ssoURL := "https://idp.example.com/sso?SAMLRequest=abc&RelayState=relay-state"
+	suite.mockSAMLService.EXPECT().BuildAuthnRequestURL(mock.Anything, "idp-123").Return(ssoURL,
+		map[string]string{
+			authnsaml.MetadataKeyState:     "relay-state",
+			authnsaml.MetadataKeyRequestID: "_request-1",
+		}, nil)
+	suite.mockIDPService.On("GetIdentityProvider", mock.Anything, "idp-123").
+		Return(&providers.IDPDTO{ID: "idp-123", Name: "Corporate ADFS"}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecExternalRedirection, resp.Status)
+	assert.Equal(suite.T(), ssoURL, resp.RedirectURL)
+	assert.Equal(suite.T(), "Corporate ADFS", resp.AdditionalData[common.DataIDPName])
+	assert.Equal(suite.T(), "relay-state", resp.RuntimeData[common.RuntimeKeyOAuthState])
+	assert.Equal(suite.T(), "_request-1", resp.RuntimeData[common.RuntimeKeySAMLRequestID])
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_BuildAuthnRequestClientError() {
+	ctx := &providers.NodeContext{
+		ExecutionID:    "flow-123",
+		UserInputs:     map[string]string{},
+		NodeInputs:     defaultCodeOnlyInputs,
+		NodeProperties: map[string]interface{}{"idpId": "idp-123"},
+	}
+	suite.mockSAMLService.EXPECT().BuildAuthnRequestURL(mock.Anything, "idp-123").
+		Return("", nil, &authnsaml.ErrorInvalidIDP)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), authnsaml.ErrorInvalidIDP.Code, resp.Error.Code)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_BuildAuthnRequestServerError() {
+	ctx := &providers.NodeContext{
+		ExecutionID:    "flow-123",
+		UserInputs:     map[string]string{},
+		NodeInputs:     defaultCodeOnlyInputs,
+		NodeProperties: map[string]interface{}{"idpId": "idp-123"},
+	}
+	suite.mockSAMLService.EXPECT().BuildAuthnRequestURL(mock.Anything, "idp-123").
+		Return("", nil, &tidcommon.InternalServerError)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), resp)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_CodeProvided_AuthenticatesUser() {
+	ctx := suite.newResponseContext(map[string]string{"code": "handle-1", "state": "relay-state"})
+
+	authenticatedAuthUser := newOAuthAuthenticatedUser()
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(credentials map[string]interface{}) bool {
+			cred, ok := credentials[authnprovidercm.CredentialTypeFederated].(*authncm.FederatedAuthCredential)
+			return ok && cred.IDPID == "idp-123" && cred.IDPType == providers.IDPTypeSAML &&
+				cred.AuthorizationData.Code == "handle-1" && cred.AuthorizationData.RequestID == "_request-1"
+		}), mock.Anything, mock.Anything, mock.Anything).
+		Return(authenticatedAuthUser, providers.AuthenticatedClaims{
+			"sub": "alice@example.com", "email": "alice@example.com",
+		}, (*tidcommon.ServiceError)(nil))
+	expectEntityReferenceResolved(suite.mockAuthnProvider, authenticatedAuthUser)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.True(suite.T(), resp.AuthUser.IsAuthenticated())
+	assert.Equal(suite.T(), "alice@example.com", resp.RuntimeData["email"])
+	assert.NotContains(suite.T(), ctx.RuntimeData, common.RuntimeKeyOAuthState)
+	assert.NotContains(suite.T(), ctx.RuntimeData, common.RuntimeKeySAMLRequestID)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_StateMismatch_Fails() {
+	ctx := suite.newResponseContext(map[string]string{"code": "handle-1", "state": "other-state"})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrInvalidOAuthState.Code, resp.Error.Code)
+	suite.mockAuthnProvider.AssertNotCalled(suite.T(), "AuthenticateUser", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_ProviderClientError() {
+	ctx := suite.newResponseContext(map[string]string{"code": "handle-1"})
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims(nil), &authnsaml.ErrorInvalidResponse)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), authnsaml.ErrorInvalidResponse.Code, resp.Error.Code)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_ProviderServerError() {
+	ctx := suite.newResponseContext(map[string]string{"code": "handle-1"})
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims(nil), &tidcommon.InternalServerError)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), resp)
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_AllowAuthWithoutLocalUser() {
+	ctx := suite.newResponseContext(map[string]string{"code": "handle-1"})
+	ctx.NodeProperties[common.NodePropertyAllowAuthenticationWithoutLocalUser] = true
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims{"sub": "new-user"},
+			(*tidcommon.ServiceError)(nil))
+	expectEntityReferenceNotFound(suite.mockAuthnProvider, providers.AuthUser{})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), dataValueTrue, resp.RuntimeData[common.RuntimeKeyUserEligibleForProvisioning])
+	assert.Equal(suite.T(), "new-user", resp.RuntimeData["sub"])
+}
+
+func (suite *SAMLAuthExecutorTestSuite) TestExecute_IdpIDNotConfigured() {
+	ctx := &providers.NodeContext{
+		ExecutionID:    "flow-123",
+		UserInputs:     map[string]string{},
+		NodeInputs:     defaultCodeOnlyInputs,
+		NodeProperties: map[string]interface{}{},
+	}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), resp)
+}
@@ -170,6 +170,8 @@ func (e *sessionExecutor) saveCheckpoint(ctx *providers.NodeContext, execResp *p
 	}
 
 	execResp.RuntimeData[savedKey] = result.Handle
+	execResp.RuntimeData[common.RuntimeKeySessionSID] = result.SID
+	execResp.RuntimeData[common.RuntimeKeySessionBrowserState] = session.BrowserState(result.Handle)
 	// Publish the session handle as the shared hint so later joins in this execution attach to the
 	// same session directly.
 	execResp.RuntimeData[common.RuntimeKeySSOSessionHandle] = result.Handle
@@ -268,6 +270,8 @@ func (e *sessionExecutor) loadCheckpoint(ctx *providers.NodeContext, execResp *p
 	if tokenFamilyID != "" {
 		execResp.RuntimeData[common.RuntimeKeyTokenFamilyID] = tokenFamilyID
 	}
+	execResp.RuntimeData[common.RuntimeKeySessionSID] = ssoSession.SID()
+	execResp.RuntimeData[common.RuntimeKeySessionBrowserState] = session.BrowserState(ssoSession.HandleID)
 
 	logger.Debug(ctx.Context, "Loaded SSO checkpoint",
 		log.String("flowId", session.SSOInputsFrom(ctx.Context).FlowID),
@@ -289,8 +293,17 @@ var requestScopedSnapshotDenyList = map[string]struct{}{
 	common.RuntimeKeyRequiredLocales:             {},
 	common.RuntimeKeyClientID:                    {},
 	common.RuntimeKeyAuthorizationRequestID:      {},
+	// The callback type routes the terminal assertion to the initiating protocol (OAuth grant or SAML
+	// SSO); replaying it onto an app joining through a different protocol would misroute its callback.
+	common.RuntimeKeyCallbackType: {},
 	// The token family id is minted fresh per flow execution, so it must not ride a reused snapshot.
 	common.RuntimeKeyTokenFamilyID: {},
+	// Authorization details are requested per authorization request, like the requested permissions.
+	common.RuntimeKeyRequestedAuthorizationDetails: {},
+	// The sid and browser state are published from the live session on every save and load, never
+	// from a snapshot.
+	common.RuntimeKeySessionSID:          {},
+	common.RuntimeKeySessionBrowserState: {},
 	// applicationId has no shared constant (set as a raw literal in enrichRuntimeData).
 	"applicationId": {},
 }
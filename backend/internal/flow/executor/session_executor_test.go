@@ -111,7 +111,7 @@ func captureSave(sso *sessionmock.ServiceMock, captured *session.SaveCheckpointI
 func (suite *SessionExecutorTestSuite) TestFreshSave() {
 	sso := sessionmock.NewServiceMock(suite.T())
 	var in session.SaveCheckpointInput
-	captureSave(sso, &in, session.SaveCheckpointResult{Handle: "handle-xyz", Created: true})
+	captureSave(sso, &in, session.SaveCheckpointResult{Handle: "handle-xyz", SID: "sid-xyz", Created: true})
 	exec := suite.newExecutor(sso, suite.saveAuthnMock())
 
 	resp, err := exec.Execute(freshCtx())
@@ -142,6 +142,9 @@ func (suite *SessionExecutorTestSuite) TestFreshSave() {
 	suite.Equal("handle-xyz",
 		resp.RuntimeData[common.SSOCheckpointKey(common.RuntimeKeySSOSessionSaved, "session")])
 	suite.Equal("handle-xyz", resp.RuntimeData[common.RuntimeKeySSOSessionHandle])
+	// The session's sid is published for the auth assertion.
+	suite.Equal("sid-xyz", resp.RuntimeData[common.RuntimeKeySessionSID])
+	suite.Equal(session.BrowserState("handle-xyz"), resp.RuntimeData[common.RuntimeKeySessionBrowserState])
 	// The already-authenticated subject is echoed back so the engine keeps it.
 	suite.True(resp.AuthUser.IsAuthenticated())
 }
@@ -273,6 +276,7 @@ func (suite *SessionExecutorTestSuite) TestFreshSave_SanitizesSnapshot() {
 	ctx.RuntimeData["applicationId"] = "app-a"
 	ctx.RuntimeData[common.RuntimeKeyClientID] = "sso_app_a"
 	ctx.RuntimeData[common.RuntimeKeyAuthorizationRequestID] = "authz-req-1"
+	ctx.RuntimeData[common.RuntimeKeyCallbackType] = "saml2"
 
 	_, err := exec.Execute(ctx)
 	suite.Require().NoError(err)
@@ -294,6 +298,7 @@ func (suite *SessionExecutorTestSuite) TestFreshSave_SanitizesSnapshot() {
 	suite.NotContains(rd, "applicationId")
 	suite.NotContains(rd, common.RuntimeKeyClientID)
 	suite.NotContains(rd, common.RuntimeKeyAuthorizationRequestID)
+	suite.NotContains(rd, common.RuntimeKeyCallbackType)
 }
 
 func ssoLoadCtx() *providers.NodeContext {
@@ -353,6 +358,9 @@ func (suite *SessionExecutorTestSuite) TestSSOLoad() {
 	suite.Equal("eng", resp.RuntimeData["department"])
 	// auth_time comes from the lean session and wins over the stale snapshot copy.
 	suite.Equal("1700000000", resp.RuntimeData[common.RuntimeKeyAuthTime])
+	// The sid is derived from the loaded session.
+	suite.Equal((&session.Session{SessionID: "sess-1"}).SID(), resp.RuntimeData[common.RuntimeKeySessionSID])
+	suite.Equal(session.BrowserState("handle-abc"), resp.RuntimeData[common.RuntimeKeySessionBrowserState])
 }
 
 // TestSSOLoad_PassesForwardedReadsToService is the executor half of the reuse-path read reduction: the
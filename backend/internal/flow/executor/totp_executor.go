@@ -0,0 +1,308 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/authn/totp"
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// defaultMaxTOTPAttempts is the number of failed verifications allowed when the node does not set
+// the maxAttempts property.
+const defaultMaxTOTPAttempts = 5
+
+// totpExecutor handles authenticator app (TOTP) enrollment and verification.
+// Enroll mode: issues a secret and otpauth:// URI, activates it once the user submits a matching code,
+// and returns one-time recovery codes.
+// Verify mode: authenticates the user with an authenticator app code or a recovery code.
+type totpExecutor struct {
+	providers.Executor
+	entityProvider entityprovider.EntityProviderInterface
+	totpService    totp.TOTPAuthnServiceInterface
+	authnProvider  providers.AuthnProviderManager
+	logger         *log.Logger
+}
+
+// newTOTPExecutor creates a new instance of totpExecutor.
+func newTOTPExecutor(
+	flowFactory core.FlowFactoryInterface,
+	totpService totp.TOTPAuthnServiceInterface,
+	authnProvider providers.AuthnProviderManager,
+	entityProvider entityprovider.EntityProviderInterface,
+) *totpExecutor {
+	defaultInputs := []providers.Input{
+		{
+			Ref:        "totp_input",
+			Identifier: userInputTOTP,
+			Type:       providers.InputTypeOTP,
+			Required:   true,
+		},
+		{
+			Ref:        "recovery_code_input",
+			Identifier: userInputRecoveryCode,
+			Type:       providers.InputTypeText,
+			Required:   false,
+		},
+	}
+	prerequisites := []providers.Input{
+		{
+			Identifier: userAttributeUserID,
+			Type:       "string",
+			Required:   true,
+		},
+	}
+
+	logger := log.GetLogger().With(
+		log.String(log.LoggerKeyComponentName, "TOTPExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameTOTPExecutor),
+	)
+
+	base := flowFactory.CreateExecutor(ExecutorNameTOTPExecutor, providers.ExecutorTypeAuthentication,
+		defaultInputs, prerequisites, &providers.ExecutorMeta{
+			SupportedModes: []string{
+				ExecutorModeEnroll,
+				ExecutorModeVerify,
+			},
+			SupportedProperties: []providers.ExecutorSupportedProperties{
+				{Property: propertyKeyMaxOTPAttempts, ApplicableModes: []string{ExecutorModeVerify}},
+			},
+		})
+
+	return &totpExecutor{
+		Executor:       base,
+		entityProvider: entityProvider,
+		totpService:    totpService,
+		authnProvider:  authnProvider,
+		logger:         logger,
+	}
+}
+
+// Execute dispatches to enroll or verify mode based on ctx.ExecutorMode.
+func (e *totpExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug(ctx.Context, "Executing TOTP executor")
+
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		AuthUser:       ctx.AuthUser,
+	}
+
+	switch ctx.ExecutorMode {
+	case ExecutorModeEnroll:
+		return e.executeEnroll(ctx, execResp)
+	case ExecutorModeVerify:
+		return e.executeVerify(ctx, execResp)
+	default:
+		return execResp, fmt.Errorf("invalid executor mode: %s", ctx.ExecutorMode)
+	}
+}
+
+// executeEnroll issues a pending secret on the first pass and activates it once the user submits a
+// code generated from it. The secret is only stored against the user after the code matches.
+func (e *totpExecutor) executeEnroll(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse) (*providers.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	userID := e.GetUserIDFromContext(ctx, execResp, e.authnProvider)
+	if userID == "" {
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrUserIDRequiredForTOTPEnrollment
+		return execResp, nil
+	}
+
+	secret := ctx.RuntimeData[common.RuntimeKeyTOTPPendingSecret]
+	keyURI := ctx.RuntimeData[common.RuntimeKeyTOTPPendingKeyURI]
+	if secret == "" {
+		enrollment, svcErr := e.totpService.StartEnrollment(ctx.Context, e.resolveAccountName(ctx, userID))
+		if svcErr != nil {
+			return execResp, fmt.Errorf("failed to start TOTP enrollment: %s",
+				svcErr.ErrorDescription.DefaultValue)
+		}
+		execResp.RuntimeData[common.RuntimeKeyTOTPPendingSecret] = enrollment.Secret
+		execResp.RuntimeData[common.RuntimeKeyTOTPPendingKeyURI] = enrollment.KeyURI
+		e.requestEnrollmentCode(ctx, execResp, enrollment.Secret, enrollment.KeyURI, nil)
+		logger.Debug(ctx.Context, "TOTP enrollment started", log.MaskedString(log.LoggerKeyUserID, userID))
+		return execResp, nil
+	}
+
+	code := ctx.UserInputs[userInputTOTP]
+	if code == "" {
+		e.requestEnrollmentCode(ctx, execResp, secret, keyURI, nil)
+		return execResp, nil
+	}
+
+	recoveryCodes, svcErr := e.totpService.FinishEnrollment(ctx.Context, userID, secret, code)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			logger.Debug(ctx.Context, "TOTP enrollment code rejected",
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			e.requestEnrollmentCode(ctx, execResp, secret, keyURI, &ErrInvalidTOTP)
+			return execResp, nil
+		}
+		return execResp, fmt.Errorf("failed to finish TOTP enrollment: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+
+	codesJSON, err := json.Marshal(recoveryCodes)
+	if err != nil {
+		return execResp, fmt.Errorf("failed to marshal recovery codes: %w", err)
+	}
+	execResp.AdditionalData[common.DataTOTPRecoveryCodes] = string(codesJSON)
+	execResp.RuntimeData[common.RuntimeKeyTOTPPendingSecret] = ""
+	execResp.RuntimeData[common.RuntimeKeyTOTPPendingKeyURI] = ""
+	execResp.Status = providers.ExecComplete
+
+	logger.Debug(ctx.Context, "TOTP enrollment completed", log.MaskedString(log.LoggerKeyUserID, userID))
+	return execResp, nil
+}
+
+// requestEnrollmentCode prompts for a code from the pending secret, re-sending the secret and key URI
+// so the client can keep displaying the QR code.
+func (e *totpExecutor) requestEnrollmentCode(ctx *providers.NodeContext, execResp *providers.ExecutorResponse,
+	secret, keyURI string, svcErr *tidcommon.ServiceError) {
+	execResp.AdditionalData[common.DataTOTPSecret] = secret
+	execResp.AdditionalData[common.DataTOTPKeyURI] = keyURI
+	execResp.Status = providers.ExecUserInputRequired
+	execResp.Inputs = e.getCodeInputs(ctx)
+	execResp.Error = svcErr
+}
+
+// executeVerify authenticates the user with the submitted authenticator app code or recovery code.
+func (e *totpExecutor) executeVerify(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse) (*providers.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	if !e.ValidatePrerequisites(ctx, execResp, e.authnProvider) {
+		return execResp, nil
+	}
+	userID := e.GetUserIDFromContext(ctx, execResp, e.authnProvider)
+
+	attemptCount, err := e.getAttemptCount(ctx)
+	if err != nil {
+		return execResp, err
+	}
+	if attemptCount >= e.getMaxAttempts(ctx) {
+		logger.Debug(ctx.Context, "Maximum TOTP verification attempts reached",
+			log.Int("attemptCount", attemptCount))
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrMaxTOTPAttemptsReached
+		return execResp, nil
+	}
+
+	enrolled, svcErr := e.totpService.IsEnrolled(ctx.Context, userID)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			execResp.Status = providers.ExecFailure
+			execResp.Error = &ErrUserNotFound
+			return execResp, nil
+		}
+		return execResp, fmt.Errorf("failed to check TOTP enrollment: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	if !enrolled {
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrTOTPNotEnrolled
+		return execResp, nil
+	}
+
+	totpCredential := map[string]interface{}{}
+	if code := ctx.UserInputs[userInputTOTP]; code != "" {
+		totpCredential["code"] = code
+	} else if recoveryCode := ctx.UserInputs[userInputRecoveryCode]; recoveryCode != "" {
+		totpCredential["recoveryCode"] = recoveryCode
+	} else {
+		execResp.Status = providers.ExecUserInputRequired
+		execResp.Inputs = e.getCodeInputs(ctx)
+		return execResp, nil
+	}
+
+	identifiers := map[string]interface{}{authnprovidercm.UserAttributeUserID: userID}
+	credentials := map[string]interface{}{authnprovidercm.CredentialTypeTOTP: totpCredential}
+	authUser, authenticatedClaims, svcErr := e.authnProvider.AuthenticateUser(
+		ctx.Context, identifiers, credentials, nil, nil, execResp.AuthUser)
+	if svcErr != nil {
+		if svcErr.Code == authnprovidermgr.ErrorAuthenticationFailed.Code ||
+			svcErr.Code == authnprovidermgr.ErrorInvalidRequest.Code {
+			logger.Debug(ctx.Context, "TOTP verification failed", log.Int("attemptCount", attemptCount+1))
+			execResp.RuntimeData[common.RuntimeKeyTOTPAttemptCount] = strconv.Itoa(attemptCount + 1)
+			execResp.Status = providers.ExecUserInputRequired
+			execResp.Inputs = e.getCodeInputs(ctx)
+			execResp.Error = &ErrInvalidTOTP
+			return execResp, nil
+		}
+		return execResp, fmt.Errorf("failed to verify TOTP: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+
+	execResp.AuthUser = authUser
+	execResp.RuntimeData[common.RuntimeKeyTOTPAttemptCount] = ""
+	for key, value := range authenticatedClaims {
+		execResp.RuntimeData[key] = systemutils.ConvertInterfaceValueToString(value)
+	}
+	execResp.Status = providers.ExecComplete
+	return execResp, nil
+}
+
+// resolveAccountName returns the label shown in the authenticator app, preferring the user's username
+// or email and falling back to the user ID.
+func (e *totpExecutor) resolveAccountName(ctx *providers.NodeContext, userID string) string {
+	user, providerErr := e.entityProvider.GetEntity(userID)
+	if providerErr != nil {
+		e.logger.Debug(ctx.Context, "Failed to read user for TOTP account name, using user ID",
+			log.String("error", providerErr.Error()))
+		return userID
+	}
+	for _, attr := range []string{userAttributeUsername, userAttributeEmail} {
+		if value, err := GetUserAttribute(user, attr); err == nil && value != "" {
+			return value
+		}
+	}
+	return userID
+}
+
+// getCodeInputs returns the inputs prompting for a code, preferring the node's declared inputs.
+func (e *totpExecutor) getCodeInputs(ctx *providers.NodeContext) []providers.Input {
+	if len(ctx.NodeInputs) > 0 {
+		return ctx.NodeInputs
+	}
+	return e.GetDefaultInputs()
+}
+
+// getAttemptCount returns the number of failed TOTP verifications in the current flow execution.
+func (e *totpExecutor) getAttemptCount(ctx *providers.NodeContext) (int, error) {
+	countStr := ctx.RuntimeData[common.RuntimeKeyTOTPAttemptCount]
+	if countStr == "" {
+		return 0, nil
+	}
+	count, err := strconv.Atoi(countStr)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse TOTP attempt count: %w", err)
+	}
+	return count, nil
+}
+
+// getMaxAttempts returns the maximum failed TOTP verifications from NodeProperties,
+// falling back to defaultMaxTOTPAttempts if not set or invalid.
+func (e *totpExecutor) getMaxAttempts(ctx *providers.NodeContext) int {
+	if n, ok := systemutils.ToInt64(ctx.NodeProperties[propertyKeyMaxOTPAttempts]); ok && n > 0 {
+		return int(n)
+	}
+	if v, ok := ctx.NodeProperties[propertyKeyMaxOTPAttempts].(string); ok {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return defaultMaxTOTPAttempts
+}
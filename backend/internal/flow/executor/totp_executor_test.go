@@ -0,0 +1,253 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package executor
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/authn/totp"
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/totpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+const (
+	testTOTPUserID = "user-totp-1"
+	testTOTPSecret = "JBSWY3DPEHPK3PXP"
+	testTOTPKeyURI = "otpauth://totp/ThunderID:alice?secret=JBSWY3DPEHPK3PXP"
+)
+
+type TOTPExecutorTestSuite struct {
+	suite.Suite
+	mockTOTPService    *totpmock.TOTPAuthnServiceInterfaceMock
+	mockAuthnProvider  *managermock.AuthnProviderManagerMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockBaseExec       *coremock.ExecutorInterfaceMock
+	executor           *totpExecutor
+}
+
+func TestTOTPExecutorSuite(t *testing.T) {
+	suite.Run(t, new(TOTPExecutorTestSuite))
+}
+
+func (suite *TOTPExecutorTestSuite) SetupTest() {
+	suite.mockTOTPService = totpmock.NewTOTPAuthnServiceInterfaceMock(suite.T())
+	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	mockFlowFactory := coremock.NewFlowFactoryInterfaceMock(suite.T())
+
+	defaultInputs := []providers.Input{
+		{Ref: "totp_input", Identifier: userInputTOTP, Type: providers.InputTypeOTP, Required: true},
+		{Ref: "recovery_code_input", Identifier: userInputRecoveryCode, Type: providers.InputTypeText},
+	}
+
+	suite.mockBaseExec = coremock.NewExecutorInterfaceMock(suite.T())
+	suite.mockBaseExec.On("GetDefaultInputs").Return(defaultInputs).Maybe()
+	suite.mockBaseExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(true).Maybe()
+	mockFlowFactory.On("CreateExecutor", ExecutorNameTOTPExecutor, providers.ExecutorTypeAuthentication,
+		defaultInputs, mock.Anything, mock.Anything).Return(suite.mockBaseExec)
+
+	suite.executor = newTOTPExecutor(mockFlowFactory, suite.mockTOTPService, suite.mockAuthnProvider,
+		suite.mockEntityProvider)
+	suite.executor.Executor = suite.mockBaseExec
+}
+
+func (suite *TOTPExecutorTestSuite) newContext(mode string, userInputs, runtimeData map[string]string,
+) *providers.NodeContext {
+	ctx := &providers.NodeContext{
+		ExecutionID:    "exec-totp",
+		ExecutorMode:   mode,
+		UserInputs:     userInputs,
+		RuntimeData:    runtimeData,
+		NodeProperties: map[string]interface{}{},
+	}
+	suite.mockBaseExec.On("GetUserIDFromContext", ctx, mock.Anything, mock.Anything).Return(testTOTPUserID).Maybe()
+	return ctx
+}
+
+// Enroll mode tests
+
+func (suite *TOTPExecutorTestSuite) TestEnroll_StartIssuesSecret() {
+	ctx := suite.newContext(ExecutorModeEnroll, map[string]string{}, map[string]string{})
+	suite.mockEntityProvider.On("GetEntity", testTOTPUserID).
+		Return(&providers.Entity{ID: testTOTPUserID, Attributes: json.RawMessage(`{"username":"alice"}`)}, nil)
+	suite.mockTOTPService.On("StartEnrollment", mock.Anything, "alice").
+		Return(&totp.EnrollmentData{Secret: testTOTPSecret, KeyURI: testTOTPKeyURI}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), testTOTPSecret, resp.RuntimeData[common.RuntimeKeyTOTPPendingSecret])
+	assert.Equal(suite.T(), testTOTPKeyURI, resp.AdditionalData[common.DataTOTPKeyURI])
+	assert.Equal(suite.T(), testTOTPSecret, resp.AdditionalData[common.DataTOTPSecret])
+	assert.Len(suite.T(), resp.Inputs, 2)
+}
+
+func (suite *TOTPExecutorTestSuite) TestEnroll_NoUser() {
+	ctx := &providers.NodeContext{ExecutionID: "exec-totp", ExecutorMode: ExecutorModeEnroll}
+	suite.mockBaseExec.On("GetUserIDFromContext", ctx, mock.Anything, mock.Anything).Return("")
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrUserIDRequiredForTOTPEnrollment.Code, resp.Error.Code)
+}
+
+func (suite *TOTPExecutorTestSuite) TestEnroll_FinishReturnsRecoveryCodes() {
+	ctx := suite.newContext(ExecutorModeEnroll, map[string]string{userInputTOTP: "123456"}, map[string]string{
+		common.RuntimeKeyTOTPPendingSecret: testTOTPSecret,
+		common.RuntimeKeyTOTPPendingKeyURI: testTOTPKeyURI,
+	})
+	suite.mockTOTPService.On("FinishEnrollment", mock.Anything, testTOTPUserID, testTOTPSecret, "123456").
+		Return([]string{"abcde-fghjk", "mnpqr-stuvw"}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.JSONEq(suite.T(), `["abcde-fghjk","mnpqr-stuvw"]`, resp.AdditionalData[common.DataTOTPRecoveryCodes])
+	assert.Empty(suite.T(), resp.RuntimeData[common.RuntimeKeyTOTPPendingSecret])
+	assert.Empty(suite.T(), resp.RuntimeData[common.RuntimeKeyTOTPPendingKeyURI])
+}
+
+func (suite *TOTPExecutorTestSuite) TestEnroll_IncorrectCodeReprompts() {
+	ctx := suite.newContext(ExecutorModeEnroll, map[string]string{userInputTOTP: "000000"}, map[string]string{
+		common.RuntimeKeyTOTPPendingSecret: testTOTPSecret,
+		common.RuntimeKeyTOTPPendingKeyURI: testTOTPKeyURI,
+	})
+	suite.mockTOTPService.On("FinishEnrollment", mock.Anything, testTOTPUserID, testTOTPSecret, "000000").
+		Return(nil, &totp.ErrorIncorrectCode)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), ErrInvalidTOTP.Code, resp.Error.Code)
+	assert.Equal(suite.T(), testTOTPKeyURI, resp.AdditionalData[common.DataTOTPKeyURI])
+}
+
+func (suite *TOTPExecutorTestSuite) TestEnroll_ServerError() {
+	ctx := suite.newContext(ExecutorModeEnroll, map[string]string{userInputTOTP: "123456"}, map[string]string{
+		common.RuntimeKeyTOTPPendingSecret: testTOTPSecret,
+	})
+	suite.mockTOTPService.On("FinishEnrollment", mock.Anything, testTOTPUserID, testTOTPSecret, "123456").
+		Return(nil, &tidcommon.InternalServerError)
+
+	_, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+}
+
+// Verify mode tests
+
+func (suite *TOTPExecutorTestSuite) TestVerify_Success() {
+	ctx := suite.newContext(ExecutorModeVerify, map[string]string{userInputTOTP: "123456"}, map[string]string{
+		common.RuntimeKeyTOTPAttemptCount: "2",
+	})
+	suite.mockTOTPService.On("IsEnrolled", mock.Anything, testTOTPUserID).Return(true, nil)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything,
+		map[string]interface{}{authnprovidercm.UserAttributeUserID: testTOTPUserID},
+		map[string]interface{}{authnprovidercm.CredentialTypeTOTP: map[string]interface{}{"code": "123456"}},
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims{userAttributeUserID: testTOTPUserID},
+			(*tidcommon.ServiceError)(nil))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), testTOTPUserID, resp.RuntimeData[userAttributeUserID])
+	assert.Empty(suite.T(), resp.RuntimeData[common.RuntimeKeyTOTPAttemptCount])
+}
+
+func (suite *TOTPExecutorTestSuite) TestVerify_RecoveryCode() {
+	ctx := suite.newContext(ExecutorModeVerify, map[string]string{userInputRecoveryCode: "abcde-fghjk"},
+		map[string]string{})
+	suite.mockTOTPService.On("IsEnrolled", mock.Anything, testTOTPUserID).Return(true, nil)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything,
+		map[string]interface{}{
+			authnprovidercm.CredentialTypeTOTP: map[string]interface{}{"recoveryCode": "abcde-fghjk"},
+		},
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims{}, (*tidcommon.ServiceError)(nil))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+}
+
+func (suite *TOTPExecutorTestSuite) TestVerify_NoCodePrompts() {
+	ctx := suite.newContext(ExecutorModeVerify, map[string]string{}, map[string]string{})
+	suite.mockTOTPService.On("IsEnrolled", mock.Anything, testTOTPUserID).Return(true, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Nil(suite.T(), resp.Error)
+	assert.Len(suite.T(), resp.Inputs, 2)
+}
+
+func (suite *TOTPExecutorTestSuite) TestVerify_IncorrectCodeCountsAttempt() {
+	ctx := suite.newContext(ExecutorModeVerify, map[string]string{userInputTOTP: "000000"}, map[string]string{
+		common.RuntimeKeyTOTPAttemptCount: "1",
+	})
+	suite.mockTOTPService.On("IsEnrolled", mock.Anything, testTOTPUserID).Return(true, nil)
+	suite.mockAuthnProvider.On("AuthenticateUser",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims(nil), &authnprovidermgr.ErrorAuthenticationFailed)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), ErrInvalidTOTP.Code, resp.Error.Code)
+	assert.Equal(suite.T(), "2", resp.RuntimeData[common.RuntimeKeyTOTPAttemptCount])
+}
+
+func (suite *TOTPExecutorTestSuite) TestVerify_MaxAttemptsReached() {
+	ctx := suite.newContext(ExecutorModeVerify, map[string]string{userInputTOTP: "123456"}, map[string]string{
+		common.RuntimeKeyTOTPAttemptCount: "3",
+	})
+	ctx.NodeProperties[propertyKeyMaxOTPAttempts] = float64(3)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrMaxTOTPAttemptsReached.Code, resp.Error.Code)
+}
+
+func (suite *TOTPExecutorTestSuite) TestVerify_NotEnrolled() {
+	ctx := suite.newContext(ExecutorModeVerify, map[string]string{userInputTOTP: "123456"}, map[string]string{})
+	suite.mockTOTPService.On("IsEnrolled", mock.Anything, testTOTPUserID).Return(false, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrTOTPNotEnrolled.Code, resp.Error.Code)
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecute_InvalidMode() {
+	ctx := &providers.NodeContext{ExecutionID: "exec-totp", ExecutorMode: "unknown"}
+
+	_, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+}
@@ -14,6 +14,7 @@ import (
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	entitytypemodel "github.com/thunder-id/thunderid/internal/entitytype/model"
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	"github.com/thunder-id/thunderid/internal/revocation"
 	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -61,10 +62,12 @@ func getAuthnServiceName(executorName string) string {
 	executorToAuthnServiceMap := map[string]string{
 		ExecutorNameCredentialsAuth: authncm.AuthenticatorCredentials,
 		ExecutorNameOTPExecutor:     authncm.AuthenticatorOTP,
+		ExecutorNameTOTPExecutor:    authncm.AuthenticatorTOTP,
 		ExecutorNameOAuth:           authncm.AuthenticatorOAuth,
 		ExecutorNameOIDCAuth:        authncm.AuthenticatorOIDC,
 		ExecutorNameGitHubAuth:      authncm.AuthenticatorGithub,
 		ExecutorNameGoogleAuth:      authncm.AuthenticatorGoogle,
+		ExecutorNameSAMLAuth:        authncm.AuthenticatorSAML,
 		ExecutorNameMagicLink:       authncm.AuthenticatorMagicLink,
 	}
 	return executorToAuthnServiceMap[executorName]
@@ -251,3 +254,63 @@ func validateFederatedIdentifierConsistency(ctx *providers.NodeContext,
 
 	return true
 }
+
+// checkLockout consults the lockout policy before a credential is verified. When the attempt is
+// refused, it sets the refusal on execResp and returns true; a permanently locked account fails the
+// flow, while other refusals let the user retry once the lock or delay has elapsed. A nil lockout
+// service accepts every attempt.
+func checkLockout(ctx *providers.NodeContext, lockoutService lockout.LockoutServiceInterface,
+	entityID string, execResp *providers.ExecutorResponse) (bool, error) {
+	if lockoutService == nil {
+		return false, nil
+	}
+	refusal, svcErr := lockoutService.CheckAttempt(ctx.Context, entityID)
+	if svcErr != nil {
+		return false, fmt.Errorf("failed to check the lockout policy: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	if refusal == nil {
+		return false, nil
+	}
+	applyLockout(refusal, execResp)
+	return true, nil
+}
+
+// recordFailedAttempt reports a failed verification to the lockout policy. When the failure locks the
+// account or blocks the client, the refusal replaces the error already set on execResp.
+func recordFailedAttempt(ctx *providers.NodeContext, lockoutService lockout.LockoutServiceInterface,
+	entityID string, execResp *providers.ExecutorResponse) error {
+	if lockoutService == nil {
+		return nil
+	}
+	refusal, svcErr := lockoutService.RecordFailure(ctx.Context, entityID)
+	if svcErr != nil {
+		return fmt.Errorf("failed to record the failed attempt: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	if refusal != nil {
+		applyLockout(refusal, execResp)
+	}
+	return nil
+}
+
+// recordSuccessfulAttempt reports a successful verification to the lockout policy.
+func recordSuccessfulAttempt(ctx *providers.NodeContext, lockoutService lockout.LockoutServiceInterface,
+	entityID string) error {
+	if lockoutService == nil {
+		return nil
+	}
+	if svcErr := lockoutService.RecordSuccess(ctx.Context, entityID); svcErr != nil {
+		return fmt.Errorf("failed to record the successful attempt: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	return nil
+}
+
+// applyLockout sets the refusal of an attempt by the lockout policy on execResp.
+func applyLockout(refusal *lockout.Lockout, execResp *providers.ExecutorResponse) {
+	if refusal.Reason == lockout.LockReasonPermanent {
+		execResp.Status = providers.ExecFailure
+		execResp.Inputs = nil
+	} else {
+		execResp.Status = providers.ExecUserInputRequired
+	}
+	execResp.Error = errLockoutFor(refusal)
+}
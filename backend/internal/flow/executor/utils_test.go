@@ -68,6 +68,7 @@ func (s *UtilsTestSuite) TestGetAuthnServiceName() {
 	}{
 		{"CredentialsAuth executor", ExecutorNameCredentialsAuth, authncm.AuthenticatorCredentials},
 		{"OTP executor", ExecutorNameOTPExecutor, authncm.AuthenticatorOTP},
+		{"TOTP executor", ExecutorNameTOTPExecutor, authncm.AuthenticatorTOTP},
 		{"OAuth executor", ExecutorNameOAuth, authncm.AuthenticatorOAuth},
 		{"OIDC Auth executor", ExecutorNameOIDCAuth, authncm.AuthenticatorOIDC},
 		{"GitHub Auth executor", ExecutorNameGitHubAuth, authncm.AuthenticatorGithub},
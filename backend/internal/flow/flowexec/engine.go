@@ -163,6 +163,7 @@ func (fe *flowEngine) executeNodePackage(ctx *EngineContext,
 		Handle:      ctx.SSOHandleIn,
 		FlowID:      ssoFlowID(ctx),
 		FlowVersion: ctx.SSOFlowVersion,
+		ExecutionID: ctx.ExecutionID,
 	})
 	fe.replayPromptInputs(ctx)
 
@@ -94,11 +94,16 @@ func (h *flowExecutionHandler) HandleFlowExecutionRequest(w http.ResponseWriter,
 		// lifetime.
 		h.ssoTransport.Write(w, session.CookieName(flowStep.SSOFlowID), flowStep.SSOHandleOut,
 			h.ssoHandleTTL)
+		// Pair it with the browser state OIDC Session Management compares session_state against.
+		h.ssoTransport.WriteBrowserState(w, session.BrowserStateCookieName(flowStep.SSOFlowID),
+			session.BrowserState(flowStep.SSOHandleOut), h.ssoHandleTTL)
 	}
 
-	// Clear the per-flow SSO cookie when the flow terminated the session (sign-out).
+	// Clear the per-flow SSO cookie when the flow terminated the session (sign-out). Clearing the
+	// browser state with it is what check_session_iframe reports to RPs as a changed session.
 	if flowStep.SSOClearFlowID != "" {
 		h.ssoTransport.Clear(w, session.CookieName(flowStep.SSOClearFlowID))
+		h.ssoTransport.ClearBrowserState(w, session.BrowserStateCookieName(flowStep.SSOClearFlowID))
 	}
 
 	flowResp := FlowResponse{
@@ -227,6 +227,16 @@ func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_WritesSSOHandleCookie(
 	s.Positive(ssoCookie.MaxAge, "cookie TTL must be non-zero")
 	s.True(ssoCookie.Secure)
 	s.True(ssoCookie.HttpOnly)
+
+	var browserStateCookie *http.Cookie
+	for _, ck := range w.Result().Cookies() {
+		if ck.Name == session.BrowserStateCookieName("flow-1") {
+			browserStateCookie = ck
+		}
+	}
+	s.Require().NotNil(browserStateCookie, "expected the per-flow browser state cookie to be set")
+	s.Equal(session.BrowserState("minted-handle"), browserStateCookie.Value)
+	s.False(browserStateCookie.HttpOnly)
 }
 
 // The Attestation-Token request header must be read and forwarded to the service layer as the
@@ -320,3 +330,33 @@ func (s *HandlerTestSuite) TestHandleFlowError_WithoutErrorAssertion_OmitsAssert
 	_, hasAssertion := body["errorAssertion"]
 	s.False(hasAssertion)
 }
+
+// When the flow terminated the session, both the per-flow SSO handle cookie and its browser state
+// cookie must be expired.
+func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_ClearsSSOCookies() {
+	t := s.T()
+	mockSvc := NewFlowExecServiceInterfaceMock(t)
+	flowStep := &FlowStep{
+		ExecutionID:    "exec-1",
+		Status:         providers.FlowStatusComplete,
+		SSOClearFlowID: "flow-1",
+	}
+	mockSvc.EXPECT().Execute(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(flowStep, (*tidcommon.ServiceError)(nil))
+
+	h := newFlowExecutionHandler(mockSvc, session.NewCookieTransport(false), 0)
+	req := httptest.NewRequest(http.MethodPost, "/flow/execute", bytes.NewBufferString(testFlowExecRequestBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	h.HandleFlowExecutionRequest(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	cleared := map[string]bool{}
+	for _, ck := range w.Result().Cookies() {
+		cleared[ck.Name] = ck.MaxAge < 0
+	}
+	s.True(cleared[session.CookieName("flow-1")])
+	s.True(cleared[session.BrowserStateCookieName("flow-1")])
+}
@@ -23,6 +23,7 @@ import (
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 )
 
 // serverConfigProvider is the minimal subset of serverconfig.ServerConfigService consumed by flowmgt.
@@ -42,6 +43,7 @@ func Initialize(
 	serverConfigSvc serverConfigProvider,
 	ouSvc ouProvider,
 	configHandler *FlowConfigHandler,
+	observabilitySvc providers.ObservabilityProvider,
 ) (FlowMgtServiceInterface, declarativeresource.ResourceExporter, error) {
 	flowValidator := newFlowValidator(executorRegistry, interceptorRegistry, graphBuilder)
 	store, compositeStore, transactioner, err := initializeStore(cacheManager, flowValidator)
@@ -53,6 +55,7 @@ func Initialize(
 	service := newFlowMgtService(
 		store, inferenceService, graphBuilder, executorRegistry,
 		interceptorRegistry, flowValidator, compositeStore, transactioner, serverConfigSvc, ouSvc,
+		audit.NewRecorder(observabilitySvc),
 	)
 
 	// TODO: Check whether this can be improved to avoid injecting configHandler to flow mgt service
@@ -21,6 +21,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/interceptor"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -83,6 +84,7 @@ type flowMgtService struct {
 	dependencyRegistry  resourcedependency.Registry
 	serverConfigSvc     serverConfigProvider
 	ouSvc               ouProvider
+	auditRecorder       *audit.Recorder
 	logger              *log.Logger
 }
 
@@ -98,6 +100,7 @@ func newFlowMgtService(
 	transactioner providers.Transactioner,
 	serverConfigSvc serverConfigProvider,
 	ouSvc ouProvider,
+	auditRecorder *audit.Recorder,
 ) FlowMgtServiceInterface {
 	return &flowMgtService{
 		store:               store,
@@ -110,6 +113,7 @@ func newFlowMgtService(
 		transactioner:       transactioner,
 		serverConfigSvc:     serverConfigSvc,
 		ouSvc:               ouSvc,
+		auditRecorder:       auditRecorder,
 		logger:              log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
 	}
 }
@@ -202,6 +206,7 @@ func (s *flowMgtService) CreateFlow(ctx context.Context, flowDef *FlowDefinition
 		return nil, &tidcommon.InternalServerError
 	}
 
+	s.auditRecorder.Created(ctx, audit.TargetFlow, flowID, createdFlow)
 	s.logger.Debug(ctx, "Flow created successfully", log.String(logKeyFlowID, flowID))
 
 	s.tryInferRegistrationFlow(ctx, flowID, flowDef)
@@ -411,7 +416,7 @@ func (s *flowMgtService) UpdateFlow(ctx context.Context, flowID string, flowDef
 
 	logger := s.logger.With(log.String(logKeyFlowID, flowID))
 
-	var updatedFlow *providers.CompleteFlowDefinition
+	var previousFlow, updatedFlow *providers.CompleteFlowDefinition
 	var validationSvcErr *tidcommon.ServiceError
 	var storeWriteAttempted bool
 	var existingHandle string
@@ -421,6 +426,7 @@ func (s *flowMgtService) UpdateFlow(ctx context.Context, flowID string, flowDef
 		if err != nil {
 			return err
 		}
+		previousFlow = existingFlow
 		existingHandle = existingFlow.Handle
 		existingType = existingFlow.FlowType
 
@@ -485,6 +491,7 @@ func (s *flowMgtService) UpdateFlow(ctx context.Context, flowID string, flowDef
 		return nil, &tidcommon.InternalServerError
 	}
 
+	s.auditRecorder.Updated(ctx, audit.TargetFlow, flowID, previousFlow, updatedFlow)
 	logger.Debug(ctx, "Flow updated successfully")
 
 	return updatedFlow, nil
@@ -518,6 +525,7 @@ func (s *flowMgtService) DeleteFlow(ctx context.Context, flowID string) *tidcomm
 		return &tidcommon.InternalServerError
 	}
 
+	s.auditRecorder.Deleted(ctx, audit.TargetFlow, flowID, existingFlow)
 	logger.Debug(ctx, "Flow deleted successfully")
 
 	// Invalidate the cached graph since the flow has been deleted
@@ -599,13 +607,17 @@ func (s *flowMgtService) RestoreFlowVersion(ctx context.Context, flowID string,
 
 	logger := s.logger.With(log.String(logKeyFlowID, flowID), log.Int(logKeyVersion, version))
 
-	var restoredFlow *providers.CompleteFlowDefinition
+	var previousFlow, restoredFlow *providers.CompleteFlowDefinition
 	txErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		_, err := s.store.GetFlowVersion(txCtx, flowID, version)
 		if err != nil {
 			return err
 		}
 
+		if s.auditRecorder.IsEnabled() {
+			previousFlow, _ = s.store.GetFlowByID(txCtx, flowID)
+		}
+
 		restoredFlow, err = s.store.RestoreFlowVersion(txCtx, flowID, version)
 		return err
 	})
@@ -620,6 +632,14 @@ func (s *flowMgtService) RestoreFlowVersion(ctx context.Context, flowID string,
 		return nil, &tidcommon.InternalServerError
 	}
 
+	s.auditRecorder.Record(ctx, audit.Entry{
+		Operation:  audit.OperationUpdate,
+		TargetType: audit.TargetFlow,
+		TargetID:   flowID,
+		Action:     "flow.version.restore",
+		Before:     previousFlow,
+		After:      restoredFlow,
+	})
 	logger.Debug(ctx, "Flow version restored successfully")
 
 	// Invalidate the cached graph since a version has been restored
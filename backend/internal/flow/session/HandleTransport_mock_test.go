@@ -84,6 +84,52 @@ func (_c *HandleTransportMock_Clear_Call) RunAndReturn(run func(w http.ResponseW
 	return _c
 }
 
+// ClearBrowserState provides a mock function for the type HandleTransportMock
+func (_mock *HandleTransportMock) ClearBrowserState(w http.ResponseWriter, cookieName string) {
+	_mock.Called(w, cookieName)
+	return
+}
+
+// HandleTransportMock_ClearBrowserState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearBrowserState'
+type HandleTransportMock_ClearBrowserState_Call struct {
+	*mock.Call
+}
+
+// ClearBrowserState is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - cookieName string
+func (_e *HandleTransportMock_Expecter) ClearBrowserState(w interface{}, cookieName interface{}) *HandleTransportMock_ClearBrowserState_Call {
+	return &HandleTransportMock_ClearBrowserState_Call{Call: _e.mock.On("ClearBrowserState", w, cookieName)}
+}
+
+func (_c *HandleTransportMock_ClearBrowserState_Call) Run(run func(w http.ResponseWriter, cookieName string)) *HandleTransportMock_ClearBrowserState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *HandleTransportMock_ClearBrowserState_Call) Return() *HandleTransportMock_ClearBrowserState_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HandleTransportMock_ClearBrowserState_Call) RunAndReturn(run func(w http.ResponseWriter, cookieName string)) *HandleTransportMock_ClearBrowserState_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Read provides a mock function for the type HandleTransportMock
 func (_mock *HandleTransportMock) Read(r *http.Request) InboundHandle {
 	ret := _mock.Called(r)
@@ -192,3 +238,61 @@ func (_c *HandleTransportMock_Write_Call) RunAndReturn(run func(w http.ResponseW
 	_c.Run(run)
 	return _c
 }
+
+// WriteBrowserState provides a mock function for the type HandleTransportMock
+func (_mock *HandleTransportMock) WriteBrowserState(w http.ResponseWriter, cookieName string, browserState string, ttl time.Duration) {
+	_mock.Called(w, cookieName, browserState, ttl)
+	return
+}
+
+// HandleTransportMock_WriteBrowserState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteBrowserState'
+type HandleTransportMock_WriteBrowserState_Call struct {
+	*mock.Call
+}
+
+// WriteBrowserState is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - cookieName string
+//   - browserState string
+//   - ttl time.Duration
+func (_e *HandleTransportMock_Expecter) WriteBrowserState(w interface{}, cookieName interface{}, browserState interface{}, ttl interface{}) *HandleTransportMock_WriteBrowserState_Call {
+	return &HandleTransportMock_WriteBrowserState_Call{Call: _e.mock.On("WriteBrowserState", w, cookieName, browserState, ttl)}
+}
+
+func (_c *HandleTransportMock_WriteBrowserState_Call) Run(run func(w http.ResponseWriter, cookieName string, browserState string, ttl time.Duration)) *HandleTransportMock_WriteBrowserState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *HandleTransportMock_WriteBrowserState_Call) Return() *HandleTransportMock_WriteBrowserState_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HandleTransportMock_WriteBrowserState_Call) RunAndReturn(run func(w http.ResponseWriter, cookieName string, browserState string, ttl time.Duration)) *HandleTransportMock_WriteBrowserState_Call {
+	_c.Run(run)
+	return _c
+}
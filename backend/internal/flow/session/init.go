@@ -14,7 +14,7 @@ import (
 // receive only the Service and never hold a store. Timeouts fall back per field to the built-in
 // defaults so an unset (zero) value never makes sessions expire immediately.
 func Initialize(dbProvider provider.DBProviderInterface, deploymentID string,
-	timeouts Timeouts, criteriaRevoker CriteriaRevoker) (Service, error) {
+	timeouts Timeouts, criteriaRevoker CriteriaRevoker, logoutNotifier LogoutNotifier) (Service, error) {
 	transactioner, err := dbProvider.GetRuntimePersistentDBTransactioner()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get runtime persistent DB transactioner for the SSO session service: %w", err)
@@ -37,6 +37,7 @@ func Initialize(dbProvider provider.DBProviderInterface, deploymentID string,
 		resolver:        newResolver(store),
 		transactioner:   transactioner,
 		criteriaRevoker: criteriaRevoker,
+		logoutNotifier:  logoutNotifier,
 		timeouts:        timeouts,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SSOSessionService")),
 	}, nil
@@ -5,9 +5,19 @@ package session
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"time"
 )
 
+const (
+	// sidDerivationPrefix domain-separates the sid hash from any other digest of the session id.
+	sidDerivationPrefix = "oidc-sid:"
+	// browserStateDerivationPrefix domain-separates the browser state hash from any other digest of
+	// the session handle.
+	browserStateDerivationPrefix = "oidc-opbs:"
+)
+
 // State represents the lifecycle state of a session.
 type State string
 
@@ -61,6 +71,27 @@ type Session struct {
 	State State
 	// Version is the optimistic-lock token, incremented on every successful update.
 	Version int
+
+	// UserAgent and IPAddress describe the client that established the session, so the subject can
+	// recognise where they are signed in. Either is empty when the request did not carry it.
+	UserAgent string
+	IPAddress string
+}
+
+// SID returns the OpenID Connect session identifier (sid) for the session. It is derived one-way from
+// the internal session id, so it is stable for the session's lifetime and shared by every participant
+// without disclosing the id itself.
+func (s *Session) SID() string {
+	sum := sha256.Sum256([]byte(sidDerivationPrefix + s.SessionID))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// BrowserState returns the OpenID Connect Session Management browser state (opbs) for a session
+// handle. It is derived one-way from the handle, so it can sit in a script-readable cookie without
+// exposing the handle, and changes whenever the browser's session does.
+func BrowserState(handle string) string {
+	sum := sha256.Sum256([]byte(browserStateDerivationPrefix + handle))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
 }
 
 // Participant records an application that has used (joined) an SSO session. A session is shared
@@ -82,6 +113,13 @@ type Participant struct {
 	LastActiveAt time.Time
 }
 
+// SessionDetail is a session together with the applications participating in it, as listed to the
+// subject the session belongs to.
+type SessionDetail struct {
+	Session      Session
+	Participants []Participant
+}
+
 // SSOInputs are the transient, request-scoped inputs the SSO-Check and Session nodes need to resolve
 // or establish a session: the inbound handle for the current flow and the flow's identity/version
 // (the SSO group key). They are carried on the Go context.Context rather than a NodeContext field, so
@@ -93,6 +131,9 @@ type SSOInputs struct {
 	FlowID string
 	// FlowVersion is the current active version of the flow definition.
 	FlowVersion int
+	// ExecutionID is the id of the flow execution the node runs in. It lets a LogoutNotifier tie an
+	// ended session back to the sign-out execution that ended it.
+	ExecutionID string
 }
 
 type ssoInputsContextKey struct{}
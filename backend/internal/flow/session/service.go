@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"time"
 
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
@@ -62,6 +63,18 @@ type Service interface {
 	// grants first so no session is deleted while its tokens remain live. It is idempotent, returning
 	// nil when the subject holds no sessions.
 	TerminateBySubject(ctx context.Context, subjectID string) error
+
+	// ListSubjectSessions returns the subject's live sessions at now, most recently active first, each
+	// with the applications participating in it. Ended, revoked and expired sessions are left out.
+	ListSubjectSessions(ctx context.Context, subjectID string, now time.Time) ([]SessionDetail, error)
+	// TerminateSubjectSession ends the subject's session identified by its OpenID Connect session
+	// identifier (sid) the way Terminate does, revoking the token families of its participants. It
+	// returns (nil, nil) when the subject holds no session with that sid.
+	TerminateSubjectSession(ctx context.Context, subjectID, sid string) (*Session, error)
+	// TerminateOtherSubjectSessions ends every session of the subject except the one identified by
+	// keepSID, or all of them when keepSID is empty, revoking the token families of their
+	// participants. It returns the number of sessions ended.
+	TerminateOtherSubjectSessions(ctx context.Context, subjectID, keepSID string) (int, error)
 }
 
 // LoadCheckpointInput carries what a Session join needs to restore a checkpoint. Session and Context
@@ -103,11 +116,12 @@ type SaveCheckpointInput struct {
 	TokenFamilyID string
 }
 
-// SaveCheckpointResult reports the outcome of a save. Handle is the session's handle; Created is
-// true only when this call minted the session (so the caller emits the cookie); Skipped is true
-// when the save was declined because of a subject mismatch.
+// SaveCheckpointResult reports the outcome of a save. Handle is the session's handle; SID is its
+// OpenID Connect session identifier; Created is true only when this call minted the session (so the
+// caller emits the cookie); Skipped is true when the save was declined because of a subject mismatch.
 type SaveCheckpointResult struct {
 	Handle  string
+	SID     string
 	Created bool
 	Skipped bool
 }
@@ -119,12 +133,21 @@ type CriteriaRevoker interface {
 	RevokeTokenFamily(ctx context.Context, tokenFamilyID string) error
 }
 
+// LogoutNotifier is told that a session ended, once its deletion has committed, so the applications
+// that participated in it can be notified out of band (OpenID Connect Back-Channel Logout). It is
+// injected for the same reason as CriteriaRevoker; a nil notifier disables notification. The call runs
+// on the sign-out path, so implementations must not block on delivery.
+type LogoutNotifier interface {
+	NotifySessionEnded(ctx context.Context, sess Session, participants []Participant)
+}
+
 // service is the store-backed implementation of Service.
 type service struct {
 	store           sessionStore
 	resolver        Resolver
 	transactioner   providers.Transactioner
 	criteriaRevoker CriteriaRevoker
+	logoutNotifier  LogoutNotifier
 	timeouts        Timeouts
 	logger          *log.Logger
 }
@@ -197,7 +220,7 @@ func (s *service) SaveCheckpoint(ctx context.Context, in SaveCheckpointInput) (S
 	}
 
 	s.logger.Debug(ctx, "Saved SSO checkpoint", log.String("checkpoint", in.Checkpoint))
-	return SaveCheckpointResult{Handle: target.HandleID, Created: created}, nil
+	return SaveCheckpointResult{Handle: target.HandleID, SID: target.SID(), Created: created}, nil
 }
 
 // LoadCheckpoint implements Service.
@@ -303,20 +326,15 @@ func (s *service) Terminate(ctx context.Context, handle, flowID string) (*Sessio
 	// (SSO_SESSION_PARTICIPANT). Repeated calls are idempotent: once the row is gone, GetByHandle
 	// returns nil above. Token families are revoked first, in the same transaction, so a crash can
 	// never orphan live tokens for a deleted session.
+	var participants []Participant
 	if txErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
-		if revErr := s.revokeSessionFamilies(txCtx, sess.SessionID); revErr != nil {
-			return revErr
-		}
-		if delErr := s.store.DeleteSession(txCtx, sess.SessionID); delErr != nil {
-			return delErr
-		}
-		if delErr := s.store.Delete(txCtx, sess.SessionID); delErr != nil {
-			return delErr
-		}
-		return s.store.DeleteBySessionID(txCtx, sess.SessionID)
+		var endErr error
+		participants, endErr = s.endSession(txCtx, sess.SessionID)
+		return endErr
 	}); txErr != nil {
 		return nil, fmt.Errorf("failed to terminate session: %w", txErr)
 	}
+	s.notifySessionEnded(ctx, *sess, participants)
 
 	s.logger.Debug(ctx, "Terminated SSO session", log.String("flowId", sess.FlowID))
 	return sess, nil
@@ -348,8 +366,17 @@ func (s *service) TerminateBySubject(ctx context.Context, subjectID string) erro
 		return nil
 	}
 
+	// Participants are read only when a logout notifier is wired: this path revokes no families, so
+	// notification is their sole consumer.
+	participants := make([][]Participant, len(sessions))
 	if txErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
-		for _, sess := range sessions {
+		for i, sess := range sessions {
+			if s.logoutNotifier != nil {
+				var listErr error
+				if participants[i], listErr = s.store.ListBySessionID(txCtx, sess.SessionID); listErr != nil {
+					return listErr
+				}
+			}
 			if delErr := s.store.DeleteSession(txCtx, sess.SessionID); delErr != nil {
 				return delErr
 			}
@@ -364,22 +391,150 @@ func (s *service) TerminateBySubject(ctx context.Context, subjectID string) erro
 	}); txErr != nil {
 		return fmt.Errorf("failed to terminate subject sessions: %w", txErr)
 	}
+	for i, sess := range sessions {
+		s.notifySessionEnded(ctx, sess, participants[i])
+	}
 
 	s.logger.Debug(ctx, "Terminated all SSO sessions for subject", log.Int("sessionCount", len(sessions)))
 	return nil
 }
 
+// ListSubjectSessions implements Service.
+func (s *service) ListSubjectSessions(ctx context.Context, subjectID string,
+	now time.Time) ([]SessionDetail, error) {
+	details := make([]SessionDetail, 0)
+	if subjectID == "" {
+		return details, nil
+	}
+	sessions, err := s.store.ListBySubject(ctx, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions by subject: %w", err)
+	}
+	for _, sess := range sessions {
+		if sess.State != StateActive || expired(sess.IdleExpiresAt, now) || expired(sess.AbsoluteExpiresAt, now) {
+			continue
+		}
+		participants, err := s.store.ListBySessionID(ctx, sess.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list session participants: %w", err)
+		}
+		details = append(details, SessionDetail{Session: sess, Participants: participants})
+	}
+	return details, nil
+}
+
+// TerminateSubjectSession implements Service. Sessions are matched on their sid rather than looked up
+// by it, since the sid is a one-way digest of the internal session id.
+func (s *service) TerminateSubjectSession(ctx context.Context, subjectID, sid string) (*Session, error) {
+	if subjectID == "" || sid == "" {
+		return nil, nil
+	}
+	sessions, err := s.store.ListBySubject(ctx, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions by subject: %w", err)
+	}
+	for i := range sessions {
+		if sessions[i].SID() != sid {
+			continue
+		}
+		ended, err := s.terminateSessions(ctx, sessions[i:i+1])
+		if err != nil {
+			return nil, err
+		}
+		s.logger.Debug(ctx, "Terminated SSO session of subject", log.String("flowId", sessions[i].FlowID))
+		return &ended[0], nil
+	}
+	return nil, nil
+}
+
+// TerminateOtherSubjectSessions implements Service. Unlike TerminateBySubject, it revokes the token
+// families of every ended session, since sessions are ended selectively and no subject-wide criteria
+// revocation covers their tokens.
+func (s *service) TerminateOtherSubjectSessions(ctx context.Context, subjectID, keepSID string) (int, error) {
+	if subjectID == "" {
+		return 0, nil
+	}
+	sessions, err := s.store.ListBySubject(ctx, subjectID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list sessions by subject: %w", err)
+	}
+	others := make([]Session, 0, len(sessions))
+	for _, sess := range sessions {
+		if keepSID == "" || sess.SID() != keepSID {
+			others = append(others, sess)
+		}
+	}
+	if len(others) == 0 {
+		return 0, nil
+	}
+	if _, err := s.terminateSessions(ctx, others); err != nil {
+		return 0, err
+	}
+
+	s.logger.Debug(ctx, "Terminated SSO sessions of subject", log.Int("sessionCount", len(others)))
+	return len(others), nil
+}
+
+// terminateSessions ends the given sessions in one transaction, so a partial failure leaves every
+// session intact, and notifies the logout notifier of each once the transaction commits.
+func (s *service) terminateSessions(ctx context.Context, sessions []Session) ([]Session, error) {
+	participants := make([][]Participant, len(sessions))
+	if txErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		for i := range sessions {
+			var endErr error
+			if participants[i], endErr = s.endSession(txCtx, sessions[i].SessionID); endErr != nil {
+				return endErr
+			}
+		}
+		return nil
+	}); txErr != nil {
+		return nil, fmt.Errorf("failed to terminate sessions: %w", txErr)
+	}
+	for i := range sessions {
+		s.notifySessionEnded(ctx, sessions[i], participants[i])
+	}
+	return sessions, nil
+}
+
+// endSession revokes the token families of the session's participants and hard-deletes the session
+// along with its checkpoint contexts and participants. It runs inside the caller's transaction and
+// returns the participants it read, for the caller to notify once the transaction commits.
+func (s *service) endSession(ctx context.Context, sessionID string) ([]Participant, error) {
+	participants, err := s.listParticipants(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.revokeSessionFamilies(ctx, participants); err != nil {
+		return nil, err
+	}
+	if err := s.store.DeleteSession(ctx, sessionID); err != nil {
+		return nil, err
+	}
+	if err := s.store.Delete(ctx, sessionID); err != nil {
+		return nil, err
+	}
+	if err := s.store.DeleteBySessionID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+	return participants, nil
+}
+
+// listParticipants reads the session's participants for sign-out. The read is skipped when neither
+// a family revoker nor a logout notifier is wired, since nothing would consume it.
+func (s *service) listParticipants(ctx context.Context, sessionID string) ([]Participant, error) {
+	if s.criteriaRevoker == nil && s.logoutNotifier == nil {
+		return nil, nil
+	}
+	return s.store.ListBySessionID(ctx, sessionID)
+}
+
 // revokeSessionFamilies revokes the token family of every application participating in the session,
 // so signing out of a login drops all of that login's grants. It is a no-op when no family revoker is
 // wired. A participant recorded before tfid was introduced (empty tfid) is skipped by the revoker.
-func (s *service) revokeSessionFamilies(ctx context.Context, sessionID string) error {
+func (s *service) revokeSessionFamilies(ctx context.Context, participants []Participant) error {
 	if s.criteriaRevoker == nil {
 		return nil
 	}
-	participants, err := s.store.ListBySessionID(ctx, sessionID)
-	if err != nil {
-		return err
-	}
 	for _, p := range participants {
 		if err := s.criteriaRevoker.RevokeTokenFamily(ctx, p.TokenFamilyID); err != nil {
 			return err
@@ -388,6 +543,17 @@ func (s *service) revokeSessionFamilies(ctx context.Context, sessionID string) e
 	return nil
 }
 
+// notifySessionEnded hands an ended session and its participants to the logout notifier, if one is
+// wired. It is called only after the deletion commits, so a rolled-back sign-out never notifies. A
+// session nobody joined is still reported, since the end of the session itself is of interest to
+// notifiers that are not per-application, such as the Shared Signals transmitter.
+func (s *service) notifySessionEnded(ctx context.Context, sess Session, participants []Participant) {
+	if s.logoutNotifier == nil {
+		return
+	}
+	s.logoutNotifier.NotifySessionEnded(ctx, sess, participants)
+}
+
 // targetSession returns the session this execution's checkpoints attach to, establishing one when
 // none exists yet. The bool reports whether this call minted the session. It returns (nil, false,
 // nil) when an existing session belongs to a different subject than the one just authenticated, so
@@ -448,6 +614,8 @@ func (s *service) establishSession(ctx context.Context, in SaveCheckpointInput)
 		AbsoluteExpiresAt: now.Add(s.timeouts.Absolute),
 		State:             StateActive,
 		Version:           1,
+		UserAgent:         sysContext.GetUserAgent(ctx),
+		IPAddress:         sysContext.GetClientIP(ctx),
 	}
 	if err := s.store.Create(ctx, newSession); err != nil {
 		return nil, false, err
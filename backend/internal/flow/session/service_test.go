@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/tests/mocks/transactionmock"
 )
@@ -201,6 +202,26 @@ func (suite *ServiceTestSuite) TestSaveCheckpoint_Establishes() {
 	suite.True(created.AbsoluteExpiresAt.After(created.IdleExpiresAt))
 }
 
+func (suite *ServiceTestSuite) TestSaveCheckpoint_RecordsClientDetails() {
+	svc, m := suite.newService()
+	var created *Session
+	m.store.EXPECT().GetByExecutionID(mock.Anything, mock.Anything).RunAndReturn(
+		func(context.Context, string) (*Session, error) { return created, nil })
+	m.store.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, s Session) error { created = &s; return nil })
+	runTx(m)
+	m.store.EXPECT().CreateContext(mock.Anything, mock.Anything).Return(nil)
+	m.store.EXPECT().Record(mock.Anything, mock.Anything).Return(nil)
+	ctx := sysContext.WithUserAgent(sysContext.WithClientIP(context.Background(), "192.0.2.10"), "curl/8.5.0")
+
+	_, err := svc.SaveCheckpoint(ctx, saveInput())
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(created)
+	suite.Equal("curl/8.5.0", created.UserAgent)
+	suite.Equal("192.0.2.10", created.IPAddress)
+}
+
 func (suite *ServiceTestSuite) TestSaveCheckpoint_AttachesToExisting() {
 	svc, m := suite.newService()
 	m.store.EXPECT().GetByHandle(mock.Anything, "handle-abc").Return(liveStoreSession(), nil)
@@ -600,6 +621,86 @@ func (suite *ServiceTestSuite) TestTerminateBySubject_DeleteFailureRollsBackBatc
 	suite.Require().Error(err)
 }
 
+// newNotifyingService builds a service with a logout notifier wired and no family revoker.
+func (suite *ServiceTestSuite) newNotifyingService() (*service, *serviceMocks, *LogoutNotifierMock) {
+	svc, m := suite.newService()
+	notifier := NewLogoutNotifierMock(suite.T())
+	svc.logoutNotifier = notifier
+	return svc, m, notifier
+}
+
+// The notifier receives the participants read before the delete, and only after the delete commits.
+func (suite *ServiceTestSuite) TestTerminate_NotifiesParticipantsAfterCommit() {
+	svc, m, notifier := suite.newNotifyingService()
+	participants := []Participant{{SessionID: "sess-1", AppID: "app-1"}, {SessionID: "sess-1", AppID: "app-2"}}
+	committed := false
+	m.store.EXPECT().GetByHandle(mock.Anything, "handle-abc").Return(liveStoreSession(), nil)
+	m.tx.EXPECT().Transact(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			err := fn(ctx)
+			committed = err == nil
+			return err
+		})
+	m.store.EXPECT().ListBySessionID(mock.Anything, "sess-1").Return(participants, nil)
+	m.store.EXPECT().DeleteSession(mock.Anything, "sess-1").Return(nil)
+	m.store.EXPECT().Delete(mock.Anything, "sess-1").Return(nil)
+	m.store.EXPECT().DeleteBySessionID(mock.Anything, "sess-1").Return(nil)
+	notifier.EXPECT().NotifySessionEnded(mock.Anything, *liveStoreSession(), participants).
+		Run(func(context.Context, Session, []Participant) { suite.True(committed) }).Return()
+
+	_, err := svc.Terminate(context.Background(), "handle-abc", "flow-1")
+
+	suite.Require().NoError(err)
+}
+
+func (suite *ServiceTestSuite) TestTerminate_RolledBackDeleteDoesNotNotify() {
+	svc, m, notifier := suite.newNotifyingService()
+	m.store.EXPECT().GetByHandle(mock.Anything, "handle-abc").Return(liveStoreSession(), nil)
+	runTx(m)
+	m.store.EXPECT().ListBySessionID(mock.Anything, "sess-1").Return(
+		[]Participant{{SessionID: "sess-1", AppID: "app-1"}}, nil)
+	m.store.EXPECT().DeleteSession(mock.Anything, "sess-1").Return(errors.New("db down"))
+
+	_, err := svc.Terminate(context.Background(), "handle-abc", "flow-1")
+
+	suite.Require().Error(err)
+	notifier.AssertNotCalled(suite.T(), "NotifySessionEnded", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestTerminateBySubject_NotifiesEachSession() {
+	svc, m, notifier := suite.newNotifyingService()
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{
+		{SessionID: "sess-1", SubjectID: "user-1"},
+		{SessionID: "sess-2", SubjectID: "user-1"},
+	}, nil)
+	runTx(m)
+	m.store.EXPECT().ListBySessionID(mock.Anything, "sess-1").Return(
+		[]Participant{{SessionID: "sess-1", AppID: "app-1"}}, nil)
+	// A session nobody joined is still reported, with no participants.
+	m.store.EXPECT().ListBySessionID(mock.Anything, "sess-2").Return(nil, nil)
+	for _, sessionID := range []string{"sess-1", "sess-2"} {
+		m.store.EXPECT().DeleteSession(mock.Anything, sessionID).Return(nil)
+		m.store.EXPECT().Delete(mock.Anything, sessionID).Return(nil)
+		m.store.EXPECT().DeleteBySessionID(mock.Anything, sessionID).Return(nil)
+	}
+	notifier.EXPECT().NotifySessionEnded(mock.Anything,
+		Session{SessionID: "sess-1", SubjectID: "user-1"},
+		[]Participant{{SessionID: "sess-1", AppID: "app-1"}}).Return().Once()
+	notifier.EXPECT().NotifySessionEnded(mock.Anything,
+		Session{SessionID: "sess-2", SubjectID: "user-1"}, []Participant(nil)).Return().Once()
+
+	suite.Require().NoError(svc.TerminateBySubject(context.Background(), "user-1"))
+}
+
+func (suite *ServiceTestSuite) TestSessionSID_StableAndOpaque() {
+	a := &Session{SessionID: "sess-1"}
+	b := &Session{SessionID: "sess-2"}
+
+	suite.Equal(a.SID(), (&Session{SessionID: "sess-1"}).SID(), "the sid is stable for a session")
+	suite.NotEqual(a.SID(), b.SID())
+	suite.NotContains(a.SID(), "sess-1", "the sid must not disclose the internal session id")
+}
+
 // No sessions means no transaction at all.
 func (suite *ServiceTestSuite) TestTerminateBySubject_NoSessionsIsNoOp() {
 	m := &serviceMocks{
@@ -672,3 +773,171 @@ func (suite *ServiceTestSuite) TestTerminate_DeleteError() {
 	suite.Require().Error(err)
 	suite.Contains(err.Error(), "failed to terminate session")
 }
+
+// --- Subject session management ---
+
+// newRevokingService builds a service with a token family revoker wired.
+func (suite *ServiceTestSuite) newRevokingService() (*service, *serviceMocks, *CriteriaRevokerMock) {
+	svc, m := suite.newService()
+	revoker := NewCriteriaRevokerMock(suite.T())
+	svc.criteriaRevoker = revoker
+	return svc, m, revoker
+}
+
+// expectSessionEnded expects the family revocations and deletes ending one session with a single
+// participant holding the given token family.
+func expectSessionEnded(m *serviceMocks, revoker *CriteriaRevokerMock, sessionID, tokenFamilyID string) {
+	m.store.EXPECT().ListBySessionID(mock.Anything, sessionID).Return(
+		[]Participant{{SessionID: sessionID, AppID: "app-1", TokenFamilyID: tokenFamilyID}}, nil)
+	revoker.EXPECT().RevokeTokenFamily(mock.Anything, tokenFamilyID).Return(nil).Once()
+	m.store.EXPECT().DeleteSession(mock.Anything, sessionID).Return(nil)
+	m.store.EXPECT().Delete(mock.Anything, sessionID).Return(nil)
+	m.store.EXPECT().DeleteBySessionID(mock.Anything, sessionID).Return(nil)
+}
+
+func (suite *ServiceTestSuite) TestListSubjectSessions_ListsLiveSessionsWithParticipants() {
+	svc, m := suite.newService()
+	now := time.Date(2026, 6, 16, 10, 0, 0, 0, time.UTC)
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{
+		{SessionID: "sess-1", SubjectID: "user-1", State: StateActive, IdleExpiresAt: now.Add(time.Hour)},
+		{SessionID: "sess-2", SubjectID: "user-1", State: StateEnded},
+		{SessionID: "sess-3", SubjectID: "user-1", State: StateActive, IdleExpiresAt: now.Add(-time.Minute)},
+		{SessionID: "sess-4", SubjectID: "user-1", State: StateActive, AbsoluteExpiresAt: now},
+	}, nil)
+	participants := []Participant{{SessionID: "sess-1", AppID: "app-1"}}
+	m.store.EXPECT().ListBySessionID(mock.Anything, "sess-1").Return(participants, nil)
+
+	got, err := svc.ListSubjectSessions(context.Background(), "user-1", now)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(got, 1, "ended and expired sessions must be left out")
+	suite.Equal("sess-1", got[0].Session.SessionID)
+	suite.Equal(participants, got[0].Participants)
+}
+
+func (suite *ServiceTestSuite) TestListSubjectSessions_EmptySubject() {
+	svc, m := suite.newService()
+
+	got, err := svc.ListSubjectSessions(context.Background(), "", time.Now().UTC())
+
+	suite.Require().NoError(err)
+	suite.Empty(got)
+	m.store.AssertNotCalled(suite.T(), "ListBySubject", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestListSubjectSessions_StoreError() {
+	svc, m := suite.newService()
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return(nil, errors.New("db down"))
+
+	_, err := svc.ListSubjectSessions(context.Background(), "user-1", time.Now().UTC())
+
+	suite.Require().Error(err)
+}
+
+func (suite *ServiceTestSuite) TestTerminateSubjectSession_RevokesFamilies() {
+	svc, m, revoker := suite.newRevokingService()
+	target := Session{SessionID: "sess-2", SubjectID: "user-1"}
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{
+		{SessionID: "sess-1", SubjectID: "user-1"}, target,
+	}, nil)
+	runTx(m)
+	expectSessionEnded(m, revoker, "sess-2", "tfid-b")
+
+	got, err := svc.TerminateSubjectSession(context.Background(), "user-1", target.SID())
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(got)
+	suite.Equal("sess-2", got.SessionID)
+	m.store.AssertNotCalled(suite.T(), "DeleteSession", mock.Anything, "sess-1")
+}
+
+func (suite *ServiceTestSuite) TestTerminateSubjectSession_UnknownSIDIsNoOp() {
+	svc, m := suite.newService()
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{
+		{SessionID: "sess-1", SubjectID: "user-1"},
+	}, nil)
+
+	got, err := svc.TerminateSubjectSession(context.Background(), "user-1", "unknown-sid")
+
+	suite.Require().NoError(err)
+	suite.Nil(got)
+	m.tx.AssertNotCalled(suite.T(), "Transact", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestTerminateSubjectSession_NotifiesAfterCommit() {
+	svc, m, notifier := suite.newNotifyingService()
+	target := Session{SessionID: "sess-1", SubjectID: "user-1"}
+	participants := []Participant{{SessionID: "sess-1", AppID: "app-1"}}
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{target}, nil)
+	runTx(m)
+	m.store.EXPECT().ListBySessionID(mock.Anything, "sess-1").Return(participants, nil)
+	m.store.EXPECT().DeleteSession(mock.Anything, "sess-1").Return(nil)
+	m.store.EXPECT().Delete(mock.Anything, "sess-1").Return(nil)
+	m.store.EXPECT().DeleteBySessionID(mock.Anything, "sess-1").Return(nil)
+	notifier.EXPECT().NotifySessionEnded(mock.Anything, target, participants).Return().Once()
+
+	_, err := svc.TerminateSubjectSession(context.Background(), "user-1", target.SID())
+
+	suite.Require().NoError(err)
+}
+
+func (suite *ServiceTestSuite) TestTerminateOtherSubjectSessions_KeepsCurrentSession() {
+	svc, m, revoker := suite.newRevokingService()
+	current := Session{SessionID: "sess-1", SubjectID: "user-1"}
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{
+		current, {SessionID: "sess-2", SubjectID: "user-1"}, {SessionID: "sess-3", SubjectID: "user-1"},
+	}, nil)
+	runTx(m)
+	expectSessionEnded(m, revoker, "sess-2", "tfid-b")
+	expectSessionEnded(m, revoker, "sess-3", "tfid-c")
+
+	n, err := svc.TerminateOtherSubjectSessions(context.Background(), "user-1", current.SID())
+
+	suite.Require().NoError(err)
+	suite.Equal(2, n)
+	m.store.AssertNotCalled(suite.T(), "DeleteSession", mock.Anything, "sess-1")
+}
+
+func (suite *ServiceTestSuite) TestTerminateOtherSubjectSessions_EmptyKeepSIDEndsAll() {
+	svc, m, revoker := suite.newRevokingService()
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{
+		{SessionID: "sess-1", SubjectID: "user-1"}, {SessionID: "sess-2", SubjectID: "user-1"},
+	}, nil)
+	runTx(m)
+	expectSessionEnded(m, revoker, "sess-1", "tfid-a")
+	expectSessionEnded(m, revoker, "sess-2", "tfid-b")
+
+	n, err := svc.TerminateOtherSubjectSessions(context.Background(), "user-1", "")
+
+	suite.Require().NoError(err)
+	suite.Equal(2, n)
+}
+
+func (suite *ServiceTestSuite) TestTerminateOtherSubjectSessions_NoOtherSessionsIsNoOp() {
+	svc, m := suite.newService()
+	current := Session{SessionID: "sess-1", SubjectID: "user-1"}
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{current}, nil)
+
+	n, err := svc.TerminateOtherSubjectSessions(context.Background(), "user-1", current.SID())
+
+	suite.Require().NoError(err)
+	suite.Zero(n)
+	m.tx.AssertNotCalled(suite.T(), "Transact", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestTerminateOtherSubjectSessions_RevocationFailureRollsBack() {
+	svc, m, revoker := suite.newRevokingService()
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{
+		{SessionID: "sess-1", SubjectID: "user-1"},
+	}, nil)
+	runTx(m)
+	m.store.EXPECT().ListBySessionID(mock.Anything, "sess-1").Return(
+		[]Participant{{SessionID: "sess-1", AppID: "app-1", TokenFamilyID: "tfid-a"}}, nil)
+	revoker.EXPECT().RevokeTokenFamily(mock.Anything, "tfid-a").Return(errors.New("db down"))
+
+	n, err := svc.TerminateOtherSubjectSessions(context.Background(), "user-1", "")
+
+	suite.Require().Error(err)
+	suite.Zero(n)
+	m.store.AssertNotCalled(suite.T(), "DeleteSession", mock.Anything, mock.Anything)
+}
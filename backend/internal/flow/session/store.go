@@ -39,7 +39,8 @@ func (st *store) Create(ctx context.Context, s Session) error {
 			s.SessionID, st.deploymentID, s.SubjectID, s.FlowID, s.FlowVersion,
 			s.FlowExecutionID, s.HandleID,
 			s.AuthenticatedAt, s.CreatedAt, s.LastActiveAt,
-			nullableTime(s.IdleExpiresAt), nullableTime(s.AbsoluteExpiresAt), string(s.State), s.Version)
+			nullableTime(s.IdleExpiresAt), nullableTime(s.AbsoluteExpiresAt), string(s.State), s.Version,
+			nullableString(s.UserAgent), nullableString(s.IPAddress))
 		if err != nil {
 			return fmt.Errorf("failed to create session: %w", err)
 		}
@@ -322,6 +323,14 @@ func nullableTime(t time.Time) interface{} {
 	return t
 }
 
+// nullableString returns nil for an empty string so nullable columns store NULL, otherwise the string.
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
 // buildSessionFromRow maps a database result row into a Session.
 func buildSessionFromRow(row map[string]interface{}) (*Session, error) {
 	sessionID, err := parseString(row["session_id"], "session_id")
@@ -379,6 +388,8 @@ func buildSessionFromRow(row map[string]interface{}) (*Session, error) {
 		AbsoluteExpiresAt: parseNullableTime(row["absolute_expires_at"]),
 		State:             State(parseNullableString(row["state"])),
 		Version:           version,
+		UserAgent:         parseNullableString(row["user_agent"]),
+		IPAddress:         parseNullableString(row["ip_address"]),
 	}, nil
 }
 
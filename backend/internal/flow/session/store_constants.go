@@ -16,8 +16,9 @@ var (
 		ID: "SSO-SESS-01",
 		Query: `INSERT INTO "SSO_SESSION" (SESSION_ID, DEPLOYMENT_ID, SUBJECT_ID, FLOW_ID, FLOW_VERSION, ` +
 			`FLOW_EXECUTION_ID, HANDLE_ID, ` +
-			`AUTHENTICATED_AT, CREATED_AT, LAST_ACTIVE_AT, IDLE_EXPIRES_AT, ABSOLUTE_EXPIRES_AT, STATE, VERSION) ` +
-			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ` +
+			`AUTHENTICATED_AT, CREATED_AT, LAST_ACTIVE_AT, IDLE_EXPIRES_AT, ABSOLUTE_EXPIRES_AT, STATE, VERSION, ` +
+			`USER_AGENT, IP_ADDRESS) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) ` +
 			`ON CONFLICT (FLOW_EXECUTION_ID, DEPLOYMENT_ID) DO NOTHING`,
 	}
 
@@ -26,7 +27,8 @@ var (
 	queryGetSessionByHandle = model.DBQuery{
 		ID: "SSO-SESS-02",
 		Query: `SELECT SESSION_ID, SUBJECT_ID, FLOW_ID, FLOW_VERSION, FLOW_EXECUTION_ID, HANDLE_ID, ` +
-			`AUTHENTICATED_AT, CREATED_AT, LAST_ACTIVE_AT, IDLE_EXPIRES_AT, ABSOLUTE_EXPIRES_AT, STATE, VERSION ` +
+			`AUTHENTICATED_AT, CREATED_AT, LAST_ACTIVE_AT, IDLE_EXPIRES_AT, ABSOLUTE_EXPIRES_AT, STATE, VERSION, ` +
+			`USER_AGENT, IP_ADDRESS ` +
 			`FROM "SSO_SESSION" WHERE HANDLE_ID = $1 AND DEPLOYMENT_ID = $2`,
 	}
 
@@ -36,7 +38,8 @@ var (
 	queryGetSessionByExecutionID = model.DBQuery{
 		ID: "SSO-SESS-03",
 		Query: `SELECT SESSION_ID, SUBJECT_ID, FLOW_ID, FLOW_VERSION, FLOW_EXECUTION_ID, HANDLE_ID, ` +
-			`AUTHENTICATED_AT, CREATED_AT, LAST_ACTIVE_AT, IDLE_EXPIRES_AT, ABSOLUTE_EXPIRES_AT, STATE, VERSION ` +
+			`AUTHENTICATED_AT, CREATED_AT, LAST_ACTIVE_AT, IDLE_EXPIRES_AT, ABSOLUTE_EXPIRES_AT, STATE, VERSION, ` +
+			`USER_AGENT, IP_ADDRESS ` +
 			`FROM "SSO_SESSION" WHERE FLOW_EXECUTION_ID = $1 AND DEPLOYMENT_ID = $2`,
 	}
 
@@ -108,11 +111,12 @@ var (
 		Query: `DELETE FROM "SSO_SESSION" WHERE SESSION_ID = $1 AND DEPLOYMENT_ID = $2`,
 	}
 
-	// queryListSessionsBySubject returns all SSO sessions owned by a subject.
+	// queryListSessionsBySubject returns all SSO sessions owned by a subject, most recently active first.
 	queryListSessionsBySubject = model.DBQuery{
 		ID: "SSO-SESS-13",
 		Query: `SELECT SESSION_ID, SUBJECT_ID, FLOW_ID, FLOW_VERSION, FLOW_EXECUTION_ID, HANDLE_ID, ` +
-			`AUTHENTICATED_AT, CREATED_AT, LAST_ACTIVE_AT, IDLE_EXPIRES_AT, ABSOLUTE_EXPIRES_AT, STATE, VERSION ` +
-			`FROM "SSO_SESSION" WHERE SUBJECT_ID = $1 AND DEPLOYMENT_ID = $2`,
+			`AUTHENTICATED_AT, CREATED_AT, LAST_ACTIVE_AT, IDLE_EXPIRES_AT, ABSOLUTE_EXPIRES_AT, STATE, VERSION, ` +
+			`USER_AGENT, IP_ADDRESS ` +
+			`FROM "SSO_SESSION" WHERE SUBJECT_ID = $1 AND DEPLOYMENT_ID = $2 ORDER BY LAST_ACTIVE_AT DESC`,
 	}
 )
@@ -52,6 +52,8 @@ func (s *StoreTestSuite) sampleSession() Session {
 		AbsoluteExpiresAt: base.Add(8 * time.Hour),
 		State:             StateActive,
 		Version:           1,
+		UserAgent:         "Mozilla/5.0 (X11; Linux x86_64)",
+		IPAddress:         "192.0.2.10",
 	}
 }
 
@@ -69,7 +71,8 @@ func (s *StoreTestSuite) TestCreate_Success() {
 		sess.SessionID, testDeploymentID, sess.SubjectID, sess.FlowID, sess.FlowVersion,
 		sess.FlowExecutionID, sess.HandleID,
 		sess.AuthenticatedAt, sess.CreatedAt, sess.LastActiveAt,
-		nil, sess.AbsoluteExpiresAt, string(sess.State), sess.Version).
+		nil, sess.AbsoluteExpiresAt, string(sess.State), sess.Version,
+		sess.UserAgent, sess.IPAddress).
 		Return(int64(1), nil)
 
 	err := s.store.Create(context.Background(), sess)
@@ -79,6 +82,26 @@ func (s *StoreTestSuite) TestCreate_Success() {
 	s.mockDBClient.AssertExpectations(s.T())
 }
 
+func (s *StoreTestSuite) TestCreate_WithoutClientDetails() {
+	sess := s.sampleSession()
+	sess.UserAgent = ""
+	sess.IPAddress = ""
+
+	s.mockDBProvider.On("GetRuntimePersistentDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", context.Background(), queryCreateSession,
+		sess.SessionID, testDeploymentID, sess.SubjectID, sess.FlowID, sess.FlowVersion,
+		sess.FlowExecutionID, sess.HandleID,
+		sess.AuthenticatedAt, sess.CreatedAt, sess.LastActiveAt,
+		nil, sess.AbsoluteExpiresAt, string(sess.State), sess.Version,
+		nil, nil).
+		Return(int64(1), nil)
+
+	err := s.store.Create(context.Background(), sess)
+
+	s.NoError(err)
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
 func (s *StoreTestSuite) TestCreate_DBError() {
 	sess := s.sampleSession()
 
@@ -87,7 +110,8 @@ func (s *StoreTestSuite) TestCreate_DBError() {
 		sess.SessionID, testDeploymentID, sess.SubjectID, sess.FlowID, sess.FlowVersion,
 		sess.FlowExecutionID, sess.HandleID,
 		sess.AuthenticatedAt, sess.CreatedAt, sess.LastActiveAt,
-		nil, sess.AbsoluteExpiresAt, string(sess.State), sess.Version).
+		nil, sess.AbsoluteExpiresAt, string(sess.State), sess.Version,
+		sess.UserAgent, sess.IPAddress).
 		Return(int64(0), errors.New("db down"))
 
 	err := s.store.Create(context.Background(), sess)
@@ -199,6 +223,7 @@ func (s *StoreTestSuite) TestListBySubject() {
 		"authenticated_at": base, "created_at": base, "last_active_at": base,
 		"idle_expires_at": nil, "absolute_expires_at": base.Add(8 * time.Hour),
 		"state": "ACTIVE", "version": int64(1),
+		"user_agent": "Mozilla/5.0 (X11; Linux x86_64)", "ip_address": "192.0.2.10",
 	}
 	s.mockDBProvider.On("GetRuntimePersistentDBClient").Return(s.mockDBClient, nil)
 	s.mockDBClient.On("QueryContext", context.Background(), queryListSessionsBySubject,
@@ -210,6 +235,8 @@ func (s *StoreTestSuite) TestListBySubject() {
 	s.Require().Len(got, 1)
 	s.Equal("sess-1", got[0].SessionID)
 	s.Equal("user-1", got[0].SubjectID)
+	s.Equal("Mozilla/5.0 (X11; Linux x86_64)", got[0].UserAgent)
+	s.Equal("192.0.2.10", got[0].IPAddress)
 }
 
 func (s *StoreTestSuite) TestUpdate_Success() {
@@ -11,16 +11,32 @@ import (
 	"time"
 )
 
-// cookieNamePrefix prefixes every per-flow SSO cookie name.
-const cookieNamePrefix = "tid_sso_"
+const (
+	// cookieNamePrefix prefixes every per-flow SSO cookie name.
+	cookieNamePrefix = "tid_sso_"
+	// BrowserStateCookieNamePrefix prefixes every per-flow browser state cookie name. It is exported
+	// for the check_session_iframe, which scans the cookies carrying it.
+	BrowserStateCookieNamePrefix = "tid_opbs_"
+)
 
 // CookieName derives the per-flow SSO cookie name from the flow ID. Each flow gets its
 // own cookie so sessions from different flows do not clobber each other's handle. The
 // flow ID is hashed so the raw ID is not exposed in the cookie name and the name stays
 // within the cookie-token character set.
 func CookieName(flowID string) string {
+	return cookieNamePrefix + flowIDDigest(flowID)
+}
+
+// BrowserStateCookieName derives the per-flow browser state cookie name from the flow ID, paired with
+// the flow's SSO cookie by the same digest.
+func BrowserStateCookieName(flowID string) string {
+	return BrowserStateCookieNamePrefix + flowIDDigest(flowID)
+}
+
+// flowIDDigest returns the truncated hex digest of a flow ID used in cookie names.
+func flowIDDigest(flowID string) string {
 	sum := sha256.Sum256([]byte(flowID))
-	return cookieNamePrefix + hex.EncodeToString(sum[:])[:16]
+	return hex.EncodeToString(sum[:])[:16]
 }
 
 // InboundHandle holds the request-scoped SSO transport inputs read from a transport. It is
@@ -64,6 +80,12 @@ type HandleTransport interface {
 	Write(w http.ResponseWriter, cookieName, handle string, ttl time.Duration)
 	// Clear removes the handle from the response. Seam for logout / session end.
 	Clear(w http.ResponseWriter, cookieName string)
+	// WriteBrowserState emits the session's browser state (see BrowserState) under the given
+	// (per-flow) cookie name, valid for ttl. Unlike the handle it must stay readable by script, since
+	// the check_session_iframe compares it against an RP's session_state in the browser.
+	WriteBrowserState(w http.ResponseWriter, cookieName, browserState string, ttl time.Duration)
+	// ClearBrowserState removes the browser state from the response, signalling a session change.
+	ClearBrowserState(w http.ResponseWriter, cookieName string)
 }
 
 // cookieTransport carries the handle as an HTTP cookie.
@@ -116,3 +138,40 @@ func (c *cookieTransport) Clear(w http.ResponseWriter, cookieName string) {
 		SameSite: http.SameSiteLaxMode,
 	})
 }
+
+// WriteBrowserState sets the per-flow browser state cookie on the response. It is read by the
+// check_session_iframe, which RPs embed cross-site, so behind TLS it is sent with SameSite=None;
+// browsers reject SameSite=None without Secure, so plain-HTTP deployments fall back to Lax.
+func (c *cookieTransport) WriteBrowserState(w http.ResponseWriter, cookieName, browserState string,
+	ttl time.Duration) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieName,
+		Value:    browserState,
+		Path:     "/",
+		MaxAge:   int(ttl.Seconds()),
+		HttpOnly: false,
+		Secure:   c.secure,
+		SameSite: c.browserStateSameSite(),
+	})
+}
+
+// ClearBrowserState expires the per-flow browser state cookie on the response.
+func (c *cookieTransport) ClearBrowserState(w http.ResponseWriter, cookieName string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: false,
+		Secure:   c.secure,
+		SameSite: c.browserStateSameSite(),
+	})
+}
+
+// browserStateSameSite returns the SameSite mode for the browser state cookie.
+func (c *cookieTransport) browserStateSameSite() http.SameSite {
+	if c.secure {
+		return http.SameSiteNoneMode
+	}
+	return http.SameSiteLaxMode
+}
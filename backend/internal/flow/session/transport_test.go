@@ -107,3 +107,58 @@ func (s *TransportTestSuite) TestCookieTransport_RoundTrip() {
 	ih := transport.Read(r)
 	s.Equal("handle-xyz", ih.HandleFor("flow-1"))
 }
+
+func (s *TransportTestSuite) TestBrowserStateCookieName() {
+	name := BrowserStateCookieName("flow-1")
+
+	s.True(strings.HasPrefix(name, BrowserStateCookieNamePrefix))
+	s.Equal(strings.TrimPrefix(CookieName("flow-1"), cookieNamePrefix),
+		strings.TrimPrefix(name, BrowserStateCookieNamePrefix), "must pair with the flow's SSO cookie")
+}
+
+func (s *TransportTestSuite) TestBrowserState_StableAndOpaque() {
+	state := BrowserState("handle-1")
+
+	s.Equal(state, BrowserState("handle-1"))
+	s.NotEqual(state, BrowserState("handle-2"))
+	s.NotContains(state, "handle-1")
+}
+
+func (s *TransportTestSuite) TestCookieTransport_WriteBrowserState() {
+	testCases := []struct {
+		name     string
+		secure   bool
+		sameSite http.SameSite
+	}{
+		{"Secure", true, http.SameSiteNoneMode},
+		{"Insecure", false, http.SameSiteLaxMode},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			w := httptest.NewRecorder()
+
+			NewCookieTransport(tc.secure).WriteBrowserState(w, BrowserStateCookieName("flow-1"), "opbs-1",
+				time.Hour)
+
+			cookies := w.Result().Cookies()
+			s.Require().Len(cookies, 1)
+			ck := cookies[0]
+			s.Equal("opbs-1", ck.Value)
+			s.False(ck.HttpOnly, "the check_session_iframe must be able to read it")
+			s.Equal(tc.secure, ck.Secure)
+			s.Equal(tc.sameSite, ck.SameSite)
+			s.Equal(3600, ck.MaxAge)
+		})
+	}
+}
+
+func (s *TransportTestSuite) TestCookieTransport_ClearBrowserState() {
+	w := httptest.NewRecorder()
+
+	NewCookieTransport(false).ClearBrowserState(w, BrowserStateCookieName("flow-1"))
+
+	cookies := w.Result().Cookies()
+	s.Require().Len(cookies, 1)
+	s.Equal("", cookies[0].Value)
+	s.True(cookies[0].MaxAge < 0)
+}
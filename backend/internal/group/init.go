@@ -14,6 +14,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -26,6 +27,7 @@ func Initialize(
 	entityService entity.EntityServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	observabilitySvc providers.ObservabilityProvider,
 ) (GroupServiceInterface, oupkg.OUGroupResolver, declarativeresource.ResourceExporter, error) {
 	// Step 1: Initialize store and transactioner based on store mode (no declarative loading yet).
 	store, transactioner, fileStore, dbStore, err := initializeGroupStore(dbProvider)
@@ -36,6 +38,7 @@ func Initialize(
 	// Step 2: Create service with store.
 	groupService := newGroupServiceWithStore(
 		store, ouService, entityService, entityTypeService, authzService, transactioner,
+		audit.NewRecorder(observabilitySvc),
 	)
 
 	// Step 3: Load declarative resources into file store (if applicable).
@@ -19,6 +19,7 @@ import (
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
@@ -67,6 +68,7 @@ type groupService struct {
 	transactioner      providers.Transactioner
 	authzService       sysauthz.SystemAuthorizationServiceInterface
 	dependencyRegistry resourcedependency.Registry
+	auditRecorder      *audit.Recorder
 }
 
 // newGroupServiceWithStore creates a new instance of GroupService with an externally provided store.
@@ -77,6 +79,7 @@ func newGroupServiceWithStore(
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
 	transactioner providers.Transactioner,
+	auditRecorder *audit.Recorder,
 ) GroupServiceInterface {
 	return &groupService{
 		groupStore:        store,
@@ -85,6 +88,7 @@ func newGroupServiceWithStore(
 		entityTypeService: entityTypeService,
 		authzService:      authzService,
 		transactioner:     transactioner,
+		auditRecorder:     auditRecorder,
 	}
 }
 
@@ -354,6 +358,7 @@ func (gs *groupService) CreateGroup(ctx context.Context, request CreateGroupRequ
 		logger.Error(ctx, "Failed to create group", log.Error(err), log.String("name", request.Name))
 		return nil, &tidcommon.InternalServerError
 	}
+	gs.auditRecorder.Created(ctx, audit.TargetGroup, createdGroup.ID, createdGroup)
 
 	// Resolve member types (entity → user/app) for the API response.
 	resolvedMembers, svcErr := gs.resolveMembers(ctx, createdGroup.Members, false, logger)
@@ -471,7 +476,7 @@ func (gs *groupService) UpdateGroup(
 		return nil, &ErrorImmutableGroup
 	}
 
-	var updatedGroup *Group
+	var previousGroup, updatedGroup *Group
 	var capturedSvcErr *tidcommon.ServiceError
 
 	err := gs.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -488,6 +493,10 @@ func (gs *groupService) UpdateGroup(
 		existingGroup := convertGroupDAOToGroup(existingGroupDAO)
 		updateOUID := existingGroupDAO.OUID
 
+		// Members are managed through the member endpoints and are not part of this update.
+		previousGroup = &existingGroup
+		previousGroup.Members = nil
+
 		if gs.isOrganizationUnitChanged(existingGroup, request) {
 			if err := gs.validateOU(txCtx, request.OUID); err != nil {
 				capturedSvcErr = err
@@ -556,6 +565,7 @@ func (gs *groupService) UpdateGroup(
 		logger.Error(ctx, "Failed to update group", log.Error(err), log.String("groupID", groupID))
 		return nil, &tidcommon.InternalServerError
 	}
+	gs.auditRecorder.Updated(ctx, audit.TargetGroup, groupID, previousGroup, updatedGroup)
 
 	logger.Debug(ctx, "Successfully updated group",
 		log.String("id", groupID), log.String("name", request.Name))
@@ -628,6 +638,7 @@ func (gs *groupService) DeleteGroup(ctx context.Context, groupID string) *tidcom
 		return &tidcommon.InternalServerError
 	}
 
+	gs.auditRecorder.Deleted(ctx, audit.TargetGroup, groupID, convertGroupDAOToGroup(existingGroupDAO))
 	logger.Debug(ctx, "Successfully deleted group", log.String("id", groupID))
 	return nil
 }
@@ -796,6 +807,7 @@ func (gs *groupService) AddGroupMembers(
 		Debug(ctx, "Adding members to group", log.String("id", groupID))
 	return gs.modifyGroupMembers(ctx, groupID, members,
 		gs.groupStore.AddGroupMembers,
+		"group.members.add",
 		"Failed to add members to group",
 		"Successfully added members to group",
 	)
@@ -808,19 +820,21 @@ func (gs *groupService) RemoveGroupMembers(
 		Debug(ctx, "Removing members from group", log.String("id", groupID))
 	return gs.modifyGroupMembers(ctx, groupID, members,
 		gs.groupStore.RemoveGroupMembers,
+		"group.members.remove",
 		"Failed to remove members from group",
 		"Successfully removed members from group",
 	)
 }
 
 // modifyGroupMembers is the shared implementation for AddGroupMembers and RemoveGroupMembers.
-// It validates, normalizes, and applies storeOp inside a transaction, then resolves member types.
+// It validates, normalizes, and applies storeOp inside a transaction, records auditAction, then
+// resolves member types.
 func (gs *groupService) modifyGroupMembers(
 	ctx context.Context,
 	groupID string,
 	members []Member,
 	storeOp func(context.Context, string, []Member) error,
-	errMsg, successMsg string,
+	auditAction, errMsg, successMsg string,
 ) (*Group, *tidcommon.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
 
@@ -930,6 +944,13 @@ func (gs *groupService) modifyGroupMembers(
 		logger.Error(ctx, errMsg, log.String("id", groupID), log.Error(err))
 		return nil, &tidcommon.InternalServerError
 	}
+	gs.auditRecorder.Record(ctx, audit.Entry{
+		Operation:  audit.OperationUpdate,
+		TargetType: audit.TargetGroup,
+		TargetID:   groupID,
+		Action:     auditAction,
+		After:      map[string]interface{}{"members": members},
+	})
 
 	updatedGroup := convertGroupDAOToGroup(updatedGroupDAO)
 	resolvedMembers, svcErr := gs.resolveMembers(ctx, updatedGroup.Members, false, logger)
@@ -21,6 +21,13 @@ const (
 	PropTokenExchangeEnabled  = "token_exchange_enabled"
 	PropTrustedTokenAudience  = "trusted_token_audience"
 	PropIDJagEnabled          = "id_jag_enabled"
+	PropSPEntityID            = "sp_entity_id"
+	PropIDPEntityID           = "idp_entity_id"
+	PropSSOURL                = "sso_url"
+	PropIDPCertificate        = "idp_certificate"
+	PropNameIDFormat          = "name_id_format"
+	PropSignAuthnRequests     = "sign_authn_requests"
+	PropMetadata              = "metadata"
 )
 
 // Claims and scopes shared by the OIDC-style providers. A claim is what the provider emits; a scope
@@ -149,6 +156,21 @@ var idpPropertyConfigs = map[providers.IDPType]idpPropertyConfig{
 			PropUserEmailEndpoint:     gitHubUserEmailEndpoint,
 		},
 	},
+	providers.IDPTypeSAML: {
+		Required: []string{
+			PropSPEntityID,
+			PropRedirectURI,
+			PropIDPEntityID,
+			PropSSOURL,
+			PropIDPCertificate,
+		},
+		Optional: []string{
+			PropNameIDFormat,
+			PropSignAuthnRequests,
+			PropMetadata,
+		},
+		Defaults: map[string]string{},
+	},
 }
 
 // tokenExchangeRequiredProps defines the required properties per IDP type when token exchange is enabled.
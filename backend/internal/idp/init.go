@@ -12,6 +12,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -21,6 +22,7 @@ import (
 func Initialize(
 	cacheManager cache.CacheManagerInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
+	observabilitySvc providers.ObservabilityProvider,
 ) (IDPServiceInterface, error) {
 	// Create store and transactioner based on store mode
 	idpStore, transactioner, err := initializeStore(cacheManager)
@@ -28,7 +30,7 @@ func Initialize(
 		return nil, err
 	}
 
-	idpService := newIDPService(idpStore, entityTypeService, transactioner)
+	idpService := newIDPService(idpStore, entityTypeService, transactioner, audit.NewRecorder(observabilitySvc))
 	return idpService, nil
 }
 
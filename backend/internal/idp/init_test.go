@@ -94,7 +94,7 @@ func (s *IDPInitTestSuite) TestInitialize() {
 	}
 	_ = config.InitializeServerRuntime("", testConfig)
 
-	service, err := Initialize(cache.Initialize(config.GetServerRuntime().Config.Cache, "test-deployment"), nil)
+	service, err := Initialize(cache.Initialize(config.GetServerRuntime().Config.Cache, "test-deployment"), nil, nil)
 	s.NoError(err)
 	s.NotNil(service)
 	s.Implements((*IDPServiceInterface)(nil), service)
@@ -102,7 +102,7 @@ func (s *IDPInitTestSuite) TestInitialize() {
 
 func (s *IDPInitTestSuite) TestNewIDPService() {
 	store := &idpStore{}
-	service := newIDPService(store, nil, &mockTransactioner{})
+	service := newIDPService(store, nil, &mockTransactioner{}, nil)
 
 	s.NotNil(service)
 	s.Implements((*IDPServiceInterface)(nil), service)
@@ -204,7 +204,7 @@ func (suite *IDPInitTestSuite) TestInitialize_WithDeclarativeResourcesDisabled()
 	assert.NoError(suite.T(), err)
 
 	// Execute
-	service, err := Initialize(cache.Initialize(config.GetServerRuntime().Config.Cache, "test-deployment"), nil)
+	service, err := Initialize(cache.Initialize(config.GetServerRuntime().Config.Cache, "test-deployment"), nil, nil)
 
 	// Assert
 	suite.NoError(err)
@@ -345,7 +345,7 @@ func (s *IDPInitTestSuite) TestInitialize_DBClientError() {
 		getDBProvider = originalGetDBProvider
 	}()
 
-	_, err := Initialize(cache.Initialize(config.GetServerRuntime().Config.Cache, "test-deployment"), nil)
+	_, err := Initialize(cache.Initialize(config.GetServerRuntime().Config.Cache, "test-deployment"), nil, nil)
 
 	s.Error(err)
 	s.Equal("mock db client error", err.Error())
@@ -368,7 +368,7 @@ func (s *IDPInitTestSuite) TestInitialize_TransactionerError() {
 		getDBProvider = originalGetDBProvider
 	}()
 
-	_, err := Initialize(cache.Initialize(config.GetServerRuntime().Config.Cache, "test-deployment"), nil)
+	_, err := Initialize(cache.Initialize(config.GetServerRuntime().Config.Cache, "test-deployment"), nil, nil)
 
 	s.Error(err)
 	s.Equal("mock transactioner error", err.Error())
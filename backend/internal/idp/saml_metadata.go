@@ -0,0 +1,188 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idp
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/url"
+	"strings"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/xmldsig"
+)
+
+// SAML metadata namespace and the binding used to send authentication requests to an IdP.
+const (
+	samlMetadataNamespace   = "urn:oasis:names:tc:SAML:2.0:metadata"
+	samlBindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+)
+
+// samlIDPMetadata holds the values imported from a SAML identity provider's metadata document.
+type samlIDPMetadata struct {
+	EntityID    string
+	SSOURL      string
+	Certificate string
+}
+
+// parseSAMLMetadata extracts the entity ID, the HTTP-Redirect single sign-on endpoint and the signing
+// certificate from an IdP metadata document. An EntitiesDescriptor is accepted when it describes
+// exactly one entity.
+func parseSAMLMetadata(data []byte) (*samlIDPMetadata, error) {
+	root, err := xmldsig.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if root.Is(samlMetadataNamespace, "EntitiesDescriptor") {
+		entities := root.FindChildren(samlMetadataNamespace, "EntityDescriptor")
+		if len(entities) != 1 {
+			return nil, errors.New("metadata must describe exactly one entity")
+		}
+		root = entities[0]
+	}
+	if !root.Is(samlMetadataNamespace, "EntityDescriptor") {
+		return nil, errors.New("metadata root is not an EntityDescriptor")
+	}
+
+	descriptor := root.FindChild(samlMetadataNamespace, "IDPSSODescriptor")
+	if descriptor == nil {
+		return nil, errors.New("metadata has no IDPSSODescriptor")
+	}
+
+	metadata := &samlIDPMetadata{EntityID: root.AttrValue("entityID")}
+	for _, service := range descriptor.FindChildren(samlMetadataNamespace, "SingleSignOnService") {
+		if service.AttrValue("Binding") == samlBindingHTTPRedirect {
+			metadata.SSOURL = service.AttrValue("Location")
+			break
+		}
+	}
+	for _, keyDescriptor := range descriptor.FindChildren(samlMetadataNamespace, "KeyDescriptor") {
+		if use := keyDescriptor.AttrValue("use"); use != "" && use != "signing" {
+			continue
+		}
+		certificate, err := keyDescriptorCertificate(keyDescriptor)
+		if err != nil {
+			return nil, err
+		}
+		metadata.Certificate = certificate
+		break
+	}
+
+	switch {
+	case metadata.EntityID == "":
+		return nil, errors.New("metadata has no entityID")
+	case metadata.SSOURL == "":
+		return nil, errors.New("metadata has no HTTP-Redirect SingleSignOnService")
+	case metadata.Certificate == "":
+		return nil, errors.New("metadata has no signing certificate")
+	}
+	return metadata, nil
+}
+
+// keyDescriptorCertificate returns the X.509 certificate of a KeyDescriptor, PEM-encoded.
+func keyDescriptorCertificate(keyDescriptor *xmldsig.Element) (string, error) {
+	certificateEl := keyDescriptor.FindChild(xmldsig.Namespace, "KeyInfo")
+	if certificateEl != nil {
+		certificateEl = certificateEl.FindChild(xmldsig.Namespace, "X509Data")
+	}
+	if certificateEl != nil {
+		certificateEl = certificateEl.FindChild(xmldsig.Namespace, "X509Certificate")
+	}
+	if certificateEl == nil {
+		return "", errors.New("KeyDescriptor has no X509Certificate")
+	}
+
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(certificateEl.Text()), ""))
+	if err != nil {
+		return "", errors.New("X509Certificate is not base64 encoded")
+	}
+	if _, err := x509.ParseCertificate(der); err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+// importSAMLMetadata fills the IdP entity ID, single sign-on URL and certificate from the metadata
+// property, keeping any of them that were set explicitly. The metadata document itself is not stored.
+func importSAMLMetadata(ctx context.Context, propertyMap map[string]cmodels.Property,
+	logger *log.Logger) *tidcommon.ServiceError {
+	metadataProp, exists := propertyMap[PropMetadata]
+	if !exists {
+		return nil
+	}
+	delete(propertyMap, PropMetadata)
+
+	document, err := metadataProp.GetValue()
+	if err != nil {
+		return tidcommon.CustomServiceError(ErrorInvalidIDPProperty, tidcommon.I18nMessage{
+			Key:          "error.idpservice.property_value_get_failed_description",
+			DefaultValue: "failed to get value for property '{{param(property)}}': {{param(error)}}",
+			Params:       map[string]string{"property": PropMetadata, "error": err.Error()},
+		})
+	}
+	metadata, err := parseSAMLMetadata([]byte(document))
+	if err != nil {
+		return tidcommon.CustomServiceError(ErrorInvalidIDPProperty, tidcommon.I18nMessage{
+			Key:          "error.idpservice.saml_metadata_invalid_description",
+			DefaultValue: "SAML metadata is invalid: {{param(error)}}",
+			Params:       map[string]string{"error": err.Error()},
+		})
+	}
+
+	imported := map[string]string{
+		PropIDPEntityID:    metadata.EntityID,
+		PropSSOURL:         metadata.SSOURL,
+		PropIDPCertificate: metadata.Certificate,
+	}
+	for name, value := range imported {
+		if _, exists := propertyMap[name]; exists {
+			continue
+		}
+		if err := createAndAppendProperty(ctx, propertyMap, name, value, false, logger); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSAMLProperties checks that the single sign-on URL is an absolute HTTP(S) URL and that the
+// IdP certificate is a PEM-encoded X.509 certificate.
+func validateSAMLProperties(propertyMap map[string]cmodels.Property) *tidcommon.ServiceError {
+	ssoURLProp := propertyMap[PropSSOURL]
+	ssoURL, _ := ssoURLProp.GetValue()
+	parsedURL, err := url.Parse(ssoURL)
+	if err != nil || (parsedURL.Scheme != "https" && parsedURL.Scheme != "http") || parsedURL.Host == "" {
+		return tidcommon.CustomServiceError(ErrorInvalidIDPProperty, tidcommon.I18nMessage{
+			Key:          "error.idpservice.saml_sso_url_invalid_description",
+			DefaultValue: "value for property '{{param(property)}}' must be an absolute HTTP(S) URL",
+			Params:       map[string]string{"property": PropSSOURL},
+		})
+	}
+
+	certificateProp := propertyMap[PropIDPCertificate]
+	certificatePEM, _ := certificateProp.GetValue()
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return invalidSAMLCertificateError()
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return invalidSAMLCertificateError()
+	}
+	return nil
+}
+
+// invalidSAMLCertificateError reports an IdP certificate that is not a PEM-encoded X.509 certificate.
+func invalidSAMLCertificateError() *tidcommon.ServiceError {
+	return tidcommon.CustomServiceError(ErrorInvalidIDPProperty, tidcommon.I18nMessage{
+		Key:          "error.idpservice.saml_certificate_invalid_description",
+		DefaultValue: "value for property '{{param(property)}}' must be a PEM-encoded X.509 certificate",
+		Params:       map[string]string{"property": PropIDPCertificate},
+	})
+}
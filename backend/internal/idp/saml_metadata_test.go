@@ -0,0 +1,197 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const (
+	testSAMLIDPEntityID = "https://idp.example.com/saml"
+	testSAMLSSOURL      = "https://idp.example.com/saml/sso"
+)
+
+type SAMLMetadataTestSuite struct {
+	suite.Suite
+	logger  *log.Logger
+	certDER []byte
+}
+
+func TestSAMLMetadataTestSuite(t *testing.T) {
+	suite.Run(t, new(SAMLMetadataTestSuite))
+}
+
+func (s *SAMLMetadataTestSuite) SetupTest() {
+	s.logger = log.GetLogger()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	s.certDER, err = x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	s.Require().NoError(err)
+}
+
+func (s *SAMLMetadataTestSuite) certPEM() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.certDER}))
+}
+
+func (s *SAMLMetadataTestSuite) metadataXML() string {
+	return `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" ` +
+		`xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="` + testSAMLIDPEntityID + `">` +
+		`<md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">` +
+		`<md:KeyDescriptor use="encryption"><ds:KeyInfo><ds:X509Data><ds:X509Certificate>bm90IGEgY2VydA==` +
+		`</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>` +
+		`<md:KeyDescriptor use="signing"><ds:KeyInfo><ds:X509Data><ds:X509Certificate>` +
+		base64.StdEncoding.EncodeToString(s.certDER) +
+		`</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>` +
+		`<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" ` +
+		`Location="https://idp.example.com/saml/post"/>` +
+		`<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" ` +
+		`Location="` + testSAMLSSOURL + `"/>` +
+		`</md:IDPSSODescriptor></md:EntityDescriptor>`
+}
+
+func (s *SAMLMetadataTestSuite) baseProperties(extra ...cmodels.Property) []cmodels.Property {
+	spEntityID, _ := cmodels.NewProperty(PropSPEntityID, "https://thunder.example.com/sp", false)
+	redirectURI, _ := cmodels.NewProperty(PropRedirectURI, "https://thunder.example.com/callback", false)
+	return append([]cmodels.Property{*spEntityID, *redirectURI}, extra...)
+}
+
+func (s *SAMLMetadataTestSuite) TestParseSAMLMetadata() {
+	metadata, err := parseSAMLMetadata([]byte(s.metadataXML()))
+
+	s.Require().NoError(err)
+	s.Equal(testSAMLIDPEntityID, metadata.EntityID)
+	s.Equal(testSAMLSSOURL, metadata.SSOURL)
+	s.Equal(s.certPEM(), metadata.Certificate)
+}
+
+func (s *SAMLMetadataTestSuite) TestParseSAMLMetadata_SingleEntityInEntitiesDescriptor() {
+	document := `<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata">` +
+		s.metadataXML() + `</md:EntitiesDescriptor>`
+
+	metadata, err := parseSAMLMetadata([]byte(document))
+
+	s.Require().NoError(err)
+	s.Equal(testSAMLIDPEntityID, metadata.EntityID)
+}
+
+func (s *SAMLMetadataTestSuite) TestParseSAMLMetadata_Invalid() {
+	testCases := map[string]string{
+		"NotXML":    "not xml",
+		"WrongRoot": `<md:SPSSODescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata"/>`,
+		"NoIDPSSO":  `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="x"/>`,
+		"MultipleEntities": `<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata">` +
+			s.metadataXML() + s.metadataXML() + `</md:EntitiesDescriptor>`,
+		"NoRedirectBinding": `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="x">` +
+			`<md:IDPSSODescriptor/></md:EntityDescriptor>`,
+	}
+	for name, document := range testCases {
+		s.Run(name, func() {
+			_, err := parseSAMLMetadata([]byte(document))
+			s.Error(err)
+		})
+	}
+}
+
+func (s *SAMLMetadataTestSuite) TestValidateIDPProperties_SAML_ImportsMetadata() {
+	metadata, _ := cmodels.NewProperty(PropMetadata, s.metadataXML(), false)
+
+	result, err := validateIDPProperties(context.Background(), providers.IDPTypeSAML,
+		s.baseProperties(*metadata), s.logger)
+
+	s.Require().Nil(err)
+	s.Equal(testSAMLIDPEntityID, GetPropertyValue(result, PropIDPEntityID))
+	s.Equal(testSAMLSSOURL, GetPropertyValue(result, PropSSOURL))
+	s.Equal(s.certPEM(), GetPropertyValue(result, PropIDPCertificate))
+	s.Empty(GetPropertyValue(result, PropMetadata))
+}
+
+func (s *SAMLMetadataTestSuite) TestValidateIDPProperties_SAML_ExplicitValuesWinOverMetadata() {
+	metadata, _ := cmodels.NewProperty(PropMetadata, s.metadataXML(), false)
+	ssoURL, _ := cmodels.NewProperty(PropSSOURL, "https://idp.example.com/custom", false)
+
+	result, err := validateIDPProperties(context.Background(), providers.IDPTypeSAML,
+		s.baseProperties(*metadata, *ssoURL), s.logger)
+
+	s.Require().Nil(err)
+	s.Equal("https://idp.example.com/custom", GetPropertyValue(result, PropSSOURL))
+}
+
+func (s *SAMLMetadataTestSuite) TestValidateIDPProperties_SAML_InvalidMetadata() {
+	metadata, _ := cmodels.NewProperty(PropMetadata, "<broken", false)
+
+	result, err := validateIDPProperties(context.Background(), providers.IDPTypeSAML,
+		s.baseProperties(*metadata), s.logger)
+
+	s.Nil(result)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidIDPProperty.Code, err.Code)
+	s.Equal("error.idpservice.saml_metadata_invalid_description", err.ErrorDescription.Key)
+}
+
+func (s *SAMLMetadataTestSuite) TestValidateIDPProperties_SAML_MissingRequired() {
+	result, err := validateIDPProperties(context.Background(), providers.IDPTypeSAML, s.baseProperties(), s.logger)
+
+	s.Nil(result)
+	s.Require().NotNil(err)
+	s.Equal("error.idpservice.required_property_missing_description", err.ErrorDescription.Key)
+}
+
+func (s *SAMLMetadataTestSuite) TestValidateIDPProperties_SAML_InvalidValues() {
+	entityID, _ := cmodels.NewProperty(PropIDPEntityID, testSAMLIDPEntityID, false)
+	validURL, _ := cmodels.NewProperty(PropSSOURL, testSAMLSSOURL, false)
+	invalidURL, _ := cmodels.NewProperty(PropSSOURL, "/relative", false)
+	validCert, _ := cmodels.NewProperty(PropIDPCertificate, s.certPEM(), false)
+	invalidCert, _ := cmodels.NewProperty(PropIDPCertificate, "not a certificate", false)
+	invalidBool, _ := cmodels.NewProperty(PropSignAuthnRequests, "yes", false)
+
+	testCases := map[string]struct {
+		properties []cmodels.Property
+		key        string
+	}{
+		"RelativeSSOURL": {
+			s.baseProperties(*entityID, *invalidURL, *validCert),
+			"error.idpservice.saml_sso_url_invalid_description",
+		},
+		"InvalidCertificate": {
+			s.baseProperties(*entityID, *validURL, *invalidCert),
+			"error.idpservice.saml_certificate_invalid_description",
+		},
+		"NonBooleanSignFlag": {
+			s.baseProperties(*entityID, *validURL, *validCert, *invalidBool),
+			"error.idpservice.property_value_not_boolean_description",
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			result, err := validateIDPProperties(context.Background(), providers.IDPTypeSAML, tc.properties,
+				s.logger)
+			s.Nil(result)
+			s.Require().NotNil(err)
+			s.Equal(tc.key, err.ErrorDescription.Key)
+		})
+	}
+}
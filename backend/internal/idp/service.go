@@ -17,6 +17,7 @@ import (
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -49,6 +50,7 @@ type idpService struct {
 	dependencyRegistry resourcedependency.Registry
 	logger             *log.Logger
 	uuidGenerator      func() (string, error)
+	auditRecorder      *audit.Recorder
 }
 
 // userTypeAttributes holds a user type's non-credential schema attributes.
@@ -73,13 +75,14 @@ func (u userTypeAttributes) isRequired(attr string) bool {
 
 // newIDPService creates a new instance of IdPService.
 func newIDPService(idpStore idpStoreInterface, entityTypeService entitytype.EntityTypeServiceInterface,
-	transactioner providers.Transactioner) IDPServiceInterface {
+	transactioner providers.Transactioner, auditRecorder *audit.Recorder) IDPServiceInterface {
 	return &idpService{
 		idpStore:          idpStore,
 		entityTypeService: entityTypeService,
 		transactioner:     transactioner,
 		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "IdPService")),
 		uuidGenerator:     utils.GenerateUUIDv7,
+		auditRecorder:     auditRecorder,
 	}
 }
 
@@ -142,6 +145,7 @@ func (is *idpService) CreateIdentityProvider(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	is.auditRecorder.Created(ctx, audit.TargetIdentityProvider, idp.ID, auditSnapshot(idp))
 	return idp, nil
 }
 
@@ -253,6 +257,7 @@ func (is *idpService) UpdateIdentityProvider(
 	}
 
 	idp.ID = idpID
+	var previousIDP *providers.IDPDTO
 	var svcErr *tidcommon.ServiceError
 	err := is.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		// Check if the identity provider exists
@@ -264,6 +269,7 @@ func (is *idpService) UpdateIdentityProvider(
 			}
 			return err
 		}
+		previousIDP = existingIDP
 
 		// If the name is being updated, check whether another IdP with the same name exists
 		if existingIDP.Name != idp.Name {
@@ -297,6 +303,7 @@ func (is *idpService) UpdateIdentityProvider(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	is.auditRecorder.Updated(ctx, audit.TargetIdentityProvider, idpID, auditSnapshot(previousIDP), auditSnapshot(idp))
 	return idp, nil
 }
 
@@ -318,16 +325,18 @@ func (is *idpService) DeleteIdentityProvider(ctx context.Context, idpID string)
 		return svcErr
 	}
 
+	var deletedIDP *providers.IDPDTO
 	var svcErr *tidcommon.ServiceError
 	err := is.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		// Check if the identity provider exists
-		_, err := is.idpStore.GetIdentityProvider(txCtx, idpID)
+		existingIDP, err := is.idpStore.GetIdentityProvider(txCtx, idpID)
 		if err != nil {
 			if errors.Is(err, ErrIDPNotFound) {
 				return nil
 			}
 			return err
 		}
+		deletedIDP = existingIDP
 
 		err = is.idpStore.DeleteIdentityProvider(txCtx, idpID)
 		if err != nil {
@@ -349,6 +358,9 @@ func (is *idpService) DeleteIdentityProvider(ctx context.Context, idpID string)
 		return &tidcommon.InternalServerError
 	}
 
+	if deletedIDP != nil {
+		is.auditRecorder.Deleted(ctx, audit.TargetIdentityProvider, idpID, auditSnapshot(deletedIDP))
+	}
 	return nil
 }
 
@@ -282,7 +282,6 @@ func validateIDPProperties(ctx context.Context, idpType providers.IDPType, prope
 
 	// Filter and validate provided properties
 	filteredPropsMap := make(map[string]cmodels.Property)
-	filteredPropKeys := []string{}
 	for _, prop := range properties {
 		propName := prop.GetName()
 		if strings.TrimSpace(propName) == "" {
@@ -314,7 +313,8 @@ func validateIDPProperties(ctx context.Context, idpType providers.IDPType, prope
 				Params:       map[string]string{"property": propName},
 			})
 		}
-		if propName == PropIDJagEnabled || propName == PropTokenExchangeEnabled {
+		if propName == PropIDJagEnabled || propName == PropTokenExchangeEnabled ||
+			propName == PropSignAuthnRequests {
 			if propertyValue != "true" && propertyValue != "false" {
 				return nil, tidcommon.CustomServiceError(ErrorInvalidIDPProperty, tidcommon.I18nMessage{
 					Key: "error.idpservice.property_value_not_boolean_description",
@@ -326,7 +326,13 @@ func validateIDPProperties(ctx context.Context, idpType providers.IDPType, prope
 		}
 
 		filteredPropsMap[propName] = prop
-		filteredPropKeys = append(filteredPropKeys, propName)
+	}
+
+	// Fill the SAML endpoint, entity ID and certificate from imported metadata, if supplied.
+	if idpType == providers.IDPTypeSAML {
+		if err := importSAMLMetadata(ctx, filteredPropsMap, logger); err != nil {
+			return nil, err
+		}
 	}
 
 	// Check for required properties, using the token-exchange override when applicable.
@@ -346,7 +352,7 @@ func validateIDPProperties(ctx context.Context, idpType providers.IDPType, prope
 		}
 	}
 	for _, requiredProp := range requiredProps {
-		if !slices.Contains(filteredPropKeys, requiredProp) {
+		if _, exists := filteredPropsMap[requiredProp]; !exists {
 			return nil, tidcommon.CustomServiceError(ErrorInvalidIDPProperty, tidcommon.I18nMessage{
 				Key: "error.idpservice.required_property_missing_description",
 				DefaultValue: "required property '{{param(property)}}' is missing " +
@@ -381,6 +387,12 @@ func validateIDPProperties(ctx context.Context, idpType providers.IDPType, prope
 		}
 	}
 
+	if idpType == providers.IDPTypeSAML {
+		if err := validateSAMLProperties(filteredPropsMap); err != nil {
+			return nil, err
+		}
+	}
+
 	return propertyMapToSlice(filteredPropsMap), nil
 }
 
@@ -523,3 +535,32 @@ func propertyMapToSlice(propertyMap map[string]cmodels.Property) []cmodels.Prope
 	}
 	return properties
 }
+
+// auditSnapshot returns the audit representation of an identity provider. Properties are exposed
+// with their plain-text values so changes are detected; the audit recorder redacts the values of
+// properties flagged as secret.
+func auditSnapshot(idp *providers.IDPDTO) map[string]interface{} {
+	if idp == nil {
+		return nil
+	}
+	properties := make([]cmodels.PropertyDTO, 0, len(idp.Properties))
+	for _, property := range idp.Properties {
+		value, err := property.GetValue()
+		if err != nil {
+			value = ""
+		}
+		properties = append(properties, cmodels.PropertyDTO{
+			Name:     property.GetName(),
+			Value:    value,
+			IsSecret: property.IsSecret(),
+		})
+	}
+	return map[string]interface{}{
+		"id":                     idp.ID,
+		"name":                   idp.Name,
+		"description":            idp.Description,
+		"type":                   idp.Type,
+		"properties":             properties,
+		"attributeConfiguration": idp.AttributeConfiguration,
+	}
+}
@@ -668,6 +668,148 @@ func (_c *InboundClientServiceInterfaceMock_GetOAuthProfileByEntityID_Call) RunA
 	return _c
 }
 
+// GetSAMLProfileByEntityID provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (*providers.SAMLProfile, error) {
+	ret := _mock.Called(ctx, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSAMLProfileByEntityID")
+	}
+
+	var r0 *providers.SAMLProfile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*providers.SAMLProfile, error)); ok {
+		return returnFunc(ctx, entityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *providers.SAMLProfile); ok {
+		r0 = returnFunc(ctx, entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*providers.SAMLProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, entityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSAMLProfileByEntityID'
+type InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call struct {
+	*mock.Call
+}
+
+// GetSAMLProfileByEntityID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+func (_e *InboundClientServiceInterfaceMock_Expecter) GetSAMLProfileByEntityID(ctx interface{}, entityID interface{}) *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call {
+	return &InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call{Call: _e.mock.On("GetSAMLProfileByEntityID", ctx, entityID)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call) Run(run func(ctx context.Context, entityID string)) *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call) Return(sAMLProfile *providers.SAMLProfile, err error) *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call {
+	_c.Call.Return(sAMLProfile, err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call) RunAndReturn(run func(ctx context.Context, entityID string) (*providers.SAMLProfile, error)) *InboundClientServiceInterfaceMock_GetSAMLProfileByEntityID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSAMLProfileBySPEntityID provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (string, *providers.SAMLProfile, error) {
+	ret := _mock.Called(ctx, spEntityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSAMLProfileBySPEntityID")
+	}
+
+	var r0 string
+	var r1 *providers.SAMLProfile
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, *providers.SAMLProfile, error)); ok {
+		return returnFunc(ctx, spEntityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, spEntityID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *providers.SAMLProfile); ok {
+		r1 = returnFunc(ctx, spEntityID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*providers.SAMLProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, spEntityID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// InboundClientServiceInterfaceMock_GetSAMLProfileBySPEntityID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSAMLProfileBySPEntityID'
+type InboundClientServiceInterfaceMock_GetSAMLProfileBySPEntityID_Call struct {
+	*mock.Call
+}
+
+// GetSAMLProfileBySPEntityID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spEntityID string
+func (_e *InboundClientServiceInterfaceMock_Expecter) GetSAMLProfileBySPEntityID(ctx interface{}, spEntityID interface{}) *InboundClientServiceInterfaceMock_GetSAMLProfileBySPEntityID_Call {
+	return &InboundClientServiceInterfaceMock_GetSAMLProfileBySPEntityID_Call{Call: _e.mock.On("GetSAMLProfileBySPEntityID", ctx, spEntityID)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLProfileBySPEntityID_Call) Run(run func(ctx context.Context, spEntityID string)) *InboundClientServiceInterfaceMock_GetSAMLProfileBySPEntityID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLProfileBySPEntityID_Call) Return(s string, sAMLProfile *providers.SAMLProfile, err error) *InboundClientServiceInterfaceMock_GetSAMLProfileBySPEntityID_Call {
+	_c.Call.Return(s, sAMLProfile, err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetSAMLProfileBySPEntityID_Call) RunAndReturn(run func(ctx context.Context, spEntityID string) (string, *providers.SAMLProfile, error)) *InboundClientServiceInterfaceMock_GetSAMLProfileBySPEntityID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsDeclarative provides a mock function for the type InboundClientServiceInterfaceMock
 func (_mock *InboundClientServiceInterfaceMock) IsDeclarative(ctx context.Context, entityID string) bool {
 	ret := _mock.Called(ctx, entityID)
@@ -958,6 +1100,69 @@ func (_c *InboundClientServiceInterfaceMock_RevalidateFKs_Call) RunAndReturn(run
 	return _c
 }
 
+// SyncSAMLProfile provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) SyncSAMLProfile(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error {
+	ret := _mock.Called(ctx, entityID, samlProfile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncSAMLProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *providers.SAMLProfile) error); ok {
+		r0 = returnFunc(ctx, entityID, samlProfile)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// InboundClientServiceInterfaceMock_SyncSAMLProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncSAMLProfile'
+type InboundClientServiceInterfaceMock_SyncSAMLProfile_Call struct {
+	*mock.Call
+}
+
+// SyncSAMLProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - samlProfile *providers.SAMLProfile
+func (_e *InboundClientServiceInterfaceMock_Expecter) SyncSAMLProfile(ctx interface{}, entityID interface{}, samlProfile interface{}) *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call {
+	return &InboundClientServiceInterfaceMock_SyncSAMLProfile_Call{Call: _e.mock.On("SyncSAMLProfile", ctx, entityID, samlProfile)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call) Run(run func(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile)) *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *providers.SAMLProfile
+		if args[2] != nil {
+			arg2 = args[2].(*providers.SAMLProfile)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call) Return(err error) *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call) RunAndReturn(run func(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error) *InboundClientServiceInterfaceMock_SyncSAMLProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateInboundClient provides a mock function for the type InboundClientServiceInterfaceMock
 func (_mock *InboundClientServiceInterfaceMock) UpdateInboundClient(ctx context.Context, client *model.InboundClient, oauthProfile *providers.OAuthProfile, hasClientSecret bool, oauthClientID string) error {
 	ret := _mock.Called(ctx, client, oauthProfile, hasClientSecret, oauthClientID)
@@ -1101,3 +1306,66 @@ func (_c *InboundClientServiceInterfaceMock_Validate_Call) RunAndReturn(run func
 	_c.Call.Return(run)
 	return _c
 }
+
+// ValidateSAMLProfile provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) ValidateSAMLProfile(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error {
+	ret := _mock.Called(ctx, entityID, samlProfile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateSAMLProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *providers.SAMLProfile) error); ok {
+		r0 = returnFunc(ctx, entityID, samlProfile)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// InboundClientServiceInterfaceMock_ValidateSAMLProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateSAMLProfile'
+type InboundClientServiceInterfaceMock_ValidateSAMLProfile_Call struct {
+	*mock.Call
+}
+
+// ValidateSAMLProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - samlProfile *providers.SAMLProfile
+func (_e *InboundClientServiceInterfaceMock_Expecter) ValidateSAMLProfile(ctx interface{}, entityID interface{}, samlProfile interface{}) *InboundClientServiceInterfaceMock_ValidateSAMLProfile_Call {
+	return &InboundClientServiceInterfaceMock_ValidateSAMLProfile_Call{Call: _e.mock.On("ValidateSAMLProfile", ctx, entityID, samlProfile)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_ValidateSAMLProfile_Call) Run(run func(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile)) *InboundClientServiceInterfaceMock_ValidateSAMLProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *providers.SAMLProfile
+		if args[2] != nil {
+			arg2 = args[2].(*providers.SAMLProfile)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_ValidateSAMLProfile_Call) Return(err error) *InboundClientServiceInterfaceMock_ValidateSAMLProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_ValidateSAMLProfile_Call) RunAndReturn(run func(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error) *InboundClientServiceInterfaceMock_ValidateSAMLProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -128,6 +128,32 @@ func (c *cachedBackStore) InboundClientExists(ctx context.Context, entityID stri
 	return c.inner.InboundClientExists(ctx, entityID)
 }
 
+// SAML profiles are read once per SSO request and are not cached; calls pass straight through.
+
+func (c *cachedBackStore) CreateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *providers.SAMLProfile) error {
+	return c.inner.CreateSAMLProfile(ctx, entityID, samlProfile)
+}
+
+func (c *cachedBackStore) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (
+	*providers.SAMLProfile, error) {
+	return c.inner.GetSAMLProfileByEntityID(ctx, entityID)
+}
+
+func (c *cachedBackStore) GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (
+	string, *providers.SAMLProfile, error) {
+	return c.inner.GetSAMLProfileBySPEntityID(ctx, spEntityID)
+}
+
+func (c *cachedBackStore) UpdateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *providers.SAMLProfile) error {
+	return c.inner.UpdateSAMLProfile(ctx, entityID, samlProfile)
+}
+
+func (c *cachedBackStore) DeleteSAMLProfile(ctx context.Context, entityID string) error {
+	return c.inner.DeleteSAMLProfile(ctx, entityID)
+}
+
 func (c *cachedBackStore) IsDeclarative(ctx context.Context, entityID string) bool {
 	return c.inner.IsDeclarative(ctx, entityID)
 }
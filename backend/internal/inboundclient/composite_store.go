@@ -157,6 +157,57 @@ func (c *compositeStore) InboundClientExists(ctx context.Context, entityID strin
 	)
 }
 
+func (c *compositeStore) CreateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *providers.SAMLProfile) error {
+	return c.dbStore.CreateSAMLProfile(ctx, entityID, samlProfile)
+}
+
+func (c *compositeStore) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (
+	*providers.SAMLProfile, error) {
+	return declarativeresource.CompositeGetHelper(
+		func() (*providers.SAMLProfile, error) { return c.dbStore.GetSAMLProfileByEntityID(ctx, entityID) },
+		func() (*providers.SAMLProfile, error) {
+			return c.fileStore.GetSAMLProfileByEntityID(ctx, entityID)
+		},
+		ErrInboundClientNotFound,
+	)
+}
+
+// samlProfileLookup pairs a SAML profile with its owning entity ID so that the issuer lookup can
+// reuse the generic composite get helper.
+type samlProfileLookup struct {
+	entityID string
+	profile  *providers.SAMLProfile
+}
+
+func (c *compositeStore) GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (
+	string, *providers.SAMLProfile, error) {
+	lookup := func(s inboundClientStoreInterface) func() (*samlProfileLookup, error) {
+		return func() (*samlProfileLookup, error) {
+			entityID, profile, err := s.GetSAMLProfileBySPEntityID(ctx, spEntityID)
+			if err != nil {
+				return nil, err
+			}
+			return &samlProfileLookup{entityID: entityID, profile: profile}, nil
+		}
+	}
+	result, err := declarativeresource.CompositeGetHelper(
+		lookup(c.dbStore), lookup(c.fileStore), ErrInboundClientNotFound)
+	if err != nil {
+		return "", nil, err
+	}
+	return result.entityID, result.profile, nil
+}
+
+func (c *compositeStore) UpdateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *providers.SAMLProfile) error {
+	return c.dbStore.UpdateSAMLProfile(ctx, entityID, samlProfile)
+}
+
+func (c *compositeStore) DeleteSAMLProfile(ctx context.Context, entityID string) error {
+	return c.dbStore.DeleteSAMLProfile(ctx, entityID)
+}
+
 func (c *compositeStore) IsDeclarative(ctx context.Context, entityID string) bool {
 	return declarativeresource.CompositeIsDeclarativeHelper(
 		entityID,
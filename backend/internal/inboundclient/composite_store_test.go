@@ -412,3 +412,48 @@ func (suite *CompositeStoreTestSuite) TestGetEntityIDsByThemeID_FileHitsCap() {
 	suite.Nil(ids)
 	suite.Equal(0, total)
 }
+
+// GetSAMLProfileBySPEntityID — falls back to file store when the DB has no match.
+func (suite *CompositeStoreTestSuite) TestGetSAMLProfileBySPEntityID_FallsBackToFile() {
+	ctx := context.Background()
+	suite.dbMock.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+		Return("", nil, ErrInboundClientNotFound)
+
+	suite.Require().NoError(suite.fileStore.CreateInboundClient(ctx, inboundmodel.InboundClient{
+		ID: "f1",
+		Properties: map[string]interface{}{
+			PropSAMLProfile: providers.SAMLProfile{EntityID: "https://sp.example.com"},
+		},
+	}))
+
+	entityID, got, err := suite.composite.GetSAMLProfileBySPEntityID(ctx, "https://sp.example.com")
+	suite.NoError(err)
+	suite.Equal("f1", entityID)
+	suite.Equal("https://sp.example.com", got.EntityID)
+}
+
+// GetSAMLProfileBySPEntityID — DB match wins.
+func (suite *CompositeStoreTestSuite) TestGetSAMLProfileBySPEntityID_FromDB() {
+	ctx := context.Background()
+	want := &providers.SAMLProfile{EntityID: "https://sp.example.com"}
+	suite.dbMock.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+		Return("db1", want, nil)
+
+	entityID, got, err := suite.composite.GetSAMLProfileBySPEntityID(ctx, "https://sp.example.com")
+	suite.NoError(err)
+	suite.Equal("db1", entityID)
+	suite.Equal(want, got)
+}
+
+// SAML profile writes delegate to the DB store.
+func (suite *CompositeStoreTestSuite) TestSAMLProfileWrites_DelegateToDB() {
+	ctx := context.Background()
+	profile := &providers.SAMLProfile{EntityID: "https://sp.example.com"}
+	suite.dbMock.EXPECT().CreateSAMLProfile(mock.Anything, "db1", profile).Return(nil)
+	suite.dbMock.EXPECT().UpdateSAMLProfile(mock.Anything, "db1", profile).Return(nil)
+	suite.dbMock.EXPECT().DeleteSAMLProfile(mock.Anything, "db1").Return(nil)
+
+	suite.NoError(suite.composite.CreateSAMLProfile(ctx, "db1", profile))
+	suite.NoError(suite.composite.UpdateSAMLProfile(ctx, "db1", profile))
+	suite.NoError(suite.composite.DeleteSAMLProfile(ctx, "db1"))
+}
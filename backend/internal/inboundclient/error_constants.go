@@ -68,6 +68,12 @@ var (
 	ErrOAuthRedirectURIFragmentNotAllowed = errors.New("redirect URI must not contain a fragment")
 	// ErrOAuthAuthCodeRequiresRedirectURIs is returned when authorization_code grant has no redirect URIs.
 	ErrOAuthAuthCodeRequiresRedirectURIs = errors.New("authorization_code grant requires redirect URIs")
+	// ErrOAuthInvalidBackchannelLogoutURI is returned when the back-channel logout URI is not an SSRF-safe
+	// https URL without a fragment.
+	ErrOAuthInvalidBackchannelLogoutURI = errors.New("invalid back-channel logout URI")
+	// ErrOAuthInvalidFrontchannelLogoutURI is returned when the front-channel logout URI is not an
+	// absolute http(s) URL without a fragment on the origin of a registered redirect URI.
+	ErrOAuthInvalidFrontchannelLogoutURI = errors.New("invalid front-channel logout URI")
 	// ErrOAuthInvalidGrantType is returned when an unsupported grant type is specified.
 	ErrOAuthInvalidGrantType = errors.New("invalid grant type")
 	// ErrOAuthInvalidResponseType is returned when an unsupported response type is specified.
@@ -94,6 +100,17 @@ var (
 	ErrOAuthCertificateRequiresClientID = errors.New("certificate requires an OAuth client ID")
 	// ErrOAuthPrivateKeyJWTCannotHaveClientSecret is returned when private_key_jwt is used with a client secret.
 	ErrOAuthPrivateKeyJWTCannotHaveClientSecret = errors.New("private_key_jwt cannot have a client secret")
+	// ErrOAuthTLSClientAuthRequiresSubject is returned when tls_client_auth is used without exactly one
+	// certificate subject identifier.
+	ErrOAuthTLSClientAuthRequiresSubject = errors.New(
+		"tls_client_auth requires exactly one certificate subject identifier")
+	// ErrOAuthSelfSignedTLSClientAuthRequiresCertificate is returned when self_signed_tls_client_auth is used
+	// without an inline JWKS certificate.
+	ErrOAuthSelfSignedTLSClientAuthRequiresCertificate = errors.New(
+		"self_signed_tls_client_auth requires an inline JWKS certificate")
+	// ErrOAuthMTLSAuthCannotHaveClientSecret is returned when a mutual-TLS auth method is used with a client
+	// secret.
+	ErrOAuthMTLSAuthCannotHaveClientSecret = errors.New("mutual-TLS auth methods cannot have a client secret")
 	// ErrOAuthNoneAuthRequiresPublicClient is returned when none auth method is used without a public client.
 	ErrOAuthNoneAuthRequiresPublicClient = errors.New("none auth method requires a public client")
 	// ErrOAuthNoneAuthCannotHaveSecret is returned when none auth method is used with a client secret.
@@ -106,6 +123,28 @@ var (
 	// ErrOAuthClientIDJAGCannotUseNoneAuth is returned when an ID-JAG configuration uses none auth method.
 	// Requesting ID-JAGs requires a confidential client.
 	ErrOAuthClientIDJAGCannotUseNoneAuth = errors.New("ID-JAG configuration cannot use none auth method")
+	// ErrOAuthInvalidSecurityProfile is returned when an unsupported security profile is specified.
+	ErrOAuthInvalidSecurityProfile = errors.New("invalid security profile")
+	// ErrOAuthFAPI2RequiresStrongClientAuth is returned when a FAPI 2.0 client uses an auth method other
+	// than private_key_jwt or mutual-TLS.
+	ErrOAuthFAPI2RequiresStrongClientAuth = errors.New(
+		"FAPI 2.0 security profile requires private_key_jwt or mutual-TLS client authentication")
+	// ErrOAuthFAPI2RequiresCodeResponseType is returned when a FAPI 2.0 client registers a response type
+	// other than code.
+	ErrOAuthFAPI2RequiresCodeResponseType = errors.New("FAPI 2.0 security profile only allows the code response type")
+	// ErrOAuthInvalidSubjectType is returned when an unsupported subject identifier type is specified.
+	ErrOAuthInvalidSubjectType = errors.New("invalid subject type")
+	// ErrOAuthInvalidSectorIdentifierURI is returned when the sector identifier URI is not an absolute https
+	// URL, or when it cannot be retrieved as a JSON array of redirect URIs.
+	ErrOAuthInvalidSectorIdentifierURI = errors.New("invalid sector identifier URI")
+	// ErrOAuthSectorIdentifierURIMissingRedirectURI is returned when a registered redirect URI is not listed
+	// in the document at the sector identifier URI.
+	ErrOAuthSectorIdentifierURIMissingRedirectURI = errors.New(
+		"sector identifier URI does not list all redirect URIs")
+	// ErrOAuthPairwiseRequiresSectorIdentifierURI is returned when a pairwise client registers redirect URIs
+	// on more than one host without a sector identifier URI.
+	ErrOAuthPairwiseRequiresSectorIdentifierURI = errors.New(
+		"pairwise subject type requires a sector identifier URI for redirect URIs on multiple hosts")
 	// ErrOAuthPublicClientMustUseNoneAuth is returned when a public client uses an auth method other than none.
 	ErrOAuthPublicClientMustUseNoneAuth = errors.New("public client must use none auth method")
 	// ErrOAuthPublicClientMustHavePKCE is returned when a public client does not have PKCE required.
@@ -148,6 +187,34 @@ var (
 	ErrOAuthUserInfoAlgRequiresResponseType = errors.New(
 		"userinfo responseType is required when signingAlg or encryptionAlg is set")
 
+	// ErrOAuthAuthorizationResponseUnsupportedSigningAlg is returned when the JARM signing algorithm is
+	// not supported.
+	ErrOAuthAuthorizationResponseUnsupportedSigningAlg = errors.New(
+		"unsupported authorization response signing algorithm")
+	// ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg is returned when the JARM encryption algorithm
+	// is not supported.
+	ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg = errors.New(
+		"unsupported authorization response encryption algorithm")
+	// ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc is returned when the JARM content-encryption
+	// algorithm is not supported.
+	ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc = errors.New(
+		"unsupported authorization response content-encryption algorithm")
+	// ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc is returned when encryptionAlg is set without
+	// encryptionEnc.
+	ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc = errors.New(
+		"authorization response encryptionEnc is required when encryptionAlg is set")
+	// ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg is returned when encryptionEnc is set without
+	// encryptionAlg.
+	ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg = errors.New(
+		"authorization response encryptionAlg is required when encryptionEnc is set")
+	// ErrOAuthAuthorizationResponseEncryptionRequiresCertificate is returned when authorization response
+	// encryption has no certificate.
+	ErrOAuthAuthorizationResponseEncryptionRequiresCertificate = errors.New(
+		"authorization response encryption requires a certificate (JWKS or JWKS_URI)")
+	// ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe is returned when the JWKS URI fails SSRF safety checks.
+	ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe = errors.New(
+		"authorization response JWKS URI must be a publicly reachable HTTPS URL")
+
 	// ErrOAuthIDTokenUnsupportedEncryptionAlg is returned when the ID token encryption algorithm is not supported.
 	ErrOAuthIDTokenUnsupportedEncryptionAlg = errors.New("unsupported ID token encryption algorithm")
 	// ErrOAuthIDTokenUnsupportedEncryptionEnc is returned when the ID token content-encryption
@@ -169,6 +236,22 @@ var (
 	// ErrOAuthIDTokenEncryptionFieldsNotAllowed is returned when encryption fields are set for JWT responseType.
 	ErrOAuthIDTokenEncryptionFieldsNotAllowed = errors.New(
 		"idToken encryptionAlg and encryptionEnc must not be set when responseType is JWT")
+
+	// ErrSAMLEntityIDRequired is returned when a SAML profile has no service provider entity ID.
+	ErrSAMLEntityIDRequired = errors.New("SAML entity ID is required")
+	// ErrSAMLEntityIDAlreadyExists is returned when another inbound client already registers the
+	// same SAML entity ID.
+	ErrSAMLEntityIDAlreadyExists = errors.New("SAML entity ID is already registered")
+	// ErrSAMLInvalidACSURL is returned when an assertion consumer service URL is missing or invalid.
+	ErrSAMLInvalidACSURL = errors.New("invalid SAML assertion consumer service URL")
+	// ErrSAMLInvalidNameIDFormat is returned when an unsupported NameID format is specified.
+	ErrSAMLInvalidNameIDFormat = errors.New("invalid SAML NameID format")
+	// ErrSAMLInvalidCertificate is returned when the service provider certificate is not a valid
+	// PEM-encoded X.509 certificate.
+	ErrSAMLInvalidCertificate = errors.New("invalid SAML service provider certificate")
+	// ErrSAMLSignedRequestsRequireCertificate is returned when signed AuthnRequests are required
+	// without a service provider certificate to verify them.
+	ErrSAMLSignedRequestsRequireCertificate = errors.New("signed SAML AuthnRequests require a certificate")
 )
 
 // Certificate operation labels used in CertOperationError.
@@ -18,6 +18,10 @@ import (
 // the typed OAuthProfile for a declaratively-loaded inbound client.
 const PropOAuthProfile = "oauth_profile"
 
+// PropSAMLProfile is the key under InboundClient.Properties used by the file store to embed
+// the typed SAMLProfile for a declaratively-loaded inbound client.
+const PropSAMLProfile = "saml_profile"
+
 // fileBasedStore is a read-only in-memory inboundClientStoreInterface backed by declaratively-loaded
 // YAML resources. Create is the only write path and is invoked by the declarative loader
 // framework; update/delete/CreateOAuthProfile/DeleteOAuthProfile all return errors.
@@ -104,6 +108,62 @@ func (f *fileBasedStore) GetOAuthProfileByEntityID(ctx context.Context, entityID
 	return &oauthProfile, nil
 }
 
+// GetSAMLProfileByEntityID extracts the SAML profile embedded in the inbound client's Properties.
+func (f *fileBasedStore) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (
+	*providers.SAMLProfile, error) {
+	client, err := f.GetInboundClientByEntityID(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	return samlProfileFromProperties(client)
+}
+
+// GetSAMLProfileBySPEntityID scans the declarative inbound clients for the SAML profile whose
+// service provider entity ID matches.
+func (f *fileBasedStore) GetSAMLProfileBySPEntityID(_ context.Context, spEntityID string) (
+	string, *providers.SAMLProfile, error) {
+	list, err := f.GenericFileBasedStore.List()
+	if err != nil {
+		return "", nil, err
+	}
+	for _, item := range list {
+		c, ok := item.Data.(*inboundmodel.InboundClient)
+		if !ok {
+			continue
+		}
+		profile, err := samlProfileFromProperties(c)
+		if err != nil {
+			return "", nil, err
+		}
+		if profile != nil && profile.EntityID == spEntityID {
+			return c.ID, profile, nil
+		}
+	}
+	return "", nil, ErrInboundClientNotFound
+}
+
+// samlProfileFromProperties returns the SAML profile stored under PropSAMLProfile, or nil when the
+// inbound client has none.
+func samlProfileFromProperties(client *inboundmodel.InboundClient) (*providers.SAMLProfile, error) {
+	if client == nil || client.Properties == nil {
+		return nil, nil
+	}
+	raw, ok := client.Properties[PropSAMLProfile]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	switch p := raw.(type) {
+	case providers.SAMLProfile:
+		return &p, nil
+	case *providers.SAMLProfile:
+		return p, nil
+	default:
+		declarativeresource.LogTypeAssertionError("inbound SAML profile", client.ID)
+		return nil, ErrInboundClientDataCorrupted
+	}
+}
+
 // GetInboundClientList returns all inbound clients in the file store with IsReadOnly set.
 func (f *fileBasedStore) GetInboundClientList(_ context.Context, limit int) ([]inboundmodel.InboundClient, error) {
 	list, err := f.GenericFileBasedStore.List()
@@ -150,6 +210,22 @@ func (f *fileBasedStore) DeleteOAuthProfile(_ context.Context, _ string) error {
 	return errors.New("DeleteOAuthProfile is not supported in file-based store")
 }
 
+// CreateSAMLProfile is not supported in the file store — SAML profile is embedded in the
+// inbound client's Properties under PropSAMLProfile.
+func (f *fileBasedStore) CreateSAMLProfile(_ context.Context, _ string, _ *providers.SAMLProfile) error {
+	return errors.New("CreateSAMLProfile is not supported in file-based store")
+}
+
+// UpdateSAMLProfile is not supported in the file store.
+func (f *fileBasedStore) UpdateSAMLProfile(_ context.Context, _ string, _ *providers.SAMLProfile) error {
+	return errors.New("UpdateSAMLProfile is not supported in file-based store")
+}
+
+// DeleteSAMLProfile is not supported in the file store.
+func (f *fileBasedStore) DeleteSAMLProfile(_ context.Context, _ string) error {
+	return errors.New("DeleteSAMLProfile is not supported in file-based store")
+}
+
 // InboundClientExists reports whether an inbound client with the given entity ID is present
 // in the file store.
 func (f *fileBasedStore) InboundClientExists(_ context.Context, entityID string) (bool, error) {
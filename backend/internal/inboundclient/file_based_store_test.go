@@ -421,3 +421,58 @@ func (suite *FileBasedStoreTestSuite) TestGetEntityIDsByReference_UnknownType()
 	suite.Equal(0, total)
 	suite.Empty(ids)
 }
+
+func (suite *FileBasedStoreTestSuite) TestGetSAMLProfile_EmbeddedProfile() {
+	store := newFileBasedStoreForTest()
+	ctx := context.Background()
+
+	suite.NoError(store.CreateInboundClient(ctx, inboundmodel.InboundClient{
+		ID:         "app-1",
+		Properties: map[string]interface{}{PropOAuthProfile: providers.OAuthProfile{}},
+	}))
+	suite.NoError(store.CreateInboundClient(ctx, inboundmodel.InboundClient{
+		ID: "app-2",
+		Properties: map[string]interface{}{
+			PropSAMLProfile: &providers.SAMLProfile{EntityID: "https://sp.example.com"},
+		},
+	}))
+
+	profile, err := store.GetSAMLProfileByEntityID(ctx, "app-2")
+	suite.NoError(err)
+	suite.Require().NotNil(profile)
+	suite.Equal("https://sp.example.com", profile.EntityID)
+
+	profile, err = store.GetSAMLProfileByEntityID(ctx, "app-1")
+	suite.NoError(err)
+	suite.Nil(profile)
+
+	entityID, profile, err := store.GetSAMLProfileBySPEntityID(ctx, "https://sp.example.com")
+	suite.NoError(err)
+	suite.Equal("app-2", entityID)
+	suite.NotNil(profile)
+
+	_, _, err = store.GetSAMLProfileBySPEntityID(ctx, "https://unknown.example.com")
+	suite.ErrorIs(err, ErrInboundClientNotFound)
+}
+
+func (suite *FileBasedStoreTestSuite) TestGetSAMLProfileByEntityID_InvalidType() {
+	store := newFileBasedStoreForTest()
+	ctx := context.Background()
+
+	suite.NoError(store.CreateInboundClient(ctx, inboundmodel.InboundClient{
+		ID:         "app-1",
+		Properties: map[string]interface{}{PropSAMLProfile: "not-a-profile"},
+	}))
+
+	_, err := store.GetSAMLProfileByEntityID(ctx, "app-1")
+	suite.ErrorIs(err, ErrInboundClientDataCorrupted)
+}
+
+func (suite *FileBasedStoreTestSuite) TestSAMLProfileWrites_NotSupported() {
+	store := newFileBasedStoreForTest()
+	ctx := context.Background()
+
+	suite.Error(store.CreateSAMLProfile(ctx, "app-1", &providers.SAMLProfile{}))
+	suite.Error(store.UpdateSAMLProfile(ctx, "app-1", &providers.SAMLProfile{}))
+	suite.Error(store.DeleteSAMLProfile(ctx, "app-1"))
+}
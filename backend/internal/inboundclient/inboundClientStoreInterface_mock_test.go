@@ -158,6 +158,69 @@ func (_c *inboundClientStoreInterfaceMock_CreateOAuthProfile_Call) RunAndReturn(
 	return _c
 }
 
+// CreateSAMLProfile provides a mock function for the type inboundClientStoreInterfaceMock
+func (_mock *inboundClientStoreInterfaceMock) CreateSAMLProfile(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error {
+	ret := _mock.Called(ctx, entityID, samlProfile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSAMLProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *providers.SAMLProfile) error); ok {
+		r0 = returnFunc(ctx, entityID, samlProfile)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// inboundClientStoreInterfaceMock_CreateSAMLProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSAMLProfile'
+type inboundClientStoreInterfaceMock_CreateSAMLProfile_Call struct {
+	*mock.Call
+}
+
+// CreateSAMLProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - samlProfile *providers.SAMLProfile
+func (_e *inboundClientStoreInterfaceMock_Expecter) CreateSAMLProfile(ctx interface{}, entityID interface{}, samlProfile interface{}) *inboundClientStoreInterfaceMock_CreateSAMLProfile_Call {
+	return &inboundClientStoreInterfaceMock_CreateSAMLProfile_Call{Call: _e.mock.On("CreateSAMLProfile", ctx, entityID, samlProfile)}
+}
+
+func (_c *inboundClientStoreInterfaceMock_CreateSAMLProfile_Call) Run(run func(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile)) *inboundClientStoreInterfaceMock_CreateSAMLProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *providers.SAMLProfile
+		if args[2] != nil {
+			arg2 = args[2].(*providers.SAMLProfile)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_CreateSAMLProfile_Call) Return(err error) *inboundClientStoreInterfaceMock_CreateSAMLProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_CreateSAMLProfile_Call) RunAndReturn(run func(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error) *inboundClientStoreInterfaceMock_CreateSAMLProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteInboundClient provides a mock function for the type inboundClientStoreInterfaceMock
 func (_mock *inboundClientStoreInterfaceMock) DeleteInboundClient(ctx context.Context, entityID string) error {
 	ret := _mock.Called(ctx, entityID)
@@ -272,6 +335,63 @@ func (_c *inboundClientStoreInterfaceMock_DeleteOAuthProfile_Call) RunAndReturn(
 	return _c
 }
 
+// DeleteSAMLProfile provides a mock function for the type inboundClientStoreInterfaceMock
+func (_mock *inboundClientStoreInterfaceMock) DeleteSAMLProfile(ctx context.Context, entityID string) error {
+	ret := _mock.Called(ctx, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSAMLProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, entityID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// inboundClientStoreInterfaceMock_DeleteSAMLProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSAMLProfile'
+type inboundClientStoreInterfaceMock_DeleteSAMLProfile_Call struct {
+	*mock.Call
+}
+
+// DeleteSAMLProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+func (_e *inboundClientStoreInterfaceMock_Expecter) DeleteSAMLProfile(ctx interface{}, entityID interface{}) *inboundClientStoreInterfaceMock_DeleteSAMLProfile_Call {
+	return &inboundClientStoreInterfaceMock_DeleteSAMLProfile_Call{Call: _e.mock.On("DeleteSAMLProfile", ctx, entityID)}
+}
+
+func (_c *inboundClientStoreInterfaceMock_DeleteSAMLProfile_Call) Run(run func(ctx context.Context, entityID string)) *inboundClientStoreInterfaceMock_DeleteSAMLProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_DeleteSAMLProfile_Call) Return(err error) *inboundClientStoreInterfaceMock_DeleteSAMLProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_DeleteSAMLProfile_Call) RunAndReturn(run func(ctx context.Context, entityID string) error) *inboundClientStoreInterfaceMock_DeleteSAMLProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntityIDsByReference provides a mock function for the type inboundClientStoreInterfaceMock
 func (_mock *inboundClientStoreInterfaceMock) GetEntityIDsByReference(ctx context.Context, refType string, refID string, limit int, offset int) ([]string, int, error) {
 	ret := _mock.Called(ctx, refType, refID, limit, offset)
@@ -568,6 +688,148 @@ func (_c *inboundClientStoreInterfaceMock_GetOAuthProfileByEntityID_Call) RunAnd
 	return _c
 }
 
+// GetSAMLProfileByEntityID provides a mock function for the type inboundClientStoreInterfaceMock
+func (_mock *inboundClientStoreInterfaceMock) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (*providers.SAMLProfile, error) {
+	ret := _mock.Called(ctx, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSAMLProfileByEntityID")
+	}
+
+	var r0 *providers.SAMLProfile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*providers.SAMLProfile, error)); ok {
+		return returnFunc(ctx, entityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *providers.SAMLProfile); ok {
+		r0 = returnFunc(ctx, entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*providers.SAMLProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, entityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// inboundClientStoreInterfaceMock_GetSAMLProfileByEntityID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSAMLProfileByEntityID'
+type inboundClientStoreInterfaceMock_GetSAMLProfileByEntityID_Call struct {
+	*mock.Call
+}
+
+// GetSAMLProfileByEntityID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+func (_e *inboundClientStoreInterfaceMock_Expecter) GetSAMLProfileByEntityID(ctx interface{}, entityID interface{}) *inboundClientStoreInterfaceMock_GetSAMLProfileByEntityID_Call {
+	return &inboundClientStoreInterfaceMock_GetSAMLProfileByEntityID_Call{Call: _e.mock.On("GetSAMLProfileByEntityID", ctx, entityID)}
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetSAMLProfileByEntityID_Call) Run(run func(ctx context.Context, entityID string)) *inboundClientStoreInterfaceMock_GetSAMLProfileByEntityID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetSAMLProfileByEntityID_Call) Return(sAMLProfile *providers.SAMLProfile, err error) *inboundClientStoreInterfaceMock_GetSAMLProfileByEntityID_Call {
+	_c.Call.Return(sAMLProfile, err)
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetSAMLProfileByEntityID_Call) RunAndReturn(run func(ctx context.Context, entityID string) (*providers.SAMLProfile, error)) *inboundClientStoreInterfaceMock_GetSAMLProfileByEntityID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSAMLProfileBySPEntityID provides a mock function for the type inboundClientStoreInterfaceMock
+func (_mock *inboundClientStoreInterfaceMock) GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (string, *providers.SAMLProfile, error) {
+	ret := _mock.Called(ctx, spEntityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSAMLProfileBySPEntityID")
+	}
+
+	var r0 string
+	var r1 *providers.SAMLProfile
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, *providers.SAMLProfile, error)); ok {
+		return returnFunc(ctx, spEntityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, spEntityID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *providers.SAMLProfile); ok {
+		r1 = returnFunc(ctx, spEntityID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*providers.SAMLProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, spEntityID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// inboundClientStoreInterfaceMock_GetSAMLProfileBySPEntityID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSAMLProfileBySPEntityID'
+type inboundClientStoreInterfaceMock_GetSAMLProfileBySPEntityID_Call struct {
+	*mock.Call
+}
+
+// GetSAMLProfileBySPEntityID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spEntityID string
+func (_e *inboundClientStoreInterfaceMock_Expecter) GetSAMLProfileBySPEntityID(ctx interface{}, spEntityID interface{}) *inboundClientStoreInterfaceMock_GetSAMLProfileBySPEntityID_Call {
+	return &inboundClientStoreInterfaceMock_GetSAMLProfileBySPEntityID_Call{Call: _e.mock.On("GetSAMLProfileBySPEntityID", ctx, spEntityID)}
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetSAMLProfileBySPEntityID_Call) Run(run func(ctx context.Context, spEntityID string)) *inboundClientStoreInterfaceMock_GetSAMLProfileBySPEntityID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetSAMLProfileBySPEntityID_Call) Return(s string, sAMLProfile *providers.SAMLProfile, err error) *inboundClientStoreInterfaceMock_GetSAMLProfileBySPEntityID_Call {
+	_c.Call.Return(s, sAMLProfile, err)
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetSAMLProfileBySPEntityID_Call) RunAndReturn(run func(ctx context.Context, spEntityID string) (string, *providers.SAMLProfile, error)) *inboundClientStoreInterfaceMock_GetSAMLProfileBySPEntityID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTotalInboundClientCount provides a mock function for the type inboundClientStoreInterfaceMock
 func (_mock *inboundClientStoreInterfaceMock) GetTotalInboundClientCount(ctx context.Context) (int, error) {
 	ret := _mock.Called(ctx)
@@ -870,3 +1132,66 @@ func (_c *inboundClientStoreInterfaceMock_UpdateOAuthProfile_Call) RunAndReturn(
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateSAMLProfile provides a mock function for the type inboundClientStoreInterfaceMock
+func (_mock *inboundClientStoreInterfaceMock) UpdateSAMLProfile(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error {
+	ret := _mock.Called(ctx, entityID, samlProfile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSAMLProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *providers.SAMLProfile) error); ok {
+		r0 = returnFunc(ctx, entityID, samlProfile)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// inboundClientStoreInterfaceMock_UpdateSAMLProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSAMLProfile'
+type inboundClientStoreInterfaceMock_UpdateSAMLProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateSAMLProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - samlProfile *providers.SAMLProfile
+func (_e *inboundClientStoreInterfaceMock_Expecter) UpdateSAMLProfile(ctx interface{}, entityID interface{}, samlProfile interface{}) *inboundClientStoreInterfaceMock_UpdateSAMLProfile_Call {
+	return &inboundClientStoreInterfaceMock_UpdateSAMLProfile_Call{Call: _e.mock.On("UpdateSAMLProfile", ctx, entityID, samlProfile)}
+}
+
+func (_c *inboundClientStoreInterfaceMock_UpdateSAMLProfile_Call) Run(run func(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile)) *inboundClientStoreInterfaceMock_UpdateSAMLProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *providers.SAMLProfile
+		if args[2] != nil {
+			arg2 = args[2].(*providers.SAMLProfile)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_UpdateSAMLProfile_Call) Return(err error) *inboundClientStoreInterfaceMock_UpdateSAMLProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_UpdateSAMLProfile_Call) RunAndReturn(run func(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error) *inboundClientStoreInterfaceMock_UpdateSAMLProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,10 +5,15 @@ package inboundclient
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
 	"maps"
+	"net"
+	"net/http"
 	"slices"
 	"strings"
 
@@ -25,9 +30,11 @@ import (
 	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/fapi"
 	oauthutils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	syshttp "github.com/thunder-id/thunderid/internal/system/http"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -67,6 +74,18 @@ type InboundClientServiceInterface interface {
 	// GetOAuthClientByClientID resolves a full OAuthClient by its public client_id.
 	GetOAuthClientByClientID(ctx context.Context, clientID string) (*providers.OAuthClient, error)
 
+	// GetSAMLProfileByEntityID returns the stored SAML profile for the given entity.
+	GetSAMLProfileByEntityID(ctx context.Context, entityID string) (*providers.SAMLProfile, error)
+	// GetSAMLProfileBySPEntityID resolves a SAML profile and its owning entity ID by the service
+	// provider's SAML entity ID.
+	GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (string, *providers.SAMLProfile, error)
+	// ValidateSAMLProfile applies SAML profile defaults and validates it for the given entity without
+	// persisting.
+	ValidateSAMLProfile(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error
+	// SyncSAMLProfile validates and creates, updates, or deletes (when nil) the SAML profile of an
+	// existing inbound client.
+	SyncSAMLProfile(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error
+
 	// GetInboundClientAttributes returns the configured user attributes for a single inbound client.
 	// A missing inbound client is treated as one with no configured attributes.
 	GetInboundClientAttributes(ctx context.Context, inboundClientID string) (
@@ -95,6 +114,7 @@ type inboundClientService struct {
 	entityType     entitytype.EntityTypeServiceInterface
 	cryptoProvider providers.RuntimeCryptoProvider
 	jweService     jwe.JWEServiceInterface
+	httpClient     syshttp.HTTPClientInterface
 	logger         *log.Logger
 }
 
@@ -120,7 +140,10 @@ func newInboundClientService(store inboundClientStoreInterface, transactioner pr
 		entityType:     entityType,
 		cryptoProvider: cryptoProvider,
 		jweService:     jweService,
-		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "InboundClientService")),
+		httpClient: syshttp.NewHTTPClientWithCheckRedirect(func(req *http.Request, _ []*http.Request) error {
+			return syshttp.IsSSRFSafeURL(req.URL.String())
+		}),
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "InboundClientService")),
 	}
 }
 
@@ -153,6 +176,9 @@ func (s *inboundClientService) CreateInboundClient(ctx context.Context, client *
 		if vErr := validateOAuthProfile(oauthProfile, hasClientSecret, s.cryptoProvider, s.jweService); vErr != nil {
 			return vErr
 		}
+		if vErr := s.validateSectorIdentifierURI(ctx, oauthProfile); vErr != nil {
+			return vErr
+		}
 	}
 	if err := s.validateSubjectAttributeMapping(
 		ctx, client.SubjectAttribute, client.AllowedUserTypes); err != nil {
@@ -241,6 +267,9 @@ func (s *inboundClientService) UpdateInboundClient(ctx context.Context, client *
 		if vErr := validateOAuthProfile(oauthProfile, hasClientSecret, s.cryptoProvider, s.jweService); vErr != nil {
 			return vErr
 		}
+		if vErr := s.validateSectorIdentifierURI(ctx, oauthProfile); vErr != nil {
+			return vErr
+		}
 	}
 	if err := s.validateSubjectAttributeMapping(
 		ctx, client.SubjectAttribute, client.AllowedUserTypes); err != nil {
@@ -301,6 +330,9 @@ func (s *inboundClientService) Validate(ctx context.Context, client *inboundmode
 		if vErr := validateOAuthProfile(oauthProfile, hasClientSecret, s.cryptoProvider, s.jweService); vErr != nil {
 			return vErr
 		}
+		if vErr := s.validateSectorIdentifierURI(ctx, oauthProfile); vErr != nil {
+			return vErr
+		}
 	}
 	if err := s.validateSubjectAttributeMapping(
 		ctx, client.SubjectAttribute, client.AllowedUserTypes); err != nil {
@@ -517,6 +549,76 @@ func (s *inboundClientService) syncOAuthProfile(ctx context.Context, entityID st
 	})
 }
 
+// GetSAMLProfileByEntityID returns the stored SAML profile for the given entity.
+func (s *inboundClientService) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (
+	*providers.SAMLProfile, error) {
+	return s.store.GetSAMLProfileByEntityID(ctx, entityID)
+}
+
+// GetSAMLProfileBySPEntityID resolves a SAML profile and its owning entity ID by the service
+// provider's SAML entity ID.
+func (s *inboundClientService) GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (
+	string, *providers.SAMLProfile, error) {
+	if spEntityID == "" {
+		return "", nil, ErrInboundClientNotFound
+	}
+	return s.store.GetSAMLProfileBySPEntityID(ctx, spEntityID)
+}
+
+// ValidateSAMLProfile applies SAML profile defaults and validates it for the given entity without
+// persisting. The service provider entity ID must not be registered by any other inbound client.
+func (s *inboundClientService) ValidateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *providers.SAMLProfile) error {
+	if samlProfile == nil {
+		return nil
+	}
+	if samlProfile.NameIDFormat == "" {
+		samlProfile.NameIDFormat = providers.SAMLNameIDFormatUnspecified
+	}
+	if err := validateSAMLProfile(samlProfile); err != nil {
+		return err
+	}
+	ownerID, _, err := s.store.GetSAMLProfileBySPEntityID(ctx, samlProfile.EntityID)
+	if err != nil {
+		if errors.Is(err, ErrInboundClientNotFound) {
+			return nil
+		}
+		return err
+	}
+	if ownerID != entityID {
+		return ErrSAMLEntityIDAlreadyExists
+	}
+	return nil
+}
+
+// SyncSAMLProfile validates and creates, updates, or deletes the stored SAML profile to match the
+// desired state. A nil profile removes any existing SAML registration.
+func (s *inboundClientService) SyncSAMLProfile(ctx context.Context, entityID string,
+	desired *providers.SAMLProfile) error {
+	if s.store.IsDeclarative(ctx, entityID) {
+		return ErrCannotModifyDeclarative
+	}
+	if err := s.ValidateSAMLProfile(ctx, entityID, desired); err != nil {
+		return err
+	}
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.GetSAMLProfileByEntityID(txCtx, entityID)
+		if err != nil && !errors.Is(err, ErrInboundClientNotFound) {
+			return err
+		}
+		switch {
+		case desired != nil && existing != nil:
+			return s.store.UpdateSAMLProfile(txCtx, entityID, desired)
+		case desired != nil && existing == nil:
+			return s.store.CreateSAMLProfile(txCtx, entityID, desired)
+		case desired == nil && existing != nil:
+			return s.store.DeleteSAMLProfile(txCtx, entityID)
+		default:
+			return nil
+		}
+	})
+}
+
 // GetOAuthClientByClientID resolves a full OAuthClient by its public client_id.
 func (s *inboundClientService) GetOAuthClientByClientID(ctx context.Context, clientID string) (
 	*providers.OAuthClient, error) {
@@ -563,6 +665,16 @@ func (s *inboundClientService) GetOAuthClientByClientID(ctx context.Context, cli
 	}
 	client.Certificate = certificate
 
+	if client.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodClientSecretJWT &&
+		oauthProfile.EncryptedClientSecret != "" {
+		secret, err := s.cryptoProvider.Decrypt(ctx, nil, string(cryptolib.AlgorithmAESGCM),
+			nil, []byte(oauthProfile.EncryptedClientSecret))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt client secret: %w", err)
+		}
+		client.ClientSecret = string(secret)
+	}
+
 	return client, nil
 }
 
@@ -577,18 +689,30 @@ func BuildOAuthClient(
 		EntityCategory:                     entityCategory,
 		RedirectURIs:                       p.RedirectURIs,
 		PostLogoutRedirectURIs:             p.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               p.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   p.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              p.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  p.FrontchannelLogoutSessionRequired,
 		TokenEndpointAuthMethod:            providers.TokenEndpointAuthMethod(p.TokenEndpointAuthMethod),
 		PKCERequired:                       p.PKCERequired,
 		PublicClient:                       p.PublicClient,
 		RequirePushedAuthorizationRequests: p.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         p.RequireSignedRequestObject,
 		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
+		MTLSBoundAccessTokens:              p.MTLSBoundAccessTokens,
+		SecurityProfile:                    providers.SecurityProfile(p.SecurityProfile),
+		SubjectType:                        providers.SubjectType(p.SubjectType),
+		SectorIdentifierURI:                p.SectorIdentifierURI,
 		IncludeActClaim:                    p.IncludeActClaim,
 		Scopes:                             p.Scopes,
 		ScopeClaims:                        p.ScopeClaims,
 		Token:                              p.Token,
 		UserInfo:                           p.UserInfo,
+		AuthorizationResponse:              p.AuthorizationResponse,
+		TLSClientAuth:                      p.TLSClientAuth,
 		Certificate:                        p.Certificate,
 		AcrValues:                          p.AcrValues,
+		RegistrationTokenHash:              p.RegistrationTokenHash,
 	}
 	for _, gt := range p.GrantTypes {
 		client.GrantTypes = append(client.GrantTypes, providers.GrantType(gt))
@@ -817,12 +941,24 @@ func validateOAuthProfile(p *providers.OAuthProfile, hasClientSecret bool,
 	if err := validateRedirectURIs(p); err != nil {
 		return err
 	}
+	if err := validateBackchannelLogoutURI(p); err != nil {
+		return err
+	}
+	if err := validateFrontchannelLogoutURI(p); err != nil {
+		return err
+	}
 	if err := validateGrantAndResponseTypes(p); err != nil {
 		return err
 	}
 	if err := validateTokenEndpointAuthMethod(p, hasClientSecret); err != nil {
 		return err
 	}
+	if err := validateSecurityProfile(p); err != nil {
+		return err
+	}
+	if err := validateSubjectType(p); err != nil {
+		return err
+	}
 	if p.PublicClient {
 		if err := validatePublicClient(p); err != nil {
 			return err
@@ -831,6 +967,9 @@ func validateOAuthProfile(p *providers.OAuthProfile, hasClientSecret bool,
 	if err := validateUserInfoConfig(p, cryptoProvider, jweService); err != nil {
 		return err
 	}
+	if err := validateAuthorizationResponseConfig(p, cryptoProvider, jweService); err != nil {
+		return err
+	}
 	if err := validateIDTokenConfig(p, jweService); err != nil {
 		return err
 	}
@@ -855,6 +994,46 @@ func validateAccessTokenConfig(p *providers.OAuthProfile) error {
 	return nil
 }
 
+// validateAuthorizationResponseConfig validates the JWT Secured Authorization Response Mode (JARM)
+// signing and encryption configuration.
+func validateAuthorizationResponseConfig(p *providers.OAuthProfile, cryptoProvider providers.RuntimeCryptoProvider,
+	jweService jwe.JWEServiceInterface) error {
+	if p.AuthorizationResponse == nil {
+		return nil
+	}
+	cfg := p.AuthorizationResponse
+
+	if cfg.SigningAlg != "" && !slices.Contains(cryptoProvider.GetSupportedSigningAlgorithms(), cfg.SigningAlg) {
+		return ErrOAuthAuthorizationResponseUnsupportedSigningAlg
+	}
+
+	if cfg.EncryptionEnc != "" && cfg.EncryptionAlg == "" {
+		return ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg
+	}
+
+	if cfg.EncryptionAlg != "" {
+		if !slices.Contains(jweService.SupportedKeyEncryptionAlgorithms(), cfg.EncryptionAlg) {
+			return ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg
+		}
+		if cfg.EncryptionEnc == "" {
+			return ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc
+		}
+		if !slices.Contains(jweService.SupportedContentEncryptionAlgorithms(), cfg.EncryptionEnc) {
+			return ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc
+		}
+		if p.Certificate == nil || p.Certificate.Type == "" {
+			return ErrOAuthAuthorizationResponseEncryptionRequiresCertificate
+		}
+		if p.Certificate.Type == cert.CertificateTypeJWKSURI {
+			if err := syshttp.IsSSRFSafeURL(p.Certificate.Value); err != nil {
+				return ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe
+			}
+		}
+	}
+
+	return nil
+}
+
 // validateUserInfoConfig validates the UserInfo signing and encryption configuration.
 func validateUserInfoConfig(p *providers.OAuthProfile, cryptoProvider providers.RuntimeCryptoProvider,
 	jweService jwe.JWEServiceInterface) error {
@@ -1007,6 +1186,86 @@ func validateRedirectURIs(p *providers.OAuthProfile) error {
 	return nil
 }
 
+// validateBackchannelLogoutURI validates the OIDC Back-Channel Logout endpoint. Unlike redirect URIs
+// it is contacted server-to-server, so like the jwks_uri it must be an SSRF-safe https URL and, per
+// the specification, carry no fragment.
+func validateBackchannelLogoutURI(p *providers.OAuthProfile) error {
+	if p.BackchannelLogoutURI == "" {
+		return nil
+	}
+	parsedURI, err := sysutils.ParseURL(p.BackchannelLogoutURI)
+	if err != nil || parsedURI.Fragment != "" {
+		return ErrOAuthInvalidBackchannelLogoutURI
+	}
+	if err := syshttp.IsSSRFSafeURL(p.BackchannelLogoutURI); err != nil {
+		return ErrOAuthInvalidBackchannelLogoutURI
+	}
+	return nil
+}
+
+// validateFrontchannelLogoutURI validates the OIDC Front-Channel Logout endpoint. It is rendered in
+// an iframe of the logout page, so it must be an absolute http(s) URL without a fragment and, per the
+// specification, share its scheme, host and port with one of the registered redirect URIs.
+func validateFrontchannelLogoutURI(p *providers.OAuthProfile) error {
+	if p.FrontchannelLogoutURI == "" {
+		return nil
+	}
+	parsedURI, err := sysutils.ParseURL(p.FrontchannelLogoutURI)
+	if err != nil {
+		return ErrOAuthInvalidFrontchannelLogoutURI
+	}
+	if (parsedURI.Scheme != "http" && parsedURI.Scheme != "https") || parsedURI.Host == "" ||
+		parsedURI.Fragment != "" {
+		return ErrOAuthInvalidFrontchannelLogoutURI
+	}
+	for _, redirectURI := range p.RedirectURIs {
+		parsedRedirectURI, err := sysutils.ParseURL(redirectURI)
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(parsedRedirectURI.Scheme, parsedURI.Scheme) &&
+			strings.EqualFold(parsedRedirectURI.Host, parsedURI.Host) {
+			return nil
+		}
+	}
+	return ErrOAuthInvalidFrontchannelLogoutURI
+}
+
+// validateSAMLProfile validates the service provider registration of a SAML profile.
+func validateSAMLProfile(p *providers.SAMLProfile) error {
+	if strings.TrimSpace(p.EntityID) == "" {
+		return ErrSAMLEntityIDRequired
+	}
+	if len(p.AssertionConsumerServiceURLs) == 0 {
+		return ErrSAMLInvalidACSURL
+	}
+	for _, acsURL := range p.AssertionConsumerServiceURLs {
+		parsedURL, err := sysutils.ParseURL(acsURL)
+		if err != nil || (parsedURL.Scheme != "https" && parsedURL.Scheme != "http") ||
+			parsedURL.Host == "" || parsedURL.Fragment != "" {
+			return ErrSAMLInvalidACSURL
+		}
+	}
+	switch p.NameIDFormat {
+	case providers.SAMLNameIDFormatUnspecified, providers.SAMLNameIDFormatEmail,
+		providers.SAMLNameIDFormatPersistent, providers.SAMLNameIDFormatTransient:
+	default:
+		return ErrSAMLInvalidNameIDFormat
+	}
+	if p.Certificate != "" {
+		block, _ := pem.Decode([]byte(p.Certificate))
+		if block == nil || block.Type != "CERTIFICATE" {
+			return ErrSAMLInvalidCertificate
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return ErrSAMLInvalidCertificate
+		}
+	} else if p.RequireSignedAuthnRequests {
+		return ErrSAMLSignedRequestsRequireCertificate
+	}
+	return nil
+}
+
 // validateHostWildcardPattern enforces structural rules for wildcards in the host
 // component: no * in the port portion of host:port, and no whole-label *. * matches one
 // or more alphanumeric characters at match time, enforced by the matcher itself.
@@ -1091,7 +1350,23 @@ func validateTokenEndpointAuthMethod(p *providers.OAuthProfile, hasClientSecret
 		if hasClientSecret {
 			return ErrOAuthPrivateKeyJWTCannotHaveClientSecret
 		}
-	case providers.TokenEndpointAuthMethodClientSecretBasic, providers.TokenEndpointAuthMethodClientSecretPost:
+	case providers.TokenEndpointAuthMethodTLSClientAuth:
+		if hasClientSecret {
+			return ErrOAuthMTLSAuthCannotHaveClientSecret
+		}
+		if !isValidTLSClientAuthConfig(p.TLSClientAuth) {
+			return ErrOAuthTLSClientAuthRequiresSubject
+		}
+	case providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
+		// The registered certificate is matched against the presented one, so it must be held inline.
+		if !hasCert || p.Certificate.Type != cert.CertificateTypeJWKS {
+			return ErrOAuthSelfSignedTLSClientAuthRequiresCertificate
+		}
+		if hasClientSecret {
+			return ErrOAuthMTLSAuthCannotHaveClientSecret
+		}
+	case providers.TokenEndpointAuthMethodClientSecretBasic, providers.TokenEndpointAuthMethodClientSecretPost,
+		providers.TokenEndpointAuthMethodClientSecretJWT:
 		// A certificate is allowed: it carries the client's public key for token encryption
 		// (JWE / NESTED_JWT), independent of how the client authenticates.
 	case providers.TokenEndpointAuthMethodNone:
@@ -1117,6 +1392,126 @@ func validateTokenEndpointAuthMethod(p *providers.OAuthProfile, hasClientSecret
 	return nil
 }
 
+// validateSecurityProfile validates the client's security profile and, for the FAPI 2.0 Security
+// Profile, the registration constraints it places on the client. The requirements on individual
+// requests are enforced by the OAuth endpoints.
+func validateSecurityProfile(p *providers.OAuthProfile) error {
+	if p.SecurityProfile == "" {
+		return nil
+	}
+	profile := providers.SecurityProfile(p.SecurityProfile)
+	if !profile.IsValid() {
+		return ErrOAuthInvalidSecurityProfile
+	}
+	if profile != providers.SecurityProfileFAPI2 {
+		return nil
+	}
+	if p.PublicClient ||
+		!fapi.IsAllowedClientAuthMethod(providers.TokenEndpointAuthMethod(p.TokenEndpointAuthMethod)) {
+		return ErrOAuthFAPI2RequiresStrongClientAuth
+	}
+	for _, responseType := range p.ResponseTypes {
+		if responseType != string(providers.ResponseTypeCode) {
+			return ErrOAuthFAPI2RequiresCodeResponseType
+		}
+	}
+	return nil
+}
+
+// validateSubjectType validates the client's subject identifier type and sector identifier URI. A
+// pairwise client without a sector identifier URI must keep its redirect URIs on a single host, since
+// that host is the sector its pairwise subjects are computed for (OpenID Connect Core 1.0 §8.1).
+func validateSubjectType(p *providers.OAuthProfile) error {
+	if p.SubjectType != "" && !providers.SubjectType(p.SubjectType).IsValid() {
+		return ErrOAuthInvalidSubjectType
+	}
+	if p.SectorIdentifierURI != "" {
+		parsedURI, err := sysutils.ParseURL(p.SectorIdentifierURI)
+		if err != nil || parsedURI.Scheme != "https" || parsedURI.Host == "" || parsedURI.Fragment != "" {
+			return ErrOAuthInvalidSectorIdentifierURI
+		}
+		return nil
+	}
+	if providers.SubjectType(p.SubjectType) != providers.SubjectTypePairwise {
+		return nil
+	}
+	host := ""
+	for _, redirectURI := range p.RedirectURIs {
+		parsedURI, err := sysutils.ParseURL(redirectURI)
+		if err != nil {
+			continue
+		}
+		if host != "" && parsedURI.Hostname() != host {
+			return ErrOAuthPairwiseRequiresSectorIdentifierURI
+		}
+		host = parsedURI.Hostname()
+	}
+	return nil
+}
+
+// maxSectorIdentifierDocumentBytes bounds the size of the redirect URI array read from a sector
+// identifier URI.
+const maxSectorIdentifierDocumentBytes = 64 * 1024
+
+// validateSectorIdentifierURI retrieves the JSON array of redirect URIs published at the client's
+// sector identifier URI and checks that it lists every registered redirect URI (OpenID Connect Dynamic
+// Client Registration 1.0 §5).
+func (s *inboundClientService) validateSectorIdentifierURI(ctx context.Context, p *providers.OAuthProfile) error {
+	if p == nil || p.SectorIdentifierURI == "" {
+		return nil
+	}
+	if err := syshttp.IsSSRFSafeURL(p.SectorIdentifierURI); err != nil || s.httpClient == nil {
+		return ErrOAuthInvalidSectorIdentifierURI
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.SectorIdentifierURI, nil)
+	if err != nil {
+		return ErrOAuthInvalidSectorIdentifierURI
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Debug(ctx, "Failed to fetch the sector identifier URI", log.Error(err))
+		return ErrOAuthInvalidSectorIdentifierURI
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Debug(ctx, "Sector identifier URI returned non-200 status", log.Int("statusCode", resp.StatusCode))
+		return ErrOAuthInvalidSectorIdentifierURI
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSectorIdentifierDocumentBytes+1))
+	if err != nil || len(body) > maxSectorIdentifierDocumentBytes {
+		return ErrOAuthInvalidSectorIdentifierURI
+	}
+	var listed []string
+	if err := json.Unmarshal(body, &listed); err != nil {
+		return ErrOAuthInvalidSectorIdentifierURI
+	}
+	for _, redirectURI := range p.RedirectURIs {
+		if !slices.Contains(listed, redirectURI) {
+			return ErrOAuthSectorIdentifierURIMissingRedirectURI
+		}
+	}
+	return nil
+}
+
+// isValidTLSClientAuthConfig reports whether exactly one certificate subject identifier is configured,
+// as required for tls_client_auth (RFC 8705 §2.1.2).
+func isValidTLSClientAuthConfig(c *providers.TLSClientAuthConfig) bool {
+	if c == nil {
+		return false
+	}
+	count := 0
+	for _, v := range []string{c.SubjectDN, c.SANDNS, c.SANURI, c.SANIP, c.SANEmail} {
+		if v != "" {
+			count++
+		}
+	}
+	if count != 1 {
+		return false
+	}
+	return c.SANIP == "" || net.ParseIP(c.SANIP) != nil
+}
+
 // validateAllowedGrantTypes rejects grant types not permitted by the deployment's configured
 // oauth.allowed_grant_types allow-list. An empty allow-list permits all grant types.
 func validateWithAllowedGrantTypes(grantTypes []string) error {
@@ -1153,7 +1548,13 @@ func validateWithAllowedTokenEndpointAuthMethod(method string) error {
 	if !providers.TokenEndpointAuthMethod(method).IsValid() {
 		return ErrOAuthInvalidTokenEndpointAuthMethod
 	}
-	allowed := config.GetServerRuntime().Config.OAuth.AllowedAuthMethods
+	oauthCfg := config.GetServerRuntime().Config.OAuth
+	// The mutual-TLS methods cannot authenticate anyone unless client certificates are captured.
+	if !oauthCfg.MTLS.Enabled && (method == string(providers.TokenEndpointAuthMethodTLSClientAuth) ||
+		method == string(providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth)) {
+		return ErrOAuthInvalidTokenEndpointAuthMethod
+	}
+	allowed := oauthCfg.AllowedAuthMethods
 	if len(allowed) == 0 || slices.Contains(allowed, method) {
 		return nil
 	}
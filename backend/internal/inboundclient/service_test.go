@@ -5,9 +5,19 @@ package inboundclient
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"io"
+	"math/big"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
@@ -15,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/cert"
@@ -36,6 +47,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
 )
 
 type InboundClientServiceTestSuite struct {
@@ -999,6 +1011,65 @@ func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_WildcardInQ
 	assert.ErrorIs(suite.T(), err, ErrOAuthInvalidRedirectURI)
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidateBackchannelLogoutURI() {
+	cases := []struct {
+		name    string
+		uri     string
+		wantErr error
+	}{
+		{name: "Unset", uri: ""},
+		{name: "HTTPS", uri: "https://rp.example.com/backchannel-logout"},
+		{name: "HTTPSWithQuery", uri: "https://rp.example.com:8443/logout?tenant=a"},
+		{name: "HTTPRejected", uri: "http://rp.example.com/logout", wantErr: ErrOAuthInvalidBackchannelLogoutURI},
+		{name: "LoopbackRejected", uri: "https://127.0.0.1/logout", wantErr: ErrOAuthInvalidBackchannelLogoutURI},
+		{name: "MetadataRejected", uri: "https://169.254.169.254/latest", wantErr: ErrOAuthInvalidBackchannelLogoutURI},
+		{name: "RelativeRejected", uri: "/logout", wantErr: ErrOAuthInvalidBackchannelLogoutURI},
+		{name: "CustomSchemeRejected", uri: "myapp://logout", wantErr: ErrOAuthInvalidBackchannelLogoutURI},
+		{name: "FragmentRejected", uri: "https://rp.example.com/logout#x", wantErr: ErrOAuthInvalidBackchannelLogoutURI},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			err := validateBackchannelLogoutURI(&providers.OAuthProfile{BackchannelLogoutURI: tc.uri})
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+				return
+			}
+			assert.ErrorIs(suite.T(), err, tc.wantErr)
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateFrontchannelLogoutURI() {
+	redirectURIs := []string{"https://rp.example.com/callback", "http://localhost:3000/callback"}
+	cases := []struct {
+		name    string
+		uri     string
+		wantErr error
+	}{
+		{name: "Unset", uri: ""},
+		{name: "SameOriginAsRedirectURI", uri: "https://rp.example.com/frontchannel-logout"},
+		{name: "WithQuery", uri: "http://localhost:3000/logout?tenant=a"},
+		{name: "OtherOriginRejected", uri: "https://other.example.com/logout",
+			wantErr: ErrOAuthInvalidFrontchannelLogoutURI},
+		{name: "OtherPortRejected", uri: "http://localhost:4000/logout", wantErr: ErrOAuthInvalidFrontchannelLogoutURI},
+		{name: "RelativeRejected", uri: "/logout", wantErr: ErrOAuthInvalidFrontchannelLogoutURI},
+		{name: "FragmentRejected", uri: "https://rp.example.com/logout#x", wantErr: ErrOAuthInvalidFrontchannelLogoutURI},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			err := validateFrontchannelLogoutURI(&providers.OAuthProfile{
+				RedirectURIs:          redirectURIs,
+				FrontchannelLogoutURI: tc.uri,
+			})
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+				return
+			}
+			assert.ErrorIs(suite.T(), err, tc.wantErr)
+		})
+	}
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidatePublicClient_PKCENotRequired_Fails() {
 	p := &providers.OAuthProfile{
 		PublicClient:            true,
@@ -1059,6 +1130,207 @@ func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_
 	assert.ErrorIs(suite.T(), err, ErrOAuthPrivateKeyJWTCannotHaveClientSecret)
 }
 
+func (suite *InboundClientServiceTestSuite) enableMTLS() {
+	sysconfig.ResetServerRuntime()
+	cfg := &sysconfig.Config{}
+	cfg.OAuth.MTLS.Enabled = true
+	suite.Require().NoError(sysconfig.InitializeServerRuntime("/tmp/test", cfg))
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_MTLSRequiresMTLSEnabled() {
+	p := &providers.OAuthProfile{
+		TokenEndpointAuthMethod: "tls_client_auth",
+		TLSClientAuth:           &providers.TLSClientAuthConfig{SubjectDN: "CN=client"},
+	}
+	err := validateTokenEndpointAuthMethod(p, false)
+	assert.ErrorIs(suite.T(), err, ErrOAuthInvalidTokenEndpointAuthMethod)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_TLSClientAuth() {
+	suite.enableMTLS()
+	testCases := []struct {
+		name      string
+		tlsAuth   *providers.TLSClientAuthConfig
+		hasSecret bool
+		wantErr   error
+	}{
+		{"SubjectDN", &providers.TLSClientAuthConfig{SubjectDN: "CN=client"}, false, nil},
+		{"SANIP", &providers.TLSClientAuthConfig{SANIP: "10.0.0.1"}, false, nil},
+		{"InvalidSANIP", &providers.TLSClientAuthConfig{SANIP: "not-an-ip"}, false,
+			ErrOAuthTLSClientAuthRequiresSubject},
+		{"MissingConfig", nil, false, ErrOAuthTLSClientAuthRequiresSubject},
+		{"NoIdentifier", &providers.TLSClientAuthConfig{}, false, ErrOAuthTLSClientAuthRequiresSubject},
+		{"MultipleIdentifiers", &providers.TLSClientAuthConfig{SubjectDN: "CN=client", SANDNS: "client.example"},
+			false, ErrOAuthTLSClientAuthRequiresSubject},
+		{"WithSecret", &providers.TLSClientAuthConfig{SubjectDN: "CN=client"}, true,
+			ErrOAuthMTLSAuthCannotHaveClientSecret},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			p := &providers.OAuthProfile{TokenEndpointAuthMethod: "tls_client_auth", TLSClientAuth: tc.tlsAuth}
+			err := validateTokenEndpointAuthMethod(p, tc.hasSecret)
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+				return
+			}
+			assert.ErrorIs(suite.T(), err, tc.wantErr)
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSecurityProfile() {
+	testCases := []struct {
+		name    string
+		profile *providers.OAuthProfile
+		wantErr error
+	}{
+		{"Unset", &providers.OAuthProfile{TokenEndpointAuthMethod: "client_secret_basic"}, nil},
+		{"None", &providers.OAuthProfile{SecurityProfile: "none", TokenEndpointAuthMethod: "client_secret_basic"}, nil},
+		{"Unknown", &providers.OAuthProfile{SecurityProfile: "fapi1"}, ErrOAuthInvalidSecurityProfile},
+		{"FAPI2PrivateKeyJWT", &providers.OAuthProfile{SecurityProfile: "fapi2",
+			TokenEndpointAuthMethod: "private_key_jwt", ResponseTypes: []string{"code"}}, nil},
+		{"FAPI2TLSClientAuth", &providers.OAuthProfile{SecurityProfile: "fapi2",
+			TokenEndpointAuthMethod: "tls_client_auth"}, nil},
+		{"FAPI2ClientSecret", &providers.OAuthProfile{SecurityProfile: "fapi2",
+			TokenEndpointAuthMethod: "client_secret_basic"}, ErrOAuthFAPI2RequiresStrongClientAuth},
+		{"FAPI2PublicClient", &providers.OAuthProfile{SecurityProfile: "fapi2", PublicClient: true,
+			TokenEndpointAuthMethod: "none"}, ErrOAuthFAPI2RequiresStrongClientAuth},
+		{"FAPI2IDTokenResponseType", &providers.OAuthProfile{SecurityProfile: "fapi2",
+			TokenEndpointAuthMethod: "private_key_jwt", ResponseTypes: []string{"code", "id_token"}},
+			ErrOAuthFAPI2RequiresCodeResponseType},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateSecurityProfile(tc.profile)
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+				return
+			}
+			assert.ErrorIs(suite.T(), err, tc.wantErr)
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSubjectType() {
+	testCases := []struct {
+		name    string
+		profile *providers.OAuthProfile
+		wantErr error
+	}{
+		{"Unset", &providers.OAuthProfile{}, nil},
+		{"Public", &providers.OAuthProfile{SubjectType: "public"}, nil},
+		{"Unknown", &providers.OAuthProfile{SubjectType: "private"}, ErrOAuthInvalidSubjectType},
+		{"PairwiseSingleHost", &providers.OAuthProfile{SubjectType: "pairwise",
+			RedirectURIs: []string{"https://app.example.com/a", "https://app.example.com/b"}}, nil},
+		{"PairwiseMultipleHosts", &providers.OAuthProfile{SubjectType: "pairwise",
+			RedirectURIs: []string{"https://app.example.com/cb", "https://other.example.com/cb"}},
+			ErrOAuthPairwiseRequiresSectorIdentifierURI},
+		{"PairwiseMultipleHostsWithSector", &providers.OAuthProfile{SubjectType: "pairwise",
+			SectorIdentifierURI: "https://sector.example.com/uris.json",
+			RedirectURIs:        []string{"https://app.example.com/cb", "https://other.example.com/cb"}}, nil},
+		{"HTTPSectorIdentifierURI", &providers.OAuthProfile{SubjectType: "pairwise",
+			SectorIdentifierURI: "http://sector.example.com/uris.json"}, ErrOAuthInvalidSectorIdentifierURI},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateSubjectType(tc.profile)
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+				return
+			}
+			assert.ErrorIs(suite.T(), err, tc.wantErr)
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSectorIdentifierURI() {
+	const sectorURI = "https://sector.example.com/uris.json"
+	profile := &providers.OAuthProfile{
+		SubjectType:         "pairwise",
+		SectorIdentifierURI: sectorURI,
+		RedirectURIs:        []string{"https://app.example.com/cb", "https://other.example.com/cb"},
+	}
+	testCases := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{"ListsAllRedirectURIs", http.StatusOK,
+			`["https://app.example.com/cb","https://other.example.com/cb","https://third.example.com/cb"]`, nil},
+		{"MissingRedirectURI", http.StatusOK, `["https://app.example.com/cb"]`,
+			ErrOAuthSectorIdentifierURIMissingRedirectURI},
+		{"NotAnArray", http.StatusOK, `{"redirect_uris":[]}`, ErrOAuthInvalidSectorIdentifierURI},
+		{"NotFound", http.StatusNotFound, ``, ErrOAuthInvalidSectorIdentifierURI},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			httpClient := httpmock.NewHTTPClientInterfaceMock(suite.T())
+			httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == http.MethodGet && req.URL.String() == sectorURI
+			})).Return(&http.Response{
+				StatusCode: tc.status,
+				Body:       io.NopCloser(strings.NewReader(tc.body)),
+			}, nil).Once()
+			svc := &inboundClientService{httpClient: httpClient, logger: log.GetLogger()}
+
+			err := svc.validateSectorIdentifierURI(context.Background(), profile)
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+				return
+			}
+			assert.ErrorIs(suite.T(), err, tc.wantErr)
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSectorIdentifierURI_NotSet() {
+	svc := &inboundClientService{logger: log.GetLogger()}
+	assert.NoError(suite.T(), svc.validateSectorIdentifierURI(context.Background(), &providers.OAuthProfile{}))
+	assert.NoError(suite.T(), svc.validateSectorIdentifierURI(context.Background(), nil))
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSectorIdentifierURI_FetchError() {
+	httpClient := httpmock.NewHTTPClientInterfaceMock(suite.T())
+	httpClient.EXPECT().Do(mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	svc := &inboundClientService{httpClient: httpClient, logger: log.GetLogger()}
+
+	err := svc.validateSectorIdentifierURI(context.Background(),
+		&providers.OAuthProfile{SectorIdentifierURI: "https://sector.example.com/uris.json"})
+	assert.ErrorIs(suite.T(), err, ErrOAuthInvalidSectorIdentifierURI)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_SelfSignedTLSClientAuth() {
+	suite.enableMTLS()
+	jwks := &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: "{}"}
+	jwksURI := &inboundmodel.Certificate{Type: cert.CertificateTypeJWKSURI, Value: "https://client.example/jwks"}
+	testCases := []struct {
+		name        string
+		certificate *inboundmodel.Certificate
+		hasSecret   bool
+		wantErr     error
+	}{
+		{"InlineJWKS", jwks, false, nil},
+		{"MissingCert", nil, false, ErrOAuthSelfSignedTLSClientAuthRequiresCertificate},
+		{"JWKSURI", jwksURI, false, ErrOAuthSelfSignedTLSClientAuthRequiresCertificate},
+		{"WithSecret", jwks, true, ErrOAuthMTLSAuthCannotHaveClientSecret},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			p := &providers.OAuthProfile{
+				TokenEndpointAuthMethod: "self_signed_tls_client_auth",
+				Certificate:             tc.certificate,
+			}
+			err := validateTokenEndpointAuthMethod(p, tc.hasSecret)
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+				return
+			}
+			assert.ErrorIs(suite.T(), err, tc.wantErr)
+		})
+	}
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_NoneRequiresPublicClient() {
 	p := &providers.OAuthProfile{TokenEndpointAuthMethod: "none"}
 	err := validateTokenEndpointAuthMethod(p, false)
@@ -1299,6 +1571,60 @@ func (suite *InboundClientServiceTestSuite) TestValidateUserInfoConfig_AllAlgsRe
 		ErrOAuthUserInfoAlgRequiresResponseType)
 }
 
+// validateAuthorizationResponseConfig
+
+func (suite *InboundClientServiceTestSuite) TestValidateAuthorizationResponseConfig_Nil() {
+	assert.NoError(suite.T(), validateAuthorizationResponseConfig(&providers.OAuthProfile{},
+		suite.cryptoMock, suite.jweService))
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateAuthorizationResponseConfig_SignedAndEncrypted() {
+	p := &providers.OAuthProfile{
+		Certificate: &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: "{}"},
+		AuthorizationResponse: &providers.AuthorizationResponseConfig{
+			SigningAlg: "RS256", EncryptionAlg: "RSA-OAEP-256", EncryptionEnc: "A256GCM",
+		},
+	}
+	assert.NoError(suite.T(), validateAuthorizationResponseConfig(p, suite.cryptoMock, suite.jweService))
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateAuthorizationResponseConfig_Errors() {
+	jwks := &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: "{}"}
+	cases := []struct {
+		name     string
+		cert     *inboundmodel.Certificate
+		cfg      *providers.AuthorizationResponseConfig
+		expected error
+	}{
+		{"UnsupportedSigningAlg", nil, &providers.AuthorizationResponseConfig{SigningAlg: "BOGUS"},
+			ErrOAuthAuthorizationResponseUnsupportedSigningAlg},
+		{"EncWithoutAlg", nil, &providers.AuthorizationResponseConfig{EncryptionEnc: "A256GCM"},
+			ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg},
+		{"UnsupportedEncryptionAlg", jwks,
+			&providers.AuthorizationResponseConfig{EncryptionAlg: "BOGUS", EncryptionEnc: "A256GCM"},
+			ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg},
+		{"AlgWithoutEnc", jwks, &providers.AuthorizationResponseConfig{EncryptionAlg: "RSA-OAEP-256"},
+			ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc},
+		{"UnsupportedEncryptionEnc", jwks,
+			&providers.AuthorizationResponseConfig{EncryptionAlg: "RSA-OAEP-256", EncryptionEnc: "BOGUS"},
+			ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc},
+		{"EncryptionRequiresCertificate", nil,
+			&providers.AuthorizationResponseConfig{EncryptionAlg: "RSA-OAEP-256", EncryptionEnc: "A256GCM"},
+			ErrOAuthAuthorizationResponseEncryptionRequiresCertificate},
+		{"JWKSURINotSSRFSafe",
+			&inboundmodel.Certificate{Type: cert.CertificateTypeJWKSURI, Value: "http://127.0.0.1/jwks"},
+			&providers.AuthorizationResponseConfig{EncryptionAlg: "RSA-OAEP-256", EncryptionEnc: "A256GCM"},
+			ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			p := &providers.OAuthProfile{Certificate: tc.cert, AuthorizationResponse: tc.cfg}
+			assert.ErrorIs(suite.T(), validateAuthorizationResponseConfig(p, suite.cryptoMock, suite.jweService),
+				tc.expected)
+		})
+	}
+}
+
 // validateIDTokenConfig — happy paths
 
 func (suite *InboundClientServiceTestSuite) TestValidateIDTokenConfig_NilToken() {
@@ -3514,3 +3840,157 @@ func (suite *InboundClientServiceTestSuite) TestValidateSubjectAttributeMapping_
 		context.Background(), map[string]string{"employee": "email"}, []string{"employee"}),
 		ErrUniqueAttributeLookupFailed)
 }
+
+// ----- SAML profile -----
+
+// selfSignedCertPEM returns a freshly generated self-signed certificate in PEM form.
+func selfSignedCertPEM(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func validSAMLProfile() *providers.SAMLProfile {
+	return &providers.SAMLProfile{
+		EntityID:                     "https://sp.example.com",
+		AssertionConsumerServiceURLs: []string{"https://sp.example.com/acs"},
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSAMLProfile_AppliesDefaults() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+		Return("", nil, ErrInboundClientNotFound)
+	svc := newServiceForTest(store)
+
+	p := validSAMLProfile()
+	suite.NoError(svc.ValidateSAMLProfile(context.Background(), "p1", p))
+	suite.Equal(providers.SAMLNameIDFormatUnspecified, p.NameIDFormat)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSAMLProfile_AllowsOwnEntityID() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+		Return("p1", validSAMLProfile(), nil)
+	svc := newServiceForTest(store)
+
+	suite.NoError(svc.ValidateSAMLProfile(context.Background(), "p1", validSAMLProfile()))
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSAMLProfile_RejectsDuplicateEntityID() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+		Return("other", validSAMLProfile(), nil)
+	svc := newServiceForTest(store)
+
+	err := svc.ValidateSAMLProfile(context.Background(), "p1", validSAMLProfile())
+	suite.ErrorIs(err, ErrSAMLEntityIDAlreadyExists)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSAMLProfile_Nil() {
+	svc := newServiceForTest(newInboundClientStoreInterfaceMock(suite.T()))
+	suite.NoError(svc.ValidateSAMLProfile(context.Background(), "p1", nil))
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSAMLProfileFields() {
+	certPEM := selfSignedCertPEM(suite.T())
+	tests := []struct {
+		name   string
+		mutate func(p *providers.SAMLProfile)
+		want   error
+	}{
+		{"valid with certificate", func(p *providers.SAMLProfile) {
+			p.Certificate = certPEM
+			p.RequireSignedAuthnRequests = true
+		}, nil},
+		{"missing entity ID", func(p *providers.SAMLProfile) { p.EntityID = " " }, ErrSAMLEntityIDRequired},
+		{"no ACS URLs", func(p *providers.SAMLProfile) { p.AssertionConsumerServiceURLs = nil }, ErrSAMLInvalidACSURL},
+		{"relative ACS URL", func(p *providers.SAMLProfile) {
+			p.AssertionConsumerServiceURLs = []string{"/acs"}
+		}, ErrSAMLInvalidACSURL},
+		{"non-http ACS URL", func(p *providers.SAMLProfile) {
+			p.AssertionConsumerServiceURLs = []string{"javascript:alert(1)"}
+		}, ErrSAMLInvalidACSURL},
+		{"ACS URL with fragment", func(p *providers.SAMLProfile) {
+			p.AssertionConsumerServiceURLs = []string{"https://sp.example.com/acs#x"}
+		}, ErrSAMLInvalidACSURL},
+		{"unknown NameID format", func(p *providers.SAMLProfile) { p.NameIDFormat = "urn:custom" },
+			ErrSAMLInvalidNameIDFormat},
+		{"malformed certificate", func(p *providers.SAMLProfile) { p.Certificate = "not-a-pem" },
+			ErrSAMLInvalidCertificate},
+		{"signed requests without certificate", func(p *providers.SAMLProfile) {
+			p.RequireSignedAuthnRequests = true
+		}, ErrSAMLSignedRequestsRequireCertificate},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			p := validSAMLProfile()
+			p.NameIDFormat = providers.SAMLNameIDFormatEmail
+			tc.mutate(p)
+			err := validateSAMLProfile(p)
+			if tc.want == nil {
+				suite.NoError(err)
+			} else {
+				suite.ErrorIs(err, tc.want)
+			}
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestSyncSAMLProfile_RefusesDeclarative() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(true)
+	svc := newServiceForTest(store)
+
+	err := svc.SyncSAMLProfile(context.Background(), "p1", validSAMLProfile())
+	suite.ErrorIs(err, ErrCannotModifyDeclarative)
+}
+
+func (suite *InboundClientServiceTestSuite) TestSyncSAMLProfile_CreatesWhenAbsent() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+		Return("", nil, ErrInboundClientNotFound)
+	store.EXPECT().GetSAMLProfileByEntityID(mock.Anything, "p1").Return(nil, ErrInboundClientNotFound)
+	store.EXPECT().CreateSAMLProfile(mock.Anything, "p1", mock.Anything).Return(nil)
+	svc := newServiceForTest(store)
+
+	suite.NoError(svc.SyncSAMLProfile(context.Background(), "p1", validSAMLProfile()))
+}
+
+func (suite *InboundClientServiceTestSuite) TestSyncSAMLProfile_UpdatesWhenPresent() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetSAMLProfileBySPEntityID(mock.Anything, "https://sp.example.com").
+		Return("p1", validSAMLProfile(), nil)
+	store.EXPECT().GetSAMLProfileByEntityID(mock.Anything, "p1").Return(validSAMLProfile(), nil)
+	store.EXPECT().UpdateSAMLProfile(mock.Anything, "p1", mock.Anything).Return(nil)
+	svc := newServiceForTest(store)
+
+	suite.NoError(svc.SyncSAMLProfile(context.Background(), "p1", validSAMLProfile()))
+}
+
+func (suite *InboundClientServiceTestSuite) TestSyncSAMLProfile_DeletesWhenNil() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetSAMLProfileByEntityID(mock.Anything, "p1").Return(validSAMLProfile(), nil)
+	store.EXPECT().DeleteSAMLProfile(mock.Anything, "p1").Return(nil)
+	svc := newServiceForTest(store)
+
+	suite.NoError(svc.SyncSAMLProfile(context.Background(), "p1", nil))
+}
+
+func (suite *InboundClientServiceTestSuite) TestGetSAMLProfileBySPEntityID_EmptyIssuer() {
+	svc := newServiceForTest(newInboundClientStoreInterfaceMock(suite.T()))
+
+	_, _, err := svc.GetSAMLProfileBySPEntityID(context.Background(), "")
+	suite.ErrorIs(err, ErrInboundClientNotFound)
+}
@@ -6,6 +6,7 @@ package inboundclient
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
@@ -47,6 +48,11 @@ type inboundClientStoreInterface interface {
 	DeleteInboundClient(ctx context.Context, entityID string) error
 	DeleteOAuthProfile(ctx context.Context, entityID string) error
 	InboundClientExists(ctx context.Context, entityID string) (bool, error)
+	CreateSAMLProfile(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error
+	GetSAMLProfileByEntityID(ctx context.Context, entityID string) (*providers.SAMLProfile, error)
+	GetSAMLProfileBySPEntityID(ctx context.Context, spEntityID string) (string, *providers.SAMLProfile, error)
+	UpdateSAMLProfile(ctx context.Context, entityID string, samlProfile *providers.SAMLProfile) error
+	DeleteSAMLProfile(ctx context.Context, entityID string) error
 	// IsDeclarative reports whether the inbound client with the given entity ID is sourced
 	// from a declarative (YAML) resource and therefore immutable. DB-backed stores always
 	// return false; file-based stores return true when the inbound client exists in their
@@ -425,6 +431,118 @@ func (st *store) DeleteOAuthProfile(ctx context.Context, entityID string) error
 	return nil
 }
 
+// CreateSAMLProfile creates a new SAML inbound profile entry. The service provider's entity ID
+// is stored in its own column so that incoming AuthnRequests can be resolved by issuer.
+func (st *store) CreateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *providers.SAMLProfile) error {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	profileJSON, err := marshalSAMLProfile(samlProfile)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateSAMLProfile, entityID, samlProfile.EntityID,
+		profileJSON, st.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to insert SAML profile: %w", err)
+	}
+	return nil
+}
+
+// GetSAMLProfileByEntityID retrieves a SAML profile by entity ID.
+func (st *store) GetSAMLProfileByEntityID(ctx context.Context, entityID string) (*providers.SAMLProfile, error) {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSAMLProfileByEntityID, entityID, st.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrInboundClientNotFound
+	}
+	return buildSAMLProfileFromRow(results[0])
+}
+
+// GetSAMLProfileBySPEntityID retrieves a SAML profile by the service provider's SAML entity ID
+// and returns it together with the owning entity ID.
+func (st *store) GetSAMLProfileBySPEntityID(ctx context.Context,
+	spEntityID string) (string, *providers.SAMLProfile, error) {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSAMLProfileBySPEntityID, spEntityID, st.deploymentID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return "", nil, ErrInboundClientNotFound
+	}
+	profile, err := buildSAMLProfileFromRow(results[0])
+	if err != nil {
+		return "", nil, err
+	}
+	return parseStringColumn(results[0], "entity_id"), profile, nil
+}
+
+// UpdateSAMLProfile updates a SAML profile for an entity.
+func (st *store) UpdateSAMLProfile(ctx context.Context, entityID string,
+	samlProfile *providers.SAMLProfile) error {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	profileJSON, err := marshalSAMLProfile(samlProfile)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryUpdateSAMLProfileByEntityID,
+		entityID, samlProfile.EntityID, profileJSON, st.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update SAML profile: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrInboundClientNotFound
+	}
+	return nil
+}
+
+// DeleteSAMLProfile deletes a SAML profile by entity ID.
+func (st *store) DeleteSAMLProfile(ctx context.Context, entityID string) error {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteSAMLProfileByEntityID, entityID, st.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete SAML profile: %w", err)
+	}
+	return nil
+}
+
+// marshalSAMLProfile serializes a SAMLProfile to the SAML_CONFIG JSON format.
+func marshalSAMLProfile(p *providers.SAMLProfile) (json.RawMessage, error) {
+	if p == nil {
+		return nil, errors.New("SAML profile is nil")
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SAML profile JSON: %w", err)
+	}
+	return data, nil
+}
+
 // InboundClientExists checks if an inbound client exists by entity ID.
 func (st *store) InboundClientExists(ctx context.Context, entityID string) (bool, error) {
 	dbClient, err := st.dbProvider.GetConfigDBClient()
@@ -515,6 +633,20 @@ func buildOAuthProfileFromRow(row map[string]interface{}) (*providers.OAuthProfi
 	return &p, nil
 }
 
+// buildSAMLProfileFromRow constructs a SAMLProfile from a database result row.
+// Returns nil when the row has no saml_config payload.
+func buildSAMLProfileFromRow(row map[string]interface{}) (*providers.SAMLProfile, error) {
+	profileStr := parseJSONColumnString(row, "saml_config")
+	if profileStr == "" {
+		return nil, nil
+	}
+	var p providers.SAMLProfile
+	if err := json.Unmarshal([]byte(profileStr), &p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal SAML profile JSON: %w", err)
+	}
+	return &p, nil
+}
+
 // marshalNullableJSON marshals a value to JSON, returning nil for nil/empty input.
 func marshalNullableJSON(v interface{}) (interface{}, error) {
 	if v == nil {
@@ -121,4 +121,39 @@ var (
 			`(AUTH_FLOW_ID = $1 OR REGISTRATION_FLOW_ID = $2 OR RECOVERY_FLOW_ID = $3 OR SIGNOUT_FLOW_ID = $4) ` +
 			`AND DEPLOYMENT_ID = $5`,
 	}
+
+	// queryCreateSAMLProfile creates a new SAML inbound profile entry keyed by entity ID.
+	queryCreateSAMLProfile = dbmodel.DBQuery{
+		ID: "ASQ-INBC_MGT-19",
+		Query: `INSERT INTO "SAML_INBOUND_PROFILE" (ENTITY_ID, SP_ENTITY_ID, SAML_CONFIG, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4)`,
+	}
+
+	// queryGetSAMLProfileByEntityID retrieves a SAML inbound profile by entity ID.
+	queryGetSAMLProfileByEntityID = dbmodel.DBQuery{
+		ID: "ASQ-INBC_MGT-20",
+		Query: `SELECT ENTITY_ID, SAML_CONFIG FROM "SAML_INBOUND_PROFILE" ` +
+			`WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetSAMLProfileBySPEntityID retrieves a SAML inbound profile by the service provider's
+	// SAML entity ID.
+	queryGetSAMLProfileBySPEntityID = dbmodel.DBQuery{
+		ID: "ASQ-INBC_MGT-21",
+		Query: `SELECT ENTITY_ID, SAML_CONFIG FROM "SAML_INBOUND_PROFILE" ` +
+			`WHERE SP_ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryUpdateSAMLProfileByEntityID updates a SAML inbound profile by entity ID.
+	queryUpdateSAMLProfileByEntityID = dbmodel.DBQuery{
+		ID: "ASQ-INBC_MGT-22",
+		Query: `UPDATE "SAML_INBOUND_PROFILE" SET SP_ENTITY_ID=$2, SAML_CONFIG=$3 ` +
+			`WHERE ENTITY_ID=$1 AND DEPLOYMENT_ID=$4`,
+	}
+
+	// queryDeleteSAMLProfileByEntityID deletes a SAML inbound profile by entity ID.
+	queryDeleteSAMLProfileByEntityID = dbmodel.DBQuery{
+		ID:    "ASQ-INBC_MGT-23",
+		Query: `DELETE FROM "SAML_INBOUND_PROFILE" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
 )
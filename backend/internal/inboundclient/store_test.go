@@ -883,3 +883,118 @@ func (suite *InboundClientStoreTestSuite) TestSubjectAttribute_RoundTrip() {
 	suite.Require().NotNil(result)
 	suite.Equal(mapping, result.SubjectAttribute)
 }
+
+func (suite *InboundClientStoreTestSuite) TestCreateSAMLProfile() {
+	profile := &providers.SAMLProfile{EntityID: "https://sp.example.com"}
+
+	suite.Run("stores the SP entity ID alongside the profile", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateSAMLProfile,
+			testEntityID, "https://sp.example.com", mock.Anything, testServerID).Return(int64(1), nil).Once()
+
+		suite.NoError(suite.store.CreateSAMLProfile(context.Background(), testEntityID, profile))
+	})
+
+	suite.Run("rejects nil profile", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+
+		suite.Error(suite.store.CreateSAMLProfile(context.Background(), testEntityID, nil))
+	})
+}
+
+func (suite *InboundClientStoreTestSuite) TestGetSAMLProfileByEntityID() {
+	suite.Run("returns SAML config when found", func() {
+		cfgBytes, _ := json.Marshal(providers.SAMLProfile{EntityID: "https://sp.example.com", SignResponse: true})
+		mockRow := map[string]interface{}{
+			"entity_id":   testEntityID,
+			"saml_config": string(cfgBytes),
+		}
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLProfileByEntityID,
+			testEntityID, testServerID).Return([]map[string]interface{}{mockRow}, nil).Once()
+
+		result, err := suite.store.GetSAMLProfileByEntityID(context.Background(), testEntityID)
+		suite.NoError(err)
+		suite.Require().NotNil(result)
+		suite.Equal("https://sp.example.com", result.EntityID)
+		suite.True(result.SignResponse)
+	})
+
+	suite.Run("returns ErrInboundClientNotFound when not found", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLProfileByEntityID,
+			testEntityID, testServerID).Return([]map[string]interface{}{}, nil).Once()
+
+		result, err := suite.store.GetSAMLProfileByEntityID(context.Background(), testEntityID)
+		suite.ErrorIs(err, ErrInboundClientNotFound)
+		suite.Nil(result)
+	})
+}
+
+func (suite *InboundClientStoreTestSuite) TestGetSAMLProfileBySPEntityID() {
+	suite.Run("returns owning entity ID and profile", func() {
+		cfgBytes, _ := json.Marshal(providers.SAMLProfile{EntityID: "https://sp.example.com"})
+		mockRow := map[string]interface{}{
+			"entity_id":   testEntityID,
+			"saml_config": cfgBytes,
+		}
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLProfileBySPEntityID,
+			"https://sp.example.com", testServerID).Return([]map[string]interface{}{mockRow}, nil).Once()
+
+		entityID, result, err := suite.store.GetSAMLProfileBySPEntityID(
+			context.Background(), "https://sp.example.com")
+		suite.NoError(err)
+		suite.Equal(testEntityID, entityID)
+		suite.Require().NotNil(result)
+		suite.Equal("https://sp.example.com", result.EntityID)
+	})
+
+	suite.Run("returns ErrInboundClientNotFound when not found", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetSAMLProfileBySPEntityID,
+			"unknown", testServerID).Return([]map[string]interface{}{}, nil).Once()
+
+		_, _, err := suite.store.GetSAMLProfileBySPEntityID(context.Background(), "unknown")
+		suite.ErrorIs(err, ErrInboundClientNotFound)
+	})
+}
+
+func (suite *InboundClientStoreTestSuite) TestUpdateSAMLProfile() {
+	profile := &providers.SAMLProfile{EntityID: "https://sp.example.com"}
+
+	suite.Run("successfully executes", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateSAMLProfileByEntityID,
+			testEntityID, "https://sp.example.com", mock.Anything, testServerID).Return(int64(1), nil).Once()
+
+		suite.NoError(suite.store.UpdateSAMLProfile(context.Background(), testEntityID, profile))
+	})
+
+	suite.Run("returns ErrInboundClientNotFound when no rows affected", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateSAMLProfileByEntityID,
+			testEntityID, "https://sp.example.com", mock.Anything, testServerID).Return(int64(0), nil).Once()
+
+		err := suite.store.UpdateSAMLProfile(context.Background(), testEntityID, profile)
+		suite.ErrorIs(err, ErrInboundClientNotFound)
+	})
+}
+
+func (suite *InboundClientStoreTestSuite) TestDeleteSAMLProfile() {
+	suite.Run("successfully executes", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSAMLProfileByEntityID,
+			testEntityID, testServerID).Return(int64(1), nil).Once()
+
+		suite.NoError(suite.store.DeleteSAMLProfile(context.Background(), testEntityID))
+	})
+
+	suite.Run("propagates execution error", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSAMLProfileByEntityID,
+			testEntityID, testServerID).Return(int64(0), errors.New("db error")).Once()
+
+		suite.Error(suite.store.DeleteSAMLProfile(context.Background(), testEntityID))
+	})
+}
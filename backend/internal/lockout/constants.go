@@ -0,0 +1,31 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lockout
+
+import "time"
+
+const loggerComponentName = "LockoutService"
+
+const (
+	// entityKeyPrefix prefixes the runtime store key of the failed-attempt counter of an entity.
+	entityKeyPrefix = "entity:"
+	// ipKeyPrefix prefixes the runtime store key of the failed-attempt counter of a client IP.
+	ipKeyPrefix = "ip:"
+	// versionField is the field of a stored counter compared when the counter is updated.
+	versionField = "version"
+	// maxUpdateAttempts bounds the retries of a counter update that lost a race with a concurrent one.
+	maxUpdateAttempts = 5
+)
+
+// systemAttrLockout is the entity system attribute holding the lock state of the entity.
+const systemAttrLockout = "lockout"
+
+// Defaults applied when the lockout settings are not configured.
+const (
+	defaultMaxFailedAttempts = 5
+	defaultFailureWindow     = 15 * time.Minute
+	defaultLockDuration      = 15 * time.Minute
+	defaultMaxDelay          = 30 * time.Second
+	defaultIPBlockDuration   = 15 * time.Minute
+)
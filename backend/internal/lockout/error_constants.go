@@ -0,0 +1,26 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lockout
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for lockout operations.
+var (
+	// ErrorEntityNotFound is the error returned when the entity whose lock state is requested does not
+	// exist.
+	ErrorEntityNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "LCK-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.lockoutservice.entity_not_found",
+			DefaultValue: "Entity not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.lockoutservice.entity_not_found_description",
+			DefaultValue: "The entity with the specified id does not exist",
+		},
+	}
+)
@@ -0,0 +1,38 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lockout
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize returns the lockout service configured by the lockout settings. The service is returned
+// even when lockout is disabled, in which case attempts are neither tracked nor refused, but existing
+// locks can still be inspected and cleared.
+func Initialize(
+	storeProvider providers.RuntimeStoreProvider,
+	entityProvider entityprovider.EntityProviderInterface,
+) LockoutServiceInterface {
+	return newLockoutService(newPolicy(config.GetServerRuntime().Config.Lockout),
+		newAttemptStore(storeProvider), entityProvider)
+}
+
+// newPolicy resolves the lockout policy from the configuration.
+func newPolicy(cfg config.LockoutConfig) policy {
+	return policy{
+		enabled:             cfg.IsEnabled(),
+		maxFailedAttempts:   config.PositiveIntOrDefault(cfg.MaxFailedAttempts, defaultMaxFailedAttempts),
+		failureWindow:       config.SecondsOrDefault(cfg.FailureWindow, defaultFailureWindow),
+		lockDuration:        config.SecondsOrDefault(cfg.LockDuration, defaultLockDuration),
+		maxTemporaryLocks:   max(cfg.MaxTemporaryLocks, 0),
+		initialDelay:        time.Duration(max(cfg.InitialDelay, 0)) * time.Second,
+		maxDelay:            config.SecondsOrDefault(cfg.MaxDelay, defaultMaxDelay),
+		ipMaxFailedAttempts: max(cfg.IPMaxFailedAttempts, 0),
+		ipBlockDuration:     config.SecondsOrDefault(cfg.IPBlockDuration, defaultIPBlockDuration),
+	}
+}
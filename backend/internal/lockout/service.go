@@ -0,0 +1,425 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lockout protects password and OTP verification against brute-force and password spraying
+// attacks. It counts failed attempts per entity and per client IP in the runtime store, slows down
+// repeated failures with progressive delays, and locks entities that keep failing, first temporarily
+// and eventually permanently.
+package lockout
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// LockoutServiceInterface defines the operations authenticators use to enforce the lockout policy, and
+// the operations administrators use to inspect and clear locks.
+//
+// An empty entity ID is accepted wherever the entity could not be identified, in which case only the
+// client IP of the request is tracked.
+type LockoutServiceInterface interface {
+	// ResolveEntityID returns the ID of the entity the identifiers name, either directly or as the only
+	// entity matching them, or "" when there is none or the policy is disabled.
+	ResolveEntityID(ctx context.Context, identifiers map[string]interface{}) string
+	// CheckAttempt returns the lockout refusing an authentication attempt, or nil if it may proceed.
+	CheckAttempt(ctx context.Context, entityID string) (*Lockout, *tidcommon.ServiceError)
+	// RecordFailure records a failed attempt and returns the lockout it caused, if any.
+	RecordFailure(ctx context.Context, entityID string) (*Lockout, *tidcommon.ServiceError)
+	// RecordSuccess clears the failed attempts and the lock history of the entity.
+	RecordSuccess(ctx context.Context, entityID string) *tidcommon.ServiceError
+	// GetLockStatus returns the lock state of the entity.
+	GetLockStatus(ctx context.Context, entityID string) (*LockStatus, *tidcommon.ServiceError)
+	// Unlock removes any lock on the entity and clears its failed attempts.
+	Unlock(ctx context.Context, entityID string) *tidcommon.ServiceError
+}
+
+// lockoutService is the default implementation of LockoutServiceInterface.
+type lockoutService struct {
+	policy         policy
+	attemptStore   attemptStoreInterface
+	entityProvider entityprovider.EntityProviderInterface
+	now            func() time.Time
+	logger         *log.Logger
+}
+
+// newLockoutService creates a new instance of lockoutService.
+func newLockoutService(p policy, attemptStore attemptStoreInterface,
+	entityProvider entityprovider.EntityProviderInterface) LockoutServiceInterface {
+	return &lockoutService{
+		policy:         p,
+		attemptStore:   attemptStore,
+		entityProvider: entityProvider,
+		now:            time.Now,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ResolveEntityID returns the ID of the entity the identifiers name, either directly or as the only
+// entity matching them, or "" when there is none or the policy is disabled.
+func (s *lockoutService) ResolveEntityID(ctx context.Context, identifiers map[string]interface{}) string {
+	if !s.policy.enabled || len(identifiers) == 0 {
+		return ""
+	}
+	if entityID, ok := identifiers[authnprovidercm.UserAttributeUserID].(string); ok && entityID != "" {
+		return entityID
+	}
+	entityID, epErr := s.entityProvider.IdentifyEntity(identifiers)
+	if epErr != nil || entityID == nil {
+		if epErr != nil && epErr.Code == entityprovider.ErrorCodeSystemError {
+			s.logger.Error(ctx, "Failed to identify the entity", log.String("error", epErr.Error()))
+		}
+		return ""
+	}
+	return *entityID
+}
+
+// CheckAttempt returns the lockout refusing an authentication attempt, or nil if it may proceed. A
+// blocked client IP takes precedence over the state of the entity.
+func (s *lockoutService) CheckAttempt(ctx context.Context, entityID string) (*Lockout, *tidcommon.ServiceError) {
+	if !s.policy.enabled {
+		return nil, nil
+	}
+	now := s.now()
+
+	if ipKey := s.ipKey(ctx); ipKey != "" {
+		record, err := s.attemptStore.GetAttempts(ctx, ipKey)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to check the client IP", log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		if record != nil && now.Before(record.BlockedUntil) {
+			return &Lockout{Reason: LockReasonIPBlocked, Until: record.BlockedUntil}, nil
+		}
+	}
+
+	if entityID == "" {
+		return nil, nil
+	}
+
+	entity, svcErr := s.getEntity(ctx, entityID)
+	if svcErr != nil {
+		if svcErr.Code == ErrorEntityNotFound.Code {
+			return nil, nil
+		}
+		return nil, svcErr
+	}
+	state, svcErr := s.readLockState(ctx, entity)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if state.Permanent {
+		return &Lockout{Reason: LockReasonPermanent}, nil
+	}
+	if state.isLocked(now) {
+		return &Lockout{Reason: LockReasonTemporary, Until: *state.LockedUntil}, nil
+	}
+
+	record, err := s.attemptStore.GetAttempts(ctx, entityKeyPrefix+entityID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to check the failed attempts", log.String("entityID", entityID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if record != nil && now.Before(record.NextAttemptAt) {
+		return &Lockout{Reason: LockReasonThrottled, Until: record.NextAttemptAt}, nil
+	}
+	return nil, nil
+}
+
+// RecordFailure records a failed attempt against the client IP and the entity. It returns the lockout
+// the failure caused when it locked the entity or blocked the client IP, and nil otherwise; progressive
+// delays are reported by CheckAttempt on the next attempt.
+func (s *lockoutService) RecordFailure(ctx context.Context, entityID string) (*Lockout, *tidcommon.ServiceError) {
+	if !s.policy.enabled {
+		return nil, nil
+	}
+	now := s.now()
+
+	var ipLockout *Lockout
+	if ipKey := s.ipKey(ctx); ipKey != "" {
+		record, err := s.attemptStore.RecordAttempt(ctx, ipKey, s.policy.failureWindow,
+			func(record *attemptRecord) {
+				record.Failures++
+				if record.Failures >= s.policy.ipMaxFailedAttempts && !now.Before(record.BlockedUntil) {
+					record.BlockedUntil = now.Add(s.policy.ipBlockDuration)
+				}
+			})
+		if err != nil {
+			s.logger.Error(ctx, "Failed to record the failed attempt of the client IP", log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		if record.BlockedUntil.After(now) {
+			if err := s.attemptStore.ExtendAttempts(ctx, ipKey, record.BlockedUntil.Sub(now)); err != nil {
+				s.logger.Error(ctx, "Failed to extend the client IP block", log.Error(err))
+				return nil, &tidcommon.InternalServerError
+			}
+			ipLockout = &Lockout{Reason: LockReasonIPBlocked, Until: record.BlockedUntil}
+		}
+	}
+
+	if entityID == "" {
+		return ipLockout, nil
+	}
+
+	entityKey := entityKeyPrefix + entityID
+	record, err := s.attemptStore.RecordAttempt(ctx, entityKey, s.policy.failureWindow,
+		func(record *attemptRecord) {
+			record.Failures++
+			if delay := s.policy.delayAfter(record.Failures); delay > 0 {
+				record.NextAttemptAt = now.Add(delay)
+			}
+		})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to record the failed attempt", log.String("entityID", entityID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if record.Failures < s.policy.maxFailedAttempts {
+		return ipLockout, nil
+	}
+
+	lockout, svcErr := s.lock(ctx, entityID, now)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if lockout == nil {
+		return ipLockout, nil
+	}
+	if err := s.attemptStore.DeleteAttempts(ctx, entityKey); err != nil {
+		s.logger.Error(ctx, "Failed to clear the failed attempts", log.String("entityID", entityID),
+			log.Error(err))
+	}
+	return lockout, nil
+}
+
+// RecordSuccess clears the failed attempts and the lock history of the entity, so that a later lock
+// starts again from a temporary one.
+func (s *lockoutService) RecordSuccess(ctx context.Context, entityID string) *tidcommon.ServiceError {
+	if !s.policy.enabled || entityID == "" {
+		return nil
+	}
+
+	if err := s.attemptStore.DeleteAttempts(ctx, entityKeyPrefix+entityID); err != nil {
+		s.logger.Error(ctx, "Failed to clear the failed attempts", log.String("entityID", entityID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	entity, svcErr := s.getEntity(ctx, entityID)
+	if svcErr != nil {
+		if svcErr.Code == ErrorEntityNotFound.Code {
+			return nil
+		}
+		return svcErr
+	}
+	state, svcErr := s.readLockState(ctx, entity)
+	if svcErr != nil {
+		return svcErr
+	}
+	if state.LockCount == 0 || state.isLocked(s.now()) {
+		return nil
+	}
+	return s.writeLockState(ctx, entity, nil)
+}
+
+// GetLockStatus returns the lock state of the entity.
+func (s *lockoutService) GetLockStatus(ctx context.Context, entityID string) (*LockStatus, *tidcommon.ServiceError) {
+	entity, svcErr := s.getEntity(ctx, entityID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	state, svcErr := s.readLockState(ctx, entity)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	record, err := s.attemptStore.GetAttempts(ctx, entityKeyPrefix+entityID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get the failed attempts", log.String("entityID", entityID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	status := &LockStatus{
+		Locked:    state.isLocked(s.now()),
+		Permanent: state.Permanent,
+		LockCount: state.LockCount,
+	}
+	if status.Locked && !state.Permanent {
+		status.LockedUntil = state.LockedUntil
+	}
+	if record != nil {
+		status.FailedAttempts = record.Failures
+	}
+	return status, nil
+}
+
+// Unlock removes any lock on the entity and clears its failed attempts and lock history.
+func (s *lockoutService) Unlock(ctx context.Context, entityID string) *tidcommon.ServiceError {
+	entity, svcErr := s.getEntity(ctx, entityID)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.attemptStore.DeleteAttempts(ctx, entityKeyPrefix+entityID); err != nil {
+		s.logger.Error(ctx, "Failed to clear the failed attempts", log.String("entityID", entityID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	state, svcErr := s.readLockState(ctx, entity)
+	if svcErr != nil {
+		return svcErr
+	}
+	if *state == (lockState{}) {
+		return nil
+	}
+	return s.writeLockState(ctx, entity, nil)
+}
+
+// lock locks the entity after it reached the failed-attempt threshold. The lock is temporary unless the
+// entity has already been locked temporarily as many times as the policy allows. Read-only entities are
+// not locked, since their system attributes cannot be written; they remain subject to progressive
+// delays.
+func (s *lockoutService) lock(ctx context.Context, entityID string, now time.Time) (
+	*Lockout, *tidcommon.ServiceError) {
+	entity, svcErr := s.getEntity(ctx, entityID)
+	if svcErr != nil {
+		if svcErr.Code == ErrorEntityNotFound.Code {
+			return nil, nil
+		}
+		return nil, svcErr
+	}
+	if entity.IsReadOnly {
+		s.logger.Debug(ctx, "Skipping the lock of a read-only entity", log.String("entityID", entityID))
+		return nil, nil
+	}
+
+	state, svcErr := s.readLockState(ctx, entity)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var lockout *Lockout
+	if s.policy.maxTemporaryLocks > 0 && state.LockCount >= s.policy.maxTemporaryLocks {
+		state.Permanent = true
+		state.LockedUntil = nil
+		lockout = &Lockout{Reason: LockReasonPermanent}
+	} else {
+		lockedUntil := now.Add(s.policy.lockDuration).UTC()
+		state.LockedUntil = &lockedUntil
+		state.LockCount++
+		lockout = &Lockout{Reason: LockReasonTemporary, Until: lockedUntil}
+	}
+
+	if svcErr := s.writeLockState(ctx, entity, state); svcErr != nil {
+		return nil, svcErr
+	}
+	s.logger.Info(ctx, "Locked the entity after repeated failed attempts", log.String("entityID", entityID),
+		log.String("reason", string(lockout.Reason)))
+	return lockout, nil
+}
+
+// getEntity returns the entity, or ErrorEntityNotFound if it does not exist.
+func (s *lockoutService) getEntity(ctx context.Context, entityID string) (
+	*providers.Entity, *tidcommon.ServiceError) {
+	entity, epErr := s.entityProvider.GetEntity(entityID)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return nil, &ErrorEntityNotFound
+		}
+		s.logger.Error(ctx, "Failed to get the entity", log.String("entityID", entityID),
+			log.String("error", epErr.Error()))
+		return nil, &tidcommon.InternalServerError
+	}
+	return entity, nil
+}
+
+// readLockState returns the lock state kept in the system attributes of the entity.
+func (s *lockoutService) readLockState(ctx context.Context, entity *providers.Entity) (
+	*lockState, *tidcommon.ServiceError) {
+	attrs, svcErr := s.systemAttributes(ctx, entity)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	state := &lockState{}
+	if raw, ok := attrs[systemAttrLockout]; ok {
+		if err := json.Unmarshal(raw, state); err != nil {
+			s.logger.Error(ctx, "Failed to unmarshal the lock state", log.String("entityID", entity.ID),
+				log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+	}
+	return state, nil
+}
+
+// writeLockState stores the lock state in the system attributes of the entity, removing it when state
+// is nil. The other system attributes are kept, since the update replaces all of them.
+func (s *lockoutService) writeLockState(ctx context.Context, entity *providers.Entity,
+	state *lockState) *tidcommon.ServiceError {
+	attrs, svcErr := s.systemAttributes(ctx, entity)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	if state == nil {
+		delete(attrs, systemAttrLockout)
+	} else {
+		raw, err := json.Marshal(state)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to marshal the lock state", log.String("entityID", entity.ID),
+				log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		attrs[systemAttrLockout] = raw
+	}
+
+	updated, err := json.Marshal(attrs)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to marshal the system attributes", log.String("entityID", entity.ID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if epErr := s.entityProvider.UpdateSystemAttributes(entity.ID, updated); epErr != nil {
+		s.logger.Error(ctx, "Failed to update the lock state", log.String("entityID", entity.ID),
+			log.String("error", epErr.Error()))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
+// systemAttributes returns the system attributes of the entity by name.
+func (s *lockoutService) systemAttributes(ctx context.Context, entity *providers.Entity) (
+	map[string]json.RawMessage, *tidcommon.ServiceError) {
+	attrs := make(map[string]json.RawMessage)
+	if len(entity.SystemAttributes) == 0 || string(entity.SystemAttributes) == "null" {
+		return attrs, nil
+	}
+	if err := json.Unmarshal(entity.SystemAttributes, &attrs); err != nil {
+		s.logger.Error(ctx, "Failed to unmarshal the system attributes", log.String("entityID", entity.ID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return attrs, nil
+}
+
+// ipKey returns the runtime store key of the counter of the client IP of the request, or "" when the
+// client IP is unknown or IP blocking is disabled.
+func (s *lockoutService) ipKey(ctx context.Context) string {
+	if s.policy.ipMaxFailedAttempts <= 0 {
+		return ""
+	}
+	ip := sysContext.GetClientIP(ctx)
+	if ip == "" {
+		return ""
+	}
+	return ipKeyPrefix + ip
+}
@@ -0,0 +1,259 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lockout
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+)
+
+const testEntityID = "user-1"
+
+type LockoutServiceTestSuite struct {
+	suite.Suite
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	entity             *providers.Entity
+	service            *lockoutService
+	now                time.Time
+	ctx                context.Context
+}
+
+func TestLockoutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LockoutServiceTestSuite))
+}
+
+func testPolicy() policy {
+	return policy{
+		enabled:             true,
+		maxFailedAttempts:   3,
+		failureWindow:       15 * time.Minute,
+		lockDuration:        10 * time.Minute,
+		maxTemporaryLocks:   2,
+		initialDelay:        time.Second,
+		maxDelay:            4 * time.Second,
+		ipMaxFailedAttempts: 5,
+		ipBlockDuration:     time.Hour,
+	}
+}
+
+func (suite *LockoutServiceTestSuite) SetupTest() {
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.entity = &providers.Entity{
+		ID:               testEntityID,
+		SystemAttributes: json.RawMessage(`{"credentialUpdatedAt":"2026-01-01T00:00:00Z"}`),
+	}
+	suite.now = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	suite.ctx = sysContext.WithClientIP(context.Background(), "192.0.2.10")
+	suite.newService(testPolicy())
+
+	// The mocked entity provider keeps the system attributes it is given, like the entity store does.
+	suite.mockEntityProvider.EXPECT().GetEntity(testEntityID).RunAndReturn(
+		func(string) (*providers.Entity, *entityprovider.EntityProviderError) {
+			entity := *suite.entity
+			return &entity, nil
+		}).Maybe()
+	suite.mockEntityProvider.EXPECT().UpdateSystemAttributes(testEntityID, mock.Anything).RunAndReturn(
+		func(_ string, attributes json.RawMessage) *entityprovider.EntityProviderError {
+			suite.entity.SystemAttributes = attributes
+			return nil
+		}).Maybe()
+}
+
+func (suite *LockoutServiceTestSuite) newService(p policy) {
+	suite.service = newLockoutService(p, newAttemptStore(inmemory.Initialize("test-deployment")),
+		suite.mockEntityProvider).(*lockoutService)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *LockoutServiceTestSuite) fail(times int) *Lockout {
+	var lockout *Lockout
+	for range times {
+		var svcErr *tidcommon.ServiceError
+		lockout, svcErr = suite.service.RecordFailure(suite.ctx, testEntityID)
+		suite.Require().Nil(svcErr)
+	}
+	return lockout
+}
+
+func (suite *LockoutServiceTestSuite) TestDisabledPolicy() {
+	p := testPolicy()
+	p.enabled = false
+	suite.newService(p)
+
+	suite.Empty(suite.service.ResolveEntityID(suite.ctx, map[string]interface{}{"username": "alice"}))
+	suite.Nil(suite.fail(10))
+	lockout, svcErr := suite.service.CheckAttempt(suite.ctx, testEntityID)
+	suite.Nil(svcErr)
+	suite.Nil(lockout)
+}
+
+func (suite *LockoutServiceTestSuite) TestResolveEntityID() {
+	entityID := testEntityID
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "alice"}).
+		Return(&entityID, nil)
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "mallory"}).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", ""))
+
+	suite.Equal(testEntityID, suite.service.ResolveEntityID(suite.ctx, map[string]interface{}{"username": "alice"}))
+	suite.Empty(suite.service.ResolveEntityID(suite.ctx, map[string]interface{}{"username": "mallory"}))
+	suite.Equal("user-2", suite.service.ResolveEntityID(suite.ctx, map[string]interface{}{"userID": "user-2"}))
+}
+
+func (suite *LockoutServiceTestSuite) TestProgressiveDelay() {
+	suite.Nil(suite.fail(1))
+
+	lockout, svcErr := suite.service.CheckAttempt(suite.ctx, testEntityID)
+	suite.Require().Nil(svcErr)
+	suite.Require().NotNil(lockout)
+	suite.Equal(LockReasonThrottled, lockout.Reason)
+	suite.Equal(suite.now.Add(time.Second), lockout.Until)
+
+	suite.now = suite.now.Add(time.Second)
+	lockout, svcErr = suite.service.CheckAttempt(suite.ctx, testEntityID)
+	suite.Nil(svcErr)
+	suite.Nil(lockout)
+
+	suite.Nil(suite.fail(1))
+	lockout, _ = suite.service.CheckAttempt(suite.ctx, testEntityID)
+	suite.Require().NotNil(lockout)
+	suite.Equal(suite.now.Add(2*time.Second), lockout.Until)
+}
+
+func (suite *LockoutServiceTestSuite) TestDelayAfter() {
+	p := testPolicy()
+	assert.Equal(suite.T(), time.Duration(0), p.delayAfter(0))
+	assert.Equal(suite.T(), time.Second, p.delayAfter(1))
+	assert.Equal(suite.T(), 2*time.Second, p.delayAfter(2))
+	assert.Equal(suite.T(), 4*time.Second, p.delayAfter(3))
+	assert.Equal(suite.T(), 4*time.Second, p.delayAfter(30))
+
+	p.initialDelay = 0
+	assert.Equal(suite.T(), time.Duration(0), p.delayAfter(3))
+}
+
+func (suite *LockoutServiceTestSuite) TestTemporaryLock() {
+	lockout := suite.fail(3)
+	suite.Require().NotNil(lockout)
+	suite.Equal(LockReasonTemporary, lockout.Reason)
+	suite.Equal(suite.now.Add(10*time.Minute), lockout.Until)
+
+	// The lock is kept next to the other system attributes of the entity.
+	var attrs map[string]json.RawMessage
+	suite.Require().NoError(json.Unmarshal(suite.entity.SystemAttributes, &attrs))
+	suite.Contains(attrs, "credentialUpdatedAt")
+	suite.Contains(attrs, systemAttrLockout)
+
+	checked, svcErr := suite.service.CheckAttempt(suite.ctx, testEntityID)
+	suite.Require().Nil(svcErr)
+	suite.Equal(lockout, checked)
+
+	suite.now = suite.now.Add(10 * time.Minute)
+	checked, svcErr = suite.service.CheckAttempt(suite.ctx, testEntityID)
+	suite.Nil(svcErr)
+	suite.Nil(checked, "the lock and the failed attempts before it must no longer apply")
+}
+
+func (suite *LockoutServiceTestSuite) TestPermanentLock() {
+	for range 2 {
+		suite.Require().Equal(LockReasonTemporary, suite.fail(3).Reason)
+		suite.now = suite.now.Add(10 * time.Minute)
+	}
+
+	lockout := suite.fail(3)
+	suite.Require().NotNil(lockout)
+	suite.Equal(LockReasonPermanent, lockout.Reason)
+	suite.True(lockout.Until.IsZero())
+
+	suite.now = suite.now.Add(24 * time.Hour)
+	checked, _ := suite.service.CheckAttempt(suite.ctx, testEntityID)
+	suite.Require().NotNil(checked)
+	suite.Equal(LockReasonPermanent, checked.Reason)
+
+	status, svcErr := suite.service.GetLockStatus(suite.ctx, testEntityID)
+	suite.Require().Nil(svcErr)
+	suite.True(status.Locked)
+	suite.True(status.Permanent)
+	suite.Nil(status.LockedUntil)
+	suite.Equal(2, status.LockCount)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordSuccessResetsLockHistory() {
+	suite.Require().Equal(LockReasonTemporary, suite.fail(3).Reason)
+	suite.now = suite.now.Add(10 * time.Minute)
+	suite.Nil(suite.fail(1))
+
+	suite.Require().Nil(suite.service.RecordSuccess(suite.ctx, testEntityID))
+
+	status, svcErr := suite.service.GetLockStatus(suite.ctx, testEntityID)
+	suite.Require().Nil(svcErr)
+	suite.Equal(LockStatus{}, *status)
+	suite.JSONEq(`{"credentialUpdatedAt":"2026-01-01T00:00:00Z"}`, string(suite.entity.SystemAttributes))
+}
+
+func (suite *LockoutServiceTestSuite) TestUnlock() {
+	suite.Require().NotNil(suite.fail(3))
+
+	suite.Require().Nil(suite.service.Unlock(suite.ctx, testEntityID))
+
+	lockout, svcErr := suite.service.CheckAttempt(suite.ctx, testEntityID)
+	suite.Nil(svcErr)
+	suite.Nil(lockout)
+	suite.JSONEq(`{"credentialUpdatedAt":"2026-01-01T00:00:00Z"}`, string(suite.entity.SystemAttributes))
+}
+
+func (suite *LockoutServiceTestSuite) TestUnlock_EntityNotFound() {
+	suite.mockEntityProvider.On("GetEntity", "missing").
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", ""))
+
+	suite.Equal(&ErrorEntityNotFound, suite.service.Unlock(suite.ctx, "missing"))
+	_, svcErr := suite.service.GetLockStatus(suite.ctx, "missing")
+	suite.Equal(&ErrorEntityNotFound, svcErr)
+}
+
+func (suite *LockoutServiceTestSuite) TestReadOnlyEntityIsNotLocked() {
+	suite.entity.IsReadOnly = true
+
+	suite.Nil(suite.fail(3))
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "UpdateSystemAttributes", mock.Anything, mock.Anything)
+
+	lockout, _ := suite.service.CheckAttempt(suite.ctx, testEntityID)
+	suite.Require().NotNil(lockout)
+	suite.Equal(LockReasonThrottled, lockout.Reason)
+}
+
+func (suite *LockoutServiceTestSuite) TestIPBlock() {
+	for range 4 {
+		lockout, svcErr := suite.service.RecordFailure(suite.ctx, "")
+		suite.Require().Nil(svcErr)
+		suite.Nil(lockout)
+	}
+
+	lockout, svcErr := suite.service.RecordFailure(suite.ctx, "")
+	suite.Require().Nil(svcErr)
+	suite.Require().NotNil(lockout)
+	suite.Equal(LockReasonIPBlocked, lockout.Reason)
+	suite.Equal(suite.now.Add(time.Hour), lockout.Until)
+
+	// Every entity is refused from the blocked IP, and no other IP is affected.
+	checked, _ := suite.service.CheckAttempt(suite.ctx, "another-user")
+	suite.Require().NotNil(checked)
+	suite.Equal(LockReasonIPBlocked, checked.Reason)
+
+	otherCtx := sysContext.WithClientIP(context.Background(), "198.51.100.7")
+	checked, _ = suite.service.CheckAttempt(otherCtx, "")
+	suite.Nil(checked)
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lockout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// attemptStoreInterface keeps the failed-attempt counters of entities and client IPs.
+type attemptStoreInterface interface {
+	// GetAttempts returns the counter stored under key, or nil when there is none.
+	GetAttempts(ctx context.Context, key string) (*attemptRecord, error)
+	// RecordAttempt applies update to the counter stored under key, creating the counter with the
+	// given window as its lifetime when there is none, and returns the updated counter.
+	RecordAttempt(ctx context.Context, key string, window time.Duration,
+		update func(record *attemptRecord)) (*attemptRecord, error)
+	// ExtendAttempts keeps the counter stored under key for the given duration from now.
+	ExtendAttempts(ctx context.Context, key string, ttl time.Duration) error
+	// DeleteAttempts removes the counter stored under key.
+	DeleteAttempts(ctx context.Context, key string) error
+}
+
+// attemptStore is the runtime store backed attemptStoreInterface.
+type attemptStore struct {
+	storeProvider providers.RuntimeStoreProvider
+}
+
+// newAttemptStore returns an attemptStoreInterface backed by the configured runtime store.
+func newAttemptStore(storeProvider providers.RuntimeStoreProvider) attemptStoreInterface {
+	return &attemptStore{
+		storeProvider: storeProvider,
+	}
+}
+
+// GetAttempts returns the counter stored under key, or nil when there is none.
+func (s *attemptStore) GetAttempts(ctx context.Context, key string) (*attemptRecord, error) {
+	value, err := s.storeProvider.Get(ctx, providers.NamespaceLockout, key)
+	if err != nil {
+		if errors.Is(err, providers.ErrRuntimeStoreKeyNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get failed attempts: %w", err)
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var record attemptRecord
+	if err := json.Unmarshal(value, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal failed attempts: %w", err)
+	}
+	return &record, nil
+}
+
+// RecordAttempt applies update to the counter stored under key. A new counter is inserted only if no
+// other request inserted one first, and an existing counter is replaced only if it was not updated
+// since it was read, so that failures recorded concurrently are all counted.
+func (s *attemptStore) RecordAttempt(ctx context.Context, key string, window time.Duration,
+	update func(record *attemptRecord)) (*attemptRecord, error) {
+	for range maxUpdateAttempts {
+		current, err := s.GetAttempts(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		record := attemptRecord{}
+		if current != nil {
+			record = *current
+		}
+		update(&record)
+		record.Version = nextVersion(current)
+
+		value, err := json.Marshal(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal failed attempts: %w", err)
+		}
+
+		var stored bool
+		if current == nil {
+			stored, err = s.storeProvider.PutIfNotExists(ctx, providers.NamespaceLockout, key, value,
+				int64(window.Seconds()))
+		} else {
+			stored, err = s.storeProvider.CompareFieldAndSwap(ctx, providers.NamespaceLockout, key,
+				versionField, current.Version, value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to store failed attempts: %w", err)
+		}
+		if stored {
+			return &record, nil
+		}
+	}
+	return nil, errors.New("failed to store failed attempts: too many concurrent updates")
+}
+
+// ExtendAttempts keeps the counter stored under key for the given duration from now.
+func (s *attemptStore) ExtendAttempts(ctx context.Context, key string, ttl time.Duration) error {
+	err := s.storeProvider.ExtendTTL(ctx, providers.NamespaceLockout, key, int64(ttl.Seconds()))
+	if err != nil && !errors.Is(err, providers.ErrRuntimeStoreKeyNotFound) {
+		return fmt.Errorf("failed to extend failed attempts: %w", err)
+	}
+	return nil
+}
+
+// DeleteAttempts removes the counter stored under key.
+func (s *attemptStore) DeleteAttempts(ctx context.Context, key string) error {
+	err := s.storeProvider.Delete(ctx, providers.NamespaceLockout, key)
+	if err != nil && !errors.Is(err, providers.ErrRuntimeStoreKeyNotFound) {
+		return fmt.Errorf("failed to delete failed attempts: %w", err)
+	}
+	return nil
+}
+
+// nextVersion returns the version of the counter that replaces current.
+func nextVersion(current *attemptRecord) string {
+	if current == nil {
+		return "1"
+	}
+	version, _ := strconv.Atoi(current.Version)
+	return strconv.Itoa(version + 1)
+}
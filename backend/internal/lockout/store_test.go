@@ -0,0 +1,104 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lockout
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/runtimestoreprovidermock"
+)
+
+// AttemptStoreTestSuite exercises the attemptStore adapter against a real in-memory runtime store.
+type AttemptStoreTestSuite struct {
+	suite.Suite
+	store *attemptStore
+	ctx   context.Context
+}
+
+func TestAttemptStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(AttemptStoreTestSuite))
+}
+
+func (suite *AttemptStoreTestSuite) SetupTest() {
+	suite.store = &attemptStore{storeProvider: inmemory.Initialize("test-deployment")}
+	suite.ctx = context.Background()
+}
+
+func increment(record *attemptRecord) {
+	record.Failures++
+}
+
+func (suite *AttemptStoreTestSuite) TestGetAttempts_NotFound() {
+	record, err := suite.store.GetAttempts(suite.ctx, "entity:user-1")
+	suite.Require().NoError(err)
+	suite.Nil(record)
+}
+
+func (suite *AttemptStoreTestSuite) TestRecordAttempt_CreatesAndUpdates() {
+	record, err := suite.store.RecordAttempt(suite.ctx, "entity:user-1", time.Minute, increment)
+	suite.Require().NoError(err)
+	suite.Equal(1, record.Failures)
+	suite.Equal("1", record.Version)
+
+	record, err = suite.store.RecordAttempt(suite.ctx, "entity:user-1", time.Minute, increment)
+	suite.Require().NoError(err)
+	suite.Equal(2, record.Failures)
+	suite.Equal("2", record.Version)
+
+	stored, err := suite.store.GetAttempts(suite.ctx, "entity:user-1")
+	suite.Require().NoError(err)
+	suite.Equal(2, stored.Failures)
+}
+
+func (suite *AttemptStoreTestSuite) TestDeleteAttempts() {
+	_, err := suite.store.RecordAttempt(suite.ctx, "ip:192.0.2.10", time.Minute, increment)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.store.DeleteAttempts(suite.ctx, "ip:192.0.2.10"))
+	suite.Require().NoError(suite.store.DeleteAttempts(suite.ctx, "ip:192.0.2.10"))
+
+	record, err := suite.store.GetAttempts(suite.ctx, "ip:192.0.2.10")
+	suite.Require().NoError(err)
+	suite.Nil(record)
+}
+
+func (suite *AttemptStoreTestSuite) TestExtendAttempts_MissingKey() {
+	suite.NoError(suite.store.ExtendAttempts(suite.ctx, "ip:192.0.2.10", time.Minute))
+}
+
+func (suite *AttemptStoreTestSuite) TestRecordAttempt_RetriesConcurrentUpdate() {
+	storeProvider := runtimestoreprovidermock.NewRuntimeStoreProviderMock(suite.T())
+	storeProvider.On("Get", mock.Anything, providers.NamespaceLockout, "entity:user-1").
+		Return([]byte(`{"version":"3","failures":3}`), nil).Once()
+	storeProvider.On("CompareFieldAndSwap", mock.Anything, providers.NamespaceLockout, "entity:user-1",
+		versionField, "3", mock.Anything).Return(false, nil).Once()
+	storeProvider.On("Get", mock.Anything, providers.NamespaceLockout, "entity:user-1").
+		Return([]byte(`{"version":"4","failures":4}`), nil).Once()
+	storeProvider.On("CompareFieldAndSwap", mock.Anything, providers.NamespaceLockout, "entity:user-1",
+		versionField, "4", mock.Anything).Return(true, nil).Once()
+
+	store := &attemptStore{storeProvider: storeProvider}
+	record, err := store.RecordAttempt(suite.ctx, "entity:user-1", time.Minute, increment)
+	suite.Require().NoError(err)
+	suite.Equal(5, record.Failures)
+	suite.Equal("5", record.Version)
+}
+
+func (suite *AttemptStoreTestSuite) TestRecordAttempt_StoreError() {
+	storeProvider := runtimestoreprovidermock.NewRuntimeStoreProviderMock(suite.T())
+	storeProvider.On("Get", mock.Anything, providers.NamespaceLockout, "entity:user-1").
+		Return(nil, errors.New("connection refused"))
+
+	store := &attemptStore{storeProvider: storeProvider}
+	_, err := store.RecordAttempt(suite.ctx, "entity:user-1", time.Minute, increment)
+	suite.Error(err)
+}
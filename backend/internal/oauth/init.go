@@ -14,20 +14,27 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/jwks"
 	oauth2authz "github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/callback"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/checksession"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/frontchannellogout"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/granthandlers"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/introspect"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	oauth2logout "github.com/thunder-id/thunderid/internal/oauth/oauth2/logout"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/responsemode"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/token"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/userinfo"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	"github.com/thunder-id/thunderid/internal/saml"
 	syshttp "github.com/thunder-id/thunderid/internal/system/http"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
@@ -55,6 +62,9 @@ func Initialize(
 	transactioner providers.Transactioner,
 	enforcementService revocation.EnforcementServiceInterface,
 	revocationSvc revocation.RevocationServiceInterface,
+	samlService saml.SAMLServiceInterface,
+	frontchannelLogout frontchannellogout.FrontchannelLogoutServiceInterface,
+	pairwiseService pairwise.PairwiseSubjectServiceInterface,
 	cfg oauthconfig.Config,
 ) (tokenservice.TokenValidatorInterface, error) {
 	jwks.Initialize(mux, runtimeCrypto)
@@ -77,11 +87,15 @@ func Initialize(
 	}
 
 	tokenBuilder, tokenValidator := tokenservice.Initialize(
-		cfg, jwtService, jweService, resolver, idpService, enforcementService, jtiStore)
+		cfg, jwtService, jweService, resolver, idpService, enforcementService, jtiStore, pairwiseService)
+	requestObjectService := requestobject.Initialize(jwtService, jweService, resolver, httpClient, cfg)
 	parService := par.Initialize(mux, actorProvider, authnProvider, jwtService, discoveryService,
-		resourceService, dpopVerifier, cfg, runtimeStore, jtiStore)
+		resourceService, requestObjectService, dpopVerifier, cfg, runtimeStore, jtiStore)
+	responseModeService := responsemode.Initialize(mux, jwtService, jweService, resolver, actorProvider,
+		runtimeStore, cfg)
 	oauth2AuthzService, err := oauth2authz.Initialize(mux, actorProvider, resourceService,
-		jwtService, flowExecService, parService, revocationSvc, cfg, runtimeStore, transactioner)
+		jwtService, flowExecService, parService, requestObjectService, revocationSvc, responseModeService, cfg,
+		runtimeStore, transactioner)
 	if err != nil {
 		return nil, err
 	}
@@ -90,25 +104,35 @@ func Initialize(
 	if len(cfg.OAuth.AllowedGrantTypes) == 0 ||
 		slices.Contains(cfg.OAuth.AllowedGrantTypes, string(providers.GrantTypeCIBA)) {
 		cibaService = ciba.Initialize(mux, jwtService, actorProvider, authnProvider, flowExecService,
+			discoveryService, resourceService, runtimeStore, jtiStore, pairwiseService, cfg)
+	}
+
+	var deviceService device.DeviceServiceInterface
+	if len(cfg.OAuth.AllowedGrantTypes) == 0 ||
+		slices.Contains(cfg.OAuth.AllowedGrantTypes, string(providers.GrantTypeDeviceCode)) {
+		deviceService = device.Initialize(mux, jwtService, actorProvider, authnProvider, flowExecService,
 			discoveryService, resourceService, runtimeStore, jtiStore, cfg)
 	}
 
 	grantHandlerProvider := granthandlers.Initialize(
 		jwtService, oauth2AuthzService, tokenBuilder, tokenValidator,
 		attributeCacheSvc, ouService, authzService, actorProvider, resourceService,
-		cibaService, revocationSvc, revocationSvc, cfg)
+		cibaService, deviceService, revocationSvc, revocationSvc, cfg)
 
 	token.Initialize(mux, jwtService, actorProvider, authnProvider, grantHandlerProvider,
 		scopeValidator, observabilitySvc, discoveryService, dpopVerifier, jtiStore, cfg)
 	introspect.Initialize(mux, jwtService, actorProvider, authnProvider, discoveryService, tokenValidator,
-		jtiStore, cfg.JWT.Leeway)
+		jtiStore, pairwiseService, cfg.JWT.Leeway)
 	userinfo.Initialize(mux, jwtService, jweService, resolver,
 		tokenValidator, actorProvider, attributeCacheSvc,
-		discoveryService, dpopVerifier, cfg)
-	callback.Initialize(mux, oauth2AuthzService, cibaService, cfg)
+		discoveryService, dpopVerifier, pairwiseService, cfg)
+	callback.Initialize(mux, oauth2AuthzService, cibaService, deviceService, samlService, responseModeService,
+		cfg)
 
 	if cfg.OAuth.Logout.IsEnabled() {
-		oauth2logout.Initialize(mux, jwtService, actorProvider, flowExecService, runtimeStore, cfg)
+		oauth2logout.Initialize(mux, jwtService, actorProvider, flowExecService, frontchannelLogout,
+			runtimeStore, pairwiseService, cfg)
+		checksession.Initialize(mux)
 	}
 	return tokenValidator, nil
 }
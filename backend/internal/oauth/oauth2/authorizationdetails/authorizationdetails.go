@@ -0,0 +1,219 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package authorizationdetails provides shared helpers for RFC 9396 rich authorization request
+// processing across the authorization, pushed authorization, backchannel authentication and token
+// endpoints.
+package authorizationdetails
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/google/jsonschema-go/jsonschema"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+)
+
+// Parse parses the authorization_details request parameter (RFC 9396 §2). It returns nil when the
+// parameter is absent. The value must be a JSON array of objects, each carrying a non-empty string
+// "type" member; anything else is rejected with invalid_authorization_details.
+func Parse(raw string) ([]model.AuthorizationDetail, *model.ErrorResponse) {
+	if raw == "" {
+		return nil, nil
+	}
+	var details []model.AuthorizationDetail
+	if err := json.Unmarshal([]byte(raw), &details); err != nil {
+		return nil, invalidDetails("The authorization_details parameter must be a JSON array of objects")
+	}
+	if len(details) == 0 {
+		return nil, invalidDetails("The authorization_details parameter must not be empty")
+	}
+	for _, detail := range details {
+		if detail == nil || detail.Type() == "" {
+			return nil, invalidDetails("Each authorization detail must contain a type")
+		}
+	}
+	return details, nil
+}
+
+// ResolveAndValidate binds the requested authorization details to a resource server and validates
+// them against it. targetRS is the resource server the request is already bound to; when it is nil
+// the single target resource server is resolved from resources (RFC 8707). It returns the resource
+// server the details are bound to, which is targetRS unchanged when no details are requested.
+func ResolveAndValidate(
+	ctx context.Context,
+	resourceService providers.ResourceServerProvider,
+	resources []string,
+	targetRS *providers.ResourceServer,
+	details []model.AuthorizationDetail,
+) (*providers.ResourceServer, *model.ErrorResponse) {
+	if len(details) == 0 {
+		return targetRS, nil
+	}
+	if targetRS == nil {
+		rs, errResp := resourceindicators.ResolveTargetResourceServer(ctx, resourceService, resources)
+		if errResp != nil {
+			return nil, errResp
+		}
+		targetRS = rs
+	}
+	if errResp := Validate(targetRS, details); errResp != nil {
+		return nil, errResp
+	}
+	return targetRS, nil
+}
+
+// Validate checks each authorization detail against the types registered on the resource server
+// (RFC 9396 §5): the type must be registered, the detail must satisfy the type's JSON schema, and
+// when the detail names locations the resource server's identifier must be among them.
+func Validate(rs *providers.ResourceServer, details []model.AuthorizationDetail) *model.ErrorResponse {
+	if len(details) == 0 {
+		return nil
+	}
+	if rs == nil {
+		return invalidDetails("The authorization details are not supported by the target resource")
+	}
+	for _, detail := range details {
+		detailType := findType(rs.AuthorizationDetailTypes, detail.Type())
+		if detailType == nil {
+			return invalidDetails("Unsupported authorization details type: " + detail.Type())
+		}
+		if locations := detail.Locations(); len(locations) > 0 && !slices.Contains(locations, rs.Identifier) {
+			return invalidDetails("The authorization details locations do not include the target resource")
+		}
+		if len(detailType.Schema) == 0 {
+			continue
+		}
+		resolved, err := resolveSchema(detailType.Schema)
+		if err != nil {
+			return &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to validate authorization details",
+			}
+		}
+		if err := resolved.Validate(map[string]interface{}(detail)); err != nil {
+			return invalidDetails("The authorization details do not conform to the type " + detail.Type())
+		}
+	}
+	return nil
+}
+
+// IsSubset reports whether every detail in requested is also present in granted. Details are
+// compared by their canonical JSON form, so a client may narrow but never widen the authorization
+// details of a grant at the token endpoint (RFC 9396 §6.1).
+func IsSubset(requested, granted []model.AuthorizationDetail) bool {
+	grantedSet := make(map[string]struct{}, len(granted))
+	for _, detail := range granted {
+		canonical, err := Canonicalize(detail)
+		if err != nil {
+			return false
+		}
+		grantedSet[canonical] = struct{}{}
+	}
+	for _, detail := range requested {
+		canonical, err := Canonicalize(detail)
+		if err != nil {
+			return false
+		}
+		if _, ok := grantedSet[canonical]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Narrow resolves the authorization details granted at the token endpoint. When the token request
+// carries no authorization_details parameter the details of the grant are returned unchanged;
+// otherwise the requested details must be a subset of the grant's details and are returned in their
+// place (RFC 9396 §6.1).
+func Narrow(raw string, granted []model.AuthorizationDetail) ([]model.AuthorizationDetail, *model.ErrorResponse) {
+	requested, errResp := Parse(raw)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if requested == nil {
+		return granted, nil
+	}
+	if !IsSubset(requested, granted) {
+		return nil, invalidDetails("The requested authorization details exceed those of the grant")
+	}
+	return requested, nil
+}
+
+// Canonicalize returns the canonical JSON form of an authorization detail, with object members
+// sorted by name, so that equal details always produce the same string.
+func Canonicalize(detail model.AuthorizationDetail) (string, error) {
+	data, err := json.Marshal(detail)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Serialize returns the JSON array form of the authorization details, or an empty string when there
+// are none.
+func Serialize(details []model.AuthorizationDetail) (string, error) {
+	if len(details) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(details)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// FromClaim converts an authorization_details claim value decoded from a JWT back into
+// authorization details. It returns nil when the claim is absent or malformed.
+func FromClaim(value interface{}) []model.AuthorizationDetail {
+	raw, ok := value.([]interface{})
+	if !ok || len(raw) == 0 {
+		return nil
+	}
+	details := make([]model.AuthorizationDetail, 0, len(raw))
+	for _, entry := range raw {
+		obj, ok := entry.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		details = append(details, model.AuthorizationDetail(obj))
+	}
+	return details
+}
+
+// findType returns the registered authorization details type with the given name, or nil.
+func findType(types []providers.AuthorizationDetailType, name string) *providers.AuthorizationDetailType {
+	for i := range types {
+		if types[i].Type == name {
+			return &types[i]
+		}
+	}
+	return nil
+}
+
+// resolveSchema converts a registered JSON schema into a resolved schema ready for validation.
+func resolveSchema(schemaDoc map[string]interface{}) (*jsonschema.Resolved, error) {
+	data, err := json.Marshal(schemaDoc)
+	if err != nil {
+		return nil, err
+	}
+	var schema jsonschema.Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+	return schema.Resolve(nil)
+}
+
+// invalidDetails builds an invalid_authorization_details error response.
+func invalidDetails(description string) *model.ErrorResponse {
+	return &model.ErrorResponse{
+		Error:            constants.ErrorInvalidAuthorizationDetails,
+		ErrorDescription: description,
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authorizationdetails
+
+import (
+	"context"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+)
+
+const testPaymentDetails = `[{"type":"payment_initiation","instructedAmount":{"currency":"EUR","amount":"123.50"},` +
+	`"locations":["https://api.example.com/payments"]}]`
+
+type AuthorizationDetailsTestSuite struct {
+	suite.Suite
+	mockResourceService *resourcemock.ResourceServiceInterfaceMock
+	rs                  *providers.ResourceServer
+}
+
+func TestAuthorizationDetailsTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationDetailsTestSuite))
+}
+
+func (suite *AuthorizationDetailsTestSuite) SetupTest() {
+	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.rs = &providers.ResourceServer{
+		ID:         "rs01",
+		Identifier: "https://api.example.com/payments",
+		AuthorizationDetailTypes: []providers.AuthorizationDetailType{
+			{
+				Type: "payment_initiation",
+				Schema: map[string]interface{}{
+					"type":     "object",
+					"required": []interface{}{"instructedAmount"},
+					"properties": map[string]interface{}{
+						"instructedAmount": map[string]interface{}{
+							"type":     "object",
+							"required": []interface{}{"currency", "amount"},
+						},
+					},
+				},
+			},
+			{Type: "account_information"},
+		},
+	}
+}
+
+// Parse tests
+
+func (suite *AuthorizationDetailsTestSuite) TestParse_Empty() {
+	details, errResp := Parse("")
+	assert.Nil(suite.T(), errResp)
+	assert.Nil(suite.T(), details)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestParse_Valid() {
+	details, errResp := Parse(testPaymentDetails)
+	assert.Nil(suite.T(), errResp)
+	assert.Len(suite.T(), details, 1)
+	assert.Equal(suite.T(), "payment_initiation", details[0].Type())
+	assert.Equal(suite.T(), []string{"https://api.example.com/payments"}, details[0].Locations())
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestParse_Invalid() {
+	cases := []string{
+		`{"type":"payment_initiation"}`,
+		`[]`,
+		`["payment_initiation"]`,
+		`[{"actions":["read"]}]`,
+		`[{"type":""}]`,
+		`[{"type":42}]`,
+		`not-json`,
+	}
+	for _, raw := range cases {
+		details, errResp := Parse(raw)
+		assert.Nil(suite.T(), details, raw)
+		if assert.NotNil(suite.T(), errResp, raw) {
+			assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error, raw)
+		}
+	}
+}
+
+// Validate tests
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_Valid() {
+	details, _ := Parse(testPaymentDetails)
+	assert.Nil(suite.T(), Validate(suite.rs, details))
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_TypeWithoutSchema() {
+	details, _ := Parse(`[{"type":"account_information","actions":["list_accounts"]}]`)
+	assert.Nil(suite.T(), Validate(suite.rs, details))
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_NilResourceServer() {
+	details, _ := Parse(testPaymentDetails)
+	errResp := Validate(nil, details)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_UnknownType() {
+	details, _ := Parse(`[{"type":"customer_information"}]`)
+	errResp := Validate(suite.rs, details)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+	assert.Contains(suite.T(), errResp.ErrorDescription, "customer_information")
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_SchemaViolation() {
+	details, _ := Parse(`[{"type":"payment_initiation","instructedAmount":{"currency":"EUR"}}]`)
+	errResp := Validate(suite.rs, details)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_LocationMismatch() {
+	details, _ := Parse(`[{"type":"account_information","locations":["https://other.example.com"]}]`)
+	errResp := Validate(suite.rs, details)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+}
+
+// ResolveAndValidate tests
+
+func (suite *AuthorizationDetailsTestSuite) TestResolveAndValidate_NoDetailsKeepsTarget() {
+	rs, errResp := ResolveAndValidate(context.Background(), suite.mockResourceService, nil, suite.rs, nil)
+	assert.Nil(suite.T(), errResp)
+	assert.Same(suite.T(), suite.rs, rs)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestResolveAndValidate_ResolvesDefaultResourceServer() {
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, "").Return(suite.rs, nil)
+	details, _ := Parse(testPaymentDetails)
+
+	rs, errResp := ResolveAndValidate(context.Background(), suite.mockResourceService, nil, nil, details)
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), suite.rs, rs)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestResolveAndValidate_UnknownResource() {
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, "https://unknown.example.com").
+		Return(nil, &tidcommon.ServiceError{Type: tidcommon.ClientErrorType})
+	details, _ := Parse(testPaymentDetails)
+
+	rs, errResp := ResolveAndValidate(context.Background(), suite.mockResourceService,
+		[]string{"https://unknown.example.com"}, nil, details)
+	assert.Nil(suite.T(), rs)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidTarget, errResp.Error)
+}
+
+// IsSubset tests
+
+func (suite *AuthorizationDetailsTestSuite) TestIsSubset() {
+	granted, _ := Parse(`[{"type":"account_information","actions":["list_accounts"]},` +
+		`{"type":"payment_initiation","instructedAmount":{"currency":"EUR","amount":"1.00"}}]`)
+	narrowed, _ := Parse(`[{"actions":["list_accounts"],"type":"account_information"}]`)
+	widened, _ := Parse(`[{"type":"account_information","actions":["list_accounts","read_balances"]}]`)
+
+	assert.True(suite.T(), IsSubset(narrowed, granted))
+	assert.True(suite.T(), IsSubset(nil, granted))
+	assert.False(suite.T(), IsSubset(widened, granted))
+}
+
+// Narrow tests
+
+func (suite *AuthorizationDetailsTestSuite) TestNarrow() {
+	granted, _ := Parse(`[{"type":"account_information","actions":["list_accounts"]},` +
+		`{"type":"payment_initiation","instructedAmount":{"currency":"EUR","amount":"1.00"}}]`)
+
+	details, errResp := Narrow("", granted)
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), granted, details)
+
+	details, errResp = Narrow(`[{"type":"account_information","actions":["list_accounts"]}]`, granted)
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), granted[:1], details)
+
+	details, errResp = Narrow(`[{"type":"account_information","actions":["read_balances"]}]`, granted)
+	assert.Nil(suite.T(), details)
+	if assert.NotNil(suite.T(), errResp) {
+		assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+	}
+
+	details, errResp = Narrow(`[{"type":"account_information"}]`, nil)
+	assert.Nil(suite.T(), details)
+	assert.NotNil(suite.T(), errResp)
+}
+
+// Serialize and FromClaim tests
+
+func (suite *AuthorizationDetailsTestSuite) TestSerializeAndFromClaim() {
+	empty, err := Serialize(nil)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), empty)
+
+	details, _ := Parse(testPaymentDetails)
+	serialized, err := Serialize(details)
+	assert.NoError(suite.T(), err)
+
+	reparsed, errResp := Parse(serialized)
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), details, reparsed)
+
+	claim := []interface{}{map[string]interface{}(details[0])}
+	assert.Equal(suite.T(), details, FromClaim(claim))
+	assert.Nil(suite.T(), FromClaim("not-an-array"))
+	assert.Nil(suite.T(), FromClaim([]interface{}{"not-an-object"}))
+	assert.Equal(suite.T(), []model.AuthorizationDetail(nil), FromClaim(nil))
+}
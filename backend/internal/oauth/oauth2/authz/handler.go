@@ -12,6 +12,7 @@ import (
 
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/responsemode"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
@@ -27,14 +28,17 @@ type AuthorizeHandlerInterface interface {
 type authorizeHandler struct {
 	cfg          oauthconfig.Config
 	authZService AuthorizeServiceInterface
+	responseMode responsemode.ResponseModeServiceInterface
 	logger       *log.Logger
 }
 
 // newAuthorizeHandler creates a new instance of authorizeHandler with injected dependencies.
-func newAuthorizeHandler(authZService AuthorizeServiceInterface, cfg oauthconfig.Config) AuthorizeHandlerInterface {
+func newAuthorizeHandler(authZService AuthorizeServiceInterface,
+	responseMode responsemode.ResponseModeServiceInterface, cfg oauthconfig.Config) AuthorizeHandlerInterface {
 	return &authorizeHandler{
 		cfg:          cfg,
 		authZService: authZService,
+		responseMode: responseMode,
 		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeHandler")),
 	}
 }
@@ -58,7 +62,7 @@ func (ah *authorizeHandler) HandleAuthorizeGetRequest(w http.ResponseWriter, r *
 			if authErr.State != "" {
 				queryParams[oauth2const.RequestParamState] = authErr.State
 			}
-			redirectURI, err := oauth2utils.GetURIWithQueryParams(authErr.ClientRedirectURI, queryParams)
+			redirectURI, err := ah.responseMode.BuildResponseURI(ctx, authErr.ClientResponse(queryParams))
 			if err != nil {
 				ah.logger.Error(ctx, "Failed to construct client redirect URI", log.Error(err))
 				ah.redirectToErrorPage(w, r, oauth2const.ErrorServerError, "Failed to process authorization request")
@@ -314,7 +318,7 @@ func (ah *authorizeHandler) writeAuthZResponseToClientRedirect(
 		queryParams[oauth2const.RequestParamState] = authErr.State
 	}
 
-	redirectURI, err := oauth2utils.GetURIWithQueryParams(authErr.ClientRedirectURI, queryParams)
+	redirectURI, err := ah.responseMode.BuildResponseURI(ctx, authErr.ClientResponse(queryParams))
 	if err != nil {
 		ah.logger.Error(ctx, "Failed to construct client redirect URI", log.Error(err))
 		ah.writeAuthZResponseToErrorPage(ctx, w, oauth2const.ErrorServerError,
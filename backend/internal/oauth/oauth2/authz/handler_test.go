@@ -71,7 +71,8 @@ func (suite *AuthorizeHandlerTestSuite) SetupTest() {
 	_ = config.InitializeServerRuntime("test", testConfig)
 
 	suite.mockAuthzService = NewAuthorizeServiceInterfaceMock(suite.T())
-	suite.handler = newAuthorizeHandler(suite.mockAuthzService, authorizeServiceCfgFromRuntime()).(*authorizeHandler)
+	suite.handler = newAuthorizeHandler(suite.mockAuthzService, newTestResponseModeService(),
+		authorizeServiceCfgFromRuntime()).(*authorizeHandler)
 }
 
 func (suite *AuthorizeHandlerTestSuite) TearDownTest() {
@@ -80,7 +81,7 @@ func (suite *AuthorizeHandlerTestSuite) TearDownTest() {
 
 func (suite *AuthorizeHandlerTestSuite) TestnewAuthorizeHandler() {
 	mockSvc := NewAuthorizeServiceInterfaceMock(suite.T())
-	handler := newAuthorizeHandler(mockSvc, testhelpers.OAuthConfig())
+	handler := newAuthorizeHandler(mockSvc, newTestResponseModeService(), testhelpers.OAuthConfig())
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*AuthorizeHandlerInterface)(nil), handler)
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/responsemode"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -22,7 +24,9 @@ func Initialize(
 	jwtService jwt.JWTServiceInterface,
 	flowExecService flowexec.FlowExecServiceInterface,
 	parService par.PARServiceInterface,
+	requestObject requestobject.RequestObjectServiceInterface,
 	criteriaRevoker revocation.CriteriaRevokerInterface,
+	responseMode responsemode.ResponseModeServiceInterface,
 	cfg oauthconfig.Config,
 	storeProvider providers.RuntimeStoreProvider,
 	transactioner providers.Transactioner,
@@ -32,9 +36,9 @@ func Initialize(
 
 	authzService := newAuthorizeService(
 		actorProvider, resourceService, jwtService, flowExecService,
-		authzCodeStore, authzReqStore, parService, transactioner, criteriaRevoker, cfg,
+		authzCodeStore, authzReqStore, parService, requestObject, transactioner, criteriaRevoker, responseMode, cfg,
 	)
-	authzHandler := newAuthorizeHandler(authzService, cfg)
+	authzHandler := newAuthorizeHandler(authzService, responseMode, cfg)
 	registerRoutes(mux, authzHandler)
 	return authzService, nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/responsemode"
 )
 
 // OAuthMessage represents the OAuth message.
@@ -44,6 +45,13 @@ type AuthorizationCode struct {
 	// assertion. It is stamped onto the access and refresh tokens issued for this code so revocation
 	// can target the whole family. Empty when the login flow issued no tfid (e.g. pre-rollout tokens).
 	TokenFamilyID string
+	// SessionID is the OpenID Connect session identifier (sid) of the SSO session the login flow saved
+	// to or reused. It is stamped onto the ID tokens issued for this code. Empty when the flow has no
+	// Session node.
+	SessionID string
+	// AuthorizationDetails holds the RFC 9396 authorization details the user consented to. They are
+	// granted to the access tokens issued for this code.
+	AuthorizationDetails []oauth2model.AuthorizationDetail
 }
 
 // AuthZPostRequest represents the request body for the authorization POST request.
@@ -69,6 +77,22 @@ type AuthorizationError struct {
 	SendErrorToClient bool   // if true, redirect error to client's redirect_uri rather than the error page
 	ClientRedirectURI string // populated when SendErrorToClient is true
 	State             string // from the original request
+	// ClientID, ResponseType and ResponseMode select how the error is delivered to ClientRedirectURI.
+	ClientID     string
+	ResponseType string
+	ResponseMode string
+}
+
+// ClientResponse returns the authorization response that delivers the given error parameters to the
+// client's redirect URI in the response mode of the original request.
+func (e *AuthorizationError) ClientResponse(params map[string]string) *responsemode.AuthorizationResponse {
+	return &responsemode.AuthorizationResponse{
+		ClientID:     e.ClientID,
+		RedirectURI:  e.ClientRedirectURI,
+		ResponseType: e.ResponseType,
+		ResponseMode: e.ResponseMode,
+		Params:       params,
+	}
 }
 
 // assertionClaims represents the claims extracted from the flow assertion JWT.
@@ -79,5 +103,7 @@ type assertionClaims struct {
 	completedACR           string
 	authorizationRequestID string
 	tokenFamilyID          string
+	sessionID              string
+	sessionBrowserState    string
 	flowErrorType          string
 }
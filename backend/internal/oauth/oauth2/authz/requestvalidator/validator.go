@@ -19,7 +19,8 @@ import (
 // ValidateAuthorizationRequestParams validates the common authorization request parameters
 // shared by both the standard authorize endpoint and the PAR endpoint.
 //
-// This validates: prompt, grant_type, response_type, PKCE, nonce, and dpop_jkt.
+// This validates: prompt, grant_type, response_type, PKCE, nonce, dpop_jkt, and the FAPI 2.0
+// Security Profile restrictions for clients held to it.
 // Callers are responsible for validating client_id and redirect_uri before calling this
 // function, since those validations have endpoint-specific error handling semantics
 // (e.g., the authorize endpoint must not redirect errors when the redirect_uri is invalid).
@@ -61,6 +62,23 @@ func ValidateAuthorizationRequestParams(
 	if !constants.IsSupportedResponseMode(responseMode) {
 		return constants.ErrorInvalidRequest, "Unsupported response_mode parameter"
 	}
+	// Tokens must never be delivered in the query component (OAuth 2.0 Multiple Response Types §3.0).
+	if responseType != string(providers.ResponseTypeCode) &&
+		(responseMode == constants.ResponseModeQuery || responseMode == constants.ResponseModeQueryJWT) {
+		return constants.ErrorInvalidRequest, "The query response_mode is not allowed for the response_type"
+	}
+
+	// The FAPI 2.0 Security Profile allows only the code flow, with the redirect URI always sent.
+	if oauthApp.IsFAPI2() {
+		if responseType != string(providers.ResponseTypeCode) {
+			return constants.ErrorUnsupportedResponseType,
+				"The FAPI 2.0 security profile only allows the code response type"
+		}
+		if params.Get(constants.RequestParamRedirectURI) == "" {
+			return constants.ErrorInvalidRequest,
+				"redirect_uri is required by the FAPI 2.0 security profile"
+		}
+	}
 
 	// Validate PKCE parameters.
 	if responseType == string(providers.ResponseTypeCode) {
@@ -74,6 +74,41 @@ func (suite *AuthzValidationTestSuite) TestValidateParams_UnsupportedResponseTyp
 	assert.Equal(suite.T(), constants.ErrorUnsupportedResponseType, errCode)
 }
 
+func (suite *AuthzValidationTestSuite) TestValidateParams_FAPI2() {
+	suite.oauthApp.SecurityProfile = providers.SecurityProfileFAPI2
+	suite.oauthApp.ResponseTypes = append(suite.oauthApp.ResponseTypes, providers.ResponseTypeIDToken)
+	fapiParams := func() url.Values {
+		params := suite.validParams()
+		params.Set(constants.RequestParamRedirectURI, "https://client.example.com/callback")
+		params.Set(constants.RequestParamCodeChallenge, strings.Repeat("a", 43))
+		params.Set(constants.RequestParamCodeChallengeMethod, "S256")
+		return params
+	}
+
+	errCode, errMsg := ValidateAuthorizationRequestParams(fapiParams(), suite.oauthApp, "")
+	assert.Empty(suite.T(), errCode, errMsg)
+
+	params := fapiParams()
+	params.Del(constants.RequestParamCodeChallenge)
+	params.Del(constants.RequestParamCodeChallengeMethod)
+	errCode, errMsg = ValidateAuthorizationRequestParams(params, suite.oauthApp, "")
+	assert.Equal(suite.T(), constants.ErrorInvalidRequest, errCode)
+	assert.Contains(suite.T(), errMsg, "code_challenge")
+
+	params = fapiParams()
+	params.Del(constants.RequestParamRedirectURI)
+	errCode, errMsg = ValidateAuthorizationRequestParams(params, suite.oauthApp, "")
+	assert.Equal(suite.T(), constants.ErrorInvalidRequest, errCode)
+	assert.Contains(suite.T(), errMsg, "FAPI 2.0")
+
+	params = fapiParams()
+	params.Set(constants.RequestParamResponseType, string(providers.ResponseTypeIDToken))
+	params.Set(constants.RequestParamResponseMode, constants.ResponseModeFormPost)
+	errCode, errMsg = ValidateAuthorizationRequestParams(params, suite.oauthApp, "")
+	assert.Equal(suite.T(), constants.ErrorUnsupportedResponseType, errCode)
+	assert.Contains(suite.T(), errMsg, "FAPI 2.0")
+}
+
 func (suite *AuthzValidationTestSuite) TestValidateParams_QueryResponseMode() {
 	params := suite.validParams()
 	params.Set(constants.RequestParamResponseMode, constants.ResponseModeQuery)
@@ -84,8 +119,22 @@ func (suite *AuthzValidationTestSuite) TestValidateParams_QueryResponseMode() {
 	assert.Empty(suite.T(), errMsg)
 }
 
+func (suite *AuthzValidationTestSuite) TestValidateParams_SupportedResponseModes() {
+	for _, responseMode := range constants.SupportedResponseModes {
+		suite.T().Run(responseMode, func(t *testing.T) {
+			params := suite.validParams()
+			params.Set(constants.RequestParamResponseMode, responseMode)
+
+			errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.oauthApp, "")
+
+			assert.Empty(t, errCode)
+			assert.Empty(t, errMsg)
+		})
+	}
+}
+
 func (suite *AuthzValidationTestSuite) TestValidateParams_UnsupportedResponseMode() {
-	for _, responseMode := range []string{"fragment", "form_post"} {
+	for _, responseMode := range []string{"web_message", "query.JWT", "form_post.jwe"} {
 		suite.T().Run(responseMode, func(t *testing.T) {
 			params := suite.validParams()
 			params.Set(constants.RequestParamResponseMode, responseMode)
@@ -98,6 +147,22 @@ func (suite *AuthzValidationTestSuite) TestValidateParams_UnsupportedResponseMod
 	}
 }
 
+func (suite *AuthzValidationTestSuite) TestValidateParams_QueryResponseModeWithTokenResponseType() {
+	suite.oauthApp.ResponseTypes = append(suite.oauthApp.ResponseTypes, providers.ResponseTypeIDToken)
+	for _, responseMode := range []string{constants.ResponseModeQuery, constants.ResponseModeQueryJWT} {
+		suite.T().Run(responseMode, func(t *testing.T) {
+			params := url.Values{
+				constants.RequestParamResponseType: {string(providers.ResponseTypeIDToken)},
+				constants.RequestParamResponseMode: {responseMode},
+			}
+
+			errCode, _ := ValidateAuthorizationRequestParams(params, suite.oauthApp, "")
+
+			assert.Equal(t, constants.ErrorInvalidRequest, errCode)
+		})
+	}
+}
+
 func (suite *AuthzValidationTestSuite) TestValidateParams_GrantTypeNotAllowed() {
 	app := &providers.OAuthClient{
 		ClientID:                "test-client-id",
@@ -17,11 +17,16 @@ import (
 	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz/requestvalidator"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/checksession"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/fapi"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/responsemode"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
@@ -49,10 +54,12 @@ type authorizeService struct {
 	authCodeStore   AuthorizationCodeStoreInterface
 	authReqStore    authorizationRequestStoreInterface
 	parService      par.PARServiceInterface
+	requestObject   requestobject.RequestObjectServiceInterface
 	jwtService      jwt.JWTServiceInterface
 	flowExecService flowexec.FlowExecServiceInterface
 	transactioner   providers.Transactioner
 	criteriaRevoker revocation.CriteriaRevokerInterface
+	responseMode    responsemode.ResponseModeServiceInterface
 	logger          *log.Logger
 }
 
@@ -65,8 +72,10 @@ func newAuthorizeService(
 	authCodeStore AuthorizationCodeStoreInterface,
 	authReqStore authorizationRequestStoreInterface,
 	parService par.PARServiceInterface,
+	requestObject requestobject.RequestObjectServiceInterface,
 	transactioner providers.Transactioner,
 	criteriaRevoker revocation.CriteriaRevokerInterface,
+	responseMode responsemode.ResponseModeServiceInterface,
 	cfg oauthconfig.Config,
 ) AuthorizeServiceInterface {
 	return &authorizeService{
@@ -77,10 +86,12 @@ func newAuthorizeService(
 		authCodeStore:   authCodeStore,
 		authReqStore:    authReqStore,
 		parService:      parService,
+		requestObject:   requestObject,
 		jwtService:      jwtService,
 		flowExecService: flowExecService,
 		transactioner:   transactioner,
 		criteriaRevoker: criteriaRevoker,
+		responseMode:    responseMode,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeService")),
 	}
 }
@@ -188,8 +199,9 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 		}
 	}
 
-	// If request_uri is present, resolve the pushed authorization request.
-	if requestURI != "" {
+	// If request_uri references a pushed authorization request, resolve it. Any other request_uri
+	// references a request object hosted by the client.
+	if requestURI != "" && par.IsPushedRequestURI(requestURI) {
 		return as.handlePARAuthorizationRequest(ctx, requestURI, clientID, app)
 	}
 
@@ -201,12 +213,26 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 		}
 	}
 
+	// Apply the request object, if any, passed by value or by reference (RFC 9101).
+	resolvedParams, errResp := as.requestObject.ResolveRequestParameters(ctx, queryParams, app)
+	if errResp != nil {
+		return nil, &AuthorizationError{
+			Code:    errResp.Error,
+			Message: errResp.ErrorDescription,
+		}
+	}
+	resolvedMsg := *msg
+	if queryParams.Get(oauth2const.RequestParamRequest) != "" || requestURI != "" {
+		resolvedMsg.RequestQueryParams = resolvedParams
+		resolvedMsg.Resources = resolvedParams[oauth2const.RequestParamResource]
+	}
+
 	initiatorReq := &providers.InitiatorRequest{
 		Headers:     utils.FilterSensitiveHeaders(msg.RequestHeaders),
-		QueryParams: msg.RequestQueryParams,
+		QueryParams: resolvedMsg.RequestQueryParams,
 	}
 
-	return as.handleStandardAuthorizationRequest(ctx, msg, app, initiatorReq)
+	return as.handleStandardAuthorizationRequest(ctx, &resolvedMsg, app, initiatorReq)
 }
 
 // handlePARAuthorizationRequest resolves a request_uri from a PAR and continues the authorization flow.
@@ -244,6 +270,7 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 	scope := queryParams.Get(oauth2const.RequestParamScope)
 	state := queryParams.Get(oauth2const.RequestParamState)
 	responseType := queryParams.Get(oauth2const.RequestParamResponseType)
+	responseMode := queryParams.Get(oauth2const.RequestParamResponseMode)
 
 	// Extract PKCE parameters.
 	codeChallenge := queryParams.Get(oauth2const.RequestParamCodeChallenge)
@@ -299,6 +326,12 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		if sendErrorToApp && redirectURI != "" {
 			authErr.SendErrorToClient = true
 			authErr.ClientRedirectURI = redirectURI
+			authErr.ClientID = app.ClientID
+			authErr.ResponseType = responseType
+			// An unsupported response mode may be the error itself; it then falls back to the default.
+			if oauth2const.IsSupportedResponseMode(responseMode) {
+				authErr.ResponseMode = responseMode
+			}
 		}
 		return nil, authErr
 	}
@@ -315,6 +348,7 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		RedirectURI:         redirectURI,
 		RedirectURIProvided: redirectURI != "",
 		ResponseType:        responseType,
+		ResponseMode:        responseMode,
 		StandardScopes:      oidcScopes,
 		PermissionScopes:    nonOidcScopes,
 		CodeChallenge:       codeChallenge,
@@ -344,6 +378,22 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		oauthParams.RedirectURI = app.RedirectURIs[0]
 	}
 
+	authorizationDetails, errResp := authorizationdetails.Parse(
+		queryParams.Get(oauth2const.RequestParamAuthorizationDetails))
+	if errResp != nil {
+		return nil, &AuthorizationError{
+			Code:              errResp.Error,
+			Message:           errResp.ErrorDescription,
+			SendErrorToClient: true,
+			ClientRedirectURI: oauthParams.RedirectURI,
+			ClientID:          oauthParams.ClientID,
+			ResponseType:      oauthParams.ResponseType,
+			ResponseMode:      oauthParams.ResponseMode,
+			State:             oauthParams.State,
+		}
+	}
+	oauthParams.AuthorizationDetails = authorizationDetails
+
 	return as.initiateFlowAndStoreRequest(ctx, oauthParams, app, initiatorReq)
 }
 
@@ -353,6 +403,11 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	ctx context.Context, oauthParams *oauth2model.OAuthParameters,
 	app *providers.OAuthClient, initiatorReq *providers.InitiatorRequest,
 ) (*AuthorizationInitResult, *AuthorizationError) {
+	// The FAPI 2.0 Security Profile bounds the lifetime of the authorization code.
+	if app.IsFAPI2() {
+		oauthParams.CodeValidityPeriod = fapi.AuthorizationCodeValidity(as.cfg.OAuth.AuthorizationCode.ValidityPeriod)
+	}
+
 	// Bind the request to a single target resource server before the flow starts. OIDC-only or
 	// scopeless requests stay unbound and their audience is the client_id. A permission-bearing
 	// request resolves an explicit resource or the configured default, rejecting with invalid_target
@@ -366,6 +421,40 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 			Message:           errResp.ErrorDescription,
 			SendErrorToClient: oauthParams.RedirectURI != "",
 			ClientRedirectURI: oauthParams.RedirectURI,
+			ClientID:          oauthParams.ClientID,
+			ResponseType:      oauthParams.ResponseType,
+			ResponseMode:      oauthParams.ResponseMode,
+			State:             oauthParams.State,
+		}
+	}
+	// Authorization details bind the request to a resource server as well: they are validated against
+	// the types it registers, resolving the target from the resource parameter or the configured
+	// default when no permission scope has bound it already.
+	targetRS, errResp = authorizationdetails.ResolveAndValidate(
+		ctx, as.resourceService, oauthParams.Resources, targetRS, oauthParams.AuthorizationDetails)
+	if errResp != nil {
+		return nil, &AuthorizationError{
+			Code:              errResp.Error,
+			Message:           errResp.ErrorDescription,
+			SendErrorToClient: oauthParams.RedirectURI != "",
+			ClientRedirectURI: oauthParams.RedirectURI,
+			ClientID:          oauthParams.ClientID,
+			ResponseType:      oauthParams.ResponseType,
+			ResponseMode:      oauthParams.ResponseMode,
+			State:             oauthParams.State,
+		}
+	}
+	authorizationDetails, err := authorizationdetails.Serialize(oauthParams.AuthorizationDetails)
+	if err != nil {
+		as.logger.Error(ctx, "Failed to serialize authorization details", log.Error(err))
+		return nil, &AuthorizationError{
+			Code:              oauth2const.ErrorServerError,
+			Message:           "Failed to process authorization request",
+			SendErrorToClient: true,
+			ClientRedirectURI: oauthParams.RedirectURI,
+			ClientID:          oauthParams.ClientID,
+			ResponseType:      oauthParams.ResponseType,
+			ResponseMode:      oauthParams.ResponseMode,
 			State:             oauthParams.State,
 		}
 	}
@@ -379,6 +468,9 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 				Message:           dErr.ErrorDescription,
 				SendErrorToClient: oauthParams.RedirectURI != "",
 				ClientRedirectURI: oauthParams.RedirectURI,
+				ClientID:          oauthParams.ClientID,
+				ResponseType:      oauthParams.ResponseType,
+				ResponseMode:      oauthParams.ResponseMode,
 				State:             oauthParams.State,
 			}
 		}
@@ -404,6 +496,9 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 			Message:           "Failed to process authorization request",
 			SendErrorToClient: true,
 			ClientRedirectURI: oauthParams.RedirectURI,
+			ClientID:          oauthParams.ClientID,
+			ResponseType:      oauthParams.ResponseType,
+			ResponseMode:      oauthParams.ResponseMode,
 			State:             oauthParams.State,
 		}
 	}
@@ -428,6 +523,9 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	if oauthParams.MaxAge != "" {
 		runtimeData[flowcm.RuntimeKeyMaxAge] = oauthParams.MaxAge
 	}
+	if authorizationDetails != "" {
+		runtimeData[flowcm.RuntimeKeyRequestedAuthorizationDetails] = authorizationDetails
+	}
 	flowInitCtx := &flowexec.FlowInitContext{
 		ApplicationID:    app.ID,
 		FlowType:         string(providers.FlowTypeAuthentication),
@@ -444,6 +542,9 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 			Message:           "Failed to process authorization request",
 			SendErrorToClient: true,
 			ClientRedirectURI: oauthParams.RedirectURI,
+			ClientID:          oauthParams.ClientID,
+			ResponseType:      oauthParams.ResponseType,
+			ResponseMode:      oauthParams.ResponseMode,
 			State:             oauthParams.State,
 		}
 	}
@@ -467,6 +568,9 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 			Message:           "Failed to process authorization request",
 			SendErrorToClient: true,
 			ClientRedirectURI: oauthParams.RedirectURI,
+			ClientID:          oauthParams.ClientID,
+			ResponseType:      oauthParams.ResponseType,
+			ResponseMode:      oauthParams.ResponseMode,
 			State:             oauthParams.State,
 		}
 	}
@@ -554,6 +658,9 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 				Message:           "Assertion does not match the authorization request",
 				SendErrorToClient: true,
 				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
+				ClientID:          authRequestCtx.OAuthParameters.ClientID,
+				ResponseType:      authRequestCtx.OAuthParameters.ResponseType,
+				ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 				State:             authRequestCtx.OAuthParameters.State,
 			}
 			return errors.New("assertion not bound to authorization request")
@@ -565,6 +672,9 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 				Message:           "Authorization request failed",
 				SendErrorToClient: true,
 				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
+				ClientID:          authRequestCtx.OAuthParameters.ClientID,
+				ResponseType:      authRequestCtx.OAuthParameters.ResponseType,
+				ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 				State:             authRequestCtx.OAuthParameters.State,
 			}
 			return errors.New("user ID is empty")
@@ -583,6 +693,9 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 					Message:           "Authorization request failed",
 					SendErrorToClient: true,
 					ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
+					ClientID:          authRequestCtx.OAuthParameters.ClientID,
+					ResponseType:      authRequestCtx.OAuthParameters.ResponseType,
+					ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 					State:             authRequestCtx.OAuthParameters.State,
 				}
 				return err
@@ -607,6 +720,9 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 				Message:           "Failed to process authorization request",
 				SendErrorToClient: true,
 				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
+				ClientID:          authRequestCtx.OAuthParameters.ClientID,
+				ResponseType:      authRequestCtx.OAuthParameters.ResponseType,
+				ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 				State:             authRequestCtx.OAuthParameters.State,
 			}
 			return err
@@ -619,26 +735,49 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 				Message:           "Failed to process authorization request",
 				SendErrorToClient: true,
 				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
+				ClientID:          authRequestCtx.OAuthParameters.ClientID,
+				ResponseType:      authRequestCtx.OAuthParameters.ResponseType,
+				ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 				State:             authRequestCtx.OAuthParameters.State,
 			}
 			return persistErr
 		}
 
-		// Construct the redirect URI with the authorization code.
-		queryParams := map[string]string{
+		// Construct the authorization response carrying the authorization code.
+		responseParams := map[string]string{
 			"code":                      authzCode.Code,
 			oauth2const.RequestParamIss: as.cfg.JWT.Issuer,
 		}
 		if authRequestCtx.OAuthParameters.State != "" {
-			queryParams[oauth2const.RequestParamState] = authRequestCtx.OAuthParameters.State
+			responseParams[oauth2const.RequestParamState] = authRequestCtx.OAuthParameters.State
 		}
-		redirectURI, err = oauth2utils.GetURIWithQueryParams(authzCode.RedirectURI, queryParams)
+		// OIDC Session Management: let the client watch the SSO session through check_session_iframe.
+		// Only a flow with a Session node has a browser state to derive it from.
+		if hasOpenIDScope && claims.sessionBrowserState != "" {
+			sessionState, ssErr := checksession.ComputeSessionState(authzCode.ClientID, authzCode.RedirectURI,
+				claims.sessionBrowserState)
+			if ssErr != nil {
+				as.logger.Debug(ctx, "Omitting session_state from authorization response", log.Error(ssErr))
+			} else {
+				responseParams[oauth2const.RequestParamSessionState] = sessionState
+			}
+		}
+		redirectURI, err = as.responseMode.BuildResponseURI(ctx, &responsemode.AuthorizationResponse{
+			ClientID:     authzCode.ClientID,
+			RedirectURI:  authzCode.RedirectURI,
+			ResponseType: authRequestCtx.OAuthParameters.ResponseType,
+			ResponseMode: authRequestCtx.OAuthParameters.ResponseMode,
+			Params:       responseParams,
+		})
 		if err != nil {
 			authErr = &AuthorizationError{
 				Code:              oauth2const.ErrorServerError,
 				Message:           "Failed to process authorization request",
 				SendErrorToClient: true,
 				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
+				ClientID:          authRequestCtx.OAuthParameters.ClientID,
+				ResponseType:      authRequestCtx.OAuthParameters.ResponseType,
+				ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 				State:             authRequestCtx.OAuthParameters.State,
 			}
 			return err
@@ -705,6 +844,9 @@ func (as *authorizeService) handleFailedCallback(
 		Message:           message,
 		SendErrorToClient: sendToClient,
 		ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
+		ClientID:          authRequestCtx.OAuthParameters.ClientID,
+		ResponseType:      authRequestCtx.OAuthParameters.ResponseType,
+		ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 		State:             authRequestCtx.OAuthParameters.State,
 	}
 }
@@ -760,6 +902,14 @@ func decodeAttributesFromAssertion(assertion string) (assertionClaims, time.Time
 		claims.tokenFamilyID = v
 	}
 
+	if v, ok := payload[oauth2const.ClaimSessionID].(string); ok {
+		claims.sessionID = v
+	}
+
+	if v, ok := payload[oauth2const.ClaimSessionBrowserState].(string); ok {
+		claims.sessionBrowserState = v
+	}
+
 	if v, ok := payload[flowcm.ClaimFlowErrorType].(string); ok {
 		claims.flowErrorType = v
 	}
@@ -806,6 +956,9 @@ func createAuthorizationCode(
 	resources := authRequestCtx.OAuthParameters.Resources
 
 	validityPeriod := cfg.OAuth.AuthorizationCode.ValidityPeriod
+	if authRequestCtx.OAuthParameters.CodeValidityPeriod > 0 {
+		validityPeriod = authRequestCtx.OAuthParameters.CodeValidityPeriod
+	}
 	expiryTime := authTime.Add(time.Duration(validityPeriod) * time.Second)
 
 	codeID, err := utils.GenerateUUIDv7()
@@ -851,6 +1004,9 @@ func createAuthorizationCode(
 		CompletedACR:        claims.completedACR,
 		DPoPJkt:             authRequestCtx.OAuthParameters.DPoPJkt,
 		TokenFamilyID:       tokenFamilyID,
+		SessionID:           claims.sessionID,
+
+		AuthorizationDetails: authRequestCtx.OAuthParameters.AuthorizationDetails,
 	}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"testing"
@@ -27,6 +28,8 @@ import (
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/responsemode"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -37,6 +40,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/requestobjectmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/revocationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 )
@@ -69,6 +73,12 @@ const (
 	svcJWTWithIat = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
 		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJpYXQiOjE3MDE0MjEyMDAsImF1dGhvcml6YXRpb25fcmVxdWVzdF9pZCI6InRlc3QtYXV0aC1pZCJ9."
 	// Header: {"alg":"none","typ":"JWT"}
+	// Payload: {"sub":"test-user","iat":1701421200,"authorization_request_id":"test-auth-id",
+	//           "session_browser_state":"opbs-1"}
+	svcJWTWithBrowserState = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
+		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJpYXQiOjE3MDE0MjEyMDAsImF1dGhvcml6YXRpb25fcmVxdWVz" +
+		"dF9pZCI6InRlc3QtYXV0aC1pZCIsInNlc3Npb25fYnJvd3Nlcl9zdGF0ZSI6Im9wYnMtMSJ9."
+	// Header: {"alg":"none","typ":"JWT"}
 	// Payload: {"sub":"test-user","authorization_request_id":"test-auth-id"}
 	svcJWTMinimal = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
 		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJhdXRob3JpemF0aW9uX3JlcXVlc3RfaWQiOiJ0ZXN0LWF1dGgtaWQifQ."
@@ -159,10 +169,24 @@ func (suite *AuthorizeServiceTestSuite) newService() *authorizeService {
 		jwtService:      suite.mockJWTService,
 		flowExecService: suite.mockFlowExecService,
 		transactioner:   &stubTransactioner{},
+		requestObject:   newTestRequestObjectService(),
+		responseMode:    newTestResponseModeService(),
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeServiceTest")),
 	}
 }
 
+// newTestResponseModeService builds a response mode service for query and fragment delivery; the
+// JWT and form_post modes need dependencies these tests do not set up.
+func newTestResponseModeService() responsemode.ResponseModeServiceInterface {
+	return responsemode.Initialize(http.NewServeMux(), nil, nil, nil, nil, nil, authorizeServiceCfgFromRuntime())
+}
+
+// newTestRequestObjectService builds a request object service for requests that carry no request
+// object; verifying one needs dependencies these tests do not set up.
+func newTestRequestObjectService() requestobject.RequestObjectServiceInterface {
+	return requestobject.Initialize(nil, nil, nil, nil, authorizeServiceCfgFromRuntime())
+}
+
 // testApp returns a minimal OAuthClient for use in tests.
 func (suite *AuthorizeServiceTestSuite) testApp() *providers.OAuthClient {
 	return &providers.OAuthClient{
@@ -291,6 +315,36 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Va
 	assert.Equal(suite.T(), "test-state", authErr.State)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_ValidationError_KeepsResponseMode() {
+	testCases := []struct {
+		responseMode string
+		expected     string
+	}{
+		{oauth2const.ResponseModeFormPost, oauth2const.ResponseModeFormPost},
+		// An unsupported response mode falls back to the response type's default.
+		{"web_message", ""},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.responseMode, func() {
+			suite.SetupTest()
+			app := suite.testApp()
+			suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").
+				Return(app, nil)
+			suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
+				Return(true, oauth2const.ErrorInvalidRequest, "Invalid request")
+
+			msg := suite.testMsg()
+			msg.RequestQueryParams[oauth2const.RequestParamResponseMode] = []string{tc.responseMode}
+			_, authErr := suite.newService().HandleInitialAuthorizationRequest(context.Background(), msg)
+
+			suite.Require().NotNil(authErr)
+			suite.Equal("test-client-id", authErr.ClientID)
+			suite.Equal("code", authErr.ResponseType)
+			suite.Equal(tc.expected, authErr.ResponseMode)
+		})
+	}
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_FlowInitError() {
 	app := suite.testApp()
 	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
@@ -367,6 +421,98 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Su
 	assert.Equal(suite.T(), "hi", result.QueryParams[oauth2const.RequestParamUILocales])
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InvalidAuthorizationDetails() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
+		Return(false, "", "")
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams["authorization_details"] = []string{`{"type":"payment_initiation"}`}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidAuthorizationDetails, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_AppliesRequestObject() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	resolved := url.Values{
+		"client_id":     {"test-client-id"},
+		"redirect_uri":  {"https://client.example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"read write"},
+		"state":         {"signed-state"},
+		"ui_locales":    {"fr"},
+	}
+	requestObject := requestobjectmock.NewRequestObjectServiceInterfaceMock(suite.T())
+	requestObject.EXPECT().ResolveRequestParameters(mock.Anything, mock.Anything, app).Return(resolved, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything,
+		mock.MatchedBy(func(msg *OAuthMessage) bool {
+			return url.Values(msg.RequestQueryParams).Get("state") == "signed-state"
+		}), app).Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: url.Values{
+			"client_id": {"test-client-id"},
+			"request":   {"signed-request-object"},
+		},
+	}
+
+	svc := suite.newService()
+	svc.requestObject = requestObject
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	suite.Require().Nil(authErr)
+	suite.Require().NotNil(result)
+	assert.Equal(suite.T(), "fr", result.QueryParams[oauth2const.RequestParamUILocales])
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObjectError() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	requestObject := requestobjectmock.NewRequestObjectServiceInterfaceMock(suite.T())
+	requestObject.EXPECT().ResolveRequestParameters(mock.Anything, mock.Anything, app).Return(nil,
+		&oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorInvalidRequestURI,
+			ErrorDescription: "Failed to retrieve the request object from request_uri",
+		})
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams["request_uri"] = []string{"https://client.example.com/request.jwt"}
+
+	svc := suite.newService()
+	svc.requestObject = requestObject
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	suite.Require().NotNil(authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestURI, authErr.Code)
+	assert.False(suite.T(), authErr.SendErrorToClient)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SignedRequestObjectRequired() {
+	app := suite.testApp()
+	app.RequireSignedRequestObject = true
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.testMsg())
+
+	assert.Nil(suite.T(), result)
+	suite.Require().NotNil(authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_ExplicitResourceSetsRuntimeRSID() {
 	app := suite.testApp()
 	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
@@ -835,6 +981,79 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_WithStat
 	assert.Contains(suite.T(), redirectURI, "iss=https%3A%2F%2Flocalhost%3A8090")
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_FragmentResponseMode() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
+			ResponseType: "code",
+			ResponseMode: oauth2const.ResponseModeFragment,
+			State:        "test-state-123",
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, "", "").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+
+	svc := suite.newService()
+	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+
+	assert.Nil(suite.T(), authErr)
+	assert.True(suite.T(), strings.HasPrefix(redirectURI, "https://client.example.com/callback#code="))
+	assert.Contains(suite.T(), redirectURI, "state=test-state-123")
+}
+
+// A flow with a Session node stamps the SSO session's browser state on the assertion; an OpenID
+// authorization response then carries a session_state the check_session_iframe can recompute.
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_WithSessionState() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:       "test-client",
+			RedirectURI:    "https://client.example.com/callback",
+			StandardScopes: []string{oauth2const.ScopeOpenID},
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithBrowserState, "", "").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+
+	svc := suite.newService()
+	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID,
+		svcJWTWithBrowserState)
+
+	assert.Nil(suite.T(), authErr)
+	parsed, err := url.Parse(redirectURI)
+	assert.NoError(suite.T(), err)
+	sessionState := parsed.Query().Get(oauth2const.RequestParamSessionState)
+	hash, salt, found := strings.Cut(sessionState, ".")
+	assert.True(suite.T(), found)
+	assert.NotEmpty(suite.T(), hash)
+	assert.NotEmpty(suite.T(), salt)
+}
+
+// Without the openid scope the response is plain OAuth and carries no session_state.
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_SessionStateRequiresOpenID() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:    "test-client",
+			RedirectURI: "https://client.example.com/callback",
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithBrowserState, "", "").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+
+	svc := suite.newService()
+	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID,
+		svcJWTWithBrowserState)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotContains(suite.T(), redirectURI, oauth2const.RequestParamSessionState+"=")
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_EmptyAuthorizedPermissions() {
 	// svcJWTWithIat has no authorized_permissions claim.
 	// Permission scopes in the auth context should be cleared.
@@ -1013,6 +1232,24 @@ func (suite *AuthorizeServiceTestSuite) TestCreateAuthorizationCode_PreservesInc
 	assert.Equal(suite.T(), "tfid-from-sso", code.TokenFamilyID)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestCreateAuthorizationCode_UsesRequestCodeValidityPeriod() {
+	// A FAPI 2.0 request bounds the code lifetime at initiation; the code must honour that bound.
+	authCtx := &authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:           "test-client",
+			RedirectURI:        "https://client.example.com/callback",
+			CodeValidityPeriod: 30,
+		},
+	}
+	claims := &assertionClaims{userID: "user-1"}
+	authTime := time.Now()
+
+	code, err := createAuthorizationCode(authorizeServiceCfgFromRuntime(), authCtx, claims, authTime)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), authTime.Add(30*time.Second), code.ExpiryTime)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_Success() {
 	record := &AuthorizationCode{
 		CodeID:           "code-id-123",
@@ -0,0 +1,25 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package backchannellogout
+
+import "time"
+
+const (
+	// backchannelLogoutEvent is the event type a logout token carries in its events claim.
+	backchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+	// claimEvents is the logout token claim holding the event set.
+	claimEvents = "events"
+	// paramLogoutToken is the form parameter the logout token is POSTed in.
+	paramLogoutToken = "logout_token"
+
+	// logoutTokenValidity bounds how long a logout token is accepted, in seconds. It only has to
+	// outlive delivery, including retries.
+	logoutTokenValidity int64 = 120
+	// maxDeliveryAttempts is how many times a logout token is POSTed before delivery is abandoned.
+	maxDeliveryAttempts = 3
+	// initialRetryBackoff is the wait before the first retry; it doubles on each further retry.
+	initialRetryBackoff = 2 * time.Second
+	// deliveryTimeout bounds a single delivery attempt.
+	deliveryTimeout = 10 * time.Second
+)
@@ -0,0 +1,28 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package backchannellogout implements OpenID Connect Back-Channel Logout 1.0: when an SSO session
+// ends, every participating application that registered a backchannel_logout_uri is sent a signed
+// logout token naming the session (sid) and the subject (sub).
+package backchannellogout
+
+import (
+	"net/http"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+)
+
+// Initialize constructs the back-channel logout service. It is built by the service manager ahead of
+// the SSO session service, which notifies it; the actor provider it resolves clients through is only
+// available later and is injected with SetActorProvider. Logout tokens are sent through the SSRF-safe
+// client, which refuses private targets at dial time and on every redirect.
+func Initialize(jwtService jwt.JWTServiceInterface, pairwiseService pairwise.PairwiseSubjectServiceInterface,
+	cfg oauthconfig.Config) BackchannelLogoutServiceInterface {
+	httpClient := syshttp.NewHTTPClientWithCheckRedirect(func(req *http.Request, _ []*http.Request) error {
+		return syshttp.IsSSRFSafeURL(req.URL.String())
+	})
+	return newBackchannelLogoutService(jwtService, pairwiseService, httpClient, cfg.JWT.Issuer)
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package backchannellogout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// BackchannelLogoutServiceInterface notifies the participants of ended SSO sessions over the back
+// channel. It implements session.LogoutNotifier.
+type BackchannelLogoutServiceInterface interface {
+	// NotifySessionEnded sends a logout token to every participant that registered a back-channel
+	// logout URI. Delivery is asynchronous and retried; the call itself never blocks on it.
+	NotifySessionEnded(ctx context.Context, sess session.Session, participants []session.Participant)
+	// SetActorProvider injects the provider participating applications are resolved through.
+	SetActorProvider(actorProvider providers.ActorProvider)
+}
+
+// backchannelLogoutService is the default implementation of BackchannelLogoutServiceInterface.
+type backchannelLogoutService struct {
+	jwtService      jwt.JWTServiceInterface
+	actorProvider   providers.ActorProvider
+	pairwiseService pairwise.PairwiseSubjectServiceInterface
+	httpClient      syshttp.HTTPClientInterface
+	issuer          string
+	retryBackoff    time.Duration
+	logger          *log.Logger
+}
+
+var _ session.LogoutNotifier = (*backchannelLogoutService)(nil)
+
+// newBackchannelLogoutService creates a new back-channel logout service.
+func newBackchannelLogoutService(jwtService jwt.JWTServiceInterface,
+	pairwiseService pairwise.PairwiseSubjectServiceInterface, httpClient syshttp.HTTPClientInterface,
+	issuer string) *backchannelLogoutService {
+	return &backchannelLogoutService{
+		jwtService:      jwtService,
+		pairwiseService: pairwiseService,
+		httpClient:      httpClient,
+		issuer:          issuer,
+		retryBackoff:    initialRetryBackoff,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BackchannelLogoutService")),
+	}
+}
+
+// SetActorProvider injects the actor provider. See Initialize.
+func (s *backchannelLogoutService) SetActorProvider(actorProvider providers.ActorProvider) {
+	s.actorProvider = actorProvider
+}
+
+// NotifySessionEnded implements BackchannelLogoutServiceInterface.
+func (s *backchannelLogoutService) NotifySessionEnded(ctx context.Context, sess session.Session,
+	participants []session.Participant) {
+	if s.actorProvider == nil {
+		s.logger.Warn(ctx, "Actor provider not configured; skipping back-channel logout")
+		return
+	}
+	// Delivery outlives the sign-out request that triggered it, so it must not inherit its cancellation.
+	deliveryCtx := context.WithoutCancel(ctx)
+	sid := sess.SID()
+	for _, p := range participants {
+		go s.notifyParticipant(deliveryCtx, sess.SubjectID, sid, p.AppID)
+	}
+}
+
+// notifyParticipant delivers a logout token to one participating application, if it registered a
+// back-channel logout URI. Failures are logged; nothing is reported back to the sign-out path.
+func (s *backchannelLogoutService) notifyParticipant(ctx context.Context, subject, sid, appID string) {
+	logger := s.logger.With(log.String("appId", appID))
+
+	logoutURI, client, ok := s.resolveTarget(ctx, appID, logger)
+	if !ok {
+		return
+	}
+	// A pairwise client only ever saw its pairwise identifier for the End-User, so the logout token
+	// must name the subject the same way.
+	if client.IsPairwise() && s.pairwiseService != nil {
+		subject = s.pairwiseService.GetSubject(client, subject)
+	}
+
+	claims := map[string]interface{}{
+		"aud":                    client.ClientID,
+		constants.ClaimSessionID: sid,
+		claimEvents: map[string]interface{}{
+			backchannelLogoutEvent: map[string]interface{}{},
+		},
+	}
+	logoutToken, _, svcErr := s.jwtService.GenerateJWT(ctx, subject, s.issuer, logoutTokenValidity, claims,
+		jwt.TokenTypeLogoutToken, "")
+	if svcErr != nil {
+		logger.Error(ctx, "Failed to generate logout token", log.String("error", svcErr.Code))
+		return
+	}
+
+	s.deliver(ctx, logoutURI, logoutToken, logger)
+}
+
+// resolveTarget returns the application's back-channel logout URI and the client the logout token is
+// addressed to. It reports false when the application cannot be resolved or has not registered a URI.
+func (s *backchannelLogoutService) resolveTarget(ctx context.Context, appID string, logger *log.Logger) (
+	string, *providers.OAuthClient, bool) {
+	profile, svcErr := s.actorProvider.GetOAuthProfileByID(ctx, appID)
+	if svcErr != nil {
+		// The application may have been deleted, or never had an OAuth profile, since it joined.
+		if svcErr.Type == tidcommon.ClientErrorType {
+			logger.Debug(ctx, "No OAuth profile for session participant; skipping back-channel logout")
+		} else {
+			logger.Error(ctx, "Failed to resolve OAuth profile for back-channel logout")
+		}
+		return "", nil, false
+	}
+	if profile == nil || profile.BackchannelLogoutURI == "" {
+		return "", nil, false
+	}
+
+	entity, svcErr := s.actorProvider.GetActor(appID)
+	if svcErr != nil || entity == nil {
+		logger.Error(ctx, "Failed to resolve client for back-channel logout")
+		return "", nil, false
+	}
+	var attrs struct {
+		ClientID string `json:"clientId"`
+	}
+	if len(entity.SystemAttributes) > 0 {
+		if err := json.Unmarshal(entity.SystemAttributes, &attrs); err != nil {
+			logger.Error(ctx, "Failed to read client id for back-channel logout", log.Error(err))
+			return "", nil, false
+		}
+	}
+	if attrs.ClientID == "" {
+		logger.Debug(ctx, "Session participant has no client id; skipping back-channel logout")
+		return "", nil, false
+	}
+	return profile.BackchannelLogoutURI, &providers.OAuthClient{
+		ClientID:            attrs.ClientID,
+		RedirectURIs:        profile.RedirectURIs,
+		SubjectType:         providers.SubjectType(profile.SubjectType),
+		SectorIdentifierURI: profile.SectorIdentifierURI,
+	}, true
+}
+
+// deliver POSTs the logout token to the logout URI, retrying with exponential backoff on network
+// failures and 5xx responses. Any other error response means the relying party rejected the token,
+// which a retry would not change.
+func (s *backchannelLogoutService) deliver(ctx context.Context, logoutURI, logoutToken string,
+	logger *log.Logger) {
+	backoff := s.retryBackoff
+	for attempt := 1; attempt <= maxDeliveryAttempts; attempt++ {
+		retryable, err := s.post(ctx, logoutURI, logoutToken)
+		if err == nil {
+			logger.Debug(ctx, "Delivered back-channel logout token", log.Int("attempt", attempt))
+			return
+		}
+		if !retryable {
+			logger.Warn(ctx, "Back-channel logout token rejected", log.Error(err))
+			return
+		}
+		if attempt < maxDeliveryAttempts {
+			logger.Debug(ctx, "Back-channel logout delivery failed; retrying",
+				log.Int("attempt", attempt), log.Error(err))
+			time.Sleep(backoff)
+			backoff *= 2
+			continue
+		}
+		logger.Warn(ctx, "Back-channel logout delivery failed; giving up",
+			log.Int("attempts", attempt), log.Error(err))
+	}
+}
+
+// post performs one delivery attempt. The bool reports whether a failure is worth retrying.
+func (s *backchannelLogoutService) post(ctx context.Context, logoutURI, logoutToken string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	form := url.Values{paramLogoutToken: {logoutToken}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, logoutURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode >= 500:
+		return true, fmt.Errorf("logout endpoint returned status %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("logout endpoint returned status %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package backchannellogout
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
+)
+
+const (
+	testIssuer    = "https://idp.example.com"
+	testAppID     = "app-1"
+	testClientID  = "client-1"
+	testSubject   = "user-1"
+	testLogoutURI = "https://rp.example.com/backchannel-logout"
+	testToken     = "logout.token.jwt"
+)
+
+type BackchannelLogoutServiceTestSuite struct {
+	suite.Suite
+	jwtService    *jwtmock.JWTServiceInterfaceMock
+	actorProvider *actorprovidermock.ActorProviderMock
+	httpClient    *httpmock.HTTPClientInterfaceMock
+	service       *backchannelLogoutService
+}
+
+func TestBackchannelLogoutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BackchannelLogoutServiceTestSuite))
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) SetupTest() {
+	suite.jwtService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.actorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.httpClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newBackchannelLogoutService(suite.jwtService, nil, suite.httpClient, testIssuer)
+	suite.service.SetActorProvider(suite.actorProvider)
+	suite.service.retryBackoff = 0
+}
+
+func response(status int) *http.Response {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+// expectRegisteredClient sets up a participant that registered a back-channel logout URI.
+func (suite *BackchannelLogoutServiceTestSuite) expectRegisteredClient() {
+	suite.actorProvider.EXPECT().GetOAuthProfileByID(mock.Anything, testAppID).Return(
+		&providers.OAuthProfile{BackchannelLogoutURI: testLogoutURI}, nil)
+	suite.actorProvider.EXPECT().GetActor(testAppID).Return(
+		&providers.Entity{ID: testAppID, SystemAttributes: []byte(`{"clientId":"` + testClientID + `"}`)}, nil)
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) expectLogoutToken(sid string) {
+	suite.jwtService.EXPECT().GenerateJWT(mock.Anything, testSubject, testIssuer, logoutTokenValidity,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			events, _ := claims[claimEvents].(map[string]interface{})
+			_, hasEvent := events[backchannelLogoutEvent]
+			_, hasNonce := claims["nonce"]
+			return claims["aud"] == testClientID && claims["sid"] == sid && hasEvent && !hasNonce
+		}), jwt.TokenTypeLogoutToken, "").Return(testToken, int64(0), nil)
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) TestNotifyParticipant_DeliversLogoutToken() {
+	suite.expectRegisteredClient()
+	suite.expectLogoutToken("sid-1")
+	suite.httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.Method == http.MethodPost && req.URL.String() == testLogoutURI &&
+			req.Header.Get("Content-Type") == "application/x-www-form-urlencoded" &&
+			string(body) == "logout_token="+testToken
+	})).Return(response(http.StatusOK), nil).Once()
+
+	suite.service.notifyParticipant(context.Background(), testSubject, "sid-1", testAppID)
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) TestNotifyParticipant_PairwiseClientGetsPairwiseSubject() {
+	pairwiseSvc := pairwisemock.NewPairwiseSubjectServiceInterfaceMock(suite.T())
+	suite.service.pairwiseService = pairwiseSvc
+	suite.actorProvider.EXPECT().GetOAuthProfileByID(mock.Anything, testAppID).Return(
+		&providers.OAuthProfile{BackchannelLogoutURI: testLogoutURI, SubjectType: "pairwise"}, nil)
+	suite.actorProvider.EXPECT().GetActor(testAppID).Return(
+		&providers.Entity{ID: testAppID, SystemAttributes: []byte(`{"clientId":"` + testClientID + `"}`)}, nil)
+	pairwiseSvc.EXPECT().GetSubject(mock.MatchedBy(func(client *providers.OAuthClient) bool {
+		return client.ClientID == testClientID && client.IsPairwise()
+	}), testSubject).Return("pairwise-sub")
+	suite.jwtService.EXPECT().GenerateJWT(mock.Anything, "pairwise-sub", testIssuer, logoutTokenValidity,
+		mock.Anything, jwt.TokenTypeLogoutToken, "").Return(testToken, int64(0), nil)
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(response(http.StatusOK), nil).Once()
+
+	suite.service.notifyParticipant(context.Background(), testSubject, "sid-1", testAppID)
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) TestNotifyParticipant_NoLogoutURISkips() {
+	suite.actorProvider.EXPECT().GetOAuthProfileByID(mock.Anything, testAppID).Return(
+		&providers.OAuthProfile{}, nil)
+
+	suite.service.notifyParticipant(context.Background(), testSubject, "sid-1", testAppID)
+
+	suite.jwtService.AssertNotCalled(suite.T(), "GenerateJWT",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) TestNotifyParticipant_UnknownApplicationSkips() {
+	suite.actorProvider.EXPECT().GetOAuthProfileByID(mock.Anything, testAppID).Return(
+		nil, &tidcommon.ServiceError{Type: tidcommon.ClientErrorType})
+
+	suite.service.notifyParticipant(context.Background(), testSubject, "sid-1", testAppID)
+
+	suite.actorProvider.AssertNotCalled(suite.T(), "GetActor", mock.Anything)
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) TestNotifyParticipant_TokenGenerationFailureSkipsDelivery() {
+	suite.expectRegisteredClient()
+	suite.jwtService.EXPECT().GenerateJWT(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return("", int64(0), &tidcommon.InternalServerError)
+
+	suite.service.notifyParticipant(context.Background(), testSubject, "sid-1", testAppID)
+
+	suite.httpClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) TestDeliver_RetriesServerErrorsThenSucceeds() {
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(response(http.StatusServiceUnavailable), nil).Once()
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(response(http.StatusNoContent), nil).Once()
+
+	suite.service.deliver(context.Background(), testLogoutURI, testToken, suite.service.logger)
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) TestDeliver_GivesUpAfterMaxAttempts() {
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(response(http.StatusBadGateway), nil).
+		Times(maxDeliveryAttempts)
+
+	suite.service.deliver(context.Background(), testLogoutURI, testToken, suite.service.logger)
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) TestDeliver_RejectedTokenIsNotRetried() {
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(response(http.StatusBadRequest), nil).Once()
+
+	suite.service.deliver(context.Background(), testLogoutURI, testToken, suite.service.logger)
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) TestNotifySessionEnded_DeliversAsynchronously() {
+	sess := session.Session{SessionID: "sess-1", SubjectID: testSubject}
+	delivered := make(chan struct{})
+	suite.expectRegisteredClient()
+	suite.expectLogoutToken(sess.SID())
+	suite.httpClient.EXPECT().Do(mock.Anything).RunAndReturn(func(*http.Request) (*http.Response, error) {
+		close(delivered)
+		return response(http.StatusOK), nil
+	}).Once()
+
+	suite.service.NotifySessionEnded(context.Background(), sess,
+		[]session.Participant{{SessionID: "sess-1", AppID: testAppID}})
+
+	select {
+	case <-delivered:
+	case <-time.After(5 * time.Second):
+		suite.Fail("logout token was not delivered")
+	}
+}
+
+func (suite *BackchannelLogoutServiceTestSuite) TestNotifySessionEnded_NoActorProviderIsNoOp() {
+	svc := newBackchannelLogoutService(suite.jwtService, nil, suite.httpClient, testIssuer)
+
+	svc.NotifySessionEnded(context.Background(), session.Session{SessionID: "sess-1"},
+		[]session.Participant{{SessionID: "sess-1", AppID: testAppID}})
+
+	suite.httpClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
@@ -3,8 +3,9 @@
 
 // Package callback owns the single POST /oauth2/auth/callback endpoint and dispatches
 // completed flow assertions to the appropriate grant-type handler based on the type
-// field in the request body. Adding support for a new grant type requires only a new
-// case in the handler switch — no changes to the authz or ciba packages.
+// field in the request body, including SAML SSO requests that share the same login
+// flow. Adding support for a new grant type requires only a new case in the handler
+// switch — no changes to the authz, ciba or device packages.
 package callback
 
 import (
@@ -17,7 +18,10 @@ import (
 	oauth2authz "github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/responsemode"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/saml"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/internal/system/utils"
@@ -37,22 +41,31 @@ type flowCallbackRequest struct {
 
 // callbackDispatcher dispatches flow assertion callbacks to the appropriate grant-type handler.
 type callbackDispatcher struct {
-	cfg          oauthconfig.Config
-	authZService oauth2authz.AuthorizeServiceInterface
-	cibaService  ciba.CIBAServiceInterface
-	logger       *log.Logger
+	cfg           oauthconfig.Config
+	authZService  oauth2authz.AuthorizeServiceInterface
+	cibaService   ciba.CIBAServiceInterface
+	deviceService device.DeviceServiceInterface
+	samlService   saml.SAMLServiceInterface
+	responseMode  responsemode.ResponseModeServiceInterface
+	logger        *log.Logger
 }
 
 func newCallbackDispatcher(
 	cfg oauthconfig.Config,
 	authZService oauth2authz.AuthorizeServiceInterface,
 	cibaService ciba.CIBAServiceInterface,
+	deviceService device.DeviceServiceInterface,
+	samlService saml.SAMLServiceInterface,
+	responseMode responsemode.ResponseModeServiceInterface,
 ) *callbackDispatcher {
 	return &callbackDispatcher{
-		cfg:          cfg,
-		authZService: authZService,
-		cibaService:  cibaService,
-		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CallbackHandler")),
+		cfg:           cfg,
+		authZService:  authZService,
+		cibaService:   cibaService,
+		deviceService: deviceService,
+		samlService:   samlService,
+		responseMode:  responseMode,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CallbackHandler")),
 	}
 }
 
@@ -61,9 +74,12 @@ func Initialize(
 	mux *http.ServeMux,
 	authZService oauth2authz.AuthorizeServiceInterface,
 	cibaService ciba.CIBAServiceInterface,
+	deviceService device.DeviceServiceInterface,
+	samlService saml.SAMLServiceInterface,
+	responseMode responsemode.ResponseModeServiceInterface,
 	cfg oauthconfig.Config,
 ) {
-	d := newCallbackDispatcher(cfg, authZService, cibaService)
+	d := newCallbackDispatcher(cfg, authZService, cibaService, deviceService, samlService, responseMode)
 	corsOpts := middleware.CORSOptions{
 		AllowedMethods:   []string{"POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
@@ -131,6 +147,40 @@ func (d *callbackDispatcher) handleFlowCallback(w http.ResponseWriter, r *http.R
 		}
 		utils.WriteSuccessResponse(ctx, w, http.StatusOK, map[string]string{"status": "OK"})
 
+	case string(providers.GrantTypeDeviceCode):
+		if d.deviceService == nil {
+			utils.WriteJSONError(ctx, w, oauth2const.ErrorInvalidRequest,
+				"Unsupported callback type", http.StatusBadRequest, nil)
+			return
+		}
+		redirectURI, deviceErr := d.deviceService.HandleCallback(ctx, req.AuthID, req.Assertion)
+		if deviceErr != nil {
+			statusCode := http.StatusBadRequest
+			if deviceErr.Code == oauth2const.ErrorServerError {
+				statusCode = http.StatusInternalServerError
+			}
+			utils.WriteJSONError(ctx, w, deviceErr.Code, deviceErr.Message, statusCode, nil)
+			return
+		}
+		utils.WriteSuccessResponse(ctx, w, http.StatusOK, oauth2authz.AuthZPostResponse{RedirectURI: redirectURI})
+
+	case string(providers.SAMLInboundAuthType):
+		if d.samlService == nil {
+			utils.WriteJSONError(ctx, w, oauth2const.ErrorInvalidRequest,
+				"Unsupported callback type", http.StatusBadRequest, nil)
+			return
+		}
+		redirectURI, samlErr := d.samlService.HandleCallback(ctx, req.AuthID, req.Assertion)
+		if samlErr != nil {
+			statusCode := http.StatusBadRequest
+			if samlErr.Code == saml.ErrorServerError {
+				statusCode = http.StatusInternalServerError
+			}
+			utils.WriteJSONError(ctx, w, samlErr.Code, samlErr.Message, statusCode, nil)
+			return
+		}
+		utils.WriteSuccessResponse(ctx, w, http.StatusOK, oauth2authz.AuthZPostResponse{RedirectURI: redirectURI})
+
 	default:
 		utils.WriteJSONError(ctx, w, oauth2const.ErrorInvalidRequest,
 			"Unsupported callback type", http.StatusBadRequest, nil)
@@ -149,7 +199,7 @@ func (
 	if authErr.State != "" {
 		queryParams[oauth2const.RequestParamState] = authErr.State
 	}
-	redirectURI, err := oauth2utils.GetURIWithQueryParams(authErr.ClientRedirectURI, queryParams)
+	redirectURI, err := d.responseMode.BuildResponseURI(ctx, authErr.ClientResponse(queryParams))
 	if err != nil {
 		d.logger.Error(ctx, "Failed to construct client redirect URI", log.Error(err))
 		d.writeErrorPageRedirect(ctx, w, oauth2const.ErrorServerError,
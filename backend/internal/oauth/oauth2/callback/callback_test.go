@@ -19,17 +19,26 @@ import (
 	oauth2authz "github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/responsemode"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/saml"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/authzmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/cibamock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/devicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/samlmock"
 	"github.com/thunder-id/thunderid/tests/testhelpers"
 )
 
 type CallbackDispatcherTestSuite struct {
 	suite.Suite
-	mockAuthZ  *authzmock.AuthorizeServiceInterfaceMock
-	mockCIBA   *cibamock.CIBAServiceInterfaceMock
-	dispatcher *callbackDispatcher
+	mockAuthZ    *authzmock.AuthorizeServiceInterfaceMock
+	mockCIBA     *cibamock.CIBAServiceInterfaceMock
+	mockDevice   *devicemock.DeviceServiceInterfaceMock
+	mockSAML     *samlmock.SAMLServiceInterfaceMock
+	responseMode responsemode.ResponseModeServiceInterface
+	dispatcher   *callbackDispatcher
 }
 
 func TestCallbackDispatcherSuite(t *testing.T) {
@@ -39,7 +48,12 @@ func TestCallbackDispatcherSuite(t *testing.T) {
 func (suite *CallbackDispatcherTestSuite) SetupTest() {
 	suite.mockAuthZ = authzmock.NewAuthorizeServiceInterfaceMock(suite.T())
 	suite.mockCIBA = cibamock.NewCIBAServiceInterfaceMock(suite.T())
-	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, suite.mockCIBA)
+	suite.mockDevice = devicemock.NewDeviceServiceInterfaceMock(suite.T())
+	suite.mockSAML = samlmock.NewSAMLServiceInterfaceMock(suite.T())
+	suite.responseMode = responsemode.Initialize(http.NewServeMux(), nil, nil, nil, nil,
+		inmemory.Initialize("test-deployment"), testhelpers.OAuthConfig())
+	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, suite.mockCIBA,
+		suite.mockDevice, suite.mockSAML, suite.responseMode)
 
 	_ = config.InitializeServerRuntime("test", &config.Config{
 		JWT: engineconfig.JWTConfig{
@@ -241,7 +255,8 @@ func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_CIBA_Error() {
 func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_CIBA_NilCIBAService_ReturnsBadRequest() {
 	// When the CIBA grant type is not in allowed_grant_types, cibaService is nil. A CIBA
 	// callback must be rejected gracefully instead of panicking on the nil service.
-	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, nil)
+	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, nil, suite.mockDevice,
+		suite.mockSAML, suite.responseMode)
 
 	w := suite.postCallback(
 		`{"authId":"auth-req-1","assertion":"ciba-assertion","type":"urn:openid:params:grant-type:ciba"}`)
@@ -253,6 +268,110 @@ func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_CIBA_NilCIBASer
 	suite.Contains(body["error_description"], "Unsupported callback type")
 }
 
+// --- handleFlowCallback: device code path ---
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_DeviceCode_Success() {
+	suite.mockDevice.EXPECT().
+		HandleCallback(mock.Anything, "device-req-1", "device-assertion").
+		Return("https://localhost:8090/oauth2/device?status=approved", nil)
+
+	w := suite.postCallback(`{"authId":"device-req-1","assertion":"device-assertion",` +
+		`"type":"urn:ietf:params:oauth:grant-type:device_code"}`)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var body oauth2authz.AuthZPostResponse
+	suite.NoError(json.NewDecoder(w.Body).Decode(&body))
+	suite.Equal("https://localhost:8090/oauth2/device?status=approved", body.RedirectURI)
+}
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_DeviceCode_Error() {
+	suite.mockDevice.EXPECT().
+		HandleCallback(mock.Anything, "device-req-1", "bad-assertion").
+		Return("", &device.DeviceError{Code: oauth2const.ErrorInvalidRequest, Message: "invalid"})
+
+	w := suite.postCallback(`{"authId":"device-req-1","assertion":"bad-assertion",` +
+		`"type":"urn:ietf:params:oauth:grant-type:device_code"}`)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	var body map[string]string
+	suite.NoError(json.NewDecoder(w.Body).Decode(&body))
+	suite.Equal(oauth2const.ErrorInvalidRequest, body["error"])
+}
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_DeviceCode_ServerError_Returns500() {
+	suite.mockDevice.EXPECT().
+		HandleCallback(mock.Anything, "device-req-1", "the-assertion").
+		Return("", &device.DeviceError{Code: oauth2const.ErrorServerError, Message: "store failure"})
+
+	w := suite.postCallback(`{"authId":"device-req-1","assertion":"the-assertion",` +
+		`"type":"urn:ietf:params:oauth:grant-type:device_code"}`)
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_DeviceCode_NilDeviceService_ReturnsBadRequest() {
+	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, suite.mockCIBA, nil,
+		suite.mockSAML, suite.responseMode)
+
+	w := suite.postCallback(`{"authId":"device-req-1","assertion":"device-assertion",` +
+		`"type":"urn:ietf:params:oauth:grant-type:device_code"}`)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	var body map[string]string
+	suite.NoError(json.NewDecoder(w.Body).Decode(&body))
+	suite.Contains(body["error_description"], "Unsupported callback type")
+}
+
+// --- handleFlowCallback: SAML path ---
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_SAML_Success() {
+	suite.mockSAML.EXPECT().
+		HandleCallback(mock.Anything, "saml-req-1", "saml-assertion").
+		Return("https://localhost:8090/saml2/response?id=resp-1", nil)
+
+	w := suite.postCallback(`{"authId":"saml-req-1","assertion":"saml-assertion","type":"saml2"}`)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var body oauth2authz.AuthZPostResponse
+	suite.NoError(json.NewDecoder(w.Body).Decode(&body))
+	suite.Equal("https://localhost:8090/saml2/response?id=resp-1", body.RedirectURI)
+}
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_SAML_Error() {
+	suite.mockSAML.EXPECT().
+		HandleCallback(mock.Anything, "saml-req-1", "bad-assertion").
+		Return("", &saml.SAMLError{Code: saml.ErrorInvalidRequest, Message: "invalid"})
+
+	w := suite.postCallback(`{"authId":"saml-req-1","assertion":"bad-assertion","type":"saml2"}`)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	var body map[string]string
+	suite.NoError(json.NewDecoder(w.Body).Decode(&body))
+	suite.Equal(saml.ErrorInvalidRequest, body["error"])
+}
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_SAML_ServerError_Returns500() {
+	suite.mockSAML.EXPECT().
+		HandleCallback(mock.Anything, "saml-req-1", "the-assertion").
+		Return("", &saml.SAMLError{Code: saml.ErrorServerError, Message: "store failure"})
+
+	w := suite.postCallback(`{"authId":"saml-req-1","assertion":"the-assertion","type":"saml2"}`)
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_SAML_NilSAMLService_ReturnsBadRequest() {
+	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, suite.mockCIBA,
+		suite.mockDevice, nil, suite.responseMode)
+
+	w := suite.postCallback(`{"authId":"saml-req-1","assertion":"saml-assertion","type":"saml2"}`)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	var body map[string]string
+	suite.NoError(json.NewDecoder(w.Body).Decode(&body))
+	suite.Contains(body["error_description"], "Unsupported callback type")
+}
+
 // --- handleFlowCallback: unsupported type ---
 
 func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_UnsupportedType_ReturnsBadRequest() {
@@ -303,6 +422,45 @@ func (suite *CallbackDispatcherTestSuite) TestWriteRedirectWithError_WithoutStat
 	suite.Contains(resp.RedirectURI, "error="+oauth2const.ErrorInvalidRequest)
 }
 
+func (suite *CallbackDispatcherTestSuite) TestWriteRedirectWithError_FragmentResponseMode() {
+	authErr := &oauth2authz.AuthorizationError{
+		Code:              oauth2const.ErrorAccessDenied,
+		Message:           "user denied",
+		SendErrorToClient: true,
+		ClientRedirectURI: "https://client.example.com/cb",
+		ResponseMode:      oauth2const.ResponseModeFragment,
+		State:             "abc123",
+	}
+	w := httptest.NewRecorder()
+	suite.dispatcher.writeRedirectWithError(context.Background(), w, authErr)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp oauth2authz.AuthZPostResponse
+	suite.NoError(json.NewDecoder(w.Body).Decode(&resp))
+	suite.True(strings.HasPrefix(resp.RedirectURI, "https://client.example.com/cb#"))
+	suite.Contains(resp.RedirectURI, "error="+oauth2const.ErrorAccessDenied)
+	suite.Contains(resp.RedirectURI, "state=abc123")
+}
+
+func (suite *CallbackDispatcherTestSuite) TestWriteRedirectWithError_FormPostResponseMode() {
+	authErr := &oauth2authz.AuthorizationError{
+		Code:              oauth2const.ErrorAccessDenied,
+		Message:           "user denied",
+		SendErrorToClient: true,
+		ClientRedirectURI: "https://client.example.com/cb",
+		ResponseMode:      oauth2const.ResponseModeFormPost,
+		State:             "abc123",
+	}
+	w := httptest.NewRecorder()
+	suite.dispatcher.writeRedirectWithError(context.Background(), w, authErr)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp oauth2authz.AuthZPostResponse
+	suite.NoError(json.NewDecoder(w.Body).Decode(&resp))
+	suite.True(strings.HasPrefix(resp.RedirectURI, "https://thunder.io/oauth2/authorize/response?id="))
+	suite.NotContains(resp.RedirectURI, "error=")
+}
+
 func (suite *CallbackDispatcherTestSuite) TestWriteRedirectWithError_URIConstructionError_FallsBackToErrorPage() {
 	// Inject an invalid error code character so GetURIWithQueryParams returns an error,
 	// exercising the fallback path that calls writeErrorPageRedirect.
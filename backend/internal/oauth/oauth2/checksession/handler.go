@@ -0,0 +1,103 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checksession
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// checkSessionIframeTemplate renders the check_session_iframe. A relying party posts
+// "<client_id> <session_state>" to it; the nonce-bound script recomputes session_state from each
+// per-flow browser state cookie and answers "unchanged" when one matches, "changed" when none does
+// (including when the cookies were cleared by a sign-out), and "error" for a malformed message.
+var checkSessionIframeTemplate = template.Must(template.New("checksession").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Check Session</title>
+</head>
+<body>
+<script nonce="{{.Nonce}}">
+(function () {
+  var cookiePrefix = {{.CookiePrefix}};
+
+  function browserStates() {
+    return document.cookie.split(";").map(function (c) { return c.trim(); })
+      .filter(function (c) { return c.indexOf(cookiePrefix) === 0; })
+      .map(function (c) { return decodeURIComponent(c.substring(c.indexOf("=") + 1)); })
+      .filter(function (v) { return v !== ""; });
+  }
+
+  function base64URL(buffer) {
+    var bytes = new Uint8Array(buffer), binary = "";
+    for (var i = 0; i < bytes.length; i++) { binary += String.fromCharCode(bytes[i]); }
+    return btoa(binary).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
+  }
+
+  function sessionState(clientID, origin, browserState, salt) {
+    var data = new TextEncoder().encode(clientID + " " + origin + " " + browserState + " " + salt);
+    return crypto.subtle.digest("SHA-256", data).then(function (sum) {
+      return base64URL(sum) + "." + salt;
+    });
+  }
+
+  window.addEventListener("message", function (e) {
+    if (!e.source || typeof e.data !== "string") { return; }
+    var reply = function (status) { e.source.postMessage(status, e.origin); };
+    var parts = e.data.split(" ");
+    var salt = parts.length === 2 ? parts[1].substring(parts[1].lastIndexOf(".") + 1) : "";
+    if (parts.length !== 2 || parts[0] === "" || parts[1].indexOf(".") < 0 || salt === "") {
+      reply("error");
+      return;
+    }
+    Promise.all(browserStates().map(function (state) {
+      return sessionState(parts[0], e.origin, state, salt);
+    })).then(function (states) {
+      reply(states.indexOf(parts[1]) >= 0 ? "unchanged" : "changed");
+    }, function () { reply("error"); });
+  }, false);
+})();
+</script>
+</body>
+</html>
+`))
+
+// checkSessionIframePage holds the data rendered into the check_session_iframe template.
+type checkSessionIframePage struct {
+	Nonce        string
+	CookiePrefix string
+}
+
+// HandleCheckSessionIframe serves the check_session_iframe. The page is meant to be framed by relying
+// parties on any origin, so its content security policy restricts only what it may load and run.
+func HandleCheckSessionIframe(w http.ResponseWriter, r *http.Request) {
+	nonceBytes := make([]byte, 16)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		log.GetLogger().Error(r.Context(), "Failed to generate check_session_iframe script nonce", log.Error(err))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(nonceBytes)
+
+	w.Header().Set(sysconst.ContentTypeHeaderName, sysconst.ContentTypeHTML)
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoStore)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+	w.Header().Set(sysconst.ContentSecurityPolicyHeaderName, fmt.Sprintf(
+		"default-src 'none'; script-src 'nonce-%s'; base-uri 'none'; form-action 'none'", nonce))
+	w.WriteHeader(http.StatusOK)
+	if err := checkSessionIframeTemplate.Execute(w, checkSessionIframePage{
+		Nonce:        nonce,
+		CookiePrefix: session.BrowserStateCookieNamePrefix,
+	}); err != nil {
+		log.GetLogger().Error(r.Context(), "Failed to render check_session_iframe", log.Error(err))
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checksession
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+)
+
+type CheckSessionHandlerTestSuite struct {
+	suite.Suite
+}
+
+func TestCheckSessionHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(CheckSessionHandlerTestSuite))
+}
+
+func (suite *CheckSessionHandlerTestSuite) TestHandleCheckSessionIframe() {
+	w := httptest.NewRecorder()
+
+	HandleCheckSessionIframe(w, httptest.NewRequest(http.MethodGet, "/oauth2/check-session", nil))
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal(sysconst.ContentTypeHTML, w.Header().Get(sysconst.ContentTypeHeaderName))
+	suite.Equal(sysconst.CacheControlNoStore, w.Header().Get(sysconst.CacheControlHeaderName))
+
+	csp := w.Header().Get(sysconst.ContentSecurityPolicyHeaderName)
+	suite.Contains(csp, "default-src 'none'")
+	// Relying parties on any origin must be able to frame it.
+	suite.NotContains(csp, "frame-ancestors")
+
+	_, afterNonce, found := strings.Cut(csp, "'nonce-")
+	suite.Require().True(found)
+	nonce, _, _ := strings.Cut(afterNonce, "'")
+	body := w.Body.String()
+	suite.Contains(body, `<script nonce="`+nonce+`">`)
+	suite.Contains(body, `"`+session.BrowserStateCookieNamePrefix+`"`)
+}
+
+func (suite *CheckSessionHandlerTestSuite) TestHandleCheckSessionIframe_FreshNoncePerResponse() {
+	first := httptest.NewRecorder()
+	HandleCheckSessionIframe(first, httptest.NewRequest(http.MethodGet, "/oauth2/check-session", nil))
+	second := httptest.NewRecorder()
+	HandleCheckSessionIframe(second, httptest.NewRequest(http.MethodGet, "/oauth2/check-session", nil))
+
+	suite.NotEqual(first.Header().Get(sysconst.ContentSecurityPolicyHeaderName),
+		second.Header().Get(sysconst.ContentSecurityPolicyHeaderName))
+}
@@ -0,0 +1,19 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package checksession implements OpenID Connect Session Management 1.0. Authorization responses carry
+// a session_state derived from the SSO session's browser state (see session.BrowserState), and the
+// check_session_iframe served here lets a relying party's page compare that value against the browser
+// state cookie without a round trip, so it learns when the End-User's session has changed or ended.
+package checksession
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+)
+
+// Initialize registers the check_session_iframe endpoint.
+func Initialize(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+constants.OAuth2CheckSessionEndpoint, HandleCheckSessionIframe)
+}
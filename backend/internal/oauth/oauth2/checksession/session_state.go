@@ -0,0 +1,61 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checksession
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// saltLength is the number of random bytes in a session_state salt.
+const saltLength = 16
+
+// errInvalidRedirectURI is returned when no origin can be derived from the redirect URI.
+var errInvalidRedirectURI = errors.New("redirect URI has no origin")
+
+// ComputeSessionState returns a fresh session_state for an authorization response: a salted hash of
+// the client id, the origin of the redirect URI and the session's browser state, followed by the
+// salt. check_session_iframe recomputes the hash from the browser state cookie to tell whether the
+// session has changed since the response was issued.
+func ComputeSessionState(clientID, redirectURI, browserState string) (string, error) {
+	origin, err := originOf(redirectURI)
+	if err != nil {
+		return "", err
+	}
+	saltBytes := make([]byte, saltLength)
+	if _, err := rand.Read(saltBytes); err != nil {
+		return "", fmt.Errorf("failed to generate session_state salt: %w", err)
+	}
+	return sessionState(clientID, origin, browserState, base64.RawURLEncoding.EncodeToString(saltBytes)), nil
+}
+
+// sessionState hashes the inputs exactly as the check_session_iframe script does.
+func sessionState(clientID, origin, browserState, salt string) string {
+	sum := sha256.Sum256([]byte(clientID + " " + origin + " " + browserState + " " + salt))
+	return base64.RawURLEncoding.EncodeToString(sum[:]) + "." + salt
+}
+
+// originOf returns the serialized origin of a URI as a browser reports it in MessageEvent.origin:
+// lower-case scheme and host, with the port only when it is not the scheme's default.
+func originOf(rawURI string) (string, error) {
+	parsed, err := url.Parse(rawURI)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return "", errInvalidRedirectURI
+	}
+	scheme := strings.ToLower(parsed.Scheme)
+	host := strings.ToLower(parsed.Hostname())
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	port := parsed.Port()
+	if port == "" || (scheme == "https" && port == "443") || (scheme == "http" && port == "80") {
+		return scheme + "://" + host, nil
+	}
+	return scheme + "://" + host + ":" + port, nil
+}
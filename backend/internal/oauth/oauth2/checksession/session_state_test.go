@@ -0,0 +1,69 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package checksession
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionStateTestSuite struct {
+	suite.Suite
+}
+
+func TestSessionStateTestSuite(t *testing.T) {
+	suite.Run(t, new(SessionStateTestSuite))
+}
+
+func (suite *SessionStateTestSuite) TestComputeSessionState_RecomputableFromSalt() {
+	state, err := ComputeSessionState("client-1", "https://rp.example.com/callback?x=1", "opbs-1")
+	suite.Require().NoError(err)
+
+	hash, salt, found := strings.Cut(state, ".")
+	suite.Require().True(found)
+	suite.NotEmpty(hash)
+	suite.NotEmpty(salt)
+	// The iframe recomputes it from the RP's origin, not its full redirect URI.
+	suite.Equal(state, sessionState("client-1", "https://rp.example.com", "opbs-1", salt))
+	suite.NotEqual(state, sessionState("client-1", "https://rp.example.com", "opbs-2", salt))
+	suite.NotEqual(state, sessionState("client-2", "https://rp.example.com", "opbs-1", salt))
+}
+
+func (suite *SessionStateTestSuite) TestComputeSessionState_FreshSaltPerResponse() {
+	first, err := ComputeSessionState("client-1", "https://rp.example.com/callback", "opbs-1")
+	suite.Require().NoError(err)
+	second, err := ComputeSessionState("client-1", "https://rp.example.com/callback", "opbs-1")
+	suite.Require().NoError(err)
+
+	suite.NotEqual(first, second)
+}
+
+func (suite *SessionStateTestSuite) TestComputeSessionState_InvalidRedirectURI() {
+	_, err := ComputeSessionState("client-1", "/callback", "opbs-1")
+
+	suite.ErrorIs(err, errInvalidRedirectURI)
+}
+
+func (suite *SessionStateTestSuite) TestOriginOf() {
+	testCases := []struct {
+		uri    string
+		origin string
+	}{
+		{"https://rp.example.com/callback", "https://rp.example.com"},
+		{"HTTPS://RP.Example.com:443/callback", "https://rp.example.com"},
+		{"http://localhost:80/cb", "http://localhost"},
+		{"http://localhost:3000/cb?x=1", "http://localhost:3000"},
+		{"https://[::1]:8443/cb", "https://[::1]:8443"},
+		{"myapp://callback", "myapp://callback"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.uri, func() {
+			origin, err := originOf(tc.uri)
+			suite.NoError(err)
+			suite.Equal(tc.origin, origin)
+		})
+	}
+}
@@ -6,6 +6,8 @@ package ciba
 
 import (
 	"time"
+
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 )
 
 // CIBARequestState represents the lifecycle state of a CIBA authentication request.
@@ -42,6 +44,10 @@ type CIBAAuthRequest struct {
 	AuthTime         time.Time
 	LastPolledAt     time.Time
 	ExpiryTime       time.Time
+
+	// AuthorizationDetails holds the RFC 9396 authorization details requested by the client and
+	// consented to by the user. They are granted to the access token issued for the request.
+	AuthorizationDetails []oauth2model.AuthorizationDetail
 }
 
 // BackchannelAuthResponse represents the response body for a successful backchannel authentication request.
@@ -68,6 +74,9 @@ type BackchannelAuthRequest struct {
 	ACRValues       string
 	Headers         map[string][]string
 	QueryParams     map[string][]string
+
+	// AuthorizationDetails is the raw authorization_details parameter (RFC 9396).
+	AuthorizationDetails string
 }
 
 // assertionClaims represents the claims extracted from the flow assertion JWT.
@@ -17,7 +17,9 @@ import (
 	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
@@ -56,6 +58,7 @@ type cibaService struct {
 	jwtService      jwt.JWTServiceInterface
 	inboundClient   providers.ActorProvider
 	resourceService providers.ResourceServerProvider
+	pairwiseService pairwise.PairwiseSubjectServiceInterface
 	logger          *log.Logger
 }
 
@@ -66,6 +69,7 @@ func newCIBAService(
 	jwtService jwt.JWTServiceInterface,
 	actorProvider providers.ActorProvider,
 	resourceService providers.ResourceServerProvider,
+	pairwiseService pairwise.PairwiseSubjectServiceInterface,
 	cfg oauthconfig.Config,
 ) CIBAServiceInterface {
 	return &cibaService{
@@ -75,6 +79,7 @@ func newCIBAService(
 		jwtService:      jwtService,
 		inboundClient:   actorProvider,
 		resourceService: resourceService,
+		pairwiseService: pairwiseService,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CIBAService")),
 	}
 }
@@ -119,6 +124,23 @@ func (s *cibaService) InitiateBackchannelAuth(
 	if rsErr != nil {
 		return nil, &CIBAError{Code: rsErr.Error, Message: rsErr.ErrorDescription}
 	}
+	authorizationDetails, rsErr := authorizationdetails.Parse(request.AuthorizationDetails)
+	if rsErr != nil {
+		return nil, &CIBAError{Code: rsErr.Error, Message: rsErr.ErrorDescription}
+	}
+	targetRS, rsErr = authorizationdetails.ResolveAndValidate(
+		ctx, s.resourceService, request.Resources, targetRS, authorizationDetails)
+	if rsErr != nil {
+		return nil, &CIBAError{Code: rsErr.Error, Message: rsErr.ErrorDescription}
+	}
+	serializedDetails, err := authorizationdetails.Serialize(authorizationDetails)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to serialize authorization details", log.Error(err))
+		return nil, &CIBAError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process backchannel authentication request",
+		}
+	}
 
 	var effectiveResources []string
 	resourceServerIdentifier := ""
@@ -160,6 +182,9 @@ func (s *cibaService) InitiateBackchannelAuth(
 	if request.ACRValues != "" {
 		runtimeData[flowcm.RuntimeKeyRequestedAuthClasses] = request.ACRValues
 	}
+	if serializedDetails != "" {
+		runtimeData[flowcm.RuntimeKeyRequestedAuthorizationDetails] = serializedDetails
+	}
 
 	loginHint := request.LoginHint
 	if request.IDTokenHint != "" {
@@ -204,6 +229,8 @@ func (s *cibaService) InitiateBackchannelAuth(
 		Resources:      effectiveResources,
 		State:          CIBAStatePending,
 		ExpiryTime:     now.Add(time.Duration(expiresIn) * time.Second),
+
+		AuthorizationDetails: authorizationDetails,
 	}
 	if storeErr := s.store.Add(ctx, cibaRequest); storeErr != nil {
 		s.logger.Error(ctx, "Failed to store CIBA authentication request", log.Error(storeErr))
@@ -484,6 +511,19 @@ func (s *cibaService) validateIDTokenHint(ctx context.Context, idTokenHint strin
 		}
 	}
 
+	if s.pairwiseService != nil {
+		// A hint issued to a pairwise client names the user by that client's pairwise identifier.
+		localSub, resolveErr := s.pairwiseService.ResolveLocalSubject(ctx, sub)
+		if resolveErr != nil {
+			s.logger.Error(ctx, "Failed to resolve id_token_hint subject", log.Error(resolveErr))
+			return "", &CIBAError{
+				Code:    oauth2const.ErrorServerError,
+				Message: "Failed to process backchannel authentication request",
+			}
+		}
+		sub = localSub
+	}
+
 	return sub, nil
 }
 
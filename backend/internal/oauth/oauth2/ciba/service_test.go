@@ -30,6 +30,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
 	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 	"github.com/thunder-id/thunderid/tests/testhelpers"
 )
@@ -64,7 +65,7 @@ func (suite *CIBAServiceTestSuite) SetupTest() {
 	suite.mockResourceSvc = resourcemock.NewResourceServiceInterfaceMock(suite.T())
 	actorProv := actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil)
 	suite.service = newCIBAService(suite.mockStore, suite.mockFlowExec,
-		suite.mockJWTService, actorProv, suite.mockResourceSvc, testhelpers.OAuthConfig())
+		suite.mockJWTService, actorProv, suite.mockResourceSvc, nil, testhelpers.OAuthConfig())
 	suite.oauthApp = &providers.OAuthClient{
 		ID:         "app-1",
 		ClientID:   "client-1",
@@ -974,7 +975,7 @@ func (suite *CIBAServiceTestSuite) withIssuer() {
 	cfg.JWT.Issuer = testIssuer
 	actorProv := actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil)
 	suite.service = newCIBAService(suite.mockStore, suite.mockFlowExec,
-		suite.mockJWTService, actorProv, suite.mockResourceSvc, cfg)
+		suite.mockJWTService, actorProv, suite.mockResourceSvc, nil, cfg)
 }
 
 func (suite *CIBAServiceTestSuite) validIDTokenHint() string {
@@ -1005,6 +1006,57 @@ func (suite *CIBAServiceTestSuite) TestInitiate_WithIDTokenHint_Success() {
 	suite.NotNil(resp)
 }
 
+// withPairwiseService rebuilds the service with a pairwise subject resolver.
+func (suite *CIBAServiceTestSuite) withPairwiseService() *pairwisemock.PairwiseSubjectServiceInterfaceMock {
+	cfg := testhelpers.OAuthConfig()
+	cfg.JWT.Issuer = testIssuer
+	pairwiseSvc := pairwisemock.NewPairwiseSubjectServiceInterfaceMock(suite.T())
+	actorProv := actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil)
+	suite.service = newCIBAService(suite.mockStore, suite.mockFlowExec,
+		suite.mockJWTService, actorProv, suite.mockResourceSvc, pairwiseSvc, cfg)
+	return pairwiseSvc
+}
+
+func (suite *CIBAServiceTestSuite) TestInitiate_WithIDTokenHint_PairwiseSubjectResolved() {
+	pairwiseSvc := suite.withPairwiseService()
+	hint := buildTestAssertion(map[string]interface{}{
+		"iss": testIssuer,
+		"sub": "pairwise-sub",
+		"exp": float64(time.Now().Add(10 * time.Minute).Unix()),
+	})
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(mock.Anything, hint).Return(nil)
+	pairwiseSvc.EXPECT().ResolveLocalSubject(mock.Anything, "pairwise-sub").Return(testEntityID, nil)
+	suite.mockFlowExec.EXPECT().InitiateAndExecute(mock.Anything, mock.MatchedBy(
+		func(initCtx *flowexec.FlowInitContext) bool {
+			return initCtx.InitialInputs[oauth2const.RequestParamLoginHint] == testEntityID
+		})).Return(&flowexec.FlowStep{ExecutionID: "exec-1", Status: providers.FlowStatusIncomplete}, nil)
+	suite.expectStoreAddSuccess()
+
+	resp, cibaErr := suite.service.InitiateBackchannelAuth(context.Background(), &BackchannelAuthRequest{
+		IDTokenHint: hint,
+		Scope:       "openid",
+	}, suite.oauthApp)
+
+	suite.Nil(cibaErr)
+	suite.NotNil(resp)
+}
+
+func (suite *CIBAServiceTestSuite) TestInitiate_WithIDTokenHint_PairwiseResolveError() {
+	pairwiseSvc := suite.withPairwiseService()
+	hint := suite.validIDTokenHint()
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(mock.Anything, hint).Return(nil)
+	pairwiseSvc.EXPECT().ResolveLocalSubject(mock.Anything, testEntityID).Return("", errors.New("db down"))
+
+	resp, cibaErr := suite.service.InitiateBackchannelAuth(context.Background(), &BackchannelAuthRequest{
+		IDTokenHint: hint,
+		Scope:       "openid",
+	}, suite.oauthApp)
+
+	suite.Nil(resp)
+	suite.Require().NotNil(cibaErr)
+	suite.Equal(oauth2const.ErrorServerError, cibaErr.Code)
+}
+
 func (suite *CIBAServiceTestSuite) TestInitiate_WithIDTokenHint_InvalidJWT() {
 	suite.withIssuer()
 
@@ -1269,7 +1321,7 @@ func (suite *CIBAServiceTestSuite) serviceWithServerErrorReporting(enabled bool)
 	cfg.OAuth.SendServerErrorsToClient = &enabled
 	actorProv := actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil)
 	return newCIBAService(suite.mockStore, suite.mockFlowExec,
-		suite.mockJWTService, actorProv, suite.mockResourceSvc, cfg)
+		suite.mockJWTService, actorProv, suite.mockResourceSvc, nil, cfg)
 }
 
 // TestHandleCallback_Failure_ServerErrorsNotReported verifies that with
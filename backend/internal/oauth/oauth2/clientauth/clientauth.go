@@ -17,7 +17,9 @@ import (
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	"github.com/thunder-id/thunderid/internal/cert"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/fapi"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -25,7 +27,7 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
-// jtiNamespace identifies private_key_jwt client assertions in the shared JTI replay store.
+// jtiNamespace identifies private_key_jwt and client_secret_jwt client assertions in the shared JTI replay store.
 const jtiNamespace = "client_assertion"
 
 // authenticate authenticates the OAuth2 client from the request.
@@ -66,7 +68,7 @@ func authenticate(
 		detectedMethod = providers.TokenEndpointAuthMethodClientSecretPost
 	}
 
-	// Method 3: Client assertion (private_key_jwt)
+	// Method 3: Client assertion (private_key_jwt or client_secret_jwt)
 	if clientAssertionType != "" || clientAssertion != "" {
 		if detectedMethod != "" {
 			return nil, errMultipleAuthMethods
@@ -130,6 +132,18 @@ func authenticate(
 		return nil, errInvalidClientCredentials
 	}
 
+	// Mutual-TLS clients identify themselves with client_id alone; the credential is the client
+	// certificate of the connection (RFC 8705 §2).
+	if detectedMethod == providers.TokenEndpointAuthMethodNone && isMTLSAuthMethod(oauthApp.TokenEndpointAuthMethod) {
+		detectedMethod = oauthApp.TokenEndpointAuthMethod
+	}
+	// A client assertion is verified with the secret rather than a public key when the client is
+	// registered for client_secret_jwt.
+	if detectedMethod == providers.TokenEndpointAuthMethodPrivateKeyJWT &&
+		oauthApp.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodClientSecretJWT {
+		detectedMethod = providers.TokenEndpointAuthMethodClientSecretJWT
+	}
+
 	if oauthApp.TokenEndpointAuthMethod != detectedMethod {
 		// No credentials presented for a client that requires authentication.
 		if detectedMethod == providers.TokenEndpointAuthMethodNone {
@@ -138,15 +152,30 @@ func authenticate(
 		return nil, errUnauthorizedAuthMethod
 	}
 
+	if oauthApp.IsFAPI2() {
+		if authErr := validateFAPI2ClientAuth(detectedMethod, clientAssertion); authErr != nil {
+			logger.Debug(ctx, "Client authentication does not conform to the FAPI 2.0 security profile",
+				log.MaskedString("clientID", clientID))
+			return nil, authErr
+		}
+	}
+
 	// Validate credentials based on method
 	switch detectedMethod {
 	// TODO: Move this to authnProvider.Authenticate
-	case providers.TokenEndpointAuthMethodPrivateKeyJWT:
+	case providers.TokenEndpointAuthMethodPrivateKeyJWT, providers.TokenEndpointAuthMethodClientSecretJWT:
 		if err := validateClientAssertion(ctx, oauthApp, jwtService, jtiStore, issuer, clientID,
 			clientAssertion, leeway); err != nil {
 			logger.Debug(ctx, "Invalid client assertion: "+err.Error())
 			return nil, errInvalidClientAssertion
 		}
+	case providers.TokenEndpointAuthMethodTLSClientAuth,
+		providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
+		if err := validateClientCertificate(ctx, oauthApp); err != nil {
+			logger.Debug(ctx, "Mutual-TLS client authentication failed: "+err.Error(),
+				log.MaskedString("clientID", clientID))
+			return nil, errInvalidClientCertificate
+		}
 	case providers.TokenEndpointAuthMethodClientSecretBasic,
 		providers.TokenEndpointAuthMethodClientSecretPost:
 		_, _, authnErr := authnProvider.AuthenticateUser(ctx,
@@ -167,6 +196,64 @@ func authenticate(
 	}, nil
 }
 
+// validateFAPI2ClientAuth enforces the FAPI 2.0 Security Profile on client authentication: the client
+// must authenticate with private_key_jwt or mutual-TLS, and a client assertion must be signed with
+// one of the profile's algorithms.
+func validateFAPI2ClientAuth(method providers.TokenEndpointAuthMethod, clientAssertion string) *authError {
+	if !fapi.IsAllowedClientAuthMethod(method) {
+		return errFAPI2AuthMethodNotAllowed
+	}
+	if method != providers.TokenEndpointAuthMethodPrivateKeyJWT {
+		return nil
+	}
+	header, err := jwt.DecodeJWTHeader(clientAssertion)
+	if err != nil {
+		return errInvalidClientAssertion
+	}
+	if alg, _ := header["alg"].(string); !fapi.IsAllowedSigningAlgorithm(alg) {
+		return errFAPI2AssertionAlgNotAllowed
+	}
+	return nil
+}
+
+// isMTLSAuthMethod reports whether the method authenticates the client with its TLS client certificate.
+func isMTLSAuthMethod(method providers.TokenEndpointAuthMethod) bool {
+	return method == providers.TokenEndpointAuthMethodTLSClientAuth ||
+		method == providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth
+}
+
+// validateClientCertificate validates the client certificate presented on the connection against the
+// client's registration: a CA-issued certificate must carry the registered subject (RFC 8705 §2.1),
+// while a self-signed one must hold a key from the registered JWKS (RFC 8705 §2.2).
+func validateClientCertificate(ctx context.Context, oauthApp *providers.OAuthClient) error {
+	clientCert := mtls.GetClientCertificate(ctx)
+	if clientCert == nil {
+		return fmt.Errorf("no client certificate presented")
+	}
+
+	if oauthApp.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodTLSClientAuth {
+		if !clientCert.Trusted {
+			return fmt.Errorf("client certificate is not issued by a trusted CA")
+		}
+		if !mtls.MatchesTLSClientAuth(clientCert.Certificate, oauthApp.TLSClientAuth) {
+			return fmt.Errorf("client certificate does not match the registered subject")
+		}
+		return nil
+	}
+
+	if oauthApp.Certificate == nil || oauthApp.Certificate.Type != cert.CertificateTypeJWKS {
+		return fmt.Errorf("no inline JWKS configured for self-signed certificate validation")
+	}
+	matched, err := mtls.MatchesJWKS(clientCert.Certificate, oauthApp.Certificate.Value)
+	if err != nil {
+		return err
+	}
+	if !matched {
+		return fmt.Errorf("client certificate key is not in the registered JWKS")
+	}
+	return nil
+}
+
 // extractBasicAuthCredentials extracts the basic authentication credentials from the request header.
 func extractBasicAuthCredentials(r *http.Request) (string, string, *authError) {
 	authHeader := r.Header.Get(serverconst.AuthorizationHeaderName)
@@ -225,7 +312,8 @@ func extractClientIDFromAssertion(ctx context.Context, assertion string) (string
 	return subject, nil
 }
 
-// validateClientAssertion validates the provided client assertion JWT using the configured certificate and JWT service.
+// validateClientAssertion validates the provided client assertion JWT, verifying it with the client's configured
+// certificate (private_key_jwt) or its client secret (client_secret_jwt).
 // Per FAPI 2.0 Security Profile Section 5.3.2.1, the assertion's 'aud' claim must be the authorization server's
 // issuer identifier.
 func validateClientAssertion(ctx context.Context,
@@ -235,7 +323,11 @@ func validateClientAssertion(ctx context.Context,
 	issuer string,
 	clientID, clientAssertion string,
 	leeway int64) error {
-	if oauthApp.Certificate == nil {
+	if oauthApp.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodClientSecretJWT {
+		if oauthApp.ClientSecret == "" {
+			return fmt.Errorf("no client secret available for client assertion validation")
+		}
+	} else if oauthApp.Certificate == nil {
 		return fmt.Errorf("no certificate configured for client assertion validation")
 	}
 
@@ -253,7 +345,14 @@ func validateClientAssertion(ctx context.Context,
 		return fmt.Errorf("client assertion 'aud' claim %q does not match the issuer", aud)
 	}
 
-	if err := verifyAssertionSignature(ctx, oauthApp, jwtService, issuer, clientID, clientAssertion); err != nil {
+	if oauthApp.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodClientSecretJWT {
+		if err := jwtService.VerifyJWTWithSecret(ctx, clientAssertion, []byte(oauthApp.ClientSecret), issuer,
+			clientID); err != nil {
+			return fmt.Errorf("client assertion verification with client secret failed: %s",
+				err.ErrorDescription.DefaultValue)
+		}
+	} else if err := verifyAssertionSignature(ctx, oauthApp, jwtService, issuer, clientID,
+		clientAssertion); err != nil {
 		return err
 	}
 
@@ -270,7 +369,8 @@ func verifyAssertionSignature(ctx context.Context,
 	if oauthApp.Certificate.Type == cert.CertificateTypeJWKSURI {
 		if err := jwtService.VerifyJWTWithJWKS(ctx, clientAssertion, oauthApp.Certificate.Value, issuer,
 			clientID); err != nil {
-			return fmt.Errorf("client assertion verification with JWKS URI failed: %v", err.Error)
+			return fmt.Errorf("client assertion verification with JWKS URI failed: %s",
+				err.ErrorDescription.DefaultValue)
 		}
 		return nil
 	}
@@ -304,7 +404,7 @@ func verifyAssertionSignature(ctx context.Context,
 
 	if err := jwtService.VerifyJWTWithPublicKey(ctx, clientAssertion, providers.KeyRef{PublicKeyJWK: jwk},
 		issuer, clientID); err != nil {
-		return fmt.Errorf("client assertion verification failed: %v", err.Error)
+		return fmt.Errorf("client assertion verification failed: %s", err.ErrorDescription.DefaultValue)
 	}
 
 	return nil
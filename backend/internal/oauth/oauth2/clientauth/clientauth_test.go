@@ -5,8 +5,12 @@ package clientauth
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -15,6 +19,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -28,6 +33,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/cert"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
@@ -1103,6 +1109,28 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_JWKSURI_Verificati
 	assert.Contains(suite.T(), err.Error(), "client assertion verification with JWKS URI failed")
 }
 
+func (suite *ClientAuthTestSuite) TestValidateClientAssertion_ClientSecretJWT_VerificationFails() {
+	oauthApp := &providers.OAuthClient{
+		ClientID:                "test-client",
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodClientSecretJWT,
+		ClientSecret:            "test-secret",
+	}
+
+	assertion := buildFakeJWTWithSub("test-client")
+
+	suite.mockJwtService.EXPECT().
+		VerifyJWTWithSecret(mock.Anything, assertion, []byte("test-secret"), testIssuer, "test-client").
+		Return(&tidcommon.ServiceError{
+			Error:            tidcommon.I18nMessage{DefaultValue: "Invalid token"},
+			ErrorDescription: tidcommon.I18nMessage{DefaultValue: "signature does not match"},
+		})
+
+	err := validateClientAssertion(context.Background(),
+		oauthApp, suite.mockJwtService, suite.mockJtiStore, testIssuer, "test-client", assertion, testLeeway)
+	assert.EqualError(suite.T(), err,
+		"client assertion verification with client secret failed: signature does not match")
+}
+
 func (suite *ClientAuthTestSuite) TestValidateClientAssertion_InvalidJWKSJSON() {
 	oauthApp := &providers.OAuthClient{
 		ClientID: "test-client",
@@ -1602,3 +1630,307 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_MissingJTI() {
 func noopAuthnMgr() *managermock.AuthnProviderManagerMock {
 	return &managermock.AuthnProviderManagerMock{}
 }
+
+// newMTLSTestCertificate returns a self-signed client certificate for the given subject and its key.
+func newMTLSTestCertificate(t *testing.T, commonName string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return certificate, key
+}
+
+// newMTLSRequest builds a token request carrying only client_id, with the given client certificate
+// attached to its context.
+func newMTLSRequest(clientCert *mtls.ClientCertificate) *http.Request {
+	formData := url.Values{}
+	formData.Set("client_id", testClientID)
+	req, _ := http.NewRequest("POST", "/test", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_ = req.ParseForm()
+	if clientCert != nil {
+		req = req.WithContext(mtls.WithClientCertificate(req.Context(), clientCert))
+	}
+	return req
+}
+
+func (suite *ClientAuthTestSuite) TestAuthenticate_TLSClientAuth() {
+	clientCert, _ := newMTLSTestCertificate(suite.T(), "client")
+	mockApp := &providers.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuth:           &providers.TLSClientAuthConfig{SubjectDN: "CN=client"},
+	}
+
+	testCases := []struct {
+		name        string
+		clientCert  *mtls.ClientCertificate
+		expectedErr *authError
+	}{
+		{"Success", &mtls.ClientCertificate{Certificate: clientCert, Trusted: true}, nil},
+		{"NoCertificate", nil, errInvalidClientCertificate},
+		{"UntrustedCertificate", &mtls.ClientCertificate{Certificate: clientCert}, errInvalidClientCertificate},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+				Return(mockApp, nil).Once()
+			req := newMTLSRequest(tc.clientCert)
+
+			clientInfo, authErr := authenticate(req.Context(), req,
+				suite.actorProvider(), suite.mockAuthnProvider, suite.mockJwtService, suite.mockJtiStore,
+				testIssuer, testLeeway)
+
+			suite.Equal(tc.expectedErr, authErr)
+			if tc.expectedErr == nil {
+				suite.Require().NotNil(clientInfo)
+				suite.Equal(testClientID, clientInfo.ClientID)
+			}
+		})
+	}
+}
+
+func (suite *ClientAuthTestSuite) TestAuthenticate_TLSClientAuth_SubjectMismatch() {
+	clientCert, _ := newMTLSTestCertificate(suite.T(), "other")
+	mockApp := &providers.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuth:           &providers.TLSClientAuthConfig{SubjectDN: "CN=client"},
+	}
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+		Return(mockApp, nil).Once()
+	req := newMTLSRequest(&mtls.ClientCertificate{Certificate: clientCert, Trusted: true})
+
+	clientInfo, authErr := authenticate(req.Context(), req,
+		suite.actorProvider(), suite.mockAuthnProvider, suite.mockJwtService, suite.mockJtiStore,
+		testIssuer, testLeeway)
+
+	suite.Nil(clientInfo)
+	suite.Equal(errInvalidClientCertificate, authErr)
+}
+
+func (suite *ClientAuthTestSuite) TestAuthenticate_SelfSignedTLSClientAuth() {
+	clientCert, key := newMTLSTestCertificate(suite.T(), "client")
+	otherCert, _ := newMTLSTestCertificate(suite.T(), "other")
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	key.PublicKey.X.FillBytes(x)
+	key.PublicKey.Y.FillBytes(y)
+	jwks, _ := json.Marshal(map[string]any{"keys": []map[string]any{{
+		"kty": "EC", "crv": "P-256",
+		"x": base64.RawURLEncoding.EncodeToString(x),
+		"y": base64.RawURLEncoding.EncodeToString(y),
+	}}})
+	mockApp := &providers.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth,
+		Certificate:             &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: string(jwks)},
+	}
+
+	testCases := []struct {
+		name        string
+		clientCert  *x509.Certificate
+		expectedErr *authError
+	}{
+		{"Success", clientCert, nil},
+		{"KeyNotRegistered", otherCert, errInvalidClientCertificate},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+				Return(mockApp, nil).Once()
+			req := newMTLSRequest(&mtls.ClientCertificate{Certificate: tc.clientCert})
+
+			_, authErr := authenticate(req.Context(), req,
+				suite.actorProvider(), suite.mockAuthnProvider, suite.mockJwtService, suite.mockJtiStore,
+				testIssuer, testLeeway)
+
+			suite.Equal(tc.expectedErr, authErr)
+		})
+	}
+}
+
+func (suite *ClientAuthTestSuite) TestAuthenticate_ClientSecretJWT() {
+	assertion := buildTestJWT(
+		map[string]any{"alg": "HS256", "typ": "JWT"},
+		map[string]any{"sub": testClientID, "aud": testIssuer, "jti": "test-jti", "exp": 9999999999})
+
+	testCases := []struct {
+		name        string
+		secret      string
+		verifyErr   *tidcommon.ServiceError
+		expectedErr *authError
+	}{
+		{name: "Success", secret: "test-secret"},
+		{name: "InvalidSignature", secret: "test-secret", verifyErr: &tidcommon.ServiceError{},
+			expectedErr: errInvalidClientAssertion},
+		{name: "NoStoredSecret", expectedErr: errInvalidClientAssertion},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			mockApp := &providers.OAuthClient{
+				ClientID:                testClientID,
+				TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodClientSecretJWT,
+				GrantTypes:              []providers.GrantType{providers.GrantTypeClientCredentials},
+				ClientSecret:            tc.secret,
+			}
+			suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+				Return(mockApp, nil).Once()
+			if tc.secret != "" {
+				suite.mockJwtService.EXPECT().
+					VerifyJWTWithSecret(mock.Anything, assertion, []byte(tc.secret), testIssuer, testClientID).
+					Return(tc.verifyErr).Once()
+			}
+
+			formData := url.Values{}
+			formData.Set("client_assertion_type", constants.SupportedClientAssertionType)
+			formData.Set("client_assertion", assertion)
+			req, _ := http.NewRequest("POST", "/test", strings.NewReader(formData.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			_ = req.ParseForm()
+
+			clientInfo, authErr := authenticate(req.Context(), req,
+				suite.actorProvider(), suite.mockAuthnProvider, suite.mockJwtService, suite.mockJtiStore,
+				testIssuer, testLeeway)
+
+			if tc.expectedErr != nil {
+				assert.Equal(suite.T(), tc.expectedErr, authErr)
+				assert.Nil(suite.T(), clientInfo)
+				return
+			}
+			assert.Nil(suite.T(), authErr)
+			suite.Require().NotNil(clientInfo)
+			assert.Equal(suite.T(), testClientID, clientInfo.ClientID)
+		})
+	}
+}
+
+func (suite *ClientAuthTestSuite) TestAuthenticate_ClientSecretJWT_ReplayRejected() {
+	assertion := buildTestJWT(
+		map[string]any{"alg": "HS256", "typ": "JWT"},
+		map[string]any{"sub": testClientID, "aud": testIssuer, "jti": "test-jti", "exp": 9999999999})
+	mockApp := &providers.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodClientSecretJWT,
+		GrantTypes:              []providers.GrantType{providers.GrantTypeClientCredentials},
+		ClientSecret:            "test-secret",
+	}
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+		Return(mockApp, nil).Once()
+	suite.mockJwtService.EXPECT().
+		VerifyJWTWithSecret(mock.Anything, assertion, mock.Anything, testIssuer, testClientID).
+		Return(nil).Once()
+
+	replayStore := jtimock.NewJTIStoreInterfaceMock(suite.T())
+	replayStore.EXPECT().
+		RecordJTI(mock.Anything, jtiNamespace, "test-jti", mock.Anything).
+		Return(false, nil).Once()
+
+	formData := url.Values{}
+	formData.Set("client_assertion_type", constants.SupportedClientAssertionType)
+	formData.Set("client_assertion", assertion)
+	req, _ := http.NewRequest("POST", "/test", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_ = req.ParseForm()
+
+	clientInfo, authErr := authenticate(req.Context(), req,
+		suite.actorProvider(), suite.mockAuthnProvider, suite.mockJwtService, replayStore, testIssuer, testLeeway)
+
+	assert.Equal(suite.T(), errInvalidClientAssertion, authErr)
+	assert.Nil(suite.T(), clientInfo)
+}
+
+func (suite *ClientAuthTestSuite) authenticateAssertion(assertion string) (*OAuthClientInfo, *authError) {
+	formData := url.Values{}
+	formData.Set("client_assertion_type", constants.SupportedClientAssertionType)
+	formData.Set("client_assertion", assertion)
+
+	req, _ := http.NewRequest("POST", "/test", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_ = req.ParseForm()
+
+	return authenticate(req.Context(), req,
+		suite.actorProvider(), suite.mockAuthnProvider, suite.mockJwtService, suite.mockJtiStore,
+		testIssuer, testLeeway)
+}
+
+func (suite *ClientAuthTestSuite) TestAuthenticate_FAPI2_ClientSecretRejected() {
+	mockApp := &providers.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodClientSecretPost,
+		SecurityProfile:         providers.SecurityProfileFAPI2,
+	}
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+		Return(mockApp, nil).Once()
+
+	formData := url.Values{}
+	formData.Set("client_id", testClientID)
+	formData.Set("client_secret", testClientSecret)
+	req, _ := http.NewRequest("POST", "/test", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_ = req.ParseForm()
+
+	clientInfo, authErr := authenticate(req.Context(), req,
+		suite.actorProvider(), suite.mockAuthnProvider, suite.mockJwtService, suite.mockJtiStore,
+		testIssuer, testLeeway)
+
+	assert.Nil(suite.T(), clientInfo)
+	assert.Equal(suite.T(), errFAPI2AuthMethodNotAllowed, authErr)
+}
+
+func (suite *ClientAuthTestSuite) TestAuthenticate_FAPI2_PrivateKeyJWT() {
+	mockApp := &providers.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodPrivateKeyJWT,
+		Certificate:             &inboundmodel.Certificate{Value: buildTestRSAJWKS("test-kid")},
+		SecurityProfile:         providers.SecurityProfileFAPI2,
+	}
+	assertion := buildTestJWT(
+		map[string]any{"alg": "PS256", "kid": "test-kid", "typ": "JWT"},
+		map[string]any{"sub": testClientID, "aud": testIssuer, "jti": "test-jti", "exp": 9999999999},
+	)
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+		Return(mockApp, nil).Once()
+	suite.mockJwtService.EXPECT().
+		VerifyJWTWithPublicKey(mock.Anything, assertion, mock.Anything, testIssuer, testClientID).
+		Return(nil)
+
+	clientInfo, authErr := suite.authenticateAssertion(assertion)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), clientInfo)
+}
+
+func (suite *ClientAuthTestSuite) TestAuthenticate_FAPI2_PrivateKeyJWT_DisallowedAlg() {
+	mockApp := &providers.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodPrivateKeyJWT,
+		Certificate:             &inboundmodel.Certificate{Value: buildTestRSAJWKS("test-kid")},
+		SecurityProfile:         providers.SecurityProfileFAPI2,
+	}
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+		Return(mockApp, nil).Once()
+
+	clientInfo, authErr := suite.authenticateAssertion(buildFakeJWTWithSub(testClientID))
+
+	assert.Nil(suite.T(), clientInfo)
+	assert.Equal(suite.T(), errFAPI2AssertionAlgNotAllowed, authErr)
+}
@@ -67,4 +67,19 @@ var (
 		"Client authentication is required",
 		http.StatusUnauthorized,
 	)
+	errInvalidClientCertificate = newAuthError(
+		constants.ErrorInvalidClient,
+		"Invalid client certificate",
+		http.StatusUnauthorized,
+	)
+	errFAPI2AuthMethodNotAllowed = newAuthError(
+		constants.ErrorInvalidClient,
+		"The FAPI 2.0 security profile requires private_key_jwt or mutual-TLS client authentication",
+		http.StatusUnauthorized,
+	)
+	errFAPI2AssertionAlgNotAllowed = newAuthError(
+		constants.ErrorInvalidClient,
+		"The client assertion must be signed with PS256, ES256 or EdDSA under the FAPI 2.0 security profile",
+		http.StatusUnauthorized,
+	)
 )
@@ -6,6 +6,7 @@ package constants
 
 import (
 	"errors"
+	"slices"
 
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
@@ -30,8 +31,10 @@ const (
 	RequestParamRefreshToken        string = "refresh_token"
 	RequestParamResponseType        string = "response_type"
 	RequestParamResponseMode        string = "response_mode"
+	RequestParamResponse            string = "response"
 	RequestParamState               string = "state"
 	RequestParamIss                 string = "iss"
+	RequestParamSessionState        string = "session_state"
 	RequestParamResource            string = "resource"
 	RequestParamError               string = "error"
 	RequestParamErrorDescription    string = "error_description"
@@ -50,6 +53,7 @@ const (
 	RequestParamNonce               string = "nonce"
 	RequestParamPrompt              string = "prompt"
 	RequestParamRequestURI          string = "request_uri"
+	RequestParamRequest             string = "request"
 	RequestParamAcrValues           string = "acr_values"
 	RequestParamMaxAge              string = "max_age"
 	RequestParamDPoPJkt             string = "dpop_jkt"
@@ -60,6 +64,11 @@ const (
 	RequestParamBindingMessage      string = "binding_message"
 	RequestParamRequestedExpiry     string = "requested_expiry"
 	RequestParamAuthReqID           string = "auth_req_id"
+	RequestParamDeviceCode          string = "device_code"
+	RequestParamUserCode            string = "user_code"
+
+	// RequestParamAuthorizationDetails carries the RFC 9396 rich authorization request.
+	RequestParamAuthorizationDetails string = "authorization_details"
 )
 
 // OAuth2 HTTP headers.
@@ -67,14 +76,33 @@ const (
 	HeaderDPoP string = "DPoP"
 )
 
-// OAuth2 response modes.
+// OAuth2 response modes (OAuth 2.0 Multiple Response Types, Form Post Response Mode and JWT Secured
+// Authorization Response Mode).
 const (
-	ResponseModeQuery string = "query"
+	ResponseModeQuery       string = "query"
+	ResponseModeFragment    string = "fragment"
+	ResponseModeFormPost    string = "form_post"
+	ResponseModeQueryJWT    string = "query.jwt"
+	ResponseModeFragmentJWT string = "fragment.jwt"
+	ResponseModeFormPostJWT string = "form_post.jwt"
+	ResponseModeJWT         string = "jwt"
 )
 
-// IsSupportedResponseMode checks if the response mode is supported.
+// SupportedResponseModes lists the response modes advertised in discovery metadata.
+var SupportedResponseModes = []string{
+	ResponseModeQuery,
+	ResponseModeFragment,
+	ResponseModeFormPost,
+	ResponseModeQueryJWT,
+	ResponseModeFragmentJWT,
+	ResponseModeFormPostJWT,
+	ResponseModeJWT,
+}
+
+// IsSupportedResponseMode checks if the response mode is supported. An empty response mode selects the
+// default mode of the response type.
 func IsSupportedResponseMode(responseMode string) bool {
-	return responseMode == "" || responseMode == ResponseModeQuery
+	return responseMode == "" || slices.Contains(SupportedResponseModes, responseMode)
 }
 
 // OIDC prompt parameter values.
@@ -122,10 +150,13 @@ const (
 	OAuth2UserInfoEndpoint                string = "/oauth2/userinfo"
 	OAuth2JWKSEndpoint                    string = "/oauth2/jwks"
 	OAuth2LogoutEndpoint                  string = "/oauth2/logout"
+	OAuth2CheckSessionEndpoint            string = "/oauth2/check-session"
 	OAuth2DCREndpoint                     string = "/oauth2/dcr/register"
 	OAuth2PAREndpoint                     string = "/oauth2/par"
 	OAuth2BackchannelAuthEndpoint         string = "/oauth2/bc-authorize"
 	OAuth2BackchannelAuthCallbackEndpoint string = "/oauth2/bc-authorize/callback"
+	OAuth2DeviceAuthorizationEndpoint     string = "/oauth2/device_authorization"
+	OAuth2DeviceVerificationEndpoint      string = "/oauth2/device"
 )
 
 // OAuth2 token types.
@@ -197,6 +228,11 @@ const (
 	ErrorExpiredToken             string = "expired_token" // #nosec G101
 	ErrorUnknownUserID            string = "unknown_user_id"
 	ErrorInvalidBindingMessage    string = "invalid_binding_message"
+	ErrorInvalidRequestURI        string = "invalid_request_uri"
+	ErrorInvalidRequestObject     string = "invalid_request_object"
+
+	// ErrorInvalidAuthorizationDetails is returned for malformed or unknown authorization_details (RFC 9396 §5).
+	ErrorInvalidAuthorizationDetails string = "invalid_authorization_details"
 )
 
 // UnSupportedGrantTypeError is returned when an unsupported grant type is requested.
@@ -279,6 +315,15 @@ const (
 	// family at once. Revocation-only and not a client-managed identifier: it rides the token JWTs
 	// but is not part of any client-facing API.
 	ClaimTokenFamilyID string = "tfid"
+	// ClaimAuthorizationDetails carries the authorization details granted to an access token (RFC 9396 §9.1).
+	ClaimAuthorizationDetails string = "authorization_details"
+	// ClaimSessionID is the OpenID Connect session identifier (sid). It is carried in ID tokens and
+	// back-channel logout tokens so a relying party can correlate a logout with its local session.
+	ClaimSessionID string = "sid"
+	// ClaimSessionBrowserState carries the SSO session's OIDC Session Management browser state from the
+	// flow assertion to the authorization endpoint, which derives session_state from it. It never
+	// appears in a token.
+	ClaimSessionBrowserState string = "session_browser_state"
 )
 
 // SurfaceableClientSystemClaims is the fixed set of entity system-attribute keys that may be
@@ -290,7 +335,8 @@ var SurfaceableClientSystemClaims = map[string]bool{
 
 // OIDC subject types.
 const (
-	SubjectTypePublic string = "public"
+	SubjectTypePublic   string = "public"
+	SubjectTypePairwise string = "pairwise"
 )
 
 // Token-exchange token family modes (oauth.token_exchange.token_family).
@@ -336,6 +382,16 @@ const (
 	CIBAMaxExpiresInSeconds = 600
 )
 
+const (
+	// DeviceCodeDefaultExpiresInSeconds is the default lifetime in seconds of a device authorization request.
+	DeviceCodeDefaultExpiresInSeconds = 600
+	// DeviceCodeDefaultIntervalSeconds is the default minimum interval in seconds between device code polls.
+	DeviceCodeDefaultIntervalSeconds = 5
+	// DeviceCodeSlowDownIncrementSeconds is the amount by which the polling interval grows after each
+	// slow_down response, as required by RFC 8628 section 3.5.
+	DeviceCodeSlowDownIncrementSeconds = 5
+)
+
 const (
 	// SupportedAuthorizationGrantProfileIDJAG is the constant for supported authorization grant profile ID-JAG.
 	SupportedAuthorizationGrantProfileIDJAG = "urn:ietf:params:oauth:grant-profile:id-jag"
@@ -368,21 +424,30 @@ func GetSupportedGrantTypes(oauthConfig oauthconfig.Config) []string {
 }
 
 // GetSupportedTokenEndpointAuthMethods returns all supported token endpoint authentication methods.
+// The mutual-TLS methods are only advertised when oauth.mtls is enabled.
 func GetSupportedTokenEndpointAuthMethods(oauthConfig oauthconfig.Config) []string {
-	allowedAuthMethods := oauthConfig.OAuth.AllowedAuthMethods
-	if len(allowedAuthMethods) > 0 {
-		return allowedAuthMethods
+	methods := oauthConfig.OAuth.AllowedAuthMethods
+	if len(methods) == 0 {
+		methods = make([]string, len(providers.SupportedTokenEndpointAuthMethods))
+		for i, tam := range providers.SupportedTokenEndpointAuthMethods {
+			methods[i] = string(tam)
+		}
 	}
-	result := make([]string, len(providers.SupportedTokenEndpointAuthMethods))
-	for i, tam := range providers.SupportedTokenEndpointAuthMethods {
-		result[i] = string(tam)
+	if oauthConfig.OAuth.MTLS.Enabled {
+		return methods
 	}
-	return result
+	return slices.DeleteFunc(slices.Clone(methods), func(method string) bool {
+		return method == string(providers.TokenEndpointAuthMethodTLSClientAuth) ||
+			method == string(providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth)
+	})
 }
 
+// ClientSecretJWTSigningAlgs lists the HMAC algorithms accepted for client_secret_jwt client assertions.
+var ClientSecretJWTSigningAlgs = []string{"HS256", "HS384", "HS512"}
+
 // GetSupportedSubjectTypes returns all supported OIDC subject types.
 func GetSupportedSubjectTypes() []string {
-	return []string{SubjectTypePublic}
+	return []string{SubjectTypePublic, SubjectTypePairwise}
 }
 
 // GetStandardClaims returns all standard JWT claims that are always included in tokens.
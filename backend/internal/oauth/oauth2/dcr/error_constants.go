@@ -84,6 +84,63 @@ var (
 		},
 	}
 
+	// ErrorClientIDMismatch is the error returned when an update request names a different client
+	ErrorClientIDMismatch = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "invalid_client_metadata",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.dcr.client_id_mismatch",
+			DefaultValue: "Client ID mismatch",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.dcr.client_id_mismatch_description",
+			DefaultValue: "The client_id in the request does not match the registered client",
+		},
+	}
+
+	// ErrorInvalidSoftwareStatement is the error returned when a software statement cannot be verified
+	ErrorInvalidSoftwareStatement = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "invalid_software_statement",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.dcr.invalid_software_statement",
+			DefaultValue: "Invalid software statement",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.dcr.invalid_software_statement_description",
+			DefaultValue: "The software statement is invalid or its signature could not be verified",
+		},
+	}
+
+	// ErrorSoftwareStatementRequired is the error returned when a required software statement is missing
+	ErrorSoftwareStatementRequired = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "invalid_software_statement",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.dcr.software_statement_required",
+			DefaultValue: "Software statement required",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.dcr.software_statement_required_description",
+			DefaultValue: "A software statement is required to register a client",
+		},
+	}
+
+	// ErrorUnapprovedSoftwareStatement is the error returned when a software statement is not issued
+	// by a configured trust anchor
+	ErrorUnapprovedSoftwareStatement = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "unapproved_software_statement",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.dcr.unapproved_software_statement",
+			DefaultValue: "Unapproved software statement",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.dcr.unapproved_software_statement_description",
+			DefaultValue: "The software statement is not issued by a trusted issuer",
+		},
+	}
+
 	// ErrorServerError is the standard error for server issues
 	ErrorServerError = tidcommon.ServiceError{
 		Type: tidcommon.ServerErrorType,
@@ -112,4 +169,19 @@ var (
 			DefaultValue: "Authentication with sufficient permissions is required to register a client",
 		},
 	}
+
+	// ErrorInvalidToken is the error returned when a client configuration request does not carry a
+	// valid registration access token for the client, or the client no longer exists (RFC 7592 §2).
+	ErrorInvalidToken = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "invalid_token",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.dcr.invalid_token",
+			DefaultValue: "Invalid registration access token",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.dcr.invalid_token_description",
+			DefaultValue: "The registration access token is missing, invalid or expired",
+		},
+	}
 )
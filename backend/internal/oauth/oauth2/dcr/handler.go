@@ -5,11 +5,14 @@ package dcr
 
 import (
 	"context"
+	"crypto/subtle"
+	"fmt"
 	"net/http"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
@@ -17,23 +20,24 @@ import (
 
 // dcrHandler defines the handler for DCR API requests.
 type dcrHandler struct {
-	dcrInsecure bool
-	dcrService  DCRServiceInterface
+	dcrInsecure         bool
+	initialAccessTokens []string
+	dcrService          DCRServiceInterface
 }
 
 // newDCRHandler creates a new instance of dcrHandler.
 func newDCRHandler(dcrService DCRServiceInterface, cfg oauthconfig.Config) *dcrHandler {
 	return &dcrHandler{
-		dcrInsecure: cfg.OAuth.DCR.Insecure,
-		dcrService:  dcrService,
+		dcrInsecure:         cfg.OAuth.DCR.Insecure,
+		initialAccessTokens: cfg.OAuth.DCR.InitialAccessTokens,
+		dcrService:          dcrService,
 	}
 }
 
 // HandleDCRRegistration handles the DCR client registration request.
 func (dh *dcrHandler) HandleDCRRegistration(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	// When DCR is not insecure, require a valid token with required permissions.
-	if !dh.dcrInsecure && !dh.checkDCRAuthorization(r, w) {
+	if !dh.checkDCRAuthorization(r, w) {
 		return
 	}
 
@@ -58,13 +62,66 @@ func (dh *dcrHandler) HandleDCRRegistration(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	writeNoStoreHeaders(w)
 	sysutils.WriteSuccessResponse(ctx, w, http.StatusCreated, dcrResponse)
 }
 
-// checkDCRAuthorization verifies that the caller holds required permission.
-// Returns true if authorized, false (and writes an HTTP 401) otherwise.
+// HandleDCRClientRead handles a client read request on the client configuration endpoint.
+func (dh *dcrHandler) HandleDCRClientRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	dcrResponse, svcErr := dh.dcrService.GetClient(ctx, r.PathValue("clientId"), registrationToken(r))
+	if svcErr != nil {
+		dh.writeServiceErrorResponse(ctx, w, svcErr)
+		return
+	}
+
+	writeNoStoreHeaders(w)
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, dcrResponse)
+}
+
+// HandleDCRClientUpdate handles a client update request on the client configuration endpoint.
+func (dh *dcrHandler) HandleDCRClientUpdate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	clientID := r.PathValue("clientId")
+	token := registrationToken(r)
+
+	dcrRequest, err := sysutils.DecodeJSONBody[DCRRegistrationRequest](r)
+	if err != nil {
+		sysutils.WriteJSONError(ctx, w, ErrorInvalidRequestFormat.Code,
+			ErrorInvalidRequestFormat.ErrorDescription.DefaultValue, http.StatusBadRequest, nil)
+		return
+	}
+
+	dcrResponse, svcErr := dh.dcrService.UpdateClient(ctx, clientID, token, dcrRequest)
+	if svcErr != nil {
+		dh.writeServiceErrorResponse(ctx, w, svcErr)
+		return
+	}
+
+	writeNoStoreHeaders(w)
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, dcrResponse)
+}
+
+// HandleDCRClientDelete handles a client delete request on the client configuration endpoint.
+func (dh *dcrHandler) HandleDCRClientDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if svcErr := dh.dcrService.DeleteClient(ctx, r.PathValue("clientId"), registrationToken(r)); svcErr != nil {
+		dh.writeServiceErrorResponse(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+}
+
+// checkDCRAuthorization verifies that the caller may register a client. Secure registration requires
+// the system permission; open registration requires one of the configured initial access tokens when
+// any are configured. Returns true if authorized, false (and writes an HTTP 401) otherwise.
 func (dh *dcrHandler) checkDCRAuthorization(r *http.Request, w http.ResponseWriter) bool {
-	if security.HasSystemPermission(security.GetPermissions(r.Context())) {
+	if dh.dcrInsecure {
+		if len(dh.initialAccessTokens) == 0 || dh.hasInitialAccessToken(r) {
+			return true
+		}
+	} else if security.HasSystemPermission(security.GetPermissions(r.Context())) {
 		return true
 	}
 	sysutils.WriteJSONError(r.Context(), w, ErrorUnauthorized.Code,
@@ -72,6 +129,37 @@ func (dh *dcrHandler) checkDCRAuthorization(r *http.Request, w http.ResponseWrit
 	return false
 }
 
+// hasInitialAccessToken reports whether the request presents one of the configured initial access
+// tokens as its bearer token.
+func (dh *dcrHandler) hasInitialAccessToken(r *http.Request) bool {
+	token := registrationToken(r)
+	if token == "" {
+		return false
+	}
+	for _, initialAccessToken := range dh.initialAccessTokens {
+		if initialAccessToken != "" &&
+			subtle.ConstantTimeCompare([]byte(token), []byte(initialAccessToken)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// registrationToken returns the bearer token of the request, or an empty string when absent.
+func registrationToken(r *http.Request) string {
+	token, err := sysutils.ExtractBearerToken(r.Header.Get(serverconst.AuthorizationHeaderName))
+	if err != nil {
+		return ""
+	}
+	return token
+}
+
+// writeNoStoreHeaders marks a response carrying client credentials as non-cacheable.
+func writeNoStoreHeaders(w http.ResponseWriter) {
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
+	w.Header().Set(serverconst.PragmaHeaderName, serverconst.PragmaNoCache)
+}
+
 // writeServiceErrorResponse writes a service error response.
 func (
 	dh *dcrHandler) writeServiceErrorResponse(ctx context.Context,
@@ -79,6 +167,14 @@ func (
 	svcErr *tidcommon.ServiceError) {
 	var statusCode int
 
+	// A missing, invalid or expired registration access token is a bearer token error (RFC 6750 §3).
+	if svcErr.Code == ErrorInvalidToken.Code {
+		wwwAuth := fmt.Sprintf("Bearer error=%q", svcErr.Code)
+		sysutils.WriteJSONError(ctx, w, svcErr.Code, svcErr.ErrorDescription.DefaultValue,
+			http.StatusUnauthorized, []map[string]string{{serverconst.WWWAuthenticateHeaderName: wwwAuth}})
+		return
+	}
+
 	switch svcErr.Type {
 	case tidcommon.ClientErrorType:
 		statusCode = http.StatusBadRequest
@@ -367,3 +367,124 @@ func TestHandleDCRRegistration_ClosedDCR_WithSystemPermission(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, rr.Code)
 	mockService.AssertExpectations(t)
 }
+
+// TestHandleDCRRegistration_InitialAccessToken tests that open registration requires one of the
+// configured initial access tokens when any are configured.
+func TestHandleDCRRegistration_InitialAccessToken(t *testing.T) {
+	cfg := testhelpers.OAuthConfig()
+	cfg.OAuth.DCR.Insecure = true
+	cfg.OAuth.DCR.InitialAccessTokens = []string{"iat-1"}
+
+	testCases := []struct {
+		name          string
+		authorization string
+		expectedCode  int
+	}{
+		{name: "missing token", authorization: "", expectedCode: http.StatusUnauthorized},
+		{name: "unknown token", authorization: "Bearer iat-2", expectedCode: http.StatusUnauthorized},
+		{name: "valid token", authorization: "Bearer iat-1", expectedCode: http.StatusCreated},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewDCRServiceInterfaceMock(t)
+			if tc.expectedCode == http.StatusCreated {
+				mockService.On("RegisterClient", mock.Anything, mock.Anything).
+					Return(&DCRRegistrationResponse{ClientID: "new-client"}, (*tidcommon.ServiceError)(nil))
+			}
+			handler := newDCRHandler(mockService, cfg)
+
+			req := httptest.NewRequest(http.MethodPost, "/oauth2/dcr/register", bytes.NewReader([]byte(`{}`)))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.HandleDCRRegistration(rr, req)
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+		})
+	}
+}
+
+// TestHandleDCRClientRead_InvalidToken tests that an invalid registration access token is
+// rejected with a bearer challenge.
+func (s *DCRHandlerTestSuite) TestHandleDCRClientRead_InvalidToken() {
+	s.mockService.On("GetClient", mock.Anything, "client-id", "bad-token").Return(nil, &ErrorInvalidToken)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/dcr/register/client-id", nil)
+	req.SetPathValue("clientId", "client-id")
+	req.Header.Set("Authorization", "Bearer bad-token")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientRead(rr, req)
+
+	s.Equal(http.StatusUnauthorized, rr.Code)
+	s.Contains(rr.Header().Get("WWW-Authenticate"), `error="invalid_token"`)
+}
+
+// TestHandleDCRClientRead_Success tests reading a registered client.
+func (s *DCRHandlerTestSuite) TestHandleDCRClientRead_Success() {
+	response := &DCRRegistrationResponse{ClientID: "client-id", RegistrationAccessToken: "token"}
+	s.mockService.On("GetClient", mock.Anything, "client-id", "token").
+		Return(response, (*tidcommon.ServiceError)(nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/dcr/register/client-id", nil)
+	req.SetPathValue("clientId", "client-id")
+	req.Header.Set("Authorization", "Bearer token")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientRead(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("no-store", rr.Header().Get("Cache-Control"))
+	var body DCRRegistrationResponse
+	s.NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("client-id", body.ClientID)
+}
+
+// TestHandleDCRClientUpdate_Success tests updating a registered client.
+func (s *DCRHandlerTestSuite) TestHandleDCRClientUpdate_Success() {
+	request := &DCRRegistrationRequest{ClientID: "client-id", ClientName: "Renamed Client"}
+	response := &DCRRegistrationResponse{ClientID: "client-id", ClientName: "Renamed Client"}
+	s.mockService.On("UpdateClient", mock.Anything, "client-id", "token", request).
+		Return(response, (*tidcommon.ServiceError)(nil))
+
+	requestJSON, _ := json.Marshal(request)
+	req := httptest.NewRequest(http.MethodPut, "/oauth2/dcr/register/client-id", bytes.NewReader(requestJSON))
+	req.SetPathValue("clientId", "client-id")
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientUpdate(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+// TestHandleDCRClientUpdate_InvalidRequestFormat tests handling of invalid JSON on update.
+func (s *DCRHandlerTestSuite) TestHandleDCRClientUpdate_InvalidRequestFormat() {
+	req := httptest.NewRequest(http.MethodPut, "/oauth2/dcr/register/client-id", bytes.NewReader([]byte(`{`)))
+	req.SetPathValue("clientId", "client-id")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientUpdate(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+// TestHandleDCRClientDelete_Success tests deleting a registered client.
+func (s *DCRHandlerTestSuite) TestHandleDCRClientDelete_Success() {
+	s.mockService.On("DeleteClient", mock.Anything, "client-id", "token").Return((*tidcommon.ServiceError)(nil))
+
+	req := httptest.NewRequest(http.MethodDelete, "/oauth2/dcr/register/client-id", nil)
+	req.SetPathValue("clientId", "client-id")
+	req.Header.Set("Authorization", "Bearer token")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientDelete(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+	s.Empty(rr.Body.Bytes())
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 )
@@ -24,6 +25,7 @@ func Initialize(
 	appService application.ApplicationServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
 	i18nService i18nmgt.I18nServiceInterface,
+	jwtService jwt.JWTServiceInterface,
 	cfg oauthconfig.Config,
 ) error {
 	// Fetch runtime transient transactioner for OAuth services.
@@ -35,7 +37,7 @@ func Initialize(
 			"Failed to initialize DCR service", log.Error(wrappedErr))
 		return wrappedErr
 	}
-	dcrService := newDCRService(appService, ouService, i18nService, transactioner)
+	dcrService := newDCRService(appService, ouService, i18nService, jwtService, transactioner, cfg)
 	dcrHandler := newDCRHandler(dcrService, cfg)
 	registerRoutes(mux, dcrHandler)
 	return nil
@@ -55,4 +57,22 @@ func registerRoutes(mux *http.ServeMux, dcrHandler *dcrHandler) {
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts))
+
+	// Client configuration endpoint (RFC 7592).
+	clientOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE", "OPTIONS"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /oauth2/dcr/register/{clientId}",
+		dcrHandler.HandleDCRClientRead, clientOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT /oauth2/dcr/register/{clientId}",
+		dcrHandler.HandleDCRClientUpdate, clientOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE /oauth2/dcr/register/{clientId}",
+		dcrHandler.HandleDCRClientDelete, clientOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /oauth2/dcr/register/{clientId}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, clientOpts))
 }
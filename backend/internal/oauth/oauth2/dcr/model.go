@@ -15,13 +15,32 @@ import (
 const (
 	ClientSecretExpiresAtNever   = 0 // Never expires
 	maxLocalizedVariantsPerField = 20
+	// defaultRegistrationTokenValidity is the registration access token lifetime in seconds used when
+	// none is configured.
+	defaultRegistrationTokenValidity int64 = 2592000
+	// registrationTokenType is the JWT "typ" header of registration access tokens, which keeps them
+	// from being accepted in place of any other server-issued JWT.
+	registrationTokenType = "registration+jwt"
+	// registrationPath is the path of the client registration endpoint.
+	registrationPath = "/oauth2/dcr/register"
 )
 
 // DCRRegistrationRequest represents the RFC 7591 Dynamic Client Registration request.
 type DCRRegistrationRequest struct {
+	// ClientID identifies the client being updated through the client configuration endpoint
+	// (RFC 7592 §2.2). It is ignored on registration.
+	ClientID string `json:"client_id,omitempty"`
+	// ClientSecret may be echoed by a client updating its registration (RFC 7592 §2.2). The stored
+	// secret is never changed through the client configuration endpoint, so the value is ignored.
+	ClientSecret string `json:"client_secret,omitempty"`
+	// SoftwareStatement is a signed JWT asserting client metadata (RFC 7591 §2.3).
+	SoftwareStatement string `json:"software_statement,omitempty"`
+
 	OUID                    string                            `json:"ou_id,omitempty"`
 	RedirectURIs            []string                          `json:"redirect_uris"`
 	PostLogoutRedirectURIs  []string                          `json:"post_logout_redirect_uris,omitempty"`
+	BackchannelLogoutURI    string                            `json:"backchannel_logout_uri,omitempty"`
+	FrontchannelLogoutURI   string                            `json:"frontchannel_logout_uri,omitempty"`
 	GrantTypes              []providers.GrantType             `json:"grant_types,omitempty"`
 	ResponseTypes           []providers.ResponseType          `json:"response_types,omitempty"`
 	ClientName              string                            `json:"client_name,omitempty"`
@@ -34,14 +53,28 @@ type DCRRegistrationRequest struct {
 	Contacts                []string                          `json:"contacts,omitempty"`
 	TosURI                  string                            `json:"tos_uri,omitempty"`
 	PolicyURI               string                            `json:"policy_uri,omitempty"`
+	SubjectType             providers.SubjectType             `json:"subject_type,omitempty"`
+	SectorIdentifierURI     string                            `json:"sector_identifier_uri,omitempty"`
 
 	RequirePushedAuthorizationRequests bool   `json:"require_pushed_authorization_requests,omitempty"`
+	RequireSignedRequestObject         bool   `json:"require_signed_request_object,omitempty"`
 	DPoPBoundAccessTokens              bool   `json:"dpop_bound_access_tokens,omitempty"`
+	MTLSBoundAccessTokens              bool   `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+	TLSClientAuthSubjectDN             string `json:"tls_client_auth_subject_dn,omitempty"`
+	TLSClientAuthSANDNS                string `json:"tls_client_auth_san_dns,omitempty"`
+	TLSClientAuthSANURI                string `json:"tls_client_auth_san_uri,omitempty"`
+	TLSClientAuthSANIP                 string `json:"tls_client_auth_san_ip,omitempty"`
+	TLSClientAuthSANEmail              string `json:"tls_client_auth_san_email,omitempty"`
+	BackchannelLogoutSessionRequired   bool   `json:"backchannel_logout_session_required,omitempty"`
+	FrontchannelLogoutSessionRequired  bool   `json:"frontchannel_logout_session_required,omitempty"`
 	UserInfoSignedResponseAlg          string `json:"userinfo_signed_response_alg,omitempty"`
 	UserInfoEncryptedResponseAlg       string `json:"userinfo_encrypted_response_alg,omitempty"`
 	UserInfoEncryptedResponseEnc       string `json:"userinfo_encrypted_response_enc,omitempty"`
 	IDTokenEncryptedResponseAlg        string `json:"id_token_encrypted_response_alg,omitempty"`
 	IDTokenEncryptedResponseEnc        string `json:"id_token_encrypted_response_enc,omitempty"`
+	AuthorizationSignedResponseAlg     string `json:"authorization_signed_response_alg,omitempty"`
+	AuthorizationEncryptedResponseAlg  string `json:"authorization_encrypted_response_alg,omitempty"`
+	AuthorizationEncryptedResponseEnc  string `json:"authorization_encrypted_response_enc,omitempty"`
 	// Localized variant maps — populated from #-keyed JSON fields (e.g. "client_name#fr").
 	LocalizedClientName map[string]string `json:"-"`
 	LocalizedLogoURI    map[string]string `json:"-"`
@@ -119,6 +152,8 @@ type DCRRegistrationResponse struct {
 	ClientSecretExpiresAt   int64                             `json:"client_secret_expires_at"`
 	RedirectURIs            []string                          `json:"redirect_uris,omitempty"`
 	PostLogoutRedirectURIs  []string                          `json:"post_logout_redirect_uris,omitempty"`
+	BackchannelLogoutURI    string                            `json:"backchannel_logout_uri,omitempty"`
+	FrontchannelLogoutURI   string                            `json:"frontchannel_logout_uri,omitempty"`
 	GrantTypes              []providers.GrantType             `json:"grant_types,omitempty"`
 	ResponseTypes           []providers.ResponseType          `json:"response_types,omitempty"`
 	ClientName              string                            `json:"client_name,omitempty"`
@@ -131,15 +166,32 @@ type DCRRegistrationResponse struct {
 	Contacts                []string                          `json:"contacts,omitempty"`
 	TosURI                  string                            `json:"tos_uri,omitempty"`
 	PolicyURI               string                            `json:"policy_uri,omitempty"`
+	SubjectType             providers.SubjectType             `json:"subject_type,omitempty"`
+	SectorIdentifierURI     string                            `json:"sector_identifier_uri,omitempty"`
 	AppID                   string                            `json:"app_id,omitempty"`
+	SoftwareStatement       string                            `json:"software_statement,omitempty"`
+	RegistrationAccessToken string                            `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string                            `json:"registration_client_uri,omitempty"`
 
 	RequirePushedAuthorizationRequests bool   `json:"require_pushed_authorization_requests,omitempty"`
+	RequireSignedRequestObject         bool   `json:"require_signed_request_object,omitempty"`
 	DPoPBoundAccessTokens              bool   `json:"dpop_bound_access_tokens,omitempty"`
+	MTLSBoundAccessTokens              bool   `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+	TLSClientAuthSubjectDN             string `json:"tls_client_auth_subject_dn,omitempty"`
+	TLSClientAuthSANDNS                string `json:"tls_client_auth_san_dns,omitempty"`
+	TLSClientAuthSANURI                string `json:"tls_client_auth_san_uri,omitempty"`
+	TLSClientAuthSANIP                 string `json:"tls_client_auth_san_ip,omitempty"`
+	TLSClientAuthSANEmail              string `json:"tls_client_auth_san_email,omitempty"`
+	BackchannelLogoutSessionRequired   bool   `json:"backchannel_logout_session_required,omitempty"`
+	FrontchannelLogoutSessionRequired  bool   `json:"frontchannel_logout_session_required,omitempty"`
 	UserInfoSignedResponseAlg          string `json:"userinfo_signed_response_alg,omitempty"`
 	UserInfoEncryptedResponseAlg       string `json:"userinfo_encrypted_response_alg,omitempty"`
 	UserInfoEncryptedResponseEnc       string `json:"userinfo_encrypted_response_enc,omitempty"`
 	IDTokenEncryptedResponseAlg        string `json:"id_token_encrypted_response_alg,omitempty"`
 	IDTokenEncryptedResponseEnc        string `json:"id_token_encrypted_response_enc,omitempty"`
+	AuthorizationSignedResponseAlg     string `json:"authorization_signed_response_alg,omitempty"`
+	AuthorizationEncryptedResponseAlg  string `json:"authorization_encrypted_response_alg,omitempty"`
+	AuthorizationEncryptedResponseEnc  string `json:"authorization_encrypted_response_enc,omitempty"`
 	// Localized variant maps — injected as #-keyed top-level fields during serialization.
 	LocalizedClientName map[string]string `json:"-"`
 	LocalizedLogoURI    map[string]string `json:"-"`
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net/url"
 	"strings"
 	"time"
 
@@ -17,9 +18,12 @@ import (
 	"github.com/thunder-id/thunderid/internal/application/model"
 	"github.com/thunder-id/thunderid/internal/cert"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	oauthutils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -29,6 +33,13 @@ type DCRServiceInterface interface {
 	RegisterClient(
 		ctx context.Context, request *DCRRegistrationRequest,
 	) (*DCRRegistrationResponse, *tidcommon.ServiceError)
+	GetClient(
+		ctx context.Context, clientID, registrationToken string,
+	) (*DCRRegistrationResponse, *tidcommon.ServiceError)
+	UpdateClient(
+		ctx context.Context, clientID, registrationToken string, request *DCRRegistrationRequest,
+	) (*DCRRegistrationResponse, *tidcommon.ServiceError)
+	DeleteClient(ctx context.Context, clientID, registrationToken string) *tidcommon.ServiceError
 }
 
 // dcrService is the default implementation of DCRServiceInterface.
@@ -36,7 +47,9 @@ type dcrService struct {
 	appService    application.ApplicationServiceInterface
 	ouService     ou.OrganizationUnitServiceInterface
 	i18nService   i18nmgt.I18nServiceInterface
+	jwtService    jwt.JWTServiceInterface
 	transactioner providers.Transactioner
+	cfg           oauthconfig.Config
 }
 
 // newDCRService creates a new instance of dcrService.
@@ -44,13 +57,17 @@ func newDCRService(
 	appService application.ApplicationServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
 	i18nService i18nmgt.I18nServiceInterface,
+	jwtService jwt.JWTServiceInterface,
 	transactioner providers.Transactioner,
+	cfg oauthconfig.Config,
 ) DCRServiceInterface {
 	return &dcrService{
 		appService:    appService,
 		ouService:     ouService,
 		i18nService:   i18nService,
+		jwtService:    jwtService,
 		transactioner: transactioner,
+		cfg:           cfg,
 	}
 }
 
@@ -62,15 +79,11 @@ func (ds *dcrService) RegisterClient(ctx context.Context, request *DCRRegistrati
 	if request == nil {
 		return nil, &ErrorInvalidRequestFormat
 	}
-
-	if request.JWKSUri != "" && len(request.JWKS) > 0 {
-		return nil, &ErrorJWKSConfigurationConflict
+	if svcErr := ds.applySoftwareStatement(ctx, request); svcErr != nil {
+		return nil, svcErr
 	}
-	if request.JWKSUri != "" {
-		parsedJWKSURI, err := sysutils.ParseURL(request.JWKSUri)
-		if err != nil || parsedJWKSURI.Scheme != "https" || parsedJWKSURI.Host == "" {
-			return nil, &ErrorInvalidClientMetadata
-		}
+	if svcErr := validateKeyMetadata(request); svcErr != nil {
+		return nil, svcErr
 	}
 
 	// TODO: Revisit OU for DCR apps
@@ -95,6 +108,13 @@ func (ds *dcrService) RegisterClient(ctx context.Context, request *DCRRegistrati
 		return nil, &ErrorServerError
 	}
 
+	oauthConfig := appDTO.InboundAuthConfig[0].OAuthConfig
+	registrationToken, svcErr := ds.issueRegistrationToken(ctx, oauthConfig.ClientID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	oauthConfig.RegistrationTokenHash = cryptolib.HashToken(registrationToken)
+
 	var response *DCRRegistrationResponse
 	var capturedErr *tidcommon.ServiceError
 	var createdAppID string
@@ -167,12 +187,338 @@ func (ds *dcrService) RegisterClient(ctx context.Context, request *DCRRegistrati
 	response.LocalizedLogoURI = request.LocalizedLogoURI
 	response.LocalizedTosURI = request.LocalizedTosURI
 	response.LocalizedPolicyURI = request.LocalizedPolicyURI
+	response.SoftwareStatement = request.SoftwareStatement
+	response.RegistrationAccessToken = registrationToken
+	response.RegistrationClientURI = ds.registrationClientURI(response.ClientID)
+
+	return response, nil
+}
+
+// GetClient returns the current registration of a client through the client configuration endpoint
+// (RFC 7592 §2.1). The registration access token presented by the client is returned unchanged.
+func (ds *dcrService) GetClient(ctx context.Context, clientID, registrationToken string) (
+	*DCRRegistrationResponse, *tidcommon.ServiceError) {
+	oauthClient, svcErr := ds.verifyRegistrationToken(ctx, clientID, registrationToken)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	app, svcErr := ds.loadRegisteredApplication(ctx, oauthClient)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	appDTO := registeredApplicationDTO(app)
+	response, svcErr := ds.convertApplicationToDCRResponse(appDTO, appDTO.Name)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	response.RegistrationAccessToken = registrationToken
+	response.RegistrationClientURI = ds.registrationClientURI(clientID)
+	return response, nil
+}
+
+// UpdateClient replaces the registered metadata of a client through the client configuration
+// endpoint (RFC 7592 §2.2). Metadata omitted from the request is reset, the client secret is kept,
+// and a fresh registration access token is issued. The new token's hash replaces the stored one in
+// the same update, which revokes the token presented with the request.
+func (ds *dcrService) UpdateClient(
+	ctx context.Context, clientID, registrationToken string, request *DCRRegistrationRequest,
+) (*DCRRegistrationResponse, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService"))
+
+	oauthClient, svcErr := ds.verifyRegistrationToken(ctx, clientID, registrationToken)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if request == nil {
+		return nil, &ErrorInvalidRequestFormat
+	}
+	if request.ClientID != clientID {
+		return nil, &ErrorClientIDMismatch
+	}
+	existing, svcErr := ds.loadRegisteredApplication(ctx, oauthClient)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := ds.applySoftwareStatement(ctx, request); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := validateKeyMetadata(request); svcErr != nil {
+		return nil, svcErr
+	}
+
+	request.OUID = existing.OUID
+	appDTO, svcErr := buildApplicationDTO(request, existing.ID, clientID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	// Settings outside the scope of client metadata are not managed through registration.
+	appDTO.Description = existing.Description
+	appDTO.Template = existing.Template
+	appDTO.InboundAuthProfile = existing.InboundAuthProfile
+	appDTO.Metadata = existing.Metadata
+
+	newToken, svcErr := ds.issueRegistrationToken(ctx, clientID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	appDTO.InboundAuthConfig[0].OAuthConfig.RegistrationTokenHash = cryptolib.HashToken(newToken)
+
+	var response *DCRRegistrationResponse
+	var capturedErr *tidcommon.ServiceError
+	err := ds.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		updatedApp, svcErr := ds.appService.UpdateApplication(txCtx, existing.ID, appDTO)
+		if svcErr != nil {
+			if svcErr.Type == tidcommon.ServerErrorType {
+				logger.Error(ctx, "Failed to update application via Application service",
+					log.String("error_code", svcErr.Code))
+				capturedErr = &ErrorServerError
+				return errors.New("failed to update application")
+			}
+			capturedErr = ds.mapApplicationErrorToDCRError(svcErr)
+			return errors.New("failed to update application")
+		}
+
+		var convErr *tidcommon.ServiceError
+		response, convErr = ds.convertApplicationToDCRResponse(updatedApp, request.ClientName)
+		if convErr != nil {
+			capturedErr = convErr
+			return errors.New("conversion failed")
+		}
+		return nil
+	})
+	if err != nil {
+		if capturedErr != nil {
+			return nil, capturedErr
+		}
+		return nil, &ErrorServerError
+	}
+
+	if svcErr := ds.writeLocalizedVariants(ctx, existing.ID, request); svcErr != nil {
+		return nil, svcErr
+	}
 
+	response.LocalizedClientName = request.LocalizedClientName
+	response.LocalizedLogoURI = request.LocalizedLogoURI
+	response.LocalizedTosURI = request.LocalizedTosURI
+	response.LocalizedPolicyURI = request.LocalizedPolicyURI
+	response.SoftwareStatement = request.SoftwareStatement
+	response.RegistrationAccessToken = newToken
+	response.RegistrationClientURI = ds.registrationClientURI(clientID)
 	return response, nil
 }
 
+// DeleteClient deregisters a client through the client configuration endpoint (RFC 7592 §2.3).
+// Deleting the client removes its stored token hash, so the token cannot be used again even if a
+// client is later registered under the same client ID.
+func (ds *dcrService) DeleteClient(ctx context.Context, clientID, registrationToken string) *tidcommon.ServiceError {
+	oauthClient, svcErr := ds.verifyRegistrationToken(ctx, clientID, registrationToken)
+	if svcErr != nil {
+		return svcErr
+	}
+	app, svcErr := ds.loadRegisteredApplication(ctx, oauthClient)
+	if svcErr != nil {
+		return svcErr
+	}
+	if svcErr := ds.appService.DeleteApplication(ctx, app.ID); svcErr != nil {
+		if svcErr.Type == tidcommon.ServerErrorType {
+			log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService")).Error(ctx,
+				"Failed to delete application via Application service", log.String("error_code", svcErr.Code))
+			return &ErrorServerError
+		}
+		return ds.mapApplicationErrorToDCRError(svcErr)
+	}
+	return nil
+}
+
+// verifyRegistrationToken checks that the registration access token was issued by this server for
+// the client's configuration endpoint and is the one last issued to the client, and returns the client.
+// A token replaced by a later one, or presented for a client that no longer exists, is rejected.
+func (ds *dcrService) verifyRegistrationToken(
+	ctx context.Context, clientID, registrationToken string) (*providers.OAuthClient, *tidcommon.ServiceError) {
+	if clientID == "" || registrationToken == "" {
+		return nil, &ErrorInvalidToken
+	}
+	header, err := jwt.DecodeJWTHeader(registrationToken)
+	if err != nil || header["typ"] != registrationTokenType {
+		return nil, &ErrorInvalidToken
+	}
+	if svcErr := ds.jwtService.VerifyJWT(
+		ctx, registrationToken, ds.registrationClientURI(clientID), ds.cfg.JWT.Issuer); svcErr != nil {
+		return nil, &ErrorInvalidToken
+	}
+	payload, err := jwt.DecodeJWTPayload(registrationToken)
+	if err != nil || payload["sub"] != clientID {
+		return nil, &ErrorInvalidToken
+	}
+
+	oauthClient, svcErr := ds.appService.GetOAuthApplication(ctx, clientID)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ServerErrorType {
+			log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService")).Error(ctx,
+				"Failed to load registered client", log.String("error_code", svcErr.Code))
+			return nil, &ErrorServerError
+		}
+		return nil, &ErrorInvalidToken
+	}
+	if oauthClient == nil || oauthClient.RegistrationTokenHash == "" ||
+		!cryptolib.ValidateTokenHash(registrationToken, oauthClient.RegistrationTokenHash) {
+		return nil, &ErrorInvalidToken
+	}
+	return oauthClient, nil
+}
+
+// issueRegistrationToken issues a registration access token scoped to the client's configuration
+// endpoint.
+func (ds *dcrService) issueRegistrationToken(ctx context.Context, clientID string) (
+	string, *tidcommon.ServiceError) {
+	validity := ds.cfg.OAuth.DCR.RegistrationTokenValidity
+	if validity <= 0 {
+		validity = defaultRegistrationTokenValidity
+	}
+	claims := map[string]interface{}{"aud": ds.registrationClientURI(clientID)}
+	token, _, svcErr := ds.jwtService.GenerateJWT(
+		ctx, clientID, ds.cfg.JWT.Issuer, validity, claims, registrationTokenType, "")
+	if svcErr != nil {
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService")).Error(ctx,
+			"Failed to issue registration access token", log.String("error_code", svcErr.Code))
+		return "", &ErrorServerError
+	}
+	return token, nil
+}
+
+// registrationClientURI returns the client configuration endpoint of a registered client.
+func (ds *dcrService) registrationClientURI(clientID string) string {
+	return strings.TrimSuffix(ds.cfg.BaseURL, "/") + registrationPath + "/" + url.PathEscape(clientID)
+}
+
+// loadRegisteredApplication returns the application behind a registered client. A client that no
+// longer exists is reported as an invalid token (RFC 7592 §2).
+func (ds *dcrService) loadRegisteredApplication(ctx context.Context, oauthClient *providers.OAuthClient) (
+	*providers.Application, *tidcommon.ServiceError) {
+	app, svcErr := ds.appService.GetApplication(ctx, oauthClient.ID)
+	if svcErr == nil && app != nil {
+		return app, nil
+	}
+	if svcErr != nil && svcErr.Type == tidcommon.ServerErrorType {
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService")).Error(ctx,
+			"Failed to load registered client", log.String("error_code", svcErr.Code))
+		return nil, &ErrorServerError
+	}
+	return nil, &ErrorInvalidToken
+}
+
+// registeredApplicationDTO converts a stored application into the DTO the DCR response is built
+// from. Display fields holding an i18n template reference are cleared, as they are not client
+// metadata values.
+func registeredApplicationDTO(app *providers.Application) *model.ApplicationDTO {
+	plain := func(field, value string) string {
+		if value == application.AppI18nRef(app.ID, field) {
+			return ""
+		}
+		return value
+	}
+	return &model.ApplicationDTO{
+		ID:                app.ID,
+		OUID:              app.OUID,
+		Name:              plain("name", app.Name),
+		URL:               app.URL,
+		LogoURL:           plain("logo_uri", app.LogoURL),
+		TosURI:            plain("tos_uri", app.TosURI),
+		PolicyURI:         plain("policy_uri", app.PolicyURI),
+		Contacts:          app.Contacts,
+		InboundAuthConfig: app.InboundAuthConfig,
+	}
+}
+
+// applySoftwareStatement verifies the software statement of a request against the configured trust
+// anchors and merges the metadata it asserts into the request. Asserted values take precedence over
+// the plain JSON values of the request (RFC 7591 §3.1.1).
+func (ds *dcrService) applySoftwareStatement(
+	ctx context.Context, request *DCRRegistrationRequest) *tidcommon.ServiceError {
+	statementCfg := ds.cfg.OAuth.DCR.SoftwareStatement
+	if request.SoftwareStatement == "" {
+		if statementCfg.Required {
+			return &ErrorSoftwareStatementRequired
+		}
+		return nil
+	}
+
+	claims, err := jwt.DecodeJWTPayload(request.SoftwareStatement)
+	if err != nil {
+		return &ErrorInvalidSoftwareStatement
+	}
+	issuer, _ := claims["iss"].(string)
+	var jwksURI string
+	for _, anchor := range statementCfg.TrustAnchors {
+		if issuer != "" && anchor.Issuer == issuer {
+			jwksURI = anchor.JWKSURI
+			break
+		}
+	}
+	if jwksURI == "" {
+		return &ErrorUnapprovedSoftwareStatement
+	}
+	if svcErr := ds.jwtService.VerifyJWTSignatureWithJWKS(ctx, request.SoftwareStatement, jwksURI); svcErr != nil {
+		return &ErrorInvalidSoftwareStatement
+	}
+	if exp, ok := claims["exp"].(float64); ok && time.Now().Unix() >= int64(exp)+ds.cfg.JWT.Leeway {
+		return &ErrorInvalidSoftwareStatement
+	}
+
+	// Drop the JWT claims and any values a statement must not assert before merging.
+	for _, claim := range []string{
+		"iss", "sub", "aud", "exp", "nbf", "iat", "jti",
+		"client_id", "client_secret", "software_statement", "ou_id",
+	} {
+		delete(claims, claim)
+	}
+	asserted, err := json.Marshal(claims)
+	if err != nil {
+		return &ErrorInvalidSoftwareStatement
+	}
+	if err := json.Unmarshal(asserted, request); err != nil {
+		return &ErrorInvalidSoftwareStatement
+	}
+	return nil
+}
+
+// validateKeyMetadata validates the jwks and jwks_uri client metadata of a request.
+func validateKeyMetadata(request *DCRRegistrationRequest) *tidcommon.ServiceError {
+	if request.JWKSUri != "" && len(request.JWKS) > 0 {
+		return &ErrorJWKSConfigurationConflict
+	}
+	if request.JWKSUri != "" {
+		parsedJWKSURI, err := sysutils.ParseURL(request.JWKSUri)
+		if err != nil || parsedJWKSURI.Scheme != "https" || parsedJWKSURI.Host == "" {
+			return &ErrorInvalidClientMetadata
+		}
+	}
+	return nil
+}
+
 // convertDCRToApplication converts DCR registration request to Application DTO.
 func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest) (
+	*model.ApplicationDTO, *tidcommon.ServiceError) {
+	// Pre-generate the application ID so we can build an i18n template reference if needed.
+	appID, uuidErr := sysutils.GenerateUUIDv7()
+	if uuidErr != nil {
+		return nil, &ErrorServerError
+	}
+
+	// Generate client ID during DCR conversion so certificate-backed clients can persist
+	// the OAuth-app certificate against the final client_id.
+	clientID, err := oauthutils.GenerateOAuth2ClientID()
+	if err != nil {
+		return nil, &ErrorServerError
+	}
+
+	return buildApplicationDTO(request, appID, clientID)
+}
+
+// buildApplicationDTO builds the Application DTO for a DCR request with the given application and
+// client identifiers.
+func buildApplicationDTO(request *DCRRegistrationRequest, appID, clientID string) (
 	*model.ApplicationDTO, *tidcommon.ServiceError) {
 	isPublicClient := request.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodNone
 
@@ -198,20 +544,8 @@ func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest) (
 		scopes = strings.Fields(request.Scope)
 	}
 
-	// Pre-generate the application ID so we can build an i18n template reference if needed.
-	appID, uuidErr := sysutils.GenerateUUIDv7()
-	if uuidErr != nil {
-		return nil, &ErrorServerError
-	}
-
-	// Generate client ID during DCR conversion so certificate-backed clients can persist
-	// the OAuth-app certificate against the final client_id.
 	// When localized variants are present without a client_name, use an i18n ref as the app name
 	// so the UI resolves the display name from the i18n table rather than falling back to the clientID.
-	clientID, err := oauthutils.GenerateOAuth2ClientID()
-	if err != nil {
-		return nil, &ErrorServerError
-	}
 	appName := request.ClientName
 	if appName == "" {
 		if len(request.LocalizedClientName) > 0 {
@@ -228,15 +562,25 @@ func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest) (
 		ClientID:                           clientID,
 		RedirectURIs:                       request.RedirectURIs,
 		PostLogoutRedirectURIs:             request.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               request.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   request.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              request.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  request.FrontchannelLogoutSessionRequired,
 		GrantTypes:                         request.GrantTypes,
 		ResponseTypes:                      request.ResponseTypes,
 		TokenEndpointAuthMethod:            request.TokenEndpointAuthMethod,
 		PublicClient:                       isPublicClient,
 		PKCERequired:                       isPublicClient,
 		RequirePushedAuthorizationRequests: request.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         request.RequireSignedRequestObject,
 		DPoPBoundAccessTokens:              request.DPoPBoundAccessTokens,
+		MTLSBoundAccessTokens:              request.MTLSBoundAccessTokens,
+		SubjectType:                        request.SubjectType,
+		SectorIdentifierURI:                request.SectorIdentifierURI,
 		Scopes:                             scopes,
 		UserInfo:                           buildUserInfoConfig(request),
+		AuthorizationResponse:              buildAuthorizationResponseConfig(request),
+		TLSClientAuth:                      buildTLSClientAuthConfig(request),
 		Token:                              buildTokenConfig(request),
 		Certificate:                        oauthCertificate,
 	}
@@ -266,6 +610,36 @@ func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest) (
 	return appDTO, nil
 }
 
+// buildAuthorizationResponseConfig maps the JARM alg fields from a DCR request to an
+// AuthorizationResponseConfig.
+func buildAuthorizationResponseConfig(request *DCRRegistrationRequest) *providers.AuthorizationResponseConfig {
+	if request.AuthorizationSignedResponseAlg == "" && request.AuthorizationEncryptedResponseAlg == "" &&
+		request.AuthorizationEncryptedResponseEnc == "" {
+		return nil
+	}
+	return &providers.AuthorizationResponseConfig{
+		SigningAlg:    request.AuthorizationSignedResponseAlg,
+		EncryptionAlg: request.AuthorizationEncryptedResponseAlg,
+		EncryptionEnc: request.AuthorizationEncryptedResponseEnc,
+	}
+}
+
+// buildTLSClientAuthConfig maps the tls_client_auth certificate subject fields from a DCR request to a
+// TLSClientAuthConfig.
+func buildTLSClientAuthConfig(request *DCRRegistrationRequest) *providers.TLSClientAuthConfig {
+	cfg := &providers.TLSClientAuthConfig{
+		SubjectDN: request.TLSClientAuthSubjectDN,
+		SANDNS:    request.TLSClientAuthSANDNS,
+		SANURI:    request.TLSClientAuthSANURI,
+		SANIP:     request.TLSClientAuthSANIP,
+		SANEmail:  request.TLSClientAuthSANEmail,
+	}
+	if *cfg == (providers.TLSClientAuthConfig{}) {
+		return nil
+	}
+	return cfg
+}
+
 // buildUserInfoConfig maps UserInfo alg fields from a DCR request to a UserInfoConfig.
 // ResponseType is derived from the algorithm fields per OIDC DCR conventions.
 func buildUserInfoConfig(request *DCRRegistrationRequest) *providers.UserInfoConfig {
@@ -351,6 +725,18 @@ func (ds *dcrService) convertApplicationToDCRResponse(appDTO *model.ApplicationD
 		userInfoEncryptedEnc = oauthConfig.UserInfo.EncryptionEnc
 	}
 
+	var authzSignedAlg, authzEncryptedAlg, authzEncryptedEnc string
+	if oauthConfig.AuthorizationResponse != nil {
+		authzSignedAlg = oauthConfig.AuthorizationResponse.SigningAlg
+		authzEncryptedAlg = oauthConfig.AuthorizationResponse.EncryptionAlg
+		authzEncryptedEnc = oauthConfig.AuthorizationResponse.EncryptionEnc
+	}
+
+	tlsClientAuth := oauthConfig.TLSClientAuth
+	if tlsClientAuth == nil {
+		tlsClientAuth = &providers.TLSClientAuthConfig{}
+	}
+
 	var idTokenEncryptedAlg, idTokenEncryptedEnc string
 	if oauthConfig.Token != nil && oauthConfig.Token.IDToken != nil {
 		idTokenEncryptedAlg = oauthConfig.Token.IDToken.EncryptionAlg
@@ -363,6 +749,10 @@ func (ds *dcrService) convertApplicationToDCRResponse(appDTO *model.ApplicationD
 		ClientSecretExpiresAt:              ClientSecretExpiresAtNever,
 		RedirectURIs:                       oauthConfig.RedirectURIs,
 		PostLogoutRedirectURIs:             oauthConfig.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               oauthConfig.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   oauthConfig.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              oauthConfig.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  oauthConfig.FrontchannelLogoutSessionRequired,
 		GrantTypes:                         oauthConfig.GrantTypes,
 		ResponseTypes:                      oauthConfig.ResponseTypes,
 		ClientName:                         clientName,
@@ -377,12 +767,24 @@ func (ds *dcrService) convertApplicationToDCRResponse(appDTO *model.ApplicationD
 		Contacts:                           appDTO.Contacts,
 		AppID:                              appDTO.ID,
 		RequirePushedAuthorizationRequests: oauthConfig.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         oauthConfig.RequireSignedRequestObject,
 		DPoPBoundAccessTokens:              oauthConfig.DPoPBoundAccessTokens,
+		MTLSBoundAccessTokens:              oauthConfig.MTLSBoundAccessTokens,
+		SubjectType:                        oauthConfig.SubjectType,
+		SectorIdentifierURI:                oauthConfig.SectorIdentifierURI,
+		TLSClientAuthSubjectDN:             tlsClientAuth.SubjectDN,
+		TLSClientAuthSANDNS:                tlsClientAuth.SANDNS,
+		TLSClientAuthSANURI:                tlsClientAuth.SANURI,
+		TLSClientAuthSANIP:                 tlsClientAuth.SANIP,
+		TLSClientAuthSANEmail:              tlsClientAuth.SANEmail,
 		UserInfoSignedResponseAlg:          userInfoSignedAlg,
 		UserInfoEncryptedResponseAlg:       userInfoEncryptedAlg,
 		UserInfoEncryptedResponseEnc:       userInfoEncryptedEnc,
 		IDTokenEncryptedResponseAlg:        idTokenEncryptedAlg,
 		IDTokenEncryptedResponseEnc:        idTokenEncryptedEnc,
+		AuthorizationSignedResponseAlg:     authzSignedAlg,
+		AuthorizationEncryptedResponseAlg:  authzEncryptedAlg,
+		AuthorizationEncryptedResponseEnc:  authzEncryptedEnc,
 	}
 
 	return response, nil
@@ -5,9 +5,12 @@ package dcr
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"testing"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	"github.com/stretchr/testify/mock"
@@ -17,10 +20,14 @@ import (
 	"github.com/thunder-id/thunderid/internal/application/model"
 	"github.com/thunder-id/thunderid/internal/cert"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/tests/mocks/applicationmock"
 	i18nmock "github.com/thunder-id/thunderid/tests/mocks/i18n/mgtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+	"github.com/thunder-id/thunderid/tests/testhelpers"
 )
 
 // DCRServiceTestSuite is the test suite for DCR service
@@ -28,6 +35,8 @@ type DCRServiceTestSuite struct {
 	suite.Suite
 	mockAppService *applicationmock.ApplicationServiceInterfaceMock
 	mockOUService  *oumock.OrganizationUnitServiceInterfaceMock
+	mockJWTService *jwtmock.JWTServiceInterfaceMock
+	cfg            oauthconfig.Config
 	service        DCRServiceInterface
 }
 
@@ -45,12 +54,17 @@ func (m *MockTransactioner) Transact(ctx context.Context, txFunc func(context.Co
 func (s *DCRServiceTestSuite) SetupTest() {
 	s.mockAppService = applicationmock.NewApplicationServiceInterfaceMock(s.T())
 	s.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(s.T())
-	s.service = newDCRService(s.mockAppService, s.mockOUService, nil, &MockTransactioner{})
+	s.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.mockJWTService.On("GenerateJWT", mock.Anything, mock.Anything, testhelpers.OAuthConfig().JWT.Issuer,
+		defaultRegistrationTokenValidity, mock.Anything, registrationTokenType, "").
+		Return("registration-token", int64(0), (*tidcommon.ServiceError)(nil)).Maybe()
+	s.cfg = testhelpers.OAuthConfig()
+	s.service = newDCRService(s.mockAppService, s.mockOUService, nil, s.mockJWTService, &MockTransactioner{}, s.cfg)
 }
 
 // TestNewDCRService tests the service constructor
 func (s *DCRServiceTestSuite) TestNewDCRService() {
-	service := newDCRService(s.mockAppService, s.mockOUService, nil, &MockTransactioner{})
+	service := newDCRService(s.mockAppService, s.mockOUService, nil, s.mockJWTService, &MockTransactioner{}, s.cfg)
 	s.NotNil(service)
 	s.Implements((*DCRServiceInterface)(nil), service)
 }
@@ -410,6 +424,95 @@ func (s *DCRServiceTestSuite) TestRegisterClient_RequirePushedAuthorizationReque
 	s.True(response.RequirePushedAuthorizationRequests)
 }
 
+func (s *DCRServiceTestSuite) TestRegisterClient_PairwiseSubjectType() {
+	request := &DCRRegistrationRequest{
+		OUID:                "test-ou-1",
+		RedirectURIs:        []string{"https://client.example.com/callback"},
+		GrantTypes:          []providers.GrantType{providers.GrantTypeAuthorizationCode},
+		ClientName:          "Test Client",
+		SubjectType:         providers.SubjectTypePairwise,
+		SectorIdentifierURI: "https://client.example.com/sector.json",
+	}
+
+	appDTO := &model.ApplicationDTO{
+		ID:   "app-id",
+		Name: "Test Client",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{
+			{
+				Type: providers.OAuthInboundAuthType,
+				OAuthConfig: &providers.OAuthConfigWithSecret{
+					ClientID:            "client-id",
+					ClientSecret:        "client-secret",
+					Scopes:              []string{},
+					SubjectType:         providers.SubjectTypePairwise,
+					SectorIdentifierURI: "https://client.example.com/sector.json",
+				},
+			},
+		},
+	}
+
+	s.mockAppService.On(
+		"CreateApplication", mock.Anything,
+		mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+			if len(dto.InboundAuthConfig) == 0 || dto.InboundAuthConfig[0].OAuthConfig == nil {
+				return false
+			}
+			oauthConfig := dto.InboundAuthConfig[0].OAuthConfig
+			return oauthConfig.SubjectType == providers.SubjectTypePairwise &&
+				oauthConfig.SectorIdentifierURI == "https://client.example.com/sector.json"
+		}),
+	).Return(appDTO, (*tidcommon.ServiceError)(nil))
+
+	response, err := s.service.RegisterClient(context.Background(), request)
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.Equal(providers.SubjectTypePairwise, response.SubjectType)
+	s.Equal("https://client.example.com/sector.json", response.SectorIdentifierURI)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_RequireSignedRequestObject() {
+	request := &DCRRegistrationRequest{
+		OUID:                       "test-ou-1",
+		RedirectURIs:               []string{"https://client.example.com/callback"},
+		GrantTypes:                 []providers.GrantType{providers.GrantTypeAuthorizationCode},
+		ClientName:                 "Test Client",
+		RequireSignedRequestObject: true,
+	}
+
+	appDTO := &model.ApplicationDTO{
+		ID:   "app-id",
+		Name: "Test Client",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{
+			{
+				Type: providers.OAuthInboundAuthType,
+				OAuthConfig: &providers.OAuthConfigWithSecret{
+					ClientID:                   "client-id",
+					ClientSecret:               "client-secret",
+					Scopes:                     []string{},
+					RequireSignedRequestObject: true,
+				},
+			},
+		},
+	}
+
+	s.mockAppService.On(
+		"CreateApplication", mock.Anything,
+		mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+			if len(dto.InboundAuthConfig) == 0 || dto.InboundAuthConfig[0].OAuthConfig == nil {
+				return false
+			}
+			return dto.InboundAuthConfig[0].OAuthConfig.RequireSignedRequestObject
+		}),
+	).Return(appDTO, (*tidcommon.ServiceError)(nil))
+
+	response, err := s.service.RegisterClient(context.Background(), request)
+
+	s.NotNil(response)
+	s.Nil(err)
+	s.True(response.RequireSignedRequestObject)
+}
+
 // TestRegisterClient_EmptyInboundAuthConfig verifies that a created application returned by
 // the application service without any OAuth inbound config is treated as a server-side
 // invariant violation: the DCR endpoint must NOT silently respond 200 with an empty body.
@@ -442,7 +545,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_EmptyInboundAuthConfig() {
 // and that the non-tagged default is stored under SystemLanguage.
 func (s *DCRServiceTestSuite) TestRegisterClient_WithLocalizedVariants() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService, &MockTransactioner{}, s.cfg)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -497,7 +600,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_WithLocalizedVariants() {
 // client_name is provided (no localized variants), it is stored under SystemLanguage.
 func (s *DCRServiceTestSuite) TestRegisterClient_DefaultOnlyStoresSystemLanguage() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService, &MockTransactioner{}, s.cfg)
 
 	request := &DCRRegistrationRequest{
 		OUID:       "test-ou-1",
@@ -542,7 +645,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_DefaultOnlyStoresSystemLanguage
 // default and an explicit #SystemLanguage-tagged variant are provided, the tagged variant wins.
 func (s *DCRServiceTestSuite) TestRegisterClient_TaggedSystemLanguageWinsOverDefault() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService, &MockTransactioner{}, s.cfg)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -590,7 +693,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_TaggedSystemLanguageWinsOverDef
 // partial-row cleanup and app compensation delete.
 func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService, &MockTransactioner{}, s.cfg)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -641,7 +744,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure()
 // validation must return ErrorInvalidClientMetadata and trigger the compensation rollback.
 func (s *DCRServiceTestSuite) TestRegisterClient_InvalidLocalizedURI() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService, &MockTransactioner{}, s.cfg)
 
 	request := &DCRRegistrationRequest{
 		OUID:             "test-ou-1",
@@ -703,6 +806,33 @@ func (s *DCRServiceTestSuite) TestBuildIDTokenConfig_MapsAlgAndEnc() {
 	s.Equal("A256GCM", cfg.EncryptionEnc)
 }
 
+// TestBuildAuthorizationResponseConfig verifies that buildAuthorizationResponseConfig maps the JARM
+// alg fields and returns nil when none are set.
+func (s *DCRServiceTestSuite) TestBuildAuthorizationResponseConfig() {
+	s.Nil(buildAuthorizationResponseConfig(&DCRRegistrationRequest{}))
+
+	cfg := buildAuthorizationResponseConfig(&DCRRegistrationRequest{
+		AuthorizationSignedResponseAlg:    "PS256",
+		AuthorizationEncryptedResponseAlg: "RSA-OAEP-256",
+		AuthorizationEncryptedResponseEnc: "A256GCM",
+	})
+	s.Require().NotNil(cfg)
+	s.Equal("PS256", cfg.SigningAlg)
+	s.Equal("RSA-OAEP-256", cfg.EncryptionAlg)
+	s.Equal("A256GCM", cfg.EncryptionEnc)
+}
+
+// TestBuildTLSClientAuthConfig verifies that buildTLSClientAuthConfig maps the tls_client_auth subject
+// fields and returns nil when none are set.
+func (s *DCRServiceTestSuite) TestBuildTLSClientAuthConfig() {
+	s.Nil(buildTLSClientAuthConfig(&DCRRegistrationRequest{}))
+
+	cfg := buildTLSClientAuthConfig(&DCRRegistrationRequest{TLSClientAuthSANDNS: "client.example.com"})
+	s.Require().NotNil(cfg)
+	s.Equal("client.example.com", cfg.SANDNS)
+	s.Empty(cfg.SubjectDN)
+}
+
 // TestRegisterClient_WithIDTokenEncryption verifies that DCR registration round-trips
 // IDTokenEncryptedResponseAlg and IDTokenEncryptedResponseEnc correctly.
 func (s *DCRServiceTestSuite) TestRegisterClient_WithIDTokenEncryption() {
@@ -762,7 +892,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_WithIDTokenEncryption() {
 // i18n error maps to ErrorServerError to avoid leaking internal details to external callers.
 func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure_ClientError() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService, &MockTransactioner{}, s.cfg)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -811,3 +941,289 @@ func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure_C
 	mockI18n.AssertExpectations(s.T())
 	s.mockAppService.AssertExpectations(s.T())
 }
+
+// compactJWT builds a compact JWS with the given header and claims and a placeholder signature.
+func compactJWT(header, claims map[string]interface{}) string {
+	h, _ := json.Marshal(header)
+	c, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c) + ".c2ln"
+}
+
+func testRegistrationToken(clientID string) string {
+	return compactJWT(map[string]interface{}{"alg": "RS256", "typ": registrationTokenType},
+		map[string]interface{}{"sub": clientID})
+}
+
+func testRegisteredApplication() *providers.Application {
+	return &providers.Application{
+		ID:   "app-id",
+		OUID: "test-ou-1",
+		Name: application.AppI18nRef("app-id", "name"),
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{
+			{
+				Type: providers.OAuthInboundAuthType,
+				OAuthConfig: &providers.OAuthConfigWithSecret{
+					ClientID:     "client-id",
+					RedirectURIs: []string{"https://client.example.com/callback"},
+				},
+			},
+		},
+	}
+}
+
+func (s *DCRServiceTestSuite) expectRegistrationTokenVerified(token string) {
+	s.mockJWTService.On("VerifyJWT", mock.Anything, token,
+		"https://thunder.io/oauth2/dcr/register/client-id", "https://thunder.io").
+		Return((*tidcommon.ServiceError)(nil))
+}
+
+// expectRegisteredClient registers the client the token from testRegistrationToken was last issued to.
+func (s *DCRServiceTestSuite) expectRegisteredClient() {
+	s.mockAppService.On("GetOAuthApplication", mock.Anything, "client-id").
+		Return(&providers.OAuthClient{
+			ID:                    "app-id",
+			ClientID:              "client-id",
+			RegistrationTokenHash: cryptolib.HashToken(testRegistrationToken("client-id")),
+		}, (*tidcommon.ServiceError)(nil))
+}
+
+func (s *DCRServiceTestSuite) expectRegisteredApplication() {
+	s.expectRegisteredClient()
+	s.mockAppService.On("GetApplication", mock.Anything, "app-id").
+		Return(testRegisteredApplication(), (*tidcommon.ServiceError)(nil))
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_IssuesRegistrationAccessToken() {
+	request := &DCRRegistrationRequest{
+		OUID:         "test-ou-1",
+		RedirectURIs: []string{"https://client.example.com/callback"},
+	}
+	s.mockAppService.On("CreateApplication", mock.Anything, mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+		return dto.InboundAuthConfig[0].OAuthConfig.RegistrationTokenHash == cryptolib.HashToken("registration-token")
+	})).Return(&model.ApplicationDTO{
+		ID: "app-id",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{
+			{Type: providers.OAuthInboundAuthType, OAuthConfig: &providers.OAuthConfigWithSecret{ClientID: "client-id"}},
+		},
+	}, (*tidcommon.ServiceError)(nil))
+
+	response, err := s.service.RegisterClient(context.Background(), request)
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.Equal("registration-token", response.RegistrationAccessToken)
+	s.Equal("https://thunder.io/oauth2/dcr/register/client-id", response.RegistrationClientURI)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_SoftwareStatementRequired() {
+	s.cfg.OAuth.DCR.SoftwareStatement.Required = true
+	svc := newDCRService(s.mockAppService, s.mockOUService, nil, s.mockJWTService, &MockTransactioner{}, s.cfg)
+
+	response, err := svc.RegisterClient(context.Background(), &DCRRegistrationRequest{OUID: "test-ou-1"})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorSoftwareStatementRequired.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_SoftwareStatementUntrustedIssuer() {
+	statement := compactJWT(map[string]interface{}{"alg": "PS256"},
+		map[string]interface{}{"iss": "https://untrusted.example.com"})
+
+	response, err := s.service.RegisterClient(context.Background(),
+		&DCRRegistrationRequest{OUID: "test-ou-1", SoftwareStatement: statement})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorUnapprovedSoftwareStatement.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_SoftwareStatementInvalidSignature() {
+	s.cfg.OAuth.DCR.SoftwareStatement.TrustAnchors = []engineconfig.SoftwareStatementTrustAnchor{
+		{Issuer: "https://directory.example.com", JWKSURI: "https://directory.example.com/jwks"},
+	}
+	svc := newDCRService(s.mockAppService, s.mockOUService, nil, s.mockJWTService, &MockTransactioner{}, s.cfg)
+	statement := compactJWT(map[string]interface{}{"alg": "PS256"},
+		map[string]interface{}{"iss": "https://directory.example.com"})
+	s.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, statement, "https://directory.example.com/jwks").
+		Return(&tidcommon.ServiceError{Type: tidcommon.ClientErrorType})
+
+	response, err := svc.RegisterClient(context.Background(),
+		&DCRRegistrationRequest{OUID: "test-ou-1", SoftwareStatement: statement})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidSoftwareStatement.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_SoftwareStatementMetadataTakesPrecedence() {
+	s.cfg.OAuth.DCR.SoftwareStatement.TrustAnchors = []engineconfig.SoftwareStatementTrustAnchor{
+		{Issuer: "https://directory.example.com", JWKSURI: "https://directory.example.com/jwks"},
+	}
+	svc := newDCRService(s.mockAppService, s.mockOUService, nil, s.mockJWTService, &MockTransactioner{}, s.cfg)
+	statement := compactJWT(map[string]interface{}{"alg": "PS256"}, map[string]interface{}{
+		"iss":         "https://directory.example.com",
+		"client_name": "Directory Client",
+		"ou_id":       "other-ou",
+	})
+	s.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, statement, "https://directory.example.com/jwks").
+		Return((*tidcommon.ServiceError)(nil))
+	s.mockAppService.On("CreateApplication", mock.Anything, mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+		return dto.Name == "Directory Client" && dto.OUID == "test-ou-1"
+	})).Return(&model.ApplicationDTO{
+		ID: "app-id",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{
+			{Type: providers.OAuthInboundAuthType, OAuthConfig: &providers.OAuthConfigWithSecret{ClientID: "client-id"}},
+		},
+	}, (*tidcommon.ServiceError)(nil))
+
+	response, err := svc.RegisterClient(context.Background(), &DCRRegistrationRequest{
+		OUID:              "test-ou-1",
+		ClientName:        "Self-Asserted Name",
+		SoftwareStatement: statement,
+	})
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.Equal("Directory Client", response.ClientName)
+	s.Equal(statement, response.SoftwareStatement)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_Success() {
+	token := testRegistrationToken("client-id")
+	s.expectRegistrationTokenVerified(token)
+	s.expectRegisteredApplication()
+
+	response, err := s.service.GetClient(context.Background(), "client-id", token)
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.Equal("client-id", response.ClientID)
+	s.Equal("client-id", response.ClientName)
+	s.Equal([]string{"https://client.example.com/callback"}, response.RedirectURIs)
+	s.Equal(token, response.RegistrationAccessToken)
+	s.Equal("https://thunder.io/oauth2/dcr/register/client-id", response.RegistrationClientURI)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_InvalidToken() {
+	cases := map[string]string{
+		"missing":    "",
+		"wrong type": compactJWT(map[string]interface{}{"alg": "RS256", "typ": "at+jwt"}, map[string]interface{}{}),
+		"not a jwt":  "opaque-token",
+	}
+	for name, token := range cases {
+		response, err := s.service.GetClient(context.Background(), "client-id", token)
+
+		s.Nil(response, name)
+		s.Require().NotNil(err, name)
+		s.Equal(ErrorInvalidToken.Code, err.Code, name)
+	}
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_TokenForAnotherClient() {
+	token := testRegistrationToken("other-client")
+	s.expectRegistrationTokenVerified(token)
+
+	response, err := s.service.GetClient(context.Background(), "client-id", token)
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidToken.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_SupersededToken() {
+	token := compactJWT(map[string]interface{}{"alg": "RS256", "typ": registrationTokenType},
+		map[string]interface{}{"sub": "client-id", "iat": 1})
+	s.expectRegistrationTokenVerified(token)
+	s.expectRegisteredClient()
+
+	response, err := s.service.GetClient(context.Background(), "client-id", token)
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidToken.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_ClientNoLongerExists() {
+	token := testRegistrationToken("client-id")
+	s.expectRegistrationTokenVerified(token)
+	s.mockAppService.On("GetOAuthApplication", mock.Anything, "client-id").
+		Return(nil, &tidcommon.ServiceError{Type: tidcommon.ClientErrorType, Code: "APP-1001"})
+
+	response, err := s.service.GetClient(context.Background(), "client-id", token)
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidToken.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestUpdateClient_ClientIDMismatch() {
+	token := testRegistrationToken("client-id")
+	s.expectRegistrationTokenVerified(token)
+	s.expectRegisteredClient()
+
+	response, err := s.service.UpdateClient(context.Background(), "client-id", token,
+		&DCRRegistrationRequest{ClientID: "other-client"})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorClientIDMismatch.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestUpdateClient_Success() {
+	token := testRegistrationToken("client-id")
+	s.expectRegistrationTokenVerified(token)
+	s.expectRegisteredApplication()
+	s.mockAppService.On("UpdateApplication", mock.Anything, "app-id", mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+		oauth := dto.InboundAuthConfig[0].OAuthConfig
+		return dto.ID == "app-id" && dto.OUID == "test-ou-1" && oauth.ClientID == "client-id" &&
+			oauth.ClientSecret == "" && dto.Name == "Renamed Client" &&
+			oauth.RegistrationTokenHash == cryptolib.HashToken("registration-token")
+	})).Return(&model.ApplicationDTO{
+		ID:   "app-id",
+		Name: "Renamed Client",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{
+			{
+				Type: providers.OAuthInboundAuthType,
+				OAuthConfig: &providers.OAuthConfigWithSecret{
+					ClientID:     "client-id",
+					RedirectURIs: []string{"https://client.example.com/new"},
+				},
+			},
+		},
+	}, (*tidcommon.ServiceError)(nil))
+
+	response, err := s.service.UpdateClient(context.Background(), "client-id", token, &DCRRegistrationRequest{
+		ClientID:     "client-id",
+		ClientName:   "Renamed Client",
+		RedirectURIs: []string{"https://client.example.com/new"},
+	})
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.Equal("Renamed Client", response.ClientName)
+	s.Equal([]string{"https://client.example.com/new"}, response.RedirectURIs)
+	s.Equal("registration-token", response.RegistrationAccessToken)
+}
+
+func (s *DCRServiceTestSuite) TestDeleteClient_Success() {
+	token := testRegistrationToken("client-id")
+	s.expectRegistrationTokenVerified(token)
+	s.expectRegisteredApplication()
+	s.mockAppService.On("DeleteApplication", mock.Anything, "app-id").Return((*tidcommon.ServiceError)(nil))
+
+	err := s.service.DeleteClient(context.Background(), "client-id", token)
+
+	s.Nil(err)
+}
+
+func (s *DCRServiceTestSuite) TestDeleteClient_InvalidToken() {
+	token := testRegistrationToken("client-id")
+	s.mockJWTService.On("VerifyJWT", mock.Anything, token, mock.Anything, mock.Anything).
+		Return(&tidcommon.ServiceError{Type: tidcommon.ClientErrorType})
+
+	err := s.service.DeleteClient(context.Background(), "client-id", token)
+
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidToken.Code, err.Code)
+}
@@ -0,0 +1,23 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import "errors"
+
+// ErrDeviceRequestNotFound is returned when a device authorization request is not found in the store.
+var ErrDeviceRequestNotFound = errors.New("device authorization request not found")
+
+// ErrDevicePollConflict is returned when another poll recorded a poll time for the same device
+// authorization request after the caller read it.
+var ErrDevicePollConflict = errors.New("device authorization request polled concurrently")
+
+// errUserCodeCollision is returned when a generated user code is already bound to a live request.
+var errUserCodeCollision = errors.New("user code already in use")
+
+// errDeviceRequestStateChanged is returned when a device authorization request changes state between
+// being read and being written back.
+var errDeviceRequestStateChanged = errors.New("device authorization request state changed concurrently")
+
+// errDeviceRequestUnchanged is returned by a store update that has nothing to write.
+var errDeviceRequestUnchanged = errors.New("device authorization request unchanged")
@@ -0,0 +1,151 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// DeviceHandlerInterface defines the interface for handling device authorization and verification requests.
+type DeviceHandlerInterface interface {
+	HandleDeviceAuthorizationRequest(w http.ResponseWriter, r *http.Request)
+	HandleVerificationRequest(w http.ResponseWriter, r *http.Request)
+}
+
+// deviceHandler implements the DeviceHandlerInterface.
+type deviceHandler struct {
+	cfg           oauthconfig.Config
+	deviceService DeviceServiceInterface
+	logger        *log.Logger
+}
+
+// newDeviceHandler creates a new instance of deviceHandler.
+func newDeviceHandler(deviceService DeviceServiceInterface, cfg oauthconfig.Config) DeviceHandlerInterface {
+	return &deviceHandler{
+		cfg:           cfg,
+		deviceService: deviceService,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeviceHandler")),
+	}
+}
+
+// HandleDeviceAuthorizationRequest handles a POST /oauth2/device_authorization request.
+func (h *deviceHandler) HandleDeviceAuthorizationRequest(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		utils.WriteJSONError(r.Context(), w, oauth2const.ErrorInvalidRequest, "Failed to parse request body",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	// Get authenticated client from context (set by ClientAuthMiddleware).
+	clientInfo := clientauth.GetOAuthClient(r.Context())
+	if clientInfo == nil {
+		h.logger.Error(r.Context(),
+			"OAuth client not found in context - ClientAuthMiddleware must be applied")
+		utils.WriteJSONError(r.Context(), w, oauth2const.ErrorServerError, "Something went wrong",
+			http.StatusInternalServerError, nil)
+		return
+	}
+
+	request := &DeviceAuthorizationRequest{
+		Scope:     r.FormValue(oauth2const.RequestParamScope),
+		Resources: r.Form[oauth2const.RequestParamResource],
+	}
+
+	response, deviceErr := h.deviceService.InitiateDeviceAuthorization(r.Context(), request, clientInfo.OAuthApp)
+	if deviceErr != nil {
+		writeDeviceError(r.Context(), w, deviceErr)
+		return
+	}
+
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoStore)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+	utils.WriteSuccessResponse(r.Context(), w, http.StatusOK, response)
+}
+
+// HandleVerificationRequest handles a GET /oauth2/device request. Without a user code it renders the
+// code entry form; with one it initiates the authentication flow and redirects to the login page. The
+// status parameter is set by the flow callback to display the outcome once the flow completes.
+func (h *deviceHandler) HandleVerificationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	if status := query.Get(verificationStatusParam); status != "" {
+		h.renderPage(ctx, w, http.StatusOK, verificationPage{Message: verificationStatusMessage(status)})
+		return
+	}
+
+	userCode := query.Get(oauth2const.RequestParamUserCode)
+	if userCode == "" {
+		h.renderPage(ctx, w, http.StatusOK, verificationPage{ShowForm: true})
+		return
+	}
+
+	queryParams, deviceErr := h.deviceService.InitiateVerification(ctx, &VerificationRequest{
+		UserCode:    userCode,
+		Headers:     utils.SanitizeRawMultiValueStringMap(r.Header),
+		QueryParams: utils.SanitizeRawMultiValueStringMap(query),
+	})
+	if deviceErr != nil {
+		statusCode := http.StatusBadRequest
+		if deviceErr.Code == oauth2const.ErrorServerError {
+			statusCode = http.StatusInternalServerError
+		}
+		h.renderPage(ctx, w, statusCode, verificationPage{
+			Message:  deviceErr.Message,
+			UserCode: userCode,
+			ShowForm: true,
+		})
+		return
+	}
+
+	redirectURI, err := h.getLoginPageRedirectURI(queryParams)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to construct login page URL", log.Error(err))
+		h.renderPage(ctx, w, http.StatusInternalServerError, verificationPage{
+			Message:  "Failed to process the device verification request",
+			ShowForm: true,
+		})
+		return
+	}
+	http.Redirect(w, r, redirectURI, http.StatusFound)
+}
+
+// getLoginPageRedirectURI constructs the login page URL with the provided query parameters.
+func (h *deviceHandler) getLoginPageRedirectURI(queryParams map[string]string) (string, error) {
+	loginPageURL := (&url.URL{
+		Scheme: h.cfg.GateClient.Scheme,
+		Host:   fmt.Sprintf("%s:%d", h.cfg.GateClient.Hostname, h.cfg.GateClient.Port),
+		Path:   h.cfg.GateClient.LoginPath,
+	}).String()
+
+	return oauth2utils.GetURIWithQueryParams(loginPageURL, queryParams)
+}
+
+// renderPage writes the verification page, logging any rendering failure.
+func (h *deviceHandler) renderPage(ctx context.Context, w http.ResponseWriter, statusCode int,
+	page verificationPage) {
+	if err := writeVerificationPage(w, statusCode, page); err != nil {
+		h.logger.Error(ctx, "Failed to render device verification page", log.Error(err))
+	}
+}
+
+// writeDeviceError maps a DeviceError to the appropriate HTTP status code and writes the JSON response.
+func writeDeviceError(ctx context.Context, w http.ResponseWriter, deviceErr *DeviceError) {
+	statusCode := http.StatusBadRequest
+	if deviceErr.Code == oauth2const.ErrorServerError {
+		statusCode = http.StatusInternalServerError
+	}
+	utils.WriteJSONError(ctx, w, deviceErr.Code, deviceErr.Message, statusCode, nil)
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/testhelpers"
+)
+
+type DeviceHandlerTestSuite struct {
+	suite.Suite
+	mockService *DeviceServiceInterfaceMock
+	handler     DeviceHandlerInterface
+}
+
+func TestDeviceHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceHandlerTestSuite))
+}
+
+func (suite *DeviceHandlerTestSuite) SetupTest() {
+	suite.mockService = NewDeviceServiceInterfaceMock(suite.T())
+	suite.handler = newDeviceHandler(suite.mockService, testhelpers.OAuthConfig())
+}
+
+func (suite *DeviceHandlerTestSuite) newAuthRequest(body string, client *clientauth.OAuthClientInfo) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, oauth2const.OAuth2DeviceAuthorizationEndpoint,
+		strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if client != nil {
+		req = req.WithContext(context.WithValue(req.Context(), clientauth.OAuthClientKey, client))
+	}
+	return req
+}
+
+func (suite *DeviceHandlerTestSuite) TestDeviceAuthorization_Success() {
+	client := &clientauth.OAuthClientInfo{
+		ClientID: "client-1",
+		OAuthApp: &providers.OAuthClient{ClientID: "client-1"},
+	}
+	suite.mockService.EXPECT().InitiateDeviceAuthorization(mock.Anything, mock.MatchedBy(
+		func(r *DeviceAuthorizationRequest) bool {
+			return r.Scope == "openid" && len(r.Resources) == 1 && r.Resources[0] == "https://api.example.com"
+		}), client.OAuthApp).Return(&DeviceAuthorizationResponse{
+		DeviceCode:      "device-code",
+		UserCode:        "BCDF-GHJK",
+		VerificationURI: "https://thunder.io/oauth2/device",
+		ExpiresIn:       600,
+		Interval:        5,
+	}, nil)
+
+	req := suite.newAuthRequest("scope=openid&resource="+url.QueryEscape("https://api.example.com"), client)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleDeviceAuthorizationRequest(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("no-store", w.Header().Get("Cache-Control"))
+	var resp DeviceAuthorizationResponse
+	suite.NoError(json.NewDecoder(w.Body).Decode(&resp))
+	suite.Equal("device-code", resp.DeviceCode)
+	suite.Equal("BCDF-GHJK", resp.UserCode)
+}
+
+func (suite *DeviceHandlerTestSuite) TestDeviceAuthorization_NoClientInContext() {
+	req := suite.newAuthRequest("scope=openid", nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleDeviceAuthorizationRequest(w, req)
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
+
+func (suite *DeviceHandlerTestSuite) TestDeviceAuthorization_ServiceError() {
+	client := &clientauth.OAuthClientInfo{
+		ClientID: "client-1",
+		OAuthApp: &providers.OAuthClient{ClientID: "client-1"},
+	}
+	suite.mockService.EXPECT().InitiateDeviceAuthorization(mock.Anything, mock.Anything, client.OAuthApp).
+		Return(nil, &DeviceError{Code: oauth2const.ErrorUnauthorizedClient, Message: "not allowed"})
+
+	w := httptest.NewRecorder()
+	suite.handler.HandleDeviceAuthorizationRequest(w, suite.newAuthRequest("scope=openid", client))
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	var body map[string]string
+	suite.NoError(json.NewDecoder(w.Body).Decode(&body))
+	suite.Equal(oauth2const.ErrorUnauthorizedClient, body["error"])
+}
+
+func (suite *DeviceHandlerTestSuite) TestVerification_NoCodeRendersForm() {
+	req := httptest.NewRequest(http.MethodGet, oauth2const.OAuth2DeviceVerificationEndpoint, nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleVerificationRequest(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Header().Get("Content-Type"), "text/html")
+	suite.Contains(w.Body.String(), `name="user_code"`)
+}
+
+func (suite *DeviceHandlerTestSuite) TestVerification_StatusRendersOutcome() {
+	req := httptest.NewRequest(http.MethodGet, oauth2const.OAuth2DeviceVerificationEndpoint+"?status=approved", nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleVerificationRequest(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "Your device has been connected")
+	suite.NotContains(w.Body.String(), "<form")
+}
+
+func (suite *DeviceHandlerTestSuite) TestVerification_ValidCodeRedirectsToLogin() {
+	suite.mockService.EXPECT().InitiateVerification(mock.Anything, mock.MatchedBy(
+		func(r *VerificationRequest) bool { return r.UserCode == "BCDF-GHJK" })).
+		Return(map[string]string{
+			oauth2const.AuthID:      "request-1",
+			oauth2const.AppID:       "app-1",
+			oauth2const.ExecutionID: "exec-1",
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		oauth2const.OAuth2DeviceVerificationEndpoint+"?user_code=BCDF-GHJK", nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleVerificationRequest(w, req)
+
+	suite.Equal(http.StatusFound, w.Code)
+	location, err := url.Parse(w.Header().Get("Location"))
+	suite.Require().NoError(err)
+	suite.Equal("localhost:3000", location.Host)
+	suite.Equal("/login", location.Path)
+	suite.Equal("request-1", location.Query().Get(oauth2const.AuthID))
+	suite.Equal("exec-1", location.Query().Get(oauth2const.ExecutionID))
+}
+
+func (suite *DeviceHandlerTestSuite) TestVerification_InvalidCodeRerendersFormEscaped() {
+	suite.mockService.EXPECT().InitiateVerification(mock.Anything, mock.Anything).
+		Return(nil, &DeviceError{Code: oauth2const.ErrorInvalidRequest, Message: "The code you entered is not valid"})
+
+	req := httptest.NewRequest(http.MethodGet,
+		oauth2const.OAuth2DeviceVerificationEndpoint+"?user_code="+url.QueryEscape(`"><script>`), nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleVerificationRequest(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), "The code you entered is not valid")
+	suite.Contains(w.Body.String(), `name="user_code"`)
+	suite.NotContains(w.Body.String(), "<script>")
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize initializes the device authorization handler, registers its routes, and returns the
+// DeviceServiceInterface. The store is created internally and never exposed. The returned service is
+// used by both the callback dispatcher and the token grant handler.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	actorProvider providers.ActorProvider,
+	authnProvider providers.AuthnProviderManager,
+	flowExecService flowexec.FlowExecServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+	resourceService providers.ResourceServerProvider,
+	runtimeStore providers.RuntimeStoreProvider,
+	jtiStore jti.JTIStoreInterface,
+	cfg oauthconfig.Config,
+) DeviceServiceInterface {
+	store := newDeviceStore(runtimeStore)
+	deviceSvc := newDeviceService(store, flowExecService, jwtService, actorProvider, resourceService, cfg)
+	deviceHandler := newDeviceHandler(deviceSvc, cfg)
+	registerRoutes(mux, deviceHandler, actorProvider, authnProvider, jwtService, discoveryService,
+		jtiStore, cfg.JWT.Leeway)
+	return deviceSvc
+}
+
+// registerRoutes registers the device authorization and verification endpoints. The callback
+// (/oauth2/auth/callback) is handled by the shared callback package which dispatches by grant type.
+func registerRoutes(
+	mux *http.ServeMux,
+	deviceHandler DeviceHandlerInterface,
+	actorProvider providers.ActorProvider,
+	authnProvider providers.AuthnProviderManager,
+	jwtService jwt.JWTServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+	jtiStore jti.JTIStoreInterface,
+	leeway int64,
+) {
+	corsOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	issuer := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background()).Issuer
+	clientAuthMiddleware := clientauth.ClientAuthMiddleware(actorProvider, authnProvider, jwtService,
+		jtiStore, issuer, leeway)
+	authHandler := clientAuthMiddleware(http.HandlerFunc(deviceHandler.HandleDeviceAuthorizationRequest))
+
+	authPattern, wrappedAuthHandler := middleware.WithCORS(
+		"POST "+constants.OAuth2DeviceAuthorizationEndpoint,
+		authHandler.ServeHTTP,
+		corsOpts,
+	)
+	mux.HandleFunc(authPattern, wrappedAuthHandler)
+
+	// The verification page is a top-level browser navigation, so it needs no CORS handling.
+	mux.HandleFunc("GET "+constants.OAuth2DeviceVerificationEndpoint, deviceHandler.HandleVerificationRequest)
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"html/template"
+	"net/http"
+
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+)
+
+// verificationPageTemplate renders the user code entry form and the verification outcome. It carries no
+// inline scripts or styles so it is served unchanged under the server's content security policy.
+var verificationPageTemplate = template.Must(template.New("device").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Device Activation</title>
+</head>
+<body>
+<main>
+<h1>Device Activation</h1>
+{{- if .Message}}
+<p role="status">{{.Message}}</p>
+{{- end}}
+{{- if .ShowForm}}
+<form method="get" action="{{.Action}}">
+<label for="user_code">Enter the code displayed on your device</label>
+<input id="user_code" name="user_code" type="text" value="{{.UserCode}}" autocomplete="off"
+ autocapitalize="characters" spellcheck="false" required>
+<button type="submit">Continue</button>
+</form>
+{{- end}}
+</main>
+</body>
+</html>
+`))
+
+// verificationPage holds the data rendered into the verification page template.
+type verificationPage struct {
+	Action   string
+	Message  string
+	UserCode string
+	ShowForm bool
+}
+
+// writeVerificationPage renders the verification page with the given HTTP status code.
+func writeVerificationPage(w http.ResponseWriter, statusCode int, page verificationPage) error {
+	page.Action = "device"
+	w.Header().Set(sysconst.ContentTypeHeaderName, sysconst.ContentTypeHTML)
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoStore)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+	w.WriteHeader(statusCode)
+	return verificationPageTemplate.Execute(w, page)
+}
+
+// verificationStatusMessage returns the message shown on the verification page for a completion status.
+func verificationStatusMessage(status string) string {
+	switch status {
+	case verificationStatusApproved:
+		return "Your device has been connected. You can close this window and return to your device."
+	case verificationStatusDenied:
+		return "The device was not connected. You can close this window."
+	default:
+		return "Something went wrong while connecting your device. Please try again on your device."
+	}
+}
@@ -0,0 +1,584 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// maxUserCodeAttempts bounds the number of user codes generated for a single request when a freshly
+// generated code collides with one that is still live.
+const maxUserCodeAttempts = 5
+
+// verificationStatusParam is the verification page query parameter carrying the outcome of a completed
+// authentication flow.
+const verificationStatusParam = "status"
+
+// Verification page status values carried on the completion redirect.
+const (
+	verificationStatusApproved = "approved"
+	verificationStatusDenied   = "denied"
+	verificationStatusFailed   = "failed"
+)
+
+// DeviceServiceInterface defines the interface for the device authorization service.
+// It covers the full lifecycle: initiation, user verification, callback, and token-endpoint polling.
+// The grant handler uses this interface instead of the raw store so the store stays private.
+type DeviceServiceInterface interface {
+	InitiateDeviceAuthorization(
+		ctx context.Context, request *DeviceAuthorizationRequest, oauthApp *providers.OAuthClient,
+	) (*DeviceAuthorizationResponse, *DeviceError)
+	InitiateVerification(ctx context.Context, request *VerificationRequest) (map[string]string, *DeviceError)
+	HandleCallback(ctx context.Context, requestID, assertion string) (string, *DeviceError)
+
+	// Polling operations used by the device code grant handler at the token endpoint.
+	GetByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuthRequest, error)
+	UpdateLastPolled(ctx context.Context, requestID string, previousPolledAt, polledAt time.Time,
+		interval int64) error
+	UpdateState(ctx context.Context, requestID string, state DeviceRequestState) error
+	MarkConsumed(ctx context.Context, requestID string) (bool, error)
+}
+
+// deviceService implements the DeviceServiceInterface.
+type deviceService struct {
+	cfg             oauthconfig.Config
+	store           DeviceRequestStoreInterface
+	flowExecService flowexec.FlowExecServiceInterface
+	jwtService      jwt.JWTServiceInterface
+	inboundClient   providers.ActorProvider
+	resourceService providers.ResourceServerProvider
+	logger          *log.Logger
+}
+
+// newDeviceService creates a new instance of deviceService with injected dependencies.
+func newDeviceService(
+	store DeviceRequestStoreInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	actorProvider providers.ActorProvider,
+	resourceService providers.ResourceServerProvider,
+	cfg oauthconfig.Config,
+) DeviceServiceInterface {
+	return &deviceService{
+		cfg:             cfg,
+		store:           store,
+		flowExecService: flowExecService,
+		jwtService:      jwtService,
+		inboundClient:   actorProvider,
+		resourceService: resourceService,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeviceService")),
+	}
+}
+
+// InitiateDeviceAuthorization validates the request, binds it to a resource server, persists a pending
+// device authorization request, and returns the device and user codes with polling metadata.
+func (s *deviceService) InitiateDeviceAuthorization(
+	ctx context.Context, request *DeviceAuthorizationRequest, oauthApp *providers.OAuthClient,
+) (*DeviceAuthorizationResponse, *DeviceError) {
+	if !oauthApp.IsAllowedGrantType(providers.GrantTypeDeviceCode) {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorUnauthorizedClient,
+			Message: "The client is not authorized to use the device code grant type",
+		}
+	}
+
+	// Separate OIDC (standard) and permission scopes and bind the request to a single target resource
+	// server, mirroring the authorization_code and CIBA paths.
+	oidcScopes, permissionScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(request.Scope, oauthApp.ScopeClaims)
+	targetRS, rsErr := resourceindicators.ResolveAudienceBinding(
+		ctx, s.resourceService, request.Resources, permissionScopes)
+	if rsErr != nil {
+		return nil, &DeviceError{Code: rsErr.Error, Message: rsErr.ErrorDescription}
+	}
+
+	var effectiveResources []string
+	if targetRS != nil {
+		downscoped, dErr := resourceindicators.DownscopeToResourceServer(
+			ctx, s.resourceService, targetRS.ID, permissionScopes)
+		if dErr != nil {
+			return nil, &DeviceError{Code: dErr.Error, Message: dErr.ErrorDescription}
+		}
+		permissionScopes = downscoped
+		effectiveResources = []string{targetRS.Identifier}
+	}
+
+	// The raw device_code is returned to the client only; the request is keyed by its digest so a
+	// leaked store entry or verification page URL cannot be used to poll the token endpoint.
+	deviceCode, err := cryptolib.GenerateSecureToken()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate device_code", log.Error(err))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization request",
+		}
+	}
+
+	expiresIn := s.resolveExpiresIn()
+	interval := s.resolveInterval()
+	deviceRequest := &DeviceAuthRequest{
+		ID:               cryptolib.HashToken(deviceCode),
+		ClientID:         oauthApp.ClientID,
+		StandardScopes:   utils.StringifyStringArray(oidcScopes, " "),
+		PermissionScopes: utils.StringifyStringArray(permissionScopes, " "),
+		Resources:        effectiveResources,
+		State:            DeviceStatePending,
+		Interval:         interval,
+		ExpiryTime:       time.Now().Add(time.Duration(expiresIn) * time.Second),
+	}
+	if storeErr := s.storeWithUniqueUserCode(ctx, deviceRequest); storeErr != nil {
+		s.logger.Error(ctx, "Failed to store device authorization request", log.Error(storeErr))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization request",
+		}
+	}
+
+	verificationURI := s.cfg.BaseURL + oauth2const.OAuth2DeviceVerificationEndpoint
+	displayCode := formatUserCode(deviceRequest.UserCode)
+	verificationURIComplete, err := oauth2utils.GetURIWithQueryParams(verificationURI, map[string]string{
+		oauth2const.RequestParamUserCode: displayCode,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to construct verification_uri_complete", log.Error(err))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization request",
+		}
+	}
+
+	return &DeviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                displayCode,
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: verificationURIComplete,
+		ExpiresIn:               expiresIn,
+		Interval:                interval,
+	}, nil
+}
+
+// storeWithUniqueUserCode assigns a fresh user code to the request and persists it, retrying when the
+// generated code is already bound to another live request.
+func (s *deviceService) storeWithUniqueUserCode(ctx context.Context, request *DeviceAuthRequest) error {
+	for range maxUserCodeAttempts {
+		userCode, err := generateUserCode()
+		if err != nil {
+			return err
+		}
+		request.UserCode = userCode
+
+		err = s.store.Add(ctx, request)
+		if errors.Is(err, errUserCodeCollision) {
+			continue
+		}
+		return err
+	}
+	return errors.New("failed to allocate a unique user code")
+}
+
+// InitiateVerification resolves the user code entered on the verification page and initiates the
+// application's authentication flow for it. It returns the query parameters for the login page
+// redirect. The flow carries the request ID as the authorization request ID so the completed
+// assertion is bound to this device authorization request.
+func (s *deviceService) InitiateVerification(
+	ctx context.Context, request *VerificationRequest) (map[string]string, *DeviceError) {
+	userCode := normalizeUserCode(request.UserCode)
+	if len(userCode) != userCodeLength {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "The code you entered is not valid",
+		}
+	}
+
+	record, err := s.store.GetByUserCode(ctx, userCode)
+	if err != nil {
+		if errors.Is(err, ErrDeviceRequestNotFound) {
+			return nil, &DeviceError{
+				Code:    oauth2const.ErrorInvalidRequest,
+				Message: "The code you entered is not valid",
+			}
+		}
+		s.logger.Error(ctx, "Failed to retrieve device authorization request", log.Error(err))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process the device verification request",
+		}
+	}
+
+	remaining := time.Until(record.ExpiryTime)
+	if record.State != DeviceStatePending || remaining <= 0 {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorExpiredToken,
+			Message: "The code you entered has expired or has already been used",
+		}
+	}
+
+	app, svcErr := s.inboundClient.GetOAuthClientByClientID(ctx, record.ClientID)
+	if svcErr != nil || app == nil {
+		s.logger.Error(ctx, "Failed to resolve client for device verification",
+			log.String("client_id", record.ClientID))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process the device verification request",
+		}
+	}
+
+	oidcScopes := utils.ParseStringArray(record.StandardScopes, " ")
+	permissionScopes := utils.ParseStringArray(record.PermissionScopes, " ")
+	resourceServerIdentifier := ""
+	if len(record.Resources) > 0 {
+		resourceServerIdentifier = record.Resources[0]
+	}
+
+	// The user is approving access for a device they are not using, so consent is always prompted
+	// to make the granted access visible even when an earlier consent would otherwise apply.
+	runtimeData := map[string]string{
+		flowcm.RuntimeKeyAuthorizationRequestID:      record.ID,
+		flowcm.RuntimeKeyCallbackType:                string(providers.GrantTypeDeviceCode),
+		flowcm.RuntimeKeyClientID:                    record.ClientID,
+		flowcm.RuntimeKeyRequestedPermissions:        record.PermissionScopes,
+		flowcm.RuntimeKeyResourceServerIdentifier:    resourceServerIdentifier,
+		flowcm.RuntimeKeyRequiredEssentialAttributes: "",
+		flowcm.RuntimeKeyRequiredOptionalAttributes: getRequiredOptionalAttributes(
+			append(oidcScopes, permissionScopes...), app),
+		flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: strconv.FormatInt(s.resolveUserAttributesCacheTTL(app), 10),
+		flowcm.RuntimeKeyForceConsentReprompt:          "true",
+	}
+	if len(app.AcrValues) > 0 {
+		runtimeData[flowcm.RuntimeKeyRequestedAuthClasses] = strings.Join(app.AcrValues, " ")
+	}
+
+	executionID, flowErr := s.flowExecService.InitiateFlow(ctx, &flowexec.FlowInitContext{
+		ApplicationID: app.ID,
+		FlowType:      string(providers.FlowTypeAuthentication),
+		RuntimeData:   runtimeData,
+		ExpirySeconds: int64(remaining.Seconds()),
+		InitiatorRequest: &providers.InitiatorRequest{
+			Headers:     utils.FilterSensitiveHeaders(request.Headers),
+			QueryParams: request.QueryParams,
+		},
+	})
+	if flowErr != nil {
+		s.logger.Error(ctx, "Failed to initiate device authentication flow",
+			log.String("error_code", flowErr.Code))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process the device verification request",
+		}
+	}
+
+	return map[string]string{
+		oauth2const.AuthID:      record.ID,
+		oauth2const.AppID:       app.ID,
+		oauth2const.ExecutionID: executionID,
+	}, nil
+}
+
+// HandleCallback verifies the flow assertion and applies its outcome to the request. The assertion is
+// either an authentication assertion from a completed flow or a signed error assertion minted when the
+// flow terminated in failure; only the latter carries the flow error type claim, so that claim selects
+// the branch. On success it returns the verification page URL that shows the outcome to the user.
+func (s *deviceService) HandleCallback(
+	ctx context.Context, requestID, assertion string) (string, *DeviceError) {
+	record, deviceErr := s.loadPendingRequestForCallback(ctx, requestID, assertion)
+	if deviceErr != nil {
+		return "", deviceErr
+	}
+
+	claims, authTime, decodeErr := decodeAttributesFromAssertion(assertion)
+	if decodeErr != nil {
+		s.logger.Error(ctx, "Failed to decode assertion claims", log.Error(decodeErr))
+		return "", &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization callback",
+		}
+	}
+
+	status := verificationStatusApproved
+	if claims.flowErrorType != "" {
+		// Cannot fail: the same assertion decoded successfully above.
+		errClaims, _ := oauth2utils.DecodeFlowErrorAssertionClaims(assertion)
+		status, deviceErr = s.handleFailedCallback(ctx, record, errClaims)
+	} else {
+		deviceErr = s.handleSuccessCallback(ctx, record, claims, authTime)
+	}
+	if deviceErr != nil {
+		return "", deviceErr
+	}
+
+	completionURI, err := oauth2utils.GetURIWithQueryParams(
+		s.cfg.BaseURL+oauth2const.OAuth2DeviceVerificationEndpoint,
+		map[string]string{verificationStatusParam: status})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to construct device verification completion URI", log.Error(err))
+		return "", &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization callback",
+		}
+	}
+	return completionURI, nil
+}
+
+// loadPendingRequestForCallback loads the request a flow callback refers to and verifies the assertion
+// against it: the request must exist, still be pending and unexpired, and the assertion must carry a
+// valid signature for the owning client's audience. Shared by the success and failure callbacks.
+func (s *deviceService) loadPendingRequestForCallback(
+	ctx context.Context, requestID, assertion string) (*DeviceAuthRequest, *DeviceError) {
+	if requestID == "" || assertion == "" {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "authId and assertion are required",
+		}
+	}
+
+	record, err := s.store.GetByID(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, ErrDeviceRequestNotFound) {
+			return nil, &DeviceError{
+				Code:    oauth2const.ErrorInvalidRequest,
+				Message: "Invalid authId",
+			}
+		}
+		s.logger.Error(ctx, "Failed to retrieve device authorization request", log.Error(err))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization callback",
+		}
+	}
+
+	if record.State != DeviceStatePending {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Device authorization request is not pending",
+		}
+	}
+	if record.ExpiryTime.Before(time.Now()) {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorExpiredToken,
+			Message: "Device authorization request has expired",
+		}
+	}
+
+	// Resolve the owning client to obtain the expected audience (the app entity ID used as the
+	// assertion `aud`) for defense-in-depth audience validation during signature verification.
+	expectedAud := s.resolveExpectedAudience(ctx, record.ClientID)
+
+	if verifyErr := s.jwtService.VerifyJWT(ctx, assertion, expectedAud, ""); verifyErr != nil {
+		s.logger.Debug(ctx, "Assertion verification failed",
+			log.String("error", verifyErr.Error.DefaultValue))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Invalid assertion signature",
+		}
+	}
+
+	return record, nil
+}
+
+// handleSuccessCallback enforces the request binding of a verified authentication assertion and marks
+// the request authenticated.
+func (s *deviceService) handleSuccessCallback(ctx context.Context, record *DeviceAuthRequest,
+	claims assertionClaims, authTime time.Time) *DeviceError {
+	// Bind the assertion to this specific device request. The request ID is threaded through the flow
+	// runtime data into the assertion as the authorization_request_id claim; requiring it to match
+	// prevents an assertion minted for one request from authorizing another.
+	if claims.authReqID == "" || claims.authReqID != record.ID {
+		s.logger.Debug(ctx, "Assertion is not bound to the device authorization request")
+		return &DeviceError{
+			Code:    oauth2const.ErrorAccessDenied,
+			Message: "Assertion does not match the device authorization request",
+		}
+	}
+	if claims.userID == "" {
+		s.logger.Debug(ctx, "Assertion subject is missing")
+		return &DeviceError{
+			Code:    oauth2const.ErrorAccessDenied,
+			Message: "Assertion subject is missing",
+		}
+	}
+
+	if authTime.IsZero() {
+		authTime = time.Now()
+	}
+
+	// StandardScopes (OIDC) were stored at initiation; authorized_permissions from the assertion
+	// replaces the requested permission scopes.
+	authorizedScopes := utils.StringifyStringArray(
+		append(utils.ParseStringArray(record.StandardScopes, " "),
+			utils.ParseStringArray(claims.authorizedPermissions, " ")...),
+		" ")
+
+	if markErr := s.store.MarkAuthenticated(ctx, record.ID, claims.userID, authorizedScopes,
+		claims.attributeCacheID, claims.completedACR, authTime); markErr != nil {
+		s.logger.Error(ctx, "Failed to mark device authorization request as authenticated",
+			log.Error(markErr))
+		return &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization callback",
+		}
+	}
+
+	return nil
+}
+
+// handleFailedCallback marks the request DENIED (end-user failure) or FAILED (server-side failure) for a
+// verified error assertion, so the polling token endpoint returns access_denied or a 500. It returns the
+// verification page status to show the user.
+func (s *deviceService) handleFailedCallback(ctx context.Context, record *DeviceAuthRequest,
+	claims oauth2utils.FlowErrorAssertionClaims) (string, *DeviceError) {
+	if claims.AuthorizationRequestID != record.ID {
+		s.logger.Debug(ctx, "Error assertion is not bound to the device authorization request")
+		return "", &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Error assertion does not match the device authorization request",
+		}
+	}
+
+	targetState, status := DeviceStateFailed, verificationStatusFailed
+	if claims.ErrorType == flowcm.FlowErrorTypeEndUser {
+		targetState, status = DeviceStateDenied, verificationStatusDenied
+	} else if !s.cfg.OAuth.SendServerErrorsToClientEnabled() {
+		// The deployment opts out of reporting server errors. The request is left pending for the
+		// polling client to time out on.
+		s.logger.Debug(ctx, "Not failing device authorization request on a server error",
+			log.String("flowErrorType", claims.ErrorType))
+		return status, nil
+	}
+	s.logger.Debug(ctx, "Failing device authorization request",
+		log.String("state", string(targetState)),
+		log.String("flowErrorType", claims.ErrorType),
+		log.String("flowErrorDescription", claims.Description))
+	if err := s.store.UpdateState(ctx, record.ID, targetState); err != nil {
+		s.logger.Error(ctx, "Failed to update device authorization request state",
+			log.String("state", string(targetState)), log.Error(err))
+		return "", &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization callback",
+		}
+	}
+	return status, nil
+}
+
+// resolveExpectedAudience resolves the app entity ID for the given client ID, which the flow uses as the
+// assertion `aud`. It returns an empty string (skipping the audience check) on lookup failure; the
+// authorization_request_id binding remains the primary protection in that case.
+func (s *deviceService) resolveExpectedAudience(ctx context.Context, clientID string) string {
+	app, svcErr := s.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+	if svcErr != nil {
+		s.logger.Warn(ctx, "Failed to resolve client for audience validation; skipping audience check",
+			log.String("error", svcErr.Error.DefaultValue))
+		return ""
+	}
+	if app == nil {
+		return ""
+	}
+	return app.ID
+}
+
+// resolveExpiresIn returns the configured device authorization request lifetime, defaulting when unset.
+func (s *deviceService) resolveExpiresIn() int64 {
+	if s.cfg.OAuth.DeviceCode.ExpiresIn > 0 {
+		return s.cfg.OAuth.DeviceCode.ExpiresIn
+	}
+	return oauth2const.DeviceCodeDefaultExpiresInSeconds
+}
+
+// resolveInterval returns the configured minimum polling interval, defaulting when unset.
+func (s *deviceService) resolveInterval() int64 {
+	if s.cfg.OAuth.DeviceCode.Interval > 0 {
+		return s.cfg.OAuth.DeviceCode.Interval
+	}
+	return oauth2const.DeviceCodeDefaultIntervalSeconds
+}
+
+// resolveUserAttributesCacheTTL determines the TTL for caching user attributes during the flow. It
+// mirrors the CIBA path: the largest of the access and refresh token (if allowed) validity periods,
+// plus the device request lifetime to cover the poll window, plus a fixed buffer.
+func (s *deviceService) resolveUserAttributesCacheTTL(app *providers.OAuthClient) int64 {
+	maxTTL := tokenservice.ResolveTokenConfig(
+		s.cfg, app, tokenservice.TokenTypeAccess, app.UserAccessTokenConfig().ValidityPeriodOrZero()).ValidityPeriod
+	if app.IsAllowedGrantType(providers.GrantTypeRefreshToken) {
+		refreshTTL := tokenservice.ResolveTokenConfig(s.cfg, app, tokenservice.TokenTypeRefresh, 0).ValidityPeriod
+		if refreshTTL > maxTTL {
+			maxTTL = refreshTTL
+		}
+	}
+	return maxTTL + s.resolveExpiresIn() + oauth2const.AttributeCacheTTLBufferSeconds
+}
+
+// GetByDeviceCode retrieves a device authorization request by the device_code issued to the client.
+// Used by the grant handler at the token endpoint to check request state without exposing the store.
+func (s *deviceService) GetByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuthRequest, error) {
+	if deviceCode == "" {
+		return nil, ErrDeviceRequestNotFound
+	}
+	return s.store.GetByID(ctx, cryptolib.HashToken(deviceCode))
+}
+
+// UpdateLastPolled records the last poll time and the polling interval now in effect for the request.
+// It returns ErrDevicePollConflict when another poll recorded a poll time after previousPolledAt was read.
+func (s *deviceService) UpdateLastPolled(ctx context.Context, requestID string, previousPolledAt,
+	polledAt time.Time, interval int64) error {
+	return s.store.UpdateLastPolled(ctx, requestID, previousPolledAt, polledAt, interval)
+}
+
+// UpdateState updates the state of a device authorization request.
+func (s *deviceService) UpdateState(ctx context.Context, requestID string, state DeviceRequestState) error {
+	return s.store.UpdateState(ctx, requestID, state)
+}
+
+// MarkConsumed atomically transitions an authenticated request to consumed.
+func (s *deviceService) MarkConsumed(ctx context.Context, requestID string) (bool, error) {
+	return s.store.MarkConsumed(ctx, requestID)
+}
+
+// decodeAttributesFromAssertion decodes claims from the flow assertion JWT using the shared base
+// decoder, then extracts the request binding and permission claims.
+func decodeAttributesFromAssertion(assertion string) (assertionClaims, time.Time, error) {
+	base, payload, err := oauth2utils.DecodeFlowAssertionClaims(assertion)
+	if err != nil {
+		return assertionClaims{}, time.Time{}, fmt.Errorf("failed to decode assertion: %w", err)
+	}
+
+	claims := assertionClaims{
+		userID:           base.UserID,
+		attributeCacheID: base.AttributeCacheID,
+		completedACR:     base.CompletedACR,
+	}
+
+	if v, ok := payload[oauth2const.ClaimAuthorizationRequestID]; ok {
+		strValue, ok := v.(string)
+		if !ok {
+			return assertionClaims{}, time.Time{}, errors.New("JWT 'authorization_request_id' claim is not a string")
+		}
+		claims.authReqID = strValue
+	}
+
+	if v, ok := payload["authorized_permissions"].(string); ok {
+		claims.authorizedPermissions = v
+	}
+
+	if v, ok := payload[flowcm.ClaimFlowErrorType].(string); ok {
+		claims.flowErrorType = v
+	}
+
+	return claims, base.AuthTime, nil
+}
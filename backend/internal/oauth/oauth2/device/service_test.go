@@ -0,0 +1,549 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/actorprovider"
+	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+	"github.com/thunder-id/thunderid/tests/testhelpers"
+)
+
+const testUserID = "user-1"
+
+type DeviceServiceTestSuite struct {
+	suite.Suite
+	mockStore          *DeviceRequestStoreInterfaceMock
+	mockFlowExec       *flowexecmock.FlowExecServiceInterfaceMock
+	mockJWTService     *jwtmock.JWTServiceInterfaceMock
+	mockInboundClient  *inboundclientmock.InboundClientServiceInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockResourceSvc    *resourcemock.ResourceServiceInterfaceMock
+	service            DeviceServiceInterface
+	oauthApp           *providers.OAuthClient
+}
+
+func TestDeviceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceServiceTestSuite))
+}
+
+func (suite *DeviceServiceTestSuite) SetupTest() {
+	_ = config.InitializeServerRuntime("test", &config.Config{})
+
+	suite.mockStore = NewDeviceRequestStoreInterfaceMock(suite.T())
+	suite.mockFlowExec = flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockResourceSvc = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.service = suite.newService(testhelpers.OAuthConfig())
+	suite.oauthApp = &providers.OAuthClient{
+		ID:         "app-1",
+		ClientID:   "client-1",
+		GrantTypes: []providers.GrantType{providers.GrantTypeDeviceCode},
+		ScopeClaims: map[string][]string{
+			"openid":  {"sub"},
+			"profile": {"name"},
+		},
+	}
+}
+
+func (suite *DeviceServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// newService builds the service under test with the given OAuth configuration.
+func (suite *DeviceServiceTestSuite) newService(cfg oauthconfig.Config) DeviceServiceInterface {
+	actorProv := actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider,
+		&managermock.AuthnProviderManagerMock{}, nil)
+	return newDeviceService(suite.mockStore, suite.mockFlowExec, suite.mockJWTService, actorProv,
+		suite.mockResourceSvc, cfg)
+}
+
+// -------------------------------------------------------------------
+// InitiateDeviceAuthorization tests
+// -------------------------------------------------------------------
+
+func (suite *DeviceServiceTestSuite) TestInitiate_Success() {
+	var stored *DeviceAuthRequest
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.AnythingOfType("*device.DeviceAuthRequest")).
+		Run(func(_ context.Context, r *DeviceAuthRequest) { stored = r }).Return(nil)
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid profile"}, suite.oauthApp)
+
+	suite.Nil(deviceErr)
+	suite.Require().NotNil(resp)
+	suite.Require().NotNil(stored)
+	suite.NotEmpty(resp.DeviceCode)
+	suite.Equal(cryptolib.HashToken(resp.DeviceCode), stored.ID)
+	suite.NotEqual(resp.DeviceCode, stored.ID)
+	suite.Equal("client-1", stored.ClientID)
+	suite.Equal("openid profile", stored.StandardScopes)
+	suite.Equal(DeviceStatePending, stored.State)
+	suite.Equal(formatUserCode(stored.UserCode), resp.UserCode)
+	suite.Equal("https://thunder.io/oauth2/device", resp.VerificationURI)
+	suite.Equal(
+		resp.VerificationURI+"?"+url.Values{"user_code": {resp.UserCode}}.Encode(), resp.VerificationURIComplete)
+	suite.Equal(int64(oauth2const.DeviceCodeDefaultExpiresInSeconds), resp.ExpiresIn)
+	suite.Equal(int64(oauth2const.DeviceCodeDefaultIntervalSeconds), resp.Interval)
+	suite.Equal(resp.Interval, stored.Interval)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_ConfiguredLifetimeAndInterval() {
+	cfg := testhelpers.OAuthConfig()
+	cfg.OAuth.DeviceCode.ExpiresIn = 300
+	cfg.OAuth.DeviceCode.Interval = 10
+	suite.service = suite.newService(cfg)
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.MatchedBy(func(r *DeviceAuthRequest) bool {
+		return r.Interval == 10 && time.Until(r.ExpiryTime) <= 300*time.Second
+	})).Return(nil)
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid"}, suite.oauthApp)
+
+	suite.Nil(deviceErr)
+	suite.Equal(int64(300), resp.ExpiresIn)
+	suite.Equal(int64(10), resp.Interval)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_RetriesOnUserCodeCollision() {
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.Anything).Return(errUserCodeCollision).Once()
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.Anything).Return(nil).Once()
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid"}, suite.oauthApp)
+
+	suite.Nil(deviceErr)
+	suite.NotNil(resp)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_UserCodeCollisionsExhausted() {
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.Anything).Return(errUserCodeCollision).
+		Times(maxUserCodeAttempts)
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid"}, suite.oauthApp)
+
+	suite.Nil(resp)
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorServerError, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_StorePersistenceFails() {
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid"}, suite.oauthApp)
+
+	suite.Nil(resp)
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorServerError, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_UnauthorizedClient() {
+	suite.oauthApp.GrantTypes = []providers.GrantType{providers.GrantTypeAuthorizationCode}
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid"}, suite.oauthApp)
+
+	suite.Nil(resp)
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorUnauthorizedClient, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_ExplicitResourceBindsAndDownscopes() {
+	suite.mockResourceSvc.EXPECT().GetResourceServerByIdentifier(mock.Anything, "https://api.example.com").
+		Return(&providers.ResourceServer{ID: "rs-1", Identifier: "https://api.example.com"}, nil)
+	suite.mockResourceSvc.EXPECT().ValidatePermissions(mock.Anything, "rs-1", []string{"read"}).
+		Return([]string{}, nil)
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.MatchedBy(func(r *DeviceAuthRequest) bool {
+		return r.PermissionScopes == "read" &&
+			len(r.Resources) == 1 && r.Resources[0] == "https://api.example.com"
+	})).Return(nil)
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid read", Resources: []string{"https://api.example.com"}},
+		suite.oauthApp)
+
+	suite.Nil(deviceErr)
+	suite.NotNil(resp)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_MultipleResourcesRejected() {
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "read", Resources: []string{"https://a.example", "https://b.example"}},
+		suite.oauthApp)
+
+	suite.Nil(resp)
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidTarget, deviceErr.Code)
+}
+
+// -------------------------------------------------------------------
+// InitiateVerification tests
+// -------------------------------------------------------------------
+
+func (suite *DeviceServiceTestSuite) pendingRecord() *DeviceAuthRequest {
+	return &DeviceAuthRequest{
+		ID:             "request-1",
+		UserCode:       "BCDFGHJK",
+		ClientID:       "client-1",
+		StandardScopes: "openid profile",
+		State:          DeviceStatePending,
+		ExpiryTime:     time.Now().Add(5 * time.Minute),
+	}
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiateVerification_Success() {
+	suite.oauthApp.AcrValues = []string{"urn:acr:pwd", "urn:acr:mfa"}
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, "BCDFGHJK").Return(suite.pendingRecord(), nil)
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-1").
+		Return(suite.oauthApp, nil)
+	suite.mockFlowExec.EXPECT().InitiateFlow(mock.Anything, mock.MatchedBy(
+		func(initCtx *flowexec.FlowInitContext) bool {
+			rd := initCtx.RuntimeData
+			return initCtx.ApplicationID == "app-1" &&
+				initCtx.FlowType == string(providers.FlowTypeAuthentication) &&
+				initCtx.ExpirySeconds > 0 && initCtx.ExpirySeconds <= 300 &&
+				rd[flowcm.RuntimeKeyAuthorizationRequestID] == "request-1" &&
+				rd[flowcm.RuntimeKeyCallbackType] == string(providers.GrantTypeDeviceCode) &&
+				rd[flowcm.RuntimeKeyForceConsentReprompt] == "true" &&
+				rd[flowcm.RuntimeKeyRequestedAuthClasses] == "urn:acr:pwd urn:acr:mfa"
+		})).Return("exec-1", nil)
+
+	// User input is normalized: lowercase and separators are accepted.
+	params, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: "bcdf-ghjk"})
+
+	suite.Nil(deviceErr)
+	suite.Equal(map[string]string{
+		oauth2const.AuthID:      "request-1",
+		oauth2const.AppID:       "app-1",
+		oauth2const.ExecutionID: "exec-1",
+	}, params)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiateVerification_MalformedCode() {
+	params, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: "ABC"})
+
+	suite.Nil(params)
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiateVerification_UnknownCode() {
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, "BCDFGHJK").Return(nil, ErrDeviceRequestNotFound)
+
+	params, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: "BCDF-GHJK"})
+
+	suite.Nil(params)
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiateVerification_StoreError() {
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, "BCDFGHJK").Return(nil, errors.New("db error"))
+
+	_, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: "BCDF-GHJK"})
+
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorServerError, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiateVerification_NotPendingOrExpired() {
+	notPending := suite.pendingRecord()
+	notPending.State = DeviceStateAuthenticated
+	expired := suite.pendingRecord()
+	expired.ExpiryTime = time.Now().Add(-time.Second)
+
+	for name, record := range map[string]*DeviceAuthRequest{"NotPending": notPending, "Expired": expired} {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.mockStore.EXPECT().GetByUserCode(mock.Anything, "BCDFGHJK").Return(record, nil)
+
+			_, deviceErr := suite.service.InitiateVerification(context.Background(),
+				&VerificationRequest{UserCode: "BCDFGHJK"})
+
+			suite.Require().NotNil(deviceErr)
+			suite.Equal(oauth2const.ErrorExpiredToken, deviceErr.Code)
+		})
+	}
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiateVerification_FlowInitiationFails() {
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, "BCDFGHJK").Return(suite.pendingRecord(), nil)
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-1").
+		Return(suite.oauthApp, nil)
+	suite.mockFlowExec.EXPECT().InitiateFlow(mock.Anything, mock.Anything).
+		Return("", &tidcommon.ServiceError{Code: "FES-1"})
+
+	_, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: "BCDFGHJK"})
+
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorServerError, deviceErr.Code)
+}
+
+// -------------------------------------------------------------------
+// HandleCallback tests
+// -------------------------------------------------------------------
+
+func (suite *DeviceServiceTestSuite) expectVerifiedAssertion(assertion string) {
+	suite.mockStore.EXPECT().GetByID(mock.Anything, "request-1").Return(suite.pendingRecord(), nil)
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-1").
+		Return(&providers.OAuthClient{ID: "app-1", ClientID: "client-1"}, nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, assertion, "app-1", "").Return(nil)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_Success() {
+	iat := time.Now().Unix()
+	assertion := buildTestAssertion(map[string]interface{}{
+		"sub":                      testUserID,
+		"aci":                      "cache-1",
+		"completed_auth_class":     "urn:acr:pwd",
+		"authorization_request_id": "request-1",
+		"authorized_permissions":   "read",
+		"iat":                      float64(iat),
+	})
+	suite.expectVerifiedAssertion(assertion)
+	suite.mockStore.EXPECT().MarkAuthenticated(mock.Anything, "request-1", testUserID,
+		"openid profile read", "cache-1", "urn:acr:pwd",
+		mock.MatchedBy(func(authTime time.Time) bool { return authTime.Unix() == iat })).Return(nil)
+
+	uri, deviceErr := suite.service.HandleCallback(context.Background(), "request-1", assertion)
+
+	suite.Nil(deviceErr)
+	suite.Equal("https://thunder.io/oauth2/device?status=approved", uri)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_BindingMismatch() {
+	assertion := buildTestAssertion(map[string]interface{}{
+		"sub":                      testUserID,
+		"authorization_request_id": "other-request",
+	})
+	suite.expectVerifiedAssertion(assertion)
+
+	uri, deviceErr := suite.service.HandleCallback(context.Background(), "request-1", assertion)
+
+	suite.Empty(uri)
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorAccessDenied, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_SubMissing() {
+	assertion := buildTestAssertion(map[string]interface{}{
+		"authorization_request_id": "request-1",
+	})
+	suite.expectVerifiedAssertion(assertion)
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), "request-1", assertion)
+
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorAccessDenied, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_MissingParams() {
+	_, deviceErr := suite.service.HandleCallback(context.Background(), "", "assertion")
+
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_RequestNotFound() {
+	suite.mockStore.EXPECT().GetByID(mock.Anything, "missing").Return(nil, ErrDeviceRequestNotFound)
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), "missing", "assertion")
+
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_NotPending() {
+	record := suite.pendingRecord()
+	record.State = DeviceStateConsumed
+	suite.mockStore.EXPECT().GetByID(mock.Anything, "request-1").Return(record, nil)
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), "request-1", "assertion")
+
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_Expired() {
+	record := suite.pendingRecord()
+	record.ExpiryTime = time.Now().Add(-time.Minute)
+	suite.mockStore.EXPECT().GetByID(mock.Anything, "request-1").Return(record, nil)
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), "request-1", "assertion")
+
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorExpiredToken, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_BadSignature() {
+	suite.mockStore.EXPECT().GetByID(mock.Anything, "request-1").Return(suite.pendingRecord(), nil)
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-1").
+		Return(&providers.OAuthClient{ID: "app-1", ClientID: "client-1"}, nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, "bad-assertion", "app-1", "").
+		Return(&tidcommon.ServiceError{Code: "JWT-1"})
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), "request-1", "bad-assertion")
+
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_MarkAuthenticatedError() {
+	assertion := buildTestAssertion(map[string]interface{}{
+		"sub":                      testUserID,
+		"authorization_request_id": "request-1",
+	})
+	suite.expectVerifiedAssertion(assertion)
+	suite.mockStore.EXPECT().MarkAuthenticated(mock.Anything, "request-1", testUserID,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("not pending"))
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), "request-1", assertion)
+
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorServerError, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_Failure_TransitionsStateByErrorType() {
+	tests := []struct {
+		name           string
+		errorType      string
+		expectedState  DeviceRequestState
+		expectedStatus string
+	}{
+		{"EndUserErrorDenies", flowcm.FlowErrorTypeEndUser, DeviceStateDenied, verificationStatusDenied},
+		{"ServerErrorFails", flowcm.FlowErrorTypeServer, DeviceStateFailed, verificationStatusFailed},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			enabled := true
+			cfg := testhelpers.OAuthConfig()
+			cfg.OAuth.SendServerErrorsToClient = &enabled
+			suite.service = suite.newService(cfg)
+
+			assertion := buildErrorAssertion("request-1", tt.errorType)
+			suite.expectVerifiedAssertion(assertion)
+			suite.mockStore.EXPECT().UpdateState(mock.Anything, "request-1", tt.expectedState).Return(nil)
+
+			uri, deviceErr := suite.service.HandleCallback(context.Background(), "request-1", assertion)
+
+			suite.Nil(deviceErr)
+			suite.Equal("https://thunder.io/oauth2/device?status="+tt.expectedStatus, uri)
+		})
+	}
+}
+
+// TestCallback_Failure_ServerErrorsNotReported verifies that with oauth.send_server_errors_to_client
+// disabled, a server-side failure leaves the request pending for the polling client to time out on.
+func (suite *DeviceServiceTestSuite) TestCallback_Failure_ServerErrorsNotReported() {
+	assertion := buildErrorAssertion("request-1", flowcm.FlowErrorTypeServer)
+	suite.expectVerifiedAssertion(assertion)
+
+	uri, deviceErr := suite.service.HandleCallback(context.Background(), "request-1", assertion)
+
+	suite.Nil(deviceErr)
+	suite.Equal("https://thunder.io/oauth2/device?status=failed", uri)
+	suite.mockStore.AssertNotCalled(suite.T(), "UpdateState", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_Failure_BindingMismatch() {
+	assertion := buildErrorAssertion("other-request", flowcm.FlowErrorTypeEndUser)
+	suite.expectVerifiedAssertion(assertion)
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), "request-1", assertion)
+
+	suite.Require().NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+// -------------------------------------------------------------------
+// Polling operation tests
+// -------------------------------------------------------------------
+
+func (suite *DeviceServiceTestSuite) TestGetByDeviceCode_LooksUpByDigest() {
+	suite.mockStore.EXPECT().GetByID(mock.Anything, cryptolib.HashToken("raw-device-code")).
+		Return(suite.pendingRecord(), nil)
+
+	record, err := suite.service.GetByDeviceCode(context.Background(), "raw-device-code")
+
+	suite.NoError(err)
+	suite.NotNil(record)
+}
+
+func (suite *DeviceServiceTestSuite) TestGetByDeviceCode_Empty() {
+	record, err := suite.service.GetByDeviceCode(context.Background(), "")
+
+	suite.ErrorIs(err, ErrDeviceRequestNotFound)
+	suite.Nil(record)
+}
+
+// -------------------------------------------------------------------
+// User code helper tests
+// -------------------------------------------------------------------
+
+func (suite *DeviceServiceTestSuite) TestGenerateUserCode_UsesAlphabet() {
+	code, err := generateUserCode()
+
+	suite.NoError(err)
+	suite.Len(code, userCodeLength)
+	suite.Equal(code, normalizeUserCode(code))
+}
+
+func (suite *DeviceServiceTestSuite) TestFormatAndNormalizeUserCode() {
+	suite.Equal("BCDF-GHJK", formatUserCode("BCDFGHJK"))
+	suite.Equal("BCDFGHJK", normalizeUserCode(" bcdf-ghjk "))
+	// Vowels and digits are outside the alphabet and are dropped.
+	suite.Equal("BCD", normalizeUserCode("ab1cd"))
+}
+
+// buildTestAssertion builds a JWT-shaped string (header.payload.signature) for decode-path testing.
+// Signature verification is mocked, so the signature segment is a placeholder.
+func buildTestAssertion(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]interface{}{"alg": "RS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(header) + "." + enc.EncodeToString(payload) + "." + enc.EncodeToString([]byte("sig"))
+}
+
+// buildErrorAssertion builds a flow error assertion bound to requestID.
+func buildErrorAssertion(requestID, errorType string) string {
+	return buildTestAssertion(map[string]interface{}{
+		flowcm.ClaimAuthorizationRequestID: requestID,
+		flowcm.ClaimFlowErrorType:          errorType,
+	})
+}
@@ -0,0 +1,267 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// deviceVersionField is the JSON field name that guards every write to a stored request. It must match
+// the marshaled field name of DeviceAuthRequest.Version.
+const deviceVersionField = "Version"
+
+// maxUpdateAttempts bounds how often a write re-reads the request when it changes between the read and
+// the write.
+const maxUpdateAttempts = 3
+
+// DeviceRequestStoreInterface defines the interface for device authorization request storage.
+type DeviceRequestStoreInterface interface {
+	Add(ctx context.Context, request *DeviceAuthRequest) error
+	GetByID(ctx context.Context, requestID string) (*DeviceAuthRequest, error)
+	GetByUserCode(ctx context.Context, userCode string) (*DeviceAuthRequest, error)
+	MarkAuthenticated(ctx context.Context, requestID, userID, authorizedScopes, attributeCacheID,
+		completedACR string, authTime time.Time) error
+	MarkConsumed(ctx context.Context, requestID string) (bool, error)
+	UpdateLastPolled(ctx context.Context, requestID string, previousPolledAt, polledAt time.Time,
+		interval int64) error
+	UpdateState(ctx context.Context, requestID string, state DeviceRequestState) error
+}
+
+// deviceStore adapts a runtime store provider to device authorization request storage. Requests are
+// stored under the device code namespace keyed by request ID, and a secondary user code namespace maps
+// the user code shown to the end user back to the request ID.
+type deviceStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newDeviceStore creates a device request store backed by the given runtime store provider.
+func newDeviceStore(store providers.RuntimeStoreProvider) DeviceRequestStoreInterface {
+	return &deviceStore{store: store}
+}
+
+// Add inserts a new device authorization request and its user code index with a TTL derived from the
+// expiry time. It returns errUserCodeCollision when the user code is already bound to a live request,
+// so the caller can retry with a fresh code.
+func (s *deviceStore) Add(ctx context.Context, request *DeviceAuthRequest) error {
+	ttl := time.Until(request.ExpiryTime)
+	if ttl <= 0 {
+		return fmt.Errorf("device authorization request already expired")
+	}
+	ttlSeconds := int64(ttl.Seconds())
+
+	index, err := json.Marshal(userCodeEntry{RequestID: request.ID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal user code entry: %w", err)
+	}
+	stored, err := s.store.PutIfNotExists(ctx, providers.NamespaceDeviceUserCode, request.UserCode, index,
+		ttlSeconds)
+	if err != nil {
+		return fmt.Errorf("failed to store user code: %w", err)
+	}
+	if !stored {
+		return errUserCodeCollision
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device authorization request: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceDeviceCode, request.ID, data, ttlSeconds)
+}
+
+// GetByID retrieves a device authorization request by ID. Returns ErrDeviceRequestNotFound if absent.
+func (s *deviceStore) GetByID(ctx context.Context, requestID string) (*DeviceAuthRequest, error) {
+	if requestID == "" {
+		return nil, ErrDeviceRequestNotFound
+	}
+
+	data, err := s.store.Get(ctx, providers.NamespaceDeviceCode, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device authorization request: %w", err)
+	}
+	if data == nil {
+		return nil, ErrDeviceRequestNotFound
+	}
+
+	var request DeviceAuthRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal device authorization request: %w", err)
+	}
+	return &request, nil
+}
+
+// GetByUserCode resolves a normalized user code to its device authorization request. Returns
+// ErrDeviceRequestNotFound if the code is unknown or expired.
+func (s *deviceStore) GetByUserCode(ctx context.Context, userCode string) (*DeviceAuthRequest, error) {
+	if userCode == "" {
+		return nil, ErrDeviceRequestNotFound
+	}
+
+	data, err := s.store.Get(ctx, providers.NamespaceDeviceUserCode, userCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user code: %w", err)
+	}
+	if data == nil {
+		return nil, ErrDeviceRequestNotFound
+	}
+
+	var entry userCodeEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user code entry: %w", err)
+	}
+	return s.GetByID(ctx, entry.RequestID)
+}
+
+// MarkAuthenticated transitions a pending request to authenticated and records the user ID (from the
+// assertion sub claim), authorized scopes, attribute cache ID, completed ACR, and authentication time.
+// The versioned write prevents two browsers that entered the same user code from both completing the
+// request. The user code is released once the transition succeeds.
+func (s *deviceStore) MarkAuthenticated(ctx context.Context, requestID, userID,
+	authorizedScopes, attributeCacheID, completedACR string, authTime time.Time) error {
+	var userCode string
+	err := s.update(ctx, requestID, func(record *DeviceAuthRequest) error {
+		if record.State != DeviceStatePending {
+			return fmt.Errorf("device authorization request %s is not pending", requestID)
+		}
+		record.State = DeviceStateAuthenticated
+		record.UserID = userID
+		record.AuthorizedScopes = authorizedScopes
+		record.AttributeCacheID = attributeCacheID
+		record.CompletedACR = completedACR
+		record.AuthTime = authTime
+		userCode = record.UserCode
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.releaseUserCode(ctx, userCode)
+	return nil
+}
+
+// MarkConsumed atomically transitions an authenticated request to consumed. It returns false when
+// the request is not in the AUTHENTICATED state, enabling one-time-use enforcement under concurrent
+// polling.
+func (s *deviceStore) MarkConsumed(ctx context.Context, requestID string) (bool, error) {
+	err := s.update(ctx, requestID, func(record *DeviceAuthRequest) error {
+		if record.State != DeviceStateAuthenticated {
+			return errDeviceRequestStateChanged
+		}
+		record.State = DeviceStateConsumed
+		return nil
+	})
+	if errors.Is(err, ErrDeviceRequestNotFound) || errors.Is(err, errDeviceRequestStateChanged) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UpdateLastPolled records the last poll time and the polling interval now in effect for the request.
+// previousPolledAt is the last poll time the caller read; when another poll has recorded a different
+// time since then, ErrDevicePollConflict is returned so only one of the racing polls is accepted. A
+// concurrent state change alone does not fail the update: the poll fields are re-applied to the fresh
+// record instead of writing a stale pending record over the state the callback just set.
+func (s *deviceStore) UpdateLastPolled(ctx context.Context, requestID string, previousPolledAt,
+	polledAt time.Time, interval int64) error {
+	return s.update(ctx, requestID, func(record *DeviceAuthRequest) error {
+		if !record.LastPolledAt.Equal(previousPolledAt) {
+			return ErrDevicePollConflict
+		}
+		record.LastPolledAt = polledAt
+		record.Interval = interval
+		return nil
+	})
+}
+
+// UpdateState transitions a device authorization request to the given state. The transition fails with
+// errDeviceRequestStateChanged rather than undoing a concurrent transition out of the state the request
+// was first read in. Leaving the pending state also releases the user code so it can no longer be
+// entered on the verification page.
+func (s *deviceStore) UpdateState(ctx context.Context, requestID string, state DeviceRequestState) error {
+	var current DeviceRequestState
+	var userCode string
+	err := s.update(ctx, requestID, func(record *DeviceAuthRequest) error {
+		if current == "" {
+			current = record.State
+		}
+		if record.State != current {
+			return errDeviceRequestStateChanged
+		}
+		if record.State == state {
+			return errDeviceRequestUnchanged
+		}
+		record.State = state
+		userCode = record.UserCode
+		return nil
+	})
+	if errors.Is(err, errDeviceRequestUnchanged) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if state != DeviceStatePending {
+		s.releaseUserCode(ctx, userCode)
+	}
+	return nil
+}
+
+// update reads the request, applies mutate and writes the result back only when the stored request
+// still has the version that was read, preserving its TTL. Every write bumps the version, so a write
+// based on a stale read is retried against the fresh record, up to maxUpdateAttempts times. An error
+// from mutate aborts the update and is returned as is.
+func (s *deviceStore) update(ctx context.Context, requestID string,
+	mutate func(record *DeviceAuthRequest) error) error {
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		record, err := s.GetByID(ctx, requestID)
+		if err != nil {
+			return err
+		}
+		if err := mutate(record); err != nil {
+			return err
+		}
+
+		expected := record.Version
+		record.Version = nextVersion(expected)
+		data, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("failed to marshal device authorization request: %w", err)
+		}
+		swapped, err := s.store.CompareFieldAndSwap(ctx, providers.NamespaceDeviceCode, requestID,
+			deviceVersionField, expected, data)
+		if err != nil {
+			return fmt.Errorf("failed to update device authorization request: %w", err)
+		}
+		if swapped {
+			return nil
+		}
+	}
+	return errDeviceRequestStateChanged
+}
+
+// nextVersion returns the version of the request that replaces one stored with version current.
+func nextVersion(current string) string {
+	version, _ := strconv.Atoi(current)
+	return strconv.Itoa(version + 1)
+}
+
+// releaseUserCode removes the user code index entry. Failures are ignored: the entry expires with the
+// request, and a stale entry only resolves to a request that is no longer pending.
+func (s *deviceStore) releaseUserCode(ctx context.Context, userCode string) {
+	if userCode == "" {
+		return
+	}
+	_ = s.store.Delete(ctx, providers.NamespaceDeviceUserCode, userCode)
+}
@@ -0,0 +1,371 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const testDeploymentID = "test-deployment"
+
+// DeviceStoreTestSuite exercises the device store adapter against the in-memory runtime store.
+type DeviceStoreTestSuite struct {
+	suite.Suite
+	store DeviceRequestStoreInterface
+	ctx   context.Context
+}
+
+func TestDeviceStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceStoreTestSuite))
+}
+
+func (s *DeviceStoreTestSuite) SetupTest() {
+	s.store = newDeviceStore(inmemory.Initialize(testDeploymentID))
+	s.ctx = context.Background()
+}
+
+func (s *DeviceStoreTestSuite) sampleRequest() *DeviceAuthRequest {
+	return &DeviceAuthRequest{
+		ID:             "request-1",
+		UserCode:       "BCDFGHJK",
+		ClientID:       "client-1",
+		StandardScopes: "openid profile",
+		Resources:      []string{"https://api.example.com"},
+		State:          DeviceStatePending,
+		Interval:       5,
+		ExpiryTime:     time.Now().Add(10 * time.Minute),
+	}
+}
+
+func (s *DeviceStoreTestSuite) TestNewDeviceStore() {
+	s.NotNil(s.store)
+	s.Implements((*DeviceRequestStoreInterface)(nil), s.store)
+}
+
+func (s *DeviceStoreTestSuite) TestAddAndGetByID_RoundTrip() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	got, err := s.store.GetByID(s.ctx, req.ID)
+	s.NoError(err)
+	s.Require().NotNil(got)
+	s.Equal(req.ID, got.ID)
+	s.Equal(req.UserCode, got.UserCode)
+	s.Equal(req.ClientID, got.ClientID)
+	s.Equal(req.StandardScopes, got.StandardScopes)
+	s.Equal(req.Resources, got.Resources)
+	s.Equal(DeviceStatePending, got.State)
+	s.Equal(int64(5), got.Interval)
+	s.WithinDuration(req.ExpiryTime, got.ExpiryTime, time.Second)
+}
+
+func (s *DeviceStoreTestSuite) TestAdd_AlreadyExpired_ReturnsError() {
+	req := s.sampleRequest()
+	req.ExpiryTime = time.Now().Add(-time.Second)
+
+	s.Error(s.store.Add(s.ctx, req))
+}
+
+func (s *DeviceStoreTestSuite) TestAdd_UserCodeCollision_ReturnsCollisionError() {
+	s.Require().NoError(s.store.Add(s.ctx, s.sampleRequest()))
+
+	other := s.sampleRequest()
+	other.ID = "request-2"
+	s.ErrorIs(s.store.Add(s.ctx, other), errUserCodeCollision)
+
+	_, err := s.store.GetByID(s.ctx, other.ID)
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+}
+
+func (s *DeviceStoreTestSuite) TestGetByID_Missing_ReturnsNotFound() {
+	got, err := s.store.GetByID(s.ctx, "no-such-request")
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+	s.Nil(got)
+}
+
+func (s *DeviceStoreTestSuite) TestGetByID_EmptyID_ReturnsNotFound() {
+	got, err := s.store.GetByID(s.ctx, "")
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+	s.Nil(got)
+}
+
+func (s *DeviceStoreTestSuite) TestGetByUserCode_ResolvesRequest() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	got, err := s.store.GetByUserCode(s.ctx, req.UserCode)
+	s.NoError(err)
+	s.Require().NotNil(got)
+	s.Equal(req.ID, got.ID)
+}
+
+func (s *DeviceStoreTestSuite) TestGetByUserCode_Unknown_ReturnsNotFound() {
+	got, err := s.store.GetByUserCode(s.ctx, "ZZZZZZZZ")
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+	s.Nil(got)
+
+	got, err = s.store.GetByUserCode(s.ctx, "")
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+	s.Nil(got)
+}
+
+func (s *DeviceStoreTestSuite) TestMarkAuthenticated_TransitionsAndReleasesUserCode() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	authTime := time.Now().Truncate(time.Second)
+	err := s.store.MarkAuthenticated(s.ctx, req.ID, "user-1", "openid", "cache-1", "urn:acr", authTime)
+	s.NoError(err)
+
+	got, err := s.store.GetByID(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.Equal(DeviceStateAuthenticated, got.State)
+	s.Equal("user-1", got.UserID)
+	s.Equal("openid", got.AuthorizedScopes)
+	s.Equal("cache-1", got.AttributeCacheID)
+	s.Equal("urn:acr", got.CompletedACR)
+	s.True(authTime.Equal(got.AuthTime))
+
+	_, err = s.store.GetByUserCode(s.ctx, req.UserCode)
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+}
+
+func (s *DeviceStoreTestSuite) TestMarkAuthenticated_Missing_ReturnsNotFound() {
+	err := s.store.MarkAuthenticated(s.ctx, "no-such-request", "u", "", "", "", time.Now())
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+}
+
+func (s *DeviceStoreTestSuite) TestMarkAuthenticated_NotPending_ReturnsError() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+	s.Require().NoError(s.store.UpdateState(s.ctx, req.ID, DeviceStateDenied))
+
+	err := s.store.MarkAuthenticated(s.ctx, req.ID, "u", "", "", "", time.Now())
+	s.Error(err)
+}
+
+// TestMarkAuthenticated_ConcurrentCallbacks verifies that only one of several browsers completing the
+// same user code may transition the pending request.
+func (s *DeviceStoreTestSuite) TestMarkAuthenticated_ConcurrentCallbacks() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	const workers = 10
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	successes := 0
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.store.MarkAuthenticated(s.ctx, req.ID, "user-1", "", "", "", time.Now()); err == nil {
+				mu.Lock()
+				successes++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(1, successes)
+}
+
+func (s *DeviceStoreTestSuite) TestMarkConsumed_OnlyOnce() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+	s.Require().NoError(s.store.MarkAuthenticated(s.ctx, req.ID, "user-1", "", "", "", time.Now()))
+
+	consumed, err := s.store.MarkConsumed(s.ctx, req.ID)
+	s.NoError(err)
+	s.True(consumed)
+
+	consumed, err = s.store.MarkConsumed(s.ctx, req.ID)
+	s.NoError(err)
+	s.False(consumed)
+}
+
+func (s *DeviceStoreTestSuite) TestMarkConsumed_NotAuthenticated_ReturnsFalse() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	consumed, err := s.store.MarkConsumed(s.ctx, req.ID)
+	s.NoError(err)
+	s.False(consumed)
+}
+
+func (s *DeviceStoreTestSuite) TestMarkConsumed_Missing_ReturnsFalse() {
+	consumed, err := s.store.MarkConsumed(s.ctx, "no-such-request")
+	s.NoError(err)
+	s.False(consumed)
+}
+
+func (s *DeviceStoreTestSuite) TestUpdateLastPolled_RecordsTimeAndInterval() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	polledAt := time.Now().Truncate(time.Second)
+	s.Require().NoError(s.store.UpdateLastPolled(s.ctx, req.ID, time.Time{}, polledAt, 10))
+
+	got, err := s.store.GetByID(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.True(polledAt.Equal(got.LastPolledAt))
+	s.Equal(int64(10), got.Interval)
+	s.Equal(DeviceStatePending, got.State)
+}
+
+// TestUpdateLastPolled_ConcurrentCallback verifies that a poll which read the request while it was
+// pending does not write the stale pending state over a verification callback that completed before
+// the poll's write.
+func (s *DeviceStoreTestSuite) TestUpdateLastPolled_ConcurrentCallback() {
+	req := s.sampleRequest()
+	backing := &interleavingStore{RuntimeStoreProvider: inmemory.Initialize(testDeploymentID)}
+	store := newDeviceStore(backing)
+	s.Require().NoError(store.Add(s.ctx, req))
+	backing.beforeSwap = func() {
+		s.Require().NoError(store.MarkAuthenticated(s.ctx, req.ID, "user-1", "openid", "", "", time.Now()))
+	}
+
+	polledAt := time.Now().Truncate(time.Second)
+	s.Require().NoError(store.UpdateLastPolled(s.ctx, req.ID, time.Time{}, polledAt, 10))
+
+	got, err := store.GetByID(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.Equal(DeviceStateAuthenticated, got.State)
+	s.Equal("user-1", got.UserID)
+	s.True(polledAt.Equal(got.LastPolledAt))
+	s.Equal(int64(10), got.Interval)
+}
+
+func (s *DeviceStoreTestSuite) TestUpdateLastPolled_Missing_ReturnsNotFound() {
+	err := s.store.UpdateLastPolled(s.ctx, "no-such-request", time.Time{}, time.Now(), 5)
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+}
+
+// TestUpdateLastPolled_ConcurrentPoll verifies that of two polls which read the same last poll time,
+// only the one that writes first is recorded.
+func (s *DeviceStoreTestSuite) TestUpdateLastPolled_ConcurrentPoll() {
+	req := s.sampleRequest()
+	backing := &interleavingStore{RuntimeStoreProvider: inmemory.Initialize(testDeploymentID)}
+	store := newDeviceStore(backing)
+	s.Require().NoError(store.Add(s.ctx, req))
+	firstPolledAt := time.Now().Truncate(time.Second)
+	backing.beforeSwap = func() {
+		s.Require().NoError(store.UpdateLastPolled(s.ctx, req.ID, time.Time{}, firstPolledAt, 5))
+	}
+
+	err := store.UpdateLastPolled(s.ctx, req.ID, time.Time{}, firstPolledAt.Add(time.Millisecond), 5)
+	s.ErrorIs(err, ErrDevicePollConflict)
+
+	got, err := store.GetByID(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.True(firstPolledAt.Equal(got.LastPolledAt))
+}
+
+func (s *DeviceStoreTestSuite) TestUpdateLastPolled_StalePreviousPoll_Conflicts() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+	polledAt := time.Now().Truncate(time.Second)
+	s.Require().NoError(s.store.UpdateLastPolled(s.ctx, req.ID, time.Time{}, polledAt, 5))
+
+	err := s.store.UpdateLastPolled(s.ctx, req.ID, time.Time{}, polledAt.Add(time.Second), 10)
+	s.ErrorIs(err, ErrDevicePollConflict)
+
+	got, err := s.store.GetByID(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.True(polledAt.Equal(got.LastPolledAt))
+	s.Equal(int64(5), got.Interval)
+}
+
+// TestMarkAuthenticated_ConcurrentPoll verifies that a poll recorded between the callback's read and
+// write does not fail the callback.
+func (s *DeviceStoreTestSuite) TestMarkAuthenticated_ConcurrentPoll() {
+	req := s.sampleRequest()
+	backing := &interleavingStore{RuntimeStoreProvider: inmemory.Initialize(testDeploymentID)}
+	store := newDeviceStore(backing)
+	s.Require().NoError(store.Add(s.ctx, req))
+	polledAt := time.Now().Truncate(time.Second)
+	backing.beforeSwap = func() {
+		s.Require().NoError(store.UpdateLastPolled(s.ctx, req.ID, time.Time{}, polledAt, 5))
+	}
+
+	s.Require().NoError(store.MarkAuthenticated(s.ctx, req.ID, "user-1", "openid", "", "", time.Now()))
+
+	got, err := store.GetByID(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.Equal(DeviceStateAuthenticated, got.State)
+	s.True(polledAt.Equal(got.LastPolledAt))
+}
+
+func (s *DeviceStoreTestSuite) TestUpdateState_NonPendingReleasesUserCode() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	s.Require().NoError(s.store.UpdateState(s.ctx, req.ID, DeviceStateDenied))
+
+	got, err := s.store.GetByID(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.Equal(DeviceStateDenied, got.State)
+
+	_, err = s.store.GetByUserCode(s.ctx, req.UserCode)
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+}
+
+// TestUpdateState_ConcurrentCallback verifies that a state transition based on a stale read fails
+// rather than undoing the transition a concurrent verification callback made.
+func (s *DeviceStoreTestSuite) TestUpdateState_ConcurrentCallback() {
+	req := s.sampleRequest()
+	backing := &interleavingStore{RuntimeStoreProvider: inmemory.Initialize(testDeploymentID)}
+	store := newDeviceStore(backing)
+	s.Require().NoError(store.Add(s.ctx, req))
+	backing.beforeSwap = func() {
+		s.Require().NoError(store.MarkAuthenticated(s.ctx, req.ID, "user-1", "openid", "", "", time.Now()))
+	}
+
+	err := store.UpdateState(s.ctx, req.ID, DeviceStateExpired)
+	s.ErrorIs(err, errDeviceRequestStateChanged)
+
+	got, err := store.GetByID(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.Equal(DeviceStateAuthenticated, got.State)
+}
+
+func (s *DeviceStoreTestSuite) TestUpdateState_SameState_IsNoop() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	s.NoError(s.store.UpdateState(s.ctx, req.ID, DeviceStatePending))
+
+	_, err := s.store.GetByUserCode(s.ctx, req.UserCode)
+	s.NoError(err)
+}
+
+func (s *DeviceStoreTestSuite) TestUpdateState_Missing_ReturnsNotFound() {
+	err := s.store.UpdateState(s.ctx, "no-such-request", DeviceStateExpired)
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+}
+
+// interleavingStore wraps a runtime store and runs beforeSwap once, ahead of the first compare-and-swap
+// that is not issued by beforeSwap itself, to interleave a concurrent writer between a read and its
+// write-back.
+type interleavingStore struct {
+	providers.RuntimeStoreProvider
+	beforeSwap func()
+}
+
+func (st *interleavingStore) CompareFieldAndSwap(ctx context.Context, namespace providers.RuntimeStoreNamespace,
+	key, field, expected string, newValue []byte) (bool, error) {
+	if hook := st.beforeSwap; hook != nil {
+		st.beforeSwap = nil
+		hook()
+	}
+	return st.RuntimeStoreProvider.CompareFieldAndSwap(ctx, namespace, key, field, expected, newValue)
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"crypto/rand"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// userCodeCharset is the base-20 consonant alphabet recommended by RFC 8628 section 6.1. It avoids
+// vowels (so codes cannot spell words) and characters that are easily confused when typed.
+const userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+
+// userCodeLength is the number of characters in a user code, giving 20^8 (~2^34.5) possible codes.
+const userCodeLength = 8
+
+// generateUserCode returns a random user code in its normalized (unformatted) form.
+func generateUserCode() (string, error) {
+	code := make([]byte, userCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(userCodeCharset))))
+		if err != nil {
+			return "", fmt.Errorf("failed to generate user code: %w", err)
+		}
+		code[i] = userCodeCharset[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// formatUserCode splits a normalized user code into two dash-separated halves for display.
+func formatUserCode(userCode string) string {
+	half := len(userCode) / 2
+	return userCode[:half] + "-" + userCode[half:]
+}
+
+// normalizeUserCode canonicalizes user input so that case differences and separators typed by the user
+// do not affect matching. Characters outside the user code alphabet are dropped.
+func normalizeUserCode(input string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(input) {
+		if strings.ContainsRune(userCodeCharset, r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// getRequiredOptionalAttributes determines the space-separated optional user attributes to resolve for
+// a device authorization request, so the auth assertion caches them and the token grant can embed them.
+// It mirrors the CIBA selection: the device grant always issues an access token and never carries an
+// OIDC claims request parameter, so the essential set is always empty and only the optional set is
+// derived from the access token attributes and the scope-derived OIDC attributes.
+func getRequiredOptionalAttributes(scopes []string, app *providers.OAuthClient) string {
+	if app == nil {
+		return ""
+	}
+
+	optionalAttributes := make(map[string]bool)
+
+	if app.Token != nil && app.Token.AccessToken != nil && app.Token.AccessToken.UserConfig != nil {
+		for _, attr := range app.Token.AccessToken.UserConfig.Attributes {
+			optionalAttributes[attr] = true
+		}
+	}
+
+	if slices.Contains(scopes, oauth2const.ScopeOpenID) {
+		var idTokenAllowed map[string]bool
+		if app.Token != nil && app.Token.IDToken != nil {
+			idTokenAllowed = toSet(app.Token.IDToken.UserAttributes)
+		}
+		var userInfoAllowed map[string]bool
+		if app.UserInfo != nil {
+			userInfoAllowed = toSet(app.UserInfo.UserAttributes)
+		}
+		for _, scope := range scopes {
+			for _, attr := range app.ScopeClaims[scope] {
+				if idTokenAllowed[attr] || userInfoAllowed[attr] {
+					optionalAttributes[attr] = true
+				}
+			}
+		}
+	}
+
+	return strings.Join(slices.Collect(maps.Keys(optionalAttributes)), " ")
+}
+
+// toSet converts a list of attribute names into a lookup set.
+func toSet(values []string) map[string]bool {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
@@ -135,6 +136,7 @@ func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata() {
 
 	// Verify only implemented response types are present
 	assert.Equal(suite.T(), []string{"code"}, metadata.ResponseTypesSupported)
+	assert.Equal(suite.T(), constants.SupportedResponseModes, metadata.ResponseModesSupported)
 
 	// Verify RFC 9207 advertisement
 	assert.True(suite.T(), metadata.AuthorizationResponseIssParameterSupported)
@@ -150,6 +152,33 @@ func (suite *DiscoveryTestSuite) TestCIBAMetadataAdvertised() {
 	assert.False(suite.T(), metadata.BackchannelUserCodeParameterSupported)
 }
 
+func (suite *DiscoveryTestSuite) TestDeviceAuthorizationEndpointAdvertised() {
+	metadata := suite.discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.Contains(suite.T(), metadata.GrantTypesSupported, string(providers.GrantTypeDeviceCode))
+	assert.True(suite.T(), strings.HasSuffix(metadata.DeviceAuthorizationEndpoint,
+		constants.OAuth2DeviceAuthorizationEndpoint))
+}
+
+func (suite *DiscoveryTestSuite) TestDeviceAuthorizationEndpointOmittedWhenGrantNotAllowed() {
+	testConfig := &config.Config{
+		Server: engineconfig.ServerConfig{Hostname: "localhost", Port: 8080},
+		JWT:    engineconfig.JWTConfig{Issuer: "https://auth.example.com"},
+		OAuth: engineconfig.OAuthConfig{
+			AllowedGrantTypes: []string{"client_credentials", "refresh_token"},
+		},
+	}
+	cryptoMock := cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+	cryptoMock.EXPECT().GetSupportedSigningAlgorithms().Return(suite.oauthCfg.OAuth.DPoP.AllowedAlgs).Maybe()
+	cryptoMock.EXPECT().GetSupportedEncryptionAlgorithms().
+		Return([]string{string(cryptolib.AlgorithmRSAOAEP256)}).Maybe()
+
+	svc := newDiscoveryService(cryptoMock, newTestJWEService(cryptoMock), oauthCfgFromServerConfig(testConfig))
+	meta := svc.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.Empty(suite.T(), meta.DeviceAuthorizationEndpoint)
+}
+
 func (suite *DiscoveryTestSuite) TestOIDCDiscovery() {
 	suite.cryptoMock.EXPECT().GetPublicKeys(mock.Anything, providers.PublicKeyFilter{}).
 		Return([]providers.PublicKeyInfo{{KeyID: "k1", Algorithm: string(cryptolib.AlgorithmRS256)}}, nil)
@@ -183,6 +212,27 @@ func (suite *DiscoveryTestSuite) TestOIDCDiscovery() {
 
 	// Verify claims parameter support
 	assert.True(suite.T(), metadata.ClaimsParameterSupported, "claims_parameter_supported should be true")
+	assert.True(suite.T(), metadata.BackchannelLogoutSupported, "backchannel_logout_supported should be true")
+	assert.True(suite.T(), metadata.BackchannelLogoutSessionSupported,
+		"backchannel_logout_session_supported should be true")
+	assert.True(suite.T(), metadata.FrontchannelLogoutSupported, "frontchannel_logout_supported should be true")
+	assert.True(suite.T(), metadata.FrontchannelLogoutSessionSupported,
+		"frontchannel_logout_session_supported should be true")
+	assert.True(suite.T(), strings.HasSuffix(metadata.CheckSessionIframe, "/oauth2/check-session"))
+
+	// Verify JWT Secured Authorization Response Mode advertisement
+	assert.Contains(suite.T(), metadata.ResponseModesSupported, constants.ResponseModeFormPostJWT)
+	assert.Contains(suite.T(), metadata.AuthorizationSigningAlgValuesSupported, "RS256")
+	assert.NotEmpty(suite.T(), metadata.AuthorizationEncryptionAlgValuesSupported)
+	assert.NotEmpty(suite.T(), metadata.AuthorizationEncryptionEncValuesSupported)
+
+	// Verify JWT-Secured Authorization Request (RFC 9101) advertisement
+	assert.True(suite.T(), metadata.RequestParameterSupported)
+	assert.True(suite.T(), metadata.RequestURIParameterSupported)
+	assert.False(suite.T(), metadata.RequireRequestURIRegistration)
+	assert.NotEmpty(suite.T(), metadata.RequestObjectSigningAlgValuesSupported)
+	assert.NotEmpty(suite.T(), metadata.RequestObjectEncryptionAlgValuesSupported)
+	assert.NotEmpty(suite.T(), metadata.RequestObjectEncryptionEncValuesSupported)
 
 	// Verify RFC 9207 advertisement (inherited from embedded OAuth2AuthorizationServerMetadata)
 	assert.True(suite.T(), metadata.AuthorizationResponseIssParameterSupported)
@@ -214,6 +264,37 @@ func (suite *DiscoveryTestSuite) TestTokenEndpointAuthSigningAlgValuesAdvertised
 	assert.Contains(suite.T(), oauth2Meta.TokenEndpointAuthSigningAlgValuesSupported, "EdDSA")
 }
 
+// TestTokenEndpointAuthSigningAlgValuesIncludeHMACForClientSecretJWT verifies the HMAC algorithms are
+// advertised only while client_secret_jwt is an allowed authentication method.
+func (suite *DiscoveryTestSuite) TestTokenEndpointAuthSigningAlgValuesIncludeHMACForClientSecretJWT() {
+	cfg := suite.oauthCfg
+	cfg.OAuth.AllowedAuthMethods = []string{"client_secret_basic", "client_secret_jwt"}
+	svc := newDiscoveryService(suite.cryptoMock, newTestJWEService(suite.cryptoMock), cfg)
+	algs := svc.GetOAuth2AuthorizationServerMetadata(context.Background()).TokenEndpointAuthSigningAlgValuesSupported
+	assert.Contains(suite.T(), algs, "HS256")
+	assert.Contains(suite.T(), algs, "HS512")
+
+	cfg.OAuth.AllowedAuthMethods = []string{"client_secret_basic", "private_key_jwt"}
+	svc = newDiscoveryService(suite.cryptoMock, newTestJWEService(suite.cryptoMock), cfg)
+	algs = svc.GetOAuth2AuthorizationServerMetadata(context.Background()).TokenEndpointAuthSigningAlgValuesSupported
+	assert.NotContains(suite.T(), algs, "HS256")
+}
+
+// TestFAPI2SecurityProfileNarrowsMetadata verifies that a global FAPI 2.0 security profile requires
+// pushed authorization requests and advertises only the FAPI 2.0 permitted signing algorithms.
+func (suite *DiscoveryTestSuite) TestFAPI2SecurityProfileNarrowsMetadata() {
+	cfg := suite.oauthCfg
+	cfg.OAuth.SecurityProfile = "fapi2"
+	cfg.OAuth.AllowedAuthMethods = []string{"private_key_jwt", "client_secret_jwt"}
+	svc := newDiscoveryService(suite.cryptoMock, newTestJWEService(suite.cryptoMock), cfg)
+
+	metadata := svc.GetOAuth2AuthorizationServerMetadata(context.Background())
+	assert.True(suite.T(), metadata.RequirePushedAuthorizationRequests)
+	assert.Equal(suite.T(), []string{"ES256", "PS256", "EdDSA"}, metadata.DPoPSigningAlgValuesSupported)
+	assert.NotContains(suite.T(), metadata.TokenEndpointAuthSigningAlgValuesSupported, "RS256")
+	assert.NotContains(suite.T(), metadata.TokenEndpointAuthSigningAlgValuesSupported, "HS256")
+}
+
 func (suite *DiscoveryTestSuite) TestDPoPSigningAlgValuesOmittedWhenUnconfigured() {
 	config.ResetServerRuntime()
 	testConfig := &config.Config{
@@ -255,6 +336,8 @@ func (suite *DiscoveryTestSuite) TestDCRRevocationLogoutEndpointsOmittedWhenDisa
 	oidcMeta, err := svc.GetOIDCMetadata(context.Background())
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), oidcMeta.EndSessionEndpoint)
+	assert.Empty(suite.T(), oidcMeta.CheckSessionIframe)
+	assert.False(suite.T(), oidcMeta.FrontchannelLogoutSupported)
 
 	body, err := json.Marshal(oauth2Meta)
 	assert.NoError(suite.T(), err)
@@ -262,6 +345,46 @@ func (suite *DiscoveryTestSuite) TestDCRRevocationLogoutEndpointsOmittedWhenDisa
 	assert.NotContains(suite.T(), string(body), "revocation_endpoint")
 }
 
+func (suite *DiscoveryTestSuite) TestMTLSMetadataOmittedWhenDisabled() {
+	oauth2Meta := suite.discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+	assert.False(suite.T(), oauth2Meta.TLSClientCertificateBoundAccessTokens)
+	assert.Nil(suite.T(), oauth2Meta.MTLSEndpointAliases)
+
+	body, err := json.Marshal(oauth2Meta)
+	assert.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), string(body), "tls_client_certificate_bound_access_tokens")
+	assert.NotContains(suite.T(), string(body), "mtls_endpoint_aliases")
+}
+
+func (suite *DiscoveryTestSuite) TestMTLSMetadataAdvertised() {
+	cfg := suite.oauthCfg
+	cfg.OAuth.MTLS = engineconfig.MTLSConfig{Enabled: true, EndpointAliasBaseURL: "https://mtls.example.com/"}
+	svc := newDiscoveryService(suite.cryptoMock, newTestJWEService(suite.cryptoMock), cfg)
+
+	oauth2Meta := svc.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.True(suite.T(), oauth2Meta.TLSClientCertificateBoundAccessTokens)
+	assert.Contains(suite.T(), oauth2Meta.TokenEndpointAuthMethodsSupported, "tls_client_auth")
+	aliases := oauth2Meta.MTLSEndpointAliases
+	suite.Require().NotNil(aliases)
+	assert.Equal(suite.T(), "https://mtls.example.com/oauth2/token", aliases.TokenEndpoint)
+	assert.Equal(suite.T(), "https://mtls.example.com/oauth2/introspect", aliases.IntrospectionEndpoint)
+	assert.Equal(suite.T(), "https://mtls.example.com/oauth2/revoke", aliases.RevocationEndpoint)
+	assert.Equal(suite.T(), "https://mtls.example.com/oauth2/par", aliases.PushedAuthorizationRequestEndpoint)
+	assert.Equal(suite.T(), "https://mtls.example.com/oauth2/userinfo", aliases.UserInfoEndpoint)
+}
+
+func (suite *DiscoveryTestSuite) TestMTLSEndpointAliasesOmittedWithoutAliasBaseURL() {
+	cfg := suite.oauthCfg
+	cfg.OAuth.MTLS = engineconfig.MTLSConfig{Enabled: true}
+	svc := newDiscoveryService(suite.cryptoMock, newTestJWEService(suite.cryptoMock), cfg)
+
+	oauth2Meta := svc.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.True(suite.T(), oauth2Meta.TLSClientCertificateBoundAccessTokens)
+	assert.Nil(suite.T(), oauth2Meta.MTLSEndpointAliases)
+}
+
 // TestGrantTypeIsValid tests the GrantType.IsValid() method
 // This is a standalone test for constants - doesn't require discovery service setup
 func TestGrantTypeIsValid(t *testing.T) {
@@ -297,11 +420,14 @@ func TestTokenEndpointAuthMethodIsValid(t *testing.T) {
 	assert.True(t, providers.TokenEndpointAuthMethodClientSecretBasic.IsValid())
 	assert.True(t, providers.TokenEndpointAuthMethodClientSecretPost.IsValid())
 	assert.True(t, providers.TokenEndpointAuthMethodNone.IsValid())
+	assert.True(t, providers.TokenEndpointAuthMethodClientSecretJWT.IsValid())
 	assert.True(t, providers.TokenEndpointAuthMethodPrivateKeyJWT.IsValid())
+	assert.True(t, providers.TokenEndpointAuthMethodTLSClientAuth.IsValid())
+	assert.True(t, providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth.IsValid())
 
 	// Test invalid authentication methods
 	assert.False(t, providers.TokenEndpointAuthMethod("invalid").IsValid())
-	assert.False(t, providers.TokenEndpointAuthMethod("client_secret_jwt").IsValid())
+	assert.False(t, providers.TokenEndpointAuthMethod("client_secret_sha256").IsValid())
 	assert.False(t, providers.TokenEndpointAuthMethod("").IsValid())
 }
 
@@ -329,13 +455,14 @@ func TestGetSupportedGrantTypes(t *testing.T) {
 	supported := constants.GetSupportedGrantTypes(oauthconfig.Config{})
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 6, len(supported))
+	assert.Equal(t, 7, len(supported))
 	assert.Contains(t, supported, "authorization_code")
 	assert.Contains(t, supported, "client_credentials")
 	assert.Contains(t, supported, "refresh_token")
 	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:token-exchange")
 	assert.Contains(t, supported, "urn:openid:params:grant-type:ciba")
 	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:device_code")
 	assert.NotContains(t, supported, "password")
 	assert.NotContains(t, supported, "implicit")
 }
@@ -353,12 +480,32 @@ func TestGetSupportedTokenEndpointAuthMethods(t *testing.T) {
 	supported := constants.GetSupportedTokenEndpointAuthMethods(oauthconfig.Config{})
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 4, len(supported))
+	assert.Equal(t, 5, len(supported))
 	assert.Contains(t, supported, "client_secret_basic")
 	assert.Contains(t, supported, "client_secret_post")
+	assert.Contains(t, supported, "client_secret_jwt")
 	assert.Contains(t, supported, "none")
 	assert.Contains(t, supported, "private_key_jwt")
-	assert.NotContains(t, supported, "client_secret_jwt")
+	assert.NotContains(t, supported, "tls_client_auth")
+	assert.NotContains(t, supported, "self_signed_tls_client_auth")
+}
+
+func TestGetSupportedTokenEndpointAuthMethods_MTLSEnabled(t *testing.T) {
+	supported := constants.GetSupportedTokenEndpointAuthMethods(oauthconfig.Config{
+		OAuth: engineconfig.OAuthConfig{MTLS: engineconfig.MTLSConfig{Enabled: true}},
+	})
+
+	assert.Equal(t, 7, len(supported))
+	assert.Contains(t, supported, "tls_client_auth")
+	assert.Contains(t, supported, "self_signed_tls_client_auth")
+}
+
+func TestGetSupportedTokenEndpointAuthMethods_AllowListWithoutMTLS(t *testing.T) {
+	cfg := oauthconfig.Config{
+		OAuth: engineconfig.OAuthConfig{AllowedAuthMethods: []string{"client_secret_basic", "tls_client_auth"}},
+	}
+	assert.Equal(t, []string{"client_secret_basic"}, constants.GetSupportedTokenEndpointAuthMethods(cfg))
+	assert.Equal(t, []string{"client_secret_basic", "tls_client_auth"}, cfg.OAuth.AllowedAuthMethods)
 }
 
 func TestGetSupportedTokenEndpointAuthMethods_ConfiguredAllowList(t *testing.T) {
@@ -374,9 +521,9 @@ func TestGetSupportedSubjectTypes(t *testing.T) {
 	supported := constants.GetSupportedSubjectTypes()
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 1, len(supported))
+	assert.Equal(t, 2, len(supported))
 	assert.Contains(t, supported, constants.SubjectTypePublic)
-	assert.Equal(t, []string{"public"}, supported)
+	assert.Equal(t, []string{"public", "pairwise"}, supported)
 }
 
 // TestGetStandardClaims tests the GetStandardClaims function
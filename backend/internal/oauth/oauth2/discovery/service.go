@@ -8,9 +8,11 @@ import (
 	"errors"
 	"slices"
 	"sort"
+	"strings"
 
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/fapi"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pkce"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -54,12 +56,16 @@ func (ds *discoveryService) GetOAuth2AuthorizationServerMetadata(
 		PushedAuthorizationRequestEndpoint:         ds.getPAREndpoint(),
 		RequirePushedAuthorizationRequests:         ds.isGlobalPARRequired(),
 		ResponseTypesSupported:                     ds.getSupportedResponseTypes(),
+		ResponseModesSupported:                     slices.Clone(constants.SupportedResponseModes),
 		GrantTypesSupported:                        ds.getSupportedGrantTypes(),
 		TokenEndpointAuthMethodsSupported:          ds.getSupportedTokenEndpointAuthMethods(),
 		TokenEndpointAuthSigningAlgValuesSupported: ds.getSupportedTokenEndpointAuthSigningAlgs(),
 		CodeChallengeMethodsSupported:              ds.getSupportedCodeChallengeMethods(),
 		AuthorizationResponseIssParameterSupported: true,
 		DPoPSigningAlgValuesSupported:              ds.getSupportedDPoPSigningAlgs(),
+		RequestParameterSupported:                  true,
+		RequestURIParameterSupported:               true,
+		RequestObjectSigningAlgValuesSupported:     ds.getSupportedRequestObjectSigningAlgs(),
 		AuthorizationGrantProfilesSupported:        ds.getSupportedAuthorizationGrantProfiles(),
 	}
 
@@ -68,12 +74,19 @@ func (ds *discoveryService) GetOAuth2AuthorizationServerMetadata(
 		metadata.BackchannelTokenDeliveryModesSupported = []string{"poll"}
 		metadata.BackchannelUserCodeParameterSupported = false
 	}
+	if slices.Contains(metadata.GrantTypesSupported, string(providers.GrantTypeDeviceCode)) {
+		metadata.DeviceAuthorizationEndpoint = ds.getDeviceAuthorizationEndpoint()
+	}
 	if ds.cfg.OAuth.TokenRevocation.IsEnabled() {
 		metadata.RevocationEndpoint = ds.getRevocationEndpoint()
 	}
 	if ds.cfg.OAuth.DCR.IsEnabled() {
 		metadata.RegistrationEndpoint = ds.getRegistrationEndpoint()
 	}
+	if ds.cfg.OAuth.MTLS.Enabled {
+		metadata.TLSClientCertificateBoundAccessTokens = true
+		metadata.MTLSEndpointAliases = ds.getMTLSEndpointAliases(metadata)
+	}
 	return metadata
 }
 
@@ -89,28 +102,44 @@ func (ds *discoveryService) GetOIDCMetadata(ctx context.Context) (*OIDCProviderM
 	encryptionEncs := ds.jweService.SupportedContentEncryptionAlgorithms()
 
 	oidcProviderMetadata := &OIDCProviderMetadata{
-		OAuth2AuthorizationServerMetadata:    *oauth2Meta,
-		UserInfoEndpoint:                     ds.getUserInfoEndpoint(),
-		ScopesSupported:                      ds.getSupportedOIDCScopes(),
-		SubjectTypesSupported:                ds.getSupportedSubjectTypes(),
-		IDTokenSigningAlgValuesSupported:     signingAlgs,
-		UserInfoSigningAlgValuesSupported:    signingAlgs,
-		UserInfoEncryptionAlgValuesSupported: encryptionAlgs,
-		UserInfoEncryptionEncValuesSupported: encryptionEncs,
-		IDTokenEncryptionAlgValuesSupported:  encryptionAlgs,
-		IDTokenEncryptionEncValuesSupported:  encryptionEncs,
-		ClaimsSupported:                      ds.getSupportedClaims(),
-		ClaimsParameterSupported:             true,
-		AcrValuesSupported:                   ds.getSupportedAcrValues(),
+		OAuth2AuthorizationServerMetadata:         *oauth2Meta,
+		UserInfoEndpoint:                          ds.getUserInfoEndpoint(),
+		ScopesSupported:                           ds.getSupportedOIDCScopes(),
+		SubjectTypesSupported:                     ds.getSupportedSubjectTypes(),
+		IDTokenSigningAlgValuesSupported:          signingAlgs,
+		UserInfoSigningAlgValuesSupported:         signingAlgs,
+		UserInfoEncryptionAlgValuesSupported:      encryptionAlgs,
+		UserInfoEncryptionEncValuesSupported:      encryptionEncs,
+		IDTokenEncryptionAlgValuesSupported:       encryptionAlgs,
+		IDTokenEncryptionEncValuesSupported:       encryptionEncs,
+		AuthorizationSigningAlgValuesSupported:    signingAlgs,
+		AuthorizationEncryptionAlgValuesSupported: encryptionAlgs,
+		AuthorizationEncryptionEncValuesSupported: encryptionEncs,
+		RequestObjectEncryptionAlgValuesSupported: encryptionAlgs,
+		RequestObjectEncryptionEncValuesSupported: encryptionEncs,
+		ClaimsSupported:                           ds.getSupportedClaims(),
+		ClaimsParameterSupported:                  true,
+		BackchannelLogoutSupported:                true,
+		BackchannelLogoutSessionSupported:         true,
+		AcrValuesSupported:                        ds.getSupportedAcrValues(),
 	}
 
 	if ds.cfg.OAuth.Logout.IsEnabled() {
 		oidcProviderMetadata.EndSessionEndpoint = ds.getEndSessionEndpoint()
+		// Front-channel logout and session management ride the end_session_endpoint: the logout page
+		// is rendered on its completion, and the session it ends is what check_session_iframe reports.
+		oidcProviderMetadata.FrontchannelLogoutSupported = true
+		oidcProviderMetadata.FrontchannelLogoutSessionSupported = true
+		oidcProviderMetadata.CheckSessionIframe = ds.getCheckSessionIframe()
 	}
 
 	return oidcProviderMetadata, nil
 }
 
+func (ds *discoveryService) getCheckSessionIframe() string {
+	return ds.cfg.BaseURL + constants.OAuth2CheckSessionEndpoint
+}
+
 func (ds *discoveryService) getEndSessionEndpoint() string {
 	return ds.cfg.BaseURL + constants.OAuth2LogoutEndpoint
 }
@@ -179,16 +208,71 @@ func (ds *discoveryService) getBackchannelAuthenticationEndpoint() string {
 	return ds.cfg.BaseURL + constants.OAuth2BackchannelAuthEndpoint
 }
 
+func (ds *discoveryService) getDeviceAuthorizationEndpoint() string {
+	return ds.cfg.BaseURL + constants.OAuth2DeviceAuthorizationEndpoint
+}
+
+// getMTLSEndpointAliases re-roots the advertised endpoints that accept client authentication or
+// certificate-bound tokens under the configured mutual-TLS base URL. Returns nil when no alias base
+// URL is configured, in which case the regular endpoints accept mutual-TLS connections.
+func (ds *discoveryService) getMTLSEndpointAliases(
+	metadata *OAuth2AuthorizationServerMetadata,
+) *MTLSEndpointAliases {
+	aliasBaseURL := strings.TrimSuffix(ds.cfg.OAuth.MTLS.EndpointAliasBaseURL, "/")
+	if aliasBaseURL == "" {
+		return nil
+	}
+	alias := func(endpoint string) string {
+		if endpoint == "" {
+			return ""
+		}
+		return aliasBaseURL + strings.TrimPrefix(endpoint, ds.cfg.BaseURL)
+	}
+	return &MTLSEndpointAliases{
+		TokenEndpoint:                      alias(metadata.TokenEndpoint),
+		RevocationEndpoint:                 alias(metadata.RevocationEndpoint),
+		IntrospectionEndpoint:              alias(metadata.IntrospectionEndpoint),
+		PushedAuthorizationRequestEndpoint: alias(metadata.PushedAuthorizationRequestEndpoint),
+		BackchannelAuthenticationEndpoint:  alias(metadata.BackchannelAuthenticationEndpoint),
+		DeviceAuthorizationEndpoint:        alias(metadata.DeviceAuthorizationEndpoint),
+		UserInfoEndpoint:                   alias(ds.getUserInfoEndpoint()),
+	}
+}
+
 func (ds *discoveryService) isGlobalPARRequired() bool {
-	return ds.cfg.OAuth.PAR.RequirePAR
+	return ds.cfg.OAuth.PAR.RequirePAR || ds.isGlobalFAPI2()
+}
+
+// isGlobalFAPI2 reports whether the FAPI 2.0 Security Profile is enforced server-wide, in which case
+// the advertised signing algorithms are narrowed to those the profile permits.
+func (ds *discoveryService) isGlobalFAPI2() bool {
+	return ds.cfg.OAuth.SecurityProfile == string(providers.SecurityProfileFAPI2)
 }
 
 func (ds *discoveryService) getSupportedDPoPSigningAlgs() []string {
-	return ds.cryptoProvider.GetSupportedSigningAlgorithms()
+	return ds.getSupportedClientSigningAlgs()
+}
+
+func (ds *discoveryService) getSupportedRequestObjectSigningAlgs() []string {
+	return ds.getSupportedClientSigningAlgs()
 }
 
 func (ds *discoveryService) getSupportedTokenEndpointAuthSigningAlgs() []string {
-	return ds.cryptoProvider.GetSupportedSigningAlgorithms()
+	algs := ds.getSupportedClientSigningAlgs()
+	if !ds.isGlobalFAPI2() && slices.Contains(ds.getSupportedTokenEndpointAuthMethods(),
+		string(providers.TokenEndpointAuthMethodClientSecretJWT)) {
+		algs = append(slices.Clone(algs), constants.ClientSecretJWTSigningAlgs...)
+	}
+	return algs
+}
+
+// getSupportedClientSigningAlgs returns the algorithms accepted on JWTs signed by clients.
+func (ds *discoveryService) getSupportedClientSigningAlgs() []string {
+	algs := ds.cryptoProvider.GetSupportedSigningAlgorithms()
+	if ds.isGlobalFAPI2() {
+		return fapi.FilterSigningAlgorithms(algs)
+	}
+	return algs
 }
 
 func (ds *discoveryService) getSupportedSubjectTypes() []string {
@@ -0,0 +1,60 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fapi provides the shared requirements of the FAPI 2.0 Security Profile, enforced by the
+// authorization, pushed authorization and token endpoints for clients held to that profile.
+package fapi
+
+import (
+	"slices"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// MaxAuthorizationCodeValidity is the longest lifetime, in seconds, of an authorization code issued
+// under the FAPI 2.0 Security Profile.
+const MaxAuthorizationCodeValidity int64 = 60
+
+// signingAlgorithms lists the JWS algorithms permitted for request objects, client assertions and
+// DPoP proofs under the FAPI 2.0 Security Profile.
+var signingAlgorithms = []string{"PS256", "ES256", "EdDSA"}
+
+// clientAuthMethods lists the client authentication methods permitted under the FAPI 2.0 Security
+// Profile.
+var clientAuthMethods = []providers.TokenEndpointAuthMethod{
+	providers.TokenEndpointAuthMethodPrivateKeyJWT,
+	providers.TokenEndpointAuthMethodTLSClientAuth,
+	providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth,
+}
+
+// IsAllowedSigningAlgorithm reports whether the JWS algorithm is permitted by the profile.
+func IsAllowedSigningAlgorithm(alg string) bool {
+	return slices.Contains(signingAlgorithms, alg)
+}
+
+// FilterSigningAlgorithms returns the algorithms of algs that the profile permits, in their
+// original order.
+func FilterSigningAlgorithms(algs []string) []string {
+	filtered := make([]string, 0, len(algs))
+	for _, alg := range algs {
+		if IsAllowedSigningAlgorithm(alg) {
+			filtered = append(filtered, alg)
+		}
+	}
+	return filtered
+}
+
+// IsAllowedClientAuthMethod reports whether the client authentication method is permitted by the
+// profile: private_key_jwt or one of the mutual-TLS methods.
+func IsAllowedClientAuthMethod(method providers.TokenEndpointAuthMethod) bool {
+	return slices.Contains(clientAuthMethods, method)
+}
+
+// AuthorizationCodeValidity bounds the configured authorization code validity period, in seconds,
+// to the profile's maximum.
+func AuthorizationCodeValidity(configured int64) int64 {
+	if configured <= 0 || configured > MaxAuthorizationCodeValidity {
+		return MaxAuthorizationCodeValidity
+	}
+	return configured
+}
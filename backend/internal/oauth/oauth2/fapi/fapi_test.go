@@ -0,0 +1,51 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type FAPITestSuite struct {
+	suite.Suite
+}
+
+func TestFAPITestSuite(t *testing.T) {
+	suite.Run(t, new(FAPITestSuite))
+}
+
+func (suite *FAPITestSuite) TestIsAllowedSigningAlgorithm() {
+	for _, alg := range []string{"PS256", "ES256", "EdDSA"} {
+		assert.True(suite.T(), IsAllowedSigningAlgorithm(alg), alg)
+	}
+	for _, alg := range []string{"RS256", "HS256", "none", ""} {
+		assert.False(suite.T(), IsAllowedSigningAlgorithm(alg), alg)
+	}
+}
+
+func (suite *FAPITestSuite) TestFilterSigningAlgorithms() {
+	assert.Equal(suite.T(), []string{"ES256", "PS256"},
+		FilterSigningAlgorithms([]string{"RS256", "ES256", "HS256", "PS256"}))
+	assert.Empty(suite.T(), FilterSigningAlgorithms([]string{"RS256"}))
+}
+
+func (suite *FAPITestSuite) TestIsAllowedClientAuthMethod() {
+	assert.True(suite.T(), IsAllowedClientAuthMethod(providers.TokenEndpointAuthMethodPrivateKeyJWT))
+	assert.True(suite.T(), IsAllowedClientAuthMethod(providers.TokenEndpointAuthMethodTLSClientAuth))
+	assert.True(suite.T(), IsAllowedClientAuthMethod(providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth))
+	assert.False(suite.T(), IsAllowedClientAuthMethod(providers.TokenEndpointAuthMethodClientSecretBasic))
+	assert.False(suite.T(), IsAllowedClientAuthMethod(providers.TokenEndpointAuthMethodClientSecretJWT))
+	assert.False(suite.T(), IsAllowedClientAuthMethod(providers.TokenEndpointAuthMethodNone))
+}
+
+func (suite *FAPITestSuite) TestAuthorizationCodeValidity() {
+	assert.Equal(suite.T(), int64(30), AuthorizationCodeValidity(30))
+	assert.Equal(suite.T(), MaxAuthorizationCodeValidity, AuthorizationCodeValidity(600))
+	assert.Equal(suite.T(), MaxAuthorizationCodeValidity, AuthorizationCodeValidity(0))
+}
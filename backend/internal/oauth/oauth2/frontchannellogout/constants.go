@@ -0,0 +1,15 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package frontchannellogout
+
+const (
+	// paramIss is the front-channel logout query parameter carrying the issuer.
+	paramIss = "iss"
+	// paramSID is the front-channel logout query parameter carrying the session identifier.
+	paramSID = "sid"
+
+	// logoutURIsValidity bounds how long the logout URIs recorded for a sign-out are kept, in seconds.
+	// They only have to outlive the remainder of the sign-out flow and its completion callback.
+	logoutURIsValidity int64 = 600
+)
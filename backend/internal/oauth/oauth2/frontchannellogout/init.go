@@ -0,0 +1,19 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package frontchannellogout implements OpenID Connect Front-Channel Logout 1.0: when an SSO session is
+// ended by a sign-out flow, the frontchannel_logout_uri of every participating application that
+// registered one is recorded, with iss and sid, for the logout page to load in iframes.
+package frontchannellogout
+
+import (
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize constructs the front-channel logout service. Like the back-channel logout service it is
+// built ahead of the SSO session service, which notifies it, and receives the actor provider later
+// through SetActorProvider.
+func Initialize(runtimeStore providers.RuntimeStoreProvider, cfg oauthconfig.Config) FrontchannelLogoutServiceInterface {
+	return newFrontchannelLogoutService(runtimeStore, cfg.JWT.Issuer)
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package frontchannellogout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// FrontchannelLogoutServiceInterface records the front-channel logout URIs of ended SSO sessions for
+// the logout page. It implements session.LogoutNotifier.
+type FrontchannelLogoutServiceInterface interface {
+	// NotifySessionEnded records the front-channel logout URI of every participant that registered
+	// one, keyed by the sign-out flow execution that ended the session. Sessions ended outside a flow
+	// execution (e.g. by subject) have no browser to render the logout page in and are ignored.
+	NotifySessionEnded(ctx context.Context, sess session.Session, participants []session.Participant)
+	// TakeLogoutURIs returns and forgets the front-channel logout URIs recorded for a sign-out flow
+	// execution. It returns nil when none were recorded.
+	TakeLogoutURIs(ctx context.Context, executionID string) ([]string, error)
+	// SetActorProvider injects the provider participating applications are resolved through.
+	SetActorProvider(actorProvider providers.ActorProvider)
+}
+
+// frontchannelLogoutService is the default implementation of FrontchannelLogoutServiceInterface.
+type frontchannelLogoutService struct {
+	runtimeStore  providers.RuntimeStoreProvider
+	actorProvider providers.ActorProvider
+	issuer        string
+	logger        *log.Logger
+}
+
+var _ session.LogoutNotifier = (*frontchannelLogoutService)(nil)
+
+// newFrontchannelLogoutService creates a new front-channel logout service.
+func newFrontchannelLogoutService(runtimeStore providers.RuntimeStoreProvider,
+	issuer string) *frontchannelLogoutService {
+	return &frontchannelLogoutService{
+		runtimeStore: runtimeStore,
+		issuer:       issuer,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FrontchannelLogoutService")),
+	}
+}
+
+// SetActorProvider injects the actor provider. See Initialize.
+func (s *frontchannelLogoutService) SetActorProvider(actorProvider providers.ActorProvider) {
+	s.actorProvider = actorProvider
+}
+
+// NotifySessionEnded implements FrontchannelLogoutServiceInterface. Nothing is delivered here: the
+// URIs are only resolved and stored, so the sign-out path is not held up beyond that.
+func (s *frontchannelLogoutService) NotifySessionEnded(ctx context.Context, sess session.Session,
+	participants []session.Participant) {
+	executionID := session.SSOInputsFrom(ctx).ExecutionID
+	if executionID == "" {
+		return
+	}
+	if s.actorProvider == nil {
+		s.logger.Warn(ctx, "Actor provider not configured; skipping front-channel logout")
+		return
+	}
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, executionID))
+
+	sid := sess.SID()
+	logoutURIs := make([]string, 0, len(participants))
+	for _, p := range participants {
+		if logoutURI, ok := s.resolveLogoutURI(ctx, p.AppID, sid, logger); ok {
+			logoutURIs = append(logoutURIs, logoutURI)
+		}
+	}
+	if len(logoutURIs) == 0 {
+		return
+	}
+
+	// A sign-out execution may end more than one session; its URIs accumulate under the execution.
+	existing, err := s.getLogoutURIs(ctx, executionID)
+	if err != nil {
+		logger.Error(ctx, "Failed to read recorded front-channel logout URIs", log.Error(err))
+		return
+	}
+	data, err := json.Marshal(append(existing, logoutURIs...))
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal front-channel logout URIs", log.Error(err))
+		return
+	}
+	if err := s.runtimeStore.Put(ctx, providers.NamespaceFrontchannel, executionID, data,
+		logoutURIsValidity); err != nil {
+		logger.Error(ctx, "Failed to record front-channel logout URIs", log.Error(err))
+		return
+	}
+	logger.Debug(ctx, "Recorded front-channel logout URIs", log.Int("count", len(logoutURIs)))
+}
+
+// TakeLogoutURIs implements FrontchannelLogoutServiceInterface.
+func (s *frontchannelLogoutService) TakeLogoutURIs(ctx context.Context, executionID string) ([]string, error) {
+	if executionID == "" {
+		return nil, nil
+	}
+	data, err := s.runtimeStore.Take(ctx, providers.NamespaceFrontchannel, executionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take front-channel logout URIs: %w", err)
+	}
+	return unmarshalLogoutURIs(data)
+}
+
+// getLogoutURIs returns the front-channel logout URIs already recorded for an execution.
+func (s *frontchannelLogoutService) getLogoutURIs(ctx context.Context, executionID string) ([]string, error) {
+	data, err := s.runtimeStore.Get(ctx, providers.NamespaceFrontchannel, executionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get front-channel logout URIs: %w", err)
+	}
+	return unmarshalLogoutURIs(data)
+}
+
+// resolveLogoutURI returns the application's front-channel logout URI with iss and sid appended. It
+// reports false when the application cannot be resolved or has not registered a URI.
+func (s *frontchannelLogoutService) resolveLogoutURI(ctx context.Context, appID, sid string,
+	logger *log.Logger) (string, bool) {
+	profile, svcErr := s.actorProvider.GetOAuthProfileByID(ctx, appID)
+	if svcErr != nil {
+		// The application may have been deleted, or never had an OAuth profile, since it joined.
+		if svcErr.Type == tidcommon.ClientErrorType {
+			logger.Debug(ctx, "No OAuth profile for session participant; skipping front-channel logout",
+				log.String("appId", appID))
+		} else {
+			logger.Error(ctx, "Failed to resolve OAuth profile for front-channel logout",
+				log.String("appId", appID))
+		}
+		return "", false
+	}
+	if profile == nil || profile.FrontchannelLogoutURI == "" {
+		return "", false
+	}
+
+	// iss and sid are sent to every participant, not only those that require them, so a relying
+	// party can always tell which of its sessions is being logged out.
+	logoutURI, err := oauth2utils.GetURIWithQueryParams(profile.FrontchannelLogoutURI, map[string]string{
+		paramIss: s.issuer,
+		paramSID: sid,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to build front-channel logout URI", log.String("appId", appID), log.Error(err))
+		return "", false
+	}
+	return logoutURI, true
+}
+
+// unmarshalLogoutURIs decodes stored front-channel logout URIs; absent data decodes to nil.
+func unmarshalLogoutURIs(data []byte) ([]string, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var logoutURIs []string
+	if err := json.Unmarshal(data, &logoutURIs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal front-channel logout URIs: %w", err)
+	}
+	return logoutURIs, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package frontchannellogout
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/runtimestoreprovidermock"
+)
+
+const (
+	testIssuer      = "https://idp.example.com"
+	testExecutionID = "exec-1"
+	testLogoutURI   = "https://rp.example.com/frontchannel-logout"
+)
+
+type FrontchannelLogoutServiceTestSuite struct {
+	suite.Suite
+	runtimeStore  *runtimestoreprovidermock.RuntimeStoreProviderMock
+	actorProvider *actorprovidermock.ActorProviderMock
+	service       *frontchannelLogoutService
+}
+
+func TestFrontchannelLogoutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(FrontchannelLogoutServiceTestSuite))
+}
+
+func (suite *FrontchannelLogoutServiceTestSuite) SetupTest() {
+	suite.runtimeStore = runtimestoreprovidermock.NewRuntimeStoreProviderMock(suite.T())
+	suite.actorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.service = newFrontchannelLogoutService(suite.runtimeStore, testIssuer)
+	suite.service.SetActorProvider(suite.actorProvider)
+}
+
+// signOutContext returns a context as seen by the session service inside a sign-out flow execution.
+func signOutContext() context.Context {
+	return session.WithSSOInputs(context.Background(), session.SSOInputs{ExecutionID: testExecutionID})
+}
+
+func (suite *FrontchannelLogoutServiceTestSuite) TestNotifySessionEnded_RecordsLogoutURIs() {
+	sess := session.Session{SessionID: "sess-1"}
+	suite.actorProvider.EXPECT().GetOAuthProfileByID(mock.Anything, "app-1").Return(
+		&providers.OAuthProfile{FrontchannelLogoutURI: testLogoutURI + "?tenant=a"}, nil)
+	suite.actorProvider.EXPECT().GetOAuthProfileByID(mock.Anything, "app-2").Return(
+		&providers.OAuthProfile{}, nil)
+	suite.actorProvider.EXPECT().GetOAuthProfileByID(mock.Anything, "app-3").Return(
+		nil, &tidcommon.ServiceError{Type: tidcommon.ClientErrorType})
+	suite.runtimeStore.EXPECT().Get(mock.Anything, providers.NamespaceFrontchannel, testExecutionID).
+		Return(nil, nil)
+
+	var stored []string
+	suite.runtimeStore.EXPECT().Put(mock.Anything, providers.NamespaceFrontchannel, testExecutionID,
+		mock.Anything, logoutURIsValidity).Run(
+		func(_ context.Context, _ providers.RuntimeStoreNamespace, _ string, value []byte, _ int64) {
+			suite.Require().NoError(json.Unmarshal(value, &stored))
+		}).Return(nil)
+
+	suite.service.NotifySessionEnded(signOutContext(), sess, []session.Participant{
+		{AppID: "app-1"}, {AppID: "app-2"}, {AppID: "app-3"},
+	})
+
+	suite.Require().Len(stored, 1)
+	parsed, err := url.Parse(stored[0])
+	suite.Require().NoError(err)
+	suite.Equal("rp.example.com", parsed.Host)
+	suite.Equal("a", parsed.Query().Get("tenant"))
+	suite.Equal(testIssuer, parsed.Query().Get(paramIss))
+	suite.Equal(sess.SID(), parsed.Query().Get(paramSID))
+}
+
+func (suite *FrontchannelLogoutServiceTestSuite) TestNotifySessionEnded_AccumulatesAcrossSessions() {
+	suite.actorProvider.EXPECT().GetOAuthProfileByID(mock.Anything, "app-1").Return(
+		&providers.OAuthProfile{FrontchannelLogoutURI: testLogoutURI}, nil)
+	suite.runtimeStore.EXPECT().Get(mock.Anything, providers.NamespaceFrontchannel, testExecutionID).
+		Return([]byte(`["https://other.example.com/logout"]`), nil)
+	suite.runtimeStore.EXPECT().Put(mock.Anything, providers.NamespaceFrontchannel, testExecutionID,
+		mock.MatchedBy(func(value []byte) bool {
+			var uris []string
+			return json.Unmarshal(value, &uris) == nil && len(uris) == 2
+		}), logoutURIsValidity).Return(nil)
+
+	suite.service.NotifySessionEnded(signOutContext(), session.Session{SessionID: "sess-2"},
+		[]session.Participant{{AppID: "app-1"}})
+}
+
+func (suite *FrontchannelLogoutServiceTestSuite) TestNotifySessionEnded_NoRegisteredURIs_StoresNothing() {
+	suite.actorProvider.EXPECT().GetOAuthProfileByID(mock.Anything, "app-1").Return(
+		&providers.OAuthProfile{BackchannelLogoutURI: "https://rp.example.com/bc"}, nil)
+
+	suite.service.NotifySessionEnded(signOutContext(), session.Session{SessionID: "sess-1"},
+		[]session.Participant{{AppID: "app-1"}})
+
+	suite.runtimeStore.AssertNotCalled(suite.T(), "Put", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+}
+
+// A session ended outside a flow execution (e.g. subject-wide termination) has no logout page to
+// render the iframes in, so no application is even resolved.
+func (suite *FrontchannelLogoutServiceTestSuite) TestNotifySessionEnded_OutsideExecution_Ignored() {
+	suite.service.NotifySessionEnded(context.Background(), session.Session{SessionID: "sess-1"},
+		[]session.Participant{{AppID: "app-1"}})
+
+	suite.actorProvider.AssertNotCalled(suite.T(), "GetOAuthProfileByID", mock.Anything, mock.Anything)
+}
+
+func (suite *FrontchannelLogoutServiceTestSuite) TestTakeLogoutURIs() {
+	suite.runtimeStore.EXPECT().Take(mock.Anything, providers.NamespaceFrontchannel, testExecutionID).
+		Return([]byte(`["`+testLogoutURI+`"]`), nil)
+
+	uris, err := suite.service.TakeLogoutURIs(context.Background(), testExecutionID)
+
+	suite.NoError(err)
+	suite.Equal([]string{testLogoutURI}, uris)
+}
+
+func (suite *FrontchannelLogoutServiceTestSuite) TestTakeLogoutURIs_NoneRecorded() {
+	suite.runtimeStore.EXPECT().Take(mock.Anything, providers.NamespaceFrontchannel, testExecutionID).
+		Return(nil, nil)
+
+	uris, err := suite.service.TakeLogoutURIs(context.Background(), testExecutionID)
+
+	suite.NoError(err)
+	suite.Nil(uris)
+}
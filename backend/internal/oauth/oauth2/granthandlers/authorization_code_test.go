@@ -1608,3 +1608,44 @@ func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_DownscopeVa
 	assert.NotNil(suite.T(), err)
 	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
 }
+
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_AuthorizationDetailsFromCode() {
+	authzCode := suite.testAuthzCode
+	authzCode.AuthorizationDetails = []model.AuthorizationDetail{
+		{"type": "account_information", "actions": []interface{}{"list_accounts"}},
+		{"type": "payment_initiation"},
+	}
+	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
+		Return(&authzCode, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything,
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return len(ctx.AuthorizationDetails) == 1 &&
+				ctx.AuthorizationDetails[0].Type() == "account_information"
+		})).Return(&model.TokenDTO{Token: "test-jwt-token"}, nil)
+
+	tokenReq := *suite.testTokenReq
+	tokenReq.AuthorizationDetails = `[{"type":"account_information","actions":["list_accounts"]}]`
+
+	result, err := suite.handler.HandleGrant(context.Background(), &tokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockTokenBuilder.AssertExpectations(suite.T())
+}
+
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_AuthorizationDetailsExceedGrant() {
+	authzCode := suite.testAuthzCode
+	authzCode.AuthorizationDetails = []model.AuthorizationDetail{{"type": "account_information"}}
+	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
+		Return(&authzCode, nil)
+
+	tokenReq := *suite.testTokenReq
+	tokenReq.AuthorizationDetails = `[{"type":"payment_initiation"}]`
+
+	result, err := suite.handler.HandleGrant(context.Background(), &tokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, err.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything, mock.Anything)
+}
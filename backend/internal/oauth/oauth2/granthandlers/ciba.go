@@ -14,6 +14,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
@@ -85,17 +86,17 @@ func (h *cibaGrantHandler) ValidateGrant(ctx context.Context, tokenRequest *mode
 	if errResp := resourceindicators.ValidateResourceURIs(tokenRequest.Resources); errResp != nil {
 		return errResp
 	}
-	if errResp := enforceCIBAPollingResource(tokenRequest.Resources, record.Resources); errResp != nil {
+	if errResp := enforcePollingResource(tokenRequest.Resources, record.Resources); errResp != nil {
 		return errResp
 	}
 
 	return nil
 }
 
-// enforceCIBAPollingResource allows an absent polling resource (use the stored binding) or a single
+// enforcePollingResource allows an absent polling resource (use the stored binding) or a single
 // resource equal to the stored binding. A different resource, more than one, or a resource against an
 // unbound request cannot widen the binding and is rejected with invalid_target.
-func enforceCIBAPollingResource(pollingResources, storedResources []string) *model.ErrorResponse {
+func enforcePollingResource(pollingResources, storedResources []string) *model.ErrorResponse {
 	if len(pollingResources) == 0 {
 		return nil
 	}
@@ -232,6 +233,9 @@ func (h *cibaGrantHandler) issueTokens(ctx context.Context, record *ciba.CIBAAut
 		OAuthApp:          oauthApp,
 		ValidityPeriod:    userSubConfig.ValidityPeriodOrZero(),
 		DPoPJkt:           dpop.GetJkt(ctx),
+		CertThumbprint:    mtls.GetThumbprint(ctx),
+
+		AuthorizationDetails: record.AuthorizationDetails,
 	}
 	if oauthApp.ShouldAppendActorClaim() {
 		accessTokenCtx.ActorClaims = &tokenservice.SubjectTokenClaims{Sub: oauthApp.ID}
@@ -7,9 +7,11 @@ import (
 	"context"
 	"slices"
 
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -78,6 +80,17 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		return nil, errResp
 	}
 
+	// Authorization details are validated against the types registered on the target resource server.
+	authorizationDetails, errResp := authorizationdetails.Parse(tokenRequest.AuthorizationDetails)
+	if errResp != nil {
+		return nil, errResp
+	}
+	targetRS, errResp = authorizationdetails.ResolveAndValidate(
+		ctx, h.resourceService, tokenRequest.Resources, targetRS, authorizationDetails)
+	if errResp != nil {
+		return nil, errResp
+	}
+
 	audiences := []string{oauthApp.ResolveDefaultAudience(tokenRequest.ClientID)}
 	if targetRS != nil {
 		audiences = []string{targetRS.Identifier}
@@ -141,6 +154,9 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		OAuthApp:          oauthApp,
 		ValidityPeriod:    oauthApp.ClientAccessTokenConfig().ValidityPeriodOrZero(),
 		DPoPJkt:           dpop.GetJkt(ctx),
+		CertThumbprint:    mtls.GetThumbprint(ctx),
+
+		AuthorizationDetails: authorizationDetails,
 	})
 	if err != nil {
 		return nil, &model.ErrorResponse{
@@ -977,3 +977,52 @@ func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_NoDPoPProof
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), constants.TokenTypeBearer, result.AccessToken.TokenType)
 }
+
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_AuthorizationDetails() {
+	mockResourceService := resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.handler.resourceService = mockResourceService
+	mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, testResourceURL).
+		Return(&providers.ResourceServer{
+			ID:                       "rs-payments",
+			Identifier:               testResourceURL,
+			AuthorizationDetailTypes: []providers.AuthorizationDetailType{{Type: "payment_initiation"}},
+		}, nil)
+	mockResourceService.On("ValidatePermissions", mock.Anything, mock.Anything, mock.Anything).
+		Return([]string{}, nil).Maybe()
+
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything,
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return len(ctx.AuthorizationDetails) == 1 &&
+				ctx.AuthorizationDetails[0].Type() == "payment_initiation" &&
+				len(ctx.Audiences) == 1 && ctx.Audiences[0] == testResourceURL
+		})).Return(&model.TokenDTO{Token: testJWTToken}, nil)
+
+	tokenRequest := &model.TokenRequest{
+		GrantType:            "client_credentials",
+		ClientID:             testClientID,
+		Resources:            []string{testResourceURL},
+		AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+	}
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.NotNil(suite.T(), result)
+	suite.mockTokenBuilder.AssertExpectations(suite.T())
+}
+
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_UnsupportedAuthorizationDetailsType() {
+	tokenRequest := &model.TokenRequest{
+		GrantType:            "client_credentials",
+		ClientID:             testClientID,
+		Resources:            []string{testResourceURL},
+		AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+	}
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,334 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package granthandlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// deviceCodeGrantHandler handles the OAuth 2.0 device authorization grant type (RFC 8628).
+type deviceCodeGrantHandler struct {
+	deviceService   device.DeviceServiceInterface
+	tokenBuilder    tokenservice.TokenBuilderInterface
+	attributeCache  attributecache.AttributeCacheServiceInterface
+	resourceService providers.ResourceServerProvider
+	logger          *log.Logger
+}
+
+// newDeviceCodeGrantHandler creates a new instance of deviceCodeGrantHandler.
+func newDeviceCodeGrantHandler(
+	deviceService device.DeviceServiceInterface,
+	tokenBuilder tokenservice.TokenBuilderInterface,
+	attributeCache attributecache.AttributeCacheServiceInterface,
+	resourceService providers.ResourceServerProvider,
+) GrantHandlerInterface {
+	return &deviceCodeGrantHandler{
+		deviceService:   deviceService,
+		tokenBuilder:    tokenBuilder,
+		attributeCache:  attributeCache,
+		resourceService: resourceService,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeviceCodeGrantHandler")),
+	}
+}
+
+// ValidateGrant validates the device code grant request.
+func (h *deviceCodeGrantHandler) ValidateGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *providers.OAuthClient) *model.ErrorResponse {
+	if providers.GrantType(tokenRequest.GrantType) != providers.GrantTypeDeviceCode {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorUnsupportedGrantType,
+			ErrorDescription: "Unsupported grant type",
+		}
+	}
+	if tokenRequest.DeviceCode == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "device_code is required",
+		}
+	}
+
+	record, errResp := h.getRecord(ctx, tokenRequest.DeviceCode)
+	if errResp != nil {
+		return errResp
+	}
+	if record.ClientID != oauthApp.ClientID {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid device_code",
+		}
+	}
+
+	if errResp := resourceindicators.ValidateResourceURIs(tokenRequest.Resources); errResp != nil {
+		return errResp
+	}
+	if errResp := enforcePollingResource(tokenRequest.Resources, record.Resources); errResp != nil {
+		return errResp
+	}
+
+	return nil
+}
+
+// HandleGrant processes the device code grant request following the RFC 8628 polling state machine.
+func (h *deviceCodeGrantHandler) HandleGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *providers.OAuthClient) (*model.TokenResponseDTO, *model.ErrorResponse) {
+	record, errResp := h.getRecord(ctx, tokenRequest.DeviceCode)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	now := time.Now()
+
+	// Expiry takes precedence over all other states.
+	if now.After(record.ExpiryTime) {
+		if record.State != device.DeviceStateExpired && record.State != device.DeviceStateConsumed {
+			if updateErr := h.deviceService.UpdateState(ctx, record.ID, device.DeviceStateExpired); updateErr != nil {
+				h.logger.Error(ctx, "Failed to mark device authorization request as expired", log.Error(updateErr))
+			}
+		}
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorExpiredToken,
+			ErrorDescription: "The device_code has expired",
+		}
+	}
+
+	switch record.State {
+	case device.DeviceStatePending:
+		return nil, h.handlePending(ctx, record, now)
+	case device.DeviceStateDenied:
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorAccessDenied,
+			ErrorDescription: "The user denied the authorization request",
+		}
+	case device.DeviceStateFailed:
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Authentication could not be completed due to a server error",
+		}
+	case device.DeviceStateConsumed:
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "The device_code has already been used",
+		}
+	case device.DeviceStateAuthenticated:
+		return h.issueTokens(ctx, record, oauthApp)
+	default:
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid device authorization request state",
+		}
+	}
+}
+
+// getRecord loads the device authorization request for the presented device_code.
+func (h *deviceCodeGrantHandler) getRecord(ctx context.Context,
+	deviceCode string) (*device.DeviceAuthRequest, *model.ErrorResponse) {
+	record, err := h.deviceService.GetByDeviceCode(ctx, deviceCode)
+	if err != nil {
+		if errors.Is(err, device.ErrDeviceRequestNotFound) {
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorInvalidGrant,
+				ErrorDescription: "Invalid device_code",
+			}
+		}
+		h.logger.Error(ctx, "Failed to retrieve device authorization request", log.Error(err))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to process token request",
+		}
+	}
+	return record, nil
+}
+
+// handlePending enforces the polling interval and returns slow_down or authorization_pending. Each
+// slow_down permanently increases the interval for the request, as required by RFC 8628 section 3.5.
+// Of several polls that read the same last poll time, only the first to record its poll is accepted;
+// the others get slow_down.
+func (h *deviceCodeGrantHandler) handlePending(ctx context.Context, record *device.DeviceAuthRequest,
+	now time.Time) *model.ErrorResponse {
+	interval := record.Interval
+	if interval <= 0 {
+		interval = constants.DeviceCodeDefaultIntervalSeconds
+	}
+	tooFast := !record.LastPolledAt.IsZero() &&
+		now.Sub(record.LastPolledAt) < time.Duration(interval)*time.Second
+	if tooFast {
+		interval += constants.DeviceCodeSlowDownIncrementSeconds
+	}
+
+	updateErr := h.deviceService.UpdateLastPolled(ctx, record.ID, record.LastPolledAt, now, interval)
+	if errors.Is(updateErr, device.ErrDevicePollConflict) {
+		tooFast = true
+	} else if updateErr != nil {
+		h.logger.Error(ctx, "Failed to update device authorization last polled time", log.Error(updateErr))
+	}
+
+	if tooFast {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorSlowDown,
+			ErrorDescription: "The client is polling too frequently",
+		}
+	}
+	return &model.ErrorResponse{
+		Error:            constants.ErrorAuthorizationPending,
+		ErrorDescription: "The user has not yet completed authorization",
+	}
+}
+
+// issueTokens builds the access and (when openid) ID tokens, then marks the request consumed. The
+// refresh token is issued by the token service when the client allows it.
+func (h *deviceCodeGrantHandler) issueTokens(ctx context.Context, record *device.DeviceAuthRequest,
+	oauthApp *providers.OAuthClient) (*model.TokenResponseDTO, *model.ErrorResponse) {
+	scopeStr := record.AuthorizedScopes
+	if scopeStr == "" {
+		scopeStr = record.StandardScopes
+	}
+
+	accessTokenAudiences, accessTokenScopes, bindErr := h.resolveIssuedAudiencesAndScopes(
+		ctx, record, oauthApp, scopeStr)
+	if bindErr != nil {
+		return nil, bindErr
+	}
+
+	attrs := make(map[string]interface{})
+	if record.AttributeCacheID != "" {
+		cacheEntry, cacheErr := h.attributeCache.GetAttributeCache(ctx, record.AttributeCacheID)
+		if cacheErr != nil {
+			h.logger.Error(ctx, "Failed to get user attributes from attribute cache",
+				log.String("error", cacheErr.ErrorDescription.DefaultValue))
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to get user attributes from attribute cache",
+			}
+		}
+		attrs = cacheEntry.Attributes
+	}
+
+	userSubConfig := oauthApp.UserAccessTokenConfig()
+	accessTokenCtx := &tokenservice.AccessTokenBuildContext{
+		Subject:           record.UserID,
+		Audiences:         accessTokenAudiences,
+		ClientID:          oauthApp.ClientID,
+		Scopes:            accessTokenScopes,
+		SubjectAttributes: tokenservice.FilterAttributesByAllowList(attrs, userSubConfig),
+		AttributeCacheID:  record.AttributeCacheID,
+		GrantType:         string(providers.GrantTypeDeviceCode),
+		OAuthApp:          oauthApp,
+		ValidityPeriod:    userSubConfig.ValidityPeriodOrZero(),
+		DPoPJkt:           dpop.GetJkt(ctx),
+		CertThumbprint:    mtls.GetThumbprint(ctx),
+	}
+	if oauthApp.ShouldAppendActorClaim() {
+		accessTokenCtx.ActorClaims = &tokenservice.SubjectTokenClaims{Sub: oauthApp.ID}
+	}
+	accessToken, err := h.tokenBuilder.BuildAccessToken(ctx, accessTokenCtx)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to generate access token", log.Error(err))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+	// The refresh token preserves this single audience for continuity.
+	accessToken.OriginalAudiences = accessTokenAudiences
+
+	tokenResponse := &model.TokenResponseDTO{
+		AccessToken: *accessToken,
+	}
+
+	if slices.Contains(accessTokenScopes, constants.ScopeOpenID) {
+		idToken, idErr := h.tokenBuilder.BuildIDToken(ctx, &tokenservice.IDTokenBuildContext{
+			Subject:        record.UserID,
+			Audience:       oauthApp.ClientID,
+			Scopes:         accessTokenScopes,
+			UserAttributes: attrs,
+			AuthTime:       record.AuthTime.Unix(),
+			OAuthApp:       oauthApp,
+			CompletedACR:   record.CompletedACR,
+		})
+		if idErr != nil {
+			h.logger.Error(ctx, "Failed to generate ID token", log.Error(idErr))
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to generate token",
+			}
+		}
+		tokenResponse.IDToken = *idToken
+	}
+
+	// Atomically consume the request to enforce one-time use. If another concurrent poll already
+	// consumed it, reject this one with invalid_grant.
+	consumed, consumeErr := h.deviceService.MarkConsumed(ctx, record.ID)
+	if consumeErr != nil {
+		h.logger.Error(ctx, "Failed to consume device authorization request", log.Error(consumeErr))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to process token request",
+		}
+	}
+	if !consumed {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "The device_code has already been used",
+		}
+	}
+
+	return tokenResponse, nil
+}
+
+// resolveIssuedAudiencesAndScopes derives the access-token audiences and scopes for a device record.
+// A resource-bound record yields the RS identifier as the sole audience, with permission scopes
+// refiltered against that RS. An unbound record uses the app's configured default audience, falling
+// back to the client_id; an unbound record that unexpectedly carries permission scopes is rejected.
+func (h *deviceCodeGrantHandler) resolveIssuedAudiencesAndScopes(ctx context.Context,
+	record *device.DeviceAuthRequest, oauthApp *providers.OAuthClient, scopeStr string,
+) ([]string, []string, *model.ErrorResponse) {
+	oidcScopes, permissionScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(scopeStr, oauthApp.ScopeClaims)
+
+	if len(record.Resources) == 0 {
+		if len(permissionScopes) > 0 {
+			return nil, nil, &model.ErrorResponse{
+				Error:            constants.ErrorInvalidGrant,
+				ErrorDescription: "The authorization request is not bound to a resource server",
+			}
+		}
+		return []string{oauthApp.ResolveDefaultAudience(oauthApp.ClientID)}, oidcScopes, nil
+	}
+
+	resourceIdentifier := record.Resources[0]
+	rs, svcErr := h.resourceService.GetResourceServerByIdentifier(ctx, resourceIdentifier)
+	if svcErr != nil {
+		h.logger.Error(ctx, "Failed to resolve stored device authorization resource server",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+		return nil, nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to process token request",
+		}
+	}
+
+	downscoped, dErr := resourceindicators.DownscopeToResourceServer(
+		ctx, h.resourceService, rs.ID, permissionScopes)
+	if dErr != nil {
+		return nil, nil, dErr
+	}
+
+	scopes := make([]string, 0, len(oidcScopes)+len(downscoped))
+	scopes = append(scopes, oidcScopes...)
+	scopes = append(scopes, downscoped...)
+	return []string{resourceIdentifier}, scopes, nil
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package granthandlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/devicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+)
+
+const testDeviceResourceURL = "https://api.example.com"
+
+type DeviceCodeGrantHandlerTestSuite struct {
+	suite.Suite
+	handler              GrantHandlerInterface
+	mockDeviceService    *devicemock.DeviceServiceInterfaceMock
+	mockTokenBuilder     *tokenservicemock.TokenBuilderInterfaceMock
+	mockAttrCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockResource         *resourcemock.ResourceServiceInterfaceMock
+	oauthApp             *providers.OAuthClient
+	tokenReq             *model.TokenRequest
+}
+
+func TestDeviceCodeGrantHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceCodeGrantHandlerTestSuite))
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) SetupTest() {
+	suite.mockDeviceService = devicemock.NewDeviceServiceInterfaceMock(suite.T())
+	suite.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
+	suite.mockAttrCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
+	suite.mockResource = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.handler = newDeviceCodeGrantHandler(suite.mockDeviceService, suite.mockTokenBuilder,
+		suite.mockAttrCacheService, suite.mockResource)
+	suite.oauthApp = &providers.OAuthClient{
+		ClientID: "client-1",
+		ScopeClaims: map[string][]string{
+			"openid":  {"sub"},
+			"profile": {"name"},
+		},
+	}
+	suite.tokenReq = &model.TokenRequest{
+		GrantType:  string(providers.GrantTypeDeviceCode),
+		ClientID:   "client-1",
+		DeviceCode: "device-code-1",
+	}
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) pendingRecord() *device.DeviceAuthRequest {
+	return &device.DeviceAuthRequest{
+		ID:               "request-1",
+		ClientID:         "client-1",
+		UserID:           "user-1",
+		AuthorizedScopes: "openid profile",
+		State:            device.DeviceStatePending,
+		Interval:         5,
+		ExpiryTime:       time.Now().Add(5 * time.Minute),
+	}
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) expectRecord(record *device.DeviceAuthRequest) {
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").Return(record, nil)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_Success() {
+	suite.expectRecord(suite.pendingRecord())
+
+	errResp := suite.handler.ValidateGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(errResp)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_WrongGrantType() {
+	req := &model.TokenRequest{GrantType: string(providers.GrantTypeRefreshToken), DeviceCode: "x"}
+
+	errResp := suite.handler.ValidateGrant(context.Background(), req, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorUnsupportedGrantType, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_MissingDeviceCode() {
+	req := &model.TokenRequest{GrantType: string(providers.GrantTypeDeviceCode)}
+
+	errResp := suite.handler.ValidateGrant(context.Background(), req, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidRequest, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_RequestNotFound() {
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").
+		Return(nil, device.ErrDeviceRequestNotFound)
+
+	errResp := suite.handler.ValidateGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_StoreError() {
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").
+		Return(nil, errors.New("db error"))
+
+	errResp := suite.handler.ValidateGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorServerError, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_ClientMismatch() {
+	record := suite.pendingRecord()
+	record.ClientID = "other-client"
+	suite.expectRecord(record)
+
+	errResp := suite.handler.ValidateGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_ResourceMismatch() {
+	record := suite.pendingRecord()
+	record.Resources = []string{testDeviceResourceURL}
+	suite.expectRecord(record)
+	suite.tokenReq.Resources = []string{"https://other.example.com"}
+
+	errResp := suite.handler.ValidateGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidTarget, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_FirstPollIsPending() {
+	suite.expectRecord(suite.pendingRecord())
+	suite.mockDeviceService.EXPECT().UpdateLastPolled(mock.Anything, "request-1", mock.Anything,
+		mock.AnythingOfType("time.Time"), int64(5)).Return(nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(resp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_PollAfterIntervalIsPending() {
+	record := suite.pendingRecord()
+	record.LastPolledAt = time.Now().Add(-6 * time.Second)
+	suite.expectRecord(record)
+	suite.mockDeviceService.EXPECT().UpdateLastPolled(mock.Anything, "request-1", mock.Anything,
+		mock.AnythingOfType("time.Time"), int64(5)).Return(nil)
+
+	_, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorAuthorizationPending, errResp.Error)
+}
+
+// Polling faster than the interval returns slow_down and permanently raises the interval by five seconds.
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_PollTooFastSlowsDown() {
+	record := suite.pendingRecord()
+	record.LastPolledAt = time.Now().Add(-time.Second)
+	suite.expectRecord(record)
+	suite.mockDeviceService.EXPECT().UpdateLastPolled(mock.Anything, "request-1", mock.Anything,
+		mock.AnythingOfType("time.Time"), int64(10)).Return(nil)
+
+	_, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorSlowDown, errResp.Error)
+}
+
+// A poll that loses the race to record its poll time against a concurrent poll returns slow_down.
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_ConcurrentPollSlowsDown() {
+	record := suite.pendingRecord()
+	record.LastPolledAt = time.Now().Add(-6 * time.Second)
+	suite.expectRecord(record)
+	suite.mockDeviceService.EXPECT().UpdateLastPolled(mock.Anything, "request-1", record.LastPolledAt,
+		mock.AnythingOfType("time.Time"), int64(5)).Return(device.ErrDevicePollConflict)
+
+	_, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorSlowDown, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_Expired() {
+	record := suite.pendingRecord()
+	record.ExpiryTime = time.Now().Add(-time.Minute)
+	suite.expectRecord(record)
+	suite.mockDeviceService.EXPECT().UpdateState(mock.Anything, "request-1", device.DeviceStateExpired).
+		Return(nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(resp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorExpiredToken, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_TerminalStates() {
+	tests := []struct {
+		name          string
+		state         device.DeviceRequestState
+		expectedError string
+	}{
+		{"Denied", device.DeviceStateDenied, constants.ErrorAccessDenied},
+		{"Failed", device.DeviceStateFailed, constants.ErrorServerError},
+		{"Consumed", device.DeviceStateConsumed, constants.ErrorInvalidGrant},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			record := suite.pendingRecord()
+			record.State = tt.state
+			suite.expectRecord(record)
+
+			resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+			suite.Nil(resp)
+			suite.NotNil(errResp)
+			suite.Equal(tt.expectedError, errResp.Error)
+		})
+	}
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_Authenticated_IssuesTokens() {
+	record := suite.pendingRecord()
+	record.State = device.DeviceStateAuthenticated
+	record.AttributeCacheID = "cache-1"
+	record.CompletedACR = "urn:acr:pwd"
+	record.AuthTime = time.Now()
+	suite.expectRecord(record)
+	suite.mockAttrCacheService.EXPECT().GetAttributeCache(mock.Anything, "cache-1").Return(
+		&attributecache.AttributeCache{ID: "cache-1", Attributes: map[string]interface{}{"name": "Alice"}}, nil)
+	suite.mockTokenBuilder.EXPECT().BuildAccessToken(mock.Anything, mock.MatchedBy(
+		func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return ctx.Subject == "user-1" && ctx.ClientID == "client-1" &&
+				ctx.GrantType == string(providers.GrantTypeDeviceCode)
+		})).Return(&model.TokenDTO{Token: "access-token", TokenType: "Bearer", ExpiresIn: 3600}, nil)
+	suite.mockTokenBuilder.EXPECT().BuildIDToken(mock.Anything, mock.MatchedBy(
+		func(ctx *tokenservice.IDTokenBuildContext) bool {
+			return ctx.Subject == "user-1" && ctx.CompletedACR == "urn:acr:pwd"
+		})).Return(&model.TokenDTO{Token: "id-token"}, nil)
+	suite.mockDeviceService.EXPECT().MarkConsumed(mock.Anything, "request-1").Return(true, nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(errResp)
+	suite.NotNil(resp)
+	suite.Equal("access-token", resp.AccessToken.Token)
+	suite.Equal("id-token", resp.IDToken.Token)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_Authenticated_BoundResource() {
+	record := suite.pendingRecord()
+	record.State = device.DeviceStateAuthenticated
+	record.AuthorizedScopes = testScopeRead
+	record.Resources = []string{testDeviceResourceURL}
+	suite.expectRecord(record)
+	suite.mockResource.EXPECT().GetResourceServerByIdentifier(mock.Anything, testDeviceResourceURL).
+		Return(&providers.ResourceServer{ID: "rs-1", Identifier: testDeviceResourceURL}, nil)
+	suite.mockResource.EXPECT().ValidatePermissions(mock.Anything, "rs-1", mock.Anything).
+		Return([]string{}, nil)
+	suite.mockTokenBuilder.EXPECT().BuildAccessToken(mock.Anything, mock.MatchedBy(
+		func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return len(ctx.Audiences) == 1 && ctx.Audiences[0] == testDeviceResourceURL
+		})).Return(&model.TokenDTO{Token: "access-token", TokenType: "Bearer"}, nil)
+	suite.mockDeviceService.EXPECT().MarkConsumed(mock.Anything, "request-1").Return(true, nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(errResp)
+	suite.NotNil(resp)
+	suite.Empty(resp.IDToken.Token)
+}
+
+// A concurrent poll that loses the consume race must not receive tokens.
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_Authenticated_AlreadyConsumed() {
+	record := suite.pendingRecord()
+	record.State = device.DeviceStateAuthenticated
+	suite.expectRecord(record)
+	suite.mockTokenBuilder.EXPECT().BuildAccessToken(mock.Anything, mock.Anything).Return(
+		&model.TokenDTO{Token: "access-token"}, nil)
+	suite.mockTokenBuilder.EXPECT().BuildIDToken(mock.Anything, mock.Anything).Return(
+		&model.TokenDTO{Token: "id-token"}, nil)
+	suite.mockDeviceService.EXPECT().MarkConsumed(mock.Anything, "request-1").Return(false, nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(resp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidGrant, errResp.Error)
+}
@@ -35,6 +35,7 @@ type RefreshTokenGrantHandlerInterface interface {
 		claimsLocales string,
 		attributeCacheID string,
 		tokenFamilyID string,
+		sessionID string,
 		expiresAt int64,
 	) *model.ErrorResponse
 }
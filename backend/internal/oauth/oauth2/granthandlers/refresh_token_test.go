@@ -350,6 +350,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_Success()
 				ctx.GrantType == "authorization_code" &&
 				ctx.AccessTokenSubject == testRefreshTokenUserID &&
 				ctx.TokenFamilyID == "tfid-issue-refresh" &&
+				ctx.SessionID == "sid-issue-refresh" &&
 				len(ctx.AccessTokenAudiences) == 1 && ctx.AccessTokenAudiences[0] == testRefreshTokenAudience
 		})).Return(&model.TokenDTO{
 		Token:     "new.refresh.token",
@@ -364,7 +365,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_Success()
 
 	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp,
 		testRefreshTokenUserID, []string{testRefreshTokenAudience},
-		"authorization_code", []string{"read", "write"}, nil, "", "", "tfid-issue-refresh", 0)
+		"authorization_code", []string{"read", "write"}, nil, "", "", "tfid-issue-refresh", "sid-issue-refresh", 0)
 
 	assert.Nil(suite.T(), err)
 	assert.NotNil(suite.T(), tokenResponse.RefreshToken)
@@ -384,7 +385,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_JWTGenerat
 	tokenResponse := &model.TokenResponseDTO{}
 
 	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp, "", nil,
-		"authorization_code", []string{"read"}, nil, "", "", "", 0)
+		"authorization_code", []string{"read"}, nil, "", "", "", "", 0)
 
 	assert.NotNil(suite.T(), err)
 	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
@@ -404,7 +405,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_WithEmptyT
 	tokenResponse := &model.TokenResponseDTO{}
 
 	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp, "", nil,
-		"authorization_code", []string{"read"}, nil, "", "", "", 0)
+		"authorization_code", []string{"read"}, nil, "", "", "", "", 0)
 
 	assert.Nil(suite.T(), err)
 }
@@ -429,7 +430,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_WithClaims
 
 	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp,
 		testRefreshTokenUserID, []string{testRefreshTokenAudience},
-		"authorization_code", []string{"read"}, nil, "en-US fr-CA ja", "", "", 0)
+		"authorization_code", []string{"read"}, nil, "en-US fr-CA ja", "", "", "", 0)
 
 	assert.Nil(suite.T(), err)
 	assert.NotNil(suite.T(), tokenResponse.RefreshToken)
@@ -459,7 +460,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_AgentClien
 	tokenResponse := &model.TokenResponseDTO{}
 	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, agentApp,
 		testRefreshTokenUserID, []string{testRefreshTokenAudience},
-		"authorization_code", []string{"read"}, nil, "", "", "", 0)
+		"authorization_code", []string{"read"}, nil, "", "", "", "", 0)
 
 	assert.Nil(suite.T(), err)
 	assert.Equal(suite.T(), actAppID, capturedActorSub)
@@ -488,7 +489,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_AppClientW
 	tokenResponse := &model.TokenResponseDTO{}
 	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, appApp,
 		testRefreshTokenUserID, []string{testRefreshTokenAudience},
-		"authorization_code", []string{"read"}, nil, "", "", "", 0)
+		"authorization_code", []string{"read"}, nil, "", "", "", "", 0)
 
 	assert.Nil(suite.T(), err)
 	assert.Empty(suite.T(), capturedActorSub)
@@ -2071,7 +2072,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_PublicClie
 
 	err := suite.handler.IssueRefreshToken(ctx, tokenResponse, suite.oauthApp,
 		testRefreshTokenUserID, []string{testRefreshTokenAudience},
-		"authorization_code", []string{"read"}, nil, "", "", "", 0)
+		"authorization_code", []string{"read"}, nil, "", "", "", "", 0)
 
 	assert.Nil(suite.T(), err)
 	suite.mockTokenBuilder.AssertExpectations(suite.T())
@@ -2093,7 +2094,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_Confidenti
 
 	err := suite.handler.IssueRefreshToken(ctx, tokenResponse, suite.oauthApp,
 		testRefreshTokenUserID, []string{testRefreshTokenAudience},
-		"authorization_code", []string{"read"}, nil, "", "", "", 0)
+		"authorization_code", []string{"read"}, nil, "", "", "", "", 0)
 
 	assert.Nil(suite.T(), err)
 	suite.mockTokenBuilder.AssertExpectations(suite.T())
@@ -9,12 +9,17 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
 // TokenIntrospectionServiceInterface defines the interface for OAuth 2.0 token introspection.
@@ -24,15 +29,21 @@ type TokenIntrospectionServiceInterface interface {
 
 // tokenIntrospectionService implements the TokenIntrospectionServiceInterface.
 type tokenIntrospectionService struct {
-	tokenValidator tokenservice.TokenValidatorInterface
+	tokenValidator  tokenservice.TokenValidatorInterface
+	actorProvider   providers.ActorProvider
+	pairwiseService pairwise.PairwiseSubjectServiceInterface
 }
 
 // newTokenIntrospectionService creates a new tokenIntrospectionService instance (internal use).
 func newTokenIntrospectionService(
 	tokenValidator tokenservice.TokenValidatorInterface,
+	actorProvider providers.ActorProvider,
+	pairwiseService pairwise.PairwiseSubjectServiceInterface,
 ) TokenIntrospectionServiceInterface {
 	return &tokenIntrospectionService{
-		tokenValidator: tokenValidator,
+		tokenValidator:  tokenValidator,
+		actorProvider:   actorProvider,
+		pairwiseService: pairwiseService,
 	}
 }
 
@@ -61,7 +72,47 @@ func (s *tokenIntrospectionService) IntrospectToken(
 		}, nil
 	}
 
-	return s.prepareValidResponse(payload), nil
+	response := s.prepareValidResponse(payload)
+	if err := s.applyPairwiseSubject(ctx, payload, response); err != nil {
+		if errors.Is(err, errPairwiseClientNotFound) {
+			logger.Debug(ctx, "Token client could not be resolved", log.String("clientId", response.ClientID))
+			return &IntrospectResponse{
+				Active: false,
+			}, nil
+		}
+		logger.Error(ctx, "Failed to resolve the token client", log.Error(err))
+		return nil, err
+	}
+	return response, nil
+}
+
+// errPairwiseClientNotFound is returned when the client a token was issued to no longer resolves.
+var errPairwiseClientNotFound = errors.New("token client not found")
+
+// applyPairwiseSubject replaces the local subject in the response with the pairwise identifier the
+// token's client sees, so a pairwise relying party never learns the local subject through introspection.
+// Client credentials tokens carry the client itself as the subject and are left untouched.
+func (s *tokenIntrospectionService) applyPairwiseSubject(
+	ctx context.Context, payload map[string]interface{}, response *IntrospectResponse,
+) error {
+	if s.pairwiseService == nil || s.actorProvider == nil || response.Sub == "" || response.ClientID == "" {
+		return nil
+	}
+	if grantType, _ := payload["grant_type"].(string); grantType == string(providers.GrantTypeClientCredentials) {
+		return nil
+	}
+
+	client, svcErr := s.actorProvider.GetOAuthClientByClientID(ctx, response.ClientID)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			return errPairwiseClientNotFound
+		}
+		return fmt.Errorf("failed to resolve client %q: %s", response.ClientID, svcErr.Code)
+	}
+	if client.IsPairwise() {
+		response.Sub = s.pairwiseService.GetSubject(client, response.Sub)
+	}
+	return nil
 }
 
 // validateByType validates the token with the validator for its typ header and returns its claims.
@@ -75,7 +126,7 @@ func (s *tokenIntrospectionService) validateByType(
 
 	switch typ, _ := header["typ"].(string); typ {
 	case jwt.TokenTypeAccessToken:
-		claims, validateErr := s.tokenValidator.ValidateAccessToken(ctx, token)
+		claims, validateErr := s.tokenValidator.IntrospectAccessToken(ctx, token)
 		if validateErr != nil {
 			return nil, validateErr
 		}
@@ -103,6 +154,13 @@ func (s *tokenIntrospectionService) prepareValidResponse(payload map[string]inte
 		response.TokenType = constants.TokenTypeDPoP
 	}
 
+	if thumbprint, _ := mtls.ExtractCnfX5tS256(payload); thumbprint != "" {
+		if response.Cnf == nil {
+			response.Cnf = &CnfClaim{}
+		}
+		response.Cnf.X5tS256 = thumbprint
+	}
+
 	if scope, ok := payload["scope"].(string); ok {
 		response.Scope = scope
 	}
@@ -146,6 +204,7 @@ func (s *tokenIntrospectionService) prepareValidResponse(payload map[string]inte
 	if jti, ok := payload["jti"].(string); ok {
 		response.Jti = jti
 	}
+	response.AuthorizationDetails = authorizationdetails.FromClaim(payload[constants.ClaimAuthorizationDetails])
 
 	return response
 }
@@ -13,6 +13,10 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
 
 	"github.com/stretchr/testify/assert"
@@ -32,7 +36,7 @@ func TestTokenIntrospectionServiceTestSuite(t *testing.T) {
 
 func (s *TokenIntrospectionServiceTestSuite) SetupTest() {
 	s.tokenValidatorMock = tokenservicemock.NewTokenValidatorInterfaceMock(s.T())
-	s.introspectService = newTokenIntrospectionService(s.tokenValidatorMock)
+	s.introspectService = newTokenIntrospectionService(s.tokenValidatorMock, nil, nil)
 }
 
 // tokenWithTyp builds a syntactically valid JWT whose typ header selects the validator the service
@@ -54,14 +58,14 @@ func genericTokenFor(id string) string { return tokenWithTyp(jwt.TokenTypeJWT, i
 
 // stubAccessToken makes the token resolve as a valid access token carrying the given raw claims.
 func (s *TokenIntrospectionServiceTestSuite) stubAccessToken(token string, claims map[string]interface{}) {
-	s.tokenValidatorMock.On("ValidateAccessToken", mock.Anything, token).
+	s.tokenValidatorMock.On("IntrospectAccessToken", mock.Anything, token).
 		Return(&tokenservice.AccessTokenClaims{Claims: claims}, nil)
 }
 
 // stubAccessTokenError makes an at+jwt fixture fail validation. Only the access-token validator is
 // stubbed because the typ header routes the token there and no fallback runs.
 func (s *TokenIntrospectionServiceTestSuite) stubAccessTokenError(token string, err error) {
-	s.tokenValidatorMock.On("ValidateAccessToken", mock.Anything, token).Return(nil, err)
+	s.tokenValidatorMock.On("IntrospectAccessToken", mock.Anything, token).Return(nil, err)
 }
 
 func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_EmptyToken() {
@@ -154,7 +158,7 @@ func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_RevokedToken_Is
 // than asserting the token is active. The refresh path is never reached, so a revocation outage
 // cannot be masked by falling through to the next validator.
 func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_EnforcementUnavailable_FailsClosed() {
-	s.tokenValidatorMock.On("ValidateAccessToken", mock.Anything, accessTokenFor("some-token")).
+	s.tokenValidatorMock.On("IntrospectAccessToken", mock.Anything, accessTokenFor("some-token")).
 		Return(nil, revocation.ErrEnforcementUnavailable)
 
 	response, err := s.introspectService.IntrospectToken(context.Background(), accessTokenFor("some-token"), "")
@@ -214,7 +218,7 @@ func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_UnsupportedToke
 			assert.NoError(s.T(), err)
 			assert.NotNil(s.T(), response)
 			assert.False(s.T(), response.Active)
-			s.tokenValidatorMock.AssertNotCalled(s.T(), "ValidateAccessToken", mock.Anything, token)
+			s.tokenValidatorMock.AssertNotCalled(s.T(), "IntrospectAccessToken", mock.Anything, token)
 			s.tokenValidatorMock.AssertNotCalled(s.T(), "ValidateRefreshToken", mock.Anything, token)
 		})
 	}
@@ -249,3 +253,127 @@ func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_DPoPBoundToken_
 	assert.NotNil(s.T(), response.Cnf)
 	assert.Equal(s.T(), "thumbprint-abc", response.Cnf.Jkt)
 }
+
+// A token carrying cnf.x5t#S256 keeps the Bearer token type and surfaces the certificate thumbprint.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_CertificateBoundToken_SurfacesCnf() {
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"client_id": "client123",
+		"cnf":       map[string]interface{}{"x5t#S256": "cert-thumbprint"},
+	}
+	s.stubAccessToken(accessTokenFor("mtls-token"), claims)
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), accessTokenFor("mtls-token"), "")
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), response)
+	assert.Equal(s.T(), constants.TokenTypeBearer, response.TokenType)
+	assert.NotNil(s.T(), response.Cnf)
+	assert.Equal(s.T(), "cert-thumbprint", response.Cnf.X5tS256)
+	assert.Empty(s.T(), response.Cnf.Jkt)
+}
+
+// A token carrying authorization_details surfaces them in the introspection response (RFC 9396 §9.2).
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_AuthorizationDetails() {
+	claims := map[string]interface{}{
+		"sub": "user123",
+		"authorization_details": []interface{}{
+			map[string]interface{}{"type": "account_information", "actions": []interface{}{"list_accounts"}},
+		},
+	}
+	s.stubAccessToken(accessTokenFor("rar-token"), claims)
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), accessTokenFor("rar-token"), "")
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), response)
+	if assert.Len(s.T(), response.AuthorizationDetails, 1) {
+		assert.Equal(s.T(), "account_information", response.AuthorizationDetails[0].Type())
+	}
+}
+
+// newPairwiseIntrospectService wires the service with actor and pairwise mocks for subject mapping tests.
+func (s *TokenIntrospectionServiceTestSuite) newPairwiseIntrospectService() (
+	TokenIntrospectionServiceInterface, *actorprovidermock.ActorProviderMock,
+	*pairwisemock.PairwiseSubjectServiceInterfaceMock,
+) {
+	actorProvider := actorprovidermock.NewActorProviderMock(s.T())
+	pairwiseService := pairwisemock.NewPairwiseSubjectServiceInterfaceMock(s.T())
+	return newTokenIntrospectionService(s.tokenValidatorMock, actorProvider, pairwiseService),
+		actorProvider, pairwiseService
+}
+
+// A token issued to a pairwise client reports the pairwise subject that client sees.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_PairwiseClient_MapsSubject() {
+	service, actorProvider, pairwiseService := s.newPairwiseIntrospectService()
+	client := &providers.OAuthClient{ClientID: "client123", SubjectType: providers.SubjectTypePairwise}
+	s.stubAccessToken(accessTokenFor("pairwise-token"), map[string]interface{}{
+		"sub": "user123", "client_id": "client123",
+	})
+	actorProvider.On("GetOAuthClientByClientID", mock.Anything, "client123").Return(client, nil)
+	pairwiseService.On("GetSubject", client, "user123").Return("pairwise-sub")
+
+	response, err := service.IntrospectToken(context.Background(), accessTokenFor("pairwise-token"), "")
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), response.Active)
+	assert.Equal(s.T(), "pairwise-sub", response.Sub)
+}
+
+// A token issued to a public-subject client keeps its local subject.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_PublicClient_KeepsSubject() {
+	service, actorProvider, _ := s.newPairwiseIntrospectService()
+	s.stubAccessToken(accessTokenFor("public-token"), map[string]interface{}{
+		"sub": "user123", "client_id": "client123",
+	})
+	actorProvider.On("GetOAuthClientByClientID", mock.Anything, "client123").
+		Return(&providers.OAuthClient{ClientID: "client123"}, nil)
+
+	response, err := service.IntrospectToken(context.Background(), accessTokenFor("public-token"), "")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "user123", response.Sub)
+}
+
+// Client credentials tokens carry the client as the subject, so no client lookup is made.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_ClientCredentials_SkipsPairwise() {
+	service, _, _ := s.newPairwiseIntrospectService()
+	s.stubAccessToken(accessTokenFor("cc-token"), map[string]interface{}{
+		"sub": "client123", "client_id": "client123", "grant_type": string(providers.GrantTypeClientCredentials),
+	})
+
+	response, err := service.IntrospectToken(context.Background(), accessTokenFor("cc-token"), "")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "client123", response.Sub)
+}
+
+// A token whose client no longer exists is reported inactive rather than leaking the local subject.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_UnknownClient_IsInactive() {
+	service, actorProvider, _ := s.newPairwiseIntrospectService()
+	s.stubAccessToken(accessTokenFor("orphan-token"), map[string]interface{}{
+		"sub": "user123", "client_id": "client123",
+	})
+	actorProvider.On("GetOAuthClientByClientID", mock.Anything, "client123").
+		Return(nil, &tidcommon.ServiceError{Type: tidcommon.ClientErrorType, Code: "APP-1001"})
+
+	response, err := service.IntrospectToken(context.Background(), accessTokenFor("orphan-token"), "")
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), response.Active)
+}
+
+// A server error while resolving the client fails the introspection.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_ClientLookupServerError() {
+	service, actorProvider, _ := s.newPairwiseIntrospectService()
+	s.stubAccessToken(accessTokenFor("lookup-error-token"), map[string]interface{}{
+		"sub": "user123", "client_id": "client123",
+	})
+	actorProvider.On("GetOAuthClientByClientID", mock.Anything, "client123").
+		Return(nil, &tidcommon.InternalServerError)
+
+	response, err := service.IntrospectToken(context.Background(), accessTokenFor("lookup-error-token"), "")
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), response)
+}
@@ -1,9 +1,10 @@
 // Copyright 2026 The ThunderID Authors
 // SPDX-License-Identifier: Apache-2.0
 
-// Package jwksresolver provides utilities for resolving RSA encryption keys from
-// a relying party's JWKS (inline or remote URI). It is intentionally RSA- and
-// encryption-specific and is not a general-purpose JWK resolver.
+// Package jwksresolver provides utilities for resolving keys from a relying party's
+// JWKS (inline or remote URI): encryption keys for the responses sent to the RP, and
+// signature verification keys for the request objects it sends. It is not a
+// general-purpose JWK resolver.
 package jwksresolver
 
 import (
@@ -83,6 +84,39 @@ func (r *Resolver) ResolveEncryptionKey(
 	return r.parseEncryptionKeyFromJWKS(ctx, jwksData, encryptionAlg, policy)
 }
 
+// ResolveVerificationKey resolves the RP's public key for verifying a JWS it signed with signingAlg.
+// When kid is set only the JWK with that kid is considered; otherwise the JWKS must hold exactly one
+// candidate key, so that the choice of key is never ambiguous.
+func (r *Resolver) ResolveVerificationKey(
+	ctx context.Context,
+	certificate *inboundmodel.Certificate,
+	kid string,
+	signingAlg string,
+) (map[string]interface{}, *tidcommon.ServiceError) {
+	if certificate == nil || certificate.Type == "" {
+		r.logger.Debug(ctx, "No certificate configured for verification key resolution")
+		return nil, &tidcommon.InternalServerError
+	}
+
+	var jwksData []byte
+	switch certificate.Type {
+	case certmodel.CertificateTypeJWKS:
+		jwksData = []byte(certificate.Value)
+	case certmodel.CertificateTypeJWKSURI:
+		body, svcErr := r.fetchJWKS(ctx, certificate.Value)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		jwksData = body
+	default:
+		r.logger.Debug(ctx, "Unsupported certificate type for verification key resolution",
+			log.String("type", string(certificate.Type)))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return r.parseVerificationKeyFromJWKS(ctx, jwksData, kid, signingAlg)
+}
+
 // fetchJWKS fetches the JWKS document from the given URI with SSRF protection and a 1 MB size cap.
 // It does not log JWKS body, key material, or HTTP response headers.
 func (r *Resolver) fetchJWKS(ctx context.Context, jwksURI string) ([]byte, *tidcommon.ServiceError) {
@@ -175,6 +209,64 @@ func (r *Resolver) parseEncryptionKeyFromJWKS(ctx context.Context,
 	return nil, "", &tidcommon.InternalServerError
 }
 
+// parseVerificationKeyFromJWKS finds the signature verification key in the JWKS for kid and signingAlg.
+func (r *Resolver) parseVerificationKeyFromJWKS(ctx context.Context,
+	jwksData []byte,
+	kid string,
+	signingAlg string,
+) (map[string]interface{}, *tidcommon.ServiceError) {
+	var jwksObj struct {
+		Keys []map[string]interface{} `json:"keys"`
+	}
+	if err := json.Unmarshal(jwksData, &jwksObj); err != nil {
+		r.logger.Debug(ctx, "Failed to parse JWKS for verification key resolution", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	var candidate map[string]interface{}
+	for _, key := range jwksObj.Keys {
+		if keyID, _ := key["kid"].(string); kid != "" && keyID != kid {
+			continue
+		}
+		// Keys published only for encryption must not verify signatures (RFC 7517 §4.2).
+		if use, _ := key["use"].(string); use != "" && use != "sig" {
+			continue
+		}
+		if keyAlg, _ := key["alg"].(string); keyAlg != "" && keyAlg != signingAlg {
+			continue
+		}
+		if kty, _ := key["kty"].(string); kty == "" || kty != keyTypeForSigningAlg(signingAlg) {
+			continue
+		}
+		if candidate != nil {
+			r.logger.Debug(ctx, "Multiple candidate verification keys found in JWKS",
+				log.String("alg", signingAlg))
+			return nil, &tidcommon.InternalServerError
+		}
+		candidate = key
+	}
+	if candidate == nil {
+		r.logger.Debug(ctx, "No suitable verification key found in JWKS", log.String("alg", signingAlg))
+		return nil, &tidcommon.InternalServerError
+	}
+	return candidate, nil
+}
+
+// keyTypeForSigningAlg returns the JWK "kty" required by the given JWS algorithm: "RSA" for the
+// RS and PS families, "EC" for ES, and "OKP" for EdDSA.
+func keyTypeForSigningAlg(signingAlg string) string {
+	switch {
+	case strings.HasPrefix(signingAlg, "RS"), strings.HasPrefix(signingAlg, "PS"):
+		return "RSA"
+	case strings.HasPrefix(signingAlg, "ES"):
+		return "EC"
+	case signingAlg == "EdDSA":
+		return "OKP"
+	default:
+		return ""
+	}
+}
+
 // keyTypeForEncryptionAlg returns the JWK "kty" required by the given JWE key encryption
 // algorithm: "RSA" for RSA-OAEP variants, "EC" for ECDH-ES variants.
 func keyTypeForEncryptionAlg(encryptionAlg string) string {
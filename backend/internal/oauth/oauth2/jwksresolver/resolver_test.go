@@ -357,3 +357,81 @@ func (suite *ResolverTestSuite) TestParseEncryptionKeyFromJWKS_SigUseKey_Lenient
 	assert.Nil(suite.T(), pub) // use="sig" is explicitly non-enc; skipped in both policies
 	assert.NotNil(suite.T(), svcErr)
 }
+
+// ---------------------------------------------------------------------------
+// ResolveVerificationKey
+// ---------------------------------------------------------------------------
+
+func (suite *ResolverTestSuite) TestResolveVerificationKey_NilCertificate() {
+	r := newJWKSResolver(nil)
+	pub, svcErr := r.ResolveVerificationKey(context.Background(), nil, "k1", "RS256")
+	assert.Nil(suite.T(), pub)
+	assert.NotNil(suite.T(), svcErr)
+}
+
+func (suite *ResolverTestSuite) TestResolveVerificationKey_InlineJWKS_MatchesKid() {
+	jwks := multiKeyJWKS(
+		map[string]interface{}{"kty": "RSA", "kid": "k1", "use": "sig", "n": "n1", "e": "AQAB"},
+		map[string]interface{}{"kty": "RSA", "kid": "k2", "n": "n2", "e": "AQAB"},
+	)
+
+	r := newJWKSResolver(nil)
+	cert := &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKS, Value: jwks}
+	pub, svcErr := r.ResolveVerificationKey(context.Background(), cert, "k2", "PS256")
+	suite.Require().Nil(svcErr)
+	suite.Equal("k2", pub["kid"])
+}
+
+func (suite *ResolverTestSuite) TestResolveVerificationKey_JWKSURI_Success() {
+	jwks := `{"keys":[{"kty":"EC","kid":"ec1","crv":"P-256","x":"x","y":"y"}]}`
+	mockHTTP := httpmock.NewHTTPClientInterfaceMock(suite.T())
+	mockHTTP.On("Do", mock.Anything).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(jwks)),
+	}, nil)
+
+	r := newJWKSResolver(mockHTTP)
+	cert := &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKSURI, Value: testJWKSURI}
+	pub, svcErr := r.ResolveVerificationKey(context.Background(), cert, "ec1", "ES256")
+	suite.Require().Nil(svcErr)
+	suite.Equal("EC", pub["kty"])
+}
+
+func (suite *ResolverTestSuite) TestParseVerificationKeyFromJWKS_SkipsEncKeys() {
+	jwks := `{"keys":[{"kty":"RSA","kid":"k1","use":"enc","n":"n","e":"AQAB"}]}`
+	r := newJWKSResolver(nil)
+	pub, svcErr := r.parseVerificationKeyFromJWKS(context.Background(), []byte(jwks), "k1", "RS256")
+	assert.Nil(suite.T(), pub)
+	assert.NotNil(suite.T(), svcErr)
+}
+
+func (suite *ResolverTestSuite) TestParseVerificationKeyFromJWKS_KeyTypeMismatch() {
+	jwks := `{"keys":[{"kty":"RSA","kid":"k1","n":"n","e":"AQAB"}]}`
+	r := newJWKSResolver(nil)
+	pub, svcErr := r.parseVerificationKeyFromJWKS(context.Background(), []byte(jwks), "k1", "ES256")
+	assert.Nil(suite.T(), pub)
+	assert.NotNil(suite.T(), svcErr)
+}
+
+func (suite *ResolverTestSuite) TestParseVerificationKeyFromJWKS_NoKid_SingleKey() {
+	jwks := `{"keys":[{"kty":"OKP","crv":"Ed25519","x":"x"}]}`
+	r := newJWKSResolver(nil)
+	pub, svcErr := r.parseVerificationKeyFromJWKS(context.Background(), []byte(jwks), "", "EdDSA")
+	suite.Require().Nil(svcErr)
+	suite.Equal("OKP", pub["kty"])
+}
+
+func (suite *ResolverTestSuite) TestParseVerificationKeyFromJWKS_NoKid_AmbiguousKeys() {
+	jwks := `{"keys":[{"kty":"RSA","kid":"k1","n":"n1","e":"AQAB"},{"kty":"RSA","kid":"k2","n":"n2","e":"AQAB"}]}`
+	r := newJWKSResolver(nil)
+	pub, svcErr := r.parseVerificationKeyFromJWKS(context.Background(), []byte(jwks), "", "RS256")
+	assert.Nil(suite.T(), pub)
+	assert.NotNil(suite.T(), svcErr)
+}
+
+func (suite *ResolverTestSuite) TestParseVerificationKeyFromJWKS_InvalidJSON() {
+	r := newJWKSResolver(nil)
+	pub, svcErr := r.parseVerificationKeyFromJWKS(context.Background(), []byte("not-json"), "k1", "RS256")
+	assert.Nil(suite.T(), pub)
+	assert.NotNil(suite.T(), svcErr)
+}
@@ -5,6 +5,7 @@ package logout
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -18,8 +19,12 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
 
-// paramLogoutID is the gate query/callback parameter carrying the stored logout-request id.
-const paramLogoutID = "logoutId"
+const (
+	// paramLogoutID is the gate query/callback parameter carrying the stored logout-request id.
+	paramLogoutID = "logoutId"
+	// frontchannelLogoutEndpoint serves the OIDC Front-Channel Logout page.
+	frontchannelLogoutEndpoint = constants.OAuth2LogoutEndpoint + "/frontchannel"
+)
 
 // logoutHandler serves the RP-initiated logout endpoint. It validates the request, persists the
 // validated post-logout target server-side, initiates the application's sign-out flow, and redirects the
@@ -124,6 +129,33 @@ func (h *logoutHandler) HandleLogoutCallback(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// HandleFrontchannelLogout serves the front-channel logout page the completion callback redirected the
+// browser to. The page loads each participant's front-channel logout URI in an iframe and then lands the
+// browser on the post-logout redirect URI. The logout id is single-use, so a reload is rejected.
+func (h *logoutHandler) HandleFrontchannelLogout(w http.ResponseWriter, r *http.Request) {
+	logoutID := r.URL.Query().Get(paramLogoutID)
+	if logoutID == "" {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	page, err := h.service.GetFrontchannelLogoutPage(r.Context(), logoutID)
+	if err != nil {
+		if errors.Is(err, errFrontchannelLogoutNotFound) {
+			h.logger.Debug(r.Context(), "Unknown front-channel logout request", log.Error(err))
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		h.logger.Error(r.Context(), "Failed to load front-channel logout request", log.Error(err))
+		http.Error(w, "logout failed", http.StatusInternalServerError)
+		return
+	}
+
+	if err := writeFrontchannelLogoutPage(w, page); err != nil {
+		h.logger.Error(r.Context(), "Failed to render front-channel logout page", log.Error(err))
+	}
+}
+
 // getSignOutPageRedirectURI builds the gate sign-out page URL with the given query params.
 func getSignOutPageRedirectURI(cfg oauthconfig.Config, queryParams map[string]string) (string, error) {
 	signOutPageURL := (&url.URL{
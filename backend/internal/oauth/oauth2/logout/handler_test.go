@@ -77,7 +77,7 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogout_InitiatesFlowAndRedirectsT
 	store := newLogoutRequestStoreInterfaceMock(suite.T())
 	store.EXPECT().AddRequest(mock.Anything, mock.Anything).Return("logout-1", nil)
 	handler := newLogoutHandler(
-		newLogoutService(jwtSvc, actor, flowSvc, store, testIssuer), gateConfig())
+		newLogoutService(jwtSvc, actor, flowSvc, nil, store, testIssuer, testBaseURL, nil), gateConfig())
 
 	req := httptest.NewRequest(http.MethodGet,
 		"/oauth2/logout?id_token_hint="+token+"&post_logout_redirect_uri=https://rp.example/after&state=xyz", nil)
@@ -102,8 +102,8 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogout_InvalidRequestRejected() {
 	actor := actorprovidermock.NewActorProviderMock(suite.T())
 	flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	// The request is rejected during resolution, so the store is never touched.
-	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()), actor, flowSvc,
-		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer)
+	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()), actor, flowSvc, nil,
+		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer, testBaseURL, nil)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout", nil)
@@ -135,11 +135,10 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogout_FlowInitiationError_MapsSt
 			flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 			flowSvc.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("",
 				&tidcommon.ServiceError{Type: tc.errType, Error: tidcommon.I18nMessage{DefaultValue: "flow boom"}})
-			// The request is persisted before the flow is initiated; the flow init is what fails here.
+			// The request is persisted only once the flow is initiated, so nothing is stored here.
 			store := newLogoutRequestStoreInterfaceMock(suite.T())
-			store.EXPECT().AddRequest(mock.Anything, mock.Anything).Return("logout-1", nil)
-			svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()), actor, flowSvc,
-				store, testIssuer)
+			svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()), actor, flowSvc, nil,
+				store, testIssuer, testBaseURL, nil)
 			handler := newLogoutHandler(svc, gateConfig())
 
 			req := httptest.NewRequest(tc.method, "/oauth2/logout?client_id=client-x", nil)
@@ -169,8 +168,8 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogout_POSTReadsFormBodyAndRedire
 		})
 	store := newLogoutRequestStoreInterfaceMock(suite.T())
 	store.EXPECT().AddRequest(mock.Anything, mock.Anything).Return("logout-1", nil)
-	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()), actor, flowSvc,
-		store, testIssuer)
+	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()), actor, flowSvc, nil,
+		store, testIssuer, testBaseURL, nil)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	body := url.Values{
@@ -201,7 +200,7 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogoutCallback_ReturnsRedirect()
 	store.EXPECT().ClearRequest(mock.Anything, "logout-1").Return(nil)
 	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
 		actorprovidermock.NewActorProviderMock(suite.T()),
-		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), store, testIssuer)
+		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), nil, store, testIssuer, testBaseURL, nil)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout/callback",
@@ -223,8 +222,8 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogout_MalformedFormRejected() {
 	// An unparseable query string (invalid percent-encoding) fails ParseForm before anything else.
 	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
 		actorprovidermock.NewActorProviderMock(suite.T()),
-		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()),
-		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer)
+		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), nil,
+		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer, testBaseURL, nil)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout?%zz", nil)
@@ -242,7 +241,7 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogoutCallback_CompletionError()
 		Return(false, logoutRequestContext{}, fmt.Errorf("store down"))
 	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
 		actorprovidermock.NewActorProviderMock(suite.T()),
-		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), store, testIssuer)
+		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), nil, store, testIssuer, testBaseURL, nil)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout/callback",
@@ -258,8 +257,8 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogoutCallback_InvalidRequest() {
 	// The body carries no logout id, so it is rejected before the store is consulted.
 	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
 		actorprovidermock.NewActorProviderMock(suite.T()),
-		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()),
-		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer)
+		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), nil,
+		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer, testBaseURL, nil)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout/callback", strings.NewReader(`{}`))
@@ -269,3 +268,52 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogoutCallback_InvalidRequest() {
 
 	suite.Equal(http.StatusBadRequest, rec.Code)
 }
+
+// The front-channel logout page frames each participant logout URI, allows framing only their origins,
+// and carries the post-logout redirect URI for its script to land the browser on.
+func (suite *LogoutHandlerTestSuite) TestHandleFrontchannelLogout_RendersPage() {
+	store := newLogoutRequestStoreInterfaceMock(suite.T())
+	store.EXPECT().GetRequest(mock.Anything, "logout-2").Return(true, logoutRequestContext{
+		AppID: "app-1", PostLogoutRedirectURI: "https://rp.example/after",
+		FrontchannelLogoutURIs: []string{
+			"https://rp.example/fc?iss=https%3A%2F%2Fissuer.test&sid=s1",
+			"https://other.example:8443/logout?sid=s1",
+		},
+	}, nil)
+	store.EXPECT().ClearRequest(mock.Anything, "logout-2").Return(nil)
+	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
+		actorprovidermock.NewActorProviderMock(suite.T()),
+		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), nil, store, testIssuer, testBaseURL, nil)
+	handler := newLogoutHandler(svc, gateConfig())
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout/frontchannel?logoutId=logout-2", nil)
+	rec := httptest.NewRecorder()
+
+	handler.HandleFrontchannelLogout(rec, req)
+
+	suite.Equal(http.StatusOK, rec.Code)
+	csp := rec.Header().Get("Content-Security-Policy")
+	suite.Contains(csp, "frame-src https://rp.example https://other.example:8443;")
+	suite.Contains(csp, "frame-ancestors 'none'")
+	body := rec.Body.String()
+	suite.Contains(body, `<iframe src="https://rp.example/fc?iss=https%3A%2F%2Fissuer.test&amp;sid=s1"`)
+	suite.Contains(body, `<iframe src="https://other.example:8443/logout?sid=s1"`)
+	suite.Contains(body, `var redirectURI = "https://rp.example/after";`)
+}
+
+// The page request is single-use; an unknown (or replayed) logout id is rejected.
+func (suite *LogoutHandlerTestSuite) TestHandleFrontchannelLogout_UnknownID() {
+	store := newLogoutRequestStoreInterfaceMock(suite.T())
+	store.EXPECT().GetRequest(mock.Anything, "logout-2").Return(false, logoutRequestContext{}, nil)
+	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
+		actorprovidermock.NewActorProviderMock(suite.T()),
+		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), nil, store, testIssuer, testBaseURL, nil)
+	handler := newLogoutHandler(svc, gateConfig())
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout/frontchannel?logoutId=logout-2", nil)
+	rec := httptest.NewRecorder()
+
+	handler.HandleFrontchannelLogout(rec, req)
+
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
@@ -9,28 +9,35 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/frontchannellogout"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
-// Initialize wires the RP-initiated logout feature and registers the end_session_endpoint.
+// Initialize wires the RP-initiated logout feature and registers the end_session_endpoint and the
+// front-channel logout page.
 func Initialize(
 	mux *http.ServeMux,
 	jwtService jwt.JWTServiceInterface,
 	actorProvider providers.ActorProvider,
 	flowExecService flowexec.FlowExecServiceInterface,
+	frontchannelLogout frontchannellogout.FrontchannelLogoutServiceInterface,
 	runtimeStore providers.RuntimeStoreProvider,
+	pairwiseService pairwise.PairwiseSubjectServiceInterface,
 	cfg oauthconfig.Config,
 ) {
 	store := newLogoutRequestStore(runtimeStore)
-	service := newLogoutService(jwtService, actorProvider, flowExecService, store, cfg.JWT.Issuer)
+	service := newLogoutService(jwtService, actorProvider, flowExecService, frontchannelLogout, store,
+		cfg.JWT.Issuer, cfg.BaseURL, pairwiseService)
 	handler := newLogoutHandler(service, cfg)
 	registerRoutes(mux, handler)
 }
 
 // registerRoutes registers the GET/POST/OPTIONS routes for the logout endpoint and its completion
-// callback (POST /oauth2/logout/callback), which the gate calls once the sign-out flow finishes.
+// callback (POST /oauth2/logout/callback), which the gate calls once the sign-out flow finishes, and the
+// front-channel logout page (GET /oauth2/logout/frontchannel) the completion may redirect the browser to.
 func registerRoutes(mux *http.ServeMux, handler *logoutHandler) {
 	opts := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
@@ -44,6 +51,7 @@ func registerRoutes(mux *http.ServeMux, handler *logoutHandler) {
 	mux.HandleFunc(middleware.WithCORS("GET "+constants.OAuth2LogoutEndpoint, handler.HandleLogout, opts))
 	mux.HandleFunc(middleware.WithCORS("POST "+constants.OAuth2LogoutEndpoint, handler.HandleLogout, opts))
 	mux.HandleFunc(middleware.WithCORS("POST "+callbackEndpoint, handler.HandleLogoutCallback, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+frontchannelLogoutEndpoint, handler.HandleFrontchannelLogout, opts))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS "+constants.OAuth2LogoutEndpoint,
 		func(w http.ResponseWriter, _ *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
@@ -0,0 +1,120 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logout
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+)
+
+// frontchannelLogoutPageWaitMillis bounds how long the page waits for the participant iframes to load
+// before moving on, so an unreachable relying party cannot hold the browser on the page.
+const frontchannelLogoutPageWaitMillis = 5000
+
+// frontchannelLogoutPageTemplate renders the OIDC Front-Channel Logout page. Each participant's logout
+// URI is loaded in a hidden iframe; once all have loaded (or the wait elapses) the nonce-bound script
+// lands the browser on the post-logout redirect URI, or reveals the signed-out notice when there is none.
+var frontchannelLogoutPageTemplate = template.Must(template.New("frontchannellogout").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Signing Out</title>
+</head>
+<body>
+<main>
+<p id="status" role="status">Signing you out&hellip;</p>
+</main>
+{{- range .LogoutURIs}}
+<iframe src="{{.}}" hidden title="Sign-out notification"></iframe>
+{{- end}}
+<script nonce="{{.Nonce}}">
+(function () {
+  var redirectURI = {{.RedirectURI}};
+  var frames = document.getElementsByTagName("iframe");
+  var pending = frames.length, done = false;
+
+  function finish() {
+    if (done) { return; }
+    done = true;
+    if (redirectURI) {
+      window.location.replace(redirectURI);
+      return;
+    }
+    document.getElementById("status").textContent = "You have been signed out.";
+  }
+
+  for (var i = 0; i < frames.length; i++) {
+    frames[i].addEventListener("load", function () {
+      if (--pending <= 0) { finish(); }
+    });
+  }
+  setTimeout(finish, {{.WaitMillis}});
+})();
+</script>
+</body>
+</html>
+`))
+
+// frontchannelLogoutPageData holds the data rendered into the front-channel logout page template.
+type frontchannelLogoutPageData struct {
+	Nonce       string
+	LogoutURIs  []string
+	RedirectURI string
+	WaitMillis  int
+}
+
+// writeFrontchannelLogoutPage renders the front-channel logout page. The content security policy only
+// allows framing the participants' origins and running the page's own nonce-bound script.
+func writeFrontchannelLogoutPage(w http.ResponseWriter, page *FrontchannelLogoutPage) error {
+	nonceBytes := make([]byte, 16)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return fmt.Errorf("failed to generate script nonce: %w", err)
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(nonceBytes)
+
+	w.Header().Set(sysconst.ContentTypeHeaderName, sysconst.ContentTypeHTML)
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoStore)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+	w.Header().Set(sysconst.ContentSecurityPolicyHeaderName, fmt.Sprintf(
+		"default-src 'none'; script-src 'nonce-%s'; frame-src %s; base-uri 'none'; form-action 'none'; "+
+			"frame-ancestors 'none'", nonce, frameSources(page.LogoutURIs)))
+	w.WriteHeader(http.StatusOK)
+	return frontchannelLogoutPageTemplate.Execute(w, frontchannelLogoutPageData{
+		Nonce:       nonce,
+		LogoutURIs:  page.LogoutURIs,
+		RedirectURI: page.RedirectURI,
+		WaitMillis:  frontchannelLogoutPageWaitMillis,
+	})
+}
+
+// frameSources returns the distinct origins of the given logout URIs as a CSP source list, or 'none'
+// when no URI yields an origin.
+func frameSources(logoutURIs []string) string {
+	seen := make(map[string]struct{}, len(logoutURIs))
+	origins := make([]string, 0, len(logoutURIs))
+	for _, logoutURI := range logoutURIs {
+		parsed, err := url.Parse(logoutURI)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			continue
+		}
+		origin := parsed.Scheme + "://" + parsed.Host
+		if _, dup := seen[origin]; dup {
+			continue
+		}
+		seen[origin] = struct{}{}
+		origins = append(origins, origin)
+	}
+	if len(origins) == 0 {
+		return "'none'"
+	}
+	return strings.Join(origins, " ")
+}
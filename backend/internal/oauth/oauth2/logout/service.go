@@ -14,6 +14,8 @@ import (
 	flowcommon "github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/frontchannellogout"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -28,6 +30,7 @@ var (
 	errClientRequired               = errors.New("id_token_hint or client_id is required")
 	errInvalidClient                = errors.New("invalid client")
 	errInvalidPostLogoutRedirectURI = errors.New("invalid post_logout_redirect_uri")
+	errFrontchannelLogoutNotFound   = errors.New("front-channel logout request not found")
 )
 
 // LogoutRequest holds the RP-initiated logout parameters received from the request.
@@ -42,7 +45,10 @@ type LogoutRequest struct {
 
 // LogoutResolution is the validated target of a logout request.
 type LogoutResolution struct {
-	AppID                 string
+	AppID string
+	// Subject is the local subject identifier of the End-User named by id_token_hint, or "" when no
+	// hint was supplied. A pairwise sub in the hint is resolved back to the local subject.
+	Subject               string
 	PostLogoutRedirectURI string
 	State                 string
 	Headers               map[string][]string
@@ -60,57 +66,66 @@ type SignOutInitiation struct {
 	ExecutionID string
 }
 
+// FrontchannelLogoutPage is what the front-channel logout page renders: the participant logout URIs to
+// load in iframes and where to land the browser once they have loaded ("" shows a signed-out notice).
+type FrontchannelLogoutPage struct {
+	LogoutURIs  []string
+	RedirectURI string
+}
+
 // LogoutServiceInterface validates an RP-initiated logout request, resolves its target, initiates the
-// application's sign-out flow, and completes it (issuing the post-logout redirect).
+// application's sign-out flow, and completes it (issuing the post-logout redirect, through the
+// front-channel logout page when participants of the ended session registered a front-channel logout URI).
 type LogoutServiceInterface interface {
 	Resolve(ctx context.Context, req LogoutRequest) (*LogoutResolution, error)
 	InitiateSignOutFlow(ctx context.Context, resolution *LogoutResolution) (*SignOutInitiation, *tidcommon.ServiceError)
 	CompleteSignOut(ctx context.Context, logoutID string) (string, error)
+	GetFrontchannelLogoutPage(ctx context.Context, logoutID string) (*FrontchannelLogoutPage, error)
 }
 
 // logoutService is the default LogoutServiceInterface implementation. It verifies the id_token_hint,
 // resolves the target client (and its post-logout redirect allow-list) via the actor provider, drives
 // the application's sign-out flow through the flow-exec service, and persists the in-progress logout
-// request in its store so the completion callback can issue the post-logout redirect.
+// request in its store so the completion callback can issue the post-logout redirect. The front-channel
+// logout service is optional; without it the completion callback always redirects directly.
 type logoutService struct {
-	jwtService      jwt.JWTServiceInterface
-	actorProvider   providers.ActorProvider
-	flowExecService flowexec.FlowExecServiceInterface
-	store           logoutRequestStoreInterface
-	issuer          string
-	logger          *log.Logger
+	jwtService         jwt.JWTServiceInterface
+	actorProvider      providers.ActorProvider
+	flowExecService    flowexec.FlowExecServiceInterface
+	frontchannelLogout frontchannellogout.FrontchannelLogoutServiceInterface
+	store              logoutRequestStoreInterface
+	issuer             string
+	baseURL            string
+	pairwiseService    pairwise.PairwiseSubjectServiceInterface
+	logger             *log.Logger
 }
 
 func newLogoutService(jwtService jwt.JWTServiceInterface, actorProvider providers.ActorProvider,
-	flowExecService flowexec.FlowExecServiceInterface, store logoutRequestStoreInterface,
-	issuer string) *logoutService {
+	flowExecService flowexec.FlowExecServiceInterface,
+	frontchannelLogout frontchannellogout.FrontchannelLogoutServiceInterface,
+	store logoutRequestStoreInterface, issuer, baseURL string,
+	pairwiseService pairwise.PairwiseSubjectServiceInterface) *logoutService {
 	return &logoutService{
-		jwtService:      jwtService,
-		actorProvider:   actorProvider,
-		flowExecService: flowExecService,
-		store:           store,
-		issuer:          issuer,
-		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LogoutService")),
+		jwtService:         jwtService,
+		actorProvider:      actorProvider,
+		flowExecService:    flowExecService,
+		frontchannelLogout: frontchannelLogout,
+		store:              store,
+		issuer:             issuer,
+		baseURL:            baseURL,
+		pairwiseService:    pairwiseService,
+		logger:             log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LogoutService")),
 	}
 }
 
-// InitiateSignOutFlow persists the validated logout target server-side and initiates the application's
-// sign-out flow. It returns the stored logout-request id (which the gate echoes back on completion) and
+// InitiateSignOutFlow initiates the application's sign-out flow and persists the validated logout target
+// server-side. It returns the stored logout-request id (which the gate echoes back on completion) and
 // the flow execution id. The post-logout landing is kept out of the flow entirely — OAuth resolves it on
-// the completion callback — keeping the flow engine protocol-agnostic.
+// the completion callback — keeping the flow engine protocol-agnostic. The execution id is stored with
+// the request so completion can collect the front-channel logout URIs recorded while the flow ran.
 func (s *logoutService) InitiateSignOutFlow(
 	ctx context.Context, resolution *LogoutResolution,
 ) (*SignOutInitiation, *tidcommon.ServiceError) {
-	logoutID, err := s.store.AddRequest(ctx, logoutRequestContext{
-		AppID:                 resolution.AppID,
-		PostLogoutRedirectURI: resolution.PostLogoutRedirectURI,
-		State:                 resolution.State,
-	})
-	if err != nil {
-		s.logger.Error(ctx, "Failed to persist logout request", log.Error(err))
-		return nil, &tidcommon.InternalServerError
-	}
-
 	// id_token_hint is used by the OAuth layer to resolve the target client; the sign-out flow
 	// itself never consumes it. Strip it from the forwarded initiator request so we don't persist a
 	// JWT with user identity claims into the flow context store.
@@ -126,6 +141,8 @@ func (s *logoutService) InitiateSignOutFlow(
 	}
 	if resolution.PromptRequired {
 		initContext.RuntimeData = map[string]string{flowcommon.RuntimeKeyLogoutPromptRequired: "true"}
+	} else if resolution.Subject != "" {
+		initContext.RuntimeData = map[string]string{flowcommon.RuntimeKeyLogoutHintSubject: resolution.Subject}
 	}
 
 	executionID, svcErr := s.flowExecService.InitiateFlow(ctx, initContext)
@@ -133,6 +150,17 @@ func (s *logoutService) InitiateSignOutFlow(
 		return nil, svcErr
 	}
 
+	logoutID, err := s.store.AddRequest(ctx, logoutRequestContext{
+		AppID:                 resolution.AppID,
+		PostLogoutRedirectURI: resolution.PostLogoutRedirectURI,
+		State:                 resolution.State,
+		ExecutionID:           executionID,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to persist logout request", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
 	return &SignOutInitiation{LogoutID: logoutID, ExecutionID: executionID}, nil
 }
 
@@ -140,31 +168,95 @@ func (s *logoutService) InitiateSignOutFlow(
 // returns the post-logout redirect URI (with state appended), or "" when the RP supplied none or the
 // request is unknown/expired. Protocol-level actions that must run on sign-out (e.g. token revocation)
 // belong here — the OAuth layer regains control at this point, which it cannot inside the flow.
+//
+// When the flow ended sessions whose participants registered a front-channel logout URI, the browser is
+// sent to the front-channel logout page instead; it loads those URIs and then lands on the post-logout
+// redirect URI itself.
 func (s *logoutService) CompleteSignOut(ctx context.Context, logoutID string) (string, error) {
+	reqCtx, err := s.consumeRequest(ctx, logoutID)
+	if err != nil || reqCtx == nil {
+		return "", err
+	}
+
+	if s.frontchannelLogout != nil && reqCtx.ExecutionID != "" {
+		logoutURIs, takeErr := s.frontchannelLogout.TakeLogoutURIs(ctx, reqCtx.ExecutionID)
+		if takeErr != nil {
+			// Front-channel logout is best-effort; the sign-out itself already succeeded.
+			s.logger.Warn(ctx, "Failed to read front-channel logout URIs", log.Error(takeErr))
+		}
+		if len(logoutURIs) > 0 {
+			return s.frontchannelLogoutPageURI(ctx, reqCtx, logoutURIs)
+		}
+	}
+
+	return postLogoutRedirectURI(reqCtx)
+}
+
+// GetFrontchannelLogoutPage consumes the logout request stored for the front-channel logout page and
+// returns the participant logout URIs together with the final post-logout redirect URI.
+func (s *logoutService) GetFrontchannelLogoutPage(
+	ctx context.Context, logoutID string,
+) (*FrontchannelLogoutPage, error) {
+	reqCtx, err := s.consumeRequest(ctx, logoutID)
+	if err != nil {
+		return nil, err
+	}
+	if reqCtx == nil || len(reqCtx.FrontchannelLogoutURIs) == 0 {
+		return nil, errFrontchannelLogoutNotFound
+	}
+
+	redirectURI, err := postLogoutRedirectURI(reqCtx)
+	if err != nil {
+		return nil, err
+	}
+	return &FrontchannelLogoutPage{LogoutURIs: reqCtx.FrontchannelLogoutURIs, RedirectURI: redirectURI}, nil
+}
+
+// consumeRequest loads and clears the stored logout request, so a logout id cannot be replayed. It
+// returns nil when the request is unknown or expired.
+func (s *logoutService) consumeRequest(ctx context.Context, logoutID string) (*logoutRequestContext, error) {
 	found, reqCtx, err := s.store.GetRequest(ctx, logoutID)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	if !found {
-		return "", nil
+		return nil, nil
 	}
-	// Consume the request so a logout id cannot be replayed.
 	if clearErr := s.store.ClearRequest(ctx, logoutID); clearErr != nil {
 		s.logger.Warn(ctx, "Failed to clear logout request", log.Error(clearErr))
 	}
+	return &reqCtx, nil
+}
+
+// frontchannelLogoutPageURI stores a follow-up logout request carrying the participant logout URIs and
+// returns the URL of the front-channel logout page that renders it.
+func (s *logoutService) frontchannelLogoutPageURI(
+	ctx context.Context, reqCtx *logoutRequestContext, logoutURIs []string,
+) (string, error) {
+	pageLogoutID, err := s.store.AddRequest(ctx, logoutRequestContext{
+		AppID:                  reqCtx.AppID,
+		PostLogoutRedirectURI:  reqCtx.PostLogoutRedirectURI,
+		State:                  reqCtx.State,
+		FrontchannelLogoutURIs: logoutURIs,
+	})
+	if err != nil {
+		return "", err
+	}
+	return oauth2utils.GetURIWithQueryParams(s.baseURL+frontchannelLogoutEndpoint,
+		map[string]string{paramLogoutID: pageLogoutID})
+}
 
+// postLogoutRedirectURI returns the request's post-logout redirect URI with state appended, or "" when
+// the RP supplied none.
+func postLogoutRedirectURI(reqCtx *logoutRequestContext) (string, error) {
 	if reqCtx.PostLogoutRedirectURI == "" {
 		return "", nil
 	}
 	if reqCtx.State == "" {
 		return reqCtx.PostLogoutRedirectURI, nil
 	}
-	redirectURI, err := oauth2utils.GetURIWithQueryParams(
+	return oauth2utils.GetURIWithQueryParams(
 		reqCtx.PostLogoutRedirectURI, map[string]string{constants.RequestParamState: reqCtx.State})
-	if err != nil {
-		return "", err
-	}
-	return redirectURI, nil
 }
 
 // Resolve identifies the client from id_token_hint (preferred) or the client_id parameter, validates
@@ -177,11 +269,13 @@ func (s *logoutService) CompleteSignOut(ctx context.Context, logoutID string) (s
 // the logout with the End-User, as the spec requires in that case.
 func (s *logoutService) Resolve(ctx context.Context, req LogoutRequest) (*LogoutResolution, error) {
 	clientID := req.ClientID
+	subject := ""
 	if req.IDTokenHint != "" {
-		hintClientID, err := s.clientIDFromIDTokenHint(ctx, req.IDTokenHint)
+		hintClientID, hintSubject, err := s.parseIDTokenHint(ctx, req.IDTokenHint)
 		if err != nil {
 			return nil, err
 		}
+		subject = hintSubject
 		if clientID != "" && hintClientID != "" && clientID != hintClientID {
 			return nil, errClientMismatch
 		}
@@ -213,6 +307,7 @@ func (s *logoutService) Resolve(ctx context.Context, req LogoutRequest) (*Logout
 
 	return &LogoutResolution{
 		AppID:                 client.ID,
+		Subject:               subject,
 		PostLogoutRedirectURI: req.PostLogoutRedirectURI,
 		State:                 req.State,
 		Headers:               req.Headers,
@@ -221,35 +316,46 @@ func (s *logoutService) Resolve(ctx context.Context, req LogoutRequest) (*Logout
 	}, nil
 }
 
-// clientIDFromIDTokenHint verifies the id_token_hint was issued by this server (signature + issuer)
-// and returns its audience (the client id). The token's expiry is intentionally not enforced: per
-// OIDC RP-Initiated Logout, id_token_hint may be an expired ID token.
-func (s *logoutService) clientIDFromIDTokenHint(ctx context.Context, idTokenHint string) (string, error) {
+// parseIDTokenHint verifies the id_token_hint was issued by this server (signature + issuer) and
+// returns its audience (the client id) and the local subject it names. The token's expiry is
+// intentionally not enforced: per OIDC RP-Initiated Logout, id_token_hint may be an expired ID token.
+func (s *logoutService) parseIDTokenHint(ctx context.Context, idTokenHint string) (string, string, error) {
 	if svcErr := s.jwtService.VerifyJWTSignature(ctx, idTokenHint); svcErr != nil {
-		return "", errInvalidIDTokenHint
+		return "", "", errInvalidIDTokenHint
 	}
 	header, err := jwt.DecodeJWTHeader(idTokenHint)
 	if err != nil {
-		return "", errInvalidIDTokenHint
+		return "", "", errInvalidIDTokenHint
 	}
 	// The hint must be an ID token, not any other JWT this server signs. An access token issued to an
 	// application with no configured default audience carries aud=client_id, so without this check it
 	// would resolve to a client and be accepted as a hint, which suppresses the End-User sign-out
 	// confirmation.
 	if typ, _ := header["typ"].(string); typ != jwt.TokenTypeJWT {
-		return "", errInvalidIDTokenHint
+		return "", "", errInvalidIDTokenHint
 	}
 	payload, err := jwt.DecodeJWTPayload(idTokenHint)
 	if err != nil {
-		return "", errInvalidIDTokenHint
+		return "", "", errInvalidIDTokenHint
 	}
 	if _, isRefreshToken := payload[constants.ClaimAccessTokenSubject]; isRefreshToken {
-		return "", errInvalidIDTokenHint
+		return "", "", errInvalidIDTokenHint
 	}
 	if iss, _ := payload[constants.ClaimIss].(string); iss != s.issuer {
-		return "", errInvalidIDTokenHint
+		return "", "", errInvalidIDTokenHint
+	}
+
+	subject, _ := payload[constants.ClaimSub].(string)
+	if subject != "" && s.pairwiseService != nil {
+		// An ID token issued to a pairwise client carries that client's pairwise identifier; map it
+		// back so the sign-out flow sees the same subject regardless of the client's subject type.
+		subject, err = s.pairwiseService.ResolveLocalSubject(ctx, subject)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to resolve id_token_hint subject", log.Error(err))
+			return "", "", err
+		}
 	}
-	return audienceClientID(payload), nil
+	return audienceClientID(payload), subject, nil
 }
 
 // filterQueryParams returns a copy of the given query-parameter map with the named keys removed.
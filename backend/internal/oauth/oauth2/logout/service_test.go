@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -21,10 +22,13 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/frontchannellogoutmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
 )
 
 const (
 	testIssuer      = "https://issuer.test"
+	testBaseURL     = "https://idp.test"
 	testExecutionID = "exec-1"
 )
 
@@ -50,21 +54,23 @@ func (suite *LogoutServiceTestSuite) newService() (*logoutService, *jwtmock.JWTS
 	actor := actorprovidermock.NewActorProviderMock(suite.T())
 	flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	store := newLogoutRequestStoreInterfaceMock(suite.T())
-	return newLogoutService(jwtSvc, actor, flowSvc, store, testIssuer), jwtSvc, actor
+	return newLogoutService(jwtSvc, actor, flowSvc, nil, store, testIssuer, testBaseURL, nil), jwtSvc, actor
 }
 
 func (suite *LogoutServiceTestSuite) newServiceWithStore(
 	store logoutRequestStoreInterface, flowSvc *flowexecmock.FlowExecServiceInterfaceMock,
 ) *logoutService {
 	return newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
-		actorprovidermock.NewActorProviderMock(suite.T()), flowSvc, store, testIssuer)
+		actorprovidermock.NewActorProviderMock(suite.T()), flowSvc, nil, store, testIssuer, testBaseURL, nil)
 }
 
 func (suite *LogoutServiceTestSuite) TestInitiateSignOutFlow_StoresContextAndInitiates() {
 	store := newLogoutRequestStoreInterfaceMock(suite.T())
-	// The validated target is persisted server-side, keyed by the returned logout id.
+	// The validated target is persisted server-side, keyed by the returned logout id, together with the
+	// flow execution id so completion can collect the front-channel logout URIs the flow recorded.
 	store.EXPECT().AddRequest(mock.Anything, logoutRequestContext{
 		AppID: "app-1", PostLogoutRedirectURI: "https://rp.example/after", State: "xyz",
+		ExecutionID: testExecutionID,
 	}).Return("logout-1", nil)
 	flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	var captured *flowexec.FlowInitContext
@@ -152,6 +158,84 @@ func (suite *LogoutServiceTestSuite) TestCompleteSignOut_UnknownID() {
 	suite.Empty(redirectURI)
 }
 
+// When the sign-out flow ended sessions with front-channel participants, completion stores a follow-up
+// request carrying their logout URIs and sends the browser to the front-channel logout page.
+func (suite *LogoutServiceTestSuite) TestCompleteSignOut_FrontchannelParticipants_RedirectsToLogoutPage() {
+	store := newLogoutRequestStoreInterfaceMock(suite.T())
+	store.EXPECT().GetRequest(mock.Anything, "logout-1").Return(true, logoutRequestContext{
+		AppID: "app-1", PostLogoutRedirectURI: "https://rp.example/after", State: "xyz",
+		ExecutionID: testExecutionID,
+	}, nil)
+	store.EXPECT().ClearRequest(mock.Anything, "logout-1").Return(nil)
+	store.EXPECT().AddRequest(mock.Anything, logoutRequestContext{
+		AppID: "app-1", PostLogoutRedirectURI: "https://rp.example/after", State: "xyz",
+		FrontchannelLogoutURIs: []string{"https://rp.example/fc?sid=s1"},
+	}).Return("logout-2", nil)
+	frontchannel := frontchannellogoutmock.NewFrontchannelLogoutServiceInterfaceMock(suite.T())
+	frontchannel.EXPECT().TakeLogoutURIs(mock.Anything, testExecutionID).
+		Return([]string{"https://rp.example/fc?sid=s1"}, nil)
+	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
+		actorprovidermock.NewActorProviderMock(suite.T()), flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()),
+		frontchannel, store, testIssuer, testBaseURL, nil)
+
+	redirectURI, err := svc.CompleteSignOut(context.Background(), "logout-1")
+
+	suite.Require().NoError(err)
+	suite.Equal(testBaseURL+"/oauth2/logout/frontchannel?logoutId=logout-2", redirectURI)
+}
+
+// Front-channel logout is best-effort: a failure to read the recorded URIs falls back to the direct
+// post-logout redirect.
+func (suite *LogoutServiceTestSuite) TestCompleteSignOut_FrontchannelLookupError_RedirectsDirectly() {
+	store := newLogoutRequestStoreInterfaceMock(suite.T())
+	store.EXPECT().GetRequest(mock.Anything, "logout-1").Return(true, logoutRequestContext{
+		AppID: "app-1", PostLogoutRedirectURI: "https://rp.example/after", ExecutionID: testExecutionID,
+	}, nil)
+	store.EXPECT().ClearRequest(mock.Anything, "logout-1").Return(nil)
+	frontchannel := frontchannellogoutmock.NewFrontchannelLogoutServiceInterfaceMock(suite.T())
+	frontchannel.EXPECT().TakeLogoutURIs(mock.Anything, testExecutionID).Return(nil, errors.New("store down"))
+	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
+		actorprovidermock.NewActorProviderMock(suite.T()), flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()),
+		frontchannel, store, testIssuer, testBaseURL, nil)
+
+	redirectURI, err := svc.CompleteSignOut(context.Background(), "logout-1")
+
+	suite.Require().NoError(err)
+	suite.Equal("https://rp.example/after", redirectURI)
+}
+
+func (suite *LogoutServiceTestSuite) TestGetFrontchannelLogoutPage_ReturnsURIsAndRedirect() {
+	store := newLogoutRequestStoreInterfaceMock(suite.T())
+	store.EXPECT().GetRequest(mock.Anything, "logout-2").Return(true, logoutRequestContext{
+		AppID: "app-1", PostLogoutRedirectURI: "https://rp.example/after", State: "xyz",
+		FrontchannelLogoutURIs: []string{"https://rp.example/fc?sid=s1"},
+	}, nil)
+	// The page request is single-use as well.
+	store.EXPECT().ClearRequest(mock.Anything, "logout-2").Return(nil)
+	svc := suite.newServiceWithStore(store, flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()))
+
+	page, err := svc.GetFrontchannelLogoutPage(context.Background(), "logout-2")
+
+	suite.Require().NoError(err)
+	suite.Equal([]string{"https://rp.example/fc?sid=s1"}, page.LogoutURIs)
+	suite.Equal("https://rp.example/after?state=xyz", page.RedirectURI)
+}
+
+// A logout request that carries no front-channel URIs (e.g. the completion-callback request itself)
+// cannot be rendered as a front-channel logout page.
+func (suite *LogoutServiceTestSuite) TestGetFrontchannelLogoutPage_NotFrontchannelRequest() {
+	store := newLogoutRequestStoreInterfaceMock(suite.T())
+	store.EXPECT().GetRequest(mock.Anything, "logout-1").
+		Return(true, logoutRequestContext{AppID: "app-1", ExecutionID: testExecutionID}, nil)
+	store.EXPECT().ClearRequest(mock.Anything, "logout-1").Return(nil)
+	svc := suite.newServiceWithStore(store, flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()))
+
+	page, err := svc.GetFrontchannelLogoutPage(context.Background(), "logout-1")
+
+	suite.ErrorIs(err, errFrontchannelLogoutNotFound)
+	suite.Nil(page)
+}
+
 func (suite *LogoutServiceTestSuite) TestInitiateSignOutFlow_StripsIDTokenHintFromInitiatorRequest() {
 	// id_token_hint has already been consumed by the OAuth layer at Resolve() to identify the
 	// target client; it must not be persisted into the flow context store as part of the initiator
@@ -418,6 +502,57 @@ func (suite *LogoutServiceTestSuite) TestResolve_IDTokenHintIdentifiesClient() {
 	suite.False(res.PromptRequired)
 }
 
+func (suite *LogoutServiceTestSuite) TestResolve_IDTokenHintResolvesPairwiseSubject() {
+	jwtSvc := jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	actor := actorprovidermock.NewActorProviderMock(suite.T())
+	pairwiseSvc := pairwisemock.NewPairwiseSubjectServiceInterfaceMock(suite.T())
+	svc := newLogoutService(jwtSvc, actor, flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), nil,
+		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer, testBaseURL, pairwiseSvc)
+	token := makeTypedToken("JWT", testIssuer, "client-x", map[string]interface{}{"sub": "pairwise-sub"})
+	jwtSvc.EXPECT().VerifyJWTSignature(mock.Anything, token).Return(nil)
+	pairwiseSvc.EXPECT().ResolveLocalSubject(mock.Anything, "pairwise-sub").Return("user-1", nil)
+	actor.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-x").Return(clientWithPostLogout(), nil)
+
+	res, err := svc.Resolve(context.Background(), LogoutRequest{IDTokenHint: token})
+
+	suite.Require().NoError(err)
+	suite.Equal("user-1", res.Subject)
+}
+
+func (suite *LogoutServiceTestSuite) TestResolve_IDTokenHintPairwiseResolveError() {
+	jwtSvc := jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	pairwiseSvc := pairwisemock.NewPairwiseSubjectServiceInterfaceMock(suite.T())
+	svc := newLogoutService(jwtSvc, actorprovidermock.NewActorProviderMock(suite.T()),
+		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), nil,
+		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer, testBaseURL, pairwiseSvc)
+	token := makeTypedToken("JWT", testIssuer, "client-x", map[string]interface{}{"sub": "pairwise-sub"})
+	jwtSvc.EXPECT().VerifyJWTSignature(mock.Anything, token).Return(nil)
+	pairwiseSvc.EXPECT().ResolveLocalSubject(mock.Anything, "pairwise-sub").Return("", errors.New("db down"))
+
+	_, err := svc.Resolve(context.Background(), LogoutRequest{IDTokenHint: token})
+
+	suite.Require().Error(err)
+}
+
+func (suite *LogoutServiceTestSuite) TestInitiateSignOutFlow_ForwardsHintSubject() {
+	store := newLogoutRequestStoreInterfaceMock(suite.T())
+	store.EXPECT().AddRequest(mock.Anything, mock.Anything).Return("logout-1", nil)
+	flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
+	var captured *flowexec.FlowInitContext
+	flowSvc.EXPECT().InitiateFlow(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, ic *flowexec.FlowInitContext) (string, *tidcommon.ServiceError) {
+			captured = ic
+			return testExecutionID, nil
+		})
+	svc := suite.newServiceWithStore(store, flowSvc)
+
+	_, svcErr := svc.InitiateSignOutFlow(context.Background(), &LogoutResolution{AppID: "app-1", Subject: "user-1"})
+
+	suite.Nil(svcErr)
+	suite.Require().NotNil(captured)
+	suite.Equal("user-1", captured.RuntimeData[flowcommon.RuntimeKeyLogoutHintSubject])
+}
+
 func (suite *LogoutServiceTestSuite) TestResolve_IDTokenHintPrefersAzpForMultiAudience() {
 	svc, jwtSvc, actor := suite.newService()
 	token := makeIDTokenMultiAud(testIssuer, []string{"other-aud", "client-x"}, "client-x")
@@ -537,8 +672,11 @@ func (suite *LogoutServiceTestSuite) TestInitiateSignOutFlow_StorePersistError()
 	store := newLogoutRequestStoreInterfaceMock(suite.T())
 	store.EXPECT().AddRequest(mock.Anything, mock.Anything).
 		Return("", fmt.Errorf("store down"))
-	// The flow must not be initiated when the request cannot be persisted.
-	svc := suite.newServiceWithStore(store, flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()))
+	// The request is persisted after the flow is initiated (it records the execution id); a persist
+	// failure fails the initiation, leaving the unused execution to expire.
+	flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
+	flowSvc.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return(testExecutionID, nil)
+	svc := suite.newServiceWithStore(store, flowSvc)
 
 	initiation, svcErr := svc.InitiateSignOutFlow(context.Background(), &LogoutResolution{AppID: "app-1"})
 
@@ -17,6 +17,8 @@ const (
 	jsonKeyLogoutAppID       = "app_id"
 	jsonKeyLogoutRedirectURI = "post_logout_redirect_uri"
 	jsonKeyLogoutState       = "state"
+	jsonKeyLogoutExecutionID = "execution_id"
+	jsonKeyFrontchannelURIs  = "frontchannel_logout_uris"
 )
 
 // logoutRequestContext is the validated RP-initiated logout target held server-side between the
@@ -27,6 +29,12 @@ type logoutRequestContext struct {
 	AppID                 string
 	PostLogoutRedirectURI string
 	State                 string
+	// ExecutionID is the sign-out flow execution, under which the sessions it ends record their
+	// front-channel logout URIs.
+	ExecutionID string
+	// FrontchannelLogoutURIs is set once the sign-out completes with participants to log out over
+	// the front channel; the request is then held until the logout page rendering them is served.
+	FrontchannelLogoutURIs []string
 }
 
 // logoutRequestStoreInterface stores and retrieves logout request contexts.
@@ -62,6 +70,8 @@ func (s *logoutRequestStore) AddRequest(ctx context.Context, value logoutRequest
 		jsonKeyLogoutAppID:       value.AppID,
 		jsonKeyLogoutRedirectURI: value.PostLogoutRedirectURI,
 		jsonKeyLogoutState:       value.State,
+		jsonKeyLogoutExecutionID: value.ExecutionID,
+		jsonKeyFrontchannelURIs:  value.FrontchannelLogoutURIs,
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal logout request context to JSON: %w", err)
@@ -117,5 +127,15 @@ func unmarshalLogoutRequestContext(dataBytes []byte) (logoutRequestContext, erro
 	if s, ok := data[jsonKeyLogoutState].(string); ok {
 		value.State = s
 	}
+	if s, ok := data[jsonKeyLogoutExecutionID].(string); ok {
+		value.ExecutionID = s
+	}
+	if uris, ok := data[jsonKeyFrontchannelURIs].([]interface{}); ok {
+		for _, uri := range uris {
+			if s, ok := uri.(string); ok {
+				value.FrontchannelLogoutURIs = append(value.FrontchannelLogoutURIs, s)
+			}
+		}
+	}
 	return value, nil
 }
@@ -36,6 +36,10 @@ func (suite *LogoutRequestStoreTestSuite) TestAddThenGetRoundTrips() {
 		AppID:                 "app-1",
 		PostLogoutRedirectURI: "https://rp.example/after",
 		State:                 "xyz",
+		ExecutionID:           "exec-1",
+		FrontchannelLogoutURIs: []string{
+			"https://rp.example/frontchannel-logout?iss=https%3A%2F%2Fidp.example&sid=s1",
+		},
 	}
 	id, err := suite.store.AddRequest(context.Background(), want)
 	suite.Require().NoError(err)
@@ -0,0 +1,31 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+// AuthorizationDetail is a single entry of an RFC 9396 authorization_details array. The only
+// member common to all entries is "type"; every other member is defined by the type and is kept
+// as decoded JSON so that it can be validated against the type's registered schema and echoed
+// back unchanged.
+type AuthorizationDetail map[string]interface{}
+
+// Type returns the authorization details type of the entry, or an empty string when absent.
+func (d AuthorizationDetail) Type() string {
+	t, _ := d["type"].(string)
+	return t
+}
+
+// Locations returns the string members of the entry's "locations" array (RFC 9396 §2.2).
+func (d AuthorizationDetail) Locations() []string {
+	raw, ok := d["locations"].([]interface{})
+	if !ok {
+		return nil
+	}
+	locations := make([]string, 0, len(raw))
+	for _, l := range raw {
+		if s, ok := l.(string); ok {
+			locations = append(locations, s)
+		}
+	}
+	return locations
+}
@@ -19,6 +19,7 @@ type OAuthParameters struct {
 	RedirectURI         string
 	RedirectURIProvided bool
 	ResponseType        string
+	ResponseMode        string
 	StandardScopes      []string
 	PermissionScopes    []string
 	CodeChallenge       string
@@ -32,6 +33,12 @@ type OAuthParameters struct {
 	MaxAge              string
 	DPoPJkt             string
 	Prompt              string
+
+	// AuthorizationDetails holds the RFC 9396 authorization details of the request.
+	AuthorizationDetails []AuthorizationDetail
+	// CodeValidityPeriod is the validity period, in seconds, of the authorization code issued for the
+	// request. Zero uses the configured oauth.authorization_code.validity_period.
+	CodeValidityPeriod int64
 }
 
 // VerifiedClaimsMember is the OIDC Identity Assurance member name that may appear in the
@@ -25,6 +25,9 @@ type TokenRequest struct {
 	Audiences          []string `json:"audiences,omitempty"`
 	AuthReqID          string   `json:"auth_req_id,omitempty"`
 	Assertion          string   `json:"assertion,omitempty"`
+	DeviceCode         string   `json:"device_code,omitempty"`
+
+	AuthorizationDetails string `json:"authorization_details,omitempty"`
 }
 
 // TokenResponse represents the OAuth2 token response.
@@ -36,6 +39,8 @@ type TokenResponse struct {
 	Scope           string `json:"scope,omitempty"`
 	IDToken         string `json:"id_token,omitempty"`
 	IssuedTokenType string `json:"issued_token_type,omitempty"`
+
+	AuthorizationDetails []AuthorizationDetail `json:"authorization_details,omitempty"`
 }
 
 // TokenDTO represents the data transfer object for tokens.
@@ -56,6 +61,12 @@ type TokenDTO struct {
 	// TokenFamilyID is the token family id (tfid) stamped on the token, carried here so the refresh
 	// token issued alongside an access token can be stamped with the same family id.
 	TokenFamilyID string
+	// SessionID is the OpenID Connect session identifier (sid) of the grant's SSO session, carried here
+	// so the refresh token issued alongside can keep it for the ID tokens minted on refresh. It is not
+	// stamped on the token this DTO describes.
+	SessionID string
+	// AuthorizationDetails are the RFC 9396 authorization details granted to the token.
+	AuthorizationDetails []AuthorizationDetail
 }
 
 // TokenResponseDTO represents the data transfer object for token responses.
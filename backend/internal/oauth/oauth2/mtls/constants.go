@@ -0,0 +1,11 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+// cnfX5tS256 is the confirmation method member carrying the SHA-256 thumbprint of the client
+// certificate an access token is bound to (RFC 8705 §3.1).
+const cnfX5tS256 = "x5t#S256"
+
+// maxCertificateHeaderLength caps the size of a proxy-forwarded client certificate header.
+const maxCertificateHeaderLength = 16 << 10
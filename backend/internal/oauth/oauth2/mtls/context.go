@@ -0,0 +1,49 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import "context"
+
+// contextKey is a private type for mutual-TLS context value keys to avoid collisions.
+type contextKey string
+
+// Context keys for mutual-TLS values propagated across the request pipeline.
+const (
+	certificateKey contextKey = "mtls_client_certificate"
+	thumbprintKey  contextKey = "mtls_thumbprint"
+)
+
+// WithClientCertificate attaches the client certificate presented on the connection to the context.
+func WithClientCertificate(ctx context.Context, cert *ClientCertificate) context.Context {
+	return context.WithValue(ctx, certificateKey, cert)
+}
+
+// GetClientCertificate returns the client certificate previously attached via WithClientCertificate,
+// or nil when the client did not present one.
+func GetClientCertificate(ctx context.Context) *ClientCertificate {
+	if ctx == nil {
+		return nil
+	}
+	if v, ok := ctx.Value(certificateKey).(*ClientCertificate); ok {
+		return v
+	}
+	return nil
+}
+
+// WithThumbprint attaches the thumbprint of the client certificate the issued tokens must be bound
+// to, so grant handlers can sender-constrain them.
+func WithThumbprint(ctx context.Context, thumbprint string) context.Context {
+	return context.WithValue(ctx, thumbprintKey, thumbprint)
+}
+
+// GetThumbprint returns the certificate thumbprint previously attached via WithThumbprint, or "".
+func GetThumbprint(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if v, ok := ctx.Value(thumbprintKey).(string); ok {
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,10 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import "errors"
+
+// ErrCertificateBindingMismatch indicates a certificate-bound access token was presented without the
+// client certificate it is bound to.
+var ErrCertificateBindingMismatch = errors.New("access token is not bound to the presented client certificate")
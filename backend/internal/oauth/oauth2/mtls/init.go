@@ -0,0 +1,47 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mtls implements OAuth 2.0 mutual-TLS client authentication and certificate-bound access
+// tokens (RFC 8705).
+package mtls
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+)
+
+// Initialize builds the middleware that attaches the client certificate of each request to its
+// context. The trusted CA bundle, when configured, is resolved relative to the server home. A
+// forwarded certificate header must be accompanied by the proxies allowed to set it.
+func Initialize(cfg engineconfig.MTLSConfig) (func(http.Handler) http.Handler, error) {
+	var trustedCAs *x509.CertPool
+	if cfg.TrustedCAFile != "" {
+		caFilePath := path.Join(config.GetServerRuntime().ServerHome, cfg.TrustedCAFile)
+		pemData, err := os.ReadFile(caFilePath) // #nosec G304 -- path comes from server configuration
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mTLS trusted CA file: %w", err)
+		}
+		trustedCAs = x509.NewCertPool()
+		if !trustedCAs.AppendCertsFromPEM(pemData) {
+			return nil, fmt.Errorf("no certificates found in mTLS trusted CA file %s", cfg.TrustedCAFile)
+		}
+	}
+
+	trustedProxies, err := utils.ParseIPNets(cfg.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mTLS trusted proxies: %w", err)
+	}
+	if cfg.ClientCertificateHeader != "" && len(trustedProxies) == 0 {
+		return nil, fmt.Errorf("mTLS client certificate header %s requires trusted proxies",
+			cfg.ClientCertificateHeader)
+	}
+
+	return newClientCertificateMiddleware(trustedCAs, cfg.ClientCertificateHeader, trustedProxies).Handler, nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+)
+
+func TestInitialize_HeaderRequiresTrustedProxies(t *testing.T) {
+	_, err := Initialize(engineconfig.MTLSConfig{Enabled: true, ClientCertificateHeader: "X-Client-Cert"})
+	assert.Error(t, err)
+}
+
+func TestInitialize_HeaderWithTrustedProxies(t *testing.T) {
+	middleware, err := Initialize(engineconfig.MTLSConfig{
+		Enabled: true, ClientCertificateHeader: "X-Client-Cert", TrustedProxies: []string{"10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, middleware)
+}
+
+func TestInitialize_InvalidTrustedProxy(t *testing.T) {
+	_, err := Initialize(engineconfig.MTLSConfig{
+		Enabled: true, ClientCertificateHeader: "X-Client-Cert", TrustedProxies: []string{"not-an-address"},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"crypto/x509"
+	"net"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// clientCertificateMiddleware captures the client certificate of each request and attaches it to the
+// request context. When the server terminates TLS, the certificate is taken from the connection only.
+// Otherwise it is taken from certificateHeader, but only when the request comes from one of the
+// trustedProxies, since any other caller could place a client's public certificate in the header.
+type clientCertificateMiddleware struct {
+	trustedCAs        *x509.CertPool
+	certificateHeader string
+	trustedProxies    []*net.IPNet
+	logger            *log.Logger
+}
+
+// newClientCertificateMiddleware creates a new client certificate middleware.
+func newClientCertificateMiddleware(trustedCAs *x509.CertPool, certificateHeader string,
+	trustedProxies []*net.IPNet) *clientCertificateMiddleware {
+	return &clientCertificateMiddleware{
+		trustedCAs:        trustedCAs,
+		certificateHeader: certificateHeader,
+		trustedProxies:    trustedProxies,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "MTLSMiddleware")),
+	}
+}
+
+// Handler wraps next so that downstream handlers can read the client certificate from the context.
+func (m *clientCertificateMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cert := m.extract(r); cert != nil {
+			r = r.WithContext(WithClientCertificate(r.Context(), cert))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// extract returns the client certificate presented with the request, or nil when there is none, the
+// forwarded certificate comes from an untrusted address, or it cannot be parsed.
+func (m *clientCertificateMiddleware) extract(r *http.Request) *ClientCertificate {
+	var chain []*x509.Certificate
+	switch {
+	case r.TLS != nil:
+		if len(r.TLS.PeerCertificates) == 0 {
+			return nil
+		}
+		chain = r.TLS.PeerCertificates
+	case m.certificateHeader != "" && r.Header.Get(m.certificateHeader) != "":
+		if !m.fromTrustedProxy(r) {
+			m.logger.Debug(r.Context(), "Ignoring client certificate forwarded from an untrusted address")
+			return nil
+		}
+		cert, err := parseCertificateHeader(r.Header.Get(m.certificateHeader))
+		if err != nil {
+			m.logger.Debug(r.Context(), "Ignoring unparsable forwarded client certificate", log.Error(err))
+			return nil
+		}
+		chain = []*x509.Certificate{cert}
+	default:
+		return nil
+	}
+
+	return &ClientCertificate{
+		Certificate: chain[0],
+		Trusted:     m.verify(chain),
+	}
+}
+
+// fromTrustedProxy reports whether the connection of the request comes from a trusted proxy.
+func (m *clientCertificateMiddleware) fromTrustedProxy(r *http.Request) bool {
+	return utils.IsRemoteAddrIn(r, m.trustedProxies)
+}
+
+// verify reports whether the leaf certificate chains to a trusted CA, using the remaining
+// certificates of the presented chain as intermediates.
+func (m *clientCertificateMiddleware) verify(chain []*x509.Certificate) bool {
+	if m.trustedCAs == nil {
+		return false
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         m.trustedCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err == nil
+}
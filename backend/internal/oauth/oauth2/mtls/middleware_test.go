@@ -0,0 +1,142 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const testCertificateHeader = "X-Client-Cert"
+
+// testTrustedProxies trusts the remote address httptest assigns to requests (192.0.2.1).
+var testTrustedProxies = []*net.IPNet{{IP: net.IPv4(192, 0, 2, 0).To4(), Mask: net.CIDRMask(24, 32)}}
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+	ca       *x509.Certificate
+	caKey    *ecdsa.PrivateKey
+	caPool   *x509.CertPool
+	leaf     *x509.Certificate
+	captured *ClientCertificate
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	suite.ca, suite.caKey = newTestCertificate(suite.T(), &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	suite.caPool = x509.NewCertPool()
+	suite.caPool.AddCert(suite.ca)
+	suite.leaf, _ = newTestCertificate(suite.T(), &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "client"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, suite.ca, suite.caKey)
+	suite.captured = nil
+}
+
+func (suite *MiddlewareTestSuite) serve(m *clientCertificateMiddleware, r *http.Request) {
+	next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		suite.captured = GetClientCertificate(r.Context())
+	})
+	m.Handler(next).ServeHTTP(httptest.NewRecorder(), r)
+}
+
+func (suite *MiddlewareTestSuite) TestHandler_TLSPeerCertificate() {
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{suite.leaf}}
+
+	suite.serve(newClientCertificateMiddleware(suite.caPool, "", nil), r)
+
+	suite.Require().NotNil(suite.captured)
+	suite.True(suite.captured.Certificate.Equal(suite.leaf))
+	suite.True(suite.captured.Trusted)
+}
+
+func (suite *MiddlewareTestSuite) TestHandler_UntrustedCertificate() {
+	selfSigned, _ := newTestCertificate(suite.T(), &x509.Certificate{Subject: pkix.Name{CommonName: "self"}}, nil, nil)
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{selfSigned}}
+
+	suite.serve(newClientCertificateMiddleware(suite.caPool, "", nil), r)
+
+	suite.Require().NotNil(suite.captured)
+	suite.False(suite.captured.Trusted)
+}
+
+func (suite *MiddlewareTestSuite) TestHandler_ForwardedCertificateHeader() {
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	r.Header.Set(testCertificateHeader, base64.StdEncoding.EncodeToString(suite.leaf.Raw))
+
+	suite.serve(newClientCertificateMiddleware(suite.caPool, testCertificateHeader, testTrustedProxies), r)
+
+	suite.Require().NotNil(suite.captured)
+	suite.True(suite.captured.Certificate.Equal(suite.leaf))
+	suite.True(suite.captured.Trusted)
+}
+
+// TestHandler_ForgedHeaderFromUntrustedAddress verifies that a caller reaching the server directly
+// cannot present a client's public certificate through the forwarded certificate header.
+func (suite *MiddlewareTestSuite) TestHandler_ForgedHeaderFromUntrustedAddress() {
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	r.RemoteAddr = "198.51.100.7:40000"
+	r.Header.Set(testCertificateHeader, base64.StdEncoding.EncodeToString(suite.leaf.Raw))
+
+	suite.serve(newClientCertificateMiddleware(suite.caPool, testCertificateHeader, testTrustedProxies), r)
+
+	suite.Nil(suite.captured)
+}
+
+func (suite *MiddlewareTestSuite) TestHandler_HeaderIgnoredWhenServerTerminatesTLS() {
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	r.TLS = &tls.ConnectionState{}
+	r.Header.Set(testCertificateHeader, base64.StdEncoding.EncodeToString(suite.leaf.Raw))
+
+	suite.serve(newClientCertificateMiddleware(suite.caPool, testCertificateHeader, testTrustedProxies), r)
+
+	suite.Nil(suite.captured)
+}
+
+func (suite *MiddlewareTestSuite) TestHandler_HeaderIgnoredWhenNotConfigured() {
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	r.Header.Set(testCertificateHeader, base64.StdEncoding.EncodeToString(suite.leaf.Raw))
+
+	suite.serve(newClientCertificateMiddleware(suite.caPool, "", nil), r)
+
+	suite.Nil(suite.captured)
+}
+
+func (suite *MiddlewareTestSuite) TestHandler_UnparsableHeader() {
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	r.Header.Set(testCertificateHeader, "garbage")
+
+	suite.serve(newClientCertificateMiddleware(suite.caPool, testCertificateHeader, testTrustedProxies), r)
+
+	suite.Nil(suite.captured)
+}
+
+func (suite *MiddlewareTestSuite) TestHandler_NoTrustedCAs() {
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{suite.leaf}}
+
+	suite.serve(newClientCertificateMiddleware(nil, "", nil), r)
+
+	suite.Require().NotNil(suite.captured)
+	suite.False(suite.captured.Trusted)
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import "crypto/x509"
+
+// ClientCertificate is the client certificate presented on the mutual-TLS connection.
+type ClientCertificate struct {
+	// Certificate is the leaf certificate presented by the client.
+	Certificate *x509.Certificate
+	// Trusted is true when the certificate chains to one of the configured trusted CAs. Only trusted
+	// certificates may authenticate a client with tls_client_auth.
+	Trusted bool
+}
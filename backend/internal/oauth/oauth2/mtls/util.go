@@ -0,0 +1,176 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"context"
+	"crypto"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// publicKey is implemented by every public key type in the standard library.
+type publicKey interface {
+	Equal(x crypto.PublicKey) bool
+}
+
+// Thumbprint returns the base64url-encoded SHA-256 thumbprint of the certificate's DER encoding,
+// the value carried in cnf.x5t#S256 (RFC 8705 §3.1).
+func Thumbprint(cert *x509.Certificate) string {
+	return cryptolib.GenerateThumbprint(cert.Raw)
+}
+
+// ExtractCnfX5tS256 extracts the cnf.x5t#S256 certificate thumbprint from token claims.
+// Returns "" with no error when the token is not certificate-bound.
+func ExtractCnfX5tS256(claims map[string]any) (string, error) {
+	cnfRaw, exists := claims["cnf"]
+	if !exists {
+		return "", nil
+	}
+	cnf, ok := cnfRaw.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("invalid 'cnf' claim: must be an object")
+	}
+	thumbprintRaw, hasThumbprint := cnf[cnfX5tS256]
+	if !hasThumbprint {
+		return "", nil
+	}
+	thumbprint, ok := thumbprintRaw.(string)
+	if !ok || thumbprint == "" {
+		return "", fmt.Errorf("invalid 'cnf.x5t#S256' claim")
+	}
+	return thumbprint, nil
+}
+
+// SetCnfX5tS256 sets the client certificate thumbprint on a token claims map under cnf.x5t#S256,
+// preserving any other confirmation members already present. No-op when thumbprint is empty.
+func SetCnfX5tS256(claims map[string]any, thumbprint string) {
+	if thumbprint == "" {
+		return
+	}
+	cnf, ok := claims["cnf"].(map[string]any)
+	if !ok {
+		cnf = map[string]any{}
+	}
+	cnf[cnfX5tS256] = thumbprint
+	claims["cnf"] = cnf
+}
+
+// VerifyBinding checks that a certificate-bound access token is presented over a connection
+// authenticated with the certificate it is bound to (RFC 8705 §3). Unbound tokens always pass.
+func VerifyBinding(ctx context.Context, claims map[string]any) error {
+	thumbprint, err := ExtractCnfX5tS256(claims)
+	if err != nil {
+		return err
+	}
+	if thumbprint == "" {
+		return nil
+	}
+	clientCert := GetClientCertificate(ctx)
+	if clientCert == nil ||
+		subtle.ConstantTimeCompare([]byte(Thumbprint(clientCert.Certificate)), []byte(thumbprint)) != 1 {
+		return ErrCertificateBindingMismatch
+	}
+	return nil
+}
+
+// MatchesTLSClientAuth reports whether the certificate carries the subject identifier registered for
+// a tls_client_auth client (RFC 8705 §2.1.2). The subject DN is compared against its RFC 4514 string
+// representation; the SAN values against the corresponding certificate extension entries.
+func MatchesTLSClientAuth(cert *x509.Certificate, cfg *providers.TLSClientAuthConfig) bool {
+	if cert == nil || cfg == nil {
+		return false
+	}
+	switch {
+	case cfg.SubjectDN != "":
+		return cert.Subject.String() == cfg.SubjectDN
+	case cfg.SANDNS != "":
+		for _, name := range cert.DNSNames {
+			if strings.EqualFold(name, cfg.SANDNS) {
+				return true
+			}
+		}
+	case cfg.SANURI != "":
+		for _, uri := range cert.URIs {
+			if uri.String() == cfg.SANURI {
+				return true
+			}
+		}
+	case cfg.SANIP != "":
+		expected := net.ParseIP(cfg.SANIP)
+		for _, ip := range cert.IPAddresses {
+			if expected != nil && ip.Equal(expected) {
+				return true
+			}
+		}
+	case cfg.SANEmail != "":
+		for _, email := range cert.EmailAddresses {
+			if email == cfg.SANEmail {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MatchesJWKS reports whether the certificate's public key is one of the keys in the inline JWKS
+// registered for a self_signed_tls_client_auth client (RFC 8705 §2.2).
+func MatchesJWKS(cert *x509.Certificate, jwksValue string) (bool, error) {
+	if cert == nil {
+		return false, nil
+	}
+	var jwks struct {
+		Keys []map[string]any `json:"keys"`
+	}
+	if err := json.Unmarshal([]byte(jwksValue), &jwks); err != nil {
+		return false, fmt.Errorf("invalid JWKS certificate format: %w", err)
+	}
+	certKey, ok := cert.PublicKey.(publicKey)
+	if !ok {
+		return false, errors.New("unsupported client certificate public key type")
+	}
+	for _, jwk := range jwks.Keys {
+		key, err := defaultkm.JWKToPublicKey(jwk)
+		if err != nil {
+			continue
+		}
+		if certKey.Equal(key) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseCertificateHeader parses a client certificate forwarded by a TLS-terminating proxy. The value
+// may be a URL-encoded PEM certificate or the base64 encoding of its DER form.
+func parseCertificateHeader(value string) (*x509.Certificate, error) {
+	if len(value) > maxCertificateHeaderLength {
+		return nil, errors.New("client certificate header is too large")
+	}
+	if unescaped, err := url.QueryUnescape(value); err == nil {
+		if block, _ := pem.Decode([]byte(unescaped)); block != nil {
+			if block.Type != "CERTIFICATE" {
+				return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+			}
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+	der, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.New("client certificate header is neither PEM nor base64 DER")
+	}
+	return x509.ParseCertificate(der)
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type UtilTestSuite struct {
+	suite.Suite
+}
+
+func TestUtilTestSuite(t *testing.T) {
+	suite.Run(t, new(UtilTestSuite))
+}
+
+// newTestCertificate issues a client certificate for template, signed by parent/parentKey, or
+// self-signed when parent is nil.
+func newTestCertificate(t *testing.T, template *x509.Certificate, parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return cert, key
+}
+
+// ecJWKS returns an inline JWKS holding the public key of key.
+func ecJWKS(key *ecdsa.PrivateKey) string {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	key.PublicKey.X.FillBytes(x)
+	key.PublicKey.Y.FillBytes(y)
+	jwks, _ := json.Marshal(map[string]any{"keys": []map[string]any{{
+		"kty": "EC",
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(x),
+		"y":   base64.RawURLEncoding.EncodeToString(y),
+	}}})
+	return string(jwks)
+}
+
+func (suite *UtilTestSuite) TestThumbprint() {
+	cert, _ := newTestCertificate(suite.T(), &x509.Certificate{Subject: pkix.Name{CommonName: "client"}}, nil, nil)
+	sum := sha256.Sum256(cert.Raw)
+
+	suite.Equal(base64.RawURLEncoding.EncodeToString(sum[:]), Thumbprint(cert))
+}
+
+func (suite *UtilTestSuite) TestSetAndExtractCnfX5tS256() {
+	claims := map[string]any{"cnf": map[string]any{"jkt": "key-thumbprint"}}
+
+	SetCnfX5tS256(claims, "cert-thumbprint")
+
+	thumbprint, err := ExtractCnfX5tS256(claims)
+	suite.NoError(err)
+	suite.Equal("cert-thumbprint", thumbprint)
+	suite.Equal("key-thumbprint", claims["cnf"].(map[string]any)["jkt"])
+}
+
+func (suite *UtilTestSuite) TestSetCnfX5tS256_EmptyThumbprint() {
+	claims := map[string]any{}
+
+	SetCnfX5tS256(claims, "")
+
+	suite.NotContains(claims, "cnf")
+}
+
+func (suite *UtilTestSuite) TestExtractCnfX5tS256() {
+	testCases := []struct {
+		name        string
+		claims      map[string]any
+		expected    string
+		expectError bool
+	}{
+		{"NoCnf", map[string]any{}, "", false},
+		{"CnfWithoutThumbprint", map[string]any{"cnf": map[string]any{"jkt": "abc"}}, "", false},
+		{"CnfNotObject", map[string]any{"cnf": "abc"}, "", true},
+		{"ThumbprintNotString", map[string]any{"cnf": map[string]any{cnfX5tS256: 1}}, "", true},
+		{"EmptyThumbprint", map[string]any{"cnf": map[string]any{cnfX5tS256: ""}}, "", true},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			thumbprint, err := ExtractCnfX5tS256(tc.claims)
+			suite.Equal(tc.expectError, err != nil)
+			suite.Equal(tc.expected, thumbprint)
+		})
+	}
+}
+
+func (suite *UtilTestSuite) TestMatchesTLSClientAuth() {
+	uri, _ := url.Parse("spiffe://example.com/client")
+	cert, _ := newTestCertificate(suite.T(), &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "client", Organization: []string{"Example"}},
+		DNSNames:       []string{"client.example.com"},
+		URIs:           []*url.URL{uri},
+		IPAddresses:    []net.IP{net.ParseIP("10.0.0.1")},
+		EmailAddresses: []string{"client@example.com"},
+	}, nil, nil)
+
+	testCases := []struct {
+		name     string
+		cfg      *providers.TLSClientAuthConfig
+		expected bool
+	}{
+		{"SubjectDN", &providers.TLSClientAuthConfig{SubjectDN: "CN=client,O=Example"}, true},
+		{"SubjectDNMismatch", &providers.TLSClientAuthConfig{SubjectDN: "CN=other,O=Example"}, false},
+		{"SANDNSCaseInsensitive", &providers.TLSClientAuthConfig{SANDNS: "CLIENT.example.com"}, true},
+		{"SANDNSMismatch", &providers.TLSClientAuthConfig{SANDNS: "other.example.com"}, false},
+		{"SANURI", &providers.TLSClientAuthConfig{SANURI: "spiffe://example.com/client"}, true},
+		{"SANIP", &providers.TLSClientAuthConfig{SANIP: "10.0.0.1"}, true},
+		{"SANIPMismatch", &providers.TLSClientAuthConfig{SANIP: "10.0.0.2"}, false},
+		{"SANEmail", &providers.TLSClientAuthConfig{SANEmail: "client@example.com"}, true},
+		{"EmptyConfig", &providers.TLSClientAuthConfig{}, false},
+		{"NilConfig", nil, false},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			suite.Equal(tc.expected, MatchesTLSClientAuth(cert, tc.cfg))
+		})
+	}
+}
+
+func (suite *UtilTestSuite) TestMatchesJWKS() {
+	cert, key := newTestCertificate(suite.T(), &x509.Certificate{Subject: pkix.Name{CommonName: "client"}}, nil, nil)
+	_, otherKey := newTestCertificate(suite.T(), &x509.Certificate{Subject: pkix.Name{CommonName: "other"}}, nil, nil)
+
+	matched, err := MatchesJWKS(cert, ecJWKS(key))
+	suite.NoError(err)
+	suite.True(matched)
+
+	matched, err = MatchesJWKS(cert, ecJWKS(otherKey))
+	suite.NoError(err)
+	suite.False(matched)
+
+	_, err = MatchesJWKS(cert, "not-json")
+	suite.Error(err)
+}
+
+func (suite *UtilTestSuite) TestParseCertificateHeader() {
+	cert, _ := newTestCertificate(suite.T(), &x509.Certificate{Subject: pkix.Name{CommonName: "client"}}, nil, nil)
+	pemCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+
+	parsed, err := parseCertificateHeader(url.QueryEscape(pemCert))
+	suite.NoError(err)
+	suite.True(parsed.Equal(cert))
+
+	parsed, err = parseCertificateHeader(base64.StdEncoding.EncodeToString(cert.Raw))
+	suite.NoError(err)
+	suite.True(parsed.Equal(cert))
+
+	_, err = parseCertificateHeader("not a certificate")
+	suite.Error(err)
+}
+
+func (suite *UtilTestSuite) TestVerifyBinding() {
+	cert, _ := newTestCertificate(suite.T(), &x509.Certificate{Subject: pkix.Name{CommonName: "client"}}, nil, nil)
+	other, _ := newTestCertificate(suite.T(), &x509.Certificate{Subject: pkix.Name{CommonName: "other"}}, nil, nil)
+	bound := map[string]any{"cnf": map[string]any{cnfX5tS256: Thumbprint(cert)}}
+
+	suite.NoError(VerifyBinding(context.Background(), map[string]any{}))
+	suite.NoError(VerifyBinding(WithClientCertificate(context.Background(),
+		&ClientCertificate{Certificate: cert}), bound))
+	suite.ErrorIs(VerifyBinding(context.Background(), bound), ErrCertificateBindingMismatch)
+	suite.ErrorIs(VerifyBinding(WithClientCertificate(context.Background(),
+		&ClientCertificate{Certificate: other}), bound), ErrCertificateBindingMismatch)
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// pairwiseKeyInfo is the HKDF info label under which the pairwise key is derived from the crypto
+// encryption key. The fixed label keeps the derived key independent of any other use of that key.
+const pairwiseKeyInfo = "thunderid pairwise subject identifier key"
+
+// Initialize constructs the pairwise subject service. The pairwise identifiers are keyed with
+// oauth.pairwise_subject.salt or, when no salt is configured, with a key derived from the crypto
+// encryption key via HKDF-SHA256; the encryption key itself is never used as the HMAC key.
+func Initialize(cfg oauthconfig.Config) (PairwiseSubjectServiceInterface, error) {
+	encryptionKey := config.GetServerRuntime().Config.Crypto.Encryption.Key
+	key, err := pairwiseKey(cfg.OAuth.PairwiseSubject.Salt, encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return newPairwiseSubjectService(newPairwiseSubjectStore(), key), nil
+}
+
+// pairwiseKey returns the HMAC key for pairwise identifiers: the configured salt when set, otherwise
+// a key derived from the encryption key under pairwiseKeyInfo.
+func pairwiseKey(salt, encryptionKey string) ([]byte, error) {
+	if salt != "" {
+		return []byte(salt), nil
+	}
+	if encryptionKey == "" {
+		return nil, errors.New("pairwise subject key not configured: set oauth.pairwise_subject.salt " +
+			"or crypto.encryption.key")
+	}
+	key, err := hkdf.Key(sha256.New, []byte(encryptionKey), nil, pairwiseKeyInfo, sha256.Size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive the pairwise subject key: %w", err)
+	}
+	return key, nil
+}
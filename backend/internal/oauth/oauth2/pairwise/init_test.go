@@ -0,0 +1,50 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const testEncryptionKey = "0123456789abcdef0123456789abcdef"
+
+type InitTestSuite struct {
+	suite.Suite
+}
+
+func TestInitTestSuite(t *testing.T) {
+	suite.Run(t, new(InitTestSuite))
+}
+
+func (suite *InitTestSuite) TestPairwiseKey_UsesSalt() {
+	key, err := pairwiseKey("dedicated-salt", testEncryptionKey)
+
+	suite.NoError(err)
+	suite.Equal([]byte("dedicated-salt"), key)
+}
+
+func (suite *InitTestSuite) TestPairwiseKey_DerivesFromEncryptionKey() {
+	key, err := pairwiseKey("", testEncryptionKey)
+
+	suite.NoError(err)
+	suite.Len(key, 32)
+	suite.NotEqual([]byte(testEncryptionKey), key)
+
+	again, err := pairwiseKey("", testEncryptionKey)
+	suite.NoError(err)
+	suite.Equal(key, again)
+
+	other, err := pairwiseKey("", "fedcba9876543210fedcba9876543210")
+	suite.NoError(err)
+	suite.NotEqual(key, other)
+}
+
+func (suite *InitTestSuite) TestPairwiseKey_NotConfigured() {
+	key, err := pairwiseKey("", "")
+
+	suite.Error(err)
+	suite.Nil(key)
+}
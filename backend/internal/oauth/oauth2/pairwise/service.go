@@ -0,0 +1,103 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pairwise implements OpenID Connect pairwise subject identifiers (OpenID Connect Core 1.0
+// §8.1): a client registered with the pairwise subject type receives a subject identifier that is a
+// stable keyed hash of its sector and the user's local subject, so that clients in different sectors
+// cannot correlate a user by subject.
+package pairwise
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// PairwiseSubjectServiceInterface maps local subjects to the subject identifiers issued to clients,
+// and back.
+type PairwiseSubjectServiceInterface interface {
+	// GetSubject returns the subject identifier issued to the client for the local subject: the
+	// pairwise identifier for a pairwise client, otherwise the local subject unchanged.
+	GetSubject(client *providers.OAuthClient, localSubject string) string
+	// RecordSubject returns the subject identifier issued to the client for the local subject and, for
+	// a pairwise client, records the mapping so that ResolveLocalSubject can reverse it later.
+	RecordSubject(ctx context.Context, client *providers.OAuthClient, localSubject string) (string, error)
+	// ResolveLocalSubject returns the local subject a recorded pairwise identifier was issued for. A
+	// subject that is not a recorded pairwise identifier is returned unchanged.
+	ResolveLocalSubject(ctx context.Context, subject string) (string, error)
+}
+
+// pairwiseSubjectService is the default implementation of PairwiseSubjectServiceInterface.
+type pairwiseSubjectService struct {
+	store  pairwiseSubjectStoreInterface
+	key    []byte
+	logger *log.Logger
+}
+
+// newPairwiseSubjectService creates a new pairwiseSubjectService keyed with the given secret.
+func newPairwiseSubjectService(store pairwiseSubjectStoreInterface, key []byte) PairwiseSubjectServiceInterface {
+	return &pairwiseSubjectService{
+		store:  store,
+		key:    key,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PairwiseSubjectService")),
+	}
+}
+
+// GetSubject implements PairwiseSubjectServiceInterface.
+func (s *pairwiseSubjectService) GetSubject(client *providers.OAuthClient, localSubject string) string {
+	if !client.IsPairwise() || localSubject == "" {
+		return localSubject
+	}
+	return computePairwiseID(s.key, client.SectorIdentifier(), localSubject)
+}
+
+// RecordSubject implements PairwiseSubjectServiceInterface.
+func (s *pairwiseSubjectService) RecordSubject(ctx context.Context, client *providers.OAuthClient,
+	localSubject string) (string, error) {
+	subject := s.GetSubject(client, localSubject)
+	if subject == localSubject {
+		return subject, nil
+	}
+	if err := s.store.insertPairwiseSubject(ctx, pairwiseSubject{
+		PairwiseID:       subject,
+		SectorIdentifier: client.SectorIdentifier(),
+		SubjectID:        localSubject,
+		CreatedAt:        time.Now().UTC(),
+	}); err != nil {
+		return "", err
+	}
+	return subject, nil
+}
+
+// ResolveLocalSubject implements PairwiseSubjectServiceInterface.
+func (s *pairwiseSubjectService) ResolveLocalSubject(ctx context.Context, subject string) (string, error) {
+	if subject == "" {
+		return subject, nil
+	}
+	localSubject, found, err := s.store.getSubjectID(ctx, subject)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return subject, nil
+	}
+	s.logger.Debug(ctx, "Resolved pairwise subject identifier to its local subject")
+	return localSubject, nil
+}
+
+// computePairwiseID derives the pairwise subject identifier of a local subject within a sector as the
+// base64url-encoded HMAC-SHA256 of the sector and the local subject, joined by a NUL byte. Neither a
+// sector identifier (a host name) nor a subject identifier contains NUL, so no two (sector, subject)
+// pairs share an input.
+func computePairwiseID(key []byte, sector, localSubject string) string {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(sector))
+	_, _ = mac.Write([]byte{0})
+	_, _ = mac.Write([]byte(localSubject))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const testLocalSubject = "user-123"
+
+type PairwiseSubjectServiceTestSuite struct {
+	suite.Suite
+	mockStore *pairwiseSubjectStoreInterfaceMock
+	service   PairwiseSubjectServiceInterface
+	client    *providers.OAuthClient
+}
+
+func TestPairwiseSubjectServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PairwiseSubjectServiceTestSuite))
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) SetupTest() {
+	suite.mockStore = newPairwiseSubjectStoreInterfaceMock(suite.T())
+	suite.service = newPairwiseSubjectService(suite.mockStore, []byte("test-salt"))
+	suite.client = &providers.OAuthClient{
+		ClientID:     "client1",
+		SubjectType:  providers.SubjectTypePairwise,
+		RedirectURIs: []string{"https://app.example.com/callback"},
+	}
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestGetSubject_PublicClient() {
+	assert.Equal(suite.T(), testLocalSubject, suite.service.GetSubject(nil, testLocalSubject))
+	assert.Equal(suite.T(), testLocalSubject,
+		suite.service.GetSubject(&providers.OAuthClient{ClientID: "client1"}, testLocalSubject))
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestGetSubject_PairwiseClient() {
+	subject := suite.service.GetSubject(suite.client, testLocalSubject)
+	assert.NotEqual(suite.T(), testLocalSubject, subject)
+	assert.Equal(suite.T(), subject, suite.service.GetSubject(suite.client, testLocalSubject))
+	assert.NotEqual(suite.T(), subject, suite.service.GetSubject(suite.client, "user-456"))
+	assert.Empty(suite.T(), suite.service.GetSubject(suite.client, ""))
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestGetSubject_SameSectorSameSubject() {
+	sibling := &providers.OAuthClient{
+		ClientID:     "client2",
+		SubjectType:  providers.SubjectTypePairwise,
+		RedirectURIs: []string{"https://app.example.com/other"},
+	}
+	otherSector := &providers.OAuthClient{
+		ClientID:     "client3",
+		SubjectType:  providers.SubjectTypePairwise,
+		RedirectURIs: []string{"https://other.example.com/callback"},
+	}
+	subject := suite.service.GetSubject(suite.client, testLocalSubject)
+	assert.Equal(suite.T(), subject, suite.service.GetSubject(sibling, testLocalSubject))
+	assert.NotEqual(suite.T(), subject, suite.service.GetSubject(otherSector, testLocalSubject))
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestGetSubject_KeyedBySalt() {
+	other := newPairwiseSubjectService(suite.mockStore, []byte("other-salt"))
+	assert.NotEqual(suite.T(), suite.service.GetSubject(suite.client, testLocalSubject),
+		other.GetSubject(suite.client, testLocalSubject))
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestRecordSubject_PairwiseClient() {
+	expected := suite.service.GetSubject(suite.client, testLocalSubject)
+	suite.mockStore.EXPECT().insertPairwiseSubject(mock.Anything, mock.MatchedBy(func(s pairwiseSubject) bool {
+		return s.PairwiseID == expected && s.SectorIdentifier == "app.example.com" &&
+			s.SubjectID == testLocalSubject && !s.CreatedAt.IsZero()
+	})).Return(nil).Once()
+
+	subject, err := suite.service.RecordSubject(context.Background(), suite.client, testLocalSubject)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expected, subject)
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestRecordSubject_PublicClientNotRecorded() {
+	subject, err := suite.service.RecordSubject(context.Background(),
+		&providers.OAuthClient{ClientID: "client1"}, testLocalSubject)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testLocalSubject, subject)
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestRecordSubject_StoreError() {
+	suite.mockStore.EXPECT().insertPairwiseSubject(mock.Anything, mock.Anything).
+		Return(errors.New("db error")).Once()
+
+	subject, err := suite.service.RecordSubject(context.Background(), suite.client, testLocalSubject)
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), subject)
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestResolveLocalSubject_Recorded() {
+	suite.mockStore.EXPECT().getSubjectID(mock.Anything, "pairwise-id").Return(testLocalSubject, true, nil).Once()
+
+	subject, err := suite.service.ResolveLocalSubject(context.Background(), "pairwise-id")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testLocalSubject, subject)
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestResolveLocalSubject_NotRecorded() {
+	suite.mockStore.EXPECT().getSubjectID(mock.Anything, testLocalSubject).Return("", false, nil).Once()
+
+	subject, err := suite.service.ResolveLocalSubject(context.Background(), testLocalSubject)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testLocalSubject, subject)
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestResolveLocalSubject_Empty() {
+	subject, err := suite.service.ResolveLocalSubject(context.Background(), "")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), subject)
+}
+
+func (suite *PairwiseSubjectServiceTestSuite) TestResolveLocalSubject_StoreError() {
+	suite.mockStore.EXPECT().getSubjectID(mock.Anything, "pairwise-id").
+		Return("", false, errors.New("db error")).Once()
+
+	subject, err := suite.service.ResolveLocalSubject(context.Background(), "pairwise-id")
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), subject)
+}
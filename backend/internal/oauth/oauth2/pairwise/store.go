@@ -0,0 +1,88 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// pairwiseSubject is a recorded mapping from a pairwise subject identifier to its local subject.
+type pairwiseSubject struct {
+	// PairwiseID is the subject identifier issued to the clients of the sector.
+	PairwiseID string
+	// SectorIdentifier is the sector the identifier was computed for.
+	SectorIdentifier string
+	// SubjectID is the local subject the identifier was issued for.
+	SubjectID string
+	// CreatedAt is the time the mapping was first recorded.
+	CreatedAt time.Time
+}
+
+// pairwiseSubjectStoreInterface defines the persistence of pairwise subject identifier mappings.
+type pairwiseSubjectStoreInterface interface {
+	// insertPairwiseSubject records a pairwise subject identifier. The write is idempotent.
+	insertPairwiseSubject(ctx context.Context, subject pairwiseSubject) error
+	// getSubjectID returns the local subject recorded for the pairwise identifier, and whether one is
+	// recorded.
+	getSubjectID(ctx context.Context, pairwiseID string) (string, bool, error)
+}
+
+// pairwiseSubjectStore implements pairwiseSubjectStoreInterface against the runtime persistent database.
+type pairwiseSubjectStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newPairwiseSubjectStore creates a new pairwiseSubjectStore.
+func newPairwiseSubjectStore() pairwiseSubjectStoreInterface {
+	return &pairwiseSubjectStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// insertPairwiseSubject records a pairwise subject identifier. A duplicate (pairwise id, deployment) is
+// a no-op.
+func (s *pairwiseSubjectStore) insertPairwiseSubject(ctx context.Context, subject pairwiseSubject) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryInsertPairwiseSubject, subject.PairwiseID, s.deploymentID,
+		subject.SectorIdentifier, subject.SubjectID, subject.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error inserting pairwise subject: %w", err)
+	}
+
+	return nil
+}
+
+// getSubjectID returns the local subject recorded for the pairwise identifier.
+func (s *pairwiseSubjectStore) getSubjectID(ctx context.Context, pairwiseID string) (string, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPairwiseSubject, pairwiseID, s.deploymentID)
+	if err != nil {
+		return "", false, fmt.Errorf("error retrieving pairwise subject: %w", err)
+	}
+	if len(results) == 0 {
+		return "", false, nil
+	}
+
+	subjectID, ok := results[0]["subject_id"].(string)
+	if !ok {
+		return "", false, errors.New("failed to parse subject_id as string")
+	}
+	return subjectID, true, nil
+}
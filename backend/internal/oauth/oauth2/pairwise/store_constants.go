@@ -0,0 +1,23 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// queryInsertPairwiseSubject records a pairwise subject identifier. The identifier is derived from
+// the sector and the local subject, so a duplicate (PAIRWISE_ID, DEPLOYMENT_ID) always carries the same
+// mapping and is a no-op.
+var queryInsertPairwiseSubject = dbmodel.DBQuery{
+	ID: "PWQ-PWS-01",
+	Query: `INSERT INTO "PAIRWISE_SUBJECT" (PAIRWISE_ID, DEPLOYMENT_ID, SECTOR_IDENTIFIER, SUBJECT_ID, ` +
+		`CREATED_AT) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (PAIRWISE_ID, DEPLOYMENT_ID) DO NOTHING`,
+}
+
+// queryGetPairwiseSubject retrieves the local subject a pairwise subject identifier was issued for.
+var queryGetPairwiseSubject = dbmodel.DBQuery{
+	ID:    "PWQ-PWS-02",
+	Query: `SELECT SUBJECT_ID FROM "PAIRWISE_SUBJECT" WHERE PAIRWISE_ID = $1 AND DEPLOYMENT_ID = $2`,
+}
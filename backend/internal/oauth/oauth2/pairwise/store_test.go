@@ -0,0 +1,111 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type PairwiseSubjectStoreTestSuite struct {
+	suite.Suite
+	mockdbProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *pairwiseSubjectStore
+}
+
+func TestPairwiseSubjectStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(PairwiseSubjectStoreTestSuite))
+}
+
+func (suite *PairwiseSubjectStoreTestSuite) SetupTest() {
+	suite.mockdbProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &pairwiseSubjectStore{
+		dbProvider:   suite.mockdbProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *PairwiseSubjectStoreTestSuite) TestInsertPairwiseSubject() {
+	createdAt := time.Now().UTC()
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryInsertPairwiseSubject,
+		"pairwise-id", testDeploymentID, "app.example.com", "user-123", createdAt).Return(int64(1), nil)
+
+	err := suite.store.insertPairwiseSubject(context.Background(), pairwiseSubject{
+		PairwiseID:       "pairwise-id",
+		SectorIdentifier: "app.example.com",
+		SubjectID:        "user-123",
+		CreatedAt:        createdAt,
+	})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *PairwiseSubjectStoreTestSuite) TestInsertPairwiseSubject_DBClientError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(nil, errors.New("db unavailable"))
+
+	err := suite.store.insertPairwiseSubject(context.Background(), pairwiseSubject{PairwiseID: "pairwise-id"})
+	assert.ErrorContains(suite.T(), err, "failed to get runtime persistent database client")
+}
+
+func (suite *PairwiseSubjectStoreTestSuite) TestInsertPairwiseSubject_ExecuteError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryInsertPairwiseSubject,
+		"pairwise-id", testDeploymentID, "", "", time.Time{}).Return(int64(0), errors.New("insert error"))
+
+	err := suite.store.insertPairwiseSubject(context.Background(), pairwiseSubject{PairwiseID: "pairwise-id"})
+	assert.ErrorContains(suite.T(), err, "error inserting pairwise subject")
+}
+
+func (suite *PairwiseSubjectStoreTestSuite) TestGetSubjectID_Found() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetPairwiseSubject,
+		"pairwise-id", testDeploymentID).Return([]map[string]interface{}{{"subject_id": "user-123"}}, nil)
+
+	subjectID, found, err := suite.store.getSubjectID(context.Background(), "pairwise-id")
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), "user-123", subjectID)
+}
+
+func (suite *PairwiseSubjectStoreTestSuite) TestGetSubjectID_NotFound() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetPairwiseSubject,
+		"user-123", testDeploymentID).Return([]map[string]interface{}{}, nil)
+
+	subjectID, found, err := suite.store.getSubjectID(context.Background(), "user-123")
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), found)
+	assert.Empty(suite.T(), subjectID)
+}
+
+func (suite *PairwiseSubjectStoreTestSuite) TestGetSubjectID_QueryError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetPairwiseSubject,
+		"pairwise-id", testDeploymentID).Return(nil, errors.New("query error"))
+
+	_, found, err := suite.store.getSubjectID(context.Background(), "pairwise-id")
+	assert.ErrorContains(suite.T(), err, "error retrieving pairwise subject")
+	assert.False(suite.T(), found)
+}
+
+func (suite *PairwiseSubjectStoreTestSuite) TestGetSubjectID_InvalidRow() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetPairwiseSubject,
+		"pairwise-id", testDeploymentID).Return([]map[string]interface{}{{"subject_id": 42}}, nil)
+
+	_, found, err := suite.store.getSubjectID(context.Background(), "pairwise-id")
+	assert.Error(suite.T(), err)
+	assert.False(suite.T(), found)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -26,13 +27,14 @@ func Initialize(
 	jwtService jwt.JWTServiceInterface,
 	discoveryService discovery.DiscoveryServiceInterface,
 	resourceService providers.ResourceServerProvider,
+	requestObject requestobject.RequestObjectServiceInterface,
 	dpopVerifier dpop.VerifierInterface,
 	cfg oauthconfig.Config,
 	storeProvider providers.RuntimeStoreProvider,
 	jtiStore jti.JTIStoreInterface,
 ) PARServiceInterface {
 	store := newPARRequestStore(storeProvider)
-	parSvc := newPARService(store, resourceService, cfg)
+	parSvc := newPARService(store, resourceService, requestObject, cfg)
 	parEndpoint := discoveryService.GetOAuth2AuthorizationServerMetadata(
 		context.Background()).PushedAuthorizationRequestEndpoint
 	handler := newPARHandler(parSvc, dpopVerifier, parEndpoint)
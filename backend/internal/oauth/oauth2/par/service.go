@@ -11,9 +11,11 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz/requestvalidator"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -22,6 +24,12 @@ import (
 // requestURIPrefix is the URN prefix used for PAR request URIs per RFC 9126.
 const requestURIPrefix = "urn:ietf:params:oauth:request_uri:"
 
+// IsPushedRequestURI reports whether requestURI references a pushed authorization request, as opposed
+// to a request object hosted by the client.
+func IsPushedRequestURI(requestURI string) bool {
+	return strings.HasPrefix(requestURI, requestURIPrefix)
+}
+
 // sensitiveParParams is the deny-list of PAR body parameters that must not be persisted into
 // InitiatorRequest.QueryParams, since they carry client credentials and the PAR store is a
 // plaintext runtime cache.
@@ -46,6 +54,7 @@ type PARServiceInterface interface {
 type parService struct {
 	store           parStoreInterface
 	resourceService providers.ResourceServerProvider
+	requestObject   requestobject.RequestObjectServiceInterface
 	cfg             oauthconfig.Config
 	logger          *log.Logger
 }
@@ -53,11 +62,12 @@ type parService struct {
 // newPARService creates a new PAR service instance.
 func newPARService(
 	store parStoreInterface, resourceService providers.ResourceServerProvider,
-	cfg oauthconfig.Config,
+	requestObject requestobject.RequestObjectServiceInterface, cfg oauthconfig.Config,
 ) PARServiceInterface {
 	return &parService{
 		store:           store,
 		resourceService: resourceService,
+		requestObject:   requestObject,
 		cfg:             cfg,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PARService")),
 	}
@@ -75,6 +85,12 @@ func (s *parService) HandlePushedAuthorizationRequest(
 			"request_uri parameter must not be included in a pushed authorization request"
 	}
 
+	// A request object, when pushed, carries the authorization request parameters (RFC 9126 §3).
+	params, resources, errResp := s.resolveRequestObject(ctx, params, resources, oauthApp)
+	if errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+
 	// Validate the redirect URI.
 	redirectURI := params[oauth2const.RequestParamRedirectURI]
 	if err := oauthApp.ValidateRedirectURI(ctx, redirectURI); err != nil {
@@ -119,8 +135,19 @@ func (s *parService) HandlePushedAuthorizationRequest(
 	// here at push time. The authoritative binding and per-resource-server downscoping still happen
 	// when the pushed request is redeemed at the authorization endpoint, so both standard and
 	// PAR-based requests bind identically.
-	if _, errResp := resourceindicators.ResolveAudienceBinding(
-		ctx, s.resourceService, resources, nonOidcScopes); errResp != nil {
+	targetRS, errResp := resourceindicators.ResolveAudienceBinding(ctx, s.resourceService, resources, nonOidcScopes)
+	if errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+
+	// Authorization details are validated against the bound resource server at push time as well, so
+	// the client learns of an unsupported type before redirecting the user.
+	authorizationDetails, errResp := authorizationdetails.Parse(params[oauth2const.RequestParamAuthorizationDetails])
+	if errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+	if _, errResp := authorizationdetails.ResolveAndValidate(
+		ctx, s.resourceService, resources, targetRS, authorizationDetails); errResp != nil {
 		return nil, errResp.Error, errResp.ErrorDescription
 	}
 
@@ -135,6 +162,7 @@ func (s *parService) HandlePushedAuthorizationRequest(
 		RedirectURI:         redirectURI,
 		RedirectURIProvided: redirectURIProvided,
 		ResponseType:        params[oauth2const.RequestParamResponseType],
+		ResponseMode:        params[oauth2const.RequestParamResponseMode],
 		StandardScopes:      oidcScopes,
 		PermissionScopes:    nonOidcScopes,
 		CodeChallenge:       params[oauth2const.RequestParamCodeChallenge],
@@ -148,6 +176,8 @@ func (s *parService) HandlePushedAuthorizationRequest(
 		MaxAge:              params[oauth2const.RequestParamMaxAge],
 		DPoPJkt:             resolveDPoPJkt(params[oauth2const.RequestParamDPoPJkt], dpopHeaderJkt),
 		Prompt:              params[oauth2const.RequestParamPrompt],
+
+		AuthorizationDetails: authorizationDetails,
 	}
 
 	initiatorQueryParams := make(map[string][]string, len(params)+1)
@@ -183,6 +213,32 @@ func (s *parService) HandlePushedAuthorizationRequest(
 	}, "", ""
 }
 
+// resolveRequestObject returns the pushed parameters and resources in effect once the request object,
+// if any, is verified and applied.
+func (s *parService) resolveRequestObject(
+	ctx context.Context, params map[string]string, resources []string, oauthApp *providers.OAuthClient,
+) (map[string]string, []string, *oauth2model.ErrorResponse) {
+	values := make(url.Values, len(params)+1)
+	for k, v := range params {
+		values.Set(k, v)
+	}
+	if len(resources) > 0 {
+		values[oauth2const.RequestParamResource] = resources
+	}
+
+	resolved, errResp := s.requestObject.ResolveRequestParameters(ctx, values, oauthApp)
+	if errResp != nil {
+		return nil, nil, errResp
+	}
+	resolvedParams := make(map[string]string, len(resolved))
+	for k, v := range resolved {
+		if k != oauth2const.RequestParamResource && len(v) > 0 {
+			resolvedParams[k] = v[0]
+		}
+	}
+	return resolvedParams, resolved[oauth2const.RequestParamResource], nil
+}
+
 // resolveDPoPJkt picks the effective DPoP key thumbprint. The proof-derived thumbprint
 // takes precedence; the validator has already enforced equality when both are present.
 func resolveDPoPJkt(paramJkt, headerJkt string) string {
@@ -197,7 +253,7 @@ func resolveDPoPJkt(paramJkt, headerJkt string) string {
 func (s *parService) ResolvePushedAuthorizationRequest(
 	ctx context.Context, requestURI string, clientID string,
 ) (*oauth2model.OAuthParameters, *providers.InitiatorRequest, error) {
-	if !strings.HasPrefix(requestURI, requestURIPrefix) {
+	if !IsPushedRequestURI(requestURI) {
 		return nil, nil, errInvalidRequestURI
 	}
 	randomKey := strings.TrimPrefix(requestURI, requestURIPrefix)
@@ -6,6 +6,7 @@ package par
 import (
 	"context"
 	"errors"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -22,6 +23,7 @@ import (
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/requestobjectmock"
 	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 	"github.com/thunder-id/thunderid/tests/testhelpers"
 )
@@ -89,6 +91,19 @@ func (s *ServiceTestSuite) newPermissiveResourceMock() *resourcemock.ResourceSer
 	return m
 }
 
+// newPassThroughRequestObjectMock returns a request object service mock that leaves the parameters
+// unchanged, so tests not concerned with request objects don't need to script expectations.
+func (s *ServiceTestSuite) newPassThroughRequestObjectMock() *requestobjectmock.RequestObjectServiceInterfaceMock {
+	m := requestobjectmock.NewRequestObjectServiceInterfaceMock(s.T())
+	m.On("ResolveRequestParameters", mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, params url.Values, _ *providers.OAuthClient) url.Values {
+			return params
+		}, func(_ context.Context, _ url.Values, _ *providers.OAuthClient) *model.ErrorResponse {
+			return nil
+		}).Maybe()
+	return m
+}
+
 func (s *ServiceTestSuite) newValidParams() map[string]string {
 	return map[string]string{
 		oauth2const.RequestParamResponseType: "code",
@@ -101,7 +116,7 @@ func (s *ServiceTestSuite) newValidParams() map[string]string {
 func (s *ServiceTestSuite) TestHandlePAR_Success() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 
@@ -115,7 +130,7 @@ func (s *ServiceTestSuite) TestHandlePAR_Success() {
 
 func (s *ServiceTestSuite) TestHandlePAR_RejectsRequestURIInBody() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamRequestURI] = "urn:ietf:params:oauth:request_uri:test"
@@ -127,9 +142,61 @@ func (s *ServiceTestSuite) TestHandlePAR_RejectsRequestURIInBody() {
 	assert.Contains(s.T(), errDesc, "request_uri parameter must not be included")
 }
 
+func (s *ServiceTestSuite) TestHandlePAR_AppliesRequestObject() {
+	store := newParStoreInterfaceMock(s.T())
+	var stored pushedAuthorizationRequest
+	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, req pushedAuthorizationRequest, _ int64) { stored = req }).
+		Return("test-uri", nil)
+	requestObject := requestobjectmock.NewRequestObjectServiceInterfaceMock(s.T())
+	requestObject.EXPECT().ResolveRequestParameters(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, params url.Values, _ *providers.OAuthClient) (
+			url.Values, *model.ErrorResponse) {
+			s.Equal("signed-request", params.Get(oauth2const.RequestParamRequest))
+			return url.Values{
+				oauth2const.RequestParamClientID:     {"test-client"},
+				oauth2const.RequestParamResponseType: {"code"},
+				oauth2const.RequestParamRedirectURI:  {"https://example.com/callback"},
+				oauth2const.RequestParamScope:        {"openid"},
+				oauth2const.RequestParamState:        {"signed-state"},
+				oauth2const.RequestParamResource:     {"https://api.example.com"},
+			}, nil
+		})
+	svc := newPARService(store, s.newPermissiveResourceMock(), requestObject, s.testCfg)
+	params := map[string]string{
+		oauth2const.RequestParamClientID: "test-client",
+		oauth2const.RequestParamRequest:  "signed-request",
+	}
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, s.newTestApp(), "")
+
+	s.Empty(errCode)
+	s.NotNil(resp)
+	s.Equal("signed-state", stored.OAuthParameters.State)
+	s.Equal([]string{"https://api.example.com"}, stored.OAuthParameters.Resources)
+	s.NotContains(stored.InitiatorRequest.QueryParams, oauth2const.RequestParamRequest)
+}
+
+func (s *ServiceTestSuite) TestHandlePAR_RequestObjectError() {
+	store := newParStoreInterfaceMock(s.T())
+	requestObject := requestobjectmock.NewRequestObjectServiceInterfaceMock(s.T())
+	requestObject.EXPECT().ResolveRequestParameters(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, &model.ErrorResponse{
+			Error:            oauth2const.ErrorInvalidRequestObject,
+			ErrorDescription: "The request object must be signed",
+		})
+	svc := newPARService(store, s.newPermissiveResourceMock(), requestObject, s.testCfg)
+
+	resp, errCode, errDesc := svc.HandlePushedAuthorizationRequest(s.ctx, s.newValidParams(), nil, s.newTestApp(), "")
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidRequestObject, errCode)
+	s.Equal("The request object must be signed", errDesc)
+}
+
 func (s *ServiceTestSuite) TestHandlePAR_MissingResponseType() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	delete(params, oauth2const.RequestParamResponseType)
@@ -142,7 +209,7 @@ func (s *ServiceTestSuite) TestHandlePAR_MissingResponseType() {
 
 func (s *ServiceTestSuite) TestHandlePAR_InvalidRedirectURI() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamRedirectURI] = "https://evil.com/callback"
@@ -155,7 +222,7 @@ func (s *ServiceTestSuite) TestHandlePAR_InvalidRedirectURI() {
 
 func (s *ServiceTestSuite) TestHandlePAR_UnauthorizedGrantType() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	app.GrantTypes = []providers.GrantType{providers.GrantTypeClientCredentials}
 	params := s.newValidParams()
@@ -168,7 +235,7 @@ func (s *ServiceTestSuite) TestHandlePAR_UnauthorizedGrantType() {
 
 func (s *ServiceTestSuite) TestHandlePAR_UnsupportedResponseType() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamResponseType] = "token"
@@ -181,7 +248,7 @@ func (s *ServiceTestSuite) TestHandlePAR_UnsupportedResponseType() {
 
 func (s *ServiceTestSuite) TestHandlePAR_PKCERequired() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	app.PKCERequired = true
 	params := s.newValidParams()
@@ -196,7 +263,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PKCERequired() {
 func (s *ServiceTestSuite) TestHandlePAR_StoreError() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("store error"))
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 
@@ -208,7 +275,7 @@ func (s *ServiceTestSuite) TestHandlePAR_StoreError() {
 
 func (s *ServiceTestSuite) TestHandlePAR_PromptNone_LoginRequired() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamPrompt] = "none"
@@ -222,7 +289,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PromptNone_LoginRequired() {
 
 func (s *ServiceTestSuite) TestHandlePAR_PromptInvalid() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamPrompt] = "invalid_value"
@@ -236,7 +303,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PromptInvalid() {
 func (s *ServiceTestSuite) TestHandlePAR_PromptLogin_Success() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamPrompt] = "login"
@@ -249,7 +316,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PromptLogin_Success() {
 
 func (s *ServiceTestSuite) TestHandlePAR_ResourceWithFragment() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://api.example.com/resource#fragment"}
@@ -262,7 +329,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ResourceWithFragment() {
 
 func (s *ServiceTestSuite) TestHandlePAR_ResourceMissingScheme() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"api.example.com/resource"}
@@ -276,7 +343,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ResourceMissingScheme() {
 func (s *ServiceTestSuite) TestHandlePAR_ValidResource_Success() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://api.example.com/resource"}
@@ -295,7 +362,7 @@ func (s *ServiceTestSuite) TestHandlePAR_UnregisteredResource_InvalidTarget() {
 			Type: tidcommon.ClientErrorType,
 			Code: "RES-1001",
 		})
-	svc := newPARService(store, rsMock, s.testCfg)
+	svc := newPARService(store, rsMock, s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://unknown.example.com"}
@@ -314,7 +381,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ResourceResolutionServerError() {
 			Type: tidcommon.ServerErrorType,
 			Code: "RES-5000",
 		})
-	svc := newPARService(store, rsMock, s.testCfg)
+	svc := newPARService(store, rsMock, s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://api.example.com/resource"}
@@ -338,7 +405,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ValidatesResourceAndStoresRawScopes() {
 		Return(&providers.ResourceServer{ID: "rs-1", Identifier: "https://api.example.com"},
 			(*tidcommon.ServiceError)(nil))
 
-	svc := newPARService(store, rsMock, s.testCfg)
+	svc := newPARService(store, rsMock, s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamScope] = "read write"
@@ -363,7 +430,7 @@ func (s *ServiceTestSuite) TestHandlePAR_NoResourceNoDefaultRejectsAtPush() {
 			Code: "RES-1003",
 		})
 
-	svc := newPARService(store, rsMock, s.testCfg)
+	svc := newPARService(store, rsMock, s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	// Permission scope with no explicit resource and no default configured: reject up front.
@@ -388,7 +455,7 @@ func (s *ServiceTestSuite) TestHandlePAR_NoResourceWithDefaultSucceedsAtPush() {
 		Return(&providers.ResourceServer{ID: "rs-default", Identifier: "https://default.example.com"},
 			(*tidcommon.ServiceError)(nil))
 
-	svc := newPARService(store, rsMock, s.testCfg)
+	svc := newPARService(store, rsMock, s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamScope] = "openid read"
@@ -411,7 +478,7 @@ func (s *ServiceTestSuite) TestHandlePAR_NarrowsOIDCClaimsByScopeClaims() {
 			captured = req
 		}).Return("test-uri", nil)
 
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	app.ScopeClaims = map[string][]string{"profile": {"name"}}
 	params := s.newValidParams()
@@ -433,7 +500,7 @@ func (s *ServiceTestSuite) TestHandlePAR_AcrValuesPropagated() {
 			captured = req
 		}).Return("test-uri", nil)
 
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamAcrValues] = "urn:thunder:acr:password urn:thunder:acr:generated-code"
@@ -455,7 +522,7 @@ func (s *ServiceTestSuite) TestHandlePAR_MaxAgePropagated() {
 			captured = req
 		}).Return("test-uri", nil)
 
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamMaxAge] = "1"
@@ -474,7 +541,7 @@ func (s *ServiceTestSuite) TestHandlePAR_DPoPHeaderJkt_PersistedOnRequest() {
 		Run(func(_ context.Context, req pushedAuthorizationRequest, _ int64) {
 			captured = req
 		}).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 
@@ -492,7 +559,7 @@ func (s *ServiceTestSuite) TestHandlePAR_DPoPJktParam_PersistedWhenNoHeader() {
 		Run(func(_ context.Context, req pushedAuthorizationRequest, _ int64) {
 			captured = req
 		}).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamDPoPJkt] = testJKT
@@ -506,7 +573,7 @@ func (s *ServiceTestSuite) TestHandlePAR_DPoPJktParam_PersistedWhenNoHeader() {
 
 func (s *ServiceTestSuite) TestHandlePAR_DPoPJktParam_HeaderMismatch_Rejected() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamDPoPJkt] = testJKT
@@ -519,7 +586,7 @@ func (s *ServiceTestSuite) TestHandlePAR_DPoPJktParam_HeaderMismatch_Rejected()
 
 func (s *ServiceTestSuite) TestHandlePAR_NonceTooLong() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamNonce] = strings.Repeat("a", oauth2const.MaxNonceLength+1)
@@ -538,7 +605,7 @@ func (s *ServiceTestSuite) TestHandlePAR_StripsCredentialsFromInitiatorQueryPara
 			captured = req
 		}).Return("test-uri", nil)
 
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamClientSecret] = "super-secret"
@@ -569,7 +636,7 @@ func (s *ServiceTestSuite) TestResolvePAR_Success() {
 	}
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).Return(storedRequest, true, nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 
 	result, _, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"test-uri", "test-client")
@@ -582,7 +649,7 @@ func (s *ServiceTestSuite) TestResolvePAR_Success() {
 
 func (s *ServiceTestSuite) TestResolvePAR_InvalidURIFormat() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 
 	result, _, err := svc.ResolvePushedAuthorizationRequest(s.ctx, "invalid-uri", "test-client")
 
@@ -593,7 +660,7 @@ func (s *ServiceTestSuite) TestResolvePAR_InvalidURIFormat() {
 func (s *ServiceTestSuite) TestResolvePAR_NotFound() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).Return(pushedAuthorizationRequest{}, false, nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 
 	result, _, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"nonexistent", "test-client")
@@ -611,7 +678,7 @@ func (s *ServiceTestSuite) TestResolvePAR_ClientIDMismatch() {
 	}
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).Return(storedRequest, true, nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 
 	result, _, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"test-uri", "client-b")
@@ -624,7 +691,7 @@ func (s *ServiceTestSuite) TestResolvePAR_ConsumeError() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).
 		Return(pushedAuthorizationRequest{}, false, errors.New("cache error"))
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 
 	result, _, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"test-uri", "test-client")
@@ -635,7 +702,7 @@ func (s *ServiceTestSuite) TestResolvePAR_ConsumeError() {
 
 func (s *ServiceTestSuite) TestHandlePAR_MultipleResources_InvalidTarget() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.newPassThroughRequestObjectMock(), s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://a.example.com", "https://b.example.com"}
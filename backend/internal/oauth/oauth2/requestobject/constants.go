@@ -0,0 +1,13 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package requestobject
+
+const (
+	// requestObjectContentType is the media type of a request object served from a request_uri (RFC 9101 §10.2).
+	requestObjectContentType = "application/oauth-authz-req+jwt"
+	// maxRequestObjectBytes caps the size of a request object fetched from a request_uri.
+	maxRequestObjectBytes = 64 << 10
+	// algNone is the JWS alg of an unsigned request object.
+	algNone = "none"
+)
@@ -0,0 +1,24 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package requestobject
+
+import (
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+)
+
+// Initialize creates the request object service. httpClient fetches request objects passed by
+// reference and must already be configured with timeouts and SSRF-safe redirects.
+func Initialize(
+	jwtService jwt.JWTServiceInterface,
+	jweService jwe.JWEServiceInterface,
+	resolver *jwksresolver.Resolver,
+	httpClient syshttp.HTTPClientInterface,
+	cfg oauthconfig.Config,
+) RequestObjectServiceInterface {
+	return newRequestObjectService(jwtService, jweService, resolver, httpClient, cfg)
+}
@@ -0,0 +1,294 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package requestobject resolves JWT-Secured Authorization Requests (RFC 9101): authorization
+// request parameters passed in a request object, either by value in the request parameter or by
+// reference in a request_uri hosted by the client.
+package requestobject
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/fapi"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// registeredClaims are the JWT claims of a request object that are not authorization request parameters.
+var registeredClaims = map[string]bool{
+	oauth2const.ClaimIss: true,
+	oauth2const.ClaimAud: true,
+	oauth2const.ClaimExp: true,
+	oauth2const.ClaimIat: true,
+	oauth2const.ClaimJTI: true,
+	"nbf":                true,
+}
+
+// RequestObjectServiceInterface resolves the authorization request parameters carried by a request object.
+type RequestObjectServiceInterface interface {
+	// ResolveRequestParameters returns the authorization request parameters in effect for params. When
+	// params carry a request object it is decrypted and verified, and its claims take precedence over
+	// the plain parameters. Pushed authorization request_uri values are not handled here.
+	ResolveRequestParameters(ctx context.Context, params url.Values, client *providers.OAuthClient) (
+		url.Values, *model.ErrorResponse)
+}
+
+// requestObjectService is the default implementation of RequestObjectServiceInterface.
+type requestObjectService struct {
+	cfg          oauthconfig.Config
+	jwtService   jwt.JWTServiceInterface
+	jweService   jwe.JWEServiceInterface
+	jwksResolver *jwksresolver.Resolver
+	httpClient   syshttp.HTTPClientInterface
+	logger       *log.Logger
+}
+
+// newRequestObjectService creates a new request object service.
+func newRequestObjectService(
+	jwtService jwt.JWTServiceInterface,
+	jweService jwe.JWEServiceInterface,
+	resolver *jwksresolver.Resolver,
+	httpClient syshttp.HTTPClientInterface,
+	cfg oauthconfig.Config,
+) *requestObjectService {
+	return &requestObjectService{
+		cfg:          cfg,
+		jwtService:   jwtService,
+		jweService:   jweService,
+		jwksResolver: resolver,
+		httpClient:   httpClient,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "RequestObjectService")),
+	}
+}
+
+// ResolveRequestParameters implements RequestObjectServiceInterface.
+func (s *requestObjectService) ResolveRequestParameters(ctx context.Context, params url.Values,
+	client *providers.OAuthClient) (url.Values, *model.ErrorResponse) {
+	requestObject := params.Get(oauth2const.RequestParamRequest)
+	requestURI := params.Get(oauth2const.RequestParamRequestURI)
+	if requestObject != "" && requestURI != "" {
+		return nil, &model.ErrorResponse{
+			Error:            oauth2const.ErrorInvalidRequest,
+			ErrorDescription: "The request and request_uri parameters must not be used together",
+		}
+	}
+	if requestURI != "" {
+		var errResp *model.ErrorResponse
+		if requestObject, errResp = s.fetchRequestObject(ctx, requestURI); errResp != nil {
+			return nil, errResp
+		}
+	}
+	if requestObject == "" {
+		if client.RequireSignedRequestObject {
+			return nil, &model.ErrorResponse{
+				Error:            oauth2const.ErrorInvalidRequest,
+				ErrorDescription: "A signed request object is required for this client",
+			}
+		}
+		return params, nil
+	}
+
+	claims, errResp := s.decodeRequestObject(ctx, requestObject, client)
+	if errResp != nil {
+		return nil, errResp
+	}
+	return mergeRequestParameters(params, claims, client)
+}
+
+// fetchRequestObject retrieves a request object passed by reference. The request_uri must be an
+// https URI that is safe to call; the response is capped at maxRequestObjectBytes.
+func (s *requestObjectService) fetchRequestObject(ctx context.Context, requestURI string) (
+	string, *model.ErrorResponse) {
+	invalidRequestURI := &model.ErrorResponse{
+		Error:            oauth2const.ErrorInvalidRequestURI,
+		ErrorDescription: "Failed to retrieve the request object from request_uri",
+	}
+	if err := syshttp.IsSSRFSafeURL(requestURI); err != nil {
+		s.logger.Debug(ctx, "Rejected request_uri that is not SSRF-safe", log.Error(err))
+		return "", invalidRequestURI
+	}
+	if s.httpClient == nil {
+		s.logger.Error(ctx, "HTTP client is not configured for request object retrieval")
+		return "", &model.ErrorResponse{
+			Error:            oauth2const.ErrorServerError,
+			ErrorDescription: "Failed to process the request object",
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURI, nil)
+	if err != nil {
+		s.logger.Debug(ctx, "Failed to build request_uri request", log.Error(err))
+		return "", invalidRequestURI
+	}
+	req.Header.Set("Accept", requestObjectContentType)
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Debug(ctx, "Failed to fetch request object from request_uri", log.Error(err))
+		return "", invalidRequestURI
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Debug(ctx, "request_uri returned non-200 status", log.Int("statusCode", resp.StatusCode))
+		return "", invalidRequestURI
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRequestObjectBytes+1))
+	if err != nil || len(body) > maxRequestObjectBytes {
+		s.logger.Debug(ctx, "Failed to read request object from request_uri", log.Error(err))
+		return "", invalidRequestURI
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// decodeRequestObject decrypts the request object when it is a JWE and verifies its signature against
+// the client's registered keys. The signed object must be issued by the client to this server and carry
+// an expiry. Unsigned objects are accepted only from clients that do not require signed request objects.
+func (s *requestObjectService) decodeRequestObject(ctx context.Context, requestObject string,
+	client *providers.OAuthClient) (map[string]interface{}, *model.ErrorResponse) {
+	if strings.Count(requestObject, ".") == 4 {
+		payload, svcErr := s.jweService.Decrypt(ctx, requestObject)
+		if svcErr != nil {
+			s.logger.Debug(ctx, "Failed to decrypt request object", log.String("error", svcErr.Error.DefaultValue))
+			return nil, invalidRequestObject("Failed to decrypt the request object")
+		}
+		requestObject = string(payload)
+	}
+
+	header, err := jwt.DecodeJWTHeader(requestObject)
+	if err != nil {
+		s.logger.Debug(ctx, "Failed to decode request object header", log.Error(err))
+		return nil, invalidRequestObject("The request object is malformed")
+	}
+	alg, _ := header["alg"].(string)
+
+	if client.IsFAPI2() && !fapi.IsAllowedSigningAlgorithm(alg) {
+		return nil, invalidRequestObject(
+			"The request object must be signed with PS256, ES256 or EdDSA under the FAPI 2.0 security profile")
+	}
+
+	if alg == algNone {
+		if client.RequireSignedRequestObject {
+			return nil, invalidRequestObject("The request object must be signed")
+		}
+		if !strings.HasSuffix(requestObject, ".") {
+			return nil, invalidRequestObject("The request object is malformed")
+		}
+	} else {
+		kid, _ := header["kid"].(string)
+		key, svcErr := s.jwksResolver.ResolveVerificationKey(ctx, client.Certificate, kid, alg)
+		if svcErr != nil {
+			return nil, invalidRequestObject("No registered key of the client verifies the request object")
+		}
+		if svcErr := s.jwtService.VerifyJWTWithPublicKey(ctx, requestObject, providers.KeyRef{PublicKeyJWK: key},
+			s.cfg.JWT.Issuer, client.ClientID); svcErr != nil {
+			s.logger.Debug(ctx, "Request object verification failed",
+				log.String("error", svcErr.Error.DefaultValue))
+			return nil, invalidRequestObject("The request object signature or claims are invalid")
+		}
+	}
+
+	claims, err := jwt.DecodeJWTPayload(requestObject)
+	if err != nil {
+		s.logger.Debug(ctx, "Failed to decode request object payload", log.Error(err))
+		return nil, invalidRequestObject("The request object is malformed")
+	}
+	return claims, nil
+}
+
+// mergeRequestParameters applies the request object claims over the plain request parameters. The
+// request object always takes precedence; for clients that require signed request objects only the
+// request object is used, so no parameter outside its signature can influence the request (RFC 9101 §5).
+// client_id and response_type sent outside the request object must match it.
+func mergeRequestParameters(params url.Values, claims map[string]interface{},
+	client *providers.OAuthClient) (url.Values, *model.ErrorResponse) {
+	if _, ok := claims[oauth2const.RequestParamRequest]; ok {
+		return nil, invalidRequestObject("The request object must not contain a request parameter")
+	}
+	if _, ok := claims[oauth2const.RequestParamRequestURI]; ok {
+		return nil, invalidRequestObject("The request object must not contain a request_uri parameter")
+	}
+	if clientID, ok := claims[oauth2const.RequestParamClientID]; ok && clientID != client.ClientID {
+		return nil, invalidRequestObject("The request object client_id does not match the request")
+	}
+	if responseType := params.Get(oauth2const.RequestParamResponseType); responseType != "" {
+		if claimed, ok := claims[oauth2const.RequestParamResponseType]; ok && claimed != responseType {
+			return nil, invalidRequestObject("The request object response_type does not match the request")
+		}
+	}
+
+	merged := url.Values{}
+	if client.RequireSignedRequestObject {
+		merged.Set(oauth2const.RequestParamClientID, client.ClientID)
+	} else {
+		for key, values := range params {
+			if key == oauth2const.RequestParamRequest || key == oauth2const.RequestParamRequestURI {
+				continue
+			}
+			merged[key] = values
+		}
+	}
+
+	for key, value := range claims {
+		if registeredClaims[key] {
+			continue
+		}
+		values, ok := claimValues(key, value)
+		if !ok {
+			return nil, invalidRequestObject("The request object parameter " + key + " has an invalid value")
+		}
+		merged[key] = values
+	}
+	return merged, nil
+}
+
+// claimValues converts a request object claim to its request parameter values. resource is the only
+// parameter that may repeat; JSON-valued parameters such as claims are carried in their JSON encoding.
+func claimValues(key string, value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, true
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}, true
+	case bool:
+		return []string{strconv.FormatBool(v)}, true
+	case []interface{}:
+		if key == oauth2const.RequestParamResource {
+			values := make([]string, 0, len(v))
+			for _, item := range v {
+				s, ok := item.(string)
+				if !ok {
+					return nil, false
+				}
+				values = append(values, s)
+			}
+			return values, true
+		}
+	case nil:
+		return nil, false
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+	return []string{string(encoded)}, true
+}
+
+// invalidRequestObject returns an invalid_request_object error with the given description.
+func invalidRequestObject(description string) *model.ErrorResponse {
+	return &model.ErrorResponse{
+		Error:            oauth2const.ErrorInvalidRequestObject,
+		ErrorDescription: description,
+	}
+}
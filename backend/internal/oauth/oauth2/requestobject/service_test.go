@@ -0,0 +1,309 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package requestobject
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwemock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/testhelpers"
+)
+
+const (
+	testClientID   = "client-1"
+	testIssuer     = "https://thunder.io"
+	testRequestURI = "https://client.example.com/request.jwt"
+	testJWKS       = `{"keys":[{"kty":"RSA","kid":"k1","n":"n","e":"AQAB"}]}`
+)
+
+type RequestObjectServiceTestSuite struct {
+	suite.Suite
+	jwtService *jwtmock.JWTServiceInterfaceMock
+	jweService *jwemock.JWEServiceInterfaceMock
+	httpClient *httpmock.HTTPClientInterfaceMock
+	service    *requestObjectService
+}
+
+func TestRequestObjectServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestObjectServiceTestSuite))
+}
+
+func (suite *RequestObjectServiceTestSuite) SetupTest() {
+	suite.jwtService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.jweService = jwemock.NewJWEServiceInterfaceMock(suite.T())
+	suite.httpClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newRequestObjectService(suite.jwtService, suite.jweService, jwksresolver.Initialize(nil),
+		suite.httpClient, testhelpers.OAuthConfig())
+}
+
+func (suite *RequestObjectServiceTestSuite) client(requireSigned bool) *providers.OAuthClient {
+	return &providers.OAuthClient{
+		ClientID:                   testClientID,
+		RequireSignedRequestObject: requireSigned,
+		Certificate:                &providers.Certificate{Type: providers.CertificateTypeJWKS, Value: testJWKS},
+	}
+}
+
+// compactJWT builds a compact JWS with the given header and claims and a placeholder signature.
+func compactJWT(header, claims map[string]interface{}, signature string) string {
+	h, _ := json.Marshal(header)
+	c, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c) + "." + signature
+}
+
+func signedRequestObject(claims map[string]interface{}) string {
+	return compactJWT(map[string]interface{}{"alg": "RS256", "kid": "k1"}, claims, "c2ln")
+}
+
+func (suite *RequestObjectServiceTestSuite) expectVerified(token string) {
+	suite.jwtService.EXPECT().VerifyJWTWithPublicKey(mock.Anything, token, mock.Anything, testIssuer, testClientID).
+		Return(nil)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_NoRequestObject() {
+	params := url.Values{oauth2const.RequestParamClientID: {testClientID}, oauth2const.RequestParamState: {"s"}}
+
+	resolved, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Nil(errResp)
+	suite.Equal(params, resolved)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_SignedRequestObjectRequired() {
+	params := url.Values{oauth2const.RequestParamClientID: {testClientID}}
+
+	resolved, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(true))
+
+	suite.Nil(resolved)
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequest, errResp.Error)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_RequestAndRequestURI() {
+	params := url.Values{
+		oauth2const.RequestParamRequest:    {"a.b.c"},
+		oauth2const.RequestParamRequestURI: {testRequestURI},
+	}
+
+	_, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequest, errResp.Error)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_SignedRequestObject_TakesPrecedence() {
+	token := signedRequestObject(map[string]interface{}{
+		"iss":                                testClientID,
+		"aud":                                testIssuer,
+		"exp":                                4102444800,
+		oauth2const.RequestParamClientID:     testClientID,
+		oauth2const.RequestParamResponseType: "code",
+		oauth2const.RequestParamState:        "signed-state",
+		oauth2const.RequestParamMaxAge:       300,
+		oauth2const.RequestParamResource:     []interface{}{"https://api.example.com"},
+		oauth2const.RequestParamClaims:       map[string]interface{}{"userinfo": map[string]interface{}{"email": nil}},
+	})
+	suite.expectVerified(token)
+	params := url.Values{
+		oauth2const.RequestParamClientID:     {testClientID},
+		oauth2const.RequestParamResponseType: {"code"},
+		oauth2const.RequestParamState:        {"plain-state"},
+		oauth2const.RequestParamNonce:        {"plain-nonce"},
+		oauth2const.RequestParamRequest:      {token},
+	}
+
+	resolved, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().Nil(errResp)
+	suite.Equal("signed-state", resolved.Get(oauth2const.RequestParamState))
+	suite.Equal("plain-nonce", resolved.Get(oauth2const.RequestParamNonce))
+	suite.Equal("300", resolved.Get(oauth2const.RequestParamMaxAge))
+	suite.Equal([]string{"https://api.example.com"}, resolved[oauth2const.RequestParamResource])
+	suite.JSONEq(`{"userinfo":{"email":null}}`, resolved.Get(oauth2const.RequestParamClaims))
+	suite.NotContains(resolved, oauth2const.RequestParamRequest)
+	suite.NotContains(resolved, "iss")
+	suite.NotContains(resolved, "exp")
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_RequireSigned_IgnoresPlainParameters() {
+	token := signedRequestObject(map[string]interface{}{
+		"iss":                                testClientID,
+		"aud":                                testIssuer,
+		"exp":                                4102444800,
+		oauth2const.RequestParamResponseType: "code",
+	})
+	suite.expectVerified(token)
+	params := url.Values{
+		oauth2const.RequestParamClientID:    {testClientID},
+		oauth2const.RequestParamRedirectURI: {"https://attacker.example.com"},
+		oauth2const.RequestParamRequest:     {token},
+	}
+
+	resolved, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(true))
+
+	suite.Require().Nil(errResp)
+	suite.Equal(testClientID, resolved.Get(oauth2const.RequestParamClientID))
+	suite.Equal("code", resolved.Get(oauth2const.RequestParamResponseType))
+	suite.NotContains(resolved, oauth2const.RequestParamRedirectURI)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_InvalidSignature() {
+	token := signedRequestObject(map[string]interface{}{"iss": testClientID})
+	suite.jwtService.EXPECT().VerifyJWTWithPublicKey(mock.Anything, token, mock.Anything, testIssuer, testClientID).
+		Return(&jwt.ErrorInvalidTokenSignature)
+	params := url.Values{oauth2const.RequestParamRequest: {token}}
+
+	_, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errResp.Error)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_UnknownKey() {
+	token := compactJWT(map[string]interface{}{"alg": "RS256", "kid": "other"}, map[string]interface{}{}, "c2ln")
+	params := url.Values{oauth2const.RequestParamRequest: {token}}
+
+	_, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errResp.Error)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_UnsignedRequestObject() {
+	token := compactJWT(map[string]interface{}{"alg": "none"},
+		map[string]interface{}{oauth2const.RequestParamState: "unsigned-state"}, "")
+	params := url.Values{oauth2const.RequestParamClientID: {testClientID}, oauth2const.RequestParamRequest: {token}}
+
+	resolved, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().Nil(errResp)
+	suite.Equal("unsigned-state", resolved.Get(oauth2const.RequestParamState))
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_UnsignedRequestObject_RequireSigned() {
+	token := compactJWT(map[string]interface{}{"alg": "none"}, map[string]interface{}{}, "")
+	params := url.Values{oauth2const.RequestParamRequest: {token}}
+
+	_, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(true))
+
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errResp.Error)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_FAPI2_DisallowedAlg() {
+	token := signedRequestObject(map[string]interface{}{"iss": testClientID})
+	params := url.Values{oauth2const.RequestParamRequest: {token}}
+	client := suite.client(false)
+	client.SecurityProfile = providers.SecurityProfileFAPI2
+
+	_, errResp := suite.service.ResolveRequestParameters(context.Background(), params, client)
+
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errResp.Error)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_EncryptedRequestObject() {
+	token := signedRequestObject(map[string]interface{}{oauth2const.RequestParamState: "encrypted-state"})
+	encrypted := "h.k.iv.ct.tag"
+	suite.jweService.EXPECT().Decrypt(mock.Anything, encrypted).Return([]byte(token), nil)
+	suite.expectVerified(token)
+	params := url.Values{oauth2const.RequestParamRequest: {encrypted}}
+
+	resolved, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(true))
+
+	suite.Require().Nil(errResp)
+	suite.Equal("encrypted-state", resolved.Get(oauth2const.RequestParamState))
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_ClientIDMismatch() {
+	token := signedRequestObject(map[string]interface{}{oauth2const.RequestParamClientID: "client-2"})
+	suite.expectVerified(token)
+	params := url.Values{oauth2const.RequestParamRequest: {token}}
+
+	_, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errResp.Error)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_ResponseTypeMismatch() {
+	token := signedRequestObject(map[string]interface{}{oauth2const.RequestParamResponseType: "code id_token"})
+	suite.expectVerified(token)
+	params := url.Values{
+		oauth2const.RequestParamResponseType: {"code"},
+		oauth2const.RequestParamRequest:      {token},
+	}
+
+	_, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errResp.Error)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_NestedRequestURI() {
+	token := signedRequestObject(map[string]interface{}{oauth2const.RequestParamRequestURI: testRequestURI})
+	suite.expectVerified(token)
+	params := url.Values{oauth2const.RequestParamRequest: {token}}
+
+	_, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequestObject, errResp.Error)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_RequestURI() {
+	token := signedRequestObject(map[string]interface{}{oauth2const.RequestParamState: "hosted-state"})
+	suite.httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == testRequestURI && req.Header.Get("Accept") == requestObjectContentType
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(token + "\n")),
+	}, nil)
+	suite.expectVerified(token)
+	params := url.Values{oauth2const.RequestParamRequestURI: {testRequestURI}}
+
+	resolved, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().Nil(errResp)
+	suite.Equal("hosted-state", resolved.Get(oauth2const.RequestParamState))
+	suite.NotContains(resolved, oauth2const.RequestParamRequestURI)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_RequestURINotHTTPS() {
+	params := url.Values{oauth2const.RequestParamRequestURI: {"http://client.example.com/request.jwt"}}
+
+	_, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequestURI, errResp.Error)
+}
+
+func (suite *RequestObjectServiceTestSuite) TestResolve_RequestURINon200() {
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(&http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}, nil)
+	params := url.Values{oauth2const.RequestParamRequestURI: {testRequestURI}}
+
+	_, errResp := suite.service.ResolveRequestParameters(context.Background(), params, suite.client(false))
+
+	suite.Require().NotNil(errResp)
+	suite.Equal(oauth2const.ErrorInvalidRequestURI, errResp.Error)
+}
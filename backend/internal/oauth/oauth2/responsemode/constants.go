@@ -0,0 +1,20 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package responsemode
+
+const (
+	// formPostPath is the endpoint that renders a stored form_post response to the browser.
+	formPostPath = "/oauth2/authorize/response"
+	// paramResponseID is the query parameter carrying the identifier of a stored form_post response.
+	paramResponseID = "id"
+
+	// formPostResponseValidity bounds how long a form_post response is kept, in seconds. It only has
+	// to outlive the browser's navigation to the form_post endpoint.
+	formPostResponseValidity int64 = 120
+	// responseJWTValidity is the lifetime of a JWT secured authorization response, in seconds. JARM
+	// recommends a short lifetime, as the response is consumed as soon as it reaches the client.
+	responseJWTValidity int64 = 600
+	// responseJWTContentType is the cty of an encrypted authorization response, which nests a JWT.
+	responseJWTContentType = "JWT"
+)
@@ -0,0 +1,71 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package responsemode
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// responseModeHandler serves the form_post endpoint.
+type responseModeHandler struct {
+	cfg     oauthconfig.Config
+	service ResponseModeServiceInterface
+	logger  *log.Logger
+}
+
+// newResponseModeHandler creates a new response mode handler.
+func newResponseModeHandler(service ResponseModeServiceInterface, cfg oauthconfig.Config) *responseModeHandler {
+	return &responseModeHandler{
+		cfg:     cfg,
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ResponseModeHandler")),
+	}
+}
+
+// HandleFormPostRequest renders the page that submits a stored form_post response to the client.
+func (h *responseModeHandler) HandleFormPostRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.service.TakeFormPostResponse(ctx, r.URL.Query().Get(paramResponseID))
+	if err != nil {
+		if errors.Is(err, ErrFormPostResponseNotFound) {
+			h.redirectToErrorPage(w, r, oauth2const.ErrorInvalidRequest, "Invalid or expired authorization response")
+			return
+		}
+		h.logger.Error(ctx, "Failed to load form_post response", log.Error(err))
+		h.redirectToErrorPage(w, r, oauth2const.ErrorServerError, "Failed to process authorization request")
+		return
+	}
+	if err := writeFormPostPage(w, resp); err != nil {
+		h.logger.Error(ctx, "Failed to render form_post response page", log.Error(err))
+	}
+}
+
+// redirectToErrorPage redirects the browser to the Gate error page.
+func (h *responseModeHandler) redirectToErrorPage(w http.ResponseWriter, r *http.Request, code, msg string) {
+	errorPageURL := (&url.URL{
+		Scheme: h.cfg.GateClient.Scheme,
+		Host:   fmt.Sprintf("%s:%d", h.cfg.GateClient.Hostname, h.cfg.GateClient.Port),
+		Path:   h.cfg.GateClient.ErrorPath,
+	}).String()
+
+	redirectURL, err := oauth2utils.GetURIWithQueryParams(errorPageURL, map[string]string{
+		"errorCode":    code,
+		"errorMessage": msg,
+	})
+	if err != nil {
+		h.logger.Error(r.Context(), "Failed to construct error page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
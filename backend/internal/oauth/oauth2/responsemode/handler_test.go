@@ -0,0 +1,100 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package responsemode
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/runtimestoreprovidermock"
+	"github.com/thunder-id/thunderid/tests/testhelpers"
+)
+
+type ResponseModeHandlerTestSuite struct {
+	suite.Suite
+	runtimeStore *runtimestoreprovidermock.RuntimeStoreProviderMock
+	handler      *responseModeHandler
+}
+
+func TestResponseModeHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ResponseModeHandlerTestSuite))
+}
+
+func (suite *ResponseModeHandlerTestSuite) SetupTest() {
+	suite.runtimeStore = runtimestoreprovidermock.NewRuntimeStoreProviderMock(suite.T())
+	cfg := testhelpers.OAuthConfig()
+	cfg.GateClient = engineconfig.GateClientConfig{
+		Scheme:    "https",
+		Hostname:  "gate.example.com",
+		Port:      443,
+		ErrorPath: "/error",
+	}
+	suite.handler = newResponseModeHandler(newResponseModeService(nil, nil, nil, nil, suite.runtimeStore, cfg), cfg)
+}
+
+func (suite *ResponseModeHandlerTestSuite) serve(id string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, formPostPath+"?"+paramResponseID+"="+id, nil)
+	w := httptest.NewRecorder()
+	suite.handler.HandleFormPostRequest(w, req)
+	return w
+}
+
+func (suite *ResponseModeHandlerTestSuite) TestHandleFormPostRequest_RendersForm() {
+	data, err := json.Marshal(FormPostResponse{
+		RedirectURI: "https://client.example.com/callback?tenant=a",
+		Params:      map[string]string{"code": "code-1", oauth2const.RequestParamState: "<state>"},
+	})
+	suite.Require().NoError(err)
+	suite.runtimeStore.EXPECT().Take(mock.Anything, providers.NamespaceAuthzResp, "resp-1").Return(data, nil)
+
+	w := suite.serve("resp-1")
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal(sysconst.ContentTypeHTML, w.Header().Get(sysconst.ContentTypeHeaderName))
+	suite.Equal(sysconst.CacheControlNoStore, w.Header().Get(sysconst.CacheControlHeaderName))
+	csp := w.Header().Get(sysconst.ContentSecurityPolicyHeaderName)
+	suite.Contains(csp, "form-action https://client.example.com;")
+	suite.Contains(csp, "frame-ancestors 'none'")
+
+	body := w.Body.String()
+	suite.Contains(body, `action="https://client.example.com/callback?tenant=a"`)
+	suite.Contains(body, `name="code" value="code-1"`)
+	suite.Contains(body, `name="state" value="&lt;state&gt;"`)
+	suite.NotContains(body, "<state>")
+
+	nonce := strings.TrimPrefix(strings.Split(csp, "'")[3], "nonce-")
+	suite.Contains(body, `<script nonce="`+nonce+`">`)
+}
+
+func (suite *ResponseModeHandlerTestSuite) TestHandleFormPostRequest_NotFound() {
+	suite.runtimeStore.EXPECT().Take(mock.Anything, providers.NamespaceAuthzResp, "resp-1").Return(nil, nil)
+
+	w := suite.serve("resp-1")
+
+	suite.Equal(http.StatusFound, w.Code)
+	location := w.Header().Get("Location")
+	suite.True(strings.HasPrefix(location, "https://gate.example.com:443/error?"))
+	suite.Contains(location, "errorCode="+oauth2const.ErrorInvalidRequest)
+}
+
+func (suite *ResponseModeHandlerTestSuite) TestHandleFormPostRequest_StoreError() {
+	suite.runtimeStore.EXPECT().Take(mock.Anything, providers.NamespaceAuthzResp, "resp-1").
+		Return(nil, errors.New("store down"))
+
+	w := suite.serve("resp-1")
+
+	suite.Equal(http.StatusFound, w.Code)
+	suite.Contains(w.Header().Get("Location"), "errorCode="+oauth2const.ErrorServerError)
+}
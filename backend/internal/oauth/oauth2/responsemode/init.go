@@ -0,0 +1,37 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package responsemode
+
+import (
+	"net/http"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize wires the response mode service and registers the form_post endpoint.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	jweService jwe.JWEServiceInterface,
+	resolver *jwksresolver.Resolver,
+	actorProvider providers.ActorProvider,
+	runtimeStore providers.RuntimeStoreProvider,
+	cfg oauthconfig.Config,
+) ResponseModeServiceInterface {
+	svc := newResponseModeService(jwtService, jweService, resolver, actorProvider, runtimeStore, cfg)
+	registerRoutes(mux, newResponseModeHandler(svc, cfg))
+	return svc
+}
+
+// registerRoutes registers the form_post endpoint. It is a top-level browser navigation and needs no
+// CORS handling.
+func registerRoutes(mux *http.ServeMux, h *responseModeHandler) {
+	mux.HandleFunc("GET "+formPostPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleFormPostRequest)).ServeHTTP)
+}
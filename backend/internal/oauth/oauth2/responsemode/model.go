@@ -0,0 +1,21 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package responsemode
+
+// AuthorizationResponse is an authorization response, success or error, to be delivered to a client's
+// redirect URI in the response mode the client requested.
+type AuthorizationResponse struct {
+	ClientID     string
+	RedirectURI  string
+	ResponseType string
+	// ResponseMode is the response_mode request parameter; empty selects the response type's default.
+	ResponseMode string
+	Params       map[string]string
+}
+
+// FormPostResponse is a form_post response held until the browser fetches the page that submits it.
+type FormPostResponse struct {
+	RedirectURI string            `json:"redirectUri"`
+	Params      map[string]string `json:"params"`
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package responsemode
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+)
+
+// formPostTemplate renders the OAuth 2.0 Form Post Response Mode page, which carries the response
+// parameters to the client's redirect URI as hidden form fields. A nonce-bound script submits it
+// automatically; the button covers browsers with scripts disabled.
+var formPostTemplate = template.Must(template.New("formpost").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Submit This Form</title>
+</head>
+<body>
+<form id="authorization-response" method="post" action="{{.RedirectURI}}">
+{{- range $name, $value := .Params}}
+<input type="hidden" name="{{$name}}" value="{{$value}}">
+{{- end}}
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+<script nonce="{{.Nonce}}">document.getElementById("authorization-response").submit();</script>
+</body>
+</html>
+`))
+
+// formPostPage holds the data rendered into the form_post template.
+type formPostPage struct {
+	FormPostResponse
+	Nonce string
+}
+
+// writeFormPostPage renders the form_post page. The page sets its own content security policy, which
+// allows only its nonce-bound script and only form submission to the client's redirect URI origin.
+func writeFormPostPage(w http.ResponseWriter, resp *FormPostResponse) error {
+	redirectURI, err := url.Parse(resp.RedirectURI)
+	if err != nil {
+		return fmt.Errorf("invalid redirect URI: %w", err)
+	}
+	nonceBytes := make([]byte, 16)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return fmt.Errorf("failed to generate script nonce: %w", err)
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(nonceBytes)
+
+	w.Header().Set(sysconst.ContentTypeHeaderName, sysconst.ContentTypeHTML)
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoStore)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+	w.Header().Set(sysconst.ContentSecurityPolicyHeaderName, fmt.Sprintf(
+		"default-src 'none'; script-src 'nonce-%s'; form-action %s://%s; base-uri 'none'; frame-ancestors 'none'",
+		nonce, redirectURI.Scheme, redirectURI.Host))
+	w.WriteHeader(http.StatusOK)
+	return formPostTemplate.Execute(w, formPostPage{FormPostResponse: *resp, Nonce: nonce})
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package responsemode delivers authorization responses to clients in the requested response mode:
+// query and fragment encoding, form_post (OAuth 2.0 Form Post Response Mode), and the JWT Secured
+// Authorization Response Mode (JARM) variants query.jwt, fragment.jwt, form_post.jwt and jwt.
+package responsemode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// ErrFormPostResponseNotFound is returned when a form_post response is unknown, expired or already taken.
+var ErrFormPostResponseNotFound = errors.New("form_post response not found")
+
+// ResponseModeServiceInterface builds the URIs authorization responses are delivered through.
+type ResponseModeServiceInterface interface {
+	// BuildResponseURI returns the URI the browser is sent to in order to deliver the response. For
+	// the form_post modes that is the form_post endpoint, which submits the stored response on load.
+	BuildResponseURI(ctx context.Context, resp *AuthorizationResponse) (string, error)
+	// TakeFormPostResponse returns and forgets a stored form_post response.
+	TakeFormPostResponse(ctx context.Context, id string) (*FormPostResponse, error)
+}
+
+// responseModeService is the default implementation of ResponseModeServiceInterface.
+type responseModeService struct {
+	cfg           oauthconfig.Config
+	jwtService    jwt.JWTServiceInterface
+	jweService    jwe.JWEServiceInterface
+	jwksResolver  *jwksresolver.Resolver
+	actorProvider providers.ActorProvider
+	runtimeStore  providers.RuntimeStoreProvider
+	logger        *log.Logger
+}
+
+// newResponseModeService creates a new response mode service.
+func newResponseModeService(
+	jwtService jwt.JWTServiceInterface,
+	jweService jwe.JWEServiceInterface,
+	resolver *jwksresolver.Resolver,
+	actorProvider providers.ActorProvider,
+	runtimeStore providers.RuntimeStoreProvider,
+	cfg oauthconfig.Config,
+) *responseModeService {
+	return &responseModeService{
+		cfg:           cfg,
+		jwtService:    jwtService,
+		jweService:    jweService,
+		jwksResolver:  resolver,
+		actorProvider: actorProvider,
+		runtimeStore:  runtimeStore,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ResponseModeService")),
+	}
+}
+
+// ResolveResponseMode returns the effective response mode for a request. An empty mode selects the
+// response type's default (query for code, fragment otherwise) and jwt selects the JARM variant of it.
+func ResolveResponseMode(responseMode, responseType string) string {
+	queryDefault := responseType == "" || responseType == string(providers.ResponseTypeCode)
+	switch responseMode {
+	case "":
+		if queryDefault {
+			return oauth2const.ResponseModeQuery
+		}
+		return oauth2const.ResponseModeFragment
+	case oauth2const.ResponseModeJWT:
+		if queryDefault {
+			return oauth2const.ResponseModeQueryJWT
+		}
+		return oauth2const.ResponseModeFragmentJWT
+	default:
+		return responseMode
+	}
+}
+
+// BuildResponseURI implements ResponseModeServiceInterface.
+func (s *responseModeService) BuildResponseURI(ctx context.Context, resp *AuthorizationResponse) (string, error) {
+	mode := ResolveResponseMode(resp.ResponseMode, resp.ResponseType)
+	params := resp.Params
+	if strings.HasSuffix(mode, ".jwt") {
+		// The error parameters are validated as for a plain response before they are sealed in the JWT.
+		if err := oauth2utils.ValidateResponseErrorParams(params); err != nil {
+			return "", err
+		}
+		responseJWT, err := s.buildResponseJWT(ctx, resp.ClientID, params)
+		if err != nil {
+			return "", err
+		}
+		params = map[string]string{oauth2const.RequestParamResponse: responseJWT}
+		mode = strings.TrimSuffix(mode, ".jwt")
+	}
+
+	switch mode {
+	case oauth2const.ResponseModeFragment:
+		return oauth2utils.GetURIWithFragmentParams(resp.RedirectURI, params)
+	case oauth2const.ResponseModeFormPost:
+		return s.storeFormPostResponse(ctx, resp.RedirectURI, params)
+	default:
+		return oauth2utils.GetURIWithQueryParams(resp.RedirectURI, params)
+	}
+}
+
+// TakeFormPostResponse implements ResponseModeServiceInterface.
+func (s *responseModeService) TakeFormPostResponse(ctx context.Context, id string) (*FormPostResponse, error) {
+	if id == "" {
+		return nil, ErrFormPostResponseNotFound
+	}
+	data, err := s.runtimeStore.Take(ctx, providers.NamespaceAuthzResp, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take form_post response: %w", err)
+	}
+	if data == nil {
+		return nil, ErrFormPostResponseNotFound
+	}
+	var resp FormPostResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal form_post response: %w", err)
+	}
+	return &resp, nil
+}
+
+// storeFormPostResponse stores a form_post response and returns the form_post endpoint URI that
+// renders it. The response parameters never appear in a URL the browser navigates to.
+func (s *responseModeService) storeFormPostResponse(ctx context.Context, redirectURI string,
+	params map[string]string) (string, error) {
+	if err := oauth2utils.ValidateResponseErrorParams(params); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(FormPostResponse{RedirectURI: redirectURI, Params: params})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal form_post response: %w", err)
+	}
+	id := utils.GenerateUUID()
+	if err := s.runtimeStore.Put(ctx, providers.NamespaceAuthzResp, id, data,
+		formPostResponseValidity); err != nil {
+		return "", fmt.Errorf("failed to store form_post response: %w", err)
+	}
+	return utils.GetURIWithQueryParams(strings.TrimRight(s.cfg.BaseURL, "/")+formPostPath,
+		map[string]string{paramResponseID: id})
+}
+
+// buildResponseJWT seals the response parameters in a JWT signed with the client's configured
+// algorithm and, when the client registered an encryption algorithm, nests it in a JWE.
+func (s *responseModeService) buildResponseJWT(ctx context.Context, clientID string,
+	params map[string]string) (string, error) {
+	if s.actorProvider == nil {
+		return "", errors.New("actor provider not configured for JWT secured authorization responses")
+	}
+	client, svcErr := s.actorProvider.GetOAuthClientByClientID(ctx, clientID)
+	if svcErr != nil || client == nil {
+		return "", fmt.Errorf("failed to resolve client %q for JWT secured authorization response", clientID)
+	}
+	cfg := client.AuthorizationResponse
+	if cfg == nil {
+		cfg = &providers.AuthorizationResponseConfig{}
+	}
+
+	// iss is a registered claim set from the issuer; the remaining parameters are carried as-is.
+	claims := make(map[string]interface{}, len(params)+1)
+	for key, value := range params {
+		if key == oauth2const.RequestParamIss {
+			continue
+		}
+		claims[key] = value
+	}
+	claims["aud"] = clientID
+
+	signedJWT, _, svcErr := s.jwtService.GenerateJWT(ctx, "", s.cfg.JWT.Issuer, responseJWTValidity, claims,
+		jwt.TokenTypeJWT, cfg.SigningAlg)
+	if svcErr != nil {
+		return "", fmt.Errorf("failed to sign authorization response: %s", svcErr.Error.DefaultValue)
+	}
+	if cfg.EncryptionAlg == "" {
+		return signedJWT, nil
+	}
+
+	rpKey, rpKID, svcErr := s.jwksResolver.ResolveEncryptionKey(
+		ctx, client.Certificate, cfg.EncryptionAlg, jwksresolver.KeyUseStrictEnc)
+	if svcErr != nil {
+		return "", fmt.Errorf("failed to resolve authorization response encryption key: %s",
+			svcErr.Error.DefaultValue)
+	}
+	compact, svcErr := s.jweService.Encrypt(ctx,
+		[]byte(signedJWT),
+		&providers.KeyRef{PublicKeyJWK: rpKey},
+		cfg.EncryptionAlg,
+		jwe.ContentEncAlgorithm(cfg.EncryptionEnc),
+		responseJWTContentType,
+		rpKID,
+	)
+	if svcErr != nil {
+		return "", fmt.Errorf("failed to encrypt authorization response: %s", svcErr.Error.DefaultValue)
+	}
+	return compact, nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package responsemode
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	certmodel "github.com/thunder-id/thunderid/internal/cert"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwemock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/runtimestoreprovidermock"
+	"github.com/thunder-id/thunderid/tests/testhelpers"
+)
+
+const (
+	testClientID    = "client-1"
+	testRedirectURI = "https://client.example.com/callback"
+	testResponseJWT = "header.payload.signature"
+)
+
+type ResponseModeServiceTestSuite struct {
+	suite.Suite
+	jwtService    *jwtmock.JWTServiceInterfaceMock
+	jweService    *jwemock.JWEServiceInterfaceMock
+	actorProvider *actorprovidermock.ActorProviderMock
+	runtimeStore  *runtimestoreprovidermock.RuntimeStoreProviderMock
+	service       *responseModeService
+}
+
+func TestResponseModeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ResponseModeServiceTestSuite))
+}
+
+func (suite *ResponseModeServiceTestSuite) SetupTest() {
+	suite.jwtService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.jweService = jwemock.NewJWEServiceInterfaceMock(suite.T())
+	suite.actorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.runtimeStore = runtimestoreprovidermock.NewRuntimeStoreProviderMock(suite.T())
+	suite.service = newResponseModeService(suite.jwtService, suite.jweService, jwksresolver.Initialize(nil),
+		suite.actorProvider, suite.runtimeStore, testhelpers.OAuthConfig())
+}
+
+// codeResponse returns a successful authorization code response in the given response mode.
+func codeResponse(responseMode string) *AuthorizationResponse {
+	return &AuthorizationResponse{
+		ClientID:     testClientID,
+		RedirectURI:  testRedirectURI,
+		ResponseType: string(providers.ResponseTypeCode),
+		ResponseMode: responseMode,
+		Params: map[string]string{
+			"code":                        "code-1",
+			oauth2const.RequestParamState: "state-1",
+			oauth2const.RequestParamIss:   "https://thunder.io",
+		},
+	}
+}
+
+func (suite *ResponseModeServiceTestSuite) TestResolveResponseMode() {
+	testCases := []struct {
+		mode, responseType, expected string
+	}{
+		{"", "code", oauth2const.ResponseModeQuery},
+		{"", "", oauth2const.ResponseModeQuery},
+		{"", "code id_token", oauth2const.ResponseModeFragment},
+		{oauth2const.ResponseModeJWT, "code", oauth2const.ResponseModeQueryJWT},
+		{oauth2const.ResponseModeJWT, "code id_token", oauth2const.ResponseModeFragmentJWT},
+		{oauth2const.ResponseModeFormPost, "code", oauth2const.ResponseModeFormPost},
+		{oauth2const.ResponseModeFragmentJWT, "code", oauth2const.ResponseModeFragmentJWT},
+	}
+	for _, tc := range testCases {
+		suite.Equal(tc.expected, ResolveResponseMode(tc.mode, tc.responseType), "%q/%q", tc.mode, tc.responseType)
+	}
+}
+
+func (suite *ResponseModeServiceTestSuite) TestBuildResponseURI_Query() {
+	uri, err := suite.service.BuildResponseURI(context.Background(), codeResponse(""))
+	suite.Require().NoError(err)
+
+	parsed, err := url.Parse(uri)
+	suite.Require().NoError(err)
+	suite.Equal("client.example.com", parsed.Host)
+	suite.Equal("code-1", parsed.Query().Get("code"))
+	suite.Equal("state-1", parsed.Query().Get(oauth2const.RequestParamState))
+	suite.Empty(parsed.Fragment)
+}
+
+func (suite *ResponseModeServiceTestSuite) TestBuildResponseURI_Fragment() {
+	uri, err := suite.service.BuildResponseURI(context.Background(), codeResponse(oauth2const.ResponseModeFragment))
+	suite.Require().NoError(err)
+
+	parsed, err := url.Parse(uri)
+	suite.Require().NoError(err)
+	suite.Empty(parsed.RawQuery)
+	fragment, err := url.ParseQuery(parsed.Fragment)
+	suite.Require().NoError(err)
+	suite.Equal("code-1", fragment.Get("code"))
+	suite.Equal("state-1", fragment.Get(oauth2const.RequestParamState))
+}
+
+func (suite *ResponseModeServiceTestSuite) TestBuildResponseURI_FormPost() {
+	var stored FormPostResponse
+	var storedID string
+	suite.runtimeStore.EXPECT().Put(mock.Anything, providers.NamespaceAuthzResp, mock.Anything, mock.Anything,
+		formPostResponseValidity).Run(
+		func(_ context.Context, _ providers.RuntimeStoreNamespace, key string, value []byte, _ int64) {
+			storedID = key
+			suite.Require().NoError(json.Unmarshal(value, &stored))
+		}).Return(nil)
+
+	uri, err := suite.service.BuildResponseURI(context.Background(), codeResponse(oauth2const.ResponseModeFormPost))
+	suite.Require().NoError(err)
+
+	parsed, err := url.Parse(uri)
+	suite.Require().NoError(err)
+	suite.Equal("thunder.io", parsed.Host)
+	suite.Equal(formPostPath, parsed.Path)
+	suite.Equal(storedID, parsed.Query().Get(paramResponseID))
+	suite.NotContains(uri, "code-1")
+	suite.Equal(testRedirectURI, stored.RedirectURI)
+	suite.Equal("code-1", stored.Params["code"])
+}
+
+func (suite *ResponseModeServiceTestSuite) TestBuildResponseURI_FormPost_StoreError() {
+	suite.runtimeStore.EXPECT().Put(mock.Anything, providers.NamespaceAuthzResp, mock.Anything, mock.Anything,
+		formPostResponseValidity).Return(errors.New("store down"))
+
+	_, err := suite.service.BuildResponseURI(context.Background(), codeResponse(oauth2const.ResponseModeFormPost))
+	suite.Error(err)
+}
+
+func (suite *ResponseModeServiceTestSuite) TestBuildResponseURI_InvalidErrorDescription() {
+	resp := codeResponse(oauth2const.ResponseModeFormPost)
+	resp.Params = map[string]string{
+		oauth2const.RequestParamError:            oauth2const.ErrorAccessDenied,
+		oauth2const.RequestParamErrorDescription: "bad \"quote\"",
+	}
+
+	_, err := suite.service.BuildResponseURI(context.Background(), resp)
+	suite.Error(err)
+}
+
+func (suite *ResponseModeServiceTestSuite) TestBuildResponseURI_QueryJWT() {
+	suite.actorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(
+		&providers.OAuthClient{ClientID: testClientID}, nil)
+	suite.jwtService.EXPECT().GenerateJWT(mock.Anything, "", "https://thunder.io", responseJWTValidity,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			_, hasIss := claims[oauth2const.RequestParamIss]
+			return claims["aud"] == testClientID && claims["code"] == "code-1" &&
+				claims[oauth2const.RequestParamState] == "state-1" && !hasIss
+		}), jwt.TokenTypeJWT, "").Return(testResponseJWT, int64(0), nil)
+
+	uri, err := suite.service.BuildResponseURI(context.Background(), codeResponse(oauth2const.ResponseModeJWT))
+	suite.Require().NoError(err)
+
+	parsed, err := url.Parse(uri)
+	suite.Require().NoError(err)
+	suite.Equal(testResponseJWT, parsed.Query().Get(oauth2const.RequestParamResponse))
+	suite.Empty(parsed.Query().Get("code"))
+}
+
+func (suite *ResponseModeServiceTestSuite) TestBuildResponseURI_FragmentJWT_UsesClientSigningAlg() {
+	suite.actorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(
+		&providers.OAuthClient{
+			ClientID:              testClientID,
+			AuthorizationResponse: &providers.AuthorizationResponseConfig{SigningAlg: "PS256"},
+		}, nil)
+	suite.jwtService.EXPECT().GenerateJWT(mock.Anything, "", "https://thunder.io", responseJWTValidity,
+		mock.Anything, jwt.TokenTypeJWT, "PS256").Return(testResponseJWT, int64(0), nil)
+
+	uri, err := suite.service.BuildResponseURI(context.Background(),
+		codeResponse(oauth2const.ResponseModeFragmentJWT))
+	suite.Require().NoError(err)
+	suite.Equal(testRedirectURI+"#response="+testResponseJWT, uri)
+}
+
+func (suite *ResponseModeServiceTestSuite) TestBuildResponseURI_FormPostJWT_Encrypted() {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	suite.actorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(
+		&providers.OAuthClient{
+			ClientID: testClientID,
+			AuthorizationResponse: &providers.AuthorizationResponseConfig{
+				EncryptionAlg: "RSA-OAEP-256",
+				EncryptionEnc: "A256GCM",
+			},
+			Certificate: &providers.Certificate{
+				Type:  certmodel.CertificateTypeJWKS,
+				Value: rsaPublicKeyToJWKS(&privateKey.PublicKey),
+			},
+		}, nil)
+	suite.jwtService.EXPECT().GenerateJWT(mock.Anything, "", "https://thunder.io", responseJWTValidity,
+		mock.Anything, jwt.TokenTypeJWT, "").Return(testResponseJWT, int64(0), nil)
+	suite.jweService.EXPECT().Encrypt(mock.Anything, []byte(testResponseJWT), mock.Anything, "RSA-OAEP-256",
+		jwe.ContentEncAlgorithm("A256GCM"), responseJWTContentType, "").Return("compact.jwe", nil)
+	var stored FormPostResponse
+	suite.runtimeStore.EXPECT().Put(mock.Anything, providers.NamespaceAuthzResp, mock.Anything, mock.Anything,
+		formPostResponseValidity).Run(
+		func(_ context.Context, _ providers.RuntimeStoreNamespace, _ string, value []byte, _ int64) {
+			suite.Require().NoError(json.Unmarshal(value, &stored))
+		}).Return(nil)
+
+	_, err = suite.service.BuildResponseURI(context.Background(),
+		codeResponse(oauth2const.ResponseModeFormPostJWT))
+	suite.Require().NoError(err)
+	suite.Equal(map[string]string{oauth2const.RequestParamResponse: "compact.jwe"}, stored.Params)
+}
+
+func (suite *ResponseModeServiceTestSuite) TestBuildResponseURI_JWT_ClientNotFound() {
+	suite.actorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(
+		nil, &tidcommon.ServiceError{Type: tidcommon.ClientErrorType})
+
+	_, err := suite.service.BuildResponseURI(context.Background(), codeResponse(oauth2const.ResponseModeQueryJWT))
+	suite.Error(err)
+}
+
+func (suite *ResponseModeServiceTestSuite) TestBuildResponseURI_JWT_SigningError() {
+	suite.actorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(
+		&providers.OAuthClient{ClientID: testClientID}, nil)
+	suite.jwtService.EXPECT().GenerateJWT(mock.Anything, "", "https://thunder.io", responseJWTValidity,
+		mock.Anything, jwt.TokenTypeJWT, "").Return("", int64(0), &tidcommon.InternalServerError)
+
+	_, err := suite.service.BuildResponseURI(context.Background(), codeResponse(oauth2const.ResponseModeQueryJWT))
+	suite.Error(err)
+}
+
+func (suite *ResponseModeServiceTestSuite) TestTakeFormPostResponse() {
+	data, err := json.Marshal(FormPostResponse{RedirectURI: testRedirectURI, Params: map[string]string{"code": "c"}})
+	suite.Require().NoError(err)
+	suite.runtimeStore.EXPECT().Take(mock.Anything, providers.NamespaceAuthzResp, "resp-1").Return(data, nil)
+
+	resp, err := suite.service.TakeFormPostResponse(context.Background(), "resp-1")
+	suite.Require().NoError(err)
+	suite.Equal(testRedirectURI, resp.RedirectURI)
+	suite.Equal("c", resp.Params["code"])
+}
+
+func (suite *ResponseModeServiceTestSuite) TestTakeFormPostResponse_NotFound() {
+	suite.runtimeStore.EXPECT().Take(mock.Anything, providers.NamespaceAuthzResp, "resp-1").Return(nil, nil)
+
+	_, err := suite.service.TakeFormPostResponse(context.Background(), "resp-1")
+	suite.ErrorIs(err, ErrFormPostResponseNotFound)
+
+	_, err = suite.service.TakeFormPostResponse(context.Background(), "")
+	suite.ErrorIs(err, ErrFormPostResponseNotFound)
+}
+
+func (suite *ResponseModeServiceTestSuite) TestTakeFormPostResponse_StoreError() {
+	suite.runtimeStore.EXPECT().Take(mock.Anything, providers.NamespaceAuthzResp, "resp-1").
+		Return(nil, errors.New("store down"))
+
+	_, err := suite.service.TakeFormPostResponse(context.Background(), "resp-1")
+	suite.Error(err)
+	suite.NotErrorIs(err, ErrFormPostResponseNotFound)
+}
+
+// rsaPublicKeyToJWKS serializes an RSA public key as a single-key encryption JWKS.
+func rsaPublicKeyToJWKS(pub *rsa.PublicKey) string {
+	key := map[string]interface{}{
+		"kty": "RSA",
+		"use": "enc",
+		"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}
+	b, _ := json.Marshal(map[string]interface{}{"keys": []interface{}{key}})
+	return string(b)
+}
@@ -20,16 +20,19 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
-// Initialize constructs the shared revocation read and write services.
+// Initialize constructs the shared revocation read and write services. criteriaNotifier, which may be
+// nil, is told about every criteria-based revocation once it is persisted.
 func Initialize(
 	jwtService jwt.JWTServiceInterface,
 	observabilitySvc providers.ObservabilityProvider,
 	tokenFamilyRevocationTTL time.Duration,
 	revokeTokenFamilyOnExplicit bool,
+	criteriaNotifier CriteriaRevocationNotifier,
 ) (EnforcementServiceInterface, RevocationServiceInterface) {
 	store := newRevocationStore()
 	return newEnforcementService(observabilitySvc, store), newRevocationService(
-		jwtService, store, tokenFamilyRevocationTTL, revokeTokenFamilyOnExplicit, observabilitySvc)
+		jwtService, store, tokenFamilyRevocationTTL, revokeTokenFamilyOnExplicit, observabilitySvc,
+		criteriaNotifier)
 }
 
 // RegisterRoutes registers the RFC 7009 revocation endpoint using the shared revocation service.
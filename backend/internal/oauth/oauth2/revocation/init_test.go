@@ -50,7 +50,7 @@ func (suite *InitTestSuite) TearDownTest() {
 
 func (suite *InitTestSuite) TestInitialize() {
 	enforcementService, revocationService := Initialize(
-		suite.mockJWTService, nil, time.Hour, true)
+		suite.mockJWTService, nil, time.Hour, true, nil)
 
 	assert.NotNil(suite.T(), enforcementService)
 	assert.Implements(suite.T(), (*EnforcementServiceInterface)(nil), enforcementService)
@@ -66,7 +66,7 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 			RevocationEndpoint: "https://localhost:8090/oauth2/revoke",
 		})
 	mux := http.NewServeMux()
-	_, revocationService := Initialize(suite.mockJWTService, nil, time.Hour, true)
+	_, revocationService := Initialize(suite.mockJWTService, nil, time.Hour, true, nil)
 
 	RegisterRoutes(mux, suite.mockJWTService, nil, nil, suite.mockDiscoveryService, revocationService, nil, 0)
 
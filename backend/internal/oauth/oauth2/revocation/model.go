@@ -92,6 +92,9 @@ type RevocationIdentity = sharedrevocation.Identity
 // CriteriaRevocation describes a criteria-based revocation write.
 type CriteriaRevocation = sharedrevocation.CriteriaRevocation
 
+// CriteriaRevocationNotifier is told about each criteria-based revocation once it is persisted.
+type CriteriaRevocationNotifier = sharedrevocation.CriteriaRevocationNotifier
+
 // RevokedToken represents a single revoked token entry in the deny list.
 type RevokedToken struct {
 	// ID is the surrogate UUID v7 primary key. Generated by the store when empty.
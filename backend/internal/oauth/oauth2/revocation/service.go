@@ -75,6 +75,7 @@ type revocationService struct {
 	tokenFamilyLifetime time.Duration
 	revokeTokenFamily   bool
 	observabilitySvc    providers.ObservabilityProvider
+	criteriaNotifier    CriteriaRevocationNotifier
 	logger              *log.Logger
 }
 
@@ -84,13 +85,15 @@ type revocationService struct {
 // When revokeTokenFamily is true, an explicit revocation of a token carrying a token family id also revokes
 // the whole family (so a login's access tokens drop with its refresh token). tokenFamilyLifetime bounds
 // each token-family deny-list entry (revoked_at + tokenFamilyLifetime); a non-positive value falls back
-// to defaultTokenFamilyRevocationTTL.
+// to defaultTokenFamilyRevocationTTL. A nil criteriaNotifier disables notification of criteria-based
+// revocations.
 func newRevocationService(
 	jwtService jwt.JWTServiceInterface,
 	store revocationStoreInterface,
 	tokenFamilyLifetime time.Duration,
 	revokeTokenFamily bool,
 	observabilitySvc providers.ObservabilityProvider,
+	criteriaNotifier CriteriaRevocationNotifier,
 ) RevocationServiceInterface {
 	if tokenFamilyLifetime <= 0 {
 		tokenFamilyLifetime = defaultTokenFamilyRevocationTTL
@@ -101,6 +104,7 @@ func newRevocationService(
 		tokenFamilyLifetime: tokenFamilyLifetime,
 		revokeTokenFamily:   revokeTokenFamily,
 		observabilitySvc:    observabilitySvc,
+		criteriaNotifier:    criteriaNotifier,
 		logger:              log.GetLogger().With(log.String(log.LoggerKeyComponentName, "RevocationService")),
 	}
 }
@@ -248,6 +252,9 @@ func (s *revocationService) RevokeByCriteria(ctx context.Context, revocation Cri
 	s.logger.Debug(ctx, "Revoked tokens by criteria",
 		log.String("criterionType", string(revocation.Criterion.Type)),
 		log.String("reason", string(revocation.Reason)))
+	if s.criteriaNotifier != nil {
+		s.criteriaNotifier.NotifyCriteriaRevoked(ctx, revocation)
+	}
 	return nil
 }
 
@@ -38,7 +38,7 @@ func (s *RevocationServiceTestSuite) SetupTest() {
 	s.jwtServiceMock = jwtmock.NewJWTServiceInterfaceMock(s.T())
 	s.storeMock = newRevocationStoreInterfaceMock(s.T())
 	s.obsMock = observabilitymock.NewObservabilityServiceInterfaceMock(s.T())
-	s.service = newRevocationService(s.jwtServiceMock, s.storeMock, time.Hour, true, s.obsMock)
+	s.service = newRevocationService(s.jwtServiceMock, s.storeMock, time.Hour, true, s.obsMock, nil)
 }
 
 // buildToken constructs a JWT-shaped string with the given claims. DecodeJWT only base64-decodes the
@@ -220,7 +220,7 @@ func TestRevokeTokenFamily_WritesTokenFamilyCriterion(t *testing.T) {
 		}).
 		Return(nil)
 
-	revoker := newRevocationService(nil, store, time.Hour, false, nil)
+	revoker := newRevocationService(nil, store, time.Hour, false, nil, nil)
 	err := revoker.RevokeTokenFamily(context.Background(), "tfid-abc", RevocationReasonSessionLogout)
 
 	assert.NoError(t, err)
@@ -233,7 +233,7 @@ func TestRevokeTokenFamily_WritesTokenFamilyCriterion(t *testing.T) {
 func TestRevokeTokenFamily_EmptyIDIsNoOp(t *testing.T) {
 	store := newRevocationStoreInterfaceMock(t)
 	// No insertCriterion expectation: an empty tfid must not write.
-	revoker := newRevocationService(nil, store, time.Hour, false, nil)
+	revoker := newRevocationService(nil, store, time.Hour, false, nil, nil)
 
 	err := revoker.RevokeTokenFamily(context.Background(), "", RevocationReasonSessionLogout)
 	assert.NoError(t, err)
@@ -250,7 +250,7 @@ func TestRevokeByCriteria_UsesCutoffAsRevokedAt(t *testing.T) {
 			criterion.RevokedAt.Equal(cutoff)
 	})).Return(nil)
 
-	revoker := newRevocationService(nil, store, time.Hour, false, nil)
+	revoker := newRevocationService(nil, store, time.Hour, false, nil, nil)
 	err := revoker.RevokeByCriteria(context.Background(), CriteriaRevocation{
 		Criterion: Criterion{Type: CriterionTypeApplicationID, Value: "app-123"},
 		Mode:      RevocationModeBeforeAction,
@@ -261,11 +261,53 @@ func TestRevokeByCriteria_UsesCutoffAsRevokedAt(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// recordingCriteriaNotifier captures the revocations it is notified about.
+type recordingCriteriaNotifier struct {
+	revocations []CriteriaRevocation
+}
+
+func (n *recordingCriteriaNotifier) NotifyCriteriaRevoked(_ context.Context, revocation CriteriaRevocation) {
+	n.revocations = append(n.revocations, revocation)
+}
+
+func TestRevokeByCriteria_NotifiesAfterPersisting(t *testing.T) {
+	store := newRevocationStoreInterfaceMock(t)
+	store.On("insertCriterion", mock.Anything, mock.Anything).Return(nil)
+	notifier := &recordingCriteriaNotifier{}
+
+	revoker := newRevocationService(nil, store, time.Hour, false, nil, notifier)
+	revocation := CriteriaRevocation{
+		Criterion: Criterion{Type: CriterionTypeSubject, Value: "user-1"},
+		Mode:      RevocationModeAll,
+		Reason:    RevocationReasonUserDeleted,
+	}
+	err := revoker.RevokeByCriteria(context.Background(), revocation)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []CriteriaRevocation{revocation}, notifier.revocations)
+}
+
+func TestRevokeByCriteria_FailedWriteDoesNotNotify(t *testing.T) {
+	store := newRevocationStoreInterfaceMock(t)
+	store.On("insertCriterion", mock.Anything, mock.Anything).Return(errors.New("db down"))
+	notifier := &recordingCriteriaNotifier{}
+
+	revoker := newRevocationService(nil, store, time.Hour, false, nil, notifier)
+	err := revoker.RevokeByCriteria(context.Background(), CriteriaRevocation{
+		Criterion: Criterion{Type: CriterionTypeSubject, Value: "user-1"},
+		Mode:      RevocationModeAll,
+		Reason:    RevocationReasonUserDeleted,
+	})
+
+	assert.Error(t, err)
+	assert.Empty(t, notifier.revocations)
+}
+
 func TestRevokeTokenFamily_PropagatesStoreError(t *testing.T) {
 	store := newRevocationStoreInterfaceMock(t)
 	store.On("insertCriterion", mock.Anything, mock.Anything).Return(errors.New("db down"))
 
-	revoker := newRevocationService(nil, store, time.Hour, false, nil)
+	revoker := newRevocationService(nil, store, time.Hour, false, nil, nil)
 	err := revoker.RevokeTokenFamily(context.Background(), "tfid-abc", RevocationReasonRefreshReplay)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "db down")
@@ -280,7 +322,7 @@ func TestRevokeTokenFamily_NonPositiveTTLFallsBack(t *testing.T) {
 		}).
 		Return(nil)
 
-	revoker := newRevocationService(nil, store, 0, false, nil)
+	revoker := newRevocationService(nil, store, 0, false, nil, nil)
 	err := revoker.RevokeTokenFamily(context.Background(), "tfid-abc", RevocationReasonCodeReplay)
 	assert.NoError(t, err)
 	assert.WithinDuration(t, captured.RevokedAt.Add(defaultTokenFamilyRevocationTTL), captured.ExpiryTime, time.Second)
@@ -99,6 +99,9 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 		Audiences:          r.Form[constants.RequestParamAudience],
 		AuthReqID:          r.FormValue(constants.RequestParamAuthReqID),
 		Assertion:          r.FormValue(constants.RequestParamAssertion),
+		DeviceCode:         r.FormValue(constants.RequestParamDeviceCode),
+
+		AuthorizationDetails: r.FormValue(constants.RequestParamAuthorizationDetails),
 	}
 
 	// Delegate all business logic to the token service.
@@ -13,8 +13,10 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/fapi"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/granthandlers"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -153,6 +155,18 @@ func (ts *tokenService) ProcessTokenRequest(
 		return nil, dpopErr
 	}
 
+	if certErr := bindClientCertificate(&ctx, oauthApp); certErr != nil {
+		publishTokenIssuanceFailedEvent(ts.observabilitySvc, ctx, clientID, grantTypeStr, scopeStr,
+			400, certErr.ErrorDescription, startTime)
+		return nil, certErr
+	}
+
+	if bindErr := requireSenderConstraint(&ctx, oauthApp); bindErr != nil {
+		publishTokenIssuanceFailedEvent(ts.observabilitySvc, ctx, clientID, grantTypeStr, scopeStr,
+			400, bindErr.ErrorDescription, startTime)
+		return nil, bindErr
+	}
+
 	// Delegate to the grant handler for token generation.
 	tokenRespDTO, tokenError := grantHandler.HandleGrant(ctx, tokenRequest, oauthApp)
 	if tokenError != nil {
@@ -217,6 +231,7 @@ func (ts *tokenService) ProcessTokenRequest(
 			grantTypeStr, tokenRespDTO.AccessToken.Scopes, tokenRespDTO.AccessToken.ClaimsRequest,
 			tokenRespDTO.AccessToken.ClaimsLocales, tokenRespDTO.AccessToken.AttributeCacheID,
 			tokenRespDTO.AccessToken.TokenFamilyID,
+			tokenRespDTO.AccessToken.SessionID,
 			0,
 		)
 		if refreshTokenError != nil && refreshTokenError.Error != "" {
@@ -238,6 +253,8 @@ func (ts *tokenService) ProcessTokenRequest(
 		RefreshToken: tokenRespDTO.RefreshToken.Token,
 		Scope:        scopes,
 		IDToken:      tokenRespDTO.IDToken.Token,
+
+		AuthorizationDetails: tokenRespDTO.AccessToken.AuthorizationDetails,
 	}
 
 	// For token exchange, determine the issued_token_type from the request.
@@ -292,10 +309,54 @@ func (ts *tokenService) verifyDPoPProof(ctx *context.Context, oauthApp *provider
 			ErrorDescription: err.Error(),
 		}
 	}
+	if oauthApp.IsFAPI2() && !fapi.IsAllowedSigningAlgorithm(result.Alg) {
+		return &model.ErrorResponse{
+			Error: constants.ErrorInvalidDPoPProof,
+			ErrorDescription: "The DPoP proof must be signed with PS256, ES256 or EdDSA under the FAPI 2.0 " +
+				"security profile",
+		}
+	}
 	*ctx = dpop.WithJkt(*ctx, result.JKT)
 	return nil
 }
 
+// bindClientCertificate stores the thumbprint of the client's mutual-TLS certificate in ctx so grant
+// handlers bind the issued tokens to it (RFC 8705 §3). A missing certificate is rejected when the
+// client requires certificate-bound access tokens.
+func bindClientCertificate(ctx *context.Context, oauthApp *providers.OAuthClient) *model.ErrorResponse {
+	if oauthApp == nil || !oauthApp.MTLSBoundAccessTokens {
+		return nil
+	}
+	clientCert := mtls.GetClientCertificate(*ctx)
+	if clientCert == nil {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "A mutual-TLS client certificate is required for this client",
+		}
+	}
+	*ctx = mtls.WithThumbprint(*ctx, mtls.Thumbprint(clientCert.Certificate))
+	return nil
+}
+
+// requireSenderConstraint enforces the FAPI 2.0 Security Profile requirement that every access token
+// is sender-constrained. A verified DPoP proof already binds the tokens; otherwise the tokens are bound
+// to the client's mutual-TLS certificate, and the request is rejected when neither is presented.
+func requireSenderConstraint(ctx *context.Context, oauthApp *providers.OAuthClient) *model.ErrorResponse {
+	if !oauthApp.IsFAPI2() || dpop.GetJkt(*ctx) != "" || mtls.GetThumbprint(*ctx) != "" {
+		return nil
+	}
+	clientCert := mtls.GetClientCertificate(*ctx)
+	if clientCert == nil {
+		return &model.ErrorResponse{
+			Error: constants.ErrorInvalidRequest,
+			ErrorDescription: "The FAPI 2.0 security profile requires sender-constrained access tokens: " +
+				"present a DPoP proof or a mutual-TLS client certificate",
+		}
+	}
+	*ctx = mtls.WithThumbprint(*ctx, mtls.Thumbprint(clientCert.Certificate))
+	return nil
+}
+
 // publishTokenIssuanceStartedEvent publishes an event indicating that token issuance has started.
 func (ts *tokenService) publishTokenIssuanceStartedEvent(ctx context.Context, clientID, grantType, scope string) {
 	if ts.observabilitySvc == nil || !ts.observabilitySvc.IsEnabled() {
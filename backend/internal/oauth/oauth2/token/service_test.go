@@ -5,6 +5,7 @@ package token
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/dpopmock"
@@ -443,6 +445,143 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_NoDPoPProof_PerClien
 	suite.mockDPoPVerifier.AssertNotCalled(suite.T(), "Verify", mock.Anything, mock.Anything)
 }
 
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_CertificateBound_PropagatesThumbprintToHandler() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeClientCredentials),
+	}
+	app := &providers.OAuthClient{
+		ClientID:              "test-client-id",
+		GrantTypes:            []providers.GrantType{providers.GrantTypeClientCredentials},
+		MTLSBoundAccessTokens: true,
+	}
+	clientCert := &x509.Certificate{Raw: []byte("client-certificate")}
+
+	suite.mockGrantProvider.ExpectedCalls = nil
+	suite.mockGrantProvider.
+		On("GetGrantHandler", providers.GrantTypeClientCredentials).
+		Return(suite.mockGrantHandler, nil)
+	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "", "test-client-id").Return("", nil)
+	suite.mockGrantHandler.
+		On("HandleGrant",
+			mock.MatchedBy(func(ctx context.Context) bool {
+				return mtls.GetThumbprint(ctx) == mtls.Thumbprint(clientCert)
+			}),
+			mock.Anything, app).
+		Return(&model.TokenResponseDTO{
+			AccessToken: model.TokenDTO{Token: "at", TokenType: constants.TokenTypeBearer, ExpiresIn: 3600},
+		}, nil)
+
+	svc := suite.newService()
+	ctx := mtls.WithClientCertificate(context.Background(), &mtls.ClientCertificate{Certificate: clientCert})
+	resp, errResp := svc.ProcessTokenRequest(ctx, req, app)
+
+	assert.Nil(suite.T(), errResp)
+	assert.NotNil(suite.T(), resp)
+}
+
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_CertificateBound_NoCertificate_Rejected() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeClientCredentials),
+	}
+	app := &providers.OAuthClient{
+		ClientID:              "test-client-id",
+		GrantTypes:            []providers.GrantType{providers.GrantTypeClientCredentials},
+		MTLSBoundAccessTokens: true,
+	}
+
+	suite.mockGrantProvider.ExpectedCalls = nil
+	suite.mockGrantProvider.
+		On("GetGrantHandler", providers.GrantTypeClientCredentials).
+		Return(suite.mockGrantHandler, nil)
+	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "", "test-client-id").Return("", nil)
+
+	svc := suite.newService()
+	_, errResp := svc.ProcessTokenRequest(context.Background(), req, app)
+
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidRequest, errResp.Error)
+	suite.mockGrantHandler.AssertNotCalled(suite.T(), "HandleGrant", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TokenServiceTestSuite) fapi2ClientCredentialsApp() *providers.OAuthClient {
+	app := &providers.OAuthClient{
+		ClientID:        "test-client-id",
+		GrantTypes:      []providers.GrantType{providers.GrantTypeClientCredentials},
+		SecurityProfile: providers.SecurityProfileFAPI2,
+	}
+	suite.mockGrantProvider.ExpectedCalls = nil
+	suite.mockGrantProvider.
+		On("GetGrantHandler", providers.GrantTypeClientCredentials).
+		Return(suite.mockGrantHandler, nil)
+	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "", "test-client-id").Return("", nil)
+	return app
+}
+
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_FAPI2_NoSenderConstraint_Rejected() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeClientCredentials),
+	}
+	app := suite.fapi2ClientCredentialsApp()
+
+	svc := suite.newService()
+	_, errResp := svc.ProcessTokenRequest(context.Background(), req, app)
+
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidRequest, errResp.Error)
+	assert.Contains(suite.T(), errResp.ErrorDescription, "sender-constrained")
+	suite.mockGrantHandler.AssertNotCalled(suite.T(), "HandleGrant", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_FAPI2_BindsClientCertificate() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeClientCredentials),
+	}
+	app := suite.fapi2ClientCredentialsApp()
+	clientCert := &x509.Certificate{Raw: []byte("client-certificate")}
+	suite.mockGrantHandler.
+		On("HandleGrant",
+			mock.MatchedBy(func(ctx context.Context) bool {
+				return mtls.GetThumbprint(ctx) == mtls.Thumbprint(clientCert)
+			}),
+			mock.Anything, app).
+		Return(&model.TokenResponseDTO{
+			AccessToken: model.TokenDTO{Token: "at", TokenType: constants.TokenTypeBearer, ExpiresIn: 3600},
+		}, nil)
+
+	svc := suite.newService()
+	ctx := mtls.WithClientCertificate(context.Background(), &mtls.ClientCertificate{Certificate: clientCert})
+	resp, errResp := svc.ProcessTokenRequest(ctx, req, app)
+
+	assert.Nil(suite.T(), errResp)
+	assert.NotNil(suite.T(), resp)
+}
+
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_FAPI2_DPoPProofAlgRejected() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeClientCredentials),
+	}
+	app := suite.fapi2ClientCredentialsApp()
+	suite.mockDPoPVerifier.
+		On("Verify", mock.Anything, mock.Anything).
+		Return(&dpop.ProofResult{JKT: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I", Alg: "RS256"}, nil)
+
+	svc := suite.newService()
+	ctx := dpop.WithProof(context.Background(), "eyJ.dpop.proof")
+	_, errResp := svc.ProcessTokenRequest(ctx, req, app)
+
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidDPoPProof, errResp.Error)
+	assert.Contains(suite.T(), errResp.ErrorDescription, "FAPI 2.0")
+}
+
 func (suite *TokenServiceTestSuite) TestProcessTokenRequest_NoDPoPProof_GlobalRequired_Rejected() {
 	req := &model.TokenRequest{
 		ClientID:  "test-client-id",
@@ -515,7 +654,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_WithRefreshToken() {
 	// The access token's tfid must be forwarded to refresh-token issuance so both tokens share the family.
 	mockRefreshHandler.
 		On("IssueRefreshToken", mock.Anything, tokenRespDTO, app, "user123", []string{"test-audience"},
-			"authorization_code", []string{"openid"}, (*model.ClaimsRequest)(nil), "", "", "tfid-access-123", int64(0)).
+			"authorization_code", []string{"openid"}, (*model.ClaimsRequest)(nil), "", "", "tfid-access-123", "", int64(0)).
 		Return(nil)
 
 	svc := suite.newService()
@@ -570,7 +709,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_RefreshTokenIssuance
 
 	mockRefreshHandler.
 		On("IssueRefreshToken", mock.Anything, tokenRespDTO, app, "user123", []string{"test-audience"},
-			"authorization_code", []string{"openid"}, (*model.ClaimsRequest)(nil), "", "", "", int64(0)).
+			"authorization_code", []string{"openid"}, (*model.ClaimsRequest)(nil), "", "", "", "", int64(0)).
 		Return(&model.ErrorResponse{
 			Error:            "server_error",
 			ErrorDescription: "Failed to issue refresh token",
@@ -792,7 +931,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_WithRefreshToken_Use
 	mockRefreshHandler.
 		On("IssueRefreshToken", mock.Anything, tokenRespDTO, app, "user123",
 			[]string{"original-audience-1", "original-audience-2"},
-			"authorization_code", []string{"openid"}, (*model.ClaimsRequest)(nil), "", "", "", int64(0)).
+			"authorization_code", []string{"openid"}, (*model.ClaimsRequest)(nil), "", "", "", "", int64(0)).
 		Return(nil)
 
 	svc := suite.newService()
@@ -851,7 +990,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_CIBA_RefreshTokenUse
 		On("IssueRefreshToken", mock.Anything, tokenRespDTO, app, "user-1",
 			[]string{"https://api.example.com"},
 			string(providers.GrantTypeCIBA), []string{"openid", "read"},
-			(*model.ClaimsRequest)(nil), "", "", "", int64(0)).
+			(*model.ClaimsRequest)(nil), "", "", "", "", int64(0)).
 		Return(nil)
 
 	svc := suite.newService()
@@ -13,6 +13,8 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
@@ -29,10 +31,11 @@ type TokenBuilderInterface interface {
 
 // TokenBuilder implements TokenBuilderInterface.
 type tokenBuilder struct {
-	cfg          oauthconfig.Config
-	jwtService   jwt.JWTServiceInterface
-	jweService   jwe.JWEServiceInterface
-	jwksResolver *jwksresolver.Resolver
+	cfg             oauthconfig.Config
+	jwtService      jwt.JWTServiceInterface
+	jweService      jwe.JWEServiceInterface
+	jwksResolver    *jwksresolver.Resolver
+	pairwiseService pairwise.PairwiseSubjectServiceInterface
 }
 
 // newTokenBuilder creates a new TokenBuilder instance.
@@ -41,12 +44,14 @@ func newTokenBuilder(
 	jwtService jwt.JWTServiceInterface,
 	jweService jwe.JWEServiceInterface,
 	resolver *jwksresolver.Resolver,
+	pairwiseService pairwise.PairwiseSubjectServiceInterface,
 ) TokenBuilderInterface {
 	return &tokenBuilder{
-		cfg:          cfg,
-		jwtService:   jwtService,
-		jweService:   jweService,
-		jwksResolver: resolver,
+		cfg:             cfg,
+		jwtService:      jwtService,
+		jweService:      jweService,
+		jwksResolver:    resolver,
+		pairwiseService: pairwiseService,
 	}
 }
 
@@ -83,6 +88,8 @@ func (tb *tokenBuilder) BuildAccessToken(
 		ClaimsRequest:    tokenCtx.ClaimsRequest,
 		ClaimsLocales:    tokenCtx.ClaimsLocales,
 		TokenFamilyID:    tokenCtx.TokenFamilyID,
+
+		AuthorizationDetails: tokenCtx.AuthorizationDetails,
 	}
 
 	token, iat, err := tb.jwtService.GenerateJWT(
@@ -227,11 +234,16 @@ func (tb *tokenBuilder) buildAccessTokenClaims(
 	}
 
 	dpop.SetCnfJkt(claims, ctx.DPoPJkt)
+	mtls.SetCnfX5tS256(claims, ctx.CertThumbprint)
 
 	if ctx.TokenFamilyID != "" {
 		claims[constants.ClaimTokenFamilyID] = ctx.TokenFamilyID
 	}
 
+	if len(ctx.AuthorizationDetails) > 0 {
+		claims[constants.ClaimAuthorizationDetails] = ctx.AuthorizationDetails
+	}
+
 	return claims, nil
 }
 
@@ -353,6 +365,14 @@ func (tb *tokenBuilder) buildRefreshTokenClaims(ctx *RefreshTokenBuildContext) (
 		claims[constants.ClaimTokenFamilyID] = ctx.TokenFamilyID
 	}
 
+	if ctx.SessionID != "" {
+		claims[constants.ClaimSessionID] = ctx.SessionID
+	}
+
+	if len(ctx.AuthorizationDetails) > 0 {
+		claims["access_token_authorization_details"] = ctx.AuthorizationDetails
+	}
+
 	return claims, nil
 }
 
@@ -369,11 +389,22 @@ func (tb *tokenBuilder) BuildIDToken(
 
 	jwtClaims := tb.buildIDTokenClaims(tokenCtx)
 
+	// A pairwise client receives its sector's subject identifier. The mapping is recorded so that the
+	// identifier can be resolved back when the ID token is presented as a hint or a subject token.
+	subject := tokenCtx.Subject
+	if tokenCtx.OAuthApp.IsPairwise() {
+		pairwiseSubject, err := tb.pairwiseService.RecordSubject(ctx, tokenCtx.OAuthApp, tokenCtx.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve pairwise subject: %w", err)
+		}
+		subject = pairwiseSubject
+	}
+
 	tokenDTO := &oauth2model.TokenDTO{
 		ExpiresIn: tokenConfig.ValidityPeriod,
 		Scopes:    tokenCtx.Scopes,
 		ClientID:  tokenCtx.Audience,
-		Subject:   tokenCtx.Subject,
+		Subject:   subject,
 		Audiences: []string{tokenCtx.Audience},
 	}
 
@@ -381,7 +412,7 @@ func (tb *tokenBuilder) BuildIDToken(
 
 	token, iat, err := tb.jwtService.GenerateJWT(
 		ctx,
-		tokenCtx.Subject,
+		subject,
 		tokenConfig.Issuer,
 		tokenConfig.ValidityPeriod,
 		jwtClaims,
@@ -446,6 +477,10 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 		claims["acr"] = ctx.CompletedACR
 	}
 
+	if ctx.SessionID != "" {
+		claims[constants.ClaimSessionID] = ctx.SessionID
+	}
+
 	userAttributes := ctx.UserAttributes
 	if userAttributes == nil {
 		userAttributes = make(map[string]interface{})
@@ -9,6 +9,7 @@ import (
 	"crypto/rsa"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"io"
 	"math/big"
 	"net/http"
@@ -31,12 +32,14 @@ import (
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwemock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
 )
 
 const (
@@ -98,7 +101,7 @@ func (suite *TokenBuilderTestSuite) TestNewTokenBuilder() {
 	jwtService := jwtmock.NewJWTServiceInterfaceMock(suite.T())
 	builder := newTokenBuilder(oauthconfig.Config{
 		JWT: engineconfig.JWTConfig{Issuer: "https://example.com", ValidityPeriod: 3600},
-	}, jwtService, nil, nil)
+	}, jwtService, nil, nil, nil)
 
 	assert.NotNil(suite.T(), builder)
 	assert.Implements(suite.T(), (*TokenBuilderInterface)(nil), builder)
@@ -587,6 +590,37 @@ func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithClaimsLocal
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithAuthorizationDetails() {
+	details := []oauth2model.AuthorizationDetail{
+		{"type": "payment_initiation", "instructedAmount": map[string]interface{}{"currency": "EUR"}},
+	}
+	ctx := &AccessTokenBuildContext{
+		Subject:              "user123",
+		Audiences:            []string{"https://api.example.com/payments"},
+		ClientID:             "test-client",
+		GrantType:            string(providers.GrantTypeAuthorizationCode),
+		OAuthApp:             suite.oauthApp,
+		AuthorizationDetails: details,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://example.com",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return reflect.DeepEqual(claims[constants.ClaimAuthorizationDetails], details)
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(context.Background(), ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), details, result.AuthorizationDetails)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithDPoPJkt() {
 	const testJkt = "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I"
 
@@ -620,6 +654,38 @@ func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithDPoPJkt() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithCertThumbprint() {
+	const testThumbprint = "bwcK0esc3ACC3DB2Y5_lESsXE8o9ltc05O89jdN-dg2"
+
+	ctx := &AccessTokenBuildContext{
+		Subject:           "user123",
+		Audiences:         []string{"app123"},
+		ClientID:          "test-client",
+		Scopes:            []string{"read"},
+		SubjectAttributes: map[string]any{},
+		GrantType:         string(providers.GrantTypeClientCredentials),
+		OAuthApp:          suite.oauthApp,
+		CertThumbprint:    testThumbprint,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://example.com",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]any) bool {
+			cnf, ok := claims["cnf"].(map[string]any)
+			return ok && cnf["x5t#S256"] == testThumbprint
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(context.Background(), ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constants.TokenTypeBearer, result.TokenType)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithoutDPoPJkt_BearerType() {
 	ctx := &AccessTokenBuildContext{
 		Subject:           "user123",
@@ -1050,6 +1116,35 @@ func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Error_JWTGenerationFai
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithAuthorizationDetails() {
+	details := []oauth2model.AuthorizationDetail{{"type": "account_information"}}
+	ctx := &RefreshTokenBuildContext{
+		ClientID:             "test-client",
+		Scopes:               []string{"openid"},
+		GrantType:            string(providers.GrantTypeAuthorizationCode),
+		AccessTokenSubject:   "user123",
+		AccessTokenAudiences: []string{"app123"},
+		OAuthApp:             suite.oauthApp,
+		AuthorizationDetails: details,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"test-client",
+		"https://example.com",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return reflect.DeepEqual(claims["access_token_authorization_details"], details)
+		}), mock.Anything, mock.Anything,
+	).Return(testRefreshToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildRefreshToken(context.Background(), ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithClaimsLocales() {
 	ctx := &RefreshTokenBuildContext{
 		ClientID:             "test-client",
@@ -1129,6 +1224,48 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_Basic() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_PairwiseSubject() {
+	pairwiseService := pairwisemock.NewPairwiseSubjectServiceInterfaceMock(suite.T())
+	suite.builder.pairwiseService = pairwiseService
+	oauthApp := &providers.OAuthClient{
+		ClientID:     "app123",
+		SubjectType:  providers.SubjectTypePairwise,
+		RedirectURIs: []string{"https://app.example.com/callback"},
+	}
+	ctx := &IDTokenBuildContext{
+		Subject:  "user123",
+		Audience: "app123",
+		Scopes:   []string{"openid"},
+		AuthTime: time.Now().Unix(),
+		OAuthApp: oauthApp,
+	}
+
+	pairwiseService.EXPECT().RecordSubject(mock.Anything, oauthApp, "user123").Return("pairwise-sub", nil).Once()
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "pairwise-sub", "https://example.com", int64(3600),
+		mock.Anything, mock.Anything, mock.Anything).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(context.Background(), ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testIDToken, result.Token)
+	assert.Equal(suite.T(), "pairwise-sub", result.Subject)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_PairwiseSubjectError() {
+	pairwiseService := pairwisemock.NewPairwiseSubjectServiceInterfaceMock(suite.T())
+	suite.builder.pairwiseService = pairwiseService
+	oauthApp := &providers.OAuthClient{ClientID: "app123", SubjectType: providers.SubjectTypePairwise}
+	ctx := &IDTokenBuildContext{Subject: "user123", Audience: "app123", OAuthApp: oauthApp}
+
+	pairwiseService.EXPECT().RecordSubject(mock.Anything, oauthApp, "user123").
+		Return("", errors.New("db error")).Once()
+
+	result, err := suite.builder.BuildIDToken(context.Background(), ctx)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithNonce() {
 	ctx := &IDTokenBuildContext{
 		Subject:        "user123",
@@ -1192,6 +1329,32 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithoutNonce() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithSessionID() {
+	ctx := &IDTokenBuildContext{
+		Subject:   "user123",
+		Audience:  "app123",
+		Scopes:    []string{"openid"},
+		OAuthApp:  suite.oauthApp,
+		SessionID: "sid-123",
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://example.com",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["sid"] == "sid-123"
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(context.Background(), ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_NoAuthTime() {
 	ctx := &IDTokenBuildContext{
 		Subject:        "user123",
@@ -52,6 +52,9 @@ type AccessTokenBuildContext struct {
 	// DPoPJkt, when set, sender-constrains the access token to the supplied JWK thumbprint.
 	// The token receives a `cnf.jkt` claim and is issued with `token_type=DPoP`.
 	DPoPJkt string
+	// CertThumbprint, when set, binds the access token to the client's mutual-TLS certificate
+	// through a `cnf.x5t#S256` claim (RFC 8705 §3.1).
+	CertThumbprint string
 	// SourceIDP, when set, records the issuer of the external identity provider that authenticated the
 	// subject (used by the jwt-bearer/ID-JAG grant). It is emitted as the `idp` claim so downstream
 	// consumers can distinguish a federated principal from a local one.
@@ -59,6 +62,8 @@ type AccessTokenBuildContext struct {
 	// TokenFamilyID, when set, is stamped as the `tfid` claim so the token can be revoked as part of
 	// its authorization grant's family. It is constant across refresh rotation.
 	TokenFamilyID string
+	// AuthorizationDetails, when set, are emitted as the `authorization_details` claim (RFC 9396 §9).
+	AuthorizationDetails []oauth2model.AuthorizationDetail
 }
 
 // RefreshTokenBuildContext contains all the information needed to build a refresh token.
@@ -77,10 +82,16 @@ type RefreshTokenBuildContext struct {
 	// TokenFamilyID, when set, is stamped as the `tfid` claim on the refresh token. It is copied
 	// unchanged across rotation so every token of the grant shares one family id.
 	TokenFamilyID string
+	// SessionID, when set, is stamped as the `sid` claim on the refresh token so ID tokens minted on
+	// refresh keep naming the grant's SSO session.
+	SessionID string
 	// ExpiresAt, when set, is the Unix expiry the rotated token inherits from the token it replaces,
 	// so a grant cannot outlive its original issuance window. Zero starts a fresh validity period,
 	// which is what first issuance does.
 	ExpiresAt int64
+	// AuthorizationDetails are the authorization details granted to the access token, kept on the
+	// refresh token so access tokens minted on refresh carry the same grant.
+	AuthorizationDetails []oauth2model.AuthorizationDetail
 }
 
 // IDJAGBuildContext contains all the information needed to build an ID-JAG (Identity Assertion
@@ -107,6 +118,8 @@ type IDTokenBuildContext struct {
 	ClaimsRequest  *oauth2model.ClaimsRequest
 	Nonce          string
 	CompletedACR   string
+	// SessionID, when set, is stamped as the OIDC `sid` claim.
+	SessionID string
 }
 
 // RefreshTokenClaims represents the validated claims from a refresh token.
@@ -131,7 +144,12 @@ type RefreshTokenClaims struct {
 	// tokens minted during rotation so the family stays intact, and used to revoke the whole family on
 	// reuse. Empty for pre-rollout tokens.
 	TokenFamilyID string
-	Claims        map[string]interface{}
+	// SessionID is the OIDC session identifier (sid) carried on the refresh token. Empty when the grant
+	// was not issued from an SSO session.
+	SessionID string
+	Claims    map[string]interface{}
+	// AuthorizationDetails are the authorization details granted to the access tokens of the grant.
+	AuthorizationDetails []oauth2model.AuthorizationDetail
 }
 
 // SubjectTokenClaims represents the validated claims from a subject token (for token exchange).
@@ -11,10 +11,13 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/idp"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
@@ -32,7 +35,14 @@ const maxIDJAGJTILength = 256
 // for a revoked token. A revoked token yields revocation.ErrTokenRevoked and an unavailable deny list
 // yields revocation.ErrEnforcementUnavailable (fail-closed); callers discriminate via errors.Is.
 type TokenValidatorInterface interface {
+	// ValidateAccessToken validates an access token presented to a protected resource. A
+	// certificate-bound token (RFC 8705 §3) is only accepted over a connection authenticated with
+	// the certificate it is bound to.
 	ValidateAccessToken(ctx context.Context, token string) (*AccessTokenClaims, error)
+	// IntrospectAccessToken validates an access token on behalf of a resource server (RFC 7662). It
+	// performs the same checks as ValidateAccessToken except the certificate binding, since the
+	// caller is not the token holder; the binding is reported to the caller instead.
+	IntrospectAccessToken(ctx context.Context, token string) (*AccessTokenClaims, error)
 	ValidateRefreshToken(ctx context.Context, token string) (*RefreshTokenClaims, error)
 	ValidateSubjectToken(ctx context.Context, token string, oauthApp *providers.OAuthClient) (
 		*SubjectTokenClaims, error)
@@ -55,6 +65,7 @@ type tokenValidator struct {
 	idpService         providers.IDPProvider
 	enforcementService revocation.EnforcementServiceInterface
 	jtiStore           jti.JTIStoreInterface
+	pairwiseService    pairwise.PairwiseSubjectServiceInterface
 }
 
 // NewTokenValidator creates a new TokenValidator instance.
@@ -64,6 +75,7 @@ func newTokenValidator(
 	idpService providers.IDPProvider,
 	enforcementService revocation.EnforcementServiceInterface,
 	jtiStore jti.JTIStoreInterface,
+	pairwiseService pairwise.PairwiseSubjectServiceInterface,
 ) TokenValidatorInterface {
 	return &tokenValidator{
 		cfg:                cfg,
@@ -71,11 +83,25 @@ func newTokenValidator(
 		idpService:         idpService,
 		enforcementService: enforcementService,
 		jtiStore:           jtiStore,
+		pairwiseService:    pairwiseService,
 	}
 }
 
-// ValidateAccessToken validates an access token and extracts the claims.
+// ValidateAccessToken validates an access token, enforces its certificate binding and extracts the claims.
 func (tv *tokenValidator) ValidateAccessToken(ctx context.Context, token string) (*AccessTokenClaims, error) {
+	claims, err := tv.IntrospectAccessToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := mtls.VerifyBinding(ctx, claims.Claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// IntrospectAccessToken validates an access token and extracts the claims without enforcing its
+// certificate binding.
+func (tv *tokenValidator) IntrospectAccessToken(ctx context.Context, token string) (*AccessTokenClaims, error) {
 	// Verify signature and standard claims.
 	expectedIss := tv.cfg.JWT.Issuer
 	if err := tv.jwtService.VerifyJWT(ctx, token, "", expectedIss); err != nil {
@@ -167,6 +193,7 @@ func (tv *tokenValidator) ValidateRefreshToken(
 	actorSub, _ := extractStringClaim(claims, "act_sub")
 	jti, _ := extractStringClaim(claims, "jti")
 	tokenFamilyID, _ := extractStringClaim(claims, constants.ClaimTokenFamilyID)
+	sessionID, _ := extractStringClaim(claims, constants.ClaimSessionID)
 
 	// Extract claims request if present
 	var claimsRequest *oauth2model.ClaimsRequest
@@ -182,6 +209,8 @@ func (tv *tokenValidator) ValidateRefreshToken(
 	// Extract claims_locales if present
 	claimsLocales, _ := extractStringClaim(claims, "access_token_claims_locales")
 
+	authorizationDetails := authorizationdetails.FromClaim(claims["access_token_authorization_details"])
+
 	var dpopJkt string
 	if _, exists := claims["dpop_jkt"]; exists {
 		s, err := extractStringClaim(claims, "dpop_jkt")
@@ -212,6 +241,9 @@ func (tv *tokenValidator) ValidateRefreshToken(
 		JTI:              jti,
 		Exp:              exp,
 		TokenFamilyID:    tokenFamilyID,
+		SessionID:        sessionID,
+
+		AuthorizationDetails: authorizationDetails,
 	}, nil
 }
 
@@ -254,6 +286,9 @@ func (tv *tokenValidator) ValidateSubjectToken(
 		if err := tv.ensureNotRevoked(ctx, revocationIdentity(claims, selfClaims.JTI, selfTokenFamilyID)); err != nil {
 			return nil, err
 		}
+		if err := tv.resolvePairwiseSubject(ctx, header, claims, selfClaims); err != nil {
+			return nil, err
+		}
 		return selfClaims, nil
 	}
 
@@ -280,6 +315,28 @@ func (tv *tokenValidator) ValidateSubjectToken(
 	return tv.extractSubjectTokenClaims(token, iss, claims, oauthApp, issuerInfo.AttributeMappings)
 }
 
+// resolvePairwiseSubject replaces the subject of a self-issued ID token with the local subject when
+// the token was issued to a pairwise client, so that token exchange acts on the user rather than on
+// the client-specific identifier. Access and refresh tokens always carry the local subject.
+func (tv *tokenValidator) resolvePairwiseSubject(ctx context.Context, header, claims map[string]interface{},
+	subjectClaims *SubjectTokenClaims) error {
+	if tv.pairwiseService == nil {
+		return nil
+	}
+	if typ, _ := header["typ"].(string); typ != jwt.TokenTypeJWT {
+		return nil
+	}
+	if _, isRefreshToken := claims["access_token_sub"]; isRefreshToken {
+		return nil
+	}
+	localSubject, err := tv.pairwiseService.ResolveLocalSubject(ctx, subjectClaims.Sub)
+	if err != nil {
+		return fmt.Errorf("failed to resolve pairwise subject: %w", err)
+	}
+	subjectClaims.Sub = localSubject
+	return nil
+}
+
 // ValidateIDJAGSubjectToken validates a subject token for the ID-JAG issuance leg of token exchange
 // (draft-ietf-oauth-identity-assertion-authz-grant). Beyond the standard subject-token validation
 // performed by ValidateSubjectToken (signature, revocation deny list, time claims, and claim
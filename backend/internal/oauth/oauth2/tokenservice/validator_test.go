@@ -9,8 +9,10 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	"context"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -22,6 +24,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/idp"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/system/cmodels"
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -29,6 +32,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/jtimock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/revocationmock"
 )
 
@@ -164,6 +168,69 @@ func (suite *TokenValidatorTestSuite) TestValidateSubjectToken_Success_BasicToke
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenValidatorTestSuite) TestValidateSubjectToken_Success_PairwiseIDToken() {
+	pairwiseService := pairwisemock.NewPairwiseSubjectServiceInterfaceMock(suite.T())
+	suite.validator.pairwiseService = pairwiseService
+
+	now := time.Now().Unix()
+	token := suite.createTestJWT(map[string]interface{}{
+		"sub": "pairwise-sub",
+		"iss": "https://example.com",
+		"aud": suite.getDefaultAudience(),
+		"exp": float64(now + 3600),
+	})
+
+	suite.mockJWTService.On("VerifyJWTSignature", mock.Anything, token).Return(nil)
+	pairwiseService.EXPECT().ResolveLocalSubject(mock.Anything, "pairwise-sub").Return("user123", nil).Once()
+
+	result, err := suite.validator.ValidateSubjectToken(context.Background(), token, suite.oauthApp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "user123", result.Sub)
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateSubjectToken_PairwiseResolveError() {
+	pairwiseService := pairwisemock.NewPairwiseSubjectServiceInterfaceMock(suite.T())
+	suite.validator.pairwiseService = pairwiseService
+
+	now := time.Now().Unix()
+	token := suite.createTestJWT(map[string]interface{}{
+		"sub": "pairwise-sub",
+		"iss": "https://example.com",
+		"aud": suite.getDefaultAudience(),
+		"exp": float64(now + 3600),
+	})
+
+	suite.mockJWTService.On("VerifyJWTSignature", mock.Anything, token).Return(nil)
+	pairwiseService.EXPECT().ResolveLocalSubject(mock.Anything, "pairwise-sub").
+		Return("", errors.New("db error")).Once()
+
+	result, err := suite.validator.ValidateSubjectToken(context.Background(), token, suite.oauthApp)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateSubjectToken_RefreshTokenSubjectNotResolved() {
+	suite.validator.pairwiseService = pairwisemock.NewPairwiseSubjectServiceInterfaceMock(suite.T())
+
+	now := time.Now().Unix()
+	token := suite.createTestJWT(map[string]interface{}{
+		"sub":              "user123",
+		"access_token_sub": "user123",
+		"iss":              "https://example.com",
+		"aud":              suite.getDefaultAudience(),
+		"exp":              float64(now + 3600),
+	})
+
+	suite.mockJWTService.On("VerifyJWTSignature", mock.Anything, token).Return(nil)
+
+	result, err := suite.validator.ValidateSubjectToken(context.Background(), token, suite.oauthApp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "user123", result.Sub)
+}
+
 func (suite *TokenValidatorTestSuite) TestValidateSubjectToken_Success_WithTokenConfig() {
 	// App with token config should still validate using server-level issuer from config
 	customOAuthApp := &providers.OAuthClient{
@@ -1274,6 +1341,35 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithClaim
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithAuthorizationDetails() {
+	now := time.Now().Unix()
+	claims := map[string]interface{}{
+		"sub":              "test-client",
+		"iss":              "https://example.com",
+		"aud":              "test-client",
+		"exp":              float64(now + 3600),
+		"iat":              float64(now),
+		"access_token_sub": "user123",
+		"access_token_aud": testAppID,
+		"grant_type":       "authorization_code",
+		"access_token_authorization_details": []interface{}{
+			map[string]interface{}{"type": "account_information", "actions": []interface{}{"list_accounts"}},
+		},
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWT", mock.Anything, token, "", "https://example.com").Return(nil)
+
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	if assert.Len(suite.T(), result.AuthorizationDetails, 1) {
+		assert.Equal(suite.T(), "account_information", result.AuthorizationDetails[0].Type())
+	}
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithDPoPJkt() {
 	const testJkt = "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I"
 	now := time.Now().Unix()
@@ -1981,6 +2077,67 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Success_MinClaims(
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+// certBoundAccessToken returns an access token bound to the thumbprint of cert and registers its
+// signature verification with the suite's JWT service.
+func (suite *TokenValidatorTestSuite) certBoundAccessToken(cert *x509.Certificate) string {
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://example.com",
+		"aud":       "test-app",
+		"client_id": "test-client",
+	}
+	mtls.SetCnfX5tS256(claims, mtls.Thumbprint(cert))
+	token := suite.createTestAccessToken(claims)
+	suite.mockJWTService.On("VerifyJWT", mock.Anything, token, "", "https://example.com").Return(nil)
+	return token
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateAccessToken_CertificateBound_Success() {
+	cert := &x509.Certificate{Raw: []byte("bound-certificate")}
+	token := suite.certBoundAccessToken(cert)
+	ctx := mtls.WithClientCertificate(context.Background(), &mtls.ClientCertificate{Certificate: cert})
+
+	result, err := suite.validator.ValidateAccessToken(ctx, token)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), "user123", result.Sub)
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateAccessToken_CertificateBound_MissingCertificate() {
+	token := suite.certBoundAccessToken(&x509.Certificate{Raw: []byte("bound-certificate")})
+
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
+
+	assert.ErrorIs(suite.T(), err, mtls.ErrCertificateBindingMismatch)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateAccessToken_CertificateBound_MismatchedCertificate() {
+	token := suite.certBoundAccessToken(&x509.Certificate{Raw: []byte("bound-certificate")})
+	other := &x509.Certificate{Raw: []byte("other-certificate")}
+	ctx := mtls.WithClientCertificate(context.Background(), &mtls.ClientCertificate{Certificate: other})
+
+	result, err := suite.validator.ValidateAccessToken(ctx, token)
+
+	assert.ErrorIs(suite.T(), err, mtls.ErrCertificateBindingMismatch)
+	assert.Nil(suite.T(), result)
+}
+
+// Introspection is performed by a resource server rather than the token holder, so a certificate-bound
+// token is reported as valid without the caller presenting the bound certificate.
+func (suite *TokenValidatorTestSuite) TestIntrospectAccessToken_CertificateBound_SkipsBinding() {
+	cert := &x509.Certificate{Raw: []byte("bound-certificate")}
+	token := suite.certBoundAccessToken(cert)
+
+	result, err := suite.validator.IntrospectAccessToken(context.Background(), token)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	thumbprint, _ := mtls.ExtractCnfX5tS256(result.Claims)
+	assert.Equal(suite.T(), mtls.Thumbprint(cert), thumbprint)
+}
+
 // revocationEnforcementCase describes a deny-list enforcement outcome for table-driven tests.
 type revocationEnforcementCase struct {
 	name        string
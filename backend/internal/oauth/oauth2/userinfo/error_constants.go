@@ -96,6 +96,21 @@ var (
 		},
 	}
 
+	// errorCertificateBindingMismatch is returned when a certificate-bound access token is presented
+	// without the client certificate it is bound to.
+	errorCertificateBindingMismatch = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "invalid_token",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userinfoservice.certificate_binding_mismatch",
+			DefaultValue: "Invalid access token",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userinfoservice.certificate_binding_mismatch_description",
+			DefaultValue: "Access token is not bound to the presented client certificate",
+		},
+	}
+
 	// errorRevocationUnavailable is returned when the token revocation deny list could not be
 	// consulted. The validator fails closed, so the request is rejected with a server error rather
 	// than served from a token whose revocation status is unknown.
@@ -67,7 +67,8 @@ func (suite *InitTestSuite) TestInitialize() {
 	service := Initialize(mux, suite.mockJWTService, nil, nil,
 		suite.mockTokenValidator,
 		actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil),
-		suite.mockAttributeCacheService, suite.mockDiscoveryService, suite.mockDPoPVerifier, testhelpers.OAuthConfig())
+		suite.mockAttributeCacheService, suite.mockDiscoveryService, suite.mockDPoPVerifier, nil,
+		testhelpers.OAuthConfig())
 
 	assert.NotNil(suite.T(), service)
 }
@@ -78,7 +79,8 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 	Initialize(mux, suite.mockJWTService, nil, nil,
 		suite.mockTokenValidator,
 		actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil),
-		suite.mockAttributeCacheService, suite.mockDiscoveryService, suite.mockDPoPVerifier, testhelpers.OAuthConfig())
+		suite.mockAttributeCacheService, suite.mockDiscoveryService, suite.mockDPoPVerifier, nil,
+		testhelpers.OAuthConfig())
 
 	// Verify that the routes are registered by attempting to get a handler for them.
 	// The pattern includes the method because of CORS middleware wrapping.
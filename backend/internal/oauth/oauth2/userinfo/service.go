@@ -19,6 +19,8 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
@@ -47,6 +49,7 @@ type userInfoService struct {
 	inboundClient     providers.ActorProvider
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface
 	dpopVerifier      dpop.VerifierInterface
+	pairwiseService   pairwise.PairwiseSubjectServiceInterface
 	logger            *log.Logger
 }
 
@@ -59,6 +62,7 @@ func newUserInfoService(
 	actorProvider providers.ActorProvider,
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
 	dpopVerifier dpop.VerifierInterface,
+	pairwiseService pairwise.PairwiseSubjectServiceInterface,
 	cfg oauthconfig.Config,
 ) userInfoServiceInterface {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, serviceLoggerComponentName))
@@ -71,6 +75,7 @@ func newUserInfoService(
 		inboundClient:     actorProvider,
 		attributeCacheSvc: attributeCacheSvc,
 		dpopVerifier:      dpopVerifier,
+		pairwiseService:   pairwiseService,
 		logger:            logger,
 	}
 }
@@ -90,6 +95,9 @@ func (s *userInfoService) GetUserInfo(
 		if errors.Is(err, revocation.ErrEnforcementUnavailable) {
 			return nil, &errorRevocationUnavailable
 		}
+		if errors.Is(err, mtls.ErrCertificateBindingMismatch) {
+			return nil, &errorCertificateBindingMismatch
+		}
 		return nil, &errorInvalidAccessToken
 	}
 
@@ -98,6 +106,10 @@ func (s *userInfoService) GetUserInfo(
 		s.logger.Debug(ctx, "DPoP-bound access token presented under Bearer scheme")
 		return nil, &errorBearerDowngrade
 	}
+	if err := mtls.VerifyBinding(ctx, accessTokenClaims.Claims); err != nil {
+		s.logger.Debug(ctx, "Certificate-bound access token presented without its certificate", log.Error(err))
+		return nil, &errorCertificateBindingMismatch
+	}
 
 	return s.buildResponseFromClaims(ctx, accessTokenClaims)
 }
@@ -118,6 +130,9 @@ func (s *userInfoService) GetUserInfoForDPoP(
 		if errors.Is(err, revocation.ErrEnforcementUnavailable) {
 			return nil, &errorRevocationUnavailable
 		}
+		if errors.Is(err, mtls.ErrCertificateBindingMismatch) {
+			return nil, &errorCertificateBindingMismatch
+		}
 		return nil, &errorInvalidAccessToken
 	}
 
@@ -141,6 +156,10 @@ func (s *userInfoService) GetUserInfoForDPoP(
 		s.logger.Debug(ctx, "DPoP proof verification failed", log.Error(dpopErr))
 		return nil, &errorDPoPProofInvalid
 	}
+	if err := mtls.VerifyBinding(ctx, accessTokenClaims.Claims); err != nil {
+		s.logger.Debug(ctx, "Certificate-bound access token presented without its certificate", log.Error(err))
+		return nil, &errorCertificateBindingMismatch
+	}
 
 	return s.buildResponseFromClaims(ctx, accessTokenClaims)
 }
@@ -164,6 +183,10 @@ func (s *userInfoService) buildResponseFromClaims(
 	}
 
 	oauthApp := s.getOAuthApp(ctx, tokenClaims)
+	// A pairwise client must see the same subject identifier as in its ID token (OIDC Core §5.3.2).
+	if oauthApp.IsPairwise() {
+		sub = s.pairwiseService.GetSubject(oauthApp, sub)
+	}
 
 	// Extract allowed user attributes
 	var allowedUserAttributes []string
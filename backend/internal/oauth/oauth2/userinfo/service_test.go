@@ -29,6 +29,7 @@ import (
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -38,6 +39,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/dpopmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
 )
 
@@ -48,6 +50,7 @@ type UserInfoServiceTestSuite struct {
 	mockInboundClient         *inboundclientmock.InboundClientServiceInterfaceMock
 	mockEntityProvider        *entityprovidermock.EntityProviderInterfaceMock
 	mockAttributeCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockPairwiseService       *pairwisemock.PairwiseSubjectServiceInterfaceMock
 	userInfoService           userInfoServiceInterface
 	privateKey                *rsa.PrivateKey
 }
@@ -70,10 +73,11 @@ func (s *UserInfoServiceTestSuite) SetupTest() {
 	s.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
 	s.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(s.T())
 	s.mockAttributeCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(s.T())
+	s.mockPairwiseService = pairwisemock.NewPairwiseSubjectServiceInterfaceMock(s.T())
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
 		actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr(), nil),
-		s.mockAttributeCacheService, nil,
+		s.mockAttributeCacheService, nil, s.mockPairwiseService,
 		oauthconfig.Config{JWT: engineconfig.JWTConfig{Issuer: testUserInfoIssuer, ValidityPeriod: 600}},
 	)
 
@@ -133,6 +137,19 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_RevocationUnavailable() {
 	s.mockTokenValidator.AssertExpectations(s.T())
 }
 
+// TestGetUserInfo_ValidatorRejectsCertificateBinding verifies that a certificate binding rejected by
+// the validator surfaces as the certificate binding error rather than a generic invalid token.
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_ValidatorRejectsCertificateBinding() {
+	token := "token.certificate.mismatch"
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
+		nil, mtls.ErrCertificateBindingMismatch)
+
+	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
+	assert.Equal(s.T(), &errorCertificateBindingMismatch, svcErr)
+	assert.Nil(s.T(), response)
+	s.mockTokenValidator.AssertExpectations(s.T())
+}
+
 // TestGetUserInfoForDPoP_RevocationUnavailable verifies the same fail-closed behavior on the DPoP
 // path: the request is rejected with a server error before any proof binding checks.
 func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_RevocationUnavailable() {
@@ -140,7 +157,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_RevocationUnavailable(
 	actorProv := actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr(), nil)
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
-		actorProv, s.mockAttributeCacheService, verifier, userInfoTestConfig())
+		actorProv, s.mockAttributeCacheService, verifier, nil, userInfoTestConfig())
 
 	token := "token.revocation.unavailable"
 	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
@@ -349,6 +366,33 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_StandardScopes() {
 	s.mockInboundClient.AssertExpectations(s.T())
 }
 
+// TestGetUserInfo_Success_PairwiseSubject tests that a pairwise client receives its pairwise subject
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_PairwiseSubject() {
+	claims := map[string]interface{}{
+		"exp":       float64(time.Now().Add(time.Hour).Unix()),
+		"nbf":       float64(time.Now().Add(-time.Minute).Unix()),
+		"sub":       "user123",
+		"scope":     "openid",
+		"client_id": "client123",
+	}
+	token := s.createToken(claims)
+
+	oauthApp := &providers.OAuthClient{
+		ClientID:     "client123",
+		SubjectType:  providers.SubjectTypePairwise,
+		RedirectURIs: []string{"https://app.example.com/callback"},
+	}
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
+		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
+	s.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, "client123").Return(oauthApp, nil)
+	s.mockPairwiseService.EXPECT().GetSubject(oauthApp, "user123").Return("pairwise-sub").Once()
+
+	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
+	assert.Nil(s.T(), svcErr)
+	assert.NotNil(s.T(), response)
+	assert.Equal(s.T(), "pairwise-sub", response.JSONBody["sub"])
+}
+
 // TestGetUserInfo_Success_WithGroups tests successful response with groups
 func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_WithGroups() {
 	claims := map[string]interface{}{
@@ -1265,6 +1309,25 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_BearerScheme_DPoPBoundToken_R
 	s.mockTokenValidator.AssertExpectations(s.T())
 }
 
+// TestGetUserInfo_CertificateBoundToken_WithoutCertificate_Rejected verifies that a certificate-bound
+// access token (carrying cnf.x5t#S256) is rejected when the request does not present that certificate.
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_CertificateBoundToken_WithoutCertificate_Rejected() {
+	claims := map[string]any{
+		"sub":   "user123",
+		"scope": "openid",
+		"cnf":   map[string]any{"x5t#S256": "cert-thumbprint"},
+	}
+	token := s.createToken(claims)
+
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
+		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
+
+	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
+	assert.Nil(s.T(), response)
+	assert.Equal(s.T(), &errorCertificateBindingMismatch, svcErr)
+	s.mockTokenValidator.AssertExpectations(s.T())
+}
+
 // TestGetUserInfoForDPoP_NotBoundToken_Rejected verifies that a non-bound access token
 // presented under the DPoP scheme is rejected.
 func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_NotBoundToken_Rejected() {
@@ -1272,7 +1335,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_NotBoundToken_Rejected
 	actorProv := actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr(), nil)
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
-		actorProv, s.mockAttributeCacheService, verifier, userInfoTestConfig())
+		actorProv, s.mockAttributeCacheService, verifier, nil, userInfoTestConfig())
 
 	claims := map[string]any{
 		"sub":   "user123",
@@ -1298,7 +1361,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_VerifierFails_Rejected
 	actorProv := actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr(), nil)
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
-		actorProv, s.mockAttributeCacheService, verifier, userInfoTestConfig())
+		actorProv, s.mockAttributeCacheService, verifier, nil, userInfoTestConfig())
 
 	claims := map[string]any{
 		"sub":   "user123",
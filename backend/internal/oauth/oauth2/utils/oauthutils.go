@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -20,15 +21,44 @@ import (
 // GetURIWithQueryParams constructs a URI with the given query parameters.
 // It validates the error code and error description according to the spec.
 func GetURIWithQueryParams(uri string, queryParams map[string]string) (string, error) {
-	// Validate the error params if present.
-	if err := validateErrorParams(queryParams[constants.RequestParamError],
-		queryParams[constants.RequestParamErrorDescription]); err != nil {
+	if err := ValidateResponseErrorParams(queryParams); err != nil {
 		return "", err
 	}
 
 	return utils.GetURIWithQueryParams(uri, queryParams)
 }
 
+// GetURIWithFragmentParams constructs a URI carrying the given parameters form-encoded in its fragment
+// component, as the fragment response mode delivers them. Any fragment already on the URI is replaced.
+// It validates the error code and error description according to the spec.
+func GetURIWithFragmentParams(uri string, fragmentParams map[string]string) (string, error) {
+	if err := ValidateResponseErrorParams(fragmentParams); err != nil {
+		return "", err
+	}
+
+	parsedURL, err := utils.ParseURL(uri)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the return URI: %w", err)
+	}
+	parsedURL.Fragment = ""
+	parsedURL.RawFragment = ""
+	if len(fragmentParams) == 0 {
+		return parsedURL.String(), nil
+	}
+
+	values := url.Values{}
+	for key, value := range fragmentParams {
+		values.Set(key, value)
+	}
+	return parsedURL.String() + "#" + values.Encode(), nil
+}
+
+// ValidateResponseErrorParams validates the error code and error description carried in an
+// authorization response, if present.
+func ValidateResponseErrorParams(params map[string]string) error {
+	return validateErrorParams(params[constants.RequestParamError], params[constants.RequestParamErrorDescription])
+}
+
 // allowedErrorParamChars matches the character set permitted for the error and error_description
 // parameters: %x20-21 / %x23-5B / %x5D-7E.
 var allowedErrorParamChars = regexp.MustCompile(`^[\x20-\x21\x23-\x5B\x5D-\x7E]*$`)
@@ -232,6 +232,25 @@ func (suite *OAuth2UtilsTestSuite) TestGetURIWithQueryParams_OnlyErrorDescriptio
 	assert.NotContains(suite.T(), result, "error=")
 }
 
+func (suite *OAuth2UtilsTestSuite) TestGetURIWithFragmentParams() {
+	result, err := GetURIWithFragmentParams("https://example.com/callback?x=1#old", map[string]string{
+		constants.RequestParamCode:  "abc",
+		constants.RequestParamState: "s 1",
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "https://example.com/callback?x=1#code=abc&state=s+1", result)
+}
+
+func (suite *OAuth2UtilsTestSuite) TestGetURIWithFragmentParams_InvalidErrorDescription() {
+	_, err := GetURIWithFragmentParams("https://example.com/callback", map[string]string{
+		constants.RequestParamError:            "invalid_request",
+		constants.RequestParamErrorDescription: "bad \"quote\"",
+	})
+
+	assert.Error(suite.T(), err)
+}
+
 func (suite *OAuth2UtilsTestSuite) TestValidateErrorParams_DirectCall() {
 	// Test the validateErrorParams function directly (even though it's not exported)
 	// We test it through the public function
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/mtls"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
@@ -127,6 +128,10 @@ func (h *openID4VCIHandler) HandleCredential(w http.ResponseWriter, r *http.Requ
 		writeOID4VCIError(w, toOID4VCIError(err))
 		return
 	}
+	if err := verifyCertificateBinding(r, token); err != nil {
+		writeOID4VCIError(w, toOID4VCIError(err))
+		return
+	}
 	body, err := io.ReadAll(io.LimitReader(r.Body, maxCredentialRequestBytes))
 	if err != nil {
 		writeOID4VCIError(w, toOID4VCIError(ErrInvalidRequest))
@@ -161,6 +166,19 @@ func bearerToken(r *http.Request) string {
 	return ""
 }
 
+// verifyCertificateBinding enforces the mutual-TLS certificate binding (RFC 8705 §3) when the access
+// token is sender-constrained via cnf.x5t#S256.
+func verifyCertificateBinding(r *http.Request, token string) error {
+	claims, err := jwt.DecodeJWTPayload(token)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	if err := mtls.VerifyBinding(r.Context(), claims); err != nil {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
 // verifyDPoP enforces the DPoP proof (RFC 9449 §7) when the access token is
 // sender-constrained via cnf.jkt. Bearer (unbound) tokens are left untouched.
 func (h *openID4VCIHandler) verifyDPoP(r *http.Request, token string) error {
@@ -16,6 +16,7 @@ import (
 
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
@@ -108,6 +109,7 @@ type organizationUnitService struct {
 	roleResolver       OURoleResolver
 	flowResolver       ouFlowResolver
 	dependencyRegistry resourcedependency.Registry
+	auditRecorder      *audit.Recorder
 }
 
 func (ous *organizationUnitService) SetOUUserResolver(resolver OUUserResolver) {
@@ -137,11 +139,13 @@ func newOrganizationUnitService(
 	authzService sysauthz.SystemAuthorizationServiceInterface,
 	ouStore organizationUnitStoreInterface,
 	transactioner providers.Transactioner,
+	auditRecorder *audit.Recorder,
 ) ConfigurableOUService {
 	return &organizationUnitService{
 		authzService:  authzService,
 		ouStore:       ouStore,
 		transactioner: transactioner,
+		auditRecorder: auditRecorder,
 	}
 }
 
@@ -415,6 +419,7 @@ func (ous *organizationUnitService) CreateOrganizationUnit(
 		return providers.OrganizationUnit{}, &tidcommon.InternalServerError
 	}
 
+	ous.auditRecorder.Created(ctx, audit.TargetOrganizationUnit, createdOU.ID, createdOU)
 	logger.Debug(ctx, "Successfully created organization unit", log.String("ouID", createdOU.ID))
 
 	return createdOU, nil
@@ -536,7 +541,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnit(
 		return providers.OrganizationUnit{}, svcErr
 	}
 
-	var updatedOU providers.OrganizationUnit
+	var previousOU, updatedOU providers.OrganizationUnit
 	var capturedSvcErr *tidcommon.ServiceError
 
 	err := ous.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -548,6 +553,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnit(
 			}
 			return err
 		}
+		previousOU = existingOU
 
 		var svcErr *tidcommon.ServiceError
 		updatedOU, svcErr = ous.updateOUInternal(txCtx, id, request, existingOU, logger)
@@ -566,6 +572,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnit(
 		return providers.OrganizationUnit{}, &tidcommon.InternalServerError
 	}
 
+	ous.auditRecorder.Updated(ctx, audit.TargetOrganizationUnit, id, previousOU, updatedOU)
 	logger.Debug(ctx, "Successfully updated organization unit", log.String("ouID", id))
 	return updatedOU, nil
 }
@@ -582,7 +589,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnitByPath(
 		return providers.OrganizationUnit{}, serviceError
 	}
 
-	var updatedOU providers.OrganizationUnit
+	var previousOU, updatedOU providers.OrganizationUnit
 	var capturedSvcErr *tidcommon.ServiceError
 
 	err := ous.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -594,6 +601,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnitByPath(
 			}
 			return err
 		}
+		previousOU = existingOU
 
 		if svcErr := ous.checkOUAccess(txCtx, security.ActionUpdateOU, existingOU.ID); svcErr != nil {
 			capturedSvcErr = svcErr
@@ -624,6 +632,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnitByPath(
 		return providers.OrganizationUnit{}, &tidcommon.InternalServerError
 	}
 
+	ous.auditRecorder.Updated(ctx, audit.TargetOrganizationUnit, updatedOU.ID, previousOU, updatedOU)
 	logger.Debug(ctx, "Successfully updated organization unit by path", log.String("ouID", updatedOU.ID))
 	return updatedOU, nil
 }
@@ -740,6 +749,7 @@ func (ous *organizationUnitService) DeleteOrganizationUnit(
 		return svcErr
 	}
 
+	var previousOU *providers.OrganizationUnit
 	var capturedSvcErr *tidcommon.ServiceError
 
 	err := ous.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -753,6 +763,12 @@ func (ous *organizationUnitService) DeleteOrganizationUnit(
 			return errors.New("not found")
 		}
 
+		if ous.auditRecorder.IsEnabled() {
+			if existingOU, getErr := ous.ouStore.GetOrganizationUnit(txCtx, id); getErr == nil {
+				previousOU = &existingOU
+			}
+		}
+
 		svcErr := ous.deleteOUInternal(txCtx, id, logger)
 		if svcErr != nil {
 			capturedSvcErr = svcErr
@@ -769,6 +785,7 @@ func (ous *organizationUnitService) DeleteOrganizationUnit(
 		return &tidcommon.InternalServerError
 	}
 
+	ous.auditRecorder.Deleted(ctx, audit.TargetOrganizationUnit, id, previousOU)
 	logger.Debug(ctx, "Successfully deleted organization unit", log.String("ouID", id))
 	return nil
 }
@@ -786,6 +803,7 @@ func (ous *organizationUnitService) DeleteOrganizationUnitByPath(
 	}
 
 	var ouID string
+	var previousOU providers.OrganizationUnit
 	var capturedSvcErr *tidcommon.ServiceError
 
 	err := ous.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -798,6 +816,7 @@ func (ous *organizationUnitService) DeleteOrganizationUnitByPath(
 			return err
 		}
 		ouID = existingOU.ID
+		previousOU = existingOU
 
 		if svcErr := ous.checkOUAccess(txCtx, security.ActionDeleteOU, ouID); svcErr != nil {
 			capturedSvcErr = svcErr
@@ -827,6 +846,7 @@ func (ous *organizationUnitService) DeleteOrganizationUnitByPath(
 		return &tidcommon.InternalServerError
 	}
 
+	ous.auditRecorder.Deleted(ctx, audit.TargetOrganizationUnit, ouID, previousOU)
 	logger.Debug(ctx, "Successfully deleted organization unit by path", log.String("ouID", ouID))
 	return nil
 }
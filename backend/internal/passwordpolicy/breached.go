@@ -0,0 +1,129 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" // #nosec G505 -- breached-password datasets are keyed by SHA-1; not used for storage
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+// breachedCheckerInterface looks passwords up in the breached-password sources.
+type breachedCheckerInterface interface {
+	// IsBreached returns whether the password appears in a known data breach.
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+// breachedChecker looks passwords up by k-anonymity: only the first hashPrefixLength characters of the
+// SHA-1 hash of a password leave the checker, and the hash suffixes returned for the prefix are
+// compared locally. The offline dataset is consulted first; the range API only for prefixes it has no
+// file for.
+type breachedChecker struct {
+	datasetDir  string
+	rangeAPIURL string
+	httpClient  syshttp.HTTPClientInterface
+}
+
+// newBreachedChecker creates a new instance of breachedChecker.
+func newBreachedChecker(datasetDir, rangeAPIURL string,
+	httpClient syshttp.HTTPClientInterface) breachedCheckerInterface {
+	return &breachedChecker{
+		datasetDir:  datasetDir,
+		rangeAPIURL: rangeAPIURL,
+		httpClient:  httpClient,
+	}
+}
+
+// IsBreached returns whether the password appears in a known data breach. It returns false when no
+// source covers the hash prefix of the password.
+func (c *breachedChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password)) // #nosec G401 -- lookup key of the breached-password datasets
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:hashPrefixLength], hash[hashPrefixLength:]
+
+	if c.datasetDir != "" {
+		found, breached, err := c.lookupDataset(prefix, suffix)
+		if err != nil || found {
+			return breached, err
+		}
+	}
+	if c.rangeAPIURL != "" {
+		return c.lookupRangeAPI(ctx, prefix, suffix)
+	}
+	return false, nil
+}
+
+// lookupDataset looks the suffix up in the dataset file of the prefix. found reports whether the
+// dataset has a file for the prefix.
+func (c *breachedChecker) lookupDataset(prefix, suffix string) (found, breached bool, err error) {
+	file, err := os.Open(filepath.Join(c.datasetDir, prefix+".txt")) // #nosec G304 -- prefix is hex
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, false, nil
+		}
+		return false, false, fmt.Errorf("failed to open breached-password dataset: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	breached, err = containsSuffix(file, suffix)
+	if err != nil {
+		return true, false, fmt.Errorf("failed to read breached-password dataset: %w", err)
+	}
+	return true, breached, nil
+}
+
+// lookupRangeAPI looks the suffix up in the range the remote API returns for the prefix. Padding is
+// requested so the response size does not reveal the prefix.
+func (c *breachedChecker) lookupRangeAPI(ctx context.Context, prefix, suffix string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.rangeAPIURL+prefix, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create range API request: %w", err)
+	}
+	req.Header.Set("Add-Padding", "true")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("range API request failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("range API returned status %d", resp.StatusCode)
+	}
+
+	breached, err := containsSuffix(resp.Body, suffix)
+	if err != nil {
+		return false, fmt.Errorf("failed to read range API response: %w", err)
+	}
+	return breached, nil
+}
+
+// containsSuffix returns whether the "SUFFIX:COUNT" lines of a range list the suffix with a positive
+// count. Padding entries carry a zero count.
+func containsSuffix(r io.Reader, suffix string) (bool, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		entrySuffix, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || !strings.EqualFold(entrySuffix, suffix) {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(count))
+		return err == nil && n > 0, nil
+	}
+	return false, scanner.Err()
+}
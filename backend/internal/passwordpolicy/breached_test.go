@@ -0,0 +1,131 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+// The SHA-1 hash of "password1" is E38AD214943DAAD1D64C102FAEC29DE4AFE9DA3D.
+const (
+	testHashPrefix = "E38AD"
+	testHashSuffix = "214943DAAD1D64C102FAEC29DE4AFE9DA3D"
+)
+
+type BreachedCheckerTestSuite struct {
+	suite.Suite
+	datasetDir     string
+	mockHTTPClient *httpmock.HTTPClientInterfaceMock
+}
+
+func TestBreachedCheckerTestSuite(t *testing.T) {
+	suite.Run(t, new(BreachedCheckerTestSuite))
+}
+
+func (suite *BreachedCheckerTestSuite) SetupTest() {
+	suite.datasetDir = suite.T().TempDir()
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+}
+
+func (suite *BreachedCheckerTestSuite) writeDataset(prefix, content string) {
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.datasetDir, prefix+".txt"), []byte(content), 0o600))
+}
+
+func rangeResponse(status int, body string) *http.Response {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func (suite *BreachedCheckerTestSuite) TestIsBreached_Dataset() {
+	suite.writeDataset(testHashPrefix, "0018A45C4D1DEF81644B54AB7F969B88D65:3\r\n"+
+		strings.ToLower(testHashSuffix)+":42\r\n")
+	checker := newBreachedChecker(suite.datasetDir, "", suite.mockHTTPClient)
+
+	breached, err := checker.IsBreached(context.Background(), "password1")
+	suite.NoError(err)
+	suite.True(breached)
+
+	breached, err = checker.IsBreached(context.Background(), "Password1")
+	suite.NoError(err)
+	suite.False(breached)
+}
+
+func (suite *BreachedCheckerTestSuite) TestIsBreached_DatasetWinsOverRangeAPI() {
+	suite.writeDataset(testHashPrefix, "0018A45C4D1DEF81644B54AB7F969B88D65:3\n")
+	checker := newBreachedChecker(suite.datasetDir, "https://range.example.com/range/", suite.mockHTTPClient)
+
+	breached, err := checker.IsBreached(context.Background(), "password1")
+	suite.NoError(err)
+	suite.False(breached)
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *BreachedCheckerTestSuite) TestIsBreached_RangeAPI() {
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "https://range.example.com/range/"+testHashPrefix &&
+			req.Header.Get("Add-Padding") == "true"
+	})).Return(rangeResponse(http.StatusOK, testHashSuffix+":7\n"), nil).Once()
+	checker := newBreachedChecker(suite.datasetDir, "https://range.example.com/range/", suite.mockHTTPClient)
+
+	breached, err := checker.IsBreached(context.Background(), "password1")
+	suite.NoError(err)
+	suite.True(breached)
+}
+
+func (suite *BreachedCheckerTestSuite) TestIsBreached_RangeAPIPaddingIgnored() {
+	suite.mockHTTPClient.On("Do", mock.Anything).
+		Return(rangeResponse(http.StatusOK, testHashSuffix+":0\n"), nil).Once()
+	checker := newBreachedChecker("", "https://range.example.com/range/", suite.mockHTTPClient)
+
+	breached, err := checker.IsBreached(context.Background(), "password1")
+	suite.NoError(err)
+	suite.False(breached)
+}
+
+func (suite *BreachedCheckerTestSuite) TestIsBreached_RangeAPIErrors() {
+	checker := newBreachedChecker("", "https://range.example.com/range/", suite.mockHTTPClient)
+
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("timeout")).Once()
+	_, err := checker.IsBreached(context.Background(), "password1")
+	suite.Error(err)
+
+	suite.mockHTTPClient.On("Do", mock.Anything).
+		Return(rangeResponse(http.StatusServiceUnavailable, ""), nil).Once()
+	_, err = checker.IsBreached(context.Background(), "password1")
+	suite.Error(err)
+}
+
+func (suite *BreachedCheckerTestSuite) TestIsBreached_NoSource() {
+	checker := newBreachedChecker(suite.datasetDir, "", suite.mockHTTPClient)
+
+	breached, err := checker.IsBreached(context.Background(), "password1")
+	suite.NoError(err)
+	suite.False(breached)
+}
+
+func (suite *BreachedCheckerTestSuite) TestNewDictionary_File() {
+	path := filepath.Join(suite.T().TempDir(), "dictionary.txt")
+	suite.Require().NoError(os.WriteFile(path, []byte("# company words\nThunderID\n\n  acme  \n"), 0o600))
+
+	dict, err := newDictionary(path)
+	suite.Require().NoError(err)
+	suite.True(dict.contains("thunderid2026!"))
+	suite.True(dict.contains("ACME"))
+	suite.True(dict.contains("password"))
+	suite.False(dict.contains("# company words"))
+
+	_, err = newDictionary(filepath.Join(suite.T().TempDir(), "missing.txt"))
+	suite.Error(err)
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import "time"
+
+const loggerComponentName = "PasswordPolicyService"
+
+const (
+	// hashPrefixLength is the number of leading characters of a password's SHA-1 hash disclosed to the
+	// breached-password sources. The sources return every hash suffix sharing the prefix.
+	hashPrefixLength = 5
+	// minIdentityLength is the shortest identity value checked for in a password. Shorter values match
+	// too many unrelated passwords to be meaningful.
+	minIdentityLength = 3
+)
+
+// Defaults applied when the password policy settings are not configured.
+var (
+	defaultCredentialTypes    = []string{"password"}
+	defaultIdentityAttributes = []string{"username", "email"}
+)
+
+// defaultBreachedTimeout is the timeout of a range API request when none is configured.
+const defaultBreachedTimeout = 5 * time.Second
@@ -0,0 +1,79 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// commonPasswords lists frequently used passwords and base words rejected by the dictionary check
+// regardless of the configured dictionary file. Entries are lowercase.
+var commonPasswords = []string{
+	"123456", "1234567", "12345678", "123456789", "1234567890", "111111", "000000", "121212",
+	"654321", "666666", "696969", "123123", "112233", "abc123", "qwerty", "qwertyuiop", "asdfgh",
+	"asdfghjkl", "zxcvbnm", "1q2w3e4r", "qazwsx", "password", "passw0rd", "p@ssw0rd", "p@ssword",
+	"letmein", "welcome", "admin", "administrator", "root", "login", "changeme", "default", "secret",
+	"iloveyou", "monkey", "dragon", "master", "shadow", "sunshine", "princess", "football", "baseball",
+	"superman", "batman", "trustno1", "starwars", "freedom", "whatever", "computer", "internet",
+	"hello", "charlie", "michael", "jennifer", "jordan", "hunter", "ranger", "summer", "winter",
+	"spring", "autumn", "secure", "test", "guest", "user", "access", "mustang", "killer", "soccer",
+}
+
+// dictionary is the set of forbidden passwords checked by the dictionary rule.
+type dictionary map[string]struct{}
+
+// newDictionary returns the built-in common passwords together with those listed in the dictionary
+// file, if one is configured. The file lists one password per line; blank lines and lines starting with
+// "#" are ignored.
+func newDictionary(path string) (dictionary, error) {
+	dict := make(dictionary, len(commonPasswords))
+	for _, word := range commonPasswords {
+		dict[word] = struct{}{}
+	}
+	if path == "" {
+		return dict, nil
+	}
+
+	file, err := os.Open(path) // #nosec G304 -- path comes from the server configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to open password dictionary file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+		dict[strings.ToLower(word)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read password dictionary file: %w", err)
+	}
+	return dict, nil
+}
+
+// contains returns whether the password, or its base word once trailing digits and symbols are removed,
+// is in the dictionary. The comparison ignores case, so "Password123!" matches "password".
+func (d dictionary) contains(password string) bool {
+	lower := strings.ToLower(password)
+	if _, ok := d[lower]; ok {
+		return true
+	}
+	base := strings.TrimRightFunc(lower, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	if base == "" || base == lower {
+		return false
+	}
+	_, ok := d[base]
+	return ok
+}
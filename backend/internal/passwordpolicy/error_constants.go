@@ -0,0 +1,182 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for password policy violations.
+var (
+	// ErrorPasswordTooShort is the error returned when a password is shorter than the minimum length.
+	ErrorPasswordTooShort = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.too_short",
+			DefaultValue: "Password too short",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.too_short_description",
+			DefaultValue: "The password must be at least {{param(minLength)}} characters long",
+		},
+	}
+
+	// ErrorPasswordTooLong is the error returned when a password is longer than the maximum length.
+	ErrorPasswordTooLong = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.too_long",
+			DefaultValue: "Password too long",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.too_long_description",
+			DefaultValue: "The password must be at most {{param(maxLength)}} characters long",
+		},
+	}
+
+	// ErrorPasswordMissingUppercase is the error returned when a password has no uppercase letter.
+	ErrorPasswordMissingUppercase = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_uppercase",
+			DefaultValue: "Password missing uppercase letter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_uppercase_description",
+			DefaultValue: "The password must contain at least one uppercase letter",
+		},
+	}
+
+	// ErrorPasswordMissingLowercase is the error returned when a password has no lowercase letter.
+	ErrorPasswordMissingLowercase = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_lowercase",
+			DefaultValue: "Password missing lowercase letter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_lowercase_description",
+			DefaultValue: "The password must contain at least one lowercase letter",
+		},
+	}
+
+	// ErrorPasswordMissingDigit is the error returned when a password has no digit.
+	ErrorPasswordMissingDigit = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_digit",
+			DefaultValue: "Password missing digit",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_digit_description",
+			DefaultValue: "The password must contain at least one digit",
+		},
+	}
+
+	// ErrorPasswordMissingSpecial is the error returned when a password has no special character.
+	ErrorPasswordMissingSpecial = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_special",
+			DefaultValue: "Password missing special character",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.missing_special_description",
+			DefaultValue: "The password must contain at least one character that is neither a letter nor a digit",
+		},
+	}
+
+	// ErrorPasswordTooCommon is the error returned when a password is a common password or in the configured
+	// dictionary.
+	ErrorPasswordTooCommon = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.too_common",
+			DefaultValue: "Password too common",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.too_common_description",
+			DefaultValue: "The password is too common and easy to guess",
+		},
+	}
+
+	// ErrorPasswordContainsIdentity is the error returned when a password contains the user's username or
+	// another identity attribute.
+	ErrorPasswordContainsIdentity = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1008",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.contains_identity",
+			DefaultValue: "Password contains user identity",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.contains_identity_description",
+			DefaultValue: "The password must not contain the username or email address",
+		},
+	}
+
+	// ErrorPasswordReused is the error returned when a password matches one of the user's recent passwords.
+	ErrorPasswordReused = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1009",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.reused",
+			DefaultValue: "Password recently used",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.reused_description",
+			DefaultValue: "The password must not match any of the last {{param(historyCount)}} passwords",
+		},
+	}
+
+	// ErrorPasswordChangedTooRecently is the error returned when a user changes their password again before the
+	// minimum age has passed.
+	ErrorPasswordChangedTooRecently = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1010",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.changed_too_recently",
+			DefaultValue: "Password changed too recently",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.changed_too_recently_description",
+			DefaultValue: "The password cannot be changed again until {{param(minAgeSeconds)}} seconds after the last change",
+		},
+	}
+
+	// ErrorPasswordBreached is the error returned when a password appears in a known data breach.
+	ErrorPasswordBreached = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1011",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.breached",
+			DefaultValue: "Password found in a data breach",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.breached_description",
+			DefaultValue: "The password has appeared in a known data breach and cannot be used",
+		},
+	}
+
+	// ErrorEntityNotFound is the error returned when the user whose password is checked does not exist.
+	ErrorEntityNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1012",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.entity_not_found",
+			DefaultValue: "Entity not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.entity_not_found_description",
+			DefaultValue: "The entity with the specified id does not exist",
+		},
+	}
+)
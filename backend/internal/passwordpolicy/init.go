@@ -0,0 +1,68 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+// Initialize returns the password policy service configured by the password policy settings and
+// registers it as the credential policy of the entity service. The service is registered even when the
+// policy is disabled, in which case every password is accepted and none expires.
+func Initialize(
+	entityService entity.EntityServiceInterface,
+	hashService cryptolib.HashServiceInterface,
+) (PasswordPolicyServiceInterface, error) {
+	cfg := config.GetServerRuntime().Config.PasswordPolicy
+
+	var dict dictionary
+	if cfg.IsEnabled() {
+		var err error
+		if dict, err = newDictionary(cfg.DictionaryFile); err != nil {
+			return nil, err
+		}
+	}
+
+	timeout := defaultBreachedTimeout
+	if cfg.BreachedPasswords.Timeout > 0 {
+		timeout = time.Duration(cfg.BreachedPasswords.Timeout) * time.Second
+	}
+	checker := newBreachedChecker(cfg.BreachedPasswords.DatasetDir, cfg.BreachedPasswords.RangeAPIURL,
+		syshttp.NewHTTPClientWithTimeout(timeout))
+
+	service := newPasswordPolicyService(newPolicy(cfg), dict, checker, entityService, hashService)
+	entityService.SetCredentialPolicy(service)
+	return service, nil
+}
+
+// newPolicy resolves the password policy from the configuration.
+func newPolicy(cfg config.PasswordPolicyConfig) policy {
+	credentialTypes := cfg.CredentialTypes
+	if len(credentialTypes) == 0 {
+		credentialTypes = defaultCredentialTypes
+	}
+	identityAttributes := cfg.IdentityAttributes
+	if len(identityAttributes) == 0 {
+		identityAttributes = defaultIdentityAttributes
+	}
+
+	p := policy{
+		enabled:            cfg.IsEnabled(),
+		credentialTypes:    make(map[string]bool, len(credentialTypes)),
+		identityAttributes: identityAttributes,
+		defaults:           rules{}.apply(cfg.Default),
+	}
+	for _, credType := range credentialTypes {
+		p.credentialTypes[credType] = true
+	}
+	for _, o := range cfg.Overrides {
+		p.overrides = append(p.overrides, override{ouID: o.OUID, userType: o.UserType, rules: o.Rules})
+	}
+	return p
+}
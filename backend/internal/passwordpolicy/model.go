@@ -0,0 +1,112 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// rules is a password policy resolved for a user, with every rule set.
+type rules struct {
+	minLength               int
+	maxLength               int
+	requireUppercase        bool
+	requireLowercase        bool
+	requireDigit            bool
+	requireSpecial          bool
+	checkDictionary         bool
+	checkUsernameSimilarity bool
+	checkBreached           bool
+	historyCount            int
+	minAge                  time.Duration
+	maxAge                  time.Duration
+}
+
+// apply returns the rules with the rules set in cfg replacing their counterparts.
+func (r rules) apply(cfg config.PasswordPolicyRules) rules {
+	setInt(&r.minLength, cfg.MinLength)
+	setInt(&r.maxLength, cfg.MaxLength)
+	setBool(&r.requireUppercase, cfg.RequireUppercase)
+	setBool(&r.requireLowercase, cfg.RequireLowercase)
+	setBool(&r.requireDigit, cfg.RequireDigit)
+	setBool(&r.requireSpecial, cfg.RequireSpecial)
+	setBool(&r.checkDictionary, cfg.CheckDictionary)
+	setBool(&r.checkUsernameSimilarity, cfg.CheckUsernameSimilarity)
+	setBool(&r.checkBreached, cfg.CheckBreached)
+	setInt(&r.historyCount, cfg.HistoryCount)
+	if cfg.MinAge != nil {
+		r.minAge = time.Duration(max(*cfg.MinAge, 0)) * time.Second
+	}
+	if cfg.MaxAge != nil {
+		r.maxAge = time.Duration(max(*cfg.MaxAge, 0)) * time.Second
+	}
+	return r
+}
+
+// setInt replaces target with the configured value, if set. Negative values are treated as zero.
+func setInt(target *int, value *int) {
+	if value != nil {
+		*target = max(*value, 0)
+	}
+}
+
+// setBool replaces target with the configured value, if set.
+func setBool(target *bool, value *bool) {
+	if value != nil {
+		*target = *value
+	}
+}
+
+// override is a set of rules applied to the users of an organization unit, a user type, or both.
+type override struct {
+	ouID     string
+	userType string
+	rules    config.PasswordPolicyRules
+}
+
+// policy is the password policy resolved from the configuration.
+type policy struct {
+	enabled            bool
+	credentialTypes    map[string]bool
+	identityAttributes []string
+	defaults           rules
+	overrides          []override
+}
+
+// rulesFor returns the rules applying to a user of the given organization unit and user type. Overrides
+// naming only a user type are applied first, then those naming only an organization unit, then those
+// naming both, so the most specific override wins.
+func (p policy) rulesFor(ouID, userType string) rules {
+	resolved := p.defaults
+	for _, matches := range []func(override) bool{
+		func(o override) bool { return o.ouID == "" && o.userType != "" && o.userType == userType },
+		func(o override) bool { return o.userType == "" && o.ouID != "" && o.ouID == ouID },
+		func(o override) bool { return o.ouID != "" && o.ouID == ouID && o.userType == userType },
+	} {
+		for _, o := range p.overrides {
+			if matches(o) {
+				resolved = resolved.apply(o.rules)
+			}
+		}
+	}
+	return resolved
+}
+
+// hasExpiry returns whether any of the rules of the policy expires passwords.
+func (p policy) hasExpiry() bool {
+	if !p.enabled {
+		return false
+	}
+	if p.defaults.maxAge > 0 {
+		return true
+	}
+	for _, o := range p.overrides {
+		if o.rules.MaxAge != nil && *o.rules.MaxAge > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,297 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package passwordpolicy enforces the password policy configured per organization unit and user type:
+// length and character-class rules, dictionary and username-similarity checks, password history,
+// minimum and maximum password age, and a k-anonymity breached-password check. The policy is registered
+// with the entity service as its credential policy, so every path that sets a password enforces it.
+package passwordpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// PasswordPolicyServiceInterface defines the operations that enforce the password policy. It implements
+// entity.CredentialPolicy, through which new passwords are validated, and lets authenticators detect
+// expired passwords.
+type PasswordPolicyServiceInterface interface {
+	// ValidateCredential returns the policy violation of a new credential value, or nil if the value is
+	// accepted. previous holds the current and retained previous values of the credential type, most
+	// recent first.
+	ValidateCredential(ctx context.Context, user *providers.Entity, credType, value string,
+		previous []entity.StoredCredential) *tidcommon.ServiceError
+	// HistorySize returns the number of replaced credential values to retain for the history check.
+	HistorySize(ctx context.Context, user *providers.Entity, credType string) int
+	// IsExpiryEnabled returns whether any rule of the policy expires passwords.
+	IsExpiryEnabled() bool
+	// IsPasswordExpired returns whether the password of the user is older than its maximum age.
+	IsPasswordExpired(ctx context.Context, userID string) (bool, *tidcommon.ServiceError)
+}
+
+// passwordPolicyService is the default implementation of PasswordPolicyServiceInterface.
+type passwordPolicyService struct {
+	policy          policy
+	dictionary      dictionary
+	breachedChecker breachedCheckerInterface
+	entityService   entity.EntityServiceInterface
+	hashService     cryptolib.HashServiceInterface
+	now             func() time.Time
+	logger          *log.Logger
+}
+
+// newPasswordPolicyService creates a new instance of passwordPolicyService.
+func newPasswordPolicyService(p policy, dict dictionary, breachedChecker breachedCheckerInterface,
+	entityService entity.EntityServiceInterface,
+	hashService cryptolib.HashServiceInterface) PasswordPolicyServiceInterface {
+	return &passwordPolicyService{
+		policy:          p,
+		dictionary:      dict,
+		breachedChecker: breachedChecker,
+		entityService:   entityService,
+		hashService:     hashService,
+		now:             time.Now,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ValidateCredential returns the policy violation of a new credential value, or nil if the value is
+// accepted. The checks run from the cheapest to the most expensive, so a password is only sent to the
+// breached-password sources once every local rule has passed.
+func (s *passwordPolicyService) ValidateCredential(ctx context.Context, user *providers.Entity,
+	credType, value string, previous []entity.StoredCredential) *tidcommon.ServiceError {
+	if !s.appliesTo(user, credType) {
+		return nil
+	}
+	r := s.policy.rulesFor(user.OUID, user.Type)
+
+	if svcErr := checkComposition(r, value); svcErr != nil {
+		return svcErr
+	}
+	if r.checkUsernameSimilarity && s.containsIdentity(user, value) {
+		return &ErrorPasswordContainsIdentity
+	}
+	if r.checkDictionary && s.dictionary.contains(value) {
+		return &ErrorPasswordTooCommon
+	}
+	if svcErr := s.checkMinAge(ctx, user, r); svcErr != nil {
+		return svcErr
+	}
+	if svcErr := s.checkHistory(r, value, previous); svcErr != nil {
+		return svcErr
+	}
+	if r.checkBreached {
+		breached, err := s.breachedChecker.IsBreached(ctx, value)
+		if err != nil {
+			// Fail open: an unreachable source must not block every password change.
+			s.logger.Warn(ctx, "Failed to check the password against breached passwords",
+				log.Error(err))
+		} else if breached {
+			return &ErrorPasswordBreached
+		}
+	}
+	return nil
+}
+
+// HistorySize returns the number of replaced credential values to retain for the history check. The
+// current value is stored as the credential itself, so one fewer than the history count is retained.
+func (s *passwordPolicyService) HistorySize(ctx context.Context, user *providers.Entity,
+	credType string) int {
+	if !s.appliesTo(user, credType) {
+		return 0
+	}
+	return max(s.policy.rulesFor(user.OUID, user.Type).historyCount-1, 0)
+}
+
+// IsExpiryEnabled returns whether any rule of the policy expires passwords.
+func (s *passwordPolicyService) IsExpiryEnabled() bool {
+	return s.policy.hasExpiry()
+}
+
+// IsPasswordExpired returns whether the password of the user is older than its maximum age. The age is
+// measured from the last password change, or from the creation of the user if the password has never
+// changed. A password of unknown age is not considered expired.
+func (s *passwordPolicyService) IsPasswordExpired(ctx context.Context,
+	userID string) (bool, *tidcommon.ServiceError) {
+	if !s.policy.hasExpiry() {
+		return false, nil
+	}
+	user, err := s.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return false, &ErrorEntityNotFound
+		}
+		s.logger.Error(ctx, "Failed to retrieve the entity", log.Error(err))
+		return false, &tidcommon.InternalServerError
+	}
+	if user.Category != providers.EntityCategoryUser {
+		return false, nil
+	}
+
+	maxAge := s.policy.rulesFor(user.OUID, user.Type).maxAge
+	if maxAge <= 0 {
+		return false, nil
+	}
+	setAt, ok := passwordSetAt(user.SystemAttributes)
+	if !ok {
+		return false, nil
+	}
+	return s.now().After(setAt.Add(maxAge)), nil
+}
+
+// appliesTo returns whether the policy applies to the credential type of the entity.
+func (s *passwordPolicyService) appliesTo(user *providers.Entity, credType string) bool {
+	return s.policy.enabled && user != nil && user.Category == providers.EntityCategoryUser &&
+		s.policy.credentialTypes[credType]
+}
+
+// checkComposition checks the length and character classes of the password.
+func checkComposition(r rules, value string) *tidcommon.ServiceError {
+	length := utf8.RuneCountInString(value)
+	if length < r.minLength {
+		return ErrorPasswordTooShort.WithParams(map[string]string{"minLength": strconv.Itoa(r.minLength)})
+	}
+	if r.maxLength > 0 && length > r.maxLength {
+		return ErrorPasswordTooLong.WithParams(map[string]string{"maxLength": strconv.Itoa(r.maxLength)})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, c := range value {
+		switch {
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsLower(c):
+			hasLower = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		case !unicode.IsLetter(c) && !unicode.IsSpace(c):
+			hasSpecial = true
+		}
+	}
+	switch {
+	case r.requireUppercase && !hasUpper:
+		return &ErrorPasswordMissingUppercase
+	case r.requireLowercase && !hasLower:
+		return &ErrorPasswordMissingLowercase
+	case r.requireDigit && !hasDigit:
+		return &ErrorPasswordMissingDigit
+	case r.requireSpecial && !hasSpecial:
+		return &ErrorPasswordMissingSpecial
+	}
+	return nil
+}
+
+// containsIdentity returns whether the password contains, ignoring case, one of the identity attributes
+// of the user, or the local part of an email address among them.
+func (s *passwordPolicyService) containsIdentity(user *providers.Entity, value string) bool {
+	if len(user.Attributes) == 0 {
+		return false
+	}
+	var attrs map[string]interface{}
+	if err := json.Unmarshal(user.Attributes, &attrs); err != nil {
+		return false
+	}
+
+	lower := strings.ToLower(value)
+	for _, name := range s.policy.identityAttributes {
+		identity, ok := attrs[name].(string)
+		if !ok {
+			continue
+		}
+		identity = strings.ToLower(strings.TrimSpace(identity))
+		candidates := []string{identity}
+		if local, _, found := strings.Cut(identity, "@"); found {
+			candidates = append(candidates, local)
+		}
+		for _, candidate := range candidates {
+			if utf8.RuneCountInString(candidate) >= minIdentityLength && strings.Contains(lower, candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkMinAge refuses a password change the user makes before the minimum age of their current password
+// has passed. Changes made for the user, by an administrator or a flow, are not limited.
+func (s *passwordPolicyService) checkMinAge(ctx context.Context, user *providers.Entity,
+	r rules) *tidcommon.ServiceError {
+	if r.minAge <= 0 || user.ID == "" || security.GetSubject(ctx) != user.ID {
+		return nil
+	}
+	changedAt, ok := timestampOf(user.SystemAttributes, authnprovidercm.SystemAttrCredentialUpdatedAt)
+	if !ok || !s.now().Before(changedAt.Add(r.minAge)) {
+		return nil
+	}
+	return ErrorPasswordChangedTooRecently.WithParams(map[string]string{
+		"minAgeSeconds": strconv.Itoa(int(r.minAge.Seconds())),
+	})
+}
+
+// checkHistory refuses a password matching one of the most recent passwords of the user, the current one
+// included.
+func (s *passwordPolicyService) checkHistory(r rules, value string,
+	previous []entity.StoredCredential) *tidcommon.ServiceError {
+	if r.historyCount <= 0 {
+		return nil
+	}
+	if len(previous) > r.historyCount {
+		previous = previous[:r.historyCount]
+	}
+	for _, stored := range previous {
+		ref := cryptolib.Credential{
+			Algorithm:  stored.StorageAlgo,
+			Hash:       stored.Value,
+			Parameters: stored.StorageAlgoParams,
+		}
+		if ok, err := s.hashService.Verify([]byte(value), ref); err == nil && ok {
+			return ErrorPasswordReused.WithParams(map[string]string{
+				"historyCount": strconv.Itoa(r.historyCount),
+			})
+		}
+	}
+	return nil
+}
+
+// passwordSetAt returns when the current password of the user was set: at the last change, or at
+// creation if it has never changed.
+func passwordSetAt(systemAttributes json.RawMessage) (time.Time, bool) {
+	if changedAt, ok := timestampOf(systemAttributes, authnprovidercm.SystemAttrCredentialUpdatedAt); ok {
+		return changedAt, true
+	}
+	return timestampOf(systemAttributes, authnprovidercm.SystemAttrCredentialCreatedAt)
+}
+
+// timestampOf returns the RFC 3339 timestamp stored under key in the system attributes.
+func timestampOf(systemAttributes json.RawMessage, key string) (time.Time, bool) {
+	if len(systemAttributes) == 0 {
+		return time.Time{}, false
+	}
+	var attrs map[string]interface{}
+	if err := json.Unmarshal(systemAttributes, &attrs); err != nil {
+		return time.Time{}, false
+	}
+	value, ok := attrs[key].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return at, true
+}
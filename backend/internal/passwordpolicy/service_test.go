@@ -0,0 +1,284 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/hashmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+)
+
+const testUserID = "user-1"
+
+type PasswordPolicyServiceTestSuite struct {
+	suite.Suite
+	mockEntityService *entitymock.EntityServiceInterfaceMock
+	mockHashService   *hashmock.HashServiceInterfaceMock
+	mockBreached      *breachedCheckerInterfaceMock
+	user              *providers.Entity
+	service           *passwordPolicyService
+	now               time.Time
+	ctx               context.Context
+}
+
+func TestPasswordPolicyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PasswordPolicyServiceTestSuite))
+}
+
+func intPtr(v int) *int    { return &v }
+func boolPtr(v bool) *bool { return &v }
+
+func testConfig() config.PasswordPolicyConfig {
+	return config.PasswordPolicyConfig{
+		Enabled: boolPtr(true),
+		Default: config.PasswordPolicyRules{
+			MinLength:               intPtr(8),
+			MaxLength:               intPtr(64),
+			CheckDictionary:         boolPtr(true),
+			CheckUsernameSimilarity: boolPtr(true),
+		},
+	}
+}
+
+func (suite *PasswordPolicyServiceTestSuite) SetupTest() {
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockHashService = hashmock.NewHashServiceInterfaceMock(suite.T())
+	suite.mockBreached = newBreachedCheckerInterfaceMock(suite.T())
+	suite.user = &providers.Entity{
+		ID:         testUserID,
+		Category:   providers.EntityCategoryUser,
+		Type:       "employee",
+		OUID:       "ou-1",
+		Attributes: json.RawMessage(`{"username":"jdoe","email":"john.doe@example.com"}`),
+	}
+	suite.now = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	suite.ctx = context.Background()
+	suite.newService(testConfig())
+}
+
+func (suite *PasswordPolicyServiceTestSuite) newService(cfg config.PasswordPolicyConfig) {
+	dict, err := newDictionary(cfg.DictionaryFile)
+	suite.Require().NoError(err)
+	suite.service = newPasswordPolicyService(newPolicy(cfg), dict, suite.mockBreached,
+		suite.mockEntityService, suite.mockHashService).(*passwordPolicyService)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *PasswordPolicyServiceTestSuite) validate(value string) *tidcommon.ServiceError {
+	return suite.service.ValidateCredential(suite.ctx, suite.user, "password", value, nil)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredential_Composition() {
+	cfg := testConfig()
+	cfg.Default.RequireUppercase = boolPtr(true)
+	cfg.Default.RequireLowercase = boolPtr(true)
+	cfg.Default.RequireDigit = boolPtr(true)
+	cfg.Default.RequireSpecial = boolPtr(true)
+	cfg.Default.MaxLength = intPtr(16)
+	suite.newService(cfg)
+
+	cases := []struct {
+		name  string
+		value string
+		want  *tidcommon.ServiceError
+	}{
+		{"TooShort", "Ab1!", &ErrorPasswordTooShort},
+		{"TooLong", "Abcdefgh1!Abcdefgh", &ErrorPasswordTooLong},
+		{"MissingUppercase", "abcdefg1!", &ErrorPasswordMissingUppercase},
+		{"MissingLowercase", "ABCDEFG1!", &ErrorPasswordMissingLowercase},
+		{"MissingDigit", "Abcdefgh!", &ErrorPasswordMissingDigit},
+		{"MissingSpecial", "Abcdefgh1", &ErrorPasswordMissingSpecial},
+		{"Accepted", "Tr0ub4dor&3", nil},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			svcErr := suite.validate(tc.value)
+			if tc.want == nil {
+				suite.Nil(svcErr)
+				return
+			}
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.want.Code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredential_MinLengthParam() {
+	svcErr := suite.validate("short")
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorPasswordTooShort.Code, svcErr.Code)
+	suite.Equal("8", svcErr.ErrorDescription.Params["minLength"])
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredential_Dictionary() {
+	suite.Equal(ErrorPasswordTooCommon.Code, suite.validate("Password123!").Code)
+	suite.Equal(ErrorPasswordTooCommon.Code, suite.validate("12345678").Code)
+	suite.Nil(suite.validate("correct horse battery"))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredential_IdentitySimilarity() {
+	suite.Equal(ErrorPasswordContainsIdentity.Code, suite.validate("my-JDOE-pass").Code)
+	suite.Equal(ErrorPasswordContainsIdentity.Code, suite.validate("xx-john.doe-xx").Code)
+	suite.Nil(suite.validate("unrelated phrase"))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredential_DisabledOrNotApplicable() {
+	cfg := testConfig()
+	cfg.Enabled = boolPtr(false)
+	suite.newService(cfg)
+	suite.Nil(suite.validate("short"))
+
+	suite.newService(testConfig())
+	suite.Nil(suite.service.ValidateCredential(suite.ctx, suite.user, "pin", "1", nil))
+	suite.user.Category = providers.EntityCategoryApp
+	suite.Nil(suite.validate("short"))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredential_OverridePrecedence() {
+	cfg := testConfig()
+	cfg.Overrides = []config.PasswordPolicyOverride{
+		{OUID: "ou-1", UserType: "employee", Rules: config.PasswordPolicyRules{MinLength: intPtr(14)}},
+		{OUID: "ou-1", Rules: config.PasswordPolicyRules{MinLength: intPtr(12)}},
+		{UserType: "employee", Rules: config.PasswordPolicyRules{MinLength: intPtr(10)}},
+	}
+	suite.newService(cfg)
+
+	suite.Equal(14, suite.service.policy.rulesFor("ou-1", "employee").minLength)
+	suite.Equal(12, suite.service.policy.rulesFor("ou-1", "contractor").minLength)
+	suite.Equal(10, suite.service.policy.rulesFor("ou-2", "employee").minLength)
+	suite.Equal(8, suite.service.policy.rulesFor("ou-2", "contractor").minLength)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredential_History() {
+	cfg := testConfig()
+	cfg.Default.HistoryCount = intPtr(2)
+	suite.newService(cfg)
+	previous := []entity.StoredCredential{{Value: "current"}, {Value: "older"}, {Value: "oldest"}}
+	suite.mockHashService.On("Verify", []byte("reused passphrase"), mock.MatchedBy(
+		func(c cryptolib.Credential) bool { return c.Hash == "older" })).Return(true, nil)
+	suite.mockHashService.On("Verify", mock.Anything, mock.Anything).Return(false, nil)
+
+	svcErr := suite.service.ValidateCredential(suite.ctx, suite.user, "password", "reused passphrase", previous)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorPasswordReused.Code, svcErr.Code)
+	suite.Equal("2", svcErr.ErrorDescription.Params["historyCount"])
+	suite.mockHashService.AssertNumberOfCalls(suite.T(), "Verify", 2)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestHistorySize() {
+	cfg := testConfig()
+	cfg.Default.HistoryCount = intPtr(5)
+	suite.newService(cfg)
+
+	suite.Equal(4, suite.service.HistorySize(suite.ctx, suite.user, "password"))
+	suite.Equal(0, suite.service.HistorySize(suite.ctx, suite.user, "pin"))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredential_MinAge() {
+	cfg := testConfig()
+	cfg.Default.MinAge = intPtr(86400)
+	suite.newService(cfg)
+	suite.user.SystemAttributes = json.RawMessage(`{"credentialUpdatedAt":"2026-10-01T06:00:00Z"}`)
+	selfCtx := security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest(testUserID, "ou-1", "", nil, nil))
+
+	svcErr := suite.service.ValidateCredential(selfCtx, suite.user, "password", "unrelated phrase", nil)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorPasswordChangedTooRecently.Code, svcErr.Code)
+
+	// Changes made on behalf of the user are not limited.
+	suite.Nil(suite.validate("unrelated phrase"))
+
+	suite.now = suite.now.Add(24 * time.Hour)
+	suite.Nil(suite.service.ValidateCredential(selfCtx, suite.user, "password", "unrelated phrase", nil))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredential_Breached() {
+	cfg := testConfig()
+	cfg.Default.CheckBreached = boolPtr(true)
+	suite.newService(cfg)
+
+	suite.mockBreached.On("IsBreached", mock.Anything, "breached phrase").Return(true, nil).Once()
+	suite.Equal(ErrorPasswordBreached.Code, suite.validate("breached phrase").Code)
+
+	// The check fails open when the sources cannot be reached.
+	suite.mockBreached.On("IsBreached", mock.Anything, "unrelated phrase").
+		Return(false, errors.New("unreachable")).Once()
+	suite.Nil(suite.validate("unrelated phrase"))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestIsPasswordExpired() {
+	cfg := testConfig()
+	cfg.Default.MaxAge = intPtr(30 * 86400)
+	suite.newService(cfg)
+	suite.True(suite.service.IsExpiryEnabled())
+
+	cases := []struct {
+		name             string
+		systemAttributes string
+		want             bool
+	}{
+		{"ChangedRecently", `{"credentialUpdatedAt":"2026-09-20T00:00:00Z"}`, false},
+		{"ChangedLongAgo", `{"credentialUpdatedAt":"2026-08-01T00:00:00Z"}`, true},
+		{"CreatedLongAgo", `{"credentialCreatedAt":"2026-08-01T00:00:00Z"}`, true},
+		{"ChangeMarkerWins", `{"credentialCreatedAt":"2026-01-01T00:00:00Z",` +
+			`"credentialUpdatedAt":"2026-09-20T00:00:00Z"}`, false},
+		{"UnknownAge", `{}`, false},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			user := *suite.user
+			user.SystemAttributes = json.RawMessage(tc.systemAttributes)
+			suite.mockEntityService.On("GetEntity", mock.Anything, testUserID).Return(&user, nil).Once()
+
+			expired, svcErr := suite.service.IsPasswordExpired(suite.ctx, testUserID)
+
+			suite.Nil(svcErr)
+			suite.Equal(tc.want, expired)
+		})
+	}
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestIsPasswordExpired_Errors() {
+	cfg := testConfig()
+	cfg.Default.MaxAge = intPtr(86400)
+	suite.newService(cfg)
+
+	suite.mockEntityService.On("GetEntity", mock.Anything, "missing").
+		Return(nil, entity.ErrEntityNotFound).Once()
+	_, svcErr := suite.service.IsPasswordExpired(suite.ctx, "missing")
+	suite.Equal(&ErrorEntityNotFound, svcErr)
+
+	suite.mockEntityService.On("GetEntity", mock.Anything, testUserID).
+		Return(nil, errors.New("db error")).Once()
+	_, svcErr = suite.service.IsPasswordExpired(suite.ctx, testUserID)
+	suite.Equal(&tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestIsExpiryEnabled() {
+	suite.False(suite.service.IsExpiryEnabled())
+
+	cfg := testConfig()
+	cfg.Overrides = []config.PasswordPolicyOverride{
+		{UserType: "employee", Rules: config.PasswordPolicyRules{MaxAge: intPtr(3600)}},
+	}
+	suite.newService(cfg)
+	suite.True(suite.service.IsExpiryEnabled())
+}
@@ -99,6 +99,8 @@ func (e *resourceServerExporter) GetResourceByID(ctx context.Context, id string)
 		OUID:        server.OUID,
 		Delimiter:   server.Delimiter,
 		Resources:   []providers.Resource{},
+
+		AuthorizationDetailTypes: server.AuthorizationDetailTypes,
 	}
 
 	allResources, err := e.service.GetAllResourceList(ctx, id)
@@ -268,6 +270,9 @@ func parseToResourceServer(data []byte) (*providers.ResourceServer, error) {
 	if rs.Type == "" {
 		rs.Type = providers.ResourceServerTypeCustom
 	}
+	if svcErr := validateAuthorizationDetailTypes(rs.AuthorizationDetailTypes); svcErr != nil {
+		return nil, fmt.Errorf("invalid authorization details types for resource server '%s'", rs.Name)
+	}
 
 	// Apply the action kind discriminator rules (mirrors the REST path). The kind is optional for all
 	// resource server types; MCP actions default to "tool" when omitted, and any provided kind must be
@@ -286,6 +286,20 @@ var (
 			DefaultValue: "A resource server with the specified ID already exists",
 		},
 	}
+	// ErrorInvalidAuthorizationDetailTypes is returned when the authorization details types are invalid.
+	ErrorInvalidAuthorizationDetailTypes = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "RES-1024",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.resourceservice.invalid_authorization_detail_types",
+			DefaultValue: "Invalid authorization details types",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.resourceservice.invalid_authorization_detail_types_description",
+			DefaultValue: "Authorization details types must be unique, non-empty " +
+				"and declare a valid JSON schema",
+		},
+	}
 )
 
 // Internal error constants.
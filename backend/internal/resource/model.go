@@ -17,6 +17,8 @@ type ResourceServerResponse struct {
 	OUID        string                       `json:"ouId"`
 	Delimiter   string                       `json:"delimiter"`
 	IsReadOnly  bool                         `json:"isReadOnly"`
+
+	AuthorizationDetailTypes []providers.AuthorizationDetailType `json:"authorizationDetailTypes,omitempty"`
 }
 
 // ResourceResponse represents a resource.
@@ -80,6 +82,8 @@ type CreateResourceServerRequest struct {
 	Type        providers.ResourceServerType `json:"type,omitempty"`
 	OUID        string                       `json:"ouId"                  native:"required"`
 	Delimiter   string                       `json:"delimiter,omitempty"`
+
+	AuthorizationDetailTypes []providers.AuthorizationDetailType `json:"authorizationDetailTypes,omitempty"`
 }
 
 // UpdateResourceServerRequest represents the request to update a resource server.
@@ -88,6 +92,8 @@ type UpdateResourceServerRequest struct {
 	Description string `json:"description,omitempty"`
 	Identifier  string `json:"identifier,omitempty"`
 	OUID        string `json:"ouId"                  native:"required"`
+
+	AuthorizationDetailTypes []providers.AuthorizationDetailType `json:"authorizationDetailTypes,omitempty"`
 }
 
 // CreateResourceRequest represents the request to create a resource.
@@ -6,10 +6,13 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/google/jsonschema-go/jsonschema"
+
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
@@ -17,6 +20,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/utils"
@@ -114,6 +118,7 @@ type resourceService struct {
 	defaultDelimiter   string
 	transactioner      providers.Transactioner
 	dependencyRegistry resourcedependency.Registry
+	auditRecorder      *audit.Recorder
 }
 
 // SetDependencyRegistry injects the dependency registry. Called by servicemanager after the
@@ -210,6 +215,7 @@ func newResourceService(
 	ouService oupkg.OrganizationUnitServiceInterface,
 	resourceStore resourceStoreInterface,
 	transactionerInstance providers.Transactioner,
+	auditRecorder *audit.Recorder,
 ) (ResourceServiceInterface, error) {
 	// Load default delimiter from config
 	defaultDelimiter := getDefaultDelimiter()
@@ -223,6 +229,7 @@ func newResourceService(
 		ouService:        ouService,
 		defaultDelimiter: defaultDelimiter,
 		transactioner:    transactionerInstance,
+		auditRecorder:    auditRecorder,
 	}, nil
 }
 
@@ -319,12 +326,15 @@ func (rs *resourceService) CreateResourceServer(
 			Type:        resourceServer.Type,
 			OUID:        resourceServer.OUID,
 			Delimiter:   resourceServer.Delimiter,
+
+			AuthorizationDetailTypes: resourceServer.AuthorizationDetailTypes,
 		}
 		return nil
 	}); err != nil {
 		return nil, &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Created(ctx, audit.TargetResourceServer, id, createdRS)
 	rs.logger.Debug(ctx, "Successfully created resource server", log.String("id", id))
 	return createdRS, nil
 }
@@ -496,12 +506,15 @@ func (rs *resourceService) UpdateResourceServer(
 			Type:        resourceServer.Type,
 			OUID:        resourceServer.OUID,
 			Delimiter:   resourceServer.Delimiter,
+
+			AuthorizationDetailTypes: resourceServer.AuthorizationDetailTypes,
 		}
 		return nil
 	}); err != nil {
 		return nil, &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Updated(ctx, audit.TargetResourceServer, id, &existingResServer, updatedRS)
 	return updatedRS, nil
 }
 
@@ -517,7 +530,7 @@ func (rs *resourceService) DeleteResourceServer(ctx context.Context, id string)
 		return ErrorImmutableResourceServer.WithParams(map[string]string{"id": id})
 	}
 
-	_, err := rs.resourceStore.GetResourceServer(ctx, id)
+	existingResServer, err := rs.resourceStore.GetResourceServer(ctx, id)
 	if err != nil {
 		if errors.Is(err, errResourceServerNotFound) {
 			return nil // Idempotent delete
@@ -544,6 +557,7 @@ func (rs *resourceService) DeleteResourceServer(ctx context.Context, id string)
 		return &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Deleted(ctx, audit.TargetResourceServer, id, &existingResServer)
 	return nil
 }
 
@@ -642,6 +656,7 @@ func (rs *resourceService) CreateResource(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Created(ctx, audit.TargetResource, id, createdResource)
 	return createdResource, nil
 }
 
@@ -824,6 +839,7 @@ func (rs *resourceService) UpdateResource(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Updated(ctx, audit.TargetResource, id, &currentResource, updatedResource)
 	return updatedResource, nil
 }
 
@@ -854,7 +870,8 @@ func (rs *resourceService) DeleteResource(
 	}
 
 	// Check resource exists
-	if _, err := rs.resourceStore.GetResource(ctx, id, resourceServerID); err != nil {
+	existingResource, err := rs.resourceStore.GetResource(ctx, id, resourceServerID)
+	if err != nil {
 		if errors.Is(err, errResourceNotFound) {
 			return nil // Idempotent delete
 		}
@@ -879,6 +896,7 @@ func (rs *resourceService) DeleteResource(
 		return &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Deleted(ctx, audit.TargetResource, id, &existingResource)
 	return nil
 }
 
@@ -975,6 +993,7 @@ func (rs *resourceService) CreateAction(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Created(ctx, audit.TargetAction, id, createdAction)
 	return createdAction, nil
 }
 
@@ -1173,6 +1192,7 @@ func (rs *resourceService) UpdateAction(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Updated(ctx, audit.TargetAction, id, &currentAction, updatedAction)
 	return updatedAction, nil
 }
 
@@ -1232,6 +1252,13 @@ func (rs *resourceService) DeleteAction(
 		return nil // Idempotent delete
 	}
 
+	var deletedAction *providers.Action
+	if rs.auditRecorder.IsEnabled() {
+		if existingAction, err := rs.resourceStore.GetAction(ctx, id, resourceServerID, resID); err == nil {
+			deletedAction = &existingAction
+		}
+	}
+
 	// Use transaction for write operation
 	if err := rs.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		if err := rs.resourceStore.DeleteAction(txCtx, id, resourceServerID, resID); err != nil {
@@ -1243,6 +1270,7 @@ func (rs *resourceService) DeleteAction(
 		return &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Deleted(ctx, audit.TargetAction, id, deletedAction)
 	return nil
 }
 
@@ -1369,7 +1397,7 @@ func (rs *resourceService) validateResourceServerCreate(
 			return err
 		}
 	}
-	return nil
+	return validateAuthorizationDetailTypes(resourceServer.AuthorizationDetailTypes)
 }
 
 // validateResourceServerUpdate validates the input for updating a resource server.
@@ -1382,7 +1410,7 @@ func (rs *resourceService) validateResourceServerUpdate(
 	if resourceServer.OUID == "" {
 		return &ErrorInvalidRequestFormat
 	}
-	return nil
+	return validateAuthorizationDetailTypes(resourceServer.AuthorizationDetailTypes)
 }
 
 // validateResourceCreate validates the input for creating a resource.
@@ -1484,6 +1512,36 @@ func isValidPermissionCharacter(c rune) bool {
 	return strings.ContainsRune(validPermissionCharacters, c)
 }
 
+// validateAuthorizationDetailTypes validates that the RFC 9396 authorization details types of a
+// resource server are non-empty and unique, and that each declared schema is a resolvable JSON schema.
+func validateAuthorizationDetailTypes(types []providers.AuthorizationDetailType) *tidcommon.ServiceError {
+	seen := make(map[string]struct{}, len(types))
+	for _, t := range types {
+		if strings.TrimSpace(t.Type) == "" {
+			return &ErrorInvalidAuthorizationDetailTypes
+		}
+		if _, dup := seen[t.Type]; dup {
+			return &ErrorInvalidAuthorizationDetailTypes
+		}
+		seen[t.Type] = struct{}{}
+		if len(t.Schema) == 0 {
+			continue
+		}
+		schemaJSON, err := json.Marshal(t.Schema)
+		if err != nil {
+			return &ErrorInvalidAuthorizationDetailTypes
+		}
+		var schema jsonschema.Schema
+		if err := json.Unmarshal(schemaJSON, &schema); err != nil {
+			return &ErrorInvalidAuthorizationDetailTypes
+		}
+		if _, err := schema.Resolve(nil); err != nil {
+			return &ErrorInvalidAuthorizationDetailTypes
+		}
+	}
+	return nil
+}
+
 // validateDelimiter validates delimiter is a single valid delimiter character.
 func validateDelimiter(delimiter string) *tidcommon.ServiceError {
 	if len(delimiter) != 1 {
@@ -144,7 +144,7 @@ func (suite *ResourceServiceTestSuite) SetupTest() {
 	suite.mockOU = new(oumock.OrganizationUnitServiceInterfaceMock)
 	suite.mockTransactioner = &fakeTransactioner{}
 	suite.service, err = newResourceService(
-		suite.mockOU, suite.mockStore, suite.mockTransactioner,
+		suite.mockOU, suite.mockStore, suite.mockTransactioner, nil,
 	)
 	suite.NoError(err)
 	// The resource service is its own dependency provider: deletion consults the registry, which
@@ -186,7 +186,7 @@ func (suite *ResourceServiceTestSuite) TestNewResourceService_InvalidDelimiter()
 	mockOU := new(oumock.OrganizationUnitServiceInterfaceMock)
 
 	mockTransactioner := &fakeTransactioner{}
-	service, err := newResourceService(mockOU, mockStore, mockTransactioner)
+	service, err := newResourceService(mockOU, mockStore, mockTransactioner, nil)
 
 	suite.Error(err)
 	suite.Nil(service)
@@ -316,6 +316,31 @@ func (suite *ResourceServiceTestSuite) TestCreateResourceServer_ValidationErrors
 			},
 			expectedError: ErrorInvalidDelimiter,
 		},
+		{
+			name: "DuplicateAuthorizationDetailType",
+			resourceServer: providers.ResourceServer{
+				Name:       "test-rs",
+				Identifier: "test-identifier",
+				OUID:       "ou-123",
+				AuthorizationDetailTypes: []providers.AuthorizationDetailType{
+					{Type: "payment_initiation"},
+					{Type: "payment_initiation"},
+				},
+			},
+			expectedError: ErrorInvalidAuthorizationDetailTypes,
+		},
+		{
+			name: "InvalidAuthorizationDetailSchema",
+			resourceServer: providers.ResourceServer{
+				Name:       "test-rs",
+				Identifier: "test-identifier",
+				OUID:       "ou-123",
+				AuthorizationDetailTypes: []providers.AuthorizationDetailType{
+					{Type: "payment_initiation", Schema: map[string]interface{}{"type": 42}},
+				},
+			},
+			expectedError: ErrorInvalidAuthorizationDetailTypes,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4814,7 +4839,7 @@ func (suite *ResourceServiceTestSuite) TestValidatePermissions() {
 			// Create a fresh service instance with the fresh mocks
 			mockTransactioner := &fakeTransactioner{}
 			svc, err := newResourceService(
-				mockOU, mockStore, mockTransactioner,
+				mockOU, mockStore, mockTransactioner, nil,
 			)
 			suite.Require().NoError(err)
 
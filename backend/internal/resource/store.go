@@ -73,7 +73,8 @@ type resourceStore struct {
 
 // resourceServerProperties represents the JSON structure of PROPERTIES column.
 type resourceServerProperties struct {
-	Delimiter string `json:"delimiter"`
+	Delimiter                string                              `json:"delimiter"`
+	AuthorizationDetailTypes []providers.AuthorizationDetailType `json:"authorizationDetailTypes,omitempty"`
 }
 
 // actionProperties represents the JSON structure of the ACTION.PROPERTIES column.
@@ -942,6 +943,7 @@ func resolveProperties(row map[string]interface{}, rs *providers.ResourceServer)
 		if len(propsBytes) > 0 {
 			if err := json.Unmarshal(propsBytes, &props); err == nil {
 				rs.Delimiter = props.Delimiter
+				rs.AuthorizationDetailTypes = props.AuthorizationDetailTypes
 			}
 		}
 	}
@@ -949,7 +951,10 @@ func resolveProperties(row map[string]interface{}, rs *providers.ResourceServer)
 
 // buildPropertiesJSON builds the PROPERTIES JSON for a providers.ResourceServer.
 func buildPropertiesJSON(rs providers.ResourceServer) interface{} {
-	properties := resourceServerProperties{Delimiter: rs.Delimiter}
+	properties := resourceServerProperties{
+		Delimiter:                rs.Delimiter,
+		AuthorizationDetailTypes: rs.AuthorizationDetailTypes,
+	}
 	if propsJSON, err := json.Marshal(properties); err == nil {
 		return propsJSON
 	}
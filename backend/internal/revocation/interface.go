@@ -13,3 +13,11 @@ import "context"
 type CriteriaRevoker interface {
 	RevokeByCriteria(ctx context.Context, revocation CriteriaRevocation) error
 }
+
+// CriteriaRevocationNotifier is told about each criteria-based revocation once it is persisted, so
+// subsystems outside OAuth, such as the Shared Signals transmitter, can react to it. A nil notifier
+// disables notification. The call runs on the revocation path, so implementations must not block on
+// delivery.
+type CriteriaRevocationNotifier interface {
+	NotifyCriteriaRevoked(ctx context.Context, revocation CriteriaRevocation)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/group"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/utils"
@@ -46,6 +47,7 @@ type roleAssignmentService struct {
 	entityTypeService entitytype.EntityTypeServiceInterface
 	transactioner     providers.Transactioner
 	authzService      sysauthz.SystemAuthorizationServiceInterface
+	auditRecorder     *audit.Recorder
 }
 
 // newRoleAssignmentService creates a new instance of roleAssignmentService.
@@ -56,6 +58,7 @@ func newRoleAssignmentService(
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	transactioner providers.Transactioner,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	auditRecorder *audit.Recorder,
 ) RoleAssignmentServiceInterface {
 	return &roleAssignmentService{
 		roleStore:         roleStore,
@@ -64,6 +67,7 @@ func newRoleAssignmentService(
 		entityTypeService: entityTypeService,
 		transactioner:     transactioner,
 		authzService:      authzService,
+		auditRecorder:     auditRecorder,
 	}
 }
 
@@ -242,7 +246,7 @@ func (as *roleAssignmentService) AddAssignments(
 	ctx context.Context, id string, assignments []RoleAssignment) *tidcommon.ServiceError {
 	return as.modifyAssignments(ctx, id, assignments,
 		as.roleStore.AddAssignments,
-		"add assignments to role", "added assignments to role")
+		"role.assignments.add", "add assignments to role", "added assignments to role")
 }
 
 // RemoveAssignments removes assignments from a role.
@@ -251,18 +255,18 @@ func (as *roleAssignmentService) RemoveAssignments(
 	ctx context.Context, id string, assignments []RoleAssignment) *tidcommon.ServiceError {
 	return as.modifyAssignments(ctx, id, assignments,
 		as.roleStore.RemoveAssignments,
-		"remove assignments from role", "removed assignments from role")
+		"role.assignments.remove", "remove assignments from role", "removed assignments from role")
 }
 
 // modifyAssignments is the shared implementation for AddAssignments and RemoveAssignments. Both
 // directions carry the same requirement, since assigning conveys the role's permissions to the
-// assignee.
+// assignee. Successful changes are recorded under auditAction.
 func (as *roleAssignmentService) modifyAssignments(
 	ctx context.Context,
 	id string,
 	assignments []RoleAssignment,
 	storeOp func(context.Context, string, []RoleAssignment) error,
-	action, successAction string,
+	auditAction, action, successAction string,
 ) *tidcommon.ServiceError {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, assignmentLoggerComponentName))
 	logger.Debug(ctx, "Modifying role assignments",
@@ -297,6 +301,13 @@ func (as *roleAssignmentService) modifyAssignments(
 		return &tidcommon.InternalServerError
 	}
 
+	as.auditRecorder.Record(ctx, audit.Entry{
+		Operation:  audit.OperationUpdate,
+		TargetType: audit.TargetRole,
+		TargetID:   id,
+		Action:     auditAction,
+		After:      map[string]interface{}{"assignments": normalized},
+	})
 	logger.Debug(ctx, "Successfully modified role assignments",
 		log.String("action", successAction), log.String("id", id))
 	return nil
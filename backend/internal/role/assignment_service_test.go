@@ -50,6 +50,7 @@ func (suite *RoleAssignmentServiceTestSuite) SetupTest() {
 		suite.mockEntityTypeService,
 		suite.transactioner,
 		newAllowAllRoleAuthz(suite.T()),
+		nil,
 	)
 }
 
@@ -15,6 +15,7 @@ import (
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -28,6 +29,7 @@ func Initialize(
 	resourceService resourcepkg.ResourceServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	observabilitySvc providers.ObservabilityProvider,
 ) (
 	RoleServiceInterface, RoleAssignmentServiceInterface, oupkg.OURoleResolver,
 	declarativeresource.ResourceExporter, error,
@@ -39,9 +41,10 @@ func Initialize(
 	}
 
 	// Step 2: Create service with store
+	auditRecorder := audit.NewRecorder(observabilitySvc)
 	roleService := newRoleService(
 		roleStore, entityService, groupService, ouService, resourceService,
-		transactioner, authzService,
+		transactioner, authzService, auditRecorder,
 	)
 
 	// Step 3: Load declarative resources into store (if applicable)
@@ -52,7 +55,7 @@ func Initialize(
 	}
 
 	assignmentService := newRoleAssignmentService(
-		roleStore, entityService, groupService, entityTypeService, transactioner, authzService,
+		roleStore, entityService, groupService, entityTypeService, transactioner, authzService, auditRecorder,
 	)
 	roleHandler := newRoleHandler(roleService, assignmentService)
 	registerRoutes(mux, roleHandler)
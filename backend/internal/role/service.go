@@ -18,6 +18,7 @@ import (
 	resourcepkg "github.com/thunder-id/thunderid/internal/resource"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
@@ -62,6 +63,7 @@ type roleService struct {
 	resourceService resourcepkg.ResourceServiceInterface
 	transactioner   providers.Transactioner
 	authzService    sysauthz.SystemAuthorizationServiceInterface
+	auditRecorder   *audit.Recorder
 }
 
 // newRoleService creates a new instance of RoleService with injected dependencies.
@@ -73,6 +75,7 @@ func newRoleService(
 	resourceService resourcepkg.ResourceServiceInterface,
 	transactioner providers.Transactioner,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	auditRecorder *audit.Recorder,
 ) RoleServiceInterface {
 	return &roleService{
 		roleStore:       roleStore,
@@ -82,6 +85,7 @@ func newRoleService(
 		resourceService: resourceService,
 		transactioner:   transactioner,
 		authzService:    authzService,
+		auditRecorder:   auditRecorder,
 	}
 }
 
@@ -249,6 +253,7 @@ func (rs *roleService) CreateRole(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Created(ctx, audit.TargetRole, id, serviceRole)
 	logger.Debug(ctx, "Successfully created role", log.String("id", id), log.String("name", role.Name))
 	return serviceRole, nil
 }
@@ -352,6 +357,8 @@ func (rs *roleService) UpdateRoleWithPermissions(
 		return nil, &ErrorRoleNameConflict
 	}
 
+	previousRole := rs.getRoleForAudit(ctx, id, logger)
+
 	err = rs.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		return rs.roleStore.UpdateRole(txCtx, id, role)
 	})
@@ -361,15 +368,18 @@ func (rs *roleService) UpdateRoleWithPermissions(
 		return nil, &tidcommon.InternalServerError
 	}
 
-	logger.Debug(ctx, "Successfully updated role", log.String("id", id), log.String("name", role.Name))
-	return &RoleWithPermissions{
+	updatedRole := &RoleWithPermissions{
 		ID:          id,
 		Name:        role.Name,
 		Description: role.Description,
 		OUID:        role.OUID,
-		OUHandle:    ou.Handle,
 		Permissions: role.Permissions,
-	}, nil
+	}
+	rs.auditRecorder.Updated(ctx, audit.TargetRole, id, previousRole, updatedRole)
+	updatedRole.OUHandle = ou.Handle
+
+	logger.Debug(ctx, "Successfully updated role", log.String("id", id), log.String("name", role.Name))
+	return updatedRole, nil
 }
 
 // DeleteRole delete the specified role by its id.
@@ -397,6 +407,8 @@ func (rs *roleService) DeleteRole(ctx context.Context, id string) *tidcommon.Ser
 		return &ErrorImmutableRole
 	}
 
+	previousRole := rs.getRoleForAudit(ctx, id, logger)
+
 	// Delete all assignments for the role before deleting the role itself (cascade delete).
 	// The ROLE_ASSIGNMENT table does not have a FK constraint on ROLE_ID to allow assignments
 	// for roles that live in the file-based store, so cascade delete is handled here in code.
@@ -411,10 +423,25 @@ func (rs *roleService) DeleteRole(ctx context.Context, id string) *tidcommon.Ser
 		return &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.Deleted(ctx, audit.TargetRole, id, previousRole)
 	logger.Debug(ctx, "Successfully deleted role", log.String("id", id))
 	return nil
 }
 
+// getRoleForAudit loads the current state of a role as the before-snapshot of an audit event.
+// Returns nil when auditing is disabled or the role cannot be read.
+func (rs *roleService) getRoleForAudit(ctx context.Context, id string, logger *log.Logger) *RoleWithPermissions {
+	if !rs.auditRecorder.IsEnabled() {
+		return nil
+	}
+	role, err := rs.roleStore.GetRole(ctx, id)
+	if err != nil {
+		logger.Warn(ctx, "Failed to load role for audit", log.String("id", id), log.Error(err))
+		return nil
+	}
+	return &role
+}
+
 // GetAuthorizedPermissionsByResourceServer checks which requested permissions are authorized for the entity
 // based on roles, scoped to a resource server when provided.
 func (rs *roleService) GetAuthorizedPermissionsByResourceServer(
@@ -92,6 +92,7 @@ func (suite *RoleServiceTestSuite) SetupTest() {
 		suite.mockResourceService,
 		suite.transactioner,
 		newAllowAllRoleAuthz(suite.T()),
+		nil,
 	)
 }
 
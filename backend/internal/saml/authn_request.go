@@ -0,0 +1,165 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/xmldsig"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// errMessageTooLarge is returned when a decoded protocol message exceeds maxMessageSize.
+var errMessageTooLarge = errors.New("SAML message exceeds the maximum size")
+
+// errRequestNotSigned is returned when a signature check is requested on an unsigned message.
+var errRequestNotSigned = errors.New("SAML request is not signed")
+
+// decodeMessage decodes an encoded protocol message as carried on the given binding. Redirect
+// binding messages are DEFLATE compressed before base64 encoding; POST binding messages are not.
+func decodeMessage(binding, encoded string) ([]byte, error) {
+	// POST bodies are commonly wrapped at 76 columns.
+	raw, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 encoding: %w", err)
+	}
+
+	if binding != bindingHTTPRedirect {
+		if len(raw) > maxMessageSize {
+			return nil, errMessageTooLarge
+		}
+		return raw, nil
+	}
+
+	reader := flate.NewReader(bytes.NewReader(raw))
+	defer func() { _ = reader.Close() }()
+	data, err := io.ReadAll(io.LimitReader(reader, maxMessageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("invalid DEFLATE encoding: %w", err)
+	}
+	if len(data) > maxMessageSize {
+		return nil, errMessageTooLarge
+	}
+	return data, nil
+}
+
+// parseAuthnRequest parses an AuthnRequest document. It returns the parsed request together with
+// the root element so an enveloped signature can be verified once the issuer is known.
+func parseAuthnRequest(data []byte) (*authnRequest, *xmldsig.Element, error) {
+	root, err := xmldsig.Parse(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !root.Is(namespaceProtocol, "AuthnRequest") {
+		return nil, nil, errors.New("message is not an AuthnRequest")
+	}
+	if root.AttrValue("Version") != samlVersion {
+		return nil, nil, errors.New("unsupported SAML version")
+	}
+
+	request := &authnRequest{
+		ID:              root.AttrValue("ID"),
+		Destination:     root.AttrValue("Destination"),
+		ACSURL:          root.AttrValue("AssertionConsumerServiceURL"),
+		ProtocolBinding: root.AttrValue("ProtocolBinding"),
+		IsPassive:       parseBoolean(root.AttrValue("IsPassive")),
+		ForceAuthn:      parseBoolean(root.AttrValue("ForceAuthn")),
+		Signed:          xmldsig.HasSignature(root),
+	}
+	if request.ID == "" {
+		return nil, nil, errors.New("AuthnRequest ID is missing")
+	}
+
+	issueInstant, err := time.Parse(time.RFC3339Nano, root.AttrValue("IssueInstant"))
+	if err != nil {
+		return nil, nil, errors.New("AuthnRequest IssueInstant is invalid")
+	}
+	request.IssueInstant = issueInstant
+
+	issuer := root.FindChild(namespaceAssertion, "Issuer")
+	if issuer == nil || strings.TrimSpace(issuer.Text()) == "" {
+		return nil, nil, errors.New("AuthnRequest Issuer is missing")
+	}
+	request.Issuer = strings.TrimSpace(issuer.Text())
+
+	if policy := root.FindChild(namespaceProtocol, "NameIDPolicy"); policy != nil {
+		request.NameIDPolicyFormat = policy.AttrValue("Format")
+	}
+	return request, root, nil
+}
+
+// parseBoolean parses an xs:boolean attribute value. Absent and malformed values are false.
+func parseBoolean(value string) bool {
+	return value == "true" || value == "1"
+}
+
+// verifyRedirectSignature verifies the query string signature of a Redirect binding request. The
+// signed octet string is rebuilt from the parameters exactly as encoded by the service provider,
+// since re-encoding them may not reproduce the signed bytes.
+func verifyRedirectSignature(ctx context.Context, cryptoProvider providers.RuntimeCryptoProvider,
+	rawQuery string, certificate *x509.Certificate) error {
+	values := map[string]string{}
+	for _, pair := range strings.Split(rawQuery, "&") {
+		name, value, _ := strings.Cut(pair, "=")
+		if _, seen := values[name]; seen {
+			return fmt.Errorf("duplicate query parameter %q", name)
+		}
+		values[name] = value
+	}
+
+	encodedSignature, ok := values[paramSignature]
+	if !ok {
+		return errRequestNotSigned
+	}
+	encodedSigAlg, ok := values[paramSigAlg]
+	if !ok {
+		return errors.New("SigAlg parameter is missing")
+	}
+
+	signed := paramSAMLRequest + "=" + values[paramSAMLRequest]
+	if relayState, ok := values[paramRelayState]; ok {
+		signed += "&" + paramRelayState + "=" + relayState
+	}
+	signed += "&" + paramSigAlg + "=" + encodedSigAlg
+
+	sigAlg, err := url.QueryUnescape(encodedSigAlg)
+	if err != nil {
+		return fmt.Errorf("invalid SigAlg encoding: %w", err)
+	}
+	alg, err := xmldsig.AlgorithmFromURI(sigAlg)
+	if err != nil {
+		return err
+	}
+	signatureB64, err := url.QueryUnescape(encodedSignature)
+	if err != nil {
+		return fmt.Errorf("invalid Signature encoding: %w", err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(signatureB64)
+	if err != nil {
+		return fmt.Errorf("invalid Signature encoding: %w", err)
+	}
+
+	return cryptoProvider.Verify(ctx, providers.KeyRef{PublicKey: certificate.PublicKey}, alg,
+		[]byte(signed), signature)
+}
+
+// parseCertificate parses the PEM-encoded certificate registered on a SAML profile.
+func parseCertificate(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("certificate is not PEM encoded")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
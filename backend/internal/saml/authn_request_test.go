@@ -0,0 +1,136 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/xmldsig"
+)
+
+type AuthnRequestTestSuite struct {
+	suite.Suite
+}
+
+func TestAuthnRequestTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthnRequestTestSuite))
+}
+
+func (s *AuthnRequestTestSuite) TestDecodeMessage_RedirectRoundTrip() {
+	data := newAuthnRequest(time.Now()).Bytes()
+
+	decoded, err := decodeMessage(bindingHTTPRedirect, encodeRedirect(data))
+
+	s.NoError(err)
+	s.Equal(data, decoded)
+}
+
+func (s *AuthnRequestTestSuite) TestDecodeMessage_PostToleratesLineBreaks() {
+	data := newAuthnRequest(time.Now()).Bytes()
+	encoded := base64.StdEncoding.EncodeToString(data)
+	wrapped := encoded[:20] + "\r\n" + encoded[20:]
+
+	decoded, err := decodeMessage(bindingHTTPPost, wrapped)
+
+	s.NoError(err)
+	s.Equal(data, decoded)
+}
+
+func (s *AuthnRequestTestSuite) TestDecodeMessage_RejectsOversizedMessages() {
+	// A highly compressible payload must not inflate past the limit.
+	large := bytes.Repeat([]byte("a"), maxMessageSize+1)
+
+	_, err := decodeMessage(bindingHTTPRedirect, encodeRedirect(large))
+	s.ErrorIs(err, errMessageTooLarge)
+
+	_, err = decodeMessage(bindingHTTPPost, base64.StdEncoding.EncodeToString(large))
+	s.ErrorIs(err, errMessageTooLarge)
+}
+
+func (s *AuthnRequestTestSuite) TestParseAuthnRequest_Fields() {
+	root := newAuthnRequest(time.Now()).SetAttr("IsPassive", "1").SetAttr("ForceAuthn", "true")
+	root.AddChild(xmldsig.NewElement(prefixProtocol, "NameIDPolicy")).SetAttr("Format", "urn:format")
+
+	request, parsed, err := parseAuthnRequest(root.Bytes())
+
+	s.Require().NoError(err)
+	s.NotNil(parsed)
+	s.Equal(testRequestID, request.ID)
+	s.Equal(testSPEntityID, request.Issuer)
+	s.Equal(testACSURL, request.ACSURL)
+	s.Equal(testSSOURL, request.Destination)
+	s.True(request.IsPassive)
+	s.True(request.ForceAuthn)
+	s.False(request.Signed)
+	s.Equal("urn:format", request.NameIDPolicyFormat)
+}
+
+func (s *AuthnRequestTestSuite) TestParseAuthnRequest_Invalid() {
+	testCases := map[string]string{
+		"WrongRoot": `<samlp:Response xmlns:samlp="` + namespaceProtocol + `" ID="_1" Version="2.0"/>`,
+		"WrongVersion": `<samlp:AuthnRequest xmlns:samlp="` + namespaceProtocol + `" ID="_1" Version="1.1" ` +
+			`IssueInstant="2026-01-01T00:00:00Z"/>`,
+		"MissingID": `<samlp:AuthnRequest xmlns:samlp="` + namespaceProtocol + `" Version="2.0" ` +
+			`IssueInstant="2026-01-01T00:00:00Z"/>`,
+		"BadInstant": `<samlp:AuthnRequest xmlns:samlp="` + namespaceProtocol + `" ID="_1" Version="2.0" ` +
+			`IssueInstant="yesterday"/>`,
+		"MissingIssuer": `<samlp:AuthnRequest xmlns:samlp="` + namespaceProtocol + `" ID="_1" Version="2.0" ` +
+			`IssueInstant="2026-01-01T00:00:00Z"/>`,
+		"DTD": `<!DOCTYPE x [<!ENTITY a "b">]><samlp:AuthnRequest xmlns:samlp="` + namespaceProtocol + `"/>`,
+	}
+	for name, document := range testCases {
+		s.Run(name, func() {
+			_, _, err := parseAuthnRequest([]byte(document))
+			s.Error(err)
+		})
+	}
+}
+
+func (s *AuthnRequestTestSuite) TestVerifyRedirectSignature_Rejects() {
+	cert, err := parseCertificate(pemCertificate(s.T()))
+	s.Require().NoError(err)
+
+	testCases := map[string]string{
+		"Unsigned":       "SAMLRequest=abc&RelayState=r",
+		"MissingSigAlg":  "SAMLRequest=abc&Signature=c2ln",
+		"DuplicateParam": "SAMLRequest=abc&SAMLRequest=def&SigAlg=x&Signature=c2ln",
+		"UnsupportedSigAlg": "SAMLRequest=abc&SigAlg=http%3A%2F%2Fwww.w3.org%2F2000%2F09%2Fxmldsig%23rsa-sha1" +
+			"&Signature=c2ln",
+		"BadSignatureB64": "SAMLRequest=abc&SigAlg=http%3A%2F%2Fwww.w3.org%2F2001%2F04%2Fxmldsig-more%23ecdsa-sha256" +
+			"&Signature=%%%",
+	}
+	for name, rawQuery := range testCases {
+		s.Run(name, func() {
+			s.Error(verifyRedirectSignature(context.Background(), nil, rawQuery, cert))
+		})
+	}
+}
+
+func (s *AuthnRequestTestSuite) TestParseCertificate_Invalid() {
+	_, err := parseCertificate("not a certificate")
+	s.Error(err)
+
+	_, err = parseCertificate(strings.Replace(pemCertificate(s.T()), "CERTIFICATE", "PUBLIC KEY", 2))
+	s.Error(err)
+}
+
+// pemCertificate returns a PEM-encoded self-signed certificate for a fresh key.
+func pemCertificate(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: newTestCertificate(t, key)}))
+}
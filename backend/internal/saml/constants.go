@@ -0,0 +1,86 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import "time"
+
+// Endpoint paths served by the SAML identity provider.
+const (
+	ssoPath      = "/saml2/sso"
+	metadataPath = "/saml2/metadata"
+	responsePath = "/saml2/response"
+)
+
+// SAML 2.0 namespaces and the prefixes used when emitting them.
+const (
+	namespaceProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	namespaceAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	namespaceMetadata  = "urn:oasis:names:tc:SAML:2.0:metadata"
+
+	prefixProtocol  = "samlp"
+	prefixAssertion = "saml"
+	prefixMetadata  = "md"
+)
+
+// SAML 2.0 binding identifiers.
+const (
+	bindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	bindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+)
+
+// Status codes placed on issued responses.
+const (
+	statusSuccess             = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	statusRequester           = "urn:oasis:names:tc:SAML:2.0:status:Requester"
+	statusResponder           = "urn:oasis:names:tc:SAML:2.0:status:Responder"
+	statusAuthnFailed         = "urn:oasis:names:tc:SAML:2.0:status:AuthnFailed"
+	statusNoPassive           = "urn:oasis:names:tc:SAML:2.0:status:NoPassive"
+	statusInvalidNameIDPolicy = "urn:oasis:names:tc:SAML:2.0:status:InvalidNameIDPolicy"
+)
+
+const (
+	// samlVersion is the only protocol version accepted and issued.
+	samlVersion = "2.0"
+	// confirmationMethodBearer is the bearer subject confirmation method.
+	confirmationMethodBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	// authnContextUnspecified is the authentication context class used when the flow reports none.
+	authnContextUnspecified = "urn:oasis:names:tc:SAML:2.0:ac:classes:unspecified"
+	// attributeNameFormatBasic is the name format of emitted attributes.
+	attributeNameFormatBasic = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+	// defaultEmailAttribute is the user attribute used for an emailAddress NameID when the profile
+	// does not name one.
+	defaultEmailAttribute = "email"
+)
+
+// HTTP parameters of the Redirect and POST bindings.
+const (
+	paramSAMLRequest  = "SAMLRequest"
+	paramSAMLResponse = "SAMLResponse"
+	paramRelayState   = "RelayState"
+	paramSigAlg       = "SigAlg"
+	paramSignature    = "Signature"
+	paramResponseID   = "id"
+)
+
+const (
+	// maxMessageSize bounds the decoded size of an inbound protocol message.
+	maxMessageSize = 64 * 1024
+	// maxRelayStateLength is the RelayState limit from the SAML bindings specification.
+	maxRelayStateLength = 80
+	// clockSkew is tolerated between the service provider's clock and ours.
+	clockSkew = 2 * time.Minute
+	// responseTTLSeconds bounds how long a built response waits for the browser to collect it.
+	responseTTLSeconds = 120
+	// attributeCacheTTLBufferSeconds pads the attribute cache lifetime past the request lifetime so
+	// the attributes are still present when the callback arrives.
+	attributeCacheTTLBufferSeconds = 60
+)
+
+// Error codes carried by SAMLError.
+const (
+	// ErrorInvalidRequest reports a request the IdP cannot process or trust.
+	ErrorInvalidRequest = "invalid_request"
+	// ErrorServerError reports an internal failure.
+	ErrorServerError = "server_error"
+)
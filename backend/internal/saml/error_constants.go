@@ -0,0 +1,10 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import "errors"
+
+// ErrSAMLRequestNotFound is returned when a pending SSO request or built response is not found in the
+// store.
+var ErrSAMLRequestNotFound = errors.New("SAML request not found")
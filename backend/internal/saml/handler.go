@@ -0,0 +1,120 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// SAMLHandlerInterface defines the HTTP handlers of the SAML identity provider.
+type SAMLHandlerInterface interface {
+	HandleSSORequest(w http.ResponseWriter, r *http.Request)
+	HandleMetadataRequest(w http.ResponseWriter, r *http.Request)
+	HandleResponseRequest(w http.ResponseWriter, r *http.Request)
+}
+
+// samlHandler implements the SAMLHandlerInterface.
+type samlHandler struct {
+	cfg         serviceConfig
+	samlService SAMLServiceInterface
+	logger      *log.Logger
+}
+
+// newSAMLHandler creates a new instance of samlHandler.
+func newSAMLHandler(samlService SAMLServiceInterface, cfg serviceConfig) SAMLHandlerInterface {
+	return &samlHandler{
+		cfg:         cfg,
+		samlService: samlService,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SAMLHandler")),
+	}
+}
+
+// HandleSSORequest handles an AuthnRequest on GET (HTTP-Redirect binding) or POST (HTTP-POST
+// binding) /saml2/sso. The browser is sent to the login page, or straight to the response page when
+// the IdP answers without authenticating. Requests that cannot be answered to a verified service
+// provider end on the error page.
+func (h *samlHandler) HandleSSORequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	request := &SSORequest{
+		Headers:     utils.SanitizeRawMultiValueStringMap(r.Header),
+		QueryParams: utils.SanitizeRawMultiValueStringMap(r.URL.Query()),
+	}
+	if r.Method == http.MethodPost {
+		if err := r.ParseForm(); err != nil {
+			h.redirectToErrorPage(w, r, ErrorInvalidRequest, "Failed to parse request body")
+			return
+		}
+		request.Binding = bindingHTTPPost
+		request.SAMLRequest = r.PostForm.Get(paramSAMLRequest)
+		request.RelayState = r.PostForm.Get(paramRelayState)
+	} else {
+		query := r.URL.Query()
+		request.Binding = bindingHTTPRedirect
+		request.SAMLRequest = query.Get(paramSAMLRequest)
+		request.RelayState = query.Get(paramRelayState)
+		request.RawQuery = r.URL.RawQuery
+	}
+
+	result, samlErr := h.samlService.InitiateSSO(ctx, request)
+	if samlErr != nil {
+		h.redirectToErrorPage(w, r, samlErr.Code, samlErr.Message)
+		return
+	}
+	if result.ResponseURL != "" {
+		http.Redirect(w, r, result.ResponseURL, http.StatusFound)
+		return
+	}
+	http.Redirect(w, r, result.LoginURL, http.StatusFound)
+}
+
+// HandleMetadataRequest handles a GET /saml2/metadata request.
+func (h *samlHandler) HandleMetadataRequest(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(sysconst.ContentTypeHeaderName, metadataContentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(h.samlService.GetMetadata(r.Context())); err != nil {
+		h.logger.Error(r.Context(), "Failed to write SAML metadata", log.Error(err))
+	}
+}
+
+// HandleResponseRequest handles a GET /saml2/response request, delivering a built response to the
+// service provider through the HTTP-POST binding.
+func (h *samlHandler) HandleResponseRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	form, samlErr := h.samlService.TakeResponse(ctx, r.URL.Query().Get(paramResponseID))
+	if samlErr != nil {
+		h.redirectToErrorPage(w, r, samlErr.Code, samlErr.Message)
+		return
+	}
+	if err := writePostForm(w, form); err != nil {
+		h.logger.Error(ctx, "Failed to render SAML response page", log.Error(err))
+	}
+}
+
+// redirectToErrorPage redirects the browser to the Gate error page.
+func (h *samlHandler) redirectToErrorPage(w http.ResponseWriter, r *http.Request, code, msg string) {
+	errorPageURL := (&url.URL{
+		Scheme: h.cfg.GateClient.Scheme,
+		Host:   fmt.Sprintf("%s:%d", h.cfg.GateClient.Hostname, h.cfg.GateClient.Port),
+		Path:   h.cfg.GateClient.ErrorPath,
+	}).String()
+
+	redirectURL, err := utils.GetURIWithQueryParams(errorPageURL, map[string]string{
+		"errorCode":    code,
+		"errorMessage": msg,
+	})
+	if err != nil {
+		h.logger.Error(r.Context(), "Failed to construct error page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
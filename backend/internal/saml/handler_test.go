@@ -0,0 +1,130 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SAMLHandlerTestSuite struct {
+	suite.Suite
+	mockService *SAMLServiceInterfaceMock
+	handler     SAMLHandlerInterface
+}
+
+func TestSAMLHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SAMLHandlerTestSuite))
+}
+
+func (s *SAMLHandlerTestSuite) SetupTest() {
+	s.mockService = NewSAMLServiceInterfaceMock(s.T())
+	s.handler = newSAMLHandler(s.mockService, testServiceConfig())
+}
+
+func (s *SAMLHandlerTestSuite) TestHandleSSORequest_RedirectBinding() {
+	rawQuery := "SAMLRequest=abc%2B&RelayState=relay-1&SigAlg=alg&Signature=sig"
+	s.mockService.EXPECT().InitiateSSO(mock.Anything, mock.MatchedBy(func(req *SSORequest) bool {
+		return req.Binding == bindingHTTPRedirect &&
+			req.SAMLRequest == "abc+" &&
+			req.RelayState == "relay-1" &&
+			req.RawQuery == rawQuery
+	})).Return(&SSOResult{LoginURL: "https://localhost:5190/gate/signin?authId=auth-1"}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, ssoPath+"?"+rawQuery, nil)
+	w := httptest.NewRecorder()
+	s.handler.HandleSSORequest(w, req)
+
+	s.Equal(http.StatusFound, w.Code)
+	s.Equal("https://localhost:5190/gate/signin?authId=auth-1", w.Header().Get("Location"))
+}
+
+func (s *SAMLHandlerTestSuite) TestHandleSSORequest_PostBinding() {
+	s.mockService.EXPECT().InitiateSSO(mock.Anything, mock.MatchedBy(func(req *SSORequest) bool {
+		return req.Binding == bindingHTTPPost &&
+			req.SAMLRequest == "abc+" &&
+			req.RelayState == "relay-1" &&
+			req.RawQuery == ""
+	})).Return(&SSOResult{ResponseURL: testResponseURL + "?id=resp-1"}, nil)
+
+	form := url.Values{paramSAMLRequest: {"abc+"}, paramRelayState: {"relay-1"}}
+	req := httptest.NewRequest(http.MethodPost, ssoPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	s.handler.HandleSSORequest(w, req)
+
+	s.Equal(http.StatusFound, w.Code)
+	s.Equal(testResponseURL+"?id=resp-1", w.Header().Get("Location"))
+}
+
+func (s *SAMLHandlerTestSuite) TestHandleSSORequest_ErrorRedirectsToErrorPage() {
+	s.mockService.EXPECT().InitiateSSO(mock.Anything, mock.Anything).
+		Return(nil, &SAMLError{Code: ErrorInvalidRequest, Message: "Unknown service provider"})
+
+	req := httptest.NewRequest(http.MethodGet, ssoPath+"?SAMLRequest=abc", nil)
+	w := httptest.NewRecorder()
+	s.handler.HandleSSORequest(w, req)
+
+	s.Equal(http.StatusFound, w.Code)
+	location, err := url.Parse(w.Header().Get("Location"))
+	s.Require().NoError(err)
+	s.Equal("/gate/error", location.Path)
+	s.Equal(ErrorInvalidRequest, location.Query().Get("errorCode"))
+	s.Equal("Unknown service provider", location.Query().Get("errorMessage"))
+}
+
+func (s *SAMLHandlerTestSuite) TestHandleMetadataRequest() {
+	s.mockService.EXPECT().GetMetadata(mock.Anything).Return([]byte("<md:EntityDescriptor/>"))
+
+	w := httptest.NewRecorder()
+	s.handler.HandleMetadataRequest(w, httptest.NewRequest(http.MethodGet, metadataPath, nil))
+
+	s.Equal(http.StatusOK, w.Code)
+	s.Equal(metadataContentType, w.Header().Get("Content-Type"))
+	s.Equal("<md:EntityDescriptor/>", w.Body.String())
+}
+
+func (s *SAMLHandlerTestSuite) TestHandleResponseRequest_RendersPostForm() {
+	s.mockService.EXPECT().TakeResponse(mock.Anything, "resp-1").Return(&PostForm{
+		ACSURL:       testACSURL,
+		SAMLResponse: "PHJlc3BvbnNlLz4=",
+		RelayState:   `"><script>alert(1)</script>`,
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.handler.HandleResponseRequest(w, httptest.NewRequest(http.MethodGet, responsePath+"?id=resp-1", nil))
+
+	s.Equal(http.StatusOK, w.Code)
+	body := w.Body.String()
+	s.Contains(body, `action="https://sp.example.com/acs"`)
+	s.Contains(body, `name="SAMLResponse" value="PHJlc3BvbnNlLz4="`)
+	s.NotContains(body, "<script>alert(1)</script>")
+	s.Equal("no-store", w.Header().Get("Cache-Control"))
+
+	csp := w.Header().Get(contentSecurityPolicyHeaderName)
+	s.Contains(csp, "default-src 'none'")
+	s.Contains(csp, "form-action https://sp.example.com;")
+	s.Contains(csp, "frame-ancestors 'none'")
+	s.Contains(csp, "script-src 'nonce-")
+	nonce := csp[strings.Index(csp, "'nonce-")+len("'nonce-"):]
+	nonce = nonce[:strings.Index(nonce, "'")]
+	s.Contains(body, `<script nonce="`+nonce+`">`)
+}
+
+func (s *SAMLHandlerTestSuite) TestHandleResponseRequest_NotFound() {
+	s.mockService.EXPECT().TakeResponse(mock.Anything, "").
+		Return(nil, &SAMLError{Code: ErrorInvalidRequest, Message: "SAML response not found or already delivered"})
+
+	w := httptest.NewRecorder()
+	s.handler.HandleResponseRequest(w, httptest.NewRequest(http.MethodGet, responsePath, nil))
+
+	s.Equal(http.StatusFound, w.Code)
+	s.Contains(w.Header().Get("Location"), "/gate/error")
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/xmldsig"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize wires the SAML identity provider and registers its routes. The returned service
+// completes SSO requests from the shared flow callback. When no signing key is configured, the IdP is
+// disabled and Initialize returns nil without error.
+func Initialize(
+	mux *http.ServeMux,
+	inboundClientService inboundclient.InboundClientServiceInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	attributeCacheService attributecache.AttributeCacheServiceInterface,
+	cryptoProvider providers.RuntimeCryptoProvider,
+	runtimeStore providers.RuntimeStoreProvider,
+) (SAMLServiceInterface, error) {
+	runtime := config.GetServerRuntime()
+	samlCfg := runtime.Config.SAML
+	if samlCfg.SigningKeyID == "" {
+		return nil, nil
+	}
+
+	keys, err := cryptoProvider.GetPublicKeys(context.Background(),
+		providers.PublicKeyFilter{KeyID: samlCfg.SigningKeyID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load SAML signing key %q: %w", samlCfg.SigningKeyID, err)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no SAML signing key found for key id %q", samlCfg.SigningKeyID)
+	}
+	signingKey := keys[0]
+	if !slices.Contains(cryptoProvider.GetSupportedSigningAlgorithms(), signingKey.Algorithm) {
+		return nil, fmt.Errorf("unsupported signing algorithm for SAML signing key %q", samlCfg.SigningKeyID)
+	}
+	if _, err := xmldsig.SignatureMethodURI(signingKey.Algorithm); err != nil {
+		return nil, fmt.Errorf("SAML signing key %q: %w", samlCfg.SigningKeyID, err)
+	}
+	if len(signingKey.CertificateDER) == 0 {
+		return nil, fmt.Errorf("SAML signing key %q is not certificate-backed", samlCfg.SigningKeyID)
+	}
+
+	serverURL := strings.TrimRight(config.GetServerURL(&runtime.Config.Server), "/")
+	entityID := samlCfg.EntityID
+	if entityID == "" {
+		entityID = serverURL + metadataPath
+	}
+	cfg := serviceConfig{
+		EntityID:          entityID,
+		SSOURL:            serverURL + ssoPath,
+		ResponseURL:       serverURL + responsePath,
+		KeyID:             samlCfg.SigningKeyID,
+		Algorithm:         signingKey.Algorithm,
+		Certificate:       signingKey.CertificateDER,
+		AssertionValidity: time.Duration(samlCfg.AssertionValiditySeconds) * time.Second,
+		RequestValidity:   time.Duration(samlCfg.RequestValiditySeconds) * time.Second,
+		GateClient:        runtime.Config.GateClient,
+	}
+
+	svc := newSAMLService(cfg, newSAMLStore(runtimeStore), inboundClientService, flowExecService, jwtService,
+		attributeCacheService, cryptoProvider)
+	registerRoutes(mux, newSAMLHandler(svc, cfg))
+	return svc, nil
+}
+
+// registerRoutes registers the SAML endpoints. SSO and response delivery are top-level browser
+// navigations and need no CORS handling; metadata is fetched cross-origin by tooling.
+func registerRoutes(mux *http.ServeMux, h SAMLHandlerInterface) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: false,
+		MaxAge:           600,
+	}
+
+	ssoHandler := middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleSSORequest)).ServeHTTP
+	mux.HandleFunc("GET "+ssoPath, ssoHandler)
+	mux.HandleFunc("POST "+ssoPath, ssoHandler)
+	mux.HandleFunc("GET "+responsePath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleResponseRequest)).ServeHTTP)
+	mux.HandleFunc(middleware.WithCORS("GET "+metadataPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleMetadataRequest)).ServeHTTP, opts))
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"encoding/base64"
+
+	"github.com/thunder-id/thunderid/internal/system/xmldsig"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// metadataContentType is the media type of SAML metadata documents.
+const metadataContentType = "application/samlmetadata+xml"
+
+// supportedNameIDFormats lists the NameID formats the IdP can issue.
+var supportedNameIDFormats = []string{
+	providers.SAMLNameIDFormatUnspecified,
+	providers.SAMLNameIDFormatEmail,
+	providers.SAMLNameIDFormatPersistent,
+	providers.SAMLNameIDFormatTransient,
+}
+
+// buildMetadata builds the IdP EntityDescriptor. The document only depends on configuration, so it
+// is built once at startup.
+func buildMetadata(cfg serviceConfig) []byte {
+	entity := xmldsig.NewElement(prefixMetadata, "EntityDescriptor").SetAttr("entityID", cfg.EntityID)
+	entity.DeclareNamespace(prefixMetadata, namespaceMetadata)
+
+	descriptor := entity.AddChild(xmldsig.NewElement(prefixMetadata, "IDPSSODescriptor")).
+		SetAttr("WantAuthnRequestsSigned", "false").
+		SetAttr("protocolSupportEnumeration", namespaceProtocol)
+
+	if len(cfg.Certificate) > 0 {
+		keyDescriptor := descriptor.AddChild(xmldsig.NewElement(prefixMetadata, "KeyDescriptor")).
+			SetAttr("use", "signing")
+		keyInfo := keyDescriptor.AddChild(xmldsig.NewElement(xmldsig.Prefix, "KeyInfo"))
+		keyInfo.DeclareNamespace(xmldsig.Prefix, xmldsig.Namespace)
+		keyInfo.AddChild(xmldsig.NewElement(xmldsig.Prefix, "X509Data")).
+			AddChild(xmldsig.NewElement(xmldsig.Prefix, "X509Certificate")).
+			SetText(base64.StdEncoding.EncodeToString(cfg.Certificate))
+	}
+
+	for _, format := range supportedNameIDFormats {
+		descriptor.AddChild(xmldsig.NewElement(prefixMetadata, "NameIDFormat")).SetText(format)
+	}
+	for _, binding := range []string{bindingHTTPRedirect, bindingHTTPPost} {
+		descriptor.AddChild(xmldsig.NewElement(prefixMetadata, "SingleSignOnService")).
+			SetAttr("Binding", binding).
+			SetAttr("Location", cfg.SSOURL)
+	}
+	return entity.Bytes()
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"time"
+
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+)
+
+// SSORequest carries an inbound AuthnRequest as received on either binding, along with the browser
+// request context forwarded to the authentication flow.
+type SSORequest struct {
+	// Binding is the binding the request arrived on.
+	Binding string
+	// SAMLRequest is the encoded AuthnRequest: deflated and base64 encoded on the Redirect binding,
+	// base64 encoded on the POST binding.
+	SAMLRequest string
+	RelayState  string
+	// RawQuery is the undecoded query string of a Redirect binding request. Query string signatures
+	// are computed over the parameters exactly as the service provider encoded them.
+	RawQuery    string
+	Headers     map[string][]string
+	QueryParams map[string][]string
+}
+
+// SSOResult is the outcome of an accepted AuthnRequest. Exactly one field is set: LoginURL when the
+// user must authenticate, ResponseURL when the IdP answers immediately with an error status.
+type SSOResult struct {
+	LoginURL    string
+	ResponseURL string
+}
+
+// PostForm holds a built response ready for delivery to the service provider through the POST
+// binding.
+type PostForm struct {
+	ACSURL       string `json:"acsUrl"`
+	SAMLResponse string `json:"samlResponse"`
+	RelayState   string `json:"relayState,omitempty"`
+}
+
+// SAMLError holds structured error information for SSO and callback failures.
+type SAMLError struct {
+	Code    string
+	Message string
+}
+
+// authnRequestRecord is a validated AuthnRequest awaiting the outcome of its authentication flow.
+type authnRequestRecord struct {
+	ID           string `json:"id"`
+	RequestID    string `json:"requestId"`
+	AppID        string `json:"appId"`
+	SPEntityID   string `json:"spEntityId"`
+	ACSURL       string `json:"acsUrl"`
+	RelayState   string `json:"relayState,omitempty"`
+	NameIDFormat string `json:"nameIdFormat"`
+	// NameIDAttribute is the user attribute carried as the NameID value, empty for the subject ID.
+	NameIDAttribute string `json:"nameIdAttribute,omitempty"`
+	// Attributes are the user attributes released in the attribute statement.
+	Attributes   []string  `json:"attributes,omitempty"`
+	SignResponse bool      `json:"signResponse,omitempty"`
+	ExpiryTime   time.Time `json:"expiryTime"`
+}
+
+// authnRequest holds the fields of a parsed AuthnRequest that the IdP acts on.
+type authnRequest struct {
+	ID                 string
+	IssueInstant       time.Time
+	Destination        string
+	Issuer             string
+	ACSURL             string
+	ProtocolBinding    string
+	IsPassive          bool
+	ForceAuthn         bool
+	NameIDPolicyFormat string
+	Signed             bool
+}
+
+// responseStatus is the status placed on an error response.
+type responseStatus struct {
+	code    string
+	subCode string
+	message string
+}
+
+// serviceConfig holds the resolved IdP settings.
+type serviceConfig struct {
+	EntityID          string
+	SSOURL            string
+	ResponseURL       string
+	KeyID             string
+	Algorithm         string
+	Certificate       []byte
+	AssertionValidity time.Duration
+	RequestValidity   time.Duration
+	GateClient        engineconfig.GateClientConfig
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+)
+
+// contentSecurityPolicyHeaderName is the header carrying the response page's content security policy.
+const contentSecurityPolicyHeaderName = "Content-Security-Policy"
+
+// postFormTemplate renders the POST binding form that carries a response to the service provider. A
+// nonce-bound script submits it automatically; the button covers browsers with scripts disabled.
+var postFormTemplate = template.Must(template.New("saml").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Signing in</title>
+</head>
+<body>
+<form id="saml-response" method="post" action="{{.ACSURL}}">
+<input type="hidden" name="SAMLResponse" value="{{.SAMLResponse}}">
+{{- if .RelayState}}
+<input type="hidden" name="RelayState" value="{{.RelayState}}">
+{{- end}}
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+<script nonce="{{.Nonce}}">document.getElementById("saml-response").submit();</script>
+</body>
+</html>
+`))
+
+// postFormPage holds the data rendered into the POST binding form template.
+type postFormPage struct {
+	PostForm
+	Nonce string
+}
+
+// writePostForm renders the POST binding form. The page sets its own content security policy, which
+// allows only its nonce-bound script and only form submission to the service provider's origin.
+func writePostForm(w http.ResponseWriter, form *PostForm) error {
+	acsURL, err := url.Parse(form.ACSURL)
+	if err != nil {
+		return fmt.Errorf("invalid assertion consumer service URL: %w", err)
+	}
+	nonceBytes := make([]byte, 16)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return fmt.Errorf("failed to generate script nonce: %w", err)
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(nonceBytes)
+
+	w.Header().Set(sysconst.ContentTypeHeaderName, sysconst.ContentTypeHTML)
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoStore)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+	w.Header().Set(contentSecurityPolicyHeaderName, fmt.Sprintf(
+		"default-src 'none'; script-src 'nonce-%s'; form-action %s://%s; base-uri 'none'; frame-ancestors 'none'",
+		nonce, acsURL.Scheme, acsURL.Host))
+	w.WriteHeader(http.StatusOK)
+	return postFormTemplate.Execute(w, postFormPage{PostForm: *form, Nonce: nonce})
+}
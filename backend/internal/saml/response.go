@@ -0,0 +1,198 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/system/xmldsig"
+)
+
+// buildSuccessResponse builds a Success response carrying a signed assertion about the user. The
+// response itself is signed as well when the service provider asks for it.
+func (s *samlService) buildSuccessResponse(ctx context.Context, record *authnRequestRecord, nameID string,
+	attributes map[string]interface{}, authTime time.Time, acr string) (*xmldsig.Element, error) {
+	now := time.Now()
+	response := s.newResponse(record, now)
+	response.AddChild(newStatus(responseStatus{code: statusSuccess}))
+	assertion := response.AddChild(s.newAssertion(record, nameID, attributes, authTime, acr, now))
+
+	// The assertion is signed in place so its namespace context matches what the service provider
+	// will canonicalize.
+	if err := s.signer().Sign(ctx, assertion, 1); err != nil {
+		return nil, err
+	}
+	if record.SignResponse {
+		if err := s.signer().Sign(ctx, response, 1); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// buildErrorResponse builds a signed response carrying an error status and no assertion.
+func (s *samlService) buildErrorResponse(ctx context.Context, record *authnRequestRecord,
+	status responseStatus) (*xmldsig.Element, error) {
+	response := s.newResponse(record, time.Now())
+	response.AddChild(newStatus(status))
+	if err := s.signer().Sign(ctx, response, 1); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// newResponse creates a samlp:Response addressed to the record's assertion consumer service.
+func (s *samlService) newResponse(record *authnRequestRecord, now time.Time) *xmldsig.Element {
+	response := xmldsig.NewElement(prefixProtocol, "Response")
+	response.DeclareNamespace(prefixProtocol, namespaceProtocol)
+	response.DeclareNamespace(prefixAssertion, namespaceAssertion)
+	response.SetAttr("ID", newID()).
+		SetAttr("Version", samlVersion).
+		SetAttr("IssueInstant", formatInstant(now)).
+		SetAttr("Destination", record.ACSURL).
+		SetAttr("InResponseTo", record.RequestID)
+	response.AddChild(s.newIssuer())
+	return response
+}
+
+// newAssertion creates the bearer assertion for a completed authentication.
+func (s *samlService) newAssertion(record *authnRequestRecord, nameID string, attributes map[string]interface{},
+	authTime time.Time, acr string, now time.Time) *xmldsig.Element {
+	notOnOrAfter := formatInstant(now.Add(s.cfg.AssertionValidity))
+
+	assertion := xmldsig.NewElement(prefixAssertion, "Assertion").
+		SetAttr("ID", newID()).
+		SetAttr("Version", samlVersion).
+		SetAttr("IssueInstant", formatInstant(now))
+	assertion.AddChild(s.newIssuer())
+
+	subject := assertion.AddChild(xmldsig.NewElement(prefixAssertion, "Subject"))
+	subject.AddChild(xmldsig.NewElement(prefixAssertion, "NameID")).
+		SetAttr("Format", record.NameIDFormat).
+		SetAttr("SPNameQualifier", record.SPEntityID).
+		SetText(nameID)
+	confirmation := subject.AddChild(xmldsig.NewElement(prefixAssertion, "SubjectConfirmation")).
+		SetAttr("Method", confirmationMethodBearer)
+	confirmation.AddChild(xmldsig.NewElement(prefixAssertion, "SubjectConfirmationData")).
+		SetAttr("InResponseTo", record.RequestID).
+		SetAttr("NotOnOrAfter", notOnOrAfter).
+		SetAttr("Recipient", record.ACSURL)
+
+	conditions := assertion.AddChild(xmldsig.NewElement(prefixAssertion, "Conditions")).
+		SetAttr("NotBefore", formatInstant(now.Add(-clockSkew))).
+		SetAttr("NotOnOrAfter", notOnOrAfter)
+	conditions.AddChild(xmldsig.NewElement(prefixAssertion, "AudienceRestriction")).
+		AddChild(xmldsig.NewElement(prefixAssertion, "Audience")).
+		SetText(record.SPEntityID)
+
+	if acr == "" {
+		acr = authnContextUnspecified
+	}
+	authnStatement := assertion.AddChild(xmldsig.NewElement(prefixAssertion, "AuthnStatement")).
+		SetAttr("AuthnInstant", formatInstant(authTime)).
+		SetAttr("SessionIndex", newID())
+	authnStatement.AddChild(xmldsig.NewElement(prefixAssertion, "AuthnContext")).
+		AddChild(xmldsig.NewElement(prefixAssertion, "AuthnContextClassRef")).
+		SetText(acr)
+
+	if statement := newAttributeStatement(record.Attributes, attributes); statement != nil {
+		assertion.AddChild(statement)
+	}
+	return assertion
+}
+
+// newAttributeStatement releases the listed attributes the user has values for. It returns nil when
+// there is nothing to release, since an empty AttributeStatement is not schema valid.
+func newAttributeStatement(names []string, attributes map[string]interface{}) *xmldsig.Element {
+	var statement *xmldsig.Element
+	for _, name := range names {
+		values := attributeValues(attributes[name])
+		if len(values) == 0 {
+			continue
+		}
+		if statement == nil {
+			statement = xmldsig.NewElement(prefixAssertion, "AttributeStatement")
+		}
+		attribute := statement.AddChild(xmldsig.NewElement(prefixAssertion, "Attribute")).
+			SetAttr("Name", name).
+			SetAttr("NameFormat", attributeNameFormatBasic)
+		for _, value := range values {
+			attribute.AddChild(xmldsig.NewElement(prefixAssertion, "AttributeValue")).SetText(value)
+		}
+	}
+	return statement
+}
+
+// attributeValues renders a user attribute as AttributeValue strings. Lists become multiple values;
+// structured values are rendered as JSON.
+func attributeValues(value interface{}) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, attributeValues(item)...)
+		}
+		return values
+	case []string:
+		return v
+	case bool, float64, int, int64, json.Number:
+		return []string{fmt.Sprint(v)}
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		return []string{string(encoded)}
+	}
+}
+
+func (s *samlService) newIssuer() *xmldsig.Element {
+	return xmldsig.NewElement(prefixAssertion, "Issuer").SetText(s.cfg.EntityID)
+}
+
+func (s *samlService) signer() *xmldsig.Signer {
+	return &xmldsig.Signer{
+		CryptoProvider: s.cryptoProvider,
+		KeyID:          s.cfg.KeyID,
+		Algorithm:      s.cfg.Algorithm,
+		Certificate:    s.cfg.Certificate,
+	}
+}
+
+// newStatus creates a samlp:Status element.
+func newStatus(status responseStatus) *xmldsig.Element {
+	el := xmldsig.NewElement(prefixProtocol, "Status")
+	code := el.AddChild(xmldsig.NewElement(prefixProtocol, "StatusCode")).SetAttr("Value", status.code)
+	if status.subCode != "" {
+		code.AddChild(xmldsig.NewElement(prefixProtocol, "StatusCode")).SetAttr("Value", status.subCode)
+	}
+	if status.message != "" {
+		el.AddChild(xmldsig.NewElement(prefixProtocol, "StatusMessage")).SetText(status.message)
+	}
+	return el
+}
+
+// encodeResponse serializes a response for the POST binding.
+func encodeResponse(response *xmldsig.Element) string {
+	return base64.StdEncoding.EncodeToString(response.Bytes())
+}
+
+// newID returns an identifier usable as an xs:ID, which must not start with a digit.
+func newID() string {
+	return "_" + utils.GenerateUUID()
+}
+
+// formatInstant formats a time as a SAML xs:dateTime in UTC.
+func formatInstant(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,491 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package saml implements the SAML 2.0 identity provider: Web Browser SSO over the HTTP-Redirect
+// and HTTP-POST bindings, signed assertions, and metadata publishing. Authentication runs through
+// the application's authentication flow, so an SSO session established for an OIDC application also
+// covers SAML applications and vice versa.
+package saml
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/system/xmldsig"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// SAMLServiceInterface defines the SAML identity provider operations.
+type SAMLServiceInterface interface {
+	// InitiateSSO validates an AuthnRequest and starts the application's authentication flow for it.
+	InitiateSSO(ctx context.Context, request *SSORequest) (*SSOResult, *SAMLError)
+	// HandleCallback completes an SSO request from the flow assertion and returns the URL that
+	// delivers the response to the service provider.
+	HandleCallback(ctx context.Context, authID, assertion string) (string, *SAMLError)
+	// TakeResponse returns a built response for delivery. A response can be collected once.
+	TakeResponse(ctx context.Context, id string) (*PostForm, *SAMLError)
+	// GetMetadata returns the IdP metadata document.
+	GetMetadata(ctx context.Context) []byte
+}
+
+// samlService implements SAMLServiceInterface.
+type samlService struct {
+	cfg                   serviceConfig
+	store                 samlStoreInterface
+	inboundClientService  inboundclient.InboundClientServiceInterface
+	flowExecService       flowexec.FlowExecServiceInterface
+	jwtService            jwt.JWTServiceInterface
+	attributeCacheService attributecache.AttributeCacheServiceInterface
+	cryptoProvider        providers.RuntimeCryptoProvider
+	metadata              []byte
+	logger                *log.Logger
+}
+
+// newSAMLService creates a new instance of samlService with injected dependencies.
+func newSAMLService(
+	cfg serviceConfig,
+	store samlStoreInterface,
+	inboundClientService inboundclient.InboundClientServiceInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	attributeCacheService attributecache.AttributeCacheServiceInterface,
+	cryptoProvider providers.RuntimeCryptoProvider,
+) SAMLServiceInterface {
+	return &samlService{
+		cfg:                   cfg,
+		store:                 store,
+		inboundClientService:  inboundClientService,
+		flowExecService:       flowExecService,
+		jwtService:            jwtService,
+		attributeCacheService: attributeCacheService,
+		cryptoProvider:        cryptoProvider,
+		metadata:              buildMetadata(cfg),
+		logger:                log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SAMLService")),
+	}
+}
+
+// InitiateSSO validates an AuthnRequest and starts the application's authentication flow for it.
+// Until the assertion consumer service URL is verified against the registration, failures are
+// returned as errors for the caller to show on the error page; after that, failures the service
+// provider should learn about are answered with an error status response.
+func (s *samlService) InitiateSSO(ctx context.Context, request *SSORequest) (*SSOResult, *SAMLError) {
+	if request.SAMLRequest == "" {
+		return nil, invalidRequest("SAMLRequest is required")
+	}
+	if len(request.RelayState) > maxRelayStateLength {
+		return nil, invalidRequest("RelayState exceeds the maximum length")
+	}
+
+	data, err := decodeMessage(request.Binding, request.SAMLRequest)
+	if err != nil {
+		s.logger.Debug(ctx, "Failed to decode AuthnRequest", log.Error(err))
+		return nil, invalidRequest("Invalid SAML request")
+	}
+	authnReq, root, err := parseAuthnRequest(data)
+	if err != nil {
+		s.logger.Debug(ctx, "Failed to parse AuthnRequest", log.Error(err))
+		return nil, invalidRequest("Invalid SAML request")
+	}
+
+	appID, profile, err := s.inboundClientService.GetSAMLProfileBySPEntityID(ctx, authnReq.Issuer)
+	if err != nil {
+		if errors.Is(err, inboundclient.ErrInboundClientNotFound) {
+			s.logger.Debug(ctx, "AuthnRequest issuer is not registered",
+				log.MaskedString("issuer", authnReq.Issuer))
+			return nil, invalidRequest("Unknown service provider")
+		}
+		s.logger.Error(ctx, "Failed to resolve SAML service provider", log.Error(err))
+		return nil, serverError()
+	}
+
+	if samlErr := s.verifyRequestSignature(ctx, request, authnReq, root, profile); samlErr != nil {
+		return nil, samlErr
+	}
+	if samlErr := s.validateAuthnRequest(authnReq); samlErr != nil {
+		return nil, samlErr
+	}
+	acsURL, samlErr := resolveACSURL(authnReq, profile)
+	if samlErr != nil {
+		return nil, samlErr
+	}
+
+	app, err := s.inboundClientService.GetInboundClientByEntityID(ctx, appID)
+	if err != nil || app == nil {
+		s.logger.Error(ctx, "Failed to resolve application for SAML service provider",
+			log.String("appId", appID), log.Error(err))
+		return nil, serverError()
+	}
+
+	record := &authnRequestRecord{
+		ID:              utils.GenerateUUID(),
+		RequestID:       authnReq.ID,
+		AppID:           appID,
+		SPEntityID:      profile.EntityID,
+		ACSURL:          acsURL,
+		RelayState:      request.RelayState,
+		NameIDFormat:    profile.NameIDFormat,
+		NameIDAttribute: profile.NameIDAttribute,
+		SignResponse:    profile.SignResponse,
+		ExpiryTime:      time.Now().Add(s.cfg.RequestValidity),
+	}
+	if record.NameIDFormat == "" {
+		record.NameIDFormat = providers.SAMLNameIDFormatUnspecified
+	}
+	if app.Assertion != nil {
+		record.Attributes = app.Assertion.UserAttributes
+	}
+
+	if format := authnReq.NameIDPolicyFormat; format != "" &&
+		format != providers.SAMLNameIDFormatUnspecified && format != record.NameIDFormat {
+		return s.errorResult(ctx, record, responseStatus{
+			code:    statusRequester,
+			subCode: statusInvalidNameIDPolicy,
+			message: "The requested NameID format is not supported for this service provider",
+		})
+	}
+	// Every login goes through the authentication flow, which may prompt the user.
+	if authnReq.IsPassive {
+		return s.errorResult(ctx, record, responseStatus{code: statusResponder, subCode: statusNoPassive})
+	}
+
+	if err := s.store.AddRequest(ctx, record); err != nil {
+		s.logger.Error(ctx, "Failed to store SAML request", log.Error(err))
+		return nil, serverError()
+	}
+
+	runtimeData := map[string]string{
+		flowcm.RuntimeKeyAuthorizationRequestID:      record.ID,
+		flowcm.RuntimeKeyCallbackType:                string(providers.SAMLInboundAuthType),
+		flowcm.RuntimeKeyRequiredEssentialAttributes: "",
+		flowcm.RuntimeKeyRequiredOptionalAttributes:  strings.Join(requestedAttributes(record), " "),
+		flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: strconv.FormatInt(
+			int64(s.cfg.RequestValidity.Seconds())+attributeCacheTTLBufferSeconds, 10),
+	}
+	if authnReq.ForceAuthn {
+		runtimeData[flowcm.RuntimeKeyMaxAge] = "0"
+	}
+
+	executionID, flowErr := s.flowExecService.InitiateFlow(ctx, &flowexec.FlowInitContext{
+		ApplicationID: appID,
+		FlowType:      string(providers.FlowTypeAuthentication),
+		RuntimeData:   runtimeData,
+		ExpirySeconds: int64(s.cfg.RequestValidity.Seconds()),
+		InitiatorRequest: &providers.InitiatorRequest{
+			Headers:     utils.FilterSensitiveHeaders(request.Headers),
+			QueryParams: request.QueryParams,
+		},
+	})
+	if flowErr != nil {
+		s.logger.Error(ctx, "Failed to initiate SAML authentication flow",
+			log.String("error_code", flowErr.Code))
+		return nil, serverError()
+	}
+
+	loginURL, err := s.loginPageURL(map[string]string{
+		oauth2const.AuthID:      record.ID,
+		oauth2const.AppID:       appID,
+		oauth2const.ExecutionID: executionID,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to construct login page URL", log.Error(err))
+		return nil, serverError()
+	}
+	return &SSOResult{LoginURL: loginURL}, nil
+}
+
+// verifyRequestSignature verifies the AuthnRequest signature when one is present and enforces the
+// profile's signed request requirement. Redirect binding requests are signed over the query string;
+// POST binding requests carry an enveloped XML signature.
+func (s *samlService) verifyRequestSignature(ctx context.Context, request *SSORequest, authnReq *authnRequest,
+	root *xmldsig.Element, profile *providers.SAMLProfile) *SAMLError {
+	signed := authnReq.Signed
+	if request.Binding == bindingHTTPRedirect {
+		query, err := url.ParseQuery(request.RawQuery)
+		signed = err == nil && query.Get(paramSignature) != ""
+	}
+	if !signed {
+		if profile.RequireSignedAuthnRequests {
+			return invalidRequest("AuthnRequest must be signed")
+		}
+		return nil
+	}
+	if profile.Certificate == "" {
+		// Without a registered certificate the signature cannot be checked, and an unchecked signature
+		// proves nothing, so the request is treated as unsigned.
+		return nil
+	}
+
+	certificate, err := parseCertificate(profile.Certificate)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to parse SAML service provider certificate", log.Error(err))
+		return serverError()
+	}
+	if request.Binding == bindingHTTPRedirect {
+		err = verifyRedirectSignature(ctx, s.cryptoProvider, request.RawQuery, certificate)
+	} else {
+		err = xmldsig.Verify(ctx, root, s.cryptoProvider, certificate.PublicKey)
+	}
+	if err != nil {
+		s.logger.Debug(ctx, "AuthnRequest signature verification failed", log.Error(err))
+		return invalidRequest("Invalid AuthnRequest signature")
+	}
+	return nil
+}
+
+// validateAuthnRequest checks the freshness and addressing of an AuthnRequest.
+func (s *samlService) validateAuthnRequest(authnReq *authnRequest) *SAMLError {
+	now := time.Now()
+	if authnReq.IssueInstant.After(now.Add(clockSkew)) ||
+		authnReq.IssueInstant.Before(now.Add(-s.cfg.RequestValidity-clockSkew)) {
+		return invalidRequest("AuthnRequest has expired or is not yet valid")
+	}
+	if authnReq.Destination != "" && authnReq.Destination != s.cfg.SSOURL {
+		return invalidRequest("AuthnRequest destination does not match")
+	}
+	if authnReq.ProtocolBinding != "" && authnReq.ProtocolBinding != bindingHTTPPost {
+		return invalidRequest("Unsupported protocol binding")
+	}
+	return nil
+}
+
+// resolveACSURL returns the assertion consumer service URL for the request. A URL named in the
+// request must exactly match a registered one; otherwise the first registered URL is used.
+func resolveACSURL(authnReq *authnRequest, profile *providers.SAMLProfile) (string, *SAMLError) {
+	if authnReq.ACSURL == "" {
+		if len(profile.AssertionConsumerServiceURLs) == 0 {
+			return "", invalidRequest("No assertion consumer service URL is registered")
+		}
+		return profile.AssertionConsumerServiceURLs[0], nil
+	}
+	for _, registered := range profile.AssertionConsumerServiceURLs {
+		if registered == authnReq.ACSURL {
+			return registered, nil
+		}
+	}
+	return "", invalidRequest("Assertion consumer service URL is not registered")
+}
+
+// requestedAttributes lists the user attributes the flow must collect: the released attributes plus
+// the one carried as the NameID.
+func requestedAttributes(record *authnRequestRecord) []string {
+	attributes := append([]string{}, record.Attributes...)
+	if nameIDAttribute := nameIDAttributeOf(record); nameIDAttribute != "" &&
+		!slices.Contains(attributes, nameIDAttribute) {
+		attributes = append(attributes, nameIDAttribute)
+	}
+	return attributes
+}
+
+// nameIDAttributeOf returns the user attribute carried as the NameID value, or empty when the
+// NameID is the subject identifier or a transient identifier.
+func nameIDAttributeOf(record *authnRequestRecord) string {
+	switch record.NameIDFormat {
+	case providers.SAMLNameIDFormatTransient:
+		return ""
+	case providers.SAMLNameIDFormatEmail:
+		if record.NameIDAttribute == "" {
+			return defaultEmailAttribute
+		}
+	}
+	return record.NameIDAttribute
+}
+
+// HandleCallback completes an SSO request from the flow assertion. The request is consumed whether
+// or not the callback succeeds, so an assertion cannot be replayed against it.
+func (s *samlService) HandleCallback(ctx context.Context, authID, assertion string) (string, *SAMLError) {
+	if authID == "" || assertion == "" {
+		return "", invalidRequest("authId and assertion are required")
+	}
+
+	record, err := s.store.TakeRequest(ctx, authID)
+	if err != nil {
+		if errors.Is(err, ErrSAMLRequestNotFound) {
+			return "", invalidRequest("Invalid authId")
+		}
+		s.logger.Error(ctx, "Failed to retrieve SAML request", log.Error(err))
+		return "", serverError()
+	}
+	if record.ExpiryTime.Before(time.Now()) {
+		return "", invalidRequest("SAML request has expired")
+	}
+
+	if verifyErr := s.jwtService.VerifyJWT(ctx, assertion, record.AppID, ""); verifyErr != nil {
+		s.logger.Debug(ctx, "Assertion verification failed",
+			log.String("error", verifyErr.Error.DefaultValue))
+		return "", invalidRequest("Invalid assertion signature")
+	}
+
+	claims, payload, err := oauth2utils.DecodeFlowAssertionClaims(assertion)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to decode assertion claims", log.Error(err))
+		return "", serverError()
+	}
+
+	if flowErrorType, _ := payload[flowcm.ClaimFlowErrorType].(string); flowErrorType != "" {
+		// Cannot fail: the same assertion decoded successfully above.
+		errClaims, _ := oauth2utils.DecodeFlowErrorAssertionClaims(assertion)
+		if errClaims.AuthorizationRequestID != record.ID {
+			s.logger.Debug(ctx, "Error assertion is not bound to the SAML request")
+			return "", invalidRequest("Assertion does not match the SAML request")
+		}
+		status := responseStatus{code: statusResponder}
+		if errClaims.ErrorType == flowcm.FlowErrorTypeEndUser {
+			status.subCode = statusAuthnFailed
+			status.message = errClaims.Description
+		}
+		return s.errorResponseURL(ctx, record, status)
+	}
+
+	// The request ID is threaded through the flow runtime data into the assertion; requiring it to
+	// match prevents an assertion minted for one request from completing another.
+	if authReqID, _ := payload[oauth2const.ClaimAuthorizationRequestID].(string); authReqID != record.ID {
+		s.logger.Debug(ctx, "Assertion is not bound to the SAML request")
+		return "", invalidRequest("Assertion does not match the SAML request")
+	}
+	if claims.UserID == "" {
+		return "", invalidRequest("Assertion subject is missing")
+	}
+
+	attributes := map[string]interface{}{}
+	if claims.AttributeCacheID != "" {
+		cache, svcErr := s.attributeCacheService.GetAttributeCache(ctx, claims.AttributeCacheID)
+		if svcErr != nil {
+			s.logger.Error(ctx, "Failed to retrieve user attributes",
+				log.String("error_code", svcErr.Code))
+			return "", serverError()
+		}
+		if cache != nil && cache.Attributes != nil {
+			attributes = cache.Attributes
+		}
+	}
+
+	nameID, ok := resolveNameID(record, claims.UserID, attributes)
+	if !ok {
+		s.logger.Debug(ctx, "NameID attribute is unavailable for the user",
+			log.String("attribute", nameIDAttributeOf(record)))
+		return s.errorResponseURL(ctx, record, responseStatus{
+			code:    statusResponder,
+			subCode: statusInvalidNameIDPolicy,
+			message: "The user has no value for the configured NameID attribute",
+		})
+	}
+
+	authTime := claims.AuthTime
+	if authTime.IsZero() {
+		authTime = time.Now()
+	}
+	response, err := s.buildSuccessResponse(ctx, record, nameID, attributes, authTime, claims.CompletedACR)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to build SAML response", log.Error(err))
+		return "", serverError()
+	}
+	return s.storeResponse(ctx, record, response)
+}
+
+// resolveNameID computes the NameID value for the record's format. It reports false when the
+// configured attribute has no string value for the user.
+func resolveNameID(record *authnRequestRecord, userID string, attributes map[string]interface{}) (string, bool) {
+	if record.NameIDFormat == providers.SAMLNameIDFormatTransient {
+		return newID(), true
+	}
+	attribute := nameIDAttributeOf(record)
+	if attribute == "" {
+		return userID, true
+	}
+	value, ok := attributes[attribute].(string)
+	return value, ok && value != ""
+}
+
+// TakeResponse returns a built response for delivery.
+func (s *samlService) TakeResponse(ctx context.Context, id string) (*PostForm, *SAMLError) {
+	form, err := s.store.TakeResponse(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrSAMLRequestNotFound) {
+			return nil, invalidRequest("SAML response not found or already delivered")
+		}
+		s.logger.Error(ctx, "Failed to retrieve SAML response", log.Error(err))
+		return nil, serverError()
+	}
+	return form, nil
+}
+
+// GetMetadata returns the IdP metadata document.
+func (s *samlService) GetMetadata(_ context.Context) []byte {
+	return s.metadata
+}
+
+// errorResult answers a request with an error status response instead of authenticating.
+func (s *samlService) errorResult(ctx context.Context, record *authnRequestRecord,
+	status responseStatus) (*SSOResult, *SAMLError) {
+	responseURL, samlErr := s.errorResponseURL(ctx, record, status)
+	if samlErr != nil {
+		return nil, samlErr
+	}
+	return &SSOResult{ResponseURL: responseURL}, nil
+}
+
+// errorResponseURL builds and stores an error status response and returns its delivery URL.
+func (s *samlService) errorResponseURL(ctx context.Context, record *authnRequestRecord,
+	status responseStatus) (string, *SAMLError) {
+	response, err := s.buildErrorResponse(ctx, record, status)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to build SAML error response", log.Error(err))
+		return "", serverError()
+	}
+	return s.storeResponse(ctx, record, response)
+}
+
+// storeResponse stores an encoded response for the browser to collect and returns its delivery URL.
+func (s *samlService) storeResponse(ctx context.Context, record *authnRequestRecord,
+	response *xmldsig.Element) (string, *SAMLError) {
+	id := utils.GenerateUUID()
+	form := &PostForm{
+		ACSURL:       record.ACSURL,
+		SAMLResponse: encodeResponse(response),
+		RelayState:   record.RelayState,
+	}
+	if err := s.store.AddResponse(ctx, id, form); err != nil {
+		s.logger.Error(ctx, "Failed to store SAML response", log.Error(err))
+		return "", serverError()
+	}
+
+	responseURL, err := utils.GetURIWithQueryParams(s.cfg.ResponseURL, map[string]string{paramResponseID: id})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to construct SAML response URL", log.Error(err))
+		return "", serverError()
+	}
+	return responseURL, nil
+}
+
+// loginPageURL constructs the Gate login page URL with the given query parameters.
+func (s *samlService) loginPageURL(queryParams map[string]string) (string, error) {
+	loginPageURL := (&url.URL{
+		Scheme: s.cfg.GateClient.Scheme,
+		Host:   fmt.Sprintf("%s:%d", s.cfg.GateClient.Hostname, s.cfg.GateClient.Port),
+		Path:   s.cfg.GateClient.LoginPath,
+	}).String()
+	return utils.GetURIWithQueryParams(loginPageURL, queryParams)
+}
+
+func invalidRequest(message string) *SAMLError {
+	return &SAMLError{Code: ErrorInvalidRequest, Message: message}
+}
+
+func serverError() *SAMLError {
+	return &SAMLError{Code: ErrorServerError, Message: "Failed to process the SAML request"}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// samlStoreInterface defines the storage of pending SSO requests and of built responses awaiting
+// delivery. Both are single use: reading an entry removes it.
+type samlStoreInterface interface {
+	AddRequest(ctx context.Context, record *authnRequestRecord) error
+	TakeRequest(ctx context.Context, id string) (*authnRequestRecord, error)
+	AddResponse(ctx context.Context, id string, form *PostForm) error
+	TakeResponse(ctx context.Context, id string) (*PostForm, error)
+}
+
+// samlStore adapts a runtime store provider to SAML request and response storage.
+type samlStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newSAMLStore creates a SAML store backed by the given runtime store provider.
+func newSAMLStore(store providers.RuntimeStoreProvider) samlStoreInterface {
+	return &samlStore{store: store}
+}
+
+// AddRequest stores a pending SSO request until its expiry time.
+func (s *samlStore) AddRequest(ctx context.Context, record *authnRequestRecord) error {
+	ttl := time.Until(record.ExpiryTime)
+	if ttl <= 0 {
+		return fmt.Errorf("SAML request already expired")
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SAML request: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceSAMLReq, record.ID, data, int64(ttl.Seconds()))
+}
+
+// TakeRequest retrieves and removes a pending SSO request. Returns ErrSAMLRequestNotFound if absent.
+func (s *samlStore) TakeRequest(ctx context.Context, id string) (*authnRequestRecord, error) {
+	if id == "" {
+		return nil, ErrSAMLRequestNotFound
+	}
+	data, err := s.store.Take(ctx, providers.NamespaceSAMLReq, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SAML request: %w", err)
+	}
+	if data == nil {
+		return nil, ErrSAMLRequestNotFound
+	}
+
+	var record authnRequestRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal SAML request: %w", err)
+	}
+	return &record, nil
+}
+
+// AddResponse stores a built response for the browser to collect.
+func (s *samlStore) AddResponse(ctx context.Context, id string, form *PostForm) error {
+	data, err := json.Marshal(form)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SAML response: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceSAMLResp, id, data, responseTTLSeconds)
+}
+
+// TakeResponse retrieves and removes a built response. Returns ErrSAMLRequestNotFound if absent.
+func (s *samlStore) TakeResponse(ctx context.Context, id string) (*PostForm, error) {
+	if id == "" {
+		return nil, ErrSAMLRequestNotFound
+	}
+	data, err := s.store.Take(ctx, providers.NamespaceSAMLResp, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SAML response: %w", err)
+	}
+	if data == nil {
+		return nil, ErrSAMLRequestNotFound
+	}
+
+	var form PostForm
+	if err := json.Unmarshal(data, &form); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal SAML response: %w", err)
+	}
+	return &form, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saml
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+)
+
+const testDeploymentID = "test-deployment"
+
+// SAMLStoreTestSuite exercises the SAML store adapter against the in-memory runtime store.
+type SAMLStoreTestSuite struct {
+	suite.Suite
+	store samlStoreInterface
+	ctx   context.Context
+}
+
+func TestSAMLStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(SAMLStoreTestSuite))
+}
+
+func (s *SAMLStoreTestSuite) SetupTest() {
+	s.store = newSAMLStore(inmemory.Initialize(testDeploymentID))
+	s.ctx = context.Background()
+}
+
+func (s *SAMLStoreTestSuite) sampleRecord() *authnRequestRecord {
+	return &authnRequestRecord{
+		ID:           "auth-1",
+		RequestID:    "_request-1",
+		AppID:        "app-1",
+		SPEntityID:   "https://sp.example.com",
+		ACSURL:       "https://sp.example.com/acs",
+		RelayState:   "state-1",
+		NameIDFormat: "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+		Attributes:   []string{"email"},
+		SignResponse: true,
+		ExpiryTime:   time.Now().Add(5 * time.Minute),
+	}
+}
+
+func (s *SAMLStoreTestSuite) TestAddAndTakeRequest_RoundTrip() {
+	record := s.sampleRecord()
+	s.Require().NoError(s.store.AddRequest(s.ctx, record))
+
+	got, err := s.store.TakeRequest(s.ctx, record.ID)
+	s.Require().NoError(err)
+	s.Equal(record.RequestID, got.RequestID)
+	s.Equal(record.ACSURL, got.ACSURL)
+	s.Equal(record.Attributes, got.Attributes)
+	s.True(got.SignResponse)
+	s.WithinDuration(record.ExpiryTime, got.ExpiryTime, time.Second)
+}
+
+func (s *SAMLStoreTestSuite) TestTakeRequest_SingleUse() {
+	record := s.sampleRecord()
+	s.Require().NoError(s.store.AddRequest(s.ctx, record))
+
+	_, err := s.store.TakeRequest(s.ctx, record.ID)
+	s.Require().NoError(err)
+	_, err = s.store.TakeRequest(s.ctx, record.ID)
+	s.ErrorIs(err, ErrSAMLRequestNotFound)
+}
+
+func (s *SAMLStoreTestSuite) TestTakeRequest_NotFound() {
+	_, err := s.store.TakeRequest(s.ctx, "missing")
+	s.ErrorIs(err, ErrSAMLRequestNotFound)
+
+	_, err = s.store.TakeRequest(s.ctx, "")
+	s.ErrorIs(err, ErrSAMLRequestNotFound)
+}
+
+func (s *SAMLStoreTestSuite) TestAddRequest_AlreadyExpired() {
+	record := s.sampleRecord()
+	record.ExpiryTime = time.Now().Add(-time.Second)
+
+	s.Error(s.store.AddRequest(s.ctx, record))
+}
+
+func (s *SAMLStoreTestSuite) TestAddAndTakeResponse_SingleUse() {
+	form := &PostForm{ACSURL: "https://sp.example.com/acs", SAMLResponse: "PHJlc3BvbnNlLz4=", RelayState: "state-1"}
+	s.Require().NoError(s.store.AddResponse(s.ctx, "resp-1", form))
+
+	got, err := s.store.TakeResponse(s.ctx, "resp-1")
+	s.Require().NoError(err)
+	s.Equal(form, got)
+
+	_, err = s.store.TakeResponse(s.ctx, "resp-1")
+	s.ErrorIs(err, ErrSAMLRequestNotFound)
+}
+
+func (s *SAMLStoreTestSuite) TestTakeResponse_EmptyID() {
+	_, err := s.store.TakeResponse(s.ctx, "")
+	s.ErrorIs(err, ErrSAMLRequestNotFound)
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ProcessBulk runs the operations of a bulk request in order. Resources created by earlier
+// operations can be referenced as "bulkId:<id>" by later ones. Processing stops once failOnErrors
+// operations have failed.
+func (s *scimService) ProcessBulk(ctx context.Context, request BulkRequest) (*BulkResponse, *SCIMError) {
+	if !containsFold(request.Schemas, messageBulkRequest) {
+		return nil, newBadRequestError(scimTypeInvalidSyntax, "The request must use the BulkRequest schema")
+	}
+	if len(request.Operations) > s.cfg.BulkMaxOperations {
+		return nil, &SCIMError{Status: http.StatusRequestEntityTooLarge,
+			Detail: "The request exceeds the maximum of " + strconv.Itoa(s.cfg.BulkMaxOperations) + " operations"}
+	}
+
+	response := &BulkResponse{Schemas: []string{messageBulkResponse}, Operations: []BulkOperationResult{}}
+	createdIDs := map[string]string{}
+	failures := 0
+	for _, op := range request.Operations {
+		result := s.processBulkOperation(ctx, op, createdIDs)
+		response.Operations = append(response.Operations, result)
+		if result.Response != nil {
+			failures++
+			if request.FailOnErrors > 0 && failures >= request.FailOnErrors {
+				break
+			}
+		}
+	}
+	return response, nil
+}
+
+// processBulkOperation runs a single bulk operation, recording the id of a created resource
+// under the operation's bulkId.
+func (s *scimService) processBulkOperation(ctx context.Context, op BulkOperation,
+	createdIDs map[string]string) BulkOperationResult {
+	method := strings.ToUpper(op.Method)
+	result := BulkOperationResult{Method: method, BulkID: op.BulkID}
+	fail := func(scimErr *SCIMError) BulkOperationResult {
+		errResponse := scimErr.response()
+		result.Status = errResponse.Status
+		result.Response = &errResponse
+		return result
+	}
+
+	path, ok := resolveBulkReference(op.Path, createdIDs)
+	if !ok {
+		return fail(newBadRequestError(scimTypeInvalidValue, "The path refers to an unknown bulkId"))
+	}
+	resourceType, id, ok := parseBulkPath(path)
+	if !ok {
+		return fail(newBadRequestError(scimTypeInvalidPath, "Unsupported path: "+op.Path))
+	}
+	if (method == http.MethodPost) != (id == "") {
+		return fail(newBadRequestError(scimTypeInvalidPath, "Unsupported path for "+method+": "+op.Path))
+	}
+
+	var data interface{}
+	if len(op.Data) > 0 {
+		if err := json.Unmarshal(op.Data, &data); err != nil {
+			return fail(newBadRequestError(scimTypeInvalidSyntax, "The operation data is not valid JSON"))
+		}
+		if data, ok = resolveBulkReferences(data, createdIDs); !ok {
+			return fail(newBadRequestError(scimTypeInvalidValue, "The data refers to an unknown bulkId"))
+		}
+	}
+	resource, _ := asObject(data)
+
+	var (
+		out     Resource
+		scimErr *SCIMError
+		status  = http.StatusOK
+	)
+	switch method {
+	case http.MethodPost:
+		if op.BulkID == "" {
+			return fail(newBadRequestError(scimTypeInvalidValue, "A POST operation requires a bulkId"))
+		}
+		if resource == nil {
+			return fail(newBadRequestError(scimTypeInvalidSyntax, "A POST operation requires data"))
+		}
+		status = http.StatusCreated
+		if resourceType == resourceTypeUser {
+			out, scimErr = s.CreateUser(ctx, resource)
+		} else {
+			out, scimErr = s.CreateGroup(ctx, resource)
+		}
+	case http.MethodPut:
+		if resource == nil {
+			return fail(newBadRequestError(scimTypeInvalidSyntax, "A PUT operation requires data"))
+		}
+		if resourceType == resourceTypeUser {
+			out, scimErr = s.ReplaceUser(ctx, id, resource, op.Version)
+		} else {
+			out, scimErr = s.ReplaceGroup(ctx, id, resource, op.Version)
+		}
+	case http.MethodPatch:
+		var patch PatchRequest
+		if err := remarshal(data, &patch); err != nil {
+			return fail(newBadRequestError(scimTypeInvalidSyntax, "The operation data is not a valid PATCH request"))
+		}
+		if resourceType == resourceTypeUser {
+			out, scimErr = s.PatchUser(ctx, id, patch, op.Version)
+		} else {
+			out, scimErr = s.PatchGroup(ctx, id, patch, op.Version)
+		}
+	case http.MethodDelete:
+		status = http.StatusNoContent
+		if resourceType == resourceTypeUser {
+			scimErr = s.DeleteUser(ctx, id, op.Version)
+		} else {
+			scimErr = s.DeleteGroup(ctx, id, op.Version)
+		}
+	default:
+		return fail(newBadRequestError(scimTypeInvalidSyntax, "Unsupported method: "+op.Method))
+	}
+	if scimErr != nil {
+		return fail(scimErr)
+	}
+
+	result.Status = strconv.Itoa(status)
+	if out != nil {
+		result.Location = locationOf(out)
+		result.Version = versionOf(out)
+		if op.BulkID != "" {
+			createdIDs[op.BulkID] = getString(out, attrID)
+		}
+	} else {
+		result.Location = s.cfg.BaseURL + path
+	}
+	return result
+}
+
+// parseBulkPath splits a bulk operation path into the resource type and the resource id, which
+// is empty for the resource endpoint itself.
+func parseBulkPath(path string) (string, string, bool) {
+	for _, endpoint := range []struct{ path, resourceType string }{
+		{usersPath, resourceTypeUser},
+		{groupsPath, resourceTypeGroup},
+	} {
+		if path == endpoint.path {
+			return endpoint.resourceType, "", true
+		}
+		if id, ok := strings.CutPrefix(path, endpoint.path+"/"); ok && id != "" && !strings.Contains(id, "/") {
+			return endpoint.resourceType, id, true
+		}
+	}
+	return "", "", false
+}
+
+// resolveBulkReference replaces a "bulkId:<id>" reference within s by the id of the created
+// resource.
+func resolveBulkReference(s string, createdIDs map[string]string) (string, bool) {
+	prefix, ref, found := strings.Cut(s, bulkIDPrefix)
+	if !found {
+		return s, true
+	}
+	id, ok := createdIDs[ref]
+	return prefix + id, ok
+}
+
+// resolveBulkReferences replaces the string values of data that are "bulkId:<id>" references.
+func resolveBulkReferences(data interface{}, createdIDs map[string]string) (interface{}, bool) {
+	switch v := data.(type) {
+	case string:
+		if strings.HasPrefix(v, bulkIDPrefix) {
+			return resolveBulkReference(v, createdIDs)
+		}
+	case map[string]interface{}:
+		for key, element := range v {
+			resolved, ok := resolveBulkReferences(element, createdIDs)
+			if !ok {
+				return nil, false
+			}
+			v[key] = resolved
+		}
+	case []interface{}:
+		for i, element := range v {
+			resolved, ok := resolveBulkReferences(element, createdIDs)
+			if !ok {
+				return nil, false
+			}
+			v[i] = resolved
+		}
+	}
+	return data, true
+}
+
+// remarshal converts a generic JSON value into a typed one.
+func remarshal(in interface{}, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
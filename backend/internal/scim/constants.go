@@ -0,0 +1,98 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+// Schema and message URNs defined by RFC 7643 and RFC 7644.
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+	schemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	messageListResponse         = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	messageError                = "urn:ietf:params:scim:api:messages:2.0:Error"
+	messagePatchOp              = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	messageBulkRequest          = "urn:ietf:params:scim:api:messages:2.0:BulkRequest"
+	messageBulkResponse         = "urn:ietf:params:scim:api:messages:2.0:BulkResponse"
+	messageSearchRequest        = "urn:ietf:params:scim:api:messages:2.0:SearchRequest"
+)
+
+// ThunderID schema extensions. The User extension carries the user type, the organization unit,
+// and every user attribute that is not mapped to a core User attribute; the Group extension
+// carries the organization unit and description of a group.
+const (
+	schemaUserExtension  = "urn:thunderid:params:scim:schemas:extension:2.0:User"
+	schemaGroupExtension = "urn:thunderid:params:scim:schemas:extension:2.0:Group"
+)
+
+// Resource type names.
+const (
+	resourceTypeUser  = "User"
+	resourceTypeGroup = "Group"
+)
+
+// Endpoint paths.
+const (
+	basePath                  = "/scim2"
+	usersPath                 = "/Users"
+	groupsPath                = "/Groups"
+	schemasPath               = "/Schemas"
+	resourceTypesPath         = "/ResourceTypes"
+	serviceProviderConfigPath = "/ServiceProviderConfig"
+	bulkPath                  = "/Bulk"
+)
+
+// contentTypeSCIM is the media type of SCIM requests and responses.
+const contentTypeSCIM = "application/scim+json"
+
+// Common attribute names.
+const (
+	attrSchemas     = "schemas"
+	attrID          = "id"
+	attrMeta        = "meta"
+	attrUserName    = "userName"
+	attrActive      = "active"
+	attrGroups      = "groups"
+	attrPassword    = "password"
+	attrDisplayName = "displayName"
+	attrMembers     = "members"
+	attrValue       = "value"
+	attrType        = "type"
+	attrDisplay     = "display"
+	attrRef         = "$ref"
+	attrPrimary     = "primary"
+	attrOUID        = "ouId"
+	attrDescription = "description"
+)
+
+// Member types of group members.
+const (
+	memberTypeUser  = "User"
+	memberTypeGroup = "Group"
+)
+
+// multiValuedUserAttributes lists the multi-valued core User attributes. A user attribute mapped
+// to one of them holds the value of the primary (or first) element.
+var multiValuedUserAttributes = map[string]bool{
+	"emails":           true,
+	"phoneNumbers":     true,
+	"ims":              true,
+	"photos":           true,
+	"addresses":        true,
+	"entitlements":     true,
+	"roles":            true,
+	"x509Certificates": true,
+}
+
+// bulkIDPrefix prefixes references to resources created earlier in the same bulk request.
+const bulkIDPrefix = "bulkId:"
+
+// pageSize is the page size used when scanning users or groups to evaluate a filter in memory.
+const pageSize = 100
+
+// maxScanResults bounds the number of users or groups scanned to evaluate a filter in memory.
+const maxScanResults = 10000
+
+// maxRequestBodySize bounds the size in bytes of request bodies other than bulk requests.
+const maxRequestBodySize = 1 << 20
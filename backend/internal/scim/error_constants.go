@@ -0,0 +1,106 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/user"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// SCIM error types (RFC 7644 section 3.12).
+const (
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeTooMany       = "tooMany"
+	scimTypeUniqueness    = "uniqueness"
+	scimTypeMutability    = "mutability"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeNoTarget      = "noTarget"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeInvalidVers   = "invalidVers"
+)
+
+// SCIMError is a SCIM protocol error. It is rendered as the error response of RFC 7644 section
+// 3.12.
+type SCIMError struct {
+	Status   int
+	SCIMType string
+	Detail   string
+}
+
+// Error returns the error detail.
+func (e *SCIMError) Error() string {
+	return e.Detail
+}
+
+// errorResponse is the SCIM error response body.
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// response returns the response body of the error.
+func (e *SCIMError) response() errorResponse {
+	return errorResponse{
+		Schemas:  []string{messageError},
+		Status:   strconv.Itoa(e.Status),
+		SCIMType: e.SCIMType,
+		Detail:   e.Detail,
+	}
+}
+
+func newBadRequestError(scimType, detail string) *SCIMError {
+	return &SCIMError{Status: http.StatusBadRequest, SCIMType: scimType, Detail: detail}
+}
+
+func newNotFoundError(detail string) *SCIMError {
+	return &SCIMError{Status: http.StatusNotFound, Detail: detail}
+}
+
+var (
+	// errInternal is returned when the server fails to process a request.
+	errInternal = &SCIMError{Status: http.StatusInternalServerError, Detail: "An internal server error occurred"}
+	// errVersionMismatch is returned when If-Match does not match the current resource version.
+	errVersionMismatch = &SCIMError{Status: http.StatusPreconditionFailed,
+		Detail: "The resource has been modified since the given version"}
+	// errUserNotFound is returned when the user does not exist.
+	errUserNotFound = newNotFoundError("The user does not exist")
+	// errGroupNotFound is returned when the group does not exist.
+	errGroupNotFound = newNotFoundError("The group does not exist")
+	// errDeactivationNotSupported is returned when a request sets active to false.
+	errDeactivationNotSupported = newBadRequestError(scimTypeMutability,
+		"Deactivating users is not supported; delete the user instead")
+)
+
+// fromServiceError converts an error returned by the user or group service into a SCIM error.
+func fromServiceError(svcErr *tidcommon.ServiceError) *SCIMError {
+	if svcErr.Type != tidcommon.ClientErrorType {
+		return errInternal
+	}
+	detail := svcErr.ErrorDescription.DefaultValue
+	switch svcErr.Code {
+	case user.ErrorUserNotFound.Code, user.ErrorMissingUserID.Code:
+		return newNotFoundError(detail)
+	case group.ErrorGroupNotFound.Code, group.ErrorMissingGroupID.Code:
+		return newNotFoundError(detail)
+	case user.ErrorAttributeConflict.Code, group.ErrorGroupNameConflict.Code:
+		return &SCIMError{Status: http.StatusConflict, SCIMType: scimTypeUniqueness, Detail: detail}
+	case user.ErrorUserHasBlockingDependencies.Code:
+		return &SCIMError{Status: http.StatusConflict, Detail: detail}
+	case tidcommon.ErrorUnauthorized.Code, sysauthz.ErrorGrantNotPermitted.Code:
+		return &SCIMError{Status: http.StatusForbidden, Detail: detail}
+	case user.ErrorCannotModifyDeclarativeResource.Code, user.ErrorCredentialUpdateNotAllowed.Code,
+		group.ErrorImmutableGroup.Code, group.ErrorDeclarativeModeGroupCreateNotAllowed.Code:
+		return newBadRequestError(scimTypeMutability, detail)
+	default:
+		return newBadRequestError(scimTypeInvalidValue, detail)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/filter"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// matchesFilter reports whether a resource, or an element of a multi-valued attribute, satisfies a
+// filter. String comparisons are case-insensitive.
+func matchesFilter(node *filter.Node, r map[string]interface{}) bool {
+	switch node.Type {
+	case filter.NodeTypeAnd:
+		return matchesFilter(node.Children[0], r) && matchesFilter(node.Children[1], r)
+	case filter.NodeTypeOr:
+		return matchesFilter(node.Children[0], r) || matchesFilter(node.Children[1], r)
+	case filter.NodeTypeNot:
+		return !matchesFilter(node.Children[0], r)
+	case filter.NodeTypeValuePath:
+		container, attr := resolveSchema(r, node.Attribute)
+		v, _ := getValue(container, attr)
+		for _, element := range asList(v) {
+			if obj, ok := asObject(element); ok && matchesFilter(node.Children[0], obj) {
+				return true
+			}
+		}
+		return false
+	case filter.NodeTypeExpression:
+		return matchesExpression(node.Expr, attributeValues(r, node.Expr.Attribute))
+	}
+	return false
+}
+
+// resolveSchema strips a schema URN from an attribute path. Paths into the ThunderID extensions
+// resolve against the extension object of the resource.
+func resolveSchema(r map[string]interface{}, path string) (map[string]interface{}, string) {
+	for _, urn := range []string{schemaUserExtension, schemaGroupExtension} {
+		if len(path) > len(urn) && strings.EqualFold(path[:len(urn)+1], urn+":") {
+			ext, _ := getObject(r, urn)
+			return ext, path[len(urn)+1:]
+		}
+	}
+	for _, urn := range []string{schemaUser, schemaGroup} {
+		if len(path) > len(urn) && strings.EqualFold(path[:len(urn)+1], urn+":") {
+			return r, path[len(urn)+1:]
+		}
+	}
+	return r, path
+}
+
+// attributeValues returns the values an attribute path refers to. A multi-valued complex
+// attribute without a sub-attribute refers to the "value" of its elements.
+func attributeValues(r map[string]interface{}, path string) []interface{} {
+	container, path := resolveSchema(r, path)
+	if container == nil {
+		return nil
+	}
+	attr, sub, hasSub := strings.Cut(path, ".")
+	v, ok := getValue(container, attr)
+	if !ok {
+		return nil
+	}
+
+	var values []interface{}
+	for _, element := range asList(v) {
+		obj, isObject := asObject(element)
+		switch {
+		case isObject && hasSub:
+			if sv, ok := getValue(obj, sub); ok {
+				values = append(values, asList(sv)...)
+			}
+		case isObject:
+			if sv, ok := getValue(obj, attrValue); ok {
+				values = append(values, sv)
+			}
+		case !hasSub:
+			values = append(values, element)
+		}
+	}
+	return values
+}
+
+// asList returns the elements of a multi-valued value, or the value itself as a single element.
+func asList(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return t
+	default:
+		return []interface{}{v}
+	}
+}
+
+// matchesExpression reports whether any of the values satisfies a comparison; "ne" holds when
+// none of them equals the operand.
+func matchesExpression(expr *tidcommon.FilterExpression, values []interface{}) bool {
+	switch expr.Operator {
+	case tidcommon.OperatorPr:
+		for _, v := range values {
+			if s, ok := v.(string); !ok || s != "" {
+				return true
+			}
+		}
+		return false
+	case tidcommon.OperatorNe:
+		return !matchesExpression(&tidcommon.FilterExpression{Attribute: expr.Attribute,
+			Operator: tidcommon.OperatorEq, Value: expr.Value}, values)
+	case tidcommon.OperatorEq:
+		if expr.Value == nil {
+			return len(values) == 0
+		}
+	}
+	for _, v := range values {
+		if compareValue(expr.Operator, v, expr.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareValue applies a comparison operator to a single attribute value.
+func compareValue(op tidcommon.Operator, value, operand interface{}) bool {
+	switch o := operand.(type) {
+	case string:
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		s, o = strings.ToLower(s), strings.ToLower(o)
+		switch op {
+		case tidcommon.OperatorEq:
+			return s == o
+		case tidcommon.OperatorCo:
+			return strings.Contains(s, o)
+		case tidcommon.OperatorSw:
+			return strings.HasPrefix(s, o)
+		case tidcommon.OperatorEw:
+			return strings.HasSuffix(s, o)
+		case tidcommon.OperatorGt:
+			return s > o
+		case tidcommon.OperatorGe:
+			return s >= o
+		case tidcommon.OperatorLt:
+			return s < o
+		case tidcommon.OperatorLe:
+			return s <= o
+		}
+	case bool:
+		b, ok := value.(bool)
+		return ok && op == tidcommon.OperatorEq && b == o
+	case int64:
+		return compareNumber(op, value, float64(o))
+	case float64:
+		return compareNumber(op, value, o)
+	}
+	return false
+}
+
+func compareNumber(op tidcommon.Operator, value interface{}, operand float64) bool {
+	var n float64
+	switch t := value.(type) {
+	case float64:
+		n = t
+	case int64:
+		n = float64(t)
+	case int:
+		n = float64(t)
+	default:
+		return false
+	}
+	switch op {
+	case tidcommon.OperatorEq:
+		return n == operand
+	case tidcommon.OperatorGt:
+		return n > operand
+	case tidcommon.OperatorGe:
+		return n >= operand
+	case tidcommon.OperatorLt:
+		return n < operand
+	case tidcommon.OperatorLe:
+		return n <= operand
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/filter"
+)
+
+func TestMatchesFilter(t *testing.T) {
+	r, err := normalizeResource(newTestUserResource())
+	require.NoError(t, err)
+	r["age"] = float64(30)
+	r[attrActive] = true
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{`userName eq "ALICE"`, true},
+		{`userName ne "alice"`, false},
+		{`userName co "lic"`, true},
+		{`userName sw "al"`, true},
+		{`userName ew "ce"`, true},
+		{`userName gt "aa"`, true},
+		{`age ge 30`, true},
+		{`age lt 30`, false},
+		{`active eq true`, true},
+		{`name.givenName eq "Alice"`, true},
+		{`name.familyName pr`, false},
+		{`nickName eq null`, true},
+		{`emails eq "alice@home.example"`, true},
+		{`emails.type eq "work"`, true},
+		{`emails[type eq "work" and value co "work"]`, true},
+		{`emails[type eq "work" and value co "home"]`, false},
+		{`not (userName eq "bob")`, true},
+		{`userName eq "bob" or (emails pr and age gt 18)`, true},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice"`, true},
+		{`urn:thunderid:params:scim:schemas:extension:2.0:User:department eq "sales"`, true},
+		{`urn:thunderid:params:scim:schemas:extension:2.0:User:department eq "support"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			node, err := filter.ParseFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, matchesFilter(node, r))
+		})
+	}
+}
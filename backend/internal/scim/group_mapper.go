@@ -0,0 +1,109 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/group"
+)
+
+// memberTypes maps group member types to the SCIM member types.
+var memberTypes = map[group.MemberType]string{
+	group.MemberTypeUser:  memberTypeUser,
+	group.MemberTypeGroup: memberTypeGroup,
+	group.MemberTypeApp:   "Application",
+	group.MemberTypeAgent: "Agent",
+}
+
+// groupInput is a group as described by a SCIM Group resource.
+type groupInput struct {
+	Name        string
+	Description string
+	OUID        string
+	// Members holds the members of the group. A member without a type has its type resolved by
+	// the service.
+	Members []group.Member
+}
+
+// groupToResource converts a group into a SCIM Group resource. Members are included when
+// includeMembers is set.
+func groupToResource(g *group.Group, includeMembers bool, cfg serviceConfig) (Resource, error) {
+	ext := map[string]interface{}{attrOUID: g.OUID}
+	if g.Description != "" {
+		ext[attrDescription] = g.Description
+	}
+	r := Resource{
+		attrSchemas:          []interface{}{schemaGroup, schemaGroupExtension},
+		attrID:               g.ID,
+		attrDisplayName:      g.Name,
+		schemaGroupExtension: ext,
+	}
+
+	// The version covers the group's own attributes only, so that it is the same whether or not
+	// the members were fetched.
+	setMeta(r, resourceTypeGroup, cfg.BaseURL+groupsPath+"/"+g.ID)
+	if includeMembers {
+		members := make([]interface{}, 0, len(g.Members))
+		for _, m := range g.Members {
+			member := map[string]interface{}{attrValue: m.ID, attrType: memberTypes[m.Type]}
+			if m.Display != "" {
+				member[attrDisplay] = m.Display
+			}
+			switch m.Type {
+			case group.MemberTypeUser:
+				member[attrRef] = cfg.BaseURL + usersPath + "/" + m.ID
+			case group.MemberTypeGroup:
+				member[attrRef] = cfg.BaseURL + groupsPath + "/" + m.ID
+			}
+			members = append(members, member)
+		}
+		r[attrMembers] = members
+	}
+	return normalizeResource(r)
+}
+
+// resourceToGroup converts a SCIM Group resource into a group.
+func resourceToGroup(r Resource) (*groupInput, *SCIMError) {
+	in := &groupInput{Name: getString(r, attrDisplayName)}
+	if in.Name == "" {
+		return nil, newBadRequestError(scimTypeInvalidValue, "The displayName attribute is required")
+	}
+	if ext, ok := getObject(r, schemaGroupExtension); ok {
+		in.OUID = getString(ext, attrOUID)
+		in.Description = getString(ext, attrDescription)
+	}
+
+	v, _ := getValue(r, attrMembers)
+	for _, element := range asList(v) {
+		obj, ok := asObject(element)
+		if !ok {
+			return nil, newBadRequestError(scimTypeInvalidValue, "Each member must be an object")
+		}
+		id := getString(obj, attrValue)
+		if id == "" {
+			return nil, newBadRequestError(scimTypeInvalidValue, "Each member must have a value")
+		}
+		member := group.Member{ID: id}
+		if typeName := getString(obj, attrType); typeName != "" {
+			memberType, ok := parseMemberType(typeName)
+			if !ok {
+				return nil, newBadRequestError(scimTypeInvalidValue, "Unsupported member type: "+typeName)
+			}
+			member.Type = memberType
+		}
+		in.Members = append(in.Members, member)
+	}
+	return in, nil
+}
+
+// parseMemberType returns the group member type of a SCIM member type.
+func parseMemberType(name string) (group.MemberType, bool) {
+	for memberType, scimName := range memberTypes {
+		if strings.EqualFold(name, scimName) {
+			return memberType, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,372 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// SCIMHandlerInterface defines the HTTP handlers of the SCIM endpoints.
+type SCIMHandlerInterface interface {
+	HandleUserListRequest(w http.ResponseWriter, r *http.Request)
+	HandleUserSearchRequest(w http.ResponseWriter, r *http.Request)
+	HandleUserPostRequest(w http.ResponseWriter, r *http.Request)
+	HandleUserGetRequest(w http.ResponseWriter, r *http.Request)
+	HandleUserPutRequest(w http.ResponseWriter, r *http.Request)
+	HandleUserPatchRequest(w http.ResponseWriter, r *http.Request)
+	HandleUserDeleteRequest(w http.ResponseWriter, r *http.Request)
+	HandleGroupListRequest(w http.ResponseWriter, r *http.Request)
+	HandleGroupSearchRequest(w http.ResponseWriter, r *http.Request)
+	HandleGroupPostRequest(w http.ResponseWriter, r *http.Request)
+	HandleGroupGetRequest(w http.ResponseWriter, r *http.Request)
+	HandleGroupPutRequest(w http.ResponseWriter, r *http.Request)
+	HandleGroupPatchRequest(w http.ResponseWriter, r *http.Request)
+	HandleGroupDeleteRequest(w http.ResponseWriter, r *http.Request)
+	HandleSchemaListRequest(w http.ResponseWriter, r *http.Request)
+	HandleSchemaGetRequest(w http.ResponseWriter, r *http.Request)
+	HandleResourceTypeListRequest(w http.ResponseWriter, r *http.Request)
+	HandleResourceTypeGetRequest(w http.ResponseWriter, r *http.Request)
+	HandleServiceProviderConfigRequest(w http.ResponseWriter, r *http.Request)
+	HandleBulkRequest(w http.ResponseWriter, r *http.Request)
+}
+
+// scimHandler implements the SCIMHandlerInterface.
+type scimHandler struct {
+	cfg         serviceConfig
+	scimService SCIMServiceInterface
+	logger      *log.Logger
+}
+
+// newSCIMHandler creates a new instance of scimHandler.
+func newSCIMHandler(scimService SCIMServiceInterface, cfg serviceConfig) SCIMHandlerInterface {
+	return &scimHandler{
+		cfg:         cfg,
+		scimService: scimService,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SCIMHandler")),
+	}
+}
+
+// HandleUserListRequest handles a GET /scim2/Users request.
+func (h *scimHandler) HandleUserListRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleList(w, r, h.scimService.ListUsers)
+}
+
+// HandleUserSearchRequest handles a POST /scim2/Users/.search request.
+func (h *scimHandler) HandleUserSearchRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleSearch(w, r, h.scimService.ListUsers)
+}
+
+// HandleUserPostRequest handles a POST /scim2/Users request.
+func (h *scimHandler) HandleUserPostRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleCreate(w, r, h.scimService.CreateUser)
+}
+
+// HandleUserGetRequest handles a GET /scim2/Users/{id} request.
+func (h *scimHandler) HandleUserGetRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleGet(w, r, h.scimService.GetUser)
+}
+
+// HandleUserPutRequest handles a PUT /scim2/Users/{id} request.
+func (h *scimHandler) HandleUserPutRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleReplace(w, r, h.scimService.ReplaceUser)
+}
+
+// HandleUserPatchRequest handles a PATCH /scim2/Users/{id} request.
+func (h *scimHandler) HandleUserPatchRequest(w http.ResponseWriter, r *http.Request) {
+	h.handlePatch(w, r, h.scimService.PatchUser)
+}
+
+// HandleUserDeleteRequest handles a DELETE /scim2/Users/{id} request.
+func (h *scimHandler) HandleUserDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleDelete(w, r, h.scimService.DeleteUser)
+}
+
+// HandleGroupListRequest handles a GET /scim2/Groups request.
+func (h *scimHandler) HandleGroupListRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleList(w, r, h.scimService.ListGroups)
+}
+
+// HandleGroupSearchRequest handles a POST /scim2/Groups/.search request.
+func (h *scimHandler) HandleGroupSearchRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleSearch(w, r, h.scimService.ListGroups)
+}
+
+// HandleGroupPostRequest handles a POST /scim2/Groups request.
+func (h *scimHandler) HandleGroupPostRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleCreate(w, r, h.scimService.CreateGroup)
+}
+
+// HandleGroupGetRequest handles a GET /scim2/Groups/{id} request.
+func (h *scimHandler) HandleGroupGetRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleGet(w, r, h.scimService.GetGroup)
+}
+
+// HandleGroupPutRequest handles a PUT /scim2/Groups/{id} request.
+func (h *scimHandler) HandleGroupPutRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleReplace(w, r, h.scimService.ReplaceGroup)
+}
+
+// HandleGroupPatchRequest handles a PATCH /scim2/Groups/{id} request.
+func (h *scimHandler) HandleGroupPatchRequest(w http.ResponseWriter, r *http.Request) {
+	h.handlePatch(w, r, h.scimService.PatchGroup)
+}
+
+// HandleGroupDeleteRequest handles a DELETE /scim2/Groups/{id} request.
+func (h *scimHandler) HandleGroupDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleDelete(w, r, h.scimService.DeleteGroup)
+}
+
+// HandleSchemaListRequest handles a GET /scim2/Schemas request.
+func (h *scimHandler) HandleSchemaListRequest(w http.ResponseWriter, r *http.Request) {
+	schemas, scimErr := h.scimService.GetSchemas(r.Context())
+	if scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	h.writeResponse(w, r, http.StatusOK, newListResponse(len(schemas), 1, schemas))
+}
+
+// HandleSchemaGetRequest handles a GET /scim2/Schemas/{id} request.
+func (h *scimHandler) HandleSchemaGetRequest(w http.ResponseWriter, r *http.Request) {
+	schema, scimErr := h.scimService.GetSchema(r.Context(), r.PathValue("id"))
+	if scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	h.writeResponse(w, r, http.StatusOK, schema)
+}
+
+// HandleResourceTypeListRequest handles a GET /scim2/ResourceTypes request.
+func (h *scimHandler) HandleResourceTypeListRequest(w http.ResponseWriter, r *http.Request) {
+	resourceTypes := h.scimService.GetResourceTypes()
+	h.writeResponse(w, r, http.StatusOK, newListResponse(len(resourceTypes), 1, resourceTypes))
+}
+
+// HandleResourceTypeGetRequest handles a GET /scim2/ResourceTypes/{id} request.
+func (h *scimHandler) HandleResourceTypeGetRequest(w http.ResponseWriter, r *http.Request) {
+	resourceType, scimErr := h.scimService.GetResourceType(r.PathValue("id"))
+	if scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	h.writeResponse(w, r, http.StatusOK, resourceType)
+}
+
+// HandleServiceProviderConfigRequest handles a GET /scim2/ServiceProviderConfig request.
+func (h *scimHandler) HandleServiceProviderConfigRequest(w http.ResponseWriter, r *http.Request) {
+	h.writeResponse(w, r, http.StatusOK, h.scimService.GetServiceProviderConfig())
+}
+
+// HandleBulkRequest handles a POST /scim2/Bulk request.
+func (h *scimHandler) HandleBulkRequest(w http.ResponseWriter, r *http.Request) {
+	var request BulkRequest
+	if scimErr := h.decodeBody(w, r, h.cfg.BulkMaxPayloadSize, &request); scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	response, scimErr := h.scimService.ProcessBulk(r.Context(), request)
+	if scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+type listFunc func(ctx context.Context, query ListQuery) (*ListResponse, *SCIMError)
+
+// handleList lists resources with the filter, paging, and projection given as query parameters.
+func (h *scimHandler) handleList(w http.ResponseWriter, r *http.Request, list listFunc) {
+	params := r.URL.Query()
+	query := ListQuery{Filter: params.Get("filter")}
+	var err error
+	if v := params.Get("startIndex"); v != "" {
+		if query.StartIndex, err = strconv.Atoi(v); err != nil {
+			h.writeError(w, r, newBadRequestError(scimTypeInvalidValue, "startIndex must be an integer"))
+			return
+		}
+	}
+	if v := params.Get("count"); v != "" {
+		count, err := strconv.Atoi(v)
+		if err != nil {
+			h.writeError(w, r, newBadRequestError(scimTypeInvalidValue, "count must be an integer"))
+			return
+		}
+		query.Count = &count
+	}
+	p := projection{
+		Attributes:         parseAttributeList(params.Get("attributes")),
+		ExcludedAttributes: parseAttributeList(params.Get("excludedAttributes")),
+	}
+	h.writeList(w, r, list, query, p)
+}
+
+// handleSearch lists resources with the filter, paging, and projection given in a SearchRequest.
+func (h *scimHandler) handleSearch(w http.ResponseWriter, r *http.Request, list listFunc) {
+	var request SearchRequest
+	if scimErr := h.decodeBody(w, r, maxRequestBodySize, &request); scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	if !containsFold(request.Schemas, messageSearchRequest) {
+		h.writeError(w, r, newBadRequestError(scimTypeInvalidSyntax, "The request must use the SearchRequest schema"))
+		return
+	}
+	query := ListQuery{Filter: request.Filter, StartIndex: request.StartIndex, Count: request.Count}
+	p := projection{Attributes: request.Attributes, ExcludedAttributes: request.ExcludedAttributes}
+	h.writeList(w, r, list, query, p)
+}
+
+func (h *scimHandler) writeList(w http.ResponseWriter, r *http.Request, list listFunc, query ListQuery,
+	p projection) {
+	for _, attr := range p.Attributes {
+		if _, name, _ := splitPath(attr); strings.EqualFold(name, attrMembers) {
+			query.IncludeMembers = true
+		}
+	}
+	response, scimErr := list(r.Context(), query)
+	if scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	for i, resource := range response.Resources {
+		response.Resources[i] = p.apply(resource)
+	}
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// handleGet returns a resource, or 304 Not Modified when If-None-Match matches its version.
+func (h *scimHandler) handleGet(w http.ResponseWriter, r *http.Request,
+	get func(ctx context.Context, id string) (Resource, *SCIMError)) {
+	resource, scimErr := get(r.Context(), r.PathValue("id"))
+	if scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	version := versionOf(resource)
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" && matchesVersion(ifNoneMatch, version) {
+		w.Header().Set("ETag", version)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	h.writeResource(w, r, http.StatusOK, resource)
+}
+
+func (h *scimHandler) handleCreate(w http.ResponseWriter, r *http.Request,
+	create func(ctx context.Context, resource Resource) (Resource, *SCIMError)) {
+	var resource Resource
+	if scimErr := h.decodeBody(w, r, maxRequestBodySize, &resource); scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	created, scimErr := create(r.Context(), resource)
+	if scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	w.Header().Set("Location", locationOf(created))
+	h.writeResource(w, r, http.StatusCreated, created)
+}
+
+func (h *scimHandler) handleReplace(w http.ResponseWriter, r *http.Request,
+	replace func(ctx context.Context, id string, resource Resource, ifMatch string) (Resource, *SCIMError)) {
+	var resource Resource
+	if scimErr := h.decodeBody(w, r, maxRequestBodySize, &resource); scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	replaced, scimErr := replace(r.Context(), r.PathValue("id"), resource, r.Header.Get("If-Match"))
+	if scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	h.writeResource(w, r, http.StatusOK, replaced)
+}
+
+func (h *scimHandler) handlePatch(w http.ResponseWriter, r *http.Request,
+	patch func(ctx context.Context, id string, request PatchRequest, ifMatch string) (Resource, *SCIMError)) {
+	var request PatchRequest
+	if scimErr := h.decodeBody(w, r, maxRequestBodySize, &request); scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	patched, scimErr := patch(r.Context(), r.PathValue("id"), request, r.Header.Get("If-Match"))
+	if scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	h.writeResource(w, r, http.StatusOK, patched)
+}
+
+func (h *scimHandler) handleDelete(w http.ResponseWriter, r *http.Request,
+	remove func(ctx context.Context, id, ifMatch string) *SCIMError) {
+	if scimErr := remove(r.Context(), r.PathValue("id"), r.Header.Get("If-Match")); scimErr != nil {
+		h.writeError(w, r, scimErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decodeBody decodes a JSON request body of at most limit bytes.
+func (h *scimHandler) decodeBody(w http.ResponseWriter, r *http.Request, limit int64, v interface{}) *SCIMError {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, limit)).Decode(v); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return &SCIMError{Status: http.StatusRequestEntityTooLarge,
+				Detail: "The request body exceeds the maximum of " + strconv.FormatInt(limit, 10) + " bytes"}
+		}
+		return newBadRequestError(scimTypeInvalidSyntax, "The request body is not valid JSON")
+	}
+	return nil
+}
+
+// writeResource writes a single resource with its version as the ETag, applying the projection
+// given as query parameters.
+func (h *scimHandler) writeResource(w http.ResponseWriter, r *http.Request, status int, resource Resource) {
+	params := r.URL.Query()
+	p := projection{
+		Attributes:         parseAttributeList(params.Get("attributes")),
+		ExcludedAttributes: parseAttributeList(params.Get("excludedAttributes")),
+	}
+	if version := versionOf(resource); version != "" {
+		w.Header().Set("ETag", version)
+	}
+	h.writeResponse(w, r, status, p.apply(resource))
+}
+
+func (h *scimHandler) writeError(w http.ResponseWriter, r *http.Request, scimErr *SCIMError) {
+	h.writeResponse(w, r, scimErr.Status, scimErr.response())
+}
+
+// writeResponse writes a SCIM response body.
+func (h *scimHandler) writeResponse(w http.ResponseWriter, r *http.Request, status int, body interface{}) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		h.logger.Error(r.Context(), "Failed to encode SCIM response", log.Error(err))
+		status = http.StatusInternalServerError
+		buf.Reset()
+		_ = json.NewEncoder(&buf).Encode(errInternal.response())
+	}
+	w.Header().Set(sysconst.ContentTypeHeaderName, contentTypeSCIM)
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// containsFold reports whether values holds s, compared case-insensitively.
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,253 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SCIMHandlerTestSuite struct {
+	suite.Suite
+	mockService *SCIMServiceInterfaceMock
+	handler     SCIMHandlerInterface
+}
+
+func TestSCIMHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SCIMHandlerTestSuite))
+}
+
+func (s *SCIMHandlerTestSuite) SetupTest() {
+	s.mockService = NewSCIMServiceInterfaceMock(s.T())
+	cfg := testServiceConfig()
+	cfg.BulkMaxPayloadSize = 64
+	s.handler = newSCIMHandler(s.mockService, cfg)
+}
+
+func newHandlerTestResource() Resource {
+	r := Resource{
+		attrSchemas:  []interface{}{schemaUser},
+		attrID:       "user-1",
+		attrUserName: "alice",
+		"name":       map[string]interface{}{"givenName": "Alice", "familyName": "Smith"},
+	}
+	setMeta(r, resourceTypeUser, testBaseURL+"/Users/user-1")
+	return r
+}
+
+func (s *SCIMHandlerTestSuite) decodeBody(w *httptest.ResponseRecorder) map[string]interface{} {
+	var body map[string]interface{}
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &body))
+	return body
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleUserListRequest() {
+	s.mockService.EXPECT().ListUsers(mock.Anything, mock.MatchedBy(func(q ListQuery) bool {
+		return q.Filter == `userName eq "alice"` && q.StartIndex == 3 && q.Count != nil && *q.Count == 5
+	})).Return(newListResponse(1, 3, []Resource{newHandlerTestResource()}), nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		`/scim2/Users?filter=userName+eq+"alice"&startIndex=3&count=5&attributes=name.givenName`, nil)
+	w := httptest.NewRecorder()
+	s.handler.HandleUserListRequest(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	s.Equal(contentTypeSCIM, w.Header().Get("Content-Type"))
+	body := s.decodeBody(w)
+	s.Equal(float64(1), body["totalResults"])
+	resource := body["Resources"].([]interface{})[0].(map[string]interface{})
+	s.Equal("user-1", resource[attrID])
+	s.Equal(map[string]interface{}{"givenName": "Alice"}, resource["name"])
+	s.NotContains(resource, attrUserName)
+	s.Contains(resource, attrMeta)
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleUserListRequest_InvalidCount() {
+	req := httptest.NewRequest(http.MethodGet, "/scim2/Users?count=ten", nil)
+	w := httptest.NewRecorder()
+	s.handler.HandleUserListRequest(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+	body := s.decodeBody(w)
+	s.Equal([]interface{}{messageError}, body["schemas"])
+	s.Equal("400", body["status"])
+	s.Equal(scimTypeInvalidValue, body["scimType"])
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleGroupSearchRequest() {
+	s.mockService.EXPECT().ListGroups(mock.Anything, mock.MatchedBy(func(q ListQuery) bool {
+		return q.Filter == `displayName sw "eng"` && q.IncludeMembers
+	})).Return(newListResponse(0, 1, []Resource{}), nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/scim2/Groups/.search", strings.NewReader(
+		`{"schemas":["`+messageSearchRequest+`"],"filter":"displayName sw \"eng\"","attributes":["members"]}`))
+	w := httptest.NewRecorder()
+	s.handler.HandleGroupSearchRequest(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleUserSearchRequest_RequiresSchema() {
+	req := httptest.NewRequest(http.MethodPost, "/scim2/Users/.search", strings.NewReader(`{"filter":"id pr"}`))
+	w := httptest.NewRecorder()
+	s.handler.HandleUserSearchRequest(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+	s.Equal(scimTypeInvalidSyntax, s.decodeBody(w)["scimType"])
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleUserGetRequest() {
+	resource := newHandlerTestResource()
+	s.mockService.EXPECT().GetUser(mock.Anything, "user-1").Return(resource, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/scim2/Users/user-1?excludedAttributes=name", nil)
+	req.SetPathValue("id", "user-1")
+	w := httptest.NewRecorder()
+	s.handler.HandleUserGetRequest(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	s.Equal(versionOf(resource), w.Header().Get("ETag"))
+	body := s.decodeBody(w)
+	s.NotContains(body, "name")
+	s.Equal("alice", body[attrUserName])
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleUserGetRequest_NotModified() {
+	resource := newHandlerTestResource()
+	s.mockService.EXPECT().GetUser(mock.Anything, "user-1").Return(resource, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/scim2/Users/user-1", nil)
+	req.SetPathValue("id", "user-1")
+	req.Header.Set("If-None-Match", versionOf(resource))
+	w := httptest.NewRecorder()
+	s.handler.HandleUserGetRequest(w, req)
+
+	s.Equal(http.StatusNotModified, w.Code)
+	s.Empty(w.Body.Bytes())
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleUserGetRequest_NotFound() {
+	s.mockService.EXPECT().GetUser(mock.Anything, "missing").Return(nil, errUserNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/scim2/Users/missing", nil)
+	req.SetPathValue("id", "missing")
+	w := httptest.NewRecorder()
+	s.handler.HandleUserGetRequest(w, req)
+
+	s.Equal(http.StatusNotFound, w.Code)
+	s.Equal("404", s.decodeBody(w)["status"])
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleUserPostRequest() {
+	resource := newHandlerTestResource()
+	s.mockService.EXPECT().CreateUser(mock.Anything, mock.MatchedBy(func(r Resource) bool {
+		return r[attrUserName] == "alice"
+	})).Return(resource, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/scim2/Users", strings.NewReader(`{"userName":"alice"}`))
+	w := httptest.NewRecorder()
+	s.handler.HandleUserPostRequest(w, req)
+
+	s.Equal(http.StatusCreated, w.Code)
+	s.Equal(testBaseURL+"/Users/user-1", w.Header().Get("Location"))
+	s.Equal(versionOf(resource), w.Header().Get("ETag"))
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleUserPostRequest_InvalidJSON() {
+	req := httptest.NewRequest(http.MethodPost, "/scim2/Users", strings.NewReader(`{"userName":`))
+	w := httptest.NewRecorder()
+	s.handler.HandleUserPostRequest(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+	s.Equal(scimTypeInvalidSyntax, s.decodeBody(w)["scimType"])
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleGroupPutRequest_PassesIfMatch() {
+	s.mockService.EXPECT().ReplaceGroup(mock.Anything, "group-1", mock.Anything, `W/"abc"`).
+		Return(nil, errVersionMismatch)
+
+	req := httptest.NewRequest(http.MethodPut, "/scim2/Groups/group-1", strings.NewReader(`{"displayName":"x"}`))
+	req.SetPathValue("id", "group-1")
+	req.Header.Set("If-Match", `W/"abc"`)
+	w := httptest.NewRecorder()
+	s.handler.HandleGroupPutRequest(w, req)
+
+	s.Equal(http.StatusPreconditionFailed, w.Code)
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleUserPatchRequest() {
+	s.mockService.EXPECT().PatchUser(mock.Anything, "user-1", mock.MatchedBy(func(p PatchRequest) bool {
+		return len(p.Operations) == 1 && p.Operations[0].Op == "replace" && p.Operations[0].Path == "userName"
+	}), "").Return(newHandlerTestResource(), nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/scim2/Users/user-1", strings.NewReader(
+		`{"schemas":["`+messagePatchOp+`"],"Operations":[{"op":"replace","path":"userName","value":"bob"}]}`))
+	req.SetPathValue("id", "user-1")
+	w := httptest.NewRecorder()
+	s.handler.HandleUserPatchRequest(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleGroupDeleteRequest() {
+	s.mockService.EXPECT().DeleteGroup(mock.Anything, "group-1", "").Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/scim2/Groups/group-1", nil)
+	req.SetPathValue("id", "group-1")
+	w := httptest.NewRecorder()
+	s.handler.HandleGroupDeleteRequest(w, req)
+
+	s.Equal(http.StatusNoContent, w.Code)
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleBulkRequest_PayloadTooLarge() {
+	req := httptest.NewRequest(http.MethodPost, "/scim2/Bulk", strings.NewReader(
+		`{"schemas":["`+messageBulkRequest+`"],"Operations":[]}`))
+	w := httptest.NewRecorder()
+	s.handler.HandleBulkRequest(w, req)
+
+	s.Equal(http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleBulkRequest() {
+	s.mockService.EXPECT().ProcessBulk(mock.Anything, mock.Anything).Return(&BulkResponse{
+		Schemas:    []string{messageBulkResponse},
+		Operations: []BulkOperationResult{{Method: "DELETE", Status: "204"}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/scim2/Bulk", strings.NewReader(`{"Operations":[]}`))
+	w := httptest.NewRecorder()
+	s.handler.HandleBulkRequest(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	s.Equal([]interface{}{messageBulkResponse}, s.decodeBody(w)["schemas"])
+}
+
+func (s *SCIMHandlerTestSuite) TestHandleDiscoveryRequests() {
+	s.mockService.EXPECT().GetResourceTypes().Return([]Resource{{attrID: "User"}, {attrID: "Group"}})
+	w := httptest.NewRecorder()
+	s.handler.HandleResourceTypeListRequest(w, httptest.NewRequest(http.MethodGet, "/scim2/ResourceTypes", nil))
+	s.Equal(http.StatusOK, w.Code)
+	s.Equal(float64(2), s.decodeBody(w)["totalResults"])
+
+	s.mockService.EXPECT().GetServiceProviderConfig().Return(Resource{attrSchemas: []interface{}{
+		schemaServiceProviderConfig}})
+	w = httptest.NewRecorder()
+	s.handler.HandleServiceProviderConfigRequest(w,
+		httptest.NewRequest(http.MethodGet, "/scim2/ServiceProviderConfig", nil))
+	s.Equal(http.StatusOK, w.Code)
+
+	s.mockService.EXPECT().GetSchema(mock.Anything, "urn:unknown").Return(nil, newNotFoundError("missing"))
+	req := httptest.NewRequest(http.MethodGet, "/scim2/Schemas/urn:unknown", nil)
+	req.SetPathValue("id", "urn:unknown")
+	w = httptest.NewRecorder()
+	s.handler.HandleSchemaGetRequest(w, req)
+	s.Equal(http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// Initialize wires the SCIM 2.0 provisioning server and registers its routes under /scim2. When
+// SCIM is disabled, Initialize returns nil without error.
+func Initialize(
+	mux *http.ServeMux,
+	userService user.UserServiceInterface,
+	groupService group.GroupServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+) (SCIMServiceInterface, error) {
+	runtime := config.GetServerRuntime()
+	scimCfg := runtime.Config.SCIM
+	if !scimCfg.IsEnabled() {
+		return nil, nil
+	}
+
+	maxResults := scimCfg.MaxResults
+	if maxResults <= 0 || maxResults > serverconst.MaxPageSize {
+		maxResults = serverconst.MaxPageSize
+	}
+	cfg := serviceConfig{
+		BaseURL:            strings.TrimRight(config.GetServerURL(&runtime.Config.Server), "/") + basePath,
+		UserType:           scimCfg.UserType,
+		MaxResults:         maxResults,
+		UserAttributes:     scimCfg.UserAttributes,
+		PasswordAttribute:  scimCfg.UserAttributes[attrPassword],
+		BulkMaxOperations:  scimCfg.Bulk.MaxOperations,
+		BulkMaxPayloadSize: scimCfg.Bulk.MaxPayloadSize,
+	}
+
+	svc := newSCIMService(cfg, userService, groupService, entityTypeService)
+	registerRoutes(mux, newSCIMHandler(svc, cfg))
+	return svc, nil
+}
+
+// registerRoutes registers the SCIM endpoints.
+func registerRoutes(mux *http.ServeMux, h SCIMHandlerInterface) {
+	resourceOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	itemOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "PATCH", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	discoveryOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	noContent := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	users := basePath + usersPath
+	mux.HandleFunc(middleware.WithCORS("GET "+users, h.HandleUserListRequest, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+users, h.HandleUserPostRequest, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+users+"/.search", h.HandleUserSearchRequest, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+users, noContent, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+users+"/{id}", h.HandleUserGetRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT "+users+"/{id}", h.HandleUserPutRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("PATCH "+users+"/{id}", h.HandleUserPatchRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+users+"/{id}", h.HandleUserDeleteRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+users+"/{id}", noContent, itemOpts))
+
+	groups := basePath + groupsPath
+	mux.HandleFunc(middleware.WithCORS("GET "+groups, h.HandleGroupListRequest, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+groups, h.HandleGroupPostRequest, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+groups+"/.search", h.HandleGroupSearchRequest, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+groups, noContent, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+groups+"/{id}", h.HandleGroupGetRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT "+groups+"/{id}", h.HandleGroupPutRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("PATCH "+groups+"/{id}", h.HandleGroupPatchRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+groups+"/{id}", h.HandleGroupDeleteRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+groups+"/{id}", noContent, itemOpts))
+
+	mux.HandleFunc(middleware.WithCORS("GET "+basePath+schemasPath, h.HandleSchemaListRequest, discoveryOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+basePath+schemasPath+"/{id}", h.HandleSchemaGetRequest,
+		discoveryOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+basePath+resourceTypesPath, h.HandleResourceTypeListRequest,
+		discoveryOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+basePath+resourceTypesPath+"/{id}", h.HandleResourceTypeGetRequest,
+		discoveryOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+basePath+serviceProviderConfigPath,
+		h.HandleServiceProviderConfigRequest, discoveryOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+basePath+"/", noContent, discoveryOpts))
+
+	bulkOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST "+basePath+bulkPath, h.HandleBulkRequest, bulkOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+basePath+bulkPath, noContent, bulkOpts))
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import "encoding/json"
+
+// Resource is a SCIM resource, schema, or configuration in its JSON object form.
+type Resource map[string]interface{}
+
+// ListQuery holds the parameters of a list or search request.
+type ListQuery struct {
+	Filter string
+	// StartIndex is the 1-based index of the first result.
+	StartIndex int
+	// Count is the requested page size, or nil to use the server maximum.
+	Count *int
+	// IncludeMembers requests the members of listed groups, which are omitted by default.
+	IncludeMembers bool
+}
+
+// ListResponse is the SCIM list response.
+type ListResponse struct {
+	Schemas      []string   `json:"schemas"`
+	TotalResults int        `json:"totalResults"`
+	StartIndex   int        `json:"startIndex"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Resources    []Resource `json:"Resources"`
+}
+
+// SearchRequest is the body of a POST .search request.
+type SearchRequest struct {
+	Schemas            []string `json:"schemas"`
+	Attributes         []string `json:"attributes,omitempty"`
+	ExcludedAttributes []string `json:"excludedAttributes,omitempty"`
+	Filter             string   `json:"filter,omitempty"`
+	StartIndex         int      `json:"startIndex,omitempty"`
+	Count              *int     `json:"count,omitempty"`
+}
+
+// PatchRequest is the body of a PATCH request.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is a single add, replace, or remove operation of a PATCH request.
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// BulkRequest is the body of a bulk request.
+type BulkRequest struct {
+	Schemas      []string        `json:"schemas"`
+	FailOnErrors int             `json:"failOnErrors,omitempty"`
+	Operations   []BulkOperation `json:"Operations"`
+}
+
+// BulkOperation is a single operation of a bulk request.
+type BulkOperation struct {
+	Method  string          `json:"method"`
+	BulkID  string          `json:"bulkId,omitempty"`
+	Version string          `json:"version,omitempty"`
+	Path    string          `json:"path"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// BulkResponse is the response of a bulk request.
+type BulkResponse struct {
+	Schemas    []string              `json:"schemas"`
+	Operations []BulkOperationResult `json:"Operations"`
+}
+
+// BulkOperationResult is the outcome of a single bulk operation.
+type BulkOperationResult struct {
+	Method   string         `json:"method"`
+	BulkID   string         `json:"bulkId,omitempty"`
+	Version  string         `json:"version,omitempty"`
+	Location string         `json:"location,omitempty"`
+	Status   string         `json:"status"`
+	Response *errorResponse `json:"response,omitempty"`
+}
+
+// serviceConfig holds the settings of the SCIM service.
+type serviceConfig struct {
+	// BaseURL is the absolute URL of the /scim2 root, used in resource locations.
+	BaseURL string
+	// UserType is the user type of users created without one.
+	UserType string
+	// MaxResults caps the page size of list and search requests.
+	MaxResults int
+	// UserAttributes maps SCIM core User attribute paths to user attributes.
+	UserAttributes map[string]string
+	// PasswordAttribute is the credential attribute that SCIM passwords are written to, or empty
+	// when passwords are not accepted.
+	PasswordAttribute string
+	// BulkMaxOperations caps the number of operations of a bulk request.
+	BulkMaxOperations int
+	// BulkMaxPayloadSize caps the size in bytes of a bulk request body.
+	BulkMaxPayloadSize int64
+}
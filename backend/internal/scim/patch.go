@@ -0,0 +1,427 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/filter"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Patch operation names. They are matched case-insensitively.
+const (
+	patchOpAdd     = "add"
+	patchOpReplace = "replace"
+	patchOpRemove  = "remove"
+)
+
+// patchPath is a parsed PATCH path: [schema:]attribute[valueFilter][.subAttribute].
+type patchPath struct {
+	// extension is the extension URN when the path targets an extension, or empty.
+	extension string
+	// attribute is the top-level attribute, or empty when the path is the extension itself.
+	attribute    string
+	valueFilter  *filter.Node
+	subAttribute string
+}
+
+// parsePatchPath parses a PATCH path. extensions lists the schema extensions of the resource.
+func parsePatchPath(path string, extensions []string) (*patchPath, *SCIMError) {
+	p := &patchPath{}
+	rest := strings.TrimSpace(path)
+	for _, urn := range extensions {
+		if strings.EqualFold(rest, urn) {
+			p.extension = urn
+			return p, nil
+		}
+		if len(rest) > len(urn) && strings.EqualFold(rest[:len(urn)+1], urn+":") {
+			p.extension = urn
+			rest = rest[len(urn)+1:]
+			break
+		}
+	}
+	if p.extension == "" {
+		for _, urn := range []string{schemaUser, schemaGroup} {
+			if len(rest) > len(urn) && strings.EqualFold(rest[:len(urn)+1], urn+":") {
+				rest = rest[len(urn)+1:]
+				break
+			}
+		}
+	}
+
+	if open := strings.Index(rest, "["); open >= 0 {
+		closing := strings.LastIndex(rest, "]")
+		if closing < open {
+			return nil, newBadRequestError(scimTypeInvalidPath, "Invalid path: "+path)
+		}
+		node, err := filter.ParseFilter(rest[open+1 : closing])
+		if err != nil {
+			return nil, newBadRequestError(scimTypeInvalidFilter, "Invalid value filter in path: "+err.Error())
+		}
+		p.attribute = rest[:open]
+		p.valueFilter = node
+		tail := rest[closing+1:]
+		if tail != "" {
+			if !strings.HasPrefix(tail, ".") || len(tail) == 1 {
+				return nil, newBadRequestError(scimTypeInvalidPath, "Invalid path: "+path)
+			}
+			p.subAttribute = tail[1:]
+		}
+	} else {
+		p.attribute, p.subAttribute, _ = strings.Cut(rest, ".")
+	}
+
+	if p.attribute == "" || strings.ContainsAny(p.attribute, " ]") || strings.Contains(p.subAttribute, ".") {
+		return nil, newBadRequestError(scimTypeInvalidPath, "Invalid path: "+path)
+	}
+	return p, nil
+}
+
+// applyPatch applies PATCH operations to a resource in place. readOnly lists the attributes the
+// operations must not touch.
+func applyPatch(r Resource, ops []PatchOperation, extensions []string, readOnly []string) *SCIMError {
+	if len(ops) == 0 {
+		return newBadRequestError(scimTypeInvalidSyntax, "At least one operation is required")
+	}
+	for _, op := range ops {
+		if err := applyPatchOperation(r, op, extensions, readOnly); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyPatchOperation(r Resource, op PatchOperation, extensions []string, readOnly []string) *SCIMError {
+	name := strings.ToLower(op.Op)
+	if name != patchOpAdd && name != patchOpReplace && name != patchOpRemove {
+		return newBadRequestError(scimTypeInvalidSyntax, "Unsupported operation: "+op.Op)
+	}
+
+	if op.Path == "" {
+		if name == patchOpRemove {
+			return newBadRequestError(scimTypeNoTarget, "A remove operation requires a path")
+		}
+		values, ok := asObject(op.Value)
+		if !ok {
+			return newBadRequestError(scimTypeInvalidValue, "An operation without a path requires an object value")
+		}
+		// Each attribute of the value is applied as if it were the path of its own operation.
+		for key, value := range values {
+			path := key
+			if ext, isExtension := extensionOf(key, extensions); isExtension {
+				extValues, ok := asObject(value)
+				if !ok {
+					return newBadRequestError(scimTypeInvalidValue, "The value of "+key+" must be an object")
+				}
+				for extKey, extValue := range extValues {
+					if err := applyPatchOperation(r, PatchOperation{Op: name, Path: ext + ":" + extKey,
+						Value: extValue}, extensions, readOnly); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := applyPatchOperation(r, PatchOperation{Op: name, Path: path, Value: value},
+				extensions, readOnly); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	p, err := parsePatchPath(op.Path, extensions)
+	if err != nil {
+		return err
+	}
+	if p.extension == "" {
+		for _, attr := range readOnly {
+			if strings.EqualFold(p.attribute, attr) {
+				return newBadRequestError(scimTypeMutability, "The attribute "+p.attribute+" is read-only")
+			}
+		}
+	}
+
+	container := map[string]interface{}(r)
+	if p.extension != "" {
+		ext, ok := getObject(r, p.extension)
+		if !ok {
+			if name == patchOpRemove {
+				return nil
+			}
+			ext = map[string]interface{}{}
+			setValue(r, p.extension, ext)
+		}
+		if p.attribute == "" {
+			values, ok := asObject(op.Value)
+			if !ok && name != patchOpRemove {
+				return newBadRequestError(scimTypeInvalidValue, "The value of "+p.extension+" must be an object")
+			}
+			switch name {
+			case patchOpRemove:
+				deleteValue(r, p.extension)
+			case patchOpReplace:
+				setValue(r, p.extension, cloneValue(values))
+			default:
+				for key, value := range values {
+					addValue(ext, key, value)
+				}
+			}
+			return nil
+		}
+		container = ext
+	}
+
+	switch name {
+	case patchOpAdd:
+		return patchAdd(container, p, op.Value)
+	case patchOpReplace:
+		return patchReplace(container, p, op.Value)
+	default:
+		return patchRemove(container, p, op.Value)
+	}
+}
+
+// extensionOf returns the extension URN a key names.
+func extensionOf(key string, extensions []string) (string, bool) {
+	for _, urn := range extensions {
+		if strings.EqualFold(key, urn) {
+			return urn, true
+		}
+	}
+	return "", false
+}
+
+func patchAdd(container map[string]interface{}, p *patchPath, value interface{}) *SCIMError {
+	if p.valueFilter != nil {
+		return patchFiltered(container, p, value, true)
+	}
+	if p.subAttribute != "" {
+		return setSubAttribute(container, p, value)
+	}
+	addValue(container, p.attribute, value)
+	return nil
+}
+
+// addValue adds a value to an attribute: values are appended to a multi-valued attribute, objects
+// are merged into a complex attribute, and anything else replaces the attribute.
+func addValue(container map[string]interface{}, attr string, value interface{}) {
+	existing, _ := getValue(container, attr)
+	if list, ok := existing.([]interface{}); ok {
+		for _, v := range asList(value) {
+			if !containsValue(list, v) {
+				list = append(list, cloneValue(v))
+			}
+		}
+		setValue(container, attr, list)
+		return
+	}
+	if obj, ok := asObject(existing); ok {
+		if values, ok := asObject(value); ok {
+			for key, v := range values {
+				setValue(obj, key, cloneValue(v))
+			}
+			return
+		}
+	}
+	setValue(container, attr, cloneValue(value))
+}
+
+func patchReplace(container map[string]interface{}, p *patchPath, value interface{}) *SCIMError {
+	if p.valueFilter != nil {
+		return patchFiltered(container, p, value, false)
+	}
+	if p.subAttribute != "" {
+		return setSubAttribute(container, p, value)
+	}
+	setValue(container, p.attribute, cloneValue(value))
+	return nil
+}
+
+// setSubAttribute sets a sub-attribute of a complex attribute, or of every element of a
+// multi-valued attribute.
+func setSubAttribute(container map[string]interface{}, p *patchPath, value interface{}) *SCIMError {
+	existing, _ := getValue(container, p.attribute)
+	if list, ok := existing.([]interface{}); ok {
+		for _, element := range list {
+			if obj, ok := asObject(element); ok {
+				setValue(obj, p.subAttribute, cloneValue(value))
+			}
+		}
+		return nil
+	}
+	obj, ok := asObject(existing)
+	if !ok {
+		if existing != nil {
+			return newBadRequestError(scimTypeInvalidPath, "The attribute "+p.attribute+" is not complex")
+		}
+		obj = map[string]interface{}{}
+		setValue(container, p.attribute, obj)
+	}
+	setValue(obj, p.subAttribute, cloneValue(value))
+	return nil
+}
+
+// patchFiltered adds to or replaces the elements of a multi-valued attribute selected by a value
+// filter. When no element matches, a new element built from the filter's equality comparisons is
+// added, which is how clients such as Entra ID set values like emails[type eq "work"].value.
+func patchFiltered(container map[string]interface{}, p *patchPath, value interface{}, merge bool) *SCIMError {
+	existing, _ := getValue(container, p.attribute)
+	list := asList(existing)
+	matched := false
+	for i, element := range list {
+		obj, ok := asObject(element)
+		if !ok || !matchesFilter(p.valueFilter, obj) {
+			continue
+		}
+		matched = true
+		switch {
+		case p.subAttribute != "":
+			setValue(obj, p.subAttribute, cloneValue(value))
+		case merge:
+			values, ok := asObject(value)
+			if !ok {
+				return newBadRequestError(scimTypeInvalidValue, "The value of "+p.attribute+" must be an object")
+			}
+			for key, v := range values {
+				setValue(obj, key, cloneValue(v))
+			}
+		default:
+			list[i] = cloneValue(value)
+		}
+	}
+	if matched {
+		setValue(container, p.attribute, list)
+		return nil
+	}
+
+	element, ok := elementFromFilter(p.valueFilter)
+	if !ok {
+		return newBadRequestError(scimTypeNoTarget, "No value of "+p.attribute+" matches the filter")
+	}
+	if p.subAttribute != "" {
+		element[p.subAttribute] = cloneValue(value)
+	} else {
+		values, ok := asObject(value)
+		if !ok {
+			return newBadRequestError(scimTypeInvalidValue, "The value of "+p.attribute+" must be an object")
+		}
+		for key, v := range values {
+			element[key] = cloneValue(v)
+		}
+	}
+	setValue(container, p.attribute, append(list, element))
+	return nil
+}
+
+// elementFromFilter builds an element from a filter made only of equality comparisons joined by
+// "and".
+func elementFromFilter(node *filter.Node) (map[string]interface{}, bool) {
+	switch node.Type {
+	case filter.NodeTypeExpression:
+		if node.Expr.Operator != tidcommon.OperatorEq || strings.Contains(node.Expr.Attribute, ".") {
+			return nil, false
+		}
+		return map[string]interface{}{node.Expr.Attribute: node.Expr.Value}, true
+	case filter.NodeTypeAnd:
+		left, ok := elementFromFilter(node.Children[0])
+		if !ok {
+			return nil, false
+		}
+		right, ok := elementFromFilter(node.Children[1])
+		if !ok {
+			return nil, false
+		}
+		for k, v := range right {
+			left[k] = v
+		}
+		return left, true
+	}
+	return nil, false
+}
+
+func patchRemove(container map[string]interface{}, p *patchPath, value interface{}) *SCIMError {
+	existing, ok := getValue(container, p.attribute)
+	if !ok {
+		return nil
+	}
+	list, isList := existing.([]interface{})
+
+	if p.valueFilter != nil {
+		if !isList {
+			return nil
+		}
+		kept := make([]interface{}, 0, len(list))
+		for _, element := range list {
+			obj, ok := asObject(element)
+			if !ok || !matchesFilter(p.valueFilter, obj) {
+				kept = append(kept, element)
+				continue
+			}
+			if p.subAttribute != "" {
+				deleteValue(obj, p.subAttribute)
+				kept = append(kept, obj)
+			}
+		}
+		setOrDelete(container, p.attribute, kept)
+		return nil
+	}
+
+	if p.subAttribute != "" {
+		for _, element := range asList(existing) {
+			if obj, ok := asObject(element); ok {
+				deleteValue(obj, p.subAttribute)
+			}
+		}
+		return nil
+	}
+
+	// Some clients remove members by passing the elements to remove as the value.
+	if isList && value != nil {
+		kept := make([]interface{}, 0, len(list))
+		for _, element := range list {
+			if !containsValue(asList(value), element) {
+				kept = append(kept, element)
+			}
+		}
+		setOrDelete(container, p.attribute, kept)
+		return nil
+	}
+	deleteValue(container, p.attribute)
+	return nil
+}
+
+// setOrDelete sets a multi-valued attribute, removing it when no values remain.
+func setOrDelete(container map[string]interface{}, attr string, list []interface{}) {
+	if len(list) == 0 {
+		deleteValue(container, attr)
+		return
+	}
+	setValue(container, attr, list)
+}
+
+// containsValue reports whether list holds v. Complex values are equal when their "value"
+// sub-attributes are, so that a member is matched regardless of its display name.
+func containsValue(list []interface{}, v interface{}) bool {
+	for _, element := range list {
+		if sameValue(element, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameValue(a, b interface{}) bool {
+	objA, okA := asObject(a)
+	objB, okB := asObject(b)
+	if okA && okB {
+		valueA, hasA := getValue(objA, attrValue)
+		valueB, hasB := getValue(objB, attrValue)
+		if hasA && hasB {
+			return reflect.DeepEqual(valueA, valueB)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testUserReadOnly = []string{attrID, attrMeta, attrSchemas, attrGroups}
+
+func newTestUserResource() Resource {
+	return Resource{
+		attrSchemas:  []interface{}{schemaUser, schemaUserExtension},
+		attrID:       "user-1",
+		attrUserName: "alice",
+		"name":       map[string]interface{}{"givenName": "Alice"},
+		"emails": []interface{}{
+			map[string]interface{}{"value": "alice@work.example", "type": "work"},
+			map[string]interface{}{"value": "alice@home.example", "type": "home"},
+		},
+		schemaUserExtension: map[string]interface{}{"type": "Person", "department": "Sales"},
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		ops    []PatchOperation
+		verify func(t *testing.T, r Resource)
+	}{
+		{
+			name: "replace simple attribute case-insensitively",
+			ops:  []PatchOperation{{Op: "Replace", Path: "USERNAME", Value: "bob"}},
+			verify: func(t *testing.T, r Resource) {
+				assert.Equal(t, "bob", r[attrUserName])
+			},
+		},
+		{
+			name: "replace sub-attribute",
+			ops:  []PatchOperation{{Op: "replace", Path: "name.familyName", Value: "Smith"}},
+			verify: func(t *testing.T, r Resource) {
+				name := r["name"].(map[string]interface{})
+				assert.Equal(t, "Alice", name["givenName"])
+				assert.Equal(t, "Smith", name["familyName"])
+			},
+		},
+		{
+			name: "replace filtered sub-attribute",
+			ops: []PatchOperation{
+				{Op: "replace", Path: `emails[type eq "work"].value`, Value: "alice@new.example"},
+			},
+			verify: func(t *testing.T, r Resource) {
+				emails := r["emails"].([]interface{})
+				assert.Equal(t, "alice@new.example", emails[0].(map[string]interface{})["value"])
+				assert.Equal(t, "alice@home.example", emails[1].(map[string]interface{})["value"])
+			},
+		},
+		{
+			name: "add filtered sub-attribute without a match appends an element",
+			ops: []PatchOperation{
+				{Op: "add", Path: `phoneNumbers[type eq "mobile"].value`, Value: "+15550100"},
+			},
+			verify: func(t *testing.T, r Resource) {
+				phones := r["phoneNumbers"].([]interface{})
+				require.Len(t, phones, 1)
+				assert.Equal(t, map[string]interface{}{"type": "mobile", "value": "+15550100"}, phones[0])
+			},
+		},
+		{
+			name: "add appends to multi-valued attribute",
+			ops: []PatchOperation{{Op: "add", Path: "emails", Value: []interface{}{
+				map[string]interface{}{"value": "alice@other.example"},
+			}}},
+			verify: func(t *testing.T, r Resource) {
+				assert.Len(t, r["emails"], 3)
+			},
+		},
+		{
+			name: "remove filtered elements",
+			ops:  []PatchOperation{{Op: "remove", Path: `emails[type eq "home"]`}},
+			verify: func(t *testing.T, r Resource) {
+				emails := r["emails"].([]interface{})
+				require.Len(t, emails, 1)
+				assert.Equal(t, "work", emails[0].(map[string]interface{})["type"])
+			},
+		},
+		{
+			name: "remove elements by value",
+			ops: []PatchOperation{{Op: "remove", Path: "emails", Value: []interface{}{
+				map[string]interface{}{"value": "alice@work.example"},
+				map[string]interface{}{"value": "alice@home.example"},
+			}}},
+			verify: func(t *testing.T, r Resource) {
+				_, ok := r["emails"]
+				assert.False(t, ok)
+			},
+		},
+		{
+			name: "replace without path",
+			ops: []PatchOperation{{Op: "replace", Value: map[string]interface{}{
+				"userName":          "carol",
+				schemaUserExtension: map[string]interface{}{"department": "Support"},
+			}}},
+			verify: func(t *testing.T, r Resource) {
+				assert.Equal(t, "carol", r[attrUserName])
+				ext := r[schemaUserExtension].(map[string]interface{})
+				assert.Equal(t, "Support", ext["department"])
+				assert.Equal(t, "Person", ext["type"])
+			},
+		},
+		{
+			name: "replace extension attribute by qualified path",
+			ops: []PatchOperation{
+				{Op: "replace", Path: schemaUserExtension + ":department", Value: "Marketing"},
+			},
+			verify: func(t *testing.T, r Resource) {
+				assert.Equal(t, "Marketing", r[schemaUserExtension].(map[string]interface{})["department"])
+			},
+		},
+		{
+			name: "core schema prefix is ignored",
+			ops:  []PatchOperation{{Op: "replace", Path: schemaUser + ":userName", Value: "dave"}},
+			verify: func(t *testing.T, r Resource) {
+				assert.Equal(t, "dave", r[attrUserName])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestUserResource()
+			err := applyPatch(r, tt.ops, []string{schemaUserExtension}, testUserReadOnly)
+			require.Nil(t, err)
+			tt.verify(t, r)
+		})
+	}
+}
+
+func TestApplyPatch_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		ops      []PatchOperation
+		scimType string
+	}{
+		{name: "no operations", ops: nil, scimType: scimTypeInvalidSyntax},
+		{name: "unknown operation", ops: []PatchOperation{{Op: "move", Path: "userName"}},
+			scimType: scimTypeInvalidSyntax},
+		{name: "remove without path", ops: []PatchOperation{{Op: "remove"}}, scimType: scimTypeNoTarget},
+		{name: "read-only attribute", ops: []PatchOperation{{Op: "replace", Path: "id", Value: "x"}},
+			scimType: scimTypeMutability},
+		{name: "invalid path", ops: []PatchOperation{{Op: "replace", Path: "emails[type eq", Value: "x"}},
+			scimType: scimTypeInvalidPath},
+		{name: "invalid value filter", ops: []PatchOperation{{Op: "replace", Path: "emails[type xx \"a\"]",
+			Value: "x"}}, scimType: scimTypeInvalidFilter},
+		{name: "unmatched filter that cannot build an element",
+			ops:      []PatchOperation{{Op: "replace", Path: `emails[value co "zzz"].type`, Value: "work"}},
+			scimType: scimTypeNoTarget},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := applyPatch(newTestUserResource(), tt.ops, []string{schemaUserExtension}, testUserReadOnly)
+			require.NotNil(t, err)
+			assert.Equal(t, http.StatusBadRequest, err.Status)
+			assert.Equal(t, tt.scimType, err.SCIMType)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import "strings"
+
+// alwaysReturned lists the attributes returned regardless of the attributes parameter.
+var alwaysReturned = []string{attrSchemas, attrID, attrMeta}
+
+// projection holds the attributes and excludedAttributes parameters of a request.
+type projection struct {
+	Attributes         []string
+	ExcludedAttributes []string
+}
+
+// parseAttributeList parses a comma-separated attributes or excludedAttributes parameter.
+func parseAttributeList(value string) []string {
+	var attrs []string
+	for _, attr := range strings.Split(value, ",") {
+		if attr = strings.TrimSpace(attr); attr != "" {
+			attrs = append(attrs, attr)
+		}
+	}
+	return attrs
+}
+
+// apply returns the resource restricted to the requested attributes. The resource itself is left
+// unchanged.
+func (p projection) apply(r Resource) Resource {
+	if len(p.Attributes) > 0 {
+		out := Resource{}
+		for _, attr := range alwaysReturned {
+			if v, ok := getValue(r, attr); ok {
+				out[attr] = cloneValue(v)
+			}
+		}
+		for _, path := range p.Attributes {
+			copyPath(r, out, path)
+		}
+		return out
+	}
+
+	out := cloneResource(r)
+	for _, path := range p.ExcludedAttributes {
+		removePath(out, path)
+	}
+	return out
+}
+
+// splitPath resolves a possibly schema-qualified attribute path into the extension URN it belongs
+// to (empty for core attributes), the attribute, and the sub-attribute.
+func splitPath(path string) (extension, attr, sub string) {
+	for _, urn := range []string{schemaUserExtension, schemaGroupExtension} {
+		if strings.EqualFold(path, urn) {
+			return urn, "", ""
+		}
+		if len(path) > len(urn) && strings.EqualFold(path[:len(urn)+1], urn+":") {
+			attr, sub, _ = strings.Cut(path[len(urn)+1:], ".")
+			return urn, attr, sub
+		}
+	}
+	for _, urn := range []string{schemaUser, schemaGroup} {
+		if len(path) > len(urn) && strings.EqualFold(path[:len(urn)+1], urn+":") {
+			path = path[len(urn)+1:]
+			break
+		}
+	}
+	attr, sub, _ = strings.Cut(path, ".")
+	return "", attr, sub
+}
+
+// copyPath copies the attribute at path from src to dst.
+func copyPath(src, dst map[string]interface{}, path string) {
+	extension, attr, sub := splitPath(path)
+	if extension != "" {
+		ext, ok := getObject(src, extension)
+		if !ok {
+			return
+		}
+		if attr == "" {
+			setValue(dst, extension, cloneValue(ext))
+			return
+		}
+		dstExt, ok := getObject(dst, extension)
+		if !ok {
+			dstExt = map[string]interface{}{}
+			setValue(dst, extension, dstExt)
+		}
+		src, dst = ext, dstExt
+	}
+
+	key, ok := findKey(src, attr)
+	if !ok {
+		return
+	}
+	if sub == "" {
+		dst[key] = cloneValue(src[key])
+		return
+	}
+	switch v := src[key].(type) {
+	case []interface{}:
+		existing, _ := dst[key].([]interface{})
+		elements := make([]interface{}, len(v))
+		for i, element := range v {
+			target := map[string]interface{}{}
+			if i < len(existing) {
+				target, _ = existing[i].(map[string]interface{})
+			}
+			if obj, ok := asObject(element); ok {
+				if subKey, ok := findKey(obj, sub); ok {
+					target[subKey] = cloneValue(obj[subKey])
+				}
+			}
+			elements[i] = target
+		}
+		dst[key] = elements
+	case map[string]interface{}:
+		subKey, ok := findKey(v, sub)
+		if !ok {
+			return
+		}
+		target, ok := dst[key].(map[string]interface{})
+		if !ok {
+			target = map[string]interface{}{}
+			dst[key] = target
+		}
+		target[subKey] = cloneValue(v[subKey])
+	}
+}
+
+// removePath removes the attribute at path from r. Always-returned attributes are kept.
+func removePath(r map[string]interface{}, path string) {
+	extension, attr, sub := splitPath(path)
+	if extension == "" {
+		for _, kept := range alwaysReturned {
+			if strings.EqualFold(attr, kept) {
+				return
+			}
+		}
+	} else {
+		if attr == "" {
+			deleteValue(r, extension)
+			return
+		}
+		ext, ok := getObject(r, extension)
+		if !ok {
+			return
+		}
+		r = ext
+	}
+
+	if sub == "" {
+		deleteValue(r, attr)
+		return
+	}
+	v, _ := getValue(r, attr)
+	for _, element := range asList(v) {
+		if obj, ok := asObject(element); ok {
+			deleteValue(obj, sub)
+		}
+	}
+}
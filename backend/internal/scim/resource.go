@@ -0,0 +1,184 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// findKey returns the key of m that matches name case-insensitively, as SCIM attribute names are
+// case-insensitive.
+func findKey(m map[string]interface{}, name string) (string, bool) {
+	if _, ok := m[name]; ok {
+		return name, true
+	}
+	for key := range m {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// getValue returns the value of the named attribute of m.
+func getValue(m map[string]interface{}, name string) (interface{}, bool) {
+	key, ok := findKey(m, name)
+	if !ok {
+		return nil, false
+	}
+	return m[key], true
+}
+
+// setValue sets the named attribute of m, keeping the spelling of an existing key.
+func setValue(m map[string]interface{}, name string, value interface{}) {
+	if key, ok := findKey(m, name); ok {
+		m[key] = value
+		return
+	}
+	m[name] = value
+}
+
+// deleteValue removes the named attribute of m.
+func deleteValue(m map[string]interface{}, name string) {
+	if key, ok := findKey(m, name); ok {
+		delete(m, key)
+	}
+}
+
+// asObject returns v as a JSON object.
+func asObject(v interface{}) (map[string]interface{}, bool) {
+	switch o := v.(type) {
+	case map[string]interface{}:
+		return o, true
+	case Resource:
+		return o, true
+	}
+	return nil, false
+}
+
+// getObject returns the named object attribute of m.
+func getObject(m map[string]interface{}, name string) (map[string]interface{}, bool) {
+	v, ok := getValue(m, name)
+	if !ok {
+		return nil, false
+	}
+	return asObject(v)
+}
+
+// getString returns the named string attribute of m.
+func getString(m map[string]interface{}, name string) string {
+	v, _ := getValue(m, name)
+	s, _ := v.(string)
+	return s
+}
+
+// cloneResource returns a deep copy of a resource.
+func cloneResource(r Resource) Resource {
+	return Resource(cloneValue(map[string]interface{}(r)).(map[string]interface{}))
+}
+
+func cloneValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case Resource:
+		return cloneValue(map[string]interface{}(t))
+	case map[string]interface{}:
+		c := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			c[k] = cloneValue(e)
+		}
+		return c
+	case []interface{}:
+		c := make([]interface{}, len(t))
+		for i, e := range t {
+			c[i] = cloneValue(e)
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// normalizeResource round-trips a resource through JSON so that it holds only the generic JSON
+// types (objects, arrays, strings, float64 numbers, booleans and nil).
+func normalizeResource(r Resource) (Resource, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	var out Resource
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// resourceVersion computes the weak entity tag of a resource from its content, excluding meta.
+func resourceVersion(r Resource) string {
+	content := make(map[string]interface{}, len(r))
+	for k, v := range r {
+		if !strings.EqualFold(k, attrMeta) {
+			content[k] = v
+		}
+	}
+	data, _ := json.Marshal(content)
+	sum := sha256.Sum256(data)
+	return `W/"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// setMeta sets the meta attribute of a resource, including the version computed from its content.
+func setMeta(r Resource, resourceType, location string) {
+	r[attrMeta] = map[string]interface{}{
+		"resourceType": resourceType,
+		"location":     location,
+		"version":      resourceVersion(r),
+	}
+}
+
+// versionOf returns the version recorded in the meta attribute of a resource.
+func versionOf(r Resource) string {
+	meta, ok := getObject(r, attrMeta)
+	if !ok {
+		return ""
+	}
+	return getString(meta, "version")
+}
+
+// locationOf returns the location recorded in the meta attribute of a resource.
+func locationOf(r Resource) string {
+	meta, ok := getObject(r, attrMeta)
+	if !ok {
+		return ""
+	}
+	return getString(meta, "location")
+}
+
+// matchesVersion reports whether an If-Match value matches a resource version. An empty value
+// places no condition; "*" matches any version. Strong and weak forms of a tag are equivalent.
+func matchesVersion(ifMatch, version string) bool {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == strings.TrimPrefix(version, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isFalse reports whether a value is the boolean false, accepting the string forms some clients
+// send in PATCH requests.
+func isFalse(v interface{}) bool {
+	switch b := v.(type) {
+	case bool:
+		return !b
+	case string:
+		return strings.EqualFold(b, "false")
+	}
+	return false
+}
@@ -0,0 +1,343 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+)
+
+// Attribute characteristics (RFC 7643 section 7).
+const (
+	mutabilityReadOnly  = "readOnly"
+	mutabilityReadWrite = "readWrite"
+	mutabilityWriteOnly = "writeOnly"
+	returnedDefault     = "default"
+	returnedNever       = "never"
+	uniquenessNone      = "none"
+	uniquenessServer    = "server"
+)
+
+// attributeTypes maps user schema property types to SCIM attribute types.
+var attributeTypes = map[string]string{
+	"string":  "string",
+	"boolean": "boolean",
+	"number":  "decimal",
+	"object":  "complex",
+	"array":   "string",
+}
+
+// newAttribute returns the definition of a single-valued, optional, read-write attribute.
+func newAttribute(name, attrType, description string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        name,
+		"type":        attrType,
+		"multiValued": false,
+		"description": description,
+		"required":    false,
+		"caseExact":   false,
+		"mutability":  mutabilityReadWrite,
+		"returned":    returnedDefault,
+		"uniqueness":  uniquenessNone,
+	}
+}
+
+// newSchema returns a schema resource.
+func (s *scimService) newSchema(id, name, description string, attributes []interface{}) Resource {
+	return Resource{
+		attrSchemas:   []interface{}{schemaSchema},
+		attrID:        id,
+		"name":        name,
+		"description": description,
+		"attributes":  attributes,
+		attrMeta: map[string]interface{}{
+			"resourceType": "Schema",
+			"location":     s.cfg.BaseURL + schemasPath + "/" + id,
+		},
+	}
+}
+
+// GetSchemas returns the core and extension schemas of users and groups. The User schemas are
+// derived from the attribute mapping and the user types.
+func (s *scimService) GetSchemas(ctx context.Context) ([]Resource, *SCIMError) {
+	userSchema, scimErr := s.userSchema(ctx)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	extensionSchema, scimErr := s.userExtensionSchema(ctx)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	return []Resource{userSchema, extensionSchema, s.groupSchema(), s.groupExtensionSchema()}, nil
+}
+
+// GetSchema returns a schema by its URN.
+func (s *scimService) GetSchema(ctx context.Context, id string) (Resource, *SCIMError) {
+	schemas, scimErr := s.GetSchemas(ctx)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	for _, schema := range schemas {
+		if strings.EqualFold(getString(schema, attrID), id) {
+			return schema, nil
+		}
+	}
+	return nil, newNotFoundError("The schema does not exist")
+}
+
+// userSchema returns the core User schema, limited to the mapped attributes.
+func (s *scimService) userSchema(ctx context.Context) (Resource, *SCIMError) {
+	attrInfo, scimErr := s.userTypeAttributes(ctx, s.cfg.UserType)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+
+	subAttributes := map[string][]string{}
+	for scimPath, userAttr := range s.cfg.UserAttributes {
+		if userAttr == "" || scimPath == attrPassword {
+			continue
+		}
+		attr, sub, _ := strings.Cut(scimPath, ".")
+		subAttributes[attr] = append(subAttributes[attr], sub)
+	}
+	names := make([]string, 0, len(subAttributes))
+	for name := range subAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attributes := []interface{}{}
+	for _, name := range names {
+		var def map[string]interface{}
+		switch {
+		case multiValuedUserAttributes[name]:
+			def = newAttribute(name, "complex", "")
+			def["multiValued"] = true
+			def["subAttributes"] = []interface{}{
+				newAttribute(attrValue, "string", ""),
+				newAttribute(attrPrimary, "boolean", ""),
+			}
+		case subAttributes[name][0] != "":
+			def = newAttribute(name, "complex", "")
+			subs := []interface{}{}
+			sort.Strings(subAttributes[name])
+			for _, sub := range subAttributes[name] {
+				subs = append(subs, newAttribute(sub, attributeType(attrInfo[s.cfg.UserAttributes[name+"."+sub]]), ""))
+			}
+			def["subAttributes"] = subs
+		default:
+			info := attrInfo[s.cfg.UserAttributes[name]]
+			def = newAttribute(name, attributeType(info), info.DisplayName)
+			if info.Unique {
+				def["uniqueness"] = uniquenessServer
+			}
+		}
+		if name == attrUserName {
+			def["required"] = true
+			def["uniqueness"] = uniquenessServer
+		}
+		attributes = append(attributes, def)
+	}
+
+	active := newAttribute(attrActive, "boolean", "Whether the user is active")
+	attributes = append(attributes, active)
+	if s.cfg.PasswordAttribute != "" {
+		password := newAttribute(attrPassword, "string", "The password of the user")
+		password["mutability"] = mutabilityWriteOnly
+		password["returned"] = returnedNever
+		attributes = append(attributes, password)
+	}
+	groups := newAttribute(attrGroups, "complex", "The groups the user belongs to")
+	groups["multiValued"] = true
+	groups["mutability"] = mutabilityReadOnly
+	groups["subAttributes"] = readOnlyReferenceAttributes()
+	attributes = append(attributes, groups)
+
+	return s.newSchema(schemaUser, "User", "User Account", attributes), nil
+}
+
+// userExtensionSchema returns the ThunderID User extension schema, holding the type and
+// organization unit of a user and the unmapped attributes of all user types.
+func (s *scimService) userExtensionSchema(ctx context.Context) (Resource, *SCIMError) {
+	list, svcErr := s.entityTypeService.GetEntityTypeList(
+		ctx, entitytype.TypeCategoryUser, serverconst.MaxPageSize, 0, false)
+	if svcErr != nil {
+		return nil, fromServiceError(svcErr)
+	}
+
+	mapped := map[string]bool{}
+	for _, userAttr := range s.cfg.UserAttributes {
+		mapped[userAttr] = true
+	}
+	union := map[string]entitytype.AttributeInfo{}
+	for _, userType := range list.Types {
+		attrInfo, scimErr := s.userTypeAttributes(ctx, userType.Name)
+		if scimErr != nil {
+			return nil, scimErr
+		}
+		for name, info := range attrInfo {
+			if !mapped[name] {
+				union[name] = info
+			}
+		}
+	}
+	names := make([]string, 0, len(union))
+	for name := range union {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attributes := []interface{}{
+		newAttribute(attrType, "string", "The user type of the user"),
+		newAttribute(attrOUID, "string", "The organization unit of the user"),
+	}
+	for _, name := range names {
+		info := union[name]
+		def := newAttribute(name, attributeType(info), info.DisplayName)
+		if info.Type == "array" {
+			def["multiValued"] = true
+		}
+		if info.Unique {
+			def["uniqueness"] = uniquenessServer
+		}
+		attributes = append(attributes, def)
+	}
+	return s.newSchema(schemaUserExtension, "ThunderID User", "ThunderID User Extension", attributes), nil
+}
+
+// userTypeAttributes returns the non-credential attributes of a user type by name.
+func (s *scimService) userTypeAttributes(ctx context.Context, userType string) (
+	map[string]entitytype.AttributeInfo, *SCIMError) {
+	attrs, svcErr := s.entityTypeService.GetAttributes(ctx, entitytype.TypeCategoryUser, userType,
+		entitytype.AttributeFilter{AllowNonCredential: true})
+	if svcErr != nil {
+		return nil, fromServiceError(svcErr)
+	}
+	byName := make(map[string]entitytype.AttributeInfo, len(attrs))
+	for _, attr := range attrs {
+		byName[attr.Attribute] = attr
+	}
+	return byName, nil
+}
+
+// attributeType returns the SCIM type of a user attribute, defaulting to string.
+func attributeType(info entitytype.AttributeInfo) string {
+	if t, ok := attributeTypes[info.Type]; ok {
+		return t
+	}
+	return "string"
+}
+
+// groupSchema returns the core Group schema.
+func (s *scimService) groupSchema() Resource {
+	displayName := newAttribute(attrDisplayName, "string", "The name of the group")
+	displayName["required"] = true
+	displayName["uniqueness"] = uniquenessServer
+
+	value := newAttribute(attrValue, "string", "The id of the member")
+	value["mutability"] = "immutable"
+	ref := newAttribute(attrRef, "reference", "The URI of the member")
+	ref["mutability"] = "immutable"
+	ref["referenceTypes"] = []interface{}{memberTypeUser, memberTypeGroup}
+	memberType := newAttribute(attrType, "string", "The type of the member")
+	memberType["mutability"] = "immutable"
+	memberType["canonicalValues"] = []interface{}{memberTypeUser, memberTypeGroup, "Application", "Agent"}
+	display := newAttribute(attrDisplay, "string", "The display name of the member")
+	display["mutability"] = mutabilityReadOnly
+	members := newAttribute(attrMembers, "complex", "The members of the group")
+	members["multiValued"] = true
+	members["subAttributes"] = []interface{}{value, ref, memberType, display}
+
+	return s.newSchema(schemaGroup, "Group", "Group", []interface{}{displayName, members})
+}
+
+// groupExtensionSchema returns the ThunderID Group extension schema.
+func (s *scimService) groupExtensionSchema() Resource {
+	return s.newSchema(schemaGroupExtension, "ThunderID Group", "ThunderID Group Extension", []interface{}{
+		newAttribute(attrOUID, "string", "The organization unit of the group"),
+		newAttribute(attrDescription, "string", "The description of the group"),
+	})
+}
+
+// readOnlyReferenceAttributes returns the sub-attributes of a read-only reference to a group.
+func readOnlyReferenceAttributes() []interface{} {
+	value := newAttribute(attrValue, "string", "The id of the group")
+	ref := newAttribute(attrRef, "reference", "The URI of the group")
+	ref["referenceTypes"] = []interface{}{memberTypeGroup}
+	display := newAttribute(attrDisplay, "string", "The name of the group")
+	attributes := []interface{}{value, ref, display}
+	for _, attr := range attributes {
+		attr.(map[string]interface{})["mutability"] = mutabilityReadOnly
+	}
+	return attributes
+}
+
+// GetResourceTypes returns the User and Group resource types.
+func (s *scimService) GetResourceTypes() []Resource {
+	return []Resource{
+		s.newResourceType(resourceTypeUser, usersPath, "User Account", schemaUser, schemaUserExtension),
+		s.newResourceType(resourceTypeGroup, groupsPath, "Group", schemaGroup, schemaGroupExtension),
+	}
+}
+
+// GetResourceType returns a resource type by its id.
+func (s *scimService) GetResourceType(id string) (Resource, *SCIMError) {
+	for _, resourceType := range s.GetResourceTypes() {
+		if strings.EqualFold(getString(resourceType, attrID), id) {
+			return resourceType, nil
+		}
+	}
+	return nil, newNotFoundError("The resource type does not exist")
+}
+
+func (s *scimService) newResourceType(name, endpoint, description, schema, extension string) Resource {
+	return Resource{
+		attrSchemas:   []interface{}{schemaResourceType},
+		attrID:        name,
+		"name":        name,
+		"endpoint":    endpoint,
+		"description": description,
+		"schema":      schema,
+		"schemaExtensions": []interface{}{
+			map[string]interface{}{"schema": extension, "required": false},
+		},
+		attrMeta: map[string]interface{}{
+			"resourceType": "ResourceType",
+			"location":     s.cfg.BaseURL + resourceTypesPath + "/" + name,
+		},
+	}
+}
+
+// GetServiceProviderConfig returns the service provider configuration.
+func (s *scimService) GetServiceProviderConfig() Resource {
+	return Resource{
+		attrSchemas: []interface{}{schemaServiceProviderConfig},
+		"patch":     map[string]interface{}{"supported": true},
+		"bulk": map[string]interface{}{
+			"supported":      true,
+			"maxOperations":  s.cfg.BulkMaxOperations,
+			"maxPayloadSize": s.cfg.BulkMaxPayloadSize,
+		},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": s.cfg.MaxResults},
+		"changePassword": map[string]interface{}{"supported": s.cfg.PasswordAttribute != ""},
+		"sort":           map[string]interface{}{"supported": false},
+		"etag":           map[string]interface{}{"supported": true},
+		"authenticationSchemes": []interface{}{
+			map[string]interface{}{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication with an OAuth 2.0 bearer access token",
+				"primary":     true,
+			},
+		},
+		attrMeta: map[string]interface{}{
+			"resourceType": "ServiceProviderConfig",
+			"location":     s.cfg.BaseURL + serviceProviderConfigPath,
+		},
+	}
+}
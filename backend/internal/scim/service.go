@@ -0,0 +1,709 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/filter"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/user"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// SCIMServiceInterface defines the SCIM 2.0 operations on users, groups, and the discovery
+// resources.
+type SCIMServiceInterface interface {
+	GetUser(ctx context.Context, id string) (Resource, *SCIMError)
+	ListUsers(ctx context.Context, query ListQuery) (*ListResponse, *SCIMError)
+	CreateUser(ctx context.Context, resource Resource) (Resource, *SCIMError)
+	ReplaceUser(ctx context.Context, id string, resource Resource, ifMatch string) (Resource, *SCIMError)
+	PatchUser(ctx context.Context, id string, request PatchRequest, ifMatch string) (Resource, *SCIMError)
+	DeleteUser(ctx context.Context, id, ifMatch string) *SCIMError
+	GetGroup(ctx context.Context, id string) (Resource, *SCIMError)
+	ListGroups(ctx context.Context, query ListQuery) (*ListResponse, *SCIMError)
+	CreateGroup(ctx context.Context, resource Resource) (Resource, *SCIMError)
+	ReplaceGroup(ctx context.Context, id string, resource Resource, ifMatch string) (Resource, *SCIMError)
+	PatchGroup(ctx context.Context, id string, request PatchRequest, ifMatch string) (Resource, *SCIMError)
+	DeleteGroup(ctx context.Context, id, ifMatch string) *SCIMError
+	GetSchemas(ctx context.Context) ([]Resource, *SCIMError)
+	GetSchema(ctx context.Context, id string) (Resource, *SCIMError)
+	GetResourceTypes() []Resource
+	GetResourceType(id string) (Resource, *SCIMError)
+	GetServiceProviderConfig() Resource
+	ProcessBulk(ctx context.Context, request BulkRequest) (*BulkResponse, *SCIMError)
+}
+
+// scimService is the default implementation of the SCIMServiceInterface. It maps SCIM resources
+// onto the user and group services, which enforce authorization.
+type scimService struct {
+	cfg               serviceConfig
+	userService       user.UserServiceInterface
+	groupService      group.GroupServiceInterface
+	entityTypeService entitytype.EntityTypeServiceInterface
+	logger            *log.Logger
+}
+
+// newSCIMService creates a new instance of scimService.
+func newSCIMService(cfg serviceConfig, userService user.UserServiceInterface,
+	groupService group.GroupServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface) SCIMServiceInterface {
+	return &scimService{
+		cfg:               cfg,
+		userService:       userService,
+		groupService:      groupService,
+		entityTypeService: entityTypeService,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SCIMService")),
+	}
+}
+
+// GetUser returns a user, including the groups it belongs to.
+func (s *scimService) GetUser(ctx context.Context, id string) (Resource, *SCIMError) {
+	u, svcErr := s.userService.GetUser(ctx, id, false)
+	if svcErr != nil {
+		return nil, fromServiceError(svcErr)
+	}
+
+	groups := []providers.EntityGroup{}
+	for offset := 0; ; offset += pageSize {
+		page, svcErr := s.userService.GetUserGroups(ctx, id, pageSize, offset)
+		if svcErr != nil {
+			return nil, fromServiceError(svcErr)
+		}
+		groups = append(groups, page.Groups...)
+		if len(page.Groups) == 0 || offset+pageSize >= page.TotalResults {
+			break
+		}
+	}
+	return s.userResource(ctx, u, groups)
+}
+
+// ListUsers lists users matching a filter. Equality comparisons on mapped attributes that the filter
+// requires are evaluated by the user store; any remainder of the filter is evaluated on the users the
+// store returns, which must not exceed maxScanResults.
+func (s *scimService) ListUsers(ctx context.Context, query ListQuery) (*ListResponse, *SCIMError) {
+	startIndex, count := s.page(query)
+	if query.Filter == "" {
+		return s.listUserPage(ctx, startIndex, count, nil)
+	}
+
+	node, err := filter.ParseFilter(query.Filter)
+	if err != nil {
+		return nil, newBadRequestError(scimTypeInvalidFilter, err.Error())
+	}
+	if id, ok := idFilter(node); ok {
+		resource, scimErr := s.GetUser(ctx, id)
+		return singleResult(resource, scimErr, startIndex, count)
+	}
+	filters, residual := s.userStoreFilters(node)
+	if residual == nil {
+		return s.listUserPage(ctx, startIndex, count, filters)
+	}
+
+	var matched []Resource
+	for offset := 0; ; offset += pageSize {
+		page, svcErr := s.userService.GetUserList(ctx, pageSize, offset, filters, false)
+		if svcErr != nil {
+			return nil, fromServiceError(svcErr)
+		}
+		if page.TotalResults > maxScanResults {
+			return nil, newBadRequestError(scimTypeTooMany,
+				"The filter cannot be evaluated over this many users; filter on an equality instead")
+		}
+		for i := range page.Users {
+			resource, scimErr := s.userResource(ctx, &page.Users[i], nil)
+			if scimErr != nil {
+				return nil, scimErr
+			}
+			if matchesFilter(residual, resource) {
+				matched = append(matched, resource)
+			}
+		}
+		if len(page.Users) == 0 || offset+pageSize >= page.TotalResults {
+			break
+		}
+	}
+	return pageOf(matched, startIndex, count), nil
+}
+
+// listUserPage lists a page of users from the user store.
+func (s *scimService) listUserPage(ctx context.Context, startIndex, count int,
+	filters map[string]interface{}) (*ListResponse, *SCIMError) {
+	page, svcErr := s.userService.GetUserList(ctx, max(count, 1), startIndex-1, filters, false)
+	if svcErr != nil {
+		return nil, fromServiceError(svcErr)
+	}
+	resources := []Resource{}
+	for i := 0; i < len(page.Users) && i < count; i++ {
+		resource, scimErr := s.userResource(ctx, &page.Users[i], nil)
+		if scimErr != nil {
+			return nil, scimErr
+		}
+		resources = append(resources, resource)
+	}
+	return newListResponse(page.TotalResults, startIndex, resources), nil
+}
+
+// userStoreFilters splits a filter into the user store filters it implies and the residual filter
+// the store cannot evaluate, which is nil when the store filters are exact. Equality comparisons on
+// mapped attributes are pushed to the store when they are the filter itself or one of its and-ed
+// operands; comparisons made by the store are case-sensitive.
+func (s *scimService) userStoreFilters(node *filter.Node) (map[string]interface{}, *filter.Node) {
+	if node.Type == filter.NodeTypeAnd {
+		filters, leftResidual := s.userStoreFilters(node.Children[0])
+		rightFilters, rightResidual := s.userStoreFilters(node.Children[1])
+		for key, value := range rightFilters {
+			if existing, ok := filters[key]; ok && existing != value {
+				// Contradictory equalities on one attribute cannot be expressed as store filters, so
+				// the right operand is evaluated in full.
+				return filters, andFilter(leftResidual, node.Children[1])
+			}
+		}
+		if len(rightFilters) > 0 && filters == nil {
+			filters = make(map[string]interface{}, len(rightFilters))
+		}
+		for key, value := range rightFilters {
+			filters[key] = value
+		}
+		return filters, andFilter(leftResidual, rightResidual)
+	}
+	if key, ok := s.userStoreAttribute(node); ok {
+		return map[string]interface{}{key: node.Expr.Value}, nil
+	}
+	return nil, node
+}
+
+// andFilter returns the conjunction of two filters, either of which may be nil.
+func andFilter(left, right *filter.Node) *filter.Node {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return &filter.Node{Type: filter.NodeTypeAnd, Children: []*filter.Node{left, right}}
+}
+
+// userStoreAttribute returns the user attribute an equality filter on a mapped core or extension
+// attribute compares.
+func (s *scimService) userStoreAttribute(node *filter.Node) (string, bool) {
+	if node.Type != filter.NodeTypeExpression || node.Expr.Operator != tidcommon.OperatorEq {
+		return "", false
+	}
+	switch node.Expr.Value.(type) {
+	case string, bool:
+	default:
+		return "", false
+	}
+
+	extension, attr, sub := splitPath(node.Expr.Attribute)
+	if extension == schemaUserExtension {
+		if sub != "" || strings.EqualFold(attr, attrType) || strings.EqualFold(attr, attrOUID) ||
+			attr == s.cfg.PasswordAttribute {
+			return "", false
+		}
+		return attr, true
+	}
+	if extension != "" {
+		return "", false
+	}
+	// A multi-valued attribute holds a single value, so emails and emails.value are the same.
+	if multiValuedUserAttributes[attr] && strings.EqualFold(sub, attrValue) {
+		sub = ""
+	}
+	path := attr
+	if sub != "" {
+		path += "." + sub
+	}
+	for scimPath, userAttr := range s.cfg.UserAttributes {
+		if strings.EqualFold(scimPath, path) && scimPath != attrPassword && userAttr != "" {
+			return userAttr, true
+		}
+	}
+	return "", false
+}
+
+// CreateUser creates a user. Users are created with the configured user type, in the
+// organization unit of their type, unless the ThunderID extension names others.
+func (s *scimService) CreateUser(ctx context.Context, resource Resource) (Resource, *SCIMError) {
+	in, scimErr := resourceToUser(resource, s.cfg)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	if in.Type == "" {
+		in.Type = s.cfg.UserType
+	}
+	if in.OUID == "" {
+		userType, svcErr := s.entityTypeService.GetEntityTypeByName(ctx, entitytype.TypeCategoryUser, in.Type)
+		if svcErr != nil {
+			return nil, fromServiceError(svcErr)
+		}
+		in.OUID = userType.OUID
+	}
+	if in.Password != "" {
+		in.Attributes[s.cfg.PasswordAttribute] = in.Password
+	}
+	attributes, err := json.Marshal(in.Attributes)
+	if err != nil {
+		return nil, newBadRequestError(scimTypeInvalidValue, "The user attributes are not valid")
+	}
+
+	created, svcErr := s.userService.CreateUser(ctx, &user.User{OUID: in.OUID, Type: in.Type, Attributes: attributes})
+	if svcErr != nil {
+		return nil, fromServiceError(svcErr)
+	}
+	return s.GetUser(ctx, created.ID)
+}
+
+// ReplaceUser replaces the attributes of a user. When the resource omits the ThunderID extension,
+// the attributes it carries are kept.
+func (s *scimService) ReplaceUser(ctx context.Context, id string, resource Resource,
+	ifMatch string) (Resource, *SCIMError) {
+	current, currentResource, scimErr := s.getUserForUpdate(ctx, id, ifMatch)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	if _, ok := getObject(resource, schemaUserExtension); !ok {
+		resource = cloneResource(resource)
+		resource[schemaUserExtension] = currentResource[schemaUserExtension]
+	}
+	return s.updateUser(ctx, current, resource)
+}
+
+// PatchUser applies PATCH operations to a user.
+func (s *scimService) PatchUser(ctx context.Context, id string, request PatchRequest,
+	ifMatch string) (Resource, *SCIMError) {
+	if !containsFold(request.Schemas, messagePatchOp) {
+		return nil, newBadRequestError(scimTypeInvalidSyntax, "The request must use the PatchOp schema")
+	}
+	current, currentResource, scimErr := s.getUserForUpdate(ctx, id, ifMatch)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	patched := cloneResource(currentResource)
+	if scimErr := applyPatch(patched, request.Operations, []string{schemaUserExtension},
+		[]string{attrID, attrMeta, attrSchemas, attrGroups}); scimErr != nil {
+		return nil, scimErr
+	}
+	return s.updateUser(ctx, current, patched)
+}
+
+// getUserForUpdate returns a user and its resource, checking the resource version against an
+// If-Match value.
+func (s *scimService) getUserForUpdate(ctx context.Context, id, ifMatch string) (
+	*user.User, Resource, *SCIMError) {
+	current, svcErr := s.userService.GetUser(ctx, id, false)
+	if svcErr != nil {
+		return nil, nil, fromServiceError(svcErr)
+	}
+	resource, scimErr := s.userResource(ctx, current, nil)
+	if scimErr != nil {
+		return nil, nil, scimErr
+	}
+	if !matchesVersion(ifMatch, versionOf(resource)) {
+		return nil, nil, errVersionMismatch
+	}
+	return current, resource, nil
+}
+
+// updateUser writes a user resource over an existing user and sets its password when the
+// resource carries one.
+func (s *scimService) updateUser(ctx context.Context, current *user.User, resource Resource) (
+	Resource, *SCIMError) {
+	in, scimErr := resourceToUser(resource, s.cfg)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	if in.Type == "" {
+		in.Type = current.Type
+	}
+	if in.OUID == "" {
+		in.OUID = current.OUID
+	}
+	attributes, err := json.Marshal(in.Attributes)
+	if err != nil {
+		return nil, newBadRequestError(scimTypeInvalidValue, "The user attributes are not valid")
+	}
+
+	if _, svcErr := s.userService.UpdateUser(ctx, current.ID, &user.User{
+		ID: current.ID, OUID: in.OUID, Type: in.Type, Attributes: attributes,
+	}); svcErr != nil {
+		return nil, fromServiceError(svcErr)
+	}
+	if in.Password != "" {
+		credentials, err := json.Marshal(map[string]string{s.cfg.PasswordAttribute: in.Password})
+		if err != nil {
+			return nil, errInternal
+		}
+		if svcErr := s.userService.UpdateUserCredentials(ctx, current.ID, credentials); svcErr != nil {
+			return nil, fromServiceError(svcErr)
+		}
+	}
+	return s.GetUser(ctx, current.ID)
+}
+
+// DeleteUser deletes a user.
+func (s *scimService) DeleteUser(ctx context.Context, id, ifMatch string) *SCIMError {
+	if ifMatch != "" {
+		if _, _, scimErr := s.getUserForUpdate(ctx, id, ifMatch); scimErr != nil {
+			return scimErr
+		}
+	}
+	if svcErr := s.userService.DeleteUser(ctx, id); svcErr != nil {
+		return fromServiceError(svcErr)
+	}
+	return nil
+}
+
+// userResource converts a user into a resource.
+func (s *scimService) userResource(ctx context.Context, u *user.User,
+	groups []providers.EntityGroup) (Resource, *SCIMError) {
+	resource, err := userToResource(u, groups, s.cfg)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to convert user to a SCIM resource", log.String("id", u.ID), log.Error(err))
+		return nil, errInternal
+	}
+	return resource, nil
+}
+
+// GetGroup returns a group, including its members.
+func (s *scimService) GetGroup(ctx context.Context, id string) (Resource, *SCIMError) {
+	g, svcErr := s.groupService.GetGroup(ctx, id, true)
+	if svcErr != nil {
+		return nil, fromServiceError(svcErr)
+	}
+	return s.groupResource(ctx, g, true)
+}
+
+// ListGroups lists groups matching a filter. Filters are evaluated on the scanned groups; members
+// are fetched only when requested or filtered on.
+func (s *scimService) ListGroups(ctx context.Context, query ListQuery) (*ListResponse, *SCIMError) {
+	startIndex, count := s.page(query)
+	if query.Filter == "" {
+		page, svcErr := s.groupService.GetGroupList(ctx, max(count, 1), startIndex-1, false)
+		if svcErr != nil {
+			return nil, fromServiceError(svcErr)
+		}
+		resources := []Resource{}
+		for i := 0; i < len(page.Groups) && i < count; i++ {
+			resource, scimErr := s.listedGroupResource(ctx, page.Groups[i], query.IncludeMembers)
+			if scimErr != nil {
+				return nil, scimErr
+			}
+			resources = append(resources, resource)
+		}
+		return newListResponse(page.TotalResults, startIndex, resources), nil
+	}
+
+	node, err := filter.ParseFilter(query.Filter)
+	if err != nil {
+		return nil, newBadRequestError(scimTypeInvalidFilter, err.Error())
+	}
+	if id, ok := idFilter(node); ok {
+		resource, scimErr := s.GetGroup(ctx, id)
+		if scimErr == nil && !query.IncludeMembers {
+			delete(resource, attrMembers)
+		}
+		return singleResult(resource, scimErr, startIndex, count)
+	}
+
+	includeMembers := query.IncludeMembers || referencesAttribute(node, attrMembers)
+	var matched []Resource
+	for offset := 0; ; offset += pageSize {
+		page, svcErr := s.groupService.GetGroupList(ctx, pageSize, offset, false)
+		if svcErr != nil {
+			return nil, fromServiceError(svcErr)
+		}
+		if page.TotalResults > maxScanResults {
+			return nil, newBadRequestError(scimTypeTooMany, "The filter cannot be evaluated over this many groups")
+		}
+		for _, basic := range page.Groups {
+			resource, scimErr := s.listedGroupResource(ctx, basic, includeMembers)
+			if scimErr != nil {
+				return nil, scimErr
+			}
+			if matchesFilter(node, resource) {
+				if !query.IncludeMembers {
+					delete(resource, attrMembers)
+				}
+				matched = append(matched, resource)
+			}
+		}
+		if len(page.Groups) == 0 || offset+pageSize >= page.TotalResults {
+			break
+		}
+	}
+	return pageOf(matched, startIndex, count), nil
+}
+
+// listedGroupResource converts a listed group into a resource, fetching its members if requested.
+func (s *scimService) listedGroupResource(ctx context.Context, basic group.GroupBasic,
+	includeMembers bool) (Resource, *SCIMError) {
+	if includeMembers {
+		return s.GetGroup(ctx, basic.ID)
+	}
+	return s.groupResource(ctx, &group.Group{
+		ID: basic.ID, Name: basic.Name, Description: basic.Description, OUID: basic.OUID,
+	}, false)
+}
+
+// CreateGroup creates a group. Groups are created in the organization unit of the configured
+// user type unless the ThunderID extension names another.
+func (s *scimService) CreateGroup(ctx context.Context, resource Resource) (Resource, *SCIMError) {
+	in, scimErr := resourceToGroup(resource)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	if in.OUID == "" {
+		userType, svcErr := s.entityTypeService.GetEntityTypeByName(
+			ctx, entitytype.TypeCategoryUser, s.cfg.UserType)
+		if svcErr != nil {
+			return nil, fromServiceError(svcErr)
+		}
+		in.OUID = userType.OUID
+	}
+	if scimErr := s.resolveMemberTypes(ctx, in.Members); scimErr != nil {
+		return nil, scimErr
+	}
+
+	created, svcErr := s.groupService.CreateGroup(ctx, group.CreateGroupRequest{
+		Name: in.Name, Description: in.Description, OUID: in.OUID, Members: in.Members,
+	})
+	if svcErr != nil {
+		return nil, fromServiceError(svcErr)
+	}
+	return s.GetGroup(ctx, created.ID)
+}
+
+// ReplaceGroup replaces a group and its members. When the resource omits the ThunderID extension,
+// the organization unit and description of the group are kept.
+func (s *scimService) ReplaceGroup(ctx context.Context, id string, resource Resource,
+	ifMatch string) (Resource, *SCIMError) {
+	current, currentResource, scimErr := s.getGroupForUpdate(ctx, id, ifMatch)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	if _, ok := getObject(resource, schemaGroupExtension); !ok {
+		resource = cloneResource(resource)
+		resource[schemaGroupExtension] = currentResource[schemaGroupExtension]
+	}
+	return s.updateGroup(ctx, current, resource)
+}
+
+// PatchGroup applies PATCH operations to a group.
+func (s *scimService) PatchGroup(ctx context.Context, id string, request PatchRequest,
+	ifMatch string) (Resource, *SCIMError) {
+	if !containsFold(request.Schemas, messagePatchOp) {
+		return nil, newBadRequestError(scimTypeInvalidSyntax, "The request must use the PatchOp schema")
+	}
+	current, currentResource, scimErr := s.getGroupForUpdate(ctx, id, ifMatch)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	patched := cloneResource(currentResource)
+	if scimErr := applyPatch(patched, request.Operations, []string{schemaGroupExtension},
+		[]string{attrID, attrMeta, attrSchemas}); scimErr != nil {
+		return nil, scimErr
+	}
+	return s.updateGroup(ctx, current, patched)
+}
+
+// getGroupForUpdate returns a group and its resource, checking the resource version against an
+// If-Match value.
+func (s *scimService) getGroupForUpdate(ctx context.Context, id, ifMatch string) (
+	*group.Group, Resource, *SCIMError) {
+	current, svcErr := s.groupService.GetGroup(ctx, id, false)
+	if svcErr != nil {
+		return nil, nil, fromServiceError(svcErr)
+	}
+	resource, scimErr := s.groupResource(ctx, current, true)
+	if scimErr != nil {
+		return nil, nil, scimErr
+	}
+	if !matchesVersion(ifMatch, versionOf(resource)) {
+		return nil, nil, errVersionMismatch
+	}
+	return current, resource, nil
+}
+
+// updateGroup writes a group resource over an existing group, adding and removing members to
+// match it.
+func (s *scimService) updateGroup(ctx context.Context, current *group.Group, resource Resource) (
+	Resource, *SCIMError) {
+	in, scimErr := resourceToGroup(resource)
+	if scimErr != nil {
+		return nil, scimErr
+	}
+	if in.OUID == "" {
+		in.OUID = current.OUID
+	}
+	if in.Name != current.Name || in.Description != current.Description || in.OUID != current.OUID {
+		if _, svcErr := s.groupService.UpdateGroup(ctx, current.ID, group.UpdateGroupRequest{
+			Name: in.Name, Description: in.Description, OUID: in.OUID,
+		}); svcErr != nil {
+			return nil, fromServiceError(svcErr)
+		}
+	}
+
+	existing := make(map[string]bool, len(current.Members))
+	for _, m := range current.Members {
+		existing[m.ID] = true
+	}
+	desired := make(map[string]bool, len(in.Members))
+	var added []group.Member
+	for _, m := range in.Members {
+		desired[m.ID] = true
+		if !existing[m.ID] {
+			added = append(added, m)
+		}
+	}
+	var removed []group.Member
+	for _, m := range current.Members {
+		if !desired[m.ID] {
+			removed = append(removed, m)
+		}
+	}
+
+	if len(removed) > 0 {
+		if _, svcErr := s.groupService.RemoveGroupMembers(ctx, current.ID, removed); svcErr != nil {
+			return nil, fromServiceError(svcErr)
+		}
+	}
+	if len(added) > 0 {
+		if scimErr := s.resolveMemberTypes(ctx, added); scimErr != nil {
+			return nil, scimErr
+		}
+		if _, svcErr := s.groupService.AddGroupMembers(ctx, current.ID, added); svcErr != nil {
+			return nil, fromServiceError(svcErr)
+		}
+	}
+	return s.GetGroup(ctx, current.ID)
+}
+
+// resolveMemberTypes sets the type of members sent without one: members that are groups are
+// typed as groups and all others as users.
+func (s *scimService) resolveMemberTypes(ctx context.Context, members []group.Member) *SCIMError {
+	var untyped []string
+	for _, m := range members {
+		if m.Type == "" {
+			untyped = append(untyped, m.ID)
+		}
+	}
+	if len(untyped) == 0 {
+		return nil
+	}
+	groups, svcErr := s.groupService.GetGroupsByIDs(ctx, untyped)
+	if svcErr != nil {
+		return fromServiceError(svcErr)
+	}
+	for i := range members {
+		if members[i].Type != "" {
+			continue
+		}
+		if _, ok := groups[members[i].ID]; ok {
+			members[i].Type = group.MemberTypeGroup
+		} else {
+			members[i].Type = group.MemberTypeUser
+		}
+	}
+	return nil
+}
+
+// DeleteGroup deletes a group.
+func (s *scimService) DeleteGroup(ctx context.Context, id, ifMatch string) *SCIMError {
+	if ifMatch != "" {
+		if _, _, scimErr := s.getGroupForUpdate(ctx, id, ifMatch); scimErr != nil {
+			return scimErr
+		}
+	}
+	if svcErr := s.groupService.DeleteGroup(ctx, id); svcErr != nil {
+		return fromServiceError(svcErr)
+	}
+	return nil
+}
+
+// groupResource converts a group into a resource.
+func (s *scimService) groupResource(ctx context.Context, g *group.Group, includeMembers bool) (
+	Resource, *SCIMError) {
+	resource, err := groupToResource(g, includeMembers, s.cfg)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to convert group to a SCIM resource", log.String("id", g.ID), log.Error(err))
+		return nil, errInternal
+	}
+	return resource, nil
+}
+
+// page returns the 1-based start index and the page size of a list query.
+func (s *scimService) page(query ListQuery) (int, int) {
+	startIndex := max(query.StartIndex, 1)
+	count := s.cfg.MaxResults
+	if query.Count != nil {
+		count = min(max(*query.Count, 0), s.cfg.MaxResults)
+	}
+	return startIndex, count
+}
+
+// idFilter returns the id of a filter that is a single id equality.
+func idFilter(node *filter.Node) (string, bool) {
+	if node.Type != filter.NodeTypeExpression || node.Expr.Operator != tidcommon.OperatorEq ||
+		!strings.EqualFold(node.Expr.Attribute, attrID) {
+		return "", false
+	}
+	id, ok := node.Expr.Value.(string)
+	return id, ok
+}
+
+// referencesAttribute reports whether a filter refers to a top-level attribute.
+func referencesAttribute(node *filter.Node, attr string) bool {
+	switch node.Type {
+	case filter.NodeTypeExpression:
+		_, name, _ := splitPath(node.Expr.Attribute)
+		return strings.EqualFold(name, attr)
+	case filter.NodeTypeValuePath:
+		_, name, _ := splitPath(node.Attribute)
+		return strings.EqualFold(name, attr)
+	}
+	for _, child := range node.Children {
+		if referencesAttribute(child, attr) {
+			return true
+		}
+	}
+	return false
+}
+
+// singleResult returns the list response of a lookup by id. A resource that does not exist yields
+// an empty list.
+func singleResult(resource Resource, scimErr *SCIMError, startIndex, count int) (*ListResponse, *SCIMError) {
+	if scimErr != nil {
+		if scimErr.Status == errUserNotFound.Status {
+			return newListResponse(0, startIndex, []Resource{}), nil
+		}
+		return nil, scimErr
+	}
+	return pageOf([]Resource{resource}, startIndex, count), nil
+}
+
+// pageOf returns a page of a list of resources.
+func pageOf(resources []Resource, startIndex, count int) *ListResponse {
+	from := min(startIndex-1, len(resources))
+	to := min(from+count, len(resources))
+	page := make([]Resource, to-from)
+	copy(page, resources[from:to])
+	return newListResponse(len(resources), startIndex, page)
+}
+
+func newListResponse(total, startIndex int, resources []Resource) *ListResponse {
+	return &ListResponse{
+		Schemas:      []string{messageListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
@@ -0,0 +1,594 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/user"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/groupmock"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+const testBaseURL = "https://localhost:8090/scim2"
+
+func testServiceConfig() serviceConfig {
+	return serviceConfig{
+		BaseURL:    testBaseURL,
+		UserType:   "Person",
+		MaxResults: 100,
+		UserAttributes: map[string]string{
+			"userName":       "username",
+			"name.givenName": "given_name",
+			"emails":         "email",
+			"password":       "password",
+		},
+		PasswordAttribute:  "password",
+		BulkMaxOperations:  10,
+		BulkMaxPayloadSize: 1 << 20,
+	}
+}
+
+type SCIMServiceTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	userService       *usermock.UserServiceInterfaceMock
+	groupService      *groupmock.GroupServiceInterfaceMock
+	entityTypeService *entitytypemock.EntityTypeServiceInterfaceMock
+	service           SCIMServiceInterface
+}
+
+func TestSCIMServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SCIMServiceTestSuite))
+}
+
+func (s *SCIMServiceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.userService = usermock.NewUserServiceInterfaceMock(s.T())
+	s.groupService = groupmock.NewGroupServiceInterfaceMock(s.T())
+	s.entityTypeService = entitytypemock.NewEntityTypeServiceInterfaceMock(s.T())
+	s.service = newSCIMService(testServiceConfig(), s.userService, s.groupService, s.entityTypeService)
+}
+
+func newTestUser(id, username string) *user.User {
+	attrs, _ := json.Marshal(map[string]interface{}{
+		"username":   username,
+		"given_name": "Alice",
+		"email":      username + "@example.com",
+		"department": "Sales",
+	})
+	return &user.User{ID: id, OUID: "ou-1", Type: "Person", Attributes: attrs}
+}
+
+func (s *SCIMServiceTestSuite) expectGetUser(u *user.User) {
+	s.userService.EXPECT().GetUser(mock.Anything, u.ID, false).Return(u, nil)
+	s.userService.EXPECT().GetUserGroups(mock.Anything, u.ID, pageSize, 0).Return(&user.UserGroupListResponse{
+		TotalResults: 1,
+		Groups:       []providers.EntityGroup{{ID: "group-1", Name: "Engineering", OUID: "ou-1"}},
+	}, nil).Maybe()
+}
+
+func decodeAttributes(raw json.RawMessage) map[string]interface{} {
+	var attrs map[string]interface{}
+	_ = json.Unmarshal(raw, &attrs)
+	return attrs
+}
+
+func (s *SCIMServiceTestSuite) TestGetUser() {
+	s.expectGetUser(newTestUser("user-1", "alice"))
+
+	r, err := s.service.GetUser(s.ctx, "user-1")
+
+	s.Require().Nil(err)
+	s.Equal("user-1", r[attrID])
+	s.Equal("alice", r[attrUserName])
+	s.Equal(true, r[attrActive])
+	s.Equal(map[string]interface{}{"givenName": "Alice"}, r["name"])
+	s.Equal([]interface{}{map[string]interface{}{"value": "alice@example.com", "primary": true}}, r["emails"])
+	s.Equal(map[string]interface{}{"type": "Person", "ouId": "ou-1", "department": "Sales"},
+		r[schemaUserExtension])
+	s.Equal([]interface{}{map[string]interface{}{
+		"value": "group-1", "display": "Engineering", "$ref": testBaseURL + "/Groups/group-1",
+	}}, r[attrGroups])
+	s.Equal(testBaseURL+"/Users/user-1", locationOf(r))
+	s.NotEmpty(versionOf(r))
+}
+
+func (s *SCIMServiceTestSuite) TestGetUser_NotFound() {
+	s.userService.EXPECT().GetUser(mock.Anything, "missing", false).Return(nil, &user.ErrorUserNotFound)
+
+	_, err := s.service.GetUser(s.ctx, "missing")
+
+	s.Require().NotNil(err)
+	s.Equal(http.StatusNotFound, err.Status)
+}
+
+func (s *SCIMServiceTestSuite) TestCreateUser_DefaultsTypeAndOrganizationUnit() {
+	s.entityTypeService.EXPECT().GetEntityTypeByName(mock.Anything, entitytype.TypeCategoryUser, "Person").
+		Return(&entitytype.EntityType{Name: "Person", OUID: "ou-1"}, nil)
+	s.userService.EXPECT().CreateUser(mock.Anything, mock.MatchedBy(func(u *user.User) bool {
+		attrs := decodeAttributes(u.Attributes)
+		return u.OUID == "ou-1" && u.Type == "Person" && attrs["username"] == "alice" &&
+			attrs["email"] == "alice@example.com" && attrs["password"] == "secret"
+	})).Return(&user.User{ID: "user-1"}, nil)
+	s.expectGetUser(newTestUser("user-1", "alice"))
+
+	r, err := s.service.CreateUser(s.ctx, Resource{
+		attrSchemas:  []interface{}{schemaUser},
+		attrUserName: "alice",
+		"emails":     []interface{}{map[string]interface{}{"value": "alice@example.com"}},
+		attrPassword: "secret",
+	})
+
+	s.Require().Nil(err)
+	s.Equal("user-1", r[attrID])
+	_, hasPassword := r[attrPassword]
+	s.False(hasPassword)
+}
+
+func (s *SCIMServiceTestSuite) TestCreateUser_Invalid() {
+	tests := []struct {
+		name     string
+		resource Resource
+	}{
+		{"missing userName", Resource{"name": map[string]interface{}{"givenName": "Alice"}}},
+		{"inactive", Resource{attrUserName: "alice", attrActive: false}},
+		{"non-string password", Resource{attrUserName: "alice", attrPassword: 42.0}},
+		{"password in extension", Resource{attrUserName: "alice",
+			schemaUserExtension: map[string]interface{}{"password": "secret"}}},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.service.CreateUser(s.ctx, tt.resource)
+			s.Require().NotNil(err)
+			s.Equal(http.StatusBadRequest, err.Status)
+		})
+	}
+}
+
+func (s *SCIMServiceTestSuite) TestCreateUser_Conflict() {
+	s.entityTypeService.EXPECT().GetEntityTypeByName(mock.Anything, entitytype.TypeCategoryUser, "Person").
+		Return(&entitytype.EntityType{Name: "Person", OUID: "ou-1"}, nil)
+	s.userService.EXPECT().CreateUser(mock.Anything, mock.Anything).Return(nil, &user.ErrorAttributeConflict)
+
+	_, err := s.service.CreateUser(s.ctx, Resource{attrUserName: "alice"})
+
+	s.Require().NotNil(err)
+	s.Equal(http.StatusConflict, err.Status)
+	s.Equal(scimTypeUniqueness, err.SCIMType)
+}
+
+func (s *SCIMServiceTestSuite) TestReplaceUser_KeepsExtensionWhenOmitted() {
+	current := newTestUser("user-1", "alice")
+	s.expectGetUser(current)
+	s.userService.EXPECT().UpdateUser(mock.Anything, "user-1", mock.MatchedBy(func(u *user.User) bool {
+		attrs := decodeAttributes(u.Attributes)
+		return u.Type == "Person" && u.OUID == "ou-1" && attrs["username"] == "alice2" &&
+			attrs["department"] == "Sales" && attrs["given_name"] == nil
+	})).Return(current, nil)
+
+	_, err := s.service.ReplaceUser(s.ctx, "user-1", Resource{attrUserName: "alice2"}, "")
+
+	s.Require().Nil(err)
+}
+
+func (s *SCIMServiceTestSuite) TestReplaceUser_VersionMismatch() {
+	s.expectGetUser(newTestUser("user-1", "alice"))
+
+	_, err := s.service.ReplaceUser(s.ctx, "user-1", Resource{attrUserName: "alice"}, `W/"stale"`)
+
+	s.Require().NotNil(err)
+	s.Equal(http.StatusPreconditionFailed, err.Status)
+}
+
+func (s *SCIMServiceTestSuite) TestPatchUser() {
+	current := newTestUser("user-1", "alice")
+	s.expectGetUser(current)
+	s.userService.EXPECT().UpdateUser(mock.Anything, "user-1", mock.MatchedBy(func(u *user.User) bool {
+		attrs := decodeAttributes(u.Attributes)
+		return attrs["email"] == "alice@new.example" && attrs["department"] == "Support" &&
+			attrs["password"] == nil
+	})).Return(current, nil)
+	s.userService.EXPECT().UpdateUserCredentials(mock.Anything, "user-1",
+		json.RawMessage(`{"password":"new-secret"}`)).Return(nil)
+
+	_, err := s.service.PatchUser(s.ctx, "user-1", PatchRequest{
+		Schemas: []string{messagePatchOp},
+		Operations: []PatchOperation{
+			{Op: "replace", Path: "emails[primary eq true].value", Value: "alice@new.example"},
+			{Op: "replace", Path: schemaUserExtension + ":department", Value: "Support"},
+			{Op: "replace", Path: "password", Value: "new-secret"},
+		},
+	}, "*")
+
+	s.Require().Nil(err)
+}
+
+func (s *SCIMServiceTestSuite) TestPatchUser_Invalid() {
+	_, err := s.service.PatchUser(s.ctx, "user-1", PatchRequest{
+		Operations: []PatchOperation{{Op: "replace", Path: "userName", Value: "bob"}},
+	}, "")
+	s.Require().NotNil(err)
+	s.Equal(scimTypeInvalidSyntax, err.SCIMType)
+
+	s.expectGetUser(newTestUser("user-1", "alice"))
+	_, err = s.service.PatchUser(s.ctx, "user-1", PatchRequest{
+		Schemas:    []string{messagePatchOp},
+		Operations: []PatchOperation{{Op: "replace", Path: "active", Value: "False"}},
+	}, "")
+	s.Require().NotNil(err)
+	s.Equal(errDeactivationNotSupported, err)
+}
+
+func (s *SCIMServiceTestSuite) TestDeleteUser() {
+	s.userService.EXPECT().DeleteUser(mock.Anything, "user-1").Return(nil)
+	s.Nil(s.service.DeleteUser(s.ctx, "user-1", ""))
+
+	s.userService.EXPECT().DeleteUser(mock.Anything, "user-2").Return(&user.ErrorUserHasBlockingDependencies)
+	err := s.service.DeleteUser(s.ctx, "user-2", "")
+	s.Require().NotNil(err)
+	s.Equal(http.StatusConflict, err.Status)
+}
+
+func (s *SCIMServiceTestSuite) TestListUsers_PushesDownEquality() {
+	s.userService.EXPECT().GetUserList(mock.Anything, 2, 4, map[string]interface{}{"email": "alice@example.com"},
+		false).Return(&user.UserListResponse{TotalResults: 6, Users: []user.User{*newTestUser("user-1", "alice")}}, nil)
+
+	count := 2
+	resp, err := s.service.ListUsers(s.ctx, ListQuery{
+		Filter: `emails.value eq "alice@example.com"`, StartIndex: 5, Count: &count,
+	})
+
+	s.Require().Nil(err)
+	s.Equal([]string{messageListResponse}, resp.Schemas)
+	s.Equal(6, resp.TotalResults)
+	s.Equal(5, resp.StartIndex)
+	s.Equal(1, resp.ItemsPerPage)
+	_, hasGroups := resp.Resources[0][attrGroups]
+	s.False(hasGroups)
+}
+
+func (s *SCIMServiceTestSuite) TestListUsers_PushesDownConjunctionOfEqualities() {
+	s.userService.EXPECT().GetUserList(mock.Anything, 2, 0,
+		map[string]interface{}{"username": "alice", "email": "alice@example.com", "department": "Sales"}, false).
+		Return(&user.UserListResponse{TotalResults: 1, Users: []user.User{*newTestUser("user-1", "alice")}}, nil)
+
+	count := 2
+	resp, err := s.service.ListUsers(s.ctx, ListQuery{
+		Filter: `userName eq "alice" and (emails eq "alice@example.com" and ` +
+			`urn:thunderid:params:scim:schemas:extension:2.0:User:department eq "Sales")`,
+		Count: &count,
+	})
+
+	s.Require().Nil(err)
+	s.Equal(1, resp.TotalResults)
+	s.Require().Len(resp.Resources, 1)
+}
+
+// TestListUsers_NarrowsScanWithStoreFilters verifies that the equality operands of a filter the store
+// cannot evaluate in full are still pushed to the store, so only the narrowed users are scanned.
+func (s *SCIMServiceTestSuite) TestListUsers_NarrowsScanWithStoreFilters() {
+	s.userService.EXPECT().GetUserList(mock.Anything, pageSize, 0, map[string]interface{}{"email": "alex@example.com"},
+		false).Return(&user.UserListResponse{TotalResults: 2, Users: []user.User{
+		*newTestUser("user-1", "alex"), *newTestUser("user-2", "bob"),
+	}}, nil)
+
+	resp, err := s.service.ListUsers(s.ctx, ListQuery{Filter: `emails eq "alex@example.com" and userName sw "AL"`})
+
+	s.Require().Nil(err)
+	s.Equal(1, resp.TotalResults)
+	s.Require().Len(resp.Resources, 1)
+	s.Equal("user-1", resp.Resources[0][attrID])
+}
+
+func (s *SCIMServiceTestSuite) TestListUsers_ContradictoryEqualitiesAreEvaluated() {
+	s.userService.EXPECT().GetUserList(mock.Anything, pageSize, 0, map[string]interface{}{"username": "alice"},
+		false).Return(&user.UserListResponse{TotalResults: 1, Users: []user.User{*newTestUser("user-1", "alice")}},
+		nil)
+
+	resp, err := s.service.ListUsers(s.ctx, ListQuery{Filter: `userName eq "alice" and userName eq "bob"`})
+
+	s.Require().Nil(err)
+	s.Equal(0, resp.TotalResults)
+	s.Empty(resp.Resources)
+}
+
+func (s *SCIMServiceTestSuite) TestListUsers_ScansOtherFilters() {
+	s.userService.EXPECT().GetUserList(mock.Anything, pageSize, 0, map[string]interface{}(nil), false).
+		Return(&user.UserListResponse{TotalResults: 3, Users: []user.User{
+			*newTestUser("user-1", "alice"), *newTestUser("user-2", "bob"), *newTestUser("user-3", "alex"),
+		}}, nil)
+
+	count := 1
+	resp, err := s.service.ListUsers(s.ctx, ListQuery{Filter: `userName sw "AL"`, StartIndex: 2, Count: &count})
+
+	s.Require().Nil(err)
+	s.Equal(2, resp.TotalResults)
+	s.Require().Len(resp.Resources, 1)
+	s.Equal("user-3", resp.Resources[0][attrID])
+}
+
+func (s *SCIMServiceTestSuite) TestListUsers_TooManyToScan() {
+	s.userService.EXPECT().GetUserList(mock.Anything, pageSize, 0, map[string]interface{}(nil), false).
+		Return(&user.UserListResponse{TotalResults: maxScanResults + 1}, nil)
+
+	_, err := s.service.ListUsers(s.ctx, ListQuery{Filter: `userName co "a"`})
+
+	s.Require().NotNil(err)
+	s.Equal(scimTypeTooMany, err.SCIMType)
+}
+
+func (s *SCIMServiceTestSuite) TestListUsers_IDFilter() {
+	s.userService.EXPECT().GetUser(mock.Anything, "missing", false).Return(nil, &user.ErrorUserNotFound)
+
+	resp, err := s.service.ListUsers(s.ctx, ListQuery{Filter: `id eq "missing"`})
+
+	s.Require().Nil(err)
+	s.Equal(0, resp.TotalResults)
+	s.Empty(resp.Resources)
+}
+
+func (s *SCIMServiceTestSuite) TestListUsers_InvalidFilter() {
+	_, err := s.service.ListUsers(s.ctx, ListQuery{Filter: `userName eq`})
+
+	s.Require().NotNil(err)
+	s.Equal(scimTypeInvalidFilter, err.SCIMType)
+}
+
+func newTestGroup(members ...group.Member) *group.Group {
+	return &group.Group{ID: "group-1", Name: "Engineering", OUID: "ou-1", Members: members}
+}
+
+func (s *SCIMServiceTestSuite) TestGetGroup() {
+	s.groupService.EXPECT().GetGroup(mock.Anything, "group-1", true).Return(newTestGroup(
+		group.Member{ID: "user-1", Type: group.MemberTypeUser, Display: "alice"},
+		group.Member{ID: "app-1", Type: group.MemberTypeApp},
+	), nil)
+
+	r, err := s.service.GetGroup(s.ctx, "group-1")
+
+	s.Require().Nil(err)
+	s.Equal("Engineering", r[attrDisplayName])
+	s.Equal([]interface{}{
+		map[string]interface{}{"value": "user-1", "type": "User", "display": "alice",
+			"$ref": testBaseURL + "/Users/user-1"},
+		map[string]interface{}{"value": "app-1", "type": "Application"},
+	}, r[attrMembers])
+	s.Equal(map[string]interface{}{"ouId": "ou-1"}, r[schemaGroupExtension])
+}
+
+func (s *SCIMServiceTestSuite) TestCreateGroup_ResolvesMemberTypes() {
+	s.entityTypeService.EXPECT().GetEntityTypeByName(mock.Anything, entitytype.TypeCategoryUser, "Person").
+		Return(&entitytype.EntityType{Name: "Person", OUID: "ou-1"}, nil)
+	s.groupService.EXPECT().GetGroupsByIDs(mock.Anything, []string{"user-1", "group-2"}).
+		Return(map[string]*group.Group{"group-2": {ID: "group-2"}}, nil)
+	s.groupService.EXPECT().CreateGroup(mock.Anything, group.CreateGroupRequest{
+		Name: "Engineering", OUID: "ou-1", Members: []group.Member{
+			{ID: "user-1", Type: group.MemberTypeUser},
+			{ID: "group-2", Type: group.MemberTypeGroup},
+			{ID: "agent-1", Type: group.MemberTypeAgent},
+		},
+	}).Return(newTestGroup(), nil)
+	s.groupService.EXPECT().GetGroup(mock.Anything, "group-1", true).Return(newTestGroup(), nil)
+
+	_, err := s.service.CreateGroup(s.ctx, Resource{
+		attrDisplayName: "Engineering",
+		attrMembers: []interface{}{
+			map[string]interface{}{"value": "user-1"},
+			map[string]interface{}{"value": "group-2"},
+			map[string]interface{}{"value": "agent-1", "type": "agent"},
+		},
+	})
+
+	s.Require().Nil(err)
+}
+
+func (s *SCIMServiceTestSuite) TestCreateGroup_Invalid() {
+	_, err := s.service.CreateGroup(s.ctx, Resource{attrMembers: []interface{}{}})
+	s.Require().NotNil(err)
+	s.Equal(http.StatusBadRequest, err.Status)
+
+	_, err = s.service.CreateGroup(s.ctx, Resource{
+		attrDisplayName: "Engineering",
+		attrMembers:     []interface{}{map[string]interface{}{"value": "x", "type": "Robot"}},
+	})
+	s.Require().NotNil(err)
+	s.Equal(scimTypeInvalidValue, err.SCIMType)
+}
+
+func (s *SCIMServiceTestSuite) TestPatchGroup_UpdatesMembers() {
+	current := newTestGroup(
+		group.Member{ID: "user-1", Type: group.MemberTypeUser},
+		group.Member{ID: "user-2", Type: group.MemberTypeUser},
+	)
+	s.groupService.EXPECT().GetGroup(mock.Anything, "group-1", false).Return(current, nil)
+	s.groupService.EXPECT().RemoveGroupMembers(mock.Anything, "group-1",
+		[]group.Member{{ID: "user-1", Type: group.MemberTypeUser}}).Return(current, nil)
+	s.groupService.EXPECT().GetGroupsByIDs(mock.Anything, []string{"user-3"}).
+		Return(map[string]*group.Group{}, nil)
+	s.groupService.EXPECT().AddGroupMembers(mock.Anything, "group-1",
+		[]group.Member{{ID: "user-3", Type: group.MemberTypeUser}}).Return(current, nil)
+	s.groupService.EXPECT().GetGroup(mock.Anything, "group-1", true).Return(current, nil)
+
+	_, err := s.service.PatchGroup(s.ctx, "group-1", PatchRequest{
+		Schemas: []string{messagePatchOp},
+		Operations: []PatchOperation{
+			{Op: "add", Path: "members", Value: []interface{}{map[string]interface{}{"value": "user-3"}}},
+			{Op: "remove", Path: `members[value eq "user-1"]`},
+		},
+	}, "")
+
+	s.Require().Nil(err)
+}
+
+func (s *SCIMServiceTestSuite) TestPatchGroup_Rename() {
+	current := newTestGroup()
+	s.groupService.EXPECT().GetGroup(mock.Anything, "group-1", false).Return(current, nil)
+	s.groupService.EXPECT().UpdateGroup(mock.Anything, "group-1", group.UpdateGroupRequest{
+		Name: "Platform", Description: "Platform team", OUID: "ou-1",
+	}).Return(current, nil)
+	s.groupService.EXPECT().GetGroup(mock.Anything, "group-1", true).Return(current, nil)
+
+	_, err := s.service.PatchGroup(s.ctx, "group-1", PatchRequest{
+		Schemas: []string{messagePatchOp},
+		Operations: []PatchOperation{{Op: "replace", Value: map[string]interface{}{
+			"displayName":        "Platform",
+			schemaGroupExtension: map[string]interface{}{"description": "Platform team"},
+		}}},
+	}, "")
+
+	s.Require().Nil(err)
+}
+
+func (s *SCIMServiceTestSuite) TestListGroups() {
+	s.groupService.EXPECT().GetGroupList(mock.Anything, pageSize, 0, false).Return(&group.GroupListResponse{
+		TotalResults: 2,
+		Groups: []group.GroupBasic{
+			{ID: "group-1", Name: "Engineering", OUID: "ou-1"},
+			{ID: "group-2", Name: "Sales", OUID: "ou-1"},
+		},
+	}, nil)
+
+	resp, err := s.service.ListGroups(s.ctx, ListQuery{Filter: `displayName eq "sales"`})
+
+	s.Require().Nil(err)
+	s.Equal(1, resp.TotalResults)
+	s.Equal("group-2", resp.Resources[0][attrID])
+	_, hasMembers := resp.Resources[0][attrMembers]
+	s.False(hasMembers)
+}
+
+func (s *SCIMServiceTestSuite) TestProcessBulk_ResolvesBulkIDs() {
+	s.entityTypeService.EXPECT().GetEntityTypeByName(mock.Anything, entitytype.TypeCategoryUser, "Person").
+		Return(&entitytype.EntityType{Name: "Person", OUID: "ou-1"}, nil)
+	s.userService.EXPECT().CreateUser(mock.Anything, mock.Anything).Return(&user.User{ID: "user-1"}, nil)
+	s.expectGetUser(newTestUser("user-1", "alice"))
+	s.groupService.EXPECT().CreateGroup(mock.Anything, group.CreateGroupRequest{
+		Name: "Engineering", OUID: "ou-1",
+		Members: []group.Member{{ID: "user-1", Type: group.MemberTypeUser}},
+	}).Return(newTestGroup(), nil)
+	s.groupService.EXPECT().GetGroup(mock.Anything, "group-1", true).Return(newTestGroup(), nil)
+
+	resp, err := s.service.ProcessBulk(s.ctx, BulkRequest{
+		Schemas: []string{messageBulkRequest},
+		Operations: []BulkOperation{
+			{Method: "POST", BulkID: "u1", Path: "/Users", Data: json.RawMessage(`{"userName":"alice"}`)},
+			{Method: "POST", BulkID: "g1", Path: "/Groups", Data: json.RawMessage(
+				`{"displayName":"Engineering","members":[{"value":"bulkId:u1","type":"User"}]}`)},
+		},
+	})
+
+	s.Require().Nil(err)
+	s.Require().Len(resp.Operations, 2)
+	s.Equal("201", resp.Operations[0].Status)
+	s.Equal(testBaseURL+"/Users/user-1", resp.Operations[0].Location)
+	s.Equal("201", resp.Operations[1].Status)
+	s.Equal(testBaseURL+"/Groups/group-1", resp.Operations[1].Location)
+}
+
+func (s *SCIMServiceTestSuite) TestProcessBulk_FailOnErrors() {
+	resp, err := s.service.ProcessBulk(s.ctx, BulkRequest{
+		Schemas:      []string{messageBulkRequest},
+		FailOnErrors: 1,
+		Operations: []BulkOperation{
+			{Method: "DELETE", Path: "/Users/bulkId:unknown"},
+			{Method: "DELETE", Path: "/Users/user-1"},
+		},
+	})
+
+	s.Require().Nil(err)
+	s.Require().Len(resp.Operations, 1)
+	s.Equal("400", resp.Operations[0].Status)
+	s.Equal(scimTypeInvalidValue, resp.Operations[0].Response.SCIMType)
+}
+
+func (s *SCIMServiceTestSuite) TestProcessBulk_Invalid() {
+	_, err := s.service.ProcessBulk(s.ctx, BulkRequest{Operations: []BulkOperation{}})
+	s.Require().NotNil(err)
+	s.Equal(scimTypeInvalidSyntax, err.SCIMType)
+
+	_, err = s.service.ProcessBulk(s.ctx, BulkRequest{
+		Schemas:    []string{messageBulkRequest},
+		Operations: make([]BulkOperation, 11),
+	})
+	s.Require().NotNil(err)
+	s.Equal(http.StatusRequestEntityTooLarge, err.Status)
+
+	resp, err := s.service.ProcessBulk(s.ctx, BulkRequest{
+		Schemas: []string{messageBulkRequest},
+		Operations: []BulkOperation{
+			{Method: "POST", Path: "/Users", Data: json.RawMessage(`{}`)},
+			{Method: "PUT", Path: "/Users"},
+			{Method: "GET", Path: "/Users/user-1"},
+			{Method: "DELETE", Path: "/Roles/role-1"},
+		},
+	})
+	s.Require().Nil(err)
+	for _, op := range resp.Operations {
+		s.Equal("400", op.Status)
+	}
+}
+
+func (s *SCIMServiceTestSuite) TestGetSchema_User() {
+	s.entityTypeService.EXPECT().GetAttributes(mock.Anything, entitytype.TypeCategoryUser, "Person",
+		entitytype.AttributeFilter{AllowNonCredential: true}).Return([]entitytype.AttributeInfo{
+		{Attribute: "username", Type: "string", Unique: true},
+		{Attribute: "given_name", Type: "string"},
+		{Attribute: "email", Type: "string"},
+		{Attribute: "age", Type: "number"},
+	}, nil)
+	s.entityTypeService.EXPECT().GetEntityTypeList(mock.Anything, entitytype.TypeCategoryUser, 100, 0, false).
+		Return(&entitytype.EntityTypeListResponse{Types: []entitytype.EntityTypeListItem{{Name: "Person"}}}, nil)
+
+	schema, err := s.service.GetSchema(s.ctx, schemaUserExtension)
+
+	s.Require().Nil(err)
+	attributes := schema["attributes"].([]interface{})
+	s.Require().Len(attributes, 3)
+	age := attributes[2].(map[string]interface{})
+	s.Equal("age", age["name"])
+	s.Equal("decimal", age["type"])
+}
+
+func (s *SCIMServiceTestSuite) TestGetSchema_NotFound() {
+	s.entityTypeService.EXPECT().GetAttributes(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]entitytype.AttributeInfo{}, nil)
+	s.entityTypeService.EXPECT().GetEntityTypeList(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return(&entitytype.EntityTypeListResponse{}, nil)
+
+	_, err := s.service.GetSchema(s.ctx, "urn:unknown")
+
+	s.Require().NotNil(err)
+	s.Equal(http.StatusNotFound, err.Status)
+}
+
+func (s *SCIMServiceTestSuite) TestDiscovery() {
+	resourceType, err := s.service.GetResourceType("group")
+	s.Require().Nil(err)
+	s.Equal("/Groups", resourceType["endpoint"])
+
+	_, err = s.service.GetResourceType("Role")
+	s.Require().NotNil(err)
+
+	config := s.service.GetServiceProviderConfig()
+	s.Equal(map[string]interface{}{"supported": true}, config["changePassword"])
+	s.Equal(map[string]interface{}{"supported": true, "maxResults": 100}, config["filter"])
+}
+
+func (s *SCIMServiceTestSuite) TestFromServiceError() {
+	s.Equal(errInternal, fromServiceError(&tidcommon.InternalServerError))
+	s.Equal(http.StatusForbidden, fromServiceError(&tidcommon.ErrorUnauthorized).Status)
+	s.Equal(scimTypeMutability, fromServiceError(&group.ErrorImmutableGroup).SCIMType)
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// userInput is a user as described by a SCIM User resource.
+type userInput struct {
+	Type       string
+	OUID       string
+	Attributes map[string]interface{}
+	// Password is the new password, or empty when the resource does not set one.
+	Password string
+}
+
+// userToResource converts a user into a SCIM User resource. groups is nil when the groups of the
+// user were not fetched, in which case the resource has no groups attribute.
+func userToResource(u *user.User, groups []providers.EntityGroup, cfg serviceConfig) (Resource, error) {
+	attrs := map[string]interface{}{}
+	if len(u.Attributes) > 0 {
+		if err := json.Unmarshal(u.Attributes, &attrs); err != nil {
+			return nil, err
+		}
+	}
+
+	r := Resource{
+		attrSchemas: []interface{}{schemaUser, schemaUserExtension},
+		attrID:      u.ID,
+		attrActive:  true,
+	}
+	mapped := map[string]bool{}
+	for scimPath, userAttr := range cfg.UserAttributes {
+		if userAttr == "" || scimPath == attrPassword {
+			continue
+		}
+		v, ok := attrs[userAttr]
+		if !ok || v == nil {
+			continue
+		}
+		mapped[userAttr] = true
+		setCoreUserValue(r, scimPath, v)
+	}
+
+	ext := map[string]interface{}{attrType: u.Type, attrOUID: u.OUID}
+	for name, v := range attrs {
+		if !mapped[name] && name != cfg.PasswordAttribute {
+			ext[name] = v
+		}
+	}
+	r[schemaUserExtension] = ext
+
+	// The version covers the user's own attributes only, so that it is the same whether or not
+	// the groups were fetched.
+	setMeta(r, resourceTypeUser, cfg.BaseURL+usersPath+"/"+u.ID)
+	if groups != nil {
+		values := make([]interface{}, 0, len(groups))
+		for _, g := range groups {
+			values = append(values, map[string]interface{}{
+				attrValue:   g.ID,
+				attrDisplay: g.Name,
+				attrRef:     cfg.BaseURL + groupsPath + "/" + g.ID,
+			})
+		}
+		r[attrGroups] = values
+	}
+	return normalizeResource(r)
+}
+
+// setCoreUserValue sets a core User attribute from a user attribute value. A multi-valued
+// attribute becomes a single primary element.
+func setCoreUserValue(r Resource, scimPath string, v interface{}) {
+	attr, sub, hasSub := strings.Cut(scimPath, ".")
+	if multiValuedUserAttributes[attr] {
+		if !hasSub {
+			sub = attrValue
+		}
+		r[attr] = []interface{}{map[string]interface{}{sub: v, attrPrimary: true}}
+		return
+	}
+	if !hasSub {
+		r[attr] = v
+		return
+	}
+	obj, ok := r[attr].(map[string]interface{})
+	if !ok {
+		obj = map[string]interface{}{}
+		r[attr] = obj
+	}
+	obj[sub] = v
+}
+
+// coreUserValue returns the value of a core User attribute path, taking the primary (or first)
+// element of a multi-valued attribute.
+func coreUserValue(r Resource, scimPath string) (interface{}, bool) {
+	attr, sub, hasSub := strings.Cut(scimPath, ".")
+	v, ok := getValue(r, attr)
+	if !ok || v == nil {
+		return nil, false
+	}
+	if multiValuedUserAttributes[attr] {
+		if !hasSub {
+			sub = attrValue
+		}
+		element, ok := primaryElement(v)
+		if !ok {
+			return nil, false
+		}
+		return getValue(element, sub)
+	}
+	if !hasSub {
+		return v, true
+	}
+	obj, ok := asObject(v)
+	if !ok {
+		return nil, false
+	}
+	return getValue(obj, sub)
+}
+
+// primaryElement returns the element of a multi-valued attribute marked primary, or the first
+// element when none is.
+func primaryElement(v interface{}) (map[string]interface{}, bool) {
+	var first map[string]interface{}
+	for _, element := range asList(v) {
+		obj, ok := asObject(element)
+		if !ok {
+			continue
+		}
+		if primary, _ := getValue(obj, attrPrimary); primary == true {
+			return obj, true
+		}
+		if first == nil {
+			first = obj
+		}
+	}
+	return first, first != nil
+}
+
+// resourceToUser converts a SCIM User resource into a user. Attributes of the ThunderID extension
+// are taken as they are; mapped core attributes take precedence over them.
+func resourceToUser(r Resource, cfg serviceConfig) (*userInput, *SCIMError) {
+	if active, ok := getValue(r, attrActive); ok && isFalse(active) {
+		return nil, errDeactivationNotSupported
+	}
+
+	in := &userInput{Attributes: map[string]interface{}{}}
+	if ext, ok := getObject(r, schemaUserExtension); ok {
+		for name, v := range ext {
+			switch {
+			case strings.EqualFold(name, attrType):
+				in.Type, _ = v.(string)
+			case strings.EqualFold(name, attrOUID):
+				in.OUID, _ = v.(string)
+			case name == cfg.PasswordAttribute:
+				return nil, newBadRequestError(scimTypeInvalidValue,
+					"Passwords must be set through the password attribute")
+			default:
+				in.Attributes[name] = v
+			}
+		}
+	}
+
+	for scimPath, userAttr := range cfg.UserAttributes {
+		if userAttr == "" || scimPath == attrPassword {
+			continue
+		}
+		if v, ok := coreUserValue(r, scimPath); ok && v != nil {
+			in.Attributes[userAttr] = v
+		}
+	}
+
+	if userName, ok := cfg.UserAttributes[attrUserName]; ok && userName != "" {
+		if s, _ := in.Attributes[userName].(string); s == "" {
+			return nil, newBadRequestError(scimTypeInvalidValue, "The userName attribute is required")
+		}
+	}
+
+	if v, ok := getValue(r, attrPassword); ok && v != nil {
+		password, isString := v.(string)
+		if !isString {
+			return nil, newBadRequestError(scimTypeInvalidValue, "The password attribute must be a string")
+		}
+		if cfg.PasswordAttribute == "" {
+			return nil, newBadRequestError(scimTypeInvalidValue, "Setting passwords is not supported")
+		}
+		in.Password = password
+	}
+	return in, nil
+}
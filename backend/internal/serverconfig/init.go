@@ -10,6 +10,8 @@ import (
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
 // Initialize wires the server config store, service, and routes, with one handler per supported
@@ -17,13 +19,13 @@ import (
 // declarative resources into the file store before serving. It returns the service and the resource
 // exporter for registration at the composition root.
 func Initialize(mux *http.ServeMux, cacheManager cache.CacheManagerInterface,
-	handlers map[ConfigName]ServerConfigHandlerInterface) (
+	handlers map[ConfigName]ServerConfigHandlerInterface, observabilitySvc providers.ObservabilityProvider) (
 	ServerConfigService, declarativeresource.ResourceExporter, error) {
 	store, err := initializeStore(cacheManager, handlers)
 	if err != nil {
 		return nil, nil, err
 	}
-	service := newServerConfigService(store, handlers)
+	service := newServerConfigService(store, handlers, audit.NewRecorder(observabilitySvc))
 
 	handler := newServerConfigHandler(service)
 	registerRoutes(mux, handler)
@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
 
@@ -26,21 +27,23 @@ type ServerConfigService interface {
 
 // serverConfigService is the default implementation of ServerConfigService.
 type serverConfigService struct {
-	store    serverConfigStoreInterface
-	handlers map[ConfigName]ServerConfigHandlerInterface
-	logger   *log.Logger
-	configMu sync.Mutex
+	store         serverConfigStoreInterface
+	handlers      map[ConfigName]ServerConfigHandlerInterface
+	auditRecorder *audit.Recorder
+	logger        *log.Logger
+	configMu      sync.Mutex
 }
 
 // newServerConfigService creates a new instance of serverConfigService. Handlers are injected at
 // construction, one per supported section. The store may be the mutable, declarative, or composite
 // implementation depending on the configured store mode.
 func newServerConfigService(store serverConfigStoreInterface,
-	handlers map[ConfigName]ServerConfigHandlerInterface) ServerConfigService {
+	handlers map[ConfigName]ServerConfigHandlerInterface, auditRecorder *audit.Recorder) ServerConfigService {
 	return &serverConfigService{
-		store:    store,
-		handlers: handlers,
-		logger:   log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+		store:         store,
+		handlers:      handlers,
+		auditRecorder: auditRecorder,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
 	}
 }
 
@@ -158,6 +161,7 @@ func (s *serverConfigService) SetConfig(ctx context.Context,
 		s.logger.Error(ctx, "Failed to upsert server config", log.Error(err))
 		return &common.InternalServerError
 	}
+	s.auditRecorder.Updated(ctx, audit.TargetServerConfig, string(name), writable, incoming)
 	return nil
 }
 
@@ -33,12 +33,12 @@ func (suite *ServiceTestSuite) SetupTest() {
 	suite.mockStore = newServerConfigStoreInterfaceMock(suite.T())
 	suite.mockHandler = NewServerConfigHandlerInterfaceMock(suite.T())
 	suite.service = newServerConfigService(suite.mockStore,
-		map[ConfigName]ServerConfigHandlerInterface{ConfigNameCORS: suite.mockHandler})
+		map[ConfigName]ServerConfigHandlerInterface{ConfigNameCORS: suite.mockHandler}, nil)
 }
 
 // serviceWithoutHandlers builds a service with no registered handlers, sharing the suite store mock.
 func (suite *ServiceTestSuite) serviceWithoutHandlers() ServerConfigService {
-	return newServerConfigService(suite.mockStore, map[ConfigName]ServerConfigHandlerInterface{})
+	return newServerConfigService(suite.mockStore, map[ConfigName]ServerConfigHandlerInterface{}, nil)
 }
 
 // Raw (byte) layers shared across the store tests, plus an incoming PUT value.
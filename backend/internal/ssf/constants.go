@@ -0,0 +1,113 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import "time"
+
+// Security event types the transmitter emits.
+const (
+	// EventTypeSessionRevoked is the CAEP event sent when a user's session ends or all of a user's
+	// sessions are revoked.
+	EventTypeSessionRevoked = "https://schemas.openid.net/secevent/caep/event-type/session-revoked"
+	// EventTypeCredentialChange is the CAEP event sent when a user's credentials are changed.
+	EventTypeCredentialChange = "https://schemas.openid.net/secevent/caep/event-type/credential-change"
+	// EventTypeTokenClaimsChange is the CAEP event sent when attributes that back token claims change.
+	EventTypeTokenClaimsChange = "https://schemas.openid.net/secevent/caep/event-type/token-claims-change"
+	// EventTypeAccountDisabled is the RISC event sent when a user account is disabled.
+	EventTypeAccountDisabled = "https://schemas.openid.net/secevent/risc/event-type/account-disabled"
+	// EventTypeVerification is the SSF event sent when a receiver requests verification of a stream.
+	EventTypeVerification = "https://schemas.openid.net/secevent/ssf/event-type/verification"
+)
+
+// supportedEventTypes lists the event types a stream can request, in the order they are advertised.
+var supportedEventTypes = []string{
+	EventTypeSessionRevoked,
+	EventTypeCredentialChange,
+	EventTypeTokenClaimsChange,
+	EventTypeAccountDisabled,
+}
+
+// Delivery methods a stream can be configured with.
+const (
+	// DeliveryMethodPush delivers events by POSTing them to the receiver (RFC 8935).
+	DeliveryMethodPush = "urn:ietf:rfc:8935"
+	// DeliveryMethodPoll holds events until the receiver polls for them (RFC 8936).
+	DeliveryMethodPoll = "urn:ietf:rfc:8936"
+)
+
+// Stream statuses.
+const (
+	// StreamStatusEnabled marks a stream whose events are delivered.
+	StreamStatusEnabled = "enabled"
+	// StreamStatusPaused marks a stream whose events are held until it is enabled again.
+	StreamStatusPaused = "paused"
+	// StreamStatusDisabled marks a stream whose events are dropped.
+	StreamStatusDisabled = "disabled"
+)
+
+// Subject identifier formats (RFC 9493) used in the sub_id claim.
+const (
+	subjectFormatIssSub  = "iss_sub"
+	subjectFormatOpaque  = "opaque"
+	subjectFormatComplex = "complex"
+)
+
+// Values of the CAEP initiating_entity, credential_type and change_type event claims.
+const (
+	initiatingEntityAdmin  = "admin"
+	initiatingEntityUser   = "user"
+	credentialTypePassword = "password"
+	changeTypeUpdate       = "update"
+)
+
+// SET claims.
+const (
+	claimEvents         = "events"
+	claimSubID          = "sub_id"
+	claimTxn            = "txn"
+	claimEventTimestamp = "event_timestamp"
+	claimJTI            = "jti"
+)
+
+// Endpoint paths.
+const (
+	wellKnownPath = "/.well-known/ssf-configuration"
+	streamsPath   = "/ssf/streams"
+	statusPath    = streamsPath + "/status"
+	verifyPath    = streamsPath + "/verify"
+	pollPath      = "/ssf/poll"
+)
+
+const (
+	// specVersion is the Shared Signals Framework version advertised in the transmitter configuration.
+	specVersion = "1_0"
+	// defaultSubjects advertises that streams receive events for every subject.
+	defaultSubjects = "ALL"
+	// oauthAuthorizationScheme is the spec URN of the OAuth 2.0 bearer token authorization scheme.
+	oauthAuthorizationScheme = "urn:ietf:rfc:6749"
+
+	contentTypeSecEvent = "application/secevent+jwt"
+	contentTypeJSON     = "application/json"
+
+	// propertyAuthorizationHeader is the name of the encrypted property holding the Authorization header
+	// sent with pushed events.
+	propertyAuthorizationHeader = "authorizationHeader"
+
+	// maxLastErrorLength bounds the error message recorded for a failed push attempt.
+	maxLastErrorLength = 1024
+)
+
+// Defaults applied when the SSF settings are not configured.
+const (
+	defaultDispatchInterval = 5 * time.Second
+	defaultBatchSize        = 50
+	defaultMaxAttempts      = 8
+	defaultInitialBackoff   = 10 * time.Second
+	defaultMaxBackoff       = time.Hour
+	defaultRequestTimeout   = 10 * time.Second
+	defaultRetentionDays    = 7
+	defaultMaxPollEvents    = 100
+	defaultLongPollTimeout  = 20 * time.Second
+	cleanupInterval         = time.Hour
+)
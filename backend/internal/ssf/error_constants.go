@@ -0,0 +1,129 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for Shared Signals operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "SSF-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidDeliveryMethod is the error returned when the delivery method is not supported.
+	ErrorInvalidDeliveryMethod = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "SSF-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.invalid_delivery_method",
+			DefaultValue: "Invalid delivery method",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.invalid_delivery_method_description",
+			DefaultValue: "The delivery method must be urn:ietf:rfc:8935 (push) or urn:ietf:rfc:8936 (poll)",
+		},
+	}
+	// ErrorInvalidEndpointURL is the error returned when the push endpoint URL is invalid.
+	ErrorInvalidEndpointURL = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "SSF-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.invalid_endpoint_url",
+			DefaultValue: "Invalid endpoint URL",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.invalid_endpoint_url_description",
+			DefaultValue: "Push delivery requires a public https endpoint_url",
+		},
+	}
+	// ErrorInvalidEventTypes is the error returned when the requested event types are invalid.
+	ErrorInvalidEventTypes = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "SSF-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.invalid_event_types",
+			DefaultValue: "Invalid event types",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.invalid_event_types_description",
+			DefaultValue: "At least one event type listed in events_supported must be requested",
+		},
+	}
+	// ErrorStreamNotFound is the error returned when the stream does not exist.
+	ErrorStreamNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "SSF-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.stream_not_found",
+			DefaultValue: "Stream not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.stream_not_found_description",
+			DefaultValue: "The stream with the specified stream_id does not exist",
+		},
+	}
+	// ErrorMissingStreamID is the error returned when the stream_id is required but missing.
+	ErrorMissingStreamID = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "SSF-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.missing_stream_id",
+			DefaultValue: "Missing stream_id",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.missing_stream_id_description",
+			DefaultValue: "The stream_id of the stream is required",
+		},
+	}
+	// ErrorInvalidStatus is the error returned when the requested stream status is not supported.
+	ErrorInvalidStatus = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "SSF-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.invalid_status",
+			DefaultValue: "Invalid stream status",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.invalid_status_description",
+			DefaultValue: "The stream status must be enabled, paused or disabled",
+		},
+	}
+	// ErrorStreamNotPoll is the error returned when a stream that uses push delivery is polled.
+	ErrorStreamNotPoll = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "SSF-1008",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.stream_not_poll",
+			DefaultValue: "Stream does not use poll delivery",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.stream_not_poll_description",
+			DefaultValue: "Only streams configured with the urn:ietf:rfc:8936 delivery method can be polled",
+		},
+	}
+	// ErrorStreamDisabled is the error returned when a disabled stream is verified or polled.
+	ErrorStreamDisabled = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "SSF-1009",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.stream_disabled",
+			DefaultValue: "Stream is disabled",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.ssfservice.stream_disabled_description",
+			DefaultValue: "Events are not transmitted on a disabled stream",
+		},
+	}
+)
@@ -0,0 +1,200 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/revocation"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// actionUserCredentialsUpdate is the audit action the user service records when an administrator
+// changes a user's credentials.
+const actionUserCredentialsUpdate = "user.credentials.update"
+
+// Fields of the audited user snapshot whose changes are reported as token claim changes.
+const (
+	attributesField = "attributes"
+	ouIDField       = "ouId"
+)
+
+// userSubject returns the iss_sub subject identifier of a user.
+func userSubject(issuer, userID string) map[string]interface{} {
+	return map[string]interface{}{
+		"format": subjectFormatIssSub,
+		"iss":    issuer,
+		"sub":    userID,
+	}
+}
+
+// sessionSubject returns the complex subject identifier of a user's session. The session is identified
+// by its OpenID Connect sid, so receivers can correlate it with the sid claim of the ID tokens they hold.
+func sessionSubject(issuer string, sess session.Session) map[string]interface{} {
+	return map[string]interface{}{
+		"format": subjectFormatComplex,
+		"user":   userSubject(issuer, sess.SubjectID),
+		"session": map[string]interface{}{
+			"format": subjectFormatOpaque,
+			"id":     sess.SID(),
+		},
+	}
+}
+
+// newSessionRevokedEvent builds the CAEP session-revoked event for an ended session.
+func newSessionRevokedEvent(issuer string, sess session.Session, at time.Time) securityEvent {
+	return securityEvent{
+		eventType: EventTypeSessionRevoked,
+		subject:   sessionSubject(issuer, sess),
+		payload:   map[string]interface{}{claimEventTimestamp: at.Unix()},
+	}
+}
+
+// newVerificationEvent builds the SSF verification event for a stream, echoing the receiver's state.
+func newVerificationEvent(streamID, state string) securityEvent {
+	payload := map[string]interface{}{}
+	if state != "" {
+		payload["state"] = state
+	}
+	return securityEvent{
+		eventType: EventTypeVerification,
+		subject:   map[string]interface{}{"format": subjectFormatOpaque, "id": streamID},
+		payload:   payload,
+	}
+}
+
+// translateCriteriaRevocation maps a persisted criteria revocation to the security event it signals. A
+// revocation of every token of a subject because the user was deleted disables the account; every other
+// revocation is internal to the authorization server and reports false.
+func translateCriteriaRevocation(issuer string, rev revocation.CriteriaRevocation,
+	at time.Time) (securityEvent, bool) {
+	if rev.Criterion.Type != revocation.CriterionTypeSubject || rev.Criterion.Value == "" ||
+		rev.Reason != revocation.ReasonUserDeleted {
+		return securityEvent{}, false
+	}
+	return securityEvent{
+		eventType: EventTypeAccountDisabled,
+		subject:   userSubject(issuer, rev.Criterion.Value),
+		payload:   map[string]interface{}{claimEventTimestamp: at.Unix()},
+	}, true
+}
+
+// translateObservabilityEvent maps an observability event to the security event it signals. Credential
+// changes, whether made by an administrator or through a recovery flow, signal credential-change, and
+// changes to a user's attributes or organization unit signal token-claims-change. It reports false for
+// every other event.
+func translateObservabilityEvent(issuer string, evt *providers.Event) (securityEvent, bool) {
+	switch providers.EventType(evt.Type) {
+	case event.EventTypeFlowCompleted:
+		userID := dataString(evt.Data, event.DataKey.UserID)
+		if userID == "" ||
+			providers.FlowType(dataString(evt.Data, event.DataKey.FlowType)) != providers.FlowTypeRecovery {
+			return securityEvent{}, false
+		}
+		return newCredentialChangeEvent(issuer, userID, initiatingEntityUser, evt), true
+	case event.EventTypeAdminResourceUpdated:
+		if dataString(evt.Data, event.DataKey.TargetType) != audit.TargetUser {
+			return securityEvent{}, false
+		}
+		userID := dataString(evt.Data, event.DataKey.TargetID)
+		if userID == "" {
+			return securityEvent{}, false
+		}
+		initiatingEntity := initiatingEntityAdmin
+		if dataString(evt.Data, event.DataKey.Actor) == userID {
+			initiatingEntity = initiatingEntityUser
+		}
+		if dataString(evt.Data, event.DataKey.Action) == actionUserCredentialsUpdate {
+			return newCredentialChangeEvent(issuer, userID, initiatingEntity, evt), true
+		}
+		return newTokenClaimsChangeEvent(issuer, userID, initiatingEntity, evt)
+	default:
+		return securityEvent{}, false
+	}
+}
+
+// newCredentialChangeEvent builds the CAEP credential-change event for a user whose credentials changed.
+// The audit trail does not disclose which credentials changed, so they are reported as a password update.
+func newCredentialChangeEvent(issuer, userID, initiatingEntity string, evt *providers.Event) securityEvent {
+	return securityEvent{
+		eventType: EventTypeCredentialChange,
+		subject:   userSubject(issuer, userID),
+		payload: map[string]interface{}{
+			claimEventTimestamp: evt.Timestamp.Unix(),
+			"credential_type":   credentialTypePassword,
+			"change_type":       changeTypeUpdate,
+			"initiating_entity": initiatingEntity,
+		},
+		txn: evt.TraceID,
+	}
+}
+
+// newTokenClaimsChangeEvent builds the CAEP token-claims-change event for an audited update of a user,
+// carrying the new values of the changed attributes and organization unit. Sensitive attributes, whose
+// values the audit trail redacts, are left out. It reports false when the update changed nothing that
+// tokens assert.
+func newTokenClaimsChangeEvent(issuer, userID, initiatingEntity string,
+	evt *providers.Event) (securityEvent, bool) {
+	changes, _ := evt.Data[event.DataKey.Changes].(map[string]interface{})
+	claims := map[string]interface{}{}
+	for field, change := range changes {
+		before, after := changeValues(change)
+		if field == ouIDField {
+			claims[field] = after
+		}
+		if field != attributesField {
+			continue
+		}
+		beforeAttributes, _ := before.(map[string]interface{})
+		afterAttributes, _ := after.(map[string]interface{})
+		for name, value := range afterAttributes {
+			if value == audit.RedactedValue {
+				continue
+			}
+			if previous, ok := beforeAttributes[name]; !ok || !reflect.DeepEqual(previous, value) {
+				claims[name] = value
+			}
+		}
+		for name := range beforeAttributes {
+			if _, ok := afterAttributes[name]; !ok {
+				claims[name] = nil
+			}
+		}
+	}
+	if len(claims) == 0 {
+		return securityEvent{}, false
+	}
+
+	return securityEvent{
+		eventType: EventTypeTokenClaimsChange,
+		subject:   userSubject(issuer, userID),
+		payload: map[string]interface{}{
+			claimEventTimestamp: evt.Timestamp.Unix(),
+			"claims":            claims,
+			"initiating_entity": initiatingEntity,
+		},
+		txn: evt.TraceID,
+	}, true
+}
+
+// changeValues returns the before and after values of an audit diff entry.
+func changeValues(change interface{}) (interface{}, interface{}) {
+	pair, ok := change.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	return pair["before"], pair["after"]
+}
+
+// dataString returns the string value of an event data key, or an empty string.
+func dataString(data map[string]interface{}, key string) string {
+	if v, ok := data[key].(string); ok {
+		return v
+	}
+	return ""
+}
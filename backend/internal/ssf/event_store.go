@@ -0,0 +1,220 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// eventStoreInterface defines the persistence of the queue of Security Event Tokens awaiting delivery.
+type eventStoreInterface interface {
+	// insertEvent queues a Security Event Token for a stream.
+	insertEvent(ctx context.Context, evt queuedEvent) error
+	// getDueEvents returns up to limit events of a stream whose next push attempt is due at now.
+	getDueEvents(ctx context.Context, streamID string, now time.Time, limit int) ([]queuedEvent, error)
+	// claimEvent counts a push attempt against an event that has made the given number of attempts and
+	// leases it until leaseUntil. It reports false when the event was claimed by someone else.
+	claimEvent(ctx context.Context, jti string, attempts int, leaseUntil time.Time) (bool, error)
+	// rescheduleEvent schedules the next push attempt of an event after a failed attempt.
+	rescheduleEvent(ctx context.Context, jti string, nextAttemptAt time.Time, lastError string) error
+	// getPendingEvents returns up to limit unexpired events of a stream, oldest first.
+	getPendingEvents(ctx context.Context, streamID string, now time.Time, limit int) ([]queuedEvent, error)
+	// deleteEvent removes an event of a stream. It reports false when the stream has no such event.
+	deleteEvent(ctx context.Context, streamID, jti string) (bool, error)
+	// deleteStreamEvents removes every event of a stream.
+	deleteStreamEvents(ctx context.Context, streamID string) error
+	// deleteExpiredEvents removes the events that expired before now and returns how many were removed.
+	deleteExpiredEvents(ctx context.Context, now time.Time) (int64, error)
+}
+
+// eventStore implements eventStoreInterface against the runtime persistent database.
+type eventStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newEventStore creates a new eventStore.
+func newEventStore() eventStoreInterface {
+	return &eventStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// insertEvent queues a Security Event Token for a stream.
+func (s *eventStore) insertEvent(ctx context.Context, evt queuedEvent) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryInsertEvent, evt.JTI, s.deploymentID, evt.StreamID, evt.EventType,
+		evt.Token, evt.NextAttemptAt, evt.CreatedAt, evt.ExpiryTime)
+	if err != nil {
+		return fmt.Errorf("error inserting security event: %w", err)
+	}
+
+	return nil
+}
+
+// getDueEvents returns up to limit events of a stream whose next push attempt is due at now.
+func (s *eventStore) getDueEvents(ctx context.Context, streamID string, now time.Time,
+	limit int) ([]queuedEvent, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetDueEvents, streamID, s.deploymentID, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute due event query: %w", err)
+	}
+
+	return buildEventsFromResultRows(results)
+}
+
+// claimEvent counts a push attempt against an event and leases it until leaseUntil.
+func (s *eventStore) claimEvent(ctx context.Context, jti string, attempts int,
+	leaseUntil time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryClaimEvent, leaseUntil, jti, s.deploymentID, attempts)
+	if err != nil {
+		return false, fmt.Errorf("error claiming security event: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// rescheduleEvent schedules the next push attempt of an event after a failed attempt.
+func (s *eventStore) rescheduleEvent(ctx context.Context, jti string, nextAttemptAt time.Time,
+	lastError string) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryRescheduleEvent, nextAttemptAt, lastError, jti,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("error rescheduling security event: %w", err)
+	}
+
+	return nil
+}
+
+// getPendingEvents returns up to limit unexpired events of a stream, oldest first.
+func (s *eventStore) getPendingEvents(ctx context.Context, streamID string, now time.Time,
+	limit int) ([]queuedEvent, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPendingEvents, streamID, s.deploymentID, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute pending event query: %w", err)
+	}
+
+	return buildEventsFromResultRows(results)
+}
+
+// deleteEvent removes an event of a stream.
+func (s *eventStore) deleteEvent(ctx context.Context, streamID, jti string) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteEvent, jti, streamID, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("error deleting security event: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// deleteStreamEvents removes every event of a stream.
+func (s *eventStore) deleteStreamEvents(ctx context.Context, streamID string) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteStreamEvents, streamID, s.deploymentID); err != nil {
+		return fmt.Errorf("error deleting stream security events: %w", err)
+	}
+
+	return nil
+}
+
+// deleteExpiredEvents removes the events that expired before now.
+func (s *eventStore) deleteExpiredEvents(ctx context.Context, now time.Time) (int64, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteExpiredEvents, now, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting expired security events: %w", err)
+	}
+
+	return rows, nil
+}
+
+// buildEventsFromResultRows constructs queued events from database result rows.
+func buildEventsFromResultRows(results []map[string]interface{}) ([]queuedEvent, error) {
+	events := make([]queuedEvent, 0, len(results))
+	for _, row := range results {
+		evt, err := buildEventFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, evt)
+	}
+	return events, nil
+}
+
+// buildEventFromResultRow constructs a queuedEvent from a database result row.
+func buildEventFromResultRow(row map[string]interface{}) (queuedEvent, error) {
+	jti, ok := row["jti"].(string)
+	if !ok {
+		return queuedEvent{}, errors.New("failed to parse jti as string")
+	}
+
+	nextAttemptAt, err := utils.ParseDBTimeField(row["next_attempt_at"], "next_attempt_at")
+	if err != nil {
+		return queuedEvent{}, err
+	}
+	createdAt, err := utils.ParseDBTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return queuedEvent{}, err
+	}
+	expiryTime, err := utils.ParseDBTimeField(row["expiry_time"], "expiry_time")
+	if err != nil {
+		return queuedEvent{}, err
+	}
+	attempts, _ := utils.ToInt64(row["attempts"])
+
+	return queuedEvent{
+		JTI:           jti,
+		StreamID:      stringOrBytesField(row, "stream_id"),
+		EventType:     stringOrBytesField(row, "event_type"),
+		Token:         stringOrBytesField(row, "set_token"),
+		Attempts:      int(attempts),
+		NextAttemptAt: nextAttemptAt,
+		CreatedAt:     createdAt,
+		ExpiryTime:    expiryTime,
+	}, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+type EventStoreTestSuite struct {
+	suite.Suite
+	mockdbProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *eventStore
+	now            time.Time
+}
+
+func TestEventStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(EventStoreTestSuite))
+}
+
+func (suite *EventStoreTestSuite) SetupTest() {
+	suite.mockdbProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &eventStore{
+		dbProvider:   suite.mockdbProvider,
+		deploymentID: testDeploymentID,
+	}
+	suite.now = time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+}
+
+func (suite *EventStoreTestSuite) testEvent() queuedEvent {
+	return queuedEvent{
+		JTI:           "jti-1",
+		StreamID:      "stream-1",
+		EventType:     EventTypeSessionRevoked,
+		Token:         "header.payload.signature",
+		Attempts:      2,
+		NextAttemptAt: suite.now,
+		CreatedAt:     suite.now.Add(-time.Minute),
+		ExpiryTime:    suite.now.Add(7 * 24 * time.Hour),
+	}
+}
+
+func (suite *EventStoreTestSuite) eventRow() map[string]interface{} {
+	evt := suite.testEvent()
+	return map[string]interface{}{
+		"jti":             evt.JTI,
+		"stream_id":       evt.StreamID,
+		"event_type":      evt.EventType,
+		"set_token":       []byte(evt.Token),
+		"attempts":        int64(evt.Attempts),
+		"next_attempt_at": evt.NextAttemptAt,
+		"created_at":      evt.CreatedAt,
+		"expiry_time":     evt.ExpiryTime,
+	}
+}
+
+func (suite *EventStoreTestSuite) TestInsertEvent() {
+	evt := suite.testEvent()
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryInsertEvent, "jti-1", testDeploymentID,
+		"stream-1", EventTypeSessionRevoked, "header.payload.signature", evt.NextAttemptAt, evt.CreatedAt,
+		evt.ExpiryTime).Return(int64(1), nil)
+
+	assert.NoError(suite.T(), suite.store.insertEvent(context.Background(), evt))
+}
+
+func (suite *EventStoreTestSuite) TestInsertEvent_DBClientError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(nil, errors.New("db unavailable"))
+
+	err := suite.store.insertEvent(context.Background(), suite.testEvent())
+	assert.ErrorContains(suite.T(), err, "failed to get runtime persistent database client")
+}
+
+func (suite *EventStoreTestSuite) TestGetDueEvents() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetDueEvents, "stream-1", testDeploymentID,
+		suite.now, 50).Return([]map[string]interface{}{suite.eventRow()}, nil)
+
+	events, err := suite.store.getDueEvents(context.Background(), "stream-1", suite.now, 50)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []queuedEvent{suite.testEvent()}, events)
+}
+
+func (suite *EventStoreTestSuite) TestGetPendingEvents_InvalidRow() {
+	row := suite.eventRow()
+	delete(row, "jti")
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetPendingEvents, "stream-1",
+		testDeploymentID, suite.now, 11).Return([]map[string]interface{}{row}, nil)
+
+	_, err := suite.store.getPendingEvents(context.Background(), "stream-1", suite.now, 11)
+	assert.ErrorContains(suite.T(), err, "failed to parse jti")
+}
+
+func (suite *EventStoreTestSuite) TestClaimEvent() {
+	leaseUntil := suite.now.Add(20 * time.Second)
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryClaimEvent, leaseUntil, "jti-1",
+		testDeploymentID, 2).Return(int64(1), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryClaimEvent, leaseUntil, "jti-1",
+		testDeploymentID, 2).Return(int64(0), nil).Once()
+
+	claimed, err := suite.store.claimEvent(context.Background(), "jti-1", 2, leaseUntil)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), claimed)
+
+	claimed, err = suite.store.claimEvent(context.Background(), "jti-1", 2, leaseUntil)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), claimed)
+}
+
+func (suite *EventStoreTestSuite) TestRescheduleEvent() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryRescheduleEvent, suite.now,
+		"endpoint returned status 503", "jti-1", testDeploymentID).Return(int64(1), nil)
+
+	assert.NoError(suite.T(), suite.store.rescheduleEvent(context.Background(), "jti-1", suite.now,
+		"endpoint returned status 503"))
+}
+
+func (suite *EventStoreTestSuite) TestDeleteEvent() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteEvent, "jti-1", "stream-1",
+		testDeploymentID).Return(int64(1), nil)
+
+	deleted, err := suite.store.deleteEvent(context.Background(), "stream-1", "jti-1")
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), deleted)
+}
+
+func (suite *EventStoreTestSuite) TestDeleteStreamEvents_Error() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteStreamEvents, "stream-1",
+		testDeploymentID).Return(int64(0), errors.New("delete error"))
+
+	err := suite.store.deleteStreamEvents(context.Background(), "stream-1")
+	assert.ErrorContains(suite.T(), err, "error deleting stream security events")
+}
+
+func (suite *EventStoreTestSuite) TestDeleteExpiredEvents() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteExpiredEvents, suite.now,
+		testDeploymentID).Return(int64(4), nil)
+
+	deleted, err := suite.store.deleteExpiredEvents(context.Background(), suite.now)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(4), deleted)
+}
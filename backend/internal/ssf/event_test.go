@@ -0,0 +1,199 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/revocation"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const testIssuer = "https://localhost:8090"
+
+type EventTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func TestEventTestSuite(t *testing.T) {
+	suite.Run(t, new(EventTestSuite))
+}
+
+func (suite *EventTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+}
+
+// auditedUserUpdate returns the audit event recorded when the actor updates a user from before to after.
+func (suite *EventTestSuite) auditedUserUpdate(actor, action string, before, after interface{}) *providers.Event {
+	return &providers.Event{
+		TraceID:   "trace-1",
+		Type:      string(event.EventTypeAdminResourceUpdated),
+		Timestamp: suite.now,
+		Data: map[string]interface{}{
+			event.DataKey.Actor:      actor,
+			event.DataKey.Action:     action,
+			event.DataKey.TargetType: audit.TargetUser,
+			event.DataKey.TargetID:   "user-1",
+			event.DataKey.Changes:    audit.Diff(before, after),
+		},
+	}
+}
+
+func (suite *EventTestSuite) TestNewSessionRevokedEvent() {
+	sess := session.Session{SessionID: "sess-1", SubjectID: "user-1"}
+
+	evt := newSessionRevokedEvent(testIssuer, sess, suite.now)
+
+	assert.Equal(suite.T(), EventTypeSessionRevoked, evt.eventType)
+	assert.Equal(suite.T(), map[string]interface{}{
+		"format": subjectFormatComplex,
+		"user":   map[string]interface{}{"format": subjectFormatIssSub, "iss": testIssuer, "sub": "user-1"},
+		"session": map[string]interface{}{
+			"format": subjectFormatOpaque,
+			"id":     sess.SID(),
+		},
+	}, evt.subject)
+	assert.Equal(suite.T(), suite.now.Unix(), evt.payload[claimEventTimestamp])
+}
+
+func (suite *EventTestSuite) TestNewVerificationEvent() {
+	evt := newVerificationEvent("stream-1", "state-1")
+	assert.Equal(suite.T(), EventTypeVerification, evt.eventType)
+	assert.Equal(suite.T(), map[string]interface{}{"format": subjectFormatOpaque, "id": "stream-1"}, evt.subject)
+	assert.Equal(suite.T(), map[string]interface{}{"state": "state-1"}, evt.payload)
+
+	assert.Empty(suite.T(), newVerificationEvent("stream-1", "").payload)
+}
+
+func (suite *EventTestSuite) TestTranslateCriteriaRevocation() {
+	evt, ok := translateCriteriaRevocation(testIssuer, revocation.CriteriaRevocation{
+		Criterion: revocation.Criterion{Type: revocation.CriterionTypeSubject, Value: "user-1"},
+		Reason:    revocation.ReasonUserDeleted,
+	}, suite.now)
+
+	suite.Require().True(ok)
+	assert.Equal(suite.T(), EventTypeAccountDisabled, evt.eventType)
+	assert.Equal(suite.T(), userSubject(testIssuer, "user-1"), evt.subject)
+	assert.Equal(suite.T(), suite.now.Unix(), evt.payload[claimEventTimestamp])
+}
+
+func (suite *EventTestSuite) TestTranslateCriteriaRevocation_NotSignalled() {
+	testCases := []struct {
+		name string
+		rev  revocation.CriteriaRevocation
+	}{
+		{"other reason", revocation.CriteriaRevocation{
+			Criterion: revocation.Criterion{Type: revocation.CriterionTypeSubject, Value: "user-1"},
+			Reason:    revocation.ReasonRoleDeleted,
+		}},
+		{"other criterion", revocation.CriteriaRevocation{
+			Criterion: revocation.Criterion{Type: revocation.CriterionTypeApplicationID, Value: "app-1"},
+			Reason:    revocation.ReasonUserDeleted,
+		}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, ok := translateCriteriaRevocation(testIssuer, tc.rev, suite.now)
+			assert.False(suite.T(), ok)
+		})
+	}
+}
+
+func (suite *EventTestSuite) TestTranslateObservabilityEvent_RecoveryFlow() {
+	evt, ok := translateObservabilityEvent(testIssuer, &providers.Event{
+		TraceID:   "trace-1",
+		Type:      string(event.EventTypeFlowCompleted),
+		Timestamp: suite.now,
+		Data: map[string]interface{}{
+			event.DataKey.UserID:   "user-1",
+			event.DataKey.FlowType: string(providers.FlowTypeRecovery),
+		},
+	})
+
+	suite.Require().True(ok)
+	assert.Equal(suite.T(), EventTypeCredentialChange, evt.eventType)
+	assert.Equal(suite.T(), userSubject(testIssuer, "user-1"), evt.subject)
+	assert.Equal(suite.T(), initiatingEntityUser, evt.payload["initiating_entity"])
+	assert.Equal(suite.T(), changeTypeUpdate, evt.payload["change_type"])
+	assert.Equal(suite.T(), "trace-1", evt.txn)
+}
+
+func (suite *EventTestSuite) TestTranslateObservabilityEvent_AuthenticationFlowIgnored() {
+	_, ok := translateObservabilityEvent(testIssuer, &providers.Event{
+		Type: string(event.EventTypeFlowCompleted),
+		Data: map[string]interface{}{
+			event.DataKey.UserID:   "user-1",
+			event.DataKey.FlowType: string(providers.FlowTypeAuthentication),
+		},
+	})
+	assert.False(suite.T(), ok)
+}
+
+func (suite *EventTestSuite) TestTranslateObservabilityEvent_CredentialsUpdate() {
+	evt, ok := translateObservabilityEvent(testIssuer,
+		suite.auditedUserUpdate("admin-1", actionUserCredentialsUpdate, nil, nil))
+
+	suite.Require().True(ok)
+	assert.Equal(suite.T(), EventTypeCredentialChange, evt.eventType)
+	assert.Equal(suite.T(), initiatingEntityAdmin, evt.payload["initiating_entity"])
+	assert.Equal(suite.T(), credentialTypePassword, evt.payload["credential_type"])
+}
+
+func (suite *EventTestSuite) TestTranslateObservabilityEvent_AttributeUpdate() {
+	before := map[string]interface{}{
+		"ouId": "ou-1",
+		"attributes": map[string]interface{}{
+			"email":    "old@example.com",
+			"nickname": "jd",
+			"given":    "John",
+			"password": "old-secret",
+		},
+	}
+	after := map[string]interface{}{
+		"ouId": "ou-2",
+		"attributes": map[string]interface{}{
+			"email":    "new@example.com",
+			"given":    "John",
+			"password": "new-secret",
+		},
+	}
+
+	evt, ok := translateObservabilityEvent(testIssuer,
+		suite.auditedUserUpdate("user-1", "user.update", before, after))
+
+	suite.Require().True(ok)
+	assert.Equal(suite.T(), EventTypeTokenClaimsChange, evt.eventType)
+	assert.Equal(suite.T(), initiatingEntityUser, evt.payload["initiating_entity"])
+	assert.Equal(suite.T(), map[string]interface{}{
+		"ouId":     "ou-2",
+		"email":    "new@example.com",
+		"nickname": nil,
+	}, evt.payload["claims"])
+}
+
+func (suite *EventTestSuite) TestTranslateObservabilityEvent_NoClaimChanges() {
+	before := map[string]interface{}{"type": "employee"}
+	after := map[string]interface{}{"type": "contractor"}
+
+	_, ok := translateObservabilityEvent(testIssuer,
+		suite.auditedUserUpdate("admin-1", "user.update", before, after))
+	assert.False(suite.T(), ok)
+}
+
+func (suite *EventTestSuite) TestTranslateObservabilityEvent_OtherTarget() {
+	evt := suite.auditedUserUpdate("admin-1", "group.update", nil, nil)
+	evt.Data[event.DataKey.TargetType] = audit.TargetGroup
+
+	_, ok := translateObservabilityEvent(testIssuer, evt)
+	assert.False(suite.T(), ok)
+}
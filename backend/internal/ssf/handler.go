@@ -0,0 +1,221 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// queryParamStreamID is the query parameter naming the stream of a stream configuration or status request.
+const queryParamStreamID = "stream_id"
+
+// ssfHandler is the handler for the Shared Signals transmitter endpoints.
+type ssfHandler struct {
+	service SSFServiceInterface
+}
+
+// newSSFHandler creates a new instance of ssfHandler.
+func newSSFHandler(service SSFServiceInterface) *ssfHandler {
+	return &ssfHandler{
+		service: service,
+	}
+}
+
+// HandleConfigurationRequest handles GET /.well-known/ssf-configuration.
+func (h *ssfHandler) HandleConfigurationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, h.service.GetTransmitterConfiguration(ctx))
+}
+
+// HandleStreamPostRequest handles POST /ssf/streams.
+func (h *ssfHandler) HandleStreamPostRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := decodeRequest[StreamRequest](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	resp, svcErr := h.service.CreateStream(ctx, *req)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusCreated, resp)
+}
+
+// HandleStreamGetRequest handles GET /ssf/streams. It returns the stream named by the stream_id query
+// parameter, or every stream when there is none.
+func (h *ssfHandler) HandleStreamGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if streamID := r.URL.Query().Get(queryParamStreamID); streamID != "" {
+		resp, svcErr := h.service.GetStream(ctx, streamID)
+		if svcErr != nil {
+			writeServiceError(ctx, w, svcErr)
+			return
+		}
+		sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+		return
+	}
+
+	resp, svcErr := h.service.GetStreams(ctx)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleStreamPatchRequest handles PATCH /ssf/streams.
+func (h *ssfHandler) HandleStreamPatchRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := decodeRequest[StreamRequest](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	resp, svcErr := h.service.UpdateStream(ctx, *req)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleStreamPutRequest handles PUT /ssf/streams.
+func (h *ssfHandler) HandleStreamPutRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := decodeRequest[StreamRequest](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	resp, svcErr := h.service.ReplaceStream(ctx, *req)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleStreamDeleteRequest handles DELETE /ssf/streams.
+func (h *ssfHandler) HandleStreamDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if svcErr := h.service.DeleteStream(ctx, r.URL.Query().Get(queryParamStreamID)); svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+}
+
+// HandleStatusGetRequest handles GET /ssf/streams/status.
+func (h *ssfHandler) HandleStatusGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, svcErr := h.service.GetStreamStatus(ctx, r.URL.Query().Get(queryParamStreamID))
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleStatusPostRequest handles POST /ssf/streams/status.
+func (h *ssfHandler) HandleStatusPostRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := decodeRequest[StreamStatus](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	resp, svcErr := h.service.UpdateStreamStatus(ctx, *req)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleVerifyRequest handles POST /ssf/streams/verify.
+func (h *ssfHandler) HandleVerifyRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := decodeRequest[VerificationRequest](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	if svcErr := h.service.VerifyStream(ctx, *req); svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+}
+
+// HandlePollRequest handles POST /ssf/poll/{id}.
+func (h *ssfHandler) HandlePollRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := decodeRequest[PollRequest](ctx, w, r)
+	if !ok {
+		return
+	}
+
+	resp, svcErr := h.service.PollEvents(ctx, r.PathValue("id"), *req)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// decodeRequest decodes the request body, writing the error response when it is invalid.
+func decodeRequest[T any](ctx context.Context, w http.ResponseWriter, r *http.Request) (*T, bool) {
+	req, err := sysutils.DecodeJSONBody[T](r)
+	if err != nil {
+		var valErr *sysutils.ValidationError
+		if errors.As(err, &valErr) {
+			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
+			return nil, false
+		}
+		writeServiceError(ctx, w, &ErrorInvalidRequestFormat)
+		return nil, false
+	}
+	return req, true
+}
+
+// writeServiceError converts a service error into the appropriate HTTP error response.
+func writeServiceError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		switch svcErr.Code {
+		case tidcommon.ErrorUnauthorized.Code:
+			statusCode = http.StatusForbidden
+		case ErrorStreamNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorStreamDisabled.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type SSFHandlerTestSuite struct {
+	suite.Suite
+	mockService *SSFServiceInterfaceMock
+	mux         *http.ServeMux
+}
+
+func TestSSFHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SSFHandlerTestSuite))
+}
+
+func (suite *SSFHandlerTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+
+	suite.mockService = NewSSFServiceInterfaceMock(suite.T())
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, newSSFHandler(suite.mockService))
+}
+
+func (suite *SSFHandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *SSFHandlerTestSuite) serve(method, target, body string) *httptest.ResponseRecorder {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	resp := httptest.NewRecorder()
+	suite.mux.ServeHTTP(resp, req)
+	return resp
+}
+
+func (suite *SSFHandlerTestSuite) errorCode(resp *httptest.ResponseRecorder) string {
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &errResp))
+	return errResp.Code
+}
+
+func testStreamConfiguration() *StreamConfiguration {
+	return &StreamConfiguration{
+		StreamID:        "stream-1",
+		Issuer:          testIssuer,
+		Audience:        "receiver-app",
+		EventsSupported: supportedEventTypes,
+		EventsRequested: []string{EventTypeSessionRevoked},
+		EventsDelivered: []string{EventTypeSessionRevoked},
+		Delivery:        StreamDelivery{Method: DeliveryMethodPush, EndpointURL: "https://receiver.example.com/events"},
+	}
+}
+
+func (suite *SSFHandlerTestSuite) TestHandleStreamPostRequest() {
+	suite.mockService.On("CreateStream", mock.Anything, StreamRequest{
+		Delivery: &DeliveryRequest{
+			Method:              DeliveryMethodPush,
+			EndpointURL:         "https://receiver.example.com/events",
+			AuthorizationHeader: "Bearer receiver-token",
+		},
+		EventsRequested: []string{EventTypeSessionRevoked},
+	}).Return(testStreamConfiguration(), nil)
+
+	resp := suite.serve(http.MethodPost, streamsPath, `{"delivery":{"method":"`+DeliveryMethodPush+
+		`","endpoint_url":"https://receiver.example.com/events","authorization_header":"Bearer receiver-token"},`+
+		`"events_requested":["`+EventTypeSessionRevoked+`"]}`)
+	suite.Require().Equal(http.StatusCreated, resp.Code)
+
+	var body StreamConfiguration
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(suite.T(), "stream-1", body.StreamID)
+	assert.Equal(suite.T(), "receiver-app", body.Audience)
+}
+
+func (suite *SSFHandlerTestSuite) TestHandleStreamPostRequest_InvalidBody() {
+	resp := suite.serve(http.MethodPost, streamsPath, "{not json")
+	assert.Equal(suite.T(), http.StatusBadRequest, resp.Code)
+	assert.Equal(suite.T(), ErrorInvalidRequestFormat.Code, suite.errorCode(resp))
+}
+
+func (suite *SSFHandlerTestSuite) TestHandleStreamGetRequest() {
+	suite.mockService.On("GetStream", mock.Anything, "stream-1").Return(testStreamConfiguration(), nil)
+	resp := suite.serve(http.MethodGet, streamsPath+"?stream_id=stream-1", "")
+	suite.Require().Equal(http.StatusOK, resp.Code)
+	assert.Contains(suite.T(), resp.Body.String(), `"stream_id":"stream-1"`)
+
+	suite.mockService.On("GetStreams", mock.Anything).
+		Return([]StreamConfiguration{*testStreamConfiguration()}, nil)
+	resp = suite.serve(http.MethodGet, streamsPath, "")
+	suite.Require().Equal(http.StatusOK, resp.Code)
+
+	var body []StreamConfiguration
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Len(suite.T(), body, 1)
+}
+
+func (suite *SSFHandlerTestSuite) TestHandleStreamPatchAndPutRequests() {
+	suite.mockService.On("UpdateStream", mock.Anything, mock.MatchedBy(func(r StreamRequest) bool {
+		return r.StreamID == "stream-1" && r.Description != nil && *r.Description == "Session signals"
+	})).Return(testStreamConfiguration(), nil)
+	resp := suite.serve(http.MethodPatch, streamsPath, `{"stream_id":"stream-1","description":"Session signals"}`)
+	assert.Equal(suite.T(), http.StatusOK, resp.Code)
+
+	suite.mockService.On("ReplaceStream", mock.Anything, mock.Anything).Return(testStreamConfiguration(), nil)
+	resp = suite.serve(http.MethodPut, streamsPath, `{"stream_id":"stream-1"}`)
+	assert.Equal(suite.T(), http.StatusOK, resp.Code)
+}
+
+func (suite *SSFHandlerTestSuite) TestHandleStreamDeleteRequest() {
+	suite.mockService.On("DeleteStream", mock.Anything, "stream-1").Return(nil)
+
+	resp := suite.serve(http.MethodDelete, streamsPath+"?stream_id=stream-1", "")
+	assert.Equal(suite.T(), http.StatusNoContent, resp.Code)
+}
+
+func (suite *SSFHandlerTestSuite) TestHandleStatusRequests() {
+	status := &StreamStatus{StreamID: "stream-1", Status: StreamStatusPaused, Reason: "maintenance"}
+	suite.mockService.On("GetStreamStatus", mock.Anything, "stream-1").Return(status, nil)
+	resp := suite.serve(http.MethodGet, statusPath+"?stream_id=stream-1", "")
+	suite.Require().Equal(http.StatusOK, resp.Code)
+	assert.Contains(suite.T(), resp.Body.String(), `"status":"paused"`)
+
+	suite.mockService.On("UpdateStreamStatus", mock.Anything, *status).Return(status, nil)
+	resp = suite.serve(http.MethodPost, statusPath,
+		`{"stream_id":"stream-1","status":"paused","reason":"maintenance"}`)
+	assert.Equal(suite.T(), http.StatusOK, resp.Code)
+}
+
+func (suite *SSFHandlerTestSuite) TestHandleVerifyRequest() {
+	suite.mockService.On("VerifyStream", mock.Anything, VerificationRequest{StreamID: "stream-1", State: "s1"}).
+		Return(nil)
+
+	resp := suite.serve(http.MethodPost, verifyPath, `{"stream_id":"stream-1","state":"s1"}`)
+	assert.Equal(suite.T(), http.StatusNoContent, resp.Code)
+}
+
+func (suite *SSFHandlerTestSuite) TestHandlePollRequest() {
+	suite.mockService.On("PollEvents", mock.Anything, "stream-1", mock.MatchedBy(func(r PollRequest) bool {
+		return r.MaxEvents != nil && *r.MaxEvents == 5 && r.ReturnImmediately && len(r.Ack) == 1
+	})).Return(&PollResponse{Sets: map[string]string{"jti-1": "token-1"}, MoreAvailable: true}, nil)
+
+	resp := suite.serve(http.MethodPost, pollPath+"/stream-1",
+		`{"maxEvents":5,"returnImmediately":true,"ack":["jti-0"]}`)
+	suite.Require().Equal(http.StatusOK, resp.Code)
+
+	var body PollResponse
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(suite.T(), map[string]string{"jti-1": "token-1"}, body.Sets)
+	assert.True(suite.T(), body.MoreAvailable)
+}
+
+func (suite *SSFHandlerTestSuite) TestServiceErrors() {
+	testCases := []struct {
+		name   string
+		svcErr *tidcommon.ServiceError
+		status int
+	}{
+		{"unauthorized", &tidcommon.ErrorUnauthorized, http.StatusForbidden},
+		{"stream not found", &ErrorStreamNotFound, http.StatusNotFound},
+		{"stream disabled", &ErrorStreamDisabled, http.StatusConflict},
+		{"client error", &ErrorMissingStreamID, http.StatusBadRequest},
+		{"server error", &tidcommon.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockService.On("VerifyStream", mock.Anything, mock.Anything).Return(tc.svcErr)
+
+			resp := suite.serve(http.MethodPost, verifyPath, `{"stream_id":"stream-1"}`)
+			assert.Equal(suite.T(), tc.status, resp.Code)
+			assert.Equal(suite.T(), tc.svcErr.Code, suite.errorCode(resp))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"net/http"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+// Initialize initializes the Shared Signals transmitter when ssf.enabled is set, registers its routes
+// and starts the push dispatcher. It returns the stream management service and the transmitter the
+// session and revocation services report to, or nil for both when the transmitter is disabled.
+//
+// Credential and attribute changes reach the transmitter as observability events, so they are only
+// transmitted when observability is enabled.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	oauthCfg oauthconfig.Config,
+) (SSFServiceInterface, TransmitterInterface) {
+	ssfConfig := config.GetServerRuntime().Config.SSF
+	if !ssfConfig.IsEnabled() {
+		return nil, nil
+	}
+
+	streamStore := newStreamStore()
+	eventStore := newEventStore()
+	ssfTransmitter := newTransmitter(streamStore, eventStore, jwtService, oauthCfg.JWT.Issuer, ssfConfig)
+	if err := ssfTransmitter.Initialize(); err != nil {
+		log.GetLogger().Error(context.Background(), "Failed to start the SSF transmitter", log.Error(err))
+		return nil, nil
+	}
+	if observabilitySvc != nil {
+		if eventPublisher := observabilitySvc.GetPublisher(); eventPublisher != nil {
+			eventPublisher.Subscribe(ssfTransmitter)
+		}
+	}
+
+	ssfService := newSSFService(streamStore, eventStore, ssfTransmitter, authzService,
+		audit.NewRecorder(observabilitySvc), oauthCfg.BaseURL, oauthCfg.BaseURL+constants.OAuth2JWKSEndpoint,
+		config.PositiveIntOrDefault(ssfConfig.MaxPollEvents, defaultMaxPollEvents),
+		config.SecondsOrDefault(ssfConfig.LongPollTimeout, defaultLongPollTimeout))
+
+	ssfHandler := newSSFHandler(ssfService)
+	registerRoutes(mux, ssfHandler)
+
+	return ssfService, ssfTransmitter
+}
+
+// registerRoutes registers the routes for the transmitter configuration, stream management and poll
+// endpoints.
+func registerRoutes(mux *http.ServeMux, h *ssfHandler) {
+	metadataOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "OPTIONS"},
+		AllowedHeaders:   []string{"Content-Type"},
+		AllowCredentials: false,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+wellKnownPath, h.HandleConfigurationRequest, metadataOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+wellKnownPath,
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, metadataOpts))
+
+	streamOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+streamsPath, h.HandleStreamGetRequest, streamOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+streamsPath, h.HandleStreamPostRequest, streamOpts))
+	mux.HandleFunc(middleware.WithCORS("PATCH "+streamsPath, h.HandleStreamPatchRequest, streamOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT "+streamsPath, h.HandleStreamPutRequest, streamOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+streamsPath, h.HandleStreamDeleteRequest, streamOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+streamsPath,
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, streamOpts))
+
+	actionOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+statusPath, h.HandleStatusGetRequest, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+statusPath, h.HandleStatusPostRequest, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+verifyPath, h.HandleVerifyRequest, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+pollPath+"/{id}", h.HandlePollRequest, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+streamsPath+"/",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+pollPath+"/",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, actionOpts))
+}
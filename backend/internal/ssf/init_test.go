@@ -0,0 +1,99 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+const testCryptoKey = "0579f866ac7c9273580d0ff163fa01a7b2401a7ff3ddc3e3b14ae3136fa6025e"
+
+// TestMain wires cmodels' package-level config crypto provider once for the whole test binary, so the
+// authorization header properties can be encrypted regardless of which test last reset the server runtime.
+func TestMain(m *testing.M) {
+	config.ResetServerRuntime()
+	if err := config.InitializeServerRuntime("/tmp/test", &config.Config{
+		Crypto: config.CryptoConfig{Encryption: engineconfig.EncryptionConfig{Key: testCryptoKey}},
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to initialize server runtime: %v\n", err)
+		os.Exit(1)
+	}
+	_, cfgCryptoSvc, err := defaultkm.Initialize(nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to initialize default crypto provider: %v\n", err)
+		os.Exit(1)
+	}
+	cmodels.SetConfigCryptoProvider(cfgCryptoSvc)
+	config.ResetServerRuntime()
+	os.Exit(m.Run())
+}
+
+type SSFInitTestSuite struct {
+	suite.Suite
+}
+
+func TestSSFInitTestSuite(t *testing.T) {
+	suite.Run(t, new(SSFInitTestSuite))
+}
+
+func (suite *SSFInitTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *SSFInitTestSuite) initializeRuntime(enabled bool) {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{
+		SSF: config.SSFConfig{Enabled: &enabled},
+	}))
+}
+
+func (suite *SSFInitTestSuite) TestInitialize_Disabled() {
+	suite.initializeRuntime(false)
+	mux := http.NewServeMux()
+
+	service, transmitter := Initialize(mux, jwtmock.NewJWTServiceInterfaceMock(suite.T()),
+		sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T()), nil, oauthconfig.Config{})
+
+	assert.Nil(suite.T(), service)
+	assert.Nil(suite.T(), transmitter)
+
+	resp := httptest.NewRecorder()
+	mux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, wellKnownPath, nil))
+	assert.Equal(suite.T(), http.StatusNotFound, resp.Code)
+}
+
+func (suite *SSFInitTestSuite) TestRegisterRoutes() {
+	mockService := NewSSFServiceInterfaceMock(suite.T())
+	mockService.On("GetTransmitterConfiguration", mock.Anything).
+		Return(&TransmitterConfiguration{ConfigurationEndpoint: "https://localhost:8090/ssf/streams"})
+	mux := http.NewServeMux()
+	registerRoutes(mux, newSSFHandler(mockService))
+
+	resp := httptest.NewRecorder()
+	mux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, wellKnownPath, nil))
+	assert.Equal(suite.T(), http.StatusOK, resp.Code)
+	assert.Contains(suite.T(), resp.Body.String(), `"configuration_endpoint":"https://localhost:8090/ssf/streams"`)
+
+	for _, target := range []string{wellKnownPath, streamsPath, statusPath, verifyPath, pollPath + "/stream-1"} {
+		resp := httptest.NewRecorder()
+		mux.ServeHTTP(resp, httptest.NewRequest(http.MethodOptions, target, nil))
+		assert.Equal(suite.T(), http.StatusNoContent, resp.Code, target)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import "time"
+
+// TransmitterConfiguration is the transmitter metadata served at /.well-known/ssf-configuration.
+type TransmitterConfiguration struct {
+	SpecVersion              string                `json:"spec_version"`
+	Issuer                   string                `json:"issuer"`
+	JWKSURI                  string                `json:"jwks_uri"`
+	DeliveryMethodsSupported []string              `json:"delivery_methods_supported"`
+	ConfigurationEndpoint    string                `json:"configuration_endpoint"`
+	StatusEndpoint           string                `json:"status_endpoint"`
+	VerificationEndpoint     string                `json:"verification_endpoint"`
+	AuthorizationSchemes     []AuthorizationScheme `json:"authorization_schemes"`
+	DefaultSubjects          string                `json:"default_subjects"`
+}
+
+// AuthorizationScheme names an authorization scheme receivers use to call the transmitter.
+type AuthorizationScheme struct {
+	SpecURN string `json:"spec_urn"`
+}
+
+// StreamConfiguration is a stream as returned by the stream configuration endpoint.
+type StreamConfiguration struct {
+	StreamID        string         `json:"stream_id"`
+	Issuer          string         `json:"iss"`
+	Audience        string         `json:"aud"`
+	EventsSupported []string       `json:"events_supported"`
+	EventsRequested []string       `json:"events_requested"`
+	EventsDelivered []string       `json:"events_delivered"`
+	Delivery        StreamDelivery `json:"delivery"`
+	Description     string         `json:"description,omitempty"`
+}
+
+// StreamDelivery is the delivery configuration of a stream. For poll streams the endpoint URL is the
+// transmitter's poll endpoint for the stream.
+type StreamDelivery struct {
+	Method      string `json:"method"`
+	EndpointURL string `json:"endpoint_url,omitempty"`
+}
+
+// StreamRequest is the request body for creating, updating or replacing a stream. On update, only the
+// fields that are present are changed.
+type StreamRequest struct {
+	StreamID        string           `json:"stream_id,omitempty"`
+	Delivery        *DeliveryRequest `json:"delivery,omitempty"`
+	EventsRequested []string         `json:"events_requested,omitempty"`
+	Description     *string          `json:"description,omitempty"`
+}
+
+// DeliveryRequest is the requested delivery configuration of a stream. AuthorizationHeader is sent as
+// the Authorization header of pushed events and is never returned.
+type DeliveryRequest struct {
+	Method              string `json:"method"`
+	EndpointURL         string `json:"endpoint_url,omitempty"`
+	AuthorizationHeader string `json:"authorization_header,omitempty"`
+}
+
+// StreamStatus is the status of a stream, used both to read and to change it.
+type StreamStatus struct {
+	StreamID string `json:"stream_id"`
+	Status   string `json:"status"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// VerificationRequest asks the transmitter to send a verification event on a stream.
+type VerificationRequest struct {
+	StreamID string `json:"stream_id"`
+	State    string `json:"state,omitempty"`
+}
+
+// PollRequest is an RFC 8936 poll request. Events listed in Ack or SetErrs are removed from the stream
+// before the next events are returned.
+type PollRequest struct {
+	MaxEvents         *int                `json:"maxEvents,omitempty"`
+	ReturnImmediately bool                `json:"returnImmediately,omitempty"`
+	Ack               []string            `json:"ack,omitempty"`
+	SetErrs           map[string]SetError `json:"setErrs,omitempty"`
+}
+
+// SetError reports why the receiver rejected a Security Event Token.
+type SetError struct {
+	Err         string `json:"err"`
+	Description string `json:"description,omitempty"`
+}
+
+// PollResponse is an RFC 8936 poll response, mapping the jti of each Security Event Token to the token.
+type PollResponse struct {
+	Sets          map[string]string `json:"sets"`
+	MoreAvailable bool              `json:"moreAvailable,omitempty"`
+}
+
+// Stream is a stream as stored by the transmitter.
+type Stream struct {
+	ID              string   `json:"id"`
+	Audience        string   `json:"audience"`
+	Description     string   `json:"description,omitempty"`
+	DeliveryMethod  string   `json:"deliveryMethod"`
+	EndpointURL     string   `json:"endpointUrl,omitempty"`
+	EventsRequested []string `json:"eventsRequested"`
+	Status          string   `json:"status"`
+	StatusReason    string   `json:"statusReason,omitempty"`
+}
+
+// securityEvent is an event raised by a subsystem, before it is signed for the streams that deliver it.
+type securityEvent struct {
+	eventType string
+	// subject is the sub_id claim identifying the principal the event is about.
+	subject map[string]interface{}
+	// payload holds the event-specific claims placed under the event type in the events claim.
+	payload map[string]interface{}
+	// txn correlates the event with the request that caused it.
+	txn string
+}
+
+// queuedEvent is a Security Event Token waiting to be pushed to, or polled by, a stream's receiver.
+type queuedEvent struct {
+	JTI           string
+	StreamID      string
+	EventType     string
+	Token         string
+	Attempts      int
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	ExpiryTime    time.Time
+}
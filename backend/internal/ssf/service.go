@@ -0,0 +1,534 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ssf implements a Shared Signals Framework transmitter. It emits CAEP and RISC security events
+// as Security Event Tokens, delivers them by push (RFC 8935) or poll (RFC 8936), and provides the stream
+// management API receivers use to configure their streams.
+package ssf
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const loggerComponentNameService = "SSFService"
+
+// pollCheckInterval is how often a long poll request checks for newly queued events.
+const pollCheckInterval = time.Second
+
+// SSFServiceInterface defines the transmitter metadata, stream management and polling operations.
+type SSFServiceInterface interface {
+	GetTransmitterConfiguration(ctx context.Context) *TransmitterConfiguration
+	CreateStream(ctx context.Context, request StreamRequest) (*StreamConfiguration, *tidcommon.ServiceError)
+	GetStream(ctx context.Context, streamID string) (*StreamConfiguration, *tidcommon.ServiceError)
+	GetStreams(ctx context.Context) ([]StreamConfiguration, *tidcommon.ServiceError)
+	UpdateStream(ctx context.Context, request StreamRequest) (*StreamConfiguration, *tidcommon.ServiceError)
+	ReplaceStream(ctx context.Context, request StreamRequest) (*StreamConfiguration, *tidcommon.ServiceError)
+	DeleteStream(ctx context.Context, streamID string) *tidcommon.ServiceError
+	GetStreamStatus(ctx context.Context, streamID string) (*StreamStatus, *tidcommon.ServiceError)
+	UpdateStreamStatus(ctx context.Context, status StreamStatus) (*StreamStatus, *tidcommon.ServiceError)
+	VerifyStream(ctx context.Context, request VerificationRequest) *tidcommon.ServiceError
+	PollEvents(ctx context.Context, streamID string, request PollRequest) (*PollResponse, *tidcommon.ServiceError)
+}
+
+// ssfService is the default implementation of SSFServiceInterface.
+type ssfService struct {
+	streamStore     streamStoreInterface
+	eventStore      eventStoreInterface
+	transmitter     *transmitter
+	authzService    sysauthz.SystemAuthorizationServiceInterface
+	auditRecorder   *audit.Recorder
+	issuer          string
+	baseURL         string
+	jwksURI         string
+	maxPollEvents   int
+	longPollTimeout time.Duration
+	logger          *log.Logger
+}
+
+// newSSFService creates a new instance of ssfService.
+func newSSFService(streamStore streamStoreInterface, eventStore eventStoreInterface, transmitter *transmitter,
+	authzService sysauthz.SystemAuthorizationServiceInterface, auditRecorder *audit.Recorder,
+	baseURL, jwksURI string, maxPollEvents int, longPollTimeout time.Duration) SSFServiceInterface {
+	return &ssfService{
+		streamStore:     streamStore,
+		eventStore:      eventStore,
+		transmitter:     transmitter,
+		authzService:    authzService,
+		auditRecorder:   auditRecorder,
+		issuer:          transmitter.issuer,
+		baseURL:         baseURL,
+		jwksURI:         jwksURI,
+		maxPollEvents:   maxPollEvents,
+		longPollTimeout: longPollTimeout,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentNameService)),
+	}
+}
+
+// GetTransmitterConfiguration returns the transmitter metadata.
+func (s *ssfService) GetTransmitterConfiguration(_ context.Context) *TransmitterConfiguration {
+	return &TransmitterConfiguration{
+		SpecVersion:              specVersion,
+		Issuer:                   s.issuer,
+		JWKSURI:                  s.jwksURI,
+		DeliveryMethodsSupported: []string{DeliveryMethodPush, DeliveryMethodPoll},
+		ConfigurationEndpoint:    s.baseURL + streamsPath,
+		StatusEndpoint:           s.baseURL + statusPath,
+		VerificationEndpoint:     s.baseURL + verifyPath,
+		AuthorizationSchemes:     []AuthorizationScheme{{SpecURN: oauthAuthorizationScheme}},
+		DefaultSubjects:          defaultSubjects,
+	}
+}
+
+// CreateStream creates an enabled stream for the calling receiver. The receiver is identified by the
+// client_id of its access token, or by its subject when the token has none.
+func (s *ssfService) CreateStream(ctx context.Context, request StreamRequest) (
+	*StreamConfiguration, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionCreateSSFStream); svcErr != nil {
+		return nil, svcErr
+	}
+	if request.Delivery == nil {
+		return nil, &ErrorInvalidDeliveryMethod
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate stream ID", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	stream := Stream{
+		ID:       id,
+		Audience: receiverOf(ctx),
+		Status:   StreamStatusEnabled,
+	}
+	if request.Description != nil {
+		stream.Description = *request.Description
+	}
+	authorizationHeader, svcErr := s.applyDelivery(ctx, &stream, *request.Delivery)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if stream.EventsRequested, svcErr = validateEventsRequested(request.EventsRequested); svcErr != nil {
+		return nil, svcErr
+	}
+
+	if err := s.streamStore.createStream(ctx, stream, authorizationHeader); err != nil {
+		s.logger.Error(ctx, "Failed to create stream", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	s.auditRecorder.Created(ctx, audit.TargetSSFStream, stream.ID, stream)
+
+	return s.toConfiguration(stream), nil
+}
+
+// GetStream returns the configuration of a stream.
+func (s *ssfService) GetStream(ctx context.Context, streamID string) (
+	*StreamConfiguration, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionReadSSFStream); svcErr != nil {
+		return nil, svcErr
+	}
+
+	stream, svcErr := s.loadStream(ctx, streamID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return s.toConfiguration(*stream), nil
+}
+
+// GetStreams returns the configuration of every stream of the calling receiver, oldest first.
+func (s *ssfService) GetStreams(ctx context.Context) ([]StreamConfiguration, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionReadSSFStream); svcErr != nil {
+		return nil, svcErr
+	}
+
+	streams, err := s.streamStore.getStreamsByAudience(ctx, receiverOf(ctx))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list streams", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	configurations := make([]StreamConfiguration, 0, len(streams))
+	for _, stream := range streams {
+		configurations = append(configurations, *s.toConfiguration(stream))
+	}
+	return configurations, nil
+}
+
+// UpdateStream changes the fields of a stream that are present in the request.
+func (s *ssfService) UpdateStream(ctx context.Context, request StreamRequest) (
+	*StreamConfiguration, *tidcommon.ServiceError) {
+	return s.modifyStream(ctx, request, false)
+}
+
+// ReplaceStream replaces the configuration of a stream. The delivery method and requested events are
+// required.
+func (s *ssfService) ReplaceStream(ctx context.Context, request StreamRequest) (
+	*StreamConfiguration, *tidcommon.ServiceError) {
+	return s.modifyStream(ctx, request, true)
+}
+
+// modifyStream updates or, when replace is set, replaces the configuration of a stream.
+func (s *ssfService) modifyStream(ctx context.Context, request StreamRequest, replace bool) (
+	*StreamConfiguration, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionUpdateSSFStream); svcErr != nil {
+		return nil, svcErr
+	}
+
+	existing, svcErr := s.loadStream(ctx, request.StreamID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	stream := *existing
+	if request.Description != nil || replace {
+		stream.Description = ""
+		if request.Description != nil {
+			stream.Description = *request.Description
+		}
+	}
+
+	var authorizationHeader *cmodels.Property
+	deliveryChanged := request.Delivery != nil
+	if deliveryChanged {
+		if authorizationHeader, svcErr = s.applyDelivery(ctx, &stream, *request.Delivery); svcErr != nil {
+			return nil, svcErr
+		}
+	} else if replace {
+		return nil, &ErrorInvalidDeliveryMethod
+	}
+
+	if request.EventsRequested != nil || replace {
+		if stream.EventsRequested, svcErr = validateEventsRequested(request.EventsRequested); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	if err := s.streamStore.updateStream(ctx, stream); err != nil {
+		s.logger.Error(ctx, "Failed to update stream", log.String("streamID", stream.ID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if deliveryChanged {
+		if err := s.streamStore.updateAuthorizationHeader(ctx, stream.ID, authorizationHeader); err != nil {
+			s.logger.Error(ctx, "Failed to update stream authorization header",
+				log.String("streamID", stream.ID), log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+	}
+	s.auditRecorder.Updated(ctx, audit.TargetSSFStream, stream.ID, existing, stream)
+
+	return s.toConfiguration(stream), nil
+}
+
+// DeleteStream deletes a stream together with its queued events.
+func (s *ssfService) DeleteStream(ctx context.Context, streamID string) *tidcommon.ServiceError {
+	if svcErr := s.checkAction(ctx, security.ActionDeleteSSFStream); svcErr != nil {
+		return svcErr
+	}
+
+	existing, svcErr := s.loadStream(ctx, streamID)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.streamStore.deleteStream(ctx, streamID); err != nil {
+		s.logger.Error(ctx, "Failed to delete stream", log.String("streamID", streamID), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	// Events left behind are purged once their retention period ends.
+	if err := s.eventStore.deleteStreamEvents(ctx, streamID); err != nil {
+		s.logger.Warn(ctx, "Failed to delete stream events", log.String("streamID", streamID), log.Error(err))
+	}
+	s.auditRecorder.Deleted(ctx, audit.TargetSSFStream, streamID, existing)
+
+	return nil
+}
+
+// GetStreamStatus returns the status of a stream.
+func (s *ssfService) GetStreamStatus(ctx context.Context, streamID string) (
+	*StreamStatus, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionReadSSFStream); svcErr != nil {
+		return nil, svcErr
+	}
+
+	stream, svcErr := s.loadStream(ctx, streamID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return &StreamStatus{StreamID: stream.ID, Status: stream.Status, Reason: stream.StatusReason}, nil
+}
+
+// UpdateStreamStatus changes the status of a stream. Disabling a stream drops its queued events, and
+// enabling a paused stream releases the events it held.
+func (s *ssfService) UpdateStreamStatus(ctx context.Context, status StreamStatus) (
+	*StreamStatus, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionUpdateSSFStream); svcErr != nil {
+		return nil, svcErr
+	}
+	if status.Status != StreamStatusEnabled && status.Status != StreamStatusPaused &&
+		status.Status != StreamStatusDisabled {
+		return nil, &ErrorInvalidStatus
+	}
+
+	existing, svcErr := s.loadStream(ctx, status.StreamID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if err := s.streamStore.updateStreamStatus(ctx, existing.ID, status.Status, status.Reason); err != nil {
+		s.logger.Error(ctx, "Failed to update stream status", log.String("streamID", existing.ID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	switch status.Status {
+	case StreamStatusDisabled:
+		if err := s.eventStore.deleteStreamEvents(ctx, existing.ID); err != nil {
+			s.logger.Warn(ctx, "Failed to delete events of disabled stream", log.String("streamID", existing.ID),
+				log.Error(err))
+		}
+	case StreamStatusEnabled:
+		s.transmitter.signalDispatcher()
+	}
+
+	updated := *existing
+	updated.Status = status.Status
+	updated.StatusReason = status.Reason
+	s.auditRecorder.Updated(ctx, audit.TargetSSFStream, existing.ID, existing, updated)
+
+	return &StreamStatus{StreamID: existing.ID, Status: status.Status, Reason: status.Reason}, nil
+}
+
+// VerifyStream queues a verification event on a stream, so the receiver can confirm that it receives the
+// events of the stream. Verification events are sent whatever events the stream requests.
+func (s *ssfService) VerifyStream(ctx context.Context, request VerificationRequest) *tidcommon.ServiceError {
+	if svcErr := s.checkAction(ctx, security.ActionUpdateSSFStream); svcErr != nil {
+		return svcErr
+	}
+
+	stream, svcErr := s.loadStream(ctx, request.StreamID)
+	if svcErr != nil {
+		return svcErr
+	}
+	if stream.Status == StreamStatusDisabled {
+		return &ErrorStreamDisabled
+	}
+
+	evt := newVerificationEvent(stream.ID, request.State)
+	if err := s.transmitter.queueEvent(ctx, *stream, evt); err != nil {
+		s.logger.Error(ctx, "Failed to queue verification event", log.String("streamID", stream.ID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	s.transmitter.signalDispatcher()
+
+	return nil
+}
+
+// PollEvents serves an RFC 8936 poll of a poll stream. The events the receiver acknowledges or reports as
+// erroneous are removed first; the oldest queued events are then returned. Unless the receiver asks for
+// an immediate answer, the request waits up to the long poll timeout for events to become available. A
+// paused stream returns no events.
+func (s *ssfService) PollEvents(ctx context.Context, streamID string, request PollRequest) (
+	*PollResponse, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionPollSSFStream); svcErr != nil {
+		return nil, svcErr
+	}
+
+	stream, svcErr := s.loadStream(ctx, streamID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if stream.DeliveryMethod != DeliveryMethodPoll {
+		return nil, &ErrorStreamNotPoll
+	}
+	if stream.Status == StreamStatusDisabled {
+		return nil, &ErrorStreamDisabled
+	}
+
+	if svcErr := s.acknowledgeEvents(ctx, stream.ID, request); svcErr != nil {
+		return nil, svcErr
+	}
+
+	limit := s.maxPollEvents
+	if request.MaxEvents != nil {
+		limit = min(max(*request.MaxEvents, 0), s.maxPollEvents)
+	}
+	response := &PollResponse{Sets: map[string]string{}}
+	if limit == 0 || stream.Status != StreamStatusEnabled {
+		return response, nil
+	}
+
+	deadline := time.Now().Add(s.longPollTimeout)
+	for {
+		// One extra event is read to tell whether more are available.
+		events, err := s.eventStore.getPendingEvents(ctx, stream.ID, time.Now().UTC(), limit+1)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to load pending events", log.String("streamID", stream.ID),
+				log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		if len(events) > 0 || request.ReturnImmediately || !time.Now().Add(pollCheckInterval).Before(deadline) {
+			if len(events) > limit {
+				events = events[:limit]
+				response.MoreAvailable = true
+			}
+			for _, evt := range events {
+				response.Sets[evt.JTI] = evt.Token
+			}
+			return response, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return response, nil
+		case <-time.After(pollCheckInterval):
+		}
+	}
+}
+
+// acknowledgeEvents removes the events a poll request acknowledges or reports as erroneous.
+func (s *ssfService) acknowledgeEvents(ctx context.Context, streamID string,
+	request PollRequest) *tidcommon.ServiceError {
+	for _, jti := range request.Ack {
+		if _, err := s.eventStore.deleteEvent(ctx, streamID, jti); err != nil {
+			s.logger.Error(ctx, "Failed to acknowledge event", log.String("streamID", streamID),
+				log.String("jti", jti), log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+	}
+	for jti, setErr := range request.SetErrs {
+		s.logger.Warn(ctx, "Receiver rejected security event", log.String("streamID", streamID),
+			log.String("jti", jti), log.String("error", setErr.Err),
+			log.String("description", setErr.Description))
+		if _, err := s.eventStore.deleteEvent(ctx, streamID, jti); err != nil {
+			s.logger.Error(ctx, "Failed to remove rejected event", log.String("streamID", streamID),
+				log.String("jti", jti), log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+	}
+	return nil
+}
+
+// checkAction verifies that the caller is allowed to perform the given action.
+func (s *ssfService) checkAction(ctx context.Context, action security.Action) *tidcommon.ServiceError {
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, action, nil)
+	if svcErr != nil {
+		return &tidcommon.InternalServerError
+	}
+	if !allowed {
+		return &tidcommon.ErrorUnauthorized
+	}
+	return nil
+}
+
+// loadStream returns the stream with the given ID, or ErrorStreamNotFound when it does not exist or belongs
+// to another receiver. A receiver cannot tell the streams of other receivers from missing ones.
+func (s *ssfService) loadStream(ctx context.Context, streamID string) (*Stream, *tidcommon.ServiceError) {
+	if streamID == "" {
+		return nil, &ErrorMissingStreamID
+	}
+
+	stream, err := s.streamStore.getStream(ctx, streamID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get stream", log.String("streamID", streamID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if stream == nil || stream.Audience != receiverOf(ctx) {
+		return nil, &ErrorStreamNotFound
+	}
+	return stream, nil
+}
+
+// applyDelivery validates a delivery configuration and applies it to the stream. It returns the encrypted
+// authorization header to send with pushed events, or nil when there is none.
+func (s *ssfService) applyDelivery(ctx context.Context, stream *Stream, delivery DeliveryRequest) (
+	*cmodels.Property, *tidcommon.ServiceError) {
+	switch delivery.Method {
+	case DeliveryMethodPush:
+		if !isValidEndpointURL(delivery.EndpointURL) {
+			return nil, &ErrorInvalidEndpointURL
+		}
+		stream.EndpointURL = delivery.EndpointURL
+	case DeliveryMethodPoll:
+		// The receiver polls the transmitter, so the endpoint is always the stream's poll endpoint.
+		stream.EndpointURL = s.baseURL + pollPath + "/" + stream.ID
+	default:
+		return nil, &ErrorInvalidDeliveryMethod
+	}
+	stream.DeliveryMethod = delivery.Method
+
+	if delivery.Method != DeliveryMethodPush || delivery.AuthorizationHeader == "" {
+		return nil, nil
+	}
+	property, err := cmodels.NewProperty(propertyAuthorizationHeader, delivery.AuthorizationHeader, true)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to encrypt stream authorization header", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return property, nil
+}
+
+// toConfiguration returns the stream configuration of a stream.
+func (s *ssfService) toConfiguration(stream Stream) *StreamConfiguration {
+	return &StreamConfiguration{
+		StreamID:        stream.ID,
+		Issuer:          s.issuer,
+		Audience:        stream.Audience,
+		EventsSupported: slices.Clone(supportedEventTypes),
+		EventsRequested: stream.EventsRequested,
+		EventsDelivered: eventsDelivered(stream),
+		Delivery: StreamDelivery{
+			Method:      stream.DeliveryMethod,
+			EndpointURL: stream.EndpointURL,
+		},
+		Description: stream.Description,
+	}
+}
+
+// validateEventsRequested checks that at least one event type is requested and returns the requested
+// event types without duplicates. Event types the transmitter does not support are kept, as SSF allows,
+// but are never delivered.
+func validateEventsRequested(eventsRequested []string) ([]string, *tidcommon.ServiceError) {
+	events := make([]string, 0, len(eventsRequested))
+	supported := false
+	for _, eventType := range eventsRequested {
+		if eventType == "" {
+			return nil, &ErrorInvalidEventTypes
+		}
+		if slices.Contains(events, eventType) {
+			continue
+		}
+		events = append(events, eventType)
+		supported = supported || slices.Contains(supportedEventTypes, eventType)
+	}
+	if !supported {
+		return nil, &ErrorInvalidEventTypes
+	}
+	return events, nil
+}
+
+// receiverOf returns the identifier of the calling receiver: the client_id of its access token, or its
+// subject when the token has none.
+func receiverOf(ctx context.Context) string {
+	if clientID, ok := security.GetAttribute(ctx, constants.ClaimClientID).(string); ok && clientID != "" {
+		return clientID
+	}
+	return security.GetSubject(ctx)
+}
+
+// isValidEndpointURL reports whether rawURL is an https URL that is safe for the transmitter to push to,
+// i.e. not a loopback, link-local or private address.
+func isValidEndpointURL(rawURL string) bool {
+	return syshttp.IsSSRFSafeURL(rawURL) == nil
+}
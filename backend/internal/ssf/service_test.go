@@ -0,0 +1,417 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/observabilityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+const testBaseURL = "https://localhost:8090"
+
+type SSFServiceTestSuite struct {
+	suite.Suite
+	mockStreamStore   *streamStoreInterfaceMock
+	mockEventStore    *eventStoreInterfaceMock
+	mockJWTService    *jwtmock.JWTServiceInterfaceMock
+	mockAuthz         *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockObservability *observabilityprovidermock.ObservabilityProviderMock
+	transmitter       *transmitter
+	service           SSFServiceInterface
+}
+
+func TestSSFServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SSFServiceTestSuite))
+}
+
+func (suite *SSFServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+
+	suite.mockStreamStore = newStreamStoreInterfaceMock(suite.T())
+	suite.mockEventStore = newEventStoreInterfaceMock(suite.T())
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockObservability = observabilityprovidermock.NewObservabilityProviderMock(suite.T())
+	suite.mockObservability.On("IsEnabled").Return(false).Maybe()
+	suite.transmitter = newTransmitter(suite.mockStreamStore, suite.mockEventStore, suite.mockJWTService,
+		testIssuer, config.SSFConfig{})
+	suite.service = newSSFService(suite.mockStreamStore, suite.mockEventStore, suite.transmitter, suite.mockAuthz,
+		audit.NewRecorder(suite.mockObservability), testBaseURL, testBaseURL+"/oauth2/jwks", 2, time.Second)
+}
+
+func (suite *SSFServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *SSFServiceTestSuite) allow(action security.Action) {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, action, mock.Anything).Return(true, nil)
+}
+
+// receiverContext returns a request context authenticated with an access token issued to the receiver.
+func receiverContext() context.Context {
+	return clientContext("receiver-app")
+}
+
+// clientContext returns a request context authenticated with an access token issued to the given client.
+func clientContext(clientID string) context.Context {
+	return security.WithSecurityContextTest(context.Background(), security.NewSecurityContextForTest(
+		"", "", "token", nil, map[string]interface{}{"client_id": clientID}))
+}
+
+func pushRequest() StreamRequest {
+	return StreamRequest{
+		Delivery: &DeliveryRequest{
+			Method:              DeliveryMethodPush,
+			EndpointURL:         "https://receiver.example.com/events",
+			AuthorizationHeader: "Bearer receiver-token",
+		},
+		EventsRequested: []string{EventTypeSessionRevoked, EventTypeCredentialChange, EventTypeSessionRevoked},
+	}
+}
+
+func (suite *SSFServiceTestSuite) TestGetTransmitterConfiguration() {
+	cfg := suite.service.GetTransmitterConfiguration(context.Background())
+
+	assert.Equal(suite.T(), testIssuer, cfg.Issuer)
+	assert.Equal(suite.T(), testBaseURL+"/oauth2/jwks", cfg.JWKSURI)
+	assert.Equal(suite.T(), testBaseURL+"/ssf/streams", cfg.ConfigurationEndpoint)
+	assert.Equal(suite.T(), testBaseURL+"/ssf/streams/status", cfg.StatusEndpoint)
+	assert.Equal(suite.T(), testBaseURL+"/ssf/streams/verify", cfg.VerificationEndpoint)
+	assert.Equal(suite.T(), []string{DeliveryMethodPush, DeliveryMethodPoll}, cfg.DeliveryMethodsSupported)
+}
+
+func (suite *SSFServiceTestSuite) TestCreateStream_Push() {
+	suite.allow(security.ActionCreateSSFStream)
+	var header *cmodels.Property
+	suite.mockStreamStore.On("createStream", mock.Anything, mock.MatchedBy(func(s Stream) bool {
+		return s.ID != "" && s.Audience == "receiver-app" && s.Status == StreamStatusEnabled &&
+			s.EndpointURL == "https://receiver.example.com/events" && len(s.EventsRequested) == 2
+	}), mock.Anything).Run(func(args mock.Arguments) {
+		header = args.Get(2).(*cmodels.Property)
+	}).Return(nil)
+
+	resp, svcErr := suite.service.CreateStream(receiverContext(), pushRequest())
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), "receiver-app", resp.Audience)
+	assert.Equal(suite.T(), testIssuer, resp.Issuer)
+	assert.Equal(suite.T(), []string{EventTypeSessionRevoked, EventTypeCredentialChange}, resp.EventsDelivered)
+	assert.Equal(suite.T(), supportedEventTypes, resp.EventsSupported)
+
+	suite.Require().NotNil(header)
+	assert.True(suite.T(), header.IsSecret())
+	value, err := header.GetValue()
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "Bearer receiver-token", value)
+}
+
+func (suite *SSFServiceTestSuite) TestCreateStream_Poll() {
+	suite.allow(security.ActionCreateSSFStream)
+	suite.mockStreamStore.On("createStream", mock.Anything, mock.Anything, (*cmodels.Property)(nil)).Return(nil)
+	request := pushRequest()
+	request.Delivery = &DeliveryRequest{Method: DeliveryMethodPoll, AuthorizationHeader: "Bearer ignored"}
+
+	resp, svcErr := suite.service.CreateStream(receiverContext(), request)
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), testBaseURL+"/ssf/poll/"+resp.StreamID, resp.Delivery.EndpointURL)
+}
+
+func (suite *SSFServiceTestSuite) TestCreateStream_InvalidRequest() {
+	testCases := []struct {
+		name     string
+		mutate   func(r *StreamRequest)
+		expected *tidcommon.ServiceError
+	}{
+		{"no delivery", func(r *StreamRequest) { r.Delivery = nil }, &ErrorInvalidDeliveryMethod},
+		{"unknown method", func(r *StreamRequest) { r.Delivery.Method = "urn:example:webhook" },
+			&ErrorInvalidDeliveryMethod},
+		{"relative endpoint", func(r *StreamRequest) { r.Delivery.EndpointURL = "/events" }, &ErrorInvalidEndpointURL},
+		{"plain http endpoint", func(r *StreamRequest) { r.Delivery.EndpointURL = "http://receiver.example.com/events" },
+			&ErrorInvalidEndpointURL},
+		{"private endpoint", func(r *StreamRequest) { r.Delivery.EndpointURL = "https://192.168.1.10/events" },
+			&ErrorInvalidEndpointURL},
+		{"no events", func(r *StreamRequest) { r.EventsRequested = nil }, &ErrorInvalidEventTypes},
+		{"only unsupported events", func(r *StreamRequest) { r.EventsRequested = []string{"urn:example:event"} },
+			&ErrorInvalidEventTypes},
+		{"blank event", func(r *StreamRequest) { r.EventsRequested = append(r.EventsRequested, "") },
+			&ErrorInvalidEventTypes},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.allow(security.ActionCreateSSFStream)
+			request := pushRequest()
+			tc.mutate(&request)
+
+			_, svcErr := suite.service.CreateStream(receiverContext(), request)
+			assert.Equal(suite.T(), tc.expected, svcErr)
+		})
+	}
+}
+
+func (suite *SSFServiceTestSuite) TestCreateStream_Unauthorized() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionCreateSSFStream, mock.Anything).
+		Return(false, nil)
+
+	_, svcErr := suite.service.CreateStream(receiverContext(), pushRequest())
+	assert.Equal(suite.T(), &tidcommon.ErrorUnauthorized, svcErr)
+}
+
+func (suite *SSFServiceTestSuite) TestCreateStream_RecordsAuditEvent() {
+	suite.allow(security.ActionCreateSSFStream)
+	suite.mockStreamStore.On("createStream", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	var published *providers.Event
+	suite.mockObservability.ExpectedCalls = nil
+	suite.mockObservability.On("IsEnabled").Return(true)
+	suite.mockObservability.On("PublishEvent", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		published = args.Get(1).(*providers.Event)
+	}).Return()
+
+	resp, svcErr := suite.service.CreateStream(receiverContext(), pushRequest())
+	suite.Require().Nil(svcErr)
+	suite.Require().NotNil(published)
+	assert.Equal(suite.T(), string(event.EventTypeAdminResourceCreated), published.Type)
+	assert.Equal(suite.T(), audit.TargetSSFStream, published.Data[event.DataKey.TargetType])
+	assert.Equal(suite.T(), resp.StreamID, published.Data[event.DataKey.TargetID])
+}
+
+func (suite *SSFServiceTestSuite) TestGetStream_Errors() {
+	suite.allow(security.ActionReadSSFStream)
+	_, svcErr := suite.service.GetStream(context.Background(), "")
+	assert.Equal(suite.T(), &ErrorMissingStreamID, svcErr)
+
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(nil, nil)
+	_, svcErr = suite.service.GetStream(context.Background(), "stream-1")
+	assert.Equal(suite.T(), &ErrorStreamNotFound, svcErr)
+}
+
+func (suite *SSFServiceTestSuite) TestGetStreams() {
+	suite.allow(security.ActionReadSSFStream)
+	suite.mockStreamStore.On("getStreamsByAudience", mock.Anything, "receiver-app").
+		Return([]Stream{testStream()}, nil)
+
+	streams, svcErr := suite.service.GetStreams(receiverContext())
+	suite.Require().Nil(svcErr)
+	suite.Require().Len(streams, 1)
+	assert.Equal(suite.T(), "stream-1", streams[0].StreamID)
+	assert.Equal(suite.T(), DeliveryMethodPush, streams[0].Delivery.Method)
+}
+
+func (suite *SSFServiceTestSuite) TestStreamsAreScopedToReceiver() {
+	for _, action := range []security.Action{security.ActionReadSSFStream, security.ActionUpdateSSFStream,
+		security.ActionDeleteSSFStream, security.ActionPollSSFStream} {
+		suite.allow(action)
+	}
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(pollStream(), nil)
+	suite.mockStreamStore.On("getStreamsByAudience", mock.Anything, "other-app").Return([]Stream{}, nil)
+	other := clientContext("other-app")
+
+	streams, svcErr := suite.service.GetStreams(other)
+	suite.Require().Nil(svcErr)
+	assert.Empty(suite.T(), streams)
+
+	_, svcErr = suite.service.GetStream(other, "stream-1")
+	assert.Equal(suite.T(), &ErrorStreamNotFound, svcErr)
+	_, svcErr = suite.service.UpdateStream(other, StreamRequest{StreamID: "stream-1", Description: new("x")})
+	assert.Equal(suite.T(), &ErrorStreamNotFound, svcErr)
+	_, svcErr = suite.service.ReplaceStream(other, StreamRequest{StreamID: "stream-1"})
+	assert.Equal(suite.T(), &ErrorStreamNotFound, svcErr)
+	assert.Equal(suite.T(), &ErrorStreamNotFound, suite.service.DeleteStream(other, "stream-1"))
+	_, svcErr = suite.service.GetStreamStatus(other, "stream-1")
+	assert.Equal(suite.T(), &ErrorStreamNotFound, svcErr)
+	_, svcErr = suite.service.UpdateStreamStatus(other,
+		StreamStatus{StreamID: "stream-1", Status: StreamStatusDisabled})
+	assert.Equal(suite.T(), &ErrorStreamNotFound, svcErr)
+	assert.Equal(suite.T(), &ErrorStreamNotFound,
+		suite.service.VerifyStream(other, VerificationRequest{StreamID: "stream-1"}))
+	_, svcErr = suite.service.PollEvents(other, "stream-1", PollRequest{Ack: []string{"jti-1"}})
+	assert.Equal(suite.T(), &ErrorStreamNotFound, svcErr)
+
+	// The owning receiver still sees its stream.
+	resp, svcErr := suite.service.GetStream(receiverContext(), "stream-1")
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), "stream-1", resp.StreamID)
+	suite.mockStreamStore.AssertNotCalled(suite.T(), "deleteStream", mock.Anything, mock.Anything)
+	suite.mockEventStore.AssertNotCalled(suite.T(), "deleteEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SSFServiceTestSuite) TestUpdateStream_ChangesOnlyPresentFields() {
+	suite.allow(security.ActionUpdateSSFStream)
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(new(testStream()), nil)
+	description := "Session signals"
+	suite.mockStreamStore.On("updateStream", mock.Anything, mock.MatchedBy(func(s Stream) bool {
+		return s.Description == description && s.EndpointURL == "https://receiver.example.com/events" &&
+			len(s.EventsRequested) == 2
+	})).Return(nil)
+
+	resp, svcErr := suite.service.UpdateStream(receiverContext(),
+		StreamRequest{StreamID: "stream-1", Description: &description})
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), description, resp.Description)
+}
+
+func (suite *SSFServiceTestSuite) TestReplaceStream() {
+	suite.allow(security.ActionUpdateSSFStream)
+	existing := testStream()
+	existing.Description = "Session signals"
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(&existing, nil)
+
+	_, svcErr := suite.service.ReplaceStream(receiverContext(), StreamRequest{StreamID: "stream-1"})
+	assert.Equal(suite.T(), &ErrorInvalidDeliveryMethod, svcErr)
+
+	suite.mockStreamStore.On("updateStream", mock.Anything, mock.MatchedBy(func(s Stream) bool {
+		return s.Description == "" && s.DeliveryMethod == DeliveryMethodPoll &&
+			s.EventsRequested[0] == EventTypeAccountDisabled
+	})).Return(nil)
+	suite.mockStreamStore.On("updateAuthorizationHeader", mock.Anything, "stream-1", (*cmodels.Property)(nil)).
+		Return(nil)
+
+	resp, svcErr := suite.service.ReplaceStream(receiverContext(), StreamRequest{
+		StreamID:        "stream-1",
+		Delivery:        &DeliveryRequest{Method: DeliveryMethodPoll},
+		EventsRequested: []string{EventTypeAccountDisabled},
+	})
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), testBaseURL+"/ssf/poll/stream-1", resp.Delivery.EndpointURL)
+}
+
+func (suite *SSFServiceTestSuite) TestDeleteStream() {
+	suite.allow(security.ActionDeleteSSFStream)
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(new(testStream()), nil)
+	suite.mockStreamStore.On("deleteStream", mock.Anything, "stream-1").Return(nil)
+	suite.mockEventStore.On("deleteStreamEvents", mock.Anything, "stream-1").Return(nil)
+
+	assert.Nil(suite.T(), suite.service.DeleteStream(receiverContext(), "stream-1"))
+}
+
+func (suite *SSFServiceTestSuite) TestUpdateStreamStatus() {
+	suite.allow(security.ActionUpdateSSFStream)
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(new(testStream()), nil)
+
+	_, svcErr := suite.service.UpdateStreamStatus(receiverContext(),
+		StreamStatus{StreamID: "stream-1", Status: "stopped"})
+	assert.Equal(suite.T(), &ErrorInvalidStatus, svcErr)
+
+	suite.mockStreamStore.On("updateStreamStatus", mock.Anything, "stream-1", StreamStatusDisabled, "offboarded").
+		Return(nil)
+	suite.mockEventStore.On("deleteStreamEvents", mock.Anything, "stream-1").Return(nil).Once()
+	status, svcErr := suite.service.UpdateStreamStatus(receiverContext(),
+		StreamStatus{StreamID: "stream-1", Status: StreamStatusDisabled, Reason: "offboarded"})
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), &StreamStatus{StreamID: "stream-1", Status: StreamStatusDisabled,
+		Reason: "offboarded"}, status)
+
+	suite.mockStreamStore.On("updateStreamStatus", mock.Anything, "stream-1", StreamStatusEnabled, "").
+		Return(nil)
+	_, svcErr = suite.service.UpdateStreamStatus(receiverContext(),
+		StreamStatus{StreamID: "stream-1", Status: StreamStatusEnabled})
+	suite.Require().Nil(svcErr)
+	assert.Len(suite.T(), suite.transmitter.wake, 1)
+}
+
+func (suite *SSFServiceTestSuite) TestVerifyStream() {
+	suite.allow(security.ActionUpdateSSFStream)
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(new(testStream()), nil)
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "", testIssuer, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			events, _ := claims[claimEvents].(map[string]interface{})
+			payload, _ := events[EventTypeVerification].(map[string]interface{})
+			return payload["state"] == "state-1"
+		}), jwt.TokenTypeSecEvent, "").Return(testToken("jti-1"), int64(0), nil)
+	suite.mockEventStore.On("insertEvent", mock.Anything, mock.MatchedBy(func(evt queuedEvent) bool {
+		return evt.JTI == "jti-1" && evt.StreamID == "stream-1" && evt.EventType == EventTypeVerification
+	})).Return(nil)
+
+	svcErr := suite.service.VerifyStream(receiverContext(), VerificationRequest{StreamID: "stream-1",
+		State: "state-1"})
+	assert.Nil(suite.T(), svcErr)
+}
+
+func (suite *SSFServiceTestSuite) TestVerifyStream_Disabled() {
+	suite.allow(security.ActionUpdateSSFStream)
+	disabled := testStream()
+	disabled.Status = StreamStatusDisabled
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(&disabled, nil)
+
+	svcErr := suite.service.VerifyStream(receiverContext(), VerificationRequest{StreamID: "stream-1"})
+	assert.Equal(suite.T(), &ErrorStreamDisabled, svcErr)
+}
+
+func pollStream() *Stream {
+	stream := testStream()
+	stream.DeliveryMethod = DeliveryMethodPoll
+	stream.EndpointURL = testBaseURL + "/ssf/poll/stream-1"
+	return &stream
+}
+
+func (suite *SSFServiceTestSuite) TestPollEvents_AcknowledgesAndReturnsEvents() {
+	suite.allow(security.ActionPollSSFStream)
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(pollStream(), nil)
+	suite.mockEventStore.On("deleteEvent", mock.Anything, "stream-1", "jti-0").Return(true, nil)
+	suite.mockEventStore.On("deleteEvent", mock.Anything, "stream-1", "jti-bad").Return(true, nil)
+	suite.mockEventStore.On("getPendingEvents", mock.Anything, "stream-1", mock.Anything, 3).Return([]queuedEvent{
+		{JTI: "jti-1", Token: "token-1"}, {JTI: "jti-2", Token: "token-2"}, {JTI: "jti-3", Token: "token-3"},
+	}, nil)
+
+	resp, svcErr := suite.service.PollEvents(receiverContext(), "stream-1", PollRequest{
+		MaxEvents: new(5),
+		Ack:       []string{"jti-0"},
+		SetErrs:   map[string]SetError{"jti-bad": {Err: "invalid_key"}},
+	})
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), map[string]string{"jti-1": "token-1", "jti-2": "token-2"}, resp.Sets)
+	assert.True(suite.T(), resp.MoreAvailable)
+}
+
+func (suite *SSFServiceTestSuite) TestPollEvents_WaitsForEvents() {
+	suite.allow(security.ActionPollSSFStream)
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(pollStream(), nil)
+	suite.mockEventStore.On("getPendingEvents", mock.Anything, "stream-1", mock.Anything, 3).
+		Return([]queuedEvent{}, nil)
+
+	resp, svcErr := suite.service.PollEvents(receiverContext(), "stream-1", PollRequest{})
+	suite.Require().Nil(svcErr)
+	assert.Empty(suite.T(), resp.Sets)
+	assert.False(suite.T(), resp.MoreAvailable)
+}
+
+func (suite *SSFServiceTestSuite) TestPollEvents_OnlyAcknowledges() {
+	suite.allow(security.ActionPollSSFStream)
+	paused := pollStream()
+	paused.Status = StreamStatusPaused
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(paused, nil)
+	suite.mockEventStore.On("deleteEvent", mock.Anything, "stream-1", "jti-0").Return(false, nil)
+
+	resp, svcErr := suite.service.PollEvents(receiverContext(), "stream-1",
+		PollRequest{Ack: []string{"jti-0"}, ReturnImmediately: true})
+	suite.Require().Nil(svcErr)
+	assert.Empty(suite.T(), resp.Sets)
+}
+
+func (suite *SSFServiceTestSuite) TestPollEvents_PushStream() {
+	suite.allow(security.ActionPollSSFStream)
+	suite.mockStreamStore.On("getStream", mock.Anything, "stream-1").Return(new(testStream()), nil)
+
+	_, svcErr := suite.service.PollEvents(receiverContext(), "stream-1", PollRequest{})
+	assert.Equal(suite.T(), &ErrorStreamNotPoll, svcErr)
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+var (
+	// queryCreateStream creates a new stream.
+	queryCreateStream = dbmodel.DBQuery{
+		ID: "SSQ-ST-01",
+		Query: `INSERT INTO "SSF_STREAM" (ID, AUDIENCE, DESCRIPTION, DELIVERY_METHOD, ENDPOINT_URL, ` +
+			`EVENTS_REQUESTED, STATUS, STATUS_REASON, PROPERTIES, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+	}
+
+	// queryGetStreamByID gets a stream by its ID.
+	queryGetStreamByID = dbmodel.DBQuery{
+		ID: "SSQ-ST-02",
+		Query: `SELECT ID, AUDIENCE, DESCRIPTION, DELIVERY_METHOD, ENDPOINT_URL, EVENTS_REQUESTED, STATUS, ` +
+			`STATUS_REASON FROM "SSF_STREAM" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetStreams gets every stream of the deployment, oldest first.
+	queryGetStreams = dbmodel.DBQuery{
+		ID: "SSQ-ST-03",
+		Query: `SELECT ID, AUDIENCE, DESCRIPTION, DELIVERY_METHOD, ENDPOINT_URL, EVENTS_REQUESTED, STATUS, ` +
+			`STATUS_REASON FROM "SSF_STREAM" WHERE DEPLOYMENT_ID = $1 ORDER BY CREATED_AT, ID`,
+	}
+
+	// queryGetStreamsByAudience gets the streams of the deployment that belong to a receiver, oldest first.
+	queryGetStreamsByAudience = dbmodel.DBQuery{
+		ID: "SSQ-ST-10",
+		Query: `SELECT ID, AUDIENCE, DESCRIPTION, DELIVERY_METHOD, ENDPOINT_URL, EVENTS_REQUESTED, STATUS, ` +
+			`STATUS_REASON FROM "SSF_STREAM" WHERE AUDIENCE = $1 AND DEPLOYMENT_ID = $2 ORDER BY CREATED_AT, ID`,
+	}
+
+	// queryGetStreamsByStatus gets the streams of the deployment with the given status.
+	queryGetStreamsByStatus = dbmodel.DBQuery{
+		ID: "SSQ-ST-04",
+		Query: `SELECT ID, AUDIENCE, DESCRIPTION, DELIVERY_METHOD, ENDPOINT_URL, EVENTS_REQUESTED, STATUS, ` +
+			`STATUS_REASON FROM "SSF_STREAM" WHERE STATUS = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryUpdateStream updates the configuration of a stream.
+	queryUpdateStream = dbmodel.DBQuery{
+		ID: "SSQ-ST-05",
+		PostgresQuery: `UPDATE "SSF_STREAM" SET DESCRIPTION = $1, DELIVERY_METHOD = $2, ENDPOINT_URL = $3, ` +
+			`EVENTS_REQUESTED = $4, UPDATED_AT = NOW() WHERE ID = $5 AND DEPLOYMENT_ID = $6`,
+		SQLiteQuery: `UPDATE "SSF_STREAM" SET DESCRIPTION = $1, DELIVERY_METHOD = $2, ENDPOINT_URL = $3, ` +
+			`EVENTS_REQUESTED = $4, UPDATED_AT = datetime('now') WHERE ID = $5 AND DEPLOYMENT_ID = $6`,
+		Query: `UPDATE "SSF_STREAM" SET DESCRIPTION = $1, DELIVERY_METHOD = $2, ENDPOINT_URL = $3, ` +
+			`EVENTS_REQUESTED = $4, UPDATED_AT = datetime('now') WHERE ID = $5 AND DEPLOYMENT_ID = $6`,
+	}
+
+	// queryUpdateStreamStatus updates the status of a stream.
+	queryUpdateStreamStatus = dbmodel.DBQuery{
+		ID: "SSQ-ST-06",
+		PostgresQuery: `UPDATE "SSF_STREAM" SET STATUS = $1, STATUS_REASON = $2, UPDATED_AT = NOW() ` +
+			`WHERE ID = $3 AND DEPLOYMENT_ID = $4`,
+		SQLiteQuery: `UPDATE "SSF_STREAM" SET STATUS = $1, STATUS_REASON = $2, UPDATED_AT = datetime('now') ` +
+			`WHERE ID = $3 AND DEPLOYMENT_ID = $4`,
+		Query: `UPDATE "SSF_STREAM" SET STATUS = $1, STATUS_REASON = $2, UPDATED_AT = datetime('now') ` +
+			`WHERE ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryUpdateStreamProperties replaces the properties, including the authorization header, of a stream.
+	queryUpdateStreamProperties = dbmodel.DBQuery{
+		ID: "SSQ-ST-07",
+		PostgresQuery: `UPDATE "SSF_STREAM" SET PROPERTIES = $1, UPDATED_AT = NOW() ` +
+			`WHERE ID = $2 AND DEPLOYMENT_ID = $3`,
+		SQLiteQuery: `UPDATE "SSF_STREAM" SET PROPERTIES = $1, UPDATED_AT = datetime('now') ` +
+			`WHERE ID = $2 AND DEPLOYMENT_ID = $3`,
+		Query: `UPDATE "SSF_STREAM" SET PROPERTIES = $1, UPDATED_AT = datetime('now') ` +
+			`WHERE ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryGetStreamProperties gets the properties, including the authorization header, of a stream.
+	queryGetStreamProperties = dbmodel.DBQuery{
+		ID:    "SSQ-ST-08",
+		Query: `SELECT PROPERTIES FROM "SSF_STREAM" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteStream deletes a stream.
+	queryDeleteStream = dbmodel.DBQuery{
+		ID:    "SSQ-ST-09",
+		Query: `DELETE FROM "SSF_STREAM" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
+
+var (
+	// queryInsertEvent queues a Security Event Token for a stream.
+	queryInsertEvent = dbmodel.DBQuery{
+		ID: "SSQ-EV-01",
+		Query: `INSERT INTO "SSF_EVENT" (JTI, DEPLOYMENT_ID, STREAM_ID, EVENT_TYPE, SET_TOKEN, ATTEMPTS, ` +
+			`NEXT_ATTEMPT_AT, CREATED_AT, EXPIRY_TIME) VALUES ($1, $2, $3, $4, $5, 0, $6, $7, $8)`,
+	}
+
+	// queryGetDueEvents gets the events of a stream whose next push attempt is due, oldest first.
+	queryGetDueEvents = dbmodel.DBQuery{
+		ID: "SSQ-EV-02",
+		Query: `SELECT JTI, STREAM_ID, EVENT_TYPE, SET_TOKEN, ATTEMPTS, NEXT_ATTEMPT_AT, CREATED_AT, ` +
+			`EXPIRY_TIME FROM "SSF_EVENT" WHERE STREAM_ID = $1 AND DEPLOYMENT_ID = $2 ` +
+			`AND NEXT_ATTEMPT_AT <= $3 AND EXPIRY_TIME > $3 ORDER BY NEXT_ATTEMPT_AT LIMIT $4`,
+	}
+
+	// queryClaimEvent claims an event for a push attempt by counting the attempt and leasing it until the
+	// given time. The attempt count guards against another node claiming it concurrently.
+	queryClaimEvent = dbmodel.DBQuery{
+		ID: "SSQ-EV-03",
+		Query: `UPDATE "SSF_EVENT" SET ATTEMPTS = ATTEMPTS + 1, NEXT_ATTEMPT_AT = $1 ` +
+			`WHERE JTI = $2 AND DEPLOYMENT_ID = $3 AND ATTEMPTS = $4`,
+	}
+
+	// queryRescheduleEvent schedules the next push attempt of an event after a failed attempt.
+	queryRescheduleEvent = dbmodel.DBQuery{
+		ID: "SSQ-EV-04",
+		Query: `UPDATE "SSF_EVENT" SET NEXT_ATTEMPT_AT = $1, LAST_ERROR = $2 ` +
+			`WHERE JTI = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryGetPendingEvents gets the unexpired events of a stream, oldest first.
+	queryGetPendingEvents = dbmodel.DBQuery{
+		ID: "SSQ-EV-05",
+		Query: `SELECT JTI, STREAM_ID, EVENT_TYPE, SET_TOKEN, ATTEMPTS, NEXT_ATTEMPT_AT, CREATED_AT, ` +
+			`EXPIRY_TIME FROM "SSF_EVENT" WHERE STREAM_ID = $1 AND DEPLOYMENT_ID = $2 AND EXPIRY_TIME > $3 ` +
+			`ORDER BY CREATED_AT, JTI LIMIT $4`,
+	}
+
+	// queryDeleteEvent removes an event of a stream, once it is delivered, acknowledged or dropped.
+	queryDeleteEvent = dbmodel.DBQuery{
+		ID:    "SSQ-EV-06",
+		Query: `DELETE FROM "SSF_EVENT" WHERE JTI = $1 AND STREAM_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryDeleteStreamEvents removes every event of a stream.
+	queryDeleteStreamEvents = dbmodel.DBQuery{
+		ID:    "SSQ-EV-07",
+		Query: `DELETE FROM "SSF_EVENT" WHERE STREAM_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteExpiredEvents removes the events past their retention period.
+	queryDeleteExpiredEvents = dbmodel.DBQuery{
+		ID:    "SSQ-EV-08",
+		Query: `DELETE FROM "SSF_EVENT" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
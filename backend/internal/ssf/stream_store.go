@@ -0,0 +1,287 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// streamStoreInterface defines the persistence of streams.
+type streamStoreInterface interface {
+	// createStream stores a new stream with its encrypted authorization header, which may be nil.
+	createStream(ctx context.Context, stream Stream, authorizationHeader *cmodels.Property) error
+	// getStream returns the stream with the given ID, or nil when it does not exist.
+	getStream(ctx context.Context, id string) (*Stream, error)
+	// getStreams returns every stream, oldest first.
+	getStreams(ctx context.Context) ([]Stream, error)
+	// getStreamsByAudience returns every stream of the given receiver, oldest first.
+	getStreamsByAudience(ctx context.Context, audience string) ([]Stream, error)
+	// getStreamsByStatus returns every stream with the given status.
+	getStreamsByStatus(ctx context.Context, status string) ([]Stream, error)
+	// updateStream updates the configuration of a stream. The status and authorization header are left
+	// unchanged.
+	updateStream(ctx context.Context, stream Stream) error
+	// updateStreamStatus updates the status of a stream.
+	updateStreamStatus(ctx context.Context, id, status, reason string) error
+	// updateAuthorizationHeader replaces the authorization header of a stream. A nil header removes it.
+	updateAuthorizationHeader(ctx context.Context, id string, authorizationHeader *cmodels.Property) error
+	// getAuthorizationHeader returns the decrypted authorization header of a stream, or an empty string
+	// when it has none.
+	getAuthorizationHeader(ctx context.Context, id string) (string, error)
+	// deleteStream deletes a stream.
+	deleteStream(ctx context.Context, id string) error
+}
+
+// streamStore implements streamStoreInterface against the configuration database.
+type streamStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newStreamStore creates a new streamStore.
+func newStreamStore() streamStoreInterface {
+	return &streamStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createStream stores a new stream with its encrypted authorization header, which may be nil.
+func (s *streamStore) createStream(ctx context.Context, stream Stream,
+	authorizationHeader *cmodels.Property) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	eventsJSON, err := json.Marshal(stream.EventsRequested)
+	if err != nil {
+		return fmt.Errorf("failed to marshal requested events: %w", err)
+	}
+	properties, err := serializeAuthorizationHeader(authorizationHeader)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateStream, stream.ID, stream.Audience, stream.Description,
+		stream.DeliveryMethod, stream.EndpointURL, string(eventsJSON), stream.Status, stream.StatusReason,
+		properties, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// getStream returns the stream with the given ID, or nil when it does not exist.
+func (s *streamStore) getStream(ctx context.Context, id string) (*Stream, error) {
+	streams, err := s.queryStreams(ctx, queryGetStreamByID, id, s.deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(streams) == 0 {
+		return nil, nil
+	}
+	return &streams[0], nil
+}
+
+// getStreams returns every stream, oldest first.
+func (s *streamStore) getStreams(ctx context.Context) ([]Stream, error) {
+	return s.queryStreams(ctx, queryGetStreams, s.deploymentID)
+}
+
+// getStreamsByAudience returns every stream of the given receiver, oldest first.
+func (s *streamStore) getStreamsByAudience(ctx context.Context, audience string) ([]Stream, error) {
+	return s.queryStreams(ctx, queryGetStreamsByAudience, audience, s.deploymentID)
+}
+
+// getStreamsByStatus returns every stream with the given status.
+func (s *streamStore) getStreamsByStatus(ctx context.Context, status string) ([]Stream, error) {
+	return s.queryStreams(ctx, queryGetStreamsByStatus, status, s.deploymentID)
+}
+
+// queryStreams runs the given query and builds the resulting streams.
+func (s *streamStore) queryStreams(ctx context.Context, query dbmodel.DBQuery,
+	args ...interface{}) ([]Stream, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	streams := make([]Stream, 0, len(results))
+	for _, row := range results {
+		stream, err := buildStreamFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, stream)
+	}
+
+	return streams, nil
+}
+
+// updateStream updates the configuration of a stream.
+func (s *streamStore) updateStream(ctx context.Context, stream Stream) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	eventsJSON, err := json.Marshal(stream.EventsRequested)
+	if err != nil {
+		return fmt.Errorf("failed to marshal requested events: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateStream, stream.Description, stream.DeliveryMethod,
+		stream.EndpointURL, string(eventsJSON), stream.ID, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// updateStreamStatus updates the status of a stream.
+func (s *streamStore) updateStreamStatus(ctx context.Context, id, status, reason string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateStreamStatus, status, reason, id,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// updateAuthorizationHeader replaces the authorization header of a stream. A nil header removes it.
+func (s *streamStore) updateAuthorizationHeader(ctx context.Context, id string,
+	authorizationHeader *cmodels.Property) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	properties, err := serializeAuthorizationHeader(authorizationHeader)
+	if err != nil {
+		return err
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateStreamProperties, properties, id,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// getAuthorizationHeader returns the decrypted authorization header of a stream, or an empty string when
+// it has none.
+func (s *streamStore) getAuthorizationHeader(ctx context.Context, id string) (string, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetStreamProperties, id, s.deploymentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return "", fmt.Errorf("stream %s not found", id)
+	}
+
+	properties, err := cmodels.DeserializePropertiesFromJSONObject(stringOrBytesField(results[0], "properties"))
+	if err != nil {
+		return "", fmt.Errorf("failed to deserialize properties: %w", err)
+	}
+	for i := range properties {
+		if properties[i].GetName() == propertyAuthorizationHeader {
+			return properties[i].GetValue()
+		}
+	}
+
+	return "", nil
+}
+
+// deleteStream deletes a stream.
+func (s *streamStore) deleteStream(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteStream, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// serializeAuthorizationHeader returns the properties column value holding the authorization header, or
+// nil (NULL) when there is none.
+func serializeAuthorizationHeader(authorizationHeader *cmodels.Property) (interface{}, error) {
+	if authorizationHeader == nil {
+		return nil, nil
+	}
+	propertiesJSON, err := cmodels.SerializePropertiesToJSONObject([]cmodels.Property{*authorizationHeader})
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize properties: %w", err)
+	}
+	return propertiesJSON, nil
+}
+
+// buildStreamFromResultRow constructs a Stream from a database result row.
+func buildStreamFromResultRow(row map[string]interface{}) (Stream, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return Stream{}, errors.New("failed to parse id as string")
+	}
+
+	var events []string
+	if eventsJSON := stringOrBytesField(row, "events_requested"); eventsJSON != "" {
+		if err := json.Unmarshal([]byte(eventsJSON), &events); err != nil {
+			return Stream{}, fmt.Errorf("failed to unmarshal requested events: %w", err)
+		}
+	}
+
+	return Stream{
+		ID:              id,
+		Audience:        stringOrBytesField(row, "audience"),
+		Description:     stringOrBytesField(row, "description"),
+		DeliveryMethod:  stringOrBytesField(row, "delivery_method"),
+		EndpointURL:     stringOrBytesField(row, "endpoint_url"),
+		EventsRequested: events,
+		Status:          stringOrBytesField(row, "status"),
+		StatusReason:    stringOrBytesField(row, "status_reason"),
+	}, nil
+}
+
+// stringOrBytesField returns the value of a nullable text column, which drivers return as either a
+// string or a byte slice, or an empty string when it is NULL.
+func stringOrBytesField(row map[string]interface{}, column string) string {
+	switch v := row[column].(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
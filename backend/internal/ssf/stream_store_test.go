@@ -0,0 +1,222 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StreamStoreTestSuite struct {
+	suite.Suite
+	mockdbProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *streamStore
+}
+
+func TestStreamStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StreamStoreTestSuite))
+}
+
+func (suite *StreamStoreTestSuite) SetupTest() {
+	suite.mockdbProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &streamStore{
+		dbProvider:   suite.mockdbProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func testStream() Stream {
+	return Stream{
+		ID:              "stream-1",
+		Audience:        "receiver-app",
+		DeliveryMethod:  DeliveryMethodPush,
+		EndpointURL:     "https://receiver.example.com/events",
+		EventsRequested: []string{EventTypeSessionRevoked, EventTypeCredentialChange},
+		Status:          StreamStatusEnabled,
+	}
+}
+
+func streamRow() map[string]interface{} {
+	return map[string]interface{}{
+		"id":               "stream-1",
+		"audience":         "receiver-app",
+		"description":      nil,
+		"delivery_method":  DeliveryMethodPush,
+		"endpoint_url":     "https://receiver.example.com/events",
+		"events_requested": []byte(`["` + EventTypeSessionRevoked + `","` + EventTypeCredentialChange + `"]`),
+		"status":           "enabled",
+		"status_reason":    nil,
+	}
+}
+
+func (suite *StreamStoreTestSuite) TestCreateStream() {
+	header, err := cmodels.NewProperty(propertyAuthorizationHeader, "Bearer receiver-token", true)
+	suite.Require().NoError(err)
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryCreateStream, "stream-1", "receiver-app",
+		"", DeliveryMethodPush, "https://receiver.example.com/events",
+		`["`+EventTypeSessionRevoked+`","`+EventTypeCredentialChange+`"]`, "enabled", "",
+		mock.MatchedBy(func(properties string) bool {
+			return properties != "" && !strings.Contains(properties, "receiver-token")
+		}), testDeploymentID).Return(int64(1), nil)
+
+	err = suite.store.createStream(context.Background(), testStream(), header)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *StreamStoreTestSuite) TestCreateStream_WithoutAuthorizationHeader() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryCreateStream, "stream-1", "receiver-app",
+		"", DeliveryMethodPush, "https://receiver.example.com/events", mock.Anything, "enabled", "", nil,
+		testDeploymentID).Return(int64(1), nil)
+
+	assert.NoError(suite.T(), suite.store.createStream(context.Background(), testStream(), nil))
+}
+
+func (suite *StreamStoreTestSuite) TestCreateStream_DBClientError() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(nil, errors.New("db unavailable"))
+
+	err := suite.store.createStream(context.Background(), testStream(), nil)
+	assert.ErrorContains(suite.T(), err, "failed to get database client")
+}
+
+func (suite *StreamStoreTestSuite) TestGetStream() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetStreamByID, "stream-1",
+		testDeploymentID).Return([]map[string]interface{}{streamRow()}, nil)
+
+	stream, err := suite.store.getStream(context.Background(), "stream-1")
+	assert.NoError(suite.T(), err)
+	expected := testStream()
+	assert.Equal(suite.T(), &expected, stream)
+}
+
+func (suite *StreamStoreTestSuite) TestGetStream_NotFound() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetStreamByID, "stream-1",
+		testDeploymentID).Return([]map[string]interface{}{}, nil)
+
+	stream, err := suite.store.getStream(context.Background(), "stream-1")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), stream)
+}
+
+func (suite *StreamStoreTestSuite) TestGetStream_InvalidRow() {
+	row := streamRow()
+	row["events_requested"] = "not json"
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetStreamByID, "stream-1",
+		testDeploymentID).Return([]map[string]interface{}{row}, nil)
+
+	_, err := suite.store.getStream(context.Background(), "stream-1")
+	assert.ErrorContains(suite.T(), err, "failed to unmarshal requested events")
+}
+
+func (suite *StreamStoreTestSuite) TestGetStreamsByAudience() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetStreamsByAudience, "receiver-app",
+		testDeploymentID).Return([]map[string]interface{}{streamRow()}, nil)
+
+	streams, err := suite.store.getStreamsByAudience(context.Background(), "receiver-app")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []Stream{testStream()}, streams)
+}
+
+func (suite *StreamStoreTestSuite) TestGetStreamsByStatus() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetStreamsByStatus, StreamStatusEnabled,
+		testDeploymentID).Return([]map[string]interface{}{streamRow()}, nil)
+
+	streams, err := suite.store.getStreamsByStatus(context.Background(), StreamStatusEnabled)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []Stream{testStream()}, streams)
+}
+
+func (suite *StreamStoreTestSuite) TestGetStreams_QueryError() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetStreams, testDeploymentID).
+		Return(nil, errors.New("query error"))
+
+	_, err := suite.store.getStreams(context.Background())
+	assert.ErrorContains(suite.T(), err, "failed to execute query")
+}
+
+func (suite *StreamStoreTestSuite) TestUpdateStream() {
+	stream := testStream()
+	stream.Description = "Session signals"
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryUpdateStream, "Session signals",
+		DeliveryMethodPush, "https://receiver.example.com/events",
+		`["`+EventTypeSessionRevoked+`","`+EventTypeCredentialChange+`"]`, "stream-1", testDeploymentID).
+		Return(int64(1), nil)
+
+	assert.NoError(suite.T(), suite.store.updateStream(context.Background(), stream))
+}
+
+func (suite *StreamStoreTestSuite) TestUpdateStreamStatus() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryUpdateStreamStatus, StreamStatusPaused,
+		"maintenance", "stream-1", testDeploymentID).Return(int64(1), nil)
+
+	assert.NoError(suite.T(), suite.store.updateStreamStatus(context.Background(), "stream-1",
+		StreamStatusPaused, "maintenance"))
+}
+
+func (suite *StreamStoreTestSuite) TestAuthorizationHeaderRoundTrip() {
+	header, err := cmodels.NewProperty(propertyAuthorizationHeader, "Bearer receiver-token", true)
+	suite.Require().NoError(err)
+
+	var stored string
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryUpdateStreamProperties, mock.Anything,
+		"stream-1", testDeploymentID).Run(func(args mock.Arguments) {
+		stored = args.Get(2).(string)
+	}).Return(int64(1), nil)
+	suite.Require().NoError(suite.store.updateAuthorizationHeader(context.Background(), "stream-1", header))
+	assert.NotContains(suite.T(), stored, "receiver-token")
+
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetStreamProperties, "stream-1",
+		testDeploymentID).Return([]map[string]interface{}{{"properties": []byte(stored)}}, nil)
+	value, err := suite.store.getAuthorizationHeader(context.Background(), "stream-1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Bearer receiver-token", value)
+}
+
+func (suite *StreamStoreTestSuite) TestGetAuthorizationHeader_None() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetStreamProperties, "stream-1",
+		testDeploymentID).Return([]map[string]interface{}{{"properties": nil}}, nil).Once()
+
+	value, err := suite.store.getAuthorizationHeader(context.Background(), "stream-1")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), value)
+
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetStreamProperties, "stream-1",
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	_, err = suite.store.getAuthorizationHeader(context.Background(), "stream-1")
+	assert.ErrorContains(suite.T(), err, "not found")
+}
+
+func (suite *StreamStoreTestSuite) TestDeleteStream() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteStream, "stream-1",
+		testDeploymentID).Return(int64(0), errors.New("delete error"))
+
+	err := suite.store.deleteStream(context.Background(), "stream-1")
+	assert.ErrorContains(suite.T(), err, "failed to execute query")
+}
@@ -0,0 +1,455 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/revocation"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const loggerComponentNameTransmitter = "SSFTransmitter"
+
+// TransmitterInterface raises security events on the streams that request them. The session service
+// reports ended sessions and the OAuth revocation service reports criteria revocations through it.
+type TransmitterInterface interface {
+	session.LogoutNotifier
+	revocation.CriteriaRevocationNotifier
+}
+
+// transmitter signs security events as Security Event Tokens and queues one for every stream that
+// requests the event. Queued events live in the runtime persistent database, so they survive restarts;
+// a background dispatcher pushes the events of push streams, retrying failures with exponential backoff,
+// while poll streams keep theirs until the receiver acknowledges them.
+type transmitter struct {
+	id               string
+	streamStore      streamStoreInterface
+	eventStore       eventStoreInterface
+	jwtService       jwt.JWTServiceInterface
+	httpClient       syshttp.HTTPClientInterface
+	issuer           string
+	dispatchInterval time.Duration
+	batchSize        int
+	maxAttempts      int
+	initialBackoff   time.Duration
+	maxBackoff       time.Duration
+	requestTimeout   time.Duration
+	retention        time.Duration
+	wake             chan struct{}
+	stop             chan struct{}
+	closeOnce        sync.Once
+	logger           *log.Logger
+}
+
+var (
+	_ TransmitterInterface           = (*transmitter)(nil)
+	_ subscriber.SubscriberInterface = (*transmitter)(nil)
+)
+
+// newTransmitter creates a new transmitter that signs events with the given issuer. The dispatcher is not
+// started until Initialize is called.
+func newTransmitter(streamStore streamStoreInterface, eventStore eventStoreInterface,
+	jwtService jwt.JWTServiceInterface, issuer string, ssfConfig config.SSFConfig) *transmitter {
+	requestTimeout := config.SecondsOrDefault(ssfConfig.RequestTimeout, defaultRequestTimeout)
+	retentionDays := config.PositiveIntOrDefault(ssfConfig.RetentionDays, defaultRetentionDays)
+	return &transmitter{
+		streamStore:      streamStore,
+		eventStore:       eventStore,
+		jwtService:       jwtService,
+		httpClient:       newPushHTTPClient(),
+		issuer:           issuer,
+		dispatchInterval: config.SecondsOrDefault(ssfConfig.DispatchInterval, defaultDispatchInterval),
+		batchSize:        config.PositiveIntOrDefault(ssfConfig.BatchSize, defaultBatchSize),
+		maxAttempts:      config.PositiveIntOrDefault(ssfConfig.MaxAttempts, defaultMaxAttempts),
+		initialBackoff:   config.SecondsOrDefault(ssfConfig.InitialBackoff, defaultInitialBackoff),
+		maxBackoff:       config.SecondsOrDefault(ssfConfig.MaxBackoff, defaultMaxBackoff),
+		requestTimeout:   requestTimeout,
+		retention:        time.Duration(retentionDays) * 24 * time.Hour,
+		wake:             make(chan struct{}, 1),
+		stop:             make(chan struct{}),
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentNameTransmitter)),
+	}
+}
+
+// IsEnabled reports that the transmitter wants observability events; it is only created when SSF is
+// enabled.
+func (t *transmitter) IsEnabled() bool {
+	return true
+}
+
+// Initialize starts the push dispatcher.
+func (t *transmitter) Initialize() error {
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate SSF transmitter ID: %w", err)
+	}
+	t.id = id
+	go t.run()
+	return nil
+}
+
+// GetID returns the unique identifier of the transmitter as an observability subscriber.
+func (t *transmitter) GetID() string {
+	return t.id
+}
+
+// GetCategories returns the categories the transmitter is interested in: the flow events that carry
+// recoveries, and the administrative audit events that carry credential and attribute changes.
+func (t *transmitter) GetCategories() []event.EventCategory {
+	return []event.EventCategory{event.CategoryFlows, event.CategoryAudit}
+}
+
+// OnEvent raises the security event an observability event signals, if any.
+func (t *transmitter) OnEvent(evt *providers.Event) error {
+	if evt == nil {
+		return fmt.Errorf("event is nil")
+	}
+
+	securityEvt, ok := translateObservabilityEvent(t.issuer, evt)
+	if !ok {
+		return nil
+	}
+
+	// Subscribers run in detached goroutines after the request context may be
+	// cancelled, so derive a context from the event's trace ID.
+	ctx := sysContext.WithTraceID(context.Background(), evt.TraceID)
+	return t.emit(ctx, securityEvt)
+}
+
+// Close stops the push dispatcher. Queued events stay queued and are picked up on the next start.
+func (t *transmitter) Close() error {
+	t.closeOnce.Do(func() {
+		close(t.stop)
+	})
+	return nil
+}
+
+// NotifySessionEnded raises a session-revoked event for a session that ended.
+func (t *transmitter) NotifySessionEnded(ctx context.Context, sess session.Session, _ []session.Participant) {
+	if sess.SubjectID == "" {
+		return
+	}
+	t.emitAsync(ctx, newSessionRevokedEvent(t.issuer, sess, time.Now().UTC()))
+}
+
+// NotifyCriteriaRevoked raises the security event a persisted criteria revocation signals, if any.
+func (t *transmitter) NotifyCriteriaRevoked(ctx context.Context, rev revocation.CriteriaRevocation) {
+	securityEvt, ok := translateCriteriaRevocation(t.issuer, rev, time.Now().UTC())
+	if !ok {
+		return
+	}
+	t.emitAsync(ctx, securityEvt)
+}
+
+// emitAsync emits the event without holding up the caller, which is on a sign-out or revocation path.
+func (t *transmitter) emitAsync(ctx context.Context, evt securityEvent) {
+	if evt.txn == "" {
+		evt.txn = sysContext.GetTraceID(ctx)
+	}
+	// Emission outlives the request that triggered it, so it must not inherit its cancellation.
+	emitCtx := context.WithoutCancel(ctx)
+	go func() {
+		if err := t.emit(emitCtx, evt); err != nil {
+			t.logger.Error(emitCtx, "Failed to emit security event",
+				log.String("eventType", evt.eventType), log.Error(err))
+		}
+	}()
+}
+
+// emit queues the event on every stream that delivers it. Disabled streams drop events; paused streams
+// hold them until they are enabled again.
+func (t *transmitter) emit(ctx context.Context, evt securityEvent) error {
+	streams, err := t.streamStore.getStreams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load streams: %w", err)
+	}
+
+	var errs []error
+	queued := 0
+	for _, stream := range streams {
+		if stream.Status == StreamStatusDisabled || !slices.Contains(eventsDelivered(stream), evt.eventType) {
+			continue
+		}
+		if err := t.queueEvent(ctx, stream, evt); err != nil {
+			t.logger.Error(ctx, "Failed to queue security event",
+				log.String("streamID", stream.ID),
+				log.String("eventType", evt.eventType),
+				log.Error(err))
+			errs = append(errs, err)
+			continue
+		}
+		queued++
+	}
+
+	if queued > 0 {
+		t.signalDispatcher()
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to queue security events: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// queueEvent signs the event for the stream's receiver and queues the resulting Security Event Token.
+func (t *transmitter) queueEvent(ctx context.Context, stream Stream, evt securityEvent) error {
+	claims := map[string]interface{}{
+		"aud":       stream.Audience,
+		claimSubID:  evt.subject,
+		claimEvents: map[string]interface{}{evt.eventType: evt.payload},
+	}
+	if evt.txn != "" {
+		claims[claimTxn] = evt.txn
+	}
+
+	// The token stays valid for as long as it may be held for delivery.
+	token, _, svcErr := t.jwtService.GenerateJWT(ctx, "", t.issuer, int64(t.retention.Seconds()), claims,
+		jwt.TokenTypeSecEvent, "")
+	if svcErr != nil {
+		return fmt.Errorf("failed to sign security event token: %s", svcErr.Code)
+	}
+	payload, err := jwt.DecodeJWTPayload(token)
+	if err != nil {
+		return fmt.Errorf("failed to decode security event token: %w", err)
+	}
+	jti, ok := payload[claimJTI].(string)
+	if !ok || jti == "" {
+		return errors.New("security event token has no jti")
+	}
+
+	now := time.Now().UTC()
+	return t.eventStore.insertEvent(ctx, queuedEvent{
+		JTI:           jti,
+		StreamID:      stream.ID,
+		EventType:     evt.eventType,
+		Token:         token,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		ExpiryTime:    now.Add(t.retention),
+	})
+}
+
+// signalDispatcher wakes the dispatcher so new events are pushed without waiting for the next poll.
+func (t *transmitter) signalDispatcher() {
+	select {
+	case t.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run pushes the due events once every dispatch interval, or sooner when woken, and purges the expired
+// events once every cleanup interval, until Close is called.
+func (t *transmitter) run() {
+	t.purgeExpiredEvents()
+
+	dispatchTicker := time.NewTicker(t.dispatchInterval)
+	defer dispatchTicker.Stop()
+	cleanupTicker := time.NewTicker(cleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		select {
+		case <-dispatchTicker.C:
+			t.dispatchDueEvents()
+		case <-t.wake:
+			t.dispatchDueEvents()
+		case <-cleanupTicker.C:
+			t.purgeExpiredEvents()
+		case <-t.stop:
+			return
+		}
+	}
+}
+
+// dispatchDueEvents pushes a batch of the due events of every enabled push stream.
+func (t *transmitter) dispatchDueEvents() {
+	// Dispatch runs in the background, outside any request.
+	ctx := context.Background()
+
+	streams, err := t.streamStore.getStreamsByStatus(ctx, StreamStatusEnabled)
+	if err != nil {
+		t.logger.Error(ctx, "Failed to load enabled streams", log.Error(err))
+		return
+	}
+	for _, stream := range streams {
+		if stream.DeliveryMethod == DeliveryMethodPush {
+			t.dispatchStream(ctx, stream)
+		}
+	}
+}
+
+// dispatchStream pushes a batch of the due events of a stream. Each event is claimed before it is pushed
+// so that several server nodes can share the queue.
+func (t *transmitter) dispatchStream(ctx context.Context, stream Stream) {
+	events, err := t.eventStore.getDueEvents(ctx, stream.ID, time.Now().UTC(), t.batchSize)
+	if err != nil {
+		t.logger.Error(ctx, "Failed to load due security events",
+			log.String("streamID", stream.ID), log.Error(err))
+		return
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	authorizationHeader, err := t.streamStore.getAuthorizationHeader(ctx, stream.ID)
+	if err != nil {
+		t.logger.Error(ctx, "Failed to load stream authorization header",
+			log.String("streamID", stream.ID), log.Error(err))
+		return
+	}
+
+	for _, evt := range events {
+		claimed, err := t.eventStore.claimEvent(ctx, evt.JTI, evt.Attempts,
+			time.Now().UTC().Add(2*t.requestTimeout))
+		if err != nil {
+			t.logger.Error(ctx, "Failed to claim security event",
+				log.String("jti", evt.JTI), log.Error(err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		evt.Attempts++
+
+		t.push(ctx, stream, authorizationHeader, evt)
+	}
+}
+
+// push sends a claimed event to the stream's receiver and removes it once the receiver accepts or
+// rejects it, or reschedules it after a transient failure.
+func (t *transmitter) push(ctx context.Context, stream Stream, authorizationHeader string, evt queuedEvent) {
+	statusCode, err := t.send(ctx, stream.EndpointURL, authorizationHeader, evt.Token)
+	if err == nil {
+		t.removeEvent(ctx, stream.ID, evt.JTI)
+		return
+	}
+
+	logger := t.logger.With(log.String("streamID", stream.ID), log.String("jti", evt.JTI))
+	// RFC 8935 answers a Security Event Token the receiver cannot process with 400; sending it again
+	// would fail the same way.
+	if statusCode == http.StatusBadRequest {
+		logger.Warn(ctx, "Receiver rejected security event", log.Error(err))
+		t.removeEvent(ctx, stream.ID, evt.JTI)
+		return
+	}
+	if evt.Attempts >= t.maxAttempts {
+		logger.Warn(ctx, "Dropping security event after the maximum number of push attempts",
+			log.Int("attempts", evt.Attempts), log.Error(err))
+		t.removeEvent(ctx, stream.ID, evt.JTI)
+		return
+	}
+
+	logger.Debug(ctx, "Security event push attempt failed", log.Int("attempt", evt.Attempts), log.Error(err))
+	nextAttemptAt := time.Now().UTC().Add(t.backoff(evt.Attempts))
+	if err := t.eventStore.rescheduleEvent(ctx, evt.JTI, nextAttemptAt,
+		truncate(err.Error(), maxLastErrorLength)); err != nil {
+		logger.Error(ctx, "Failed to reschedule security event", log.Error(err))
+	}
+}
+
+// newPushHTTPClient returns the client push deliveries are sent through. Receivers choose their own
+// endpoint, so it refuses private targets at dial time and on every redirect.
+func newPushHTTPClient() syshttp.HTTPClientInterface {
+	return syshttp.NewHTTPClientWithCheckRedirect(func(req *http.Request, _ []*http.Request) error {
+		return syshttp.IsSSRFSafeURL(req.URL.String())
+	})
+}
+
+// send POSTs a Security Event Token to a push endpoint as described in RFC 8935. It returns the response
+// status code and an error unless the endpoint answered with a 2xx status.
+func (t *transmitter) send(ctx context.Context, endpointURL, authorizationHeader, token string) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpointURL, strings.NewReader(token))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentTypeSecEvent)
+	req.Header.Set("Accept", contentTypeJSON)
+	if authorizationHeader != "" {
+		req.Header.Set("Authorization", authorizationHeader)
+	}
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("endpoint returned status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// removeEvent removes an event that needs no further push attempts.
+func (t *transmitter) removeEvent(ctx context.Context, streamID, jti string) {
+	if _, err := t.eventStore.deleteEvent(ctx, streamID, jti); err != nil {
+		t.logger.Error(ctx, "Failed to remove security event", log.String("jti", jti), log.Error(err))
+	}
+}
+
+// backoff returns the delay before the retry that follows the given number of attempts. The delay starts
+// at the initial backoff and doubles with every attempt, up to the maximum backoff.
+func (t *transmitter) backoff(attempts int) time.Duration {
+	delay := t.initialBackoff
+	for i := 1; i < attempts && delay < t.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, t.maxBackoff)
+}
+
+// purgeExpiredEvents removes the events past their retention period that were never delivered.
+func (t *transmitter) purgeExpiredEvents() {
+	// Retention cleanup runs in the background, outside any request.
+	ctx := context.Background()
+
+	deleted, err := t.eventStore.deleteExpiredEvents(ctx, time.Now().UTC())
+	if err != nil {
+		t.logger.Error(ctx, "Failed to purge expired security events", log.Error(err))
+		return
+	}
+	if deleted > 0 {
+		t.logger.Debug(ctx, "Purged expired security events", log.Any("count", deleted))
+	}
+}
+
+// eventsDelivered returns the event types the transmitter delivers on a stream: those it supports that
+// the receiver requested.
+func eventsDelivered(stream Stream) []string {
+	delivered := make([]string, 0, len(stream.EventsRequested))
+	for _, eventType := range supportedEventTypes {
+		if slices.Contains(stream.EventsRequested, eventType) {
+			delivered = append(delivered, eventType)
+		}
+	}
+	return delivered
+}
+
+// truncate shortens s to at most maxLength bytes.
+func truncate(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+	return s[:maxLength]
+}
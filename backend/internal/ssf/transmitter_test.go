@@ -0,0 +1,245 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/revocation"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+type TransmitterTestSuite struct {
+	suite.Suite
+	mockStreamStore *streamStoreInterfaceMock
+	mockEventStore  *eventStoreInterfaceMock
+	mockJWTService  *jwtmock.JWTServiceInterfaceMock
+	mockHTTPClient  *httpmock.HTTPClientInterfaceMock
+	transmitter     *transmitter
+}
+
+func TestTransmitterTestSuite(t *testing.T) {
+	suite.Run(t, new(TransmitterTestSuite))
+}
+
+func (suite *TransmitterTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+
+	suite.mockStreamStore = newStreamStoreInterfaceMock(suite.T())
+	suite.mockEventStore = newEventStoreInterfaceMock(suite.T())
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+
+	suite.transmitter = newTransmitter(suite.mockStreamStore, suite.mockEventStore, suite.mockJWTService,
+		testIssuer, config.SSFConfig{MaxAttempts: 3, InitialBackoff: 10, MaxBackoff: 60, RetentionDays: 1})
+	suite.transmitter.httpClient = suite.mockHTTPClient
+}
+
+func (suite *TransmitterTestSuite) TearDownTest() {
+	_ = suite.transmitter.Close()
+	config.ResetServerRuntime()
+}
+
+// testToken returns an unsigned token whose payload carries the given jti.
+func testToken(jti string) string {
+	payload, _ := json.Marshal(map[string]interface{}{"jti": jti})
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(payload) + ".c2lnbmF0dXJl"
+}
+
+func queuedTestEvent(attempts int) queuedEvent {
+	return queuedEvent{
+		JTI:       "jti-1",
+		StreamID:  "stream-1",
+		EventType: EventTypeSessionRevoked,
+		Token:     testToken("jti-1"),
+		Attempts:  attempts,
+	}
+}
+
+func httpResponse(statusCode int) *http.Response {
+	return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func (suite *TransmitterTestSuite) TestSubscriber() {
+	suite.mockEventStore.On("deleteExpiredEvents", mock.Anything, mock.Anything).Return(int64(0), nil).Maybe()
+	suite.Require().NoError(suite.transmitter.Initialize())
+
+	assert.True(suite.T(), suite.transmitter.IsEnabled())
+	assert.NotEmpty(suite.T(), suite.transmitter.GetID())
+	assert.Equal(suite.T(), []event.EventCategory{event.CategoryFlows, event.CategoryAudit},
+		suite.transmitter.GetCategories())
+	assert.Equal(suite.T(), 24*time.Hour, suite.transmitter.retention)
+	assert.Equal(suite.T(), defaultDispatchInterval, suite.transmitter.dispatchInterval)
+}
+
+func (suite *TransmitterTestSuite) TestEmit_QueuesOnStreamsThatDeliverTheEvent() {
+	paused := testStream()
+	paused.ID = "stream-2"
+	paused.Status = StreamStatusPaused
+	disabled := testStream()
+	disabled.ID = "stream-3"
+	disabled.Status = StreamStatusDisabled
+	other := testStream()
+	other.ID = "stream-4"
+	other.EventsRequested = []string{EventTypeAccountDisabled}
+	suite.mockStreamStore.On("getStreams", mock.Anything).
+		Return([]Stream{testStream(), paused, disabled, other}, nil)
+
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "", testIssuer, int64(86400),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			events, _ := claims[claimEvents].(map[string]interface{})
+			_, ok := events[EventTypeSessionRevoked]
+			return claims["aud"] == "receiver-app" && claims[claimTxn] == "trace-1" && ok
+		}), jwt.TokenTypeSecEvent, "").Return(testToken("jti-1"), int64(0), nil).Twice()
+
+	var queued []queuedEvent
+	suite.mockEventStore.On("insertEvent", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		queued = append(queued, args.Get(1).(queuedEvent))
+	}).Return(nil)
+
+	evt := newSessionRevokedEvent(testIssuer, session.Session{SessionID: "sess-1", SubjectID: "user-1"},
+		time.Now())
+	evt.txn = "trace-1"
+	suite.Require().NoError(suite.transmitter.emit(context.Background(), evt))
+
+	suite.Require().Len(queued, 2)
+	assert.Equal(suite.T(), "stream-1", queued[0].StreamID)
+	assert.Equal(suite.T(), "stream-2", queued[1].StreamID)
+	assert.Equal(suite.T(), "jti-1", queued[0].JTI)
+	assert.Equal(suite.T(), EventTypeSessionRevoked, queued[0].EventType)
+	assert.Equal(suite.T(), 24*time.Hour, queued[0].ExpiryTime.Sub(queued[0].CreatedAt))
+	assert.Len(suite.T(), suite.transmitter.wake, 1)
+}
+
+func (suite *TransmitterTestSuite) TestEmit_StreamLoadError() {
+	suite.mockStreamStore.On("getStreams", mock.Anything).Return(nil, errors.New("db error"))
+
+	err := suite.transmitter.emit(context.Background(), newVerificationEvent("stream-1", ""))
+	assert.ErrorContains(suite.T(), err, "failed to load streams")
+}
+
+func (suite *TransmitterTestSuite) TestNotifySessionEnded_SkipsSessionWithoutSubject() {
+	suite.transmitter.NotifySessionEnded(context.Background(), session.Session{SessionID: "sess-1"}, nil)
+}
+
+func (suite *TransmitterTestSuite) TestNotifyCriteriaRevoked_EmitsAccountDisabled() {
+	emitted := make(chan context.Context, 1)
+	suite.mockStreamStore.On("getStreams", mock.Anything).Run(func(args mock.Arguments) {
+		emitted <- args.Get(0).(context.Context)
+	}).Return([]Stream{}, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	suite.transmitter.NotifyCriteriaRevoked(ctx, revocation.CriteriaRevocation{
+		Criterion: revocation.Criterion{Type: revocation.CriterionTypeSubject, Value: "user-1"},
+		Reason:    revocation.ReasonUserDeleted,
+	})
+	// The event is emitted after the request that caused it has completed.
+	cancel()
+
+	select {
+	case emitCtx := <-emitted:
+		assert.NoError(suite.T(), emitCtx.Err())
+	case <-time.After(time.Second):
+		suite.Fail("account-disabled event was not emitted")
+	}
+}
+
+func (suite *TransmitterTestSuite) TestNotifyCriteriaRevoked_IgnoresInternalRevocations() {
+	suite.transmitter.NotifyCriteriaRevoked(context.Background(), revocation.CriteriaRevocation{
+		Criterion: revocation.Criterion{Type: revocation.CriterionTypeSubject, Value: "user-1"},
+		Reason:    revocation.ReasonSessionLogout,
+	})
+}
+
+func (suite *TransmitterTestSuite) TestOnEvent_IgnoresUnrelatedEvents() {
+	err := suite.transmitter.OnEvent(&providers.Event{Type: string(event.EventTypeFlowStarted)})
+	assert.NoError(suite.T(), err)
+
+	assert.Error(suite.T(), suite.transmitter.OnEvent(nil))
+}
+
+func (suite *TransmitterTestSuite) TestDispatchStream_DeliversEvent() {
+	suite.mockEventStore.On("getDueEvents", mock.Anything, "stream-1", mock.Anything, defaultBatchSize).
+		Return([]queuedEvent{queuedTestEvent(0)}, nil)
+	suite.mockStreamStore.On("getAuthorizationHeader", mock.Anything, "stream-1").
+		Return("Bearer receiver-token", nil)
+	suite.mockEventStore.On("claimEvent", mock.Anything, "jti-1", 0, mock.Anything).Return(true, nil)
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.Method == http.MethodPost && req.URL.String() == "https://receiver.example.com/events" &&
+			req.Header.Get("Content-Type") == contentTypeSecEvent &&
+			req.Header.Get("Authorization") == "Bearer receiver-token" &&
+			string(body) == testToken("jti-1")
+	})).Return(httpResponse(http.StatusAccepted), nil)
+	suite.mockEventStore.On("deleteEvent", mock.Anything, "stream-1", "jti-1").Return(true, nil)
+
+	suite.transmitter.dispatchStream(context.Background(), testStream())
+}
+
+func (suite *TransmitterTestSuite) TestDispatchStream_ClaimedElsewhere() {
+	suite.mockEventStore.On("getDueEvents", mock.Anything, "stream-1", mock.Anything, defaultBatchSize).
+		Return([]queuedEvent{queuedTestEvent(1)}, nil)
+	suite.mockStreamStore.On("getAuthorizationHeader", mock.Anything, "stream-1").Return("", nil)
+	suite.mockEventStore.On("claimEvent", mock.Anything, "jti-1", 1, mock.Anything).Return(false, nil)
+
+	suite.transmitter.dispatchStream(context.Background(), testStream())
+}
+
+func (suite *TransmitterTestSuite) TestPush_RejectedEventIsDropped() {
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(httpResponse(http.StatusBadRequest), nil)
+	suite.mockEventStore.On("deleteEvent", mock.Anything, "stream-1", "jti-1").Return(true, nil)
+
+	suite.transmitter.push(context.Background(), testStream(), "", queuedTestEvent(1))
+}
+
+func (suite *TransmitterTestSuite) TestPush_FailureIsRetried() {
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(httpResponse(http.StatusServiceUnavailable), nil)
+	before := time.Now().UTC()
+	suite.mockEventStore.On("rescheduleEvent", mock.Anything, "jti-1",
+		mock.MatchedBy(func(next time.Time) bool {
+			return !next.Before(before.Add(20 * time.Second))
+		}), "endpoint returned status 503").Return(nil)
+
+	suite.transmitter.push(context.Background(), testStream(), "", queuedTestEvent(2))
+}
+
+func (suite *TransmitterTestSuite) TestPush_DroppedAfterMaxAttempts() {
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("connection refused"))
+	suite.mockEventStore.On("deleteEvent", mock.Anything, "stream-1", "jti-1").Return(true, nil)
+
+	suite.transmitter.push(context.Background(), testStream(), "", queuedTestEvent(3))
+}
+
+func (suite *TransmitterTestSuite) TestBackoff() {
+	assert.Equal(suite.T(), 10*time.Second, suite.transmitter.backoff(1))
+	assert.Equal(suite.T(), 20*time.Second, suite.transmitter.backoff(2))
+	assert.Equal(suite.T(), 40*time.Second, suite.transmitter.backoff(3))
+	assert.Equal(suite.T(), time.Minute, suite.transmitter.backoff(10))
+}
+
+func (suite *TransmitterTestSuite) TestEventsDelivered() {
+	stream := testStream()
+	stream.EventsRequested = []string{"urn:example:unsupported", EventTypeAccountDisabled, EventTypeSessionRevoked}
+
+	assert.Equal(suite.T(), []string{EventTypeSessionRevoked, EventTypeAccountDisabled}, eventsDelivered(stream))
+}
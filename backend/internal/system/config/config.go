@@ -15,6 +15,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/log/rollingfile"
@@ -174,6 +175,22 @@ type UserConfig struct {
 	//   - If DeclarativeResources.Enabled = true: behaves as "declarative"
 	//   - If DeclarativeResources.Enabled = false: behaves as "mutable"
 	Store string `yaml:"store"              json:"store"`
+	// Import holds the limits of the bulk user import jobs.
+	Import UserImportConfig `yaml:"import"             json:"import"`
+}
+
+// UserImportConfig holds the limits of the bulk user import jobs. Uploaded files are processed in the
+// background by the node that accepted them, and the job progress and row errors are kept in the runtime
+// persistent database for the retention period after the job ends.
+type UserImportConfig struct {
+	// MaxUploadSize is the maximum size, in bytes, of an uploaded import file.
+	MaxUploadSize int64 `yaml:"max_upload_size" json:"max_upload_size"`
+	// MaxConcurrentJobs is the number of import jobs a node runs at the same time.
+	MaxConcurrentJobs int `yaml:"max_concurrent_jobs" json:"max_concurrent_jobs"`
+	// MaxReportedErrors caps the number of row errors kept for the error report of a job.
+	MaxReportedErrors int `yaml:"max_reported_errors" json:"max_reported_errors"`
+	// RetentionDays is the number of days a finished job and its error report are kept.
+	RetentionDays int `yaml:"retention_days" json:"retention_days"`
 }
 
 // PasskeyConfig holds the passkey configuration details.
@@ -181,6 +198,36 @@ type PasskeyConfig struct {
 	AllowedOrigins []string `yaml:"allowed_origins" json:"allowed_origins"`
 }
 
+// TOTPConfig holds the authenticator app (TOTP) configuration details. Codes are always derived
+// with HMAC-SHA1, the only algorithm authenticator apps reliably support.
+type TOTPConfig struct {
+	// Issuer is the account issuer shown by authenticator apps.
+	Issuer        string `yaml:"issuer"         json:"issuer"`
+	Digits        int    `yaml:"digits"         json:"digits"`
+	PeriodSeconds int    `yaml:"period_seconds" json:"period_seconds"`
+	// Skew is the number of time steps accepted on each side of the current one to tolerate
+	// clock drift between the server and the authenticator.
+	Skew              int `yaml:"skew"                json:"skew"`
+	RecoveryCodeCount int `yaml:"recovery_code_count" json:"recovery_code_count"`
+}
+
+// Validate ensures TOTP configuration values are within accepted bounds.
+func (c *TOTPConfig) Validate() error {
+	if c.Digits < 6 || c.Digits > 8 {
+		return fmt.Errorf("totp.digits must be in [6, 8] (got %d)", c.Digits)
+	}
+	if c.PeriodSeconds < 15 || c.PeriodSeconds > 120 {
+		return fmt.Errorf("totp.period_seconds must be in [15, 120] (got %d)", c.PeriodSeconds)
+	}
+	if c.Skew < 0 || c.Skew > 3 {
+		return fmt.Errorf("totp.skew must be in [0, 3] (got %d)", c.Skew)
+	}
+	if c.RecoveryCodeCount < 0 || c.RecoveryCodeCount > 20 {
+		return fmt.Errorf("totp.recovery_code_count must be in [0, 20] (got %d)", c.RecoveryCodeCount)
+	}
+	return nil
+}
+
 // AttestationConfig holds engine-level platform attestation configuration shared across
 // applications.
 type AttestationConfig struct {
@@ -241,6 +288,198 @@ type TrustedAnchorEntry struct {
 	CertFile string `yaml:"cert_file" json:"cert_file"`
 }
 
+// SAMLConfig holds the SAML 2.0 identity provider configuration. Service providers are registered
+// per application as saml2 inbound auth configurations; these settings apply to the IdP as a whole.
+type SAMLConfig struct {
+	// EntityID is the IdP entity ID. Defaults to the server's /saml2/metadata URL when empty.
+	EntityID string `yaml:"entity_id" json:"entity_id"`
+	// SigningKeyID selects the certificate-backed key that signs responses and assertions.
+	SigningKeyID string `yaml:"signing_key_id" json:"signing_key_id"`
+	// AssertionValiditySeconds bounds the NotOnOrAfter of issued assertions.
+	AssertionValiditySeconds int64 `yaml:"assertion_validity_seconds" json:"assertion_validity_seconds"`
+	// RequestValiditySeconds bounds both the accepted age of an AuthnRequest and the time the user
+	// has to complete authentication.
+	RequestValiditySeconds int64 `yaml:"request_validity_seconds" json:"request_validity_seconds"`
+}
+
+// SCIMConfig holds the SCIM 2.0 provisioning server configuration.
+type SCIMConfig struct {
+	// Enabled controls whether the /scim2 endpoints are served.
+	Enabled *bool `yaml:"enabled" json:"enabled"`
+	// UserType is the user type of users created without the ThunderID extension's type.
+	UserType string `yaml:"user_type" json:"user_type"`
+	// MaxResults caps the number of resources returned by a single list or search request. It is
+	// itself capped at the maximum page size of the user and group APIs.
+	MaxResults int `yaml:"max_results" json:"max_results"`
+	// UserAttributes maps SCIM core User attribute paths (userName, name.givenName, emails, ...)
+	// to user attributes. Core attributes without a mapping are ignored.
+	UserAttributes map[string]string `yaml:"user_attributes" json:"user_attributes"`
+	// Bulk holds the limits of the /scim2/Bulk endpoint.
+	Bulk SCIMBulkConfig `yaml:"bulk" json:"bulk"`
+}
+
+// IsEnabled returns whether the SCIM server is enabled, defaulting to false if unset
+// (an explicit default lives in default.json).
+func (c SCIMConfig) IsEnabled() bool {
+	return c.Enabled != nil && *c.Enabled
+}
+
+// SSFConfig holds the Shared Signals Framework transmitter configuration. Security events are queued in
+// the runtime persistent database until the receiver's push endpoint accepts them or the receiver polls
+// and acknowledges them.
+type SSFConfig struct {
+	// Enabled controls whether the transmitter emits events and serves the /ssf endpoints.
+	Enabled *bool `yaml:"enabled" json:"enabled"`
+	// DispatchInterval is the interval, in seconds, between polls of the push delivery queue.
+	DispatchInterval int `yaml:"dispatch_interval" json:"dispatch_interval"`
+	// BatchSize is the maximum number of events pushed in one poll of the delivery queue.
+	BatchSize int `yaml:"batch_size" json:"batch_size"`
+	// MaxAttempts is the number of push attempts after which an event is dropped.
+	MaxAttempts int `yaml:"max_attempts" json:"max_attempts"`
+	// InitialBackoff is the delay, in seconds, before the first push retry. It doubles on every retry.
+	InitialBackoff int `yaml:"initial_backoff" json:"initial_backoff"`
+	// MaxBackoff is the upper bound, in seconds, of the delay between push retries.
+	MaxBackoff int `yaml:"max_backoff" json:"max_backoff"`
+	// RequestTimeout is the timeout, in seconds, of a single push request.
+	RequestTimeout int `yaml:"request_timeout" json:"request_timeout"`
+	// RetentionDays is the number of days an undelivered event is kept before it is dropped.
+	RetentionDays int `yaml:"retention_days" json:"retention_days"`
+	// MaxPollEvents caps the number of events returned by a single poll request.
+	MaxPollEvents int `yaml:"max_poll_events" json:"max_poll_events"`
+	// LongPollTimeout is the time, in seconds, a long poll request waits for events to become available.
+	LongPollTimeout int `yaml:"long_poll_timeout" json:"long_poll_timeout"`
+}
+
+// IsEnabled returns whether the SSF transmitter is enabled, defaulting to false if unset.
+func (c SSFConfig) IsEnabled() bool {
+	return c.Enabled != nil && *c.Enabled
+}
+
+// LockoutConfig holds the brute-force protection policy applied to password and OTP verification. Failed
+// attempts are counted per entity and per client IP within a sliding window; an entity that reaches the
+// threshold is locked temporarily, and permanently once it has been locked MaxTemporaryLocks times.
+type LockoutConfig struct {
+	// Enabled controls whether failed attempts are tracked and lockouts are enforced.
+	Enabled *bool `yaml:"enabled" json:"enabled"`
+	// MaxFailedAttempts is the number of failed attempts within the failure window that locks an entity.
+	MaxFailedAttempts int `yaml:"max_failed_attempts" json:"max_failed_attempts"`
+	// FailureWindow is the time, in seconds, for which a failed attempt is counted.
+	FailureWindow int `yaml:"failure_window" json:"failure_window"`
+	// LockDuration is the duration, in seconds, of a temporary lock.
+	LockDuration int `yaml:"lock_duration" json:"lock_duration"`
+	// MaxTemporaryLocks is the number of temporary locks after which the next lock is permanent.
+	// Zero disables permanent locks.
+	MaxTemporaryLocks int `yaml:"max_temporary_locks" json:"max_temporary_locks"`
+	// InitialDelay is the delay, in seconds, enforced after the first failed attempt. It doubles on every
+	// further failure. Zero disables progressive delays.
+	InitialDelay int `yaml:"initial_delay" json:"initial_delay"`
+	// MaxDelay is the upper bound, in seconds, of the progressive delay.
+	MaxDelay int `yaml:"max_delay" json:"max_delay"`
+	// IPMaxFailedAttempts is the number of failed attempts from one client IP within the failure window
+	// that blocks the IP. Zero disables IP blocking.
+	IPMaxFailedAttempts int `yaml:"ip_max_failed_attempts" json:"ip_max_failed_attempts"`
+	// IPBlockDuration is the duration, in seconds, for which a client IP stays blocked.
+	IPBlockDuration int `yaml:"ip_block_duration" json:"ip_block_duration"`
+	// ClientIPHeader is the request header carrying the client IP when the server runs behind a reverse
+	// proxy, such as X-Forwarded-For. The last address in the header is used, and only on connections
+	// from TrustedProxies. The connection address is used otherwise.
+	ClientIPHeader string `yaml:"client_ip_header" json:"client_ip_header"`
+	// TrustedProxies lists the IP addresses or CIDR ranges of the reverse proxies allowed to set
+	// ClientIPHeader. Required when ClientIPHeader is set.
+	TrustedProxies []string `yaml:"trusted_proxies" json:"trusted_proxies"`
+}
+
+// IsEnabled returns whether lockout enforcement is enabled, defaulting to false if unset.
+func (c LockoutConfig) IsEnabled() bool {
+	return c.Enabled != nil && *c.Enabled
+}
+
+// PasswordPolicyConfig holds the password policy enforced whenever a user's password is set, whether
+// at creation, through the management APIs, or from a flow. Default applies to every user; Overrides
+// tighten or relax individual rules for an organization unit, a user type, or both.
+type PasswordPolicyConfig struct {
+	// Enabled controls whether the password policy is enforced.
+	Enabled *bool `yaml:"enabled" json:"enabled"`
+	// CredentialTypes lists the credential types the policy applies to. Defaults to "password".
+	CredentialTypes []string `yaml:"credential_types" json:"credential_types"`
+	// IdentityAttributes lists the user attributes a password must not contain when username
+	// similarity is checked. Defaults to "username" and "email".
+	IdentityAttributes []string `yaml:"identity_attributes" json:"identity_attributes"`
+	// DictionaryFile is the path of a file listing additional forbidden passwords, one per line.
+	DictionaryFile string `yaml:"dictionary_file" json:"dictionary_file"`
+	// BreachedPasswords configures the sources of the breached-password check.
+	BreachedPasswords BreachedPasswordsConfig `yaml:"breached_passwords" json:"breached_passwords"`
+	// Default holds the rules applied when no override matches.
+	Default PasswordPolicyRules `yaml:"default" json:"default"`
+	// Overrides holds rules applied on top of the defaults to users of an organization unit or a user
+	// type. An override naming both is applied last, after those naming only one.
+	Overrides []PasswordPolicyOverride `yaml:"overrides" json:"overrides"`
+}
+
+// IsEnabled returns whether the password policy is enabled, defaulting to false if unset.
+func (c PasswordPolicyConfig) IsEnabled() bool {
+	return c.Enabled != nil && *c.Enabled
+}
+
+// BreachedPasswordsConfig holds the sources of the k-anonymity breached-password check. Only the first
+// five characters of the SHA-1 hash of a password are used to look up a range of hash suffixes.
+type BreachedPasswordsConfig struct {
+	// DatasetDir is the directory of the offline dataset, holding one <PREFIX>.txt file per hash prefix
+	// with "SUFFIX:COUNT" lines.
+	DatasetDir string `yaml:"dataset_dir" json:"dataset_dir"`
+	// RangeAPIURL is the URL of a remote range API the hash prefix is appended to, consulted when the
+	// offline dataset has no file for the prefix.
+	RangeAPIURL string `yaml:"range_api_url" json:"range_api_url"`
+	// Timeout is the timeout, in seconds, of a range API request.
+	Timeout int `yaml:"timeout" json:"timeout"`
+}
+
+// PasswordPolicyRules holds the rules of a password policy. Unset fields are inherited from the rules
+// the override is applied on.
+type PasswordPolicyRules struct {
+	// MinLength is the minimum number of characters of a password.
+	MinLength *int `yaml:"min_length" json:"min_length"`
+	// MaxLength is the maximum number of characters of a password. Zero disables the limit.
+	MaxLength *int `yaml:"max_length" json:"max_length"`
+	// RequireUppercase requires at least one uppercase letter.
+	RequireUppercase *bool `yaml:"require_uppercase" json:"require_uppercase"`
+	// RequireLowercase requires at least one lowercase letter.
+	RequireLowercase *bool `yaml:"require_lowercase" json:"require_lowercase"`
+	// RequireDigit requires at least one digit.
+	RequireDigit *bool `yaml:"require_digit" json:"require_digit"`
+	// RequireSpecial requires at least one character that is neither a letter nor a digit.
+	RequireSpecial *bool `yaml:"require_special" json:"require_special"`
+	// CheckDictionary rejects common passwords and those in the dictionary file.
+	CheckDictionary *bool `yaml:"check_dictionary" json:"check_dictionary"`
+	// CheckUsernameSimilarity rejects passwords containing one of the user's identity attributes.
+	CheckUsernameSimilarity *bool `yaml:"check_username_similarity" json:"check_username_similarity"`
+	// CheckBreached rejects passwords found in the breached-password sources.
+	CheckBreached *bool `yaml:"check_breached" json:"check_breached"`
+	// HistoryCount is the number of most recent passwords, including the current one, that cannot be
+	// reused. Zero disables the check.
+	HistoryCount *int `yaml:"history_count" json:"history_count"`
+	// MinAge is the time, in seconds, a user must wait after a password change before changing the
+	// password again. Zero disables the limit.
+	MinAge *int `yaml:"min_age" json:"min_age"`
+	// MaxAge is the time, in seconds, after which a password expires and must be changed at the next
+	// sign-in. Zero disables expiry.
+	MaxAge *int `yaml:"max_age" json:"max_age"`
+}
+
+// PasswordPolicyOverride holds password policy rules applied to the users of an organization unit, a
+// user type, or both.
+type PasswordPolicyOverride struct {
+	OUID     string              `yaml:"ou_id" json:"ou_id"`
+	UserType string              `yaml:"user_type" json:"user_type"`
+	Rules    PasswordPolicyRules `yaml:"rules" json:"rules"`
+}
+
+// SCIMBulkConfig holds the limits of SCIM bulk requests.
+type SCIMBulkConfig struct {
+	MaxOperations  int   `yaml:"max_operations" json:"max_operations"`
+	MaxPayloadSize int64 `yaml:"max_payload_size" json:"max_payload_size"`
+}
+
 // OpenID4VCIConfig holds the OpenID4VCI credential issuer engine configuration.
 // Engine defaults (issuer identifier, signing key, base URL, authorization
 // servers) live here; credential configurations are managed via the management
@@ -273,7 +512,50 @@ type UserProviderConfig struct {
 
 // EntityProviderConfig holds the entity provider configuration details.
 type EntityProviderConfig struct {
-	Type string `yaml:"type" json:"type"`
+	Type string                   `yaml:"type" json:"type"`
+	LDAP LDAPEntityProviderConfig `yaml:"ldap" json:"ldap"`
+}
+
+// LDAPEntityProviderConfig holds the LDAP entity provider configuration details.
+type LDAPEntityProviderConfig struct {
+	URL                string `yaml:"url" json:"url"`
+	StartTLS           bool   `yaml:"start_tls" json:"start_tls"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify" json:"insecure_skip_verify"`
+	BindDN             string `yaml:"bind_dn" json:"bind_dn"`
+	BindPassword       string `yaml:"bind_password" json:"bind_password"`
+	Timeout            int    `yaml:"timeout" json:"timeout"`
+	// Mode is either "read_only" or "read_write".
+	Mode string `yaml:"mode" json:"mode"`
+	// ActiveDirectory enables Active Directory specific handling of object GUIDs, passwords and
+	// nested group resolution.
+	ActiveDirectory bool `yaml:"active_directory" json:"active_directory"`
+	// CredentialTypes lists the credential keys routed to the LDAP authn provider.
+	CredentialTypes []string        `yaml:"credential_types" json:"credential_types"`
+	User            LDAPUserConfig  `yaml:"user" json:"user"`
+	Group           LDAPGroupConfig `yaml:"group" json:"group"`
+}
+
+// LDAPUserConfig holds the LDAP user entry configuration details.
+type LDAPUserConfig struct {
+	BaseDN        string   `yaml:"base_dn" json:"base_dn"`
+	Filter        string   `yaml:"filter" json:"filter"`
+	ObjectClasses []string `yaml:"object_classes" json:"object_classes"`
+	RDNAttribute  string   `yaml:"rdn_attribute" json:"rdn_attribute"`
+	IDAttribute   string   `yaml:"id_attribute" json:"id_attribute"`
+	EntityType    string   `yaml:"entity_type" json:"entity_type"`
+	OUID          string   `yaml:"ou_id" json:"ou_id"`
+	// PasswordAttribute is the local credential attribute holding the user's password.
+	PasswordAttribute string `yaml:"password_attribute" json:"password_attribute"`
+	// Attributes maps local attribute names to LDAP attribute names.
+	Attributes map[string]string `yaml:"attributes" json:"attributes"`
+}
+
+// LDAPGroupConfig holds the LDAP group entry configuration details.
+type LDAPGroupConfig struct {
+	BaseDN          string `yaml:"base_dn" json:"base_dn"`
+	Filter          string `yaml:"filter" json:"filter"`
+	MemberAttribute string `yaml:"member_attribute" json:"member_attribute"`
+	NameAttribute   string `yaml:"name_attribute" json:"name_attribute"`
 }
 
 // RestConfig holds the REST authentication provider configuration details.
@@ -541,6 +823,24 @@ func derefFloat(p *float64, def float64) float64 {
 	return def
 }
 
+// PositiveIntOrDefault returns value, or def when it is not positive. Numeric settings left out of the
+// configuration read as zero, which the features consuming them treat as "use the built-in default".
+func PositiveIntOrDefault(value, def int) int {
+	if value <= 0 {
+		return def
+	}
+	return value
+}
+
+// SecondsOrDefault converts a configured number of seconds to a duration, or returns def when it is not
+// positive.
+func SecondsOrDefault(seconds int, def time.Duration) time.Duration {
+	if seconds <= 0 {
+		return def
+	}
+	return time.Duration(seconds) * time.Second
+}
+
 // LogFileConfig holds the file output settings.
 type LogFileConfig struct {
 	Enabled  *bool             `yaml:"enabled"   json:"enabled"`
@@ -594,9 +894,15 @@ type Config struct {
 	EntityType           EntityTypeConfig                  `yaml:"user_type"             json:"user_type"`
 	Observability        engineconfig.ObservabilityConfig  `yaml:"observability"         json:"observability"`
 	Passkey              PasskeyConfig                     `yaml:"passkey"               json:"passkey"`
+	TOTP                 TOTPConfig                        `yaml:"totp"                  json:"totp"`
 	Attestation          AttestationConfig                 `yaml:"attestation"           json:"attestation"`
 	OpenID4VP            OpenID4VPConfig                   `yaml:"openid4vp"             json:"openid4vp"`
 	OpenID4VCI           OpenID4VCIConfig                  `yaml:"openid4vci"            json:"openid4vci"`
+	SAML                 SAMLConfig                        `yaml:"saml"                  json:"saml"`
+	SCIM                 SCIMConfig                        `yaml:"scim"                  json:"scim"`
+	SSF                  SSFConfig                         `yaml:"ssf"                   json:"ssf"`
+	Lockout              LockoutConfig                     `yaml:"lockout"               json:"lockout"`
+	PasswordPolicy       PasswordPolicyConfig              `yaml:"password_policy"       json:"password_policy"`
 	AuthnProvider        AuthnProviderConfig               `yaml:"authn_provider"        json:"authn_provider"`
 	UserProvider         UserProviderConfig                `yaml:"user_provider"         json:"user_provider"`
 	EntityProvider       EntityProviderConfig              `yaml:"entity_provider"       json:"entity_provider"`
@@ -8,6 +8,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -1616,9 +1617,47 @@ func (suite *ConfigTestSuite) TestOTPConfig_Validate_ValidityAboveMax() {
 	assert.Contains(suite.T(), err.Error(), "notification.otp.validity_period_seconds")
 }
 
+func (suite *ConfigTestSuite) TestTOTPConfig_Validate() {
+	valid := TOTPConfig{Issuer: "ThunderID", Digits: 6, PeriodSeconds: 30, Skew: 1, RecoveryCodeCount: 10}
+	assert.NoError(suite.T(), valid.Validate())
+
+	cases := []struct {
+		name   string
+		mutate func(c *TOTPConfig)
+		field  string
+	}{
+		{"DigitsBelowMin", func(c *TOTPConfig) { c.Digits = 5 }, "totp.digits"},
+		{"DigitsAboveMax", func(c *TOTPConfig) { c.Digits = 9 }, "totp.digits"},
+		{"PeriodBelowMin", func(c *TOTPConfig) { c.PeriodSeconds = 10 }, "totp.period_seconds"},
+		{"SkewAboveMax", func(c *TOTPConfig) { c.Skew = 4 }, "totp.skew"},
+		{"NegativeRecoveryCodes", func(c *TOTPConfig) { c.RecoveryCodeCount = -1 }, "totp.recovery_code_count"},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			cfg := valid
+			tc.mutate(&cfg)
+			err := cfg.Validate()
+			assert.Error(suite.T(), err)
+			assert.Contains(suite.T(), err.Error(), tc.field)
+		})
+	}
+}
+
 func (suite *ConfigTestSuite) TestNotificationConfig_Validate_DelegatesToOTP() {
 	cfg := &NotificationConfig{OTP: OTPConfig{Length: 3, ValidityPeriodSeconds: 120}}
 	err := cfg.Validate()
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "notification.otp.length")
 }
+
+func (suite *ConfigTestSuite) TestPositiveIntOrDefault() {
+	assert.Equal(suite.T(), 7, PositiveIntOrDefault(7, 3))
+	assert.Equal(suite.T(), 3, PositiveIntOrDefault(0, 3))
+	assert.Equal(suite.T(), 3, PositiveIntOrDefault(-1, 3))
+}
+
+func (suite *ConfigTestSuite) TestSecondsOrDefault() {
+	assert.Equal(suite.T(), 7*time.Second, SecondsOrDefault(7, time.Minute))
+	assert.Equal(suite.T(), time.Minute, SecondsOrDefault(0, time.Minute))
+	assert.Equal(suite.T(), time.Minute, SecondsOrDefault(-1, time.Minute))
+}
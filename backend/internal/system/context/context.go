@@ -18,6 +18,12 @@ const (
 
 	// CSPNonceKey is the context key for storing the per-request Content-Security-Policy nonce.
 	CSPNonceKey contextKey = "csp_nonce"
+
+	// ClientIPKey is the context key for storing the IP address of the client that sent the request.
+	ClientIPKey contextKey = "client_ip"
+
+	// UserAgentKey is the context key for storing the User-Agent header of the request.
+	UserAgentKey contextKey = "user_agent"
 )
 
 // ============================================================================
@@ -106,3 +112,45 @@ func WithCSPNonce(ctx context.Context, nonce string) context.Context {
 	}
 	return context.WithValue(ctx, CSPNonceKey, nonce)
 }
+
+// ============================================================================
+// Client IP Functions
+// ============================================================================
+
+// GetClientIP retrieves the IP address of the requesting client from the context. Returns "" if absent.
+func GetClientIP(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	ip, _ := ctx.Value(ClientIPKey).(string)
+	return ip
+}
+
+// WithClientIP adds the IP address of the requesting client to the context.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ClientIPKey, ip)
+}
+
+// ============================================================================
+// User Agent Functions
+// ============================================================================
+
+// GetUserAgent retrieves the User-Agent of the requesting client from the context. Returns "" if absent.
+func GetUserAgent(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	userAgent, _ := ctx.Value(UserAgentKey).(string)
+	return userAgent
+}
+
+// WithUserAgent adds the User-Agent of the requesting client to the context.
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, UserAgentKey, userAgent)
+}
@@ -264,3 +264,17 @@ func (s *ContextTestSuite) TestWithCSPNonce_NilContext() {
 	ctx := WithCSPNonce(nil, "abc123") //nolint:staticcheck // Testing nil context handling
 	s.Equal("abc123", GetCSPNonce(ctx))
 }
+
+func (s *ContextTestSuite) TestGetClientIP() {
+	s.Equal("", GetClientIP(nil)) //nolint:staticcheck // Testing nil context handling
+	s.Equal("", GetClientIP(context.Background()))
+	s.Equal("192.0.2.10", GetClientIP(WithClientIP(context.Background(), "192.0.2.10")))
+	s.Equal("192.0.2.10", GetClientIP(WithClientIP(nil, "192.0.2.10"))) //nolint:staticcheck // Testing nil context
+}
+
+func (s *ContextTestSuite) TestGetUserAgent() {
+	s.Equal("", GetUserAgent(nil)) //nolint:staticcheck // Testing nil context handling
+	s.Equal("", GetUserAgent(context.Background()))
+	s.Equal("curl/8.5.0", GetUserAgent(WithUserAgent(context.Background(), "curl/8.5.0")))
+	s.Equal("curl/8.5.0", GetUserAgent(WithUserAgent(nil, "curl/8.5.0"))) //nolint:staticcheck // Testing nil context
+}
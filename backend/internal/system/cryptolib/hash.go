@@ -32,15 +32,27 @@ const (
 	PBKDF2 CredAlgorithm = "PBKDF2"
 	// ARGON2ID represents the Argon2id key derivation function.
 	ARGON2ID CredAlgorithm = "ARGON2ID"
+	// BCRYPT represents bcrypt hashes imported from another identity provider. Verify only.
+	BCRYPT CredAlgorithm = "BCRYPT"
+	// SCRYPT represents scrypt hashes imported from another identity provider. Verify only.
+	SCRYPT CredAlgorithm = "SCRYPT"
+	// FIREBASESCRYPT represents the modified scrypt hashes exported by Firebase Authentication. Verify only.
+	FIREBASESCRYPT CredAlgorithm = "FIREBASE_SCRYPT"
+	// PHPASS represents portable PHPass hashes such as those exported by WordPress. Verify only.
+	PHPASS CredAlgorithm = "PHPASS"
 )
 
 // CredParameters holds the parameters for credential hashing algorithms.
+// BlockSize, SaltSeparator and SignerKey are only used by imported scrypt variants.
 type CredParameters struct {
-	Iterations  int
-	Parallelism int
-	Memory      int
-	KeySize     int
-	Salt        string
+	Iterations    int
+	Parallelism   int
+	Memory        int
+	KeySize       int
+	Salt          string
+	BlockSize     int    `json:",omitempty"`
+	SaltSeparator string `json:",omitempty"`
+	SignerKey     string `json:",omitempty"`
 }
 
 // Credential represents the output of a credential hash operation.
@@ -75,9 +87,14 @@ type HashConfig struct {
 }
 
 // HashServiceInterface defines the interface for credential hashing services.
+// Generate always uses the configured algorithm, while Verify accepts a credential produced by any
+// supported algorithm, including the verify-only algorithms used for imported hashes.
 type HashServiceInterface interface {
 	Generate(credentialValue []byte) (Credential, error)
 	Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error)
+	// NeedsRehash reports whether the reference credential was produced with an algorithm or parameters
+	// other than the configured ones, and should be regenerated once its plaintext is known.
+	NeedsRehash(referenceCredential Credential) bool
 }
 
 // Initialize returns a HashServiceInterface configured according to cfg.
@@ -86,6 +103,48 @@ func Initialize(cfg HashConfig) (HashServiceInterface, error) {
 	return newHashService(cfg)
 }
 
+// IsSupportedCredAlgorithm reports whether credentials stored with the algorithm can be verified.
+func IsSupportedCredAlgorithm(alg CredAlgorithm) bool {
+	_, ok := credentialVerifiers[alg]
+	return ok
+}
+
+// IsVerifyOnlyCredAlgorithm reports whether the algorithm is only supported for verifying imported
+// credentials and cannot be configured for hashing new ones.
+func IsVerifyOnlyCredAlgorithm(alg CredAlgorithm) bool {
+	switch alg {
+	case BCRYPT, SCRYPT, FIREBASESCRYPT, PHPASS:
+		return true
+	default:
+		return false
+	}
+}
+
+// credentialHasher hashes and verifies credentials with a single algorithm.
+type credentialHasher interface {
+	Generate(credentialValue []byte) (Credential, error)
+	Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error)
+}
+
+// credentialVerifiers maps each supported algorithm to the function verifying its credentials. The
+// configurable providers verify using the parameters of the reference credential alone.
+var credentialVerifiers = map[CredAlgorithm]func([]byte, Credential) (bool, error){
+	SHA256:         (&sha256HashProvider{}).Verify,
+	PBKDF2:         (&pbkdf2HashProvider{}).Verify,
+	ARGON2ID:       (&argon2idHashProvider{}).Verify,
+	BCRYPT:         verifyBcrypt,
+	SCRYPT:         verifyScrypt,
+	FIREBASESCRYPT: verifyFirebaseScrypt,
+	PHPASS:         verifyPHPass,
+}
+
+// hashService generates credentials with the configured algorithm and verifies credentials produced
+// by any supported algorithm.
+type hashService struct {
+	config    HashConfig
+	generator credentialHasher
+}
+
 type sha256HashProvider struct {
 	SaltSize int
 }
@@ -105,6 +164,50 @@ type argon2idHashProvider struct {
 }
 
 func newHashService(cfg HashConfig) (HashServiceInterface, error) {
+	generator, err := newHashProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &hashService{config: cfg, generator: generator}, nil
+}
+
+// Generate hashes the credential value with the configured algorithm.
+func (s *hashService) Generate(credentialValue []byte) (Credential, error) {
+	return s.generator.Generate(credentialValue)
+}
+
+// Verify checks the credential value against the reference credential using the algorithm it was
+// stored with.
+func (s *hashService) Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	verify, ok := credentialVerifiers[referenceCredential.Algorithm]
+	if !ok {
+		return false, fmt.Errorf("unsupported hash algorithm: %s", referenceCredential.Algorithm)
+	}
+	return verify(credentialValueToVerify, referenceCredential)
+}
+
+// NeedsRehash reports whether the reference credential differs from what Generate would produce.
+func (s *hashService) NeedsRehash(referenceCredential Credential) bool {
+	if referenceCredential.Algorithm != s.config.Algorithm {
+		return true
+	}
+	params := referenceCredential.Parameters
+	if hex.DecodedLen(len(params.Salt)) != s.config.SaltSize {
+		return true
+	}
+	switch s.config.Algorithm {
+	case PBKDF2:
+		return params.Iterations != s.config.Iterations || params.KeySize != s.config.KeySize
+	case ARGON2ID:
+		return params.Iterations != s.config.Iterations || params.Memory != s.config.Memory ||
+			params.Parallelism != s.config.Parallelism || params.KeySize != s.config.KeySize
+	default:
+		return false
+	}
+}
+
+// newHashProvider returns the provider generating credentials with the configured algorithm.
+func newHashProvider(cfg HashConfig) (credentialHasher, error) {
 	switch cfg.Algorithm {
 	case SHA256:
 		if err := validatePositiveInt(cfg.SaltSize, "salt size"); err != nil {
@@ -1137,6 +1137,79 @@ func (suite *HashServiceTestSuite) TestUnsupportedAlgorithmVerify_Failure() {
 	assert.False(suite.T(), ok)
 }
 
+func (suite *HashServiceTestSuite) TestVerifyCredentialFromPreviousAlgorithm() {
+	sha256Service, err := Initialize(HashConfig{Algorithm: SHA256, SaltSize: defaultSaltSize})
+	require.NoError(suite.T(), err)
+	legacy, err := sha256Service.Generate(suite.input)
+	require.NoError(suite.T(), err)
+
+	argon2idService, err := Initialize(HashConfig{
+		Algorithm:   ARGON2ID,
+		SaltSize:    defaultSaltSize,
+		Memory:      defaultArgon2idMemory,
+		Iterations:  defaultArgon2idIterations,
+		Parallelism: defaultArgon2idParallelism,
+		KeySize:     defaultArgon2idKeySize,
+	})
+	require.NoError(suite.T(), err)
+
+	ok, err := argon2idService.Verify(suite.input, legacy)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+}
+
+func (suite *HashServiceTestSuite) TestNeedsRehash() {
+	cfg := HashConfig{
+		Algorithm:  PBKDF2,
+		SaltSize:   defaultSaltSize,
+		Iterations: 1000,
+		KeySize:    defaultPBKDF2KeySize,
+	}
+	hashService, err := Initialize(cfg)
+	require.NoError(suite.T(), err)
+	current, err := hashService.Generate(suite.input)
+	require.NoError(suite.T(), err)
+
+	assert.False(suite.T(), hashService.NeedsRehash(current))
+
+	testCases := []struct {
+		name   string
+		modify func(c *Credential)
+	}{
+		{"DifferentAlgorithm", func(c *Credential) { c.Algorithm = SHA256 }},
+		{"DifferentIterations", func(c *Credential) { c.Parameters.Iterations = 500 }},
+		{"DifferentKeySize", func(c *Credential) { c.Parameters.KeySize = 16 }},
+		{"DifferentSaltSize", func(c *Credential) { c.Parameters.Salt = "36d2dde7" }},
+	}
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			credential := current
+			tc.modify(&credential)
+			assert.True(t, hashService.NeedsRehash(credential))
+		})
+	}
+}
+
+func (suite *HashServiceTestSuite) TestNeedsRehash_Argon2idParameters() {
+	cfg := HashConfig{
+		Algorithm:   ARGON2ID,
+		SaltSize:    defaultSaltSize,
+		Memory:      defaultArgon2idMemory,
+		Iterations:  defaultArgon2idIterations,
+		Parallelism: defaultArgon2idParallelism,
+		KeySize:     defaultArgon2idKeySize,
+	}
+	hashService, err := Initialize(cfg)
+	require.NoError(suite.T(), err)
+	current, err := hashService.Generate(suite.input)
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), hashService.NeedsRehash(current))
+
+	weaker := current
+	weaker.Parameters.Memory = defaultArgon2idMemory / 2
+	assert.True(suite.T(), hashService.NeedsRehash(weaker))
+}
+
 type InitTestSuite struct {
 	suite.Suite
 }
@@ -0,0 +1,249 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cryptolib
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5" //nolint:gosec // G501 - PHPass is defined over MD5 and is only used to verify imported hashes.
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// The algorithms in this file verify credentials imported from other identity providers so that users
+// can sign in without a password reset. They cannot be configured for hashing, so such credentials are
+// replaced with the configured algorithm once verified. Unlike the native algorithms, which encode salts
+// and hashes as hex, imported credentials keep the encoding of the system that exported them:
+//
+//   - BCRYPT: Hash holds the modular crypt string ("$2a$...", "$2b$..." or "$2y$..."), parameters are unused.
+//   - SCRYPT: Hash and Salt are standard base64. Iterations holds N, BlockSize r, Parallelism p and
+//     KeySize the derived key length, which defaults to the length of the hash.
+//   - FIREBASE_SCRYPT: Hash, Salt, SaltSeparator and SignerKey are standard base64 as exported by
+//     Firebase. Iterations holds the project's rounds and Memory its mem_cost.
+//   - PHPASS: Hash holds the portable hash string ("$P$..." or "$H$..."), parameters are unused.
+
+const (
+	phpassItoa64        = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	phpassSettingLength = 12
+	phpassMinCountLog2  = 7
+	phpassMaxCountLog2  = 30
+	firebaseKeySize     = 32
+	firebaseMaxMemCost  = 30
+	firebaseMaxRounds   = 8
+
+	// The scrypt parameters of an imported credential come from another system, so they are bounded to
+	// keep a single verification from exhausting memory or CPU: scrypt needs 128*N*r bytes of memory and
+	// p times the work. The bounds admit the parameters in common use by identity providers.
+	scryptMaxCost        = 1 << 20
+	scryptMaxBlockSize   = 16
+	scryptMaxParallelism = 16
+)
+
+// ValidateImportedParameters reports whether the parameters of an imported credential hashed with alg
+// are within the bounds this package verifies and agree with the hash they describe. It lets
+// credentials be rejected when they are imported rather than on first sign-in.
+func ValidateImportedParameters(alg CredAlgorithm, hash string, params CredParameters) error {
+	var err error
+	switch alg {
+	case SCRYPT:
+		var referenceHash []byte
+		if referenceHash, err = decodeBase64Param(hash, "hash"); err != nil {
+			return err
+		}
+		_, _, _, err = scryptParameters(params, len(referenceHash))
+	case FIREBASESCRYPT:
+		_, _, err = firebaseScryptParameters(params)
+	}
+	return err
+}
+
+// verifyBcrypt verifies a credential against an imported bcrypt hash.
+func verifyBcrypt(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	if err := validateCredentialAlgorithm(referenceCredential, BCRYPT); err != nil {
+		return false, err
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(referenceCredential.Hash), credentialValueToVerify)
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// verifyScrypt verifies a credential against an imported scrypt hash.
+func verifyScrypt(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	if err := validateCredentialAlgorithm(referenceCredential, SCRYPT); err != nil {
+		return false, err
+	}
+	params := referenceCredential.Parameters
+	referenceHash, err := decodeBase64Param(referenceCredential.Hash, "hash")
+	if err != nil {
+		return false, err
+	}
+	cost, blockSize, parallelism, err := scryptParameters(params, len(referenceHash))
+	if err != nil {
+		return false, err
+	}
+	saltBytes, err := decodeBase64Param(params.Salt, "salt")
+	if err != nil {
+		return false, err
+	}
+	h, err := scrypt.Key(credentialValueToVerify, saltBytes, cost, blockSize, parallelism, len(referenceHash))
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare(h, referenceHash) == 1, nil
+}
+
+// verifyFirebaseScrypt verifies a credential against a hash exported by Firebase Authentication. Firebase
+// derives a key with scrypt and uses it to encrypt the project's signer key with AES-256 in CTR mode.
+func verifyFirebaseScrypt(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	if err := validateCredentialAlgorithm(referenceCredential, FIREBASESCRYPT); err != nil {
+		return false, err
+	}
+	params := referenceCredential.Parameters
+	rounds, memCost, err := firebaseScryptParameters(params)
+	if err != nil {
+		return false, err
+	}
+	saltBytes, err := decodeBase64Param(params.Salt, "salt")
+	if err != nil {
+		return false, err
+	}
+	separator, err := base64.StdEncoding.DecodeString(params.SaltSeparator)
+	if err != nil {
+		return false, fmt.Errorf("invalid salt separator: %w", err)
+	}
+	signerKey, err := decodeBase64Param(params.SignerKey, "signer key")
+	if err != nil {
+		return false, err
+	}
+	referenceHash, err := decodeBase64Param(referenceCredential.Hash, "hash")
+	if err != nil {
+		return false, err
+	}
+
+	saltWithSeparator := append(append([]byte(nil), saltBytes...), separator...)
+	derivedKey, err := scrypt.Key(credentialValueToVerify, saltWithSeparator, 1<<memCost, rounds, 1,
+		firebaseKeySize)
+	if err != nil {
+		return false, err
+	}
+	block, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return false, err
+	}
+	h := make([]byte, len(signerKey))
+	cipher.NewCTR(block, make([]byte, aes.BlockSize)).XORKeyStream(h, signerKey)
+	return subtle.ConstantTimeCompare(h, referenceHash) == 1, nil
+}
+
+// scryptParameters returns the cost N, block size r and parallelism p of an imported scrypt credential,
+// rejecting values outside the supported bounds. The derived key is always as long as the reference
+// hash, so a key size, when given, must match the hash length.
+func scryptParameters(params CredParameters, hashLength int) (cost, blockSize, parallelism int, err error) {
+	if hashLength == 0 {
+		return 0, 0, 0, errors.New("hash must not be empty")
+	}
+	if params.KeySize != 0 && params.KeySize != hashLength {
+		return 0, 0, 0, fmt.Errorf("key size %d does not match the hash length %d", params.KeySize, hashLength)
+	}
+	if cost, err = requirePositiveIntWithMax(params.Iterations, scryptMaxCost, "iterations"); err != nil {
+		return 0, 0, 0, err
+	}
+	if blockSize, err = requirePositiveIntWithMax(params.BlockSize, scryptMaxBlockSize, "block size"); err != nil {
+		return 0, 0, 0, err
+	}
+	if parallelism, err = requirePositiveIntWithMax(params.Parallelism, scryptMaxParallelism,
+		"parallelism"); err != nil {
+		return 0, 0, 0, err
+	}
+	return cost, blockSize, parallelism, nil
+}
+
+// firebaseScryptParameters returns the rounds and mem_cost of an imported Firebase scrypt credential,
+// rejecting values outside the supported bounds.
+func firebaseScryptParameters(params CredParameters) (rounds, memCost int, err error) {
+	if rounds, err = requirePositiveIntWithMax(params.Iterations, firebaseMaxRounds, "iterations"); err != nil {
+		return 0, 0, err
+	}
+	if memCost, err = requirePositiveIntWithMax(params.Memory, firebaseMaxMemCost, "memory"); err != nil {
+		return 0, 0, err
+	}
+	return rounds, memCost, nil
+}
+
+// verifyPHPass verifies a credential against an imported portable PHPass hash.
+func verifyPHPass(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	if err := validateCredentialAlgorithm(referenceCredential, PHPASS); err != nil {
+		return false, err
+	}
+	reference := referenceCredential.Hash
+	if len(reference) <= phpassSettingLength ||
+		(!strings.HasPrefix(reference, "$P$") && !strings.HasPrefix(reference, "$H$")) {
+		return false, fmt.Errorf("invalid PHPass hash")
+	}
+	countLog2 := strings.IndexByte(phpassItoa64, reference[3])
+	if countLog2 < phpassMinCountLog2 || countLog2 > phpassMaxCountLog2 {
+		return false, fmt.Errorf("invalid PHPass iteration count")
+	}
+	salt := reference[4:phpassSettingLength]
+
+	//nolint:gosec // G401 - See the import above.
+	h := md5.Sum(append([]byte(salt), credentialValueToVerify...))
+	for range 1 << countLog2 {
+		h = md5.Sum(append(h[:], credentialValueToVerify...)) //nolint:gosec // G401 - See the import above.
+	}
+	computed := reference[:phpassSettingLength] + phpassEncode64(h[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(reference)) == 1, nil
+}
+
+// phpassEncode64 encodes bytes with the PHPass variant of base64, which packs bits little-endian.
+func phpassEncode64(input []byte) string {
+	var out strings.Builder
+	count := len(input)
+	for i := 0; i < count; {
+		value := int(input[i])
+		i++
+		out.WriteByte(phpassItoa64[value&0x3f])
+		if i < count {
+			value |= int(input[i]) << 8
+		}
+		out.WriteByte(phpassItoa64[(value>>6)&0x3f])
+		if i >= count {
+			break
+		}
+		i++
+		if i < count {
+			value |= int(input[i]) << 16
+		}
+		out.WriteByte(phpassItoa64[(value>>12)&0x3f])
+		if i >= count {
+			break
+		}
+		i++
+		out.WriteByte(phpassItoa64[(value>>18)&0x3f])
+	}
+	return out.String()
+}
+
+// decodeBase64Param decodes a required standard base64 credential parameter.
+func decodeBase64Param(value, name string) ([]byte, error) {
+	if value == "" {
+		return nil, fmt.Errorf("%s must be provided", name)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cryptolib
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Reference vectors published with the Firebase scrypt and PHPass implementations.
+const (
+	firebaseSignerKey = "jxspr8Ki0RYycVU8zykbdLGjFQ3McFUH0uiiTvC8pVMXAn210wjLNmdZJzxUECKbm0QsEmYUSDzZvpjeJ9WmXA=="
+	firebaseSeparator = "Bw=="
+	firebaseSalt      = "42xEC+ixf3L2lw=="
+	firebaseHash      = "lSrfV15cpx95/sZS2W9c9Kp6i/LVgQNDNC/qzrCnh1SAyZvqmZqAjTdn3aoItz+VHjoZilo78198JAdRuid5lQ=="
+	firebasePassword  = "user1password"
+	phpassHash        = "$P$9IQRaTwmfeRo7ud9Fh4E2PdI0S3r.L0"
+	phpassPassword    = "test12345"
+)
+
+type ImportedHashTestSuite struct {
+	suite.Suite
+	hashService HashServiceInterface
+}
+
+func TestImportedHashTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportedHashTestSuite))
+}
+
+func (suite *ImportedHashTestSuite) SetupTest() {
+	hashService, err := Initialize(HashConfig{Algorithm: SHA256, SaltSize: defaultSaltSize})
+	suite.Require().NoError(err)
+	suite.hashService = hashService
+}
+
+func (suite *ImportedHashTestSuite) firebaseCredential() Credential {
+	return Credential{
+		Algorithm: FIREBASESCRYPT,
+		Hash:      firebaseHash,
+		Parameters: CredParameters{
+			Iterations:    8,
+			Memory:        14,
+			Salt:          firebaseSalt,
+			SaltSeparator: firebaseSeparator,
+			SignerKey:     firebaseSignerKey,
+		},
+	}
+}
+
+func (suite *ImportedHashTestSuite) TestVerifyBcrypt() {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(suite.T(), err)
+	credential := Credential{Algorithm: BCRYPT, Hash: string(hash)}
+
+	ok, err := suite.hashService.Verify([]byte("password"), credential)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+
+	ok, err = suite.hashService.Verify([]byte("wrong"), credential)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+}
+
+func (suite *ImportedHashTestSuite) TestVerifyBcrypt_InvalidHash() {
+	ok, err := suite.hashService.Verify([]byte("password"), Credential{Algorithm: BCRYPT, Hash: "not-bcrypt"})
+	assert.Error(suite.T(), err)
+	assert.False(suite.T(), ok)
+}
+
+func (suite *ImportedHashTestSuite) TestVerifyScrypt() {
+	salt := []byte("0123456789abcdef")
+	hash, err := scrypt.Key([]byte("password"), salt, 1024, 8, 1, 32)
+	require.NoError(suite.T(), err)
+	credential := Credential{
+		Algorithm: SCRYPT,
+		Hash:      base64.StdEncoding.EncodeToString(hash),
+		Parameters: CredParameters{
+			Iterations:  1024,
+			BlockSize:   8,
+			Parallelism: 1,
+			Salt:        base64.StdEncoding.EncodeToString(salt),
+		},
+	}
+
+	ok, err := suite.hashService.Verify([]byte("password"), credential)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+
+	ok, err = suite.hashService.Verify([]byte("wrong"), credential)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+}
+
+func (suite *ImportedHashTestSuite) TestVerifyScrypt_InvalidParameters() {
+	valid := CredParameters{Iterations: 1024, BlockSize: 8, Parallelism: 1, Salt: "c2FsdA=="}
+	testCases := []struct {
+		name   string
+		hash   string
+		params func(p CredParameters) CredParameters
+	}{
+		{"MissingCost", "aGFzaA==", func(p CredParameters) CredParameters { p.Iterations = 0; return p }},
+		{"MissingBlockSize", "aGFzaA==", func(p CredParameters) CredParameters { p.BlockSize = 0; return p }},
+		{"MissingParallelism", "aGFzaA==", func(p CredParameters) CredParameters { p.Parallelism = 0; return p }},
+		{"MissingSalt", "aGFzaA==", func(p CredParameters) CredParameters { p.Salt = ""; return p }},
+		{"InvalidHash", "%%%", func(p CredParameters) CredParameters { return p }},
+		{"CostNotPowerOfTwo", "aGFzaA==", func(p CredParameters) CredParameters { p.Iterations = 1000; return p }},
+		{"CostTooLarge", "aGFzaA==", func(p CredParameters) CredParameters { p.Iterations = 1 << 21; return p }},
+		{"BlockSizeTooLarge", "aGFzaA==", func(p CredParameters) CredParameters { p.BlockSize = 17; return p }},
+		{"ParallelismTooLarge", "aGFzaA==", func(p CredParameters) CredParameters { p.Parallelism = 17; return p }},
+		{"KeySizeLongerThanHash", "aGFzaA==", func(p CredParameters) CredParameters { p.KeySize = 64; return p }},
+		{"KeySizeShorterThanHash", "aGFzaA==", func(p CredParameters) CredParameters { p.KeySize = 1; return p }},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			ok, err := suite.hashService.Verify([]byte("password"), Credential{
+				Algorithm: SCRYPT, Hash: tc.hash, Parameters: tc.params(valid),
+			})
+			assert.Error(t, err)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func (suite *ImportedHashTestSuite) TestVerifyFirebaseScrypt() {
+	ok, err := suite.hashService.Verify([]byte(firebasePassword), suite.firebaseCredential())
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+
+	ok, err = suite.hashService.Verify([]byte("wrong"), suite.firebaseCredential())
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+}
+
+func (suite *ImportedHashTestSuite) TestVerifyFirebaseScrypt_InvalidParameters() {
+	testCases := []struct {
+		name   string
+		modify func(c *Credential)
+	}{
+		{"MissingRounds", func(c *Credential) { c.Parameters.Iterations = 0 }},
+		{"RoundsTooLarge", func(c *Credential) { c.Parameters.Iterations = 9 }},
+		{"MemCostTooLarge", func(c *Credential) { c.Parameters.Memory = 31 }},
+		{"MissingSalt", func(c *Credential) { c.Parameters.Salt = "" }},
+		{"InvalidSeparator", func(c *Credential) { c.Parameters.SaltSeparator = "%%%" }},
+		{"MissingSignerKey", func(c *Credential) { c.Parameters.SignerKey = "" }},
+		{"MissingHash", func(c *Credential) { c.Hash = "" }},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			credential := suite.firebaseCredential()
+			tc.modify(&credential)
+			ok, err := suite.hashService.Verify([]byte(firebasePassword), credential)
+			assert.Error(t, err)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func (suite *ImportedHashTestSuite) TestValidateImportedParameters() {
+	// A 4-byte hash.
+	const hash = "aGFzaA=="
+	testCases := []struct {
+		name    string
+		alg     CredAlgorithm
+		hash    string
+		params  CredParameters
+		wantErr bool
+	}{
+		{"Scrypt", SCRYPT, hash, CredParameters{Iterations: 1 << 20, BlockSize: 16, Parallelism: 16}, false},
+		{"ScryptMatchingKeySize", SCRYPT, hash,
+			CredParameters{Iterations: 1024, BlockSize: 8, Parallelism: 1, KeySize: 4}, false},
+		{"ScryptKeySizeMismatch", SCRYPT, hash,
+			CredParameters{Iterations: 1024, BlockSize: 8, Parallelism: 1, KeySize: 32}, true},
+		{"ScryptInvalidHash", SCRYPT, "%%%", CredParameters{Iterations: 1024, BlockSize: 8, Parallelism: 1}, true},
+		{"ScryptCostTooLarge", SCRYPT, hash, CredParameters{Iterations: 1 << 21, BlockSize: 8, Parallelism: 1}, true},
+		{"ScryptBlockSizeTooLarge", SCRYPT, hash,
+			CredParameters{Iterations: 1024, BlockSize: 17, Parallelism: 1}, true},
+		{"ScryptParallelismTooLarge", SCRYPT, hash,
+			CredParameters{Iterations: 1024, BlockSize: 8, Parallelism: 17}, true},
+		{"ScryptMissingCost", SCRYPT, hash, CredParameters{BlockSize: 8, Parallelism: 1}, true},
+		{"FirebaseScrypt", FIREBASESCRYPT, hash, CredParameters{Iterations: 8, Memory: 14}, false},
+		{"FirebaseScryptRoundsTooLarge", FIREBASESCRYPT, hash, CredParameters{Iterations: 9, Memory: 14}, true},
+		{"FirebaseScryptMemCostTooLarge", FIREBASESCRYPT, hash, CredParameters{Iterations: 8, Memory: 31}, true},
+		{"Bcrypt", BCRYPT, "$2a$10$abc", CredParameters{}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			err := ValidateImportedParameters(tc.alg, tc.hash, tc.params)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (suite *ImportedHashTestSuite) TestVerifyPHPass() {
+	credential := Credential{Algorithm: PHPASS, Hash: phpassHash}
+
+	ok, err := suite.hashService.Verify([]byte(phpassPassword), credential)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+
+	ok, err = suite.hashService.Verify([]byte("wrong"), credential)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+}
+
+func (suite *ImportedHashTestSuite) TestVerifyPHPass_InvalidHash() {
+	testCases := []struct {
+		name string
+		hash string
+	}{
+		{"UnknownPrefix", "$X$9IQRaTwmfeRo7ud9Fh4E2PdI0S3r.L0"},
+		{"TooShort", "$P$9IQRaTwm"},
+		{"CountTooSmall", "$P$.IQRaTwmfeRo7ud9Fh4E2PdI0S3r.L0"},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			ok, err := suite.hashService.Verify([]byte(phpassPassword), Credential{Algorithm: PHPASS, Hash: tc.hash})
+			assert.Error(t, err)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func (suite *ImportedHashTestSuite) TestVerifyOnlyAlgorithms() {
+	for _, alg := range []CredAlgorithm{BCRYPT, SCRYPT, FIREBASESCRYPT, PHPASS} {
+		assert.True(suite.T(), IsSupportedCredAlgorithm(alg), alg)
+		assert.True(suite.T(), IsVerifyOnlyCredAlgorithm(alg), alg)
+
+		_, err := Initialize(HashConfig{Algorithm: alg, SaltSize: defaultSaltSize})
+		assert.Error(suite.T(), err, alg)
+	}
+	for _, alg := range []CredAlgorithm{SHA256, PBKDF2, ARGON2ID} {
+		assert.True(suite.T(), IsSupportedCredAlgorithm(alg), alg)
+		assert.False(suite.T(), IsVerifyOnlyCredAlgorithm(alg), alg)
+	}
+	assert.False(suite.T(), IsSupportedCredAlgorithm("UNSUPPORTED"))
+}
+
+func (suite *ImportedHashTestSuite) TestImportedCredentialsNeedRehash() {
+	assert.True(suite.T(), suite.hashService.NeedsRehash(suite.firebaseCredential()))
+	assert.True(suite.T(), suite.hashService.NeedsRehash(Credential{Algorithm: PHPASS, Hash: phpassHash}))
+}
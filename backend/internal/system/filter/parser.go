@@ -5,6 +5,7 @@
 package filter
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"regexp"
@@ -14,15 +15,16 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
 
-// filterPattern matches a complete single expression (with end anchor) for validation.
-var filterPattern = regexp.MustCompile(`^(\w+(?:\.\w+)*)\s+(eq|gt|lt)\s+(?:"([^"]*)"|(\S+))$`)
+// groupAttributePattern matches the attribute paths accepted in a flat filter group: dot-separated
+// words without a schema URN prefix.
+var groupAttributePattern = regexp.MustCompile(`^\w+(?:\.\w+)*$`)
 
-// singleExprPrefix matches one expression from the start of the string without an end anchor,
-// used during iterative multi-expression parsing.
-var singleExprPrefix = regexp.MustCompile(`^(\w+(?:\.\w+)*)\s+(eq|gt|lt)\s+(?:"([^"]*)"|(\S+))`)
-
-// connectorPrefix matches a leading AND or OR connector (case-insensitive) surrounded by whitespace.
-var connectorPrefix = regexp.MustCompile(`(?i)^\s+(AND|OR)\s+`)
+// groupOperators lists the comparison operators accepted in a flat filter group.
+var groupOperators = map[tidcommon.Operator]bool{
+	tidcommon.OperatorEq: true,
+	tidcommon.OperatorGt: true,
+	tidcommon.OperatorLt: true,
+}
 
 // ParseFilterParam reads the "filter" query parameter and parses it into a tidcommon.FilterGroup.
 // Returns nil when no filter parameter is present.
@@ -40,90 +42,390 @@ func ParseFilterParam(query url.Values) (*tidcommon.FilterGroup, error) {
 }
 
 // ParseFilterGroup parses a filter string that may contain multiple expressions joined by AND or OR.
-// AND has higher precedence than OR, matching standard SQL behavior.
+// AND has higher precedence than OR, matching standard SQL behavior. The filter is parsed with the
+// grammar of ParseFilter and narrowed to a flat group: only the eq, gt and lt operators are accepted,
+// and "not", value paths, null values and parentheses that change precedence are rejected.
 // Examples:
 //
 //	name eq "Engineering"
 //	name eq "Engineering" AND createdAt gt "2024-01-01T00:00:00Z"
 //	name eq "A" OR name eq "B"
 func ParseFilterGroup(filterStr string) (*tidcommon.FilterGroup, error) {
-	remaining := filterStr
-	connector := tidcommon.LogicalOperator("")
-	var clauses []tidcommon.FilterClause
-
-	for remaining != "" {
-		loc := singleExprPrefix.FindStringIndex(remaining)
-		if len(loc) == 0 || loc[0] != 0 {
-			return nil, fmt.Errorf("invalid filter format near: %q", remaining)
-		}
+	node, err := ParseFilter(filterStr)
+	if err != nil {
+		return nil, err
+	}
+
+	clauses, err := appendGroupClauses(nil, node, "", "")
+	if err != nil {
+		return nil, err
+	}
+	return &tidcommon.FilterGroup{Clauses: clauses}, nil
+}
+
+// ParseFilterExpression parses a single filter expression string of the form:
+//
+//	attribute (eq|gt|lt) "value"
+//	attribute (eq|gt|lt) value
+func ParseFilterExpression(filterStr string) (*tidcommon.FilterExpression, error) {
+	node, err := ParseFilter(filterStr)
+	if err != nil {
+		return nil, err
+	}
+	if node.Type != NodeTypeExpression {
+		return nil, fmt.Errorf("invalid filter format: %q", filterStr)
+	}
+	if err := validateGroupExpression(node.Expr); err != nil {
+		return nil, err
+	}
+	return node.Expr, nil
+}
 
-		expr, err := parseExpressionFromMatches(singleExprPrefix.FindStringSubmatch(remaining))
+// appendGroupClauses flattens a filter tree into clauses in evaluation order. connector joins the
+// first clause of node to the clauses before it, and parent is the type of the enclosing and/or node.
+// Since and binds tighter than or, a flat list can only represent an or nested under an and when
+// the source filter grouped it with parentheses, which the group form cannot express.
+func appendGroupClauses(clauses []tidcommon.FilterClause, node *Node, connector tidcommon.LogicalOperator,
+	parent NodeType) ([]tidcommon.FilterClause, error) {
+	switch node.Type {
+	case NodeTypeExpression:
+		if err := validateGroupExpression(node.Expr); err != nil {
+			return nil, err
+		}
+		return append(clauses, tidcommon.FilterClause{Connector: connector, Expr: *node.Expr}), nil
+	case NodeTypeAnd, NodeTypeOr:
+		next := tidcommon.LogicalAnd
+		if node.Type == NodeTypeOr {
+			if parent == NodeTypeAnd {
+				return nil, fmt.Errorf("grouping with parentheses is not supported")
+			}
+			next = tidcommon.LogicalOr
+		}
+		clauses, err := appendGroupClauses(clauses, node.Children[0], connector, node.Type)
 		if err != nil {
 			return nil, err
 		}
-		clauses = append(clauses, tidcommon.FilterClause{Connector: connector, Expr: *expr})
-		remaining = remaining[loc[1]:]
+		return appendGroupClauses(clauses, node.Children[1], next, node.Type)
+	default:
+		return nil, fmt.Errorf("%s is not supported in this filter", node.Type)
+	}
+}
 
-		if remaining == "" {
-			break
+// validateGroupExpression checks that an expression uses an attribute path, operator and value
+// supported by a flat filter group.
+func validateGroupExpression(expr *tidcommon.FilterExpression) error {
+	if !groupAttributePattern.MatchString(expr.Attribute) {
+		return fmt.Errorf("invalid attribute path: %q", expr.Attribute)
+	}
+	if !groupOperators[expr.Operator] {
+		return fmt.Errorf("unsupported operator: %q", expr.Operator)
+	}
+	if expr.Value == nil {
+		return fmt.Errorf("missing value for %q", expr.Attribute)
+	}
+	return nil
+}
+
+// NodeType identifies the kind of a node in a parsed filter tree.
+type NodeType string
+
+const (
+	// NodeTypeExpression is a single attribute comparison.
+	NodeTypeExpression NodeType = "expression"
+	// NodeTypeAnd is the conjunction of its two children.
+	NodeTypeAnd NodeType = "and"
+	// NodeTypeOr is the disjunction of its two children.
+	NodeTypeOr NodeType = "or"
+	// NodeTypeNot is the negation of its only child.
+	NodeTypeNot NodeType = "not"
+	// NodeTypeValuePath applies its only child to the elements of a multi-valued attribute, as in
+	// emails[type eq "work"].
+	NodeTypeValuePath NodeType = "valuePath"
+)
+
+// Node is a node of a filter tree produced by ParseFilter.
+type Node struct {
+	Type NodeType
+	// Expr holds the comparison of an expression node.
+	Expr *tidcommon.FilterExpression
+	// Attribute holds the multi-valued attribute of a value path node.
+	Attribute string
+	// Children holds the operands of and, or, not, and value path nodes.
+	Children []*Node
+}
+
+// treeOperators lists the comparison operators accepted by ParseFilter.
+var treeOperators = map[string]tidcommon.Operator{
+	"eq": tidcommon.OperatorEq,
+	"ne": tidcommon.OperatorNe,
+	"co": tidcommon.OperatorCo,
+	"sw": tidcommon.OperatorSw,
+	"ew": tidcommon.OperatorEw,
+	"gt": tidcommon.OperatorGt,
+	"ge": tidcommon.OperatorGe,
+	"lt": tidcommon.OperatorLt,
+	"le": tidcommon.OperatorLe,
+	"pr": tidcommon.OperatorPr,
+}
+
+// attributePathPattern matches an attribute path, optionally prefixed with a schema URN and
+// optionally naming a sub-attribute (urn:...:User:name.givenName).
+var attributePathPattern = regexp.MustCompile(`^[A-Za-z$][\w$:.\-]*$`)
+
+// ParseFilter parses a SCIM filter (RFC 7644 section 3.4.2.2) into a tree. It supports the eq, ne,
+// co, sw, ew, gt, ge, lt, le and pr operators, "and", "or" and "not", parentheses, value paths such
+// as emails[type eq "work"], and the null literal. Operators and logical keywords are
+// case-insensitive; "and" binds tighter than "or".
+// Examples:
+//
+//	userName eq "alice"
+//	emails co "@example.com" and not (title pr)
+//	(name.familyName sw "Sm" or nickName eq "Al") and emails[type eq "work"]
+func ParseFilter(filterStr string) (*Node, error) {
+	tokens, err := tokenizeFilter(filterStr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("no filter expressions found")
+	}
+
+	p := &treeParser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected token %q", p.peek().text)
+	}
+	return node, nil
+}
+
+type filterTokenKind int
+
+const (
+	tokenWord filterTokenKind = iota
+	tokenString
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+}
+
+// tokenizeFilter splits a filter into words, JSON string literals, parentheses and brackets.
+func tokenizeFilter(s string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, filterToken{kind: tokenLParen, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, filterToken{kind: tokenRParen, text: ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, filterToken{kind: tokenLBracket, text: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, filterToken{kind: tokenRBracket, text: "]"})
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(s) && s[end] != '"' {
+				if s[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(s) {
+				return nil, fmt.Errorf("unterminated string starting at: %q", s[i:])
+			}
+			var value string
+			if err := json.Unmarshal([]byte(s[i:end+1]), &value); err != nil {
+				return nil, fmt.Errorf("invalid string literal: %q", s[i:end+1])
+			}
+			tokens = append(tokens, filterToken{kind: tokenString, text: value})
+			i = end + 1
+		default:
+			end := i
+			for end < len(s) && !strings.ContainsRune(" \t\n\r()[]\"", rune(s[end])) {
+				end++
+			}
+			tokens = append(tokens, filterToken{kind: tokenWord, text: s[i:end]})
+			i = end
 		}
+	}
+	return tokens, nil
+}
+
+// treeParser is a recursive-descent parser over filter tokens.
+type treeParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *treeParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *treeParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+// peekKeyword reports whether the next token is the given case-insensitive keyword.
+func (p *treeParser) peekKeyword(keyword string) bool {
+	return !p.done() && p.peek().kind == tokenWord && strings.EqualFold(p.peek().text, keyword)
+}
 
-		m := connectorPrefix.FindStringSubmatch(remaining)
-		if m == nil {
-			return nil, fmt.Errorf("expected AND or OR after expression, got: %q", remaining)
+func (p *treeParser) expect(kind filterTokenKind, text string) error {
+	if p.done() {
+		return fmt.Errorf("expected %q at end of filter", text)
+	}
+	if p.peek().kind != kind {
+		return fmt.Errorf("expected %q, got %q", text, p.peek().text)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *treeParser) parseOr() (*Node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
 		}
-		connector = tidcommon.LogicalOperator(strings.ToUpper(m[1]))
-		remaining = remaining[len(m[0]):]
+		left = &Node{Type: NodeTypeOr, Children: []*Node{left, right}}
 	}
+	return left, nil
+}
 
-	if len(clauses) == 0 {
-		return nil, fmt.Errorf("no filter expressions found")
+func (p *treeParser) parseAnd() (*Node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
 	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Node{Type: NodeTypeAnd, Children: []*Node{left, right}}
+	}
+	return left, nil
+}
 
-	return &tidcommon.FilterGroup{Clauses: clauses}, nil
+func (p *treeParser) parseUnary() (*Node, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+	if p.peekKeyword("not") {
+		p.pos++
+		if err := p.expect(tokenLParen, "("); err != nil {
+			return nil, err
+		}
+		inner, err := p.parseGroupTail()
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Type: NodeTypeNot, Children: []*Node{inner}}, nil
+	}
+	if p.peek().kind == tokenLParen {
+		p.pos++
+		return p.parseGroupTail()
+	}
+	return p.parseAttributeExpression()
 }
 
-// ParseFilterExpression parses a single filter expression string of the form:
-//
-//	attribute (eq|gt|lt) "value"
-//	attribute (eq|gt|lt) value
-func ParseFilterExpression(filterStr string) (*tidcommon.FilterExpression, error) {
-	matches := filterPattern.FindStringSubmatch(filterStr)
-	if len(matches) == 0 {
-		return nil, fmt.Errorf("invalid filter format: %q", filterStr)
+// parseGroupTail parses a filter up to and including the closing parenthesis.
+func (p *treeParser) parseGroupTail() (*Node, error) {
+	inner, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(tokenRParen, ")"); err != nil {
+		return nil, err
 	}
-	return parseExpressionFromMatches(matches)
+	return inner, nil
 }
 
-// parseExpressionFromMatches extracts a tidcommon.FilterExpression from a regex match slice.
-// Slot layout: [full, attribute, operator, quotedValue, unquotedValue].
-func parseExpressionFromMatches(matches []string) (*tidcommon.FilterExpression, error) {
-	if len(matches) < 5 {
-		return nil, fmt.Errorf("invalid filter format")
+func (p *treeParser) parseAttributeExpression() (*Node, error) {
+	attr := p.peek()
+	if attr.kind != tokenWord || !attributePathPattern.MatchString(attr.text) {
+		return nil, fmt.Errorf("invalid attribute path: %q", attr.text)
 	}
+	p.pos++
 
-	attribute := matches[1]
-	op := tidcommon.Operator(matches[2])
+	if !p.done() && p.peek().kind == tokenLBracket {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenRBracket, "]"); err != nil {
+			return nil, err
+		}
+		return &Node{Type: NodeTypeValuePath, Attribute: attr.text, Children: []*Node{inner}}, nil
+	}
+
+	if p.done() || p.peek().kind != tokenWord {
+		return nil, fmt.Errorf("expected operator after %q", attr.text)
+	}
+	op, ok := treeOperators[strings.ToLower(p.peek().text)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported operator: %q", p.peek().text)
+	}
+	p.pos++
 
-	var value interface{}
-	if matches[3] != "" {
-		value = matches[3]
-	} else {
-		raw := matches[4]
-		if intVal, err := strconv.ParseInt(raw, 10, 64); err == nil {
-			value = intVal
-		} else if floatVal, err := strconv.ParseFloat(raw, 64); err == nil {
-			value = floatVal
-		} else if boolVal, err := strconv.ParseBool(raw); err == nil {
-			value = boolVal
-		} else {
-			return nil, fmt.Errorf("invalid filter value: %q", raw)
+	expr := &tidcommon.FilterExpression{Attribute: attr.text, Operator: op}
+	if op == tidcommon.OperatorPr {
+		return &Node{Type: NodeTypeExpression, Expr: expr}, nil
+	}
+
+	if p.done() {
+		return nil, fmt.Errorf("missing value for %q", attr.text)
+	}
+	value := p.peek()
+	switch {
+	case value.kind == tokenString:
+		expr.Value = value.text
+	case value.kind == tokenWord && value.text == "null":
+		expr.Value = nil
+	case value.kind == tokenWord:
+		literal, err := parseLiteral(value.text)
+		if err != nil {
+			return nil, err
 		}
+		expr.Value = literal
+	default:
+		return nil, fmt.Errorf("missing value for %q", attr.text)
 	}
+	p.pos++
+	return &Node{Type: NodeTypeExpression, Expr: expr}, nil
+}
 
-	return &tidcommon.FilterExpression{
-		Attribute: attribute,
-		Operator:  op,
-		Value:     value,
-	}, nil
+// parseLiteral parses an unquoted filter value as an integer, float, or boolean.
+func parseLiteral(raw string) (interface{}, error) {
+	if intVal, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return intVal, nil
+	}
+	if floatVal, err := strconv.ParseFloat(raw, 64); err == nil {
+		return floatVal, nil
+	}
+	if boolVal, err := strconv.ParseBool(raw); err == nil {
+		return boolVal, nil
+	}
+	return nil, fmt.Errorf("invalid filter value: %q", raw)
 }
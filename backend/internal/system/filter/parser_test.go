@@ -110,6 +110,16 @@ func TestParseFilterExpression(t *testing.T) {
 			input:   `name eq foo`,
 			wantErr: true,
 		},
+		{
+			name:    "multiple expressions",
+			input:   `name eq "a" and count eq 1`,
+			wantErr: true,
+		},
+		{
+			name:    "schema URN attribute",
+			input:   `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "a"`,
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -183,11 +193,44 @@ func TestParseFilterGroup(t *testing.T) {
 				Value:     "2024-01-01T00:00:00Z",
 			},
 		},
+		{
+			name:        "parenthesized and under or",
+			input:       `name eq "A" OR (handle eq "b" AND createdAt gt "2024")`,
+			wantClauses: 3,
+			wantFirst:   tidcommon.FilterExpression{Attribute: "name", Operator: tidcommon.OperatorEq, Value: "A"},
+			wantSecond:  &tidcommon.FilterExpression{Attribute: "handle", Operator: tidcommon.OperatorEq, Value: "b"},
+			wantConn:    tidcommon.LogicalOr,
+		},
 		{
 			name:    "invalid connector",
 			input:   `name eq "A" XOR name eq "B"`,
 			wantErr: true,
 		},
+		{
+			name:    "parenthesized or under and",
+			input:   `(name eq "A" OR name eq "B") AND handle eq "c"`,
+			wantErr: true,
+		},
+		{
+			name:    "not expression",
+			input:   `not (name eq "A")`,
+			wantErr: true,
+		},
+		{
+			name:    "value path",
+			input:   `emails[type eq "work"]`,
+			wantErr: true,
+		},
+		{
+			name:    "operator outside eq gt lt",
+			input:   `name sw "Eng"`,
+			wantErr: true,
+		},
+		{
+			name:    "null value",
+			input:   `name eq null`,
+			wantErr: true,
+		},
 		{
 			name:    "malformed second expression",
 			input:   `name eq "A" AND bad`,
@@ -256,3 +299,124 @@ func TestParseFilterParam(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func expression(attr string, op tidcommon.Operator, value interface{}) *Node {
+	return &Node{Type: NodeTypeExpression,
+		Expr: &tidcommon.FilterExpression{Attribute: attr, Operator: op, Value: value}}
+}
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  *Node
+	}{
+		{
+			name:  "single eq expression",
+			input: `userName eq "alice"`,
+			want:  expression("userName", tidcommon.OperatorEq, "alice"),
+		},
+		{
+			name:  "operators are case-insensitive",
+			input: `userName SW "al"`,
+			want:  expression("userName", tidcommon.OperatorSw, "al"),
+		},
+		{
+			name:  "present operator takes no value",
+			input: `title pr`,
+			want:  expression("title", tidcommon.OperatorPr, nil),
+		},
+		{
+			name:  "null and boolean literals",
+			input: `title eq null or active eq true`,
+			want: &Node{Type: NodeTypeOr, Children: []*Node{
+				expression("title", tidcommon.OperatorEq, nil),
+				expression("active", tidcommon.OperatorEq, true),
+			}},
+		},
+		{
+			name:  "escaped quotes in string",
+			input: `displayName co "say \"hi\""`,
+			want:  expression("displayName", tidcommon.OperatorCo, `say "hi"`),
+		},
+		{
+			name:  "and binds tighter than or",
+			input: `a eq "1" or b eq "2" and c eq "3"`,
+			want: &Node{Type: NodeTypeOr, Children: []*Node{
+				expression("a", tidcommon.OperatorEq, "1"),
+				{Type: NodeTypeAnd, Children: []*Node{
+					expression("b", tidcommon.OperatorEq, "2"),
+					expression("c", tidcommon.OperatorEq, "3"),
+				}},
+			}},
+		},
+		{
+			name:  "parentheses override precedence",
+			input: `(a eq "1" or b eq "2") and c ew "3"`,
+			want: &Node{Type: NodeTypeAnd, Children: []*Node{
+				{Type: NodeTypeOr, Children: []*Node{
+					expression("a", tidcommon.OperatorEq, "1"),
+					expression("b", tidcommon.OperatorEq, "2"),
+				}},
+				expression("c", tidcommon.OperatorEw, "3"),
+			}},
+		},
+		{
+			name:  "not with nested group",
+			input: `not (emails co "@example.com")`,
+			want: &Node{Type: NodeTypeNot, Children: []*Node{
+				expression("emails", tidcommon.OperatorCo, "@example.com"),
+			}},
+		},
+		{
+			name:  "value path",
+			input: `emails[type eq "work" and value ne "x"]`,
+			want: &Node{Type: NodeTypeValuePath, Attribute: "emails", Children: []*Node{
+				{Type: NodeTypeAnd, Children: []*Node{
+					expression("type", tidcommon.OperatorEq, "work"),
+					expression("value", tidcommon.OperatorNe, "x"),
+				}},
+			}},
+		},
+		{
+			name:  "schema URN attribute path",
+			input: `urn:ietf:params:scim:schemas:core:2.0:User:name.familyName ge 10`,
+			want: expression("urn:ietf:params:scim:schemas:core:2.0:User:name.familyName",
+				tidcommon.OperatorGe, int64(10)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseFilter(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseFilter_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ``},
+		{name: "unsupported operator", input: `name xx "a"`},
+		{name: "missing value", input: `name eq`},
+		{name: "unquoted word value", input: `name eq alice`},
+		{name: "unterminated string", input: `name eq "alice`},
+		{name: "unbalanced parenthesis", input: `(name eq "a"`},
+		{name: "not without parenthesis", input: `not name eq "a"`},
+		{name: "unclosed value path", input: `emails[type eq "work"`},
+		{name: "trailing tokens", input: `name eq "a" "b"`},
+		{name: "dangling connector", input: `name eq "a" and`},
+		{name: "invalid attribute", input: `1name eq "a"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseFilter(tc.input)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -32,6 +32,13 @@ var defaultMessages = map[string]string{
 	"error.agentservice.attribute_conflict_description": "An agent with the same unique attribute value already exists",
 	"error.agentservice.auth_code_requires_code_response_type_description": "authorization_code grant type requires 'code' response type",
 	"error.agentservice.auth_code_requires_redirect_uris_description": "authorization_code grant type requires redirect URIs",
+	"error.agentservice.authorization_response_encryption_alg_requires_enc_description": "authorization response encryptionEnc is required when encryptionAlg is set",
+	"error.agentservice.authorization_response_encryption_enc_requires_alg_description": "authorization response encryptionAlg is required when encryptionEnc is set",
+	"error.agentservice.authorization_response_encryption_requires_certificate_description": "a certificate (JWKS or JWKS_URI) is required when authorization response encryption is configured",
+	"error.agentservice.authorization_response_jwks_uri_not_ssrf_safe_description": "authorization response JWKS URI must be a publicly reachable HTTPS URL",
+	"error.agentservice.authorization_response_unsupported_encryption_alg_description": "authorization response encryption algorithm is not supported",
+	"error.agentservice.authorization_response_unsupported_encryption_enc_description": "authorization response content-encryption algorithm is not supported",
+	"error.agentservice.authorization_response_unsupported_signing_alg_description": "authorization response signing algorithm is not supported",
 	"error.agentservice.cannot_modify_declarative_resource": "Cannot modify declarative resource",
 	"error.agentservice.cannot_modify_declarative_resource_description": "Declaratively managed agents cannot be modified via the API",
 	"error.agentservice.certificate_operation_failed": "Certificate operation failed",
@@ -41,6 +48,8 @@ var defaultMessages = map[string]string{
 	"error.agentservice.client_credentials_cannot_use_response_types_description": "client_credentials grant type cannot be used with response types",
 	"error.agentservice.error_retrieving_flow_definition": "Error retrieving flow definition",
 	"error.agentservice.error_retrieving_flow_definition_description": "An error occurred while retrieving the flow definition",
+	"error.agentservice.fapi2_requires_code_response_type_description": "FAPI 2.0 security profile only allows the 'code' response type",
+	"error.agentservice.fapi2_requires_strong_client_auth_description": "FAPI 2.0 security profile requires a confidential client using private_key_jwt, tls_client_auth or self_signed_tls_client_auth authentication method",
 	"error.agentservice.idtoken_encryption_alg_requires_enc_description": "idToken encryptionEnc is required when encryptionAlg is set",
 	"error.agentservice.idtoken_encryption_enc_requires_alg_description": "idToken encryptionAlg is required when encryptionEnc is set",
 	"error.agentservice.idtoken_encryption_fields_not_allowed_description": "idToken encryptionAlg and encryptionEnc must not be set when responseType is JWT",
@@ -55,6 +64,7 @@ var defaultMessages = map[string]string{
 	"error.agentservice.invalid_agent_type_description": "The agent type must be provided",
 	"error.agentservice.invalid_auth_flow_id": "Invalid auth flow ID",
 	"error.agentservice.invalid_auth_flow_id_description": "The provided authentication flow ID is invalid",
+	"error.agentservice.invalid_backchannel_logout_uri_description": "Back-channel logout URI must be a public https URL without a fragment",
 	"error.agentservice.invalid_certificate_type": "Invalid certificate type",
 	"error.agentservice.invalid_certificate_type_description": "The provided certificate type is not supported",
 	"error.agentservice.invalid_certificate_value": "Invalid certificate value",
@@ -63,6 +73,7 @@ var defaultMessages = map[string]string{
 	"error.agentservice.invalid_credential_description": "The provided credential is invalid",
 	"error.agentservice.invalid_filter": "Invalid filter parameter",
 	"error.agentservice.invalid_filter_description": "The filter format is invalid",
+	"error.agentservice.invalid_frontchannel_logout_uri_description": "Front-channel logout URI must share a registered redirect URI's origin and have no fragment",
 	"error.agentservice.invalid_grant_type": "Invalid grant type",
 	"error.agentservice.invalid_grant_type_description": "One or more grant types are not supported",
 	"error.agentservice.invalid_jwks_uri": "Invalid JWKS URI",
@@ -85,8 +96,11 @@ var defaultMessages = map[string]string{
 	"error.agentservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.agentservice.invalid_response_type": "Invalid response type",
 	"error.agentservice.invalid_response_type_description": "One or more provided response types are invalid",
+	"error.agentservice.invalid_sector_identifier_uri_description": "Sector identifier URI must be an absolute https URL that returns a JSON array of redirect URIs",
+	"error.agentservice.invalid_security_profile_description": "Invalid security profile. Supported values are 'fapi2' and 'none'",
 	"error.agentservice.invalid_subject_attribute_mapping": "Invalid subject attribute mapping",
 	"error.agentservice.invalid_subject_attribute_mapping_description": "The subject attribute mapping must reference an attribute that is unique, required, and string-typed in an allowed agent type",
+	"error.agentservice.invalid_subject_type_description": "Invalid subject type. Supported values are 'public' and 'pairwise'",
 	"error.agentservice.invalid_token_endpoint_auth_method": "Invalid token endpoint authentication method",
 	"error.agentservice.invalid_token_endpoint_auth_method_description": "The provided token endpoint authentication method is not supported",
 	"error.agentservice.invalid_user_attribute": "Invalid user attribute",
@@ -105,8 +119,13 @@ var defaultMessages = map[string]string{
 	"error.agentservice.organization_unit_not_found_description": "The specified organization unit does not exist",
 	"error.agentservice.owner_not_found": "Owner not found",
 	"error.agentservice.owner_not_found_description": "The specified owner does not match any known user, application, or agent",
+	"error.agentservice.pairwise_requires_sector_identifier_uri_description": "Pairwise subject type requires a sector identifier URI when redirect URIs use more than one host",
 	"error.agentservice.pkce_requires_authorization_code_description": "PKCE can only be enabled when the authorization_code grant type is selected",
 	"error.agentservice.private_key_jwt_cannot_have_client_secret_description": "private_key_jwt authentication method cannot have a client secret",
+	"error.agentservice.sector_identifier_uri_missing_redirect_uri_description": "Sector identifier URI must list all registered redirect URIs",
+	"error.agentservice.tls_client_auth_requires_subject_description": "tls_client_auth authentication method requires exactly one certificate subject identifier",
+	"error.agentservice.self_signed_tls_client_auth_requires_certificate_description": "self_signed_tls_client_auth authentication method requires an inline JWKS certificate",
+	"error.agentservice.mtls_auth_cannot_have_client_secret_description": "mutual-TLS authentication methods cannot have a client secret",
 	"error.agentservice.private_key_jwt_requires_certificate_description": "private_key_jwt authentication method requires a certificate",
 	"error.agentservice.public_client_must_have_pkce_description": "Public clients must have PKCE required set to true",
 	"error.agentservice.public_client_must_use_none_auth_description": "Public clients must use 'none' as token endpoint authentication method",
@@ -146,6 +165,13 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.application_with_client_id_already_exists_description": "An application with the same client ID already exists",
 	"error.applicationservice.auth_code_requires_code_response_type_description": "authorization_code grant type requires 'code' response type",
 	"error.applicationservice.auth_code_requires_redirect_uris_description": "authorization_code grant type requires redirect URIs",
+	"error.applicationservice.authorization_response_encryption_alg_requires_enc_description": "authorization response encryptionEnc is required when encryptionAlg is set",
+	"error.applicationservice.authorization_response_encryption_enc_requires_alg_description": "authorization response encryptionAlg is required when encryptionEnc is set",
+	"error.applicationservice.authorization_response_encryption_requires_certificate_description": "a certificate (JWKS or JWKS_URI) is required when authorization response encryption is configured",
+	"error.applicationservice.authorization_response_jwks_uri_not_ssrf_safe_description": "authorization response JWKS URI must be a publicly reachable HTTPS URL",
+	"error.applicationservice.authorization_response_unsupported_encryption_alg_description": "authorization response encryption algorithm is not supported",
+	"error.applicationservice.authorization_response_unsupported_encryption_enc_description": "authorization response content-encryption algorithm is not supported",
+	"error.applicationservice.authorization_response_unsupported_signing_alg_description": "authorization response signing algorithm is not supported",
 	"error.applicationservice.cannot_modify_declarative_resource": "Cannot modify declarative resource",
 	"error.applicationservice.cannot_modify_declarative_resource_description": "The application is declarative and cannot be modified or deleted",
 	"error.applicationservice.certificate_operation_failed": "Certificate operation failed",
@@ -155,6 +181,8 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.client_credentials_cannot_use_response_types_description": "client_credentials grant type cannot be used with response types",
 	"error.applicationservice.error_retrieving_flow_definition": "Error retrieving flow definition",
 	"error.applicationservice.error_retrieving_flow_definition_description": "An error occurred while retrieving the flow definition",
+	"error.applicationservice.fapi2_requires_code_response_type_description": "FAPI 2.0 security profile only allows the 'code' response type",
+	"error.applicationservice.fapi2_requires_strong_client_auth_description": "FAPI 2.0 security profile requires a confidential client using private_key_jwt, tls_client_auth or self_signed_tls_client_auth authentication method",
 	"error.applicationservice.idjag_cannot_use_none_auth_description": "ID-JAG configuration requires a confidential client and cannot use 'none' token endpoint authentication method",
 	"error.applicationservice.idtoken_encryption_alg_requires_enc_description": "idToken encryptionEnc is required when encryptionAlg is set",
 	"error.applicationservice.idtoken_encryption_enc_requires_alg_description": "idToken encryptionAlg is required when encryptionEnc is set",
@@ -177,12 +205,14 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_application_url_description": "The provided application URL is not a valid URI",
 	"error.applicationservice.invalid_auth_flow_id": "Invalid auth flow ID",
 	"error.applicationservice.invalid_auth_flow_id_description": "The provided authentication flow ID is invalid",
+	"error.applicationservice.invalid_backchannel_logout_uri_description": "Back-channel logout URI must be a public https URL without a fragment",
 	"error.applicationservice.invalid_certificate_type": "Invalid certificate type",
 	"error.applicationservice.invalid_certificate_type_description": "The provided certificate type is not supported",
 	"error.applicationservice.invalid_certificate_value": "Invalid certificate value",
 	"error.applicationservice.invalid_certificate_value_description": "The provided certificate value is invalid",
 	"error.applicationservice.invalid_client_id": "Invalid client ID",
 	"error.applicationservice.invalid_client_id_description": "The provided client ID is invalid or empty",
+	"error.applicationservice.invalid_frontchannel_logout_uri_description": "Front-channel logout URI must share a registered redirect URI's origin and have no fragment",
 	"error.applicationservice.invalid_grant_type": "Invalid grant type",
 	"error.applicationservice.invalid_grant_type_description": "One or more provided grant types are invalid",
 	"error.applicationservice.invalid_inbound_auth_config": "Invalid inbound auth config",
@@ -207,8 +237,19 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.applicationservice.invalid_response_type": "Invalid response type",
 	"error.applicationservice.invalid_response_type_description": "One or more provided response types are invalid",
+	"error.applicationservice.invalid_saml_acs_url": "Invalid assertion consumer service URL",
+	"error.applicationservice.invalid_saml_acs_url_description": "At least one assertion consumer service URL is required and each must be an absolute HTTP(S) URL without a fragment",
+	"error.applicationservice.invalid_saml_certificate": "Invalid SAML certificate",
+	"error.applicationservice.invalid_saml_certificate_description": "The SAML service provider certificate must be a PEM-encoded X.509 certificate",
+	"error.applicationservice.invalid_saml_entity_id": "Invalid SAML entity ID",
+	"error.applicationservice.invalid_saml_entity_id_description": "The SAML configuration must specify the entity ID of the service provider",
+	"error.applicationservice.invalid_saml_nameid_format": "Invalid NameID format",
+	"error.applicationservice.invalid_saml_nameid_format_description": "The provided SAML NameID format is not supported",
+	"error.applicationservice.invalid_sector_identifier_uri_description": "Sector identifier URI must be an absolute https URL that returns a JSON array of redirect URIs",
+	"error.applicationservice.invalid_security_profile_description": "Invalid security profile. Supported values are 'fapi2' and 'none'",
 	"error.applicationservice.invalid_subject_attribute_mapping": "Invalid subject attribute mapping",
 	"error.applicationservice.invalid_subject_attribute_mapping_description": "The subject attribute mapping must reference an attribute that is unique, required, and string-typed in an allowed user type",
+	"error.applicationservice.invalid_subject_type_description": "Invalid subject type. Supported values are 'public' and 'pairwise'",
 	"error.applicationservice.invalid_token_endpoint_auth_method": "Invalid token endpoint authentication method",
 	"error.applicationservice.invalid_token_endpoint_auth_method_description": "The provided token endpoint authentication method is invalid",
 	"error.applicationservice.invalid_tos_uri": "Invalid Terms of Service URI",
@@ -222,12 +263,19 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.layout_not_found_description": "The specified layout configuration does not exist",
 	"error.applicationservice.multiple_oauth_configs": "Multiple OAuth inbound auth configs are not allowed",
 	"error.applicationservice.multiple_oauth_configs_description": "An application may have at most one inbound auth config per protocol",
+	"error.applicationservice.multiple_saml_configs": "Multiple SAML inbound auth configs are not allowed",
+	"error.applicationservice.multiple_saml_configs_description": "An application may have at most one inbound auth config per protocol",
 	"error.applicationservice.native_flow_not_allowed_for_spa": "Native flow execution is not allowed for single-page applications",
 	"error.applicationservice.native_flow_not_allowed_for_spa_description": "Single-page applications (public clients) must use the authorization_code grant type with PKCE for redirect-based flows. Direct (native) flow execution is not supported for browser-based single-page applications.",
 	"error.applicationservice.none_auth_method_cannot_have_secret_description": "'none' authentication method cannot have a client secret",
 	"error.applicationservice.none_auth_method_requires_public_client_description": "'none' authentication method requires the client to be a public client",
+	"error.applicationservice.pairwise_requires_sector_identifier_uri_description": "Pairwise subject type requires a sector identifier URI when redirect URIs use more than one host",
 	"error.applicationservice.pkce_requires_authorization_code_description": "PKCE can only be enabled when the authorization_code grant type is selected",
 	"error.applicationservice.private_key_jwt_cannot_have_client_secret_description": "private_key_jwt authentication method cannot have a client secret",
+	"error.applicationservice.sector_identifier_uri_missing_redirect_uri_description": "Sector identifier URI must list all registered redirect URIs",
+	"error.applicationservice.tls_client_auth_requires_subject_description": "tls_client_auth authentication method requires exactly one certificate subject identifier",
+	"error.applicationservice.self_signed_tls_client_auth_requires_certificate_description": "self_signed_tls_client_auth authentication method requires an inline JWKS certificate",
+	"error.applicationservice.mtls_auth_cannot_have_client_secret_description": "mutual-TLS authentication methods cannot have a client secret",
 	"error.applicationservice.private_key_jwt_requires_certificate_description": "private_key_jwt authentication method requires a certificate",
 	"error.applicationservice.public_client_must_have_pkce_description": "Public clients must have PKCE required set to true",
 	"error.applicationservice.public_client_must_use_none_auth_description": "Public clients must use 'none' as token endpoint authentication method",
@@ -235,6 +283,10 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.refresh_token_requires_token_issuing_grant_description": "refresh_token grant type requires a token-issuing grant type",
 	"error.applicationservice.response_types_require_authorization_code_description": "Response types can only be configured with the authorization_code grant type",
 	"error.applicationservice.result_limit_exceeded": "Result limit exceeded",
+	"error.applicationservice.saml_entity_id_already_exists": "SAML entity ID already exists",
+	"error.applicationservice.saml_entity_id_already_exists_description": "Another application is already registered with the provided SAML entity ID",
+	"error.applicationservice.saml_signed_requests_require_certificate": "SAML certificate required",
+	"error.applicationservice.saml_signed_requests_require_certificate_description": "A service provider certificate is required to verify signed AuthnRequests",
 	"error.applicationservice.theme_not_found": "Theme not found",
 	"error.applicationservice.theme_not_found_description": "The specified theme configuration does not exist",
 	"error.applicationservice.userinfo_alg_requires_response_type_description": "userinfo responseType is required when signingAlg or encryptionAlg is set",
@@ -266,6 +318,12 @@ var defaultMessages = map[string]string{
 	"error.attributecache.missing_attributes_description": "Attributes are required",
 	"error.attributecache.missing_cache_id": "Missing cache ID",
 	"error.attributecache.missing_cache_id_description": "Cache ID is required",
+	"error.auditlogservice.invalid_filter": "Invalid filter parameter",
+	"error.auditlogservice.invalid_filter_description": "The filter parameter is invalid. Use attribute eq \"value\" for subject, clientId, eventType, category, component, status and correlationId, and timestamp (gt|lt) \"RFC 3339 time\" for time ranges",
+	"error.auditlogservice.invalid_limit_parameter": "Invalid limit parameter",
+	"error.auditlogservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.auditlogservice.invalid_offset_parameter": "Invalid offset parameter",
+	"error.auditlogservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
 	"error.auth.forbidden": "Forbidden",
 	"error.auth.forbidden_description": "You do not have sufficient permissions to access this resource",
 	"error.auth.unauthorized": "Unauthorized",
@@ -302,6 +360,10 @@ var defaultMessages = map[string]string{
 	"error.authnotpservice.invalid_session_token_description": "The provided session token is invalid or empty",
 	"error.authnotpservice.unsupported_channel": "Unsupported channel",
 	"error.authnotpservice.unsupported_channel_description": "The provided channel is not supported for OTP authentication",
+	"error.authnservice.account_locked": "Account locked",
+	"error.authnservice.account_locked_description": "The account is locked until {{param(lockedUntil)}} due to repeated failed sign-in attempts",
+	"error.authnservice.account_locked_permanently": "Account locked",
+	"error.authnservice.account_locked_permanently_description": "The account is locked due to repeated failed sign-in attempts. Contact an administrator to unlock it",
 	"error.authnservice.ambiguous_user": "Ambiguous user",
 	"error.authnservice.ambiguous_user_description": "Multiple users match the provided attributes",
 	"error.authnservice.assertion_subject_mismatch": "Assertion subject mismatch",
@@ -347,8 +409,22 @@ var defaultMessages = map[string]string{
 	"error.authnservice.reserved_credential_type_description": "The provided credentials contain a credential type that is reserved for internal use",
 	"error.authnservice.sub_claim_not_found": "user subject not found",
 	"error.authnservice.sub_claim_not_found_description": "The 'sub' claim is not found in the ID token claims",
+	"error.authnservice.too_many_failed_attempts": "Too many failed attempts",
+	"error.authnservice.too_many_failed_attempts_description": "Too many failed sign-in attempts. Try again after {{param(retryAfter)}}",
 	"error.authnservice.user_not_found": "User not found",
 	"error.authnservice.user_not_found_description": "No user found with the provided attributes",
+	"error.authntotpservice.entity_not_found": "Entity not found",
+	"error.authntotpservice.entity_not_found_description": "No entity exists for the provided entity ID",
+	"error.authntotpservice.incorrect_code": "Incorrect code",
+	"error.authntotpservice.incorrect_code_description": "The provided code is incorrect, expired, or has already been used",
+	"error.authntotpservice.invalid_code": "Invalid code",
+	"error.authntotpservice.invalid_code_description": "The provided code is invalid or empty",
+	"error.authntotpservice.invalid_entity_id": "Invalid entity ID",
+	"error.authntotpservice.invalid_entity_id_description": "The provided entity ID is invalid or empty",
+	"error.authntotpservice.invalid_secret": "Invalid secret",
+	"error.authntotpservice.invalid_secret_description": "The enrollment secret is invalid",
+	"error.authntotpservice.not_enrolled": "Authenticator app not enrolled",
+	"error.authntotpservice.not_enrolled_description": "No authenticator app is enrolled for the user",
 	"error.authoauthservice.empty_access_token": "Empty access token",
 	"error.authoauthservice.empty_access_token_description": "The access token cannot be empty",
 	"error.authoauthservice.empty_authorization_code": "Empty authorization code",
@@ -373,6 +449,16 @@ var defaultMessages = map[string]string{
 	"error.authoidcservice.invalid_id_token_description": "The ID token is invalid or malformed",
 	"error.authoidcservice.invalid_id_token_signature": "Invalid ID token signature",
 	"error.authoidcservice.invalid_id_token_signature_description": "The ID token signature verification failed",
+	"error.authsamlservice.authentication_failed": "Authentication failed",
+	"error.authsamlservice.authentication_failed_description": "The identity provider did not authenticate the user",
+	"error.authsamlservice.empty_idp_id": "IDP id is empty",
+	"error.authsamlservice.empty_idp_id_description": "The identity provider id cannot be empty",
+	"error.authsamlservice.invalid_idp": "Invalid identity provider",
+	"error.authsamlservice.invalid_idp_description": "The identity provider is not a valid SAML identity provider",
+	"error.authsamlservice.invalid_response": "Invalid SAML response",
+	"error.authsamlservice.invalid_response_description": "The SAML response from the identity provider is malformed or failed validation",
+	"error.authsamlservice.response_not_found": "SAML response not found",
+	"error.authsamlservice.response_not_found_description": "The SAML response was not found, has expired or was already used",
 	"error.authzen.invalid_action": "Invalid action",
 	"error.authzen.invalid_action_description": "Action name is not registered on the resource server",
 	"error.authzen.invalid_request_format": "Invalid request format",
@@ -443,16 +529,26 @@ var defaultMessages = map[string]string{
 	"error.consentservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.consentservice.missing_consent_id": "Missing consent ID",
 	"error.consentservice.missing_consent_id_description": "Consent ID is required",
+	"error.dcr.client_id_mismatch": "Client ID mismatch",
+	"error.dcr.client_id_mismatch_description": "The client_id in the request does not match the registered client",
 	"error.dcr.invalid_client_metadata": "Invalid client metadata",
 	"error.dcr.invalid_client_metadata_description": "One or more client metadata values are invalid",
 	"error.dcr.invalid_redirect_uri": "Invalid redirect URI",
 	"error.dcr.invalid_redirect_uri_description": "One or more redirect URIs are invalid",
 	"error.dcr.invalid_request_format": "Invalid request format",
 	"error.dcr.invalid_request_format_description": "The request body is missing or has an invalid format",
+	"error.dcr.invalid_software_statement": "Invalid software statement",
+	"error.dcr.invalid_software_statement_description": "The software statement is invalid or its signature could not be verified",
+	"error.dcr.invalid_token": "Invalid registration access token",
+	"error.dcr.invalid_token_description": "The registration access token is missing, invalid or expired",
 	"error.dcr.jwks_configuration_conflict": "JWKS configuration conflict",
 	"error.dcr.jwks_configuration_conflict_description": "Cannot specify both 'jwks' and 'jwks_uri' parameters",
 	"error.dcr.server_error": "Server error",
 	"error.dcr.server_error_description": "An unexpected error occurred while processing the request",
+	"error.dcr.software_statement_required": "Software statement required",
+	"error.dcr.software_statement_required_description": "A software statement is required to register a client",
+	"error.dcr.unapproved_software_statement": "Unapproved software statement",
+	"error.dcr.unapproved_software_statement_description": "The software statement is not issued by a trusted issuer",
 	"error.dcr.unauthorized": "Unauthorized",
 	"error.dcr.unauthorized_description": "Authentication with sufficient permissions is required to register a client",
 	"error.declarative_resource.create_operation_not_allowed": "Declarative resource create operation is not allowed",
@@ -775,6 +871,9 @@ var defaultMessages = map[string]string{
 	"error.idpservice.required_property_missing_description": "required property '{{param(property)}}' is missing for IDP type '{{param(idpType)}}'",
 	"error.idpservice.result_limit_exceeded": "Result limit exceeded in composite mode",
 	"error.idpservice.result_limit_exceeded_description": "The total number of records exceeds the maximum limit in composite mode",
+	"error.idpservice.saml_certificate_invalid_description": "value for property '{{param(property)}}' must be a PEM-encoded X.509 certificate",
+	"error.idpservice.saml_metadata_invalid_description": "SAML metadata is invalid: {{param(error)}}",
+	"error.idpservice.saml_sso_url_invalid_description": "value for property '{{param(property)}}' must be an absolute HTTP(S) URL",
 	"error.idpservice.scopes_value_get_failed_description": "failed to get scopes value: {{param(error)}}",
 	"error.idpservice.unsupported_idp_property": "Unsupported identity provider property",
 	"error.idpservice.unsupported_idp_property_description": "One or more identity provider properties are not supported",
@@ -835,6 +934,8 @@ var defaultMessages = map[string]string{
 	"error.jwtservice.unsupported_jws_algorithm": "Unsupported JWS algorithm",
 	"error.jwtservice.unsupported_jws_algorithm_description": "The specified JWS algorithm is not supported",
 	"error.layoutservice.invalid_limit_value_description": "Limit must be between 1 and {{param(max)}}",
+	"error.lockoutservice.entity_not_found": "Entity not found",
+	"error.lockoutservice.entity_not_found_description": "The entity with the specified id does not exist",
 	"error.magiclinkservice.expired_token": "Expired token",
 	"error.magiclinkservice.expired_token_description": "The magic link token has expired",
 	"error.magiclinkservice.invalid_token": "Invalid token",
@@ -942,6 +1043,30 @@ var defaultMessages = map[string]string{
 	"error.passkeyservice.session_expired_description": "The session has expired. Please start a new session",
 	"error.passkeyservice.user_not_found": "User not found",
 	"error.passkeyservice.user_not_found_description": "The specified user was not found",
+	"error.passwordpolicyservice.breached": "Password found in a data breach",
+	"error.passwordpolicyservice.breached_description": "The password has appeared in a known data breach and cannot be used",
+	"error.passwordpolicyservice.changed_too_recently": "Password changed too recently",
+	"error.passwordpolicyservice.changed_too_recently_description": "The password cannot be changed again until {{param(minAgeSeconds)}} seconds after the last change",
+	"error.passwordpolicyservice.contains_identity": "Password contains user identity",
+	"error.passwordpolicyservice.contains_identity_description": "The password must not contain the username or email address",
+	"error.passwordpolicyservice.entity_not_found": "Entity not found",
+	"error.passwordpolicyservice.entity_not_found_description": "The entity with the specified id does not exist",
+	"error.passwordpolicyservice.missing_digit": "Password missing digit",
+	"error.passwordpolicyservice.missing_digit_description": "The password must contain at least one digit",
+	"error.passwordpolicyservice.missing_lowercase": "Password missing lowercase letter",
+	"error.passwordpolicyservice.missing_lowercase_description": "The password must contain at least one lowercase letter",
+	"error.passwordpolicyservice.missing_special": "Password missing special character",
+	"error.passwordpolicyservice.missing_special_description": "The password must contain at least one character that is neither a letter nor a digit",
+	"error.passwordpolicyservice.missing_uppercase": "Password missing uppercase letter",
+	"error.passwordpolicyservice.missing_uppercase_description": "The password must contain at least one uppercase letter",
+	"error.passwordpolicyservice.reused": "Password recently used",
+	"error.passwordpolicyservice.reused_description": "The password must not match any of the last {{param(historyCount)}} passwords",
+	"error.passwordpolicyservice.too_common": "Password too common",
+	"error.passwordpolicyservice.too_common_description": "The password is too common and easy to guess",
+	"error.passwordpolicyservice.too_long": "Password too long",
+	"error.passwordpolicyservice.too_long_description": "The password must be at most {{param(maxLength)}} characters long",
+	"error.passwordpolicyservice.too_short": "Password too short",
+	"error.passwordpolicyservice.too_short_description": "The password must be at least {{param(minLength)}} characters long",
 	"error.resourceservice.action_not_found": "Action not found",
 	"error.resourceservice.action_not_found_description": "The action with the specified id does not exist",
 	"error.resourceservice.cannot_delete": "Cannot delete",
@@ -958,6 +1083,8 @@ var defaultMessages = map[string]string{
 	"error.resourceservice.handle_conflict_description": "The same handle already exists within the specified resource",
 	"error.resourceservice.identifier_conflict": "Identifier conflict",
 	"error.resourceservice.identifier_conflict_description": "A resource server with the same identifier already exists",
+	"error.resourceservice.invalid_authorization_detail_types": "Invalid authorization details types",
+	"error.resourceservice.invalid_authorization_detail_types_description": "Authorization details types must be unique, non-empty and declare a valid JSON schema",
 	"error.resourceservice.invalid_delimiter": "Invalid delimiter",
 	"error.resourceservice.invalid_delimiter_description": "Delimiter must be a single valid character (a-z A-Z 0-9 . _ : - /)",
 	"error.resourceservice.invalid_handle": "Invalid handle",
@@ -1022,6 +1149,24 @@ var defaultMessages = map[string]string{
 	"error.serverconfigservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.serverconfigservice.unsupported_config_name": "Unsupported configuration name",
 	"error.serverconfigservice.unsupported_config_name_description": "The requested server configuration name is not supported",
+	"error.ssfservice.invalid_delivery_method": "Invalid delivery method",
+	"error.ssfservice.invalid_delivery_method_description": "The delivery method must be urn:ietf:rfc:8935 (push) or urn:ietf:rfc:8936 (poll)",
+	"error.ssfservice.invalid_endpoint_url": "Invalid endpoint URL",
+	"error.ssfservice.invalid_endpoint_url_description": "Push delivery requires a public https endpoint_url",
+	"error.ssfservice.invalid_event_types": "Invalid event types",
+	"error.ssfservice.invalid_event_types_description": "At least one event type listed in events_supported must be requested",
+	"error.ssfservice.invalid_request_format": "Invalid request format",
+	"error.ssfservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.ssfservice.invalid_status": "Invalid stream status",
+	"error.ssfservice.invalid_status_description": "The stream status must be enabled, paused or disabled",
+	"error.ssfservice.missing_stream_id": "Missing stream_id",
+	"error.ssfservice.missing_stream_id_description": "The stream_id of the stream is required",
+	"error.ssfservice.stream_disabled": "Stream is disabled",
+	"error.ssfservice.stream_disabled_description": "Events are not transmitted on a disabled stream",
+	"error.ssfservice.stream_not_found": "Stream not found",
+	"error.ssfservice.stream_not_found_description": "The stream with the specified stream_id does not exist",
+	"error.ssfservice.stream_not_poll": "Stream does not use poll delivery",
+	"error.ssfservice.stream_not_poll_description": "Only streams configured with the urn:ietf:rfc:8936 delivery method can be polled",
 	"error.sysauthz.grant_not_permitted": "Insufficient privileges to grant these permissions",
 	"error.sysauthz.grant_not_permitted_description": "The operation would grant permissions that the caller does not hold",
 	"error.templateservice.template_not_found": "Template not found",
@@ -1029,8 +1174,44 @@ var defaultMessages = map[string]string{
 	"error.themeservice.invalid_limit_value_description": "Limit must be between 1 and {{param(max)}}",
 	"error.unauthorized": "Unauthorized",
 	"error.unauthorized_description": "The caller is not authorized to perform this operation",
+	"error.userbulkservice.file_too_large": "Import file too large",
+	"error.userbulkservice.file_too_large_description": "The import file exceeds the maximum upload size",
+	"error.userbulkservice.invalid_attribute_value": "Invalid attribute value",
+	"error.userbulkservice.invalid_attribute_value_description": "A column value does not match the type of its attribute",
+	"error.userbulkservice.invalid_credential_algorithm": "Invalid credential algorithm",
+	"error.userbulkservice.invalid_credential_algorithm_description": "Credential algorithms must be supported hashing algorithms, and every pre-hashed credential column needs the algorithm of its credential type",
+	"error.userbulkservice.invalid_export_request": "Invalid export request",
+	"error.userbulkservice.invalid_export_request_description": "The format must be ndjson or csv, and a csv export needs the attributes to export",
+	"error.userbulkservice.invalid_file_format": "Invalid file format",
+	"error.userbulkservice.invalid_file_format_description": "The file format must be csv or ndjson, given in the options or by a .csv, .ndjson or .jsonl file name",
+	"error.userbulkservice.invalid_filter": "Invalid filter parameter",
+	"error.userbulkservice.invalid_filter_description": "The filter parameter is invalid. Use attribute eq \"value\"",
+	"error.userbulkservice.invalid_imported_credential": "Invalid imported credential",
+	"error.userbulkservice.invalid_imported_credential_description": "A pre-hashed credential needs a hash and a supported algorithm",
+	"error.userbulkservice.invalid_limit_parameter": "Invalid limit parameter",
+	"error.userbulkservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.userbulkservice.invalid_mapping": "Invalid column mapping",
+	"error.userbulkservice.invalid_mapping_description": "Every mapped column must exist in the CSV header and map to an attribute, type, ouId, credentials.<type>.hash or credentials.<type>.salt",
+	"error.userbulkservice.invalid_offset_parameter": "Invalid offset parameter",
+	"error.userbulkservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
+	"error.userbulkservice.invalid_request_format": "Invalid request format",
+	"error.userbulkservice.invalid_request_format_description": "The request must be a multipart form with the import file in the file field and the options as JSON in the options field",
+	"error.userbulkservice.invalid_row": "Invalid row",
+	"error.userbulkservice.invalid_row_description": "The row could not be parsed",
+	"error.userbulkservice.job_not_found": "Import job not found",
+	"error.userbulkservice.job_not_found_description": "The import job with the specified id does not exist",
+	"error.userbulkservice.job_not_running": "Import job is not running",
+	"error.userbulkservice.job_not_running_description": "Only a running import job can be cancelled",
+	"error.userbulkservice.missing_organization_unit": "Missing organization unit",
+	"error.userbulkservice.missing_organization_unit_description": "The row has no organization unit and the job has no default organization unit",
+	"error.userbulkservice.missing_user_type": "Missing user type",
+	"error.userbulkservice.missing_user_type_description": "The row has no user type and the job has no default user type",
+	"error.userbulkservice.too_many_jobs": "Too many import jobs",
+	"error.userbulkservice.too_many_jobs_description": "The maximum number of import jobs are already running. Retry once one of them ends",
 	"error.userinfoservice.client_credentials_not_supported": "Invalid access token",
 	"error.userinfoservice.client_credentials_not_supported_description": "UserInfo endpoint is not applicable for client_credentials grant type",
+	"error.userinfoservice.certificate_binding_mismatch": "Invalid access token",
+	"error.userinfoservice.certificate_binding_mismatch_description": "Access token is not bound to the presented client certificate",
 	"error.userinfoservice.dpop_bound_token_bearer_scheme": "Invalid access token",
 	"error.userinfoservice.dpop_bound_token_bearer_scheme_description": "DPoP-bound token must use DPoP scheme",
 	"error.userinfoservice.insufficient_scope": "Insufficient scope",
@@ -1081,6 +1262,8 @@ var defaultMessages = map[string]string{
 	"error.userservice.organization_unit_not_found_description": "The specified organization unit does not exist",
 	"error.userservice.schema_validation_failed": "Schema validation failed",
 	"error.userservice.schema_validation_failed_description": "User attributes do not conform to the required schema",
+	"error.userservice.session_not_found": "Session not found",
+	"error.userservice.session_not_found_description": "The user has no active session with the specified id",
 	"error.userservice.user_has_blocking_dependencies": "User cannot be deleted",
 	"error.userservice.user_has_blocking_dependencies_description": "The user cannot be deleted because other resources depend on it. Remove or reassign them first.",
 	"error.userservice.user_not_found": "User not found",
@@ -1125,6 +1308,32 @@ var defaultMessages = map[string]string{
 	"error.vp.definition_result_limit_exceeded_description": "The number of presentation definitions exceeds the supported limit in hybrid mode. Use search for larger datasets",
 	"error.vp.definition_unsupported_format": "Unsupported credential format",
 	"error.vp.definition_unsupported_format_description": "Only the dc+sd-jwt credential format is supported",
+	"error.webhookservice.delivery_not_found": "Delivery not found",
+	"error.webhookservice.delivery_not_found_description": "The delivery with the specified id does not exist for the webhook",
+	"error.webhookservice.delivery_pending": "Delivery is pending",
+	"error.webhookservice.delivery_pending_description": "The delivery is still queued for delivery and cannot be redelivered",
+	"error.webhookservice.invalid_event_types": "Invalid event types",
+	"error.webhookservice.invalid_event_types_description": "At least one event type is required. Supported event types are user.registered, user.login, user.credentials_updated and user.deleted",
+	"error.webhookservice.invalid_filter": "Invalid filter parameter",
+	"error.webhookservice.invalid_filter_description": "The filter parameter is invalid. Use status eq \"value\" or eventType eq \"value\"",
+	"error.webhookservice.invalid_format": "Invalid payload format",
+	"error.webhookservice.invalid_format_description": "The payload format must be json or cloudevents",
+	"error.webhookservice.invalid_limit_parameter": "Invalid limit parameter",
+	"error.webhookservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.webhookservice.invalid_name": "Invalid webhook name",
+	"error.webhookservice.invalid_name_description": "The webhook name must not be empty",
+	"error.webhookservice.invalid_offset_parameter": "Invalid offset parameter",
+	"error.webhookservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
+	"error.webhookservice.invalid_request_format": "Invalid request format",
+	"error.webhookservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.webhookservice.invalid_url": "Invalid webhook URL",
+	"error.webhookservice.invalid_url_description": "The webhook URL must be a public https URL",
+	"error.webhookservice.webhook_not_found": "Webhook not found",
+	"error.webhookservice.webhook_not_found_description": "The webhook with the specified id does not exist",
+	"flows.executor.errors.account_locked": "Account locked",
+	"flows.executor.errors.account_locked_desc": "The account is locked until {{param(lockedUntil)}} due to repeated failed sign-in attempts",
+	"flows.executor.errors.account_locked_permanently": "Account locked",
+	"flows.executor.errors.account_locked_permanently_desc": "The account is locked due to repeated failed sign-in attempts. Contact an administrator to unlock it",
 	"flows.executor.errors.ambiguous_user_identity": "Ambiguous user identity",
 	"flows.executor.errors.ambiguous_user_identity_desc": "User identity is ambiguous and cannot be determined",
 	"flows.executor.errors.attribute_collect_failed": "Failed to update user attributes",
@@ -1205,6 +1414,8 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.invalid_passkey_desc": "The passkey credentials provided are invalid",
 	"flows.executor.errors.invalid_revocation_mode": "Invalid revocation mode",
 	"flows.executor.errors.invalid_revocation_mode_desc": "The requested revocation mode is not supported for this action",
+	"flows.executor.errors.invalid_totp": "Invalid authenticator code",
+	"flows.executor.errors.invalid_totp_desc": "The authenticator app code or recovery code provided is invalid",
 	"flows.executor.errors.invalid_user_type": "Invalid user type",
 	"flows.executor.errors.invalid_user_type_desc": "The provided user type is not valid",
 	"flows.executor.errors.invite_token_generation_failed": "Failed to generate invite token",
@@ -1213,6 +1424,8 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.magic_link_generation_failed_desc": "Failed to generate the magic link",
 	"flows.executor.errors.max_otp_attempts_reached": "Maximum OTP attempts reached",
 	"flows.executor.errors.max_otp_attempts_reached_desc": "The maximum number of OTP verification attempts has been reached",
+	"flows.executor.errors.max_totp_attempts_reached": "Maximum authenticator code attempts reached",
+	"flows.executor.errors.max_totp_attempts_reached_desc": "The maximum number of authenticator code verification attempts has been reached",
 	"flows.executor.errors.no_live_sso_session": "No live SSO session",
 	"flows.executor.errors.no_live_sso_session_desc": "No live, compatible SSO session exists for this flow; full authentication is required",
 	"flows.executor.errors.no_registered_passkeys": "No registered passkeys found",
@@ -1247,6 +1460,8 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.passkey_auth_failed_desc": "An error occurred while authenticating with the passkey",
 	"flows.executor.errors.passkey_registration_failed": "Passkey registration failed",
 	"flows.executor.errors.passkey_registration_failed_desc": "An error occurred while registering the passkey",
+	"flows.executor.errors.password_policy_violation": "Password does not meet the password policy",
+	"flows.executor.errors.password_policy_violation_desc": "{{param(reason)}}",
 	"flows.executor.errors.prerequisites_failed": "Prerequisites validation failed",
 	"flows.executor.errors.prerequisites_failed_desc": "The prerequisites for this operation have not been met",
 	"flows.executor.errors.provisioning_assignment_failed": "Failed to assign groups and roles",
@@ -1271,6 +1486,12 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.sms_recipient_missing_desc": "An SMS recipient must be provided to send the notification",
 	"flows.executor.errors.sms_template_missing": "SMS template is required",
 	"flows.executor.errors.sms_template_missing_desc": "An SMS template must be provided to send the notification",
+	"flows.executor.errors.too_many_failed_attempts": "Too many failed attempts",
+	"flows.executor.errors.too_many_failed_attempts_desc": "Too many failed sign-in attempts. Try again after {{param(retryAfter)}}",
+	"flows.executor.errors.totp_not_enrolled": "Authenticator app not enrolled",
+	"flows.executor.errors.totp_not_enrolled_desc": "The user has not enrolled an authenticator app",
+	"flows.executor.errors.totp_user_required": "User required for authenticator app enrollment",
+	"flows.executor.errors.totp_user_required_desc": "An authenticated user is required to enroll an authenticator app",
 	"flows.executor.errors.user_already_exists": "User already exists",
 	"flows.executor.errors.user_already_exists_desc": "A user already exists with the provided attributes",
 	"flows.executor.errors.user_already_exists_in_target_ou": "User already exists in the target organization",
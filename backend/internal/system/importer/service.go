@@ -984,15 +984,26 @@ func applicationRequestToDTO(req *appmodel.ApplicationRequestWithID) *appmodel.A
 					ClientSecret:                       config.OAuthConfig.ClientSecret,
 					RedirectURIs:                       config.OAuthConfig.RedirectURIs,
 					PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+					BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+					BackchannelLogoutSessionRequired:   config.OAuthConfig.BackchannelLogoutSessionRequired,
+					FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
+					FrontchannelLogoutSessionRequired:  config.OAuthConfig.FrontchannelLogoutSessionRequired,
 					GrantTypes:                         config.OAuthConfig.GrantTypes,
 					ResponseTypes:                      config.OAuthConfig.ResponseTypes,
 					TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 					PKCERequired:                       config.OAuthConfig.PKCERequired,
 					PublicClient:                       config.OAuthConfig.PublicClient,
 					RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+					RequireSignedRequestObject:         config.OAuthConfig.RequireSignedRequestObject,
+					MTLSBoundAccessTokens:              config.OAuthConfig.MTLSBoundAccessTokens,
+					SecurityProfile:                    config.OAuthConfig.SecurityProfile,
+					SubjectType:                        config.OAuthConfig.SubjectType,
+					SectorIdentifierURI:                config.OAuthConfig.SectorIdentifierURI,
 					Token:                              config.OAuthConfig.Token,
 					Scopes:                             config.OAuthConfig.Scopes,
 					UserInfo:                           config.OAuthConfig.UserInfo,
+					AuthorizationResponse:              config.OAuthConfig.AuthorizationResponse,
+					TLSClientAuth:                      config.OAuthConfig.TLSClientAuth,
 					ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 					Certificate:                        config.OAuthConfig.Certificate,
 					AcrValues:                          config.OAuthConfig.AcrValues,
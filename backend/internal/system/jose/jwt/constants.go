@@ -3,6 +3,19 @@
 
 package jwt
 
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+)
+
+// hmacHashFuncs maps the HMAC JWS algorithms (RFC 7518 §3.2) to their hash functions.
+var hmacHashFuncs = map[string]func() hash.Hash{
+	"HS256": sha256.New,
+	"HS384": sha512.New384,
+	"HS512": sha512.New,
+}
+
 const (
 	// TokenTypeJWT is the standard JWT type header value used for general-purpose JWTs.
 	TokenTypeJWT = "JWT"
@@ -17,4 +30,10 @@ const (
 	// TokenTypeIDJAG is the JWT type header value for an Identity Assertion Authorization Grant
 	// (draft-ietf-oauth-identity-assertion-authz-grant).
 	TokenTypeIDJAG = "oauth-id-jag+jwt" //nolint:gosec // JWT typ header value, not a credential
+
+	// TokenTypeLogoutToken is the JWT type header value for OpenID Connect Back-Channel Logout tokens.
+	TokenTypeLogoutToken = "logout+jwt" //nolint:gosec // JWT typ header value, not a credential
+
+	// TokenTypeSecEvent is the JWT type header value for Security Event Tokens as defined in RFC 8417.
+	TokenTypeSecEvent = "secevent+jwt" //nolint:gosec // JWT typ header value, not a credential
 )
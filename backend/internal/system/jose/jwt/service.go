@@ -6,6 +6,7 @@ package jwt
 
 import (
 	"context"
+	"crypto/hmac"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -39,6 +40,8 @@ type JWTServiceInterface interface {
 	VerifyJWTSignatureWithPublicKey(ctx context.Context, jwtToken string,
 		keyRef providers.KeyRef) *tidcommon.ServiceError
 	VerifyJWTSignatureWithJWKS(ctx context.Context, jwtToken string, jwksURL string) *tidcommon.ServiceError
+	VerifyJWTWithSecret(ctx context.Context, jwtToken string, secret []byte, expectedAud,
+		expectedIss string) *tidcommon.ServiceError
 }
 
 // jwksCacheEntry holds a cached JWKS response with its expiry time.
@@ -254,6 +257,41 @@ func (js *jwtService) VerifyJWTWithJWKS(ctx context.Context,
 	return js.verifyJWTClaims(ctx, jwtToken, expectedAud, expectedIss)
 }
 
+// VerifyJWTWithSecret verifies an HMAC-signed (HS256, HS384 or HS512) JWT token using the shared secret.
+func (js *jwtService) VerifyJWTWithSecret(ctx context.Context, jwtToken string, secret []byte,
+	expectedAud, expectedIss string) *tidcommon.ServiceError {
+	parts := strings.Split(jwtToken, ".")
+	if len(parts) != 3 {
+		return &ErrorInvalidJWTFormat
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return &ErrorInvalidTokenSignature
+	}
+
+	header, err := DecodeJWTHeader(jwtToken)
+	if err != nil {
+		return &ErrorDecodingJWTHeader
+	}
+	algStr, _ := header["alg"].(string)
+	newHash, ok := hmacHashFuncs[algStr]
+	if !ok {
+		return &ErrorUnsupportedJWSAlgorithm
+	}
+	if len(secret) == 0 {
+		return &ErrorInvalidTokenSignature
+	}
+
+	mac := hmac.New(newHash, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return &ErrorInvalidTokenSignature
+	}
+
+	return js.verifyJWTClaims(ctx, jwtToken, expectedAud, expectedIss)
+}
+
 // VerifyJWTSignature verifies the signature of a JWT token using the server's public key.
 func (js *jwtService) VerifyJWTSignature(ctx context.Context, jwtToken string) *tidcommon.ServiceError {
 	if js.cryptoProvider == nil {
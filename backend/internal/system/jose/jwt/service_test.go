@@ -9,14 +9,18 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/elliptic"
+	"crypto/hmac"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"hash"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -1920,6 +1924,89 @@ func (suite *JWTServiceTestSuite) createJWTWithCustomHeader(header map[string]in
 	return headerBase64 + "." + payloadBase64 + "." + signatureBase64
 }
 
+func (suite *JWTServiceTestSuite) TestVerifyJWTWithSecret() {
+	secret := []byte("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	exp := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name          string
+		token         string
+		secret        []byte
+		expectedError *tidcommon.ServiceError
+	}{
+		{
+			name:   "HS256",
+			token:  createHMACJWT("HS256", sha256.New, secret, testAudience, testIssuer, exp),
+			secret: secret,
+		},
+		{
+			name:   "HS384",
+			token:  createHMACJWT("HS384", sha512.New384, secret, testAudience, testIssuer, exp),
+			secret: secret,
+		},
+		{
+			name:   "HS512",
+			token:  createHMACJWT("HS512", sha512.New, secret, testAudience, testIssuer, exp),
+			secret: secret,
+		},
+		{
+			name:          "WrongSecret",
+			token:         createHMACJWT("HS256", sha256.New, secret, testAudience, testIssuer, exp),
+			secret:        []byte("another-secret"),
+			expectedError: &ErrorInvalidTokenSignature,
+		},
+		{
+			name:          "EmptySecret",
+			token:         createHMACJWT("HS256", sha256.New, nil, testAudience, testIssuer, exp),
+			secret:        nil,
+			expectedError: &ErrorInvalidTokenSignature,
+		},
+		{
+			name:          "AlgorithmMismatch",
+			token:         createHMACJWT("RS256", sha256.New, secret, testAudience, testIssuer, exp),
+			secret:        secret,
+			expectedError: &ErrorUnsupportedJWSAlgorithm,
+		},
+		{
+			name: "Expired",
+			token: createHMACJWT("HS256", sha256.New, secret, testAudience, testIssuer,
+				time.Now().Add(-time.Hour).Unix()),
+			secret:        secret,
+			expectedError: &ErrorTokenExpired,
+		},
+		{
+			name:          "InvalidFormat",
+			token:         "not.a-jwt",
+			secret:        secret,
+			expectedError: &ErrorInvalidJWTFormat,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.jwtService.VerifyJWTWithSecret(context.Background(), tc.token, tc.secret,
+				testAudience, testIssuer)
+			if tc.expectedError == nil {
+				suite.Nil(err)
+				return
+			}
+			suite.Require().NotNil(err)
+			suite.Equal(tc.expectedError.Code, err.Code)
+		})
+	}
+}
+
+// createHMACJWT creates a JWT with the given alg header, MACed with newHash and secret.
+func createHMACJWT(alg string, newHash func() hash.Hash, secret []byte, aud, iss string, exp int64) string {
+	headerJSON, _ := json.Marshal(map[string]interface{}{"alg": alg, "typ": "JWT"})
+	payloadJSON, _ := json.Marshal(map[string]interface{}{"sub": "client", "aud": aud, "iss": iss, "exp": exp})
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." +
+		base64.RawURLEncoding.EncodeToString(payloadJSON)
+	mac := hmac.New(newHash, secret)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
 // Helper method to create mock JWKS data
 func (suite *JWTServiceTestSuite) createMockJWKSData() string {
 	n := base64.RawURLEncoding.EncodeToString(suite.testPrivateKey.PublicKey.N.Bytes())
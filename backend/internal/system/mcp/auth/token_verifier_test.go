@@ -124,6 +124,20 @@ func (m *MockJWTService) VerifyJWTSignatureWithJWKS(
 	return args.Get(0).(*tidcommon.ServiceError)
 }
 
+func (m *MockJWTService) VerifyJWTWithSecret(
+	ctx context.Context,
+	jwtToken string,
+	secret []byte,
+	expectedAud string,
+	expectedIss string,
+) *tidcommon.ServiceError {
+	args := m.Called(ctx, jwtToken, secret, expectedAud, expectedIss)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*tidcommon.ServiceError)
+}
+
 type TokenVerifierTestSuite struct {
 	suite.Suite
 }
@@ -0,0 +1,60 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ClientIPMiddleware resolves the IP address of the requesting client and stores it in the request
+// context. When header is set and the request comes from one of trustedProxies, the last address listed
+// in that header is used, since it is the one appended by the proxy directly in front of the server and
+// cannot be spoofed by the client. Otherwise, or if the header is absent, the address of the connection
+// is used. A header requires at least one trusted proxy.
+func ClientIPMiddleware(header string, trustedProxies []string) (func(http.Handler) http.Handler, error) {
+	proxies, err := utils.ParseIPNets(trustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client IP trusted proxies: %w", err)
+	}
+	if header != "" && len(proxies) == 0 {
+		return nil, fmt.Errorf("client IP header %s requires trusted proxies", header)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if ip := extractClientIP(r, header, proxies); ip != "" {
+				r = r.WithContext(sysContext.WithClientIP(r.Context(), ip))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+// extractClientIP returns the client IP of the request, or "" if it cannot be determined.
+func extractClientIP(r *http.Request, header string, trustedProxies []*net.IPNet) string {
+	if header != "" && utils.IsRemoteAddrIn(r, trustedProxies) {
+		// The header may be repeated; the proxy in front of the server appends to the last occurrence.
+		if values := r.Header.Values(header); len(values) > 0 {
+			addresses := strings.Split(values[len(values)-1], ",")
+			if ip := net.ParseIP(strings.TrimSpace(addresses[len(addresses)-1])); ip != nil {
+				return ip.String()
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String()
+	}
+	return ""
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+func TestClientIPMiddleware(t *testing.T) {
+	trustedProxies := []string{"10.0.0.0/8"}
+	testCases := []struct {
+		name       string
+		header     string
+		remoteAddr string
+		forwarded  []string
+		expected   string
+	}{
+		{"connection address", "", "192.0.2.10:5000", []string{"203.0.113.5"}, "192.0.2.10"},
+		{"last forwarded address", "X-Forwarded-For", "10.0.0.1:5000", []string{"198.51.100.7, 203.0.113.5"},
+			"203.0.113.5"},
+		{"last of repeated headers", "X-Forwarded-For", "10.0.0.1:5000", []string{"198.51.100.7", "203.0.113.5"},
+			"203.0.113.5"},
+		{"untrusted peer", "X-Forwarded-For", "192.0.2.10:5000", []string{"203.0.113.5"}, "192.0.2.10"},
+		{"header absent", "X-Forwarded-For", "10.0.0.1:5000", nil, "10.0.0.1"},
+		{"invalid forwarded address", "X-Forwarded-For", "10.0.0.1:5000", []string{"unknown"}, "10.0.0.1"},
+		{"invalid connection address", "", "pipe", nil, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proxies := trustedProxies
+			if tc.header == "" {
+				proxies = nil
+			}
+			clientIPMiddleware, err := ClientIPMiddleware(tc.header, proxies)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var actual string
+			handler := clientIPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actual = sysContext.GetClientIP(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			req.RemoteAddr = tc.remoteAddr
+			for _, value := range tc.forwarded {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if actual != tc.expected {
+				t.Errorf("Expected client IP %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestClientIPMiddleware_InvalidConfiguration(t *testing.T) {
+	if _, err := ClientIPMiddleware("X-Forwarded-For", nil); err == nil {
+		t.Error("Expected an error for a header without trusted proxies")
+	}
+	if _, err := ClientIPMiddleware("X-Forwarded-For", []string{"not-an-address"}); err == nil {
+		t.Error("Expected an error for an invalid trusted proxy")
+	}
+}
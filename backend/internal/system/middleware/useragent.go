@@ -0,0 +1,29 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+// maxUserAgentLength bounds the User-Agent kept in the request context, since it is client supplied and
+// may be persisted, for example against the SSO sessions established by the request.
+const maxUserAgentLength = 512
+
+// UserAgentMiddleware stores the User-Agent header of the request in the request context, truncated to
+// maxUserAgentLength bytes without splitting a multi-byte character.
+func UserAgentMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if userAgent := r.UserAgent(); userAgent != "" {
+			if len(userAgent) > maxUserAgentLength {
+				userAgent = strings.ToValidUTF8(userAgent[:maxUserAgentLength], "")
+			}
+			r = r.WithContext(sysContext.WithUserAgent(r.Context(), userAgent))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
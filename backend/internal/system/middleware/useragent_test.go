@@ -0,0 +1,42 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+func TestUserAgentMiddleware(t *testing.T) {
+	testCases := []struct {
+		name      string
+		userAgent string
+		expected  string
+	}{
+		{"user agent present", "Mozilla/5.0 (X11; Linux x86_64)", "Mozilla/5.0 (X11; Linux x86_64)"},
+		{"user agent absent", "", ""},
+		{"user agent truncated", strings.Repeat("a", maxUserAgentLength+10), strings.Repeat("a", maxUserAgentLength)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual string
+			handler := UserAgentMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actual = sysContext.GetUserAgent(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			req.Header.Set("User-Agent", tc.userAgent)
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if actual != tc.expected {
+				t.Errorf("Expected user agent %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
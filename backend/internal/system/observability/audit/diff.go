@@ -0,0 +1,132 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// RedactedValue replaces the value of every sensitive field in an audit diff.
+const RedactedValue = "[REDACTED]"
+
+// valueKey holds a snapshot that does not serialize to a JSON object.
+const valueKey = "value"
+
+// sensitiveKeyFragments lists the normalized field name fragments whose values are never recorded.
+var sensitiveKeyFragments = []string{
+	"secret",
+	"password",
+	"passphrase",
+	"credential",
+	"privatekey",
+	"apikey",
+}
+
+// Diff returns the top-level fields that differ between before and after, each as a map with
+// "before" and "after" entries. Both snapshots are compared in their JSON form, so any value that
+// can be marshaled is accepted and nil stands for an absent resource. Sensitive fields are
+// reported as changed but their values are replaced with RedactedValue.
+func Diff(before, after interface{}) map[string]interface{} {
+	beforeFields := toFields(before)
+	afterFields := toFields(after)
+
+	changes := make(map[string]interface{})
+	for key, beforeValue := range beforeFields {
+		afterValue := afterFields[key]
+		if !reflect.DeepEqual(beforeValue, afterValue) {
+			changes[key] = changeOf(key, beforeValue, afterValue)
+		}
+	}
+	for key, afterValue := range afterFields {
+		if _, seen := beforeFields[key]; !seen {
+			changes[key] = changeOf(key, nil, afterValue)
+		}
+	}
+	return changes
+}
+
+// changeOf builds the redacted before/after pair for a single field.
+func changeOf(key string, before, after interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"before": redactField(key, before),
+		"after":  redactField(key, after),
+	}
+}
+
+// toFields converts a snapshot to its JSON field map.
+func toFields(snapshot interface{}) map[string]interface{} {
+	if snapshot == nil {
+		return map[string]interface{}{}
+	}
+	if v := reflect.ValueOf(snapshot); v.Kind() == reflect.Ptr && v.IsNil() {
+		return map[string]interface{}{}
+	}
+
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return map[string]interface{}{}
+	}
+	if fields, ok := decoded.(map[string]interface{}); ok {
+		return fields
+	}
+	return map[string]interface{}{valueKey: decoded}
+}
+
+// redactField redacts the value of a field, recursing into nested objects and arrays. Boolean
+// flags such as isSecret describe a field rather than hold a secret and are kept.
+func redactField(key string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	if _, isFlag := value.(bool); isFlag {
+		return value
+	}
+	if isSensitiveKey(key) {
+		return RedactedValue
+	}
+	return redactValue(value)
+}
+
+// redactValue redacts sensitive fields nested in a decoded JSON value.
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		// Properties flagged with isSecret carry their secret in the value field.
+		secretProperty, _ := v["isSecret"].(bool)
+		redacted := make(map[string]interface{}, len(v))
+		for key, nested := range v {
+			if secretProperty && key == valueKey && nested != nil {
+				redacted[key] = RedactedValue
+				continue
+			}
+			redacted[key] = redactField(key, nested)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, nested := range v {
+			redacted[i] = redactValue(nested)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// isSensitiveKey reports whether a field name identifies a secret.
+func isSensitiveKey(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+	return false
+}
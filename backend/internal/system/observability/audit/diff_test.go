@@ -0,0 +1,110 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DiffTestSuite struct {
+	suite.Suite
+}
+
+func TestDiffTestSuite(t *testing.T) {
+	suite.Run(t, new(DiffTestSuite))
+}
+
+type diffTestApp struct {
+	Name         string            `json:"name"`
+	ClientSecret string            `json:"clientSecret,omitempty"`
+	Properties   []diffTestProp    `json:"properties,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+}
+
+type diffTestProp struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	IsSecret bool   `json:"isSecret"`
+}
+
+func (s *DiffTestSuite) TestDiff_ReportsChangedFieldsOnly() {
+	before := diffTestApp{Name: "app", Metadata: map[string]string{"env": "dev"}}
+	after := diffTestApp{Name: "app", Metadata: map[string]string{"env": "prod"}}
+
+	changes := Diff(before, after)
+
+	s.Equal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"before": map[string]interface{}{"env": "dev"},
+			"after":  map[string]interface{}{"env": "prod"},
+		},
+	}, changes)
+}
+
+func (s *DiffTestSuite) TestDiff_RedactsSecretFields() {
+	before := &diffTestApp{Name: "app", ClientSecret: "old-secret"}
+	after := &diffTestApp{Name: "app", ClientSecret: "new-secret"}
+
+	changes := Diff(before, after)
+
+	s.Equal(map[string]interface{}{
+		"clientSecret": map[string]interface{}{"before": RedactedValue, "after": RedactedValue},
+	}, changes)
+}
+
+func (s *DiffTestSuite) TestDiff_RedactsNestedSecrets() {
+	after := map[string]interface{}{
+		"attributes": map[string]interface{}{"email": "a@example.com", "password": "pw"},
+		"properties": []diffTestProp{
+			{Name: "client_id", Value: "cid"},
+			{Name: "client_secret", Value: "shh", IsSecret: true},
+		},
+		"credentials": map[string]interface{}{"hash": "x"},
+	}
+
+	changes := Diff(nil, after)
+
+	s.Equal(map[string]interface{}{"email": "a@example.com", "password": RedactedValue},
+		changes["attributes"].(map[string]interface{})["after"])
+	s.Equal([]interface{}{
+		map[string]interface{}{"name": "client_id", "value": "cid", "isSecret": false},
+		map[string]interface{}{"name": "client_secret", "value": RedactedValue, "isSecret": true},
+	}, changes["properties"].(map[string]interface{})["after"])
+	s.Equal(RedactedValue, changes["credentials"].(map[string]interface{})["after"])
+}
+
+func (s *DiffTestSuite) TestDiff_Deletion() {
+	var nilApp *diffTestApp
+	changes := Diff(&diffTestApp{Name: "app"}, nilApp)
+
+	s.Equal(map[string]interface{}{
+		"name": map[string]interface{}{"before": "app", "after": nil},
+	}, changes)
+}
+
+func (s *DiffTestSuite) TestDiff_NonObjectSnapshot() {
+	changes := Diff([]string{"a"}, []string{"a", "b"})
+
+	s.Equal(map[string]interface{}{
+		"value": map[string]interface{}{
+			"before": []interface{}{"a"},
+			"after":  []interface{}{"a", "b"},
+		},
+	}, changes)
+}
+
+func (s *DiffTestSuite) TestDiff_UnmarshalableSnapshot() {
+	s.Empty(Diff(nil, map[string]interface{}{"fn": func() {}}))
+}
+
+func (s *DiffTestSuite) TestIsSensitiveKey() {
+	for _, key := range []string{"clientSecret", "client_secret", "PASSWORD", "credentials", "privateKey", "api-key"} {
+		s.True(isSensitiveKey(key), key)
+	}
+	for _, key := range []string{"name", "clientId", "redirectUris", "tokenEndpointAuthMethod"} {
+		s.False(isSensitiveKey(key), key)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit builds administrative audit events for the management APIs and publishes them
+// through the observability pipeline.
+package audit
+
+import (
+	"context"
+
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const (
+	// ActorSystem is recorded as the actor for changes made without an authenticated subject,
+	// such as declarative resources loaded at startup.
+	ActorSystem = "system"
+	// ActorRuntime is recorded as the actor for changes made by an internal runtime caller,
+	// such as a registration flow creating a user.
+	ActorRuntime = "runtime"
+)
+
+// Operation identifies the kind of change recorded by an audit event.
+type Operation string
+
+const (
+	// OperationCreate records the creation of a resource.
+	OperationCreate Operation = "create"
+	// OperationUpdate records a modification of an existing resource.
+	OperationUpdate Operation = "update"
+	// OperationDelete records the deletion of a resource.
+	OperationDelete Operation = "delete"
+)
+
+// Target type constants identify the kind of resource an audit event refers to.
+const (
+	TargetUser             = "user"
+	TargetGroup            = "group"
+	TargetRole             = "role"
+	TargetOrganizationUnit = "organization_unit"
+	TargetApplication      = "application"
+	TargetIdentityProvider = "identity_provider"
+	TargetFlow             = "flow"
+	TargetResourceServer   = "resource_server"
+	TargetResource         = "resource"
+	TargetAction           = "action"
+	TargetServerConfig     = "server_config"
+	TargetWebhook          = "webhook"
+	TargetSSFStream        = "ssf_stream"
+	TargetUserImportJob    = "user_import_job"
+	TargetSession          = "session"
+)
+
+// Entry describes a single administrative change.
+type Entry struct {
+	// Operation is the kind of change.
+	Operation Operation
+	// TargetType is the kind of resource that changed, e.g. TargetUser.
+	TargetType string
+	// TargetID identifies the resource that changed.
+	TargetID string
+	// Action names the change. Defaults to "<TargetType>.<Operation>" when empty.
+	Action string
+	// Before is the resource state before the change. Nil for creations.
+	Before interface{}
+	// After is the resource state after the change. Nil for deletions.
+	After interface{}
+}
+
+// Recorder publishes administrative audit events. A nil Recorder, or one created with a nil or
+// disabled provider, drops every entry.
+type Recorder struct {
+	provider providers.ObservabilityProvider
+}
+
+// NewRecorder creates a Recorder that publishes through the given observability provider.
+func NewRecorder(provider providers.ObservabilityProvider) *Recorder {
+	return &Recorder{provider: provider}
+}
+
+// IsEnabled reports whether recorded entries are published. Callers use it to skip loading
+// before-snapshots that would otherwise be discarded.
+func (r *Recorder) IsEnabled() bool {
+	return r != nil && r.provider != nil && r.provider.IsEnabled()
+}
+
+// Created records the creation of a resource.
+func (r *Recorder) Created(ctx context.Context, targetType, targetID string, after interface{}) {
+	r.Record(ctx, Entry{Operation: OperationCreate, TargetType: targetType, TargetID: targetID, After: after})
+}
+
+// Updated records a modification of a resource.
+func (r *Recorder) Updated(ctx context.Context, targetType, targetID string, before, after interface{}) {
+	r.Record(ctx, Entry{
+		Operation:  OperationUpdate,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     before,
+		After:      after,
+	})
+}
+
+// Deleted records the deletion of a resource.
+func (r *Recorder) Deleted(ctx context.Context, targetType, targetID string, before interface{}) {
+	r.Record(ctx, Entry{Operation: OperationDelete, TargetType: targetType, TargetID: targetID, Before: before})
+}
+
+// Record publishes an audit event for the given entry.
+func (r *Recorder) Record(ctx context.Context, entry Entry) {
+	if !r.IsEnabled() {
+		return
+	}
+
+	action := entry.Action
+	if action == "" {
+		action = entry.TargetType + "." + string(entry.Operation)
+	}
+
+	correlationID := syscontext.GetTraceID(ctx)
+	evt := event.NewEvent(correlationID, string(eventTypeFor(entry.Operation)), event.ComponentManagement).
+		WithStatus(providers.StatusSuccess).
+		WithData(event.DataKey.Actor, resolveActor(ctx)).
+		WithData(event.DataKey.Action, action).
+		WithData(event.DataKey.TargetType, entry.TargetType).
+		WithData(event.DataKey.TargetID, entry.TargetID).
+		WithData(event.DataKey.Changes, Diff(entry.Before, entry.After)).
+		WithData(event.DataKey.CorrelationID, correlationID)
+	if ouID := security.GetOUID(ctx); ouID != "" {
+		evt.WithData(event.DataKey.ActorOUID, ouID)
+	}
+
+	r.provider.PublishEvent(ctx, evt)
+}
+
+// eventTypeFor maps an operation to its audit event type.
+func eventTypeFor(op Operation) providers.EventType {
+	switch op {
+	case OperationCreate:
+		return event.EventTypeAdminResourceCreated
+	case OperationDelete:
+		return event.EventTypeAdminResourceDeleted
+	default:
+		return event.EventTypeAdminResourceUpdated
+	}
+}
+
+// resolveActor returns the subject responsible for the change in the given context.
+func resolveActor(ctx context.Context) string {
+	if subject := security.GetSubject(ctx); subject != "" {
+		return subject
+	}
+	if security.IsRuntimeContext(ctx) {
+		return ActorRuntime
+	}
+	return ActorSystem
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/observabilityprovidermock"
+)
+
+type RecorderTestSuite struct {
+	suite.Suite
+	obsMock  *observabilityprovidermock.ObservabilityProviderMock
+	recorder *Recorder
+}
+
+func TestRecorderTestSuite(t *testing.T) {
+	suite.Run(t, new(RecorderTestSuite))
+}
+
+func (s *RecorderTestSuite) SetupTest() {
+	s.obsMock = observabilityprovidermock.NewObservabilityProviderMock(s.T())
+	s.recorder = NewRecorder(s.obsMock)
+}
+
+// capture enables the provider and returns a pointer to the next published event.
+func (s *RecorderTestSuite) capture() **providers.Event {
+	var published *providers.Event
+	s.obsMock.On("IsEnabled").Return(true)
+	s.obsMock.On("PublishEvent", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			published = args.Get(1).(*providers.Event)
+		}).Return()
+	return &published
+}
+
+func (s *RecorderTestSuite) TestCreated_PublishesAuditEvent() {
+	published := s.capture()
+	secCtx := security.NewSecurityContextForTest("admin-1", "ou-1", "tok", nil, nil)
+	ctx := security.WithSecurityContextTest(context.Background(), secCtx)
+	ctx = syscontext.WithTraceID(ctx, "trace-1")
+
+	s.recorder.Created(ctx, TargetUser, "user-1", map[string]interface{}{"type": "employee"})
+
+	evt := *published
+	s.Require().NotNil(evt)
+	s.Equal(string(event.EventTypeAdminResourceCreated), evt.Type)
+	s.Equal(event.ComponentManagement, evt.Component)
+	s.Equal(providers.StatusSuccess, evt.Status)
+	s.Equal("trace-1", evt.TraceID)
+	s.Equal("admin-1", evt.Data[event.DataKey.Actor])
+	s.Equal("ou-1", evt.Data[event.DataKey.ActorOUID])
+	s.Equal("user.create", evt.Data[event.DataKey.Action])
+	s.Equal(TargetUser, evt.Data[event.DataKey.TargetType])
+	s.Equal("user-1", evt.Data[event.DataKey.TargetID])
+	s.Equal("trace-1", evt.Data[event.DataKey.CorrelationID])
+	s.Equal(map[string]interface{}{
+		"type": map[string]interface{}{"before": nil, "after": "employee"},
+	}, evt.Data[event.DataKey.Changes])
+
+	category, err := event.GetCategory(providers.EventType(evt.Type))
+	s.NoError(err)
+	s.Equal(event.CategoryAudit, category)
+}
+
+func (s *RecorderTestSuite) TestUpdated_UsesCustomAction() {
+	published := s.capture()
+
+	s.recorder.Record(context.Background(), Entry{
+		Operation:  OperationUpdate,
+		TargetType: TargetRole,
+		TargetID:   "role-1",
+		Action:     "role.assignments.add",
+		After:      map[string]interface{}{"added": []string{"user-1"}},
+	})
+
+	evt := *published
+	s.Require().NotNil(evt)
+	s.Equal(string(event.EventTypeAdminResourceUpdated), evt.Type)
+	s.Equal("role.assignments.add", evt.Data[event.DataKey.Action])
+	s.Equal(ActorSystem, evt.Data[event.DataKey.Actor])
+	s.NotContains(evt.Data, event.DataKey.ActorOUID)
+}
+
+func (s *RecorderTestSuite) TestDeleted_RuntimeActor() {
+	published := s.capture()
+
+	s.recorder.Deleted(security.WithRuntimeContext(context.Background()), TargetGroup, "group-1", nil)
+
+	evt := *published
+	s.Require().NotNil(evt)
+	s.Equal(string(event.EventTypeAdminResourceDeleted), evt.Type)
+	s.Equal(ActorRuntime, evt.Data[event.DataKey.Actor])
+	s.Equal("group.delete", evt.Data[event.DataKey.Action])
+}
+
+func (s *RecorderTestSuite) TestRecord_DisabledProvider() {
+	s.obsMock.On("IsEnabled").Return(false)
+
+	s.recorder.Created(context.Background(), TargetUser, "user-1", nil)
+
+	s.obsMock.AssertNotCalled(s.T(), "PublishEvent", mock.Anything, mock.Anything)
+}
+
+func (s *RecorderTestSuite) TestRecord_NilRecorderAndProvider() {
+	var nilRecorder *Recorder
+	s.NotPanics(func() {
+		s.False(nilRecorder.IsEnabled())
+		nilRecorder.Created(context.Background(), TargetUser, "user-1", nil)
+		NewRecorder(nil).Deleted(context.Background(), TargetUser, "user-1", nil)
+	})
+}
@@ -36,6 +36,9 @@ const (
 	// CategoryFlows groups all flow orchestration events for tracing end-to-end flows.
 	CategoryFlows EventCategory = "observability.flows"
 
+	// CategoryAudit groups administrative audit events emitted by the management APIs.
+	CategoryAudit EventCategory = "observability.audit"
+
 	// CategoryAll is a special category that matches all events.
 	// Subscribers to this category receive all events regardless of type.
 	CategoryAll EventCategory = "observability.all"
@@ -71,6 +74,11 @@ var eventTypeToCategory = map[providers.EventType]EventCategory{
 	EventTypeFlowUserInputRequired:      CategoryFlows,
 	EventTypeFlowCompleted:              CategoryFlows,
 	EventTypeFlowFailed:                 CategoryFlows,
+
+	// Audit events
+	EventTypeAdminResourceCreated: CategoryAudit,
+	EventTypeAdminResourceUpdated: CategoryAudit,
+	EventTypeAdminResourceDeleted: CategoryAudit,
 }
 
 // GetCategory returns the category for a given event type.
@@ -90,6 +98,7 @@ func GetAllCategories() []EventCategory {
 		CategoryAuthentication,
 		CategoryAuthorization,
 		CategoryFlows,
+		CategoryAudit,
 	}
 }
 
@@ -51,6 +51,23 @@ func TestGetCategory(t *testing.T) {
 			eventType:    EventTypeFlowNodeExecutionStarted,
 			wantCategory: CategoryFlows,
 		},
+
+		// Audit events
+		{
+			name:         "admin resource created",
+			eventType:    EventTypeAdminResourceCreated,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "admin resource updated",
+			eventType:    EventTypeAdminResourceUpdated,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "admin resource deleted",
+			eventType:    EventTypeAdminResourceDeleted,
+			wantCategory: CategoryAudit,
+		},
 	}
 
 	for _, tt := range tests {
@@ -157,6 +174,7 @@ func TestGetAllCategories(t *testing.T) {
 		CategoryAuthentication: false,
 		CategoryAuthorization:  false,
 		CategoryFlows:          false,
+		CategoryAudit:          false,
 	}
 
 	for _, cat := range categories {
@@ -201,6 +219,11 @@ func TestIsValidCategory(t *testing.T) {
 			category: CategoryFlows,
 			want:     true,
 		},
+		{
+			name:     "valid audit category",
+			category: CategoryAudit,
+			want:     true,
+		},
 		{
 			name:     "valid CategoryAll",
 			category: CategoryAll,
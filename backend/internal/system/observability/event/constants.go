@@ -13,6 +13,9 @@ const (
 
 	// ComponentAuthHandler identifies events from authentication handlers.
 	ComponentAuthHandler = "AuthHandler"
+
+	// ComponentManagement identifies events from the administrative management services.
+	ComponentManagement = "Management"
 )
 
 // Authentication and Authorization Event Types
@@ -58,3 +61,15 @@ const (
 	// EventTypeFlowFailed is triggered when flow execution fails.
 	EventTypeFlowFailed providers.EventType = "FLOW_FAILED"
 )
+
+// Administrative Audit Event Types
+const (
+	// EventTypeAdminResourceCreated is triggered when a management API creates a resource.
+	EventTypeAdminResourceCreated providers.EventType = "ADMIN_RESOURCE_CREATED"
+
+	// EventTypeAdminResourceUpdated is triggered when a management API modifies a resource.
+	EventTypeAdminResourceUpdated providers.EventType = "ADMIN_RESOURCE_UPDATED"
+
+	// EventTypeAdminResourceDeleted is triggered when a management API deletes a resource.
+	EventTypeAdminResourceDeleted providers.EventType = "ADMIN_RESOURCE_DELETED"
+)
@@ -37,6 +37,15 @@ var DataKey = struct {
 	JTI              string
 	RevocationReason string
 
+	// Audit Keys
+	Actor         string
+	ActorOUID     string
+	Action        string
+	TargetType    string
+	TargetID      string
+	Changes       string
+	CorrelationID string
+
 	// Event Metadata Keys
 	Message     string
 	Error       string
@@ -74,6 +83,15 @@ var DataKey = struct {
 	JTI:              "jti",
 	RevocationReason: "revocation_reason",
 
+	// Audit Keys
+	Actor:         "actor",
+	ActorOUID:     "actor_ou_id",
+	Action:        "action",
+	TargetType:    "target_type",
+	TargetID:      "target_id",
+	Changes:       "changes",
+	CorrelationID: "correlation_id",
+
 	// Event Metadata Keys
 	Message:     "message",
 	Error:       "error",
@@ -17,6 +17,7 @@ var publicPaths = []string{
 	"/flow/execute/**",
 	"/flow/meta",
 	"/oauth2/**",
+	"/saml2/**",
 	// OpenID4VP wallet- and RP-facing endpoints are public; management endpoints
 	// (e.g. /openid4vp/presentation-definitions) are deliberately excluded.
 	"/openid4vp/request",
@@ -34,6 +35,7 @@ var publicPaths = []string{
 	"/.well-known/openid-credential-issuer",
 	"/.well-known/oauth-authorization-server/**",
 	"/.well-known/oauth-protected-resource",
+	"/.well-known/ssf-configuration",
 	"/gate/**",
 	"/console/**",
 	"/error/**",
@@ -95,6 +97,12 @@ const (
 	ActionDeleteUser Action = "user:delete"
 	// ActionListUsers lists users.
 	ActionListUsers Action = "user:list"
+	// ActionImportUsers runs and manages bulk user import jobs. It is not mapped to a permission, so it
+	// requires the root permission.
+	ActionImportUsers Action = "user:import"
+	// ActionExportUsers exports users in bulk. It is not mapped to a permission, so it requires the root
+	// permission.
+	ActionExportUsers Action = "user:export"
 
 	// ActionCreateGroup creates a new group.
 	ActionCreateGroup Action = "group:create"
@@ -128,6 +136,33 @@ const (
 	ActionDeleteAgentType Action = "agenttype:delete"
 	// ActionListAgentTypes lists agent types.
 	ActionListAgentTypes Action = "agenttype:list"
+
+	// ActionListAuditEvents searches the stored audit events.
+	ActionListAuditEvents Action = "audit:list"
+
+	// ActionCreateWebhook creates a new webhook.
+	ActionCreateWebhook Action = "webhook:create"
+	// ActionReadWebhook reads a webhook and its deliveries.
+	ActionReadWebhook Action = "webhook:read"
+	// ActionUpdateWebhook updates a webhook or rotates its signing secret.
+	ActionUpdateWebhook Action = "webhook:update"
+	// ActionDeleteWebhook deletes a webhook.
+	ActionDeleteWebhook Action = "webhook:delete"
+	// ActionListWebhooks lists webhooks.
+	ActionListWebhooks Action = "webhook:list"
+	// ActionRedeliverWebhook queues a webhook delivery for redelivery.
+	ActionRedeliverWebhook Action = "webhook:redeliver"
+
+	// ActionCreateSSFStream creates a new Shared Signals stream.
+	ActionCreateSSFStream Action = "ssf:create"
+	// ActionReadSSFStream reads a Shared Signals stream and its status.
+	ActionReadSSFStream Action = "ssf:read"
+	// ActionUpdateSSFStream updates a Shared Signals stream, changes its status or requests verification.
+	ActionUpdateSSFStream Action = "ssf:update"
+	// ActionDeleteSSFStream deletes a Shared Signals stream.
+	ActionDeleteSSFStream Action = "ssf:delete"
+	// ActionPollSSFStream polls and acknowledges the events of a Shared Signals stream.
+	ActionPollSSFStream Action = "ssf:poll"
 )
 
 // ---- Permissions ----
@@ -146,6 +181,12 @@ type SystemPermissions struct {
 	UserTypeView  string
 	AgentType     string
 	AgentTypeView string
+	Audit         string
+	AuditView     string
+	Webhook       string
+	WebhookView   string
+	SSF           string
+	SSFView       string
 }
 
 // sysPerms holds the active system permissions, initialized by InitSystemPermissions.
@@ -179,6 +220,12 @@ func InitSystemPermissions(handle string) {
 		UserTypeView:  buildPermission(handle, "system", "usertype", "view"),
 		AgentType:     buildPermission(handle, "system", "agenttype"),
 		AgentTypeView: buildPermission(handle, "system", "agenttype", "view"),
+		Audit:         buildPermission(handle, "system", "audit"),
+		AuditView:     buildPermission(handle, "system", "audit", "view"),
+		Webhook:       buildPermission(handle, "system", "webhook"),
+		WebhookView:   buildPermission(handle, "system", "webhook", "view"),
+		SSF:           buildPermission(handle, "system", "ssf"),
+		SSFView:       buildPermission(handle, "system", "ssf", "view"),
 	}
 	sysPerms = p
 
@@ -218,6 +265,24 @@ func InitSystemPermissions(handle string) {
 		ActionUpdateAgentType: p.AgentType,
 		ActionDeleteAgentType: p.AgentType,
 		ActionListAgentTypes:  p.AgentTypeView,
+
+		// Audit actions.
+		ActionListAuditEvents: p.AuditView,
+
+		// Webhook actions.
+		ActionCreateWebhook:    p.Webhook,
+		ActionReadWebhook:      p.WebhookView,
+		ActionUpdateWebhook:    p.Webhook,
+		ActionDeleteWebhook:    p.Webhook,
+		ActionListWebhooks:     p.WebhookView,
+		ActionRedeliverWebhook: p.Webhook,
+
+		// Shared Signals actions.
+		ActionCreateSSFStream: p.SSF,
+		ActionReadSSFStream:   p.SSFView,
+		ActionUpdateSSFStream: p.SSF,
+		ActionDeleteSSFStream: p.SSF,
+		ActionPollSSFStream:   p.SSF,
 	}
 
 	apiPermissionEntries = []apiPermissionEntry{
@@ -228,6 +293,8 @@ func InitSystemPermissions(handle string) {
 		{"GET /users/me/**", ""},
 		{"PUT /users/me/**", ""},
 		{"POST /users/me/update-credentials", ""},
+		{"DELETE /users/me/sessions", ""},
+		{"DELETE /users/me/sessions/**", ""},
 		{"GET /register/passkey/**", ""},
 		{"POST /register/passkey/**", ""},
 
@@ -241,7 +308,13 @@ func InitSystemPermissions(handle string) {
 		{"PUT /organization-units/**", p.OU},
 		{"DELETE /organization-units/**", p.OU},
 
-		// User APIs.
+		// User APIs. Bulk import and export require the root permission and are listed before the
+		// wildcards that would otherwise grant them to user viewers.
+		{"GET /users/import-jobs", p.Root},
+		{"GET /users/import-jobs/**", p.Root},
+		{"POST /users/import-jobs", p.Root},
+		{"POST /users/import-jobs/**", p.Root},
+		{"GET /users/export", p.Root},
 		{"GET /users", p.UserView},
 		{"POST /users", p.User},
 		{"GET /users/**", p.UserView},
@@ -256,6 +329,29 @@ func InitSystemPermissions(handle string) {
 		{"PUT /groups/**", p.Group},
 		{"DELETE /groups/**", p.Group},
 
+		// SCIM 2.0 APIs. Searches are reads; discovery endpoints are open to any authenticated
+		// caller, and bulk requests span both users and groups.
+		{"POST /scim2/Users/.search", p.UserView},
+		{"GET /scim2/Users", p.UserView},
+		{"POST /scim2/Users", p.User},
+		{"GET /scim2/Users/**", p.UserView},
+		{"PUT /scim2/Users/**", p.User},
+		{"PATCH /scim2/Users/**", p.User},
+		{"DELETE /scim2/Users/**", p.User},
+		{"POST /scim2/Groups/.search", p.GroupView},
+		{"GET /scim2/Groups", p.GroupView},
+		{"POST /scim2/Groups", p.Group},
+		{"GET /scim2/Groups/**", p.GroupView},
+		{"PUT /scim2/Groups/**", p.Group},
+		{"PATCH /scim2/Groups/**", p.Group},
+		{"DELETE /scim2/Groups/**", p.Group},
+		{"GET /scim2/Schemas", ""},
+		{"GET /scim2/Schemas/**", ""},
+		{"GET /scim2/ResourceTypes", ""},
+		{"GET /scim2/ResourceTypes/**", ""},
+		{"GET /scim2/ServiceProviderConfig", ""},
+		{"POST /scim2/Bulk", p.Root},
+
 		// User type APIs.
 		{"GET /user-types", p.UserTypeView},
 		{"POST /user-types", p.UserType},
@@ -270,6 +366,27 @@ func InitSystemPermissions(handle string) {
 		{"PUT /agent-types/**", p.AgentType},
 		{"DELETE /agent-types/**", p.AgentType},
 
+		// Audit APIs.
+		{"GET /audit/events", p.AuditView},
+
+		// Webhook APIs.
+		{"GET /webhooks", p.WebhookView},
+		{"POST /webhooks", p.Webhook},
+		{"GET /webhooks/**", p.WebhookView},
+		{"POST /webhooks/**", p.Webhook},
+		{"PUT /webhooks/**", p.Webhook},
+		{"DELETE /webhooks/**", p.Webhook},
+
+		// Shared Signals APIs. Polling acknowledges events, so it needs the write permission.
+		{"GET /ssf/streams", p.SSFView},
+		{"POST /ssf/streams", p.SSF},
+		{"PUT /ssf/streams", p.SSF},
+		{"PATCH /ssf/streams", p.SSF},
+		{"DELETE /ssf/streams", p.SSF},
+		{"GET /ssf/streams/**", p.SSFView},
+		{"POST /ssf/streams/**", p.SSF},
+		{"POST /ssf/poll/**", p.SSF},
+
 		// Import APIs.
 		{"POST /import", p.Root},
 		{"POST /import/delete", p.Root},
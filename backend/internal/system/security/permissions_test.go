@@ -205,6 +205,8 @@ func (s *SecurityContextTestSuite) TestResolveActionPermission() {
 		{name: "UpdateUser", action: ActionUpdateUser, wantPerm: p.User},
 		{name: "DeleteUser", action: ActionDeleteUser, wantPerm: p.User},
 		{name: "ListUsers", action: ActionListUsers, wantPerm: p.UserView},
+		{name: "ImportUsers", action: ActionImportUsers, wantPerm: p.Root},
+		{name: "ExportUsers", action: ActionExportUsers, wantPerm: p.Root},
 
 		// Group actions.
 		{name: "CreateGroup", action: ActionCreateGroup, wantPerm: p.Group},
@@ -273,6 +275,17 @@ func TestInitSystemPermissions_EmptyHandle(t *testing.T) {
 	assert.Equal(t, "system:usertype:view", p.UserTypeView)
 	assert.Equal(t, "system:agenttype", p.AgentType)
 	assert.Equal(t, "system:agenttype:view", p.AgentTypeView)
+	assert.Equal(t, "system:audit", p.Audit)
+	assert.Equal(t, "system:audit:view", p.AuditView)
+	assert.Equal(t, "system:audit:view", ResolveActionPermission(ActionListAuditEvents))
+	assert.Equal(t, "system:webhook", p.Webhook)
+	assert.Equal(t, "system:webhook:view", p.WebhookView)
+	assert.Equal(t, "system:webhook", ResolveActionPermission(ActionRedeliverWebhook))
+	assert.Equal(t, "system:webhook:view", ResolveActionPermission(ActionListWebhooks))
+	assert.Equal(t, "system:ssf", p.SSF)
+	assert.Equal(t, "system:ssf:view", p.SSFView)
+	assert.Equal(t, "system:ssf", ResolveActionPermission(ActionPollSSFStream))
+	assert.Equal(t, "system:ssf:view", ResolveActionPermission(ActionReadSSFStream))
 }
 
 func TestInitSystemPermissions_NonEmptyHandle(t *testing.T) {
@@ -291,6 +304,12 @@ func TestInitSystemPermissions_NonEmptyHandle(t *testing.T) {
 	assert.Equal(t, "mgmt:system:usertype:view", p.UserTypeView)
 	assert.Equal(t, "mgmt:system:agenttype", p.AgentType)
 	assert.Equal(t, "mgmt:system:agenttype:view", p.AgentTypeView)
+	assert.Equal(t, "mgmt:system:audit", p.Audit)
+	assert.Equal(t, "mgmt:system:audit:view", p.AuditView)
+	assert.Equal(t, "mgmt:system:webhook", p.Webhook)
+	assert.Equal(t, "mgmt:system:webhook:view", p.WebhookView)
+	assert.Equal(t, "mgmt:system:ssf", p.SSF)
+	assert.Equal(t, "mgmt:system:ssf:view", p.SSFView)
 
 	// Restore default for other tests.
 	InitSystemPermissions("")
@@ -397,11 +416,41 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 
 		// ---- Self-service wins over parent prefix ----
 		{name: "GET /users/me wins over /users/ prefix", method: http.MethodGet, path: "/users/me", wantPerm: ""},
+		{
+			name:   "DELETE /users/me/sessions self-service",
+			method: http.MethodDelete, path: "/users/me/sessions", wantPerm: "",
+		},
+		{
+			name:   "DELETE /users/me/sessions/{id} self-service",
+			method: http.MethodDelete, path: "/users/me/sessions/abc", wantPerm: "",
+		},
+		{
+			name:   "DELETE /users/{id}/sessions requires user permission",
+			method: http.MethodDelete, path: "/users/u1/sessions", wantPerm: p.User,
+		},
 		{
 			name:   "GET /users/me/profile wins over /users/ prefix",
 			method: http.MethodGet, path: "/users/me/profile", wantPerm: "",
 		},
 
+		// ---- Bulk user import and export win over the /users/ prefix ----
+		{
+			name:   "GET /users/import-jobs requires system",
+			method: http.MethodGet, path: "/users/import-jobs", wantPerm: p.Root,
+		},
+		{
+			name:   "GET /users/import-jobs/{id}/errors requires system",
+			method: http.MethodGet, path: "/users/import-jobs/job-1/errors", wantPerm: p.Root,
+		},
+		{
+			name:   "POST /users/import-jobs/{id}/cancel requires system",
+			method: http.MethodPost, path: "/users/import-jobs/job-1/cancel", wantPerm: p.Root,
+		},
+		{
+			name:   "GET /users/export requires system",
+			method: http.MethodGet, path: "/users/export", wantPerm: p.Root,
+		},
+
 		// ---- OU tree paths ----
 		{
 			name:   "GET /organization-units/tree",
@@ -0,0 +1,59 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type NetUtilTestSuite struct {
+	suite.Suite
+}
+
+func TestNetUtilTestSuite(t *testing.T) {
+	suite.Run(t, new(NetUtilTestSuite))
+}
+
+func (suite *NetUtilTestSuite) TestParseIPNets() {
+	nets, err := ParseIPNets([]string{"10.0.0.0/8", "192.0.2.10", "2001:db8::1"})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), nets, 3)
+	assert.True(suite.T(), nets[0].Contains(net.ParseIP("10.1.2.3")))
+	assert.True(suite.T(), nets[1].Contains(net.ParseIP("192.0.2.10")))
+	assert.False(suite.T(), nets[1].Contains(net.ParseIP("192.0.2.11")))
+	assert.True(suite.T(), nets[2].Contains(net.ParseIP("2001:db8::1")))
+	assert.False(suite.T(), nets[2].Contains(net.ParseIP("2001:db8::2")))
+}
+
+func (suite *NetUtilTestSuite) TestParseIPNets_Invalid() {
+	_, err := ParseIPNets([]string{"not-an-address"})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *NetUtilTestSuite) TestIsRemoteAddrIn() {
+	nets, err := ParseIPNets([]string{"10.0.0.0/8"})
+	require.NoError(suite.T(), err)
+
+	testCases := []struct {
+		remoteAddr string
+		expected   bool
+	}{
+		{"10.0.0.1:5000", true},
+		{"10.0.0.1", true},
+		{"192.0.2.10:5000", false},
+		{"pipe", false},
+	}
+	for _, tc := range testCases {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tc.remoteAddr
+		assert.Equal(suite.T(), tc.expected, IsRemoteAddrIn(req, nets), tc.remoteAddr)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmldsig
+
+import (
+	"sort"
+	"strings"
+)
+
+// Canonicalize renders the subtree rooted at el using Exclusive XML Canonicalization 1.0 without
+// comments. inclusivePrefixes lists prefixes (with "#default" for the default namespace) that are
+// handled per inclusive canonicalization, as named by an InclusiveNamespaces PrefixList.
+func Canonicalize(el *Element, inclusivePrefixes []string) []byte {
+	return canonicalize(el, inclusivePrefixes, nil)
+}
+
+// canonicalize renders el, omitting the skip element wherever it appears in the subtree.
+func canonicalize(el *Element, inclusivePrefixes []string, skip *Element) []byte {
+	inclusive := make(map[string]bool, len(inclusivePrefixes))
+	for _, p := range inclusivePrefixes {
+		if p == "#default" {
+			p = ""
+		}
+		inclusive[p] = true
+	}
+
+	var sb strings.Builder
+	c := &canonicalizer{out: &sb, inclusive: inclusive, skip: skip}
+	c.writeElement(el, map[string]string{"": ""})
+	return []byte(sb.String())
+}
+
+type canonicalizer struct {
+	out       *strings.Builder
+	inclusive map[string]bool
+	skip      *Element
+}
+
+type nsDecl struct {
+	prefix string
+	uri    string
+}
+
+type qualifiedAttr struct {
+	uri   string
+	attr  Attr
+	qname string
+}
+
+func (c *canonicalizer) writeElement(el *Element, rendered map[string]string) {
+	decls := c.namespacesToRender(el, rendered)
+
+	scope := rendered
+	if len(decls) > 0 {
+		scope = make(map[string]string, len(rendered)+len(decls))
+		for k, v := range rendered {
+			scope[k] = v
+		}
+		for _, d := range decls {
+			scope[d.prefix] = d.uri
+		}
+	}
+
+	c.out.WriteByte('<')
+	c.out.WriteString(qname(el.Prefix, el.Local))
+	for _, d := range decls {
+		if d.prefix == "" {
+			c.out.WriteString(` xmlns="`)
+		} else {
+			c.out.WriteString(" xmlns:")
+			c.out.WriteString(d.prefix)
+			c.out.WriteString(`="`)
+		}
+		c.out.WriteString(escapeAttr(d.uri))
+		c.out.WriteByte('"')
+	}
+	for _, a := range sortedAttrs(el) {
+		c.out.WriteByte(' ')
+		c.out.WriteString(a.qname)
+		c.out.WriteString(`="`)
+		c.out.WriteString(escapeAttr(a.attr.Value))
+		c.out.WriteByte('"')
+	}
+	c.out.WriteByte('>')
+
+	for _, n := range el.Children {
+		switch child := n.(type) {
+		case *Element:
+			if child == c.skip {
+				continue
+			}
+			c.writeElement(child, scope)
+		case CharData:
+			c.out.WriteString(escapeText(string(child)))
+		}
+	}
+
+	c.out.WriteString("</")
+	c.out.WriteString(qname(el.Prefix, el.Local))
+	c.out.WriteByte('>')
+}
+
+// namespacesToRender returns the declarations that must appear on el: visibly utilized prefixes
+// and any inclusive prefixes in scope, in each case only when the nearest output ancestor has not
+// already rendered the same binding.
+func (c *canonicalizer) namespacesToRender(el *Element, rendered map[string]string) []nsDecl {
+	candidates := map[string]bool{el.Prefix: true}
+	for _, a := range el.Attrs {
+		if a.Prefix != "" {
+			candidates[a.Prefix] = true
+		}
+	}
+	for prefix := range c.inclusive {
+		if _, ok := el.lookupNamespace(prefix); ok {
+			candidates[prefix] = true
+		}
+	}
+
+	var decls []nsDecl
+	for prefix := range candidates {
+		if prefix == "xml" {
+			continue
+		}
+		uri, _ := el.lookupNamespace(prefix)
+		current, ok := rendered[prefix]
+		if ok && current == uri {
+			continue
+		}
+		if !ok && uri == "" {
+			continue
+		}
+		decls = append(decls, nsDecl{prefix: prefix, uri: uri})
+	}
+	sort.Slice(decls, func(i, j int) bool { return decls[i].prefix < decls[j].prefix })
+	return decls
+}
+
+// sortedAttrs orders attributes by namespace URI and then local name.
+func sortedAttrs(el *Element) []qualifiedAttr {
+	attrs := make([]qualifiedAttr, 0, len(el.Attrs))
+	for _, a := range el.Attrs {
+		uri := ""
+		if a.Prefix != "" {
+			uri, _ = el.lookupNamespace(a.Prefix)
+		}
+		attrs = append(attrs, qualifiedAttr{uri: uri, attr: a, qname: qname(a.Prefix, a.Local)})
+	}
+	sort.SliceStable(attrs, func(i, j int) bool {
+		if attrs[i].uri != attrs[j].uri {
+			return attrs[i].uri < attrs[j].uri
+		}
+		return attrs[i].attr.Local < attrs[j].attr.Local
+	})
+	return attrs
+}
+
+func qname(prefix, local string) string {
+	if prefix == "" {
+		return local
+	}
+	return prefix + ":" + local
+}
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+var attrEscaper = strings.NewReplacer(
+	"&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAttr(s string) string {
+	return attrEscaper.Replace(s)
+}
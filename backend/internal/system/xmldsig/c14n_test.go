@@ -0,0 +1,67 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmldsig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type C14NTestSuite struct {
+	suite.Suite
+}
+
+func TestC14NTestSuite(t *testing.T) {
+	suite.Run(t, new(C14NTestSuite))
+}
+
+func (s *C14NTestSuite) canonicalChild(doc string, inclusive []string) string {
+	root, err := Parse([]byte(doc))
+	s.Require().NoError(err)
+	return string(Canonicalize(root.ChildElements()[0], inclusive))
+}
+
+// TestCanonicalize_ExclusiveExample mirrors the example in section 2.2 of the Exclusive XML
+// Canonicalization recommendation.
+func (s *C14NTestSuite) TestCanonicalize_ExclusiveExample() {
+	doc := `<n0:local xmlns:n0="foo:bar" xmlns:n3="ftp://example.org">` +
+		`<n1:elem2 xmlns:n1="http://example.net" xml:lang="en"><n3:stuff xmlns:n3="ftp://example.org"/></n1:elem2>` +
+		`</n0:local>`
+
+	s.Equal(`<n1:elem2 xmlns:n1="http://example.net" xml:lang="en">`+
+		`<n3:stuff xmlns:n3="ftp://example.org"></n3:stuff></n1:elem2>`, s.canonicalChild(doc, nil))
+}
+
+func (s *C14NTestSuite) TestCanonicalize_SortsAttributesAndNamespaces() {
+	doc := `<r xmlns:b="urn:b" xmlns:a="urn:a"><e z="1" b:y="2" a:x="3" a="4"/></r>`
+
+	s.Equal(`<e xmlns:a="urn:a" xmlns:b="urn:b" a="4" z="1" a:x="3" b:y="2"></e>`,
+		s.canonicalChild(doc, nil))
+}
+
+func (s *C14NTestSuite) TestCanonicalize_EscapesTextAndAttributes() {
+	doc := "<r><e a=\"&quot;&lt;&amp;&#9;&#10;&#13;\">&lt;&gt;&amp;&#13;\"</e></r>"
+
+	s.Equal("<e a=\"&quot;&lt;&amp;&#x9;&#xA;&#xD;\">&lt;&gt;&amp;&#xD;\"</e>", s.canonicalChild(doc, nil))
+}
+
+func (s *C14NTestSuite) TestCanonicalize_DefaultNamespace() {
+	doc := `<r xmlns="urn:d"><e><f xmlns=""/></e></r>`
+
+	s.Equal(`<e xmlns="urn:d"><f xmlns=""></f></e>`, s.canonicalChild(doc, nil))
+}
+
+func (s *C14NTestSuite) TestCanonicalize_OmitsUnusedNamespaces() {
+	doc := `<r xmlns:unused="urn:u" xmlns:p="urn:p"><p:e><p:f/></p:e></r>`
+
+	s.Equal(`<p:e xmlns:p="urn:p"><p:f></p:f></p:e>`, s.canonicalChild(doc, nil))
+}
+
+func (s *C14NTestSuite) TestCanonicalize_InclusivePrefixList() {
+	doc := `<r xmlns:xs="urn:xs" xmlns="urn:d"><e>xs:string</e></r>`
+
+	s.Equal(`<e xmlns="urn:d" xmlns:xs="urn:xs">xs:string</e>`, s.canonicalChild(doc, []string{"xs", "#default"}))
+	s.Equal(`<e xmlns="urn:d">xs:string</e>`, s.canonicalChild(doc, nil))
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmldsig
+
+import (
+	"crypto"
+	// Register the digest implementations referenced by digestMethods.
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+const (
+	// Namespace is the XML Signature namespace.
+	Namespace = "http://www.w3.org/2000/09/xmldsig#"
+	// Prefix is the prefix used for XML Signature elements produced by this package.
+	Prefix = "ds"
+
+	// AlgorithmExcC14N identifies Exclusive XML Canonicalization 1.0 without comments.
+	AlgorithmExcC14N = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	// TransformEnveloped identifies the enveloped signature transform.
+	TransformEnveloped = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+
+	// DigestSHA256 identifies the SHA-256 digest method.
+	DigestSHA256 = "http://www.w3.org/2001/04/xmlenc#sha256"
+	// DigestSHA384 identifies the SHA-384 digest method.
+	DigestSHA384 = "http://www.w3.org/2001/04/xmldsig-more#sha384"
+	// DigestSHA512 identifies the SHA-512 digest method.
+	DigestSHA512 = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+// signatureMethods maps XML Signature method URIs to the JWA identifiers understood by the
+// runtime crypto provider. SHA-1 based methods are intentionally absent.
+var signatureMethods = map[string]string{
+	"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256":      "RS256",
+	"http://www.w3.org/2001/04/xmldsig-more#rsa-sha512":      "RS512",
+	"http://www.w3.org/2007/05/xmldsig-more#sha256-rsa-MGF1": "PS256",
+	"http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256":    "ES256",
+	"http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha384":    "ES384",
+	"http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha512":    "ES512",
+	"http://www.w3.org/2021/04/xmldsig-more#eddsa-ed25519":   "EdDSA",
+}
+
+// digestMethods maps XML Signature digest URIs to hash functions.
+var digestMethods = map[string]crypto.Hash{
+	DigestSHA256: crypto.SHA256,
+	DigestSHA384: crypto.SHA384,
+	DigestSHA512: crypto.SHA512,
+}
+
+// AlgorithmFromURI returns the JWA identifier for an XML Signature method URI.
+func AlgorithmFromURI(uri string) (string, error) {
+	alg, ok := signatureMethods[uri]
+	if !ok {
+		return "", ErrUnsupportedAlgorithm
+	}
+	return alg, nil
+}
+
+// SignatureMethodURI returns the XML Signature method URI for a JWA identifier.
+func SignatureMethodURI(alg string) (string, error) {
+	for uri, a := range signatureMethods {
+		if a == alg {
+			return uri, nil
+		}
+	}
+	return "", ErrUnsupportedAlgorithm
+}
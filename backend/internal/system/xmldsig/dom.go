@@ -0,0 +1,333 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmldsig
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// xmlNamespace is the namespace bound to the reserved "xml" prefix.
+	xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+	// maxDepth bounds element nesting when parsing untrusted documents.
+	maxDepth = 64
+)
+
+// Node is a child of an Element: either another *Element or CharData.
+type Node interface {
+	isNode()
+}
+
+// CharData is a text node.
+type CharData string
+
+func (CharData) isNode() {}
+
+// Attr is a non-namespace-declaration attribute. Prefix is empty for unqualified attributes.
+type Attr struct {
+	Prefix string
+	Local  string
+	Value  string
+}
+
+// Element is a minimal, prefix-preserving XML element. Comments and processing instructions are
+// discarded at parse time, which matches the "without comments" canonicalization variants.
+type Element struct {
+	Prefix     string
+	Local      string
+	Attrs      []Attr
+	Children   []Node
+	namespaces map[string]string
+	parent     *Element
+}
+
+func (*Element) isNode() {}
+
+// NewElement creates a detached element with the given prefix and local name.
+func NewElement(prefix, local string) *Element {
+	return &Element{Prefix: prefix, Local: local}
+}
+
+// Parse parses an XML document and returns its root element. Documents carrying a DTD are
+// rejected to rule out entity expansion attacks.
+func Parse(data []byte) (*Element, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = true
+
+	var root, current *Element
+	depth := 0
+	for {
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedDocument, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if root != nil && current == nil {
+				return nil, fmt.Errorf("%w: multiple root elements", ErrMalformedDocument)
+			}
+			depth++
+			if depth > maxDepth {
+				return nil, fmt.Errorf("%w: document nesting too deep", ErrMalformedDocument)
+			}
+			el := newElementFromToken(t)
+			if current == nil {
+				root = el
+			} else {
+				current.AddChild(el)
+			}
+			current = el
+		case xml.EndElement:
+			if current == nil || current.Prefix != t.Name.Space || current.Local != t.Name.Local {
+				return nil, fmt.Errorf("%w: unexpected end element", ErrMalformedDocument)
+			}
+			depth--
+			current = current.parent
+		case xml.CharData:
+			if current == nil {
+				if len(bytes.TrimSpace(t)) > 0 {
+					return nil, fmt.Errorf("%w: text outside root element", ErrMalformedDocument)
+				}
+				continue
+			}
+			current.Children = append(current.Children, CharData(t))
+		case xml.Directive:
+			return nil, ErrDTDNotAllowed
+		case xml.Comment, xml.ProcInst:
+			continue
+		}
+	}
+
+	if root == nil || current != nil {
+		return nil, fmt.Errorf("%w: incomplete document", ErrMalformedDocument)
+	}
+	if err := root.checkNamespaces(); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// newElementFromToken converts a raw start token into an element, separating namespace
+// declarations from ordinary attributes.
+func newElementFromToken(t xml.StartElement) *Element {
+	el := NewElement(t.Name.Space, t.Name.Local)
+	for _, a := range t.Attr {
+		switch {
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			el.DeclareNamespace("", a.Value)
+		case a.Name.Space == "xmlns":
+			el.DeclareNamespace(a.Name.Local, a.Value)
+		default:
+			el.Attrs = append(el.Attrs, Attr{Prefix: a.Name.Space, Local: a.Name.Local, Value: a.Value})
+		}
+	}
+	return el
+}
+
+// checkNamespaces ensures every prefix used in the tree is bound.
+func (e *Element) checkNamespaces() error {
+	if e.Prefix != "" {
+		if _, ok := e.lookupNamespace(e.Prefix); !ok {
+			return fmt.Errorf("%w: unbound prefix %q", ErrMalformedDocument, e.Prefix)
+		}
+	}
+	for _, a := range e.Attrs {
+		if a.Prefix != "" {
+			if _, ok := e.lookupNamespace(a.Prefix); !ok {
+				return fmt.Errorf("%w: unbound prefix %q", ErrMalformedDocument, a.Prefix)
+			}
+		}
+	}
+	for _, child := range e.ChildElements() {
+		if err := child.checkNamespaces(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeclareNamespace binds prefix to uri on this element. An empty prefix declares the default
+// namespace.
+func (e *Element) DeclareNamespace(prefix, uri string) {
+	if e.namespaces == nil {
+		e.namespaces = make(map[string]string)
+	}
+	e.namespaces[prefix] = uri
+}
+
+// lookupNamespace resolves prefix against the declarations in scope for this element.
+func (e *Element) lookupNamespace(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return xmlNamespace, true
+	}
+	for el := e; el != nil; el = el.parent {
+		if uri, ok := el.namespaces[prefix]; ok {
+			return uri, true
+		}
+	}
+	if prefix == "" {
+		return "", true
+	}
+	return "", false
+}
+
+// NamespaceURI returns the namespace URI the element's name resolves to.
+func (e *Element) NamespaceURI() string {
+	uri, _ := e.lookupNamespace(e.Prefix)
+	return uri
+}
+
+// Is reports whether the element has the given namespace URI and local name.
+func (e *Element) Is(namespaceURI, local string) bool {
+	return e.Local == local && e.NamespaceURI() == namespaceURI
+}
+
+// Parent returns the parent element, or nil for a root or detached element.
+func (e *Element) Parent() *Element {
+	return e.parent
+}
+
+// SetAttr sets an attribute. name may be qualified as "prefix:local".
+func (e *Element) SetAttr(name, value string) *Element {
+	prefix, local := splitQName(name)
+	for i := range e.Attrs {
+		if e.Attrs[i].Prefix == prefix && e.Attrs[i].Local == local {
+			e.Attrs[i].Value = value
+			return e
+		}
+	}
+	e.Attrs = append(e.Attrs, Attr{Prefix: prefix, Local: local, Value: value})
+	return e
+}
+
+// AttrValue returns the value of the attribute with the given name, which may be qualified as
+// "prefix:local", or an empty string if it is absent.
+func (e *Element) AttrValue(name string) string {
+	value, _ := e.LookupAttr(name)
+	return value
+}
+
+// LookupAttr returns the value of the named attribute and whether it is present.
+func (e *Element) LookupAttr(name string) (string, bool) {
+	prefix, local := splitQName(name)
+	for _, a := range e.Attrs {
+		if a.Prefix == prefix && a.Local == local {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// AddChild appends child to the element and returns the child.
+func (e *Element) AddChild(child *Element) *Element {
+	child.parent = e
+	e.Children = append(e.Children, child)
+	return child
+}
+
+// InsertChild inserts child before the node at index. Indexes beyond the end append.
+func (e *Element) InsertChild(index int, child *Element) {
+	child.parent = e
+	if index >= len(e.Children) {
+		e.Children = append(e.Children, child)
+		return
+	}
+	if index < 0 {
+		index = 0
+	}
+	e.Children = append(e.Children[:index], append([]Node{child}, e.Children[index:]...)...)
+}
+
+// RemoveChild detaches child from the element. It reports whether the child was found.
+func (e *Element) RemoveChild(child *Element) bool {
+	for i, n := range e.Children {
+		if n == Node(child) {
+			e.Children = append(e.Children[:i], e.Children[i+1:]...)
+			child.parent = nil
+			return true
+		}
+	}
+	return false
+}
+
+// IndexOf returns the position of child among the element's nodes, or -1.
+func (e *Element) IndexOf(child *Element) int {
+	for i, n := range e.Children {
+		if n == Node(child) {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetText replaces the element's children with a single text node.
+func (e *Element) SetText(text string) *Element {
+	e.Children = []Node{CharData(text)}
+	return e
+}
+
+// Text returns the concatenated text content of the element's direct text children.
+func (e *Element) Text() string {
+	var sb strings.Builder
+	for _, n := range e.Children {
+		if cd, ok := n.(CharData); ok {
+			sb.WriteString(string(cd))
+		}
+	}
+	return sb.String()
+}
+
+// ChildElements returns the element children in document order.
+func (e *Element) ChildElements() []*Element {
+	children := make([]*Element, 0, len(e.Children))
+	for _, n := range e.Children {
+		if el, ok := n.(*Element); ok {
+			children = append(children, el)
+		}
+	}
+	return children
+}
+
+// FindChild returns the first child element with the given namespace URI and local name.
+func (e *Element) FindChild(namespaceURI, local string) *Element {
+	for _, child := range e.ChildElements() {
+		if child.Is(namespaceURI, local) {
+			return child
+		}
+	}
+	return nil
+}
+
+// FindChildren returns every child element with the given namespace URI and local name.
+func (e *Element) FindChildren(namespaceURI, local string) []*Element {
+	var matches []*Element
+	for _, child := range e.ChildElements() {
+		if child.Is(namespaceURI, local) {
+			matches = append(matches, child)
+		}
+	}
+	return matches
+}
+
+// Bytes serializes the element as a standalone document in exclusive canonical form.
+func (e *Element) Bytes() []byte {
+	return Canonicalize(e, nil)
+}
+
+// splitQName splits "prefix:local" into its parts.
+func splitQName(name string) (string, string) {
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
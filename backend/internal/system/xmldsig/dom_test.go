@@ -0,0 +1,103 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmldsig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DOMTestSuite struct {
+	suite.Suite
+}
+
+func TestDOMTestSuite(t *testing.T) {
+	suite.Run(t, new(DOMTestSuite))
+}
+
+func (s *DOMTestSuite) TestParse_ResolvesNamespaces() {
+	root, err := Parse([]byte(`<?xml version="1.0"?>
+<!-- comment -->
+<p:Root xmlns:p="urn:test:p" xmlns="urn:test:default" ID="_1">
+  <Child attr="v">text &amp; more</Child>
+  <p:Other/>
+</p:Root>`))
+	s.Require().NoError(err)
+
+	s.True(root.Is("urn:test:p", "Root"))
+	s.Equal("_1", root.AttrValue("ID"))
+
+	child := root.FindChild("urn:test:default", "Child")
+	s.Require().NotNil(child)
+	s.Equal("v", child.AttrValue("attr"))
+	s.Equal("text & more", child.Text())
+	s.Same(root, child.Parent())
+	s.NotNil(root.FindChild("urn:test:p", "Other"))
+	s.Nil(root.FindChild("urn:test:p", "Child"))
+}
+
+func (s *DOMTestSuite) TestParse_RejectsDTD() {
+	_, err := Parse([]byte(`<!DOCTYPE r [<!ENTITY x "y">]><r>&x;</r>`))
+	s.ErrorIs(err, ErrDTDNotAllowed)
+}
+
+func (s *DOMTestSuite) TestParse_RejectsMalformedDocuments() {
+	cases := map[string]string{
+		"mismatched end":  `<a><b></a></b>`,
+		"unclosed":        `<a><b></b>`,
+		"multiple roots":  `<a/><b/>`,
+		"unbound prefix":  `<x:a/>`,
+		"trailing text":   `<a/>text`,
+		"empty":           ``,
+		"unknown entity":  `<a>&unknown;</a>`,
+		"unbound attr ns": `<a y:b="c"/>`,
+	}
+	for name, doc := range cases {
+		_, err := Parse([]byte(doc))
+		s.ErrorIs(err, ErrMalformedDocument, name)
+	}
+}
+
+func (s *DOMTestSuite) TestParse_RejectsDeepNesting() {
+	doc := ""
+	for i := 0; i <= maxDepth; i++ {
+		doc += "<a>"
+	}
+	for i := 0; i <= maxDepth; i++ {
+		doc += "</a>"
+	}
+	_, err := Parse([]byte(doc))
+	s.ErrorIs(err, ErrMalformedDocument)
+}
+
+func (s *DOMTestSuite) TestBuildAndSerialize() {
+	root := NewElement("p", "Root")
+	root.DeclareNamespace("p", "urn:test:p")
+	root.SetAttr("ID", "_1")
+	child := root.AddChild(NewElement("p", "Child")).SetText("a < b")
+	child.SetAttr("Name", "x").SetAttr("Name", "y")
+
+	s.Equal(`<p:Root xmlns:p="urn:test:p" ID="_1"><p:Child Name="y">a &lt; b</p:Child></p:Root>`,
+		string(root.Bytes()))
+
+	parsed, err := Parse(root.Bytes())
+	s.Require().NoError(err)
+	s.Equal("a < b", parsed.FindChild("urn:test:p", "Child").Text())
+}
+
+func (s *DOMTestSuite) TestInsertAndRemoveChild() {
+	root := NewElement("", "r")
+	first := root.AddChild(NewElement("", "first"))
+	last := root.AddChild(NewElement("", "last"))
+	middle := NewElement("", "middle")
+
+	root.InsertChild(root.IndexOf(first)+1, middle)
+	s.Equal([]*Element{first, middle, last}, root.ChildElements())
+
+	s.True(root.RemoveChild(middle))
+	s.Nil(middle.Parent())
+	s.False(root.RemoveChild(middle))
+	s.Equal(-1, root.IndexOf(middle))
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmldsig
+
+import "errors"
+
+// Parsing errors.
+var (
+	ErrMalformedDocument = errors.New("malformed XML document")
+	ErrDTDNotAllowed     = errors.New("XML documents with a DTD are not allowed")
+)
+
+// Signature errors.
+var (
+	ErrSignatureNotFound      = errors.New("signature not found")
+	ErrMultipleSignatures     = errors.New("multiple signatures found")
+	ErrUnsupportedAlgorithm   = errors.New("unsupported signature algorithm")
+	ErrUnsupportedTransform   = errors.New("unsupported signature transform")
+	ErrUnsupportedDigest      = errors.New("unsupported digest algorithm")
+	ErrInvalidReference       = errors.New("signature reference does not match the signed element")
+	ErrDigestMismatch         = errors.New("digest value does not match the signed content")
+	ErrInvalidSignatureFormat = errors.New("invalid signature format")
+	ErrMissingID              = errors.New("element to be signed has no ID attribute")
+)
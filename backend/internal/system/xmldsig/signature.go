@@ -0,0 +1,202 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package xmldsig implements the subset of XML Signature needed for SAML: enveloped signatures
+// over a single ID-referenced element using Exclusive XML Canonicalization.
+package xmldsig
+
+import (
+	"context"
+	gocrypto "crypto"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// idAttribute is the attribute that carries the identifier referenced by signatures.
+const idAttribute = "ID"
+
+// Signer produces enveloped signatures with a key held by the runtime crypto provider.
+type Signer struct {
+	CryptoProvider providers.RuntimeCryptoProvider
+	KeyID          string
+	// Algorithm is the JWA identifier of the signing algorithm, for example RS256.
+	Algorithm string
+	// Certificate is the DER-encoded certificate published in KeyInfo. It may be empty.
+	Certificate []byte
+}
+
+// Sign computes an enveloped signature over el and inserts the ds:Signature element as the child
+// node at index insertAt. el must carry an ID attribute.
+func (s *Signer) Sign(ctx context.Context, el *Element, insertAt int) error {
+	id := el.AttrValue(idAttribute)
+	if id == "" {
+		return ErrMissingID
+	}
+	methodURI, err := SignatureMethodURI(s.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	digest := digestOf(canonicalize(el, nil, nil), gocrypto.SHA256)
+
+	signature := NewElement(Prefix, "Signature")
+	signature.DeclareNamespace(Prefix, Namespace)
+	signedInfo := signature.AddChild(NewElement(Prefix, "SignedInfo"))
+	signedInfo.AddChild(NewElement(Prefix, "CanonicalizationMethod")).SetAttr("Algorithm", AlgorithmExcC14N)
+	signedInfo.AddChild(NewElement(Prefix, "SignatureMethod")).SetAttr("Algorithm", methodURI)
+	reference := signedInfo.AddChild(NewElement(Prefix, "Reference")).SetAttr("URI", "#"+id)
+	transforms := reference.AddChild(NewElement(Prefix, "Transforms"))
+	transforms.AddChild(NewElement(Prefix, "Transform")).SetAttr("Algorithm", TransformEnveloped)
+	transforms.AddChild(NewElement(Prefix, "Transform")).SetAttr("Algorithm", AlgorithmExcC14N)
+	reference.AddChild(NewElement(Prefix, "DigestMethod")).SetAttr("Algorithm", DigestSHA256)
+	reference.AddChild(NewElement(Prefix, "DigestValue")).SetText(base64.StdEncoding.EncodeToString(digest))
+
+	// SignedInfo is canonicalized in the context it will have inside the document.
+	el.InsertChild(insertAt, signature)
+	signatureValue, err := s.CryptoProvider.Sign(ctx, providers.KeyRef{KeyID: s.KeyID}, s.Algorithm,
+		Canonicalize(signedInfo, nil))
+	if err != nil {
+		el.RemoveChild(signature)
+		return fmt.Errorf("failed to sign XML element: %w", err)
+	}
+
+	signature.AddChild(NewElement(Prefix, "SignatureValue")).
+		SetText(base64.StdEncoding.EncodeToString(signatureValue))
+	if len(s.Certificate) > 0 {
+		keyInfo := signature.AddChild(NewElement(Prefix, "KeyInfo"))
+		x509Data := keyInfo.AddChild(NewElement(Prefix, "X509Data"))
+		x509Data.AddChild(NewElement(Prefix, "X509Certificate")).
+			SetText(base64.StdEncoding.EncodeToString(s.Certificate))
+	}
+	return nil
+}
+
+// HasSignature reports whether el carries an enveloped ds:Signature child.
+func HasSignature(el *Element) bool {
+	return len(el.FindChildren(Namespace, "Signature")) > 0
+}
+
+// Verify validates the enveloped signature on el against publicKey. The signature must reference
+// el itself by ID, so a valid signature elsewhere in the document cannot vouch for el.
+func Verify(ctx context.Context, el *Element, cryptoProvider providers.RuntimeCryptoProvider,
+	publicKey gocrypto.PublicKey) error {
+	signatures := el.FindChildren(Namespace, "Signature")
+	if len(signatures) == 0 {
+		return ErrSignatureNotFound
+	}
+	if len(signatures) > 1 {
+		return ErrMultipleSignatures
+	}
+	signature := signatures[0]
+
+	signedInfo := signature.FindChild(Namespace, "SignedInfo")
+	if signedInfo == nil {
+		return ErrInvalidSignatureFormat
+	}
+	c14nMethod := signedInfo.FindChild(Namespace, "CanonicalizationMethod")
+	if c14nMethod == nil || c14nMethod.AttrValue("Algorithm") != AlgorithmExcC14N {
+		return ErrUnsupportedTransform
+	}
+	signatureMethod := signedInfo.FindChild(Namespace, "SignatureMethod")
+	if signatureMethod == nil {
+		return ErrInvalidSignatureFormat
+	}
+	alg, err := AlgorithmFromURI(signatureMethod.AttrValue("Algorithm"))
+	if err != nil {
+		return err
+	}
+
+	if err := verifyReference(el, signature, signedInfo); err != nil {
+		return err
+	}
+
+	signatureValueEl := signature.FindChild(Namespace, "SignatureValue")
+	if signatureValueEl == nil {
+		return ErrInvalidSignatureFormat
+	}
+	signatureValue, err := base64.StdEncoding.DecodeString(stripWhitespace(signatureValueEl.Text()))
+	if err != nil {
+		return ErrInvalidSignatureFormat
+	}
+
+	return cryptoProvider.Verify(ctx, providers.KeyRef{PublicKey: publicKey}, alg,
+		Canonicalize(signedInfo, inclusivePrefixes(c14nMethod)), signatureValue)
+}
+
+// verifyReference checks that SignedInfo holds exactly one reference, pointing at el, using only
+// the enveloped and exclusive canonicalization transforms, and that its digest matches.
+func verifyReference(el, signature, signedInfo *Element) error {
+	references := signedInfo.FindChildren(Namespace, "Reference")
+	if len(references) != 1 {
+		return ErrInvalidReference
+	}
+	reference := references[0]
+
+	id := el.AttrValue(idAttribute)
+	if id == "" || reference.AttrValue("URI") != "#"+id {
+		return ErrInvalidReference
+	}
+
+	var prefixes []string
+	if transforms := reference.FindChild(Namespace, "Transforms"); transforms != nil {
+		for _, transform := range transforms.ChildElements() {
+			if !transform.Is(Namespace, "Transform") {
+				return ErrUnsupportedTransform
+			}
+			switch transform.AttrValue("Algorithm") {
+			case TransformEnveloped:
+			case AlgorithmExcC14N:
+				prefixes = inclusivePrefixes(transform)
+			default:
+				return ErrUnsupportedTransform
+			}
+		}
+	}
+
+	digestMethod := reference.FindChild(Namespace, "DigestMethod")
+	if digestMethod == nil {
+		return ErrInvalidSignatureFormat
+	}
+	hash, ok := digestMethods[digestMethod.AttrValue("Algorithm")]
+	if !ok {
+		return ErrUnsupportedDigest
+	}
+	digestValueEl := reference.FindChild(Namespace, "DigestValue")
+	if digestValueEl == nil {
+		return ErrInvalidSignatureFormat
+	}
+	expected, err := base64.StdEncoding.DecodeString(stripWhitespace(digestValueEl.Text()))
+	if err != nil {
+		return ErrInvalidSignatureFormat
+	}
+
+	actual := digestOf(canonicalize(el, prefixes, signature), hash)
+	if subtle.ConstantTimeCompare(expected, actual) != 1 {
+		return ErrDigestMismatch
+	}
+	return nil
+}
+
+// inclusivePrefixes reads the InclusiveNamespaces PrefixList of a canonicalization element.
+func inclusivePrefixes(el *Element) []string {
+	for _, child := range el.ChildElements() {
+		if child.Is(AlgorithmExcC14N, "InclusiveNamespaces") {
+			return strings.Fields(child.AttrValue("PrefixList"))
+		}
+	}
+	return nil
+}
+
+func digestOf(content []byte, hash gocrypto.Hash) []byte {
+	h := hash.New()
+	h.Write(content)
+	return h.Sum(nil)
+}
+
+func stripWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
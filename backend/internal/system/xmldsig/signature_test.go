@@ -0,0 +1,196 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmldsig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+)
+
+const testKeyID = "test-key"
+
+type SignatureTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	privateKey *ecdsa.PrivateKey
+	crypto     *cryptomock.RuntimeCryptoProviderMock
+	signer     *Signer
+}
+
+func TestSignatureTestSuite(t *testing.T) {
+	suite.Run(t, new(SignatureTestSuite))
+}
+
+func (s *SignatureTestSuite) SetupTest() {
+	var err error
+	s.ctx = context.Background()
+	s.privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+
+	s.crypto = cryptomock.NewRuntimeCryptoProviderMock(s.T())
+	s.crypto.EXPECT().Sign(mock.Anything, providers.KeyRef{KeyID: testKeyID}, "ES256", mock.Anything).
+		RunAndReturn(func(_ context.Context, _ providers.KeyRef, _ string, content []byte) ([]byte, error) {
+			return cryptolib.Generate(content, cryptolib.ECDSASHA256, s.privateKey)
+		}).Maybe()
+	s.crypto.EXPECT().Verify(mock.Anything, mock.Anything, "ES256", mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, ref providers.KeyRef, _ string, content, sig []byte) error {
+			return cryptolib.Verify(content, sig, cryptolib.ECDSASHA256, ref.PublicKey)
+		}).Maybe()
+
+	s.signer = &Signer{
+		CryptoProvider: s.crypto,
+		KeyID:          testKeyID,
+		Algorithm:      "ES256",
+		Certificate:    []byte("certificate-der"),
+	}
+}
+
+func (s *SignatureTestSuite) newDocument() *Element {
+	root := NewElement("p", "Response")
+	root.DeclareNamespace("p", "urn:test:p")
+	root.SetAttr("ID", "_response")
+	root.AddChild(NewElement("p", "Issuer")).SetText("https://idp.example.com")
+	assertion := root.AddChild(NewElement("p", "Assertion")).SetAttr("ID", "_assertion")
+	assertion.AddChild(NewElement("p", "Issuer")).SetText("https://idp.example.com")
+	assertion.AddChild(NewElement("p", "Subject")).SetText("alice")
+	return root
+}
+
+// signAndReparse signs the assertion, serializes the whole document and parses it back so that
+// verification runs on the wire form.
+func (s *SignatureTestSuite) signAndReparse(root *Element) *Element {
+	assertion := root.FindChild("urn:test:p", "Assertion")
+	s.Require().NoError(s.signer.Sign(s.ctx, assertion, 1))
+
+	parsed, err := Parse(root.Bytes())
+	s.Require().NoError(err)
+	return parsed
+}
+
+func (s *SignatureTestSuite) TestSignAndVerify_RoundTrip() {
+	parsed := s.signAndReparse(s.newDocument())
+	assertion := parsed.FindChild("urn:test:p", "Assertion")
+
+	s.True(HasSignature(assertion))
+	s.False(HasSignature(parsed))
+	signature := assertion.ChildElements()[1]
+	s.True(signature.Is(Namespace, "Signature"))
+	s.Equal(base64.StdEncoding.EncodeToString([]byte("certificate-der")),
+		signature.FindChild(Namespace, "KeyInfo").FindChild(Namespace, "X509Data").
+			FindChild(Namespace, "X509Certificate").Text())
+
+	s.NoError(Verify(s.ctx, assertion, s.crypto, &s.privateKey.PublicKey))
+}
+
+func (s *SignatureTestSuite) TestVerify_TamperedContent() {
+	parsed := s.signAndReparse(s.newDocument())
+	assertion := parsed.FindChild("urn:test:p", "Assertion")
+	assertion.FindChild("urn:test:p", "Subject").SetText("mallory")
+
+	s.ErrorIs(Verify(s.ctx, assertion, s.crypto, &s.privateKey.PublicKey), ErrDigestMismatch)
+}
+
+func (s *SignatureTestSuite) TestVerify_WrongKey() {
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	parsed := s.signAndReparse(s.newDocument())
+
+	s.Error(Verify(s.ctx, parsed.FindChild("urn:test:p", "Assertion"), s.crypto, &otherKey.PublicKey))
+}
+
+func (s *SignatureTestSuite) TestVerify_SignatureMustReferenceElement() {
+	parsed := s.signAndReparse(s.newDocument())
+	assertion := parsed.FindChild("urn:test:p", "Assertion")
+
+	// Moving a valid signature onto a different element must not validate that element.
+	signature := assertion.FindChild(Namespace, "Signature")
+	assertion.RemoveChild(signature)
+	parsed.InsertChild(1, signature)
+
+	s.ErrorIs(Verify(s.ctx, parsed, s.crypto, &s.privateKey.PublicKey), ErrInvalidReference)
+	s.ErrorIs(Verify(s.ctx, assertion, s.crypto, &s.privateKey.PublicKey), ErrSignatureNotFound)
+}
+
+func (s *SignatureTestSuite) TestVerify_RejectsUnsupportedAlgorithms() {
+	parsed := s.signAndReparse(s.newDocument())
+	assertion := parsed.FindChild("urn:test:p", "Assertion")
+	signedInfo := assertion.FindChild(Namespace, "Signature").FindChild(Namespace, "SignedInfo")
+	signedInfo.FindChild(Namespace, "SignatureMethod").
+		SetAttr("Algorithm", "http://www.w3.org/2000/09/xmldsig#rsa-sha1")
+
+	s.ErrorIs(Verify(s.ctx, assertion, s.crypto, &s.privateKey.PublicKey), ErrUnsupportedAlgorithm)
+}
+
+func (s *SignatureTestSuite) TestVerify_RejectsUnsupportedTransform() {
+	parsed := s.signAndReparse(s.newDocument())
+	assertion := parsed.FindChild("urn:test:p", "Assertion")
+	reference := assertion.FindChild(Namespace, "Signature").FindChild(Namespace, "SignedInfo").
+		FindChild(Namespace, "Reference")
+	reference.FindChild(Namespace, "Transforms").ChildElements()[0].
+		SetAttr("Algorithm", "http://www.w3.org/TR/1999/REC-xpath-19991116")
+
+	s.ErrorIs(Verify(s.ctx, assertion, s.crypto, &s.privateKey.PublicKey), ErrUnsupportedTransform)
+}
+
+func (s *SignatureTestSuite) TestVerify_MultipleSignatures() {
+	parsed := s.signAndReparse(s.newDocument())
+	assertion := parsed.FindChild("urn:test:p", "Assertion")
+	assertion.AddChild(NewElement(Prefix, "Signature")).DeclareNamespace(Prefix, Namespace)
+
+	s.ErrorIs(Verify(s.ctx, assertion, s.crypto, &s.privateKey.PublicKey), ErrMultipleSignatures)
+}
+
+func (s *SignatureTestSuite) TestSign_MissingID() {
+	el := NewElement("", "NoID")
+	s.ErrorIs(s.signer.Sign(s.ctx, el, 0), ErrMissingID)
+}
+
+func (s *SignatureTestSuite) TestSign_ProviderError() {
+	crypto := cryptomock.NewRuntimeCryptoProviderMock(s.T())
+	crypto.EXPECT().Sign(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("key unavailable"))
+	signer := &Signer{CryptoProvider: crypto, KeyID: testKeyID, Algorithm: "ES256"}
+
+	root := s.newDocument()
+	err := signer.Sign(s.ctx, root, 1)
+	s.Error(err)
+	s.False(HasSignature(root))
+}
+
+func (s *SignatureTestSuite) TestSign_WithoutCertificateOmitsKeyInfo() {
+	s.signer.Certificate = nil
+	root := s.newDocument()
+	s.Require().NoError(s.signer.Sign(s.ctx, root, 1))
+
+	s.Nil(root.FindChild(Namespace, "Signature").FindChild(Namespace, "KeyInfo"))
+	s.False(strings.Contains(string(root.Bytes()), "X509Certificate"))
+}
+
+func (s *SignatureTestSuite) TestAlgorithmMapping() {
+	uri, err := SignatureMethodURI("RS256")
+	s.NoError(err)
+	s.Equal("http://www.w3.org/2001/04/xmldsig-more#rsa-sha256", uri)
+
+	alg, err := AlgorithmFromURI(uri)
+	s.NoError(err)
+	s.Equal("RS256", alg)
+
+	_, err = SignatureMethodURI("HS256")
+	s.ErrorIs(err, ErrUnsupportedAlgorithm)
+	_, err = AlgorithmFromURI("http://www.w3.org/2000/09/xmldsig#rsa-sha1")
+	s.ErrorIs(err, ErrUnsupportedAlgorithm)
+}
@@ -9,7 +9,10 @@ import (
 	"encoding/json"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
@@ -246,6 +249,135 @@ func (_c *UserServiceInterfaceMock_DeleteUser_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// ExportUsers provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) ExportUsers(ctx context.Context, filters map[string]interface{}, visit func(user *User) error) *common.ServiceError {
+	ret := _mock.Called(ctx, filters, visit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUsers")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]interface{}, func(user *User) error) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, filters, visit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// UserServiceInterfaceMock_ExportUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUsers'
+type UserServiceInterfaceMock_ExportUsers_Call struct {
+	*mock.Call
+}
+
+// ExportUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters map[string]interface{}
+//   - visit func(user *User) error
+func (_e *UserServiceInterfaceMock_Expecter) ExportUsers(ctx interface{}, filters interface{}, visit interface{}) *UserServiceInterfaceMock_ExportUsers_Call {
+	return &UserServiceInterfaceMock_ExportUsers_Call{Call: _e.mock.On("ExportUsers", ctx, filters, visit)}
+}
+
+func (_c *UserServiceInterfaceMock_ExportUsers_Call) Run(run func(ctx context.Context, filters map[string]interface{}, visit func(user *User) error)) *UserServiceInterfaceMock_ExportUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 map[string]interface{}
+		if args[1] != nil {
+			arg1 = args[1].(map[string]interface{})
+		}
+		var arg2 func(user *User) error
+		if args[2] != nil {
+			arg2 = args[2].(func(user *User) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ExportUsers_Call) Return(serviceError *common.ServiceError) *UserServiceInterfaceMock_ExportUsers_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ExportUsers_Call) RunAndReturn(run func(ctx context.Context, filters map[string]interface{}, visit func(user *User) error) *common.ServiceError) *UserServiceInterfaceMock_ExportUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCredentialAlgorithmReport provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetCredentialAlgorithmReport(ctx context.Context) (*CredentialAlgorithmReport, *common.ServiceError) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredentialAlgorithmReport")
+	}
+
+	var r0 *CredentialAlgorithmReport
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*CredentialAlgorithmReport, *common.ServiceError)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *CredentialAlgorithmReport); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CredentialAlgorithmReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) *common.ServiceError); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetCredentialAlgorithmReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialAlgorithmReport'
+type UserServiceInterfaceMock_GetCredentialAlgorithmReport_Call struct {
+	*mock.Call
+}
+
+// GetCredentialAlgorithmReport is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserServiceInterfaceMock_Expecter) GetCredentialAlgorithmReport(ctx interface{}) *UserServiceInterfaceMock_GetCredentialAlgorithmReport_Call {
+	return &UserServiceInterfaceMock_GetCredentialAlgorithmReport_Call{Call: _e.mock.On("GetCredentialAlgorithmReport", ctx)}
+}
+
+func (_c *UserServiceInterfaceMock_GetCredentialAlgorithmReport_Call) Run(run func(ctx context.Context)) *UserServiceInterfaceMock_GetCredentialAlgorithmReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetCredentialAlgorithmReport_Call) Return(credentialAlgorithmReport *CredentialAlgorithmReport, serviceError *common.ServiceError) *UserServiceInterfaceMock_GetCredentialAlgorithmReport_Call {
+	_c.Call.Return(credentialAlgorithmReport, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetCredentialAlgorithmReport_Call) RunAndReturn(run func(ctx context.Context) (*CredentialAlgorithmReport, *common.ServiceError)) *UserServiceInterfaceMock_GetCredentialAlgorithmReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUser provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) GetUser(ctx context.Context, userID string, includeDisplay bool) (*User, *common.ServiceError) {
 	ret := _mock.Called(ctx, userID, includeDisplay)
@@ -492,6 +624,76 @@ func (_c *UserServiceInterfaceMock_GetUserList_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// GetUserLockStatus provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetUserLockStatus(ctx context.Context, userID string) (*lockout.LockStatus, *common.ServiceError) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserLockStatus")
+	}
+
+	var r0 *lockout.LockStatus
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*lockout.LockStatus, *common.ServiceError)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *lockout.LockStatus); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lockout.LockStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetUserLockStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserLockStatus'
+type UserServiceInterfaceMock_GetUserLockStatus_Call struct {
+	*mock.Call
+}
+
+// GetUserLockStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) GetUserLockStatus(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_GetUserLockStatus_Call {
+	return &UserServiceInterfaceMock_GetUserLockStatus_Call{Call: _e.mock.On("GetUserLockStatus", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_GetUserLockStatus_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_GetUserLockStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserLockStatus_Call) Return(lockStatus *lockout.LockStatus, serviceError *common.ServiceError) *UserServiceInterfaceMock_GetUserLockStatus_Call {
+	_c.Call.Return(lockStatus, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserLockStatus_Call) RunAndReturn(run func(ctx context.Context, userID string) (*lockout.LockStatus, *common.ServiceError)) *UserServiceInterfaceMock_GetUserLockStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserMetadata provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) GetUserMetadata(ctx context.Context, userID string) (*entitytype.EntityType, *common.ServiceError) {
 	ret := _mock.Called(ctx, userID)
@@ -562,6 +764,76 @@ func (_c *UserServiceInterfaceMock_GetUserMetadata_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetUserSessions provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetUserSessions(ctx context.Context, userID string) (*UserSessionListResponse, *common.ServiceError) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserSessions")
+	}
+
+	var r0 *UserSessionListResponse
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*UserSessionListResponse, *common.ServiceError)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *UserSessionListResponse); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserSessionListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSessions'
+type UserServiceInterfaceMock_GetUserSessions_Call struct {
+	*mock.Call
+}
+
+// GetUserSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) GetUserSessions(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_GetUserSessions_Call {
+	return &UserServiceInterfaceMock_GetUserSessions_Call{Call: _e.mock.On("GetUserSessions", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_GetUserSessions_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_GetUserSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserSessions_Call) Return(userSessionListResponse *UserSessionListResponse, serviceError *common.ServiceError) *UserServiceInterfaceMock_GetUserSessions_Call {
+	_c.Call.Return(userSessionListResponse, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserSessions_Call) RunAndReturn(run func(ctx context.Context, userID string) (*UserSessionListResponse, *common.ServiceError)) *UserServiceInterfaceMock_GetUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserUsages provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) GetUserUsages(ctx context.Context, userID string) (*resourcedependency.DependenciesResponse, *common.ServiceError) {
 	ret := _mock.Called(ctx, userID)
@@ -726,6 +998,82 @@ func (_c *UserServiceInterfaceMock_GetUsersByPath_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// ImportUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) ImportUser(ctx context.Context, user *User, credentials map[string][]entity.StoredCredential) (*User, *common.ServiceError) {
+	ret := _mock.Called(ctx, user, credentials)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportUser")
+	}
+
+	var r0 *User
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *User, map[string][]entity.StoredCredential) (*User, *common.ServiceError)); ok {
+		return returnFunc(ctx, user, credentials)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *User, map[string][]entity.StoredCredential) *User); ok {
+		r0 = returnFunc(ctx, user, credentials)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *User, map[string][]entity.StoredCredential) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, user, credentials)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_ImportUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportUser'
+type UserServiceInterfaceMock_ImportUser_Call struct {
+	*mock.Call
+}
+
+// ImportUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *User
+//   - credentials map[string][]entity.StoredCredential
+func (_e *UserServiceInterfaceMock_Expecter) ImportUser(ctx interface{}, user interface{}, credentials interface{}) *UserServiceInterfaceMock_ImportUser_Call {
+	return &UserServiceInterfaceMock_ImportUser_Call{Call: _e.mock.On("ImportUser", ctx, user, credentials)}
+}
+
+func (_c *UserServiceInterfaceMock_ImportUser_Call) Run(run func(ctx context.Context, user *User, credentials map[string][]entity.StoredCredential)) *UserServiceInterfaceMock_ImportUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *User
+		if args[1] != nil {
+			arg1 = args[1].(*User)
+		}
+		var arg2 map[string][]entity.StoredCredential
+		if args[2] != nil {
+			arg2 = args[2].(map[string][]entity.StoredCredential)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ImportUser_Call) Return(user1 *User, serviceError *common.ServiceError) *UserServiceInterfaceMock_ImportUser_Call {
+	_c.Call.Return(user1, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ImportUser_Call) RunAndReturn(run func(ctx context.Context, user *User, credentials map[string][]entity.StoredCredential) (*User, *common.ServiceError)) *UserServiceInterfaceMock_ImportUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResolveUserOUHandle provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) ResolveUserOUHandle(ctx context.Context, user *User) *common.ServiceError {
 	ret := _mock.Called(ctx, user)
@@ -785,6 +1133,136 @@ func (_c *UserServiceInterfaceMock_ResolveUserOUHandle_Call) RunAndReturn(run fu
 	return _c
 }
 
+// RevokeUserSession provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) RevokeUserSession(ctx context.Context, userID string, sessionID string) *common.ServiceError {
+	ret := _mock.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserSession")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, userID, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// UserServiceInterfaceMock_RevokeUserSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserSession'
+type UserServiceInterfaceMock_RevokeUserSession_Call struct {
+	*mock.Call
+}
+
+// RevokeUserSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - sessionID string
+func (_e *UserServiceInterfaceMock_Expecter) RevokeUserSession(ctx interface{}, userID interface{}, sessionID interface{}) *UserServiceInterfaceMock_RevokeUserSession_Call {
+	return &UserServiceInterfaceMock_RevokeUserSession_Call{Call: _e.mock.On("RevokeUserSession", ctx, userID, sessionID)}
+}
+
+func (_c *UserServiceInterfaceMock_RevokeUserSession_Call) Run(run func(ctx context.Context, userID string, sessionID string)) *UserServiceInterfaceMock_RevokeUserSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_RevokeUserSession_Call) Return(serviceError *common.ServiceError) *UserServiceInterfaceMock_RevokeUserSession_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_RevokeUserSession_Call) RunAndReturn(run func(ctx context.Context, userID string, sessionID string) *common.ServiceError) *UserServiceInterfaceMock_RevokeUserSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserSessions provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) RevokeUserSessions(ctx context.Context, userID string, exceptSessionID string) *common.ServiceError {
+	ret := _mock.Called(ctx, userID, exceptSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserSessions")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, userID, exceptSessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// UserServiceInterfaceMock_RevokeUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserSessions'
+type UserServiceInterfaceMock_RevokeUserSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeUserSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - exceptSessionID string
+func (_e *UserServiceInterfaceMock_Expecter) RevokeUserSessions(ctx interface{}, userID interface{}, exceptSessionID interface{}) *UserServiceInterfaceMock_RevokeUserSessions_Call {
+	return &UserServiceInterfaceMock_RevokeUserSessions_Call{Call: _e.mock.On("RevokeUserSessions", ctx, userID, exceptSessionID)}
+}
+
+func (_c *UserServiceInterfaceMock_RevokeUserSessions_Call) Run(run func(ctx context.Context, userID string, exceptSessionID string)) *UserServiceInterfaceMock_RevokeUserSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_RevokeUserSessions_Call) Return(serviceError *common.ServiceError) *UserServiceInterfaceMock_RevokeUserSessions_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_RevokeUserSessions_Call) RunAndReturn(run func(ctx context.Context, userID string, exceptSessionID string) *common.ServiceError) *UserServiceInterfaceMock_RevokeUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetDependencyRegistry provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) SetDependencyRegistry(r resourcedependency.Registry) {
 	_mock.Called(r)
@@ -825,6 +1303,105 @@ func (_c *UserServiceInterfaceMock_SetDependencyRegistry_Call) RunAndReturn(run
 	return _c
 }
 
+// SetSessionService provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) SetSessionService(sessionService session.Service) {
+	_mock.Called(sessionService)
+	return
+}
+
+// UserServiceInterfaceMock_SetSessionService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSessionService'
+type UserServiceInterfaceMock_SetSessionService_Call struct {
+	*mock.Call
+}
+
+// SetSessionService is a helper method to define mock.On call
+//   - sessionService session.Service
+func (_e *UserServiceInterfaceMock_Expecter) SetSessionService(sessionService interface{}) *UserServiceInterfaceMock_SetSessionService_Call {
+	return &UserServiceInterfaceMock_SetSessionService_Call{Call: _e.mock.On("SetSessionService", sessionService)}
+}
+
+func (_c *UserServiceInterfaceMock_SetSessionService_Call) Run(run func(sessionService session.Service)) *UserServiceInterfaceMock_SetSessionService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 session.Service
+		if args[0] != nil {
+			arg0 = args[0].(session.Service)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetSessionService_Call) Return() *UserServiceInterfaceMock_SetSessionService_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetSessionService_Call) RunAndReturn(run func(sessionService session.Service)) *UserServiceInterfaceMock_SetSessionService_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UnlockUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) UnlockUser(ctx context.Context, userID string) *common.ServiceError {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockUser")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// UserServiceInterfaceMock_UnlockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockUser'
+type UserServiceInterfaceMock_UnlockUser_Call struct {
+	*mock.Call
+}
+
+// UnlockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) UnlockUser(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_UnlockUser_Call {
+	return &UserServiceInterfaceMock_UnlockUser_Call{Call: _e.mock.On("UnlockUser", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_UnlockUser_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_UnlockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_UnlockUser_Call) Return(serviceError *common.ServiceError) *UserServiceInterfaceMock_UnlockUser_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_UnlockUser_Call) RunAndReturn(run func(ctx context.Context, userID string) *common.ServiceError) *UserServiceInterfaceMock_UnlockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) UpdateUser(ctx context.Context, userID string, user *User) (*User, *common.ServiceError) {
 	ret := _mock.Called(ctx, userID, user)
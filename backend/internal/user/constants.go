@@ -29,3 +29,10 @@ func (ct CredentialType) String() string {
 func (ct CredentialType) IsSystemManaged() bool {
 	return slices.Contains(systemManagedCredentialTypes, ct)
 }
+
+// userExportBatchSize is the number of users read from the store at a time while exporting users.
+const userExportBatchSize = 500
+
+// claimSessionID is the access token claim carrying the OpenID Connect session identifier (sid) of the
+// session the token was issued in.
+const claimSessionID = "sid"
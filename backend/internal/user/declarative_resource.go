@@ -438,16 +438,27 @@ func parseCredentialObject(
 	}
 
 	iterations, _ := paramsMap["iterations"].(int)
+	memory, _ := paramsMap["memory"].(int)
+	parallelism, _ := paramsMap["parallelism"].(int)
 	keySize, _ := paramsMap["keySize"].(int)
 	salt, _ := paramsMap["salt"].(string)
+	// Parameters of scrypt hashes imported from other identity providers.
+	blockSize, _ := paramsMap["blockSize"].(int)
+	saltSeparator, _ := paramsMap["saltSeparator"].(string)
+	signerKey, _ := paramsMap["signerKey"].(string)
 
 	return Credential{
 		StorageType: storageType,
 		StorageAlgo: cryptolib.CredAlgorithm(storageAlgo),
 		StorageAlgoParams: cryptolib.CredParameters{
-			Iterations: iterations,
-			KeySize:    keySize,
-			Salt:       salt,
+			Iterations:    iterations,
+			Memory:        memory,
+			Parallelism:   parallelism,
+			KeySize:       keySize,
+			Salt:          salt,
+			BlockSize:     blockSize,
+			SaltSeparator: saltSeparator,
+			SignerKey:     signerKey,
 		},
 		Value: value,
 	}, nil
@@ -376,6 +376,34 @@ func (suite *DeclarativeResourceTestSuite) TestParseCredentialObject_YAMLMapInte
 	suite.Equal("pepper", cred.StorageAlgoParams.Salt)
 }
 
+func (suite *DeclarativeResourceTestSuite) TestParseCredentials_ImportedFirebaseScrypt() {
+	creds := map[string]interface{}{
+		"password": []interface{}{
+			map[interface{}]interface{}{
+				"storageType": "hash",
+				"storageAlgo": "FIREBASE_SCRYPT",
+				"storageAlgoParams": map[interface{}]interface{}{
+					"iterations":    8,
+					"memory":        14,
+					"salt":          "42xEC+ixf3L2lw==",
+					"saltSeparator": "Bw==",
+					"signerKey":     "c2lnbmVy",
+				},
+				"value": "lSrfV15cpx95",
+			},
+		},
+	}
+	parsed, err := parseCredentials(creds)
+	suite.NoError(err)
+	suite.Require().Len(parsed["password"], 1)
+	params := parsed["password"][0].StorageAlgoParams
+	suite.Equal(cryptolib.FIREBASESCRYPT, parsed["password"][0].StorageAlgo)
+	suite.Equal(8, params.Iterations)
+	suite.Equal(14, params.Memory)
+	suite.Equal("Bw==", params.SaltSeparator)
+	suite.Equal("c2lnbmVy", params.SignerKey)
+}
+
 func (suite *DeclarativeResourceTestSuite) TestParseCredentials_InvalidCredentialMapType() {
 	creds := map[string]interface{}{
 		"password": []interface{}{123}, // not a map
@@ -300,6 +300,19 @@ var (
 			DefaultValue: "The credential updates through this endpoint are not allowed",
 		},
 	}
+	// ErrorSessionNotFound is returned when the user has no active session with the given id.
+	ErrorSessionNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "USR-1029",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userservice.session_not_found",
+			DefaultValue: "Session not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userservice.session_not_found_description",
+			DefaultValue: "The user has no active session with the specified id",
+		},
+	}
 )
 
 // Error variables
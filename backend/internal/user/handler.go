@@ -204,6 +204,127 @@ func (uh *userHandler) HandleUserUsagesGetRequest(w http.ResponseWriter, r *http
 	logger.Debug(ctx, "Successfully retrieved user usages", log.MaskedString(log.LoggerKeyUserID, id))
 }
 
+// HandleUserLockoutGetRequest handles the request to retrieve the account lockout state of a user.
+func (uh *userHandler) HandleUserLockoutGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(ctx, w, &ErrorMissingUserID)
+		return
+	}
+
+	status, svcErr := uh.userService.GetUserLockStatus(ctx, id)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, status)
+
+	logger.Debug(ctx, "Successfully retrieved user lockout state", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
+// HandleUserSessionsGetRequest handles the request to list the live sessions of a user.
+func (uh *userHandler) HandleUserSessionsGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(ctx, w, &ErrorMissingUserID)
+		return
+	}
+
+	sessions, svcErr := uh.userService.GetUserSessions(ctx, id)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, sessions)
+
+	logger.Debug(ctx, "Successfully retrieved user sessions", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
+// HandleUserSessionsDeleteRequest handles the request to revoke every session of a user.
+func (uh *userHandler) HandleUserSessionsDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(ctx, w, &ErrorMissingUserID)
+		return
+	}
+
+	if svcErr := uh.userService.RevokeUserSessions(ctx, id, ""); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+	logger.Debug(ctx, "User sessions revoke response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
+// HandleUserSessionDeleteRequest handles the request to revoke a single session of a user.
+func (uh *userHandler) HandleUserSessionDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(ctx, w, &ErrorMissingUserID)
+		return
+	}
+
+	if svcErr := uh.userService.RevokeUserSession(ctx, id, r.PathValue("sessionId")); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+	logger.Debug(ctx, "User session revoke response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
+// HandleCredentialAlgorithmReportRequest handles the request to report the hashing algorithms used by
+// stored user credentials.
+func (uh *userHandler) HandleCredentialAlgorithmReportRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	report, svcErr := uh.userService.GetCredentialAlgorithmReport(ctx)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, report)
+
+	logger.Debug(ctx, "Successfully retrieved credential algorithm report")
+}
+
+// HandleUserUnlockRequest handles the request to unlock a user account.
+func (uh *userHandler) HandleUserUnlockRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(ctx, w, &ErrorMissingUserID)
+		return
+	}
+
+	if svcErr := uh.userService.UnlockUser(ctx, id); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+	logger.Debug(ctx, "User unlock response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
 // HandleUserPutRequest handles the user request.
 func (uh *userHandler) HandleUserPutRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -383,6 +504,70 @@ func (uh *userHandler) HandleSelfUserGetRequest(w http.ResponseWriter, r *http.R
 	logger.Debug(ctx, "Self user GET response sent", log.MaskedString(log.LoggerKeyUserID, userID))
 }
 
+// HandleSelfUserSessionsGetRequest handles the request to list the live sessions of the authenticated user.
+func (uh *userHandler) HandleSelfUserSessionsGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := security.GetSubject(ctx)
+	if strings.TrimSpace(userID) == "" {
+		handleError(ctx, w, &ErrorAuthenticationFailed)
+		return
+	}
+
+	sessions, svcErr := uh.userService.GetUserSessions(ctx, userID)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, sessions)
+
+	logger.Debug(ctx, "Self user sessions GET response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// HandleSelfUserSessionsDeleteRequest handles the request to revoke every session of the authenticated
+// user other than the one the request is made from.
+func (uh *userHandler) HandleSelfUserSessionsDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := security.GetSubject(ctx)
+	if strings.TrimSpace(userID) == "" {
+		handleError(ctx, w, &ErrorAuthenticationFailed)
+		return
+	}
+
+	if svcErr := uh.userService.RevokeUserSessions(ctx, userID, currentSessionID(ctx, userID)); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+	logger.Debug(ctx, "Self user sessions revoke response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// HandleSelfUserSessionDeleteRequest handles the request to revoke a single session of the authenticated
+// user.
+func (uh *userHandler) HandleSelfUserSessionDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := security.GetSubject(ctx)
+	if strings.TrimSpace(userID) == "" {
+		handleError(ctx, w, &ErrorAuthenticationFailed)
+		return
+	}
+
+	if svcErr := uh.userService.RevokeUserSession(ctx, userID, r.PathValue("sessionId")); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+	logger.Debug(ctx, "Self user session revoke response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
 // HandleSelfUserPutRequest handles the self user update.
 func (uh *userHandler) HandleSelfUserPutRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -563,6 +748,7 @@ func handleError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.S
 		switch svcErr.Code {
 		case ErrorMissingUserID.Code,
 			ErrorUserNotFound.Code,
+			ErrorSessionNotFound.Code,
 			ErrorOrganizationUnitNotFound.Code:
 			statusCode = http.StatusNotFound
 		case ErrorAttributeConflict.Code,
@@ -17,7 +17,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/lockout"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -1342,3 +1345,100 @@ func TestHandleSelfUserMetadataGetRequest_ServiceError(t *testing.T) {
 
 	require.Equal(t, http.StatusNotFound, rr.Code)
 }
+
+func TestHandleUserLockoutGetRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetUserLockStatus", mock.Anything, testUserID123).
+		Return(&lockout.LockStatus{Locked: true, Permanent: true, LockCount: 3}, nil)
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/lockout", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserLockoutGetRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var response lockout.LockStatus
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+	require.True(t, response.Locked)
+	require.True(t, response.Permanent)
+	require.Equal(t, 3, response.LockCount)
+}
+
+func TestHandleUserLockoutGetRequest_ErrorCases(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetUserLockStatus", mock.Anything, testUserID123).Return(nil, &ErrorUserNotFound)
+	handler := newUserHandler(mockSvc)
+
+	req := httptest.NewRequest(http.MethodGet, "/users//lockout", nil)
+	rr := httptest.NewRecorder()
+	handler.HandleUserLockoutGetRequest(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/lockout", nil)
+	req.SetPathValue("id", testUserID123)
+	rr = httptest.NewRecorder()
+	handler.HandleUserLockoutGetRequest(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestHandleCredentialAlgorithmReportRequest(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetCredentialAlgorithmReport", mock.Anything).Return(&CredentialAlgorithmReport{
+		TotalCredentials: 2,
+		PendingUpgrade:   1,
+		Algorithms: []entity.CredentialAlgorithmUsage{
+			{CredentialType: "password", Algorithm: cryptolib.ARGON2ID, Count: 1},
+			{CredentialType: "password", Algorithm: cryptolib.BCRYPT, Count: 1, PendingUpgrade: 1},
+		},
+	}, nil).Once()
+	mockSvc.On("GetCredentialAlgorithmReport", mock.Anything).Return(nil, &tidcommon.ErrorUnauthorized).Once()
+
+	handler := newUserHandler(mockSvc)
+	rr := httptest.NewRecorder()
+	handler.HandleCredentialAlgorithmReportRequest(rr,
+		httptest.NewRequest(http.MethodGet, "/users/credential-algorithms", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var response CredentialAlgorithmReport
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+	require.Equal(t, 2, response.TotalCredentials)
+	require.Equal(t, cryptolib.BCRYPT, response.Algorithms[1].Algorithm)
+
+	rr = httptest.NewRecorder()
+	handler.HandleCredentialAlgorithmReportRequest(rr,
+		httptest.NewRequest(http.MethodGet, "/users/credential-algorithms", nil))
+	require.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestHandleUserUnlockRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("UnlockUser", mock.Anything, testUserID123).Return(nil)
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodPost, "/users/"+testUserID123+"/unlock", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserUnlockRequest(rr, req)
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestHandleUserUnlockRequest_ErrorCases(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("UnlockUser", mock.Anything, testUserID123).Return(&tidcommon.ErrorUnauthorized)
+	handler := newUserHandler(mockSvc)
+
+	req := httptest.NewRequest(http.MethodPost, "/users//unlock", nil)
+	rr := httptest.NewRecorder()
+	handler.HandleUserUnlockRequest(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/users/"+testUserID123+"/unlock", nil)
+	req.SetPathValue("id", testUserID123)
+	rr = httptest.NewRecorder()
+	handler.HandleUserUnlockRequest(rr, req)
+	require.Equal(t, http.StatusForbidden, rr.Code)
+}
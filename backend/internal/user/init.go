@@ -9,12 +9,15 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
 // Initialize initializes the user service and registers its routes.
@@ -24,9 +27,12 @@ func Initialize(
 	ouService oupkg.OrganizationUnitServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	lockoutService lockout.LockoutServiceInterface,
+	observabilitySvc providers.ObservabilityProvider,
 ) (UserServiceInterface, oupkg.OUUserResolver, declarativeresource.ResourceExporter, error) {
 	// Step 1: Create service with entity service
-	userService := newUserService(authzService, entityService, ouService, entityTypeService)
+	userService := newUserService(authzService, entityService, ouService, entityTypeService, lockoutService,
+		audit.NewRecorder(observabilitySvc))
 
 	// Step 2: Load user-specific indexed attributes into the entity store.
 	if err := entityService.LoadIndexedAttributes(getUserIndexedAttributes()); err != nil {
@@ -102,10 +108,18 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 				userHandler.HandleUserGroupsGetRequest(w, r)
 			} else if len(segments) == 2 && segments[1] == "usages" {
 				userHandler.HandleUserUsagesGetRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == "lockout" {
+				userHandler.HandleUserLockoutGetRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == "sessions" {
+				userHandler.HandleUserSessionsGetRequest(w, r)
 			} else {
 				http.NotFound(w, r)
 			}
 		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/{id}/sessions",
+		userHandler.HandleUserSessionsDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/{id}/sessions/{sessionId}",
+		userHandler.HandleUserSessionDeleteRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("PUT /users/",
 		func(w http.ResponseWriter, r *http.Request) {
 			path := strings.TrimPrefix(r.URL.Path, "/users/")
@@ -125,6 +139,9 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 			if len(segments) == 2 && segments[1] == "update-credentials" {
 				r.SetPathValue("id", segments[0])
 				userHandler.HandleUserCredentialUpdateRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == "unlock" {
+				r.SetPathValue("id", segments[0])
+				userHandler.HandleUserUnlockRequest(w, r)
 			} else {
 				http.NotFound(w, r)
 			}
@@ -133,6 +150,19 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 		w.WriteHeader(http.StatusNoContent)
 	}, opts2))
 
+	optsReport := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/credential-algorithms",
+		userHandler.HandleCredentialAlgorithmReportRequest, optsReport))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/credential-algorithms",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsReport))
+
 	optsSelf := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "PUT"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
@@ -169,6 +199,27 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 			w.WriteHeader(http.StatusNoContent)
 		}, optsSelfCredentials))
 
+	optsSelfSessions := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/sessions",
+		userHandler.HandleSelfUserSessionsGetRequest, optsSelfSessions))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/sessions",
+		userHandler.HandleSelfUserSessionsDeleteRequest, optsSelfSessions))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/sessions/{sessionId}",
+		userHandler.HandleSelfUserSessionDeleteRequest, optsSelfSessions))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/sessions",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfSessions))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/sessions/{sessionId}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfSessions))
+
 	opts3 := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
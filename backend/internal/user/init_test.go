@@ -46,3 +46,16 @@ func TestRegisterRoutes_SelfUserMetadata(t *testing.T) {
 	mux.ServeHTTP(optionsRr, optionsReq)
 	require.Equal(t, http.StatusNoContent, optionsRr.Code)
 }
+
+func TestRegisterRoutes_CredentialAlgorithmReport(t *testing.T) {
+	mux := http.NewServeMux()
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetCredentialAlgorithmReport", mock.Anything).Return(&CredentialAlgorithmReport{}, nil).Once()
+
+	handler := newUserHandler(mockSvc)
+	registerRoutes(mux, handler)
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/users/credential-algorithms", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+}
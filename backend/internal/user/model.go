@@ -5,7 +5,9 @@ package user
 
 import (
 	"encoding/json"
+	"time"
 
+	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -30,6 +32,42 @@ type Credential struct {
 	Value             string                   `json:"value"`
 }
 
+// CredentialAlgorithmReport summarizes the hashing algorithms used by stored user credentials. Credentials
+// pending upgrade are re-hashed with the configured algorithm the next time the user signs in with them.
+type CredentialAlgorithmReport struct {
+	TotalCredentials int                               `json:"totalCredentials"`
+	PendingUpgrade   int                               `json:"pendingUpgrade"`
+	Algorithms       []entity.CredentialAlgorithmUsage `json:"algorithms"`
+}
+
+// UserSession is a live sign-in session of a user. The id is the session's OpenID Connect session
+// identifier (sid); the device the user signed in from is described by the user agent it presented.
+type UserSession struct {
+	ID              string               `json:"id"`
+	Current         bool                 `json:"current"`
+	UserAgent       string               `json:"userAgent,omitempty"`
+	IPAddress       string               `json:"ipAddress,omitempty"`
+	CreatedAt       time.Time            `json:"createdAt"`
+	LastActiveAt    time.Time            `json:"lastActiveAt"`
+	AuthenticatedAt time.Time            `json:"authenticatedAt"`
+	ExpiresAt       time.Time            `json:"expiresAt"`
+	Applications    []SessionApplication `json:"applications"`
+}
+
+// SessionApplication is an application participating in a user session.
+type SessionApplication struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name,omitempty"`
+	FirstJoinedAt time.Time `json:"firstJoinedAt"`
+	LastActiveAt  time.Time `json:"lastActiveAt"`
+}
+
+// UserSessionListResponse represents the response for listing the live sessions of a user.
+type UserSessionListResponse struct {
+	TotalResults int           `json:"totalResults"`
+	Sessions     []UserSession `json:"sessions"`
+}
+
 // Credentials represents the credential storage structure where credentials are organized by type.
 // Key: Credential type (e.g., "password", "pin", "secret", "passkey")
 // Value: Array of credentials of that type
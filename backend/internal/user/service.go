@@ -12,15 +12,19 @@ import (
 	"path"
 	"sort"
 	"strings"
+	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
@@ -36,6 +40,8 @@ type UserServiceInterface interface {
 	GetUsersByPath(ctx context.Context, handlePath string, limit, offset int,
 		filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *tidcommon.ServiceError)
 	CreateUser(ctx context.Context, user *User) (*User, *tidcommon.ServiceError)
+	ImportUser(ctx context.Context, user *User,
+		credentials map[string][]entity.StoredCredential) (*User, *tidcommon.ServiceError)
 	CreateUserByPath(ctx context.Context, handlePath string,
 		request CreateUserByPathRequest) (*User, *tidcommon.ServiceError)
 	GetUser(ctx context.Context, userID string, includeDisplay bool) (*User, *tidcommon.ServiceError)
@@ -53,6 +59,15 @@ type UserServiceInterface interface {
 	SetDependencyRegistry(r resourcedependency.Registry)
 	GetUserUsages(ctx context.Context, userID string) (
 		*resourcedependency.DependenciesResponse, *tidcommon.ServiceError)
+	GetUserLockStatus(ctx context.Context, userID string) (*lockout.LockStatus, *tidcommon.ServiceError)
+	UnlockUser(ctx context.Context, userID string) *tidcommon.ServiceError
+	GetCredentialAlgorithmReport(ctx context.Context) (*CredentialAlgorithmReport, *tidcommon.ServiceError)
+	ExportUsers(ctx context.Context, filters map[string]interface{},
+		visit func(user *User) error) *tidcommon.ServiceError
+	SetSessionService(sessionService session.Service)
+	GetUserSessions(ctx context.Context, userID string) (*UserSessionListResponse, *tidcommon.ServiceError)
+	RevokeUserSession(ctx context.Context, userID, sessionID string) *tidcommon.ServiceError
+	RevokeUserSessions(ctx context.Context, userID, exceptSessionID string) *tidcommon.ServiceError
 }
 
 // userService is the default implementation of the UserServiceInterface.
@@ -63,6 +78,9 @@ type userService struct {
 	entityTypeService  entitytype.EntityTypeServiceInterface
 	uuidGenerator      func() (string, error)
 	dependencyRegistry resourcedependency.Registry
+	lockoutService     lockout.LockoutServiceInterface
+	sessionService     session.Service
+	auditRecorder      *audit.Recorder
 }
 
 // newUserService creates a new instance of userService with injected dependencies.
@@ -71,6 +89,8 @@ func newUserService(
 	entityService entity.EntityServiceInterface,
 	ouService oupkg.OrganizationUnitServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
+	lockoutService lockout.LockoutServiceInterface,
+	auditRecorder *audit.Recorder,
 ) UserServiceInterface {
 	return &userService{
 		authzService:      authzService,
@@ -78,6 +98,8 @@ func newUserService(
 		ouService:         ouService,
 		entityTypeService: entityTypeService,
 		uuidGenerator:     utils.GenerateUUIDv7,
+		lockoutService:    lockoutService,
+		auditRecorder:     auditRecorder,
 	}
 }
 
@@ -132,6 +154,53 @@ func (us *userService) listAllUsers(
 	return buildUserListResponse(users, totalCount, limit, offset, utils.DisplayQueryParam(includeDisplay)), nil
 }
 
+// ExportUsers calls visit with every user matching the filters in the organization units the caller is
+// authorized to list users from. Users are read in pages, so the export does not hold them in memory; an
+// error returned by visit stops the export.
+func (us *userService) ExportUsers(ctx context.Context, filters map[string]interface{},
+	visit func(user *User) error) *tidcommon.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	accessible, svcErr := us.authzService.GetAccessibleResources(
+		ctx, security.ActionListUsers, security.ResourceTypeOU)
+	if svcErr != nil {
+		logger.Error(ctx, "Failed to resolve accessible resources for exporting users",
+			log.Any("error", svcErr))
+		return &tidcommon.InternalServerError
+	}
+	if !accessible.AllAllowed && len(accessible.IDs) == 0 {
+		return nil
+	}
+
+	// A nil OU list reads the users of every OU.
+	var ouIDs []string
+	if !accessible.AllAllowed {
+		ouIDs = accessible.IDs
+	}
+	// Batches are read by keyset, each starting after the last user of the previous one, so that every
+	// batch costs the same however deep into the export it is.
+	afterID := ""
+	for {
+		entities, err := us.entityService.GetEntityListAfterID(
+			ctx, providers.EntityCategoryUser, ouIDs, userExportBatchSize, afterID, filters)
+		if err != nil {
+			return logErrorAndReturnServerError(ctx, logger, "Failed to get users for export", err)
+		}
+
+		for i := range entities {
+			user := entityToUser(&entities[i])
+			if err := visit(&user); err != nil {
+				logger.Debug(ctx, "User export stopped", log.Error(err))
+				return &tidcommon.InternalServerError
+			}
+		}
+		if len(entities) < userExportBatchSize {
+			return nil
+		}
+		afterID = entities[len(entities)-1].ID
+	}
+}
+
 // listUsersByOUIDs retrieves users scoped to the given organization unit IDs.
 func (us *userService) listUsersByOUIDs(
 	ctx context.Context, ouIDs []string, limit, offset int, filters map[string]interface{},
@@ -296,6 +365,20 @@ func (us *userService) GetUsersByPath(
 
 // CreateUser creates the user.
 func (us *userService) CreateUser(ctx context.Context, user *User) (*User, *tidcommon.ServiceError) {
+	return us.createUser(ctx, user, nil)
+}
+
+// ImportUser creates a new user with credentials hashed by the identity provider the user is migrated
+// from. The credentials are verified with their original algorithm until the user next signs in.
+func (us *userService) ImportUser(ctx context.Context, user *User,
+	credentials map[string][]entity.StoredCredential) (*User, *tidcommon.ServiceError) {
+	return us.createUser(ctx, user, credentials)
+}
+
+// createUser creates a new user, storing the given imported credentials alongside the credential
+// attributes of the user.
+func (us *userService) createUser(ctx context.Context, user *User,
+	importedCredentials map[string][]entity.StoredCredential) (*User, *tidcommon.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
 
 	if user == nil {
@@ -323,7 +406,12 @@ func (us *userService) CreateUser(ctx context.Context, user *User) (*User, *tidc
 	}
 
 	e := userToEntity(user)
-	created, err := us.entityService.CreateEntity(ctx, e, nil)
+	var created *providers.Entity
+	if len(importedCredentials) > 0 {
+		created, err = us.entityService.ImportEntity(ctx, e, importedCredentials)
+	} else {
+		created, err = us.entityService.CreateEntity(ctx, e, nil)
+	}
 	if err != nil {
 		if svcErr := mapEntityError(err); svcErr != nil {
 			return nil, svcErr
@@ -334,6 +422,7 @@ func (us *userService) CreateUser(ctx context.Context, user *User) (*User, *tidc
 	// Sync cleaned attributes back — entity service removed credential fields from Attributes.
 	user.Attributes = created.Attributes
 
+	us.auditRecorder.Created(ctx, audit.TargetUser, user.ID, user)
 	logger.Debug(ctx, "Successfully created user", log.MaskedString(log.LoggerKeyUserID, user.ID))
 	return user, nil
 }
@@ -596,6 +685,7 @@ func (us *userService) UpdateUser(
 
 	// Sync cleaned attributes back — entity service removed credential fields from Attributes.
 	user.Attributes = updated.Attributes
+	us.auditRecorder.Updated(ctx, audit.TargetUser, userID, existingUser, user)
 	logger.Debug(ctx, "Successfully updated user", log.MaskedString(log.LoggerKeyUserID, userID))
 	return user, nil
 }
@@ -669,6 +759,7 @@ func (us *userService) UpdateUserAttributes(
 		return nil, svcErr
 	}
 
+	previousUser := existingUser
 	existingUser.Attributes = attributes
 
 	if err := us.entityService.UpdateAttributes(ctx, userID, attributes); err != nil {
@@ -679,6 +770,7 @@ func (us *userService) UpdateUserAttributes(
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
 
+	us.auditRecorder.Updated(ctx, audit.TargetUser, userID, previousUser, existingUser)
 	logger.Debug(ctx, "Successfully updated user attributes", log.MaskedString(log.LoggerKeyUserID, userID))
 	return &existingUser, nil
 }
@@ -772,6 +864,18 @@ func (us *userService) UpdateUserCredentials(
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
 
+	credentialTypes := make([]string, 0, len(plaintextCreds))
+	for credType := range plaintextCreds {
+		credentialTypes = append(credentialTypes, credType)
+	}
+	sort.Strings(credentialTypes)
+	us.auditRecorder.Record(ctx, audit.Entry{
+		Operation:  audit.OperationUpdate,
+		TargetType: audit.TargetUser,
+		TargetID:   userID,
+		Action:     "user.credentials.update",
+		After:      map[string]interface{}{"credentialTypes": credentialTypes},
+	})
 	logger.Debug(ctx, "Successfully updated user credentials",
 		log.MaskedString(log.LoggerKeyUserID, userID),
 		log.Int("credentialTypesCount", len(credentialsMap)))
@@ -863,6 +967,7 @@ func (us *userService) DeleteUser(ctx context.Context, userID string) *tidcommon
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
 
+	us.auditRecorder.Deleted(ctx, audit.TargetUser, userID, existingUser)
 	logger.Debug(ctx, "Successfully deleted user", log.MaskedString(log.LoggerKeyUserID, userID))
 	return nil
 }
@@ -976,6 +1081,310 @@ func (us *userService) GetUserUsages(
 	return result, nil
 }
 
+// GetUserLockStatus returns the account lockout state of the user.
+func (us *userService) GetUserLockStatus(
+	ctx context.Context, userID string,
+) (*lockout.LockStatus, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if svcErr := us.checkLockoutAccess(ctx, security.ActionReadUser, userID, logger); svcErr != nil {
+		return nil, svcErr
+	}
+
+	status, svcErr := us.lockoutService.GetLockStatus(ctx, userID)
+	if svcErr != nil {
+		return nil, mapLockoutError(ctx, logger, svcErr, userID)
+	}
+	return status, nil
+}
+
+// UnlockUser removes any account lock on the user and clears its failed authentication attempts.
+func (us *userService) UnlockUser(ctx context.Context, userID string) *tidcommon.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if svcErr := us.checkLockoutAccess(ctx, security.ActionUpdateUser, userID, logger); svcErr != nil {
+		return svcErr
+	}
+
+	if svcErr := us.lockoutService.Unlock(ctx, userID); svcErr != nil {
+		return mapLockoutError(ctx, logger, svcErr, userID)
+	}
+
+	us.auditRecorder.Record(ctx, audit.Entry{
+		Operation:  audit.OperationUpdate,
+		TargetType: audit.TargetUser,
+		TargetID:   userID,
+		Action:     "user.unlock",
+	})
+	logger.Debug(ctx, "Successfully unlocked user", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// SetSessionService injects the SSO session service. Called by servicemanager once the session service
+// is initialized, which happens after the user service.
+func (us *userService) SetSessionService(sessionService session.Service) {
+	us.sessionService = sessionService
+}
+
+// GetUserSessions returns the live sessions of the user, most recently active first, each with the
+// applications participating in it. The session the caller is signed in with is flagged as current.
+func (us *userService) GetUserSessions(
+	ctx context.Context, userID string,
+) (*UserSessionListResponse, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if svcErr := us.checkSessionAccess(ctx, security.ActionReadUser, userID, logger); svcErr != nil {
+		return nil, svcErr
+	}
+
+	details, err := us.sessionService.ListSubjectSessions(ctx, userID, time.Now())
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to list user sessions", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	currentSID := currentSessionID(ctx, userID)
+	appNames := make(map[string]string)
+	sessions := make([]UserSession, 0, len(details))
+	for _, detail := range details {
+		sess := detail.Session
+		apps := make([]SessionApplication, 0, len(detail.Participants))
+		for _, participant := range detail.Participants {
+			apps = append(apps, SessionApplication{
+				ID:            participant.AppID,
+				Name:          us.resolveApplicationName(ctx, participant.AppID, appNames, logger),
+				FirstJoinedAt: participant.FirstJoinedAt,
+				LastActiveAt:  participant.LastActiveAt,
+			})
+		}
+		sid := sess.SID()
+		sessions = append(sessions, UserSession{
+			ID:              sid,
+			Current:         currentSID != "" && sid == currentSID,
+			UserAgent:       sess.UserAgent,
+			IPAddress:       sess.IPAddress,
+			CreatedAt:       sess.CreatedAt,
+			LastActiveAt:    sess.LastActiveAt,
+			AuthenticatedAt: sess.AuthenticatedAt,
+			ExpiresAt:       sessionExpiry(sess),
+			Applications:    apps,
+		})
+	}
+
+	return &UserSessionListResponse{TotalResults: len(sessions), Sessions: sessions}, nil
+}
+
+// RevokeUserSession ends a single session of the user, revoking the tokens issued to the applications
+// participating in it.
+func (us *userService) RevokeUserSession(ctx context.Context, userID, sessionID string) *tidcommon.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if svcErr := us.checkSessionAccess(ctx, security.ActionUpdateUser, userID, logger); svcErr != nil {
+		return svcErr
+	}
+	if sessionID == "" {
+		return &ErrorSessionNotFound
+	}
+
+	ended, err := us.sessionService.TerminateSubjectSession(ctx, userID, sessionID)
+	if err != nil {
+		return logErrorAndReturnServerError(ctx, logger, "Failed to revoke user session", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if ended == nil {
+		return &ErrorSessionNotFound
+	}
+
+	us.auditRecorder.Record(ctx, audit.Entry{
+		Operation:  audit.OperationDelete,
+		TargetType: audit.TargetSession,
+		TargetID:   sessionID,
+		Action:     "session.revoke",
+		Before:     map[string]string{"userId": userID},
+	})
+	logger.Debug(ctx, "Successfully revoked user session", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// RevokeUserSessions ends every session of the user except the one identified by exceptSessionID, or
+// all of them when it is empty, revoking the tokens issued to the applications participating in them.
+func (us *userService) RevokeUserSessions(
+	ctx context.Context, userID, exceptSessionID string,
+) *tidcommon.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if svcErr := us.checkSessionAccess(ctx, security.ActionUpdateUser, userID, logger); svcErr != nil {
+		return svcErr
+	}
+
+	count, err := us.sessionService.TerminateOtherSubjectSessions(ctx, userID, exceptSessionID)
+	if err != nil {
+		return logErrorAndReturnServerError(ctx, logger, "Failed to revoke user sessions", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if count == 0 {
+		return nil
+	}
+
+	us.auditRecorder.Record(ctx, audit.Entry{
+		Operation:  audit.OperationDelete,
+		TargetType: audit.TargetUser,
+		TargetID:   userID,
+		Action:     "user.sessions.revoke",
+		Before:     map[string]int{"sessions": count},
+	})
+	logger.Debug(ctx, "Successfully revoked user sessions", log.MaskedString(log.LoggerKeyUserID, userID),
+		log.Int("sessionCount", count))
+	return nil
+}
+
+// GetCredentialAlgorithmReport reports how stored user credentials are distributed across hashing
+// algorithms. The report spans every organization unit, so it is only available to system-level callers.
+func (us *userService) GetCredentialAlgorithmReport(
+	ctx context.Context,
+) (*CredentialAlgorithmReport, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	accessible, svcErr := us.authzService.GetAccessibleResources(
+		ctx, security.ActionListUsers, security.ResourceTypeOU)
+	if svcErr != nil {
+		logger.Error(ctx, "Failed to resolve accessible resources for the credential algorithm report",
+			log.Any("error", svcErr))
+		return nil, &tidcommon.InternalServerError
+	}
+	if !accessible.AllAllowed {
+		return nil, &tidcommon.ErrorUnauthorized
+	}
+
+	usage, err := us.entityService.GetCredentialAlgorithmUsage(ctx, providers.EntityCategoryUser)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to get credential algorithm usage", err)
+	}
+
+	report := &CredentialAlgorithmReport{Algorithms: usage}
+	for _, entry := range usage {
+		report.TotalCredentials += entry.Count
+		report.PendingUpgrade += entry.PendingUpgrade
+	}
+	return report, nil
+}
+
+// checkLockoutAccess verifies that the user exists and that the caller may perform the given action on
+// its lockout state.
+func (us *userService) checkLockoutAccess(
+	ctx context.Context, action security.Action, userID string, logger *log.Logger,
+) *tidcommon.ServiceError {
+	if userID == "" {
+		return &ErrorMissingUserID
+	}
+
+	existingEntity, err := us.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return &ErrorUserNotFound
+		}
+		return logErrorAndReturnServerError(ctx, logger, "Failed to retrieve user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if existingEntity.Category != providers.EntityCategoryUser {
+		return &ErrorUserNotFound
+	}
+
+	if us.lockoutService == nil {
+		logger.Error(ctx, "Lockout service is not configured")
+		return &tidcommon.InternalServerError
+	}
+	return us.checkUserAccess(ctx, action, existingEntity.OUID, userID)
+}
+
+// checkSessionAccess verifies that the user exists and that the caller may perform the given action on
+// its sessions. Users always pass for their own sessions, as resource owners.
+func (us *userService) checkSessionAccess(
+	ctx context.Context, action security.Action, userID string, logger *log.Logger,
+) *tidcommon.ServiceError {
+	if userID == "" {
+		return &ErrorMissingUserID
+	}
+
+	existingEntity, err := us.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return &ErrorUserNotFound
+		}
+		return logErrorAndReturnServerError(ctx, logger, "Failed to retrieve user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if existingEntity.Category != providers.EntityCategoryUser {
+		return &ErrorUserNotFound
+	}
+
+	if us.sessionService == nil {
+		logger.Error(ctx, "Session service is not configured")
+		return &tidcommon.InternalServerError
+	}
+	return us.checkUserAccess(ctx, action, existingEntity.OUID, userID)
+}
+
+// resolveApplicationName returns the name of a session participant, or an empty string when the
+// application no longer exists. Names are memoized in cache, since applications recur across sessions.
+func (us *userService) resolveApplicationName(
+	ctx context.Context, appID string, cache map[string]string, logger *log.Logger,
+) string {
+	if name, ok := cache[appID]; ok {
+		return name
+	}
+
+	name := ""
+	app, err := us.entityService.GetEntity(ctx, appID)
+	switch {
+	case err != nil:
+		if !errors.Is(err, entity.ErrEntityNotFound) {
+			logger.Warn(ctx, "Failed to resolve session application, skipping its name",
+				log.String("appId", appID), log.Error(err))
+		}
+	case app.Category == providers.EntityCategoryApp && len(app.SystemAttributes) > 0:
+		var attrs struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(app.SystemAttributes, &attrs); err == nil {
+			name = attrs.Name
+		}
+	}
+	cache[appID] = name
+	return name
+}
+
+// currentSessionID returns the session id (sid) of the caller's token when the caller is the given
+// user, or an empty string otherwise.
+func currentSessionID(ctx context.Context, userID string) string {
+	if security.GetSubject(ctx) != userID {
+		return ""
+	}
+	sid, _ := security.GetAttribute(ctx, claimSessionID).(string)
+	return sid
+}
+
+// sessionExpiry returns when the session lapses if left idle: the earlier of its idle and absolute
+// deadlines.
+func sessionExpiry(sess session.Session) time.Time {
+	if sess.AbsoluteExpiresAt.IsZero() ||
+		(!sess.IdleExpiresAt.IsZero() && sess.IdleExpiresAt.Before(sess.AbsoluteExpiresAt)) {
+		return sess.IdleExpiresAt
+	}
+	return sess.AbsoluteExpiresAt
+}
+
+// mapLockoutError maps an error returned by the lockout service to a user service error.
+func mapLockoutError(ctx context.Context, logger *log.Logger, svcErr *tidcommon.ServiceError,
+	userID string) *tidcommon.ServiceError {
+	if svcErr.Code == lockout.ErrorEntityNotFound.Code {
+		return &ErrorUserNotFound
+	}
+	logger.Error(ctx, "Lockout service returned an error", log.MaskedString(log.LoggerKeyUserID, userID),
+		log.String("errorCode", svcErr.Code))
+	return &tidcommon.InternalServerError
+}
+
 // populateUserDisplayNames resolves display names for a slice of users in-place.
 // It batch-fetches display attribute paths from the entity type service and extracts the
 // display value from each user's attributes. Falls back to user ID if extraction fails.
@@ -1154,7 +1563,10 @@ func logErrorAndReturnServerError(ctx context.Context,
 // mapEntityError maps entity service errors to user service errors.
 // Returns nil if the error is not a recognized entity error.
 func mapEntityError(err error) *tidcommon.ServiceError {
+	var policyErr *entity.CredentialPolicyError
 	switch {
+	case errors.As(err, &policyErr):
+		return policyErr.Violation
 	case errors.Is(err, entity.ErrEntityNotFound):
 		return &ErrorUserNotFound
 	case errors.Is(err, entity.ErrAuthenticationFailed):
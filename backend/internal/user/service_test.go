@@ -7,7 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
@@ -16,7 +18,10 @@ import (
 
 	entitypkg "github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/lockout"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -25,6 +30,8 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/sessionmock"
+	"github.com/thunder-id/thunderid/tests/mocks/lockoutmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
 	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
 )
@@ -634,6 +641,15 @@ func TestUserService_UpdateUserCredentials_Rejections(t *testing.T) {
 			payload:     `{"password":[{"value":"password1"}, {"value":"password2"}]}`,
 			wantErrCode: ErrorInvalidRequestFormat.Code,
 		},
+		{
+			// Credential policy violations surface the policy's own error.
+			name:    "RejectsPolicyViolations",
+			payload: `{"password":"short"}`,
+			mockEntityErr: &entitypkg.CredentialPolicyError{
+				Violation: &tidcommon.ServiceError{Type: tidcommon.ClientErrorType, Code: "PWP-1001"},
+			},
+			wantErrCode: "PWP-1001",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -645,6 +661,10 @@ func TestUserService_UpdateUserCredentials_Rejections(t *testing.T) {
 					Category: providers.EntityCategoryUser, ID: svcTestUserID1, Type: "Person",
 				}, nil).
 				Maybe()
+			if tc.mockEntityErr != nil {
+				userStoreMock.On("UpdateCredentials", mock.Anything, svcTestUserID1, mock.Anything).
+					Return(tc.mockEntityErr).Once()
+			}
 
 			service := &userService{
 				entityService: userStoreMock,
@@ -1852,7 +1872,7 @@ func TestUserService_GetUsersByPath_WithIncludeDisplay_BatchFetchError(t *testin
 }
 
 func TestNewFunctions(t *testing.T) {
-	svc := newUserService(nil, nil, nil, nil)
+	svc := newUserService(nil, nil, nil, nil, nil, nil)
 	require.NotNil(t, svc)
 
 	handler := newUserHandler(svc)
@@ -3923,3 +3943,630 @@ func TestGetUserMetadata_GetEntityTypeSchemaError(t *testing.T) {
 	require.NotNil(t, svcErr)
 	require.Equal(t, entitytype.ErrorEntityTypeNotFound.Code, svcErr.Code)
 }
+
+func TestUserService_GetUserLockStatus(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(newUserForUsages(svcTestUserID1), nil).Once()
+	lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+	lockoutMock.EXPECT().GetLockStatus(mock.Anything, svcTestUserID1).
+		Return(&lockout.LockStatus{Locked: true, LockCount: 1}, nil).Once()
+
+	service := &userService{
+		entityService:  entityMock,
+		authzService:   newAllowAllAuthz(t),
+		lockoutService: lockoutMock,
+	}
+
+	status, err := service.GetUserLockStatus(context.Background(), svcTestUserID1)
+	require.Nil(t, err)
+	require.True(t, status.Locked)
+	require.Equal(t, 1, status.LockCount)
+}
+
+func TestUserService_GetUserLockStatus_ErrorCases(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      string
+		setup       func(t *testing.T) *userService
+		wantErrCode string
+	}{
+		{
+			name:        "MissingID",
+			userID:      "",
+			setup:       func(t *testing.T) *userService { return &userService{} },
+			wantErrCode: ErrorMissingUserID.Code,
+		},
+		{
+			name:   "UserNotFound",
+			userID: svcTestUserID1,
+			setup: func(t *testing.T) *userService {
+				entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+				entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+					Return((*providers.Entity)(nil), entitypkg.ErrEntityNotFound).Once()
+				return &userService{entityService: entityMock}
+			},
+			wantErrCode: ErrorUserNotFound.Code,
+		},
+		{
+			name:   "WrongCategory",
+			userID: svcTestUserID1,
+			setup: func(t *testing.T) *userService {
+				entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+				entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+					Return(&providers.Entity{ID: svcTestUserID1, Category: providers.EntityCategoryAgent}, nil).Once()
+				return &userService{entityService: entityMock}
+			},
+			wantErrCode: ErrorUserNotFound.Code,
+		},
+		{
+			name:   "AuthzDenied",
+			userID: svcTestUserID1,
+			setup: func(t *testing.T) *userService {
+				entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+				entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+					Return(newUserForUsages(svcTestUserID1), nil).Once()
+				authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+				authzMock.On("IsActionAllowed", mock.Anything, security.ActionReadUser, mock.Anything).
+					Return(false, nil).Once()
+				return &userService{
+					entityService:  entityMock,
+					authzService:   authzMock,
+					lockoutService: lockoutmock.NewLockoutServiceInterfaceMock(t),
+				}
+			},
+			wantErrCode: tidcommon.ErrorUnauthorized.Code,
+		},
+		{
+			name:   "LockoutServiceError",
+			userID: svcTestUserID1,
+			setup: func(t *testing.T) *userService {
+				entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+				entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+					Return(newUserForUsages(svcTestUserID1), nil).Once()
+				lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+				lockoutMock.EXPECT().GetLockStatus(mock.Anything, svcTestUserID1).
+					Return(nil, &tidcommon.InternalServerError).Once()
+				return &userService{
+					entityService:  entityMock,
+					authzService:   newAllowAllAuthz(t),
+					lockoutService: lockoutMock,
+				}
+			},
+			wantErrCode: tidcommon.InternalServerError.Code,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service := tc.setup(t)
+
+			status, err := service.GetUserLockStatus(context.Background(), tc.userID)
+			require.Nil(t, status)
+			require.NotNil(t, err)
+			require.Equal(t, tc.wantErrCode, err.Code)
+		})
+	}
+}
+
+func TestUserService_UnlockUser(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(newUserForUsages(svcTestUserID1), nil).Once()
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("IsActionAllowed", mock.Anything, security.ActionUpdateUser, mock.Anything).
+		Return(true, nil).Once()
+	lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+	lockoutMock.EXPECT().Unlock(mock.Anything, svcTestUserID1).Return(nil).Once()
+
+	service := &userService{
+		entityService:  entityMock,
+		authzService:   authzMock,
+		lockoutService: lockoutMock,
+	}
+
+	require.Nil(t, service.UnlockUser(context.Background(), svcTestUserID1))
+}
+
+func TestUserService_UnlockUser_EntityRemovedConcurrently(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(newUserForUsages(svcTestUserID1), nil).Once()
+	lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+	lockoutMock.EXPECT().Unlock(mock.Anything, svcTestUserID1).Return(&lockout.ErrorEntityNotFound).Once()
+
+	service := &userService{
+		entityService:  entityMock,
+		authzService:   newAllowAllAuthz(t),
+		lockoutService: lockoutMock,
+	}
+
+	err := service.UnlockUser(context.Background(), svcTestUserID1)
+	require.NotNil(t, err)
+	require.Equal(t, ErrorUserNotFound.Code, err.Code)
+}
+
+func TestUserService_GetUserSessions(t *testing.T) {
+	now := time.Now()
+	current := session.Session{
+		SessionID:         "session-internal-1",
+		SubjectID:         svcTestUserID1,
+		UserAgent:         "Mozilla/5.0 (X11; Linux x86_64)",
+		IPAddress:         "192.0.2.10",
+		CreatedAt:         now.Add(-2 * time.Hour),
+		LastActiveAt:      now.Add(-time.Minute),
+		AuthenticatedAt:   now.Add(-2 * time.Hour),
+		IdleExpiresAt:     now.Add(30 * time.Minute),
+		AbsoluteExpiresAt: now.Add(6 * time.Hour),
+	}
+	other := session.Session{
+		SessionID:         "session-internal-2",
+		SubjectID:         svcTestUserID1,
+		CreatedAt:         now.Add(-10 * time.Hour),
+		LastActiveAt:      now.Add(-time.Hour),
+		IdleExpiresAt:     now.Add(time.Hour),
+		AbsoluteExpiresAt: now.Add(20 * time.Minute),
+	}
+
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(newUserForUsages(svcTestUserID1), nil).Once()
+	entityMock.On("GetEntity", mock.Anything, "app-1").Return(&providers.Entity{
+		ID:               "app-1",
+		Category:         providers.EntityCategoryApp,
+		SystemAttributes: json.RawMessage(`{"name":"Portal","clientId":"portal"}`),
+	}, nil).Once()
+	entityMock.On("GetEntity", mock.Anything, "app-deleted").
+		Return((*providers.Entity)(nil), entitypkg.ErrEntityNotFound).Once()
+
+	sessionMock := sessionmock.NewServiceMock(t)
+	sessionMock.On("ListSubjectSessions", mock.Anything, svcTestUserID1, mock.Anything).
+		Return([]session.SessionDetail{
+			{Session: current, Participants: []session.Participant{
+				{AppID: "app-1", FirstJoinedAt: current.CreatedAt, LastActiveAt: current.LastActiveAt},
+			}},
+			{Session: other, Participants: []session.Participant{
+				{AppID: "app-1"}, {AppID: "app-deleted"},
+			}},
+		}, nil).Once()
+
+	service := &userService{
+		entityService:  entityMock,
+		authzService:   newAllowAllAuthz(t),
+		sessionService: sessionMock,
+	}
+	ctx := security.WithSecurityContextTest(context.Background(), security.NewSecurityContextForTest(
+		svcTestUserID1, "", "", nil, map[string]interface{}{claimSessionID: current.SID()}))
+
+	result, err := service.GetUserSessions(ctx, svcTestUserID1)
+	require.Nil(t, err)
+	require.Equal(t, 2, result.TotalResults)
+
+	first := result.Sessions[0]
+	require.Equal(t, current.SID(), first.ID)
+	require.True(t, first.Current)
+	require.Equal(t, current.UserAgent, first.UserAgent)
+	require.Equal(t, current.IPAddress, first.IPAddress)
+	require.Equal(t, current.IdleExpiresAt, first.ExpiresAt)
+	require.Equal(t, []SessionApplication{{
+		ID: "app-1", Name: "Portal", FirstJoinedAt: current.CreatedAt, LastActiveAt: current.LastActiveAt,
+	}}, first.Applications)
+
+	second := result.Sessions[1]
+	require.False(t, second.Current)
+	require.Equal(t, other.AbsoluteExpiresAt, second.ExpiresAt)
+	require.Len(t, second.Applications, 2)
+	require.Equal(t, "Portal", second.Applications[0].Name)
+	require.Empty(t, second.Applications[1].Name)
+}
+
+func TestUserService_GetUserSessions_NotCurrentForOtherCaller(t *testing.T) {
+	sess := session.Session{SessionID: "session-internal-1", SubjectID: svcTestUserID1}
+
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(newUserForUsages(svcTestUserID1), nil).Once()
+	sessionMock := sessionmock.NewServiceMock(t)
+	sessionMock.On("ListSubjectSessions", mock.Anything, svcTestUserID1, mock.Anything).
+		Return([]session.SessionDetail{{Session: sess}}, nil).Once()
+
+	service := &userService{
+		entityService:  entityMock,
+		authzService:   newAllowAllAuthz(t),
+		sessionService: sessionMock,
+	}
+	// An administrator's token carries their own sid, which must not mark the user's session as current.
+	ctx := security.WithSecurityContextTest(context.Background(), security.NewSecurityContextForTest(
+		"admin-1", "", "", nil, map[string]interface{}{claimSessionID: sess.SID()}))
+
+	result, err := service.GetUserSessions(ctx, svcTestUserID1)
+	require.Nil(t, err)
+	require.Len(t, result.Sessions, 1)
+	require.False(t, result.Sessions[0].Current)
+	require.Empty(t, result.Sessions[0].Applications)
+}
+
+func TestUserService_GetUserSessions_ErrorCases(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      string
+		setup       func(t *testing.T) *userService
+		wantErrCode string
+	}{
+		{
+			name:        "MissingID",
+			userID:      "",
+			setup:       func(t *testing.T) *userService { return &userService{} },
+			wantErrCode: ErrorMissingUserID.Code,
+		},
+		{
+			name:   "UserNotFound",
+			userID: svcTestUserID1,
+			setup: func(t *testing.T) *userService {
+				entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+				entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+					Return((*providers.Entity)(nil), entitypkg.ErrEntityNotFound).Once()
+				return &userService{entityService: entityMock}
+			},
+			wantErrCode: ErrorUserNotFound.Code,
+		},
+		{
+			name:   "SessionServiceNotConfigured",
+			userID: svcTestUserID1,
+			setup: func(t *testing.T) *userService {
+				entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+				entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+					Return(newUserForUsages(svcTestUserID1), nil).Once()
+				return &userService{entityService: entityMock}
+			},
+			wantErrCode: tidcommon.InternalServerError.Code,
+		},
+		{
+			name:   "AuthzDenied",
+			userID: svcTestUserID1,
+			setup: func(t *testing.T) *userService {
+				entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+				entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+					Return(newUserForUsages(svcTestUserID1), nil).Once()
+				authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+				authzMock.On("IsActionAllowed", mock.Anything, security.ActionReadUser, mock.Anything).
+					Return(false, nil).Once()
+				return &userService{
+					entityService:  entityMock,
+					authzService:   authzMock,
+					sessionService: sessionmock.NewServiceMock(t),
+				}
+			},
+			wantErrCode: tidcommon.ErrorUnauthorized.Code,
+		},
+		{
+			name:   "ListError",
+			userID: svcTestUserID1,
+			setup: func(t *testing.T) *userService {
+				entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+				entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+					Return(newUserForUsages(svcTestUserID1), nil).Once()
+				sessionMock := sessionmock.NewServiceMock(t)
+				sessionMock.On("ListSubjectSessions", mock.Anything, svcTestUserID1, mock.Anything).
+					Return(nil, errors.New("db down")).Once()
+				return &userService{
+					entityService:  entityMock,
+					authzService:   newAllowAllAuthz(t),
+					sessionService: sessionMock,
+				}
+			},
+			wantErrCode: tidcommon.InternalServerError.Code,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service := tc.setup(t)
+
+			result, err := service.GetUserSessions(context.Background(), tc.userID)
+			require.Nil(t, result)
+			require.NotNil(t, err)
+			require.Equal(t, tc.wantErrCode, err.Code)
+		})
+	}
+}
+
+// newSessionRevokingService returns a user service for svcTestUserID1 whose caller may update the user.
+func newSessionRevokingService(t *testing.T, sessionMock *sessionmock.ServiceMock) *userService {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(newUserForUsages(svcTestUserID1), nil).Once()
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("IsActionAllowed", mock.Anything, security.ActionUpdateUser, mock.Anything).
+		Return(true, nil).Once()
+	return &userService{
+		entityService:  entityMock,
+		authzService:   authzMock,
+		sessionService: sessionMock,
+	}
+}
+
+func TestUserService_RevokeUserSession(t *testing.T) {
+	sessionMock := sessionmock.NewServiceMock(t)
+	sessionMock.On("TerminateSubjectSession", mock.Anything, svcTestUserID1, "sid-1").
+		Return(&session.Session{SessionID: "session-internal-1"}, nil).Once()
+
+	service := newSessionRevokingService(t, sessionMock)
+
+	require.Nil(t, service.RevokeUserSession(context.Background(), svcTestUserID1, "sid-1"))
+}
+
+func TestUserService_RevokeUserSession_ErrorCases(t *testing.T) {
+	t.Run("UnknownSession", func(t *testing.T) {
+		sessionMock := sessionmock.NewServiceMock(t)
+		sessionMock.On("TerminateSubjectSession", mock.Anything, svcTestUserID1, "sid-unknown").
+			Return(nil, nil).Once()
+		service := newSessionRevokingService(t, sessionMock)
+
+		err := service.RevokeUserSession(context.Background(), svcTestUserID1, "sid-unknown")
+		require.NotNil(t, err)
+		require.Equal(t, ErrorSessionNotFound.Code, err.Code)
+	})
+
+	t.Run("MissingSessionID", func(t *testing.T) {
+		service := newSessionRevokingService(t, sessionmock.NewServiceMock(t))
+
+		err := service.RevokeUserSession(context.Background(), svcTestUserID1, "")
+		require.NotNil(t, err)
+		require.Equal(t, ErrorSessionNotFound.Code, err.Code)
+	})
+
+	t.Run("TerminateError", func(t *testing.T) {
+		sessionMock := sessionmock.NewServiceMock(t)
+		sessionMock.On("TerminateSubjectSession", mock.Anything, svcTestUserID1, "sid-1").
+			Return(nil, errors.New("revocation failed")).Once()
+		service := newSessionRevokingService(t, sessionMock)
+
+		err := service.RevokeUserSession(context.Background(), svcTestUserID1, "sid-1")
+		require.NotNil(t, err)
+		require.Equal(t, tidcommon.InternalServerError.Code, err.Code)
+	})
+}
+
+func TestUserService_RevokeUserSessions(t *testing.T) {
+	sessionMock := sessionmock.NewServiceMock(t)
+	sessionMock.On("TerminateOtherSubjectSessions", mock.Anything, svcTestUserID1, "sid-current").
+		Return(2, nil).Once()
+
+	service := newSessionRevokingService(t, sessionMock)
+
+	require.Nil(t, service.RevokeUserSessions(context.Background(), svcTestUserID1, "sid-current"))
+}
+
+func TestUserService_RevokeUserSessions_TerminateError(t *testing.T) {
+	sessionMock := sessionmock.NewServiceMock(t)
+	sessionMock.On("TerminateOtherSubjectSessions", mock.Anything, svcTestUserID1, "").
+		Return(0, errors.New("revocation failed")).Once()
+
+	service := newSessionRevokingService(t, sessionMock)
+
+	err := service.RevokeUserSessions(context.Background(), svcTestUserID1, "")
+	require.NotNil(t, err)
+	require.Equal(t, tidcommon.InternalServerError.Code, err.Code)
+}
+
+func TestUserService_GetCredentialAlgorithmReport(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetCredentialAlgorithmUsage", mock.Anything, providers.EntityCategoryUser).
+		Return([]entitypkg.CredentialAlgorithmUsage{
+			{CredentialType: "password", Algorithm: cryptolib.ARGON2ID, Count: 4},
+			{CredentialType: "password", Algorithm: cryptolib.SHA256, Count: 3, PendingUpgrade: 3},
+		}, nil).Once()
+
+	service := &userService{
+		entityService: entityMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+
+	report, err := service.GetCredentialAlgorithmReport(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 7, report.TotalCredentials)
+	require.Equal(t, 3, report.PendingUpgrade)
+	require.Len(t, report.Algorithms, 2)
+}
+
+func TestUserService_GetCredentialAlgorithmReport_ErrorCases(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(t *testing.T) *userService
+		wantErrCode string
+	}{
+		{
+			name: "NotSystemLevel",
+			setup: func(t *testing.T) *userService {
+				authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+				authzMock.On("GetAccessibleResources", mock.Anything, security.ActionListUsers,
+					security.ResourceTypeOU).Return(&sysauthz.AccessibleResources{IDs: []string{"ou1"}}, nil).Once()
+				return &userService{authzService: authzMock}
+			},
+			wantErrCode: tidcommon.ErrorUnauthorized.Code,
+		},
+		{
+			name: "AuthzError",
+			setup: func(t *testing.T) *userService {
+				authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+				authzMock.On("GetAccessibleResources", mock.Anything, security.ActionListUsers,
+					security.ResourceTypeOU).Return(nil, &tidcommon.InternalServerError).Once()
+				return &userService{authzService: authzMock}
+			},
+			wantErrCode: tidcommon.InternalServerError.Code,
+		},
+		{
+			name: "EntityServiceError",
+			setup: func(t *testing.T) *userService {
+				entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+				entityMock.On("GetCredentialAlgorithmUsage", mock.Anything, providers.EntityCategoryUser).
+					Return(nil, errors.New("store error")).Once()
+				return &userService{entityService: entityMock, authzService: newAllowAllAuthz(t)}
+			},
+			wantErrCode: tidcommon.InternalServerError.Code,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			report, err := tc.setup(t).GetCredentialAlgorithmReport(context.Background())
+			require.Nil(t, report)
+			require.NotNil(t, err)
+			require.Equal(t, tc.wantErrCode, err.Code)
+		})
+	}
+}
+
+func TestUserService_ImportUser_CallsImportEntity(t *testing.T) {
+	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+	ouServiceMock.On("IsOrganizationUnitExists", mock.Anything, testOrgID).
+		Return(true, (*tidcommon.ServiceError)(nil)).Once()
+
+	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeMock.On("GetEntityTypeByName", mock.Anything, mock.Anything, testUserType).
+		Return(&entitytype.EntityType{OUID: testOrgID}, (*tidcommon.ServiceError)(nil)).Once()
+
+	credentials := map[string][]entitypkg.StoredCredential{
+		"password": {{StorageAlgo: cryptolib.BCRYPT, Value: "$2a$10$hash"}},
+	}
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("ImportEntity", mock.Anything, mock.MatchedBy(func(e *providers.Entity) bool {
+		return e.OUID == testOrgID && e.Type == testUserType && e.ID != ""
+	}), credentials).
+		Return(&providers.Entity{OUID: testOrgID, Type: testUserType, Attributes: json.RawMessage(`{}`)}, nil).
+		Once()
+
+	service := &userService{
+		entityService:     entityMock,
+		ouService:         ouServiceMock,
+		entityTypeService: entityTypeMock,
+		authzService:      newAllowAllAuthz(t),
+		uuidGenerator:     utils.GenerateUUIDv7,
+	}
+
+	created, err := service.ImportUser(context.Background(), &User{
+		Type: testUserType, OUID: testOrgID, Attributes: json.RawMessage(`{}`),
+	}, credentials)
+	require.Nil(t, err)
+	require.NotEmpty(t, created.ID)
+	entityMock.AssertNotCalled(t, "CreateEntity", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserService_ImportUser_MapsInvalidCredential(t *testing.T) {
+	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+	ouServiceMock.On("IsOrganizationUnitExists", mock.Anything, testOrgID).
+		Return(true, (*tidcommon.ServiceError)(nil)).Once()
+
+	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeMock.On("GetEntityTypeByName", mock.Anything, mock.Anything, testUserType).
+		Return(&entitytype.EntityType{OUID: testOrgID}, (*tidcommon.ServiceError)(nil)).Once()
+
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("ImportEntity", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, entitypkg.ErrInvalidCredential).Once()
+
+	service := &userService{
+		entityService:     entityMock,
+		ouService:         ouServiceMock,
+		entityTypeService: entityTypeMock,
+		authzService:      newAllowAllAuthz(t),
+		uuidGenerator:     utils.GenerateUUIDv7,
+	}
+
+	created, err := service.ImportUser(context.Background(), &User{Type: testUserType, OUID: testOrgID},
+		map[string][]entitypkg.StoredCredential{"password": {{StorageAlgo: "MD5", Value: "hash"}}})
+	require.Nil(t, created)
+	require.NotNil(t, err)
+	require.Equal(t, ErrorInvalidCredential.Code, err.Code)
+}
+
+func TestUserService_ExportUsers_PagesThroughAllUsers(t *testing.T) {
+	firstPage := make([]providers.Entity, userExportBatchSize)
+	for i := range firstPage {
+		firstPage[i] = providers.Entity{ID: fmt.Sprintf("user-%d", i), Category: providers.EntityCategoryUser}
+	}
+	filters := map[string]interface{}{"email": "a@example.com"}
+
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntityListAfterID", mock.Anything, providers.EntityCategoryUser, []string(nil),
+		userExportBatchSize, "", filters).
+		Return(firstPage, nil).Once()
+	// The second batch starts after the last user of the first.
+	entityMock.On("GetEntityListAfterID", mock.Anything, providers.EntityCategoryUser, []string(nil),
+		userExportBatchSize, fmt.Sprintf("user-%d", userExportBatchSize-1), filters).
+		Return([]providers.Entity{{ID: "last", Category: providers.EntityCategoryUser}}, nil).Once()
+
+	service := &userService{entityService: entityMock, authzService: newAllowAllAuthz(t)}
+
+	var exported []string
+	err := service.ExportUsers(context.Background(), filters, func(user *User) error {
+		exported = append(exported, user.ID)
+		return nil
+	})
+	require.Nil(t, err)
+	require.Len(t, exported, userExportBatchSize+1)
+	require.Equal(t, "last", exported[userExportBatchSize])
+}
+
+func TestUserService_ExportUsers_ScopedByOUIDs(t *testing.T) {
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("GetAccessibleResources", mock.Anything, security.ActionListUsers, security.ResourceTypeOU).
+		Return(&sysauthz.AccessibleResources{IDs: []string{"ou1"}}, nil).Once()
+
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntityListAfterID", mock.Anything, providers.EntityCategoryUser, []string{"ou1"},
+		userExportBatchSize, "", mock.Anything).
+		Return([]providers.Entity{{ID: "u1", OUID: "ou1"}}, nil).Once()
+
+	service := &userService{entityService: entityMock, authzService: authzMock}
+
+	count := 0
+	err := service.ExportUsers(context.Background(), nil, func(user *User) error {
+		count++
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, count)
+}
+
+func TestUserService_ExportUsers_ErrorCases(t *testing.T) {
+	t.Run("NoAccessibleOUs", func(t *testing.T) {
+		authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+		authzMock.On("GetAccessibleResources", mock.Anything, security.ActionListUsers, security.ResourceTypeOU).
+			Return(&sysauthz.AccessibleResources{}, nil).Once()
+
+		service := &userService{authzService: authzMock}
+		err := service.ExportUsers(context.Background(), nil, func(*User) error {
+			t.Fatal("no user should be exported")
+			return nil
+		})
+		require.Nil(t, err)
+	})
+
+	t.Run("StoreError", func(t *testing.T) {
+		entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+		entityMock.On("GetEntityListAfterID", mock.Anything, providers.EntityCategoryUser, []string(nil),
+			userExportBatchSize, "", mock.Anything).Return(nil, errors.New("store error")).Once()
+
+		service := &userService{entityService: entityMock, authzService: newAllowAllAuthz(t)}
+		err := service.ExportUsers(context.Background(), nil, func(*User) error { return nil })
+		require.NotNil(t, err)
+		require.Equal(t, tidcommon.InternalServerError.Code, err.Code)
+	})
+
+	t.Run("VisitError", func(t *testing.T) {
+		entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+		entityMock.On("GetEntityListAfterID", mock.Anything, providers.EntityCategoryUser, []string(nil),
+			userExportBatchSize, "", mock.Anything).Return([]providers.Entity{{ID: "u1"}, {ID: "u2"}}, nil).Once()
+
+		service := &userService{entityService: entityMock, authzService: newAllowAllAuthz(t)}
+		visited := 0
+		err := service.ExportUsers(context.Background(), nil, func(*User) error {
+			visited++
+			return errors.New("client gone")
+		})
+		require.NotNil(t, err)
+		require.Equal(t, 1, visited)
+	})
+}
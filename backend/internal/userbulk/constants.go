@@ -0,0 +1,88 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import "time"
+
+// JobStatus is the state of a user import job.
+type JobStatus string
+
+const (
+	// JobStatusRunning marks a job whose file is being imported.
+	JobStatusRunning JobStatus = "running"
+	// JobStatusCompleted marks a job that read its whole file. Rows that failed to import are counted in
+	// the job and listed in its error report.
+	JobStatusCompleted JobStatus = "completed"
+	// JobStatusFailed marks a job that stopped before the end of its file, because the file could not be
+	// read or the node running the job stopped.
+	JobStatusFailed JobStatus = "failed"
+	// JobStatusCancelled marks a job that was cancelled before the end of its file.
+	JobStatusCancelled JobStatus = "cancelled"
+)
+
+// File formats accepted for import and produced by export.
+const (
+	// FormatCSV is a comma separated file whose first row names the columns.
+	FormatCSV = "csv"
+	// FormatNDJSON is a newline delimited JSON file with one user object per line.
+	FormatNDJSON = "ndjson"
+)
+
+// Targets a CSV column can be mapped to besides a user attribute.
+const (
+	// targetType maps a column to the user type of the row.
+	targetType = "type"
+	// targetOUID maps a column to the organization unit of the row.
+	targetOUID = "ouId"
+	// targetCredentialPrefix prefixes the targets of pre-hashed credential columns, which are written
+	// as credentials.<credential type>.hash and credentials.<credential type>.salt.
+	targetCredentialPrefix = "credentials."
+	targetHashSuffix       = ".hash"
+	targetSaltSuffix       = ".salt"
+)
+
+// Columns written before the attribute columns of a CSV export.
+var exportFixedColumns = []string{"id", "type", "ouId"}
+
+// Columns of the CSV error report of an import job.
+var errorReportColumns = []string{"line", "code", "message"}
+
+const (
+	contentTypeCSV    = "text/csv"
+	contentTypeNDJSON = "application/x-ndjson"
+
+	// uploadFormField is the multipart form field holding the import file.
+	uploadFormField = "file"
+	// optionsFormField is the multipart form field holding the import options.
+	optionsFormField = "options"
+	// maxOptionsSize bounds the size of the import options held in memory.
+	maxOptionsSize = 1 << 20
+
+	// spoolFilePattern names the temporary files uploads are spooled to until their job ends.
+	spoolFilePattern = "user-import-*"
+
+	// progressInterval is the number of rows imported between two progress updates of a job.
+	progressInterval = 100
+	// errorReportBatchSize is the number of row errors read at a time while writing an error report.
+	errorReportBatchSize = 500
+	// maxErrorMessageLength bounds the error message recorded for a row.
+	maxErrorMessageLength = 1024
+
+	// utf8BOM is the byte order mark spreadsheet applications write at the start of CSV files.
+	utf8BOM = "\uFEFF"
+)
+
+// Defaults applied when the user import settings are not configured.
+const (
+	defaultMaxUploadSize     = 1 << 30
+	defaultMaxConcurrentJobs = 2
+	defaultMaxReportedErrors = 10000
+	defaultRetentionDays     = 7
+
+	// maintenanceInterval is the interval between two sweeps for interrupted and expired jobs.
+	maintenanceInterval = 5 * time.Minute
+	// staleJobTimeout is the time after which a running job that has not reported progress is considered
+	// interrupted. Jobs report progress every progressInterval rows.
+	staleJobTimeout = 15 * time.Minute
+)
@@ -0,0 +1,242 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for user import and export operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the import request is malformed.
+	ErrorInvalidRequestFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.userbulkservice.invalid_request_format_description",
+			DefaultValue: "The request must be a multipart form with the import file in " +
+				"the file field and the options as JSON in the options field",
+		},
+	}
+	// ErrorInvalidFileFormat is the error returned when the import file format is not supported.
+	ErrorInvalidFileFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_file_format",
+			DefaultValue: "Invalid file format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.userbulkservice.invalid_file_format_description",
+			DefaultValue: "The file format must be csv or ndjson, given in " +
+				"the options or by a .csv, .ndjson or .jsonl file name",
+		},
+	}
+	// ErrorFileTooLarge is the error returned when the import file exceeds the maximum upload size.
+	ErrorFileTooLarge = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.file_too_large",
+			DefaultValue: "Import file too large",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.file_too_large_description",
+			DefaultValue: "The import file exceeds the maximum upload size",
+		},
+	}
+	// ErrorInvalidMapping is the error returned when the column mapping does not match the CSV file.
+	ErrorInvalidMapping = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_mapping",
+			DefaultValue: "Invalid column mapping",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.userbulkservice.invalid_mapping_description",
+			DefaultValue: "Every mapped column must exist in the CSV header " +
+				"and map to an attribute, type, ouId, credentials.<type>.hash or credentials.<type>.salt",
+		},
+	}
+	// ErrorInvalidCredentialAlgorithm is the error returned when a pre-hashed credential algorithm is invalid.
+	ErrorInvalidCredentialAlgorithm = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_credential_algorithm",
+			DefaultValue: "Invalid credential algorithm",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.userbulkservice.invalid_credential_algorithm_description",
+			DefaultValue: "Credential algorithms must be supported hashing algorithms, and every " +
+				"pre-hashed credential column needs the algorithm of its credential type",
+		},
+	}
+	// ErrorTooManyJobs is the error returned when the maximum number of import jobs are running.
+	ErrorTooManyJobs = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.too_many_jobs",
+			DefaultValue: "Too many import jobs",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.too_many_jobs_description",
+			DefaultValue: "The maximum number of import jobs are already running. Retry once one of them ends",
+		},
+	}
+	// ErrorJobNotFound is the error returned when the import job does not exist.
+	ErrorJobNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.job_not_found",
+			DefaultValue: "Import job not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.job_not_found_description",
+			DefaultValue: "The import job with the specified id does not exist",
+		},
+	}
+	// ErrorJobNotRunning is the error returned when cancelling an import job that already ended.
+	ErrorJobNotRunning = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1008",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.job_not_running",
+			DefaultValue: "Import job is not running",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.job_not_running_description",
+			DefaultValue: "Only a running import job can be cancelled",
+		},
+	}
+	// ErrorInvalidLimit is the error returned when the limit parameter is invalid.
+	ErrorInvalidLimit = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1009",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_limit_parameter",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when the offset parameter is invalid.
+	ErrorInvalidOffset = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1010",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_offset_parameter",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+	// ErrorInvalidExportRequest is the error returned when the export options are invalid.
+	ErrorInvalidExportRequest = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1011",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_export_request",
+			DefaultValue: "Invalid export request",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_export_request_description",
+			DefaultValue: "The format must be ndjson or csv, and a csv export needs the attributes to export",
+		},
+	}
+	// ErrorInvalidFilter is the error returned when the filter parameter is invalid.
+	ErrorInvalidFilter = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1012",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_filter",
+			DefaultValue: "Invalid filter parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_filter_description",
+			DefaultValue: "The filter parameter is invalid. Use attribute eq \"value\"",
+		},
+	}
+)
+
+// Errors recorded in the error report of an import job for the rows it failed to import. Rows rejected
+// by the user service are recorded with the error the user service returned.
+var (
+	// ErrorInvalidRow is recorded for a row that cannot be parsed.
+	ErrorInvalidRow = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1101",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_row",
+			DefaultValue: "Invalid row",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_row_description",
+			DefaultValue: "The row could not be parsed",
+		},
+	}
+	// ErrorMissingUserType is recorded for a row without a user type when the job has none.
+	ErrorMissingUserType = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1102",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.missing_user_type",
+			DefaultValue: "Missing user type",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.missing_user_type_description",
+			DefaultValue: "The row has no user type and the job has no default user type",
+		},
+	}
+	// ErrorMissingOrganizationUnit is recorded for a row without an organization unit when the job has none.
+	ErrorMissingOrganizationUnit = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1103",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.missing_organization_unit",
+			DefaultValue: "Missing organization unit",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.missing_organization_unit_description",
+			DefaultValue: "The row has no organization unit and the job has no default organization unit",
+		},
+	}
+	// ErrorInvalidAttributeValue is recorded for a row with a value that does not match its attribute type.
+	ErrorInvalidAttributeValue = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1104",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_attribute_value",
+			DefaultValue: "Invalid attribute value",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_attribute_value_description",
+			DefaultValue: "A column value does not match the type of its attribute",
+		},
+	}
+	// ErrorInvalidImportedCredential is recorded for a row with an incomplete pre-hashed credential.
+	ErrorInvalidImportedCredential = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UBK-1105",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_imported_credential",
+			DefaultValue: "Invalid imported credential",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userbulkservice.invalid_imported_credential_description",
+			DefaultValue: "A pre-hashed credential needs a hash and a supported algorithm",
+		},
+	}
+)
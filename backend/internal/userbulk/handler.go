@@ -0,0 +1,304 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const loggerComponentNameHandler = "UserBulkHandler"
+
+// multipartMemoryLimit is the part of an upload held in memory; the rest is buffered on disk.
+const multipartMemoryLimit = 32 << 20
+
+// userBulkHandler is the handler for the user import and export API.
+type userBulkHandler struct {
+	service       UserBulkServiceInterface
+	maxUploadSize int64
+}
+
+// newUserBulkHandler creates a new instance of userBulkHandler.
+func newUserBulkHandler(service UserBulkServiceInterface, maxUploadSize int64) *userBulkHandler {
+	return &userBulkHandler{
+		service:       service,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+// HandleImportJobPostRequest handles POST /users/import-jobs.
+func (h *userBulkHandler) HandleImportJobPostRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxUploadSize+maxOptionsSize)
+	if err := r.ParseMultipartForm(multipartMemoryLimit); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeServiceError(ctx, w, &ErrorFileTooLarge)
+			return
+		}
+		writeServiceError(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+	defer func() {
+		_ = r.MultipartForm.RemoveAll()
+	}()
+
+	var request ImportJobRequest
+	if options := r.MultipartForm.Value[optionsFormField]; len(options) > 0 {
+		if err := json.Unmarshal([]byte(options[0]), &request); err != nil {
+			writeServiceError(ctx, w, &ErrorInvalidRequestFormat)
+			return
+		}
+	}
+
+	file, header, err := r.FormFile(uploadFormField)
+	if err != nil {
+		writeServiceError(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	if header.Size > h.maxUploadSize {
+		writeServiceError(ctx, w, &ErrorFileTooLarge)
+		return
+	}
+	request.FileName = filepath.Base(header.Filename)
+
+	job, svcErr := h.service.CreateImportJob(ctx, file, request)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusAccepted, job)
+}
+
+// HandleImportJobListRequest handles GET /users/import-jobs.
+func (h *userBulkHandler) HandleImportJobListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+
+	resp, svcErr := h.service.GetImportJobList(ctx, limit, offset)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleImportJobGetRequest handles GET /users/import-jobs/{id}.
+func (h *userBulkHandler) HandleImportJobGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	job, svcErr := h.service.GetImportJob(ctx, r.PathValue("id"))
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, job)
+}
+
+// HandleImportJobCancelRequest handles POST /users/import-jobs/{id}/cancel.
+func (h *userBulkHandler) HandleImportJobCancelRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	job, svcErr := h.service.CancelImportJob(ctx, r.PathValue("id"))
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, job)
+}
+
+// HandleImportErrorReportRequest handles GET /users/import-jobs/{id}/errors.
+func (h *userBulkHandler) HandleImportErrorReportRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+
+	stream := newStreamWriter(w, contentTypeCSV, fmt.Sprintf("user-import-%s-errors.csv", id))
+	svcErr := h.service.WriteImportErrorReport(ctx, id, stream)
+	stream.finish(ctx, svcErr)
+}
+
+// HandleExportRequest handles GET /users/export.
+func (h *userBulkHandler) HandleExportRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	request := ExportRequest{Format: strings.ToLower(query.Get("format"))}
+	if request.Format == "" {
+		request.Format = FormatNDJSON
+	}
+	for _, attribute := range strings.Split(query.Get("attributes"), ",") {
+		if attribute = strings.TrimSpace(attribute); attribute != "" {
+			request.Attributes = append(request.Attributes, attribute)
+		}
+	}
+	filters, svcErr := parseFilterParams(query)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	request.Filters = filters
+
+	contentType := contentTypeNDJSON
+	if request.Format == FormatCSV {
+		contentType = contentTypeCSV
+	}
+	stream := newStreamWriter(w, contentType, "users."+request.Format)
+	svcErr = h.service.ExportUsers(ctx, request, stream)
+	stream.finish(ctx, svcErr)
+}
+
+// streamWriter writes a streamed file download. The response headers are written with the first bytes
+// of the file, so that an error raised before the download starts can still be answered as JSON.
+type streamWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	fileName    string
+	started     bool
+}
+
+// newStreamWriter creates a streamWriter for a download with the given content type and file name.
+func newStreamWriter(w http.ResponseWriter, contentType, fileName string) *streamWriter {
+	return &streamWriter{w: w, contentType: contentType, fileName: fileName}
+}
+
+// Write writes the response headers on the first call, then the given bytes.
+func (s *streamWriter) Write(p []byte) (int, error) {
+	s.start()
+	return s.w.Write(p)
+}
+
+// start writes the response headers unless they were already written.
+func (s *streamWriter) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	s.w.Header().Set("Content-Type", s.contentType)
+	s.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", s.fileName))
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// finish completes the download. An error raised before the download started is written as the error
+// response; one raised after can only end the response early.
+func (s *streamWriter) finish(ctx context.Context, svcErr *tidcommon.ServiceError) {
+	if svcErr == nil {
+		s.start()
+		return
+	}
+	if !s.started {
+		writeServiceError(ctx, s.w, svcErr)
+		return
+	}
+	log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentNameHandler)).
+		Debug(ctx, "Download ended early", log.String("code", svcErr.Code))
+}
+
+// writeServiceError converts a service error into the appropriate HTTP error response.
+func writeServiceError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		switch svcErr.Code {
+		case tidcommon.ErrorUnauthorized.Code:
+			statusCode = http.StatusForbidden
+		case ErrorJobNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorJobNotRunning.Code:
+			statusCode = http.StatusConflict
+		case ErrorFileTooLarge.Code:
+			statusCode = http.StatusRequestEntityTooLarge
+		case ErrorTooManyJobs.Code:
+			statusCode = http.StatusTooManyRequests
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
+
+// parsePaginationParams parses the limit and offset query parameters, defaulting the limit to the
+// default page size.
+func parsePaginationParams(query url.Values) (int, int, *tidcommon.ServiceError) {
+	limit := serverconst.DefaultPageSize
+	offset := 0
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidLimit
+		}
+		limit = parsedLimit
+	}
+
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidOffset
+		}
+		offset = parsedOffset
+	}
+
+	return limit, offset, nil
+}
+
+// filterPattern matches filter expressions in the format: attribute eq "value" or attribute eq value.
+var filterPattern = regexp.MustCompile(`^(\w+(?:\.\w+)*)\s+eq\s+(?:"([^"]*)"|(\w+|\d+))$`)
+
+// parseFilterParams parses the filter query parameter of an export, in the format accepted by the user
+// list API.
+func parseFilterParams(query url.Values) (map[string]interface{}, *tidcommon.ServiceError) {
+	if !query.Has("filter") {
+		return make(map[string]interface{}), nil
+	}
+
+	matches := filterPattern.FindStringSubmatch(strings.TrimSpace(query.Get("filter")))
+	if len(matches) == 0 {
+		return nil, &ErrorInvalidFilter
+	}
+
+	attribute := sysutils.SanitizeString(matches[1])
+	if matches[2] != "" || matches[3] == "" {
+		return map[string]interface{}{attribute: sysutils.SanitizeString(matches[2])}, nil
+	}
+
+	value := matches[3]
+	if intVal, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return map[string]interface{}{attribute: intVal}, nil
+	}
+	if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+		return map[string]interface{}{attribute: floatVal}, nil
+	}
+	if boolVal, err := strconv.ParseBool(value); err == nil {
+		return map[string]interface{}{attribute: boolVal}, nil
+	}
+	return nil, &ErrorInvalidFilter
+}
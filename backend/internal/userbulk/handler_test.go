@@ -0,0 +1,320 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+const testMaxUploadSize = 1024
+
+type UserBulkHandlerTestSuite struct {
+	suite.Suite
+	mockService *UserBulkServiceInterfaceMock
+	mux         *http.ServeMux
+}
+
+func TestUserBulkHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserBulkHandlerTestSuite))
+}
+
+func (suite *UserBulkHandlerTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+
+	suite.mockService = NewUserBulkServiceInterfaceMock(suite.T())
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, newUserBulkHandler(suite.mockService, testMaxUploadSize))
+}
+
+func (suite *UserBulkHandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *UserBulkHandlerTestSuite) serve(req *http.Request) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	suite.mux.ServeHTTP(resp, req)
+	return resp
+}
+
+func (suite *UserBulkHandlerTestSuite) errorCode(resp *httptest.ResponseRecorder) string {
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &errResp))
+	return errResp.Code
+}
+
+// uploadRequest builds an import upload with the given file content and options.
+func (suite *UserBulkHandlerTestSuite) uploadRequest(fileName, content, options string) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if options != "" {
+		suite.Require().NoError(writer.WriteField(optionsFormField, options))
+	}
+	if fileName != "" {
+		part, err := writer.CreateFormFile(uploadFormField, fileName)
+		suite.Require().NoError(err)
+		_, err = part.Write([]byte(content))
+		suite.Require().NoError(err)
+	}
+	suite.Require().NoError(writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/users/import-jobs", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleImportJobPostRequest() {
+	suite.mockService.On("CreateImportJob", mock.Anything, mock.Anything, mock.MatchedBy(func(r ImportJobRequest) bool {
+		return r.FileName == "users.csv" && r.UserType == "person" && r.Mapping["login"] == "username" &&
+			r.CredentialAlgorithms["password"].Algorithm == cryptolib.BCRYPT
+	})).Run(func(args mock.Arguments) {
+		content, err := io.ReadAll(args.Get(1).(io.Reader))
+		suite.Require().NoError(err)
+		suite.Equal("login\nalice\n", string(content))
+	}).Return(&ImportJob{ID: "job-1", Status: JobStatusRunning}, nil)
+
+	resp := suite.serve(suite.uploadRequest("../users.csv", "login\nalice\n",
+		`{"userType":"person","mapping":{"login":"username"},"credentialAlgorithms":{"password":{"algorithm":"BCRYPT"}}}`))
+
+	suite.Equal(http.StatusAccepted, resp.Code)
+	var job ImportJob
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &job))
+	suite.Equal("job-1", job.ID)
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleImportJobPostRequest_Rejected() {
+	tests := []struct {
+		name       string
+		req        func() *http.Request
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "NotMultipart",
+			req:        func() *http.Request { return httptest.NewRequest(http.MethodPost, "/users/import-jobs", nil) },
+			wantStatus: http.StatusBadRequest,
+			wantCode:   ErrorInvalidRequestFormat.Code,
+		},
+		{
+			name:       "MissingFile",
+			req:        func() *http.Request { return suite.uploadRequest("", "", `{"format":"csv"}`) },
+			wantStatus: http.StatusBadRequest,
+			wantCode:   ErrorInvalidRequestFormat.Code,
+		},
+		{
+			name:       "InvalidOptions",
+			req:        func() *http.Request { return suite.uploadRequest("users.csv", "a", `{"format":`) },
+			wantStatus: http.StatusBadRequest,
+			wantCode:   ErrorInvalidRequestFormat.Code,
+		},
+		{
+			name: "FileTooLarge",
+			req: func() *http.Request {
+				return suite.uploadRequest("users.csv", strings.Repeat("a", testMaxUploadSize+1), "")
+			},
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantCode:   ErrorFileTooLarge.Code,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			resp := suite.serve(tt.req())
+			suite.Equal(tt.wantStatus, resp.Code)
+			suite.Equal(tt.wantCode, suite.errorCode(resp))
+		})
+	}
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleImportJobPostRequest_ServiceErrors() {
+	tests := []struct {
+		svcErr     *tidcommon.ServiceError
+		wantStatus int
+	}{
+		{svcErr: &ErrorTooManyJobs, wantStatus: http.StatusTooManyRequests},
+		{svcErr: &ErrorInvalidMapping, wantStatus: http.StatusBadRequest},
+		{svcErr: &tidcommon.ErrorUnauthorized, wantStatus: http.StatusForbidden},
+		{svcErr: &tidcommon.InternalServerError, wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.svcErr.Code, func() {
+			suite.SetupTest()
+			suite.mockService.On("CreateImportJob", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, tt.svcErr)
+
+			resp := suite.serve(suite.uploadRequest("users.csv", "username\n", ""))
+
+			suite.Equal(tt.wantStatus, resp.Code)
+			suite.Equal(tt.svcErr.Code, suite.errorCode(resp))
+		})
+	}
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleImportJobListRequest() {
+	suite.mockService.On("GetImportJobList", mock.Anything, 5, 10).
+		Return(&ImportJobListResponse{TotalResults: 1, StartIndex: 11, Count: 1,
+			Jobs: []ImportJob{{ID: "job-1"}}}, nil)
+
+	resp := suite.serve(httptest.NewRequest(http.MethodGet, "/users/import-jobs?limit=5&offset=10", nil))
+
+	suite.Equal(http.StatusOK, resp.Code)
+	var list ImportJobListResponse
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &list))
+	suite.Equal(1, list.Count)
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleImportJobListRequest_InvalidPagination() {
+	resp := suite.serve(httptest.NewRequest(http.MethodGet, "/users/import-jobs?limit=abc", nil))
+	suite.Equal(http.StatusBadRequest, resp.Code)
+	suite.Equal(ErrorInvalidLimit.Code, suite.errorCode(resp))
+
+	resp = suite.serve(httptest.NewRequest(http.MethodGet, "/users/import-jobs?offset=abc", nil))
+	suite.Equal(http.StatusBadRequest, resp.Code)
+	suite.Equal(ErrorInvalidOffset.Code, suite.errorCode(resp))
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleImportJobGetRequest() {
+	suite.mockService.On("GetImportJob", mock.Anything, "job-1").Return(&ImportJob{ID: "job-1"}, nil)
+	suite.mockService.On("GetImportJob", mock.Anything, "missing").Return(nil, &ErrorJobNotFound)
+
+	resp := suite.serve(httptest.NewRequest(http.MethodGet, "/users/import-jobs/job-1", nil))
+	suite.Equal(http.StatusOK, resp.Code)
+
+	resp = suite.serve(httptest.NewRequest(http.MethodGet, "/users/import-jobs/missing", nil))
+	suite.Equal(http.StatusNotFound, resp.Code)
+	suite.Equal(ErrorJobNotFound.Code, suite.errorCode(resp))
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleImportJobCancelRequest() {
+	suite.mockService.On("CancelImportJob", mock.Anything, "job-1").
+		Return(&ImportJob{ID: "job-1", Status: JobStatusCancelled}, nil)
+	suite.mockService.On("CancelImportJob", mock.Anything, "job-2").Return(nil, &ErrorJobNotRunning)
+
+	resp := suite.serve(httptest.NewRequest(http.MethodPost, "/users/import-jobs/job-1/cancel", nil))
+	suite.Equal(http.StatusOK, resp.Code)
+
+	resp = suite.serve(httptest.NewRequest(http.MethodPost, "/users/import-jobs/job-2/cancel", nil))
+	suite.Equal(http.StatusConflict, resp.Code)
+	suite.Equal(ErrorJobNotRunning.Code, suite.errorCode(resp))
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleImportErrorReportRequest() {
+	suite.mockService.On("WriteImportErrorReport", mock.Anything, "job-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			_, err := args.Get(2).(io.Writer).Write([]byte("line,code,message\n"))
+			suite.Require().NoError(err)
+		}).Return(nil)
+
+	resp := suite.serve(httptest.NewRequest(http.MethodGet, "/users/import-jobs/job-1/errors", nil))
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal(contentTypeCSV, resp.Header().Get("Content-Type"))
+	suite.Equal(`attachment; filename="user-import-job-1-errors.csv"`, resp.Header().Get("Content-Disposition"))
+	suite.Equal("line,code,message\n", resp.Body.String())
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleImportErrorReportRequest_ErrorBeforeStart() {
+	suite.mockService.On("WriteImportErrorReport", mock.Anything, "missing", mock.Anything).
+		Return(&ErrorJobNotFound)
+
+	resp := suite.serve(httptest.NewRequest(http.MethodGet, "/users/import-jobs/missing/errors", nil))
+
+	suite.Equal(http.StatusNotFound, resp.Code)
+	suite.Equal(ErrorJobNotFound.Code, suite.errorCode(resp))
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleExportRequest() {
+	suite.mockService.On("ExportUsers", mock.Anything, ExportRequest{
+		Format:     FormatCSV,
+		Attributes: []string{"username", "email"},
+		Filters:    map[string]interface{}{"department": "sales"},
+	}, mock.Anything).Run(func(args mock.Arguments) {
+		_, err := args.Get(2).(io.Writer).Write([]byte("id,type,ouId,username,email\n"))
+		suite.Require().NoError(err)
+	}).Return(nil)
+
+	resp := suite.serve(httptest.NewRequest(http.MethodGet,
+		`/users/export?format=CSV&attributes=username,+email,&filter=department+eq+%22sales%22`, nil))
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal(contentTypeCSV, resp.Header().Get("Content-Type"))
+	suite.Equal(`attachment; filename="users.csv"`, resp.Header().Get("Content-Disposition"))
+	suite.Equal("id,type,ouId,username,email\n", resp.Body.String())
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleExportRequest_EmptyExport() {
+	suite.mockService.On("ExportUsers", mock.Anything, ExportRequest{
+		Format:  FormatNDJSON,
+		Filters: map[string]interface{}{"age": int64(30)},
+	}, mock.Anything).Return(nil)
+
+	resp := suite.serve(httptest.NewRequest(http.MethodGet, "/users/export?filter=age+eq+30", nil))
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal(contentTypeNDJSON, resp.Header().Get("Content-Type"))
+	suite.Empty(resp.Body.String())
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleExportRequest_Errors() {
+	resp := suite.serve(httptest.NewRequest(http.MethodGet, "/users/export?filter=bad", nil))
+	suite.Equal(http.StatusBadRequest, resp.Code)
+	suite.Equal(ErrorInvalidFilter.Code, suite.errorCode(resp))
+
+	suite.mockService.On("ExportUsers", mock.Anything, mock.Anything, mock.Anything).
+		Return(&tidcommon.ErrorUnauthorized).Once()
+	resp = suite.serve(httptest.NewRequest(http.MethodGet, "/users/export", nil))
+	suite.Equal(http.StatusForbidden, resp.Code)
+}
+
+func (suite *UserBulkHandlerTestSuite) TestHandleExportRequest_ErrorAfterStartEndsDownload() {
+	suite.mockService.On("ExportUsers", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			_, err := args.Get(2).(io.Writer).Write([]byte("{\"id\":\"user-1\"}\n"))
+			suite.Require().NoError(err)
+		}).Return(&tidcommon.InternalServerError)
+
+	resp := suite.serve(httptest.NewRequest(http.MethodGet, "/users/export", nil))
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal("{\"id\":\"user-1\"}\n", resp.Body.String())
+}
+
+func (suite *UserBulkHandlerTestSuite) TestParseFilterParams() {
+	tests := []struct {
+		filter string
+		want   map[string]interface{}
+	}{
+		{filter: `address.city eq "Colombo"`, want: map[string]interface{}{"address.city": "Colombo"}},
+		{filter: `age eq 30`, want: map[string]interface{}{"age": int64(30)}},
+		{filter: `active eq true`, want: map[string]interface{}{"active": true}},
+	}
+
+	for _, tt := range tests {
+		filters, svcErr := parseFilterParams(map[string][]string{"filter": {tt.filter}})
+		suite.Nil(svcErr, tt.filter)
+		suite.Equal(tt.want, filters, tt.filter)
+	}
+
+	_, svcErr := parseFilterParams(map[string][]string{"filter": {"name ne x"}})
+	suite.Equal(&ErrorInvalidFilter, svcErr)
+	_, svcErr = parseFilterParams(map[string][]string{"filter": {"name eq abc"}})
+	suite.Equal(&ErrorInvalidFilter, svcErr)
+}
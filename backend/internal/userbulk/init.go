@@ -0,0 +1,86 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize initializes the user import and export service, registers its routes and starts the
+// maintenance of the import jobs, which runs for the lifetime of the server.
+func Initialize(
+	mux *http.ServeMux,
+	userService user.UserServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	observabilitySvc providers.ObservabilityProvider,
+) UserBulkServiceInterface {
+	importConfig := config.GetServerRuntime().Config.User.Import
+	settings := importSettings{
+		maxReportedErrors: config.PositiveIntOrDefault(importConfig.MaxReportedErrors, defaultMaxReportedErrors),
+		retention: time.Duration(config.PositiveIntOrDefault(importConfig.RetentionDays, defaultRetentionDays)) *
+			24 * time.Hour,
+	}
+	maxUploadSize := importConfig.MaxUploadSize
+	if maxUploadSize <= 0 {
+		maxUploadSize = defaultMaxUploadSize
+	}
+
+	userBulkService := newUserBulkService(newImportJobStore(), userService, entityTypeService, authzService,
+		audit.NewRecorder(observabilitySvc), settings,
+		config.PositiveIntOrDefault(importConfig.MaxConcurrentJobs, defaultMaxConcurrentJobs))
+	go userBulkService.runMaintenance(nil)
+
+	userBulkHandler := newUserBulkHandler(userBulkService, maxUploadSize)
+	registerRoutes(mux, userBulkHandler)
+
+	return userBulkService
+}
+
+// registerRoutes registers the routes for the user import and export API.
+func registerRoutes(mux *http.ServeMux, h *userBulkHandler) {
+	listOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/import-jobs", h.HandleImportJobListRequest, listOpts))
+	mux.HandleFunc(middleware.WithCORS("POST /users/import-jobs", h.HandleImportJobPostRequest, listOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/import-jobs",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, listOpts))
+
+	mux.HandleFunc(middleware.WithCORS("GET /users/import-jobs/{id}", h.HandleImportJobGetRequest, listOpts))
+	mux.HandleFunc(middleware.WithCORS("POST /users/import-jobs/{id}/cancel",
+		h.HandleImportJobCancelRequest, listOpts))
+	mux.HandleFunc(middleware.WithCORS("GET /users/import-jobs/{id}/errors",
+		h.HandleImportErrorReportRequest, listOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/import-jobs/",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, listOpts))
+
+	exportOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/export", h.HandleExportRequest, exportOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/export",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, exportOpts))
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+type UserBulkInitTestSuite struct {
+	suite.Suite
+}
+
+func TestUserBulkInitTestSuite(t *testing.T) {
+	suite.Run(t, new(UserBulkInitTestSuite))
+}
+
+func (suite *UserBulkInitTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+}
+
+func (suite *UserBulkInitTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *UserBulkInitTestSuite) TestInitialize() {
+	mux := http.NewServeMux()
+	service := Initialize(mux, usermock.NewUserServiceInterfaceMock(suite.T()),
+		entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T()),
+		sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T()), nil)
+
+	assert.NotNil(suite.T(), service)
+	assert.Implements(suite.T(), (*UserBulkServiceInterface)(nil), service)
+	bulkService := service.(*userBulkService)
+	assert.Equal(suite.T(), defaultMaxConcurrentJobs, cap(bulkService.jobSlots))
+	assert.Equal(suite.T(), defaultMaxReportedErrors, bulkService.settings.maxReportedErrors)
+
+	for _, target := range []string{"/users/import-jobs", "/users/import-jobs/job-1/cancel", "/users/export"} {
+		resp := httptest.NewRecorder()
+		mux.ServeHTTP(resp, httptest.NewRequest(http.MethodOptions, target, nil))
+		assert.Equal(suite.T(), http.StatusNoContent, resp.Code, target)
+	}
+}
+
+func (suite *UserBulkInitTestSuite) TestInitialize_AppliesConfiguredLimits() {
+	config.ResetServerRuntime()
+	cfg := &config.Config{}
+	cfg.User.Import = config.UserImportConfig{MaxConcurrentJobs: 5, MaxReportedErrors: 50, RetentionDays: 1}
+	suite.Require().NoError(config.InitializeServerRuntime("", cfg))
+
+	service := Initialize(http.NewServeMux(), usermock.NewUserServiceInterfaceMock(suite.T()),
+		entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T()),
+		sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T()), nil).(*userBulkService)
+
+	assert.Equal(suite.T(), 5, cap(service.jobSlots))
+	assert.Equal(suite.T(), 50, service.settings.maxReportedErrors)
+	assert.Equal(suite.T(), 24, int(service.settings.retention.Hours()))
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// ImportJob is a background job importing users from an uploaded file.
+type ImportJob struct {
+	ID        string    `json:"id"`
+	Status    JobStatus `json:"status"`
+	Format    string    `json:"format"`
+	FileName  string    `json:"fileName,omitempty"`
+	UserType  string    `json:"userType,omitempty"`
+	OUID      string    `json:"ouId,omitempty"`
+	CreatedBy string    `json:"createdBy,omitempty"`
+	// Processed is the number of rows read so far, Succeeded and Failed split it by outcome.
+	Processed int `json:"processed"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	// ReportedErrors is the number of failed rows listed in the error report, which is capped.
+	ReportedErrors int        `json:"reportedErrors"`
+	Error          string     `json:"error,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+	CompletedAt    *time.Time `json:"completedAt,omitempty"`
+}
+
+// ImportJobListResponse is a paginated list of import jobs.
+type ImportJobListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	StartIndex   int          `json:"startIndex"`
+	Count        int          `json:"count"`
+	Jobs         []ImportJob  `json:"jobs"`
+	Links        []utils.Link `json:"links"`
+}
+
+// ImportJobRequest holds the options of an import job, sent alongside the uploaded file. Format is
+// inferred from the file name when it is not given. UserType and OUID apply to the rows that do not
+// name their own.
+type ImportJobRequest struct {
+	Format   string `json:"format,omitempty"`
+	UserType string `json:"userType,omitempty"`
+	OUID     string `json:"ouId,omitempty"`
+	// Mapping maps CSV columns to user attributes or to the type, ouId and credentials.<type>.hash and
+	// credentials.<type>.salt targets. Without a mapping every column maps to the target of its name.
+	Mapping map[string]string `json:"mapping,omitempty"`
+	// CredentialAlgorithms holds, per credential type, the algorithm of the pre-hashed values in the
+	// file. NDJSON rows may name the algorithm of a credential themselves.
+	CredentialAlgorithms map[string]HashAlgorithm `json:"credentialAlgorithms,omitempty"`
+	// FileName is the name of the uploaded file.
+	FileName string `json:"-"`
+}
+
+// HashAlgorithm is the algorithm, with its parameters, pre-hashed credentials were hashed with.
+type HashAlgorithm struct {
+	Algorithm  cryptolib.CredAlgorithm `json:"algorithm"`
+	Parameters HashParameters          `json:"parameters"`
+}
+
+// HashParameters are the parameters of a pre-hashed credential besides its salt. Their meaning depends
+// on the algorithm, as described for the imported hash algorithms of the cryptolib package.
+type HashParameters struct {
+	Iterations    int    `json:"iterations,omitempty"`
+	KeySize       int    `json:"keySize,omitempty"`
+	Memory        int    `json:"memory,omitempty"`
+	Parallelism   int    `json:"parallelism,omitempty"`
+	BlockSize     int    `json:"blockSize,omitempty"`
+	SaltSeparator string `json:"saltSeparator,omitempty"`
+	SignerKey     string `json:"signerKey,omitempty"`
+}
+
+// ImportedCredential is a pre-hashed credential of an NDJSON row. Algorithm and Parameters default to the
+// job's algorithm for the credential type.
+type ImportedCredential struct {
+	Hash       string                  `json:"hash"`
+	Salt       string                  `json:"salt,omitempty"`
+	Algorithm  cryptolib.CredAlgorithm `json:"algorithm,omitempty"`
+	Parameters *HashParameters         `json:"parameters,omitempty"`
+}
+
+// ImportRow is a line of an NDJSON import file. Plaintext credentials are given as attributes, as when
+// creating a user.
+type ImportRow struct {
+	Type        string                        `json:"type,omitempty"`
+	OUID        string                        `json:"ouId,omitempty"`
+	Attributes  json.RawMessage               `json:"attributes"`
+	Credentials map[string]ImportedCredential `json:"credentials,omitempty"`
+}
+
+// ImportError is a row an import job failed to import.
+type ImportError struct {
+	Line    int
+	Code    string
+	Message string
+}
+
+// importRecord is a row of an import file ready to be imported.
+type importRecord struct {
+	line        int
+	user        user.User
+	credentials map[string][]entity.StoredCredential
+}
+
+// importProgress is the progress of a running import job, recorded against the job.
+type importProgress struct {
+	processed      int
+	succeeded      int
+	failed         int
+	reportedErrors int
+	errors         []ImportError
+}
+
+// ExportRequest holds the options of a user export. Attributes selects the attribute columns of a CSV
+// export; an NDJSON export writes every attribute.
+type ExportRequest struct {
+	Format     string
+	Attributes []string
+	Filters    map[string]interface{}
+}
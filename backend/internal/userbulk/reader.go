@@ -0,0 +1,389 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/user"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// attributeTypeLookup returns the schema type of each attribute of a user type, or nil when the user type
+// is unknown. Values of unknown attributes are imported as strings.
+type attributeTypeLookup func(userType string) map[string]string
+
+// recordReader reads the rows of an import file one at a time.
+type recordReader interface {
+	// next returns the next row ready to be imported, or the error recorded for a row that cannot be
+	// imported. It returns io.EOF after the last row, and any other error when the file cannot be read
+	// further.
+	next() (*importRecord, *ImportError, error)
+}
+
+// columnTarget is what a CSV column is imported as.
+type columnTarget struct {
+	index int
+	// kind is targetType, targetOUID, targetHashSuffix, targetSaltSuffix or empty for an attribute.
+	kind string
+	// name is the attribute or the credential type the column holds.
+	name string
+}
+
+// csvRecordReader reads the rows of a CSV import file.
+type csvRecordReader struct {
+	reader         *csv.Reader
+	columns        []columnTarget
+	request        ImportJobRequest
+	attributeTypes attributeTypeLookup
+}
+
+// newCSVRecordReader reads the header of a CSV import file and resolves the target of each column.
+func newCSVRecordReader(r io.Reader, request ImportJobRequest,
+	attributeTypes attributeTypeLookup) (recordReader, *tidcommon.ServiceError) {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, &ErrorInvalidFileFormat
+	}
+
+	columns, svcErr := resolveColumns(header, request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return &csvRecordReader{
+		reader:         reader,
+		columns:        columns,
+		request:        request,
+		attributeTypes: attributeTypes,
+	}, nil
+}
+
+// resolveColumns maps the columns of a CSV header to their targets. Without a mapping every column maps
+// to the target of its name; with one only the mapped columns are imported.
+func resolveColumns(header []string, request ImportJobRequest) ([]columnTarget, *tidcommon.ServiceError) {
+	indexes := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		if i == 0 {
+			column = strings.TrimPrefix(column, utf8BOM)
+		}
+		if _, dup := indexes[column]; dup || column == "" {
+			return nil, &ErrorInvalidMapping
+		}
+		indexes[column] = i
+	}
+
+	mapping := request.Mapping
+	if len(mapping) == 0 {
+		mapping = make(map[string]string, len(indexes))
+		for column := range indexes {
+			mapping[column] = column
+		}
+	}
+
+	columns := make([]columnTarget, 0, len(mapping))
+	targets := make(map[string]struct{}, len(mapping))
+	for column, target := range mapping {
+		index, ok := indexes[column]
+		if !ok {
+			return nil, &ErrorInvalidMapping
+		}
+		if _, dup := targets[target]; dup {
+			return nil, &ErrorInvalidMapping
+		}
+		targets[target] = struct{}{}
+
+		columnTarget, ok := parseColumnTarget(target)
+		if !ok {
+			return nil, &ErrorInvalidMapping
+		}
+		columnTarget.index = index
+		if columnTarget.kind == targetHashSuffix {
+			if _, ok := request.CredentialAlgorithms[columnTarget.name]; !ok {
+				return nil, &ErrorInvalidCredentialAlgorithm
+			}
+		}
+		columns = append(columns, columnTarget)
+	}
+
+	return columns, nil
+}
+
+// parseColumnTarget parses the target of a CSV column.
+func parseColumnTarget(target string) (columnTarget, bool) {
+	switch {
+	case target == targetType || target == targetOUID:
+		return columnTarget{kind: target}, true
+	case strings.HasPrefix(target, targetCredentialPrefix):
+		rest := strings.TrimPrefix(target, targetCredentialPrefix)
+		for _, suffix := range []string{targetHashSuffix, targetSaltSuffix} {
+			if name, ok := strings.CutSuffix(rest, suffix); ok && name != "" && !strings.Contains(name, ".") {
+				return columnTarget{kind: suffix, name: name}, true
+			}
+		}
+		return columnTarget{}, false
+	case target == "" || strings.HasPrefix(target, "."):
+		return columnTarget{}, false
+	default:
+		return columnTarget{name: target}, true
+	}
+}
+
+// next returns the next row of the file.
+func (r *csvRecordReader) next() (*importRecord, *ImportError, error) {
+	fields, err := r.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+			return nil, newImportError(parseErr.StartLine, &ErrorInvalidRow, "wrong number of fields"), nil
+		}
+		return nil, nil, err
+	}
+	line, _ := r.reader.FieldPos(0)
+
+	userType, ouID := r.request.UserType, r.request.OUID
+	values := make(map[string]string, len(r.columns))
+	hashes := make(map[string]string)
+	salts := make(map[string]string)
+	for _, column := range r.columns {
+		value := fields[column.index]
+		if value == "" {
+			continue
+		}
+		switch column.kind {
+		case targetType:
+			userType = value
+		case targetOUID:
+			ouID = value
+		case targetHashSuffix:
+			hashes[column.name] = value
+		case targetSaltSuffix:
+			salts[column.name] = value
+		default:
+			values[column.name] = value
+		}
+	}
+
+	if userType == "" {
+		return nil, newImportError(line, &ErrorMissingUserType, ""), nil
+	}
+	if ouID == "" {
+		return nil, newImportError(line, &ErrorMissingOrganizationUnit, ""), nil
+	}
+
+	attributes, importErr := buildCSVAttributes(line, values, r.attributeTypes(userType))
+	if importErr != nil {
+		return nil, importErr, nil
+	}
+
+	var credentials map[string][]entity.StoredCredential
+	for credType, hash := range hashes {
+		algorithm := r.request.CredentialAlgorithms[credType]
+		if credentials == nil {
+			credentials = make(map[string][]entity.StoredCredential, len(hashes))
+		}
+		credentials[credType] = []entity.StoredCredential{
+			buildStoredCredential(hash, salts[credType], algorithm.Algorithm, algorithm.Parameters),
+		}
+	}
+
+	return &importRecord{
+		line:        line,
+		user:        user.User{Type: userType, OUID: ouID, Attributes: attributes},
+		credentials: credentials,
+	}, nil, nil
+}
+
+// buildCSVAttributes converts the attribute values of a CSV row to the types of their attributes.
+func buildCSVAttributes(line int, values map[string]string, types map[string]string) (json.RawMessage,
+	*ImportError) {
+	attributes := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		converted, ok := convertAttributeValue(value, types[name])
+		if !ok {
+			return nil, newImportError(line, &ErrorInvalidAttributeValue, name)
+		}
+		attributes[name] = converted
+	}
+
+	attributesJSON, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, newImportError(line, &ErrorInvalidRow, err.Error())
+	}
+	return attributesJSON, nil
+}
+
+// convertAttributeValue converts a CSV value to the given schema type. Object and array values are given
+// as JSON.
+func convertAttributeValue(value, attributeType string) (interface{}, bool) {
+	switch attributeType {
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, false
+		}
+		return json.Number(value), true
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, false
+		}
+		return b, true
+	case "object", "array":
+		if !json.Valid([]byte(value)) {
+			return nil, false
+		}
+		return json.RawMessage(value), true
+	default:
+		return value, true
+	}
+}
+
+// ndjsonRecordReader reads the rows of an NDJSON import file.
+type ndjsonRecordReader struct {
+	reader  *bufio.Reader
+	line    int
+	request ImportJobRequest
+}
+
+// newNDJSONRecordReader creates a reader for an NDJSON import file.
+func newNDJSONRecordReader(r io.Reader, request ImportJobRequest) recordReader {
+	return &ndjsonRecordReader{reader: bufio.NewReader(r), request: request}
+}
+
+// next returns the next row of the file. Blank lines are skipped.
+func (r *ndjsonRecordReader) next() (*importRecord, *ImportError, error) {
+	for {
+		content, err := r.reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, nil, err
+		}
+		if len(content) == 0 && errors.Is(err, io.EOF) {
+			return nil, nil, io.EOF
+		}
+		r.line++
+
+		content = bytes.TrimSpace(content)
+		if len(content) == 0 {
+			if errors.Is(err, io.EOF) {
+				return nil, nil, io.EOF
+			}
+			continue
+		}
+		record, importErr := r.parseRow(content)
+		return record, importErr, nil
+	}
+}
+
+// parseRow parses a line of the file.
+func (r *ndjsonRecordReader) parseRow(content []byte) (*importRecord, *ImportError) {
+	var row ImportRow
+	if err := json.Unmarshal(content, &row); err != nil {
+		return nil, newImportError(r.line, &ErrorInvalidRow, err.Error())
+	}
+
+	if row.Type == "" {
+		row.Type = r.request.UserType
+	}
+	if row.Type == "" {
+		return nil, newImportError(r.line, &ErrorMissingUserType, "")
+	}
+	if row.OUID == "" {
+		row.OUID = r.request.OUID
+	}
+	if row.OUID == "" {
+		return nil, newImportError(r.line, &ErrorMissingOrganizationUnit, "")
+	}
+
+	var credentials map[string][]entity.StoredCredential
+	for credType, credential := range row.Credentials {
+		storedCredential, ok := r.resolveCredential(credType, credential)
+		if !ok {
+			return nil, newImportError(r.line, &ErrorInvalidImportedCredential, credType)
+		}
+		if credentials == nil {
+			credentials = make(map[string][]entity.StoredCredential, len(row.Credentials))
+		}
+		credentials[credType] = []entity.StoredCredential{storedCredential}
+	}
+
+	return &importRecord{
+		line:        r.line,
+		user:        user.User{Type: row.Type, OUID: row.OUID, Attributes: row.Attributes},
+		credentials: credentials,
+	}, nil
+}
+
+// resolveCredential builds the stored form of a pre-hashed credential of a row. The algorithm and its
+// parameters default to the job's algorithm for the credential type.
+func (r *ndjsonRecordReader) resolveCredential(credType string,
+	credential ImportedCredential) (entity.StoredCredential, bool) {
+	if credential.Hash == "" {
+		return entity.StoredCredential{}, false
+	}
+
+	jobAlgorithm, hasJobAlgorithm := r.request.CredentialAlgorithms[credType]
+	algorithm := credential.Algorithm
+	if algorithm == "" {
+		algorithm = jobAlgorithm.Algorithm
+	}
+	if !cryptolib.IsSupportedCredAlgorithm(algorithm) {
+		return entity.StoredCredential{}, false
+	}
+
+	var parameters HashParameters
+	switch {
+	case credential.Parameters != nil:
+		parameters = *credential.Parameters
+	case hasJobAlgorithm && jobAlgorithm.Algorithm == algorithm:
+		parameters = jobAlgorithm.Parameters
+	}
+
+	return buildStoredCredential(credential.Hash, credential.Salt, algorithm, parameters), true
+}
+
+// buildStoredCredential builds the stored form of a pre-hashed credential.
+func buildStoredCredential(hash, salt string, algorithm cryptolib.CredAlgorithm,
+	parameters HashParameters) entity.StoredCredential {
+	return entity.StoredCredential{
+		StorageAlgo: algorithm,
+		StorageAlgoParams: cryptolib.CredParameters{
+			Iterations:    parameters.Iterations,
+			Parallelism:   parameters.Parallelism,
+			Memory:        parameters.Memory,
+			KeySize:       parameters.KeySize,
+			Salt:          salt,
+			BlockSize:     parameters.BlockSize,
+			SaltSeparator: parameters.SaltSeparator,
+			SignerKey:     parameters.SignerKey,
+		},
+		Value: hash,
+	}
+}
+
+// newImportError builds the error recorded for a row from the error describing it, with an optional
+// detail appended to its description.
+func newImportError(line int, svcErr *tidcommon.ServiceError, detail string) *ImportError {
+	message := svcErr.ErrorDescription.DefaultValue
+	if detail != "" {
+		message = fmt.Sprintf("%s: %s", message, detail)
+	}
+	if len(message) > maxErrorMessageLength {
+		message = message[:maxErrorMessageLength]
+	}
+	return &ImportError{Line: line, Code: svcErr.Code, Message: message}
+}
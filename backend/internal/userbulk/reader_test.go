@@ -0,0 +1,249 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+)
+
+type RecordReaderTestSuite struct {
+	suite.Suite
+}
+
+func TestRecordReaderTestSuite(t *testing.T) {
+	suite.Run(t, new(RecordReaderTestSuite))
+}
+
+// personTypes is an attribute type lookup for a person user type.
+func personTypes(userType string) map[string]string {
+	if userType != "person" {
+		return nil
+	}
+	return map[string]string{"username": "string", "age": "number", "active": "boolean", "tags": "array"}
+}
+
+// readAll reads every row of a file, returning the rows and the row errors.
+func (suite *RecordReaderTestSuite) readAll(reader recordReader) ([]*importRecord, []*ImportError) {
+	var records []*importRecord
+	var importErrors []*ImportError
+	for {
+		record, importErr, err := reader.next()
+		if errors.Is(err, io.EOF) {
+			return records, importErrors
+		}
+		suite.Require().NoError(err)
+		if importErr != nil {
+			importErrors = append(importErrors, importErr)
+			continue
+		}
+		records = append(records, record)
+	}
+}
+
+func (suite *RecordReaderTestSuite) TestCSV_ConvertsValuesToAttributeTypes() {
+	file := utf8BOM + "username,age,active,tags,ouId\n" +
+		"alice,30,true,\"[\"\"a\"\"]\",ou-2\n" +
+		"bob,,false,,\n"
+	reader, svcErr := newCSVRecordReader(strings.NewReader(file),
+		ImportJobRequest{UserType: "person", OUID: "ou-1"}, personTypes)
+	suite.Require().Nil(svcErr)
+
+	records, importErrors := suite.readAll(reader)
+
+	suite.Empty(importErrors)
+	suite.Require().Len(records, 2)
+	suite.Equal(2, records[0].line)
+	suite.Equal("person", records[0].user.Type)
+	suite.Equal("ou-2", records[0].user.OUID)
+	suite.JSONEq(`{"username":"alice","age":30,"active":true,"tags":["a"]}`, string(records[0].user.Attributes))
+	suite.Nil(records[0].credentials)
+	suite.Equal(3, records[1].line)
+	suite.Equal("ou-1", records[1].user.OUID)
+	suite.JSONEq(`{"username":"bob","active":false}`, string(records[1].user.Attributes))
+}
+
+func (suite *RecordReaderTestSuite) TestCSV_MappingAndPreHashedCredentials() {
+	file := "login,pwd,pwd_salt,kind,ignored\n" +
+		"alice,hash-1,salt-1,person,x\n" +
+		"bob,,,person,y\n"
+	request := ImportJobRequest{
+		OUID: "ou-1",
+		Mapping: map[string]string{
+			"login":    "username",
+			"pwd":      "credentials.password.hash",
+			"pwd_salt": "credentials.password.salt",
+			"kind":     "type",
+		},
+		CredentialAlgorithms: map[string]HashAlgorithm{
+			"password": {Algorithm: cryptolib.PBKDF2, Parameters: HashParameters{Iterations: 1000, KeySize: 32}},
+		},
+	}
+	reader, svcErr := newCSVRecordReader(strings.NewReader(file), request, personTypes)
+	suite.Require().Nil(svcErr)
+
+	records, importErrors := suite.readAll(reader)
+
+	suite.Empty(importErrors)
+	suite.Require().Len(records, 2)
+	suite.JSONEq(`{"username":"alice"}`, string(records[0].user.Attributes))
+	suite.Require().Len(records[0].credentials["password"], 1)
+	credential := records[0].credentials["password"][0]
+	suite.Equal(cryptolib.PBKDF2, credential.StorageAlgo)
+	suite.Equal("hash-1", credential.Value)
+	suite.Equal("salt-1", credential.StorageAlgoParams.Salt)
+	suite.Equal(1000, credential.StorageAlgoParams.Iterations)
+	suite.Equal(32, credential.StorageAlgoParams.KeySize)
+	suite.Nil(records[1].credentials)
+}
+
+func (suite *RecordReaderTestSuite) TestCSV_RowErrors() {
+	file := "username,age,type\n" +
+		"alice,old,person\n" +
+		"bob,20\n" +
+		"carol,20,\n"
+	reader, svcErr := newCSVRecordReader(strings.NewReader(file), ImportJobRequest{OUID: "ou-1"}, personTypes)
+	suite.Require().Nil(svcErr)
+
+	records, importErrors := suite.readAll(reader)
+
+	suite.Empty(records)
+	suite.Require().Len(importErrors, 3)
+	suite.Equal(ImportError{Line: 2, Code: ErrorInvalidAttributeValue.Code,
+		Message: ErrorInvalidAttributeValue.ErrorDescription.DefaultValue + ": age"}, *importErrors[0])
+	suite.Equal(3, importErrors[1].Line)
+	suite.Equal(ErrorInvalidRow.Code, importErrors[1].Code)
+	suite.Equal(4, importErrors[2].Line)
+	suite.Equal(ErrorMissingUserType.Code, importErrors[2].Code)
+}
+
+func (suite *RecordReaderTestSuite) TestCSV_InvalidHeaderOrMapping() {
+	algorithms := map[string]HashAlgorithm{"password": {Algorithm: cryptolib.BCRYPT}}
+	tests := []struct {
+		name    string
+		file    string
+		request ImportJobRequest
+		wantErr string
+	}{
+		{name: "EmptyFile", file: "", wantErr: ErrorInvalidFileFormat.Code},
+		{name: "DuplicateColumn", file: "username,username\n", wantErr: ErrorInvalidMapping.Code},
+		{name: "EmptyColumn", file: "username,\n", wantErr: ErrorInvalidMapping.Code},
+		{
+			name: "UnknownMappedColumn", file: "username\n",
+			request: ImportJobRequest{Mapping: map[string]string{"login": "username"}},
+			wantErr: ErrorInvalidMapping.Code,
+		},
+		{
+			name: "DuplicateTarget", file: "a,b\n",
+			request: ImportJobRequest{Mapping: map[string]string{"a": "username", "b": "username"}},
+			wantErr: ErrorInvalidMapping.Code,
+		},
+		{
+			name: "InvalidCredentialTarget", file: "pwd\n",
+			request: ImportJobRequest{Mapping: map[string]string{"pwd": "credentials.password"},
+				CredentialAlgorithms: algorithms},
+			wantErr: ErrorInvalidMapping.Code,
+		},
+		{
+			name: "HashWithoutAlgorithm", file: "credentials.pin.hash\n",
+			request: ImportJobRequest{CredentialAlgorithms: algorithms},
+			wantErr: ErrorInvalidCredentialAlgorithm.Code,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, svcErr := newCSVRecordReader(strings.NewReader(tt.file), tt.request, personTypes)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tt.wantErr, svcErr.Code)
+		})
+	}
+}
+
+func (suite *RecordReaderTestSuite) TestCSV_MalformedFileStopsReading() {
+	reader, svcErr := newCSVRecordReader(strings.NewReader("username\n\"alice\n"),
+		ImportJobRequest{UserType: "person", OUID: "ou-1"}, personTypes)
+	suite.Require().Nil(svcErr)
+
+	_, _, err := reader.next()
+
+	suite.Error(err)
+	suite.NotErrorIs(err, io.EOF)
+}
+
+func (suite *RecordReaderTestSuite) TestNDJSON_ReadsRows() {
+	file := `{"attributes":{"username":"alice","password":"secret"}}` + "\n" +
+		"\n" +
+		`{"type":"employee","ouId":"ou-2","attributes":{"username":"bob"},` +
+		`"credentials":{"password":{"hash":"h","salt":"s"},` +
+		`"pin":{"hash":"$2a$10$x","algorithm":"BCRYPT"}}}`
+	request := ImportJobRequest{
+		UserType: "person",
+		OUID:     "ou-1",
+		CredentialAlgorithms: map[string]HashAlgorithm{
+			"password": {Algorithm: cryptolib.SCRYPT, Parameters: HashParameters{Memory: 16384, BlockSize: 8}},
+		},
+	}
+
+	records, importErrors := suite.readAll(newNDJSONRecordReader(strings.NewReader(file), request))
+
+	suite.Empty(importErrors)
+	suite.Require().Len(records, 2)
+	suite.Equal(1, records[0].line)
+	suite.Equal("person", records[0].user.Type)
+	suite.Equal("ou-1", records[0].user.OUID)
+	suite.JSONEq(`{"username":"alice","password":"secret"}`, string(records[0].user.Attributes))
+	suite.Nil(records[0].credentials)
+
+	suite.Equal(3, records[1].line)
+	suite.Equal("employee", records[1].user.Type)
+	suite.Equal("ou-2", records[1].user.OUID)
+	password := records[1].credentials["password"][0]
+	suite.Equal(cryptolib.SCRYPT, password.StorageAlgo)
+	suite.Equal("s", password.StorageAlgoParams.Salt)
+	suite.Equal(16384, password.StorageAlgoParams.Memory)
+	suite.Equal(8, password.StorageAlgoParams.BlockSize)
+	pin := records[1].credentials["pin"][0]
+	suite.Equal(cryptolib.BCRYPT, pin.StorageAlgo)
+	suite.Equal("$2a$10$x", pin.Value)
+	suite.Zero(pin.StorageAlgoParams.Memory)
+}
+
+func (suite *RecordReaderTestSuite) TestNDJSON_RowErrors() {
+	file := "not json\n" +
+		`{"attributes":{}}` + "\n" +
+		`{"type":"person","attributes":{}}` + "\n" +
+		`{"type":"person","ouId":"ou-1","credentials":{"password":{"hash":"h"}}}` + "\n" +
+		`{"type":"person","ouId":"ou-1","credentials":{"password":{"hash":"h","algorithm":"MD5"}}}` + "\n" +
+		`{"type":"person","ouId":"ou-1","credentials":{"password":{"algorithm":"BCRYPT"}}}` + "\n"
+
+	records, importErrors := suite.readAll(newNDJSONRecordReader(strings.NewReader(file), ImportJobRequest{}))
+
+	suite.Empty(records)
+	codes := make([]string, 0, len(importErrors))
+	for _, importErr := range importErrors {
+		codes = append(codes, importErr.Code)
+	}
+	suite.Equal([]string{
+		ErrorInvalidRow.Code, ErrorMissingUserType.Code, ErrorMissingOrganizationUnit.Code,
+		ErrorInvalidImportedCredential.Code, ErrorInvalidImportedCredential.Code,
+		ErrorInvalidImportedCredential.Code,
+	}, codes)
+	suite.Equal(6, importErrors[5].Line)
+}
+
+func TestNewImportError_TruncatesMessage(t *testing.T) {
+	importErr := newImportError(7, &ErrorInvalidRow, strings.Repeat("x", 2*maxErrorMessageLength))
+
+	assert.Equal(t, 7, importErr.Line)
+	assert.Equal(t, ErrorInvalidRow.Code, importErr.Code)
+	assert.Len(t, importErr.Message, maxErrorMessageLength)
+}
@@ -0,0 +1,592 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package userbulk imports users in bulk from CSV and NDJSON files through background jobs, and exports
+// users as a stream.
+package userbulk
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/user"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const loggerComponentNameService = "UserBulkService"
+
+// UserBulkServiceInterface defines the operations for importing and exporting users in bulk.
+type UserBulkServiceInterface interface {
+	CreateImportJob(ctx context.Context, file io.Reader, request ImportJobRequest) (*ImportJob,
+		*tidcommon.ServiceError)
+	GetImportJobList(ctx context.Context, limit, offset int) (*ImportJobListResponse, *tidcommon.ServiceError)
+	GetImportJob(ctx context.Context, id string) (*ImportJob, *tidcommon.ServiceError)
+	CancelImportJob(ctx context.Context, id string) (*ImportJob, *tidcommon.ServiceError)
+	WriteImportErrorReport(ctx context.Context, id string, w io.Writer) *tidcommon.ServiceError
+	ExportUsers(ctx context.Context, request ExportRequest, w io.Writer) *tidcommon.ServiceError
+}
+
+// importSettings holds the limits applied to import jobs.
+type importSettings struct {
+	maxReportedErrors int
+	retention         time.Duration
+}
+
+// userBulkService is the default implementation of UserBulkServiceInterface.
+type userBulkService struct {
+	jobStore          importJobStoreInterface
+	userService       user.UserServiceInterface
+	entityTypeService entitytype.EntityTypeServiceInterface
+	authzService      sysauthz.SystemAuthorizationServiceInterface
+	auditRecorder     *audit.Recorder
+	settings          importSettings
+	// jobSlots bounds the number of import jobs running on this node.
+	jobSlots chan struct{}
+	// jobs tracks the import jobs running on this node.
+	jobs   sync.WaitGroup
+	logger *log.Logger
+}
+
+// newUserBulkService creates a new instance of userBulkService.
+func newUserBulkService(jobStore importJobStoreInterface, userService user.UserServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface, authzService sysauthz.SystemAuthorizationServiceInterface,
+	auditRecorder *audit.Recorder, settings importSettings, maxConcurrentJobs int) *userBulkService {
+	return &userBulkService{
+		jobStore:          jobStore,
+		userService:       userService,
+		entityTypeService: entityTypeService,
+		authzService:      authzService,
+		auditRecorder:     auditRecorder,
+		settings:          settings,
+		jobSlots:          make(chan struct{}, maxConcurrentJobs),
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentNameService)),
+	}
+}
+
+// CreateImportJob validates the import options and the start of the file, then imports the file in the
+// background and returns the running job. The file is spooled to a temporary file, so the caller may
+// release it once this returns.
+func (s *userBulkService) CreateImportJob(ctx context.Context, file io.Reader, request ImportJobRequest) (
+	*ImportJob, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionImportUsers); svcErr != nil {
+		return nil, svcErr
+	}
+
+	format, svcErr := resolveImportFormat(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	request.Format = format
+	for _, algorithm := range request.CredentialAlgorithms {
+		if !cryptolib.IsSupportedCredAlgorithm(algorithm.Algorithm) {
+			return nil, &ErrorInvalidCredentialAlgorithm
+		}
+	}
+
+	select {
+	case s.jobSlots <- struct{}{}:
+	default:
+		return nil, &ErrorTooManyJobs
+	}
+	started := false
+	defer func() {
+		if !started {
+			<-s.jobSlots
+		}
+	}()
+
+	spool, err := spoolUpload(file)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to spool user import file", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	defer func() {
+		if !started {
+			removeSpool(spool)
+		}
+	}()
+
+	reader, svcErr := s.newRecordReader(ctx, spool, request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate user import job ID", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	now := time.Now().UTC()
+	job := ImportJob{
+		ID:        id,
+		Status:    JobStatusRunning,
+		Format:    format,
+		FileName:  request.FileName,
+		UserType:  request.UserType,
+		OUID:      request.OUID,
+		CreatedBy: security.GetSubject(ctx),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.jobStore.createJob(ctx, job); err != nil {
+		s.logger.Error(ctx, "Failed to create user import job", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	s.auditRecorder.Created(ctx, audit.TargetUserImportJob, job.ID, job)
+
+	// The job outlives the request but keeps its security context, so each user is created with the
+	// authorization of the caller.
+	started = true
+	s.jobs.Add(1)
+	go func() {
+		defer s.jobs.Done()
+		defer func() { <-s.jobSlots }()
+		defer removeSpool(spool)
+		s.runImportJob(context.WithoutCancel(ctx), job.ID, reader)
+	}()
+
+	return &job, nil
+}
+
+// GetImportJobList returns a page of import jobs, newest first.
+func (s *userBulkService) GetImportJobList(ctx context.Context, limit, offset int) (
+	*ImportJobListResponse, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionImportUsers); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := validatePaginationParams(limit, offset); svcErr != nil {
+		return nil, svcErr
+	}
+
+	totalCount, err := s.jobStore.getJobListCount(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get user import job count", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	jobs, err := s.jobStore.getJobList(ctx, limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list user import jobs", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return &ImportJobListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(jobs),
+		Jobs:         jobs,
+		Links:        utils.BuildPaginationLinks("/users/import-jobs", limit, offset, totalCount, ""),
+	}, nil
+}
+
+// GetImportJob returns the import job with the given ID.
+func (s *userBulkService) GetImportJob(ctx context.Context, id string) (*ImportJob, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionImportUsers); svcErr != nil {
+		return nil, svcErr
+	}
+	return s.loadJob(ctx, id)
+}
+
+// CancelImportJob cancels a running import job. The job stops at its next progress update; users imported
+// until then are kept.
+func (s *userBulkService) CancelImportJob(ctx context.Context, id string) (*ImportJob,
+	*tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionImportUsers); svcErr != nil {
+		return nil, svcErr
+	}
+
+	existing, svcErr := s.loadJob(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if existing.Status != JobStatusRunning {
+		return nil, &ErrorJobNotRunning
+	}
+
+	now := time.Now().UTC()
+	cancelled, err := s.jobStore.endJob(ctx, id, JobStatusCancelled, "", now, now.Add(s.settings.retention))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to cancel user import job", log.String("id", id), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if !cancelled {
+		return nil, &ErrorJobNotRunning
+	}
+
+	job := *existing
+	job.Status = JobStatusCancelled
+	job.UpdatedAt = now
+	job.CompletedAt = &now
+	s.auditRecorder.Updated(ctx, audit.TargetUserImportJob, id, existing, job)
+
+	return &job, nil
+}
+
+// WriteImportErrorReport writes the rows an import job failed to import to w as CSV, in line order.
+// Nothing is written when an error is returned before the report starts.
+func (s *userBulkService) WriteImportErrorReport(ctx context.Context, id string,
+	w io.Writer) *tidcommon.ServiceError {
+	if svcErr := s.checkAction(ctx, security.ActionImportUsers); svcErr != nil {
+		return svcErr
+	}
+	if _, svcErr := s.loadJob(ctx, id); svcErr != nil {
+		return svcErr
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(errorReportColumns); err != nil {
+		return s.reportWriteError(ctx, err)
+	}
+	for afterLine := 0; ; {
+		importErrors, err := s.jobStore.getErrors(ctx, id, afterLine, errorReportBatchSize)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to get user import errors", log.String("id", id), log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		for _, importErr := range importErrors {
+			record := []string{strconv.Itoa(importErr.Line), importErr.Code, importErr.Message}
+			if err := writer.Write(record); err != nil {
+				return s.reportWriteError(ctx, err)
+			}
+			afterLine = importErr.Line
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return s.reportWriteError(ctx, err)
+		}
+		if len(importErrors) < errorReportBatchSize {
+			return nil
+		}
+	}
+}
+
+// ExportUsers writes the users matching the filters, in the organization units the caller may list users
+// from, to w. Credentials are never exported. Nothing is written when an error is returned before the
+// export starts.
+func (s *userBulkService) ExportUsers(ctx context.Context, request ExportRequest,
+	w io.Writer) *tidcommon.ServiceError {
+	if svcErr := s.checkAction(ctx, security.ActionExportUsers); svcErr != nil {
+		return svcErr
+	}
+
+	switch request.Format {
+	case FormatNDJSON:
+		encoder := json.NewEncoder(w)
+		return s.userService.ExportUsers(ctx, request.Filters, func(u *user.User) error {
+			return encoder.Encode(u)
+		})
+	case FormatCSV:
+		if len(request.Attributes) == 0 {
+			return &ErrorInvalidExportRequest
+		}
+		return s.exportUsersAsCSV(ctx, request, w)
+	default:
+		return &ErrorInvalidExportRequest
+	}
+}
+
+// exportUsersAsCSV writes the users as CSV, with the fixed columns followed by the requested attributes.
+// String attributes are written as is and other values as JSON. The CSV writer buffers its output, so
+// the header only reaches w once the export has started.
+func (s *userBulkService) exportUsersAsCSV(ctx context.Context, request ExportRequest,
+	w io.Writer) *tidcommon.ServiceError {
+	writer := csv.NewWriter(w)
+	record := make([]string, 0, len(exportFixedColumns)+len(request.Attributes))
+	record = append(append(record, exportFixedColumns...), request.Attributes...)
+	if err := writer.Write(record); err != nil {
+		return s.reportWriteError(ctx, err)
+	}
+
+	svcErr := s.userService.ExportUsers(ctx, request.Filters, func(u *user.User) error {
+		var attributes map[string]json.RawMessage
+		if len(u.Attributes) > 0 {
+			if err := json.Unmarshal(u.Attributes, &attributes); err != nil {
+				return err
+			}
+		}
+		record[0], record[1], record[2] = u.ID, u.Type, u.OUID
+		for i, name := range request.Attributes {
+			record[len(exportFixedColumns)+i] = csvAttributeValue(attributes[name])
+		}
+		return writer.Write(record)
+	})
+	if svcErr != nil {
+		return svcErr
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return s.reportWriteError(ctx, err)
+	}
+	return nil
+}
+
+// csvAttributeValue formats an attribute value for a CSV export.
+func csvAttributeValue(value json.RawMessage) string {
+	if len(value) == 0 || string(value) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return s
+	}
+	return string(value)
+}
+
+// runImportJob imports the rows of a file, recording the progress and the row errors of the job until
+// the file ends, the file cannot be read further or the job is cancelled.
+func (s *userBulkService) runImportJob(ctx context.Context, id string, reader recordReader) {
+	logger := s.logger.With(log.String("jobId", id))
+	var progress importProgress
+
+	for {
+		record, importErr, err := reader.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			logger.Debug(ctx, "Failed to read user import file", log.Error(err))
+			if s.flushProgress(ctx, logger, id, &progress) {
+				s.endImportJob(ctx, logger, id, JobStatusFailed, "the import file could not be read: "+err.Error())
+			}
+			return
+		}
+
+		progress.processed++
+		if record != nil {
+			importErr = s.importRecord(ctx, record)
+		}
+		if importErr == nil {
+			progress.succeeded++
+		} else {
+			progress.failed++
+			if progress.reportedErrors < s.settings.maxReportedErrors {
+				progress.reportedErrors++
+				progress.errors = append(progress.errors, *importErr)
+			}
+		}
+
+		if progress.processed%progressInterval == 0 && !s.flushProgress(ctx, logger, id, &progress) {
+			return
+		}
+	}
+
+	if s.flushProgress(ctx, logger, id, &progress) {
+		s.endImportJob(ctx, logger, id, JobStatusCompleted, "")
+	}
+}
+
+// importRecord creates the user of a row and returns the error recorded for the row when it fails.
+func (s *userBulkService) importRecord(ctx context.Context, record *importRecord) *ImportError {
+	var svcErr *tidcommon.ServiceError
+	if len(record.credentials) > 0 {
+		_, svcErr = s.userService.ImportUser(ctx, &record.user, record.credentials)
+	} else {
+		_, svcErr = s.userService.CreateUser(ctx, &record.user)
+	}
+	if svcErr != nil {
+		return newImportError(record.line, svcErr, "")
+	}
+	return nil
+}
+
+// flushProgress records the progress of a job and clears the recorded row errors. It reports false when
+// the job must stop, because it was cancelled or its progress could not be recorded.
+func (s *userBulkService) flushProgress(ctx context.Context, logger *log.Logger, id string,
+	progress *importProgress) bool {
+	running, err := s.jobStore.updateProgress(ctx, id, *progress, time.Now().UTC())
+	progress.errors = progress.errors[:0]
+	if err != nil {
+		logger.Error(ctx, "Failed to record user import job progress", log.Error(err))
+		s.endImportJob(ctx, logger, id, JobStatusFailed, "the progress of the job could not be recorded")
+		return false
+	}
+	if !running {
+		logger.Debug(ctx, "User import job is no longer running")
+		return false
+	}
+	return true
+}
+
+// endImportJob moves a running job to a final status.
+func (s *userBulkService) endImportJob(ctx context.Context, logger *log.Logger, id string, status JobStatus,
+	message string) {
+	if len(message) > maxErrorMessageLength {
+		message = message[:maxErrorMessageLength]
+	}
+	now := time.Now().UTC()
+	if _, err := s.jobStore.endJob(ctx, id, status, message, now, now.Add(s.settings.retention)); err != nil {
+		logger.Error(ctx, "Failed to end user import job", log.String("status", string(status)), log.Error(err))
+		return
+	}
+	logger.Debug(ctx, "User import job ended", log.String("status", string(status)))
+}
+
+// runMaintenance fails the jobs interrupted by a node stopping and purges the jobs past their retention
+// period, once every maintenance interval, until stop is closed.
+func (s *userBulkService) runMaintenance(stop <-chan struct{}) {
+	ticker := time.NewTicker(maintenanceInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.sweepJobs()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// sweepJobs fails the running jobs that stopped reporting progress and purges the expired jobs.
+func (s *userBulkService) sweepJobs() {
+	// Maintenance runs in the background, outside any request.
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	failed, err := s.jobStore.failStaleJobs(ctx, "the job was interrupted", now, now.Add(-staleJobTimeout),
+		now.Add(s.settings.retention))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to fail interrupted user import jobs", log.Error(err))
+	} else if failed > 0 {
+		s.logger.Debug(ctx, "Failed interrupted user import jobs", log.Any("count", failed))
+	}
+
+	deleted, err := s.jobStore.deleteExpiredJobs(ctx, now)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to purge expired user import jobs", log.Error(err))
+	} else if deleted > 0 {
+		s.logger.Debug(ctx, "Purged expired user import jobs", log.Any("count", deleted))
+	}
+}
+
+// newRecordReader creates the reader of an import file, validating the CSV header against the mapping.
+func (s *userBulkService) newRecordReader(ctx context.Context, file io.Reader, request ImportJobRequest) (
+	recordReader, *tidcommon.ServiceError) {
+	if request.Format == FormatNDJSON {
+		return newNDJSONRecordReader(file, request), nil
+	}
+	return newCSVRecordReader(file, request, s.attributeTypeLookup(ctx))
+}
+
+// attributeTypeLookup returns a lookup of the attribute types of user types, caching each user type for
+// the lifetime of the job.
+func (s *userBulkService) attributeTypeLookup(ctx context.Context) attributeTypeLookup {
+	cache := make(map[string]map[string]string)
+	return func(userType string) map[string]string {
+		if types, ok := cache[userType]; ok {
+			return types
+		}
+
+		var types map[string]string
+		attributes, svcErr := s.entityTypeService.GetAttributes(ctx, entitytype.TypeCategoryUser, userType,
+			entitytype.AttributeFilter{AllowCredential: true, AllowNonCredential: true})
+		if svcErr == nil {
+			types = make(map[string]string, len(attributes))
+			for _, attribute := range attributes {
+				types[attribute.Attribute] = attribute.Type
+			}
+		}
+		cache[userType] = types
+		return types
+	}
+}
+
+// checkAction verifies that the caller is allowed to perform the given action.
+func (s *userBulkService) checkAction(ctx context.Context, action security.Action) *tidcommon.ServiceError {
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, action, nil)
+	if svcErr != nil {
+		return &tidcommon.InternalServerError
+	}
+	if !allowed {
+		return &tidcommon.ErrorUnauthorized
+	}
+	return nil
+}
+
+// loadJob returns the import job with the given ID, or ErrorJobNotFound when it does not exist.
+func (s *userBulkService) loadJob(ctx context.Context, id string) (*ImportJob, *tidcommon.ServiceError) {
+	job, err := s.jobStore.getJob(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get user import job", log.String("id", id), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if job == nil {
+		return nil, &ErrorJobNotFound
+	}
+	return job, nil
+}
+
+// reportWriteError logs a failure to write a streamed response, usually because the client went away.
+func (s *userBulkService) reportWriteError(ctx context.Context, err error) *tidcommon.ServiceError {
+	s.logger.Debug(ctx, "Failed to write user bulk response", log.Error(err))
+	return &tidcommon.InternalServerError
+}
+
+// resolveImportFormat returns the format of an import file, inferred from its name when not given.
+func resolveImportFormat(request ImportJobRequest) (string, *tidcommon.ServiceError) {
+	format := strings.ToLower(request.Format)
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(request.FileName)) {
+		case ".csv":
+			format = FormatCSV
+		case ".ndjson", ".jsonl":
+			format = FormatNDJSON
+		}
+	}
+	if format != FormatCSV && format != FormatNDJSON {
+		return "", &ErrorInvalidFileFormat
+	}
+	return format, nil
+}
+
+// spoolUpload copies an uploaded file to a temporary file and returns it positioned at its start.
+func spoolUpload(file io.Reader) (*os.File, error) {
+	spool, err := os.CreateTemp("", spoolFilePattern)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(spool, file); err != nil {
+		removeSpool(spool)
+		return nil, err
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		removeSpool(spool)
+		return nil, err
+	}
+	return spool, nil
+}
+
+// removeSpool closes and deletes a spooled upload.
+func removeSpool(spool *os.File) {
+	_ = spool.Close()
+	_ = os.Remove(spool.Name())
+}
+
+// validatePaginationParams validates pagination parameters.
+func validatePaginationParams(limit, offset int) *tidcommon.ServiceError {
+	if limit < 1 || limit > serverconst.MaxPageSize {
+		return &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return &ErrorInvalidOffset
+	}
+	return nil
+}
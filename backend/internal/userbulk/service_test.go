@@ -0,0 +1,478 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/observabilityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+const testRetention = 24 * time.Hour
+
+type UserBulkServiceTestSuite struct {
+	suite.Suite
+	mockJobStore      *importJobStoreInterfaceMock
+	mockUserService   *usermock.UserServiceInterfaceMock
+	mockEntityTypes   *entitytypemock.EntityTypeServiceInterfaceMock
+	mockAuthz         *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockObservability *observabilityprovidermock.ObservabilityProviderMock
+	service           *userBulkService
+}
+
+func TestUserBulkServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserBulkServiceTestSuite))
+}
+
+func (suite *UserBulkServiceTestSuite) SetupTest() {
+	suite.mockJobStore = newImportJobStoreInterfaceMock(suite.T())
+	suite.mockUserService = usermock.NewUserServiceInterfaceMock(suite.T())
+	suite.mockEntityTypes = entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockObservability = observabilityprovidermock.NewObservabilityProviderMock(suite.T())
+	suite.mockObservability.On("IsEnabled").Return(false).Maybe()
+	suite.service = newUserBulkService(suite.mockJobStore, suite.mockUserService, suite.mockEntityTypes,
+		suite.mockAuthz, audit.NewRecorder(suite.mockObservability),
+		importSettings{maxReportedErrors: 2, retention: testRetention}, 1)
+}
+
+func (suite *UserBulkServiceTestSuite) allow(action security.Action) {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, action, mock.Anything).Return(true, nil)
+}
+
+func (suite *UserBulkServiceTestSuite) deny(action security.Action) {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, action, mock.Anything).Return(false, nil)
+}
+
+// captureAudit enables the observability provider and returns a pointer to the next published event.
+func (suite *UserBulkServiceTestSuite) captureAudit() **providers.Event {
+	var published *providers.Event
+	suite.mockObservability.ExpectedCalls = nil
+	suite.mockObservability.On("IsEnabled").Return(true)
+	suite.mockObservability.On("PublishEvent", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			published = args.Get(1).(*providers.Event)
+		}).Return()
+	return &published
+}
+
+func runningJob() *ImportJob {
+	return &ImportJob{ID: "job-1", Status: JobStatusRunning, Format: FormatCSV}
+}
+
+func (suite *UserBulkServiceTestSuite) TestCreateImportJob_ImportsFileInBackground() {
+	suite.allow(security.ActionImportUsers)
+	suite.mockEntityTypes.On("GetAttributes", mock.Anything, entitytype.TypeCategoryUser, "person",
+		mock.Anything).Return([]entitytype.AttributeInfo{{Attribute: "age", Type: "number"}}, nil)
+	suite.mockJobStore.On("createJob", mock.Anything, mock.MatchedBy(func(job ImportJob) bool {
+		return job.ID != "" && job.Status == JobStatusRunning && job.Format == FormatCSV &&
+			job.FileName == "users.csv" && job.UserType == "person" && job.OUID == "ou-1"
+	})).Return(nil)
+	suite.mockUserService.On("CreateUser", mock.Anything, mock.MatchedBy(func(u *user.User) bool {
+		return u.Type == "person" && string(u.Attributes) == `{"age":30,"username":"alice"}`
+	})).Return(&user.User{ID: "user-1"}, nil)
+	suite.mockUserService.On("ImportUser", mock.Anything, mock.MatchedBy(func(u *user.User) bool {
+		return string(u.Attributes) == `{"username":"bob"}`
+	}), mock.MatchedBy(func(credentials map[string][]entity.StoredCredential) bool {
+		return credentials["password"][0].Value == "$2a$10$hash" &&
+			credentials["password"][0].StorageAlgo == cryptolib.BCRYPT
+	})).Return(nil, &user.ErrorAttributeConflict)
+	suite.mockJobStore.On("updateProgress", mock.Anything, mock.Anything, mock.MatchedBy(func(p importProgress) bool {
+		return p.processed == 2 && p.succeeded == 1 && p.failed == 1 && p.reportedErrors == 1 &&
+			len(p.errors) == 1 && p.errors[0].Line == 3 && p.errors[0].Code == user.ErrorAttributeConflict.Code
+	}), mock.Anything).Return(true, nil).Once()
+	suite.mockJobStore.On("endJob", mock.Anything, mock.Anything, JobStatusCompleted, "", mock.Anything,
+		mock.Anything).Return(true, nil).Once()
+
+	file := "username,age,credentials.password.hash\nalice,30,\nbob,,$2a$10$hash\n"
+	job, svcErr := suite.service.CreateImportJob(context.Background(), strings.NewReader(file), ImportJobRequest{
+		UserType: "person",
+		OUID:     "ou-1",
+		FileName: "users.csv",
+		CredentialAlgorithms: map[string]HashAlgorithm{
+			"password": {Algorithm: cryptolib.BCRYPT},
+		},
+	})
+	suite.service.jobs.Wait()
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(JobStatusRunning, job.Status)
+	suite.Equal(FormatCSV, job.Format)
+	suite.Len(suite.service.jobSlots, 0)
+}
+
+func (suite *UserBulkServiceTestSuite) TestCreateImportJob_RecordsAudit() {
+	suite.allow(security.ActionImportUsers)
+	published := suite.captureAudit()
+	suite.mockJobStore.On("createJob", mock.Anything, mock.Anything).Return(nil)
+	suite.mockJobStore.On("updateProgress", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(true, nil)
+	suite.mockJobStore.On("endJob", mock.Anything, mock.Anything, JobStatusCompleted, "", mock.Anything,
+		mock.Anything).Return(true, nil)
+
+	job, svcErr := suite.service.CreateImportJob(context.Background(), strings.NewReader(""),
+		ImportJobRequest{Format: FormatNDJSON})
+	suite.service.jobs.Wait()
+
+	suite.Require().Nil(svcErr)
+	suite.Require().NotNil(*published)
+	suite.Equal(audit.TargetUserImportJob, (*published).Data["target_type"])
+	suite.Equal(job.ID, (*published).Data["target_id"])
+}
+
+func (suite *UserBulkServiceTestSuite) TestCreateImportJob_Rejected() {
+	tests := []struct {
+		name    string
+		file    string
+		request ImportJobRequest
+		wantErr string
+	}{
+		{name: "UnknownFormat", request: ImportJobRequest{FileName: "users.xlsx"}, wantErr: ErrorInvalidFileFormat.Code},
+		{
+			name: "UnsupportedAlgorithm",
+			request: ImportJobRequest{Format: FormatNDJSON,
+				CredentialAlgorithms: map[string]HashAlgorithm{"password": {Algorithm: "MD5"}}},
+			wantErr: ErrorInvalidCredentialAlgorithm.Code,
+		},
+		{
+			name: "InvalidMapping", file: "username\n",
+			request: ImportJobRequest{FileName: "users.CSV", Mapping: map[string]string{"login": "username"}},
+			wantErr: ErrorInvalidMapping.Code,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.allow(security.ActionImportUsers)
+
+			_, svcErr := suite.service.CreateImportJob(context.Background(), strings.NewReader(tt.file), tt.request)
+
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tt.wantErr, svcErr.Code)
+			suite.Len(suite.service.jobSlots, 0)
+		})
+	}
+}
+
+func (suite *UserBulkServiceTestSuite) TestCreateImportJob_TooManyJobs() {
+	suite.allow(security.ActionImportUsers)
+	suite.service.jobSlots <- struct{}{}
+
+	_, svcErr := suite.service.CreateImportJob(context.Background(), strings.NewReader(""),
+		ImportJobRequest{Format: FormatNDJSON})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorTooManyJobs.Code, svcErr.Code)
+}
+
+func (suite *UserBulkServiceTestSuite) TestCreateImportJob_StoreErrorReleasesSlot() {
+	suite.allow(security.ActionImportUsers)
+	suite.mockJobStore.On("createJob", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	_, svcErr := suite.service.CreateImportJob(context.Background(), strings.NewReader(""),
+		ImportJobRequest{Format: FormatNDJSON})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+	suite.Len(suite.service.jobSlots, 0)
+}
+
+func (suite *UserBulkServiceTestSuite) TestCreateImportJob_Unauthorized() {
+	suite.deny(security.ActionImportUsers)
+
+	_, svcErr := suite.service.CreateImportJob(context.Background(), strings.NewReader(""), ImportJobRequest{})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(tidcommon.ErrorUnauthorized.Code, svcErr.Code)
+}
+
+func (suite *UserBulkServiceTestSuite) TestRunImportJob_CapsReportedErrors() {
+	reader := newRecordReaderMock(suite.T())
+	for line := 1; line <= 3; line++ {
+		reader.On("next").Return(nil, &ImportError{Line: line, Code: ErrorInvalidRow.Code}, nil).Once()
+	}
+	reader.On("next").Return(nil, nil, io.EOF).Once()
+	suite.mockJobStore.On("updateProgress", mock.Anything, "job-1", mock.MatchedBy(func(p importProgress) bool {
+		return p.processed == 3 && p.failed == 3 && p.reportedErrors == 2 && len(p.errors) == 2
+	}), mock.Anything).Return(true, nil).Once()
+	suite.mockJobStore.On("endJob", mock.Anything, "job-1", JobStatusCompleted, "", mock.Anything,
+		mock.MatchedBy(func(expiry time.Time) bool {
+			return time.Until(expiry) > testRetention-time.Minute
+		})).Return(true, nil).Once()
+
+	suite.service.runImportJob(context.Background(), "job-1", reader)
+}
+
+func (suite *UserBulkServiceTestSuite) TestRunImportJob_StopsWhenCancelled() {
+	reader := newRecordReaderMock(suite.T())
+	reader.On("next").Return(nil, &ImportError{Line: 1, Code: ErrorInvalidRow.Code}, nil).Times(progressInterval)
+	suite.mockJobStore.On("updateProgress", mock.Anything, "job-1", mock.Anything, mock.Anything).
+		Return(false, nil).Once()
+
+	suite.service.runImportJob(context.Background(), "job-1", reader)
+}
+
+func (suite *UserBulkServiceTestSuite) TestRunImportJob_FailsWhenFileCannotBeRead() {
+	reader := newRecordReaderMock(suite.T())
+	reader.On("next").Return(nil, nil, errors.New("bare quote")).Once()
+	suite.mockJobStore.On("updateProgress", mock.Anything, "job-1", mock.Anything, mock.Anything).
+		Return(true, nil).Once()
+	suite.mockJobStore.On("endJob", mock.Anything, "job-1", JobStatusFailed,
+		"the import file could not be read: bare quote", mock.Anything, mock.Anything).Return(true, nil).Once()
+
+	suite.service.runImportJob(context.Background(), "job-1", reader)
+}
+
+func (suite *UserBulkServiceTestSuite) TestRunImportJob_FailsWhenProgressCannotBeRecorded() {
+	reader := newRecordReaderMock(suite.T())
+	reader.On("next").Return(nil, nil, io.EOF).Once()
+	suite.mockJobStore.On("updateProgress", mock.Anything, "job-1", mock.Anything, mock.Anything).
+		Return(false, errors.New("db down")).Once()
+	suite.mockJobStore.On("endJob", mock.Anything, "job-1", JobStatusFailed, mock.Anything, mock.Anything,
+		mock.Anything).Return(true, nil).Once()
+
+	suite.service.runImportJob(context.Background(), "job-1", reader)
+}
+
+func (suite *UserBulkServiceTestSuite) TestGetImportJobList() {
+	suite.allow(security.ActionImportUsers)
+	suite.mockJobStore.On("getJobListCount", mock.Anything).Return(3, nil)
+	suite.mockJobStore.On("getJobList", mock.Anything, 2, 1).Return([]ImportJob{*runningJob()}, nil)
+
+	resp, svcErr := suite.service.GetImportJobList(context.Background(), 2, 1)
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(3, resp.TotalResults)
+	suite.Equal(2, resp.StartIndex)
+	suite.Equal(1, resp.Count)
+	suite.NotEmpty(resp.Links)
+}
+
+func (suite *UserBulkServiceTestSuite) TestGetImportJobList_InvalidPagination() {
+	suite.allow(security.ActionImportUsers)
+
+	_, svcErr := suite.service.GetImportJobList(context.Background(), 0, 0)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorInvalidLimit.Code, svcErr.Code)
+
+	_, svcErr = suite.service.GetImportJobList(context.Background(), 10, -1)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorInvalidOffset.Code, svcErr.Code)
+}
+
+func (suite *UserBulkServiceTestSuite) TestGetImportJob() {
+	suite.allow(security.ActionImportUsers)
+	suite.mockJobStore.On("getJob", mock.Anything, "job-1").Return(runningJob(), nil)
+	suite.mockJobStore.On("getJob", mock.Anything, "missing").Return(nil, nil)
+	suite.mockJobStore.On("getJob", mock.Anything, "broken").Return(nil, errors.New("db down"))
+
+	job, svcErr := suite.service.GetImportJob(context.Background(), "job-1")
+	suite.Require().Nil(svcErr)
+	suite.Equal("job-1", job.ID)
+
+	_, svcErr = suite.service.GetImportJob(context.Background(), "missing")
+	suite.Equal(ErrorJobNotFound.Code, svcErr.Code)
+
+	_, svcErr = suite.service.GetImportJob(context.Background(), "broken")
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *UserBulkServiceTestSuite) TestCancelImportJob() {
+	suite.allow(security.ActionImportUsers)
+	published := suite.captureAudit()
+	suite.mockJobStore.On("getJob", mock.Anything, "job-1").Return(runningJob(), nil)
+	suite.mockJobStore.On("endJob", mock.Anything, "job-1", JobStatusCancelled, "", mock.Anything,
+		mock.Anything).Return(true, nil)
+
+	job, svcErr := suite.service.CancelImportJob(context.Background(), "job-1")
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(JobStatusCancelled, job.Status)
+	suite.NotNil(job.CompletedAt)
+	suite.Require().NotNil(*published)
+	suite.Equal(audit.TargetUserImportJob, (*published).Data["target_type"])
+}
+
+func (suite *UserBulkServiceTestSuite) TestCancelImportJob_NotRunning() {
+	suite.allow(security.ActionImportUsers)
+	completed := runningJob()
+	completed.Status = JobStatusCompleted
+	suite.mockJobStore.On("getJob", mock.Anything, "done").Return(completed, nil)
+	suite.mockJobStore.On("getJob", mock.Anything, "racing").Return(runningJob(), nil)
+	suite.mockJobStore.On("endJob", mock.Anything, "racing", JobStatusCancelled, "", mock.Anything,
+		mock.Anything).Return(false, nil)
+
+	for _, id := range []string{"done", "racing"} {
+		_, svcErr := suite.service.CancelImportJob(context.Background(), id)
+		suite.Require().NotNil(svcErr, id)
+		suite.Equal(ErrorJobNotRunning.Code, svcErr.Code, id)
+	}
+}
+
+func (suite *UserBulkServiceTestSuite) TestWriteImportErrorReport_PagesThroughErrors() {
+	suite.allow(security.ActionImportUsers)
+	suite.mockJobStore.On("getJob", mock.Anything, "job-1").Return(runningJob(), nil)
+	firstPage := make([]ImportError, errorReportBatchSize)
+	for i := range firstPage {
+		firstPage[i] = ImportError{Line: i + 2, Code: "UBK-1101", Message: "Invalid row"}
+	}
+	suite.mockJobStore.On("getErrors", mock.Anything, "job-1", 0, errorReportBatchSize).Return(firstPage, nil)
+	suite.mockJobStore.On("getErrors", mock.Anything, "job-1", errorReportBatchSize+1, errorReportBatchSize).
+		Return([]ImportError{{Line: 900, Code: "USR-1014", Message: "Attribute conflict, \"email\""}}, nil)
+
+	var out bytes.Buffer
+	svcErr := suite.service.WriteImportErrorReport(context.Background(), "job-1", &out)
+
+	suite.Require().Nil(svcErr)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	suite.Len(lines, errorReportBatchSize+2)
+	suite.Equal("line,code,message", lines[0])
+	suite.Equal("2,UBK-1101,Invalid row", lines[1])
+	suite.Equal(`900,USR-1014,"Attribute conflict, ""email"""`, lines[len(lines)-1])
+}
+
+func (suite *UserBulkServiceTestSuite) TestWriteImportErrorReport_JobNotFound() {
+	suite.allow(security.ActionImportUsers)
+	suite.mockJobStore.On("getJob", mock.Anything, "missing").Return(nil, nil)
+
+	var out bytes.Buffer
+	svcErr := suite.service.WriteImportErrorReport(context.Background(), "missing", &out)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorJobNotFound.Code, svcErr.Code)
+	suite.Zero(out.Len())
+}
+
+// exportUsers makes the user service visit the given users.
+func (suite *UserBulkServiceTestSuite) exportUsers(filters map[string]interface{}, users ...user.User) {
+	suite.mockUserService.On("ExportUsers", mock.Anything, filters, mock.Anything).
+		Run(func(args mock.Arguments) {
+			visit := args.Get(2).(func(*user.User) error)
+			for i := range users {
+				if err := visit(&users[i]); err != nil {
+					return
+				}
+			}
+		}).Return(nil)
+}
+
+func (suite *UserBulkServiceTestSuite) TestExportUsers_NDJSON() {
+	suite.allow(security.ActionExportUsers)
+	filters := map[string]interface{}{"department": "sales"}
+	suite.exportUsers(filters,
+		user.User{ID: "user-1", Type: "person", OUID: "ou-1", Attributes: json.RawMessage(`{"username":"alice"}`)},
+		user.User{ID: "user-2", Type: "person", OUID: "ou-1"})
+
+	var out bytes.Buffer
+	svcErr := suite.service.ExportUsers(context.Background(), ExportRequest{Format: FormatNDJSON, Filters: filters},
+		&out)
+
+	suite.Require().Nil(svcErr)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	suite.Require().Len(lines, 2)
+	suite.JSONEq(`{"id":"user-1","type":"person","ouId":"ou-1","attributes":{"username":"alice"},"isReadOnly":false}`,
+		lines[0])
+}
+
+func (suite *UserBulkServiceTestSuite) TestExportUsers_CSV() {
+	suite.allow(security.ActionExportUsers)
+	suite.exportUsers(nil,
+		user.User{ID: "user-1", Type: "person", OUID: "ou-1",
+			Attributes: json.RawMessage(`{"username":"alice","age":30,"tags":["a","b"],"nick":null}`)},
+		user.User{ID: "user-2", Type: "person", OUID: "ou-2"})
+
+	var out bytes.Buffer
+	svcErr := suite.service.ExportUsers(context.Background(),
+		ExportRequest{Format: FormatCSV, Attributes: []string{"username", "age", "tags", "nick"}}, &out)
+
+	suite.Require().Nil(svcErr)
+	suite.Equal("id,type,ouId,username,age,tags,nick\n"+
+		"user-1,person,ou-1,alice,30,\"[\"\"a\"\",\"\"b\"\"]\",\n"+
+		"user-2,person,ou-2,,,,\n", out.String())
+}
+
+func (suite *UserBulkServiceTestSuite) TestExportUsers_Rejected() {
+	suite.allow(security.ActionExportUsers)
+
+	for _, request := range []ExportRequest{{Format: FormatCSV}, {Format: "xml"}} {
+		var out bytes.Buffer
+		svcErr := suite.service.ExportUsers(context.Background(), request, &out)
+		suite.Require().NotNil(svcErr)
+		suite.Equal(ErrorInvalidExportRequest.Code, svcErr.Code)
+		suite.Zero(out.Len())
+	}
+}
+
+func (suite *UserBulkServiceTestSuite) TestExportUsers_FailureBeforeStartWritesNothing() {
+	suite.allow(security.ActionExportUsers)
+	suite.mockUserService.On("ExportUsers", mock.Anything, mock.Anything, mock.Anything).
+		Return(&tidcommon.InternalServerError)
+
+	var out bytes.Buffer
+	svcErr := suite.service.ExportUsers(context.Background(),
+		ExportRequest{Format: FormatCSV, Attributes: []string{"username"}}, &out)
+
+	suite.Require().NotNil(svcErr)
+	suite.Zero(out.Len())
+}
+
+func (suite *UserBulkServiceTestSuite) TestExportUsers_Unauthorized() {
+	suite.deny(security.ActionExportUsers)
+
+	svcErr := suite.service.ExportUsers(context.Background(), ExportRequest{Format: FormatNDJSON}, io.Discard)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(tidcommon.ErrorUnauthorized.Code, svcErr.Code)
+}
+
+func (suite *UserBulkServiceTestSuite) TestSweepJobs() {
+	suite.mockJobStore.On("failStaleJobs", mock.Anything, "the job was interrupted", mock.Anything,
+		mock.MatchedBy(func(staleBefore time.Time) bool {
+			return time.Since(staleBefore) >= staleJobTimeout
+		}), mock.Anything).Return(int64(1), nil).Once()
+	suite.mockJobStore.On("deleteExpiredJobs", mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("db down")).Once()
+
+	suite.service.sweepJobs()
+}
+
+func (suite *UserBulkServiceTestSuite) TestRunMaintenance_StopsWhenClosed() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		suite.service.runMaintenance(stop)
+		close(done)
+	}()
+
+	close(stop)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		suite.Fail("maintenance did not stop")
+	}
+}
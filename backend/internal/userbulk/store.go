@@ -0,0 +1,312 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// importJobStoreInterface defines the persistence of user import jobs and their row errors.
+type importJobStoreInterface interface {
+	// createJob stores a new running import job.
+	createJob(ctx context.Context, job ImportJob) error
+	// getJob returns the import job with the given ID, or nil when it does not exist.
+	getJob(ctx context.Context, id string) (*ImportJob, error)
+	// getJobListCount returns the number of import jobs.
+	getJobListCount(ctx context.Context) (int, error)
+	// getJobList returns a page of import jobs, newest first.
+	getJobList(ctx context.Context, limit, offset int) ([]ImportJob, error)
+	// updateProgress records the row errors and counters of a running import job. It reports false when
+	// the job is no longer running.
+	updateProgress(ctx context.Context, id string, progress importProgress, now time.Time) (bool, error)
+	// endJob moves a running import job to a final status, kept until expiryTime. It reports false when
+	// the job is no longer running.
+	endJob(ctx context.Context, id string, status JobStatus, message string, now, expiryTime time.Time) (bool,
+		error)
+	// getErrors returns up to limit row errors of an import job recorded after the given line.
+	getErrors(ctx context.Context, id string, afterLine, limit int) ([]ImportError, error)
+	// failStaleJobs fails the running import jobs that have not reported progress since staleBefore and
+	// returns how many were failed.
+	failStaleJobs(ctx context.Context, message string, now, staleBefore, expiryTime time.Time) (int64, error)
+	// deleteExpiredJobs removes the import jobs, with their row errors, that expired before now and returns
+	// how many jobs were removed.
+	deleteExpiredJobs(ctx context.Context, now time.Time) (int64, error)
+}
+
+// importJobStore implements importJobStoreInterface against the runtime persistent database.
+type importJobStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newImportJobStore creates a new importJobStore.
+func newImportJobStore() importJobStoreInterface {
+	return &importJobStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createJob stores a new running import job.
+func (s *importJobStore) createJob(ctx context.Context, job ImportJob) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateImportJob, job.ID, s.deploymentID, string(job.Status),
+		job.Format, job.FileName, job.UserType, job.OUID, job.CreatedBy, job.CreatedAt, job.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error creating user import job: %w", err)
+	}
+
+	return nil
+}
+
+// getJob returns the import job with the given ID, or nil when it does not exist.
+func (s *importJobStore) getJob(ctx context.Context, id string) (*ImportJob, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetImportJob, id, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute import job query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	job, err := buildImportJobFromResultRow(results[0])
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// getJobListCount returns the number of import jobs.
+func (s *importJobStore) getJobListCount(ctx context.Context) (int, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetImportJobCount, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+
+	count, ok := utils.ToInt64(results[0]["total"])
+	if !ok {
+		return 0, fmt.Errorf("unexpected type for total: %T", results[0]["total"])
+	}
+	return int(count), nil
+}
+
+// getJobList returns a page of import jobs, newest first.
+func (s *importJobStore) getJobList(ctx context.Context, limit, offset int) ([]ImportJob, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetImportJobList, limit, offset, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute import job list query: %w", err)
+	}
+
+	jobs := make([]ImportJob, 0, len(results))
+	for _, row := range results {
+		job, err := buildImportJobFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// updateProgress records the row errors and counters of a running import job. The errors are recorded
+// first so that the reported error count never exceeds the errors in the report.
+func (s *importJobStore) updateProgress(ctx context.Context, id string, progress importProgress,
+	now time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	for _, importErr := range progress.errors {
+		_, err := dbClient.ExecuteContext(ctx, queryInsertImportError, id, s.deploymentID, importErr.Line,
+			importErr.Code, importErr.Message)
+		if err != nil {
+			return false, fmt.Errorf("error recording user import error: %w", err)
+		}
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryUpdateImportJobProgress, progress.processed,
+		progress.succeeded, progress.failed, progress.reportedErrors, now, id, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("error updating user import job progress: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// endJob moves a running import job to a final status, kept until expiryTime.
+func (s *importJobStore) endJob(ctx context.Context, id string, status JobStatus, message string,
+	now, expiryTime time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	var errorMessage interface{}
+	if message != "" {
+		errorMessage = message
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryEndImportJob, string(status), errorMessage, now, expiryTime,
+		id, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("error ending user import job: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// getErrors returns up to limit row errors of an import job recorded after the given line.
+func (s *importJobStore) getErrors(ctx context.Context, id string, afterLine, limit int) ([]ImportError,
+	error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetImportErrors, id, s.deploymentID, afterLine, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute import error query: %w", err)
+	}
+
+	importErrors := make([]ImportError, 0, len(results))
+	for _, row := range results {
+		line, ok := utils.ToInt64(row["line_number"])
+		if !ok {
+			return nil, fmt.Errorf("unexpected type for line_number: %T", row["line_number"])
+		}
+		importErrors = append(importErrors, ImportError{
+			Line:    int(line),
+			Code:    stringOrBytesField(row, "error_code"),
+			Message: stringOrBytesField(row, "error_message"),
+		})
+	}
+	return importErrors, nil
+}
+
+// failStaleJobs fails the running import jobs that have not reported progress since staleBefore.
+func (s *importJobStore) failStaleJobs(ctx context.Context, message string, now, staleBefore,
+	expiryTime time.Time) (int64, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryFailStaleImportJobs, message, now, expiryTime,
+		s.deploymentID, staleBefore)
+	if err != nil {
+		return 0, fmt.Errorf("error failing stale user import jobs: %w", err)
+	}
+
+	return rows, nil
+}
+
+// deleteExpiredJobs removes the import jobs, with their row errors, that expired before now.
+func (s *importJobStore) deleteExpiredJobs(ctx context.Context, now time.Time) (int64, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteExpiredImportErrors, now, s.deploymentID); err != nil {
+		return 0, fmt.Errorf("error deleting expired user import errors: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteExpiredImportJobs, now, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting expired user import jobs: %w", err)
+	}
+
+	return rows, nil
+}
+
+// buildImportJobFromResultRow constructs an ImportJob from a database result row.
+func buildImportJobFromResultRow(row map[string]interface{}) (ImportJob, error) {
+	id, ok := row["job_id"].(string)
+	if !ok {
+		return ImportJob{}, errors.New("failed to parse job_id as string")
+	}
+
+	createdAt, err := utils.ParseDBTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return ImportJob{}, err
+	}
+	updatedAt, err := utils.ParseDBTimeField(row["updated_at"], "updated_at")
+	if err != nil {
+		return ImportJob{}, err
+	}
+	var completedAt *time.Time
+	if row["completed_at"] != nil {
+		t, err := utils.ParseDBTimeField(row["completed_at"], "completed_at")
+		if err != nil {
+			return ImportJob{}, err
+		}
+		completedAt = &t
+	}
+
+	processed, _ := utils.ToInt64(row["processed"])
+	succeeded, _ := utils.ToInt64(row["succeeded"])
+	failed, _ := utils.ToInt64(row["failed"])
+	reportedErrors, _ := utils.ToInt64(row["reported_errors"])
+
+	return ImportJob{
+		ID:             id,
+		Status:         JobStatus(stringOrBytesField(row, "status")),
+		Format:         stringOrBytesField(row, "format"),
+		FileName:       stringOrBytesField(row, "file_name"),
+		UserType:       stringOrBytesField(row, "user_type"),
+		OUID:           stringOrBytesField(row, "ou_id"),
+		CreatedBy:      stringOrBytesField(row, "created_by"),
+		Processed:      int(processed),
+		Succeeded:      int(succeeded),
+		Failed:         int(failed),
+		ReportedErrors: int(reportedErrors),
+		Error:          stringOrBytesField(row, "error_message"),
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+		CompletedAt:    completedAt,
+	}, nil
+}
+
+// stringOrBytesField returns the value of a nullable text column, which drivers return as either a
+// string or a byte slice, or an empty string when it is NULL.
+func stringOrBytesField(row map[string]interface{}, column string) string {
+	switch v := row[column].(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+var (
+	// queryCreateImportJob creates a running import job.
+	queryCreateImportJob = dbmodel.DBQuery{
+		ID: "UBQ-IJ-01",
+		Query: `INSERT INTO "USER_IMPORT_JOB" (JOB_ID, DEPLOYMENT_ID, STATUS, FORMAT, FILE_NAME, USER_TYPE, ` +
+			`OU_ID, CREATED_BY, PROCESSED, SUCCEEDED, FAILED, REPORTED_ERRORS, CREATED_AT, UPDATED_AT) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, 0, 0, 0, 0, $9, $10)`,
+	}
+
+	// queryGetImportJob gets an import job by its ID.
+	queryGetImportJob = dbmodel.DBQuery{
+		ID: "UBQ-IJ-02",
+		Query: `SELECT JOB_ID, STATUS, FORMAT, FILE_NAME, USER_TYPE, OU_ID, CREATED_BY, PROCESSED, SUCCEEDED, ` +
+			`FAILED, REPORTED_ERRORS, ERROR_MESSAGE, CREATED_AT, UPDATED_AT, COMPLETED_AT ` +
+			`FROM "USER_IMPORT_JOB" WHERE JOB_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetImportJobCount counts the import jobs of the deployment.
+	queryGetImportJobCount = dbmodel.DBQuery{
+		ID:    "UBQ-IJ-03",
+		Query: `SELECT COUNT(*) as total FROM "USER_IMPORT_JOB" WHERE DEPLOYMENT_ID = $1`,
+	}
+
+	// queryGetImportJobList gets a page of import jobs, newest first.
+	queryGetImportJobList = dbmodel.DBQuery{
+		ID: "UBQ-IJ-04",
+		Query: `SELECT JOB_ID, STATUS, FORMAT, FILE_NAME, USER_TYPE, OU_ID, CREATED_BY, PROCESSED, SUCCEEDED, ` +
+			`FAILED, REPORTED_ERRORS, ERROR_MESSAGE, CREATED_AT, UPDATED_AT, COMPLETED_AT ` +
+			`FROM "USER_IMPORT_JOB" WHERE DEPLOYMENT_ID = $3 ORDER BY CREATED_AT DESC, JOB_ID LIMIT $1 OFFSET $2`,
+	}
+
+	// queryUpdateImportJobProgress records the progress of a running import job. The status guards against
+	// overwriting a job that was cancelled meanwhile.
+	queryUpdateImportJobProgress = dbmodel.DBQuery{
+		ID: "UBQ-IJ-05",
+		Query: `UPDATE "USER_IMPORT_JOB" SET PROCESSED = $1, SUCCEEDED = $2, FAILED = $3, REPORTED_ERRORS = $4, ` +
+			`UPDATED_AT = $5 WHERE JOB_ID = $6 AND DEPLOYMENT_ID = $7 AND STATUS = 'running'`,
+	}
+
+	// queryEndImportJob moves a running import job to a final status.
+	queryEndImportJob = dbmodel.DBQuery{
+		ID: "UBQ-IJ-06",
+		Query: `UPDATE "USER_IMPORT_JOB" SET STATUS = $1, ERROR_MESSAGE = $2, UPDATED_AT = $3, COMPLETED_AT = $3, ` +
+			`EXPIRY_TIME = $4 WHERE JOB_ID = $5 AND DEPLOYMENT_ID = $6 AND STATUS = 'running'`,
+	}
+
+	// queryFailStaleImportJobs fails the running import jobs that stopped reporting progress.
+	queryFailStaleImportJobs = dbmodel.DBQuery{
+		ID: "UBQ-IJ-07",
+		Query: `UPDATE "USER_IMPORT_JOB" SET STATUS = 'failed', ERROR_MESSAGE = $1, UPDATED_AT = $2, ` +
+			`COMPLETED_AT = $2, EXPIRY_TIME = $3 WHERE DEPLOYMENT_ID = $4 AND STATUS = 'running' ` +
+			`AND UPDATED_AT < $5`,
+	}
+
+	// queryDeleteExpiredImportJobs removes the import jobs past their retention period.
+	queryDeleteExpiredImportJobs = dbmodel.DBQuery{
+		ID:    "UBQ-IJ-08",
+		Query: `DELETE FROM "USER_IMPORT_JOB" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
+
+var (
+	// queryInsertImportError records a row an import job failed to import.
+	queryInsertImportError = dbmodel.DBQuery{
+		ID: "UBQ-IE-01",
+		Query: `INSERT INTO "USER_IMPORT_ERROR" (JOB_ID, DEPLOYMENT_ID, LINE_NUMBER, ERROR_CODE, ERROR_MESSAGE) ` +
+			`VALUES ($1, $2, $3, $4, $5)`,
+	}
+
+	// queryGetImportErrors gets a page of the row errors of an import job, in line order.
+	queryGetImportErrors = dbmodel.DBQuery{
+		ID: "UBQ-IE-02",
+		Query: `SELECT LINE_NUMBER, ERROR_CODE, ERROR_MESSAGE FROM "USER_IMPORT_ERROR" ` +
+			`WHERE JOB_ID = $1 AND DEPLOYMENT_ID = $2 AND LINE_NUMBER > $3 ORDER BY LINE_NUMBER LIMIT $4`,
+	}
+
+	// queryDeleteExpiredImportErrors removes the row errors of the import jobs past their retention period.
+	queryDeleteExpiredImportErrors = dbmodel.DBQuery{
+		ID: "UBQ-IE-03",
+		Query: `DELETE FROM "USER_IMPORT_ERROR" WHERE DEPLOYMENT_ID = $2 AND JOB_ID IN ` +
+			`(SELECT JOB_ID FROM "USER_IMPORT_JOB" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2)`,
+	}
+)
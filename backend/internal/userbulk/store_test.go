@@ -0,0 +1,242 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package userbulk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type ImportJobStoreTestSuite struct {
+	suite.Suite
+	mockdbProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *importJobStore
+}
+
+func TestImportJobStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportJobStoreTestSuite))
+}
+
+func (suite *ImportJobStoreTestSuite) SetupTest() {
+	suite.mockdbProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &importJobStore{
+		dbProvider:   suite.mockdbProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+var testTime = time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+func importJobRow() map[string]interface{} {
+	return map[string]interface{}{
+		"job_id":          "job-1",
+		"status":          "completed",
+		"format":          "csv",
+		"file_name":       []byte("users.csv"),
+		"user_type":       "person",
+		"ou_id":           "ou-1",
+		"created_by":      "admin",
+		"processed":       int64(10),
+		"succeeded":       int64(8),
+		"failed":          int64(2),
+		"reported_errors": int64(2),
+		"error_message":   nil,
+		"created_at":      testTime,
+		"updated_at":      testTime,
+		"completed_at":    testTime,
+	}
+}
+
+func (suite *ImportJobStoreTestSuite) TestCreateJob() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryCreateImportJob, "job-1", testDeploymentID,
+		"running", "csv", "users.csv", "person", "ou-1", "admin", testTime, testTime).Return(int64(1), nil)
+
+	err := suite.store.createJob(context.Background(), ImportJob{
+		ID: "job-1", Status: JobStatusRunning, Format: FormatCSV, FileName: "users.csv", UserType: "person",
+		OUID: "ou-1", CreatedBy: "admin", CreatedAt: testTime, UpdatedAt: testTime,
+	})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ImportJobStoreTestSuite) TestCreateJob_DBClientError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(nil, errors.New("db unavailable"))
+
+	err := suite.store.createJob(context.Background(), ImportJob{ID: "job-1"})
+	assert.ErrorContains(suite.T(), err, "failed to get runtime persistent database client")
+}
+
+func (suite *ImportJobStoreTestSuite) TestGetJob() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetImportJob, "job-1", testDeploymentID).
+		Return([]map[string]interface{}{importJobRow()}, nil)
+
+	job, err := suite.store.getJob(context.Background(), "job-1")
+	assert.NoError(suite.T(), err)
+	completedAt := testTime
+	assert.Equal(suite.T(), &ImportJob{
+		ID: "job-1", Status: JobStatusCompleted, Format: FormatCSV, FileName: "users.csv", UserType: "person",
+		OUID: "ou-1", CreatedBy: "admin", Processed: 10, Succeeded: 8, Failed: 2, ReportedErrors: 2,
+		CreatedAt: testTime, UpdatedAt: testTime, CompletedAt: &completedAt,
+	}, job)
+}
+
+func (suite *ImportJobStoreTestSuite) TestGetJob_NotFound() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetImportJob, "job-1", testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	job, err := suite.store.getJob(context.Background(), "job-1")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), job)
+}
+
+func (suite *ImportJobStoreTestSuite) TestGetJob_InvalidRow() {
+	row := importJobRow()
+	row["created_at"] = 42
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetImportJob, "job-1", testDeploymentID).
+		Return([]map[string]interface{}{row}, nil)
+
+	_, err := suite.store.getJob(context.Background(), "job-1")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *ImportJobStoreTestSuite) TestGetJobListCount() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetImportJobCount, testDeploymentID).
+		Return([]map[string]interface{}{{"total": int64(3)}}, nil)
+
+	count, err := suite.store.getJobListCount(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, count)
+}
+
+func (suite *ImportJobStoreTestSuite) TestGetJobList() {
+	running := importJobRow()
+	running["job_id"] = "job-2"
+	running["status"] = "running"
+	running["completed_at"] = nil
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetImportJobList, 10, 0, testDeploymentID).
+		Return([]map[string]interface{}{running, importJobRow()}, nil)
+
+	jobs, err := suite.store.getJobList(context.Background(), 10, 0)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), jobs, 2)
+	assert.Equal(suite.T(), JobStatusRunning, jobs[0].Status)
+	assert.Nil(suite.T(), jobs[0].CompletedAt)
+	assert.NotNil(suite.T(), jobs[1].CompletedAt)
+}
+
+func (suite *ImportJobStoreTestSuite) TestUpdateProgress_RecordsErrorsBeforeCounters() {
+	progress := importProgress{processed: 100, succeeded: 98, failed: 2, reportedErrors: 2, errors: []ImportError{
+		{Line: 5, Code: "UBK-1101", Message: "Invalid row"},
+		{Line: 9, Code: "USR-1014", Message: "Attribute conflict"},
+	}}
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	first := suite.mockDBClient.On("ExecuteContext", context.Background(), queryInsertImportError, "job-1",
+		testDeploymentID, 5, "UBK-1101", "Invalid row").Return(int64(1), nil).Once()
+	second := suite.mockDBClient.On("ExecuteContext", context.Background(), queryInsertImportError, "job-1",
+		testDeploymentID, 9, "USR-1014", "Attribute conflict").Return(int64(1), nil).Once().NotBefore(first)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryUpdateImportJobProgress, 100, 98, 2, 2,
+		testTime, "job-1", testDeploymentID).Return(int64(0), nil).Once().NotBefore(second)
+
+	running, err := suite.store.updateProgress(context.Background(), "job-1", progress, testTime)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), running)
+}
+
+func (suite *ImportJobStoreTestSuite) TestUpdateProgress_InsertError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryInsertImportError, "job-1",
+		testDeploymentID, 5, "UBK-1101", "Invalid row").Return(int64(0), errors.New("duplicate key"))
+
+	_, err := suite.store.updateProgress(context.Background(), "job-1", importProgress{
+		errors: []ImportError{{Line: 5, Code: "UBK-1101", Message: "Invalid row"}},
+	}, testTime)
+	assert.ErrorContains(suite.T(), err, "error recording user import error")
+}
+
+func (suite *ImportJobStoreTestSuite) TestEndJob() {
+	expiry := testTime.Add(24 * time.Hour)
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryEndImportJob, "completed", nil, testTime,
+		expiry, "job-1", testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryEndImportJob, "failed", "interrupted",
+		testTime, expiry, "job-1", testDeploymentID).Return(int64(0), nil).Once()
+
+	ended, err := suite.store.endJob(context.Background(), "job-1", JobStatusCompleted, "", testTime, expiry)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ended)
+
+	ended, err = suite.store.endJob(context.Background(), "job-1", JobStatusFailed, "interrupted", testTime, expiry)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ended)
+}
+
+func (suite *ImportJobStoreTestSuite) TestGetErrors() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetImportErrors, "job-1", testDeploymentID,
+		4, 500).Return([]map[string]interface{}{
+		{"line_number": int64(5), "error_code": "UBK-1101", "error_message": []byte("Invalid row")},
+	}, nil)
+
+	importErrors, err := suite.store.getErrors(context.Background(), "job-1", 4, 500)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []ImportError{{Line: 5, Code: "UBK-1101", Message: "Invalid row"}}, importErrors)
+}
+
+func (suite *ImportJobStoreTestSuite) TestGetErrors_UnexpectedType() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetImportErrors, "job-1", testDeploymentID,
+		0, 500).Return([]map[string]interface{}{{"line_number": "5"}}, nil)
+
+	_, err := suite.store.getErrors(context.Background(), "job-1", 0, 500)
+	assert.ErrorContains(suite.T(), err, "unexpected type for line_number")
+}
+
+func (suite *ImportJobStoreTestSuite) TestFailStaleJobs() {
+	staleBefore := testTime.Add(-staleJobTimeout)
+	expiry := testTime.Add(24 * time.Hour)
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryFailStaleImportJobs, "interrupted",
+		testTime, expiry, testDeploymentID, staleBefore).Return(int64(2), nil)
+
+	failed, err := suite.store.failStaleJobs(context.Background(), "interrupted", testTime, staleBefore, expiry)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), failed)
+}
+
+func (suite *ImportJobStoreTestSuite) TestDeleteExpiredJobs() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	errorsDeleted := suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteExpiredImportErrors,
+		testTime, testDeploymentID).Return(int64(7), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteExpiredImportJobs, testTime,
+		testDeploymentID).Return(int64(2), nil).Once().NotBefore(errorsDeleted)
+
+	deleted, err := suite.store.deleteExpiredJobs(context.Background(), testTime)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), deleted)
+}
+
+func (suite *ImportJobStoreTestSuite) TestDeleteExpiredJobs_ErrorDeleteFails() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteExpiredImportErrors, testTime,
+		testDeploymentID).Return(int64(0), errors.New("db down"))
+
+	_, err := suite.store.deleteExpiredJobs(context.Background(), testTime)
+	assert.ErrorContains(suite.T(), err, "error deleting expired user import errors")
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import "time"
+
+// Webhook event types a webhook can subscribe to.
+const (
+	// EventTypeUserRegistered is delivered when a registration flow completes for a new user.
+	EventTypeUserRegistered = "user.registered"
+	// EventTypeUserLogin is delivered when an authentication flow completes for a user.
+	EventTypeUserLogin = "user.login"
+	// EventTypeUserCredentialsUpdated is delivered when a user's credentials are changed, either by an
+	// administrator or through a recovery flow.
+	EventTypeUserCredentialsUpdated = "user.credentials_updated"
+	// EventTypeUserDeleted is delivered when a user is deleted.
+	EventTypeUserDeleted = "user.deleted"
+)
+
+// supportedEventTypes lists the event types a webhook can subscribe to.
+var supportedEventTypes = map[string]struct{}{
+	EventTypeUserRegistered:         {},
+	EventTypeUserLogin:              {},
+	EventTypeUserCredentialsUpdated: {},
+	EventTypeUserDeleted:            {},
+}
+
+// Payload formats a webhook can be configured with.
+const (
+	// FormatJSON delivers the event in a plain JSON envelope.
+	FormatJSON = "json"
+	// FormatCloudEvents delivers the event in the CloudEvents 1.0 structured JSON format.
+	FormatCloudEvents = "cloudevents"
+)
+
+// DeliveryStatus is the state of a webhook delivery in the outbox.
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending marks a delivery that is waiting for its next attempt.
+	DeliveryStatusPending DeliveryStatus = "pending"
+	// DeliveryStatusDelivered marks a delivery the endpoint accepted.
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	// DeliveryStatusDeadLetter marks a delivery that ran out of attempts or whose webhook is gone.
+	DeliveryStatusDeadLetter DeliveryStatus = "dead_letter"
+)
+
+// Headers sent with every delivery, following the Standard Webhooks specification.
+const (
+	headerWebhookID        = "Webhook-Id"
+	headerWebhookTimestamp = "Webhook-Timestamp"
+	headerWebhookSignature = "Webhook-Signature"
+)
+
+const (
+	contentTypeJSON        = "application/json"
+	contentTypeCloudEvents = "application/cloudevents+json"
+
+	// cloudEventsSpecVersion is the CloudEvents specification version of the structured payloads.
+	cloudEventsSpecVersion = "1.0"
+	// cloudEventsTypePrefix namespaces the webhook event types in the CloudEvents type attribute.
+	cloudEventsTypePrefix = "io.thunderid."
+
+	// signatureVersion prefixes every signature in the Webhook-Signature header.
+	signatureVersion = "v1"
+	// secretPrefix prefixes generated signing secrets so they are recognisable.
+	secretPrefix = "whsec_"
+	// secretLength is the number of random bytes in a generated signing secret.
+	secretLength = 32
+	// propertySigningSecret is the name of the encrypted property holding the signing secret.
+	propertySigningSecret = "signingSecret"
+
+	// maxLastErrorLength bounds the error message recorded for a failed attempt.
+	maxLastErrorLength = 1024
+)
+
+// Defaults applied when the webhook output settings are not configured.
+const (
+	defaultDispatchInterval = 5 * time.Second
+	defaultBatchSize        = 50
+	defaultMaxAttempts      = 8
+	defaultInitialBackoff   = 10 * time.Second
+	defaultMaxBackoff       = time.Hour
+	defaultRequestTimeout   = 10 * time.Second
+	defaultRetentionDays    = 7
+	cleanupInterval         = time.Hour
+)
@@ -0,0 +1,313 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// deliveryStoreInterface defines the persistence of the webhook delivery outbox.
+type deliveryStoreInterface interface {
+	// insertDelivery queues a delivery for its first attempt.
+	insertDelivery(ctx context.Context, delivery Delivery) error
+	// getDueDeliveries returns up to limit pending deliveries whose next attempt is due at now.
+	getDueDeliveries(ctx context.Context, now time.Time, limit int) ([]Delivery, error)
+	// claimDelivery counts an attempt against a pending delivery that has made the given number of attempts
+	// and leases it until leaseUntil. It reports false when the delivery was claimed by someone else.
+	claimDelivery(ctx context.Context, id string, attempts int, leaseUntil time.Time) (bool, error)
+	// recordAttempt records the outcome of a delivery attempt.
+	recordAttempt(ctx context.Context, id string, attempt deliveryAttempt) error
+	// getDelivery returns a delivery of a webhook, or nil when it does not exist.
+	getDelivery(ctx context.Context, webhookID, id string) (*Delivery, error)
+	// getDeliveryListCount returns the number of deliveries of a webhook matching the filter.
+	getDeliveryListCount(ctx context.Context, webhookID string, filter *tidcommon.FilterGroup) (int, error)
+	// getDeliveryList returns a page of deliveries of a webhook matching the filter, newest first.
+	getDeliveryList(ctx context.Context, webhookID string, limit, offset int,
+		filter *tidcommon.FilterGroup) ([]Delivery, error)
+	// resetDelivery queues a delivery that is not pending for a fresh round of attempts starting at now. It
+	// reports false when the delivery is already pending.
+	resetDelivery(ctx context.Context, id string, now time.Time) (bool, error)
+	// deleteWebhookDeliveries removes every delivery of a webhook.
+	deleteWebhookDeliveries(ctx context.Context, webhookID string) error
+	// deleteExpiredDeliveries removes the deliveries that expired before now and returns how many were removed.
+	deleteExpiredDeliveries(ctx context.Context, now time.Time) (int64, error)
+}
+
+// deliveryStore implements deliveryStoreInterface against the runtime persistent database.
+type deliveryStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newDeliveryStore creates a new deliveryStore.
+func newDeliveryStore() deliveryStoreInterface {
+	return &deliveryStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// insertDelivery queues a delivery for its first attempt.
+func (s *deliveryStore) insertDelivery(ctx context.Context, delivery Delivery) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryInsertDelivery, delivery.ID, s.deploymentID, delivery.WebhookID,
+		delivery.EventID, delivery.EventType, delivery.Payload, delivery.ContentType, string(delivery.Status),
+		delivery.CreatedAt, delivery.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error inserting webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// getDueDeliveries returns up to limit pending deliveries whose next attempt is due at now.
+func (s *deliveryStore) getDueDeliveries(ctx context.Context, now time.Time, limit int) ([]Delivery, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetDueDeliveries, s.deploymentID, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute due delivery query: %w", err)
+	}
+
+	return buildDeliveriesFromResultRows(results)
+}
+
+// claimDelivery counts an attempt against a pending delivery and leases it until leaseUntil.
+func (s *deliveryStore) claimDelivery(ctx context.Context, id string, attempts int,
+	leaseUntil time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryClaimDelivery, leaseUntil, id, s.deploymentID, attempts)
+	if err != nil {
+		return false, fmt.Errorf("error claiming webhook delivery: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// recordAttempt records the outcome of a delivery attempt.
+func (s *deliveryStore) recordAttempt(ctx context.Context, id string, attempt deliveryAttempt) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	var expiryTime interface{}
+	if attempt.expiryTime != nil {
+		expiryTime = *attempt.expiryTime
+	}
+	var responseStatus interface{}
+	if attempt.responseStatus != 0 {
+		responseStatus = attempt.responseStatus
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryRecordDeliveryAttempt, string(attempt.status), attempt.nextAttemptAt,
+		attempt.attemptedAt, responseStatus, attempt.errorMessage, expiryTime, id, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("error recording webhook delivery attempt: %w", err)
+	}
+
+	return nil
+}
+
+// getDelivery returns a delivery of a webhook, or nil when it does not exist.
+func (s *deliveryStore) getDelivery(ctx context.Context, webhookID, id string) (*Delivery, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetDelivery, id, webhookID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute delivery query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	delivery, err := buildDeliveryFromResultRow(results[0])
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// getDeliveryListCount returns the number of deliveries of a webhook matching the filter.
+func (s *deliveryStore) getDeliveryListCount(ctx context.Context, webhookID string,
+	filter *tidcommon.FilterGroup) (int, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	query, filterArgs, err := buildDeliveryCountQuery(filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build delivery count query: %w", err)
+	}
+
+	args := append([]interface{}{webhookID, s.deploymentID}, filterArgs...)
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute count query: %w", err)
+	}
+
+	return parseCount(results)
+}
+
+// getDeliveryList returns a page of deliveries of a webhook matching the filter, newest first.
+func (s *deliveryStore) getDeliveryList(ctx context.Context, webhookID string, limit, offset int,
+	filter *tidcommon.FilterGroup) ([]Delivery, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	query, filterArgs, err := buildDeliveryListQuery(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build delivery list query: %w", err)
+	}
+
+	args := append([]interface{}{limit, offset, webhookID, s.deploymentID}, filterArgs...)
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute delivery list query: %w", err)
+	}
+
+	return buildDeliveriesFromResultRows(results)
+}
+
+// resetDelivery queues a delivery that is not pending for a fresh round of attempts starting at now.
+func (s *deliveryStore) resetDelivery(ctx context.Context, id string, now time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryResetDelivery, now, id, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("error resetting webhook delivery: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// deleteWebhookDeliveries removes every delivery of a webhook.
+func (s *deliveryStore) deleteWebhookDeliveries(ctx context.Context, webhookID string) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteWebhookDeliveries, webhookID, s.deploymentID); err != nil {
+		return fmt.Errorf("error deleting webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// deleteExpiredDeliveries removes the deliveries that expired before now.
+func (s *deliveryStore) deleteExpiredDeliveries(ctx context.Context, now time.Time) (int64, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteExpiredDeliveries, now, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting expired webhook deliveries: %w", err)
+	}
+
+	return rows, nil
+}
+
+// buildDeliveriesFromResultRows constructs deliveries from database result rows.
+func buildDeliveriesFromResultRows(results []map[string]interface{}) ([]Delivery, error) {
+	deliveries := make([]Delivery, 0, len(results))
+	for _, row := range results {
+		delivery, err := buildDeliveryFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+// buildDeliveryFromResultRow constructs a Delivery from a database result row. The payload columns are
+// only selected by the queries the dispatcher uses.
+func buildDeliveryFromResultRow(row map[string]interface{}) (Delivery, error) {
+	id, ok := row["delivery_id"].(string)
+	if !ok {
+		return Delivery{}, errors.New("failed to parse delivery_id as string")
+	}
+
+	createdAt, err := utils.ParseDBTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return Delivery{}, err
+	}
+	nextAttemptAt, err := parseOptionalTimeField(row, "next_attempt_at")
+	if err != nil {
+		return Delivery{}, err
+	}
+	lastAttemptAt, err := parseOptionalTimeField(row, "last_attempt_at")
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	// A delivery that reached a final status has no next attempt.
+	status := DeliveryStatus(stringOrBytesField(row, "status"))
+	if status != DeliveryStatusPending {
+		nextAttemptAt = nil
+	}
+
+	attempts, _ := utils.ToInt64(row["attempts"])
+	responseStatus, _ := utils.ToInt64(row["last_response_status"])
+
+	return Delivery{
+		ID:                 id,
+		WebhookID:          stringOrBytesField(row, "webhook_id"),
+		EventID:            stringOrBytesField(row, "event_id"),
+		EventType:          stringOrBytesField(row, "event_type"),
+		Status:             status,
+		Attempts:           int(attempts),
+		NextAttemptAt:      nextAttemptAt,
+		LastAttemptAt:      lastAttemptAt,
+		LastResponseStatus: int(responseStatus),
+		LastError:          stringOrBytesField(row, "last_error"),
+		CreatedAt:          createdAt,
+		Payload:            stringOrBytesField(row, "payload"),
+		ContentType:        stringOrBytesField(row, "content_type"),
+	}, nil
+}
+
+// parseOptionalTimeField parses a nullable time column, returning nil when it is NULL.
+func parseOptionalTimeField(row map[string]interface{}, column string) (*time.Time, error) {
+	if row[column] == nil {
+		return nil, nil
+	}
+	t, err := utils.ParseDBTimeField(row[column], column)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
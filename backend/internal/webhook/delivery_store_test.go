@@ -0,0 +1,293 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+type DeliveryStoreTestSuite struct {
+	suite.Suite
+	mockdbProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *deliveryStore
+}
+
+func TestDeliveryStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryStoreTestSuite))
+}
+
+func (suite *DeliveryStoreTestSuite) SetupTest() {
+	suite.mockdbProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &deliveryStore{
+		dbProvider:   suite.mockdbProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func statusFilter(value string) *tidcommon.FilterGroup {
+	return &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "status", Operator: tidcommon.OperatorEq, Value: value}},
+	}}
+}
+
+func (suite *DeliveryStoreTestSuite) TestInsertDelivery() {
+	createdAt := time.Now().UTC()
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryInsertDelivery, "delivery-1",
+		testDeploymentID, "webhook-1", "event-1", EventTypeUserLogin, "{}", contentTypeJSON, "pending",
+		createdAt, createdAt).Return(int64(1), nil)
+
+	err := suite.store.insertDelivery(context.Background(), Delivery{
+		ID:          "delivery-1",
+		WebhookID:   "webhook-1",
+		EventID:     "event-1",
+		EventType:   EventTypeUserLogin,
+		Status:      DeliveryStatusPending,
+		CreatedAt:   createdAt,
+		Payload:     "{}",
+		ContentType: contentTypeJSON,
+	})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *DeliveryStoreTestSuite) TestInsertDelivery_DBClientError() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(nil, errors.New("db unavailable"))
+
+	err := suite.store.insertDelivery(context.Background(), Delivery{ID: "delivery-1"})
+	assert.ErrorContains(suite.T(), err, "failed to get runtime persistent database client")
+}
+
+func (suite *DeliveryStoreTestSuite) TestGetDueDeliveries() {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetDueDeliveries, testDeploymentID, now, 50).
+		Return([]map[string]interface{}{{
+			"delivery_id":     "delivery-1",
+			"webhook_id":      "webhook-1",
+			"event_id":        "event-1",
+			"event_type":      EventTypeUserLogin,
+			"payload":         []byte("{}"),
+			"content_type":    contentTypeJSON,
+			"status":          "pending",
+			"attempts":        int64(2),
+			"next_attempt_at": now,
+			"last_attempt_at": now.Add(-time.Minute),
+			"created_at":      now.Add(-time.Hour),
+		}}, nil)
+
+	deliveries, err := suite.store.getDueDeliveries(context.Background(), now, 50)
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(deliveries, 1)
+	lastAttemptAt := now.Add(-time.Minute)
+	assert.Equal(suite.T(), Delivery{
+		ID:            "delivery-1",
+		WebhookID:     "webhook-1",
+		EventID:       "event-1",
+		EventType:     EventTypeUserLogin,
+		Status:        DeliveryStatusPending,
+		Attempts:      2,
+		NextAttemptAt: &now,
+		LastAttemptAt: &lastAttemptAt,
+		CreatedAt:     now.Add(-time.Hour),
+		Payload:       "{}",
+		ContentType:   contentTypeJSON,
+	}, deliveries[0])
+}
+
+func (suite *DeliveryStoreTestSuite) TestGetDueDeliveries_InvalidRow() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetDueDeliveries, testDeploymentID,
+		mock.Anything, 50).Return([]map[string]interface{}{{"delivery_id": "delivery-1", "created_at": 42}}, nil)
+
+	_, err := suite.store.getDueDeliveries(context.Background(), time.Now(), 50)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *DeliveryStoreTestSuite) TestClaimDelivery() {
+	leaseUntil := time.Now().UTC()
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryClaimDelivery, leaseUntil, "delivery-1",
+		testDeploymentID, 2).Return(int64(1), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryClaimDelivery, leaseUntil, "delivery-1",
+		testDeploymentID, 2).Return(int64(0), nil).Once()
+
+	claimed, err := suite.store.claimDelivery(context.Background(), "delivery-1", 2, leaseUntil)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), claimed)
+
+	claimed, err = suite.store.claimDelivery(context.Background(), "delivery-1", 2, leaseUntil)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), claimed)
+}
+
+func (suite *DeliveryStoreTestSuite) TestRecordAttempt() {
+	attemptedAt := time.Now().UTC()
+	expiry := attemptedAt.Add(time.Hour)
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryRecordDeliveryAttempt, "delivered",
+		attemptedAt, attemptedAt, 204, "", expiry, "delivery-1", testDeploymentID).Return(int64(1), nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryRecordDeliveryAttempt, "pending",
+		expiry, attemptedAt, nil, "connection refused", nil, "delivery-2", testDeploymentID).Return(int64(1), nil)
+
+	assert.NoError(suite.T(), suite.store.recordAttempt(context.Background(), "delivery-1", deliveryAttempt{
+		status:         DeliveryStatusDelivered,
+		attemptedAt:    attemptedAt,
+		nextAttemptAt:  attemptedAt,
+		responseStatus: 204,
+		expiryTime:     &expiry,
+	}))
+	assert.NoError(suite.T(), suite.store.recordAttempt(context.Background(), "delivery-2", deliveryAttempt{
+		status:        DeliveryStatusPending,
+		attemptedAt:   attemptedAt,
+		nextAttemptAt: expiry,
+		errorMessage:  "connection refused",
+	}))
+}
+
+func (suite *DeliveryStoreTestSuite) TestGetDelivery() {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetDelivery, "delivery-1", "webhook-1",
+		testDeploymentID).Return([]map[string]interface{}{{
+		"delivery_id":          "delivery-1",
+		"webhook_id":           "webhook-1",
+		"status":               "dead_letter",
+		"attempts":             int64(8),
+		"next_attempt_at":      now,
+		"last_attempt_at":      now,
+		"last_response_status": int64(500),
+		"last_error":           "endpoint returned status 500",
+		"created_at":           now,
+	}}, nil)
+
+	delivery, err := suite.store.getDelivery(context.Background(), "webhook-1", "delivery-1")
+	assert.NoError(suite.T(), err)
+	suite.Require().NotNil(delivery)
+	assert.Equal(suite.T(), DeliveryStatusDeadLetter, delivery.Status)
+	assert.Nil(suite.T(), delivery.NextAttemptAt)
+	assert.Equal(suite.T(), 500, delivery.LastResponseStatus)
+	assert.Equal(suite.T(), "endpoint returned status 500", delivery.LastError)
+}
+
+func (suite *DeliveryStoreTestSuite) TestGetDelivery_NotFound() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetDelivery, "delivery-1", "webhook-1",
+		testDeploymentID).Return([]map[string]interface{}{}, nil)
+
+	delivery, err := suite.store.getDelivery(context.Background(), "webhook-1", "delivery-1")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), delivery)
+}
+
+func (suite *DeliveryStoreTestSuite) TestGetDeliveryListCount() {
+	filter := statusFilter("dead_letter")
+	query, _, err := buildDeliveryCountQuery(filter)
+	suite.Require().NoError(err)
+
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), query, "webhook-1", testDeploymentID,
+		"dead_letter").Return([]map[string]interface{}{{"total": int64(2)}}, nil)
+
+	count, err := suite.store.getDeliveryListCount(context.Background(), "webhook-1", filter)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, count)
+}
+
+func (suite *DeliveryStoreTestSuite) TestGetDeliveryList() {
+	query, _, err := buildDeliveryListQuery(nil)
+	suite.Require().NoError(err)
+
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), query, 10, 0, "webhook-1", testDeploymentID).
+		Return([]map[string]interface{}{{"delivery_id": "delivery-1", "created_at": time.Now()}}, nil)
+
+	deliveries, err := suite.store.getDeliveryList(context.Background(), "webhook-1", 10, 0, nil)
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(deliveries, 1)
+	assert.Equal(suite.T(), "delivery-1", deliveries[0].ID)
+}
+
+func (suite *DeliveryStoreTestSuite) TestGetDeliveryList_InvalidFilter() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	filter := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "payload", Operator: tidcommon.OperatorEq, Value: "x"}},
+	}}
+
+	_, err := suite.store.getDeliveryList(context.Background(), "webhook-1", 10, 0, filter)
+	assert.ErrorContains(suite.T(), err, "failed to build delivery list query")
+}
+
+func (suite *DeliveryStoreTestSuite) TestResetDelivery() {
+	now := time.Now().UTC()
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryResetDelivery, now, "delivery-1",
+		testDeploymentID).Return(int64(1), nil)
+
+	reset, err := suite.store.resetDelivery(context.Background(), "delivery-1", now)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), reset)
+}
+
+func (suite *DeliveryStoreTestSuite) TestDeleteWebhookDeliveries() {
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteWebhookDeliveries, "webhook-1",
+		testDeploymentID).Return(int64(3), nil)
+
+	assert.NoError(suite.T(), suite.store.deleteWebhookDeliveries(context.Background(), "webhook-1"))
+}
+
+func (suite *DeliveryStoreTestSuite) TestDeleteExpiredDeliveries() {
+	now := time.Now().UTC()
+	suite.mockdbProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteExpiredDeliveries, now,
+		testDeploymentID).Return(int64(4), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteExpiredDeliveries, now,
+		testDeploymentID).Return(int64(0), errors.New("delete error")).Once()
+
+	deleted, err := suite.store.deleteExpiredDeliveries(context.Background(), now)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(4), deleted)
+
+	_, err = suite.store.deleteExpiredDeliveries(context.Background(), now)
+	assert.ErrorContains(suite.T(), err, "error deleting expired webhook deliveries")
+}
+
+func (suite *DeliveryStoreTestSuite) TestBuildDeliveryListQuery_WithFilter() {
+	filter := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "status", Operator: tidcommon.OperatorEq, Value: "pending"}},
+		{
+			Connector: tidcommon.LogicalAnd,
+			Expr: tidcommon.FilterExpression{Attribute: "eventType", Operator: tidcommon.OperatorEq,
+				Value: EventTypeUserLogin},
+		},
+	}}
+
+	query, args, err := buildDeliveryListQuery(filter)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), query.Query, "AND (STATUS = $5 AND EVENT_TYPE = $6)")
+	assert.Contains(suite.T(), query.Query, "ORDER BY CREATED_AT DESC, DELIVERY_ID LIMIT $1 OFFSET $2")
+	assert.Equal(suite.T(), []interface{}{"pending", EventTypeUserLogin}, args)
+}
+
+func (suite *DeliveryStoreTestSuite) TestBuildDeliveryCountQuery_UnsupportedOperator() {
+	filter := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "status", Operator: tidcommon.OperatorGt, Value: "a"}},
+	}}
+
+	_, _, err := buildDeliveryCountQuery(filter)
+	assert.ErrorContains(suite.T(), err, "unsupported operator")
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for webhook operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidName is the error returned when the webhook name is missing.
+	ErrorInvalidName = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_name",
+			DefaultValue: "Invalid webhook name",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_name_description",
+			DefaultValue: "The webhook name must not be empty",
+		},
+	}
+	// ErrorInvalidURL is the error returned when the webhook URL is invalid.
+	ErrorInvalidURL = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_url",
+			DefaultValue: "Invalid webhook URL",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_url_description",
+			DefaultValue: "The webhook URL must be a public https URL",
+		},
+	}
+	// ErrorInvalidEventTypes is the error returned when the subscribed event types are invalid.
+	ErrorInvalidEventTypes = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_event_types",
+			DefaultValue: "Invalid event types",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.webhookservice.invalid_event_types_description",
+			DefaultValue: "At least one event type is required. Supported event types are user.registered, user.login, " +
+				"user.credentials_updated and user.deleted",
+		},
+	}
+	// ErrorInvalidFormat is the error returned when the payload format is not supported.
+	ErrorInvalidFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_format",
+			DefaultValue: "Invalid payload format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_format_description",
+			DefaultValue: "The payload format must be json or cloudevents",
+		},
+	}
+	// ErrorWebhookNotFound is the error returned when the webhook does not exist.
+	ErrorWebhookNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.webhook_not_found",
+			DefaultValue: "Webhook not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.webhook_not_found_description",
+			DefaultValue: "The webhook with the specified id does not exist",
+		},
+	}
+	// ErrorDeliveryNotFound is the error returned when the delivery does not exist.
+	ErrorDeliveryNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.delivery_not_found",
+			DefaultValue: "Delivery not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.delivery_not_found_description",
+			DefaultValue: "The delivery with the specified id does not exist for the webhook",
+		},
+	}
+	// ErrorDeliveryPending is the error returned when the delivery is still pending.
+	ErrorDeliveryPending = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1008",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.delivery_pending",
+			DefaultValue: "Delivery is pending",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.delivery_pending_description",
+			DefaultValue: "The delivery is still queued for delivery and cannot be redelivered",
+		},
+	}
+	// ErrorInvalidLimit is the error returned when the limit parameter is invalid.
+	ErrorInvalidLimit = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1009",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_limit_parameter",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when the offset parameter is invalid.
+	ErrorInvalidOffset = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1010",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_offset_parameter",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+	// ErrorInvalidFilter is the error returned when the filter parameter is invalid.
+	ErrorInvalidFilter = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1011",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_filter",
+			DefaultValue: "Invalid filter parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_filter_description",
+			DefaultValue: "The filter parameter is invalid. Use status eq \"value\" or eventType eq \"value\"",
+		},
+	}
+)
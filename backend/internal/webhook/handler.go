@@ -0,0 +1,217 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/filter"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// webhookHandler is the handler for the webhook management API.
+type webhookHandler struct {
+	service WebhookServiceInterface
+}
+
+// newWebhookHandler creates a new instance of webhookHandler.
+func newWebhookHandler(service WebhookServiceInterface) *webhookHandler {
+	return &webhookHandler{
+		service: service,
+	}
+}
+
+// HandleWebhookListRequest handles GET /webhooks.
+func (h *webhookHandler) HandleWebhookListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+
+	resp, svcErr := h.service.GetWebhookList(ctx, limit, offset)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleWebhookPostRequest handles POST /webhooks.
+func (h *webhookHandler) HandleWebhookPostRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := decodeWebhookRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	resp, svcErr := h.service.CreateWebhook(ctx, *req)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusCreated, resp)
+}
+
+// HandleWebhookGetRequest handles GET /webhooks/{id}.
+func (h *webhookHandler) HandleWebhookGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, svcErr := h.service.GetWebhook(ctx, r.PathValue("id"))
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleWebhookPutRequest handles PUT /webhooks/{id}.
+func (h *webhookHandler) HandleWebhookPutRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := decodeWebhookRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	resp, svcErr := h.service.UpdateWebhook(ctx, r.PathValue("id"), *req)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleWebhookDeleteRequest handles DELETE /webhooks/{id}.
+func (h *webhookHandler) HandleWebhookDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if svcErr := h.service.DeleteWebhook(ctx, r.PathValue("id")); svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+}
+
+// HandleWebhookSecretRequest handles POST /webhooks/{id}/secret.
+func (h *webhookHandler) HandleWebhookSecretRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, svcErr := h.service.RotateWebhookSecret(ctx, r.PathValue("id"))
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleDeliveryListRequest handles GET /webhooks/{id}/deliveries.
+func (h *webhookHandler) HandleDeliveryListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+
+	f, err := filter.ParseFilterParam(r.URL.Query())
+	if err != nil {
+		writeServiceError(ctx, w, &ErrorInvalidFilter)
+		return
+	}
+
+	resp, svcErr := h.service.GetDeliveryList(ctx, r.PathValue("id"), limit, offset, f)
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, resp)
+}
+
+// HandleRedeliverRequest handles POST /webhooks/{id}/deliveries/{deliveryId}/redeliver.
+func (h *webhookHandler) HandleRedeliverRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, svcErr := h.service.RedeliverDelivery(ctx, r.PathValue("id"), r.PathValue("deliveryId"))
+	if svcErr != nil {
+		writeServiceError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusAccepted, resp)
+}
+
+// decodeWebhookRequest decodes the webhook request body, writing the error response when it is invalid.
+func decodeWebhookRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) (*WebhookRequest, bool) {
+	req, err := sysutils.DecodeJSONBody[WebhookRequest](r)
+	if err != nil {
+		var valErr *sysutils.ValidationError
+		if errors.As(err, &valErr) {
+			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
+			return nil, false
+		}
+		writeServiceError(ctx, w, &ErrorInvalidRequestFormat)
+		return nil, false
+	}
+	return req, true
+}
+
+// writeServiceError converts a service error into the appropriate HTTP error response.
+func writeServiceError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		switch svcErr.Code {
+		case tidcommon.ErrorUnauthorized.Code:
+			statusCode = http.StatusForbidden
+		case ErrorWebhookNotFound.Code, ErrorDeliveryNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorDeliveryPending.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
+
+// parsePaginationParams parses the limit and offset query parameters, defaulting the limit to the
+// default page size.
+func parsePaginationParams(query url.Values) (int, int, *tidcommon.ServiceError) {
+	limit := serverconst.DefaultPageSize
+	offset := 0
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidLimit
+		}
+		limit = parsedLimit
+	}
+
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidOffset
+		}
+		offset = parsedOffset
+	}
+
+	return limit, offset, nil
+}
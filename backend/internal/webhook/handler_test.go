@@ -0,0 +1,202 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type WebhookHandlerTestSuite struct {
+	suite.Suite
+	mockService *WebhookServiceInterfaceMock
+	mux         *http.ServeMux
+}
+
+func TestWebhookHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookHandlerTestSuite))
+}
+
+func (suite *WebhookHandlerTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+
+	suite.mockService = NewWebhookServiceInterfaceMock(suite.T())
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, newWebhookHandler(suite.mockService))
+}
+
+func (suite *WebhookHandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *WebhookHandlerTestSuite) serve(method, target, body string) *httptest.ResponseRecorder {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	resp := httptest.NewRecorder()
+	suite.mux.ServeHTTP(resp, req)
+	return resp
+}
+
+func (suite *WebhookHandlerTestSuite) errorCode(resp *httptest.ResponseRecorder) string {
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &errResp))
+	return errResp.Code
+}
+
+const testRequestBody = `{"name":"CRM sync","url":"https://crm.example.com/hooks","eventTypes":["user.login"]}`
+
+func (suite *WebhookHandlerTestSuite) TestHandleWebhookListRequest() {
+	suite.mockService.On("GetWebhookList", mock.Anything, 5, 10).
+		Return(&WebhookListResponse{TotalResults: 1, StartIndex: 11, Count: 1,
+			Webhooks: []Webhook{testWebhook()}}, nil)
+
+	resp := suite.serve(http.MethodGet, "/webhooks?limit=5&offset=10", "")
+	suite.Require().Equal(http.StatusOK, resp.Code)
+
+	var body WebhookListResponse
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(suite.T(), "webhook-1", body.Webhooks[0].ID)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleWebhookPostRequest() {
+	suite.mockService.On("CreateWebhook", mock.Anything, WebhookRequest{
+		Name:       "CRM sync",
+		URL:        "https://crm.example.com/hooks",
+		EventTypes: []string{EventTypeUserLogin},
+	}).Return(&WebhookSecretResponse{Webhook: testWebhook(), Secret: "whsec_c2VjcmV0"}, nil)
+
+	resp := suite.serve(http.MethodPost, "/webhooks", testRequestBody)
+	suite.Require().Equal(http.StatusCreated, resp.Code)
+
+	var body WebhookSecretResponse
+	suite.Require().NoError(json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(suite.T(), "webhook-1", body.ID)
+	assert.Equal(suite.T(), "whsec_c2VjcmV0", body.Secret)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleWebhookPostRequest_InvalidBody() {
+	resp := suite.serve(http.MethodPost, "/webhooks", "{not json")
+	assert.Equal(suite.T(), http.StatusBadRequest, resp.Code)
+	assert.Equal(suite.T(), ErrorInvalidRequestFormat.Code, suite.errorCode(resp))
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleWebhookGetRequest() {
+	webhook := testWebhook()
+	suite.mockService.On("GetWebhook", mock.Anything, "webhook-1").Return(&webhook, nil)
+
+	resp := suite.serve(http.MethodGet, "/webhooks/webhook-1", "")
+	assert.Equal(suite.T(), http.StatusOK, resp.Code)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleWebhookPutRequest() {
+	webhook := testWebhook()
+	suite.mockService.On("UpdateWebhook", mock.Anything, "webhook-1", mock.Anything).Return(&webhook, nil)
+
+	resp := suite.serve(http.MethodPut, "/webhooks/webhook-1", testRequestBody)
+	assert.Equal(suite.T(), http.StatusOK, resp.Code)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleWebhookDeleteRequest() {
+	suite.mockService.On("DeleteWebhook", mock.Anything, "webhook-1").Return(nil)
+
+	resp := suite.serve(http.MethodDelete, "/webhooks/webhook-1", "")
+	assert.Equal(suite.T(), http.StatusNoContent, resp.Code)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleWebhookSecretRequest() {
+	suite.mockService.On("RotateWebhookSecret", mock.Anything, "webhook-1").
+		Return(&WebhookSecretResponse{Webhook: testWebhook(), Secret: "whsec_bmV3"}, nil)
+
+	resp := suite.serve(http.MethodPost, "/webhooks/webhook-1/secret", "")
+	suite.Require().Equal(http.StatusOK, resp.Code)
+	assert.Contains(suite.T(), resp.Body.String(), "whsec_bmV3")
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleDeliveryListRequest() {
+	suite.mockService.On("GetDeliveryList", mock.Anything, "webhook-1", serverconst.DefaultPageSize, 0,
+		mock.MatchedBy(func(f *tidcommon.FilterGroup) bool {
+			return f != nil && len(f.Clauses) == 1 && f.Clauses[0].Expr.Attribute == "status" &&
+				f.Clauses[0].Expr.Value == "dead_letter"
+		})).
+		Return(&DeliveryListResponse{TotalResults: 1, StartIndex: 1, Count: 1,
+			Deliveries: []Delivery{{ID: "delivery-1", Payload: "{}"}}}, nil)
+
+	resp := suite.serve(http.MethodGet, `/webhooks/webhook-1/deliveries?filter=status+eq+%22dead_letter%22`, "")
+	suite.Require().Equal(http.StatusOK, resp.Code)
+	assert.Contains(suite.T(), resp.Body.String(), "delivery-1")
+	assert.NotContains(suite.T(), resp.Body.String(), "payload")
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleDeliveryListRequest_InvalidParams() {
+	testCases := []struct {
+		name   string
+		target string
+		code   string
+	}{
+		{"invalid limit", "/webhooks/webhook-1/deliveries?limit=abc", ErrorInvalidLimit.Code},
+		{"invalid offset", "/webhooks/webhook-1/deliveries?offset=abc", ErrorInvalidOffset.Code},
+		{"invalid filter", "/webhooks/webhook-1/deliveries?filter=status+eq", ErrorInvalidFilter.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			resp := suite.serve(http.MethodGet, tc.target, "")
+			assert.Equal(suite.T(), http.StatusBadRequest, resp.Code)
+			assert.Equal(suite.T(), tc.code, suite.errorCode(resp))
+		})
+	}
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleRedeliverRequest() {
+	suite.mockService.On("RedeliverDelivery", mock.Anything, "webhook-1", "delivery-1").
+		Return(&Delivery{ID: "delivery-1", Status: DeliveryStatusPending}, nil)
+
+	resp := suite.serve(http.MethodPost, "/webhooks/webhook-1/deliveries/delivery-1/redeliver", "")
+	assert.Equal(suite.T(), http.StatusAccepted, resp.Code)
+}
+
+func (suite *WebhookHandlerTestSuite) TestServiceErrors() {
+	testCases := []struct {
+		name   string
+		svcErr *tidcommon.ServiceError
+		status int
+	}{
+		{"unauthorized", &tidcommon.ErrorUnauthorized, http.StatusForbidden},
+		{"webhook not found", &ErrorWebhookNotFound, http.StatusNotFound},
+		{"delivery not found", &ErrorDeliveryNotFound, http.StatusNotFound},
+		{"delivery pending", &ErrorDeliveryPending, http.StatusConflict},
+		{"client error", &ErrorInvalidURL, http.StatusBadRequest},
+		{"server error", &tidcommon.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockService.On("RedeliverDelivery", mock.Anything, "webhook-1", "delivery-1").
+				Return(nil, tc.svcErr)
+
+			resp := suite.serve(http.MethodPost, "/webhooks/webhook-1/deliveries/delivery-1/redeliver", "")
+			assert.Equal(suite.T(), tc.status, resp.Code)
+			assert.Equal(suite.T(), tc.svcErr.Code, suite.errorCode(resp))
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize initializes the webhook management service and registers its routes. Deliveries are queued
+// and sent by the webhook observability subscriber, enabled with observability.output.webhook.enabled.
+func Initialize(
+	mux *http.ServeMux,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	observabilitySvc providers.ObservabilityProvider,
+) WebhookServiceInterface {
+	webhookService := newWebhookService(newWebhookStore(), newDeliveryStore(), authzService,
+		audit.NewRecorder(observabilitySvc))
+
+	webhookHandler := newWebhookHandler(webhookService)
+	registerRoutes(mux, webhookHandler)
+
+	return webhookService
+}
+
+// registerRoutes registers the routes for the webhook management API.
+func registerRoutes(mux *http.ServeMux, h *webhookHandler) {
+	listOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /webhooks", h.HandleWebhookListRequest, listOpts))
+	mux.HandleFunc(middleware.WithCORS("POST /webhooks", h.HandleWebhookPostRequest, listOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /webhooks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, listOpts))
+
+	itemOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /webhooks/{id}", h.HandleWebhookGetRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT /webhooks/{id}", h.HandleWebhookPutRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE /webhooks/{id}", h.HandleWebhookDeleteRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("POST /webhooks/{id}/secret", h.HandleWebhookSecretRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("GET /webhooks/{id}/deliveries", h.HandleDeliveryListRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("POST /webhooks/{id}/deliveries/{deliveryId}/redeliver",
+		h.HandleRedeliverRequest, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /webhooks/",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, itemOpts))
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+const testCryptoKey = "0579f866ac7c9273580d0ff163fa01a7b2401a7ff3ddc3e3b14ae3136fa6025e"
+
+// TestMain wires cmodels' package-level config crypto provider once for the whole test binary, so the
+// signing secret properties can be encrypted regardless of which test last reset the server runtime.
+func TestMain(m *testing.M) {
+	config.ResetServerRuntime()
+	if err := config.InitializeServerRuntime("/tmp/test", &config.Config{
+		Crypto: config.CryptoConfig{Encryption: engineconfig.EncryptionConfig{Key: testCryptoKey}},
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to initialize server runtime: %v\n", err)
+		os.Exit(1)
+	}
+	_, cfgCryptoSvc, err := defaultkm.Initialize(nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to initialize default crypto provider: %v\n", err)
+		os.Exit(1)
+	}
+	cmodels.SetConfigCryptoProvider(cfgCryptoSvc)
+	config.ResetServerRuntime()
+	os.Exit(m.Run())
+}
+
+type WebhookInitTestSuite struct {
+	suite.Suite
+}
+
+func TestWebhookInitTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookInitTestSuite))
+}
+
+func (suite *WebhookInitTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+}
+
+func (suite *WebhookInitTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *WebhookInitTestSuite) TestInitialize() {
+	mux := http.NewServeMux()
+	service := Initialize(mux, sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T()), nil)
+
+	assert.NotNil(suite.T(), service)
+	assert.Implements(suite.T(), (*WebhookServiceInterface)(nil), service)
+
+	for _, target := range []string{"/webhooks", "/webhooks/webhook-1/deliveries"} {
+		resp := httptest.NewRecorder()
+		mux.ServeHTTP(resp, httptest.NewRequest(http.MethodOptions, target, nil))
+		assert.Equal(suite.T(), http.StatusNoContent, resp.Code, target)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// Webhook is an endpoint that receives the events it subscribes to.
+type Webhook struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	URL         string   `json:"url"`
+	EventTypes  []string `json:"eventTypes"`
+	Format      string   `json:"format"`
+	Enabled     bool     `json:"enabled"`
+}
+
+// WebhookRequest is the request body for creating or updating a webhook. Format defaults to json and
+// Enabled defaults to true.
+type WebhookRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	URL         string   `json:"url"`
+	EventTypes  []string `json:"eventTypes"`
+	Format      string   `json:"format,omitempty"`
+	Enabled     *bool    `json:"enabled,omitempty"`
+}
+
+// WebhookSecretResponse is a webhook together with its newly generated signing secret. The secret is
+// only returned when it is generated, on creation and on rotation.
+type WebhookSecretResponse struct {
+	Webhook
+	Secret string `json:"secret"`
+}
+
+// WebhookListResponse is a paginated list of webhooks.
+type WebhookListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	StartIndex   int          `json:"startIndex"`
+	Count        int          `json:"count"`
+	Webhooks     []Webhook    `json:"webhooks"`
+	Links        []utils.Link `json:"links"`
+}
+
+// Delivery is a single event queued for delivery to a webhook.
+type Delivery struct {
+	ID                 string         `json:"id"`
+	WebhookID          string         `json:"webhookId"`
+	EventID            string         `json:"eventId"`
+	EventType          string         `json:"eventType"`
+	Status             DeliveryStatus `json:"status"`
+	Attempts           int            `json:"attempts"`
+	NextAttemptAt      *time.Time     `json:"nextAttemptAt,omitempty"`
+	LastAttemptAt      *time.Time     `json:"lastAttemptAt,omitempty"`
+	LastResponseStatus int            `json:"lastResponseStatus,omitempty"`
+	LastError          string         `json:"lastError,omitempty"`
+	CreatedAt          time.Time      `json:"createdAt"`
+	Payload            string         `json:"-"`
+	ContentType        string         `json:"-"`
+}
+
+// DeliveryListResponse is a paginated list of the deliveries of a webhook.
+type DeliveryListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	StartIndex   int          `json:"startIndex"`
+	Count        int          `json:"count"`
+	Deliveries   []Delivery   `json:"deliveries"`
+	Links        []utils.Link `json:"links"`
+}
+
+// deliveryAttempt is the outcome of an attempt to deliver an event, recorded against the delivery.
+type deliveryAttempt struct {
+	status         DeliveryStatus
+	attemptedAt    time.Time
+	nextAttemptAt  time.Time
+	responseStatus int
+	errorMessage   string
+	// expiryTime is set when the delivery reaches a final status and starts its retention period.
+	expiryTime *time.Time
+}
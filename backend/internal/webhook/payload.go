@@ -0,0 +1,175 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// actionUserCredentialsUpdate is the audit action the user service records when an administrator
+// changes a user's credentials.
+const actionUserCredentialsUpdate = "user.credentials.update"
+
+// webhookEvent is an observability event translated into the event delivered to webhooks.
+type webhookEvent struct {
+	id        string
+	eventType string
+	time      time.Time
+	subject   string
+	data      map[string]interface{}
+}
+
+// jsonEnvelope is the payload delivered to webhooks configured with the json format.
+type jsonEnvelope struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	Timestamp time.Time              `json:"timestamp"`
+	Data      map[string]interface{} `json:"data"`
+}
+
+// cloudEvent is the payload delivered to webhooks configured with the cloudevents format, in the
+// CloudEvents 1.0 structured JSON format.
+type cloudEvent struct {
+	SpecVersion     string                 `json:"specversion"`
+	ID              string                 `json:"id"`
+	Source          string                 `json:"source"`
+	Type            string                 `json:"type"`
+	Subject         string                 `json:"subject,omitempty"`
+	Time            time.Time              `json:"time"`
+	DataContentType string                 `json:"datacontenttype"`
+	Data            map[string]interface{} `json:"data"`
+}
+
+// translateEvent maps an observability event to the webhook event it represents. It reports false for
+// events that are not delivered to webhooks.
+func translateEvent(evt *providers.Event) (webhookEvent, bool) {
+	var eventType, userID string
+	data := map[string]interface{}{}
+
+	switch providers.EventType(evt.Type) {
+	case event.EventTypeFlowCompleted:
+		userID = dataString(evt.Data, event.DataKey.UserID)
+		if userID == "" {
+			return webhookEvent{}, false
+		}
+		switch providers.FlowType(dataString(evt.Data, event.DataKey.FlowType)) {
+		case providers.FlowTypeRegistration:
+			eventType = EventTypeUserRegistered
+		case providers.FlowTypeAuthentication:
+			eventType = EventTypeUserLogin
+		case providers.FlowTypeRecovery:
+			eventType = EventTypeUserCredentialsUpdated
+		default:
+			return webhookEvent{}, false
+		}
+		if appID := dataString(evt.Data, event.DataKey.EntityID); appID != "" {
+			data["applicationId"] = appID
+		}
+	case event.EventTypeAdminResourceUpdated:
+		if dataString(evt.Data, event.DataKey.Action) != actionUserCredentialsUpdate {
+			return webhookEvent{}, false
+		}
+		eventType = EventTypeUserCredentialsUpdated
+		userID = dataString(evt.Data, event.DataKey.TargetID)
+		data["actor"] = dataString(evt.Data, event.DataKey.Actor)
+	case event.EventTypeAdminResourceDeleted:
+		if dataString(evt.Data, event.DataKey.TargetType) != audit.TargetUser {
+			return webhookEvent{}, false
+		}
+		eventType = EventTypeUserDeleted
+		userID = dataString(evt.Data, event.DataKey.TargetID)
+		data["actor"] = dataString(evt.Data, event.DataKey.Actor)
+	default:
+		return webhookEvent{}, false
+	}
+
+	data["userId"] = userID
+	if evt.TraceID != "" {
+		data["correlationId"] = evt.TraceID
+	}
+
+	return webhookEvent{
+		id:        evt.EventID,
+		eventType: eventType,
+		time:      evt.Timestamp.UTC(),
+		subject:   userID,
+		data:      data,
+	}, true
+}
+
+// buildPayload renders a webhook event in the given format and returns the payload with its content type.
+// source identifies this server in CloudEvents payloads.
+func buildPayload(evt webhookEvent, format, source string) (string, string, error) {
+	var body interface{}
+	contentType := contentTypeJSON
+
+	if format == FormatCloudEvents {
+		contentType = contentTypeCloudEvents
+		body = cloudEvent{
+			SpecVersion:     cloudEventsSpecVersion,
+			ID:              evt.id,
+			Source:          source,
+			Type:            cloudEventsTypePrefix + evt.eventType,
+			Subject:         evt.subject,
+			Time:            evt.time,
+			DataContentType: contentTypeJSON,
+			Data:            evt.data,
+		}
+	} else {
+		body = jsonEnvelope{
+			ID:        evt.id,
+			Type:      evt.eventType,
+			Timestamp: evt.time,
+			Data:      evt.data,
+		}
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+	return string(payload), contentType, nil
+}
+
+// signPayload computes the Webhook-Signature header value for a delivery, following the Standard
+// Webhooks scheme: an HMAC-SHA256 over "{id}.{timestamp}.{payload}" keyed with the decoded secret.
+func signPayload(secret, id string, timestamp time.Time, payload string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, secretPrefix))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode signing secret: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + strconv.FormatInt(timestamp.Unix(), 10) + "." + payload))
+	return signatureVersion + "," + base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// generateSecret generates a new signing secret.
+func generateSecret() (string, error) {
+	key := make([]byte, secretLength)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to generate signing secret: %w", err)
+	}
+	return secretPrefix + base64.StdEncoding.EncodeToString(key), nil
+}
+
+// dataString returns the string value stored under key in the event data.
+func dataString(data map[string]interface{}, key string) string {
+	if v, ok := data[key].(string); ok {
+		return v
+	}
+	return ""
+}
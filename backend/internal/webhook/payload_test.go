@@ -0,0 +1,173 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type PayloadTestSuite struct {
+	suite.Suite
+}
+
+func TestPayloadTestSuite(t *testing.T) {
+	suite.Run(t, new(PayloadTestSuite))
+}
+
+func flowCompletedEvent(flowType providers.FlowType, userID string) *providers.Event {
+	return &providers.Event{
+		TraceID:   "trace-1",
+		EventID:   "event-1",
+		Type:      string(event.EventTypeFlowCompleted),
+		Timestamp: time.Date(2026, 10, 1, 12, 0, 0, 0, time.FixedZone("IST", 19800)),
+		Data: map[string]interface{}{
+			event.DataKey.UserID:   userID,
+			event.DataKey.FlowType: string(flowType),
+			event.DataKey.EntityID: "app-1",
+		},
+	}
+}
+
+func (suite *PayloadTestSuite) TestTranslateEvent_FlowCompleted() {
+	testCases := []struct {
+		flowType providers.FlowType
+		expected string
+	}{
+		{providers.FlowTypeRegistration, EventTypeUserRegistered},
+		{providers.FlowTypeAuthentication, EventTypeUserLogin},
+		{providers.FlowTypeRecovery, EventTypeUserCredentialsUpdated},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(string(tc.flowType), func() {
+			evt, ok := translateEvent(flowCompletedEvent(tc.flowType, "user-1"))
+			suite.Require().True(ok)
+			assert.Equal(suite.T(), webhookEvent{
+				id:        "event-1",
+				eventType: tc.expected,
+				time:      time.Date(2026, 10, 1, 6, 30, 0, 0, time.UTC),
+				subject:   "user-1",
+				data: map[string]interface{}{
+					"userId":        "user-1",
+					"applicationId": "app-1",
+					"correlationId": "trace-1",
+				},
+			}, evt)
+		})
+	}
+}
+
+func (suite *PayloadTestSuite) TestTranslateEvent_AdminEvents() {
+	evt, ok := translateEvent(&providers.Event{
+		EventID: "event-1",
+		Type:    string(event.EventTypeAdminResourceUpdated),
+		Data: map[string]interface{}{
+			event.DataKey.Action:   actionUserCredentialsUpdate,
+			event.DataKey.TargetID: "user-1",
+			event.DataKey.Actor:    "admin-1",
+		},
+	})
+	suite.Require().True(ok)
+	assert.Equal(suite.T(), EventTypeUserCredentialsUpdated, evt.eventType)
+	assert.Equal(suite.T(), map[string]interface{}{"userId": "user-1", "actor": "admin-1"}, evt.data)
+
+	evt, ok = translateEvent(&providers.Event{
+		EventID: "event-2",
+		Type:    string(event.EventTypeAdminResourceDeleted),
+		Data: map[string]interface{}{
+			event.DataKey.TargetType: audit.TargetUser,
+			event.DataKey.TargetID:   "user-1",
+			event.DataKey.Actor:      "admin-1",
+		},
+	})
+	suite.Require().True(ok)
+	assert.Equal(suite.T(), EventTypeUserDeleted, evt.eventType)
+	assert.Equal(suite.T(), "user-1", evt.subject)
+}
+
+func (suite *PayloadTestSuite) TestTranslateEvent_Ignored() {
+	testCases := []struct {
+		name string
+		evt  *providers.Event
+	}{
+		{"unrelated type", &providers.Event{Type: string(event.EventTypeTokenIssued)}},
+		{"flow without user", flowCompletedEvent(providers.FlowTypeAuthentication, "")},
+		{"unmapped flow type", flowCompletedEvent(providers.FlowTypeUserOnboarding, "user-1")},
+		{"other update", &providers.Event{Type: string(event.EventTypeAdminResourceUpdated),
+			Data: map[string]interface{}{event.DataKey.Action: "user.update"}}},
+		{"other deletion", &providers.Event{Type: string(event.EventTypeAdminResourceDeleted),
+			Data: map[string]interface{}{event.DataKey.TargetType: audit.TargetGroup}}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, ok := translateEvent(tc.evt)
+			assert.False(suite.T(), ok)
+		})
+	}
+}
+
+func (suite *PayloadTestSuite) TestBuildPayload() {
+	evt := webhookEvent{
+		id:        "event-1",
+		eventType: EventTypeUserLogin,
+		time:      time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		subject:   "user-1",
+		data:      map[string]interface{}{"userId": "user-1"},
+	}
+
+	payload, contentType, err := buildPayload(evt, FormatJSON, "https://id.example.com")
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), contentTypeJSON, contentType)
+	assert.JSONEq(suite.T(), `{"id":"event-1","type":"user.login","timestamp":"2026-10-01T12:00:00Z",
+		"data":{"userId":"user-1"}}`, payload)
+
+	payload, contentType, err = buildPayload(evt, FormatCloudEvents, "https://id.example.com")
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), contentTypeCloudEvents, contentType)
+	assert.JSONEq(suite.T(), `{"specversion":"1.0","id":"event-1","source":"https://id.example.com",
+		"type":"io.thunderid.user.login","subject":"user-1","time":"2026-10-01T12:00:00Z",
+		"datacontenttype":"application/json","data":{"userId":"user-1"}}`, payload)
+}
+
+func (suite *PayloadTestSuite) TestSignPayload() {
+	secret, err := generateSecret()
+	suite.Require().NoError(err)
+	suite.Require().True(strings.HasPrefix(secret, secretPrefix))
+
+	timestamp := time.Unix(1790000000, 0)
+	signature, err := signPayload(secret, "delivery-1", timestamp, `{"id":"event-1"}`)
+	suite.Require().NoError(err)
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, secretPrefix))
+	suite.Require().NoError(err)
+	assert.Len(suite.T(), key, secretLength)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(`delivery-1.1790000000.{"id":"event-1"}`))
+	assert.Equal(suite.T(), "v1,"+base64.StdEncoding.EncodeToString(mac.Sum(nil)), signature)
+}
+
+func (suite *PayloadTestSuite) TestSignPayload_InvalidSecret() {
+	_, err := signPayload("whsec_not base64", "delivery-1", time.Now(), "{}")
+	assert.ErrorContains(suite.T(), err, "failed to decode signing secret")
+}
+
+func (suite *PayloadTestSuite) TestDataString() {
+	data := map[string]interface{}{"a": "x", "b": 1}
+	assert.Equal(suite.T(), "x", dataString(data, "a"))
+	assert.Empty(suite.T(), dataString(data, "b"))
+	assert.Empty(suite.T(), dataString(nil, "a"))
+}
@@ -0,0 +1,423 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhook delivers user lifecycle events to registered HTTP endpoints and provides the webhook
+// management API.
+package webhook
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const loggerComponentNameService = "WebhookService"
+
+// WebhookServiceInterface defines the operations for managing webhooks and their deliveries.
+type WebhookServiceInterface interface {
+	CreateWebhook(ctx context.Context, request WebhookRequest) (*WebhookSecretResponse, *tidcommon.ServiceError)
+	GetWebhookList(ctx context.Context, limit, offset int) (*WebhookListResponse, *tidcommon.ServiceError)
+	GetWebhook(ctx context.Context, id string) (*Webhook, *tidcommon.ServiceError)
+	UpdateWebhook(ctx context.Context, id string, request WebhookRequest) (*Webhook, *tidcommon.ServiceError)
+	DeleteWebhook(ctx context.Context, id string) *tidcommon.ServiceError
+	RotateWebhookSecret(ctx context.Context, id string) (*WebhookSecretResponse, *tidcommon.ServiceError)
+	GetDeliveryList(ctx context.Context, webhookID string, limit, offset int, f *tidcommon.FilterGroup) (
+		*DeliveryListResponse, *tidcommon.ServiceError)
+	RedeliverDelivery(ctx context.Context, webhookID, deliveryID string) (*Delivery, *tidcommon.ServiceError)
+}
+
+// webhookService is the default implementation of WebhookServiceInterface.
+type webhookService struct {
+	webhookStore  webhookStoreInterface
+	deliveryStore deliveryStoreInterface
+	authzService  sysauthz.SystemAuthorizationServiceInterface
+	auditRecorder *audit.Recorder
+	logger        *log.Logger
+}
+
+// newWebhookService creates a new instance of webhookService.
+func newWebhookService(webhookStore webhookStoreInterface, deliveryStore deliveryStoreInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface, auditRecorder *audit.Recorder) WebhookServiceInterface {
+	return &webhookService{
+		webhookStore:  webhookStore,
+		deliveryStore: deliveryStore,
+		authzService:  authzService,
+		auditRecorder: auditRecorder,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentNameService)),
+	}
+}
+
+// CreateWebhook registers a new webhook and returns it with its generated signing secret.
+func (s *webhookService) CreateWebhook(ctx context.Context, request WebhookRequest) (
+	*WebhookSecretResponse, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionCreateWebhook); svcErr != nil {
+		return nil, svcErr
+	}
+
+	webhook, svcErr := buildWebhook(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate webhook ID", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	webhook.ID = id
+
+	secret, secretProperty, svcErr := s.newSigningSecret(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if err := s.webhookStore.createWebhook(ctx, webhook, secretProperty); err != nil {
+		s.logger.Error(ctx, "Failed to create webhook", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	s.auditRecorder.Created(ctx, audit.TargetWebhook, webhook.ID, webhook)
+
+	return &WebhookSecretResponse{Webhook: webhook, Secret: secret}, nil
+}
+
+// GetWebhookList returns a page of webhooks ordered by name.
+func (s *webhookService) GetWebhookList(ctx context.Context, limit, offset int) (
+	*WebhookListResponse, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionListWebhooks); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := validatePaginationParams(limit, offset); svcErr != nil {
+		return nil, svcErr
+	}
+
+	totalCount, err := s.webhookStore.getWebhookListCount(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get webhook count", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	webhooks, err := s.webhookStore.getWebhookList(ctx, limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list webhooks", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return &WebhookListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(webhooks),
+		Webhooks:     webhooks,
+		Links:        utils.BuildPaginationLinks("/webhooks", limit, offset, totalCount, ""),
+	}, nil
+}
+
+// GetWebhook returns the webhook with the given ID.
+func (s *webhookService) GetWebhook(ctx context.Context, id string) (*Webhook, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionReadWebhook); svcErr != nil {
+		return nil, svcErr
+	}
+	return s.loadWebhook(ctx, id)
+}
+
+// UpdateWebhook replaces the settings of a webhook. The signing secret is left unchanged.
+func (s *webhookService) UpdateWebhook(ctx context.Context, id string, request WebhookRequest) (
+	*Webhook, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionUpdateWebhook); svcErr != nil {
+		return nil, svcErr
+	}
+
+	existing, svcErr := s.loadWebhook(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	webhook, svcErr := buildWebhook(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	webhook.ID = id
+
+	if err := s.webhookStore.updateWebhook(ctx, webhook); err != nil {
+		s.logger.Error(ctx, "Failed to update webhook", log.String("id", id), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	s.auditRecorder.Updated(ctx, audit.TargetWebhook, id, existing, webhook)
+
+	return &webhook, nil
+}
+
+// DeleteWebhook deletes a webhook together with its queued and past deliveries.
+func (s *webhookService) DeleteWebhook(ctx context.Context, id string) *tidcommon.ServiceError {
+	if svcErr := s.checkAction(ctx, security.ActionDeleteWebhook); svcErr != nil {
+		return svcErr
+	}
+
+	existing, svcErr := s.loadWebhook(ctx, id)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.webhookStore.deleteWebhook(ctx, id); err != nil {
+		s.logger.Error(ctx, "Failed to delete webhook", log.String("id", id), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	// Deliveries left behind are dead-lettered by the dispatcher and purged after their retention period.
+	if err := s.deliveryStore.deleteWebhookDeliveries(ctx, id); err != nil {
+		s.logger.Warn(ctx, "Failed to delete webhook deliveries", log.String("id", id), log.Error(err))
+	}
+	s.auditRecorder.Deleted(ctx, audit.TargetWebhook, id, existing)
+
+	return nil
+}
+
+// RotateWebhookSecret replaces the signing secret of a webhook and returns the new secret. Deliveries
+// attempted from then on are signed with the new secret.
+func (s *webhookService) RotateWebhookSecret(ctx context.Context, id string) (
+	*WebhookSecretResponse, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionUpdateWebhook); svcErr != nil {
+		return nil, svcErr
+	}
+
+	webhook, svcErr := s.loadWebhook(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	secret, secretProperty, svcErr := s.newSigningSecret(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if err := s.webhookStore.updateSigningSecret(ctx, id, secretProperty); err != nil {
+		s.logger.Error(ctx, "Failed to rotate webhook secret", log.String("id", id), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	s.auditRecorder.Record(ctx, audit.Entry{
+		Operation:  audit.OperationUpdate,
+		TargetType: audit.TargetWebhook,
+		TargetID:   id,
+		Action:     "webhook.secret.rotate",
+	})
+
+	return &WebhookSecretResponse{Webhook: *webhook, Secret: secret}, nil
+}
+
+// GetDeliveryList returns a page of the deliveries of a webhook matching the filter, newest first.
+func (s *webhookService) GetDeliveryList(ctx context.Context, webhookID string, limit, offset int,
+	f *tidcommon.FilterGroup) (*DeliveryListResponse, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionReadWebhook); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := validatePaginationParams(limit, offset); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := validateDeliveryFilter(f); svcErr != nil {
+		return nil, svcErr
+	}
+	if _, svcErr := s.loadWebhook(ctx, webhookID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	totalCount, err := s.deliveryStore.getDeliveryListCount(ctx, webhookID, f)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get webhook delivery count", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	deliveries, err := s.deliveryStore.getDeliveryList(ctx, webhookID, limit, offset, f)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list webhook deliveries", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return &DeliveryListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(deliveries),
+		Deliveries:   deliveries,
+		Links: utils.BuildPaginationLinks("/webhooks/"+webhookID+"/deliveries", limit, offset,
+			totalCount, ""),
+	}, nil
+}
+
+// RedeliverDelivery queues a delivered or dead-lettered delivery for a fresh round of attempts.
+func (s *webhookService) RedeliverDelivery(ctx context.Context, webhookID, deliveryID string) (
+	*Delivery, *tidcommon.ServiceError) {
+	if svcErr := s.checkAction(ctx, security.ActionRedeliverWebhook); svcErr != nil {
+		return nil, svcErr
+	}
+	if _, svcErr := s.loadWebhook(ctx, webhookID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	delivery, err := s.deliveryStore.getDelivery(ctx, webhookID, deliveryID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get webhook delivery", log.String("id", deliveryID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if delivery == nil {
+		return nil, &ErrorDeliveryNotFound
+	}
+	if delivery.Status == DeliveryStatusPending {
+		return nil, &ErrorDeliveryPending
+	}
+
+	now := time.Now().UTC()
+	reset, err := s.deliveryStore.resetDelivery(ctx, deliveryID, now)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to queue webhook redelivery", log.String("id", deliveryID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if !reset {
+		return nil, &ErrorDeliveryPending
+	}
+	s.auditRecorder.Record(ctx, audit.Entry{
+		Operation:  audit.OperationUpdate,
+		TargetType: audit.TargetWebhook,
+		TargetID:   webhookID,
+		Action:     "webhook.delivery.redeliver",
+		After:      map[string]interface{}{"deliveryId": deliveryID},
+	})
+
+	delivery.Status = DeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = &now
+	return delivery, nil
+}
+
+// checkAction verifies that the caller is allowed to perform the given action.
+func (s *webhookService) checkAction(ctx context.Context, action security.Action) *tidcommon.ServiceError {
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, action, nil)
+	if svcErr != nil {
+		return &tidcommon.InternalServerError
+	}
+	if !allowed {
+		return &tidcommon.ErrorUnauthorized
+	}
+	return nil
+}
+
+// loadWebhook returns the webhook with the given ID, or ErrorWebhookNotFound when it does not exist.
+func (s *webhookService) loadWebhook(ctx context.Context, id string) (*Webhook, *tidcommon.ServiceError) {
+	webhook, err := s.webhookStore.getWebhook(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get webhook", log.String("id", id), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if webhook == nil {
+		return nil, &ErrorWebhookNotFound
+	}
+	return webhook, nil
+}
+
+// newSigningSecret generates a signing secret and returns it with its encrypted property form.
+func (s *webhookService) newSigningSecret(ctx context.Context) (string, *cmodels.Property,
+	*tidcommon.ServiceError) {
+	secret, err := generateSecret()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate webhook signing secret", log.Error(err))
+		return "", nil, &tidcommon.InternalServerError
+	}
+
+	property, err := cmodels.NewProperty(propertySigningSecret, secret, true)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to encrypt webhook signing secret", log.Error(err))
+		return "", nil, &tidcommon.InternalServerError
+	}
+
+	return secret, property, nil
+}
+
+// buildWebhook validates a webhook request and returns the webhook it describes, without an ID.
+func buildWebhook(request WebhookRequest) (Webhook, *tidcommon.ServiceError) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return Webhook{}, &ErrorInvalidName
+	}
+
+	if !isValidEndpointURL(request.URL) {
+		return Webhook{}, &ErrorInvalidURL
+	}
+
+	if len(request.EventTypes) == 0 {
+		return Webhook{}, &ErrorInvalidEventTypes
+	}
+	eventTypes := make([]string, 0, len(request.EventTypes))
+	seen := make(map[string]struct{}, len(request.EventTypes))
+	for _, eventType := range request.EventTypes {
+		if _, ok := supportedEventTypes[eventType]; !ok {
+			return Webhook{}, &ErrorInvalidEventTypes
+		}
+		if _, dup := seen[eventType]; dup {
+			continue
+		}
+		seen[eventType] = struct{}{}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	format := request.Format
+	if format == "" {
+		format = FormatJSON
+	}
+	if format != FormatJSON && format != FormatCloudEvents {
+		return Webhook{}, &ErrorInvalidFormat
+	}
+
+	enabled := true
+	if request.Enabled != nil {
+		enabled = *request.Enabled
+	}
+
+	return Webhook{
+		Name:        name,
+		Description: request.Description,
+		URL:         request.URL,
+		EventTypes:  eventTypes,
+		Format:      format,
+		Enabled:     enabled,
+	}, nil
+}
+
+// isValidEndpointURL reports whether rawURL is an https URL that is safe for the server to call, i.e.
+// not a loopback, link-local or private address.
+func isValidEndpointURL(rawURL string) bool {
+	return syshttp.IsSSRFSafeURL(rawURL) == nil
+}
+
+// validatePaginationParams validates pagination parameters.
+func validatePaginationParams(limit, offset int) *tidcommon.ServiceError {
+	if limit < 1 || limit > serverconst.MaxPageSize {
+		return &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return &ErrorInvalidOffset
+	}
+	return nil
+}
+
+// validateDeliveryFilter checks that every clause of the filter compares a filterable delivery attribute
+// for equality with a string value.
+func validateDeliveryFilter(f *tidcommon.FilterGroup) *tidcommon.ServiceError {
+	if f == nil {
+		return nil
+	}
+	for _, clause := range f.Clauses {
+		if _, ok := deliveryFilterableColumns[clause.Expr.Attribute]; !ok {
+			return &ErrorInvalidFilter
+		}
+		if _, ok := clause.Expr.Value.(string); !ok || clause.Expr.Operator != tidcommon.OperatorEq {
+			return &ErrorInvalidFilter
+		}
+	}
+	return nil
+}
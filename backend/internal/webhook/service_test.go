@@ -0,0 +1,355 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/observability/audit"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/observabilityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+type WebhookServiceTestSuite struct {
+	suite.Suite
+	mockWebhookStore  *webhookStoreInterfaceMock
+	mockDeliveryStore *deliveryStoreInterfaceMock
+	mockAuthz         *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockObservability *observabilityprovidermock.ObservabilityProviderMock
+	service           WebhookServiceInterface
+}
+
+func TestWebhookServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookServiceTestSuite))
+}
+
+func (suite *WebhookServiceTestSuite) SetupTest() {
+	suite.mockWebhookStore = newWebhookStoreInterfaceMock(suite.T())
+	suite.mockDeliveryStore = newDeliveryStoreInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockObservability = observabilityprovidermock.NewObservabilityProviderMock(suite.T())
+	suite.mockObservability.On("IsEnabled").Return(false).Maybe()
+	suite.service = newWebhookService(suite.mockWebhookStore, suite.mockDeliveryStore, suite.mockAuthz,
+		audit.NewRecorder(suite.mockObservability))
+}
+
+func (suite *WebhookServiceTestSuite) allow(action security.Action) {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, action, mock.Anything).Return(true, nil)
+}
+
+// captureAudit enables the observability provider and returns a pointer to the next published event.
+func (suite *WebhookServiceTestSuite) captureAudit() **providers.Event {
+	var published *providers.Event
+	suite.mockObservability.ExpectedCalls = nil
+	suite.mockObservability.On("IsEnabled").Return(true)
+	suite.mockObservability.On("PublishEvent", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			published = args.Get(1).(*providers.Event)
+		}).Return()
+	return &published
+}
+
+func validRequest() WebhookRequest {
+	return WebhookRequest{
+		Name:       " CRM sync ",
+		URL:        "https://crm.example.com/hooks",
+		EventTypes: []string{EventTypeUserRegistered, EventTypeUserDeleted, EventTypeUserRegistered},
+	}
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook() {
+	suite.allow(security.ActionCreateWebhook)
+	var secretProperty *cmodels.Property
+	suite.mockWebhookStore.On("createWebhook", mock.Anything, mock.MatchedBy(func(w Webhook) bool {
+		return w.ID != "" && w.Name == "CRM sync" && w.Format == FormatJSON && w.Enabled &&
+			len(w.EventTypes) == 2
+	}), mock.Anything).Run(func(args mock.Arguments) {
+		secretProperty = args.Get(2).(*cmodels.Property)
+	}).Return(nil)
+
+	resp, svcErr := suite.service.CreateWebhook(context.Background(), validRequest())
+	suite.Require().Nil(svcErr)
+	assert.True(suite.T(), strings.HasPrefix(resp.Secret, secretPrefix))
+	assert.Equal(suite.T(), []string{EventTypeUserRegistered, EventTypeUserDeleted}, resp.EventTypes)
+
+	suite.Require().NotNil(secretProperty)
+	assert.True(suite.T(), secretProperty.IsSecret())
+	value, err := secretProperty.GetValue()
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), resp.Secret, value)
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook_InvalidRequest() {
+	disabled := false
+	testCases := []struct {
+		name     string
+		mutate   func(r *WebhookRequest)
+		expected *tidcommon.ServiceError
+	}{
+		{"blank name", func(r *WebhookRequest) { r.Name = "  " }, &ErrorInvalidName},
+		{"relative url", func(r *WebhookRequest) { r.URL = "/hooks" }, &ErrorInvalidURL},
+		{"unsupported scheme", func(r *WebhookRequest) { r.URL = "ftp://crm.example.com" }, &ErrorInvalidURL},
+		{"plain http", func(r *WebhookRequest) { r.URL = "http://crm.example.com/hooks" }, &ErrorInvalidURL},
+		{"loopback", func(r *WebhookRequest) { r.URL = "https://127.0.0.1/hooks" }, &ErrorInvalidURL},
+		{"private address", func(r *WebhookRequest) { r.URL = "https://10.0.0.5/hooks" }, &ErrorInvalidURL},
+		{"no event types", func(r *WebhookRequest) { r.EventTypes = nil }, &ErrorInvalidEventTypes},
+		{"unknown event type", func(r *WebhookRequest) { r.EventTypes = []string{"user.updated"} },
+			&ErrorInvalidEventTypes},
+		{"unknown format", func(r *WebhookRequest) { r.Format = "xml"; r.Enabled = &disabled },
+			&ErrorInvalidFormat},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.allow(security.ActionCreateWebhook)
+			request := validRequest()
+			tc.mutate(&request)
+
+			_, svcErr := suite.service.CreateWebhook(context.Background(), request)
+			assert.Equal(suite.T(), tc.expected, svcErr)
+		})
+	}
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook_Unauthorized() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionCreateWebhook, mock.Anything).
+		Return(false, nil)
+
+	_, svcErr := suite.service.CreateWebhook(context.Background(), validRequest())
+	assert.Equal(suite.T(), &tidcommon.ErrorUnauthorized, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook_AuthzError() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionCreateWebhook, mock.Anything).
+		Return(false, &tidcommon.InternalServerError)
+
+	_, svcErr := suite.service.CreateWebhook(context.Background(), validRequest())
+	assert.Equal(suite.T(), &tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook_StoreError() {
+	suite.allow(security.ActionCreateWebhook)
+	suite.mockWebhookStore.On("createWebhook", mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("db error"))
+
+	_, svcErr := suite.service.CreateWebhook(context.Background(), validRequest())
+	assert.Equal(suite.T(), &tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestGetWebhookList() {
+	suite.allow(security.ActionListWebhooks)
+	webhooks := []Webhook{testWebhook()}
+	suite.mockWebhookStore.On("getWebhookListCount", mock.Anything).Return(3, nil)
+	suite.mockWebhookStore.On("getWebhookList", mock.Anything, 1, 0).Return(webhooks, nil)
+
+	resp, svcErr := suite.service.GetWebhookList(context.Background(), 1, 0)
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), 3, resp.TotalResults)
+	assert.Equal(suite.T(), 1, resp.StartIndex)
+	assert.Equal(suite.T(), 1, resp.Count)
+	assert.Equal(suite.T(), webhooks, resp.Webhooks)
+	assert.NotEmpty(suite.T(), resp.Links)
+}
+
+func (suite *WebhookServiceTestSuite) TestGetWebhookList_InvalidParams() {
+	suite.allow(security.ActionListWebhooks)
+
+	_, svcErr := suite.service.GetWebhookList(context.Background(), serverconst.MaxPageSize+1, 0)
+	assert.Equal(suite.T(), &ErrorInvalidLimit, svcErr)
+
+	_, svcErr = suite.service.GetWebhookList(context.Background(), 10, -1)
+	assert.Equal(suite.T(), &ErrorInvalidOffset, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestGetWebhook() {
+	suite.allow(security.ActionReadWebhook)
+	webhook := testWebhook()
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "webhook-1").Return(&webhook, nil)
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "missing").Return(nil, nil)
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "broken").Return(nil, errors.New("db error"))
+
+	resp, svcErr := suite.service.GetWebhook(context.Background(), "webhook-1")
+	assert.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), &webhook, resp)
+
+	_, svcErr = suite.service.GetWebhook(context.Background(), "missing")
+	assert.Equal(suite.T(), &ErrorWebhookNotFound, svcErr)
+
+	_, svcErr = suite.service.GetWebhook(context.Background(), "broken")
+	assert.Equal(suite.T(), &tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestUpdateWebhook() {
+	suite.allow(security.ActionUpdateWebhook)
+	existing := testWebhook()
+	disabled := false
+	request := validRequest()
+	request.Format = FormatCloudEvents
+	request.Enabled = &disabled
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "webhook-1").Return(&existing, nil)
+	suite.mockWebhookStore.On("updateWebhook", mock.Anything, mock.MatchedBy(func(w Webhook) bool {
+		return w.ID == "webhook-1" && w.Format == FormatCloudEvents && !w.Enabled
+	})).Return(nil)
+
+	resp, svcErr := suite.service.UpdateWebhook(context.Background(), "webhook-1", request)
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), "webhook-1", resp.ID)
+	assert.False(suite.T(), resp.Enabled)
+}
+
+func (suite *WebhookServiceTestSuite) TestUpdateWebhook_NotFound() {
+	suite.allow(security.ActionUpdateWebhook)
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "webhook-1").Return(nil, nil)
+
+	_, svcErr := suite.service.UpdateWebhook(context.Background(), "webhook-1", validRequest())
+	assert.Equal(suite.T(), &ErrorWebhookNotFound, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestDeleteWebhook() {
+	suite.allow(security.ActionDeleteWebhook)
+	published := suite.captureAudit()
+	existing := testWebhook()
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "webhook-1").Return(&existing, nil)
+	suite.mockWebhookStore.On("deleteWebhook", mock.Anything, "webhook-1").Return(nil)
+	suite.mockDeliveryStore.On("deleteWebhookDeliveries", mock.Anything, "webhook-1").
+		Return(errors.New("db error"))
+
+	svcErr := suite.service.DeleteWebhook(context.Background(), "webhook-1")
+	assert.Nil(suite.T(), svcErr)
+	suite.Require().NotNil(*published)
+	assert.Equal(suite.T(), string(event.EventTypeAdminResourceDeleted), (*published).Type)
+	assert.Equal(suite.T(), audit.TargetWebhook, (*published).Data[event.DataKey.TargetType])
+}
+
+func (suite *WebhookServiceTestSuite) TestDeleteWebhook_StoreError() {
+	suite.allow(security.ActionDeleteWebhook)
+	existing := testWebhook()
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "webhook-1").Return(&existing, nil)
+	suite.mockWebhookStore.On("deleteWebhook", mock.Anything, "webhook-1").Return(errors.New("db error"))
+
+	svcErr := suite.service.DeleteWebhook(context.Background(), "webhook-1")
+	assert.Equal(suite.T(), &tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestRotateWebhookSecret() {
+	suite.allow(security.ActionUpdateWebhook)
+	published := suite.captureAudit()
+	existing := testWebhook()
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "webhook-1").Return(&existing, nil)
+	suite.mockWebhookStore.On("updateSigningSecret", mock.Anything, "webhook-1",
+		mock.AnythingOfType("*cmodels.Property")).Return(nil)
+
+	resp, svcErr := suite.service.RotateWebhookSecret(context.Background(), "webhook-1")
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), "webhook-1", resp.ID)
+	assert.True(suite.T(), strings.HasPrefix(resp.Secret, secretPrefix))
+	suite.Require().NotNil(*published)
+	assert.Equal(suite.T(), "webhook.secret.rotate", (*published).Data[event.DataKey.Action])
+}
+
+func (suite *WebhookServiceTestSuite) TestGetDeliveryList() {
+	suite.allow(security.ActionReadWebhook)
+	existing := testWebhook()
+	filter := statusFilter("dead_letter")
+	deliveries := []Delivery{{ID: "delivery-1"}}
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "webhook-1").Return(&existing, nil)
+	suite.mockDeliveryStore.On("getDeliveryListCount", mock.Anything, "webhook-1", filter).Return(1, nil)
+	suite.mockDeliveryStore.On("getDeliveryList", mock.Anything, "webhook-1", 10, 0, filter).
+		Return(deliveries, nil)
+
+	resp, svcErr := suite.service.GetDeliveryList(context.Background(), "webhook-1", 10, 0, filter)
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), 1, resp.TotalResults)
+	assert.Equal(suite.T(), deliveries, resp.Deliveries)
+}
+
+func (suite *WebhookServiceTestSuite) TestGetDeliveryList_InvalidFilter() {
+	testCases := []struct {
+		name   string
+		filter *tidcommon.FilterGroup
+	}{
+		{"unknown attribute", &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+			{Expr: tidcommon.FilterExpression{Attribute: "payload", Operator: tidcommon.OperatorEq, Value: "x"}},
+		}}},
+		{"non-eq operator", &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+			{Expr: tidcommon.FilterExpression{Attribute: "status", Operator: tidcommon.OperatorGt, Value: "x"}},
+		}}},
+		{"non-string value", &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+			{Expr: tidcommon.FilterExpression{Attribute: "status", Operator: tidcommon.OperatorEq, Value: true}},
+		}}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.allow(security.ActionReadWebhook)
+
+			_, svcErr := suite.service.GetDeliveryList(context.Background(), "webhook-1", 10, 0, tc.filter)
+			assert.Equal(suite.T(), &ErrorInvalidFilter, svcErr)
+		})
+	}
+}
+
+func (suite *WebhookServiceTestSuite) TestRedeliverDelivery() {
+	suite.allow(security.ActionRedeliverWebhook)
+	published := suite.captureAudit()
+	existing := testWebhook()
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "webhook-1").Return(&existing, nil)
+	suite.mockDeliveryStore.On("getDelivery", mock.Anything, "webhook-1", "delivery-1").
+		Return(&Delivery{ID: "delivery-1", Status: DeliveryStatusDeadLetter, Attempts: 8}, nil)
+	suite.mockDeliveryStore.On("resetDelivery", mock.Anything, "delivery-1", mock.Anything).Return(true, nil)
+
+	delivery, svcErr := suite.service.RedeliverDelivery(context.Background(), "webhook-1", "delivery-1")
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), DeliveryStatusPending, delivery.Status)
+	assert.Zero(suite.T(), delivery.Attempts)
+	assert.NotNil(suite.T(), delivery.NextAttemptAt)
+	suite.Require().NotNil(*published)
+	assert.Equal(suite.T(), "webhook.delivery.redeliver", (*published).Data[event.DataKey.Action])
+}
+
+func (suite *WebhookServiceTestSuite) TestRedeliverDelivery_Errors() {
+	testCases := []struct {
+		name     string
+		delivery *Delivery
+		reset    bool
+		expected *tidcommon.ServiceError
+	}{
+		{"not found", nil, false, &ErrorDeliveryNotFound},
+		{"pending", &Delivery{ID: "delivery-1", Status: DeliveryStatusPending}, false, &ErrorDeliveryPending},
+		{"claimed concurrently", &Delivery{ID: "delivery-1", Status: DeliveryStatusDelivered}, false,
+			&ErrorDeliveryPending},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.allow(security.ActionRedeliverWebhook)
+			existing := testWebhook()
+			suite.mockWebhookStore.On("getWebhook", mock.Anything, "webhook-1").Return(&existing, nil)
+			suite.mockDeliveryStore.On("getDelivery", mock.Anything, "webhook-1", "delivery-1").
+				Return(tc.delivery, nil)
+			suite.mockDeliveryStore.On("resetDelivery", mock.Anything, "delivery-1", mock.Anything).
+				Return(tc.reset, nil).Maybe()
+
+			_, svcErr := suite.service.RedeliverDelivery(context.Background(), "webhook-1", "delivery-1")
+			assert.Equal(suite.T(), tc.expected, svcErr)
+		})
+	}
+}
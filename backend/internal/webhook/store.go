@@ -0,0 +1,285 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// webhookStoreInterface defines the persistence of webhooks.
+type webhookStoreInterface interface {
+	// createWebhook stores a new webhook with its encrypted signing secret.
+	createWebhook(ctx context.Context, webhook Webhook, secret *cmodels.Property) error
+	// getWebhook returns the webhook with the given ID, or nil when it does not exist.
+	getWebhook(ctx context.Context, id string) (*Webhook, error)
+	// getWebhookListCount returns the number of webhooks.
+	getWebhookListCount(ctx context.Context) (int, error)
+	// getWebhookList returns a page of webhooks ordered by name.
+	getWebhookList(ctx context.Context, limit, offset int) ([]Webhook, error)
+	// getEnabledWebhooks returns every enabled webhook.
+	getEnabledWebhooks(ctx context.Context) ([]Webhook, error)
+	// updateWebhook updates the settings of a webhook.
+	updateWebhook(ctx context.Context, webhook Webhook) error
+	// updateSigningSecret replaces the signing secret of a webhook.
+	updateSigningSecret(ctx context.Context, id string, secret *cmodels.Property) error
+	// getSigningSecret returns the decrypted signing secret of a webhook.
+	getSigningSecret(ctx context.Context, id string) (string, error)
+	// deleteWebhook deletes a webhook.
+	deleteWebhook(ctx context.Context, id string) error
+}
+
+// webhookStore implements webhookStoreInterface against the configuration database.
+type webhookStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newWebhookStore creates a new webhookStore.
+func newWebhookStore() webhookStoreInterface {
+	return &webhookStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createWebhook stores a new webhook with its encrypted signing secret.
+func (s *webhookStore) createWebhook(ctx context.Context, webhook Webhook, secret *cmodels.Property) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	eventTypesJSON, err := json.Marshal(webhook.EventTypes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event types: %w", err)
+	}
+	propertiesJSON, err := cmodels.SerializePropertiesToJSONObject([]cmodels.Property{*secret})
+	if err != nil {
+		return fmt.Errorf("failed to serialize properties: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateWebhook, webhook.ID, webhook.Name, webhook.Description,
+		webhook.URL, string(eventTypesJSON), webhook.Format, utils.BoolToNumString(webhook.Enabled),
+		propertiesJSON, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// getWebhook returns the webhook with the given ID, or nil when it does not exist.
+func (s *webhookStore) getWebhook(ctx context.Context, id string) (*Webhook, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetWebhookByID, id, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	webhook, err := buildWebhookFromResultRow(results[0])
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// getWebhookListCount returns the number of webhooks.
+func (s *webhookStore) getWebhookListCount(ctx context.Context) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetWebhookCount, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute count query: %w", err)
+	}
+
+	return parseCount(results)
+}
+
+// getWebhookList returns a page of webhooks ordered by name.
+func (s *webhookStore) getWebhookList(ctx context.Context, limit, offset int) ([]Webhook, error) {
+	return s.queryWebhooks(ctx, queryGetWebhookList, limit, offset, s.deploymentID)
+}
+
+// getEnabledWebhooks returns every enabled webhook.
+func (s *webhookStore) getEnabledWebhooks(ctx context.Context) ([]Webhook, error) {
+	return s.queryWebhooks(ctx, queryGetEnabledWebhooks, s.deploymentID)
+}
+
+// queryWebhooks runs the given query and builds the resulting webhooks.
+func (s *webhookStore) queryWebhooks(ctx context.Context, query dbmodel.DBQuery,
+	args ...interface{}) ([]Webhook, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	webhooks := make([]Webhook, 0, len(results))
+	for _, row := range results {
+		webhook, err := buildWebhookFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+// updateWebhook updates the settings of a webhook.
+func (s *webhookStore) updateWebhook(ctx context.Context, webhook Webhook) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	eventTypesJSON, err := json.Marshal(webhook.EventTypes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event types: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateWebhook, webhook.Name, webhook.Description, webhook.URL,
+		string(eventTypesJSON), webhook.Format, utils.BoolToNumString(webhook.Enabled), webhook.ID,
+		s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// updateSigningSecret replaces the signing secret of a webhook.
+func (s *webhookStore) updateSigningSecret(ctx context.Context, id string, secret *cmodels.Property) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	propertiesJSON, err := cmodels.SerializePropertiesToJSONObject([]cmodels.Property{*secret})
+	if err != nil {
+		return fmt.Errorf("failed to serialize properties: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateWebhookProperties, propertiesJSON, id, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// getSigningSecret returns the decrypted signing secret of a webhook.
+func (s *webhookStore) getSigningSecret(ctx context.Context, id string) (string, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetWebhookProperties, id, s.deploymentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return "", fmt.Errorf("webhook %s not found", id)
+	}
+
+	properties, err := cmodels.DeserializePropertiesFromJSONObject(stringOrBytesField(results[0], "properties"))
+	if err != nil {
+		return "", fmt.Errorf("failed to deserialize properties: %w", err)
+	}
+	for i := range properties {
+		if properties[i].GetName() == propertySigningSecret {
+			return properties[i].GetValue()
+		}
+	}
+
+	return "", fmt.Errorf("webhook %s has no signing secret", id)
+}
+
+// deleteWebhook deletes a webhook.
+func (s *webhookStore) deleteWebhook(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteWebhook, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// buildWebhookFromResultRow constructs a Webhook from a database result row.
+func buildWebhookFromResultRow(row map[string]interface{}) (Webhook, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return Webhook{}, errors.New("failed to parse id as string")
+	}
+
+	var eventTypes []string
+	if eventTypesJSON := stringOrBytesField(row, "event_types"); eventTypesJSON != "" {
+		if err := json.Unmarshal([]byte(eventTypesJSON), &eventTypes); err != nil {
+			return Webhook{}, fmt.Errorf("failed to unmarshal event types: %w", err)
+		}
+	}
+
+	return Webhook{
+		ID:          id,
+		Name:        stringOrBytesField(row, "name"),
+		Description: stringOrBytesField(row, "description"),
+		URL:         stringOrBytesField(row, "url"),
+		EventTypes:  eventTypes,
+		Format:      stringOrBytesField(row, "format"),
+		Enabled:     utils.NumStringToBool(stringOrBytesField(row, "enabled")),
+	}, nil
+}
+
+// parseCount reads the total column of a count query result.
+func parseCount(results []map[string]interface{}) (int, error) {
+	if len(results) == 0 {
+		return 0, nil
+	}
+	count, ok := results[0]["total"].(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected type for total: %T", results[0]["total"])
+	}
+	return int(count), nil
+}
+
+// stringOrBytesField returns the value of a nullable text column, which drivers return as either a
+// string or a byte slice, or an empty string when it is NULL.
+func stringOrBytesField(row map[string]interface{}, column string) string {
+	switch v := row[column].(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"fmt"
+	"strings"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+var (
+	// queryCreateWebhook creates a new webhook.
+	queryCreateWebhook = dbmodel.DBQuery{
+		ID: "WHQ-WH-01",
+		Query: `INSERT INTO "WEBHOOK" (ID, NAME, DESCRIPTION, URL, EVENT_TYPES, FORMAT, ENABLED, PROPERTIES, ` +
+			`DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+	}
+
+	// queryGetWebhookByID gets a webhook by its ID.
+	queryGetWebhookByID = dbmodel.DBQuery{
+		ID: "WHQ-WH-02",
+		Query: `SELECT ID, NAME, DESCRIPTION, URL, EVENT_TYPES, FORMAT, ENABLED ` +
+			`FROM "WEBHOOK" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetWebhookCount counts the webhooks of the deployment.
+	queryGetWebhookCount = dbmodel.DBQuery{
+		ID:    "WHQ-WH-03",
+		Query: `SELECT COUNT(*) as total FROM "WEBHOOK" WHERE DEPLOYMENT_ID = $1`,
+	}
+
+	// queryGetWebhookList gets a page of webhooks ordered by name.
+	queryGetWebhookList = dbmodel.DBQuery{
+		ID: "WHQ-WH-04",
+		Query: `SELECT ID, NAME, DESCRIPTION, URL, EVENT_TYPES, FORMAT, ENABLED ` +
+			`FROM "WEBHOOK" WHERE DEPLOYMENT_ID = $3 ORDER BY NAME, ID LIMIT $1 OFFSET $2`,
+	}
+
+	// queryGetEnabledWebhooks gets every enabled webhook of the deployment.
+	queryGetEnabledWebhooks = dbmodel.DBQuery{
+		ID: "WHQ-WH-05",
+		Query: `SELECT ID, NAME, DESCRIPTION, URL, EVENT_TYPES, FORMAT, ENABLED ` +
+			`FROM "WEBHOOK" WHERE ENABLED = '1' AND DEPLOYMENT_ID = $1`,
+	}
+
+	// queryUpdateWebhook updates the settings of a webhook.
+	queryUpdateWebhook = dbmodel.DBQuery{
+		ID: "WHQ-WH-06",
+		PostgresQuery: `UPDATE "WEBHOOK" SET NAME = $1, DESCRIPTION = $2, URL = $3, EVENT_TYPES = $4, ` +
+			`FORMAT = $5, ENABLED = $6, UPDATED_AT = NOW() WHERE ID = $7 AND DEPLOYMENT_ID = $8`,
+		SQLiteQuery: `UPDATE "WEBHOOK" SET NAME = $1, DESCRIPTION = $2, URL = $3, EVENT_TYPES = $4, ` +
+			`FORMAT = $5, ENABLED = $6, UPDATED_AT = datetime('now') WHERE ID = $7 AND DEPLOYMENT_ID = $8`,
+		Query: `UPDATE "WEBHOOK" SET NAME = $1, DESCRIPTION = $2, URL = $3, EVENT_TYPES = $4, ` +
+			`FORMAT = $5, ENABLED = $6, UPDATED_AT = datetime('now') WHERE ID = $7 AND DEPLOYMENT_ID = $8`,
+	}
+
+	// queryUpdateWebhookProperties replaces the properties, including the signing secret, of a webhook.
+	queryUpdateWebhookProperties = dbmodel.DBQuery{
+		ID: "WHQ-WH-07",
+		PostgresQuery: `UPDATE "WEBHOOK" SET PROPERTIES = $1, UPDATED_AT = NOW() ` +
+			`WHERE ID = $2 AND DEPLOYMENT_ID = $3`,
+		SQLiteQuery: `UPDATE "WEBHOOK" SET PROPERTIES = $1, UPDATED_AT = datetime('now') ` +
+			`WHERE ID = $2 AND DEPLOYMENT_ID = $3`,
+		Query: `UPDATE "WEBHOOK" SET PROPERTIES = $1, UPDATED_AT = datetime('now') ` +
+			`WHERE ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryGetWebhookProperties gets the properties, including the signing secret, of a webhook.
+	queryGetWebhookProperties = dbmodel.DBQuery{
+		ID:    "WHQ-WH-08",
+		Query: `SELECT PROPERTIES FROM "WEBHOOK" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteWebhook deletes a webhook.
+	queryDeleteWebhook = dbmodel.DBQuery{
+		ID:    "WHQ-WH-09",
+		Query: `DELETE FROM "WEBHOOK" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
+
+var (
+	// queryInsertDelivery queues a delivery.
+	queryInsertDelivery = dbmodel.DBQuery{
+		ID: "WHQ-WD-01",
+		Query: `INSERT INTO "WEBHOOK_DELIVERY" (DELIVERY_ID, DEPLOYMENT_ID, WEBHOOK_ID, EVENT_ID, EVENT_TYPE, ` +
+			`PAYLOAD, CONTENT_TYPE, STATUS, ATTEMPTS, NEXT_ATTEMPT_AT, CREATED_AT) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, 0, $9, $10)`,
+	}
+
+	// queryGetDueDeliveries gets the pending deliveries whose next attempt is due, oldest first.
+	queryGetDueDeliveries = dbmodel.DBQuery{
+		ID: "WHQ-WD-02",
+		Query: `SELECT DELIVERY_ID, WEBHOOK_ID, EVENT_ID, EVENT_TYPE, PAYLOAD, CONTENT_TYPE, STATUS, ATTEMPTS, ` +
+			`NEXT_ATTEMPT_AT, LAST_ATTEMPT_AT, LAST_RESPONSE_STATUS, LAST_ERROR, CREATED_AT ` +
+			`FROM "WEBHOOK_DELIVERY" WHERE DEPLOYMENT_ID = $1 AND STATUS = 'pending' AND NEXT_ATTEMPT_AT <= $2 ` +
+			`ORDER BY NEXT_ATTEMPT_AT LIMIT $3`,
+	}
+
+	// queryClaimDelivery claims a pending delivery for an attempt by counting the attempt and leasing it
+	// until the given time. The attempt count guards against another node claiming it concurrently.
+	queryClaimDelivery = dbmodel.DBQuery{
+		ID: "WHQ-WD-03",
+		Query: `UPDATE "WEBHOOK_DELIVERY" SET ATTEMPTS = ATTEMPTS + 1, NEXT_ATTEMPT_AT = $1 ` +
+			`WHERE DELIVERY_ID = $2 AND DEPLOYMENT_ID = $3 AND STATUS = 'pending' AND ATTEMPTS = $4`,
+	}
+
+	// queryRecordDeliveryAttempt records the outcome of a delivery attempt.
+	queryRecordDeliveryAttempt = dbmodel.DBQuery{
+		ID: "WHQ-WD-04",
+		Query: `UPDATE "WEBHOOK_DELIVERY" SET STATUS = $1, NEXT_ATTEMPT_AT = $2, LAST_ATTEMPT_AT = $3, ` +
+			`LAST_RESPONSE_STATUS = $4, LAST_ERROR = $5, EXPIRY_TIME = $6 ` +
+			`WHERE DELIVERY_ID = $7 AND DEPLOYMENT_ID = $8`,
+	}
+
+	// queryGetDelivery gets a delivery of a webhook.
+	queryGetDelivery = dbmodel.DBQuery{
+		ID: "WHQ-WD-05",
+		Query: `SELECT DELIVERY_ID, WEBHOOK_ID, EVENT_ID, EVENT_TYPE, PAYLOAD, CONTENT_TYPE, STATUS, ATTEMPTS, ` +
+			`NEXT_ATTEMPT_AT, LAST_ATTEMPT_AT, LAST_RESPONSE_STATUS, LAST_ERROR, CREATED_AT ` +
+			`FROM "WEBHOOK_DELIVERY" WHERE DELIVERY_ID = $1 AND WEBHOOK_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryResetDelivery queues a delivery again with a fresh attempt count.
+	queryResetDelivery = dbmodel.DBQuery{
+		ID: "WHQ-WD-06",
+		Query: `UPDATE "WEBHOOK_DELIVERY" SET STATUS = 'pending', ATTEMPTS = 0, NEXT_ATTEMPT_AT = $1, ` +
+			`EXPIRY_TIME = NULL WHERE DELIVERY_ID = $2 AND DEPLOYMENT_ID = $3 AND STATUS <> 'pending'`,
+	}
+
+	// queryDeleteWebhookDeliveries deletes every delivery of a webhook.
+	queryDeleteWebhookDeliveries = dbmodel.DBQuery{
+		ID:    "WHQ-WD-07",
+		Query: `DELETE FROM "WEBHOOK_DELIVERY" WHERE WEBHOOK_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteExpiredDeliveries removes the final deliveries past their retention period.
+	queryDeleteExpiredDeliveries = dbmodel.DBQuery{
+		ID:    "WHQ-WD-08",
+		Query: `DELETE FROM "WEBHOOK_DELIVERY" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
+
+// deliveryFilterableColumns maps API attribute names to WEBHOOK_DELIVERY table column names.
+var deliveryFilterableColumns = map[string]string{
+	"status":    "STATUS",
+	"eventType": "EVENT_TYPE",
+}
+
+// buildDeliveryFilterGroup generates a SQL WHERE fragment for a FilterGroup and returns the bound args.
+// startParamIdx is the positional parameter index for the first filter value. Only the eq operator is
+// supported. For multi-clause groups the fragment is wrapped in AND (...).
+func buildDeliveryFilterGroup(g *tidcommon.FilterGroup, startParamIdx int) (
+	cond string, args []interface{}, err error) {
+	if g == nil || len(g.Clauses) == 0 {
+		return "", nil, nil
+	}
+
+	var sb strings.Builder
+	idx := startParamIdx
+
+	for i, clause := range g.Clauses {
+		col, ok := deliveryFilterableColumns[clause.Expr.Attribute]
+		if !ok {
+			return "", nil, fmt.Errorf("attribute %q is not filterable", clause.Expr.Attribute)
+		}
+		if clause.Expr.Operator != tidcommon.OperatorEq {
+			return "", nil, fmt.Errorf("unsupported operator %q", clause.Expr.Operator)
+		}
+
+		if i > 0 {
+			sb.WriteString(" ")
+			sb.WriteString(string(clause.Connector))
+			sb.WriteString(" ")
+		}
+		sb.WriteString(fmt.Sprintf("%s = $%d", col, idx))
+		args = append(args, clause.Expr.Value)
+		idx++
+	}
+
+	if len(g.Clauses) == 1 {
+		cond = " AND " + sb.String()
+	} else {
+		cond = " AND (" + sb.String() + ")"
+	}
+	return cond, args, nil
+}
+
+// buildDeliveryCountQuery constructs the delivery count query of a webhook with an optional filter group.
+// Args order: webhookID=$1, deploymentID=$2 [, filterArgs...]
+func buildDeliveryCountQuery(g *tidcommon.FilterGroup) (dbmodel.DBQuery, []interface{}, error) {
+	query := `SELECT COUNT(*) as total FROM "WEBHOOK_DELIVERY" WHERE WEBHOOK_ID = $1 AND DEPLOYMENT_ID = $2`
+
+	cond, filterArgs, err := buildDeliveryFilterGroup(g, 3)
+	if err != nil {
+		return dbmodel.DBQuery{}, nil, err
+	}
+
+	return dbmodel.DBQuery{ID: "WHQ-WD-09", Query: query + cond}, filterArgs, nil
+}
+
+// buildDeliveryListQuery constructs the paginated delivery list query of a webhook with an optional filter
+// group. Deliveries are returned newest first.
+// Args order: limit=$1, offset=$2, webhookID=$3, deploymentID=$4 [, filterArgs...]
+func buildDeliveryListQuery(g *tidcommon.FilterGroup) (dbmodel.DBQuery, []interface{}, error) {
+	query := `SELECT DELIVERY_ID, WEBHOOK_ID, EVENT_ID, EVENT_TYPE, STATUS, ATTEMPTS, NEXT_ATTEMPT_AT, ` +
+		`LAST_ATTEMPT_AT, LAST_RESPONSE_STATUS, LAST_ERROR, CREATED_AT ` +
+		`FROM "WEBHOOK_DELIVERY" WHERE WEBHOOK_ID = $3 AND DEPLOYMENT_ID = $4`
+
+	cond, filterArgs, err := buildDeliveryFilterGroup(g, 5)
+	if err != nil {
+		return dbmodel.DBQuery{}, nil, err
+	}
+	query += cond + " ORDER BY CREATED_AT DESC, DELIVERY_ID LIMIT $1 OFFSET $2"
+
+	return dbmodel.DBQuery{ID: "WHQ-WD-10", Query: query}, filterArgs, nil
+}
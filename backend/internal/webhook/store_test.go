@@ -0,0 +1,211 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type WebhookStoreTestSuite struct {
+	suite.Suite
+	mockdbProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *webhookStore
+}
+
+func TestWebhookStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookStoreTestSuite))
+}
+
+func (suite *WebhookStoreTestSuite) SetupTest() {
+	suite.mockdbProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &webhookStore{
+		dbProvider:   suite.mockdbProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func testWebhook() Webhook {
+	return Webhook{
+		ID:         "webhook-1",
+		Name:       "CRM sync",
+		URL:        "https://crm.example.com/hooks",
+		EventTypes: []string{EventTypeUserRegistered, EventTypeUserDeleted},
+		Format:     FormatJSON,
+		Enabled:    true,
+	}
+}
+
+func webhookRow() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          "webhook-1",
+		"name":        "CRM sync",
+		"description": nil,
+		"url":         "https://crm.example.com/hooks",
+		"event_types": []byte(`["user.registered","user.deleted"]`),
+		"format":      "json",
+		"enabled":     "1",
+	}
+}
+
+func (suite *WebhookStoreTestSuite) TestCreateWebhook() {
+	secret, err := cmodels.NewProperty(propertySigningSecret, "whsec_c2VjcmV0", true)
+	suite.Require().NoError(err)
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryCreateWebhook, "webhook-1", "CRM sync", "",
+		"https://crm.example.com/hooks", `["user.registered","user.deleted"]`, "json", "1",
+		mock.MatchedBy(func(properties string) bool {
+			return properties != "" && !strings.Contains(properties, "whsec_c2VjcmV0")
+		}), testDeploymentID).Return(int64(1), nil)
+
+	err = suite.store.createWebhook(context.Background(), testWebhook(), secret)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *WebhookStoreTestSuite) TestCreateWebhook_DBClientError() {
+	secret, err := cmodels.NewProperty(propertySigningSecret, "whsec_c2VjcmV0", true)
+	suite.Require().NoError(err)
+	suite.mockdbProvider.On("GetConfigDBClient").Return(nil, errors.New("db unavailable"))
+
+	err = suite.store.createWebhook(context.Background(), testWebhook(), secret)
+	assert.ErrorContains(suite.T(), err, "failed to get database client")
+}
+
+func (suite *WebhookStoreTestSuite) TestGetWebhook() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetWebhookByID, "webhook-1",
+		testDeploymentID).Return([]map[string]interface{}{webhookRow()}, nil)
+
+	webhook, err := suite.store.getWebhook(context.Background(), "webhook-1")
+	assert.NoError(suite.T(), err)
+	expected := testWebhook()
+	assert.Equal(suite.T(), &expected, webhook)
+}
+
+func (suite *WebhookStoreTestSuite) TestGetWebhook_NotFound() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetWebhookByID, "webhook-1",
+		testDeploymentID).Return([]map[string]interface{}{}, nil)
+
+	webhook, err := suite.store.getWebhook(context.Background(), "webhook-1")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), webhook)
+}
+
+func (suite *WebhookStoreTestSuite) TestGetWebhook_InvalidRow() {
+	row := webhookRow()
+	row["event_types"] = "not json"
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetWebhookByID, "webhook-1",
+		testDeploymentID).Return([]map[string]interface{}{row}, nil)
+
+	_, err := suite.store.getWebhook(context.Background(), "webhook-1")
+	assert.ErrorContains(suite.T(), err, "failed to unmarshal event types")
+}
+
+func (suite *WebhookStoreTestSuite) TestGetWebhookListCount() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetWebhookCount, testDeploymentID).
+		Return([]map[string]interface{}{{"total": int64(3)}}, nil)
+
+	count, err := suite.store.getWebhookListCount(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, count)
+}
+
+func (suite *WebhookStoreTestSuite) TestGetWebhookListCount_UnexpectedType() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetWebhookCount, testDeploymentID).
+		Return([]map[string]interface{}{{"total": "3"}}, nil)
+
+	_, err := suite.store.getWebhookListCount(context.Background())
+	assert.ErrorContains(suite.T(), err, "unexpected type for total")
+}
+
+func (suite *WebhookStoreTestSuite) TestGetWebhookList() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetWebhookList, 10, 0, testDeploymentID).
+		Return([]map[string]interface{}{webhookRow()}, nil)
+
+	webhooks, err := suite.store.getWebhookList(context.Background(), 10, 0)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []Webhook{testWebhook()}, webhooks)
+}
+
+func (suite *WebhookStoreTestSuite) TestGetEnabledWebhooks_QueryError() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetEnabledWebhooks, testDeploymentID).
+		Return(nil, errors.New("query error"))
+
+	_, err := suite.store.getEnabledWebhooks(context.Background())
+	assert.ErrorContains(suite.T(), err, "failed to execute query")
+}
+
+func (suite *WebhookStoreTestSuite) TestUpdateWebhook() {
+	webhook := testWebhook()
+	webhook.Enabled = false
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryUpdateWebhook, "CRM sync", "",
+		"https://crm.example.com/hooks", `["user.registered","user.deleted"]`, "json", "0", "webhook-1",
+		testDeploymentID).Return(int64(1), nil)
+
+	assert.NoError(suite.T(), suite.store.updateWebhook(context.Background(), webhook))
+}
+
+func (suite *WebhookStoreTestSuite) TestSigningSecretRoundTrip() {
+	secret, err := cmodels.NewProperty(propertySigningSecret, "whsec_c2VjcmV0", true)
+	suite.Require().NoError(err)
+
+	var stored string
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryUpdateWebhookProperties, mock.Anything,
+		"webhook-1", testDeploymentID).Run(func(args mock.Arguments) {
+		stored = args.Get(2).(string)
+	}).Return(int64(1), nil)
+	suite.Require().NoError(suite.store.updateSigningSecret(context.Background(), "webhook-1", secret))
+	assert.NotContains(suite.T(), stored, "whsec_c2VjcmV0")
+
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetWebhookProperties, "webhook-1",
+		testDeploymentID).Return([]map[string]interface{}{{"properties": []byte(stored)}}, nil)
+	value, err := suite.store.getSigningSecret(context.Background(), "webhook-1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "whsec_c2VjcmV0", value)
+}
+
+func (suite *WebhookStoreTestSuite) TestGetSigningSecret_Missing() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetWebhookProperties, "webhook-1",
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	_, err := suite.store.getSigningSecret(context.Background(), "webhook-1")
+	assert.ErrorContains(suite.T(), err, "not found")
+
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetWebhookProperties, "webhook-1",
+		testDeploymentID).Return([]map[string]interface{}{{"properties": "{}"}}, nil).Once()
+
+	_, err = suite.store.getSigningSecret(context.Background(), "webhook-1")
+	assert.ErrorContains(suite.T(), err, "has no signing secret")
+}
+
+func (suite *WebhookStoreTestSuite) TestDeleteWebhook() {
+	suite.mockdbProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", context.Background(), queryDeleteWebhook, "webhook-1",
+		testDeploymentID).Return(int64(0), errors.New("delete error"))
+
+	err := suite.store.deleteWebhook(context.Background(), "webhook-1")
+	assert.ErrorContains(suite.T(), err, "failed to execute query")
+}
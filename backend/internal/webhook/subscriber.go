@@ -0,0 +1,439 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const webhookSubscriberComponentName = "WebhookSubscriber"
+
+// webhookSubscriber turns observability events into webhook deliveries and dispatches them. Every event
+// a webhook subscribes to is written to the delivery outbox in the runtime persistent database, so queued
+// deliveries survive restarts; a background dispatcher then attempts the due deliveries, retrying failures
+// with exponential backoff until the endpoint accepts them or the attempts run out.
+type webhookSubscriber struct {
+	id               string
+	webhookStore     webhookStoreInterface
+	deliveryStore    deliveryStoreInterface
+	httpClient       syshttp.HTTPClientInterface
+	source           string
+	dispatchInterval time.Duration
+	batchSize        int
+	maxAttempts      int
+	initialBackoff   time.Duration
+	maxBackoff       time.Duration
+	requestTimeout   time.Duration
+	retention        time.Duration
+	wake             chan struct{}
+	stop             chan struct{}
+	closeOnce        sync.Once
+	logger           *log.Logger
+}
+
+// deliveryTarget is the endpoint a delivery is sent to, resolved once per dispatch round.
+type deliveryTarget struct {
+	url     string
+	secret  string
+	enabled bool
+}
+
+var _ subscriber.SubscriberInterface = (*webhookSubscriber)(nil)
+
+// init registers the webhook subscriber factory with the observability subscriber registry.
+func init() {
+	subscriber.RegisterSubscriberFactory("webhook", func() subscriber.SubscriberInterface {
+		return newWebhookSubscriber()
+	})
+}
+
+// newWebhookSubscriber creates a new webhook subscriber instance.
+func newWebhookSubscriber() *webhookSubscriber {
+	return &webhookSubscriber{}
+}
+
+// IsEnabled checks if the webhook subscriber should be activated based on configuration.
+func (ws *webhookSubscriber) IsEnabled() bool {
+	return config.GetServerRuntime().Config.Observability.Output.Webhook.Enabled
+}
+
+// Initialize sets up the webhook subscriber and starts the delivery dispatcher.
+func (ws *webhookSubscriber) Initialize() error {
+	// Subscriber initialization runs during application startup, outside any request.
+	ctx := context.Background()
+
+	runtimeConfig := config.GetServerRuntime().Config
+	webhookConfig := runtimeConfig.Observability.Output.Webhook
+
+	ws.dispatchInterval = config.SecondsOrDefault(webhookConfig.DispatchInterval, defaultDispatchInterval)
+	ws.initialBackoff = config.SecondsOrDefault(webhookConfig.InitialBackoff, defaultInitialBackoff)
+	ws.maxBackoff = config.SecondsOrDefault(webhookConfig.MaxBackoff, defaultMaxBackoff)
+	ws.requestTimeout = config.SecondsOrDefault(webhookConfig.RequestTimeout, defaultRequestTimeout)
+	ws.batchSize = config.PositiveIntOrDefault(webhookConfig.BatchSize, defaultBatchSize)
+	ws.maxAttempts = config.PositiveIntOrDefault(webhookConfig.MaxAttempts, defaultMaxAttempts)
+	ws.retention = time.Duration(config.PositiveIntOrDefault(webhookConfig.RetentionDays,
+		defaultRetentionDays)) * 24 * time.Hour
+	ws.source = config.GetServerURL(&runtimeConfig.Server)
+
+	if ws.webhookStore == nil {
+		ws.webhookStore = newWebhookStore()
+	}
+	if ws.deliveryStore == nil {
+		ws.deliveryStore = newDeliveryStore()
+	}
+	if ws.httpClient == nil {
+		// Endpoints are registered by administrators but may resolve, or redirect, to internal hosts, so
+		// deliveries go through the SSRF-safe client.
+		ws.httpClient = syshttp.NewHTTPClientWithCheckRedirect(func(req *http.Request, _ []*http.Request) error {
+			return syshttp.IsSSRFSafeURL(req.URL.String())
+		})
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate webhook subscriber ID: %w", err)
+	}
+
+	ws.id = id
+	ws.logger = log.GetLogger().With(log.String(log.LoggerKeyComponentName, webhookSubscriberComponentName))
+	ws.wake = make(chan struct{}, 1)
+	ws.stop = make(chan struct{})
+	go ws.run()
+
+	ws.logger.Debug(ctx, "Webhook subscriber initialized",
+		log.Int("maxAttempts", ws.maxAttempts),
+		log.Int("batchSize", ws.batchSize))
+
+	return nil
+}
+
+// GetID returns the unique identifier for this subscriber.
+func (ws *webhookSubscriber) GetID() string {
+	return ws.id
+}
+
+// GetCategories returns the categories this subscriber is interested in: the flow events that carry
+// logins, registrations and recoveries, and the administrative audit events that carry user changes.
+func (ws *webhookSubscriber) GetCategories() []event.EventCategory {
+	return []event.EventCategory{event.CategoryFlows, event.CategoryAudit}
+}
+
+// OnEvent queues a delivery of the event to every enabled webhook that subscribes to it.
+func (ws *webhookSubscriber) OnEvent(evt *providers.Event) error {
+	if evt == nil {
+		return fmt.Errorf("event is nil")
+	}
+
+	webhookEvt, ok := translateEvent(evt)
+	if !ok {
+		return nil
+	}
+
+	// Subscribers run in detached goroutines after the request context may be
+	// cancelled, so derive a context from the event's trace ID.
+	ctx := sysContext.WithTraceID(context.Background(), evt.TraceID)
+
+	webhooks, err := ws.webhookStore.getEnabledWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load webhooks: %w", err)
+	}
+
+	var errs []error
+	queued := 0
+	for _, webhook := range webhooks {
+		if !slices.Contains(webhook.EventTypes, webhookEvt.eventType) {
+			continue
+		}
+		if err := ws.queueDelivery(ctx, webhook, webhookEvt); err != nil {
+			ws.logger.Error(ctx, "Failed to queue webhook delivery",
+				log.String("webhookID", webhook.ID),
+				log.String("eventID", evt.EventID),
+				log.Error(err))
+			errs = append(errs, err)
+			continue
+		}
+		queued++
+	}
+
+	if queued > 0 {
+		ws.signalDispatcher()
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to queue webhook deliveries: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Close stops the delivery dispatcher. Pending deliveries stay queued and are picked up on the next start.
+func (ws *webhookSubscriber) Close() error {
+	ws.closeOnce.Do(func() {
+		if ws.stop != nil {
+			close(ws.stop)
+		}
+	})
+	return nil
+}
+
+// queueDelivery writes a delivery of the event to the webhook to the outbox.
+func (ws *webhookSubscriber) queueDelivery(ctx context.Context, webhook Webhook, evt webhookEvent) error {
+	payload, contentType, err := buildPayload(evt, webhook.Format, ws.source)
+	if err != nil {
+		return err
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate delivery ID: %w", err)
+	}
+
+	return ws.deliveryStore.insertDelivery(ctx, Delivery{
+		ID:          id,
+		WebhookID:   webhook.ID,
+		EventID:     evt.id,
+		EventType:   evt.eventType,
+		Status:      DeliveryStatusPending,
+		CreatedAt:   time.Now().UTC(),
+		Payload:     payload,
+		ContentType: contentType,
+	})
+}
+
+// signalDispatcher wakes the dispatcher so new deliveries go out without waiting for the next poll.
+func (ws *webhookSubscriber) signalDispatcher() {
+	select {
+	case ws.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run dispatches the due deliveries once every dispatch interval, or sooner when woken, and purges the
+// expired deliveries once every cleanup interval, until Close is called.
+func (ws *webhookSubscriber) run() {
+	ws.purgeExpiredDeliveries()
+
+	dispatchTicker := time.NewTicker(ws.dispatchInterval)
+	defer dispatchTicker.Stop()
+	cleanupTicker := time.NewTicker(cleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		select {
+		case <-dispatchTicker.C:
+			ws.dispatchDueDeliveries()
+		case <-ws.wake:
+			ws.dispatchDueDeliveries()
+		case <-cleanupTicker.C:
+			ws.purgeExpiredDeliveries()
+		case <-ws.stop:
+			return
+		}
+	}
+}
+
+// dispatchDueDeliveries attempts a batch of the deliveries whose next attempt is due. Each delivery is
+// claimed before it is attempted so that several server nodes can share the outbox.
+func (ws *webhookSubscriber) dispatchDueDeliveries() {
+	// Dispatch runs in the background, outside any request.
+	ctx := context.Background()
+
+	deliveries, err := ws.deliveryStore.getDueDeliveries(ctx, time.Now().UTC(), ws.batchSize)
+	if err != nil {
+		ws.logger.Error(ctx, "Failed to load due webhook deliveries", log.Error(err))
+		return
+	}
+
+	targets := make(map[string]*deliveryTarget)
+	for _, delivery := range deliveries {
+		claimed, err := ws.deliveryStore.claimDelivery(ctx, delivery.ID, delivery.Attempts,
+			time.Now().UTC().Add(2*ws.requestTimeout))
+		if err != nil {
+			ws.logger.Error(ctx, "Failed to claim webhook delivery",
+				log.String("deliveryID", delivery.ID), log.Error(err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		delivery.Attempts++
+
+		attempt := ws.attemptDelivery(ctx, delivery, targets)
+		if err := ws.deliveryStore.recordAttempt(ctx, delivery.ID, attempt); err != nil {
+			ws.logger.Error(ctx, "Failed to record webhook delivery attempt",
+				log.String("deliveryID", delivery.ID), log.Error(err))
+		}
+	}
+}
+
+// attemptDelivery sends a claimed delivery to its webhook and returns the outcome to record.
+func (ws *webhookSubscriber) attemptDelivery(ctx context.Context, delivery Delivery,
+	targets map[string]*deliveryTarget) deliveryAttempt {
+	target, err := ws.resolveTarget(ctx, delivery.WebhookID, targets)
+	if err != nil {
+		return ws.failedAttempt(delivery, time.Now().UTC(), 0, err)
+	}
+
+	attemptedAt := time.Now().UTC()
+	if target == nil {
+		return ws.finalAttempt(DeliveryStatusDeadLetter, attemptedAt, 0, "webhook no longer exists")
+	}
+	if !target.enabled {
+		return ws.finalAttempt(DeliveryStatusDeadLetter, attemptedAt, 0, "webhook is disabled")
+	}
+
+	statusCode, err := ws.send(ctx, delivery, target, attemptedAt)
+	if err != nil {
+		ws.logger.Debug(ctx, "Webhook delivery attempt failed",
+			log.String("deliveryID", delivery.ID),
+			log.Int("attempt", delivery.Attempts),
+			log.Error(err))
+		return ws.failedAttempt(delivery, attemptedAt, statusCode, err)
+	}
+
+	return ws.finalAttempt(DeliveryStatusDelivered, attemptedAt, statusCode, "")
+}
+
+// resolveTarget returns the endpoint of a webhook, or nil when the webhook no longer exists. Endpoints are
+// cached in targets for the rest of the dispatch round.
+func (ws *webhookSubscriber) resolveTarget(ctx context.Context, webhookID string,
+	targets map[string]*deliveryTarget) (*deliveryTarget, error) {
+	if target, ok := targets[webhookID]; ok {
+		return target, nil
+	}
+
+	webhook, err := ws.webhookStore.getWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil {
+		targets[webhookID] = nil
+		return nil, nil
+	}
+
+	secret, err := ws.webhookStore.getSigningSecret(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	target := &deliveryTarget{url: webhook.URL, secret: secret, enabled: webhook.Enabled}
+	targets[webhookID] = target
+	return target, nil
+}
+
+// send POSTs the signed payload of a delivery to its endpoint. It returns the response status code and an
+// error unless the endpoint answered with a 2xx status.
+func (ws *webhookSubscriber) send(ctx context.Context, delivery Delivery, target *deliveryTarget,
+	attemptedAt time.Time) (int, error) {
+	// The delivery ID identifies the message to the endpoint and stays the same across retries, so
+	// receivers can use it to discard duplicates.
+	signature, err := signPayload(target.secret, delivery.ID, attemptedAt, delivery.Payload)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ws.requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.url, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", delivery.ContentType)
+	req.Header.Set(headerWebhookID, delivery.ID)
+	req.Header.Set(headerWebhookTimestamp, fmt.Sprintf("%d", attemptedAt.Unix()))
+	req.Header.Set(headerWebhookSignature, signature)
+
+	resp, err := ws.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("endpoint returned status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// failedAttempt schedules the retry of a failed delivery, or moves it to the dead-letter queue once it has
+// used all its attempts.
+func (ws *webhookSubscriber) failedAttempt(delivery Delivery, attemptedAt time.Time, statusCode int,
+	cause error) deliveryAttempt {
+	if delivery.Attempts >= ws.maxAttempts {
+		return ws.finalAttempt(DeliveryStatusDeadLetter, attemptedAt, statusCode, cause.Error())
+	}
+
+	return deliveryAttempt{
+		status:         DeliveryStatusPending,
+		attemptedAt:    attemptedAt,
+		nextAttemptAt:  attemptedAt.Add(ws.backoff(delivery.Attempts)),
+		responseStatus: statusCode,
+		errorMessage:   truncate(cause.Error(), maxLastErrorLength),
+	}
+}
+
+// finalAttempt builds the outcome of an attempt that leaves the delivery in a final status, starting its
+// retention period.
+func (ws *webhookSubscriber) finalAttempt(status DeliveryStatus, attemptedAt time.Time, statusCode int,
+	errorMessage string) deliveryAttempt {
+	expiryTime := attemptedAt.Add(ws.retention)
+	return deliveryAttempt{
+		status:         status,
+		attemptedAt:    attemptedAt,
+		nextAttemptAt:  attemptedAt,
+		responseStatus: statusCode,
+		errorMessage:   truncate(errorMessage, maxLastErrorLength),
+		expiryTime:     &expiryTime,
+	}
+}
+
+// backoff returns the delay before the retry that follows the given number of attempts. The delay starts
+// at the initial backoff and doubles with every attempt, up to the maximum backoff.
+func (ws *webhookSubscriber) backoff(attempts int) time.Duration {
+	delay := ws.initialBackoff
+	for i := 1; i < attempts && delay < ws.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, ws.maxBackoff)
+}
+
+// purgeExpiredDeliveries removes the delivered and dead-lettered deliveries past their retention period.
+func (ws *webhookSubscriber) purgeExpiredDeliveries() {
+	// Retention cleanup runs in the background, outside any request.
+	ctx := context.Background()
+
+	deleted, err := ws.deliveryStore.deleteExpiredDeliveries(ctx, time.Now().UTC())
+	if err != nil {
+		ws.logger.Error(ctx, "Failed to purge expired webhook deliveries", log.Error(err))
+		return
+	}
+	if deleted > 0 {
+		ws.logger.Debug(ctx, "Purged expired webhook deliveries", log.Any("count", deleted))
+	}
+}
+
+// truncate shortens s to at most maxLength bytes.
+func truncate(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+	return s[:maxLength]
+}
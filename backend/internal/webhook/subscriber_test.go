@@ -0,0 +1,332 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+const testSecret = "whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw"
+
+type WebhookSubscriberTestSuite struct {
+	suite.Suite
+	mockWebhookStore  *webhookStoreInterfaceMock
+	mockDeliveryStore *deliveryStoreInterfaceMock
+	mockHTTPClient    *httpmock.HTTPClientInterfaceMock
+	sub               *webhookSubscriber
+}
+
+func TestWebhookSubscriberTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookSubscriberTestSuite))
+}
+
+func (suite *WebhookSubscriberTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+
+	suite.mockWebhookStore = newWebhookStoreInterfaceMock(suite.T())
+	suite.mockDeliveryStore = newDeliveryStoreInterfaceMock(suite.T())
+	suite.mockDeliveryStore.On("deleteExpiredDeliveries", mock.Anything, mock.Anything).
+		Return(int64(0), nil).Maybe()
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+
+	suite.sub = newWebhookSubscriber()
+	suite.sub.webhookStore = suite.mockWebhookStore
+	suite.sub.deliveryStore = suite.mockDeliveryStore
+	suite.sub.httpClient = suite.mockHTTPClient
+}
+
+func (suite *WebhookSubscriberTestSuite) TearDownTest() {
+	_ = suite.sub.Close()
+	config.ResetServerRuntime()
+}
+
+// configure sets up the subscriber for dispatching without starting the background dispatcher.
+func (suite *WebhookSubscriberTestSuite) configure() {
+	suite.sub.source = "https://localhost:8090"
+	suite.sub.batchSize = defaultBatchSize
+	suite.sub.maxAttempts = 3
+	suite.sub.initialBackoff = 10 * time.Second
+	suite.sub.maxBackoff = time.Minute
+	suite.sub.requestTimeout = defaultRequestTimeout
+	suite.sub.retention = 24 * time.Hour
+	suite.sub.wake = make(chan struct{}, 1)
+	suite.sub.logger = log.GetLogger()
+}
+
+func (suite *WebhookSubscriberTestSuite) TestFactoryRegistered() {
+	assert.NotNil(suite.T(), subscriber.GetFactory("webhook"))
+}
+
+func (suite *WebhookSubscriberTestSuite) TestIsEnabled() {
+	assert.False(suite.T(), suite.sub.IsEnabled())
+
+	config.GetServerRuntime().Config.Observability.Output.Webhook.Enabled = true
+	assert.True(suite.T(), suite.sub.IsEnabled())
+}
+
+func (suite *WebhookSubscriberTestSuite) TestInitialize_Defaults() {
+	suite.Require().NoError(suite.sub.Initialize())
+
+	assert.NotEmpty(suite.T(), suite.sub.GetID())
+	assert.Equal(suite.T(), []event.EventCategory{event.CategoryFlows, event.CategoryAudit},
+		suite.sub.GetCategories())
+	assert.Equal(suite.T(), defaultDispatchInterval, suite.sub.dispatchInterval)
+	assert.Equal(suite.T(), defaultMaxAttempts, suite.sub.maxAttempts)
+	assert.Equal(suite.T(), defaultMaxBackoff, suite.sub.maxBackoff)
+	assert.Equal(suite.T(), 7*24*time.Hour, suite.sub.retention)
+}
+
+func (suite *WebhookSubscriberTestSuite) TestInitialize_FromConfig() {
+	webhookConfig := &config.GetServerRuntime().Config.Observability.Output.Webhook
+	webhookConfig.DispatchInterval = 1
+	webhookConfig.BatchSize = 10
+	webhookConfig.MaxAttempts = 3
+	webhookConfig.InitialBackoff = 30
+	webhookConfig.MaxBackoff = 600
+	webhookConfig.RetentionDays = 1
+
+	suite.Require().NoError(suite.sub.Initialize())
+
+	assert.Equal(suite.T(), time.Second, suite.sub.dispatchInterval)
+	assert.Equal(suite.T(), 10, suite.sub.batchSize)
+	assert.Equal(suite.T(), 3, suite.sub.maxAttempts)
+	assert.Equal(suite.T(), 30*time.Second, suite.sub.initialBackoff)
+	assert.Equal(suite.T(), 10*time.Minute, suite.sub.maxBackoff)
+	assert.Equal(suite.T(), 24*time.Hour, suite.sub.retention)
+}
+
+func (suite *WebhookSubscriberTestSuite) TestOnEvent_QueuesForSubscribedWebhooks() {
+	suite.configure()
+	cloudEventsWebhook := testWebhook()
+	cloudEventsWebhook.ID = "webhook-2"
+	cloudEventsWebhook.Format = FormatCloudEvents
+	otherWebhook := testWebhook()
+	otherWebhook.ID = "webhook-3"
+	otherWebhook.EventTypes = []string{EventTypeUserLogin}
+	suite.mockWebhookStore.On("getEnabledWebhooks", mock.Anything).
+		Return([]Webhook{testWebhook(), cloudEventsWebhook, otherWebhook}, nil)
+
+	var queued []Delivery
+	suite.mockDeliveryStore.On("insertDelivery", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			queued = append(queued, args.Get(1).(Delivery))
+		}).Return(nil)
+
+	err := suite.sub.OnEvent(flowCompletedEvent(providers.FlowTypeRegistration, "user-1"))
+	suite.Require().NoError(err)
+	suite.Require().Len(queued, 2)
+
+	assert.Equal(suite.T(), "webhook-1", queued[0].WebhookID)
+	assert.Equal(suite.T(), "event-1", queued[0].EventID)
+	assert.Equal(suite.T(), EventTypeUserRegistered, queued[0].EventType)
+	assert.Equal(suite.T(), DeliveryStatusPending, queued[0].Status)
+	assert.Equal(suite.T(), contentTypeJSON, queued[0].ContentType)
+	assert.NotEmpty(suite.T(), queued[0].ID)
+
+	assert.Equal(suite.T(), "webhook-2", queued[1].WebhookID)
+	assert.Equal(suite.T(), contentTypeCloudEvents, queued[1].ContentType)
+	assert.Contains(suite.T(), queued[1].Payload, `"source":"https://localhost:8090"`)
+
+	select {
+	case <-suite.sub.wake:
+	default:
+		suite.Fail("dispatcher was not woken for the queued deliveries")
+	}
+}
+
+func (suite *WebhookSubscriberTestSuite) TestOnEvent_IgnoredEvent() {
+	suite.configure()
+
+	assert.NoError(suite.T(), suite.sub.OnEvent(&providers.Event{Type: string(event.EventTypeTokenIssued)}))
+	assert.Error(suite.T(), suite.sub.OnEvent(nil))
+}
+
+func (suite *WebhookSubscriberTestSuite) TestOnEvent_Errors() {
+	suite.configure()
+	suite.mockWebhookStore.On("getEnabledWebhooks", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+	err := suite.sub.OnEvent(flowCompletedEvent(providers.FlowTypeRegistration, "user-1"))
+	assert.ErrorContains(suite.T(), err, "failed to load webhooks")
+
+	suite.mockWebhookStore.On("getEnabledWebhooks", mock.Anything).Return([]Webhook{testWebhook()}, nil).Once()
+	suite.mockDeliveryStore.On("insertDelivery", mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	err = suite.sub.OnEvent(flowCompletedEvent(providers.FlowTypeRegistration, "user-1"))
+	assert.ErrorContains(suite.T(), err, "failed to queue webhook deliveries")
+}
+
+func dueDelivery(attempts int) Delivery {
+	return Delivery{
+		ID:          "delivery-1",
+		WebhookID:   "webhook-1",
+		Status:      DeliveryStatusPending,
+		Attempts:    attempts,
+		Payload:     `{"id":"event-1"}`,
+		ContentType: contentTypeJSON,
+	}
+}
+
+// expectDispatch queues the delivery as due and claimable, and returns a pointer to the recorded attempt.
+func (suite *WebhookSubscriberTestSuite) expectDispatch(delivery Delivery) *deliveryAttempt {
+	var recorded deliveryAttempt
+	suite.mockDeliveryStore.On("getDueDeliveries", mock.Anything, mock.Anything, defaultBatchSize).
+		Return([]Delivery{delivery}, nil)
+	suite.mockDeliveryStore.On("claimDelivery", mock.Anything, delivery.ID, delivery.Attempts, mock.Anything).
+		Return(true, nil)
+	suite.mockDeliveryStore.On("recordAttempt", mock.Anything, delivery.ID, mock.Anything).
+		Run(func(args mock.Arguments) {
+			recorded = args.Get(2).(deliveryAttempt)
+		}).Return(nil)
+	return &recorded
+}
+
+func (suite *WebhookSubscriberTestSuite) expectWebhook(webhook *Webhook) {
+	suite.mockWebhookStore.On("getWebhook", mock.Anything, "webhook-1").Return(webhook, nil)
+	if webhook != nil {
+		suite.mockWebhookStore.On("getSigningSecret", mock.Anything, "webhook-1").Return(testSecret, nil)
+	}
+}
+
+func httpResponse(status int) *http.Response {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func (suite *WebhookSubscriberTestSuite) TestDispatch_Delivered() {
+	suite.configure()
+	webhook := testWebhook()
+	suite.expectWebhook(&webhook)
+	recorded := suite.expectDispatch(dueDelivery(0))
+
+	var sent *http.Request
+	suite.mockHTTPClient.On("Do", mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.Get(0).(*http.Request)
+	}).Return(httpResponse(http.StatusNoContent), nil)
+
+	suite.sub.dispatchDueDeliveries()
+
+	suite.Require().NotNil(sent)
+	assert.Equal(suite.T(), http.MethodPost, sent.Method)
+	assert.Equal(suite.T(), webhook.URL, sent.URL.String())
+	assert.Equal(suite.T(), contentTypeJSON, sent.Header.Get("Content-Type"))
+	assert.Equal(suite.T(), "delivery-1", sent.Header.Get(headerWebhookID))
+
+	timestamp, err := strconv.ParseInt(sent.Header.Get(headerWebhookTimestamp), 10, 64)
+	suite.Require().NoError(err)
+	expected, err := signPayload(testSecret, "delivery-1", time.Unix(timestamp, 0), `{"id":"event-1"}`)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), expected, sent.Header.Get(headerWebhookSignature))
+
+	assert.Equal(suite.T(), DeliveryStatusDelivered, recorded.status)
+	assert.Equal(suite.T(), http.StatusNoContent, recorded.responseStatus)
+	suite.Require().NotNil(recorded.expiryTime)
+	assert.Equal(suite.T(), recorded.attemptedAt.Add(24*time.Hour), *recorded.expiryTime)
+}
+
+func (suite *WebhookSubscriberTestSuite) TestDispatch_RetriesWithBackoff() {
+	suite.configure()
+	webhook := testWebhook()
+	suite.expectWebhook(&webhook)
+	recorded := suite.expectDispatch(dueDelivery(1))
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(httpResponse(http.StatusServiceUnavailable), nil)
+
+	suite.sub.dispatchDueDeliveries()
+
+	assert.Equal(suite.T(), DeliveryStatusPending, recorded.status)
+	assert.Equal(suite.T(), http.StatusServiceUnavailable, recorded.responseStatus)
+	assert.Equal(suite.T(), "endpoint returned status 503", recorded.errorMessage)
+	assert.Equal(suite.T(), recorded.attemptedAt.Add(20*time.Second), recorded.nextAttemptAt)
+	assert.Nil(suite.T(), recorded.expiryTime)
+}
+
+func (suite *WebhookSubscriberTestSuite) TestDispatch_DeadLettersAfterMaxAttempts() {
+	suite.configure()
+	webhook := testWebhook()
+	suite.expectWebhook(&webhook)
+	recorded := suite.expectDispatch(dueDelivery(2))
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("connection refused"))
+
+	suite.sub.dispatchDueDeliveries()
+
+	assert.Equal(suite.T(), DeliveryStatusDeadLetter, recorded.status)
+	assert.Equal(suite.T(), "connection refused", recorded.errorMessage)
+	assert.NotNil(suite.T(), recorded.expiryTime)
+}
+
+func (suite *WebhookSubscriberTestSuite) TestDispatch_DeadLettersForRemovedOrDisabledWebhook() {
+	suite.Run("removed", func() {
+		suite.SetupTest()
+		suite.configure()
+		suite.expectWebhook(nil)
+		recorded := suite.expectDispatch(dueDelivery(0))
+
+		suite.sub.dispatchDueDeliveries()
+
+		assert.Equal(suite.T(), DeliveryStatusDeadLetter, recorded.status)
+		assert.Equal(suite.T(), "webhook no longer exists", recorded.errorMessage)
+	})
+
+	suite.Run("disabled", func() {
+		suite.SetupTest()
+		suite.configure()
+		webhook := testWebhook()
+		webhook.Enabled = false
+		suite.expectWebhook(&webhook)
+		recorded := suite.expectDispatch(dueDelivery(0))
+
+		suite.sub.dispatchDueDeliveries()
+
+		assert.Equal(suite.T(), DeliveryStatusDeadLetter, recorded.status)
+		assert.Equal(suite.T(), "webhook is disabled", recorded.errorMessage)
+	})
+}
+
+func (suite *WebhookSubscriberTestSuite) TestDispatch_SkipsDeliveryClaimedElsewhere() {
+	suite.configure()
+	suite.mockDeliveryStore.On("getDueDeliveries", mock.Anything, mock.Anything, defaultBatchSize).
+		Return([]Delivery{dueDelivery(0)}, nil)
+	suite.mockDeliveryStore.On("claimDelivery", mock.Anything, "delivery-1", 0, mock.Anything).
+		Return(false, nil)
+
+	suite.sub.dispatchDueDeliveries()
+}
+
+func (suite *WebhookSubscriberTestSuite) TestBackoff() {
+	suite.configure()
+
+	assert.Equal(suite.T(), 10*time.Second, suite.sub.backoff(1))
+	assert.Equal(suite.T(), 20*time.Second, suite.sub.backoff(2))
+	assert.Equal(suite.T(), 40*time.Second, suite.sub.backoff(3))
+	assert.Equal(suite.T(), time.Minute, suite.sub.backoff(4))
+	assert.Equal(suite.T(), time.Minute, suite.sub.backoff(100))
+}
+
+func (suite *WebhookSubscriberTestSuite) TestTruncate() {
+	assert.Equal(suite.T(), "abc", truncate("abc", 5))
+	assert.Equal(suite.T(), "ab", truncate("abc", 2))
+}
+
+func (suite *WebhookSubscriberTestSuite) TestClose_Idempotent() {
+	suite.Require().NoError(suite.sub.Initialize())
+
+	assert.NoError(suite.T(), suite.sub.Close())
+	assert.NoError(suite.T(), suite.sub.Close())
+}
@@ -106,6 +106,20 @@ const (
 	OperatorGt Operator = "gt"
 	// OperatorLt represents the less-than operator.
 	OperatorLt Operator = "lt"
+	// OperatorNe represents the not-equal operator.
+	OperatorNe Operator = "ne"
+	// OperatorCo represents the contains operator.
+	OperatorCo Operator = "co"
+	// OperatorSw represents the starts-with operator.
+	OperatorSw Operator = "sw"
+	// OperatorEw represents the ends-with operator.
+	OperatorEw Operator = "ew"
+	// OperatorGe represents the greater-than-or-equal operator.
+	OperatorGe Operator = "ge"
+	// OperatorLe represents the less-than-or-equal operator.
+	OperatorLe Operator = "le"
+	// OperatorPr represents the present operator. It takes no value.
+	OperatorPr Operator = "pr"
 )
 
 // FilterExpression holds a parsed filter expression from an API request.
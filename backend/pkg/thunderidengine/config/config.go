@@ -203,6 +203,28 @@ type AuthorizationRequestConfig struct {
 type DCRConfig struct {
 	Enabled  *bool `yaml:"enabled" json:"enabled"`
 	Insecure bool  `yaml:"insecure" json:"insecure"`
+	// InitialAccessTokens gates open (insecure) registration: when set, a registration request must
+	// present one of these values as a bearer token (RFC 7591 §3).
+	InitialAccessTokens []string `yaml:"initial_access_tokens" json:"initial_access_tokens"`
+	// RegistrationTokenValidity is the lifetime in seconds of the registration access token issued
+	// for managing a registered client (RFC 7592).
+	RegistrationTokenValidity int64 `yaml:"registration_token_validity" json:"registration_token_validity"`
+	// SoftwareStatement configures validation of signed software statements.
+	SoftwareStatement SoftwareStatementConfig `yaml:"software_statement" json:"software_statement"`
+}
+
+// SoftwareStatementConfig holds the software statement (RFC 7591 §2.3) validation configuration.
+type SoftwareStatementConfig struct {
+	// Required rejects registration requests that do not carry a software statement.
+	Required bool `yaml:"required" json:"required"`
+	// TrustAnchors lists the issuers whose software statements are accepted.
+	TrustAnchors []SoftwareStatementTrustAnchor `yaml:"trust_anchors" json:"trust_anchors"`
+}
+
+// SoftwareStatementTrustAnchor is an issuer trusted to sign software statements.
+type SoftwareStatementTrustAnchor struct {
+	Issuer  string `yaml:"issuer"   json:"issuer"`
+	JWKSURI string `yaml:"jwks_uri" json:"jwks_uri"`
 }
 
 // IsEnabled returns whether DCR is enabled, defaulting to false if unset
@@ -226,11 +248,38 @@ type DPoPConfig struct {
 	MaxJTILength int      `yaml:"max_jti_length" json:"max_jti_length"`
 }
 
+// MTLSConfig holds the OAuth 2.0 Mutual-TLS (RFC 8705) configuration.
+type MTLSConfig struct {
+	// Enabled requests a client certificate during the TLS handshake and accepts the
+	// tls_client_auth and self_signed_tls_client_auth client authentication methods.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// TrustedCAFile is a PEM bundle, relative to the server home, of the CAs that issue
+	// certificates for tls_client_auth clients.
+	TrustedCAFile string `yaml:"trusted_ca_file" json:"trusted_ca_file"`
+	// ClientCertificateHeader names the request header in which a TLS-terminating proxy passes the
+	// client certificate (URL-encoded PEM or base64 DER). Empty reads the certificate from the TLS
+	// connection only. The header is honored only on plain connections from TrustedProxies, and the
+	// proxy must strip it from incoming requests.
+	ClientCertificateHeader string `yaml:"client_certificate_header" json:"client_certificate_header"`
+	// TrustedProxies lists the IP addresses or CIDR ranges of the TLS-terminating proxies allowed to
+	// pass the client certificate in ClientCertificateHeader. Required when the header is set.
+	TrustedProxies []string `yaml:"trusted_proxies" json:"trusted_proxies"`
+	// EndpointAliasBaseURL is the base URL of the listener that accepts client certificates when it
+	// differs from the server URL. When set, discovery publishes mtls_endpoint_aliases under it.
+	EndpointAliasBaseURL string `yaml:"endpoint_alias_base_url" json:"endpoint_alias_base_url"`
+}
+
 // CIBAConfig holds the CIBA configuration.
 type CIBAConfig struct {
 	IDTokenHintMaxAgeDays int `yaml:"id_token_hint_max_age_days" json:"id_token_hint_max_age_days"`
 }
 
+// DeviceCodeConfig holds the OAuth 2.0 Device Authorization Grant (RFC 8628) configuration.
+type DeviceCodeConfig struct {
+	ExpiresIn int64 `yaml:"expires_in" json:"expires_in"`
+	Interval  int64 `yaml:"interval"   json:"interval"`
+}
+
 // OAuthConfig holds the OAuth configuration details.
 type OAuthConfig struct {
 	RefreshToken         RefreshTokenConfig         `yaml:"refresh_token"               json:"refresh_token"`
@@ -239,10 +288,13 @@ type OAuthConfig struct {
 	DCR                  DCRConfig                  `yaml:"dcr"                         json:"dcr"`
 	PAR                  PARConfig                  `yaml:"par"                         json:"par"`
 	DPoP                 DPoPConfig                 `yaml:"dpop"                        json:"dpop"`
+	MTLS                 MTLSConfig                 `yaml:"mtls"                        json:"mtls"`
 	AuthClass            AuthClassConfig            `yaml:"auth_class"                  json:"auth_class"`
 	CIBA                 CIBAConfig                 `yaml:"ciba"                        json:"ciba"`
+	DeviceCode           DeviceCodeConfig           `yaml:"device_code"                 json:"device_code"`
 	Revocation           RevocationConfig           `yaml:"revocation"                  json:"revocation"`
 	TokenExchange        TokenExchangeConfig        `yaml:"token_exchange"              json:"token_exchange"`
+	PairwiseSubject      PairwiseSubjectConfig      `yaml:"pairwise_subject"            json:"pairwise_subject"`
 	// AllowWildcardRedirectURI enables wildcard pattern matching for redirect URIs.
 	// When false (default), only exact redirect URI matching is performed.
 	AllowWildcardRedirectURI bool `yaml:"allow_wildcard_redirect_uri" json:"allow_wildcard_redirect_uri"`
@@ -256,6 +308,9 @@ type OAuthConfig struct {
 	// server_error code is reported to the client. Denials (access_denied) are always
 	// reported and are not affected. Nil means unset; the default lives in default.json.
 	SendServerErrorsToClient *bool `yaml:"send_server_errors_to_client" json:"send_server_errors_to_client"`
+	// SecurityProfile is the security profile enforced for every client that does not set its own.
+	// "fapi2" enforces the FAPI 2.0 Security Profile; empty or "none" enforces no profile.
+	SecurityProfile string `yaml:"security_profile" json:"security_profile"`
 
 	TokenRevocation OAuthTokenRevocationConfig `yaml:"token_revocation" json:"token_revocation"`
 	Logout          LogoutConfig               `yaml:"logout" json:"logout"`
@@ -342,6 +397,13 @@ type TokenExchangeConfig struct {
 	TokenFamily string `yaml:"token_family" json:"token_family"`
 }
 
+// PairwiseSubjectConfig holds the settings for OpenID Connect pairwise subject identifiers.
+type PairwiseSubjectConfig struct {
+	// Salt is the secret keying the pairwise subject hash. Changing it changes every pairwise
+	// subject issued to clients. When empty, the crypto encryption key is used.
+	Salt string `yaml:"salt" json:"salt"`
+}
+
 // FlowConfig holds the configuration details for the flow service.
 type FlowConfig struct {
 	MaxVersionHistory     int    `yaml:"max_version_history" json:"max_version_history"`
@@ -383,9 +445,11 @@ type ObservabilityConfig struct {
 
 // ObservabilityOutputConfig holds observability output configuration.
 type ObservabilityOutputConfig struct {
-	File          ObservabilityFileConfig    `yaml:"file"          json:"file"`
-	Console       ObservabilityConsoleConfig `yaml:"console"       json:"console"`
-	OpenTelemetry ObservabilityOTelConfig    `yaml:"opentelemetry" json:"opentelemetry"`
+	File          ObservabilityFileConfig     `yaml:"file"          json:"file"`
+	Console       ObservabilityConsoleConfig  `yaml:"console"       json:"console"`
+	OpenTelemetry ObservabilityOTelConfig     `yaml:"opentelemetry" json:"opentelemetry"`
+	Database      ObservabilityDatabaseConfig `yaml:"database"      json:"database"`
+	Webhook       ObservabilityWebhookConfig  `yaml:"webhook"       json:"webhook"`
 }
 
 // ObservabilityFileConfig captures file sink settings for observability events.
@@ -405,6 +469,38 @@ type ObservabilityConsoleConfig struct {
 	Categories []string `yaml:"categories" json:"categories"`
 }
 
+// ObservabilityDatabaseConfig captures settings for persisting observability events to the runtime
+// persistent database, where they can be searched through the audit events API.
+type ObservabilityDatabaseConfig struct {
+	Enabled bool `yaml:"enabled"          json:"enabled"`
+	// RetentionDays is the number of days a stored event is kept before it is purged.
+	RetentionDays int `yaml:"retention_days"   json:"retention_days"`
+	// CleanupInterval is the interval, in seconds, between purges of expired events.
+	CleanupInterval int      `yaml:"cleanup_interval" json:"cleanup_interval"`
+	Categories      []string `yaml:"categories"       json:"categories"`
+}
+
+// ObservabilityWebhookConfig captures settings for delivering observability events to the webhook
+// endpoints registered through the webhook management API. Deliveries are queued in the runtime
+// persistent database and retried with exponential backoff.
+type ObservabilityWebhookConfig struct {
+	Enabled bool `yaml:"enabled"           json:"enabled"`
+	// DispatchInterval is the interval, in seconds, between polls of the delivery queue.
+	DispatchInterval int `yaml:"dispatch_interval" json:"dispatch_interval"`
+	// BatchSize is the maximum number of deliveries attempted in one poll.
+	BatchSize int `yaml:"batch_size"        json:"batch_size"`
+	// MaxAttempts is the number of attempts after which a delivery is moved to the dead-letter queue.
+	MaxAttempts int `yaml:"max_attempts"      json:"max_attempts"`
+	// InitialBackoff is the delay, in seconds, before the first retry. It doubles on every retry.
+	InitialBackoff int `yaml:"initial_backoff"   json:"initial_backoff"`
+	// MaxBackoff is the upper bound, in seconds, of the delay between retries.
+	MaxBackoff int `yaml:"max_backoff"       json:"max_backoff"`
+	// RequestTimeout is the timeout, in seconds, of a single delivery request.
+	RequestTimeout int `yaml:"request_timeout"   json:"request_timeout"`
+	// RetentionDays is the number of days a delivered or dead-lettered delivery is kept before it is purged.
+	RetentionDays int `yaml:"retention_days"    json:"retention_days"`
+}
+
 // ObservabilityOTelConfig holds OpenTelemetry configuration.
 type ObservabilityOTelConfig struct {
 	Enabled        bool     `yaml:"enabled"         json:"enabled"`
@@ -26,6 +26,7 @@ import (
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/runtimestore"
 	"github.com/thunder-id/thunderid/internal/system/cache"
@@ -183,7 +184,7 @@ func New(mux *http.ServeMux, opts ...Option) *Engine {
 	tokenFamilyRevocationTTL := time.Duration(engineCtx.oauthConfig.RefreshToken.ValidityPeriod) * time.Second
 	revocationEnforcer, revocationService := revocation.Initialize(engineCtx.jwtService,
 		engineCtx.observabilitySvc, tokenFamilyRevocationTTL,
-		engineCtx.oauthConfig.Revocation.TokenFamily.OnExplicitRevokeEnabled())
+		engineCtx.oauthConfig.Revocation.TokenFamily.OnExplicitRevokeEnabled(), nil)
 
 	// CORS origins come from the engine's static OriginConfig; unlike the full server there is no
 	// server-config store to back a dynamic matcher. An empty config leaves CORS disabled (matcher
@@ -200,11 +201,16 @@ func New(mux *http.ServeMux, opts ...Option) *Engine {
 	// resource provider is passed undecorated. Implicit no-resource requests that carry permission
 	// scopes are rejected (the provider resolves no server for an empty identifier); OIDC-only or
 	// scopeless requests do not need resource-server binding.
+	pairwiseSubjectSvc, err := pairwise.Initialize(oauthConfig)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize pairwise subject service", log.Error(err))
+	}
 	_, err = oauth.Initialize(mux, engineCtx.actorProvider, authnProviderManager, engineCtx.jwtService,
 		engineCtx.jweService, engineCtx.flowExecService, engineCtx.observabilitySvc, engineCtx.runtimeCryptoSvc,
 		engineCtx.ouProvider, engineCtx.attributeCacheService, engineCtx.authzProvider, engineCtx.resourceProvider,
 		engineCtx.i18nProvider, engineCtx.idpProvider, engineCtx.dpopVerifier, engineCtx.runtimeStoreProvider,
-		engineCtx.transactioner, revocationEnforcer, revocationService, oauthConfig)
+		engineCtx.transactioner, revocationEnforcer, revocationService, nil, nil, pairwiseSubjectSvc,
+		oauthConfig)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize OAuth services", log.Error(err))
 	}
@@ -21,6 +21,8 @@ const (
 	IDPTypeGoogle IDPType = "GOOGLE"
 	// IDPTypeGitHub represents a GitHub identity provider.
 	IDPTypeGitHub IDPType = "GITHUB"
+	// IDPTypeSAML represents a SAML 2.0 identity provider.
+	IDPTypeSAML IDPType = "SAML"
 )
 
 // SupportedIDPTypes lists all the supported identity provider types.
@@ -29,6 +31,7 @@ var SupportedIDPTypes = []IDPType{
 	IDPTypeOIDC,
 	IDPTypeGoogle,
 	IDPTypeGitHub,
+	IDPTypeSAML,
 }
 
 // FlowType defines the type of flow execution.
@@ -134,6 +137,8 @@ const (
 	// GrantTypeJWTBearer represents the JWT bearer grant type used to present an ID-JAG assertion
 	// (draft-ietf-oauth-identity-assertion-authz-grant) issued by a trusted external IdP.
 	GrantTypeJWTBearer GrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer" //nolint:gosec
+	// GrantTypeDeviceCode represents the OAuth 2.0 device authorization grant type (RFC 8628).
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 )
 
 // DefaultIDJAGValidityPeriod is the default validity period, in seconds, of an issued ID-JAG when the
@@ -158,9 +163,17 @@ const (
 	TokenEndpointAuthMethodClientSecretBasic TokenEndpointAuthMethod = "client_secret_basic"
 	// TokenEndpointAuthMethodClientSecretPost represents the client secret post authentication method.
 	TokenEndpointAuthMethodClientSecretPost TokenEndpointAuthMethod = "client_secret_post"
+	// TokenEndpointAuthMethodClientSecretJWT represents the HMAC-signed client assertion authentication method.
+	// #nosec G101 - This is not a hardcoded credential, but a constant representing an authentication method.
+	TokenEndpointAuthMethodClientSecretJWT TokenEndpointAuthMethod = "client_secret_jwt"
 	// TokenEndpointAuthMethodPrivateKeyJWT represents the private key JWT authentication method.
 	// #nosec G101 - This is not a hardcoded credential, but a constant representing an authentication method.
 	TokenEndpointAuthMethodPrivateKeyJWT TokenEndpointAuthMethod = "private_key_jwt"
+	// TokenEndpointAuthMethodTLSClientAuth represents PKI mutual-TLS client authentication (RFC 8705).
+	TokenEndpointAuthMethodTLSClientAuth TokenEndpointAuthMethod = "tls_client_auth"
+	// TokenEndpointAuthMethodSelfSignedTLSClientAuth represents self-signed certificate mutual-TLS client
+	// authentication (RFC 8705).
+	TokenEndpointAuthMethodSelfSignedTLSClientAuth TokenEndpointAuthMethod = "self_signed_tls_client_auth"
 	// TokenEndpointAuthMethodNone represents no authentication method.
 	TokenEndpointAuthMethodNone TokenEndpointAuthMethod = "none"
 )
@@ -173,6 +186,7 @@ var SupportedGrantTypes = []GrantType{
 	GrantTypeTokenExchange,
 	GrantTypeCIBA,
 	GrantTypeJWTBearer,
+	GrantTypeDeviceCode,
 }
 
 // IsValid checks if the GrantType is valid.
@@ -189,6 +203,7 @@ func (gt GrantType) IsValid() bool {
 var refreshTokenIssuingGrantTypes = []GrantType{
 	GrantTypeAuthorizationCode,
 	GrantTypeCIBA,
+	GrantTypeDeviceCode,
 }
 
 // IssuesRefreshToken reports whether this grant type can issue a refresh token.
@@ -222,7 +237,10 @@ func (rt ResponseType) IsValid() bool {
 var SupportedTokenEndpointAuthMethods = []TokenEndpointAuthMethod{
 	TokenEndpointAuthMethodClientSecretBasic,
 	TokenEndpointAuthMethodClientSecretPost,
+	TokenEndpointAuthMethodClientSecretJWT,
 	TokenEndpointAuthMethodPrivateKeyJWT,
+	TokenEndpointAuthMethodTLSClientAuth,
+	TokenEndpointAuthMethodSelfSignedTLSClientAuth,
 	TokenEndpointAuthMethodNone,
 }
 
@@ -236,6 +254,36 @@ func (tam TokenEndpointAuthMethod) IsValid() bool {
 	return false
 }
 
+// SecurityProfile defines a type for the security profiles an OAuth client can be held to.
+type SecurityProfile string
+
+const (
+	// SecurityProfileNone applies no security profile beyond the client's own settings.
+	SecurityProfileNone SecurityProfile = "none"
+	// SecurityProfileFAPI2 enforces the FAPI 2.0 Security Profile.
+	SecurityProfileFAPI2 SecurityProfile = "fapi2"
+)
+
+// IsValid checks if the SecurityProfile is valid.
+func (sp SecurityProfile) IsValid() bool {
+	return sp == SecurityProfileNone || sp == SecurityProfileFAPI2
+}
+
+// SubjectType defines a type for the OpenID Connect subject identifier types of a client.
+type SubjectType string
+
+const (
+	// SubjectTypePublic gives every client the same subject identifier for a user.
+	SubjectTypePublic SubjectType = "public"
+	// SubjectTypePairwise gives each sector of clients a different subject identifier for a user.
+	SubjectTypePairwise SubjectType = "pairwise"
+)
+
+// IsValid checks if the SubjectType is valid.
+func (st SubjectType) IsValid() bool {
+	return st == SubjectTypePublic || st == SubjectTypePairwise
+}
+
 // EntityCategory represents the category of an entity (e.g., user, application, agent).
 type EntityCategory string
 
@@ -352,6 +400,9 @@ const (
 	// NamespacePermission represents the permission consent namespace.
 	// Used for managing consent over resource action permissions (e.g. booking:reservations:read).
 	NamespacePermission Namespace = "permission"
+	// NamespaceAuthorizationDetail represents the authorization detail consent namespace.
+	// Used for managing consent over RFC 9396 authorization details, named by their canonical JSON form.
+	NamespaceAuthorizationDetail Namespace = "authorization_detail"
 )
 
 // ConsentAuthorizationStatus defines the possible statuses for a consent authorization record.
@@ -484,6 +535,17 @@ type InboundAuthType string
 const (
 	// OAuthInboundAuthType is the OAuth 2.0 inbound authentication type.
 	OAuthInboundAuthType InboundAuthType = "oauth2"
+	// SAMLInboundAuthType is the SAML 2.0 inbound authentication type. It also identifies SAML SSO
+	// requests on the flow callback.
+	SAMLInboundAuthType InboundAuthType = "saml2"
+)
+
+// SAML 2.0 NameID formats accepted on a SAMLProfile.
+const (
+	SAMLNameIDFormatUnspecified = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	SAMLNameIDFormatEmail       = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	SAMLNameIDFormatPersistent  = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+	SAMLNameIDFormatTransient   = "urn:oasis:names:tc:SAML:2.0:nameid-format:transient"
 )
 
 // FlowStatus defines the status of a flow execution.
@@ -526,14 +588,23 @@ const (
 	NamespaceFlow           RuntimeStoreNamespace = "flow:state"
 	NamespaceAuthzCode      RuntimeStoreNamespace = "authz:code"
 	NamespaceAuthzReq       RuntimeStoreNamespace = "authz:req"
+	NamespaceAuthzResp      RuntimeStoreNamespace = "authz:resp"
 	NamespaceLogoutReq      RuntimeStoreNamespace = "logout:req"
+	NamespaceFrontchannel   RuntimeStoreNamespace = "logout:frontchannel"
 	NamespacePAR            RuntimeStoreNamespace = "par:req"
 	NamespaceCIBA           RuntimeStoreNamespace = "ciba:req"
+	NamespaceDeviceCode     RuntimeStoreNamespace = "device:code"
+	NamespaceDeviceUserCode RuntimeStoreNamespace = "device:usercode"
+	NamespaceSAMLReq        RuntimeStoreNamespace = "saml:req"
+	NamespaceSAMLResp       RuntimeStoreNamespace = "saml:resp"
+	NamespaceSAMLACS        RuntimeStoreNamespace = "saml:acs"
 	NamespaceJTI            RuntimeStoreNamespace = "jti:token"
 	NamespaceVCINonce       RuntimeStoreNamespace = "vci:nonce"
 	NamespaceVCIOffer       RuntimeStoreNamespace = "vci:offer"
 	NamespaceVPState        RuntimeStoreNamespace = "vp:state"
 	NamespaceWebAuthn       RuntimeStoreNamespace = "webauthn:session"
+	NamespaceLockout        RuntimeStoreNamespace = "lockout:attempts"
+	NamespaceTOTPLock       RuntimeStoreNamespace = "totp:lock"
 )
 
 // Error constants
@@ -30,6 +30,7 @@ func (suite *ConstantsTestSuite) TestGrantType_IsValid() {
 		GrantTypeRefreshToken,
 		GrantTypeTokenExchange,
 		GrantTypeCIBA,
+		GrantTypeDeviceCode,
 	}
 	for _, gt := range valid {
 		assert.True(suite.T(), gt.IsValid(), "expected %q to be valid", gt)
@@ -41,6 +42,7 @@ func (suite *ConstantsTestSuite) TestGrantType_IsValid() {
 func (suite *ConstantsTestSuite) TestGrantType_IssuesRefreshToken() {
 	assert.True(suite.T(), GrantTypeAuthorizationCode.IssuesRefreshToken())
 	assert.True(suite.T(), GrantTypeCIBA.IssuesRefreshToken())
+	assert.True(suite.T(), GrantTypeDeviceCode.IssuesRefreshToken())
 	assert.False(suite.T(), GrantTypeClientCredentials.IssuesRefreshToken())
 	assert.False(suite.T(), GrantTypeRefreshToken.IssuesRefreshToken())
 	assert.False(suite.T(), GrantTypeTokenExchange.IssuesRefreshToken())
@@ -65,13 +67,16 @@ func (suite *ConstantsTestSuite) TestTokenEndpointAuthMethod_IsValid() {
 	valid := []TokenEndpointAuthMethod{
 		TokenEndpointAuthMethodClientSecretBasic,
 		TokenEndpointAuthMethodClientSecretPost,
+		TokenEndpointAuthMethodClientSecretJWT,
 		TokenEndpointAuthMethodPrivateKeyJWT,
+		TokenEndpointAuthMethodTLSClientAuth,
+		TokenEndpointAuthMethodSelfSignedTLSClientAuth,
 		TokenEndpointAuthMethodNone,
 	}
 	for _, m := range valid {
 		assert.True(suite.T(), m.IsValid(), "expected %q to be valid", m)
 	}
-	assert.False(suite.T(), TokenEndpointAuthMethod("tls_client_auth").IsValid())
+	assert.False(suite.T(), TokenEndpointAuthMethod("client_secret_sha256").IsValid())
 	assert.False(suite.T(), TokenEndpointAuthMethod("").IsValid())
 }
 
@@ -137,9 +137,11 @@ type ConsentProvider interface {
 	// application, attribute set, and authorized permission set. Returns nil if all required
 	// consents are active; otherwise returns ConsentPromptData describing which purposes /
 	// elements still need user consent. When forceReprompt is true, consent is re-prompted for
-	// all required claims regardless of existing active consent.
+	// all required claims regardless of existing active consent. authorizationDetails holds the
+	// canonical JSON form of the RFC 9396 authorization details requested by the client; each of
+	// them must be consented to for the flow to proceed.
 	ResolveConsent(ctx context.Context, ouID, appID, appName, userID string,
-		essentialAttributes, optionalAttributes, authorizedPermissions []string,
+		essentialAttributes, optionalAttributes, authorizedPermissions, authorizationDetails []string,
 		availableAttributes *AttributesResponse, forceReprompt bool,
 		runtimeMetadata map[string][]string) (
 		*ConsentPromptData, *common.ServiceError)
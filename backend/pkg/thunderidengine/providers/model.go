@@ -201,6 +201,17 @@ type ResourceServer struct {
 	Delimiter   string             `yaml:"delimiter,omitempty"   json:"delimiter,omitempty"   yamlfmt:"quoted"`
 	IsReadOnly  bool               `yaml:"-"                     json:"-"`
 	Resources   []Resource         `yaml:"resources,omitempty"   json:"resources,omitempty"`
+
+	// AuthorizationDetailTypes lists the RFC 9396 authorization details types this resource server accepts.
+	AuthorizationDetailTypes []AuthorizationDetailType `yaml:"authorizationDetailTypes,omitempty" json:"authorizationDetailTypes,omitempty"`
+}
+
+// AuthorizationDetailType registers an RFC 9396 authorization details type on a resource server.
+// Schema, when set, is a JSON schema that every requested authorization detail of the type must satisfy.
+type AuthorizationDetailType struct {
+	Type        string                 `yaml:"type"                  json:"type"`
+	Description string                 `yaml:"description,omitempty" json:"description,omitempty"`
+	Schema      map[string]interface{} `yaml:"schema,omitempty"      json:"schema,omitempty"`
 }
 
 // CompleteFlowDefinition represents a complete flow definition with all details.
@@ -538,26 +549,44 @@ func (a *AuthUser) UnmarshalJSON(b []byte) error {
 
 // OAuthClient is the resolved runtime view.
 type OAuthClient struct {
-	ID                                 string                  `yaml:"id,omitempty"`
-	OUID                               string                  `yaml:"ouId,omitempty"`
-	ClientID                           string                  `yaml:"clientId,omitempty"`
-	RedirectURIs                       []string                `yaml:"redirectUris,omitempty"`
-	PostLogoutRedirectURIs             []string                `yaml:"postLogoutRedirectUris,omitempty"`
-	GrantTypes                         []GrantType             `yaml:"grantTypes,omitempty"`
-	ResponseTypes                      []ResponseType          `yaml:"responseTypes,omitempty"`
-	TokenEndpointAuthMethod            TokenEndpointAuthMethod `yaml:"tokenEndpointAuthMethod,omitempty"`
-	PKCERequired                       bool                    `yaml:"pkceRequired,omitempty"`
-	PublicClient                       bool                    `yaml:"publicClient,omitempty"`
-	RequirePushedAuthorizationRequests bool                    `yaml:"requirePushedAuthorizationRequests,omitempty"`
-	DPoPBoundAccessTokens              bool                    `yaml:"dpopBoundAccessTokens,omitempty"`
-	IncludeActClaim                    bool                    `yaml:"includeActClaim,omitempty"`
-	EntityCategory                     EntityCategory          `yaml:"entityCategory,omitempty"`
-	Token                              *OAuthTokenConfig       `yaml:"token,omitempty"`
-	Scopes                             []string                `yaml:"scopes,omitempty"`
-	UserInfo                           *UserInfoConfig         `yaml:"userInfo,omitempty"`
-	ScopeClaims                        map[string][]string     `yaml:"scopeClaims,omitempty"`
-	Certificate                        *Certificate            `yaml:"certificate,omitempty"`
-	AcrValues                          []string                `yaml:"acrValues,omitempty"`
+	ID                                 string                       `yaml:"id,omitempty"`
+	OUID                               string                       `yaml:"ouId,omitempty"`
+	ClientID                           string                       `yaml:"clientId,omitempty"`
+	RedirectURIs                       []string                     `yaml:"redirectUris,omitempty"`
+	PostLogoutRedirectURIs             []string                     `yaml:"postLogoutRedirectUris,omitempty"`
+	BackchannelLogoutURI               string                       `yaml:"backchannelLogoutUri,omitempty"`
+	BackchannelLogoutSessionRequired   bool                         `yaml:"backchannelLogoutSessionRequired,omitempty"`
+	FrontchannelLogoutURI              string                       `yaml:"frontchannelLogoutUri,omitempty"`
+	FrontchannelLogoutSessionRequired  bool                         `yaml:"frontchannelLogoutSessionRequired,omitempty"`
+	GrantTypes                         []GrantType                  `yaml:"grantTypes,omitempty"`
+	ResponseTypes                      []ResponseType               `yaml:"responseTypes,omitempty"`
+	TokenEndpointAuthMethod            TokenEndpointAuthMethod      `yaml:"tokenEndpointAuthMethod,omitempty"`
+	PKCERequired                       bool                         `yaml:"pkceRequired,omitempty"`
+	PublicClient                       bool                         `yaml:"publicClient,omitempty"`
+	RequirePushedAuthorizationRequests bool                         `yaml:"requirePushedAuthorizationRequests,omitempty"`
+	RequireSignedRequestObject         bool                         `yaml:"requireSignedRequestObject,omitempty"`
+	DPoPBoundAccessTokens              bool                         `yaml:"dpopBoundAccessTokens,omitempty"`
+	MTLSBoundAccessTokens              bool                         `yaml:"tlsClientCertificateBoundAccessTokens,omitempty"`
+	SecurityProfile                    SecurityProfile              `yaml:"securityProfile,omitempty"`
+	SubjectType                        SubjectType                  `yaml:"subjectType,omitempty"`
+	SectorIdentifierURI                string                       `yaml:"sectorIdentifierUri,omitempty"`
+	IncludeActClaim                    bool                         `yaml:"includeActClaim,omitempty"`
+	EntityCategory                     EntityCategory               `yaml:"entityCategory,omitempty"`
+	Token                              *OAuthTokenConfig            `yaml:"token,omitempty"`
+	Scopes                             []string                     `yaml:"scopes,omitempty"`
+	UserInfo                           *UserInfoConfig              `yaml:"userInfo,omitempty"`
+	AuthorizationResponse              *AuthorizationResponseConfig `yaml:"authorizationResponse,omitempty"`
+	TLSClientAuth                      *TLSClientAuthConfig         `yaml:"tlsClientAuth,omitempty"`
+	ScopeClaims                        map[string][]string          `yaml:"scopeClaims,omitempty"`
+	Certificate                        *Certificate                 `yaml:"certificate,omitempty"`
+	AcrValues                          []string                     `yaml:"acrValues,omitempty"`
+
+	// ClientSecret is the decrypted client secret of a client_secret_jwt client, used as the HMAC key
+	// for its client assertions. It is empty for every other authentication method.
+	ClientSecret string `json:"-" yaml:"-"`
+	// RegistrationTokenHash is the hash of the registration access token currently valid for a
+	// dynamically registered client. It is empty for every other client.
+	RegistrationTokenHash string `json:"-" yaml:"-"`
 }
 
 // OAuthTokenConfig wraps access and ID token configs.
@@ -624,6 +653,23 @@ type UserInfoConfig struct {
 	EncryptionEnc  string               `json:"encryptionEnc,omitempty"  yaml:"encryptionEnc,omitempty"  jsonschema:"JWE content-encryption algorithm (e.g. A256GCM). Required when encryptionAlg is set."`
 }
 
+// AuthorizationResponseConfig is the JWT Secured Authorization Response Mode (JARM) configuration.
+type AuthorizationResponseConfig struct {
+	SigningAlg    string `json:"signingAlg,omitempty"    yaml:"signingAlg,omitempty"    jsonschema:"JWS algorithm for signed authorization responses (e.g. RS256). Defaults to the server's signing algorithm."`
+	EncryptionAlg string `json:"encryptionAlg,omitempty" yaml:"encryptionAlg,omitempty" jsonschema:"JWE key-management algorithm for encrypted authorization responses (e.g. RSA-OAEP-256)."`
+	EncryptionEnc string `json:"encryptionEnc,omitempty" yaml:"encryptionEnc,omitempty" jsonschema:"JWE content-encryption algorithm (e.g. A256GCM). Required when encryptionAlg is set."`
+}
+
+// TLSClientAuthConfig identifies the certificate a tls_client_auth client authenticates with (RFC 8705
+// Section 2.1.2). Exactly one of the fields must be set.
+type TLSClientAuthConfig struct {
+	SubjectDN string `json:"subjectDn,omitempty" yaml:"subjectDn,omitempty" jsonschema:"Expected subject distinguished name of the client certificate (RFC 4514 string representation)."`
+	SANDNS    string `json:"sanDns,omitempty"    yaml:"sanDns,omitempty"    jsonschema:"Expected dNSName SAN entry of the client certificate."`
+	SANURI    string `json:"sanUri,omitempty"    yaml:"sanUri,omitempty"    jsonschema:"Expected uniformResourceIdentifier SAN entry of the client certificate."`
+	SANIP     string `json:"sanIp,omitempty"     yaml:"sanIp,omitempty"     jsonschema:"Expected iPAddress SAN entry of the client certificate."`
+	SANEmail  string `json:"sanEmail,omitempty"  yaml:"sanEmail,omitempty"  jsonschema:"Expected rfc822Name SAN entry of the client certificate."`
+}
+
 // Certificate is a user-supplied certificate input.
 type Certificate struct {
 	Type  CertificateType `json:"type,omitempty"  yaml:"type,omitempty"  jsonschema:"Certificate type (PEM, JWK, etc.)."`
@@ -673,22 +719,54 @@ func (c *AttestationConfig) WithoutCredentials() *AttestationConfig {
 
 // OAuthProfile is the persistence shape (OAUTH_PROFILE JSONB column).
 type OAuthProfile struct {
-	RedirectURIs                       []string            `json:"redirectUris"`
-	PostLogoutRedirectURIs             []string            `json:"postLogoutRedirectUris,omitempty"`
-	GrantTypes                         []string            `json:"grantTypes"`
-	ResponseTypes                      []string            `json:"responseTypes"`
-	TokenEndpointAuthMethod            string              `json:"tokenEndpointAuthMethod"`
-	PKCERequired                       bool                `json:"pkceRequired"`
-	PublicClient                       bool                `json:"publicClient"`
-	RequirePushedAuthorizationRequests bool                `json:"requirePushedAuthorizationRequests"`
-	DPoPBoundAccessTokens              bool                `json:"dpopBoundAccessTokens"`
-	IncludeActClaim                    bool                `json:"includeActClaim"`
-	Token                              *OAuthTokenConfig   `json:"token,omitempty"`
-	Scopes                             []string            `json:"scopes,omitempty"`
-	UserInfo                           *UserInfoConfig     `json:"userInfo,omitempty"`
-	ScopeClaims                        map[string][]string `json:"scopeClaims,omitempty"`
-	Certificate                        *Certificate        `json:"certificate,omitempty"`
-	AcrValues                          []string            `json:"acrValues,omitempty"`
+	RedirectURIs                       []string                     `json:"redirectUris"`
+	PostLogoutRedirectURIs             []string                     `json:"postLogoutRedirectUris,omitempty"`
+	BackchannelLogoutURI               string                       `json:"backchannelLogoutUri,omitempty"`
+	BackchannelLogoutSessionRequired   bool                         `json:"backchannelLogoutSessionRequired,omitempty"`
+	FrontchannelLogoutURI              string                       `json:"frontchannelLogoutUri,omitempty"`
+	FrontchannelLogoutSessionRequired  bool                         `json:"frontchannelLogoutSessionRequired,omitempty"`
+	GrantTypes                         []string                     `json:"grantTypes"`
+	ResponseTypes                      []string                     `json:"responseTypes"`
+	TokenEndpointAuthMethod            string                       `json:"tokenEndpointAuthMethod"`
+	PKCERequired                       bool                         `json:"pkceRequired"`
+	PublicClient                       bool                         `json:"publicClient"`
+	RequirePushedAuthorizationRequests bool                         `json:"requirePushedAuthorizationRequests"`
+	RequireSignedRequestObject         bool                         `json:"requireSignedRequestObject"`
+	DPoPBoundAccessTokens              bool                         `json:"dpopBoundAccessTokens"`
+	MTLSBoundAccessTokens              bool                         `json:"tlsClientCertificateBoundAccessTokens"`
+	SecurityProfile                    string                       `json:"securityProfile,omitempty"`
+	SubjectType                        string                       `json:"subjectType,omitempty"`
+	SectorIdentifierURI                string                       `json:"sectorIdentifierUri,omitempty"`
+	IncludeActClaim                    bool                         `json:"includeActClaim"`
+	Token                              *OAuthTokenConfig            `json:"token,omitempty"`
+	Scopes                             []string                     `json:"scopes,omitempty"`
+	UserInfo                           *UserInfoConfig              `json:"userInfo,omitempty"`
+	AuthorizationResponse              *AuthorizationResponseConfig `json:"authorizationResponse,omitempty"`
+	TLSClientAuth                      *TLSClientAuthConfig         `json:"tlsClientAuth,omitempty"`
+	ScopeClaims                        map[string][]string          `json:"scopeClaims,omitempty"`
+	Certificate                        *Certificate                 `json:"certificate,omitempty"`
+	AcrValues                          []string                     `json:"acrValues,omitempty"`
+
+	// EncryptedClientSecret holds the client secret of a client_secret_jwt client, encrypted at rest.
+	// Verifying HMAC client assertions needs the secret itself rather than its hash. Never returned.
+	EncryptedClientSecret string `json:"encryptedClientSecret,omitempty"`
+	// RegistrationTokenHash holds the SHA-256 hash of the registration access token last issued to a
+	// dynamically registered client (RFC 7592). Only that token is accepted at the client configuration
+	// endpoint, so issuing a new one revokes the previous one. Never returned.
+	RegistrationTokenHash string `json:"registrationTokenHash,omitempty"`
+}
+
+// SAMLProfile is the SAML 2.0 service provider registration of an inbound client. It is both the
+// wire shape and the persistence shape (SAML_INBOUND_PROFILE SAML_CONFIG column); it carries no
+// secrets.
+type SAMLProfile struct {
+	EntityID                     string   `json:"entityId"                      yaml:"entityId"                      jsonschema:"SAML entity ID of the service provider. Matched against the Issuer of incoming AuthnRequests and used as the assertion audience."`
+	AssertionConsumerServiceURLs []string `json:"assertionConsumerServiceUrls"  yaml:"assertionConsumerServiceUrls"  jsonschema:"Registered Assertion Consumer Service URLs. The first entry is used when an AuthnRequest does not name one."`
+	NameIDFormat                 string   `json:"nameIdFormat,omitempty"        yaml:"nameIdFormat,omitempty"        jsonschema:"NameID format of the issued subject. Defaults to urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified."`
+	NameIDAttribute              string   `json:"nameIdAttribute,omitempty"     yaml:"nameIdAttribute,omitempty"     jsonschema:"User attribute used as the NameID value. Defaults to the subject identifier."`
+	Certificate                  string   `json:"certificate,omitempty"         yaml:"certificate,omitempty"         jsonschema:"PEM-encoded X.509 certificate of the service provider, used to verify signed AuthnRequests."`
+	RequireSignedAuthnRequests   bool     `json:"requireSignedAuthnRequests"    yaml:"requireSignedAuthnRequests"    jsonschema:"Reject AuthnRequests that are not signed with the registered certificate."`
+	SignResponse                 bool     `json:"signResponse"                  yaml:"signResponse"                  jsonschema:"Sign the Response element in addition to the assertion."`
 }
 
 // InboundClient is the persistence shape for protocol-agnostic inbound client record.
@@ -856,7 +934,7 @@ type ConsentPurposePrompt struct {
 // PromptElement represents a single element within a consent purpose prompt. Parent carries
 // rollup linkage for permission elements (zero value, omitted on the wire, for attribute elements).
 type PromptElement struct {
-	// Name is the canonical element name (attribute name or permission string)
+	// Name is the canonical element name (attribute name, permission string or authorization detail JSON)
 	Name string `json:"name"`
 	// Parent is the canonical name of the rollup parent, if any
 	Parent string `json:"parent,omitempty"`
@@ -1042,30 +1120,46 @@ type InboundAuthProfile struct {
 // OAuthConfigWithSecret is the wire input shape and the create/update echo response shape.
 // Carries ClientSecret (omitempty) so it appears only when freshly issued.
 type OAuthConfigWithSecret struct {
-	ClientID                           string                  `json:"clientId,omitempty"                 yaml:"clientId,omitempty"                 jsonschema:"OAuth client ID (auto-generated if not provided)"`
-	ClientSecret                       string                  `json:"clientSecret,omitempty"             yaml:"clientSecret,omitempty"             jsonschema:"OAuth client secret (auto-generated if not provided)"`
-	RedirectURIs                       []string                `json:"redirectUris,omitempty"             yaml:"redirectUris,omitempty"             jsonschema:"Allowed redirect URIs. Required for Public (SPA/Mobile) and Confidential (Server) clients. Omit for M2M."`
-	PostLogoutRedirectURIs             []string                `json:"postLogoutRedirectUris,omitempty"   yaml:"postLogoutRedirectUris,omitempty"   jsonschema:"Allowed post-logout redirect URIs. Optional. A post_logout_redirect_uri supplied to the logout endpoint must match one of these."`
-	GrantTypes                         []GrantType             `json:"grantTypes,omitempty"               yaml:"grantTypes,omitempty"               jsonschema:"OAuth grant types. Common: [authorization_code, refresh_token] for user apps, [client_credentials] for M2M."`
-	ResponseTypes                      []ResponseType          `json:"responseTypes,omitempty"            yaml:"responseTypes,omitempty"            jsonschema:"OAuth response types. Common: [code] for user apps. Omit for M2M."`
-	TokenEndpointAuthMethod            TokenEndpointAuthMethod `json:"tokenEndpointAuthMethod,omitempty"  yaml:"tokenEndpointAuthMethod,omitempty"  jsonschema:"Client authentication method. Use 'none' for Public clients, 'client_secret_basic' for Confidential/M2M."`
-	PKCERequired                       bool                    `json:"pkceRequired"                       yaml:"pkceRequired"                       jsonschema:"Require PKCE for security. Recommended for all user-interactive flows."`
-	PublicClient                       bool                    `json:"publicClient"                       yaml:"publicClient"                       jsonschema:"Identify if client is public (cannot store secrets). Set true for SPA/Mobile."`
-	RequirePushedAuthorizationRequests bool                    `json:"requirePushedAuthorizationRequests" yaml:"requirePushedAuthorizationRequests" jsonschema:"Require Pushed Authorization Requests (PAR) per RFC 9126."`
-	DPoPBoundAccessTokens              bool                    `json:"dpopBoundAccessTokens"              yaml:"dpopBoundAccessTokens"              jsonschema:"Require DPoP-bound access tokens (RFC 9449)."`
-	IncludeActClaim                    bool                    `json:"includeActClaim"                    yaml:"includeActClaim"                    jsonschema:"Include an implicit on-behalf-of 'act' claim (identifying the application entity) in access tokens issued through this client's authorization code flow. Agents always include it regardless of this setting."`
-	Token                              *OAuthTokenConfig       `json:"token,omitempty"                    yaml:"token,omitempty"                    jsonschema:"Token configuration for access tokens and ID tokens"`
-	Scopes                             []string                `json:"scopes,omitempty"                   yaml:"scopes,omitempty"                   jsonschema:"Allowed OAuth scopes. Add custom scopes as needed for your application."`
-	UserInfo                           *UserInfoConfig         `json:"userInfo,omitempty"                 yaml:"userInfo,omitempty"                 jsonschema:"UserInfo endpoint configuration. Configure user attributes returned from the OIDC userinfo endpoint."`
-	ScopeClaims                        map[string][]string     `json:"scopeClaims,omitempty"              yaml:"scopeClaims,omitempty"              jsonschema:"Scope-to-claims mapping. Maps OAuth scopes to user claims for both ID token and userinfo."`
-	Certificate                        *Certificate            `json:"certificate,omitempty"              yaml:"certificate,omitempty"              jsonschema:"Application certificate. Optional. For certificate-based authentication or JWT validation."`
-	AcrValues                          []string                `json:"acrValues,omitempty"                yaml:"acrValues,omitempty"                jsonschema:"Default ACR values applied when the request does not specify acr_values."`
+	ClientID                           string                       `json:"clientId,omitempty"                 yaml:"clientId,omitempty"                 jsonschema:"OAuth client ID (auto-generated if not provided)"`
+	ClientSecret                       string                       `json:"clientSecret,omitempty"             yaml:"clientSecret,omitempty"             jsonschema:"OAuth client secret (auto-generated if not provided)"`
+	RedirectURIs                       []string                     `json:"redirectUris,omitempty"             yaml:"redirectUris,omitempty"             jsonschema:"Allowed redirect URIs. Required for Public (SPA/Mobile) and Confidential (Server) clients. Omit for M2M."`
+	PostLogoutRedirectURIs             []string                     `json:"postLogoutRedirectUris,omitempty"   yaml:"postLogoutRedirectUris,omitempty"   jsonschema:"Allowed post-logout redirect URIs. Optional. A post_logout_redirect_uri supplied to the logout endpoint must match one of these."`
+	BackchannelLogoutURI               string                       `json:"backchannelLogoutUri,omitempty"     yaml:"backchannelLogoutUri,omitempty"     jsonschema:"OIDC Back-Channel Logout endpoint. Optional. When set, a signed logout token is POSTed here whenever an SSO session this client joined ends."`
+	BackchannelLogoutSessionRequired   bool                         `json:"backchannelLogoutSessionRequired"   yaml:"backchannelLogoutSessionRequired"   jsonschema:"Require the sid claim in logout tokens (and ID tokens) sent to this client."`
+	FrontchannelLogoutURI              string                       `json:"frontchannelLogoutUri,omitempty"    yaml:"frontchannelLogoutUri,omitempty"    jsonschema:"OIDC Front-Channel Logout endpoint. Optional. When set, it is loaded in an iframe of the logout page whenever the End-User signs out of an SSO session this client joined."`
+	FrontchannelLogoutSessionRequired  bool                         `json:"frontchannelLogoutSessionRequired"  yaml:"frontchannelLogoutSessionRequired"  jsonschema:"Require the iss and sid query parameters on front-channel logout requests sent to this client."`
+	GrantTypes                         []GrantType                  `json:"grantTypes,omitempty"               yaml:"grantTypes,omitempty"               jsonschema:"OAuth grant types. Common: [authorization_code, refresh_token] for user apps, [client_credentials] for M2M."`
+	ResponseTypes                      []ResponseType               `json:"responseTypes,omitempty"            yaml:"responseTypes,omitempty"            jsonschema:"OAuth response types. Common: [code] for user apps. Omit for M2M."`
+	TokenEndpointAuthMethod            TokenEndpointAuthMethod      `json:"tokenEndpointAuthMethod,omitempty"  yaml:"tokenEndpointAuthMethod,omitempty"  jsonschema:"Client authentication method. Use 'none' for Public clients, 'client_secret_basic' for Confidential/M2M."`
+	PKCERequired                       bool                         `json:"pkceRequired"                       yaml:"pkceRequired"                       jsonschema:"Require PKCE for security. Recommended for all user-interactive flows."`
+	PublicClient                       bool                         `json:"publicClient"                       yaml:"publicClient"                       jsonschema:"Identify if client is public (cannot store secrets). Set true for SPA/Mobile."`
+	RequirePushedAuthorizationRequests bool                         `json:"requirePushedAuthorizationRequests" yaml:"requirePushedAuthorizationRequests" jsonschema:"Require Pushed Authorization Requests (PAR) per RFC 9126."`
+	RequireSignedRequestObject         bool                         `json:"requireSignedRequestObject"         yaml:"requireSignedRequestObject"         jsonschema:"Require authorization requests to be passed in a signed request object (RFC 9101)."`
+	DPoPBoundAccessTokens              bool                         `json:"dpopBoundAccessTokens"              yaml:"dpopBoundAccessTokens"              jsonschema:"Require DPoP-bound access tokens (RFC 9449)."`
+	MTLSBoundAccessTokens              bool                         `json:"tlsClientCertificateBoundAccessTokens" yaml:"tlsClientCertificateBoundAccessTokens" jsonschema:"Bind access tokens to the client's mutual-TLS certificate (RFC 8705)."`
+	SecurityProfile                    SecurityProfile              `json:"securityProfile,omitempty"          yaml:"securityProfile,omitempty"          jsonschema:"Security profile enforced for the client. Use 'fapi2' for the FAPI 2.0 Security Profile or 'none' to opt out of a server-wide profile. Inherits the server-wide oauth.security_profile when omitted."`
+	SubjectType                        SubjectType                  `json:"subjectType,omitempty"              yaml:"subjectType,omitempty"              jsonschema:"OpenID Connect subject identifier type. Use 'pairwise' to issue a different 'sub' to each sector of clients. Defaults to 'public'."`
+	SectorIdentifierURI                string                       `json:"sectorIdentifierUri,omitempty"      yaml:"sectorIdentifierUri,omitempty"      jsonschema:"HTTPS URL of a JSON array of redirect URIs. Its host is the sector used to compute pairwise subjects. Required for pairwise clients whose redirect URIs span several hosts."`
+	IncludeActClaim                    bool                         `json:"includeActClaim"                    yaml:"includeActClaim"                    jsonschema:"Include an implicit on-behalf-of 'act' claim (identifying the application entity) in access tokens issued through this client's authorization code flow. Agents always include it regardless of this setting."`
+	Token                              *OAuthTokenConfig            `json:"token,omitempty"                    yaml:"token,omitempty"                    jsonschema:"Token configuration for access tokens and ID tokens"`
+	Scopes                             []string                     `json:"scopes,omitempty"                   yaml:"scopes,omitempty"                   jsonschema:"Allowed OAuth scopes. Add custom scopes as needed for your application."`
+	UserInfo                           *UserInfoConfig              `json:"userInfo,omitempty"                 yaml:"userInfo,omitempty"                 jsonschema:"UserInfo endpoint configuration. Configure user attributes returned from the OIDC userinfo endpoint."`
+	AuthorizationResponse              *AuthorizationResponseConfig `json:"authorizationResponse,omitempty" yaml:"authorizationResponse,omitempty" jsonschema:"JWT Secured Authorization Response Mode (JARM) configuration. Optional. Sets how authorization responses delivered with a .jwt response_mode are signed and encrypted."`
+	TLSClientAuth                      *TLSClientAuthConfig         `json:"tlsClientAuth,omitempty"            yaml:"tlsClientAuth,omitempty"            jsonschema:"Mutual-TLS client authentication configuration. Required for tls_client_auth; names the certificate subject the client authenticates with."`
+	ScopeClaims                        map[string][]string          `json:"scopeClaims,omitempty"              yaml:"scopeClaims,omitempty"              jsonschema:"Scope-to-claims mapping. Maps OAuth scopes to user claims for both ID token and userinfo."`
+	Certificate                        *Certificate                 `json:"certificate,omitempty"              yaml:"certificate,omitempty"              jsonschema:"Application certificate. Optional. For certificate-based authentication or JWT validation."`
+	AcrValues                          []string                     `json:"acrValues,omitempty"                yaml:"acrValues,omitempty"                jsonschema:"Default ACR values applied when the request does not specify acr_values."`
+
+	// RegistrationTokenHash carries the hash of a newly issued registration access token from dynamic
+	// client registration to the stored OAuth profile. It is never read from or written to the wire.
+	RegistrationTokenHash string `json:"-" yaml:"-"`
 }
 
 // InboundAuthConfigWithSecret is the wire input wrapper and create/update echo response wrapper.
 type InboundAuthConfigWithSecret struct {
-	Type        InboundAuthType        `json:"type"             yaml:"type"             jsonschema:"Inbound authentication type. Use 'oauth2' for OAuth/OIDC applications."`
-	OAuthConfig *OAuthConfigWithSecret `json:"config,omitempty" yaml:"config,omitempty" jsonschema:"OAuth/OIDC configuration. Required when type is 'oauth2'. Defines OAuth grant types, redirect URIs, client authentication, and PKCE settings."`
+	Type        InboundAuthType        `json:"type"                 yaml:"type"                 jsonschema:"Inbound authentication type. Use 'oauth2' for OAuth/OIDC applications and 'saml2' for SAML 2.0 service providers."`
+	OAuthConfig *OAuthConfigWithSecret `json:"config,omitempty"     yaml:"config,omitempty"     jsonschema:"OAuth/OIDC configuration. Required when type is 'oauth2'. Defines OAuth grant types, redirect URIs, client authentication, and PKCE settings."`
+	SAMLConfig  *SAMLProfile           `json:"samlConfig,omitempty" yaml:"samlConfig,omitempty" jsonschema:"SAML 2.0 service provider configuration. Required when type is 'saml2'."`
 }
 
 // InitiatorRequest holds the original HTTP request data that triggered the flow.
@@ -59,12 +59,56 @@ func (o *OAuthClient) ValidatePostLogoutRedirectURI(ctx context.Context, postLog
 
 // RequiresPKCE reports whether PKCE is required for this client.
 func (o *OAuthClient) RequiresPKCE() bool {
-	return o.PKCERequired || o.PublicClient
+	return o.PKCERequired || o.PublicClient || o.IsFAPI2()
 }
 
 // RequiresPAR reports whether pushed authorization requests are required for this client.
 func (o *OAuthClient) RequiresPAR() bool {
-	return o.RequirePushedAuthorizationRequests || config.GetServerRuntime().Config.OAuth.PAR.RequirePAR
+	return o.RequirePushedAuthorizationRequests || config.GetServerRuntime().Config.OAuth.PAR.RequirePAR ||
+		o.IsFAPI2()
+}
+
+// EffectiveSecurityProfile returns the security profile enforced for this client: its own setting
+// when present, otherwise the server-wide oauth.security_profile.
+func (o *OAuthClient) EffectiveSecurityProfile() SecurityProfile {
+	if o != nil && o.SecurityProfile != "" {
+		return o.SecurityProfile
+	}
+	if !config.IsServerRuntimeInitialized() {
+		return SecurityProfileNone
+	}
+	if profile := config.GetServerRuntime().Config.OAuth.SecurityProfile; profile != "" {
+		return SecurityProfile(profile)
+	}
+	return SecurityProfileNone
+}
+
+// IsFAPI2 reports whether the FAPI 2.0 Security Profile is enforced for this client.
+func (o *OAuthClient) IsFAPI2() bool {
+	return o.EffectiveSecurityProfile() == SecurityProfileFAPI2
+}
+
+// IsPairwise reports whether this client receives pairwise subject identifiers.
+func (o *OAuthClient) IsPairwise() bool {
+	return o != nil && o.SubjectType == SubjectTypePairwise
+}
+
+// SectorIdentifier returns the sector the pairwise subject identifiers of this client are computed
+// for: the host of the sector_identifier_uri when one is registered, otherwise the host of the
+// registered redirect URIs (OpenID Connect Core 1.0 §8.1). It falls back to the client ID for a
+// client without redirect URIs.
+func (o *OAuthClient) SectorIdentifier() string {
+	if o.SectorIdentifierURI != "" {
+		if parsed, err := url.Parse(o.SectorIdentifierURI); err == nil && parsed.Hostname() != "" {
+			return parsed.Hostname()
+		}
+	}
+	for _, redirectURI := range o.RedirectURIs {
+		if parsed, err := url.Parse(redirectURI); err == nil && parsed.Hostname() != "" {
+			return parsed.Hostname()
+		}
+	}
+	return o.ClientID
 }
 
 // ShouldAppendActorClaim reports whether an implicit OBO act claim should be added to
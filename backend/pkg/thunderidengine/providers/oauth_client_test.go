@@ -92,6 +92,9 @@ func (suite *OAuthClientTestSuite) TestOAuthClient_RequiresPKCE() {
 	suite.T().Run("neither flag set", func(t *testing.T) {
 		assert.False(t, (&OAuthClient{}).RequiresPKCE())
 	})
+	suite.T().Run("FAPI 2.0 security profile", func(t *testing.T) {
+		assert.True(t, (&OAuthClient{SecurityProfile: SecurityProfileFAPI2}).RequiresPKCE())
+	})
 }
 
 func (suite *OAuthClientTestSuite) TestOAuthClient_ShouldAppendActorClaim() {
@@ -141,6 +144,65 @@ func (suite *OAuthClientTestSuite) TestOAuthClient_RequiresPAR() {
 		suite.setupRuntime(t, engineconfig.OAuthConfig{PAR: engineconfig.PARConfig{RequirePAR: false}})
 		assert.False(t, (&OAuthClient{RequirePushedAuthorizationRequests: false}).RequiresPAR())
 	})
+
+	suite.T().Run("FAPI 2.0 security profile forces PAR", func(t *testing.T) {
+		suite.setupRuntime(t, engineconfig.OAuthConfig{})
+		assert.True(t, (&OAuthClient{SecurityProfile: SecurityProfileFAPI2}).RequiresPAR())
+	})
+}
+
+func (suite *OAuthClientTestSuite) TestOAuthClient_EffectiveSecurityProfile() {
+	suite.T().Run("defaults to none", func(t *testing.T) {
+		suite.setupRuntime(t, engineconfig.OAuthConfig{})
+		client := &OAuthClient{}
+		assert.Equal(t, SecurityProfileNone, client.EffectiveSecurityProfile())
+		assert.False(t, client.IsFAPI2())
+	})
+
+	suite.T().Run("inherits the global profile", func(t *testing.T) {
+		suite.setupRuntime(t, engineconfig.OAuthConfig{SecurityProfile: "fapi2"})
+		assert.True(t, (&OAuthClient{}).IsFAPI2())
+	})
+
+	suite.T().Run("client profile overrides the global profile", func(t *testing.T) {
+		suite.setupRuntime(t, engineconfig.OAuthConfig{SecurityProfile: "fapi2"})
+		assert.False(t, (&OAuthClient{SecurityProfile: SecurityProfileNone}).IsFAPI2())
+	})
+
+	suite.T().Run("nil client", func(t *testing.T) {
+		suite.setupRuntime(t, engineconfig.OAuthConfig{})
+		var client *OAuthClient
+		assert.False(t, client.IsFAPI2())
+	})
+}
+
+func (suite *OAuthClientTestSuite) TestOAuthClient_IsPairwise() {
+	var nilClient *OAuthClient
+	assert.False(suite.T(), nilClient.IsPairwise())
+	assert.False(suite.T(), (&OAuthClient{}).IsPairwise())
+	assert.False(suite.T(), (&OAuthClient{SubjectType: SubjectTypePublic}).IsPairwise())
+	assert.True(suite.T(), (&OAuthClient{SubjectType: SubjectTypePairwise}).IsPairwise())
+}
+
+func (suite *OAuthClientTestSuite) TestOAuthClient_SectorIdentifier() {
+	client := &OAuthClient{
+		ClientID:            "client1",
+		RedirectURIs:        []string{"https://app.example.com/callback"},
+		SectorIdentifierURI: "https://sector.example.com/redirect_uris.json",
+	}
+	assert.Equal(suite.T(), "sector.example.com", client.SectorIdentifier())
+
+	client.SectorIdentifierURI = ""
+	assert.Equal(suite.T(), "app.example.com", client.SectorIdentifier())
+
+	client.RedirectURIs = nil
+	assert.Equal(suite.T(), "client1", client.SectorIdentifier())
+}
+
+func (suite *OAuthClientTestSuite) TestSubjectType_IsValid() {
+	assert.True(suite.T(), SubjectTypePublic.IsValid())
+	assert.True(suite.T(), SubjectTypePairwise.IsValid())
+	assert.False(suite.T(), SubjectType("other").IsValid())
 }
 
 // ----- ValidateRedirectURI -----
@@ -146,8 +146,8 @@ func (_c *ConsentProviderMock_RecordConsent_Call) RunAndReturn(run func(ctx cont
 }
 
 // ResolveConsent provides a mock function for the type ConsentProviderMock
-func (_mock *ConsentProviderMock) ResolveConsent(ctx context.Context, ouID string, appID string, appName string, userID string, essentialAttributes []string, optionalAttributes []string, authorizedPermissions []string, availableAttributes *providers.AttributesResponse, forceReprompt bool, runtimeMetadata map[string][]string) (*providers.ConsentPromptData, *common.ServiceError) {
-	ret := _mock.Called(ctx, ouID, appID, appName, userID, essentialAttributes, optionalAttributes, authorizedPermissions, availableAttributes, forceReprompt, runtimeMetadata)
+func (_mock *ConsentProviderMock) ResolveConsent(ctx context.Context, ouID string, appID string, appName string, userID string, essentialAttributes []string, optionalAttributes []string, authorizedPermissions []string, authorizationDetails []string, availableAttributes *providers.AttributesResponse, forceReprompt bool, runtimeMetadata map[string][]string) (*providers.ConsentPromptData, *common.ServiceError) {
+	ret := _mock.Called(ctx, ouID, appID, appName, userID, essentialAttributes, optionalAttributes, authorizedPermissions, authorizationDetails, availableAttributes, forceReprompt, runtimeMetadata)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ResolveConsent")
@@ -155,18 +155,18 @@ func (_mock *ConsentProviderMock) ResolveConsent(ctx context.Context, ouID strin
 
 	var r0 *providers.ConsentPromptData
 	var r1 *common.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string, []string, []string, *providers.AttributesResponse, bool, map[string][]string) (*providers.ConsentPromptData, *common.ServiceError)); ok {
-		return returnFunc(ctx, ouID, appID, appName, userID, essentialAttributes, optionalAttributes, authorizedPermissions, availableAttributes, forceReprompt, runtimeMetadata)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string, []string, []string, []string, *providers.AttributesResponse, bool, map[string][]string) (*providers.ConsentPromptData, *common.ServiceError)); ok {
+		return returnFunc(ctx, ouID, appID, appName, userID, essentialAttributes, optionalAttributes, authorizedPermissions, authorizationDetails, availableAttributes, forceReprompt, runtimeMetadata)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string, []string, []string, *providers.AttributesResponse, bool, map[string][]string) *providers.ConsentPromptData); ok {
-		r0 = returnFunc(ctx, ouID, appID, appName, userID, essentialAttributes, optionalAttributes, authorizedPermissions, availableAttributes, forceReprompt, runtimeMetadata)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string, []string, []string, []string, *providers.AttributesResponse, bool, map[string][]string) *providers.ConsentPromptData); ok {
+		r0 = returnFunc(ctx, ouID, appID, appName, userID, essentialAttributes, optionalAttributes, authorizedPermissions, authorizationDetails, availableAttributes, forceReprompt, runtimeMetadata)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*providers.ConsentPromptData)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string, []string, []string, []string, *providers.AttributesResponse, bool, map[string][]string) *common.ServiceError); ok {
-		r1 = returnFunc(ctx, ouID, appID, appName, userID, essentialAttributes, optionalAttributes, authorizedPermissions, availableAttributes, forceReprompt, runtimeMetadata)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string, []string, []string, []string, []string, *providers.AttributesResponse, bool, map[string][]string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, ouID, appID, appName, userID, essentialAttributes, optionalAttributes, authorizedPermissions, authorizationDetails, availableAttributes, forceReprompt, runtimeMetadata)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*common.ServiceError)
@@ -189,14 +189,15 @@ type ConsentProviderMock_ResolveConsent_Call struct {
 //   - essentialAttributes []string
 //   - optionalAttributes []string
 //   - authorizedPermissions []string
+//   - authorizationDetails []string
 //   - availableAttributes *providers.AttributesResponse
 //   - forceReprompt bool
 //   - runtimeMetadata map[string][]string
-func (_e *ConsentProviderMock_Expecter) ResolveConsent(ctx interface{}, ouID interface{}, appID interface{}, appName interface{}, userID interface{}, essentialAttributes interface{}, optionalAttributes interface{}, authorizedPermissions interface{}, availableAttributes interface{}, forceReprompt interface{}, runtimeMetadata interface{}) *ConsentProviderMock_ResolveConsent_Call {
-	return &ConsentProviderMock_ResolveConsent_Call{Call: _e.mock.On("ResolveConsent", ctx, ouID, appID, appName, userID, essentialAttributes, optionalAttributes, authorizedPermissions, availableAttributes, forceReprompt, runtimeMetadata)}
+func (_e *ConsentProviderMock_Expecter) ResolveConsent(ctx interface{}, ouID interface{}, appID interface{}, appName interface{}, userID interface{}, essentialAttributes interface{}, optionalAttributes interface{}, authorizedPermissions interface{}, authorizationDetails interface{}, availableAttributes interface{}, forceReprompt interface{}, runtimeMetadata interface{}) *ConsentProviderMock_ResolveConsent_Call {
+	return &ConsentProviderMock_ResolveConsent_Call{Call: _e.mock.On("ResolveConsent", ctx, ouID, appID, appName, userID, essentialAttributes, optionalAttributes, authorizedPermissions, authorizationDetails, availableAttributes, forceReprompt, runtimeMetadata)}
 }
 
-func (_c *ConsentProviderMock_ResolveConsent_Call) Run(run func(ctx context.Context, ouID string, appID string, appName string, userID string, essentialAttributes []string, optionalAttributes []string, authorizedPermissions []string, availableAttributes *providers.AttributesResponse, forceReprompt bool, runtimeMetadata map[string][]string)) *ConsentProviderMock_ResolveConsent_Call {
+func (_c *ConsentProviderMock_ResolveConsent_Call) Run(run func(ctx context.Context, ouID string, appID string, appName string, userID string, essentialAttributes []string, optionalAttributes []string, authorizedPermissions []string, authorizationDetails []string, availableAttributes *providers.AttributesResponse, forceReprompt bool, runtimeMetadata map[string][]string)) *ConsentProviderMock_ResolveConsent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -230,17 +231,21 @@ func (_c *ConsentProviderMock_ResolveConsent_Call) Run(run func(ctx context.Cont
 		if args[7] != nil {
 			arg7 = args[7].([]string)
 		}
-		var arg8 *providers.AttributesResponse
+		var arg8 []string
 		if args[8] != nil {
-			arg8 = args[8].(*providers.AttributesResponse)
+			arg8 = args[8].([]string)
 		}
-		var arg9 bool
+		var arg9 *providers.AttributesResponse
 		if args[9] != nil {
-			arg9 = args[9].(bool)
+			arg9 = args[9].(*providers.AttributesResponse)
 		}
-		var arg10 map[string][]string
+		var arg10 bool
 		if args[10] != nil {
-			arg10 = args[10].(map[string][]string)
+			arg10 = args[10].(bool)
+		}
+		var arg11 map[string][]string
+		if args[11] != nil {
+			arg11 = args[11].(map[string][]string)
 		}
 		run(
 			arg0,
@@ -254,6 +259,7 @@ func (_c *ConsentProviderMock_ResolveConsent_Call) Run(run func(ctx context.Cont
 			arg8,
 			arg9,
 			arg10,
+			arg11,
 		)
 	})
 	return _c
@@ -264,7 +270,7 @@ func (_c *ConsentProviderMock_ResolveConsent_Call) Return(consentPromptData *pro
 	return _c
 }
 
-func (_c *ConsentProviderMock_ResolveConsent_Call) RunAndReturn(run func(ctx context.Context, ouID string, appID string, appName string, userID string, essentialAttributes []string, optionalAttributes []string, authorizedPermissions []string, availableAttributes *providers.AttributesResponse, forceReprompt bool, runtimeMetadata map[string][]string) (*providers.ConsentPromptData, *common.ServiceError)) *ConsentProviderMock_ResolveConsent_Call {
+func (_c *ConsentProviderMock_ResolveConsent_Call) RunAndReturn(run func(ctx context.Context, ouID string, appID string, appName string, userID string, essentialAttributes []string, optionalAttributes []string, authorizedPermissions []string, authorizationDetails []string, availableAttributes *providers.AttributesResponse, forceReprompt bool, runtimeMetadata map[string][]string) (*providers.ConsentPromptData, *common.ServiceError)) *ConsentProviderMock_ResolveConsent_Call {
 	_c.Call.Return(run)
 	return _c
 }
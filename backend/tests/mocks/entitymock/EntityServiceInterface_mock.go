@@ -319,6 +319,76 @@ func (_c *EntityServiceInterfaceMock_DeleteEntity_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// GetCredentialAlgorithmUsage provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) GetCredentialAlgorithmUsage(ctx context.Context, category providers.EntityCategory) ([]entity.CredentialAlgorithmUsage, error) {
+	ret := _mock.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredentialAlgorithmUsage")
+	}
+
+	var r0 []entity.CredentialAlgorithmUsage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, providers.EntityCategory) ([]entity.CredentialAlgorithmUsage, error)); ok {
+		return returnFunc(ctx, category)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, providers.EntityCategory) []entity.CredentialAlgorithmUsage); ok {
+		r0 = returnFunc(ctx, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CredentialAlgorithmUsage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, providers.EntityCategory) error); ok {
+		r1 = returnFunc(ctx, category)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(error)
+		}
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_GetCredentialAlgorithmUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialAlgorithmUsage'
+type EntityServiceInterfaceMock_GetCredentialAlgorithmUsage_Call struct {
+	*mock.Call
+}
+
+// GetCredentialAlgorithmUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category providers.EntityCategory
+func (_e *EntityServiceInterfaceMock_Expecter) GetCredentialAlgorithmUsage(ctx interface{}, category interface{}) *EntityServiceInterfaceMock_GetCredentialAlgorithmUsage_Call {
+	return &EntityServiceInterfaceMock_GetCredentialAlgorithmUsage_Call{Call: _e.mock.On("GetCredentialAlgorithmUsage", ctx, category)}
+}
+
+func (_c *EntityServiceInterfaceMock_GetCredentialAlgorithmUsage_Call) Run(run func(ctx context.Context, category providers.EntityCategory)) *EntityServiceInterfaceMock_GetCredentialAlgorithmUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 providers.EntityCategory
+		if args[1] != nil {
+			arg1 = args[1].(providers.EntityCategory)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetCredentialAlgorithmUsage_Call) Return(credentialAlgorithmUsages []entity.CredentialAlgorithmUsage, err error) *EntityServiceInterfaceMock_GetCredentialAlgorithmUsage_Call {
+	_c.Call.Return(credentialAlgorithmUsages, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetCredentialAlgorithmUsage_Call) RunAndReturn(run func(ctx context.Context, category providers.EntityCategory) ([]entity.CredentialAlgorithmUsage, error)) *EntityServiceInterfaceMock_GetCredentialAlgorithmUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCredentialsByType provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) GetCredentialsByType(ctx context.Context, entityID string, credType string) ([]entity.StoredCredential, error) {
 	ret := _mock.Called(ctx, entityID, credType)
@@ -695,6 +765,98 @@ func (_c *EntityServiceInterfaceMock_GetEntityList_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetEntityListAfterID provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) GetEntityListAfterID(ctx context.Context, category providers.EntityCategory, ouIDs []string, limit int, afterID string, filters map[string]interface{}) ([]providers.Entity, error) {
+	ret := _mock.Called(ctx, category, ouIDs, limit, afterID, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntityListAfterID")
+	}
+
+	var r0 []providers.Entity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, providers.EntityCategory, []string, int, string, map[string]interface{}) ([]providers.Entity, error)); ok {
+		return returnFunc(ctx, category, ouIDs, limit, afterID, filters)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, providers.EntityCategory, []string, int, string, map[string]interface{}) []providers.Entity); ok {
+		r0 = returnFunc(ctx, category, ouIDs, limit, afterID, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]providers.Entity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, providers.EntityCategory, []string, int, string, map[string]interface{}) error); ok {
+		r1 = returnFunc(ctx, category, ouIDs, limit, afterID, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_GetEntityListAfterID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntityListAfterID'
+type EntityServiceInterfaceMock_GetEntityListAfterID_Call struct {
+	*mock.Call
+}
+
+// GetEntityListAfterID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category providers.EntityCategory
+//   - ouIDs []string
+//   - limit int
+//   - afterID string
+//   - filters map[string]interface{}
+func (_e *EntityServiceInterfaceMock_Expecter) GetEntityListAfterID(ctx interface{}, category interface{}, ouIDs interface{}, limit interface{}, afterID interface{}, filters interface{}) *EntityServiceInterfaceMock_GetEntityListAfterID_Call {
+	return &EntityServiceInterfaceMock_GetEntityListAfterID_Call{Call: _e.mock.On("GetEntityListAfterID", ctx, category, ouIDs, limit, afterID, filters)}
+}
+
+func (_c *EntityServiceInterfaceMock_GetEntityListAfterID_Call) Run(run func(ctx context.Context, category providers.EntityCategory, ouIDs []string, limit int, afterID string, filters map[string]interface{})) *EntityServiceInterfaceMock_GetEntityListAfterID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 providers.EntityCategory
+		if args[1] != nil {
+			arg1 = args[1].(providers.EntityCategory)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 map[string]interface{}
+		if args[5] != nil {
+			arg5 = args[5].(map[string]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetEntityListAfterID_Call) Return(entitys []providers.Entity, err error) *EntityServiceInterfaceMock_GetEntityListAfterID_Call {
+	_c.Call.Return(entitys, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetEntityListAfterID_Call) RunAndReturn(run func(ctx context.Context, category providers.EntityCategory, ouIDs []string, limit int, afterID string, filters map[string]interface{}) ([]providers.Entity, error)) *EntityServiceInterfaceMock_GetEntityListAfterID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntityListByOUIDs provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) GetEntityListByOUIDs(ctx context.Context, category providers.EntityCategory, ouIDs []string, limit int, offset int, filters map[string]interface{}) ([]providers.Entity, error) {
 	ret := _mock.Called(ctx, category, ouIDs, limit, offset, filters)
@@ -1139,6 +1301,82 @@ func (_c *EntityServiceInterfaceMock_IdentifyEntity_Call) RunAndReturn(run func(
 	return _c
 }
 
+// ImportEntity provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) ImportEntity(ctx context.Context, entity1 *providers.Entity, credentials map[string][]entity.StoredCredential) (*providers.Entity, error) {
+	ret := _mock.Called(ctx, entity1, credentials)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportEntity")
+	}
+
+	var r0 *providers.Entity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *providers.Entity, map[string][]entity.StoredCredential) (*providers.Entity, error)); ok {
+		return returnFunc(ctx, entity1, credentials)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *providers.Entity, map[string][]entity.StoredCredential) *providers.Entity); ok {
+		r0 = returnFunc(ctx, entity1, credentials)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*providers.Entity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *providers.Entity, map[string][]entity.StoredCredential) error); ok {
+		r1 = returnFunc(ctx, entity1, credentials)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(error)
+		}
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_ImportEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportEntity'
+type EntityServiceInterfaceMock_ImportEntity_Call struct {
+	*mock.Call
+}
+
+// ImportEntity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity1 *providers.Entity
+//   - credentials map[string][]entity.StoredCredential
+func (_e *EntityServiceInterfaceMock_Expecter) ImportEntity(ctx interface{}, entity1 interface{}, credentials interface{}) *EntityServiceInterfaceMock_ImportEntity_Call {
+	return &EntityServiceInterfaceMock_ImportEntity_Call{Call: _e.mock.On("ImportEntity", ctx, entity1, credentials)}
+}
+
+func (_c *EntityServiceInterfaceMock_ImportEntity_Call) Run(run func(ctx context.Context, entity1 *providers.Entity, credentials map[string][]entity.StoredCredential)) *EntityServiceInterfaceMock_ImportEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *providers.Entity
+		if args[1] != nil {
+			arg1 = args[1].(*providers.Entity)
+		}
+		var arg2 map[string][]entity.StoredCredential
+		if args[2] != nil {
+			arg2 = args[2].(map[string][]entity.StoredCredential)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_ImportEntity_Call) Return(entity11 *providers.Entity, err error) *EntityServiceInterfaceMock_ImportEntity_Call {
+	_c.Call.Return(entity11, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_ImportEntity_Call) RunAndReturn(run func(ctx context.Context, entity1 *providers.Entity, credentials map[string][]entity.StoredCredential) (*providers.Entity, error)) *EntityServiceInterfaceMock_ImportEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsEntityDeclarative provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) IsEntityDeclarative(ctx context.Context, entityID string) (bool, error) {
 	ret := _mock.Called(ctx, entityID)
@@ -1375,6 +1613,46 @@ func (_c *EntityServiceInterfaceMock_SearchEntities_Call) RunAndReturn(run func(
 	return _c
 }
 
+// SetCredentialPolicy provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) SetCredentialPolicy(policy entity.CredentialPolicy) {
+	_mock.Called(policy)
+	return
+}
+
+// EntityServiceInterfaceMock_SetCredentialPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCredentialPolicy'
+type EntityServiceInterfaceMock_SetCredentialPolicy_Call struct {
+	*mock.Call
+}
+
+// SetCredentialPolicy is a helper method to define mock.On call
+//   - policy entity.CredentialPolicy
+func (_e *EntityServiceInterfaceMock_Expecter) SetCredentialPolicy(policy interface{}) *EntityServiceInterfaceMock_SetCredentialPolicy_Call {
+	return &EntityServiceInterfaceMock_SetCredentialPolicy_Call{Call: _e.mock.On("SetCredentialPolicy", policy)}
+}
+
+func (_c *EntityServiceInterfaceMock_SetCredentialPolicy_Call) Run(run func(policy entity.CredentialPolicy)) *EntityServiceInterfaceMock_SetCredentialPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entity.CredentialPolicy
+		if args[0] != nil {
+			arg0 = args[0].(entity.CredentialPolicy)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_SetCredentialPolicy_Call) Return() *EntityServiceInterfaceMock_SetCredentialPolicy_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_SetCredentialPolicy_Call) RunAndReturn(run func(policy entity.CredentialPolicy)) *EntityServiceInterfaceMock_SetCredentialPolicy_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetGroupMembershipProvider provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) SetGroupMembershipProvider(provider entity.GroupMembershipProvider) {
 	_mock.Called(provider)
@@ -162,6 +162,76 @@ func (_c *EntityProviderInterfaceMock_DeleteEntity_Call) RunAndReturn(run func(e
 	return _c
 }
 
+// GetCredentials provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) GetCredentials(entityID string, credentialType string) (json.RawMessage, *entityprovider.EntityProviderError) {
+	ret := _mock.Called(entityID, credentialType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredentials")
+	}
+
+	var r0 json.RawMessage
+	var r1 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(string, string) (json.RawMessage, *entityprovider.EntityProviderError)); ok {
+		return returnFunc(entityID, credentialType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) json.RawMessage); ok {
+		r0 = returnFunc(entityID, credentialType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(json.RawMessage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) *entityprovider.EntityProviderError); ok {
+		r1 = returnFunc(entityID, credentialType)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityProviderInterfaceMock_GetCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentials'
+type EntityProviderInterfaceMock_GetCredentials_Call struct {
+	*mock.Call
+}
+
+// GetCredentials is a helper method to define mock.On call
+//   - entityID string
+//   - credentialType string
+func (_e *EntityProviderInterfaceMock_Expecter) GetCredentials(entityID interface{}, credentialType interface{}) *EntityProviderInterfaceMock_GetCredentials_Call {
+	return &EntityProviderInterfaceMock_GetCredentials_Call{Call: _e.mock.On("GetCredentials", entityID, credentialType)}
+}
+
+func (_c *EntityProviderInterfaceMock_GetCredentials_Call) Run(run func(entityID string, credentialType string)) *EntityProviderInterfaceMock_GetCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetCredentials_Call) Return(v json.RawMessage, entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_GetCredentials_Call {
+	_c.Call.Return(v, entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetCredentials_Call) RunAndReturn(run func(entityID string, credentialType string) (json.RawMessage, *entityprovider.EntityProviderError)) *EntityProviderInterfaceMock_GetCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntitiesByIDs provides a mock function for the type EntityProviderInterfaceMock
 func (_mock *EntityProviderInterfaceMock) GetEntitiesByIDs(entityIDs []string) ([]providers.Entity, *entityprovider.EntityProviderError) {
 	ret := _mock.Called(entityIDs)
@@ -113,6 +113,80 @@ func (_c *ServiceMock_FindCheckpoint_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// ListSubjectSessions provides a mock function for the type ServiceMock
+func (_mock *ServiceMock) ListSubjectSessions(ctx context.Context, subjectID string, now time.Time) ([]session.SessionDetail, error) {
+	ret := _mock.Called(ctx, subjectID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubjectSessions")
+	}
+
+	var r0 []session.SessionDetail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]session.SessionDetail, error)); ok {
+		return returnFunc(ctx, subjectID, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) []session.SessionDetail); ok {
+		r0 = returnFunc(ctx, subjectID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]session.SessionDetail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, subjectID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ServiceMock_ListSubjectSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubjectSessions'
+type ServiceMock_ListSubjectSessions_Call struct {
+	*mock.Call
+}
+
+// ListSubjectSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subjectID string
+//   - now time.Time
+func (_e *ServiceMock_Expecter) ListSubjectSessions(ctx interface{}, subjectID interface{}, now interface{}) *ServiceMock_ListSubjectSessions_Call {
+	return &ServiceMock_ListSubjectSessions_Call{Call: _e.mock.On("ListSubjectSessions", ctx, subjectID, now)}
+}
+
+func (_c *ServiceMock_ListSubjectSessions_Call) Run(run func(ctx context.Context, subjectID string, now time.Time)) *ServiceMock_ListSubjectSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ServiceMock_ListSubjectSessions_Call) Return(sessionDetails []session.SessionDetail, err error) *ServiceMock_ListSubjectSessions_Call {
+	_c.Call.Return(sessionDetails, err)
+	return _c
+}
+
+func (_c *ServiceMock_ListSubjectSessions_Call) RunAndReturn(run func(ctx context.Context, subjectID string, now time.Time) ([]session.SessionDetail, error)) *ServiceMock_ListSubjectSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadCheckpoint provides a mock function for the type ServiceMock
 func (_mock *ServiceMock) LoadCheckpoint(ctx context.Context, in session.LoadCheckpointInput) (*session.Session, *session.SessionContext, error) {
 	ret := _mock.Called(ctx, in)
@@ -471,3 +545,149 @@ func (_c *ServiceMock_TerminateBySubject_Call) RunAndReturn(run func(ctx context
 	_c.Call.Return(run)
 	return _c
 }
+
+// TerminateOtherSubjectSessions provides a mock function for the type ServiceMock
+func (_mock *ServiceMock) TerminateOtherSubjectSessions(ctx context.Context, subjectID string, keepSID string) (int, error) {
+	ret := _mock.Called(ctx, subjectID, keepSID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TerminateOtherSubjectSessions")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (int, error)); ok {
+		return returnFunc(ctx, subjectID, keepSID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = returnFunc(ctx, subjectID, keepSID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, subjectID, keepSID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ServiceMock_TerminateOtherSubjectSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TerminateOtherSubjectSessions'
+type ServiceMock_TerminateOtherSubjectSessions_Call struct {
+	*mock.Call
+}
+
+// TerminateOtherSubjectSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subjectID string
+//   - keepSID string
+func (_e *ServiceMock_Expecter) TerminateOtherSubjectSessions(ctx interface{}, subjectID interface{}, keepSID interface{}) *ServiceMock_TerminateOtherSubjectSessions_Call {
+	return &ServiceMock_TerminateOtherSubjectSessions_Call{Call: _e.mock.On("TerminateOtherSubjectSessions", ctx, subjectID, keepSID)}
+}
+
+func (_c *ServiceMock_TerminateOtherSubjectSessions_Call) Run(run func(ctx context.Context, subjectID string, keepSID string)) *ServiceMock_TerminateOtherSubjectSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ServiceMock_TerminateOtherSubjectSessions_Call) Return(n int, err error) *ServiceMock_TerminateOtherSubjectSessions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *ServiceMock_TerminateOtherSubjectSessions_Call) RunAndReturn(run func(ctx context.Context, subjectID string, keepSID string) (int, error)) *ServiceMock_TerminateOtherSubjectSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TerminateSubjectSession provides a mock function for the type ServiceMock
+func (_mock *ServiceMock) TerminateSubjectSession(ctx context.Context, subjectID string, sid string) (*session.Session, error) {
+	ret := _mock.Called(ctx, subjectID, sid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TerminateSubjectSession")
+	}
+
+	var r0 *session.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*session.Session, error)); ok {
+		return returnFunc(ctx, subjectID, sid)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *session.Session); ok {
+		r0 = returnFunc(ctx, subjectID, sid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, subjectID, sid)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ServiceMock_TerminateSubjectSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TerminateSubjectSession'
+type ServiceMock_TerminateSubjectSession_Call struct {
+	*mock.Call
+}
+
+// TerminateSubjectSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subjectID string
+//   - sid string
+func (_e *ServiceMock_Expecter) TerminateSubjectSession(ctx interface{}, subjectID interface{}, sid interface{}) *ServiceMock_TerminateSubjectSession_Call {
+	return &ServiceMock_TerminateSubjectSession_Call{Call: _e.mock.On("TerminateSubjectSession", ctx, subjectID, sid)}
+}
+
+func (_c *ServiceMock_TerminateSubjectSession_Call) Run(run func(ctx context.Context, subjectID string, sid string)) *ServiceMock_TerminateSubjectSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ServiceMock_TerminateSubjectSession_Call) Return(session1 *session.Session, err error) *ServiceMock_TerminateSubjectSession_Call {
+	_c.Call.Return(session1, err)
+	return _c
+}
+
+func (_c *ServiceMock_TerminateSubjectSession_Call) RunAndReturn(run func(ctx context.Context, subjectID string, sid string) (*session.Session, error)) *ServiceMock_TerminateSubjectSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,6 +39,74 @@ func (_m *TokenValidatorInterfaceMock) EXPECT() *TokenValidatorInterfaceMock_Exp
 	return &TokenValidatorInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// IntrospectAccessToken provides a mock function for the type TokenValidatorInterfaceMock
+func (_mock *TokenValidatorInterfaceMock) IntrospectAccessToken(ctx context.Context, token string) (*tokenservice.AccessTokenClaims, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IntrospectAccessToken")
+	}
+
+	var r0 *tokenservice.AccessTokenClaims
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*tokenservice.AccessTokenClaims, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *tokenservice.AccessTokenClaims); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tokenservice.AccessTokenClaims)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenValidatorInterfaceMock_IntrospectAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IntrospectAccessToken'
+type TokenValidatorInterfaceMock_IntrospectAccessToken_Call struct {
+	*mock.Call
+}
+
+// IntrospectAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *TokenValidatorInterfaceMock_Expecter) IntrospectAccessToken(ctx interface{}, token interface{}) *TokenValidatorInterfaceMock_IntrospectAccessToken_Call {
+	return &TokenValidatorInterfaceMock_IntrospectAccessToken_Call{Call: _e.mock.On("IntrospectAccessToken", ctx, token)}
+}
+
+func (_c *TokenValidatorInterfaceMock_IntrospectAccessToken_Call) Run(run func(ctx context.Context, token string)) *TokenValidatorInterfaceMock_IntrospectAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenValidatorInterfaceMock_IntrospectAccessToken_Call) Return(accessTokenClaims *tokenservice.AccessTokenClaims, err error) *TokenValidatorInterfaceMock_IntrospectAccessToken_Call {
+	_c.Call.Return(accessTokenClaims, err)
+	return _c
+}
+
+func (_c *TokenValidatorInterfaceMock_IntrospectAccessToken_Call) RunAndReturn(run func(ctx context.Context, token string) (*tokenservice.AccessTokenClaims, error)) *TokenValidatorInterfaceMock_IntrospectAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateAccessToken provides a mock function for the type TokenValidatorInterfaceMock
 func (_mock *TokenValidatorInterfaceMock) ValidateAccessToken(ctx context.Context, token string) (*tokenservice.AccessTokenClaims, error) {
 	ret := _mock.Called(ctx, token)
@@ -850,6 +850,16 @@ const sidebars: SidebarsConfig = {
                       id: 'guides/protocols/oauth-oidc/resource-indicators',
                       label: 'Resource Indicators',
                     },
+                    {
+                      type: 'doc',
+                      id: 'guides/protocols/oauth-oidc/rich-authorization-requests',
+                      label: 'Rich Authorization Requests',
+                    },
+                    {
+                      type: 'doc',
+                      id: 'guides/protocols/oauth-oidc/fapi2',
+                      label: 'FAPI 2.0 Security Profile',
+                    },
                   ],
                 },
                 {
@@ -895,6 +905,11 @@ const sidebars: SidebarsConfig = {
                     {type: 'doc', id: 'guides/protocols/oauth-oidc/userinfo', label: 'UserInfo'},
                     {type: 'doc', id: 'guides/protocols/oauth-oidc/claims-and-scopes', label: 'Claims & Scopes'},
                     {type: 'doc', id: 'guides/protocols/oauth-oidc/token-formats', label: 'Token Formats'},
+                    {
+                      type: 'doc',
+                      id: 'guides/protocols/oauth-oidc/pairwise-subject-identifiers',
+                      label: 'Pairwise Subject Identifiers',
+                    },
                   ],
                 },
               ],